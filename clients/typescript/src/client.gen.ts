@@ -0,0 +1,3921 @@
+// Code generated by tsgen from the OpenAPI document of the API. DO NOT EDIT.
+/* eslint-disable */
+
+export interface AcceptOrganizationInviteRequest {
+  /**
+   * Ticket of the invitation email
+   */
+  ticket: string;
+}
+
+export interface AdminPasswordResetRequest {
+  options?: OptionsRedirectTo;
+}
+
+export interface AdminRole {
+  role: string;
+  /**
+   * Number of users with the role
+   */
+  users: number;
+}
+
+export interface AdminRoleRequest {
+  /**
+   * Name of the role
+   */
+  role: string;
+}
+
+export interface AdminRolesResponse {
+  roles: Array<AdminRole>;
+}
+
+export interface AdminSetPasswordRequest {
+  /**
+   * New password of the user, mutually exclusive with passwordHash
+   */
+  password?: string;
+  /**
+   * Hash of the new password of the user, in the bcrypt format or in the PHC string format of argon2, mutually exclusive with password
+   */
+  passwordHash?: string;
+  /**
+   * Revoke all the sessions of the user
+   */
+  revokeSessions?: boolean;
+}
+
+export interface AdminSignInMethod {
+  /**
+   * Whether the method is enabled in the configuration
+   */
+  configured: boolean;
+  /**
+   * Whether users can sign in with the method, it has to be configured and not disabled at runtime
+   */
+  enabled: boolean;
+  /**
+   * Name of the sign in method
+   */
+  method: string;
+}
+
+export interface AdminSignInMethodRequest {
+  /**
+   * Whether users can sign in with the method
+   */
+  enabled: boolean;
+}
+
+export interface AdminSignInMethodsResponse {
+  methods: Array<AdminSignInMethod>;
+}
+
+export interface AdminUser {
+  avatarUrl: string;
+  banReason?: string;
+  /**
+   * When the user was banned, missing if the user isn't banned
+   */
+  bannedAt?: string;
+  /**
+   * When the ban expires, missing if the ban is permanent
+   */
+  bannedUntil?: string;
+  createdAt: string;
+  defaultRole: string;
+  disabled: boolean;
+  displayName: string;
+  email?: string;
+  emailVerified: boolean;
+  id: string;
+  isAnonymous: boolean;
+  /**
+   * Last time the user signed in or refreshed their session
+   */
+  lastSeen?: string;
+  locale: string;
+  metadata: Record<string, unknown>;
+  phoneNumber?: string;
+  phoneNumberVerified: boolean;
+  /**
+   * Providers the user is linked to
+   */
+  providers: Array<string>;
+  roles: Array<string>;
+}
+
+export interface AdminUsersResponse {
+  /**
+   * Cursor to get the next page, missing on the last page
+   */
+  nextCursor?: string;
+  users: Array<AdminUser>;
+}
+
+export type AdminUsersSortBy = 'createdAt' | 'email' | 'displayName';
+
+export interface AuditLog {
+  /**
+   * Who performed the action, the user or an administrator using the admin secret
+   */
+  actor: 'user' | 'admin';
+  createdAt: string;
+  /**
+   * Authentication event
+   */
+  event: string;
+  id: string;
+  ipAddress: string;
+  /**
+   * Details of the event, like the operation, the email or phone number used and the error returned
+   */
+  metadata: Record<string, unknown>;
+  outcome: 'success' | 'failure';
+  userAgent: string;
+  /**
+   * ID of the user the event is about, if known
+   */
+  userId?: string;
+}
+
+export interface AuditLogsResponse {
+  logs: Array<AuditLog>;
+  /**
+   * Number of entries matching the filters
+   */
+  total: number;
+}
+
+export interface BanUserRequest {
+  /**
+   * When the ban expires, the ban is permanent if missing
+   */
+  expiresAt?: string;
+  /**
+   * Why the user is banned, only visible to administrators
+   */
+  reason: string;
+}
+
+export interface ConfigReloadResponse {
+  /**
+   * Environment variables whose new value is being used
+   */
+  changed: Array<string>;
+  /**
+   * Environment variables that changed but can't be reloaded, they keep their value until the service restarts
+   */
+  ignored: Array<string>;
+}
+
+export interface CreateOAuth2ClientRequest {
+  allowedRoles: Array<string>;
+  defaultRole: string;
+  description?: string;
+  /**
+   * Grants the client can use to get access tokens. Defaults to authorization_code for clients with redirect URIs and to client_credentials for the others. The token exchange grant is enabled with tokenExchangeEnabled
+   */
+  grantTypes?: Array<'client_credentials' | 'authorization_code'>;
+  /**
+   * URIs users can be redirected to when the client signs them in with OpenID Connect
+   */
+  redirectUris?: Array<string>;
+  /**
+   * Whether users are signed in to the client without being asked for their consent, for first-party applications
+   */
+  skipConsent?: boolean;
+  /**
+   * Whether the client can exchange the access tokens of users for down-scoped ones
+   */
+  tokenExchangeEnabled?: boolean;
+}
+
+export interface CreateOAuth2ClientResponse {
+  clientId: string;
+  clientSecret: string;
+}
+
+export interface CreateOrganizationRequest {
+  /**
+   * Name of the organization
+   */
+  name: string;
+}
+
+export interface CreatePATRequest {
+  /**
+   * Expiration date of the PAT. PATs without one never expire
+   */
+  expiresAt?: string;
+  metadata?: Record<string, unknown>;
+  /**
+   * Name of the PAT, defaults to the name in its metadata if there is one
+   */
+  name?: string;
+}
+
+export interface CreatePATResponse {
+  /**
+   * ID of the PAT
+   */
+  id: string;
+  /**
+   * PAT
+   */
+  personalAccessToken: string;
+}
+
+export interface DataExport {
+  completedAt?: string;
+  createdAt: string;
+  /**
+   * URL to download the export, only set once it is completed. The URL contains a signed ticket and expires after an hour, get the export again for a new one
+   */
+  downloadUrl?: string;
+  /**
+   * When the export is deleted
+   */
+  expiresAt?: string;
+  id: string;
+  status: DataExportStatus;
+  userId: string;
+}
+
+export type DataExportStatus = 'pending' | 'completed' | 'failed';
+
+export interface DeviceCodeResponse {
+  /**
+   * Code the device uses to poll for the session, it must be kept secret
+   */
+  deviceCode: string;
+  /**
+   * Number of seconds the codes are valid for
+   */
+  expiresIn: number;
+  /**
+   * Minimum number of seconds the device must wait between polling requests
+   */
+  interval: number;
+  /**
+   * Code the user needs to enter in the verification page
+   */
+  userCode: string;
+  /**
+   * URL of the verification page
+   */
+  verificationUri: string;
+  /**
+   * URL of the verification page including the user code
+   */
+  verificationUriComplete: string;
+}
+
+export interface DeviceTokenRequest {
+  /**
+   * Device code returned by /device/code
+   */
+  deviceCode: string;
+}
+
+export interface DeviceVerifyRequest {
+  /**
+   * Whether the user approves the device, if false the device is denied
+   */
+  approve: boolean;
+  /**
+   * Code displayed by the device, the dash and case are ignored
+   */
+  userCode: string;
+}
+
+export interface ElevateRequest {
+  /**
+   * One time password generated by the authenticator app, required if the user has TOTP MFA enabled
+   */
+  otp?: string;
+  /**
+   * Current password of the user, required if the user has a password
+   */
+  password?: string;
+}
+
+export interface ErrorResponse {
+  /**
+   * Error code that identifies the application error
+   */
+  error: 'default-role-must-be-in-allowed-roles' | 'disabled-endpoint' | 'disabled-user' | 'email-already-in-use' | 'email-already-verified' | 'forbidden-anonymous' | 'internal-server-error' | 'invalid-email-password' | 'invalid-request' | 'locale-not-allowed' | 'password-too-short' | 'password-in-hibp-database' | 'redirectTo-not-allowed' | 'role-not-allowed' | 'signup-disabled' | 'unverified-user' | 'user-not-anonymous' | 'invalid-pat' | 'invalid-refresh-token' | 'invalid-ticket' | 'invalid-otp' | 'cannot-send-sms' | 'invalid-webauthn-security-key' | 'disabled-mfa-totp' | 'no-totp-secret' | 'totp-already-active' | 'mfa-type-not-found' | 'elevated-claim-required' | 'invalid-state' | 'oauth-provider-error' | 'invalid-saml-response' | 'provider-already-linked' | 'provider-not-linked' | 'last-sign-in-method' | 'invalid-id-token' | 'authorization-pending' | 'slow-down' | 'expired-token' | 'access-denied' | 'invalid-user-code' | 'session-not-found' | 'user-not-found' | 'pat-not-found' | 'invalid-client' | 'client-not-found' | 'unauthorized-client' | 'invalid-subject-token' | 'email-not-found' | 'phone-number-already-in-use' | 'password-too-long' | 'password-too-weak' | 'password-missing-lowercase' | 'password-missing-uppercase' | 'password-missing-digit' | 'password-missing-symbol' | 'password-in-denylist' | 'password-contains-email' | 'too-many-requests' | 'sign-in-locked' | 'invalid-captcha' | 'ip-not-allowed' | 'signup-rejected' | 'role-not-found' | 'role-already-exists' | 'role-in-use' | 'data-export-not-found' | 'invalid-grant' | 'invalid-access-token' | 'forbidden-role' | 'organization-not-found' | 'forbidden-organization-role' | 'invalid-metadata' | 'disposable-email' | 'undeliverable-email' | 'invalid-avatar' | 'avatar-too-large' | 'session-limit-reached' | 'impossible-travel' | 'risky-sign-in' | 'invalid-configuration' | 'disabled-sign-in-method';
+  /**
+   * Human friendly error message
+   */
+  message: string;
+  /**
+   * HTTP status error code
+   */
+  status: number;
+}
+
+export interface ImpersonateUserRequest {
+  /**
+   * Who is impersonating the user, i.e. the email of the administrator. It is set in the x-hasura-impersonated-by claim and recorded in the audit log
+   */
+  impersonatedBy: string;
+  /**
+   * Only allow this role, which must be one of the roles of the user. All the roles of the user are allowed if missing
+   */
+  role?: string;
+}
+
+export interface ImpersonationResponse {
+  /**
+   * JSON Web Token (JWT)
+   */
+  accessToken: string;
+  accessTokenExpiresIn: number;
+  user: User;
+}
+
+export interface ImportUser {
+  avatarUrl?: string;
+  /**
+   * When the user was created, now if missing
+   */
+  createdAt?: string;
+  /**
+   * Default role of the user, the default role if missing
+   */
+  defaultRole?: string;
+  disabled?: boolean;
+  /**
+   * Display name of the user, the email if missing
+   */
+  displayName?: string;
+  email: string;
+  emailVerified?: boolean;
+  /**
+   * ID of the user, a random one is generated if missing
+   */
+  id?: string;
+  /**
+   * Locale of the user, the default locale if missing
+   */
+  locale?: string;
+  /**
+   * Metadata of the user. In CSV files this is a JSON object
+   */
+  metadata?: Record<string, unknown>;
+  /**
+   * Hash of the password of the user, in the bcrypt format or in the PHC string format of argon2, i.e. $argon2id$v=19$m=65536,t=3,p=4$salt$hash
+   */
+  passwordHash?: string;
+  phoneNumber?: string;
+  phoneNumberVerified?: boolean;
+  /**
+   * Roles of the user, the default allowed roles if missing. In CSV files separate the roles with spaces
+   */
+  roles?: Array<string>;
+}
+
+export interface ImportUserError {
+  email?: string;
+  /**
+   * Why the user wasn't imported
+   */
+  error: string;
+  /**
+   * Line of the body with the user, the header of CSV files is line 1
+   */
+  line: number;
+}
+
+export type ImportUsersFormat = 'ndjson' | 'csv';
+
+export interface ImportUsersResponse {
+  errors: Array<ImportUserError>;
+  /**
+   * Number of users imported
+   */
+  imported: number;
+  /**
+   * Number of users that weren't imported, see errors
+   */
+  skipped: number;
+}
+
+export interface IntrospectRequest {
+  /**
+   * Access token or refresh token to introspect
+   */
+  token: string;
+  /**
+   * Type of the token, used to look it up faster
+   */
+  token_type_hint?: 'access_token' | 'refresh_token';
+}
+
+export interface IntrospectResponse {
+  /**
+   * Whether the token is valid and can be used
+   */
+  active: boolean;
+  /**
+   * Hasura claims of the access token
+   */
+  claims?: Record<string, unknown>;
+  /**
+   * Time the token expires, in seconds since the epoch
+   */
+  exp?: number;
+  /**
+   * Time the token was issued, in seconds since the epoch
+   */
+  iat?: number;
+  /**
+   * Issuer of the access token
+   */
+  iss?: string;
+  /**
+   * ID of the user the token belongs to
+   */
+  sub?: string;
+  /**
+   * Type of the token, only present if the token is active
+   */
+  token_type?: 'access_token' | 'refresh_token' | 'personal_access_token';
+}
+
+export interface JWK {
+  /**
+   * Algorithm the key is used with
+   */
+  alg: string;
+  /**
+   * Curve of EC keys
+   */
+  crv?: string;
+  /**
+   * Exponent of RSA keys
+   */
+  e?: string;
+  /**
+   * Key ID
+   */
+  kid: string;
+  /**
+   * Key type
+   */
+  kty: string;
+  /**
+   * Modulus of RSA keys
+   */
+  n?: string;
+  /**
+   * Intended use of the key
+   */
+  use: string;
+  /**
+   * X coordinate of EC keys
+   */
+  x?: string;
+  /**
+   * Y coordinate of EC keys
+   */
+  y?: string;
+}
+
+export interface JWKSet {
+  keys: Array<JWK>;
+}
+
+export interface MFAChallengePayload {
+  ticket: string;
+}
+
+export interface MfaRecoveryCodesCountResponse {
+  /**
+   * Number of recovery codes that haven't been used yet
+   */
+  remaining: number;
+}
+
+export interface MfaRecoveryCodesResponse {
+  /**
+   * One-time recovery codes that can be used instead of a TOTP code
+   */
+  recoveryCodes: Array<string>;
+}
+
+export interface MfaTotpEnableRequest {
+  /**
+   * Code generated by the authenticator app with the secret returned by /mfa/totp/generate
+   */
+  code: string;
+}
+
+export interface OAuth2AuthorizeRequest {
+  clientId: string;
+  codeChallenge?: string;
+  codeChallengeMethod?: string;
+  /**
+   * Whether the user allows the client to access the requested scopes. Omit it to find out if the user needs to be asked
+   */
+  consent?: boolean;
+  nonce?: string;
+  redirectUri: string;
+  scope: string;
+  state?: string;
+}
+
+export interface OAuth2AuthorizeResponse {
+  client: OAuth2ClientInfo;
+  /**
+   * Whether the user needs to be asked for their consent. If it is, call the endpoint again with consent set
+   */
+  consentRequired: boolean;
+  /**
+   * URL to redirect the user to, with the authorization code or an error. Not set if the user needs to be asked for their consent
+   */
+  redirectTo?: string;
+  /**
+   * Scopes requested by the client
+   */
+  scopes: Array<string>;
+}
+
+export interface OAuth2ClientInfo {
+  clientId: string;
+  description: string;
+}
+
+export interface OAuth2TokenRequest {
+  /**
+   * ID of the client, if not sent in the authorization header
+   */
+  client_id?: string;
+  /**
+   * Secret of the client, if not sent in the authorization header
+   */
+  client_secret?: string;
+  /**
+   * Authorization code, required by the authorization code grant
+   */
+  code?: string;
+  /**
+   * PKCE code verifier, required by the authorization code grant if the authorization request had a code challenge
+   */
+  code_verifier?: string;
+  grant_type: 'client_credentials' | 'urn:ietf:params:oauth:grant-type:token-exchange' | 'authorization_code';
+  /**
+   * Redirect URI of the authorization request, required by the authorization code grant
+   */
+  redirect_uri?: string;
+  /**
+   * Space separated list of roles to include in the access token. Defaults to all the roles the client is allowed to use or, when exchanging a token, to all the roles of the subject token
+   */
+  scope?: string;
+  /**
+   * Access token of the user to exchange, required by the token exchange grant
+   */
+  subject_token?: string;
+  /**
+   * Type of the subject token, required by the token exchange grant
+   */
+  subject_token_type?: 'urn:ietf:params:oauth:token-type:access_token';
+}
+
+export interface OAuth2TokenResponse {
+  access_token: string;
+  /**
+   * Number of seconds the access token is valid for
+   */
+  expires_in: number;
+  /**
+   * ID token of the user, only returned by the authorization code grant
+   */
+  id_token?: string;
+  /**
+   * Type of the issued token, only returned by the token exchange grant
+   */
+  issued_token_type?: 'urn:ietf:params:oauth:token-type:access_token';
+  /**
+   * Space separated list of roles included in the access token
+   */
+  scope: string;
+  token_type: 'Bearer';
+}
+
+export interface OIDCUserInfo {
+  email?: string;
+  email_verified?: boolean;
+  locale?: string;
+  name?: string;
+  phone_number?: string;
+  phone_number_verified?: boolean;
+  picture?: string;
+  sub: string;
+}
+
+export type OKResponse = 'OK';
+
+export interface OpenIDConfiguration {
+  authorization_endpoint: string;
+  claims_supported: Array<string>;
+  code_challenge_methods_supported: Array<string>;
+  grant_types_supported: Array<string>;
+  id_token_signing_alg_values_supported: Array<string>;
+  issuer: string;
+  jwks_uri: string;
+  response_types_supported: Array<string>;
+  scopes_supported: Array<string>;
+  subject_types_supported: Array<string>;
+  token_endpoint: string;
+  token_endpoint_auth_methods_supported: Array<string>;
+  userinfo_endpoint: string;
+}
+
+export interface OptionsRedirectTo {
+  redirectTo?: string;
+}
+
+export interface Organization {
+  /**
+   * When the organization was created
+   */
+  createdAt: string;
+  /**
+   * ID of the organization
+   */
+  id: string;
+  /**
+   * Name of the organization
+   */
+  name: string;
+  role: OrganizationRole;
+}
+
+export interface OrganizationInviteRequest {
+  /**
+   * Email the invitation is sent to
+   */
+  email: string;
+  options?: OptionsRedirectTo;
+  role?: OrganizationRole;
+}
+
+/**
+ * Role of a member in an organization
+ */
+export type OrganizationRole = 'owner' | 'admin' | 'member';
+
+export interface OutboxEmail {
+  /**
+   * Number of times sending the email was attempted
+   */
+  attempts: number;
+  createdAt: string;
+  id: string;
+  /**
+   * Error returned by the email provider the last time
+   */
+  lastError: string;
+  recipient: string;
+  subject: string;
+  /**
+   * Template used to render the email
+   */
+  template: string;
+}
+
+export interface OutboxFailedEmailsResponse {
+  emails: Array<OutboxEmail>;
+  /**
+   * Number of emails that couldn't be delivered
+   */
+  failed: number;
+  /**
+   * Number of emails waiting to be sent
+   */
+  pending: number;
+}
+
+export interface PAT {
+  createdAt: string;
+  /**
+   * When the PAT expires, not set if it never does
+   */
+  expiresAt?: string;
+  /**
+   * ID of the PAT
+   */
+  id: string;
+  /**
+   * When the PAT was last used to sign in
+   */
+  lastUsedAt?: string;
+  metadata?: Record<string, unknown>;
+  /**
+   * Name of the PAT
+   */
+  name: string;
+}
+
+export interface PATsResponse {
+  pats: Array<PAT>;
+}
+
+export interface ReadinessResponse {
+  /**
+   * Status of each dependency checked, i.e. database, smtp and jwt
+   */
+  checks: Record<string, ReadinessStatus>;
+  status: ReadinessStatus;
+}
+
+export type ReadinessStatus = 'ok' | 'error';
+
+export interface RefreshTokenRequest {
+  /**
+   * Refresh Token
+   */
+  refreshToken: string;
+}
+
+export interface ScimEmail {
+  primary?: boolean;
+  type?: string;
+  value: string;
+}
+
+export interface ScimError {
+  /**
+   * Human friendly error message
+   */
+  detail?: string;
+  schemas: Array<string>;
+  /**
+   * SCIM error type
+   */
+  scimType?: string;
+  /**
+   * HTTP status error code
+   */
+  status: string;
+}
+
+/**
+ * SCIM 2.0 group, a role of auth.roles. Attributes that aren't listed are accepted and ignored
+ */
+export interface ScimGroup {
+  /**
+   * Name of the role
+   */
+  displayName: string;
+  /**
+   * ID of the group, the name of the role. Ignored in requests
+   */
+  id?: string;
+  /**
+   * Users that have the role
+   */
+  members?: Array<ScimMember>;
+  meta?: ScimMeta;
+  schemas?: Array<string>;
+}
+
+export interface ScimGroupListResponse {
+  Resources: Array<ScimGroup>;
+  itemsPerPage: number;
+  schemas: Array<string>;
+  startIndex: number;
+  /**
+   * Number of groups matching the filter
+   */
+  totalResults: number;
+}
+
+export interface ScimMember {
+  display?: string;
+  /**
+   * ID of the user or the group
+   */
+  value: string;
+}
+
+/**
+ * Metadata of the resource, ignored in requests
+ */
+export interface ScimMeta {
+  created?: string;
+  lastModified?: string;
+  location?: string;
+  resourceType?: string;
+}
+
+export interface ScimName {
+  familyName?: string;
+  formatted?: string;
+  givenName?: string;
+}
+
+export interface ScimPatchOperation {
+  /**
+   * add, remove or replace, case insensitive
+   */
+  op: string;
+  /**
+   * Attribute to update, the value holds the attributes to update if empty
+   */
+  path?: string;
+  /**
+   * New value of the attribute
+   */
+  value?: unknown;
+}
+
+export interface ScimPatchRequest {
+  Operations: Array<ScimPatchOperation>;
+  schemas?: Array<string>;
+}
+
+/**
+ * SCIM 2.0 user. Attributes that aren't listed, like the ones of extension schemas, are accepted and ignored
+ */
+export interface ScimUser {
+  /**
+   * Whether the user can sign in, defaults to true
+   */
+  active?: boolean;
+  displayName?: string;
+  /**
+   * Emails of the user, the primary one replaces the userName as the email of the user
+   */
+  emails?: Array<ScimEmail>;
+  /**
+   * ID of the user in the identity provider
+   */
+  externalId?: string;
+  /**
+   * Roles of the user, ignored in requests
+   */
+  groups?: Array<ScimMember>;
+  /**
+   * ID of the user, ignored in requests
+   */
+  id?: string;
+  meta?: ScimMeta;
+  name?: ScimName;
+  schemas?: Array<string>;
+  /**
+   * Email of the user
+   */
+  userName: string;
+}
+
+export interface ScimUserListResponse {
+  Resources: Array<ScimUser>;
+  itemsPerPage: number;
+  schemas: Array<string>;
+  startIndex: number;
+  /**
+   * Number of users matching the filter
+   */
+  totalResults: number;
+}
+
+export interface Session {
+  accessToken: string;
+  accessTokenExpiresIn: number;
+  /**
+   * Refresh token during authentication or when refreshing the JWT
+   */
+  refreshToken: string;
+  /**
+   * Refresh token id
+   */
+  refreshTokenId: string;
+  user?: User;
+}
+
+export interface SessionPayload {
+  session?: Session;
+}
+
+export interface SignInAnonymousRequest {
+  /**
+   * Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+   */
+  captchaToken?: string;
+  displayName?: string;
+  /**
+   * A locale, such as en or fr-CA
+   */
+  locale?: string;
+  metadata?: Record<string, unknown>;
+}
+
+export interface SignInEmailPasswordRequest {
+  /**
+   * A valid email
+   */
+  email: string;
+  /**
+   * A password of minimum 3 characters
+   */
+  password: string;
+}
+
+export interface SignInEmailPasswordResponse {
+  mfa?: MFAChallengePayload;
+  session?: Session;
+}
+
+export interface SignInIdTokenRequest {
+  /**
+   * ID token issued by the provider to the native application
+   */
+  idToken: string;
+  /**
+   * Nonce used when requesting the ID token. Required if the ID token contains a nonce
+   */
+  nonce?: string;
+  options?: SignUpOptions;
+  provider: 'apple' | 'google';
+}
+
+export interface SignInMfaRecoveryCodeRequest {
+  /**
+   * One of the recovery codes generated when MFA was activated
+   */
+  recoveryCode: string;
+  /**
+   * Ticket returned by the sign in challenge
+   */
+  ticket: string;
+}
+
+export interface SignInMfaTotpRequest {
+  /**
+   * One time password generated by the authenticator app
+   */
+  otp: string;
+  /**
+   * Ticket returned by the sign in challenge
+   */
+  ticket: string;
+}
+
+export interface SignInPATRequest {
+  /**
+   * PAT
+   */
+  personalAccessToken: string;
+}
+
+export interface SignInPasswordlessEmailRequest {
+  /**
+   * Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+   */
+  captchaToken?: string;
+  /**
+   * A valid email
+   */
+  email: string;
+  options?: SignUpOptions;
+}
+
+export interface SignInPasswordlessSmsOtpRequest {
+  /**
+   * One time password
+   */
+  otp: string;
+  /**
+   * Phone number of the user
+   */
+  phoneNumber: string;
+}
+
+export interface SignInPasswordlessSmsRequest {
+  /**
+   * Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+   */
+  captchaToken?: string;
+  options?: SignUpOptions;
+  /**
+   * Phone number of the user, in the E.164 format
+   */
+  phoneNumber: string;
+}
+
+export interface SignInProviderCallbackForm {
+  /**
+   * Authorization code returned by the provider
+   */
+  code?: string;
+  /**
+   * Error returned by the provider
+   */
+  error?: string;
+  /**
+   * Description of the error returned by the provider
+   */
+  error_description?: string;
+  /**
+   * ID token returned by the provider
+   */
+  id_token?: string;
+  /**
+   * State generated when the sign in was started
+   */
+  state: string;
+  /**
+   * JSON encoded user information. Apple only sends it the first time the user authorizes the application
+   */
+  user?: string;
+  [key: string]: unknown;
+}
+
+export interface SignInSAMLACSForm {
+  /**
+   * State generated when the sign in was started
+   */
+  RelayState: string;
+  /**
+   * Base64 encoded response of the identity provider
+   */
+  SAMLResponse: string;
+  [key: string]: unknown;
+}
+
+export interface SignInWebauthnRequest {
+  /**
+   * A valid email. If omitted, the user will be resolved from a discoverable credential (passkey) during verification
+   */
+  email?: string;
+}
+
+export type SignInWebauthnResponse = Record<string, unknown>;
+
+export interface SignInWebauthnVerifyRequest {
+  credential: Record<string, unknown>;
+  /**
+   * Deprecated, will be ignored. The user is resolved from the challenge
+   */
+  email?: string;
+}
+
+export interface SignOutRequest {
+  /**
+   * Sign out of every session of the user, requires an access token
+   */
+  all?: boolean;
+  /**
+   * Refresh token of the session to sign out of
+   */
+  refreshToken: string;
+}
+
+export interface SignUpEmailPasswordRequest {
+  /**
+   * Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+   */
+  captchaToken?: string;
+  /**
+   * A valid email
+   */
+  email: string;
+  options?: SignUpOptions;
+  /**
+   * A password of minimum 3 characters
+   */
+  password: string;
+}
+
+export interface SignUpOptions {
+  allowedRoles?: Array<string>;
+  defaultRole?: string;
+  displayName?: string;
+  /**
+   * A locale, such as en or fr-CA
+   */
+  locale?: string;
+  metadata?: Record<string, unknown>;
+  redirectTo?: string;
+}
+
+export interface SignUpWebauthnRequest {
+  /**
+   * Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+   */
+  captchaToken?: string;
+  /**
+   * A valid email
+   */
+  email: string;
+  options?: SignUpOptions;
+}
+
+export type SignUpWebauthnResponse = Record<string, unknown>;
+
+export interface SignUpWebauthnVerifyRequest {
+  credential?: Record<string, unknown>;
+  options?: SignUpOptions & {
+    nickname?: string;
+  };
+  [key: string]: unknown;
+}
+
+export type SortOrder = 'asc' | 'desc';
+
+export interface SwitchOrganizationRequest {
+  /**
+   * ID of the organization, null to leave the organizations
+   */
+  organizationId: string | null;
+}
+
+export interface TotpGenerateResponse {
+  /**
+   * QR code of the TOTP secret as a data URL
+   */
+  imageUrl: string;
+  /**
+   * TOTP secret to be stored in the authenticator app
+   */
+  totpSecret: string;
+}
+
+export interface User {
+  avatarUrl: string;
+  createdAt: string;
+  defaultRole: string;
+  displayName: string;
+  /**
+   * A valid email
+   */
+  email?: string;
+  emailVerified: boolean;
+  /**
+   * Id of the user
+   */
+  id: string;
+  isAnonymous: boolean;
+  /**
+   * A locale, such as en or fr-CA
+   */
+  locale: string;
+  metadata: Record<string, unknown>;
+  phoneNumber: string;
+  phoneNumberVerified: boolean;
+  roles: Array<string>;
+}
+
+export interface UserAddSecurityKeyVerifyRequest {
+  credential: Record<string, unknown>;
+  /**
+   * Optional nickname for the security key
+   */
+  nickname?: string;
+}
+
+export interface UserAddSecurityKeyVerifyResponse {
+  /**
+   * Security key id
+   */
+  id: string;
+  /**
+   * Nickname of the security key
+   */
+  nickname?: string;
+}
+
+export interface UserAvatar {
+  /**
+   * URL of the avatar of the user
+   */
+  avatarUrl: string;
+}
+
+export interface UserDataExport {
+  auditLogs: Array<AuditLog>;
+  exportedAt: string;
+  profile: AdminUser;
+  providers: Array<UserProvider>;
+  sessions: Array<UserSession>;
+}
+
+export interface UserDeanonymizeRequest {
+  /**
+   * Deprecated, will be ignored
+   */
+  connection?: string;
+  /**
+   * A valid email
+   */
+  email: string;
+  options?: SignUpOptions;
+  /**
+   * A password of minimum 3 characters
+   */
+  password?: string;
+  /**
+   * Which sign-in method to use
+   */
+  signInMethod: 'email-password' | 'passwordless';
+}
+
+export interface UserDeleteRequest {
+  options?: OptionsRedirectTo;
+}
+
+export interface UserDeleteResponse {
+  /**
+   * When the user is deleted unless the deletion is cancelled
+   */
+  deletionScheduledAt: string;
+}
+
+export interface UserEmailChangeRequest {
+  /**
+   * A valid email
+   */
+  newEmail: string;
+  options?: OptionsRedirectTo;
+}
+
+export interface UserEmailSendVerificationEmailRequest {
+  /**
+   * A valid email
+   */
+  email: string;
+  options?: OptionsRedirectTo;
+}
+
+export type UserMetadata = Record<string, unknown>;
+
+/**
+ * JSON merge patch applied to the metadata of the user. Keys set to null are removed
+ */
+export type UserMetadataPatch = Record<string, unknown>;
+
+export interface UserMfaRequest {
+  /**
+   * Multi-factor authentication type, an empty string deactivates it
+   */
+  activeMfaType?: 'totp' | '';
+  /**
+   * Code of the authenticator app, to confirm it's set up
+   */
+  code: string;
+}
+
+export interface UserPasswordRequest {
+  /**
+   * The new password
+   */
+  newPassword: string;
+  /**
+   * Ticket of a password reset link, to set the password without an access token
+   */
+  ticket?: string;
+}
+
+export interface UserPasswordResetRequest {
+  /**
+   * Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+   */
+  captchaToken?: string;
+  /**
+   * A valid email
+   */
+  email: string;
+  options?: OptionsRedirectTo;
+  /**
+   * Sign out every session of the user once the password reset link is followed. Always enabled when AUTH_PASSWORD_RESET_REVOKE_SESSIONS is set
+   */
+  revokeSessions?: boolean;
+}
+
+export interface UserPhoneNumberChangeRequest {
+  /**
+   * New phone number of the user, in the E.164 format
+   */
+  newPhoneNumber: string;
+}
+
+export interface UserPhoneNumberChangeVerifyRequest {
+  /**
+   * One time password sent to the new phone number
+   */
+  otp: string;
+}
+
+export interface UserProvider {
+  createdAt: string;
+  id: string;
+  /**
+   * Name of the OAuth provider
+   */
+  provider: string;
+}
+
+export interface UserProviderLinkResponse {
+  /**
+   * URL the user needs to be sent to in the browser, it continues to the provider's authorization page
+   */
+  url: string;
+}
+
+export interface UserProviderTokensRequest {
+  /**
+   * Id of the provider
+   */
+  providerId: string;
+  /**
+   * Id of the user
+   */
+  userId: string;
+}
+
+export interface UserProvidersResponse {
+  providers: Array<UserProvider>;
+}
+
+export interface UserSession {
+  /**
+   * City the session was last used from, if a GeoIP database is configured
+   */
+  city?: string;
+  /**
+   * App that started the session, sent in the X-Hasura-Auth-Client header
+   */
+  clientId?: string;
+  /**
+   * Version of the app that last used the session, sent in the X-Hasura-Auth-Client-Version header
+   */
+  clientVersion?: string;
+  /**
+   * ISO code of the country the session was last used from, if a GeoIP database is configured
+   */
+  country?: string;
+  /**
+   * When the user signed in
+   */
+  createdAt: string;
+  /**
+   * When the session expires unless it is refreshed
+   */
+  expiresAt: string;
+  /**
+   * ID of the session's current refresh token
+   */
+  id: string;
+  /**
+   * IP address of the client that last used the session
+   */
+  ipAddress?: string;
+  /**
+   * When the user signed in or last refreshed the session
+   */
+  lastUsedAt?: string;
+  /**
+   * User agent of the client that last used the session
+   */
+  userAgent?: string;
+}
+
+export interface UserSessionsResponse {
+  sessions: Array<UserSession>;
+}
+
+export interface VerifyTokenRequest {
+  /**
+   * Access token to verify
+   */
+  token?: string;
+}
+
+export interface ClientOptions {
+  /**
+   * URL of the API, i.e. https://auth.example.com/v1
+   */
+  baseURL: string;
+  /**
+   * Headers sent with every request, i.e. the admin secret or the access token of the user.
+   */
+  headers?: Record<string, string>;
+  /**
+   * Implementation of fetch to send the requests with, the global one by default.
+   */
+  fetch?: typeof fetch;
+}
+
+export interface FetchResponse<T> {
+  body: T;
+  status: number;
+  headers: Headers;
+}
+
+/**
+ * Thrown for responses with a status of 400 or more, with their decoded body.
+ */
+export class FetchError extends Error {
+  constructor(
+    public readonly body: unknown,
+    public readonly status: number,
+    public readonly headers: Headers,
+  ) {
+    super(FetchError.messageOf(body, status));
+    this.name = 'FetchError';
+  }
+
+  private static messageOf(body: unknown, status: number): string {
+    const message = (body as { message?: unknown } | null | undefined)?.message;
+    if (typeof message === 'string') {
+      return message;
+    }
+    return `request failed with status ${status}`;
+  }
+}
+
+interface APIRequest {
+  method: string;
+  path: string;
+  query?: Record<string, unknown>;
+  headers?: Record<string, unknown>;
+  body?: unknown;
+  contentType?: string;
+}
+
+const formBody = (body: unknown): URLSearchParams => {
+  const form = new URLSearchParams();
+  for (const [key, value] of Object.entries(body as Record<string, unknown>)) {
+    if (value !== undefined && value !== null) {
+      form.append(key, String(value));
+    }
+  }
+  return form;
+};
+
+const encodeBody = (req: APIRequest): BodyInit | undefined => {
+  if (req.body === undefined) {
+    return undefined;
+  }
+  if (req.contentType === 'application/x-www-form-urlencoded') {
+    return formBody(req.body);
+  }
+  if (req.contentType?.endsWith('json')) {
+    return JSON.stringify(req.body);
+  }
+  return req.body as BodyInit;
+};
+
+const createRequest =
+  (clientOptions: ClientOptions) =>
+  async <T>(req: APIRequest, options?: RequestInit): Promise<FetchResponse<T>> => {
+    const url = new URL(clientOptions.baseURL.replace(/\/$/, '') + req.path);
+    for (const [key, value] of Object.entries(req.query ?? {})) {
+      for (const v of Array.isArray(value) ? value : [value]) {
+        if (v !== undefined && v !== null) {
+          url.searchParams.append(key, String(v));
+        }
+      }
+    }
+
+    const headers = new Headers(clientOptions.headers);
+    for (const [key, value] of Object.entries(req.headers ?? {})) {
+      if (value !== undefined && value !== null) {
+        headers.set(key, String(value));
+      }
+    }
+    new Headers(options?.headers).forEach((value, key) => headers.set(key, value));
+    if (req.body !== undefined && req.contentType && !headers.has('Content-Type')) {
+      headers.set('Content-Type', req.contentType);
+    }
+
+    const res = await (clientOptions.fetch ?? fetch)(url.toString(), {
+      ...options,
+      method: req.method,
+      headers,
+      body: encodeBody(req),
+    });
+
+    const text = await res.text();
+    let body: unknown = text === '' ? undefined : text;
+    if (text !== '' && (res.headers.get('Content-Type') ?? '').includes('json')) {
+      body = JSON.parse(text);
+    }
+
+    if (res.status >= 400) {
+      throw new FetchError(body, res.status, res.headers);
+    }
+
+    return { body: body as T, status: res.status, headers: res.headers };
+  };
+
+/**
+ * Creates a client of the API at `baseURL`.
+ */
+export const createClient = (clientOptions: ClientOptions) => {
+  const request = createRequest(clientOptions);
+
+  return {
+    /**
+     * Public keys used to sign the access tokens, only present when using an asymmetric signing algorithm
+     *
+     * `GET /.well-known/jwks.json`
+     */
+    getWellKnownJwksJson: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<JWKSet>> =>
+      request<JWKSet>(
+        {
+          method: 'GET',
+          path: `/.well-known/jwks.json`,
+        },
+        options,
+      ),
+
+    /**
+     * OpenID Connect discovery document of the identity provider, only available when AUTH_OIDC_PROVIDER_ENABLED is set
+     *
+     * `GET /.well-known/openid-configuration`
+     */
+    getWellKnownOpenidConfiguration: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<OpenIDConfiguration>> =>
+      request<OpenIDConfiguration>(
+        {
+          method: 'GET',
+          path: `/.well-known/openid-configuration`,
+        },
+        options,
+      ),
+
+    /**
+     * List the entries of the audit log, most recent first
+     *
+     * `GET /admin/audit-logs`
+     */
+    getAdminAuditLogs: (
+      params?: {
+        /**
+         * Only return the entries of this event
+         */
+        event?: string;
+        /**
+         * Maximum number of entries to return
+         */
+        limit?: number;
+        /**
+         * Number of entries to skip
+         */
+        offset?: number;
+        /**
+         * Only return the entries about this user
+         */
+        userId?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<AuditLogsResponse>> =>
+      request<AuditLogsResponse>(
+        {
+          method: 'GET',
+          path: `/admin/audit-logs`,
+          query: { event: params?.event, limit: params?.limit, offset: params?.offset, userId: params?.userId },
+        },
+        options,
+      ),
+
+    /**
+     * Reload the configuration that can be changed without a restart, reading the config file and resolving the secret references again
+     *
+     * `POST /admin/config/reload`
+     */
+    postAdminConfigReload: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<ConfigReloadResponse>> =>
+      request<ConfigReloadResponse>(
+        {
+          method: 'POST',
+          path: `/admin/config/reload`,
+        },
+        options,
+      ),
+
+    /**
+     * Get the status of an export of any user
+     *
+     * `GET /admin/data-exports/{exportId}`
+     */
+    getAdminDataExportsExportId: (
+      exportId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<DataExport>> =>
+      request<DataExport>(
+        {
+          method: 'GET',
+          path: `/admin/data-exports/${encodeURIComponent(String(exportId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * List the emails of the outbox that couldn't be delivered, either because the provider rejected them or because they failed too many times
+     *
+     * `GET /admin/emails/failed`
+     */
+    getAdminEmailsFailed: (
+      params?: {
+        /**
+         * Maximum number of emails to return
+         */
+        limit?: number;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<OutboxFailedEmailsResponse>> =>
+      request<OutboxFailedEmailsResponse>(
+        {
+          method: 'GET',
+          path: `/admin/emails/failed`,
+          query: { limit: params?.limit },
+        },
+        options,
+      ),
+
+    /**
+     * Queue a failed email of the outbox to be sent again
+     *
+     * `POST /admin/emails/{emailId}/retry`
+     */
+    postAdminEmailsEmailIdRetry: (
+      emailId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/emails/${encodeURIComponent(String(emailId))}/retry`,
+        },
+        options,
+      ),
+
+    /**
+     * Register an OAuth2 client that can get access tokens with the client credentials grant. The client secret is only returned once
+     *
+     * `POST /admin/oauth2/clients`
+     */
+    postAdminOauth2Clients: (
+      body: CreateOAuth2ClientRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<CreateOAuth2ClientResponse>> =>
+      request<CreateOAuth2ClientResponse>(
+        {
+          method: 'POST',
+          path: `/admin/oauth2/clients`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Delete an OAuth2 client. Access tokens already issued to the client remain valid until they expire
+     *
+     * `DELETE /admin/oauth2/clients/{clientId}`
+     */
+    deleteAdminOauth2ClientsClientId: (
+      clientId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/admin/oauth2/clients/${encodeURIComponent(String(clientId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * List the roles of auth.roles and how many users have each of them
+     *
+     * `GET /admin/roles`
+     */
+    getAdminRoles: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<AdminRolesResponse>> =>
+      request<AdminRolesResponse>(
+        {
+          method: 'GET',
+          path: `/admin/roles`,
+        },
+        options,
+      ),
+
+    /**
+     * Add a role to auth.roles so it can be given to users. The role must also be configured in the Hasura permissions to grant any access
+     *
+     * `POST /admin/roles`
+     */
+    postAdminRoles: (
+      body: AdminRoleRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/roles`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Delete a role from auth.roles and remove it from every user that has it. Roles that are the default role of a user or part of the default allowed roles can't be deleted
+     *
+     * `DELETE /admin/roles/{role}`
+     */
+    deleteAdminRolesRole: (
+      role: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/admin/roles/${encodeURIComponent(String(role))}`,
+        },
+        options,
+      ),
+
+    /**
+     * List the sign in methods, whether they are configured and whether they are enabled once the runtime flags are applied
+     *
+     * `GET /admin/sign-in-methods`
+     */
+    getAdminSignInMethods: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<AdminSignInMethodsResponse>> =>
+      request<AdminSignInMethodsResponse>(
+        {
+          method: 'GET',
+          path: `/admin/sign-in-methods`,
+        },
+        options,
+      ),
+
+    /**
+     * Enable or disable a sign in method at runtime, on every instance, without a redeploy. Methods that aren't configured stay disabled
+     *
+     * `PUT /admin/sign-in-methods/{method}`
+     */
+    putAdminSignInMethodsMethod: (
+      method: string,
+      body: AdminSignInMethodRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<AdminSignInMethod>> =>
+      request<AdminSignInMethod>(
+        {
+          method: 'PUT',
+          path: `/admin/sign-in-methods/${encodeURIComponent(String(method))}`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * List the users, optionally filtered. Results are paginated with a cursor, pass the nextCursor of the response to get the next page
+     *
+     * `GET /admin/users`
+     */
+    getAdminUsers: (
+      params?: {
+        /**
+         * Only return the users created at or after this time
+         */
+        createdAfter?: string;
+        /**
+         * Only return the users created before this time
+         */
+        createdBefore?: string;
+        /**
+         * Cursor returned by the previous page
+         */
+        cursor?: string;
+        /**
+         * Only return the users that are disabled, or not
+         */
+        disabled?: boolean;
+        /**
+         * Only return the users with a matching email, case insensitive. `*` matches any characters, i.e. `*@nhost.io`
+         */
+        email?: string;
+        /**
+         * Only return the users that verified their email, or not
+         */
+        emailVerified?: boolean;
+        /**
+         * Maximum number of users to return
+         */
+        limit?: number;
+        /**
+         * Sort order
+         */
+        order?: SortOrder;
+        /**
+         * Only return the users linked to this provider, i.e. github
+         */
+        provider?: string;
+        /**
+         * Only return the users allowed to use this role
+         */
+        role?: string;
+        /**
+         * Field to sort the users by
+         */
+        sortBy?: AdminUsersSortBy;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<AdminUsersResponse>> =>
+      request<AdminUsersResponse>(
+        {
+          method: 'GET',
+          path: `/admin/users`,
+          query: { createdAfter: params?.createdAfter, createdBefore: params?.createdBefore, cursor: params?.cursor, disabled: params?.disabled, email: params?.email, emailVerified: params?.emailVerified, limit: params?.limit, order: params?.order, provider: params?.provider, role: params?.role, sortBy: params?.sortBy },
+        },
+        options,
+      ),
+
+    /**
+     * Import users, i.e. when migrating from another provider. The body has one user per line, either as ND-JSON with one ImportUser per line or as CSV with a header row naming the ImportUser properties. Password hashes are kept as is, bcrypt and argon2 hashes are supported. Users are inserted in batches, each in its own transaction; users whose id or email already exist are skipped
+     *
+     * `POST /admin/users/import`
+     */
+    postAdminUsersImport: (
+      body: BodyInit,
+      params?: {
+        /**
+         * Format of the body
+         */
+        format?: ImportUsersFormat;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<ImportUsersResponse>> =>
+      request<ImportUsersResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/import`,
+          query: { format: params?.format },
+          body,
+          contentType: 'application/octet-stream',
+        },
+        options,
+      ),
+
+    /**
+     * Ban a user, optionally until a given time, and revoke all their sessions. Banned users can't sign in or refresh their session while the ban lasts
+     *
+     * `POST /admin/users/{userId}/ban`
+     */
+    postAdminUsersUserIdBan: (
+      userId: string,
+      body: BanUserRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/ban`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Lift the ban of a user
+     *
+     * `DELETE /admin/users/{userId}/ban`
+     */
+    deleteAdminUsersUserIdBan: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/ban`,
+        },
+        options,
+      ),
+
+    /**
+     * Request an export of the data stored about a user, see /user/data-export
+     *
+     * `POST /admin/users/{userId}/data-export`
+     */
+    postAdminUsersUserIdDataExport: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<DataExport>> =>
+      request<DataExport>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/data-export`,
+        },
+        options,
+      ),
+
+    /**
+     * Disable a user and revoke all their sessions. Disabled users can't sign in or refresh their session until they are enabled again
+     *
+     * `POST /admin/users/{userId}/disable`
+     */
+    postAdminUsersUserIdDisable: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/disable`,
+        },
+        options,
+      ),
+
+    /**
+     * Enable a disabled user
+     *
+     * `POST /admin/users/{userId}/enable`
+     */
+    postAdminUsersUserIdEnable: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/enable`,
+        },
+        options,
+      ),
+
+    /**
+     * Get an access token to act as a user, i.e. to debug an issue only they experience. The access token carries the x-hasura-impersonated-by claim and can't be refreshed. Impersonations are always recorded in the audit log
+     *
+     * `POST /admin/users/{userId}/impersonate`
+     */
+    postAdminUsersUserIdImpersonate: (
+      userId: string,
+      body: ImpersonateUserRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ImpersonationResponse>> =>
+      request<ImpersonationResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/impersonate`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Set the password of a user, either in plain text, which must comply with the password policy, or already hashed with bcrypt, i.e. when migrating users from another system
+     *
+     * `POST /admin/users/{userId}/password`
+     */
+    postAdminUsersUserIdPassword: (
+      userId: string,
+      body: AdminSetPasswordRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/password`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Invalidate the password of a user, revoke all their sessions and email them a link to set a new password, i.e. after a breach
+     *
+     * `POST /admin/users/{userId}/password/reset`
+     */
+    postAdminUsersUserIdPasswordReset: (
+      userId: string,
+      body: AdminPasswordResetRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/password/reset`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Add a role to a user. The role must exist in auth.roles. It is included in the allowed roles of the access tokens issued from then on
+     *
+     * `POST /admin/users/{userId}/roles`
+     */
+    postAdminUsersUserIdRoles: (
+      userId: string,
+      body: AdminRoleRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/roles`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Remove a role from a user. The default role of the user can't be removed
+     *
+     * `DELETE /admin/users/{userId}/roles/{role}`
+     */
+    deleteAdminUsersUserIdRolesRole: (
+      userId: string,
+      role: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/roles/${encodeURIComponent(String(role))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Revoke all the sessions of a user, including personal access tokens. When access token revocation is enabled, access tokens issued before the call are rejected by Hasura Auth as well
+     *
+     * `POST /admin/users/{userId}/sessions/revoke-all`
+     */
+    postAdminUsersUserIdSessionsRevokeAll: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/sessions/revoke-all`,
+        },
+        options,
+      ),
+
+    /**
+     * Unlock a user locked out after too many failed sign in attempts and reset their failed attempts
+     *
+     * `POST /admin/users/{userId}/unlock`
+     */
+    postAdminUsersUserIdUnlock: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/admin/users/${encodeURIComponent(String(userId))}/unlock`,
+        },
+        options,
+      ),
+
+    /**
+     * Download a completed export with the ticket of the downloadUrl returned with its status
+     *
+     * `GET /data-export/download`
+     */
+    getDataExportDownload: (
+      params: {
+        /**
+         * Signed download ticket
+         */
+        ticket: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserDataExport>> =>
+      request<UserDataExport>(
+        {
+          method: 'GET',
+          path: `/data-export/download`,
+          query: { ticket: params?.ticket },
+        },
+        options,
+      ),
+
+    /**
+     * Start the device authorization grant (RFC 8628). Returns a device code for the device to poll /device/token with and a user code the user needs to enter in the verification page
+     *
+     * `POST /device/code`
+     */
+    postDeviceCode: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<DeviceCodeResponse>> =>
+      request<DeviceCodeResponse>(
+        {
+          method: 'POST',
+          path: `/device/code`,
+        },
+        options,
+      ),
+
+    /**
+     * Poll for the session once the user has approved the device. Until then the request fails with authorization-pending, or slow-down if the device polls faster than the interval
+     *
+     * `POST /device/token`
+     */
+    postDeviceToken: (
+      body: DeviceTokenRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/device/token`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Approve or deny a device with the user code it displays. Meant to be called by the verification page on behalf of the authenticated user
+     *
+     * `POST /device/verify`
+     */
+    postDeviceVerify: (
+      body: DeviceVerifyRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/device/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Re-verify the authenticated user's password and, if they have MFA enabled, a TOTP code, and return a new session whose access token carries the x-hasura-auth-elevated claim. The elevated access token is short lived
+     *
+     * `POST /elevate`
+     */
+    postElevate: (
+      body: ElevateRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/elevate`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Start a webauthn assertion to elevate the authenticated user's session. The challenge is restricted to the user's security keys
+     *
+     * `POST /elevate/webauthn`
+     */
+    postElevateWebauthn: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInWebauthnResponse>> =>
+      request<SignInWebauthnResponse>(
+        {
+          method: 'POST',
+          path: `/elevate/webauthn`,
+        },
+        options,
+      ),
+
+    /**
+     * Verify a webauthn assertion and return a new session whose access token carries the x-hasura-auth-elevated claim
+     *
+     * `POST /elevate/webauthn/verify`
+     */
+    postElevateWebauthnVerify: (
+      body: SignInWebauthnVerifyRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/elevate/webauthn/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify the access token of a request for a reverse proxy (Traefik forwardAuth, nginx auth_request). The access token is read from the Authorization header or from the cookie set in AUTH_FORWARD_AUTH_COOKIE_NAME. On success the identity of the user is returned in headers the proxy can forward to the upstream
+     *
+     * `GET /forward-auth/verify`
+     */
+    getForwardAuthVerify: (
+      params?: {
+        /**
+         * Access token of the user as a bearer token
+         */
+        Authorization?: string;
+        /**
+         * Cookies of the request, one of them can hold the access token
+         */
+        Cookie?: string;
+        /**
+         * Role the user must have, it is returned in X-Hasura-Role instead of the default role
+         */
+        role?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'GET',
+          path: `/forward-auth/verify`,
+          query: { role: params?.role },
+          headers: { Authorization: params?.Authorization, Cookie: params?.Cookie },
+        },
+        options,
+      ),
+
+    /**
+     * Health check
+     *
+     * `GET /healthz`
+     */
+    getHealthz: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'GET',
+          path: `/healthz`,
+        },
+        options,
+      ),
+
+    /**
+     * Health check
+     *
+     * `HEAD /healthz`
+     */
+    headHealthz: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'HEAD',
+          path: `/healthz`,
+        },
+        options,
+      ),
+
+    /**
+     * Get the number of unused MFA recovery codes of the authenticated user
+     *
+     * `GET /mfa/recovery-codes`
+     */
+    getMfaRecoveryCodes: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<MfaRecoveryCodesCountResponse>> =>
+      request<MfaRecoveryCodesCountResponse>(
+        {
+          method: 'GET',
+          path: `/mfa/recovery-codes`,
+        },
+        options,
+      ),
+
+    /**
+     * Regenerate the MFA recovery codes of the authenticated user invalidating the previous ones
+     *
+     * `POST /mfa/recovery-codes`
+     */
+    postMfaRecoveryCodes: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<MfaRecoveryCodesResponse>> =>
+      request<MfaRecoveryCodesResponse>(
+        {
+          method: 'POST',
+          path: `/mfa/recovery-codes`,
+        },
+        options,
+      ),
+
+    /**
+     * Activate TOTP multi-factor authentication for the authenticated user
+     *
+     * `POST /mfa/totp/enable`
+     */
+    postMfaTotpEnable: (
+      body: MfaTotpEnableRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<MfaRecoveryCodesResponse>> =>
+      request<MfaRecoveryCodesResponse>(
+        {
+          method: 'POST',
+          path: `/mfa/totp/enable`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Generate a secret to request the activation of TOTP multi-factor authentication
+     *
+     * `GET /mfa/totp/generate`
+     */
+    getMfaTotpGenerate: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<TotpGenerateResponse>> =>
+      request<TotpGenerateResponse>(
+        {
+          method: 'GET',
+          path: `/mfa/totp/generate`,
+        },
+        options,
+      ),
+
+    /**
+     * OpenID Connect authorization endpoint. Validates the authorization request and redirects the user to the page of the application that signs them in and asks for their consent, or back to the client with an error
+     *
+     * `GET /oauth/authorize`
+     */
+    getOauthAuthorize: (
+      params: {
+        /**
+         * ID of the client
+         */
+        client_id: string;
+        /**
+         * PKCE code challenge (RFC 7636)
+         */
+        code_challenge?: string;
+        /**
+         * PKCE code challenge method, only S256 is supported
+         */
+        code_challenge_method?: string;
+        /**
+         * Value included in the ID token to prevent replay attacks
+         */
+        nonce?: string;
+        /**
+         * URI to redirect the user to, it must be registered for the client
+         */
+        redirect_uri: string;
+        /**
+         * Only the authorization code flow is supported
+         */
+        response_type: string;
+        /**
+         * Space separated list of scopes, it must include openid. Other values than openid, profile, email and phone are roles to include in the access token
+         */
+        scope: string;
+        /**
+         * Opaque value sent back to the client
+         */
+        state?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'GET',
+          path: `/oauth/authorize`,
+          query: { client_id: params?.client_id, code_challenge: params?.code_challenge, code_challenge_method: params?.code_challenge_method, nonce: params?.nonce, redirect_uri: params?.redirect_uri, response_type: params?.response_type, scope: params?.scope, state: params?.state },
+        },
+        options,
+      ),
+
+    /**
+     * Authorize a client on behalf of the signed in user. Called by the page set in AUTH_OIDC_PROVIDER_AUTHORIZATION_URL with the parameters of the authorization request. If the user hasn't consented to the scopes yet and consent isn't set, the response asks for it, otherwise it returns the URL to redirect the user to
+     *
+     * `POST /oauth/authorize`
+     */
+    postOauthAuthorize: (
+      body: OAuth2AuthorizeRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OAuth2AuthorizeResponse>> =>
+      request<OAuth2AuthorizeResponse>(
+        {
+          method: 'POST',
+          path: `/oauth/authorize`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Token introspection (RFC 7662). Returns whether an access token or a refresh token is active and, if it is, the information it holds
+     *
+     * `POST /oauth/introspect`
+     */
+    postOauthIntrospect: (
+      body: IntrospectRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<IntrospectResponse>> =>
+      request<IntrospectResponse>(
+        {
+          method: 'POST',
+          path: `/oauth/introspect`,
+          body,
+          contentType: 'application/x-www-form-urlencoded',
+        },
+        options,
+      ),
+
+    /**
+     * Get an access token for an OAuth2 client with the client credentials grant (RFC 6749 section 4.4), exchange the access token of a user for a down-scoped one with the token exchange grant (RFC 8693) or exchange an authorization code for the tokens of a user with the authorization code grant (OpenID Connect). The client authenticates with HTTP basic authentication or with its credentials in the request body
+     *
+     * `POST /oauth/token`
+     */
+    postOauthToken: (
+      body: OAuth2TokenRequest,
+      params?: {
+        /**
+         * Client ID and secret using HTTP basic authentication
+         */
+        Authorization?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<OAuth2TokenResponse>> =>
+      request<OAuth2TokenResponse>(
+        {
+          method: 'POST',
+          path: `/oauth/token`,
+          headers: { Authorization: params?.Authorization },
+          body,
+          contentType: 'application/x-www-form-urlencoded',
+        },
+        options,
+      ),
+
+    /**
+     * OpenID Connect userinfo endpoint. Returns the claims of the user the scopes allowed by the user give access to
+     *
+     * `GET /oauth/userinfo`
+     */
+    getOauthUserinfo: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<OIDCUserInfo>> =>
+      request<OIDCUserInfo>(
+        {
+          method: 'GET',
+          path: `/oauth/userinfo`,
+        },
+        options,
+      ),
+
+    /**
+     * Create an organization. The authenticated user becomes its owner
+     *
+     * `POST /organizations`
+     */
+    postOrganizations: (
+      body: CreateOrganizationRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<Organization>> =>
+      request<Organization>(
+        {
+          method: 'POST',
+          path: `/organizations`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Accept an invitation to join an organization. The invitation must have been sent to the email of the authenticated user
+     *
+     * `POST /organizations/invites/accept`
+     */
+    postOrganizationsInvitesAccept: (
+      body: AcceptOrganizationInviteRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<Organization>> =>
+      request<Organization>(
+        {
+          method: 'POST',
+          path: `/organizations/invites/accept`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Switch the active organization of the authenticated user and return a new session whose access token carries the x-hasura-org-id and x-hasura-org-role claims of that organization. A null organizationId leaves the organizations
+     *
+     * `POST /organizations/switch`
+     */
+    postOrganizationsSwitch: (
+      body: SwitchOrganizationRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/organizations/switch`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Invite someone to join an organization by email. Only owners and admins of the organization can invite members, and only owners can invite owners
+     *
+     * `POST /organizations/{organizationId}/invites`
+     */
+    postOrganizationsOrganizationIdInvites: (
+      organizationId: string,
+      body: OrganizationInviteRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/organizations/${encodeURIComponent(String(organizationId))}/invites`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * List the Personal Access Tokens (PAT) of the authenticated user. The tokens themselves are never returned, only their details
+     *
+     * `GET /pat`
+     */
+    getPat: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<PATsResponse>> =>
+      request<PATsResponse>(
+        {
+          method: 'GET',
+          path: `/pat`,
+        },
+        options,
+      ),
+
+    /**
+     * Create a Personal Access Token (PAT)
+     *
+     * `POST /pat`
+     */
+    postPat: (
+      body: CreatePATRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<CreatePATResponse>> =>
+      request<CreatePATResponse>(
+        {
+          method: 'POST',
+          path: `/pat`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Revoke a Personal Access Token (PAT) of the authenticated user
+     *
+     * `DELETE /pat/{patId}`
+     */
+    deletePatPatId: (
+      patId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/pat/${encodeURIComponent(String(patId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Readiness check, verifies the database, the SMTP server and the JWT signing key are available
+     *
+     * `GET /readyz`
+     */
+    getReadyz: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<ReadinessResponse>> =>
+      request<ReadinessResponse>(
+        {
+          method: 'GET',
+          path: `/readyz`,
+        },
+        options,
+      ),
+
+    /**
+     * Readiness check, verifies the database, the SMTP server and the JWT signing key are available
+     *
+     * `HEAD /readyz`
+     */
+    headReadyz: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'HEAD',
+          path: `/readyz`,
+        },
+        options,
+      ),
+
+    /**
+     * List the roles of auth.roles as SCIM 2.0 groups. Only equality filters on displayName are supported
+     *
+     * `GET /scim/v2/Groups`
+     */
+    getScimV2Groups: (
+      params?: {
+        /**
+         * Maximum number of results to return, at most 100
+         */
+        count?: number;
+        /**
+         * Comma separated attributes to leave out of the response, only members is supported
+         */
+        excludedAttributes?: string;
+        /**
+         * SCIM filter, only the eq operator is supported
+         */
+        filter?: string;
+        /**
+         * 1-based index of the first result
+         */
+        startIndex?: number;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimGroupListResponse>> =>
+      request<ScimGroupListResponse>(
+        {
+          method: 'GET',
+          path: `/scim/v2/Groups`,
+          query: { count: params?.count, excludedAttributes: params?.excludedAttributes, filter: params?.filter, startIndex: params?.startIndex },
+        },
+        options,
+      ),
+
+    /**
+     * Create a role named after the displayName of the group and give it to its members
+     *
+     * `POST /scim/v2/Groups`
+     */
+    postScimV2Groups: (
+      body: ScimGroup,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimGroup>> =>
+      request<ScimGroup>(
+        {
+          method: 'POST',
+          path: `/scim/v2/Groups`,
+          body,
+          contentType: 'application/scim+json',
+        },
+        options,
+      ),
+
+    /**
+     * Get a role of auth.roles as a SCIM 2.0 group
+     *
+     * `GET /scim/v2/Groups/{groupId}`
+     */
+    getScimV2GroupsGroupId: (
+      groupId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimGroup>> =>
+      request<ScimGroup>(
+        {
+          method: 'GET',
+          path: `/scim/v2/Groups/${encodeURIComponent(String(groupId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Replace the members of a group. Groups can't be renamed
+     *
+     * `PUT /scim/v2/Groups/{groupId}`
+     */
+    putScimV2GroupsGroupId: (
+      groupId: string,
+      body: ScimGroup,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimGroup>> =>
+      request<ScimGroup>(
+        {
+          method: 'PUT',
+          path: `/scim/v2/Groups/${encodeURIComponent(String(groupId))}`,
+          body,
+          contentType: 'application/scim+json',
+        },
+        options,
+      ),
+
+    /**
+     * Add or remove members of a group. Groups can't be renamed
+     *
+     * `PATCH /scim/v2/Groups/{groupId}`
+     */
+    patchScimV2GroupsGroupId: (
+      groupId: string,
+      body: ScimPatchRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'PATCH',
+          path: `/scim/v2/Groups/${encodeURIComponent(String(groupId))}`,
+          body,
+          contentType: 'application/scim+json',
+        },
+        options,
+      ),
+
+    /**
+     * Delete a role from auth.roles. Roles that are the default role of a user or part of the default allowed roles can't be deleted
+     *
+     * `DELETE /scim/v2/Groups/{groupId}`
+     */
+    deleteScimV2GroupsGroupId: (
+      groupId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'DELETE',
+          path: `/scim/v2/Groups/${encodeURIComponent(String(groupId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * List the users as SCIM 2.0 resources. Only equality filters on userName, externalId and emails.value are supported
+     *
+     * `GET /scim/v2/Users`
+     */
+    getScimV2Users: (
+      params?: {
+        /**
+         * Maximum number of results to return, at most 100
+         */
+        count?: number;
+        /**
+         * SCIM filter, only the eq operator is supported
+         */
+        filter?: string;
+        /**
+         * 1-based index of the first result
+         */
+        startIndex?: number;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimUserListResponse>> =>
+      request<ScimUserListResponse>(
+        {
+          method: 'GET',
+          path: `/scim/v2/Users`,
+          query: { count: params?.count, filter: params?.filter, startIndex: params?.startIndex },
+        },
+        options,
+      ),
+
+    /**
+     * Provision a user. The email of the user is its userName and is trusted as verified, passwords sent by the identity provider are ignored
+     *
+     * `POST /scim/v2/Users`
+     */
+    postScimV2Users: (
+      body: ScimUser,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimUser>> =>
+      request<ScimUser>(
+        {
+          method: 'POST',
+          path: `/scim/v2/Users`,
+          body,
+          contentType: 'application/scim+json',
+        },
+        options,
+      ),
+
+    /**
+     * Get a user as a SCIM 2.0 resource
+     *
+     * `GET /scim/v2/Users/{userId}`
+     */
+    getScimV2UsersUserId: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimUser>> =>
+      request<ScimUser>(
+        {
+          method: 'GET',
+          path: `/scim/v2/Users/${encodeURIComponent(String(userId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Replace the attributes of a user. Disabling a user revokes their sessions
+     *
+     * `PUT /scim/v2/Users/{userId}`
+     */
+    putScimV2UsersUserId: (
+      userId: string,
+      body: ScimUser,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimUser>> =>
+      request<ScimUser>(
+        {
+          method: 'PUT',
+          path: `/scim/v2/Users/${encodeURIComponent(String(userId))}`,
+          body,
+          contentType: 'application/scim+json',
+        },
+        options,
+      ),
+
+    /**
+     * Update some attributes of a user. Disabling a user revokes their sessions
+     *
+     * `PATCH /scim/v2/Users/{userId}`
+     */
+    patchScimV2UsersUserId: (
+      userId: string,
+      body: ScimPatchRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<ScimUser>> =>
+      request<ScimUser>(
+        {
+          method: 'PATCH',
+          path: `/scim/v2/Users/${encodeURIComponent(String(userId))}`,
+          body,
+          contentType: 'application/scim+json',
+        },
+        options,
+      ),
+
+    /**
+     * Deprovision a user, deleting it and everything stored about it
+     *
+     * `DELETE /scim/v2/Users/{userId}`
+     */
+    deleteScimV2UsersUserId: (
+      userId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'DELETE',
+          path: `/scim/v2/Users/${encodeURIComponent(String(userId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Sign in as an anonymous user. The user will get the `anonymous` role and can later be deanonymized using /user/deanonymize
+     *
+     * `POST /signin/anonymous`
+     */
+    postSigninAnonymous: (
+      body?: SignInAnonymousRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/signin/anonymous`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with email and password
+     *
+     * `POST /signin/email-password`
+     */
+    postSigninEmailPassword: (
+      body: SignInEmailPasswordRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/signin/email-password`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with an ID token obtained by a native SDK of the provider, like Google Sign-In or Sign in with Apple. The user is created or linked the same way as with /signin/provider/{provider}
+     *
+     * `POST /signin/idtoken`
+     */
+    postSigninIdtoken: (
+      body: SignInIdTokenRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/signin/idtoken`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with a recovery code instead of a TOTP code after a multi-factor authentication challenge
+     *
+     * `POST /signin/mfa/recovery-code`
+     */
+    postSigninMfaRecoveryCode: (
+      body: SignInMfaRecoveryCodeRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/signin/mfa/recovery-code`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with a TOTP code after a multi-factor authentication challenge
+     *
+     * `POST /signin/mfa/totp`
+     */
+    postSigninMfaTotp: (
+      body: SignInMfaTotpRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/signin/mfa/totp`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with magic link sent to user's email. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
+     *
+     * `POST /signin/passwordless/email`
+     */
+    postSigninPasswordlessEmail: (
+      body: SignInPasswordlessEmailRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/signin/passwordless/email`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with a one time password sent to user's phone number. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
+     *
+     * `POST /signin/passwordless/sms`
+     */
+    postSigninPasswordlessSms: (
+      body: SignInPasswordlessSmsRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/signin/passwordless/sms`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify the one time password sent to user's phone number and sign in
+     *
+     * `POST /signin/passwordless/sms/otp`
+     */
+    postSigninPasswordlessSmsOtp: (
+      body: SignInPasswordlessSmsOtpRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/signin/passwordless/sms/otp`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign in with Personal Access Token (PAT)
+     *
+     * `POST /signin/pat`
+     */
+    postSigninPat: (
+      body: SignInPATRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/signin/pat`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Start a sign in with an OAuth provider. The user is redirected to the provider and, once authenticated, back to the callback endpoint
+     *
+     * `GET /signin/provider/{provider}`
+     */
+    getSigninProviderProvider: (
+      provider: string,
+      params?: {
+        /**
+         * Roles of the user if it is signing up
+         */
+        allowedRoles?: Array<string>;
+        /**
+         * Default role of the user if it is signing up
+         */
+        defaultRole?: string;
+        /**
+         * Display name of the user if it is signing up
+         */
+        displayName?: string;
+        /**
+         * Locale of the user if it is signing up
+         */
+        locale?: string;
+        /**
+         * JSON encoded metadata of the user if it is signing up
+         */
+        metadata?: string;
+        /**
+         * URL to redirect the user to once the sign in is completed
+         */
+        redirectTo?: string;
+        /**
+         * State returned when starting to link or deanonymize with the provider, the flow continues in the user's browser. The other parameters are ignored
+         */
+        state?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'GET',
+          path: `/signin/provider/${encodeURIComponent(String(provider))}`,
+          query: { allowedRoles: params?.allowedRoles, defaultRole: params?.defaultRole, displayName: params?.displayName, locale: params?.locale, metadata: params?.metadata, redirectTo: params?.redirectTo, state: params?.state },
+        },
+        options,
+      ),
+
+    /**
+     * OAuth callback. On success the user is redirected to the redirectTo given when starting the sign in with a refresh token, on failure with an error
+     *
+     * `GET /signin/provider/{provider}/callback`
+     */
+    getSigninProviderProviderCallback: (
+      provider: string,
+      params: {
+        /**
+         * Cookies of the request, one of them binds the sign in to the browser
+         */
+        Cookie?: string;
+        /**
+         * Authorization code returned by the provider
+         */
+        code?: string;
+        /**
+         * Error returned by the provider
+         */
+        error?: string;
+        /**
+         * Description of the error returned by the provider
+         */
+        error_description?: string;
+        /**
+         * State generated when the sign in was started
+         */
+        state: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'GET',
+          path: `/signin/provider/${encodeURIComponent(String(provider))}/callback`,
+          query: { code: params?.code, error: params?.error, error_description: params?.error_description, state: params?.state },
+          headers: { Cookie: params?.Cookie },
+        },
+        options,
+      ),
+
+    /**
+     * OAuth callback for providers that return the response with response_mode=form_post, like Apple. Behaves like the GET callback
+     *
+     * `POST /signin/provider/{provider}/callback`
+     */
+    postSigninProviderProviderCallback: (
+      provider: string,
+      body: SignInProviderCallbackForm,
+      params?: {
+        /**
+         * Cookies of the request, one of them binds the sign in to the browser
+         */
+        Cookie?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'POST',
+          path: `/signin/provider/${encodeURIComponent(String(provider))}/callback`,
+          headers: { Cookie: params?.Cookie },
+          body,
+          contentType: 'application/x-www-form-urlencoded',
+        },
+        options,
+      ),
+
+    /**
+     * Start a sign in with the SAML identity provider. The user is redirected to the identity provider and, once authenticated, its response is posted to the ACS endpoint
+     *
+     * `GET /signin/saml`
+     */
+    getSigninSaml: (
+      params?: {
+        /**
+         * Roles of the user if it is signing up
+         */
+        allowedRoles?: Array<string>;
+        /**
+         * Default role of the user if it is signing up
+         */
+        defaultRole?: string;
+        /**
+         * Display name of the user if it is signing up
+         */
+        displayName?: string;
+        /**
+         * Locale of the user if it is signing up
+         */
+        locale?: string;
+        /**
+         * JSON encoded metadata of the user if it is signing up
+         */
+        metadata?: string;
+        /**
+         * URL to redirect the user to once the sign in is completed
+         */
+        redirectTo?: string;
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'GET',
+          path: `/signin/saml`,
+          query: { allowedRoles: params?.allowedRoles, defaultRole: params?.defaultRole, displayName: params?.displayName, locale: params?.locale, metadata: params?.metadata, redirectTo: params?.redirectTo },
+        },
+        options,
+      ),
+
+    /**
+     * SAML assertion consumer service. On success the user is redirected to the redirectTo given when starting the sign in with a refresh token, on failure with an error
+     *
+     * `POST /signin/saml/acs`
+     */
+    postSigninSamlAcs: (
+      body: SignInSAMLACSForm,
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'POST',
+          path: `/signin/saml/acs`,
+          body,
+          contentType: 'application/x-www-form-urlencoded',
+        },
+        options,
+      ),
+
+    /**
+     * SAML service provider metadata, used to register hasura-auth with the identity provider
+     *
+     * `GET /signin/saml/metadata`
+     */
+    getSigninSamlMetadata: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<string>> =>
+      request<string>(
+        {
+          method: 'GET',
+          path: `/signin/saml/metadata`,
+        },
+        options,
+      ),
+
+    /**
+     * Start a webauthn sign in. If an email is provided the challenge is restricted to the user's security keys, otherwise a discoverable credential (passkey) can be used
+     *
+     * `POST /signin/webauthn`
+     */
+    postSigninWebauthn: (
+      body: SignInWebauthnRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInWebauthnResponse>> =>
+      request<SignInWebauthnResponse>(
+        {
+          method: 'POST',
+          path: `/signin/webauthn`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify webauthn sign in
+     *
+     * `POST /signin/webauthn/verify`
+     */
+    postSigninWebauthnVerify: (
+      body: SignInWebauthnVerifyRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignInEmailPasswordResponse>> =>
+      request<SignInEmailPasswordResponse>(
+        {
+          method: 'POST',
+          path: `/signin/webauthn/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Sign out of the session of the refresh token or, with a bearer token, of every session of the user. Served by the Node.js server
+     *
+     * `POST /signout`
+     */
+    postSignout: (
+      body: SignOutRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/signout`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Signup with email and password
+     *
+     * `POST /signup/email-password`
+     */
+    postSignupEmailPassword: (
+      body: SignUpEmailPasswordRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/signup/email-password`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Signup with webauthn
+     *
+     * `POST /signup/webauthn`
+     */
+    postSignupWebauthn: (
+      body: SignUpWebauthnRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignUpWebauthnResponse>> =>
+      request<SignUpWebauthnResponse>(
+        {
+          method: 'POST',
+          path: `/signup/webauthn`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify webauthn signup
+     *
+     * `POST /signup/webauthn/verify`
+     */
+    postSignupWebauthnVerify: (
+      body: SignUpWebauthnVerifyRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<SessionPayload>> =>
+      request<SessionPayload>(
+        {
+          method: 'POST',
+          path: `/signup/webauthn/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Refresh the JWT access token
+     *
+     * `POST /token`
+     */
+    postToken: (
+      body: RefreshTokenRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<Session>> =>
+      request<Session>(
+        {
+          method: 'POST',
+          path: `/token`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify an access token, the one in the body or, if there isn't one, the one in the Authorization header. Served by the Node.js server
+     *
+     * `POST /token/verify`
+     */
+    postTokenVerify: (
+      body?: VerifyTokenRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/token/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Get the user of the access token. Served by the Node.js server
+     *
+     * `GET /user`
+     */
+    getUser: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<User>> =>
+      request<User>(
+        {
+          method: 'GET',
+          path: `/user`,
+        },
+        options,
+      ),
+
+    /**
+     * Upload the avatar of the authenticated user. PNG, JPEG and GIF images are accepted, they are resized to fit AUTH_AVATAR_SIZE and stored in the configured object storage. The avatar URL of the user is set to the stored image and the previous one is deleted
+     *
+     * `PUT /user/avatar`
+     */
+    putUserAvatar: (
+      body: BodyInit,
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserAvatar>> =>
+      request<UserAvatar>(
+        {
+          method: 'PUT',
+          path: `/user/avatar`,
+          body,
+        },
+        options,
+      ),
+
+    /**
+     * Delete the uploaded avatar of the authenticated user. The avatar URL goes back to the one of the avatar provider
+     *
+     * `DELETE /user/avatar`
+     */
+    deleteUserAvatar: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserAvatar>> =>
+      request<UserAvatar>(
+        {
+          method: 'DELETE',
+          path: `/user/avatar`,
+        },
+        options,
+      ),
+
+    /**
+     * Request an export of the data stored about the authenticated user. The export is assembled in the background, poll it with the returned ID until it is completed. If an export is already pending it is returned instead of requesting a new one
+     *
+     * `POST /user/data-export`
+     */
+    postUserDataExport: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<DataExport>> =>
+      request<DataExport>(
+        {
+          method: 'POST',
+          path: `/user/data-export`,
+        },
+        options,
+      ),
+
+    /**
+     * Get the status of an export of the authenticated user. Completed exports include a short lived URL to download them
+     *
+     * `GET /user/data-export/{exportId}`
+     */
+    getUserDataExportExportId: (
+      exportId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<DataExport>> =>
+      request<DataExport>(
+        {
+          method: 'GET',
+          path: `/user/data-export/${encodeURIComponent(String(exportId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Deanonymize an anonymous user in adding missing email or email+password, depending on the chosen authentication method. Will send a confirmation email if the server is configured to do so
+     *
+     * `POST /user/deanonymize`
+     */
+    postUserDeanonymize: (
+      body?: UserDeanonymizeRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/deanonymize`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Start deanonymizing the authenticated anonymous user with an OAuth provider. The user needs to be sent to the returned URL to authenticate with the provider and, once the provider account is linked, the user keeps its id and is redirected to redirectTo with a refresh token, on failure with an error
+     *
+     * `POST /user/deanonymize/provider/{provider}`
+     */
+    postUserDeanonymizeProviderProvider: (
+      provider: string,
+      body: SignUpOptions,
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserProviderLinkResponse>> =>
+      request<UserProviderLinkResponse>(
+        {
+          method: 'POST',
+          path: `/user/deanonymize/provider/${encodeURIComponent(String(provider))}`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Schedule the deletion of the authenticated user. All the sessions of the user are revoked and signing in is rejected until the account is deleted at the end of the grace period. A link to cancel the deletion is sent to the user's email
+     *
+     * `POST /user/delete`
+     */
+    postUserDelete: (
+      body: UserDeleteRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserDeleteResponse>> =>
+      request<UserDeleteResponse>(
+        {
+          method: 'POST',
+          path: `/user/delete`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Change user email
+     *
+     * `POST /user/email/change`
+     */
+    postUserEmailChange: (
+      body?: UserEmailChangeRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/email/change`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Send email verification email
+     *
+     * `POST /user/email/send-verification-email`
+     */
+    postUserEmailSendVerificationEmail: (
+      body: UserEmailSendVerificationEmailRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/email/send-verification-email`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Update the metadata of the authenticated user. The body is a JSON merge patch (RFC 7386): objects are merged, null values remove the keys and anything else replaces the current value
+     *
+     * `PATCH /user/metadata`
+     */
+    patchUserMetadata: (
+      body: UserMetadataPatch,
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserMetadata>> =>
+      request<UserMetadata>(
+        {
+          method: 'PATCH',
+          path: `/user/metadata`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Activate or deactivate multi-factor authentication. Served by the Node.js server
+     *
+     * `POST /user/mfa`
+     */
+    postUserMfa: (
+      body: UserMfaRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/mfa`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Set a new password, for the user of the access token or the user of a password reset ticket. Served by the Node.js server
+     *
+     * `POST /user/password`
+     */
+    postUserPassword: (
+      body: UserPasswordRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/password`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Request a password reset. An email with a verification link will be sent to the user's address
+     *
+     * `POST /user/password/reset`
+     */
+    postUserPasswordReset: (
+      body?: UserPasswordResetRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/password/reset`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Change user phone number. A one time password is sent to the new phone number and the change only takes effect once it is verified
+     *
+     * `POST /user/phone-number/change`
+     */
+    postUserPhoneNumberChange: (
+      body: UserPhoneNumberChangeRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/phone-number/change`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify the one time password sent to the new phone number and change it
+     *
+     * `POST /user/phone-number/change/verify`
+     */
+    postUserPhoneNumberChangeVerify: (
+      body: UserPhoneNumberChangeVerifyRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/phone-number/change/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Refresh the OAuth access tokens of a user with a provider. Served by the Node.js server
+     *
+     * `POST /user/provider/tokens`
+     */
+    postUserProviderTokens: (
+      body: UserProviderTokensRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/provider/tokens`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * List the OAuth providers linked to the authenticated user
+     *
+     * `GET /user/providers`
+     */
+    getUserProviders: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserProvidersResponse>> =>
+      request<UserProvidersResponse>(
+        {
+          method: 'GET',
+          path: `/user/providers`,
+        },
+        options,
+      ),
+
+    /**
+     * Unlink an OAuth provider from the authenticated user. Providers can't be unlinked if they are the last method the user has to sign in
+     *
+     * `DELETE /user/providers/{provider}`
+     */
+    deleteUserProvidersProvider: (
+      provider: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/user/providers/${encodeURIComponent(String(provider))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Start linking an OAuth provider to the authenticated user. The user needs to be sent to the returned URL to authenticate with the provider and, once the provider is linked, is redirected to redirectTo with a refresh token, on failure with an error
+     *
+     * `POST /user/providers/{provider}/link`
+     */
+    postUserProvidersProviderLink: (
+      provider: string,
+      body: OptionsRedirectTo,
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserProviderLinkResponse>> =>
+      request<UserProviderLinkResponse>(
+        {
+          method: 'POST',
+          path: `/user/providers/${encodeURIComponent(String(provider))}/link`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * List the active sessions of the authenticated user. A session is identified by the ID of its current refresh token, which changes every time the session is refreshed
+     *
+     * `GET /user/sessions`
+     */
+    getUserSessions: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserSessionsResponse>> =>
+      request<UserSessionsResponse>(
+        {
+          method: 'GET',
+          path: `/user/sessions`,
+        },
+        options,
+      ),
+
+    /**
+     * Revoke all the sessions of the authenticated user, including personal access tokens. When access token revocation is enabled, access tokens issued before the call are rejected by Hasura Auth as well
+     *
+     * `POST /user/sessions/revoke-all`
+     */
+    postUserSessionsRevokeAll: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'POST',
+          path: `/user/sessions/revoke-all`,
+        },
+        options,
+      ),
+
+    /**
+     * Revoke a session of the authenticated user. All the refresh tokens of the session are deleted
+     *
+     * `DELETE /user/sessions/{sessionId}`
+     */
+    deleteUserSessionsSessionId: (
+      sessionId: string,
+      options?: RequestInit,
+    ): Promise<FetchResponse<OKResponse>> =>
+      request<OKResponse>(
+        {
+          method: 'DELETE',
+          path: `/user/sessions/${encodeURIComponent(String(sessionId))}`,
+        },
+        options,
+      ),
+
+    /**
+     * Start adding a new webauthn security key to the authenticated user
+     *
+     * `POST /user/webauthn/add`
+     */
+    postUserWebauthnAdd: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<SignUpWebauthnResponse>> =>
+      request<SignUpWebauthnResponse>(
+        {
+          method: 'POST',
+          path: `/user/webauthn/add`,
+        },
+        options,
+      ),
+
+    /**
+     * Verify and store a new webauthn security key for the authenticated user
+     *
+     * `POST /user/webauthn/verify`
+     */
+    postUserWebauthnVerify: (
+      body: UserAddSecurityKeyVerifyRequest,
+      options?: RequestInit,
+    ): Promise<FetchResponse<UserAddSecurityKeyVerifyResponse>> =>
+      request<UserAddSecurityKeyVerifyResponse>(
+        {
+          method: 'POST',
+          path: `/user/webauthn/verify`,
+          body,
+          contentType: 'application/json',
+        },
+        options,
+      ),
+
+    /**
+     * Verify a ticket sent by email (email verification, email change confirmation, magic link sign in or password reset). The ticket can only be used once. On success the user is redirected to redirectTo with a refresh token, on failure with an error.
+     *
+     * `GET /verify`
+     */
+    getVerify: (
+      params: {
+        /**
+         * URL to redirect the user to once the ticket has been verified
+         */
+        redirectTo: string;
+        /**
+         * Ticket sent to the user's email
+         */
+        ticket: string;
+        /**
+         * Type of the ticket
+         */
+        type: 'emailVerify' | 'emailConfirmChange' | 'emailChangeRevert' | 'signinPasswordless' | 'passwordReset' | 'newDeviceRevoke' | 'accountDeletionCancel';
+      },
+      options?: RequestInit,
+    ): Promise<FetchResponse<void>> =>
+      request<void>(
+        {
+          method: 'GET',
+          path: `/verify`,
+          query: { redirectTo: params?.redirectTo, ticket: params?.ticket, type: params?.type },
+        },
+        options,
+      ),
+
+    /**
+     * Get version
+     *
+     * `GET /version`
+     */
+    getVersion: (
+      options?: RequestInit,
+    ): Promise<FetchResponse<{
+        version: string;
+      }>> =>
+      request<{
+        version: string;
+      }>(
+        {
+          method: 'GET',
+          path: `/version`,
+        },
+        options,
+      ),
+  };
+};
+
+export type Client = ReturnType<typeof createClient>;
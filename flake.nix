@@ -55,12 +55,16 @@
             ./go/api/openapi.yaml
             ./go/api/server.cfg.yaml
             ./go/api/types.cfg.yaml
+            ./go/api/client.cfg.yaml
+            ./clients/typescript/src/client.gen.ts
+            ./go/grpcapi/auth.proto
             ./go/sql/schema.sh
             ./go/sql/sqlc.yaml
             ./go/sql/query.sql
             ./go/sql/auth_schema_dump.sql
             isDirectory
             (inDirectory "email-templates")
+            (inDirectory "migrations")
             (inDirectory "vendor")
           ];
         };
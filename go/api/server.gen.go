@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -17,29 +18,268 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Public keys used to sign the access tokens, only present when using an asymmetric signing algorithm
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(c *gin.Context)
+	// OpenID Connect discovery document of the identity provider, only available when AUTH_OIDC_PROVIDER_ENABLED is set
+	// (GET /.well-known/openid-configuration)
+	GetWellKnownOpenidConfiguration(c *gin.Context)
+	// List the entries of the audit log, most recent first
+	// (GET /admin/audit-logs)
+	GetAdminAuditLogs(c *gin.Context, params GetAdminAuditLogsParams)
+	// Reload the configuration that can be changed without a restart, reading the config file and resolving the secret references again
+	// (POST /admin/config/reload)
+	PostAdminConfigReload(c *gin.Context)
+	// Get the status of an export of any user
+	// (GET /admin/data-exports/{exportId})
+	GetAdminDataExportsExportId(c *gin.Context, exportId openapi_types.UUID)
+	// List the emails of the outbox that couldn't be delivered, either because the provider rejected them or because they failed too many times
+	// (GET /admin/emails/failed)
+	GetAdminEmailsFailed(c *gin.Context, params GetAdminEmailsFailedParams)
+	// Queue a failed email of the outbox to be sent again
+	// (POST /admin/emails/{emailId}/retry)
+	PostAdminEmailsEmailIdRetry(c *gin.Context, emailId openapi_types.UUID)
+	// Register an OAuth2 client that can get access tokens with the client credentials grant. The client secret is only returned once
+	// (POST /admin/oauth2/clients)
+	PostAdminOauth2Clients(c *gin.Context)
+	// Delete an OAuth2 client. Access tokens already issued to the client remain valid until they expire
+	// (DELETE /admin/oauth2/clients/{clientId})
+	DeleteAdminOauth2ClientsClientId(c *gin.Context, clientId string)
+	// List the roles of auth.roles and how many users have each of them
+	// (GET /admin/roles)
+	GetAdminRoles(c *gin.Context)
+	// Add a role to auth.roles so it can be given to users. The role must also be configured in the Hasura permissions to grant any access
+	// (POST /admin/roles)
+	PostAdminRoles(c *gin.Context)
+	// Delete a role from auth.roles and remove it from every user that has it. Roles that are the default role of a user or part of the default allowed roles can't be deleted
+	// (DELETE /admin/roles/{role})
+	DeleteAdminRolesRole(c *gin.Context, role string)
+	// List the sign in methods, whether they are configured and whether they are enabled once the runtime flags are applied
+	// (GET /admin/sign-in-methods)
+	GetAdminSignInMethods(c *gin.Context)
+	// Enable or disable a sign in method at runtime, on every instance, without a redeploy. Methods that aren't configured stay disabled
+	// (PUT /admin/sign-in-methods/{method})
+	PutAdminSignInMethodsMethod(c *gin.Context, method string)
+	// List the users, optionally filtered. Results are paginated with a cursor, pass the nextCursor of the response to get the next page
+	// (GET /admin/users)
+	GetAdminUsers(c *gin.Context, params GetAdminUsersParams)
+	// Import users, i.e. when migrating from another provider. The body has one user per line, either as ND-JSON with one ImportUser per line or as CSV with a header row naming the ImportUser properties. Password hashes are kept as is, bcrypt and argon2 hashes are supported. Users are inserted in batches, each in its own transaction; users whose id or email already exist are skipped
+	// (POST /admin/users/import)
+	PostAdminUsersImport(c *gin.Context, params PostAdminUsersImportParams)
+	// Lift the ban of a user
+	// (DELETE /admin/users/{userId}/ban)
+	DeleteAdminUsersUserIdBan(c *gin.Context, userId openapi_types.UUID)
+	// Ban a user, optionally until a given time, and revoke all their sessions. Banned users can't sign in or refresh their session while the ban lasts
+	// (POST /admin/users/{userId}/ban)
+	PostAdminUsersUserIdBan(c *gin.Context, userId openapi_types.UUID)
+	// Request an export of the data stored about a user, see /user/data-export
+	// (POST /admin/users/{userId}/data-export)
+	PostAdminUsersUserIdDataExport(c *gin.Context, userId openapi_types.UUID)
+	// Disable a user and revoke all their sessions. Disabled users can't sign in or refresh their session until they are enabled again
+	// (POST /admin/users/{userId}/disable)
+	PostAdminUsersUserIdDisable(c *gin.Context, userId openapi_types.UUID)
+	// Enable a disabled user
+	// (POST /admin/users/{userId}/enable)
+	PostAdminUsersUserIdEnable(c *gin.Context, userId openapi_types.UUID)
+	// Get an access token to act as a user, i.e. to debug an issue only they experience. The access token carries the x-hasura-impersonated-by claim and can't be refreshed. Impersonations are always recorded in the audit log
+	// (POST /admin/users/{userId}/impersonate)
+	PostAdminUsersUserIdImpersonate(c *gin.Context, userId openapi_types.UUID)
+	// Set the password of a user, either in plain text, which must comply with the password policy, or already hashed with bcrypt, i.e. when migrating users from another system
+	// (POST /admin/users/{userId}/password)
+	PostAdminUsersUserIdPassword(c *gin.Context, userId openapi_types.UUID)
+	// Invalidate the password of a user, revoke all their sessions and email them a link to set a new password, i.e. after a breach
+	// (POST /admin/users/{userId}/password/reset)
+	PostAdminUsersUserIdPasswordReset(c *gin.Context, userId openapi_types.UUID)
+	// Add a role to a user. The role must exist in auth.roles. It is included in the allowed roles of the access tokens issued from then on
+	// (POST /admin/users/{userId}/roles)
+	PostAdminUsersUserIdRoles(c *gin.Context, userId openapi_types.UUID)
+	// Remove a role from a user. The default role of the user can't be removed
+	// (DELETE /admin/users/{userId}/roles/{role})
+	DeleteAdminUsersUserIdRolesRole(c *gin.Context, userId openapi_types.UUID, role string)
+	// Revoke all the sessions of a user, including personal access tokens. When access token revocation is enabled, access tokens issued before the call are rejected by Hasura Auth as well
+	// (POST /admin/users/{userId}/sessions/revoke-all)
+	PostAdminUsersUserIdSessionsRevokeAll(c *gin.Context, userId openapi_types.UUID)
+	// Unlock a user locked out after too many failed sign in attempts and reset their failed attempts
+	// (POST /admin/users/{userId}/unlock)
+	PostAdminUsersUserIdUnlock(c *gin.Context, userId openapi_types.UUID)
+	// Download a completed export with the ticket of the downloadUrl returned with its status
+	// (GET /data-export/download)
+	GetDataExportDownload(c *gin.Context, params GetDataExportDownloadParams)
+	// Start the device authorization grant (RFC 8628). Returns a device code for the device to poll /device/token with and a user code the user needs to enter in the verification page
+	// (POST /device/code)
+	PostDeviceCode(c *gin.Context)
+	// Poll for the session once the user has approved the device. Until then the request fails with authorization-pending, or slow-down if the device polls faster than the interval
+	// (POST /device/token)
+	PostDeviceToken(c *gin.Context)
+	// Approve or deny a device with the user code it displays. Meant to be called by the verification page on behalf of the authenticated user
+	// (POST /device/verify)
+	PostDeviceVerify(c *gin.Context)
+	// Re-verify the authenticated user's password and, if they have MFA enabled, a TOTP code, and return a new session whose access token carries the x-hasura-auth-elevated claim. The elevated access token is short lived
+	// (POST /elevate)
+	PostElevate(c *gin.Context)
+	// Start a webauthn assertion to elevate the authenticated user's session. The challenge is restricted to the user's security keys
+	// (POST /elevate/webauthn)
+	PostElevateWebauthn(c *gin.Context)
+	// Verify a webauthn assertion and return a new session whose access token carries the x-hasura-auth-elevated claim
+	// (POST /elevate/webauthn/verify)
+	PostElevateWebauthnVerify(c *gin.Context)
+	// Verify the access token of a request for a reverse proxy (Traefik forwardAuth, nginx auth_request). The access token is read from the Authorization header or from the cookie set in AUTH_FORWARD_AUTH_COOKIE_NAME. On success the identity of the user is returned in headers the proxy can forward to the upstream
+	// (GET /forward-auth/verify)
+	GetForwardAuthVerify(c *gin.Context, params GetForwardAuthVerifyParams)
 	// Health check
 	// (GET /healthz)
 	GetHealthz(c *gin.Context)
 	// Health check
 	// (HEAD /healthz)
 	HeadHealthz(c *gin.Context)
+	// Get the number of unused MFA recovery codes of the authenticated user
+	// (GET /mfa/recovery-codes)
+	GetMfaRecoveryCodes(c *gin.Context)
+	// Regenerate the MFA recovery codes of the authenticated user invalidating the previous ones
+	// (POST /mfa/recovery-codes)
+	PostMfaRecoveryCodes(c *gin.Context)
+	// Activate TOTP multi-factor authentication for the authenticated user
+	// (POST /mfa/totp/enable)
+	PostMfaTotpEnable(c *gin.Context)
+	// Generate a secret to request the activation of TOTP multi-factor authentication
+	// (GET /mfa/totp/generate)
+	GetMfaTotpGenerate(c *gin.Context)
+	// OpenID Connect authorization endpoint. Validates the authorization request and redirects the user to the page of the application that signs them in and asks for their consent, or back to the client with an error
+	// (GET /oauth/authorize)
+	GetOauthAuthorize(c *gin.Context, params GetOauthAuthorizeParams)
+	// Authorize a client on behalf of the signed in user. Called by the page set in AUTH_OIDC_PROVIDER_AUTHORIZATION_URL with the parameters of the authorization request. If the user hasn't consented to the scopes yet and consent isn't set, the response asks for it, otherwise it returns the URL to redirect the user to
+	// (POST /oauth/authorize)
+	PostOauthAuthorize(c *gin.Context)
+	// Token introspection (RFC 7662). Returns whether an access token or a refresh token is active and, if it is, the information it holds
+	// (POST /oauth/introspect)
+	PostOauthIntrospect(c *gin.Context)
+	// Get an access token for an OAuth2 client with the client credentials grant (RFC 6749 section 4.4), exchange the access token of a user for a down-scoped one with the token exchange grant (RFC 8693) or exchange an authorization code for the tokens of a user with the authorization code grant (OpenID Connect). The client authenticates with HTTP basic authentication or with its credentials in the request body
+	// (POST /oauth/token)
+	PostOauthToken(c *gin.Context, params PostOauthTokenParams)
+	// OpenID Connect userinfo endpoint. Returns the claims of the user the scopes allowed by the user give access to
+	// (GET /oauth/userinfo)
+	GetOauthUserinfo(c *gin.Context)
+	// Create an organization. The authenticated user becomes its owner
+	// (POST /organizations)
+	PostOrganizations(c *gin.Context)
+	// Accept an invitation to join an organization. The invitation must have been sent to the email of the authenticated user
+	// (POST /organizations/invites/accept)
+	PostOrganizationsInvitesAccept(c *gin.Context)
+	// Switch the active organization of the authenticated user and return a new session whose access token carries the x-hasura-org-id and x-hasura-org-role claims of that organization. A null organizationId leaves the organizations
+	// (POST /organizations/switch)
+	PostOrganizationsSwitch(c *gin.Context)
+	// Invite someone to join an organization by email. Only owners and admins of the organization can invite members, and only owners can invite owners
+	// (POST /organizations/{organizationId}/invites)
+	PostOrganizationsOrganizationIdInvites(c *gin.Context, organizationId openapi_types.UUID)
+	// List the Personal Access Tokens (PAT) of the authenticated user. The tokens themselves are never returned, only their details
+	// (GET /pat)
+	GetPat(c *gin.Context)
 	// Create a Personal Access Token (PAT)
 	// (POST /pat)
 	PostPat(c *gin.Context)
+	// Revoke a Personal Access Token (PAT) of the authenticated user
+	// (DELETE /pat/{patId})
+	DeletePatPatId(c *gin.Context, patId openapi_types.UUID)
+	// Readiness check, verifies the database, the SMTP server and the JWT signing key are available
+	// (GET /readyz)
+	GetReadyz(c *gin.Context)
+	// Readiness check, verifies the database, the SMTP server and the JWT signing key are available
+	// (HEAD /readyz)
+	HeadReadyz(c *gin.Context)
+	// List the roles of auth.roles as SCIM 2.0 groups. Only equality filters on displayName are supported
+	// (GET /scim/v2/Groups)
+	GetScimV2Groups(c *gin.Context, params GetScimV2GroupsParams)
+	// Create a role named after the displayName of the group and give it to its members
+	// (POST /scim/v2/Groups)
+	PostScimV2Groups(c *gin.Context)
+	// Delete a role from auth.roles. Roles that are the default role of a user or part of the default allowed roles can't be deleted
+	// (DELETE /scim/v2/Groups/{groupId})
+	DeleteScimV2GroupsGroupId(c *gin.Context, groupId string)
+	// Get a role of auth.roles as a SCIM 2.0 group
+	// (GET /scim/v2/Groups/{groupId})
+	GetScimV2GroupsGroupId(c *gin.Context, groupId string)
+	// Add or remove members of a group. Groups can't be renamed
+	// (PATCH /scim/v2/Groups/{groupId})
+	PatchScimV2GroupsGroupId(c *gin.Context, groupId string)
+	// Replace the members of a group. Groups can't be renamed
+	// (PUT /scim/v2/Groups/{groupId})
+	PutScimV2GroupsGroupId(c *gin.Context, groupId string)
+	// List the users as SCIM 2.0 resources. Only equality filters on userName, externalId and emails.value are supported
+	// (GET /scim/v2/Users)
+	GetScimV2Users(c *gin.Context, params GetScimV2UsersParams)
+	// Provision a user. The email of the user is its userName and is trusted as verified, passwords sent by the identity provider are ignored
+	// (POST /scim/v2/Users)
+	PostScimV2Users(c *gin.Context)
+	// Deprovision a user, deleting it and everything stored about it
+	// (DELETE /scim/v2/Users/{userId})
+	DeleteScimV2UsersUserId(c *gin.Context, userId openapi_types.UUID)
+	// Get a user as a SCIM 2.0 resource
+	// (GET /scim/v2/Users/{userId})
+	GetScimV2UsersUserId(c *gin.Context, userId openapi_types.UUID)
+	// Update some attributes of a user. Disabling a user revokes their sessions
+	// (PATCH /scim/v2/Users/{userId})
+	PatchScimV2UsersUserId(c *gin.Context, userId openapi_types.UUID)
+	// Replace the attributes of a user. Disabling a user revokes their sessions
+	// (PUT /scim/v2/Users/{userId})
+	PutScimV2UsersUserId(c *gin.Context, userId openapi_types.UUID)
+	// Sign in as an anonymous user. The user will get the `anonymous` role and can later be deanonymized using /user/deanonymize
+	// (POST /signin/anonymous)
+	PostSigninAnonymous(c *gin.Context)
 	// Sign in with email and password
 	// (POST /signin/email-password)
 	PostSigninEmailPassword(c *gin.Context)
+	// Sign in with an ID token obtained by a native SDK of the provider, like Google Sign-In or Sign in with Apple. The user is created or linked the same way as with /signin/provider/{provider}
+	// (POST /signin/idtoken)
+	PostSigninIdtoken(c *gin.Context)
+	// Sign in with a recovery code instead of a TOTP code after a multi-factor authentication challenge
+	// (POST /signin/mfa/recovery-code)
+	PostSigninMfaRecoveryCode(c *gin.Context)
+	// Sign in with a TOTP code after a multi-factor authentication challenge
+	// (POST /signin/mfa/totp)
+	PostSigninMfaTotp(c *gin.Context)
 	// Sign in with magic link sent to user's email. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
 	// (POST /signin/passwordless/email)
 	PostSigninPasswordlessEmail(c *gin.Context)
+	// Sign in with a one time password sent to user's phone number. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
+	// (POST /signin/passwordless/sms)
+	PostSigninPasswordlessSms(c *gin.Context)
+	// Verify the one time password sent to user's phone number and sign in
+	// (POST /signin/passwordless/sms/otp)
+	PostSigninPasswordlessSmsOtp(c *gin.Context)
 	// Sign in with Personal Access Token (PAT)
 	// (POST /signin/pat)
 	PostSigninPat(c *gin.Context)
+	// Start a sign in with an OAuth provider. The user is redirected to the provider and, once authenticated, back to the callback endpoint
+	// (GET /signin/provider/{provider})
+	GetSigninProviderProvider(c *gin.Context, provider string, params GetSigninProviderProviderParams)
+	// OAuth callback. On success the user is redirected to the redirectTo given when starting the sign in with a refresh token, on failure with an error
+	// (GET /signin/provider/{provider}/callback)
+	GetSigninProviderProviderCallback(c *gin.Context, provider string, params GetSigninProviderProviderCallbackParams)
+	// OAuth callback for providers that return the response with response_mode=form_post, like Apple. Behaves like the GET callback
+	// (POST /signin/provider/{provider}/callback)
+	PostSigninProviderProviderCallback(c *gin.Context, provider string, params PostSigninProviderProviderCallbackParams)
+	// Start a sign in with the SAML identity provider. The user is redirected to the identity provider and, once authenticated, its response is posted to the ACS endpoint
+	// (GET /signin/saml)
+	GetSigninSaml(c *gin.Context, params GetSigninSamlParams)
+	// SAML assertion consumer service. On success the user is redirected to the redirectTo given when starting the sign in with a refresh token, on failure with an error
+	// (POST /signin/saml/acs)
+	PostSigninSamlAcs(c *gin.Context)
+	// SAML service provider metadata, used to register hasura-auth with the identity provider
+	// (GET /signin/saml/metadata)
+	GetSigninSamlMetadata(c *gin.Context)
+	// Start a webauthn sign in. If an email is provided the challenge is restricted to the user's security keys, otherwise a discoverable credential (passkey) can be used
+	// (POST /signin/webauthn)
+	PostSigninWebauthn(c *gin.Context)
+	// Verify webauthn sign in
+	// (POST /signin/webauthn/verify)
+	PostSigninWebauthnVerify(c *gin.Context)
 	// Signup with email and password
 	// (POST /signup/email-password)
 	PostSignupEmailPassword(c *gin.Context)
@@ -52,18 +292,72 @@ type ServerInterface interface {
 	// Refresh the JWT access token
 	// (POST /token)
 	PostToken(c *gin.Context)
+	// Delete the uploaded avatar of the authenticated user. The avatar URL goes back to the one of the avatar provider
+	// (DELETE /user/avatar)
+	DeleteUserAvatar(c *gin.Context)
+	// Upload the avatar of the authenticated user. PNG, JPEG and GIF images are accepted, they are resized to fit AUTH_AVATAR_SIZE and stored in the configured object storage. The avatar URL of the user is set to the stored image and the previous one is deleted
+	// (PUT /user/avatar)
+	PutUserAvatar(c *gin.Context)
+	// Request an export of the data stored about the authenticated user. The export is assembled in the background, poll it with the returned ID until it is completed. If an export is already pending it is returned instead of requesting a new one
+	// (POST /user/data-export)
+	PostUserDataExport(c *gin.Context)
+	// Get the status of an export of the authenticated user. Completed exports include a short lived URL to download them
+	// (GET /user/data-export/{exportId})
+	GetUserDataExportExportId(c *gin.Context, exportId openapi_types.UUID)
 	// Deanonymize an anonymous user in adding missing email or email+password, depending on the chosen authentication method. Will send a confirmation email if the server is configured to do so
 	// (POST /user/deanonymize)
 	PostUserDeanonymize(c *gin.Context)
+	// Start deanonymizing the authenticated anonymous user with an OAuth provider. The user needs to be sent to the returned URL to authenticate with the provider and, once the provider account is linked, the user keeps its id and is redirected to redirectTo with a refresh token, on failure with an error
+	// (POST /user/deanonymize/provider/{provider})
+	PostUserDeanonymizeProviderProvider(c *gin.Context, provider string)
+	// Schedule the deletion of the authenticated user. All the sessions of the user are revoked and signing in is rejected until the account is deleted at the end of the grace period. A link to cancel the deletion is sent to the user's email
+	// (POST /user/delete)
+	PostUserDelete(c *gin.Context)
 	// Change user email
 	// (POST /user/email/change)
 	PostUserEmailChange(c *gin.Context)
 	// Send email verification email
 	// (POST /user/email/send-verification-email)
 	PostUserEmailSendVerificationEmail(c *gin.Context)
+	// Update the metadata of the authenticated user. The body is a JSON merge patch (RFC 7386): objects are merged, null values remove the keys and anything else replaces the current value
+	// (PATCH /user/metadata)
+	PatchUserMetadata(c *gin.Context)
 	// Request a password reset. An email with a verification link will be sent to the user's address
 	// (POST /user/password/reset)
 	PostUserPasswordReset(c *gin.Context)
+	// Change user phone number. A one time password is sent to the new phone number and the change only takes effect once it is verified
+	// (POST /user/phone-number/change)
+	PostUserPhoneNumberChange(c *gin.Context)
+	// Verify the one time password sent to the new phone number and change it
+	// (POST /user/phone-number/change/verify)
+	PostUserPhoneNumberChangeVerify(c *gin.Context)
+	// List the OAuth providers linked to the authenticated user
+	// (GET /user/providers)
+	GetUserProviders(c *gin.Context)
+	// Unlink an OAuth provider from the authenticated user. Providers can't be unlinked if they are the last method the user has to sign in
+	// (DELETE /user/providers/{provider})
+	DeleteUserProvidersProvider(c *gin.Context, provider string)
+	// Start linking an OAuth provider to the authenticated user. The user needs to be sent to the returned URL to authenticate with the provider and, once the provider is linked, is redirected to redirectTo with a refresh token, on failure with an error
+	// (POST /user/providers/{provider}/link)
+	PostUserProvidersProviderLink(c *gin.Context, provider string)
+	// List the active sessions of the authenticated user. A session is identified by the ID of its current refresh token, which changes every time the session is refreshed
+	// (GET /user/sessions)
+	GetUserSessions(c *gin.Context)
+	// Revoke all the sessions of the authenticated user, including personal access tokens. When access token revocation is enabled, access tokens issued before the call are rejected by Hasura Auth as well
+	// (POST /user/sessions/revoke-all)
+	PostUserSessionsRevokeAll(c *gin.Context)
+	// Revoke a session of the authenticated user. All the refresh tokens of the session are deleted
+	// (DELETE /user/sessions/{sessionId})
+	DeleteUserSessionsSessionId(c *gin.Context, sessionId openapi_types.UUID)
+	// Start adding a new webauthn security key to the authenticated user
+	// (POST /user/webauthn/add)
+	PostUserWebauthnAdd(c *gin.Context)
+	// Verify and store a new webauthn security key for the authenticated user
+	// (POST /user/webauthn/verify)
+	PostUserWebauthnVerify(c *gin.Context)
+	// Verify a ticket sent by email (email verification, email change confirmation, magic link sign in or password reset). The ticket can only be used once. On success the user is redirected to redirectTo with a refresh token, on failure with an error.
+	// (GET /verify)
+	GetVerify(c *gin.Context, params GetVerifyParams)
 	// Get version
 	// (GET /version)
 	GetVersion(c *gin.Context)
@@ -78,8 +372,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// GetHealthz operation middleware
-func (siw *ServerInterfaceWrapper) GetHealthz(c *gin.Context) {
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -88,11 +382,11 @@ func (siw *ServerInterfaceWrapper) GetHealthz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealthz(c)
+	siw.Handler.GetWellKnownJwksJson(c)
 }
 
-// HeadHealthz operation middleware
-func (siw *ServerInterfaceWrapper) HeadHealthz(c *gin.Context) {
+// GetWellKnownOpenidConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownOpenidConfiguration(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -101,26 +395,50 @@ func (siw *ServerInterfaceWrapper) HeadHealthz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.HeadHealthz(c)
+	siw.Handler.GetWellKnownOpenidConfiguration(c)
 }
 
-// PostPat operation middleware
-func (siw *ServerInterfaceWrapper) PostPat(c *gin.Context) {
+// GetAdminAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAuditLogs(c *gin.Context) {
 
-	c.Set(BearerAuthElevatedScopes, []string{})
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	c.Set(AdminSecretScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditLogsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.PostPat(c)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// PostSigninEmailPassword operation middleware
-func (siw *ServerInterfaceWrapper) PostSigninEmailPassword(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "event" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event", c.Request.URL.Query(), &params.Event)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter event: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -129,11 +447,13 @@ func (siw *ServerInterfaceWrapper) PostSigninEmailPassword(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSigninEmailPassword(c)
+	siw.Handler.GetAdminAuditLogs(c, params)
 }
 
-// PostSigninPasswordlessEmail operation middleware
-func (siw *ServerInterfaceWrapper) PostSigninPasswordlessEmail(c *gin.Context) {
+// PostAdminConfigReload operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminConfigReload(c *gin.Context) {
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -142,11 +462,24 @@ func (siw *ServerInterfaceWrapper) PostSigninPasswordlessEmail(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSigninPasswordlessEmail(c)
+	siw.Handler.PostAdminConfigReload(c)
 }
 
-// PostSigninPat operation middleware
-func (siw *ServerInterfaceWrapper) PostSigninPat(c *gin.Context) {
+// GetAdminDataExportsExportId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminDataExportsExportId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "exportId" -------------
+	var exportId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", c.Param("exportId"), &exportId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exportId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -155,11 +488,26 @@ func (siw *ServerInterfaceWrapper) PostSigninPat(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSigninPat(c)
+	siw.Handler.GetAdminDataExportsExportId(c, exportId)
 }
 
-// PostSignupEmailPassword operation middleware
-func (siw *ServerInterfaceWrapper) PostSignupEmailPassword(c *gin.Context) {
+// GetAdminEmailsFailed operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminEmailsFailed(c *gin.Context) {
+
+	var err error
+
+	c.Set(AdminSecretScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminEmailsFailedParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -168,11 +516,24 @@ func (siw *ServerInterfaceWrapper) PostSignupEmailPassword(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSignupEmailPassword(c)
+	siw.Handler.GetAdminEmailsFailed(c, params)
 }
 
-// PostSignupWebauthn operation middleware
-func (siw *ServerInterfaceWrapper) PostSignupWebauthn(c *gin.Context) {
+// PostAdminEmailsEmailIdRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminEmailsEmailIdRetry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "emailId" -------------
+	var emailId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emailId", c.Param("emailId"), &emailId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter emailId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -181,11 +542,13 @@ func (siw *ServerInterfaceWrapper) PostSignupWebauthn(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSignupWebauthn(c)
+	siw.Handler.PostAdminEmailsEmailIdRetry(c, emailId)
 }
 
-// PostSignupWebauthnVerify operation middleware
-func (siw *ServerInterfaceWrapper) PostSignupWebauthnVerify(c *gin.Context) {
+// PostAdminOauth2Clients operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminOauth2Clients(c *gin.Context) {
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -194,11 +557,24 @@ func (siw *ServerInterfaceWrapper) PostSignupWebauthnVerify(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSignupWebauthnVerify(c)
+	siw.Handler.PostAdminOauth2Clients(c)
 }
 
-// PostToken operation middleware
-func (siw *ServerInterfaceWrapper) PostToken(c *gin.Context) {
+// DeleteAdminOauth2ClientsClientId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminOauth2ClientsClientId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clientId" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientId", c.Param("clientId"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -207,13 +583,13 @@ func (siw *ServerInterfaceWrapper) PostToken(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostToken(c)
+	siw.Handler.DeleteAdminOauth2ClientsClientId(c, clientId)
 }
 
-// PostUserDeanonymize operation middleware
-func (siw *ServerInterfaceWrapper) PostUserDeanonymize(c *gin.Context) {
+// GetAdminRoles operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminRoles(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -222,13 +598,13 @@ func (siw *ServerInterfaceWrapper) PostUserDeanonymize(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUserDeanonymize(c)
+	siw.Handler.GetAdminRoles(c)
 }
 
-// PostUserEmailChange operation middleware
-func (siw *ServerInterfaceWrapper) PostUserEmailChange(c *gin.Context) {
+// PostAdminRoles operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminRoles(c *gin.Context) {
 
-	c.Set(BearerAuthElevatedScopes, []string{})
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -237,11 +613,24 @@ func (siw *ServerInterfaceWrapper) PostUserEmailChange(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUserEmailChange(c)
+	siw.Handler.PostAdminRoles(c)
 }
 
-// PostUserEmailSendVerificationEmail operation middleware
-func (siw *ServerInterfaceWrapper) PostUserEmailSendVerificationEmail(c *gin.Context) {
+// DeleteAdminRolesRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminRolesRole(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role", c.Param("role"), &role, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -250,11 +639,13 @@ func (siw *ServerInterfaceWrapper) PostUserEmailSendVerificationEmail(c *gin.Con
 		}
 	}
 
-	siw.Handler.PostUserEmailSendVerificationEmail(c)
+	siw.Handler.DeleteAdminRolesRole(c, role)
 }
 
-// PostUserPasswordReset operation middleware
-func (siw *ServerInterfaceWrapper) PostUserPasswordReset(c *gin.Context) {
+// GetAdminSignInMethods operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminSignInMethods(c *gin.Context) {
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -263,11 +654,24 @@ func (siw *ServerInterfaceWrapper) PostUserPasswordReset(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUserPasswordReset(c)
+	siw.Handler.GetAdminSignInMethods(c)
 }
 
-// GetVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetVersion(c *gin.Context) {
+// PutAdminSignInMethodsMethod operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminSignInMethodsMethod(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "method" -------------
+	var method string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "method", c.Param("method"), &method, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter method: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -276,394 +680,7758 @@ func (siw *ServerInterfaceWrapper) GetVersion(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVersion(c)
+	siw.Handler.PutAdminSignInMethodsMethod(c, method)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetAdminUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsers(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	c.Set(AdminSecretScopes, []string{})
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/healthz", wrapper.GetHealthz)
-	router.HEAD(options.BaseURL+"/healthz", wrapper.HeadHealthz)
-	router.POST(options.BaseURL+"/pat", wrapper.PostPat)
-	router.POST(options.BaseURL+"/signin/email-password", wrapper.PostSigninEmailPassword)
-	router.POST(options.BaseURL+"/signin/passwordless/email", wrapper.PostSigninPasswordlessEmail)
-	router.POST(options.BaseURL+"/signin/pat", wrapper.PostSigninPat)
-	router.POST(options.BaseURL+"/signup/email-password", wrapper.PostSignupEmailPassword)
-	router.POST(options.BaseURL+"/signup/webauthn", wrapper.PostSignupWebauthn)
-	router.POST(options.BaseURL+"/signup/webauthn/verify", wrapper.PostSignupWebauthnVerify)
-	router.POST(options.BaseURL+"/token", wrapper.PostToken)
-	router.POST(options.BaseURL+"/user/deanonymize", wrapper.PostUserDeanonymize)
-	router.POST(options.BaseURL+"/user/email/change", wrapper.PostUserEmailChange)
-	router.POST(options.BaseURL+"/user/email/send-verification-email", wrapper.PostUserEmailSendVerificationEmail)
-	router.POST(options.BaseURL+"/user/password/reset", wrapper.PostUserPasswordReset)
-	router.GET(options.BaseURL+"/version", wrapper.GetVersion)
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type GetHealthzRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetHealthzResponseObject interface {
-	VisitGetHealthzResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "email" -------------
 
-type GetHealthz200JSONResponse OKResponse
+	err = runtime.BindQueryParameter("form", true, false, "email", c.Request.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetHealthz200JSONResponse) VisitGetHealthzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "role" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type HeadHealthzRequestObject struct {
-}
+	// ------------- Optional query parameter "provider" -------------
 
-type HeadHealthzResponseObject interface {
-	VisitHeadHealthzResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type HeadHealthz200Response struct {
-}
+	// ------------- Optional query parameter "createdAfter" -------------
 
-func (response HeadHealthz200Response) VisitHeadHealthzResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPatRequestObject struct {
-	Body *PostPatJSONRequestBody
-}
+	// ------------- Optional query parameter "createdBefore" -------------
 
-type PostPatResponseObject interface {
-	VisitPostPatResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", c.Request.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdBefore: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPat200JSONResponse CreatePATResponse
+	// ------------- Optional query parameter "disabled" -------------
 
-func (response PostPat200JSONResponse) VisitPostPatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "disabled", c.Request.URL.Query(), &params.Disabled)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter disabled: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "emailVerified" -------------
 
-type PostSigninEmailPasswordRequestObject struct {
-	Body *PostSigninEmailPasswordJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "emailVerified", c.Request.URL.Query(), &params.EmailVerified)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter emailVerified: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostSigninEmailPasswordResponseObject interface {
-	VisitPostSigninEmailPasswordResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "sortBy" -------------
 
-type PostSigninEmailPassword200JSONResponse SignInEmailPasswordResponse
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostSigninEmailPassword200JSONResponse) VisitPostSigninEmailPasswordResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "order" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostSigninPasswordlessEmailRequestObject struct {
-	Body *PostSigninPasswordlessEmailJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostSigninPasswordlessEmailResponseObject interface {
-	VisitPostSigninPasswordlessEmailResponse(w http.ResponseWriter) error
+	siw.Handler.GetAdminUsers(c, params)
 }
 
-type PostSigninPasswordlessEmail200JSONResponse OKResponse
+// PostAdminUsersImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersImport(c *gin.Context) {
 
-func (response PostSigninPasswordlessEmail200JSONResponse) VisitPostSigninPasswordlessEmailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(AdminSecretScopes, []string{})
 
-type PostSigninPatRequestObject struct {
-	Body *PostSigninPatJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminUsersImportParams
 
-type PostSigninPatResponseObject interface {
-	VisitPostSigninPatResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "format" -------------
 
-type PostSigninPat200JSONResponse SessionPayload
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostSigninPat200JSONResponse) VisitPostSigninPatResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostAdminUsersImport(c, params)
 }
 
-type PostSignupEmailPasswordRequestObject struct {
-	Body *PostSignupEmailPasswordJSONRequestBody
-}
+// DeleteAdminUsersUserIdBan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersUserIdBan(c *gin.Context) {
 
-type PostSignupEmailPasswordResponseObject interface {
-	VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostSignupEmailPassword200JSONResponse SessionPayload
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-func (response PostSignupEmailPassword200JSONResponse) VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAdminUsersUserIdBan(c, userId)
 }
 
-type PostSignupEmailPassword403JSONResponse ErrorResponse
+// PostAdminUsersUserIdBan operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdBan(c *gin.Context) {
 
-func (response PostSignupEmailPassword403JSONResponse) VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type PostSignupEmailPassword409JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostSignupEmailPassword409JSONResponse) VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	c.Set(AdminSecretScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostSignupWebauthnRequestObject struct {
-	Body *PostSignupWebauthnJSONRequestBody
+	siw.Handler.PostAdminUsersUserIdBan(c, userId)
 }
 
-type PostSignupWebauthnResponseObject interface {
-	VisitPostSignupWebauthnResponse(w http.ResponseWriter) error
-}
+// PostAdminUsersUserIdDataExport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdDataExport(c *gin.Context) {
 
-type PostSignupWebauthn200JSONResponse SignUpWebauthnResponse
+	var err error
 
-func (response PostSignupWebauthn200JSONResponse) VisitPostSignupWebauthnResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostSignupWebauthnVerifyRequestObject struct {
-	Body *PostSignupWebauthnVerifyJSONRequestBody
-}
+	c.Set(AdminSecretScopes, []string{})
 
-type PostSignupWebauthnVerifyResponseObject interface {
-	VisitPostSignupWebauthnVerifyResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdDataExport(c, userId)
 }
 
-type PostSignupWebauthnVerify200JSONResponse SessionPayload
+// PostAdminUsersUserIdDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdDisable(c *gin.Context) {
 
-func (response PostSignupWebauthnVerify200JSONResponse) VisitPostSignupWebauthnVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type PostTokenRequestObject struct {
-	Body *PostTokenJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostTokenResponseObject interface {
-	VisitPostTokenResponse(w http.ResponseWriter) error
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdDisable(c, userId)
 }
 
-type PostToken200JSONResponse Session
+// PostAdminUsersUserIdEnable operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdEnable(c *gin.Context) {
 
-func (response PostToken200JSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type PostUserDeanonymizeRequestObject struct {
-	Body *PostUserDeanonymizeJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostUserDeanonymizeResponseObject interface {
-	VisitPostUserDeanonymizeResponse(w http.ResponseWriter) error
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdEnable(c, userId)
 }
 
-type PostUserDeanonymize200JSONResponse OKResponse
+// PostAdminUsersUserIdImpersonate operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdImpersonate(c *gin.Context) {
 
-func (response PostUserDeanonymize200JSONResponse) VisitPostUserDeanonymizeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type PostUserEmailChangeRequestObject struct {
-	Body *PostUserEmailChangeJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostUserEmailChangeResponseObject interface {
-	VisitPostUserEmailChangeResponse(w http.ResponseWriter) error
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdImpersonate(c, userId)
 }
 
-type PostUserEmailChange200JSONResponse OKResponse
+// PostAdminUsersUserIdPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdPassword(c *gin.Context) {
 
-func (response PostUserEmailChange200JSONResponse) VisitPostUserEmailChangeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type PostUserEmailSendVerificationEmailRequestObject struct {
-	Body *PostUserEmailSendVerificationEmailJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostUserEmailSendVerificationEmailResponseObject interface {
-	VisitPostUserEmailSendVerificationEmailResponse(w http.ResponseWriter) error
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdPassword(c, userId)
 }
 
-type PostUserEmailSendVerificationEmail200JSONResponse OKResponse
+// PostAdminUsersUserIdPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdPasswordReset(c *gin.Context) {
 
-func (response PostUserEmailSendVerificationEmail200JSONResponse) VisitPostUserEmailSendVerificationEmailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type PostUserPasswordResetRequestObject struct {
-	Body *PostUserPasswordResetJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostUserPasswordResetResponseObject interface {
-	VisitPostUserPasswordResetResponse(w http.ResponseWriter) error
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdPasswordReset(c, userId)
 }
 
-type PostUserPasswordReset200JSONResponse OKResponse
+// PostAdminUsersUserIdRoles operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdRoles(c *gin.Context) {
 
-func (response PostUserPasswordReset200JSONResponse) VisitPostUserPasswordResetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-type GetVersionRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetVersionResponseObject interface {
-	VisitGetVersionResponse(w http.ResponseWriter) error
-}
+	c.Set(AdminSecretScopes, []string{})
 
-type GetVersion200JSONResponse struct {
-	Version string `json:"version"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdRoles(c, userId)
 }
 
-func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteAdminUsersUserIdRolesRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminUsersUserIdRolesRole(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role", c.Param("role"), &role, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAdminUsersUserIdRolesRole(c, userId, role)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Health check
-	// (GET /healthz)
-	GetHealthz(ctx context.Context, request GetHealthzRequestObject) (GetHealthzResponseObject, error)
-	// Health check
-	// (HEAD /healthz)
-	HeadHealthz(ctx context.Context, request HeadHealthzRequestObject) (HeadHealthzResponseObject, error)
-	// Create a Personal Access Token (PAT)
-	// (POST /pat)
-	PostPat(ctx context.Context, request PostPatRequestObject) (PostPatResponseObject, error)
-	// Sign in with email and password
-	// (POST /signin/email-password)
-	PostSigninEmailPassword(ctx context.Context, request PostSigninEmailPasswordRequestObject) (PostSigninEmailPasswordResponseObject, error)
-	// Sign in with magic link sent to user's email. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
-	// (POST /signin/passwordless/email)
-	PostSigninPasswordlessEmail(ctx context.Context, request PostSigninPasswordlessEmailRequestObject) (PostSigninPasswordlessEmailResponseObject, error)
-	// Sign in with Personal Access Token (PAT)
-	// (POST /signin/pat)
-	PostSigninPat(ctx context.Context, request PostSigninPatRequestObject) (PostSigninPatResponseObject, error)
-	// Signup with email and password
-	// (POST /signup/email-password)
-	PostSignupEmailPassword(ctx context.Context, request PostSignupEmailPasswordRequestObject) (PostSignupEmailPasswordResponseObject, error)
-	// Signup with webauthn
-	// (POST /signup/webauthn)
-	PostSignupWebauthn(ctx context.Context, request PostSignupWebauthnRequestObject) (PostSignupWebauthnResponseObject, error)
-	// Verify webauthn signup
-	// (POST /signup/webauthn/verify)
-	PostSignupWebauthnVerify(ctx context.Context, request PostSignupWebauthnVerifyRequestObject) (PostSignupWebauthnVerifyResponseObject, error)
-	// Refresh the JWT access token
-	// (POST /token)
-	PostToken(ctx context.Context, request PostTokenRequestObject) (PostTokenResponseObject, error)
-	// Deanonymize an anonymous user in adding missing email or email+password, depending on the chosen authentication method. Will send a confirmation email if the server is configured to do so
-	// (POST /user/deanonymize)
-	PostUserDeanonymize(ctx context.Context, request PostUserDeanonymizeRequestObject) (PostUserDeanonymizeResponseObject, error)
-	// Change user email
-	// (POST /user/email/change)
-	PostUserEmailChange(ctx context.Context, request PostUserEmailChangeRequestObject) (PostUserEmailChangeResponseObject, error)
-	// Send email verification email
-	// (POST /user/email/send-verification-email)
-	PostUserEmailSendVerificationEmail(ctx context.Context, request PostUserEmailSendVerificationEmailRequestObject) (PostUserEmailSendVerificationEmailResponseObject, error)
-	// Request a password reset. An email with a verification link will be sent to the user's address
-	// (POST /user/password/reset)
-	PostUserPasswordReset(ctx context.Context, request PostUserPasswordResetRequestObject) (PostUserPasswordResetResponseObject, error)
-	// Get version
-	// (GET /version)
-	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
+// PostAdminUsersUserIdSessionsRevokeAll operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdSessionsRevokeAll(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdSessionsRevokeAll(c, userId)
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// PostAdminUsersUserIdUnlock operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdUnlock(c *gin.Context) {
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(AdminSecretScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminUsersUserIdUnlock(c, userId)
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// GetDataExportDownload operation middleware
+func (siw *ServerInterfaceWrapper) GetDataExportDownload(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDataExportDownloadParams
+
+	// ------------- Required query parameter "ticket" -------------
+
+	if paramValue := c.Query("ticket"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument ticket is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "ticket", c.Request.URL.Query(), &params.Ticket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDataExportDownload(c, params)
+}
+
+// PostDeviceCode operation middleware
+func (siw *ServerInterfaceWrapper) PostDeviceCode(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostDeviceCode(c)
+}
+
+// PostDeviceToken operation middleware
+func (siw *ServerInterfaceWrapper) PostDeviceToken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostDeviceToken(c)
+}
+
+// PostDeviceVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostDeviceVerify(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostDeviceVerify(c)
+}
+
+// PostElevate operation middleware
+func (siw *ServerInterfaceWrapper) PostElevate(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostElevate(c)
+}
+
+// PostElevateWebauthn operation middleware
+func (siw *ServerInterfaceWrapper) PostElevateWebauthn(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostElevateWebauthn(c)
+}
+
+// PostElevateWebauthnVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostElevateWebauthnVerify(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostElevateWebauthnVerify(c)
+}
+
+// GetForwardAuthVerify operation middleware
+func (siw *ServerInterfaceWrapper) GetForwardAuthVerify(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetForwardAuthVerifyParams
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = &Authorization
+
+	}
+
+	// ------------- Optional header parameter "Cookie" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Cookie")]; found {
+		var Cookie string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Cookie, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Cookie", valueList[0], &Cookie, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Cookie: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Cookie = &Cookie
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetForwardAuthVerify(c, params)
 }
 
 // GetHealthz operation middleware
-func (sh *strictHandler) GetHealthz(ctx *gin.Context) {
-	var request GetHealthzRequestObject
+func (siw *ServerInterfaceWrapper) GetHealthz(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealthz(c)
+}
+
+// HeadHealthz operation middleware
+func (siw *ServerInterfaceWrapper) HeadHealthz(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HeadHealthz(c)
+}
+
+// GetMfaRecoveryCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetMfaRecoveryCodes(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMfaRecoveryCodes(c)
+}
+
+// PostMfaRecoveryCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostMfaRecoveryCodes(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMfaRecoveryCodes(c)
+}
+
+// PostMfaTotpEnable operation middleware
+func (siw *ServerInterfaceWrapper) PostMfaTotpEnable(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMfaTotpEnable(c)
+}
+
+// GetMfaTotpGenerate operation middleware
+func (siw *ServerInterfaceWrapper) GetMfaTotpGenerate(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMfaTotpGenerate(c)
+}
+
+// GetOauthAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) GetOauthAuthorize(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOauthAuthorizeParams
+
+	// ------------- Required query parameter "response_type" -------------
+
+	if paramValue := c.Query("response_type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument response_type is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "response_type", c.Request.URL.Query(), &params.ResponseType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter response_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "client_id" -------------
+
+	if paramValue := c.Query("client_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument client_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "client_id", c.Request.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter client_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "redirect_uri" -------------
+
+	if paramValue := c.Query("redirect_uri"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument redirect_uri is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "redirect_uri", c.Request.URL.Query(), &params.RedirectUri)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirect_uri: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "scope" -------------
+
+	if paramValue := c.Query("scope"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument scope is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scope", c.Request.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "nonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nonce", c.Request.URL.Query(), &params.Nonce)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nonce: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "code_challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge", c.Request.URL.Query(), &params.CodeChallenge)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code_challenge: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "code_challenge_method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge_method", c.Request.URL.Query(), &params.CodeChallengeMethod)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code_challenge_method: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOauthAuthorize(c, params)
+}
+
+// PostOauthAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) PostOauthAuthorize(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOauthAuthorize(c)
+}
+
+// PostOauthIntrospect operation middleware
+func (siw *ServerInterfaceWrapper) PostOauthIntrospect(c *gin.Context) {
+
+	c.Set(IntrospectionClientScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOauthIntrospect(c)
+}
+
+// PostOauthToken operation middleware
+func (siw *ServerInterfaceWrapper) PostOauthToken(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostOauthTokenParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Authorization, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Authorization: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Authorization = &Authorization
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOauthToken(c, params)
+}
+
+// GetOauthUserinfo operation middleware
+func (siw *ServerInterfaceWrapper) GetOauthUserinfo(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOauthUserinfo(c)
+}
+
+// PostOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizations(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOrganizations(c)
+}
+
+// PostOrganizationsInvitesAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationsInvitesAccept(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOrganizationsInvitesAccept(c)
+}
+
+// PostOrganizationsSwitch operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationsSwitch(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOrganizationsSwitch(c)
+}
+
+// PostOrganizationsOrganizationIdInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostOrganizationsOrganizationIdInvites(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", c.Param("organizationId"), &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter organizationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostOrganizationsOrganizationIdInvites(c, organizationId)
+}
+
+// GetPat operation middleware
+func (siw *ServerInterfaceWrapper) GetPat(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPat(c)
+}
+
+// PostPat operation middleware
+func (siw *ServerInterfaceWrapper) PostPat(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPat(c)
+}
+
+// DeletePatPatId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePatPatId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "patId" -------------
+	var patId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patId", c.Param("patId"), &patId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter patId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePatPatId(c, patId)
+}
+
+// GetReadyz operation middleware
+func (siw *ServerInterfaceWrapper) GetReadyz(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReadyz(c)
+}
+
+// HeadReadyz operation middleware
+func (siw *ServerInterfaceWrapper) HeadReadyz(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.HeadReadyz(c)
+}
+
+// GetScimV2Groups operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2Groups(c *gin.Context) {
+
+	var err error
+
+	c.Set(ScimTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScimV2GroupsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startIndex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", c.Request.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startIndex: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "excludedAttributes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludedAttributes", c.Request.URL.Query(), &params.ExcludedAttributes)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter excludedAttributes: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetScimV2Groups(c, params)
+}
+
+// PostScimV2Groups operation middleware
+func (siw *ServerInterfaceWrapper) PostScimV2Groups(c *gin.Context) {
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostScimV2Groups(c)
+}
+
+// DeleteScimV2GroupsGroupId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScimV2GroupsGroupId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteScimV2GroupsGroupId(c, groupId)
+}
+
+// GetScimV2GroupsGroupId operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2GroupsGroupId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetScimV2GroupsGroupId(c, groupId)
+}
+
+// PatchScimV2GroupsGroupId operation middleware
+func (siw *ServerInterfaceWrapper) PatchScimV2GroupsGroupId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchScimV2GroupsGroupId(c, groupId)
+}
+
+// PutScimV2GroupsGroupId operation middleware
+func (siw *ServerInterfaceWrapper) PutScimV2GroupsGroupId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutScimV2GroupsGroupId(c, groupId)
+}
+
+// GetScimV2Users operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2Users(c *gin.Context) {
+
+	var err error
+
+	c.Set(ScimTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScimV2UsersParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startIndex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", c.Request.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startIndex: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetScimV2Users(c, params)
+}
+
+// PostScimV2Users operation middleware
+func (siw *ServerInterfaceWrapper) PostScimV2Users(c *gin.Context) {
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostScimV2Users(c)
+}
+
+// DeleteScimV2UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScimV2UsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteScimV2UsersUserId(c, userId)
+}
+
+// GetScimV2UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetScimV2UsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetScimV2UsersUserId(c, userId)
+}
+
+// PatchScimV2UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchScimV2UsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchScimV2UsersUserId(c, userId)
+}
+
+// PutScimV2UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutScimV2UsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ScimTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutScimV2UsersUserId(c, userId)
+}
+
+// PostSigninAnonymous operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninAnonymous(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninAnonymous(c)
+}
+
+// PostSigninEmailPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninEmailPassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninEmailPassword(c)
+}
+
+// PostSigninIdtoken operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninIdtoken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninIdtoken(c)
+}
+
+// PostSigninMfaRecoveryCode operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninMfaRecoveryCode(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninMfaRecoveryCode(c)
+}
+
+// PostSigninMfaTotp operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninMfaTotp(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninMfaTotp(c)
+}
+
+// PostSigninPasswordlessEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninPasswordlessEmail(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninPasswordlessEmail(c)
+}
+
+// PostSigninPasswordlessSms operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninPasswordlessSms(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninPasswordlessSms(c)
+}
+
+// PostSigninPasswordlessSmsOtp operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninPasswordlessSmsOtp(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninPasswordlessSmsOtp(c)
+}
+
+// PostSigninPat operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninPat(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninPat(c)
+}
+
+// GetSigninProviderProvider operation middleware
+func (siw *ServerInterfaceWrapper) GetSigninProviderProvider(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSigninProviderProviderParams
+
+	// ------------- Optional query parameter "redirectTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "redirectTo", c.Request.URL.Query(), &params.RedirectTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirectTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "allowedRoles" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "allowedRoles", c.Request.URL.Query(), &params.AllowedRoles)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter allowedRoles: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "defaultRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "defaultRole", c.Request.URL.Query(), &params.DefaultRole)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter defaultRole: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "displayName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "displayName", c.Request.URL.Query(), &params.DisplayName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter displayName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", c.Request.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter locale: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata", c.Request.URL.Query(), &params.Metadata)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metadata: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSigninProviderProvider(c, provider, params)
+}
+
+// GetSigninProviderProviderCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetSigninProviderProviderCallback(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSigninProviderProviderCallbackParams
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := c.Query("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument state is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", c.Request.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter error: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "error_description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_description", c.Request.URL.Query(), &params.ErrorDescription)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter error_description: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Cookie" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Cookie")]; found {
+		var Cookie string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Cookie, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Cookie", valueList[0], &Cookie, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Cookie: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Cookie = &Cookie
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSigninProviderProviderCallback(c, provider, params)
+}
+
+// PostSigninProviderProviderCallback operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninProviderProviderCallback(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSigninProviderProviderCallbackParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Cookie" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Cookie")]; found {
+		var Cookie string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Cookie, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Cookie", valueList[0], &Cookie, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Cookie: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.Cookie = &Cookie
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninProviderProviderCallback(c, provider, params)
+}
+
+// GetSigninSaml operation middleware
+func (siw *ServerInterfaceWrapper) GetSigninSaml(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSigninSamlParams
+
+	// ------------- Optional query parameter "redirectTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "redirectTo", c.Request.URL.Query(), &params.RedirectTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirectTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "allowedRoles" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "allowedRoles", c.Request.URL.Query(), &params.AllowedRoles)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter allowedRoles: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "defaultRole" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "defaultRole", c.Request.URL.Query(), &params.DefaultRole)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter defaultRole: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "displayName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "displayName", c.Request.URL.Query(), &params.DisplayName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter displayName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", c.Request.URL.Query(), &params.Locale)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter locale: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metadata", c.Request.URL.Query(), &params.Metadata)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metadata: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSigninSaml(c, params)
+}
+
+// PostSigninSamlAcs operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninSamlAcs(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninSamlAcs(c)
+}
+
+// GetSigninSamlMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetSigninSamlMetadata(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSigninSamlMetadata(c)
+}
+
+// PostSigninWebauthn operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninWebauthn(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninWebauthn(c)
+}
+
+// PostSigninWebauthnVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostSigninWebauthnVerify(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSigninWebauthnVerify(c)
+}
+
+// PostSignupEmailPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostSignupEmailPassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSignupEmailPassword(c)
+}
+
+// PostSignupWebauthn operation middleware
+func (siw *ServerInterfaceWrapper) PostSignupWebauthn(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSignupWebauthn(c)
+}
+
+// PostSignupWebauthnVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostSignupWebauthnVerify(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSignupWebauthnVerify(c)
+}
+
+// PostToken operation middleware
+func (siw *ServerInterfaceWrapper) PostToken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostToken(c)
+}
+
+// DeleteUserAvatar operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserAvatar(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUserAvatar(c)
+}
+
+// PutUserAvatar operation middleware
+func (siw *ServerInterfaceWrapper) PutUserAvatar(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutUserAvatar(c)
+}
+
+// PostUserDataExport operation middleware
+func (siw *ServerInterfaceWrapper) PostUserDataExport(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserDataExport(c)
+}
+
+// GetUserDataExportExportId operation middleware
+func (siw *ServerInterfaceWrapper) GetUserDataExportExportId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "exportId" -------------
+	var exportId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", c.Param("exportId"), &exportId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exportId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserDataExportExportId(c, exportId)
+}
+
+// PostUserDeanonymize operation middleware
+func (siw *ServerInterfaceWrapper) PostUserDeanonymize(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserDeanonymize(c)
+}
+
+// PostUserDeanonymizeProviderProvider operation middleware
+func (siw *ServerInterfaceWrapper) PostUserDeanonymizeProviderProvider(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserDeanonymizeProviderProvider(c, provider)
+}
+
+// PostUserDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostUserDelete(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserDelete(c)
+}
+
+// PostUserEmailChange operation middleware
+func (siw *ServerInterfaceWrapper) PostUserEmailChange(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserEmailChange(c)
+}
+
+// PostUserEmailSendVerificationEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostUserEmailSendVerificationEmail(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserEmailSendVerificationEmail(c)
+}
+
+// PatchUserMetadata operation middleware
+func (siw *ServerInterfaceWrapper) PatchUserMetadata(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchUserMetadata(c)
+}
+
+// PostUserPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostUserPasswordReset(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserPasswordReset(c)
+}
+
+// PostUserPhoneNumberChange operation middleware
+func (siw *ServerInterfaceWrapper) PostUserPhoneNumberChange(c *gin.Context) {
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserPhoneNumberChange(c)
+}
+
+// PostUserPhoneNumberChangeVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostUserPhoneNumberChangeVerify(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserPhoneNumberChangeVerify(c)
+}
+
+// GetUserProviders operation middleware
+func (siw *ServerInterfaceWrapper) GetUserProviders(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserProviders(c)
+}
+
+// DeleteUserProvidersProvider operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserProvidersProvider(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUserProvidersProvider(c, provider)
+}
+
+// PostUserProvidersProviderLink operation middleware
+func (siw *ServerInterfaceWrapper) PostUserProvidersProviderLink(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthElevatedScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserProvidersProviderLink(c, provider)
+}
+
+// GetUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSessions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserSessions(c)
+}
+
+// PostUserSessionsRevokeAll operation middleware
+func (siw *ServerInterfaceWrapper) PostUserSessionsRevokeAll(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserSessionsRevokeAll(c)
+}
+
+// DeleteUserSessionsSessionId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserSessionsSessionId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUserSessionsSessionId(c, sessionId)
+}
+
+// PostUserWebauthnAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostUserWebauthnAdd(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserWebauthnAdd(c)
+}
+
+// PostUserWebauthnVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostUserWebauthnVerify(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUserWebauthnVerify(c)
+}
+
+// GetVerify operation middleware
+func (siw *ServerInterfaceWrapper) GetVerify(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVerifyParams
+
+	// ------------- Required query parameter "ticket" -------------
+
+	if paramValue := c.Query("ticket"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument ticket is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "ticket", c.Request.URL.Query(), &params.Ticket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ticket: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "type" -------------
+
+	if paramValue := c.Query("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument type is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "redirectTo" -------------
+
+	if paramValue := c.Query("redirectTo"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument redirectTo is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "redirectTo", c.Request.URL.Query(), &params.RedirectTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirectTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVerify(c, params)
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVersion(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.GET(options.BaseURL+"/.well-known/openid-configuration", wrapper.GetWellKnownOpenidConfiguration)
+	router.GET(options.BaseURL+"/admin/audit-logs", wrapper.GetAdminAuditLogs)
+	router.POST(options.BaseURL+"/admin/config/reload", wrapper.PostAdminConfigReload)
+	router.GET(options.BaseURL+"/admin/data-exports/:exportId", wrapper.GetAdminDataExportsExportId)
+	router.GET(options.BaseURL+"/admin/emails/failed", wrapper.GetAdminEmailsFailed)
+	router.POST(options.BaseURL+"/admin/emails/:emailId/retry", wrapper.PostAdminEmailsEmailIdRetry)
+	router.POST(options.BaseURL+"/admin/oauth2/clients", wrapper.PostAdminOauth2Clients)
+	router.DELETE(options.BaseURL+"/admin/oauth2/clients/:clientId", wrapper.DeleteAdminOauth2ClientsClientId)
+	router.GET(options.BaseURL+"/admin/roles", wrapper.GetAdminRoles)
+	router.POST(options.BaseURL+"/admin/roles", wrapper.PostAdminRoles)
+	router.DELETE(options.BaseURL+"/admin/roles/:role", wrapper.DeleteAdminRolesRole)
+	router.GET(options.BaseURL+"/admin/sign-in-methods", wrapper.GetAdminSignInMethods)
+	router.PUT(options.BaseURL+"/admin/sign-in-methods/:method", wrapper.PutAdminSignInMethodsMethod)
+	router.GET(options.BaseURL+"/admin/users", wrapper.GetAdminUsers)
+	router.POST(options.BaseURL+"/admin/users/import", wrapper.PostAdminUsersImport)
+	router.DELETE(options.BaseURL+"/admin/users/:userId/ban", wrapper.DeleteAdminUsersUserIdBan)
+	router.POST(options.BaseURL+"/admin/users/:userId/ban", wrapper.PostAdminUsersUserIdBan)
+	router.POST(options.BaseURL+"/admin/users/:userId/data-export", wrapper.PostAdminUsersUserIdDataExport)
+	router.POST(options.BaseURL+"/admin/users/:userId/disable", wrapper.PostAdminUsersUserIdDisable)
+	router.POST(options.BaseURL+"/admin/users/:userId/enable", wrapper.PostAdminUsersUserIdEnable)
+	router.POST(options.BaseURL+"/admin/users/:userId/impersonate", wrapper.PostAdminUsersUserIdImpersonate)
+	router.POST(options.BaseURL+"/admin/users/:userId/password", wrapper.PostAdminUsersUserIdPassword)
+	router.POST(options.BaseURL+"/admin/users/:userId/password/reset", wrapper.PostAdminUsersUserIdPasswordReset)
+	router.POST(options.BaseURL+"/admin/users/:userId/roles", wrapper.PostAdminUsersUserIdRoles)
+	router.DELETE(options.BaseURL+"/admin/users/:userId/roles/:role", wrapper.DeleteAdminUsersUserIdRolesRole)
+	router.POST(options.BaseURL+"/admin/users/:userId/sessions/revoke-all", wrapper.PostAdminUsersUserIdSessionsRevokeAll)
+	router.POST(options.BaseURL+"/admin/users/:userId/unlock", wrapper.PostAdminUsersUserIdUnlock)
+	router.GET(options.BaseURL+"/data-export/download", wrapper.GetDataExportDownload)
+	router.POST(options.BaseURL+"/device/code", wrapper.PostDeviceCode)
+	router.POST(options.BaseURL+"/device/token", wrapper.PostDeviceToken)
+	router.POST(options.BaseURL+"/device/verify", wrapper.PostDeviceVerify)
+	router.POST(options.BaseURL+"/elevate", wrapper.PostElevate)
+	router.POST(options.BaseURL+"/elevate/webauthn", wrapper.PostElevateWebauthn)
+	router.POST(options.BaseURL+"/elevate/webauthn/verify", wrapper.PostElevateWebauthnVerify)
+	router.GET(options.BaseURL+"/forward-auth/verify", wrapper.GetForwardAuthVerify)
+	router.GET(options.BaseURL+"/healthz", wrapper.GetHealthz)
+	router.HEAD(options.BaseURL+"/healthz", wrapper.HeadHealthz)
+	router.GET(options.BaseURL+"/mfa/recovery-codes", wrapper.GetMfaRecoveryCodes)
+	router.POST(options.BaseURL+"/mfa/recovery-codes", wrapper.PostMfaRecoveryCodes)
+	router.POST(options.BaseURL+"/mfa/totp/enable", wrapper.PostMfaTotpEnable)
+	router.GET(options.BaseURL+"/mfa/totp/generate", wrapper.GetMfaTotpGenerate)
+	router.GET(options.BaseURL+"/oauth/authorize", wrapper.GetOauthAuthorize)
+	router.POST(options.BaseURL+"/oauth/authorize", wrapper.PostOauthAuthorize)
+	router.POST(options.BaseURL+"/oauth/introspect", wrapper.PostOauthIntrospect)
+	router.POST(options.BaseURL+"/oauth/token", wrapper.PostOauthToken)
+	router.GET(options.BaseURL+"/oauth/userinfo", wrapper.GetOauthUserinfo)
+	router.POST(options.BaseURL+"/organizations", wrapper.PostOrganizations)
+	router.POST(options.BaseURL+"/organizations/invites/accept", wrapper.PostOrganizationsInvitesAccept)
+	router.POST(options.BaseURL+"/organizations/switch", wrapper.PostOrganizationsSwitch)
+	router.POST(options.BaseURL+"/organizations/:organizationId/invites", wrapper.PostOrganizationsOrganizationIdInvites)
+	router.GET(options.BaseURL+"/pat", wrapper.GetPat)
+	router.POST(options.BaseURL+"/pat", wrapper.PostPat)
+	router.DELETE(options.BaseURL+"/pat/:patId", wrapper.DeletePatPatId)
+	router.GET(options.BaseURL+"/readyz", wrapper.GetReadyz)
+	router.HEAD(options.BaseURL+"/readyz", wrapper.HeadReadyz)
+	router.GET(options.BaseURL+"/scim/v2/Groups", wrapper.GetScimV2Groups)
+	router.POST(options.BaseURL+"/scim/v2/Groups", wrapper.PostScimV2Groups)
+	router.DELETE(options.BaseURL+"/scim/v2/Groups/:groupId", wrapper.DeleteScimV2GroupsGroupId)
+	router.GET(options.BaseURL+"/scim/v2/Groups/:groupId", wrapper.GetScimV2GroupsGroupId)
+	router.PATCH(options.BaseURL+"/scim/v2/Groups/:groupId", wrapper.PatchScimV2GroupsGroupId)
+	router.PUT(options.BaseURL+"/scim/v2/Groups/:groupId", wrapper.PutScimV2GroupsGroupId)
+	router.GET(options.BaseURL+"/scim/v2/Users", wrapper.GetScimV2Users)
+	router.POST(options.BaseURL+"/scim/v2/Users", wrapper.PostScimV2Users)
+	router.DELETE(options.BaseURL+"/scim/v2/Users/:userId", wrapper.DeleteScimV2UsersUserId)
+	router.GET(options.BaseURL+"/scim/v2/Users/:userId", wrapper.GetScimV2UsersUserId)
+	router.PATCH(options.BaseURL+"/scim/v2/Users/:userId", wrapper.PatchScimV2UsersUserId)
+	router.PUT(options.BaseURL+"/scim/v2/Users/:userId", wrapper.PutScimV2UsersUserId)
+	router.POST(options.BaseURL+"/signin/anonymous", wrapper.PostSigninAnonymous)
+	router.POST(options.BaseURL+"/signin/email-password", wrapper.PostSigninEmailPassword)
+	router.POST(options.BaseURL+"/signin/idtoken", wrapper.PostSigninIdtoken)
+	router.POST(options.BaseURL+"/signin/mfa/recovery-code", wrapper.PostSigninMfaRecoveryCode)
+	router.POST(options.BaseURL+"/signin/mfa/totp", wrapper.PostSigninMfaTotp)
+	router.POST(options.BaseURL+"/signin/passwordless/email", wrapper.PostSigninPasswordlessEmail)
+	router.POST(options.BaseURL+"/signin/passwordless/sms", wrapper.PostSigninPasswordlessSms)
+	router.POST(options.BaseURL+"/signin/passwordless/sms/otp", wrapper.PostSigninPasswordlessSmsOtp)
+	router.POST(options.BaseURL+"/signin/pat", wrapper.PostSigninPat)
+	router.GET(options.BaseURL+"/signin/provider/:provider", wrapper.GetSigninProviderProvider)
+	router.GET(options.BaseURL+"/signin/provider/:provider/callback", wrapper.GetSigninProviderProviderCallback)
+	router.POST(options.BaseURL+"/signin/provider/:provider/callback", wrapper.PostSigninProviderProviderCallback)
+	router.GET(options.BaseURL+"/signin/saml", wrapper.GetSigninSaml)
+	router.POST(options.BaseURL+"/signin/saml/acs", wrapper.PostSigninSamlAcs)
+	router.GET(options.BaseURL+"/signin/saml/metadata", wrapper.GetSigninSamlMetadata)
+	router.POST(options.BaseURL+"/signin/webauthn", wrapper.PostSigninWebauthn)
+	router.POST(options.BaseURL+"/signin/webauthn/verify", wrapper.PostSigninWebauthnVerify)
+	router.POST(options.BaseURL+"/signup/email-password", wrapper.PostSignupEmailPassword)
+	router.POST(options.BaseURL+"/signup/webauthn", wrapper.PostSignupWebauthn)
+	router.POST(options.BaseURL+"/signup/webauthn/verify", wrapper.PostSignupWebauthnVerify)
+	router.POST(options.BaseURL+"/token", wrapper.PostToken)
+	router.DELETE(options.BaseURL+"/user/avatar", wrapper.DeleteUserAvatar)
+	router.PUT(options.BaseURL+"/user/avatar", wrapper.PutUserAvatar)
+	router.POST(options.BaseURL+"/user/data-export", wrapper.PostUserDataExport)
+	router.GET(options.BaseURL+"/user/data-export/:exportId", wrapper.GetUserDataExportExportId)
+	router.POST(options.BaseURL+"/user/deanonymize", wrapper.PostUserDeanonymize)
+	router.POST(options.BaseURL+"/user/deanonymize/provider/:provider", wrapper.PostUserDeanonymizeProviderProvider)
+	router.POST(options.BaseURL+"/user/delete", wrapper.PostUserDelete)
+	router.POST(options.BaseURL+"/user/email/change", wrapper.PostUserEmailChange)
+	router.POST(options.BaseURL+"/user/email/send-verification-email", wrapper.PostUserEmailSendVerificationEmail)
+	router.PATCH(options.BaseURL+"/user/metadata", wrapper.PatchUserMetadata)
+	router.POST(options.BaseURL+"/user/password/reset", wrapper.PostUserPasswordReset)
+	router.POST(options.BaseURL+"/user/phone-number/change", wrapper.PostUserPhoneNumberChange)
+	router.POST(options.BaseURL+"/user/phone-number/change/verify", wrapper.PostUserPhoneNumberChangeVerify)
+	router.GET(options.BaseURL+"/user/providers", wrapper.GetUserProviders)
+	router.DELETE(options.BaseURL+"/user/providers/:provider", wrapper.DeleteUserProvidersProvider)
+	router.POST(options.BaseURL+"/user/providers/:provider/link", wrapper.PostUserProvidersProviderLink)
+	router.GET(options.BaseURL+"/user/sessions", wrapper.GetUserSessions)
+	router.POST(options.BaseURL+"/user/sessions/revoke-all", wrapper.PostUserSessionsRevokeAll)
+	router.DELETE(options.BaseURL+"/user/sessions/:sessionId", wrapper.DeleteUserSessionsSessionId)
+	router.POST(options.BaseURL+"/user/webauthn/add", wrapper.PostUserWebauthnAdd)
+	router.POST(options.BaseURL+"/user/webauthn/verify", wrapper.PostUserWebauthnVerify)
+	router.GET(options.BaseURL+"/verify", wrapper.GetVerify)
+	router.GET(options.BaseURL+"/version", wrapper.GetVersion)
+}
+
+type GetWellKnownJwksJsonRequestObject struct {
+}
+
+type GetWellKnownJwksJsonResponseObject interface {
+	VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error
+}
+
+type GetWellKnownJwksJson200JSONResponse JWKSet
+
+func (response GetWellKnownJwksJson200JSONResponse) VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWellKnownOpenidConfigurationRequestObject struct {
+}
+
+type GetWellKnownOpenidConfigurationResponseObject interface {
+	VisitGetWellKnownOpenidConfigurationResponse(w http.ResponseWriter) error
+}
+
+type GetWellKnownOpenidConfiguration200JSONResponse OpenIDConfiguration
+
+func (response GetWellKnownOpenidConfiguration200JSONResponse) VisitGetWellKnownOpenidConfigurationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAuditLogsRequestObject struct {
+	Params GetAdminAuditLogsParams
+}
+
+type GetAdminAuditLogsResponseObject interface {
+	VisitGetAdminAuditLogsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAuditLogs200JSONResponse AuditLogsResponse
+
+func (response GetAdminAuditLogs200JSONResponse) VisitGetAdminAuditLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminConfigReloadRequestObject struct {
+}
+
+type PostAdminConfigReloadResponseObject interface {
+	VisitPostAdminConfigReloadResponse(w http.ResponseWriter) error
+}
+
+type PostAdminConfigReload200JSONResponse ConfigReloadResponse
+
+func (response PostAdminConfigReload200JSONResponse) VisitPostAdminConfigReloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminDataExportsExportIdRequestObject struct {
+	ExportId openapi_types.UUID `json:"exportId"`
+}
+
+type GetAdminDataExportsExportIdResponseObject interface {
+	VisitGetAdminDataExportsExportIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminDataExportsExportId200JSONResponse DataExport
+
+func (response GetAdminDataExportsExportId200JSONResponse) VisitGetAdminDataExportsExportIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminEmailsFailedRequestObject struct {
+	Params GetAdminEmailsFailedParams
+}
+
+type GetAdminEmailsFailedResponseObject interface {
+	VisitGetAdminEmailsFailedResponse(w http.ResponseWriter) error
+}
+
+type GetAdminEmailsFailed200JSONResponse OutboxFailedEmailsResponse
+
+func (response GetAdminEmailsFailed200JSONResponse) VisitGetAdminEmailsFailedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminEmailsEmailIdRetryRequestObject struct {
+	EmailId openapi_types.UUID `json:"emailId"`
+}
+
+type PostAdminEmailsEmailIdRetryResponseObject interface {
+	VisitPostAdminEmailsEmailIdRetryResponse(w http.ResponseWriter) error
+}
+
+type PostAdminEmailsEmailIdRetry200JSONResponse OKResponse
+
+func (response PostAdminEmailsEmailIdRetry200JSONResponse) VisitPostAdminEmailsEmailIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminOauth2ClientsRequestObject struct {
+	Body *PostAdminOauth2ClientsJSONRequestBody
+}
+
+type PostAdminOauth2ClientsResponseObject interface {
+	VisitPostAdminOauth2ClientsResponse(w http.ResponseWriter) error
+}
+
+type PostAdminOauth2Clients200JSONResponse CreateOAuth2ClientResponse
+
+func (response PostAdminOauth2Clients200JSONResponse) VisitPostAdminOauth2ClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminOauth2ClientsClientIdRequestObject struct {
+	ClientId string `json:"clientId"`
+}
+
+type DeleteAdminOauth2ClientsClientIdResponseObject interface {
+	VisitDeleteAdminOauth2ClientsClientIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminOauth2ClientsClientId200JSONResponse OKResponse
+
+func (response DeleteAdminOauth2ClientsClientId200JSONResponse) VisitDeleteAdminOauth2ClientsClientIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminRolesRequestObject struct {
+}
+
+type GetAdminRolesResponseObject interface {
+	VisitGetAdminRolesResponse(w http.ResponseWriter) error
+}
+
+type GetAdminRoles200JSONResponse AdminRolesResponse
+
+func (response GetAdminRoles200JSONResponse) VisitGetAdminRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminRolesRequestObject struct {
+	Body *PostAdminRolesJSONRequestBody
+}
+
+type PostAdminRolesResponseObject interface {
+	VisitPostAdminRolesResponse(w http.ResponseWriter) error
+}
+
+type PostAdminRoles200JSONResponse OKResponse
+
+func (response PostAdminRoles200JSONResponse) VisitPostAdminRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminRolesRoleRequestObject struct {
+	Role string `json:"role"`
+}
+
+type DeleteAdminRolesRoleResponseObject interface {
+	VisitDeleteAdminRolesRoleResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminRolesRole200JSONResponse OKResponse
+
+func (response DeleteAdminRolesRole200JSONResponse) VisitDeleteAdminRolesRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminSignInMethodsRequestObject struct {
+}
+
+type GetAdminSignInMethodsResponseObject interface {
+	VisitGetAdminSignInMethodsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminSignInMethods200JSONResponse AdminSignInMethodsResponse
+
+func (response GetAdminSignInMethods200JSONResponse) VisitGetAdminSignInMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAdminSignInMethodsMethodRequestObject struct {
+	Method string `json:"method"`
+	Body   *PutAdminSignInMethodsMethodJSONRequestBody
+}
+
+type PutAdminSignInMethodsMethodResponseObject interface {
+	VisitPutAdminSignInMethodsMethodResponse(w http.ResponseWriter) error
+}
+
+type PutAdminSignInMethodsMethod200JSONResponse AdminSignInMethod
+
+func (response PutAdminSignInMethodsMethod200JSONResponse) VisitPutAdminSignInMethodsMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsersRequestObject struct {
+	Params GetAdminUsersParams
+}
+
+type GetAdminUsersResponseObject interface {
+	VisitGetAdminUsersResponse(w http.ResponseWriter) error
+}
+
+type GetAdminUsers200JSONResponse AdminUsersResponse
+
+func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersImportRequestObject struct {
+	Params PostAdminUsersImportParams
+	Body   io.Reader
+}
+
+type PostAdminUsersImportResponseObject interface {
+	VisitPostAdminUsersImportResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersImport200JSONResponse ImportUsersResponse
+
+func (response PostAdminUsersImport200JSONResponse) VisitPostAdminUsersImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminUsersUserIdBanRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type DeleteAdminUsersUserIdBanResponseObject interface {
+	VisitDeleteAdminUsersUserIdBanResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminUsersUserIdBan200JSONResponse OKResponse
+
+func (response DeleteAdminUsersUserIdBan200JSONResponse) VisitDeleteAdminUsersUserIdBanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdBanRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PostAdminUsersUserIdBanJSONRequestBody
+}
+
+type PostAdminUsersUserIdBanResponseObject interface {
+	VisitPostAdminUsersUserIdBanResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdBan200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdBan200JSONResponse) VisitPostAdminUsersUserIdBanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdDataExportRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type PostAdminUsersUserIdDataExportResponseObject interface {
+	VisitPostAdminUsersUserIdDataExportResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdDataExport200JSONResponse DataExport
+
+func (response PostAdminUsersUserIdDataExport200JSONResponse) VisitPostAdminUsersUserIdDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdDisableRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type PostAdminUsersUserIdDisableResponseObject interface {
+	VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdDisable200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdDisable200JSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdEnableRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type PostAdminUsersUserIdEnableResponseObject interface {
+	VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdEnable200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdEnable200JSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdImpersonateRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PostAdminUsersUserIdImpersonateJSONRequestBody
+}
+
+type PostAdminUsersUserIdImpersonateResponseObject interface {
+	VisitPostAdminUsersUserIdImpersonateResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdImpersonate200JSONResponse ImpersonationResponse
+
+func (response PostAdminUsersUserIdImpersonate200JSONResponse) VisitPostAdminUsersUserIdImpersonateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdPasswordRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PostAdminUsersUserIdPasswordJSONRequestBody
+}
+
+type PostAdminUsersUserIdPasswordResponseObject interface {
+	VisitPostAdminUsersUserIdPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdPassword200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdPassword200JSONResponse) VisitPostAdminUsersUserIdPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdPasswordResetRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PostAdminUsersUserIdPasswordResetJSONRequestBody
+}
+
+type PostAdminUsersUserIdPasswordResetResponseObject interface {
+	VisitPostAdminUsersUserIdPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdPasswordReset200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdPasswordReset200JSONResponse) VisitPostAdminUsersUserIdPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdRolesRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PostAdminUsersUserIdRolesJSONRequestBody
+}
+
+type PostAdminUsersUserIdRolesResponseObject interface {
+	VisitPostAdminUsersUserIdRolesResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdRoles200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdRoles200JSONResponse) VisitPostAdminUsersUserIdRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminUsersUserIdRolesRoleRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Role   string             `json:"role"`
+}
+
+type DeleteAdminUsersUserIdRolesRoleResponseObject interface {
+	VisitDeleteAdminUsersUserIdRolesRoleResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminUsersUserIdRolesRole200JSONResponse OKResponse
+
+func (response DeleteAdminUsersUserIdRolesRole200JSONResponse) VisitDeleteAdminUsersUserIdRolesRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdSessionsRevokeAllRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type PostAdminUsersUserIdSessionsRevokeAllResponseObject interface {
+	VisitPostAdminUsersUserIdSessionsRevokeAllResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdSessionsRevokeAll200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdSessionsRevokeAll200JSONResponse) VisitPostAdminUsersUserIdSessionsRevokeAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersUserIdUnlockRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type PostAdminUsersUserIdUnlockResponseObject interface {
+	VisitPostAdminUsersUserIdUnlockResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersUserIdUnlock200JSONResponse OKResponse
+
+func (response PostAdminUsersUserIdUnlock200JSONResponse) VisitPostAdminUsersUserIdUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDataExportDownloadRequestObject struct {
+	Params GetDataExportDownloadParams
+}
+
+type GetDataExportDownloadResponseObject interface {
+	VisitGetDataExportDownloadResponse(w http.ResponseWriter) error
+}
+
+type GetDataExportDownload200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetDataExportDownload200JSONResponse struct {
+	Body    UserDataExport
+	Headers GetDataExportDownload200ResponseHeaders
+}
+
+func (response GetDataExportDownload200JSONResponse) VisitGetDataExportDownloadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostDeviceCodeRequestObject struct {
+}
+
+type PostDeviceCodeResponseObject interface {
+	VisitPostDeviceCodeResponse(w http.ResponseWriter) error
+}
+
+type PostDeviceCode200JSONResponse DeviceCodeResponse
+
+func (response PostDeviceCode200JSONResponse) VisitPostDeviceCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeviceTokenRequestObject struct {
+	Body *PostDeviceTokenJSONRequestBody
+}
+
+type PostDeviceTokenResponseObject interface {
+	VisitPostDeviceTokenResponse(w http.ResponseWriter) error
+}
+
+type PostDeviceToken200JSONResponse SessionPayload
+
+func (response PostDeviceToken200JSONResponse) VisitPostDeviceTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeviceVerifyRequestObject struct {
+	Body *PostDeviceVerifyJSONRequestBody
+}
+
+type PostDeviceVerifyResponseObject interface {
+	VisitPostDeviceVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostDeviceVerify200JSONResponse OKResponse
+
+func (response PostDeviceVerify200JSONResponse) VisitPostDeviceVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostElevateRequestObject struct {
+	Body *PostElevateJSONRequestBody
+}
+
+type PostElevateResponseObject interface {
+	VisitPostElevateResponse(w http.ResponseWriter) error
+}
+
+type PostElevate200JSONResponse SignInEmailPasswordResponse
+
+func (response PostElevate200JSONResponse) VisitPostElevateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostElevateWebauthnRequestObject struct {
+}
+
+type PostElevateWebauthnResponseObject interface {
+	VisitPostElevateWebauthnResponse(w http.ResponseWriter) error
+}
+
+type PostElevateWebauthn200JSONResponse SignInWebauthnResponse
+
+func (response PostElevateWebauthn200JSONResponse) VisitPostElevateWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostElevateWebauthnVerifyRequestObject struct {
+	Body *PostElevateWebauthnVerifyJSONRequestBody
+}
+
+type PostElevateWebauthnVerifyResponseObject interface {
+	VisitPostElevateWebauthnVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostElevateWebauthnVerify200JSONResponse SignInEmailPasswordResponse
+
+func (response PostElevateWebauthnVerify200JSONResponse) VisitPostElevateWebauthnVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetForwardAuthVerifyRequestObject struct {
+	Params GetForwardAuthVerifyParams
+}
+
+type GetForwardAuthVerifyResponseObject interface {
+	VisitGetForwardAuthVerifyResponse(w http.ResponseWriter) error
+}
+
+type GetForwardAuthVerify200ResponseHeaders struct {
+	XHasuraAllowedRoles    string
+	XHasuraRole            string
+	XHasuraUserId          string
+	XHasuraUserIsAnonymous string
+}
+
+type GetForwardAuthVerify200Response struct {
+	Headers GetForwardAuthVerify200ResponseHeaders
+}
+
+func (response GetForwardAuthVerify200Response) VisitGetForwardAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Hasura-Allowed-Roles", fmt.Sprint(response.Headers.XHasuraAllowedRoles))
+	w.Header().Set("X-Hasura-Role", fmt.Sprint(response.Headers.XHasuraRole))
+	w.Header().Set("X-Hasura-User-Id", fmt.Sprint(response.Headers.XHasuraUserId))
+	w.Header().Set("X-Hasura-User-Is-Anonymous", fmt.Sprint(response.Headers.XHasuraUserIsAnonymous))
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetHealthzRequestObject struct {
+}
+
+type GetHealthzResponseObject interface {
+	VisitGetHealthzResponse(w http.ResponseWriter) error
+}
+
+type GetHealthz200JSONResponse OKResponse
+
+func (response GetHealthz200JSONResponse) VisitGetHealthzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadHealthzRequestObject struct {
+}
+
+type HeadHealthzResponseObject interface {
+	VisitHeadHealthzResponse(w http.ResponseWriter) error
+}
+
+type HeadHealthz200Response struct {
+}
+
+func (response HeadHealthz200Response) VisitHeadHealthzResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetMfaRecoveryCodesRequestObject struct {
+}
+
+type GetMfaRecoveryCodesResponseObject interface {
+	VisitGetMfaRecoveryCodesResponse(w http.ResponseWriter) error
+}
+
+type GetMfaRecoveryCodes200JSONResponse MfaRecoveryCodesCountResponse
+
+func (response GetMfaRecoveryCodes200JSONResponse) VisitGetMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaRecoveryCodesRequestObject struct {
+}
+
+type PostMfaRecoveryCodesResponseObject interface {
+	VisitPostMfaRecoveryCodesResponse(w http.ResponseWriter) error
+}
+
+type PostMfaRecoveryCodes200JSONResponse MfaRecoveryCodesResponse
+
+func (response PostMfaRecoveryCodes200JSONResponse) VisitPostMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaTotpEnableRequestObject struct {
+	Body *PostMfaTotpEnableJSONRequestBody
+}
+
+type PostMfaTotpEnableResponseObject interface {
+	VisitPostMfaTotpEnableResponse(w http.ResponseWriter) error
+}
+
+type PostMfaTotpEnable200JSONResponse MfaRecoveryCodesResponse
+
+func (response PostMfaTotpEnable200JSONResponse) VisitPostMfaTotpEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMfaTotpGenerateRequestObject struct {
+}
+
+type GetMfaTotpGenerateResponseObject interface {
+	VisitGetMfaTotpGenerateResponse(w http.ResponseWriter) error
+}
+
+type GetMfaTotpGenerate200JSONResponse TotpGenerateResponse
+
+func (response GetMfaTotpGenerate200JSONResponse) VisitGetMfaTotpGenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOauthAuthorizeRequestObject struct {
+	Params GetOauthAuthorizeParams
+}
+
+type GetOauthAuthorizeResponseObject interface {
+	VisitGetOauthAuthorizeResponse(w http.ResponseWriter) error
+}
+
+type GetOauthAuthorize302ResponseHeaders struct {
+	Location string
+}
+
+type GetOauthAuthorize302Response struct {
+	Headers GetOauthAuthorize302ResponseHeaders
+}
+
+func (response GetOauthAuthorize302Response) VisitGetOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type PostOauthAuthorizeRequestObject struct {
+	Body *PostOauthAuthorizeJSONRequestBody
+}
+
+type PostOauthAuthorizeResponseObject interface {
+	VisitPostOauthAuthorizeResponse(w http.ResponseWriter) error
+}
+
+type PostOauthAuthorize200JSONResponse OAuth2AuthorizeResponse
+
+func (response PostOauthAuthorize200JSONResponse) VisitPostOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOauthIntrospectRequestObject struct {
+	Body *PostOauthIntrospectFormdataRequestBody
+}
+
+type PostOauthIntrospectResponseObject interface {
+	VisitPostOauthIntrospectResponse(w http.ResponseWriter) error
+}
+
+type PostOauthIntrospect200JSONResponse IntrospectResponse
+
+func (response PostOauthIntrospect200JSONResponse) VisitPostOauthIntrospectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOauthTokenRequestObject struct {
+	Params PostOauthTokenParams
+	Body   *PostOauthTokenFormdataRequestBody
+}
+
+type PostOauthTokenResponseObject interface {
+	VisitPostOauthTokenResponse(w http.ResponseWriter) error
+}
+
+type PostOauthToken200JSONResponse OAuth2TokenResponse
+
+func (response PostOauthToken200JSONResponse) VisitPostOauthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOauthUserinfoRequestObject struct {
+}
+
+type GetOauthUserinfoResponseObject interface {
+	VisitGetOauthUserinfoResponse(w http.ResponseWriter) error
+}
+
+type GetOauthUserinfo200JSONResponse OIDCUserInfo
+
+func (response GetOauthUserinfo200JSONResponse) VisitGetOauthUserinfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrganizationsRequestObject struct {
+	Body *PostOrganizationsJSONRequestBody
+}
+
+type PostOrganizationsResponseObject interface {
+	VisitPostOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type PostOrganizations200JSONResponse Organization
+
+func (response PostOrganizations200JSONResponse) VisitPostOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrganizationsInvitesAcceptRequestObject struct {
+	Body *PostOrganizationsInvitesAcceptJSONRequestBody
+}
+
+type PostOrganizationsInvitesAcceptResponseObject interface {
+	VisitPostOrganizationsInvitesAcceptResponse(w http.ResponseWriter) error
+}
+
+type PostOrganizationsInvitesAccept200JSONResponse Organization
+
+func (response PostOrganizationsInvitesAccept200JSONResponse) VisitPostOrganizationsInvitesAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrganizationsSwitchRequestObject struct {
+	Body *PostOrganizationsSwitchJSONRequestBody
+}
+
+type PostOrganizationsSwitchResponseObject interface {
+	VisitPostOrganizationsSwitchResponse(w http.ResponseWriter) error
+}
+
+type PostOrganizationsSwitch200JSONResponse SessionPayload
+
+func (response PostOrganizationsSwitch200JSONResponse) VisitPostOrganizationsSwitchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrganizationsOrganizationIdInvitesRequestObject struct {
+	OrganizationId openapi_types.UUID `json:"organizationId"`
+	Body           *PostOrganizationsOrganizationIdInvitesJSONRequestBody
+}
+
+type PostOrganizationsOrganizationIdInvitesResponseObject interface {
+	VisitPostOrganizationsOrganizationIdInvitesResponse(w http.ResponseWriter) error
+}
+
+type PostOrganizationsOrganizationIdInvites200JSONResponse OKResponse
+
+func (response PostOrganizationsOrganizationIdInvites200JSONResponse) VisitPostOrganizationsOrganizationIdInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPatRequestObject struct {
+}
+
+type GetPatResponseObject interface {
+	VisitGetPatResponse(w http.ResponseWriter) error
+}
+
+type GetPat200JSONResponse PATsResponse
+
+func (response GetPat200JSONResponse) VisitGetPatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPatRequestObject struct {
+	Body *PostPatJSONRequestBody
+}
+
+type PostPatResponseObject interface {
+	VisitPostPatResponse(w http.ResponseWriter) error
+}
+
+type PostPat200JSONResponse CreatePATResponse
+
+func (response PostPat200JSONResponse) VisitPostPatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePatPatIdRequestObject struct {
+	PatId openapi_types.UUID `json:"patId"`
+}
+
+type DeletePatPatIdResponseObject interface {
+	VisitDeletePatPatIdResponse(w http.ResponseWriter) error
+}
+
+type DeletePatPatId200JSONResponse OKResponse
+
+func (response DeletePatPatId200JSONResponse) VisitDeletePatPatIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadyzRequestObject struct {
+}
+
+type GetReadyzResponseObject interface {
+	VisitGetReadyzResponse(w http.ResponseWriter) error
+}
+
+type GetReadyz200JSONResponse ReadinessResponse
+
+func (response GetReadyz200JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReadyz503JSONResponse ReadinessResponse
+
+func (response GetReadyz503JSONResponse) VisitGetReadyzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadReadyzRequestObject struct {
+}
+
+type HeadReadyzResponseObject interface {
+	VisitHeadReadyzResponse(w http.ResponseWriter) error
+}
+
+type HeadReadyz200Response struct {
+}
+
+func (response HeadReadyz200Response) VisitHeadReadyzResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HeadReadyz503Response struct {
+}
+
+func (response HeadReadyz503Response) VisitHeadReadyzResponse(w http.ResponseWriter) error {
+	w.WriteHeader(503)
+	return nil
+}
+
+type GetScimV2GroupsRequestObject struct {
+	Params GetScimV2GroupsParams
+}
+
+type GetScimV2GroupsResponseObject interface {
+	VisitGetScimV2GroupsResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2Groups200ApplicationScimPlusJSONResponse ScimGroupListResponse
+
+func (response GetScimV2Groups200ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2GroupsdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimV2GroupsdefaultApplicationScimPlusJSONResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostScimV2GroupsRequestObject struct {
+	Body *PostScimV2GroupsApplicationScimPlusJSONRequestBody
+}
+
+type PostScimV2GroupsResponseObject interface {
+	VisitPostScimV2GroupsResponse(w http.ResponseWriter) error
+}
+
+type PostScimV2Groups201ApplicationScimPlusJSONResponse ScimGroup
+
+func (response PostScimV2Groups201ApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2GroupsdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PostScimV2GroupsdefaultApplicationScimPlusJSONResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteScimV2GroupsGroupIdRequestObject struct {
+	GroupId string `json:"groupId"`
+}
+
+type DeleteScimV2GroupsGroupIdResponseObject interface {
+	VisitDeleteScimV2GroupsGroupIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteScimV2GroupsGroupId204Response struct {
+}
+
+func (response DeleteScimV2GroupsGroupId204Response) VisitDeleteScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response DeleteScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse) VisitDeleteScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetScimV2GroupsGroupIdRequestObject struct {
+	GroupId string `json:"groupId"`
+}
+
+type GetScimV2GroupsGroupIdResponseObject interface {
+	VisitGetScimV2GroupsGroupIdResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2GroupsGroupId200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response GetScimV2GroupsGroupId200ApplicationScimPlusJSONResponse) VisitGetScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse) VisitGetScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchScimV2GroupsGroupIdRequestObject struct {
+	GroupId string `json:"groupId"`
+	Body    *PatchScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody
+}
+
+type PatchScimV2GroupsGroupIdResponseObject interface {
+	VisitPatchScimV2GroupsGroupIdResponse(w http.ResponseWriter) error
+}
+
+type PatchScimV2GroupsGroupId204Response struct {
+}
+
+func (response PatchScimV2GroupsGroupId204Response) VisitPatchScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PatchScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PatchScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse) VisitPatchScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutScimV2GroupsGroupIdRequestObject struct {
+	GroupId string `json:"groupId"`
+	Body    *PutScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody
+}
+
+type PutScimV2GroupsGroupIdResponseObject interface {
+	VisitPutScimV2GroupsGroupIdResponse(w http.ResponseWriter) error
+}
+
+type PutScimV2GroupsGroupId200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response PutScimV2GroupsGroupId200ApplicationScimPlusJSONResponse) VisitPutScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PutScimV2GroupsGroupIddefaultApplicationScimPlusJSONResponse) VisitPutScimV2GroupsGroupIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetScimV2UsersRequestObject struct {
+	Params GetScimV2UsersParams
+}
+
+type GetScimV2UsersResponseObject interface {
+	VisitGetScimV2UsersResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2Users200ApplicationScimPlusJSONResponse ScimUserListResponse
+
+func (response GetScimV2Users200ApplicationScimPlusJSONResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2UsersdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimV2UsersdefaultApplicationScimPlusJSONResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostScimV2UsersRequestObject struct {
+	Body *PostScimV2UsersApplicationScimPlusJSONRequestBody
+}
+
+type PostScimV2UsersResponseObject interface {
+	VisitPostScimV2UsersResponse(w http.ResponseWriter) error
+}
+
+type PostScimV2Users201ApplicationScimPlusJSONResponse ScimUser
+
+func (response PostScimV2Users201ApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostScimV2UsersdefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PostScimV2UsersdefaultApplicationScimPlusJSONResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteScimV2UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type DeleteScimV2UsersUserIdResponseObject interface {
+	VisitDeleteScimV2UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteScimV2UsersUserId204Response struct {
+}
+
+func (response DeleteScimV2UsersUserId204Response) VisitDeleteScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteScimV2UsersUserIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response DeleteScimV2UsersUserIddefaultApplicationScimPlusJSONResponse) VisitDeleteScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetScimV2UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type GetScimV2UsersUserIdResponseObject interface {
+	VisitGetScimV2UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type GetScimV2UsersUserId200ApplicationScimPlusJSONResponse ScimUser
+
+func (response GetScimV2UsersUserId200ApplicationScimPlusJSONResponse) VisitGetScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScimV2UsersUserIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response GetScimV2UsersUserIddefaultApplicationScimPlusJSONResponse) VisitGetScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchScimV2UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody
+}
+
+type PatchScimV2UsersUserIdResponseObject interface {
+	VisitPatchScimV2UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse ScimUser
+
+func (response PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse) VisitPatchScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchScimV2UsersUserIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PatchScimV2UsersUserIddefaultApplicationScimPlusJSONResponse) VisitPatchScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutScimV2UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody
+}
+
+type PutScimV2UsersUserIdResponseObject interface {
+	VisitPutScimV2UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PutScimV2UsersUserId200ApplicationScimPlusJSONResponse ScimUser
+
+func (response PutScimV2UsersUserId200ApplicationScimPlusJSONResponse) VisitPutScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutScimV2UsersUserIddefaultApplicationScimPlusJSONResponse struct {
+	Body       ScimError
+	StatusCode int
+}
+
+func (response PutScimV2UsersUserIddefaultApplicationScimPlusJSONResponse) VisitPutScimV2UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSigninAnonymousRequestObject struct {
+	Body *PostSigninAnonymousJSONRequestBody
+}
+
+type PostSigninAnonymousResponseObject interface {
+	VisitPostSigninAnonymousResponse(w http.ResponseWriter) error
+}
+
+type PostSigninAnonymous200JSONResponse SessionPayload
+
+func (response PostSigninAnonymous200JSONResponse) VisitPostSigninAnonymousResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninEmailPasswordRequestObject struct {
+	Body *PostSigninEmailPasswordJSONRequestBody
+}
+
+type PostSigninEmailPasswordResponseObject interface {
+	VisitPostSigninEmailPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostSigninEmailPassword200JSONResponse SignInEmailPasswordResponse
+
+func (response PostSigninEmailPassword200JSONResponse) VisitPostSigninEmailPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninIdtokenRequestObject struct {
+	Body *PostSigninIdtokenJSONRequestBody
+}
+
+type PostSigninIdtokenResponseObject interface {
+	VisitPostSigninIdtokenResponse(w http.ResponseWriter) error
+}
+
+type PostSigninIdtoken200JSONResponse SessionPayload
+
+func (response PostSigninIdtoken200JSONResponse) VisitPostSigninIdtokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninMfaRecoveryCodeRequestObject struct {
+	Body *PostSigninMfaRecoveryCodeJSONRequestBody
+}
+
+type PostSigninMfaRecoveryCodeResponseObject interface {
+	VisitPostSigninMfaRecoveryCodeResponse(w http.ResponseWriter) error
+}
+
+type PostSigninMfaRecoveryCode200JSONResponse SignInEmailPasswordResponse
+
+func (response PostSigninMfaRecoveryCode200JSONResponse) VisitPostSigninMfaRecoveryCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninMfaTotpRequestObject struct {
+	Body *PostSigninMfaTotpJSONRequestBody
+}
+
+type PostSigninMfaTotpResponseObject interface {
+	VisitPostSigninMfaTotpResponse(w http.ResponseWriter) error
+}
+
+type PostSigninMfaTotp200JSONResponse SignInEmailPasswordResponse
+
+func (response PostSigninMfaTotp200JSONResponse) VisitPostSigninMfaTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninPasswordlessEmailRequestObject struct {
+	Body *PostSigninPasswordlessEmailJSONRequestBody
+}
+
+type PostSigninPasswordlessEmailResponseObject interface {
+	VisitPostSigninPasswordlessEmailResponse(w http.ResponseWriter) error
+}
+
+type PostSigninPasswordlessEmail200JSONResponse OKResponse
+
+func (response PostSigninPasswordlessEmail200JSONResponse) VisitPostSigninPasswordlessEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninPasswordlessSmsRequestObject struct {
+	Body *PostSigninPasswordlessSmsJSONRequestBody
+}
+
+type PostSigninPasswordlessSmsResponseObject interface {
+	VisitPostSigninPasswordlessSmsResponse(w http.ResponseWriter) error
+}
+
+type PostSigninPasswordlessSms200JSONResponse OKResponse
+
+func (response PostSigninPasswordlessSms200JSONResponse) VisitPostSigninPasswordlessSmsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninPasswordlessSmsOtpRequestObject struct {
+	Body *PostSigninPasswordlessSmsOtpJSONRequestBody
+}
+
+type PostSigninPasswordlessSmsOtpResponseObject interface {
+	VisitPostSigninPasswordlessSmsOtpResponse(w http.ResponseWriter) error
+}
+
+type PostSigninPasswordlessSmsOtp200JSONResponse SignInEmailPasswordResponse
+
+func (response PostSigninPasswordlessSmsOtp200JSONResponse) VisitPostSigninPasswordlessSmsOtpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninPatRequestObject struct {
+	Body *PostSigninPatJSONRequestBody
+}
+
+type PostSigninPatResponseObject interface {
+	VisitPostSigninPatResponse(w http.ResponseWriter) error
+}
+
+type PostSigninPat200JSONResponse SessionPayload
+
+func (response PostSigninPat200JSONResponse) VisitPostSigninPatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSigninProviderProviderRequestObject struct {
+	Provider string `json:"provider"`
+	Params   GetSigninProviderProviderParams
+}
+
+type GetSigninProviderProviderResponseObject interface {
+	VisitGetSigninProviderProviderResponse(w http.ResponseWriter) error
+}
+
+type GetSigninProviderProvider302ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type GetSigninProviderProvider302Response struct {
+	Headers GetSigninProviderProvider302ResponseHeaders
+}
+
+func (response GetSigninProviderProvider302Response) VisitGetSigninProviderProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type GetSigninProviderProviderCallbackRequestObject struct {
+	Provider string `json:"provider"`
+	Params   GetSigninProviderProviderCallbackParams
+}
+
+type GetSigninProviderProviderCallbackResponseObject interface {
+	VisitGetSigninProviderProviderCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetSigninProviderProviderCallback302ResponseHeaders struct {
+	Location string
+}
+
+type GetSigninProviderProviderCallback302Response struct {
+	Headers GetSigninProviderProviderCallback302ResponseHeaders
+}
+
+func (response GetSigninProviderProviderCallback302Response) VisitGetSigninProviderProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type PostSigninProviderProviderCallbackRequestObject struct {
+	Provider string `json:"provider"`
+	Params   PostSigninProviderProviderCallbackParams
+	Body     *PostSigninProviderProviderCallbackFormdataRequestBody
+}
+
+type PostSigninProviderProviderCallbackResponseObject interface {
+	VisitPostSigninProviderProviderCallbackResponse(w http.ResponseWriter) error
+}
+
+type PostSigninProviderProviderCallback302ResponseHeaders struct {
+	Location string
+}
+
+type PostSigninProviderProviderCallback302Response struct {
+	Headers PostSigninProviderProviderCallback302ResponseHeaders
+}
+
+func (response PostSigninProviderProviderCallback302Response) VisitPostSigninProviderProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type GetSigninSamlRequestObject struct {
+	Params GetSigninSamlParams
+}
+
+type GetSigninSamlResponseObject interface {
+	VisitGetSigninSamlResponse(w http.ResponseWriter) error
+}
+
+type GetSigninSaml302ResponseHeaders struct {
+	Location string
+}
+
+type GetSigninSaml302Response struct {
+	Headers GetSigninSaml302ResponseHeaders
+}
+
+func (response GetSigninSaml302Response) VisitGetSigninSamlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type PostSigninSamlAcsRequestObject struct {
+	Body *PostSigninSamlAcsFormdataRequestBody
+}
+
+type PostSigninSamlAcsResponseObject interface {
+	VisitPostSigninSamlAcsResponse(w http.ResponseWriter) error
+}
+
+type PostSigninSamlAcs302ResponseHeaders struct {
+	Location string
+}
+
+type PostSigninSamlAcs302Response struct {
+	Headers PostSigninSamlAcs302ResponseHeaders
+}
+
+func (response PostSigninSamlAcs302Response) VisitPostSigninSamlAcsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type GetSigninSamlMetadataRequestObject struct {
+}
+
+type GetSigninSamlMetadataResponseObject interface {
+	VisitGetSigninSamlMetadataResponse(w http.ResponseWriter) error
+}
+
+type GetSigninSamlMetadata200ApplicationsamlmetadataXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetSigninSamlMetadata200ApplicationsamlmetadataXmlResponse) VisitGetSigninSamlMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostSigninWebauthnRequestObject struct {
+	Body *PostSigninWebauthnJSONRequestBody
+}
+
+type PostSigninWebauthnResponseObject interface {
+	VisitPostSigninWebauthnResponse(w http.ResponseWriter) error
+}
+
+type PostSigninWebauthn200JSONResponse SignInWebauthnResponse
+
+func (response PostSigninWebauthn200JSONResponse) VisitPostSigninWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSigninWebauthnVerifyRequestObject struct {
+	Body *PostSigninWebauthnVerifyJSONRequestBody
+}
+
+type PostSigninWebauthnVerifyResponseObject interface {
+	VisitPostSigninWebauthnVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostSigninWebauthnVerify200JSONResponse SignInEmailPasswordResponse
+
+func (response PostSigninWebauthnVerify200JSONResponse) VisitPostSigninWebauthnVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSignupEmailPasswordRequestObject struct {
+	Body *PostSignupEmailPasswordJSONRequestBody
+}
+
+type PostSignupEmailPasswordResponseObject interface {
+	VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostSignupEmailPassword200JSONResponse SessionPayload
+
+func (response PostSignupEmailPassword200JSONResponse) VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSignupEmailPassword403JSONResponse ErrorResponse
+
+func (response PostSignupEmailPassword403JSONResponse) VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSignupEmailPassword409JSONResponse ErrorResponse
+
+func (response PostSignupEmailPassword409JSONResponse) VisitPostSignupEmailPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSignupWebauthnRequestObject struct {
+	Body *PostSignupWebauthnJSONRequestBody
+}
+
+type PostSignupWebauthnResponseObject interface {
+	VisitPostSignupWebauthnResponse(w http.ResponseWriter) error
+}
+
+type PostSignupWebauthn200JSONResponse SignUpWebauthnResponse
+
+func (response PostSignupWebauthn200JSONResponse) VisitPostSignupWebauthnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSignupWebauthnVerifyRequestObject struct {
+	Body *PostSignupWebauthnVerifyJSONRequestBody
+}
+
+type PostSignupWebauthnVerifyResponseObject interface {
+	VisitPostSignupWebauthnVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostSignupWebauthnVerify200JSONResponse SessionPayload
+
+func (response PostSignupWebauthnVerify200JSONResponse) VisitPostSignupWebauthnVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTokenRequestObject struct {
+	Body *PostTokenJSONRequestBody
+}
+
+type PostTokenResponseObject interface {
+	VisitPostTokenResponse(w http.ResponseWriter) error
+}
+
+type PostToken200JSONResponse Session
+
+func (response PostToken200JSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserAvatarRequestObject struct {
+}
+
+type DeleteUserAvatarResponseObject interface {
+	VisitDeleteUserAvatarResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserAvatar200JSONResponse UserAvatar
+
+func (response DeleteUserAvatar200JSONResponse) VisitDeleteUserAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUserAvatarRequestObject struct {
+	ContentType string
+	Body        io.Reader
+}
+
+type PutUserAvatarResponseObject interface {
+	VisitPutUserAvatarResponse(w http.ResponseWriter) error
+}
+
+type PutUserAvatar200JSONResponse UserAvatar
+
+func (response PutUserAvatar200JSONResponse) VisitPutUserAvatarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserDataExportRequestObject struct {
+}
+
+type PostUserDataExportResponseObject interface {
+	VisitPostUserDataExportResponse(w http.ResponseWriter) error
+}
+
+type PostUserDataExport200JSONResponse DataExport
+
+func (response PostUserDataExport200JSONResponse) VisitPostUserDataExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDataExportExportIdRequestObject struct {
+	ExportId openapi_types.UUID `json:"exportId"`
+}
+
+type GetUserDataExportExportIdResponseObject interface {
+	VisitGetUserDataExportExportIdResponse(w http.ResponseWriter) error
+}
+
+type GetUserDataExportExportId200JSONResponse DataExport
+
+func (response GetUserDataExportExportId200JSONResponse) VisitGetUserDataExportExportIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserDeanonymizeRequestObject struct {
+	Body *PostUserDeanonymizeJSONRequestBody
+}
+
+type PostUserDeanonymizeResponseObject interface {
+	VisitPostUserDeanonymizeResponse(w http.ResponseWriter) error
+}
+
+type PostUserDeanonymize200JSONResponse OKResponse
+
+func (response PostUserDeanonymize200JSONResponse) VisitPostUserDeanonymizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserDeanonymizeProviderProviderRequestObject struct {
+	Provider string `json:"provider"`
+	Body     *PostUserDeanonymizeProviderProviderJSONRequestBody
+}
+
+type PostUserDeanonymizeProviderProviderResponseObject interface {
+	VisitPostUserDeanonymizeProviderProviderResponse(w http.ResponseWriter) error
+}
+
+type PostUserDeanonymizeProviderProvider200JSONResponse UserProviderLinkResponse
+
+func (response PostUserDeanonymizeProviderProvider200JSONResponse) VisitPostUserDeanonymizeProviderProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserDeleteRequestObject struct {
+	Body *PostUserDeleteJSONRequestBody
+}
+
+type PostUserDeleteResponseObject interface {
+	VisitPostUserDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostUserDelete200JSONResponse UserDeleteResponse
+
+func (response PostUserDelete200JSONResponse) VisitPostUserDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserEmailChangeRequestObject struct {
+	Body *PostUserEmailChangeJSONRequestBody
+}
+
+type PostUserEmailChangeResponseObject interface {
+	VisitPostUserEmailChangeResponse(w http.ResponseWriter) error
+}
+
+type PostUserEmailChange200JSONResponse OKResponse
+
+func (response PostUserEmailChange200JSONResponse) VisitPostUserEmailChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserEmailSendVerificationEmailRequestObject struct {
+	Body *PostUserEmailSendVerificationEmailJSONRequestBody
+}
+
+type PostUserEmailSendVerificationEmailResponseObject interface {
+	VisitPostUserEmailSendVerificationEmailResponse(w http.ResponseWriter) error
+}
+
+type PostUserEmailSendVerificationEmail200JSONResponse OKResponse
+
+func (response PostUserEmailSendVerificationEmail200JSONResponse) VisitPostUserEmailSendVerificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserEmailSendVerificationEmail429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostUserEmailSendVerificationEmail429JSONResponse struct {
+	Body    ErrorResponse
+	Headers PostUserEmailSendVerificationEmail429ResponseHeaders
+}
+
+func (response PostUserEmailSendVerificationEmail429JSONResponse) VisitPostUserEmailSendVerificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchUserMetadataRequestObject struct {
+	Body *PatchUserMetadataJSONRequestBody
+}
+
+type PatchUserMetadataResponseObject interface {
+	VisitPatchUserMetadataResponse(w http.ResponseWriter) error
+}
+
+type PatchUserMetadata200JSONResponse UserMetadata
+
+func (response PatchUserMetadata200JSONResponse) VisitPatchUserMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserPasswordResetRequestObject struct {
+	Body *PostUserPasswordResetJSONRequestBody
+}
+
+type PostUserPasswordResetResponseObject interface {
+	VisitPostUserPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostUserPasswordReset200JSONResponse OKResponse
+
+func (response PostUserPasswordReset200JSONResponse) VisitPostUserPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserPhoneNumberChangeRequestObject struct {
+	Body *PostUserPhoneNumberChangeJSONRequestBody
+}
+
+type PostUserPhoneNumberChangeResponseObject interface {
+	VisitPostUserPhoneNumberChangeResponse(w http.ResponseWriter) error
+}
+
+type PostUserPhoneNumberChange200JSONResponse OKResponse
+
+func (response PostUserPhoneNumberChange200JSONResponse) VisitPostUserPhoneNumberChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserPhoneNumberChangeVerifyRequestObject struct {
+	Body *PostUserPhoneNumberChangeVerifyJSONRequestBody
+}
+
+type PostUserPhoneNumberChangeVerifyResponseObject interface {
+	VisitPostUserPhoneNumberChangeVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostUserPhoneNumberChangeVerify200JSONResponse OKResponse
+
+func (response PostUserPhoneNumberChangeVerify200JSONResponse) VisitPostUserPhoneNumberChangeVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserProvidersRequestObject struct {
+}
+
+type GetUserProvidersResponseObject interface {
+	VisitGetUserProvidersResponse(w http.ResponseWriter) error
+}
+
+type GetUserProviders200JSONResponse UserProvidersResponse
+
+func (response GetUserProviders200JSONResponse) VisitGetUserProvidersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserProvidersProviderRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type DeleteUserProvidersProviderResponseObject interface {
+	VisitDeleteUserProvidersProviderResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserProvidersProvider200JSONResponse OKResponse
+
+func (response DeleteUserProvidersProvider200JSONResponse) VisitDeleteUserProvidersProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserProvidersProviderLinkRequestObject struct {
+	Provider string `json:"provider"`
+	Body     *PostUserProvidersProviderLinkJSONRequestBody
+}
+
+type PostUserProvidersProviderLinkResponseObject interface {
+	VisitPostUserProvidersProviderLinkResponse(w http.ResponseWriter) error
+}
+
+type PostUserProvidersProviderLink200JSONResponse UserProviderLinkResponse
+
+func (response PostUserProvidersProviderLink200JSONResponse) VisitPostUserProvidersProviderLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSessionsRequestObject struct {
+}
+
+type GetUserSessionsResponseObject interface {
+	VisitGetUserSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetUserSessions200JSONResponse UserSessionsResponse
+
+func (response GetUserSessions200JSONResponse) VisitGetUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserSessionsRevokeAllRequestObject struct {
+}
+
+type PostUserSessionsRevokeAllResponseObject interface {
+	VisitPostUserSessionsRevokeAllResponse(w http.ResponseWriter) error
+}
+
+type PostUserSessionsRevokeAll200JSONResponse OKResponse
+
+func (response PostUserSessionsRevokeAll200JSONResponse) VisitPostUserSessionsRevokeAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserSessionsSessionIdRequestObject struct {
+	SessionId openapi_types.UUID `json:"sessionId"`
+}
+
+type DeleteUserSessionsSessionIdResponseObject interface {
+	VisitDeleteUserSessionsSessionIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserSessionsSessionId200JSONResponse OKResponse
+
+func (response DeleteUserSessionsSessionId200JSONResponse) VisitDeleteUserSessionsSessionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserWebauthnAddRequestObject struct {
+}
+
+type PostUserWebauthnAddResponseObject interface {
+	VisitPostUserWebauthnAddResponse(w http.ResponseWriter) error
+}
+
+type PostUserWebauthnAdd200JSONResponse SignUpWebauthnResponse
+
+func (response PostUserWebauthnAdd200JSONResponse) VisitPostUserWebauthnAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserWebauthnVerifyRequestObject struct {
+	Body *PostUserWebauthnVerifyJSONRequestBody
+}
+
+type PostUserWebauthnVerifyResponseObject interface {
+	VisitPostUserWebauthnVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostUserWebauthnVerify200JSONResponse UserAddSecurityKeyVerifyResponse
+
+func (response PostUserWebauthnVerify200JSONResponse) VisitPostUserWebauthnVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVerifyRequestObject struct {
+	Params GetVerifyParams
+}
+
+type GetVerifyResponseObject interface {
+	VisitGetVerifyResponse(w http.ResponseWriter) error
+}
+
+type GetVerify302ResponseHeaders struct {
+	Location string
+}
+
+type GetVerify302Response struct {
+	Headers GetVerify302ResponseHeaders
+}
+
+func (response GetVerify302Response) VisitGetVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type GetVersionRequestObject struct {
+}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse struct {
+	Version string `json:"version"`
+}
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Public keys used to sign the access tokens, only present when using an asymmetric signing algorithm
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)
+	// OpenID Connect discovery document of the identity provider, only available when AUTH_OIDC_PROVIDER_ENABLED is set
+	// (GET /.well-known/openid-configuration)
+	GetWellKnownOpenidConfiguration(ctx context.Context, request GetWellKnownOpenidConfigurationRequestObject) (GetWellKnownOpenidConfigurationResponseObject, error)
+	// List the entries of the audit log, most recent first
+	// (GET /admin/audit-logs)
+	GetAdminAuditLogs(ctx context.Context, request GetAdminAuditLogsRequestObject) (GetAdminAuditLogsResponseObject, error)
+	// Reload the configuration that can be changed without a restart, reading the config file and resolving the secret references again
+	// (POST /admin/config/reload)
+	PostAdminConfigReload(ctx context.Context, request PostAdminConfigReloadRequestObject) (PostAdminConfigReloadResponseObject, error)
+	// Get the status of an export of any user
+	// (GET /admin/data-exports/{exportId})
+	GetAdminDataExportsExportId(ctx context.Context, request GetAdminDataExportsExportIdRequestObject) (GetAdminDataExportsExportIdResponseObject, error)
+	// List the emails of the outbox that couldn't be delivered, either because the provider rejected them or because they failed too many times
+	// (GET /admin/emails/failed)
+	GetAdminEmailsFailed(ctx context.Context, request GetAdminEmailsFailedRequestObject) (GetAdminEmailsFailedResponseObject, error)
+	// Queue a failed email of the outbox to be sent again
+	// (POST /admin/emails/{emailId}/retry)
+	PostAdminEmailsEmailIdRetry(ctx context.Context, request PostAdminEmailsEmailIdRetryRequestObject) (PostAdminEmailsEmailIdRetryResponseObject, error)
+	// Register an OAuth2 client that can get access tokens with the client credentials grant. The client secret is only returned once
+	// (POST /admin/oauth2/clients)
+	PostAdminOauth2Clients(ctx context.Context, request PostAdminOauth2ClientsRequestObject) (PostAdminOauth2ClientsResponseObject, error)
+	// Delete an OAuth2 client. Access tokens already issued to the client remain valid until they expire
+	// (DELETE /admin/oauth2/clients/{clientId})
+	DeleteAdminOauth2ClientsClientId(ctx context.Context, request DeleteAdminOauth2ClientsClientIdRequestObject) (DeleteAdminOauth2ClientsClientIdResponseObject, error)
+	// List the roles of auth.roles and how many users have each of them
+	// (GET /admin/roles)
+	GetAdminRoles(ctx context.Context, request GetAdminRolesRequestObject) (GetAdminRolesResponseObject, error)
+	// Add a role to auth.roles so it can be given to users. The role must also be configured in the Hasura permissions to grant any access
+	// (POST /admin/roles)
+	PostAdminRoles(ctx context.Context, request PostAdminRolesRequestObject) (PostAdminRolesResponseObject, error)
+	// Delete a role from auth.roles and remove it from every user that has it. Roles that are the default role of a user or part of the default allowed roles can't be deleted
+	// (DELETE /admin/roles/{role})
+	DeleteAdminRolesRole(ctx context.Context, request DeleteAdminRolesRoleRequestObject) (DeleteAdminRolesRoleResponseObject, error)
+	// List the sign in methods, whether they are configured and whether they are enabled once the runtime flags are applied
+	// (GET /admin/sign-in-methods)
+	GetAdminSignInMethods(ctx context.Context, request GetAdminSignInMethodsRequestObject) (GetAdminSignInMethodsResponseObject, error)
+	// Enable or disable a sign in method at runtime, on every instance, without a redeploy. Methods that aren't configured stay disabled
+	// (PUT /admin/sign-in-methods/{method})
+	PutAdminSignInMethodsMethod(ctx context.Context, request PutAdminSignInMethodsMethodRequestObject) (PutAdminSignInMethodsMethodResponseObject, error)
+	// List the users, optionally filtered. Results are paginated with a cursor, pass the nextCursor of the response to get the next page
+	// (GET /admin/users)
+	GetAdminUsers(ctx context.Context, request GetAdminUsersRequestObject) (GetAdminUsersResponseObject, error)
+	// Import users, i.e. when migrating from another provider. The body has one user per line, either as ND-JSON with one ImportUser per line or as CSV with a header row naming the ImportUser properties. Password hashes are kept as is, bcrypt and argon2 hashes are supported. Users are inserted in batches, each in its own transaction; users whose id or email already exist are skipped
+	// (POST /admin/users/import)
+	PostAdminUsersImport(ctx context.Context, request PostAdminUsersImportRequestObject) (PostAdminUsersImportResponseObject, error)
+	// Lift the ban of a user
+	// (DELETE /admin/users/{userId}/ban)
+	DeleteAdminUsersUserIdBan(ctx context.Context, request DeleteAdminUsersUserIdBanRequestObject) (DeleteAdminUsersUserIdBanResponseObject, error)
+	// Ban a user, optionally until a given time, and revoke all their sessions. Banned users can't sign in or refresh their session while the ban lasts
+	// (POST /admin/users/{userId}/ban)
+	PostAdminUsersUserIdBan(ctx context.Context, request PostAdminUsersUserIdBanRequestObject) (PostAdminUsersUserIdBanResponseObject, error)
+	// Request an export of the data stored about a user, see /user/data-export
+	// (POST /admin/users/{userId}/data-export)
+	PostAdminUsersUserIdDataExport(ctx context.Context, request PostAdminUsersUserIdDataExportRequestObject) (PostAdminUsersUserIdDataExportResponseObject, error)
+	// Disable a user and revoke all their sessions. Disabled users can't sign in or refresh their session until they are enabled again
+	// (POST /admin/users/{userId}/disable)
+	PostAdminUsersUserIdDisable(ctx context.Context, request PostAdminUsersUserIdDisableRequestObject) (PostAdminUsersUserIdDisableResponseObject, error)
+	// Enable a disabled user
+	// (POST /admin/users/{userId}/enable)
+	PostAdminUsersUserIdEnable(ctx context.Context, request PostAdminUsersUserIdEnableRequestObject) (PostAdminUsersUserIdEnableResponseObject, error)
+	// Get an access token to act as a user, i.e. to debug an issue only they experience. The access token carries the x-hasura-impersonated-by claim and can't be refreshed. Impersonations are always recorded in the audit log
+	// (POST /admin/users/{userId}/impersonate)
+	PostAdminUsersUserIdImpersonate(ctx context.Context, request PostAdminUsersUserIdImpersonateRequestObject) (PostAdminUsersUserIdImpersonateResponseObject, error)
+	// Set the password of a user, either in plain text, which must comply with the password policy, or already hashed with bcrypt, i.e. when migrating users from another system
+	// (POST /admin/users/{userId}/password)
+	PostAdminUsersUserIdPassword(ctx context.Context, request PostAdminUsersUserIdPasswordRequestObject) (PostAdminUsersUserIdPasswordResponseObject, error)
+	// Invalidate the password of a user, revoke all their sessions and email them a link to set a new password, i.e. after a breach
+	// (POST /admin/users/{userId}/password/reset)
+	PostAdminUsersUserIdPasswordReset(ctx context.Context, request PostAdminUsersUserIdPasswordResetRequestObject) (PostAdminUsersUserIdPasswordResetResponseObject, error)
+	// Add a role to a user. The role must exist in auth.roles. It is included in the allowed roles of the access tokens issued from then on
+	// (POST /admin/users/{userId}/roles)
+	PostAdminUsersUserIdRoles(ctx context.Context, request PostAdminUsersUserIdRolesRequestObject) (PostAdminUsersUserIdRolesResponseObject, error)
+	// Remove a role from a user. The default role of the user can't be removed
+	// (DELETE /admin/users/{userId}/roles/{role})
+	DeleteAdminUsersUserIdRolesRole(ctx context.Context, request DeleteAdminUsersUserIdRolesRoleRequestObject) (DeleteAdminUsersUserIdRolesRoleResponseObject, error)
+	// Revoke all the sessions of a user, including personal access tokens. When access token revocation is enabled, access tokens issued before the call are rejected by Hasura Auth as well
+	// (POST /admin/users/{userId}/sessions/revoke-all)
+	PostAdminUsersUserIdSessionsRevokeAll(ctx context.Context, request PostAdminUsersUserIdSessionsRevokeAllRequestObject) (PostAdminUsersUserIdSessionsRevokeAllResponseObject, error)
+	// Unlock a user locked out after too many failed sign in attempts and reset their failed attempts
+	// (POST /admin/users/{userId}/unlock)
+	PostAdminUsersUserIdUnlock(ctx context.Context, request PostAdminUsersUserIdUnlockRequestObject) (PostAdminUsersUserIdUnlockResponseObject, error)
+	// Download a completed export with the ticket of the downloadUrl returned with its status
+	// (GET /data-export/download)
+	GetDataExportDownload(ctx context.Context, request GetDataExportDownloadRequestObject) (GetDataExportDownloadResponseObject, error)
+	// Start the device authorization grant (RFC 8628). Returns a device code for the device to poll /device/token with and a user code the user needs to enter in the verification page
+	// (POST /device/code)
+	PostDeviceCode(ctx context.Context, request PostDeviceCodeRequestObject) (PostDeviceCodeResponseObject, error)
+	// Poll for the session once the user has approved the device. Until then the request fails with authorization-pending, or slow-down if the device polls faster than the interval
+	// (POST /device/token)
+	PostDeviceToken(ctx context.Context, request PostDeviceTokenRequestObject) (PostDeviceTokenResponseObject, error)
+	// Approve or deny a device with the user code it displays. Meant to be called by the verification page on behalf of the authenticated user
+	// (POST /device/verify)
+	PostDeviceVerify(ctx context.Context, request PostDeviceVerifyRequestObject) (PostDeviceVerifyResponseObject, error)
+	// Re-verify the authenticated user's password and, if they have MFA enabled, a TOTP code, and return a new session whose access token carries the x-hasura-auth-elevated claim. The elevated access token is short lived
+	// (POST /elevate)
+	PostElevate(ctx context.Context, request PostElevateRequestObject) (PostElevateResponseObject, error)
+	// Start a webauthn assertion to elevate the authenticated user's session. The challenge is restricted to the user's security keys
+	// (POST /elevate/webauthn)
+	PostElevateWebauthn(ctx context.Context, request PostElevateWebauthnRequestObject) (PostElevateWebauthnResponseObject, error)
+	// Verify a webauthn assertion and return a new session whose access token carries the x-hasura-auth-elevated claim
+	// (POST /elevate/webauthn/verify)
+	PostElevateWebauthnVerify(ctx context.Context, request PostElevateWebauthnVerifyRequestObject) (PostElevateWebauthnVerifyResponseObject, error)
+	// Verify the access token of a request for a reverse proxy (Traefik forwardAuth, nginx auth_request). The access token is read from the Authorization header or from the cookie set in AUTH_FORWARD_AUTH_COOKIE_NAME. On success the identity of the user is returned in headers the proxy can forward to the upstream
+	// (GET /forward-auth/verify)
+	GetForwardAuthVerify(ctx context.Context, request GetForwardAuthVerifyRequestObject) (GetForwardAuthVerifyResponseObject, error)
+	// Health check
+	// (GET /healthz)
+	GetHealthz(ctx context.Context, request GetHealthzRequestObject) (GetHealthzResponseObject, error)
+	// Health check
+	// (HEAD /healthz)
+	HeadHealthz(ctx context.Context, request HeadHealthzRequestObject) (HeadHealthzResponseObject, error)
+	// Get the number of unused MFA recovery codes of the authenticated user
+	// (GET /mfa/recovery-codes)
+	GetMfaRecoveryCodes(ctx context.Context, request GetMfaRecoveryCodesRequestObject) (GetMfaRecoveryCodesResponseObject, error)
+	// Regenerate the MFA recovery codes of the authenticated user invalidating the previous ones
+	// (POST /mfa/recovery-codes)
+	PostMfaRecoveryCodes(ctx context.Context, request PostMfaRecoveryCodesRequestObject) (PostMfaRecoveryCodesResponseObject, error)
+	// Activate TOTP multi-factor authentication for the authenticated user
+	// (POST /mfa/totp/enable)
+	PostMfaTotpEnable(ctx context.Context, request PostMfaTotpEnableRequestObject) (PostMfaTotpEnableResponseObject, error)
+	// Generate a secret to request the activation of TOTP multi-factor authentication
+	// (GET /mfa/totp/generate)
+	GetMfaTotpGenerate(ctx context.Context, request GetMfaTotpGenerateRequestObject) (GetMfaTotpGenerateResponseObject, error)
+	// OpenID Connect authorization endpoint. Validates the authorization request and redirects the user to the page of the application that signs them in and asks for their consent, or back to the client with an error
+	// (GET /oauth/authorize)
+	GetOauthAuthorize(ctx context.Context, request GetOauthAuthorizeRequestObject) (GetOauthAuthorizeResponseObject, error)
+	// Authorize a client on behalf of the signed in user. Called by the page set in AUTH_OIDC_PROVIDER_AUTHORIZATION_URL with the parameters of the authorization request. If the user hasn't consented to the scopes yet and consent isn't set, the response asks for it, otherwise it returns the URL to redirect the user to
+	// (POST /oauth/authorize)
+	PostOauthAuthorize(ctx context.Context, request PostOauthAuthorizeRequestObject) (PostOauthAuthorizeResponseObject, error)
+	// Token introspection (RFC 7662). Returns whether an access token or a refresh token is active and, if it is, the information it holds
+	// (POST /oauth/introspect)
+	PostOauthIntrospect(ctx context.Context, request PostOauthIntrospectRequestObject) (PostOauthIntrospectResponseObject, error)
+	// Get an access token for an OAuth2 client with the client credentials grant (RFC 6749 section 4.4), exchange the access token of a user for a down-scoped one with the token exchange grant (RFC 8693) or exchange an authorization code for the tokens of a user with the authorization code grant (OpenID Connect). The client authenticates with HTTP basic authentication or with its credentials in the request body
+	// (POST /oauth/token)
+	PostOauthToken(ctx context.Context, request PostOauthTokenRequestObject) (PostOauthTokenResponseObject, error)
+	// OpenID Connect userinfo endpoint. Returns the claims of the user the scopes allowed by the user give access to
+	// (GET /oauth/userinfo)
+	GetOauthUserinfo(ctx context.Context, request GetOauthUserinfoRequestObject) (GetOauthUserinfoResponseObject, error)
+	// Create an organization. The authenticated user becomes its owner
+	// (POST /organizations)
+	PostOrganizations(ctx context.Context, request PostOrganizationsRequestObject) (PostOrganizationsResponseObject, error)
+	// Accept an invitation to join an organization. The invitation must have been sent to the email of the authenticated user
+	// (POST /organizations/invites/accept)
+	PostOrganizationsInvitesAccept(ctx context.Context, request PostOrganizationsInvitesAcceptRequestObject) (PostOrganizationsInvitesAcceptResponseObject, error)
+	// Switch the active organization of the authenticated user and return a new session whose access token carries the x-hasura-org-id and x-hasura-org-role claims of that organization. A null organizationId leaves the organizations
+	// (POST /organizations/switch)
+	PostOrganizationsSwitch(ctx context.Context, request PostOrganizationsSwitchRequestObject) (PostOrganizationsSwitchResponseObject, error)
+	// Invite someone to join an organization by email. Only owners and admins of the organization can invite members, and only owners can invite owners
+	// (POST /organizations/{organizationId}/invites)
+	PostOrganizationsOrganizationIdInvites(ctx context.Context, request PostOrganizationsOrganizationIdInvitesRequestObject) (PostOrganizationsOrganizationIdInvitesResponseObject, error)
+	// List the Personal Access Tokens (PAT) of the authenticated user. The tokens themselves are never returned, only their details
+	// (GET /pat)
+	GetPat(ctx context.Context, request GetPatRequestObject) (GetPatResponseObject, error)
+	// Create a Personal Access Token (PAT)
+	// (POST /pat)
+	PostPat(ctx context.Context, request PostPatRequestObject) (PostPatResponseObject, error)
+	// Revoke a Personal Access Token (PAT) of the authenticated user
+	// (DELETE /pat/{patId})
+	DeletePatPatId(ctx context.Context, request DeletePatPatIdRequestObject) (DeletePatPatIdResponseObject, error)
+	// Readiness check, verifies the database, the SMTP server and the JWT signing key are available
+	// (GET /readyz)
+	GetReadyz(ctx context.Context, request GetReadyzRequestObject) (GetReadyzResponseObject, error)
+	// Readiness check, verifies the database, the SMTP server and the JWT signing key are available
+	// (HEAD /readyz)
+	HeadReadyz(ctx context.Context, request HeadReadyzRequestObject) (HeadReadyzResponseObject, error)
+	// List the roles of auth.roles as SCIM 2.0 groups. Only equality filters on displayName are supported
+	// (GET /scim/v2/Groups)
+	GetScimV2Groups(ctx context.Context, request GetScimV2GroupsRequestObject) (GetScimV2GroupsResponseObject, error)
+	// Create a role named after the displayName of the group and give it to its members
+	// (POST /scim/v2/Groups)
+	PostScimV2Groups(ctx context.Context, request PostScimV2GroupsRequestObject) (PostScimV2GroupsResponseObject, error)
+	// Delete a role from auth.roles. Roles that are the default role of a user or part of the default allowed roles can't be deleted
+	// (DELETE /scim/v2/Groups/{groupId})
+	DeleteScimV2GroupsGroupId(ctx context.Context, request DeleteScimV2GroupsGroupIdRequestObject) (DeleteScimV2GroupsGroupIdResponseObject, error)
+	// Get a role of auth.roles as a SCIM 2.0 group
+	// (GET /scim/v2/Groups/{groupId})
+	GetScimV2GroupsGroupId(ctx context.Context, request GetScimV2GroupsGroupIdRequestObject) (GetScimV2GroupsGroupIdResponseObject, error)
+	// Add or remove members of a group. Groups can't be renamed
+	// (PATCH /scim/v2/Groups/{groupId})
+	PatchScimV2GroupsGroupId(ctx context.Context, request PatchScimV2GroupsGroupIdRequestObject) (PatchScimV2GroupsGroupIdResponseObject, error)
+	// Replace the members of a group. Groups can't be renamed
+	// (PUT /scim/v2/Groups/{groupId})
+	PutScimV2GroupsGroupId(ctx context.Context, request PutScimV2GroupsGroupIdRequestObject) (PutScimV2GroupsGroupIdResponseObject, error)
+	// List the users as SCIM 2.0 resources. Only equality filters on userName, externalId and emails.value are supported
+	// (GET /scim/v2/Users)
+	GetScimV2Users(ctx context.Context, request GetScimV2UsersRequestObject) (GetScimV2UsersResponseObject, error)
+	// Provision a user. The email of the user is its userName and is trusted as verified, passwords sent by the identity provider are ignored
+	// (POST /scim/v2/Users)
+	PostScimV2Users(ctx context.Context, request PostScimV2UsersRequestObject) (PostScimV2UsersResponseObject, error)
+	// Deprovision a user, deleting it and everything stored about it
+	// (DELETE /scim/v2/Users/{userId})
+	DeleteScimV2UsersUserId(ctx context.Context, request DeleteScimV2UsersUserIdRequestObject) (DeleteScimV2UsersUserIdResponseObject, error)
+	// Get a user as a SCIM 2.0 resource
+	// (GET /scim/v2/Users/{userId})
+	GetScimV2UsersUserId(ctx context.Context, request GetScimV2UsersUserIdRequestObject) (GetScimV2UsersUserIdResponseObject, error)
+	// Update some attributes of a user. Disabling a user revokes their sessions
+	// (PATCH /scim/v2/Users/{userId})
+	PatchScimV2UsersUserId(ctx context.Context, request PatchScimV2UsersUserIdRequestObject) (PatchScimV2UsersUserIdResponseObject, error)
+	// Replace the attributes of a user. Disabling a user revokes their sessions
+	// (PUT /scim/v2/Users/{userId})
+	PutScimV2UsersUserId(ctx context.Context, request PutScimV2UsersUserIdRequestObject) (PutScimV2UsersUserIdResponseObject, error)
+	// Sign in as an anonymous user. The user will get the `anonymous` role and can later be deanonymized using /user/deanonymize
+	// (POST /signin/anonymous)
+	PostSigninAnonymous(ctx context.Context, request PostSigninAnonymousRequestObject) (PostSigninAnonymousResponseObject, error)
+	// Sign in with email and password
+	// (POST /signin/email-password)
+	PostSigninEmailPassword(ctx context.Context, request PostSigninEmailPasswordRequestObject) (PostSigninEmailPasswordResponseObject, error)
+	// Sign in with an ID token obtained by a native SDK of the provider, like Google Sign-In or Sign in with Apple. The user is created or linked the same way as with /signin/provider/{provider}
+	// (POST /signin/idtoken)
+	PostSigninIdtoken(ctx context.Context, request PostSigninIdtokenRequestObject) (PostSigninIdtokenResponseObject, error)
+	// Sign in with a recovery code instead of a TOTP code after a multi-factor authentication challenge
+	// (POST /signin/mfa/recovery-code)
+	PostSigninMfaRecoveryCode(ctx context.Context, request PostSigninMfaRecoveryCodeRequestObject) (PostSigninMfaRecoveryCodeResponseObject, error)
+	// Sign in with a TOTP code after a multi-factor authentication challenge
+	// (POST /signin/mfa/totp)
+	PostSigninMfaTotp(ctx context.Context, request PostSigninMfaTotpRequestObject) (PostSigninMfaTotpResponseObject, error)
+	// Sign in with magic link sent to user's email. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
+	// (POST /signin/passwordless/email)
+	PostSigninPasswordlessEmail(ctx context.Context, request PostSigninPasswordlessEmailRequestObject) (PostSigninPasswordlessEmailResponseObject, error)
+	// Sign in with a one time password sent to user's phone number. If user doesn't exist, it will be created. The options object is optional and can be used to set the user's when signing up a new user. It is ignored if the user already exists.
+	// (POST /signin/passwordless/sms)
+	PostSigninPasswordlessSms(ctx context.Context, request PostSigninPasswordlessSmsRequestObject) (PostSigninPasswordlessSmsResponseObject, error)
+	// Verify the one time password sent to user's phone number and sign in
+	// (POST /signin/passwordless/sms/otp)
+	PostSigninPasswordlessSmsOtp(ctx context.Context, request PostSigninPasswordlessSmsOtpRequestObject) (PostSigninPasswordlessSmsOtpResponseObject, error)
+	// Sign in with Personal Access Token (PAT)
+	// (POST /signin/pat)
+	PostSigninPat(ctx context.Context, request PostSigninPatRequestObject) (PostSigninPatResponseObject, error)
+	// Start a sign in with an OAuth provider. The user is redirected to the provider and, once authenticated, back to the callback endpoint
+	// (GET /signin/provider/{provider})
+	GetSigninProviderProvider(ctx context.Context, request GetSigninProviderProviderRequestObject) (GetSigninProviderProviderResponseObject, error)
+	// OAuth callback. On success the user is redirected to the redirectTo given when starting the sign in with a refresh token, on failure with an error
+	// (GET /signin/provider/{provider}/callback)
+	GetSigninProviderProviderCallback(ctx context.Context, request GetSigninProviderProviderCallbackRequestObject) (GetSigninProviderProviderCallbackResponseObject, error)
+	// OAuth callback for providers that return the response with response_mode=form_post, like Apple. Behaves like the GET callback
+	// (POST /signin/provider/{provider}/callback)
+	PostSigninProviderProviderCallback(ctx context.Context, request PostSigninProviderProviderCallbackRequestObject) (PostSigninProviderProviderCallbackResponseObject, error)
+	// Start a sign in with the SAML identity provider. The user is redirected to the identity provider and, once authenticated, its response is posted to the ACS endpoint
+	// (GET /signin/saml)
+	GetSigninSaml(ctx context.Context, request GetSigninSamlRequestObject) (GetSigninSamlResponseObject, error)
+	// SAML assertion consumer service. On success the user is redirected to the redirectTo given when starting the sign in with a refresh token, on failure with an error
+	// (POST /signin/saml/acs)
+	PostSigninSamlAcs(ctx context.Context, request PostSigninSamlAcsRequestObject) (PostSigninSamlAcsResponseObject, error)
+	// SAML service provider metadata, used to register hasura-auth with the identity provider
+	// (GET /signin/saml/metadata)
+	GetSigninSamlMetadata(ctx context.Context, request GetSigninSamlMetadataRequestObject) (GetSigninSamlMetadataResponseObject, error)
+	// Start a webauthn sign in. If an email is provided the challenge is restricted to the user's security keys, otherwise a discoverable credential (passkey) can be used
+	// (POST /signin/webauthn)
+	PostSigninWebauthn(ctx context.Context, request PostSigninWebauthnRequestObject) (PostSigninWebauthnResponseObject, error)
+	// Verify webauthn sign in
+	// (POST /signin/webauthn/verify)
+	PostSigninWebauthnVerify(ctx context.Context, request PostSigninWebauthnVerifyRequestObject) (PostSigninWebauthnVerifyResponseObject, error)
+	// Signup with email and password
+	// (POST /signup/email-password)
+	PostSignupEmailPassword(ctx context.Context, request PostSignupEmailPasswordRequestObject) (PostSignupEmailPasswordResponseObject, error)
+	// Signup with webauthn
+	// (POST /signup/webauthn)
+	PostSignupWebauthn(ctx context.Context, request PostSignupWebauthnRequestObject) (PostSignupWebauthnResponseObject, error)
+	// Verify webauthn signup
+	// (POST /signup/webauthn/verify)
+	PostSignupWebauthnVerify(ctx context.Context, request PostSignupWebauthnVerifyRequestObject) (PostSignupWebauthnVerifyResponseObject, error)
+	// Refresh the JWT access token
+	// (POST /token)
+	PostToken(ctx context.Context, request PostTokenRequestObject) (PostTokenResponseObject, error)
+	// Delete the uploaded avatar of the authenticated user. The avatar URL goes back to the one of the avatar provider
+	// (DELETE /user/avatar)
+	DeleteUserAvatar(ctx context.Context, request DeleteUserAvatarRequestObject) (DeleteUserAvatarResponseObject, error)
+	// Upload the avatar of the authenticated user. PNG, JPEG and GIF images are accepted, they are resized to fit AUTH_AVATAR_SIZE and stored in the configured object storage. The avatar URL of the user is set to the stored image and the previous one is deleted
+	// (PUT /user/avatar)
+	PutUserAvatar(ctx context.Context, request PutUserAvatarRequestObject) (PutUserAvatarResponseObject, error)
+	// Request an export of the data stored about the authenticated user. The export is assembled in the background, poll it with the returned ID until it is completed. If an export is already pending it is returned instead of requesting a new one
+	// (POST /user/data-export)
+	PostUserDataExport(ctx context.Context, request PostUserDataExportRequestObject) (PostUserDataExportResponseObject, error)
+	// Get the status of an export of the authenticated user. Completed exports include a short lived URL to download them
+	// (GET /user/data-export/{exportId})
+	GetUserDataExportExportId(ctx context.Context, request GetUserDataExportExportIdRequestObject) (GetUserDataExportExportIdResponseObject, error)
+	// Deanonymize an anonymous user in adding missing email or email+password, depending on the chosen authentication method. Will send a confirmation email if the server is configured to do so
+	// (POST /user/deanonymize)
+	PostUserDeanonymize(ctx context.Context, request PostUserDeanonymizeRequestObject) (PostUserDeanonymizeResponseObject, error)
+	// Start deanonymizing the authenticated anonymous user with an OAuth provider. The user needs to be sent to the returned URL to authenticate with the provider and, once the provider account is linked, the user keeps its id and is redirected to redirectTo with a refresh token, on failure with an error
+	// (POST /user/deanonymize/provider/{provider})
+	PostUserDeanonymizeProviderProvider(ctx context.Context, request PostUserDeanonymizeProviderProviderRequestObject) (PostUserDeanonymizeProviderProviderResponseObject, error)
+	// Schedule the deletion of the authenticated user. All the sessions of the user are revoked and signing in is rejected until the account is deleted at the end of the grace period. A link to cancel the deletion is sent to the user's email
+	// (POST /user/delete)
+	PostUserDelete(ctx context.Context, request PostUserDeleteRequestObject) (PostUserDeleteResponseObject, error)
+	// Change user email
+	// (POST /user/email/change)
+	PostUserEmailChange(ctx context.Context, request PostUserEmailChangeRequestObject) (PostUserEmailChangeResponseObject, error)
+	// Send email verification email
+	// (POST /user/email/send-verification-email)
+	PostUserEmailSendVerificationEmail(ctx context.Context, request PostUserEmailSendVerificationEmailRequestObject) (PostUserEmailSendVerificationEmailResponseObject, error)
+	// Update the metadata of the authenticated user. The body is a JSON merge patch (RFC 7386): objects are merged, null values remove the keys and anything else replaces the current value
+	// (PATCH /user/metadata)
+	PatchUserMetadata(ctx context.Context, request PatchUserMetadataRequestObject) (PatchUserMetadataResponseObject, error)
+	// Request a password reset. An email with a verification link will be sent to the user's address
+	// (POST /user/password/reset)
+	PostUserPasswordReset(ctx context.Context, request PostUserPasswordResetRequestObject) (PostUserPasswordResetResponseObject, error)
+	// Change user phone number. A one time password is sent to the new phone number and the change only takes effect once it is verified
+	// (POST /user/phone-number/change)
+	PostUserPhoneNumberChange(ctx context.Context, request PostUserPhoneNumberChangeRequestObject) (PostUserPhoneNumberChangeResponseObject, error)
+	// Verify the one time password sent to the new phone number and change it
+	// (POST /user/phone-number/change/verify)
+	PostUserPhoneNumberChangeVerify(ctx context.Context, request PostUserPhoneNumberChangeVerifyRequestObject) (PostUserPhoneNumberChangeVerifyResponseObject, error)
+	// List the OAuth providers linked to the authenticated user
+	// (GET /user/providers)
+	GetUserProviders(ctx context.Context, request GetUserProvidersRequestObject) (GetUserProvidersResponseObject, error)
+	// Unlink an OAuth provider from the authenticated user. Providers can't be unlinked if they are the last method the user has to sign in
+	// (DELETE /user/providers/{provider})
+	DeleteUserProvidersProvider(ctx context.Context, request DeleteUserProvidersProviderRequestObject) (DeleteUserProvidersProviderResponseObject, error)
+	// Start linking an OAuth provider to the authenticated user. The user needs to be sent to the returned URL to authenticate with the provider and, once the provider is linked, is redirected to redirectTo with a refresh token, on failure with an error
+	// (POST /user/providers/{provider}/link)
+	PostUserProvidersProviderLink(ctx context.Context, request PostUserProvidersProviderLinkRequestObject) (PostUserProvidersProviderLinkResponseObject, error)
+	// List the active sessions of the authenticated user. A session is identified by the ID of its current refresh token, which changes every time the session is refreshed
+	// (GET /user/sessions)
+	GetUserSessions(ctx context.Context, request GetUserSessionsRequestObject) (GetUserSessionsResponseObject, error)
+	// Revoke all the sessions of the authenticated user, including personal access tokens. When access token revocation is enabled, access tokens issued before the call are rejected by Hasura Auth as well
+	// (POST /user/sessions/revoke-all)
+	PostUserSessionsRevokeAll(ctx context.Context, request PostUserSessionsRevokeAllRequestObject) (PostUserSessionsRevokeAllResponseObject, error)
+	// Revoke a session of the authenticated user. All the refresh tokens of the session are deleted
+	// (DELETE /user/sessions/{sessionId})
+	DeleteUserSessionsSessionId(ctx context.Context, request DeleteUserSessionsSessionIdRequestObject) (DeleteUserSessionsSessionIdResponseObject, error)
+	// Start adding a new webauthn security key to the authenticated user
+	// (POST /user/webauthn/add)
+	PostUserWebauthnAdd(ctx context.Context, request PostUserWebauthnAddRequestObject) (PostUserWebauthnAddResponseObject, error)
+	// Verify and store a new webauthn security key for the authenticated user
+	// (POST /user/webauthn/verify)
+	PostUserWebauthnVerify(ctx context.Context, request PostUserWebauthnVerifyRequestObject) (PostUserWebauthnVerifyResponseObject, error)
+	// Verify a ticket sent by email (email verification, email change confirmation, magic link sign in or password reset). The ticket can only be used once. On success the user is redirected to redirectTo with a refresh token, on failure with an error.
+	// (GET /verify)
+	GetVerify(ctx context.Context, request GetVerifyRequestObject) (GetVerifyResponseObject, error)
+	// Get version
+	// (GET /version)
+	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetWellKnownJwksJson operation middleware
+func (sh *strictHandler) GetWellKnownJwksJson(ctx *gin.Context) {
+	var request GetWellKnownJwksJsonRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownJwksJson(ctx, request.(GetWellKnownJwksJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownJwksJson")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetWellKnownJwksJsonResponseObject); ok {
+		if err := validResponse.VisitGetWellKnownJwksJsonResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWellKnownOpenidConfiguration operation middleware
+func (sh *strictHandler) GetWellKnownOpenidConfiguration(ctx *gin.Context) {
+	var request GetWellKnownOpenidConfigurationRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownOpenidConfiguration(ctx, request.(GetWellKnownOpenidConfigurationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownOpenidConfiguration")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetWellKnownOpenidConfigurationResponseObject); ok {
+		if err := validResponse.VisitGetWellKnownOpenidConfigurationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminAuditLogs operation middleware
+func (sh *strictHandler) GetAdminAuditLogs(ctx *gin.Context, params GetAdminAuditLogsParams) {
+	var request GetAdminAuditLogsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAuditLogs(ctx, request.(GetAdminAuditLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAuditLogs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminAuditLogsResponseObject); ok {
+		if err := validResponse.VisitGetAdminAuditLogsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminConfigReload operation middleware
+func (sh *strictHandler) PostAdminConfigReload(ctx *gin.Context) {
+	var request PostAdminConfigReloadRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminConfigReload(ctx, request.(PostAdminConfigReloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminConfigReload")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminConfigReloadResponseObject); ok {
+		if err := validResponse.VisitPostAdminConfigReloadResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminDataExportsExportId operation middleware
+func (sh *strictHandler) GetAdminDataExportsExportId(ctx *gin.Context, exportId openapi_types.UUID) {
+	var request GetAdminDataExportsExportIdRequestObject
+
+	request.ExportId = exportId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminDataExportsExportId(ctx, request.(GetAdminDataExportsExportIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminDataExportsExportId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminDataExportsExportIdResponseObject); ok {
+		if err := validResponse.VisitGetAdminDataExportsExportIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminEmailsFailed operation middleware
+func (sh *strictHandler) GetAdminEmailsFailed(ctx *gin.Context, params GetAdminEmailsFailedParams) {
+	var request GetAdminEmailsFailedRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminEmailsFailed(ctx, request.(GetAdminEmailsFailedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminEmailsFailed")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminEmailsFailedResponseObject); ok {
+		if err := validResponse.VisitGetAdminEmailsFailedResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminEmailsEmailIdRetry operation middleware
+func (sh *strictHandler) PostAdminEmailsEmailIdRetry(ctx *gin.Context, emailId openapi_types.UUID) {
+	var request PostAdminEmailsEmailIdRetryRequestObject
+
+	request.EmailId = emailId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminEmailsEmailIdRetry(ctx, request.(PostAdminEmailsEmailIdRetryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminEmailsEmailIdRetry")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminEmailsEmailIdRetryResponseObject); ok {
+		if err := validResponse.VisitPostAdminEmailsEmailIdRetryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminOauth2Clients operation middleware
+func (sh *strictHandler) PostAdminOauth2Clients(ctx *gin.Context) {
+	var request PostAdminOauth2ClientsRequestObject
+
+	var body PostAdminOauth2ClientsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminOauth2Clients(ctx, request.(PostAdminOauth2ClientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminOauth2Clients")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminOauth2ClientsResponseObject); ok {
+		if err := validResponse.VisitPostAdminOauth2ClientsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminOauth2ClientsClientId operation middleware
+func (sh *strictHandler) DeleteAdminOauth2ClientsClientId(ctx *gin.Context, clientId string) {
+	var request DeleteAdminOauth2ClientsClientIdRequestObject
+
+	request.ClientId = clientId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminOauth2ClientsClientId(ctx, request.(DeleteAdminOauth2ClientsClientIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminOauth2ClientsClientId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAdminOauth2ClientsClientIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminOauth2ClientsClientIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminRoles operation middleware
+func (sh *strictHandler) GetAdminRoles(ctx *gin.Context) {
+	var request GetAdminRolesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminRoles(ctx, request.(GetAdminRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminRolesResponseObject); ok {
+		if err := validResponse.VisitGetAdminRolesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminRoles operation middleware
+func (sh *strictHandler) PostAdminRoles(ctx *gin.Context) {
+	var request PostAdminRolesRequestObject
+
+	var body PostAdminRolesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminRoles(ctx, request.(PostAdminRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminRolesResponseObject); ok {
+		if err := validResponse.VisitPostAdminRolesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminRolesRole operation middleware
+func (sh *strictHandler) DeleteAdminRolesRole(ctx *gin.Context, role string) {
+	var request DeleteAdminRolesRoleRequestObject
+
+	request.Role = role
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminRolesRole(ctx, request.(DeleteAdminRolesRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminRolesRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAdminRolesRoleResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminRolesRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminSignInMethods operation middleware
+func (sh *strictHandler) GetAdminSignInMethods(ctx *gin.Context) {
+	var request GetAdminSignInMethodsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminSignInMethods(ctx, request.(GetAdminSignInMethodsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminSignInMethods")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminSignInMethodsResponseObject); ok {
+		if err := validResponse.VisitGetAdminSignInMethodsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAdminSignInMethodsMethod operation middleware
+func (sh *strictHandler) PutAdminSignInMethodsMethod(ctx *gin.Context, method string) {
+	var request PutAdminSignInMethodsMethodRequestObject
+
+	request.Method = method
+
+	var body PutAdminSignInMethodsMethodJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAdminSignInMethodsMethod(ctx, request.(PutAdminSignInMethodsMethodRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAdminSignInMethodsMethod")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutAdminSignInMethodsMethodResponseObject); ok {
+		if err := validResponse.VisitPutAdminSignInMethodsMethodResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminUsers operation middleware
+func (sh *strictHandler) GetAdminUsers(ctx *gin.Context, params GetAdminUsersParams) {
+	var request GetAdminUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsers(ctx, request.(GetAdminUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
+		if err := validResponse.VisitGetAdminUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersImport operation middleware
+func (sh *strictHandler) PostAdminUsersImport(ctx *gin.Context, params PostAdminUsersImportParams) {
+	var request PostAdminUsersImportRequestObject
+
+	request.Params = params
+
+	request.Body = ctx.Request.Body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersImport(ctx, request.(PostAdminUsersImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersImportResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersImportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminUsersUserIdBan operation middleware
+func (sh *strictHandler) DeleteAdminUsersUserIdBan(ctx *gin.Context, userId openapi_types.UUID) {
+	var request DeleteAdminUsersUserIdBanRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminUsersUserIdBan(ctx, request.(DeleteAdminUsersUserIdBanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminUsersUserIdBan")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAdminUsersUserIdBanResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminUsersUserIdBanResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdBan operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdBan(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdBanRequestObject
+
+	request.UserId = userId
+
+	var body PostAdminUsersUserIdBanJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdBan(ctx, request.(PostAdminUsersUserIdBanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdBan")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdBanResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdBanResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdDataExport operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdDataExport(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdDataExportRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdDataExport(ctx, request.(PostAdminUsersUserIdDataExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdDataExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdDataExportResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdDataExportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdDisable operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdDisable(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdDisableRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdDisable(ctx, request.(PostAdminUsersUserIdDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdDisable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdDisableResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdDisableResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdEnable operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdEnable(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdEnableRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdEnable(ctx, request.(PostAdminUsersUserIdEnableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdEnable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdEnableResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdEnableResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdImpersonate operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdImpersonate(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdImpersonateRequestObject
+
+	request.UserId = userId
+
+	var body PostAdminUsersUserIdImpersonateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdImpersonate(ctx, request.(PostAdminUsersUserIdImpersonateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdImpersonate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdImpersonateResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdImpersonateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdPassword operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdPassword(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdPasswordRequestObject
+
+	request.UserId = userId
+
+	var body PostAdminUsersUserIdPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdPassword(ctx, request.(PostAdminUsersUserIdPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdPasswordResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdPasswordReset operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdPasswordReset(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdPasswordResetRequestObject
+
+	request.UserId = userId
+
+	var body PostAdminUsersUserIdPasswordResetJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdPasswordReset(ctx, request.(PostAdminUsersUserIdPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdPasswordResetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdRoles operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdRoles(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdRolesRequestObject
+
+	request.UserId = userId
+
+	var body PostAdminUsersUserIdRolesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdRoles(ctx, request.(PostAdminUsersUserIdRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdRolesResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdRolesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminUsersUserIdRolesRole operation middleware
+func (sh *strictHandler) DeleteAdminUsersUserIdRolesRole(ctx *gin.Context, userId openapi_types.UUID, role string) {
+	var request DeleteAdminUsersUserIdRolesRoleRequestObject
+
+	request.UserId = userId
+	request.Role = role
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminUsersUserIdRolesRole(ctx, request.(DeleteAdminUsersUserIdRolesRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminUsersUserIdRolesRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAdminUsersUserIdRolesRoleResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminUsersUserIdRolesRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdSessionsRevokeAll operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdSessionsRevokeAll(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdSessionsRevokeAllRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdSessionsRevokeAll(ctx, request.(PostAdminUsersUserIdSessionsRevokeAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdSessionsRevokeAll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdSessionsRevokeAllResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdSessionsRevokeAllResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdUnlock operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdUnlock(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PostAdminUsersUserIdUnlockRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdUnlock(ctx, request.(PostAdminUsersUserIdUnlockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdUnlock")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdUnlockResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdUnlockResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDataExportDownload operation middleware
+func (sh *strictHandler) GetDataExportDownload(ctx *gin.Context, params GetDataExportDownloadParams) {
+	var request GetDataExportDownloadRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDataExportDownload(ctx, request.(GetDataExportDownloadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDataExportDownload")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetDataExportDownloadResponseObject); ok {
+		if err := validResponse.VisitGetDataExportDownloadResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDeviceCode operation middleware
+func (sh *strictHandler) PostDeviceCode(ctx *gin.Context) {
+	var request PostDeviceCodeRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDeviceCode(ctx, request.(PostDeviceCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDeviceCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDeviceCodeResponseObject); ok {
+		if err := validResponse.VisitPostDeviceCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDeviceToken operation middleware
+func (sh *strictHandler) PostDeviceToken(ctx *gin.Context) {
+	var request PostDeviceTokenRequestObject
+
+	var body PostDeviceTokenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDeviceToken(ctx, request.(PostDeviceTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDeviceToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDeviceTokenResponseObject); ok {
+		if err := validResponse.VisitPostDeviceTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDeviceVerify operation middleware
+func (sh *strictHandler) PostDeviceVerify(ctx *gin.Context) {
+	var request PostDeviceVerifyRequestObject
+
+	var body PostDeviceVerifyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDeviceVerify(ctx, request.(PostDeviceVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDeviceVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDeviceVerifyResponseObject); ok {
+		if err := validResponse.VisitPostDeviceVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostElevate operation middleware
+func (sh *strictHandler) PostElevate(ctx *gin.Context) {
+	var request PostElevateRequestObject
+
+	var body PostElevateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostElevate(ctx, request.(PostElevateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostElevate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostElevateResponseObject); ok {
+		if err := validResponse.VisitPostElevateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostElevateWebauthn operation middleware
+func (sh *strictHandler) PostElevateWebauthn(ctx *gin.Context) {
+	var request PostElevateWebauthnRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostElevateWebauthn(ctx, request.(PostElevateWebauthnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostElevateWebauthn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostElevateWebauthnResponseObject); ok {
+		if err := validResponse.VisitPostElevateWebauthnResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostElevateWebauthnVerify operation middleware
+func (sh *strictHandler) PostElevateWebauthnVerify(ctx *gin.Context) {
+	var request PostElevateWebauthnVerifyRequestObject
+
+	var body PostElevateWebauthnVerifyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostElevateWebauthnVerify(ctx, request.(PostElevateWebauthnVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostElevateWebauthnVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostElevateWebauthnVerifyResponseObject); ok {
+		if err := validResponse.VisitPostElevateWebauthnVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetForwardAuthVerify operation middleware
+func (sh *strictHandler) GetForwardAuthVerify(ctx *gin.Context, params GetForwardAuthVerifyParams) {
+	var request GetForwardAuthVerifyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetForwardAuthVerify(ctx, request.(GetForwardAuthVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetForwardAuthVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetForwardAuthVerifyResponseObject); ok {
+		if err := validResponse.VisitGetForwardAuthVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealthz operation middleware
+func (sh *strictHandler) GetHealthz(ctx *gin.Context) {
+	var request GetHealthzRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealthz(ctx, request.(GetHealthzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealthz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetHealthzResponseObject); ok {
+		if err := validResponse.VisitGetHealthzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HeadHealthz operation middleware
+func (sh *strictHandler) HeadHealthz(ctx *gin.Context) {
+	var request HeadHealthzRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadHealthz(ctx, request.(HeadHealthzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadHealthz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(HeadHealthzResponseObject); ok {
+		if err := validResponse.VisitHeadHealthzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMfaRecoveryCodes operation middleware
+func (sh *strictHandler) GetMfaRecoveryCodes(ctx *gin.Context) {
+	var request GetMfaRecoveryCodesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMfaRecoveryCodes(ctx, request.(GetMfaRecoveryCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMfaRecoveryCodes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMfaRecoveryCodesResponseObject); ok {
+		if err := validResponse.VisitGetMfaRecoveryCodesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostMfaRecoveryCodes operation middleware
+func (sh *strictHandler) PostMfaRecoveryCodes(ctx *gin.Context) {
+	var request PostMfaRecoveryCodesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMfaRecoveryCodes(ctx, request.(PostMfaRecoveryCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMfaRecoveryCodes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMfaRecoveryCodesResponseObject); ok {
+		if err := validResponse.VisitPostMfaRecoveryCodesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostMfaTotpEnable operation middleware
+func (sh *strictHandler) PostMfaTotpEnable(ctx *gin.Context) {
+	var request PostMfaTotpEnableRequestObject
+
+	var body PostMfaTotpEnableJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMfaTotpEnable(ctx, request.(PostMfaTotpEnableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMfaTotpEnable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMfaTotpEnableResponseObject); ok {
+		if err := validResponse.VisitPostMfaTotpEnableResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMfaTotpGenerate operation middleware
+func (sh *strictHandler) GetMfaTotpGenerate(ctx *gin.Context) {
+	var request GetMfaTotpGenerateRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMfaTotpGenerate(ctx, request.(GetMfaTotpGenerateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMfaTotpGenerate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMfaTotpGenerateResponseObject); ok {
+		if err := validResponse.VisitGetMfaTotpGenerateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOauthAuthorize operation middleware
+func (sh *strictHandler) GetOauthAuthorize(ctx *gin.Context, params GetOauthAuthorizeParams) {
+	var request GetOauthAuthorizeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOauthAuthorize(ctx, request.(GetOauthAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOauthAuthorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOauthAuthorizeResponseObject); ok {
+		if err := validResponse.VisitGetOauthAuthorizeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOauthAuthorize operation middleware
+func (sh *strictHandler) PostOauthAuthorize(ctx *gin.Context) {
+	var request PostOauthAuthorizeRequestObject
+
+	var body PostOauthAuthorizeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOauthAuthorize(ctx, request.(PostOauthAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOauthAuthorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOauthAuthorizeResponseObject); ok {
+		if err := validResponse.VisitPostOauthAuthorizeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOauthIntrospect operation middleware
+func (sh *strictHandler) PostOauthIntrospect(ctx *gin.Context) {
+	var request PostOauthIntrospectRequestObject
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.Error(err)
+		return
+	}
+	var body PostOauthIntrospectFormdataRequestBody
+	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOauthIntrospect(ctx, request.(PostOauthIntrospectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOauthIntrospect")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOauthIntrospectResponseObject); ok {
+		if err := validResponse.VisitPostOauthIntrospectResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOauthToken operation middleware
+func (sh *strictHandler) PostOauthToken(ctx *gin.Context, params PostOauthTokenParams) {
+	var request PostOauthTokenRequestObject
+
+	request.Params = params
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.Error(err)
+		return
+	}
+	var body PostOauthTokenFormdataRequestBody
+	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOauthToken(ctx, request.(PostOauthTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOauthToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOauthTokenResponseObject); ok {
+		if err := validResponse.VisitPostOauthTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOauthUserinfo operation middleware
+func (sh *strictHandler) GetOauthUserinfo(ctx *gin.Context) {
+	var request GetOauthUserinfoRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOauthUserinfo(ctx, request.(GetOauthUserinfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOauthUserinfo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOauthUserinfoResponseObject); ok {
+		if err := validResponse.VisitGetOauthUserinfoResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrganizations operation middleware
+func (sh *strictHandler) PostOrganizations(ctx *gin.Context) {
+	var request PostOrganizationsRequestObject
+
+	var body PostOrganizationsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizations(ctx, request.(PostOrganizationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOrganizationsResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrganizationsInvitesAccept operation middleware
+func (sh *strictHandler) PostOrganizationsInvitesAccept(ctx *gin.Context) {
+	var request PostOrganizationsInvitesAcceptRequestObject
+
+	var body PostOrganizationsInvitesAcceptJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationsInvitesAccept(ctx, request.(PostOrganizationsInvitesAcceptRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationsInvitesAccept")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOrganizationsInvitesAcceptResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationsInvitesAcceptResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrganizationsSwitch operation middleware
+func (sh *strictHandler) PostOrganizationsSwitch(ctx *gin.Context) {
+	var request PostOrganizationsSwitchRequestObject
+
+	var body PostOrganizationsSwitchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationsSwitch(ctx, request.(PostOrganizationsSwitchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationsSwitch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOrganizationsSwitchResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationsSwitchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrganizationsOrganizationIdInvites operation middleware
+func (sh *strictHandler) PostOrganizationsOrganizationIdInvites(ctx *gin.Context, organizationId openapi_types.UUID) {
+	var request PostOrganizationsOrganizationIdInvitesRequestObject
+
+	request.OrganizationId = organizationId
+
+	var body PostOrganizationsOrganizationIdInvitesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrganizationsOrganizationIdInvites(ctx, request.(PostOrganizationsOrganizationIdInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrganizationsOrganizationIdInvites")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOrganizationsOrganizationIdInvitesResponseObject); ok {
+		if err := validResponse.VisitPostOrganizationsOrganizationIdInvitesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPat operation middleware
+func (sh *strictHandler) GetPat(ctx *gin.Context) {
+	var request GetPatRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPat(ctx, request.(GetPatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPat")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPatResponseObject); ok {
+		if err := validResponse.VisitGetPatResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPat operation middleware
+func (sh *strictHandler) PostPat(ctx *gin.Context) {
+	var request PostPatRequestObject
+
+	var body PostPatJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPat(ctx, request.(PostPatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPat")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPatResponseObject); ok {
+		if err := validResponse.VisitPostPatResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePatPatId operation middleware
+func (sh *strictHandler) DeletePatPatId(ctx *gin.Context, patId openapi_types.UUID) {
+	var request DeletePatPatIdRequestObject
+
+	request.PatId = patId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePatPatId(ctx, request.(DeletePatPatIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePatPatId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeletePatPatIdResponseObject); ok {
+		if err := validResponse.VisitDeletePatPatIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReadyz operation middleware
+func (sh *strictHandler) GetReadyz(ctx *gin.Context) {
+	var request GetReadyzRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReadyz(ctx, request.(GetReadyzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReadyz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetReadyzResponseObject); ok {
+		if err := validResponse.VisitGetReadyzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HeadReadyz operation middleware
+func (sh *strictHandler) HeadReadyz(ctx *gin.Context) {
+	var request HeadReadyzRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadReadyz(ctx, request.(HeadReadyzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadReadyz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(HeadReadyzResponseObject); ok {
+		if err := validResponse.VisitHeadReadyzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2Groups operation middleware
+func (sh *strictHandler) GetScimV2Groups(ctx *gin.Context, params GetScimV2GroupsParams) {
+	var request GetScimV2GroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2Groups(ctx, request.(GetScimV2GroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2Groups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetScimV2GroupsResponseObject); ok {
+		if err := validResponse.VisitGetScimV2GroupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostScimV2Groups operation middleware
+func (sh *strictHandler) PostScimV2Groups(ctx *gin.Context) {
+	var request PostScimV2GroupsRequestObject
+
+	var body PostScimV2GroupsApplicationScimPlusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostScimV2Groups(ctx, request.(PostScimV2GroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostScimV2Groups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostScimV2GroupsResponseObject); ok {
+		if err := validResponse.VisitPostScimV2GroupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteScimV2GroupsGroupId operation middleware
+func (sh *strictHandler) DeleteScimV2GroupsGroupId(ctx *gin.Context, groupId string) {
+	var request DeleteScimV2GroupsGroupIdRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteScimV2GroupsGroupId(ctx, request.(DeleteScimV2GroupsGroupIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteScimV2GroupsGroupId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteScimV2GroupsGroupIdResponseObject); ok {
+		if err := validResponse.VisitDeleteScimV2GroupsGroupIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2GroupsGroupId operation middleware
+func (sh *strictHandler) GetScimV2GroupsGroupId(ctx *gin.Context, groupId string) {
+	var request GetScimV2GroupsGroupIdRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2GroupsGroupId(ctx, request.(GetScimV2GroupsGroupIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2GroupsGroupId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetScimV2GroupsGroupIdResponseObject); ok {
+		if err := validResponse.VisitGetScimV2GroupsGroupIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchScimV2GroupsGroupId operation middleware
+func (sh *strictHandler) PatchScimV2GroupsGroupId(ctx *gin.Context, groupId string) {
+	var request PatchScimV2GroupsGroupIdRequestObject
+
+	request.GroupId = groupId
+
+	var body PatchScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchScimV2GroupsGroupId(ctx, request.(PatchScimV2GroupsGroupIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchScimV2GroupsGroupId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchScimV2GroupsGroupIdResponseObject); ok {
+		if err := validResponse.VisitPatchScimV2GroupsGroupIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutScimV2GroupsGroupId operation middleware
+func (sh *strictHandler) PutScimV2GroupsGroupId(ctx *gin.Context, groupId string) {
+	var request PutScimV2GroupsGroupIdRequestObject
+
+	request.GroupId = groupId
+
+	var body PutScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutScimV2GroupsGroupId(ctx, request.(PutScimV2GroupsGroupIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutScimV2GroupsGroupId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutScimV2GroupsGroupIdResponseObject); ok {
+		if err := validResponse.VisitPutScimV2GroupsGroupIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2Users operation middleware
+func (sh *strictHandler) GetScimV2Users(ctx *gin.Context, params GetScimV2UsersParams) {
+	var request GetScimV2UsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2Users(ctx, request.(GetScimV2UsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2Users")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetScimV2UsersResponseObject); ok {
+		if err := validResponse.VisitGetScimV2UsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostScimV2Users operation middleware
+func (sh *strictHandler) PostScimV2Users(ctx *gin.Context) {
+	var request PostScimV2UsersRequestObject
+
+	var body PostScimV2UsersApplicationScimPlusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostScimV2Users(ctx, request.(PostScimV2UsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostScimV2Users")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostScimV2UsersResponseObject); ok {
+		if err := validResponse.VisitPostScimV2UsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteScimV2UsersUserId operation middleware
+func (sh *strictHandler) DeleteScimV2UsersUserId(ctx *gin.Context, userId openapi_types.UUID) {
+	var request DeleteScimV2UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteScimV2UsersUserId(ctx, request.(DeleteScimV2UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteScimV2UsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteScimV2UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitDeleteScimV2UsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScimV2UsersUserId operation middleware
+func (sh *strictHandler) GetScimV2UsersUserId(ctx *gin.Context, userId openapi_types.UUID) {
+	var request GetScimV2UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScimV2UsersUserId(ctx, request.(GetScimV2UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScimV2UsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetScimV2UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitGetScimV2UsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchScimV2UsersUserId operation middleware
+func (sh *strictHandler) PatchScimV2UsersUserId(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PatchScimV2UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	var body PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchScimV2UsersUserId(ctx, request.(PatchScimV2UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchScimV2UsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchScimV2UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPatchScimV2UsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutScimV2UsersUserId operation middleware
+func (sh *strictHandler) PutScimV2UsersUserId(ctx *gin.Context, userId openapi_types.UUID) {
+	var request PutScimV2UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	var body PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutScimV2UsersUserId(ctx, request.(PutScimV2UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutScimV2UsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutScimV2UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPutScimV2UsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninAnonymous operation middleware
+func (sh *strictHandler) PostSigninAnonymous(ctx *gin.Context) {
+	var request PostSigninAnonymousRequestObject
+
+	var body PostSigninAnonymousJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninAnonymous(ctx, request.(PostSigninAnonymousRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninAnonymous")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninAnonymousResponseObject); ok {
+		if err := validResponse.VisitPostSigninAnonymousResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninEmailPassword operation middleware
+func (sh *strictHandler) PostSigninEmailPassword(ctx *gin.Context) {
+	var request PostSigninEmailPasswordRequestObject
+
+	var body PostSigninEmailPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninEmailPassword(ctx, request.(PostSigninEmailPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninEmailPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninEmailPasswordResponseObject); ok {
+		if err := validResponse.VisitPostSigninEmailPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninIdtoken operation middleware
+func (sh *strictHandler) PostSigninIdtoken(ctx *gin.Context) {
+	var request PostSigninIdtokenRequestObject
+
+	var body PostSigninIdtokenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninIdtoken(ctx, request.(PostSigninIdtokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninIdtoken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninIdtokenResponseObject); ok {
+		if err := validResponse.VisitPostSigninIdtokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninMfaRecoveryCode operation middleware
+func (sh *strictHandler) PostSigninMfaRecoveryCode(ctx *gin.Context) {
+	var request PostSigninMfaRecoveryCodeRequestObject
+
+	var body PostSigninMfaRecoveryCodeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninMfaRecoveryCode(ctx, request.(PostSigninMfaRecoveryCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninMfaRecoveryCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninMfaRecoveryCodeResponseObject); ok {
+		if err := validResponse.VisitPostSigninMfaRecoveryCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninMfaTotp operation middleware
+func (sh *strictHandler) PostSigninMfaTotp(ctx *gin.Context) {
+	var request PostSigninMfaTotpRequestObject
+
+	var body PostSigninMfaTotpJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninMfaTotp(ctx, request.(PostSigninMfaTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninMfaTotp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninMfaTotpResponseObject); ok {
+		if err := validResponse.VisitPostSigninMfaTotpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninPasswordlessEmail operation middleware
+func (sh *strictHandler) PostSigninPasswordlessEmail(ctx *gin.Context) {
+	var request PostSigninPasswordlessEmailRequestObject
+
+	var body PostSigninPasswordlessEmailJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninPasswordlessEmail(ctx, request.(PostSigninPasswordlessEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninPasswordlessEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninPasswordlessEmailResponseObject); ok {
+		if err := validResponse.VisitPostSigninPasswordlessEmailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninPasswordlessSms operation middleware
+func (sh *strictHandler) PostSigninPasswordlessSms(ctx *gin.Context) {
+	var request PostSigninPasswordlessSmsRequestObject
+
+	var body PostSigninPasswordlessSmsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninPasswordlessSms(ctx, request.(PostSigninPasswordlessSmsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninPasswordlessSms")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninPasswordlessSmsResponseObject); ok {
+		if err := validResponse.VisitPostSigninPasswordlessSmsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninPasswordlessSmsOtp operation middleware
+func (sh *strictHandler) PostSigninPasswordlessSmsOtp(ctx *gin.Context) {
+	var request PostSigninPasswordlessSmsOtpRequestObject
+
+	var body PostSigninPasswordlessSmsOtpJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninPasswordlessSmsOtp(ctx, request.(PostSigninPasswordlessSmsOtpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninPasswordlessSmsOtp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninPasswordlessSmsOtpResponseObject); ok {
+		if err := validResponse.VisitPostSigninPasswordlessSmsOtpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninPat operation middleware
+func (sh *strictHandler) PostSigninPat(ctx *gin.Context) {
+	var request PostSigninPatRequestObject
+
+	var body PostSigninPatJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninPat(ctx, request.(PostSigninPatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninPat")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninPatResponseObject); ok {
+		if err := validResponse.VisitPostSigninPatResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSigninProviderProvider operation middleware
+func (sh *strictHandler) GetSigninProviderProvider(ctx *gin.Context, provider string, params GetSigninProviderProviderParams) {
+	var request GetSigninProviderProviderRequestObject
+
+	request.Provider = provider
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSigninProviderProvider(ctx, request.(GetSigninProviderProviderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSigninProviderProvider")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSigninProviderProviderResponseObject); ok {
+		if err := validResponse.VisitGetSigninProviderProviderResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSigninProviderProviderCallback operation middleware
+func (sh *strictHandler) GetSigninProviderProviderCallback(ctx *gin.Context, provider string, params GetSigninProviderProviderCallbackParams) {
+	var request GetSigninProviderProviderCallbackRequestObject
+
+	request.Provider = provider
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSigninProviderProviderCallback(ctx, request.(GetSigninProviderProviderCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSigninProviderProviderCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSigninProviderProviderCallbackResponseObject); ok {
+		if err := validResponse.VisitGetSigninProviderProviderCallbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninProviderProviderCallback operation middleware
+func (sh *strictHandler) PostSigninProviderProviderCallback(ctx *gin.Context, provider string, params PostSigninProviderProviderCallbackParams) {
+	var request PostSigninProviderProviderCallbackRequestObject
+
+	request.Provider = provider
+	request.Params = params
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.Error(err)
+		return
+	}
+	var body PostSigninProviderProviderCallbackFormdataRequestBody
+	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninProviderProviderCallback(ctx, request.(PostSigninProviderProviderCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninProviderProviderCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninProviderProviderCallbackResponseObject); ok {
+		if err := validResponse.VisitPostSigninProviderProviderCallbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSigninSaml operation middleware
+func (sh *strictHandler) GetSigninSaml(ctx *gin.Context, params GetSigninSamlParams) {
+	var request GetSigninSamlRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSigninSaml(ctx, request.(GetSigninSamlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSigninSaml")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSigninSamlResponseObject); ok {
+		if err := validResponse.VisitGetSigninSamlResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninSamlAcs operation middleware
+func (sh *strictHandler) PostSigninSamlAcs(ctx *gin.Context) {
+	var request PostSigninSamlAcsRequestObject
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.Error(err)
+		return
+	}
+	var body PostSigninSamlAcsFormdataRequestBody
+	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninSamlAcs(ctx, request.(PostSigninSamlAcsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninSamlAcs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninSamlAcsResponseObject); ok {
+		if err := validResponse.VisitPostSigninSamlAcsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSigninSamlMetadata operation middleware
+func (sh *strictHandler) GetSigninSamlMetadata(ctx *gin.Context) {
+	var request GetSigninSamlMetadataRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSigninSamlMetadata(ctx, request.(GetSigninSamlMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSigninSamlMetadata")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSigninSamlMetadataResponseObject); ok {
+		if err := validResponse.VisitGetSigninSamlMetadataResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninWebauthn operation middleware
+func (sh *strictHandler) PostSigninWebauthn(ctx *gin.Context) {
+	var request PostSigninWebauthnRequestObject
+
+	var body PostSigninWebauthnJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninWebauthn(ctx, request.(PostSigninWebauthnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninWebauthn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninWebauthnResponseObject); ok {
+		if err := validResponse.VisitPostSigninWebauthnResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSigninWebauthnVerify operation middleware
+func (sh *strictHandler) PostSigninWebauthnVerify(ctx *gin.Context) {
+	var request PostSigninWebauthnVerifyRequestObject
+
+	var body PostSigninWebauthnVerifyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSigninWebauthnVerify(ctx, request.(PostSigninWebauthnVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSigninWebauthnVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSigninWebauthnVerifyResponseObject); ok {
+		if err := validResponse.VisitPostSigninWebauthnVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSignupEmailPassword operation middleware
+func (sh *strictHandler) PostSignupEmailPassword(ctx *gin.Context) {
+	var request PostSignupEmailPasswordRequestObject
+
+	var body PostSignupEmailPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSignupEmailPassword(ctx, request.(PostSignupEmailPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSignupEmailPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSignupEmailPasswordResponseObject); ok {
+		if err := validResponse.VisitPostSignupEmailPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSignupWebauthn operation middleware
+func (sh *strictHandler) PostSignupWebauthn(ctx *gin.Context) {
+	var request PostSignupWebauthnRequestObject
+
+	var body PostSignupWebauthnJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSignupWebauthn(ctx, request.(PostSignupWebauthnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSignupWebauthn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSignupWebauthnResponseObject); ok {
+		if err := validResponse.VisitPostSignupWebauthnResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostSignupWebauthnVerify operation middleware
+func (sh *strictHandler) PostSignupWebauthnVerify(ctx *gin.Context) {
+	var request PostSignupWebauthnVerifyRequestObject
+
+	var body PostSignupWebauthnVerifyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSignupWebauthnVerify(ctx, request.(PostSignupWebauthnVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSignupWebauthnVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostSignupWebauthnVerifyResponseObject); ok {
+		if err := validResponse.VisitPostSignupWebauthnVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostToken operation middleware
+func (sh *strictHandler) PostToken(ctx *gin.Context) {
+	var request PostTokenRequestObject
+
+	var body PostTokenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostToken(ctx, request.(PostTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTokenResponseObject); ok {
+		if err := validResponse.VisitPostTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserAvatar operation middleware
+func (sh *strictHandler) DeleteUserAvatar(ctx *gin.Context) {
+	var request DeleteUserAvatarRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserAvatar(ctx, request.(DeleteUserAvatarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserAvatar")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteUserAvatarResponseObject); ok {
+		if err := validResponse.VisitDeleteUserAvatarResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutUserAvatar operation middleware
+func (sh *strictHandler) PutUserAvatar(ctx *gin.Context) {
+	var request PutUserAvatarRequestObject
+
+	request.ContentType = ctx.ContentType()
+
+	request.Body = ctx.Request.Body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUserAvatar(ctx, request.(PutUserAvatarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUserAvatar")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutUserAvatarResponseObject); ok {
+		if err := validResponse.VisitPutUserAvatarResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUserDataExport operation middleware
+func (sh *strictHandler) PostUserDataExport(ctx *gin.Context) {
+	var request PostUserDataExportRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealthz(ctx, request.(GetHealthzRequestObject))
+		return sh.ssi.PostUserDataExport(ctx, request.(PostUserDataExportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealthz")
+		handler = middleware(handler, "PostUserDataExport")
 	}
 
 	response, err := handler(ctx, request)
@@ -671,8 +8439,8 @@ func (sh *strictHandler) GetHealthz(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetHealthzResponseObject); ok {
-		if err := validResponse.VisitGetHealthzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserDataExportResponseObject); ok {
+		if err := validResponse.VisitPostUserDataExportResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -680,15 +8448,17 @@ func (sh *strictHandler) GetHealthz(ctx *gin.Context) {
 	}
 }
 
-// HeadHealthz operation middleware
-func (sh *strictHandler) HeadHealthz(ctx *gin.Context) {
-	var request HeadHealthzRequestObject
+// GetUserDataExportExportId operation middleware
+func (sh *strictHandler) GetUserDataExportExportId(ctx *gin.Context, exportId openapi_types.UUID) {
+	var request GetUserDataExportExportIdRequestObject
+
+	request.ExportId = exportId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HeadHealthz(ctx, request.(HeadHealthzRequestObject))
+		return sh.ssi.GetUserDataExportExportId(ctx, request.(GetUserDataExportExportIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HeadHealthz")
+		handler = middleware(handler, "GetUserDataExportExportId")
 	}
 
 	response, err := handler(ctx, request)
@@ -696,8 +8466,8 @@ func (sh *strictHandler) HeadHealthz(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HeadHealthzResponseObject); ok {
-		if err := validResponse.VisitHeadHealthzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUserDataExportExportIdResponseObject); ok {
+		if err := validResponse.VisitGetUserDataExportExportIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -705,11 +8475,11 @@ func (sh *strictHandler) HeadHealthz(ctx *gin.Context) {
 	}
 }
 
-// PostPat operation middleware
-func (sh *strictHandler) PostPat(ctx *gin.Context) {
-	var request PostPatRequestObject
+// PostUserDeanonymize operation middleware
+func (sh *strictHandler) PostUserDeanonymize(ctx *gin.Context) {
+	var request PostUserDeanonymizeRequestObject
 
-	var body PostPatJSONRequestBody
+	var body PostUserDeanonymizeJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -718,10 +8488,10 @@ func (sh *strictHandler) PostPat(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPat(ctx, request.(PostPatRequestObject))
+		return sh.ssi.PostUserDeanonymize(ctx, request.(PostUserDeanonymizeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPat")
+		handler = middleware(handler, "PostUserDeanonymize")
 	}
 
 	response, err := handler(ctx, request)
@@ -729,8 +8499,8 @@ func (sh *strictHandler) PostPat(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPatResponseObject); ok {
-		if err := validResponse.VisitPostPatResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserDeanonymizeResponseObject); ok {
+		if err := validResponse.VisitPostUserDeanonymizeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -738,11 +8508,13 @@ func (sh *strictHandler) PostPat(ctx *gin.Context) {
 	}
 }
 
-// PostSigninEmailPassword operation middleware
-func (sh *strictHandler) PostSigninEmailPassword(ctx *gin.Context) {
-	var request PostSigninEmailPasswordRequestObject
+// PostUserDeanonymizeProviderProvider operation middleware
+func (sh *strictHandler) PostUserDeanonymizeProviderProvider(ctx *gin.Context, provider string) {
+	var request PostUserDeanonymizeProviderProviderRequestObject
 
-	var body PostSigninEmailPasswordJSONRequestBody
+	request.Provider = provider
+
+	var body PostUserDeanonymizeProviderProviderJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -751,10 +8523,10 @@ func (sh *strictHandler) PostSigninEmailPassword(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSigninEmailPassword(ctx, request.(PostSigninEmailPasswordRequestObject))
+		return sh.ssi.PostUserDeanonymizeProviderProvider(ctx, request.(PostUserDeanonymizeProviderProviderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSigninEmailPassword")
+		handler = middleware(handler, "PostUserDeanonymizeProviderProvider")
 	}
 
 	response, err := handler(ctx, request)
@@ -762,8 +8534,8 @@ func (sh *strictHandler) PostSigninEmailPassword(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSigninEmailPasswordResponseObject); ok {
-		if err := validResponse.VisitPostSigninEmailPasswordResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserDeanonymizeProviderProviderResponseObject); ok {
+		if err := validResponse.VisitPostUserDeanonymizeProviderProviderResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -771,11 +8543,11 @@ func (sh *strictHandler) PostSigninEmailPassword(ctx *gin.Context) {
 	}
 }
 
-// PostSigninPasswordlessEmail operation middleware
-func (sh *strictHandler) PostSigninPasswordlessEmail(ctx *gin.Context) {
-	var request PostSigninPasswordlessEmailRequestObject
+// PostUserDelete operation middleware
+func (sh *strictHandler) PostUserDelete(ctx *gin.Context) {
+	var request PostUserDeleteRequestObject
 
-	var body PostSigninPasswordlessEmailJSONRequestBody
+	var body PostUserDeleteJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -784,10 +8556,10 @@ func (sh *strictHandler) PostSigninPasswordlessEmail(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSigninPasswordlessEmail(ctx, request.(PostSigninPasswordlessEmailRequestObject))
+		return sh.ssi.PostUserDelete(ctx, request.(PostUserDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSigninPasswordlessEmail")
+		handler = middleware(handler, "PostUserDelete")
 	}
 
 	response, err := handler(ctx, request)
@@ -795,8 +8567,8 @@ func (sh *strictHandler) PostSigninPasswordlessEmail(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSigninPasswordlessEmailResponseObject); ok {
-		if err := validResponse.VisitPostSigninPasswordlessEmailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserDeleteResponseObject); ok {
+		if err := validResponse.VisitPostUserDeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -804,11 +8576,11 @@ func (sh *strictHandler) PostSigninPasswordlessEmail(ctx *gin.Context) {
 	}
 }
 
-// PostSigninPat operation middleware
-func (sh *strictHandler) PostSigninPat(ctx *gin.Context) {
-	var request PostSigninPatRequestObject
+// PostUserEmailChange operation middleware
+func (sh *strictHandler) PostUserEmailChange(ctx *gin.Context) {
+	var request PostUserEmailChangeRequestObject
 
-	var body PostSigninPatJSONRequestBody
+	var body PostUserEmailChangeJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -817,10 +8589,10 @@ func (sh *strictHandler) PostSigninPat(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSigninPat(ctx, request.(PostSigninPatRequestObject))
+		return sh.ssi.PostUserEmailChange(ctx, request.(PostUserEmailChangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSigninPat")
+		handler = middleware(handler, "PostUserEmailChange")
 	}
 
 	response, err := handler(ctx, request)
@@ -828,8 +8600,8 @@ func (sh *strictHandler) PostSigninPat(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSigninPatResponseObject); ok {
-		if err := validResponse.VisitPostSigninPatResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserEmailChangeResponseObject); ok {
+		if err := validResponse.VisitPostUserEmailChangeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -837,11 +8609,11 @@ func (sh *strictHandler) PostSigninPat(ctx *gin.Context) {
 	}
 }
 
-// PostSignupEmailPassword operation middleware
-func (sh *strictHandler) PostSignupEmailPassword(ctx *gin.Context) {
-	var request PostSignupEmailPasswordRequestObject
+// PostUserEmailSendVerificationEmail operation middleware
+func (sh *strictHandler) PostUserEmailSendVerificationEmail(ctx *gin.Context) {
+	var request PostUserEmailSendVerificationEmailRequestObject
 
-	var body PostSignupEmailPasswordJSONRequestBody
+	var body PostUserEmailSendVerificationEmailJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -850,10 +8622,10 @@ func (sh *strictHandler) PostSignupEmailPassword(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSignupEmailPassword(ctx, request.(PostSignupEmailPasswordRequestObject))
+		return sh.ssi.PostUserEmailSendVerificationEmail(ctx, request.(PostUserEmailSendVerificationEmailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSignupEmailPassword")
+		handler = middleware(handler, "PostUserEmailSendVerificationEmail")
 	}
 
 	response, err := handler(ctx, request)
@@ -861,8 +8633,8 @@ func (sh *strictHandler) PostSignupEmailPassword(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSignupEmailPasswordResponseObject); ok {
-		if err := validResponse.VisitPostSignupEmailPasswordResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserEmailSendVerificationEmailResponseObject); ok {
+		if err := validResponse.VisitPostUserEmailSendVerificationEmailResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -870,11 +8642,11 @@ func (sh *strictHandler) PostSignupEmailPassword(ctx *gin.Context) {
 	}
 }
 
-// PostSignupWebauthn operation middleware
-func (sh *strictHandler) PostSignupWebauthn(ctx *gin.Context) {
-	var request PostSignupWebauthnRequestObject
+// PatchUserMetadata operation middleware
+func (sh *strictHandler) PatchUserMetadata(ctx *gin.Context) {
+	var request PatchUserMetadataRequestObject
 
-	var body PostSignupWebauthnJSONRequestBody
+	var body PatchUserMetadataJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -883,10 +8655,10 @@ func (sh *strictHandler) PostSignupWebauthn(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSignupWebauthn(ctx, request.(PostSignupWebauthnRequestObject))
+		return sh.ssi.PatchUserMetadata(ctx, request.(PatchUserMetadataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSignupWebauthn")
+		handler = middleware(handler, "PatchUserMetadata")
 	}
 
 	response, err := handler(ctx, request)
@@ -894,8 +8666,8 @@ func (sh *strictHandler) PostSignupWebauthn(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSignupWebauthnResponseObject); ok {
-		if err := validResponse.VisitPostSignupWebauthnResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchUserMetadataResponseObject); ok {
+		if err := validResponse.VisitPatchUserMetadataResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -903,11 +8675,11 @@ func (sh *strictHandler) PostSignupWebauthn(ctx *gin.Context) {
 	}
 }
 
-// PostSignupWebauthnVerify operation middleware
-func (sh *strictHandler) PostSignupWebauthnVerify(ctx *gin.Context) {
-	var request PostSignupWebauthnVerifyRequestObject
+// PostUserPasswordReset operation middleware
+func (sh *strictHandler) PostUserPasswordReset(ctx *gin.Context) {
+	var request PostUserPasswordResetRequestObject
 
-	var body PostSignupWebauthnVerifyJSONRequestBody
+	var body PostUserPasswordResetJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -916,10 +8688,10 @@ func (sh *strictHandler) PostSignupWebauthnVerify(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSignupWebauthnVerify(ctx, request.(PostSignupWebauthnVerifyRequestObject))
+		return sh.ssi.PostUserPasswordReset(ctx, request.(PostUserPasswordResetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSignupWebauthnVerify")
+		handler = middleware(handler, "PostUserPasswordReset")
 	}
 
 	response, err := handler(ctx, request)
@@ -927,8 +8699,8 @@ func (sh *strictHandler) PostSignupWebauthnVerify(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostSignupWebauthnVerifyResponseObject); ok {
-		if err := validResponse.VisitPostSignupWebauthnVerifyResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostUserPasswordResetResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -936,11 +8708,11 @@ func (sh *strictHandler) PostSignupWebauthnVerify(ctx *gin.Context) {
 	}
 }
 
-// PostToken operation middleware
-func (sh *strictHandler) PostToken(ctx *gin.Context) {
-	var request PostTokenRequestObject
+// PostUserPhoneNumberChange operation middleware
+func (sh *strictHandler) PostUserPhoneNumberChange(ctx *gin.Context) {
+	var request PostUserPhoneNumberChangeRequestObject
 
-	var body PostTokenJSONRequestBody
+	var body PostUserPhoneNumberChangeJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -949,10 +8721,10 @@ func (sh *strictHandler) PostToken(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostToken(ctx, request.(PostTokenRequestObject))
+		return sh.ssi.PostUserPhoneNumberChange(ctx, request.(PostUserPhoneNumberChangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostToken")
+		handler = middleware(handler, "PostUserPhoneNumberChange")
 	}
 
 	response, err := handler(ctx, request)
@@ -960,8 +8732,8 @@ func (sh *strictHandler) PostToken(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostTokenResponseObject); ok {
-		if err := validResponse.VisitPostTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserPhoneNumberChangeResponseObject); ok {
+		if err := validResponse.VisitPostUserPhoneNumberChangeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -969,11 +8741,11 @@ func (sh *strictHandler) PostToken(ctx *gin.Context) {
 	}
 }
 
-// PostUserDeanonymize operation middleware
-func (sh *strictHandler) PostUserDeanonymize(ctx *gin.Context) {
-	var request PostUserDeanonymizeRequestObject
+// PostUserPhoneNumberChangeVerify operation middleware
+func (sh *strictHandler) PostUserPhoneNumberChangeVerify(ctx *gin.Context) {
+	var request PostUserPhoneNumberChangeVerifyRequestObject
 
-	var body PostUserDeanonymizeJSONRequestBody
+	var body PostUserPhoneNumberChangeVerifyJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -982,10 +8754,10 @@ func (sh *strictHandler) PostUserDeanonymize(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUserDeanonymize(ctx, request.(PostUserDeanonymizeRequestObject))
+		return sh.ssi.PostUserPhoneNumberChangeVerify(ctx, request.(PostUserPhoneNumberChangeVerifyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUserDeanonymize")
+		handler = middleware(handler, "PostUserPhoneNumberChangeVerify")
 	}
 
 	response, err := handler(ctx, request)
@@ -993,8 +8765,8 @@ func (sh *strictHandler) PostUserDeanonymize(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUserDeanonymizeResponseObject); ok {
-		if err := validResponse.VisitPostUserDeanonymizeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserPhoneNumberChangeVerifyResponseObject); ok {
+		if err := validResponse.VisitPostUserPhoneNumberChangeVerifyResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1002,23 +8774,42 @@ func (sh *strictHandler) PostUserDeanonymize(ctx *gin.Context) {
 	}
 }
 
-// PostUserEmailChange operation middleware
-func (sh *strictHandler) PostUserEmailChange(ctx *gin.Context) {
-	var request PostUserEmailChangeRequestObject
+// GetUserProviders operation middleware
+func (sh *strictHandler) GetUserProviders(ctx *gin.Context) {
+	var request GetUserProvidersRequestObject
 
-	var body PostUserEmailChangeJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserProviders(ctx, request.(GetUserProvidersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserProviders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserProvidersResponseObject); ok {
+		if err := validResponse.VisitGetUserProvidersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// DeleteUserProvidersProvider operation middleware
+func (sh *strictHandler) DeleteUserProvidersProvider(ctx *gin.Context, provider string) {
+	var request DeleteUserProvidersProviderRequestObject
+
+	request.Provider = provider
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUserEmailChange(ctx, request.(PostUserEmailChangeRequestObject))
+		return sh.ssi.DeleteUserProvidersProvider(ctx, request.(DeleteUserProvidersProviderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUserEmailChange")
+		handler = middleware(handler, "DeleteUserProvidersProvider")
 	}
 
 	response, err := handler(ctx, request)
@@ -1026,8 +8817,8 @@ func (sh *strictHandler) PostUserEmailChange(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUserEmailChangeResponseObject); ok {
-		if err := validResponse.VisitPostUserEmailChangeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteUserProvidersProviderResponseObject); ok {
+		if err := validResponse.VisitDeleteUserProvidersProviderResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1035,11 +8826,13 @@ func (sh *strictHandler) PostUserEmailChange(ctx *gin.Context) {
 	}
 }
 
-// PostUserEmailSendVerificationEmail operation middleware
-func (sh *strictHandler) PostUserEmailSendVerificationEmail(ctx *gin.Context) {
-	var request PostUserEmailSendVerificationEmailRequestObject
+// PostUserProvidersProviderLink operation middleware
+func (sh *strictHandler) PostUserProvidersProviderLink(ctx *gin.Context, provider string) {
+	var request PostUserProvidersProviderLinkRequestObject
 
-	var body PostUserEmailSendVerificationEmailJSONRequestBody
+	request.Provider = provider
+
+	var body PostUserProvidersProviderLinkJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1048,10 +8841,10 @@ func (sh *strictHandler) PostUserEmailSendVerificationEmail(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUserEmailSendVerificationEmail(ctx, request.(PostUserEmailSendVerificationEmailRequestObject))
+		return sh.ssi.PostUserProvidersProviderLink(ctx, request.(PostUserProvidersProviderLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUserEmailSendVerificationEmail")
+		handler = middleware(handler, "PostUserProvidersProviderLink")
 	}
 
 	response, err := handler(ctx, request)
@@ -1059,8 +8852,8 @@ func (sh *strictHandler) PostUserEmailSendVerificationEmail(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUserEmailSendVerificationEmailResponseObject); ok {
-		if err := validResponse.VisitPostUserEmailSendVerificationEmailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserProvidersProviderLinkResponseObject); ok {
+		if err := validResponse.VisitPostUserProvidersProviderLinkResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1068,11 +8861,113 @@ func (sh *strictHandler) PostUserEmailSendVerificationEmail(ctx *gin.Context) {
 	}
 }
 
-// PostUserPasswordReset operation middleware
-func (sh *strictHandler) PostUserPasswordReset(ctx *gin.Context) {
-	var request PostUserPasswordResetRequestObject
+// GetUserSessions operation middleware
+func (sh *strictHandler) GetUserSessions(ctx *gin.Context) {
+	var request GetUserSessionsRequestObject
 
-	var body PostUserPasswordResetJSONRequestBody
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserSessions(ctx, request.(GetUserSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserSessionsResponseObject); ok {
+		if err := validResponse.VisitGetUserSessionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUserSessionsRevokeAll operation middleware
+func (sh *strictHandler) PostUserSessionsRevokeAll(ctx *gin.Context) {
+	var request PostUserSessionsRevokeAllRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUserSessionsRevokeAll(ctx, request.(PostUserSessionsRevokeAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUserSessionsRevokeAll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUserSessionsRevokeAllResponseObject); ok {
+		if err := validResponse.VisitPostUserSessionsRevokeAllResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserSessionsSessionId operation middleware
+func (sh *strictHandler) DeleteUserSessionsSessionId(ctx *gin.Context, sessionId openapi_types.UUID) {
+	var request DeleteUserSessionsSessionIdRequestObject
+
+	request.SessionId = sessionId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserSessionsSessionId(ctx, request.(DeleteUserSessionsSessionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserSessionsSessionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteUserSessionsSessionIdResponseObject); ok {
+		if err := validResponse.VisitDeleteUserSessionsSessionIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUserWebauthnAdd operation middleware
+func (sh *strictHandler) PostUserWebauthnAdd(ctx *gin.Context) {
+	var request PostUserWebauthnAddRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUserWebauthnAdd(ctx, request.(PostUserWebauthnAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUserWebauthnAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUserWebauthnAddResponseObject); ok {
+		if err := validResponse.VisitPostUserWebauthnAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUserWebauthnVerify operation middleware
+func (sh *strictHandler) PostUserWebauthnVerify(ctx *gin.Context) {
+	var request PostUserWebauthnVerifyRequestObject
+
+	var body PostUserWebauthnVerifyJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1081,10 +8976,10 @@ func (sh *strictHandler) PostUserPasswordReset(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUserPasswordReset(ctx, request.(PostUserPasswordResetRequestObject))
+		return sh.ssi.PostUserWebauthnVerify(ctx, request.(PostUserWebauthnVerifyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUserPasswordReset")
+		handler = middleware(handler, "PostUserWebauthnVerify")
 	}
 
 	response, err := handler(ctx, request)
@@ -1092,8 +8987,35 @@ func (sh *strictHandler) PostUserPasswordReset(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUserPasswordResetResponseObject); ok {
-		if err := validResponse.VisitPostUserPasswordResetResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUserWebauthnVerifyResponseObject); ok {
+		if err := validResponse.VisitPostUserWebauthnVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVerify operation middleware
+func (sh *strictHandler) GetVerify(ctx *gin.Context, params GetVerifyParams) {
+	var request GetVerifyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVerify(ctx, request.(GetVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetVerifyResponseObject); ok {
+		if err := validResponse.VisitGetVerifyResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1129,55 +9051,329 @@ func (sh *strictHandler) GetVersion(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbWXfbNvb/Kjjo/38yc4YUVdvTafQ0auq27hLrxE7zkPgBIq5IJCTAAqAVNaPvPgcL",
-	"N5Fa7PGWzDxFIQHw3vu7+4U/4VjkheDAtcKTT1jFKeTE/nwhgWiYTS9fwR8lKG2eEUqZZoKTbCZFAVIz",
-	"UHiyIJmCABetR58wfCyYBDW1+yioWLLCbMUTfGpeEfMfRIkGJBZIp4Bm00sc4IWQOdF4gs2rULMccID1",
-	"qgA8wUpLxhO8DnAOmlCiyXaitCwhwPCR5EUGZhknuTkjX4UF0TjApQIazlfuESmKMM6YOdp/S8zfQ6zx",
-	"eh1gCX+UTALFk7cttq56S4O2zFQhuIIbCo3RvrTOvu8KqGYJH8XHf59/szgO45P58/DkWzgOn//jWxLS",
-	"EzpefE1PjuDoBAe4IFqDNEe9ezd/Ow6fk3Bx9enb9bt387D+78l66+/2rq+PzLYhRAqQyvA4jWNQ6lJ8",
-	"AN7n5QlzsIEzo3iYpyHYT6UU8paQg9k7YCPmMYoFBaRTohGjwDVbMFBWFUhRZCx2NuROCDDwMjekU1iQ",
-	"MtOhFBmEeal0OIeQ8ZBkmVgCtc8VDjBliswzoCFwWgjGdftZqcCemROWhSSTQOjKHFIq6D2+Bmkoo856",
-	"54xS4CHhgq9yUZovMW7gI1moQF6DDCuKGb8mGaOhO64gSi2FpK0X0rueAGciJhmEXOiKD6sXbkeohQhV",
-	"KqRuP2Q8TNm8CI2fmBNLtwTKJMT6UmycZGXVfaRYwssirCRiPAavOK3EY/5x2zrcOuKdm2lYWUhQaag3",
-	"tKjt05QiCfR14acyJxwtJANOs5XDG1WrBw5SmuhSDZxzeTlD7qU/xOhXc4KBKQHZswV/XkNh4LV2yBZ+",
-	"+2H6IiVZBjyBGVllgtAbWoRm8QewYWO3ifp1Q0Sc/3KwNVZWc/7LICjnVnjqVa04N2RGdjY2ni/VulCT",
-	"KHKxZxSLPIqJjtOw2mAgG3JSPV5fOcWy3ul2oVq2TuhrjT8fudefh/fucDSkIBeglGXvRoIi3djWU5bW",
-	"+1OXJ5zZhXVOw7j+5mTA4IIDMbDOA9HSfBCRUqcmKPgwICRapsCRP8msMJHi5zdPOGloc31G9/FtY/IT",
-	"5cQGhMkn/H8SFniCv4qavDrySXX0Wg0417ZObdGgDe3oiW2Hgt/OA6vGOnbxUxnRoFu6YAk/46cmss98",
-	"RL5lIWGO6KvGFNm4itzrtl68FykfqZzp9J88FUqPmGjXFdWGfgZb5R8D36remVQ8Z5zlZY6OUZwSSWIN",
-	"UnUIuNByzBPL9VcmEXl+8q//N9GT8V+BJzrFk+N9Dqwisqbp6lAR3yoJzRdkH9hDcd3kGnemKreuNL+w",
-	"yuPQosNLzQOfgVJWE564kQmXT+3VF5bw14VPvrZYxzahvC4+J69zO4F8pt6q4eCGiZeryl7ZyrWdR7/F",
-	"tj9ko+9VgJmGXA2mZv4BkZKszP99kWxO7CbmvrLrHUCZKjKyemm7SO0NP4uUowsD/NA2V7cOgaSXImwg",
-	"QX5hGxkb63PyscLhqIPK0d20xRZMKu24sqyYWpvUTxxfQ077/gsbpzBvYG4SXf4/v9aWRRPlu0sD/DFM",
-	"ROgfFlJoEYtsNCvnGYt/gdULCbaRRDLbqmSCV7S0doYsL4TUraZpdZCLiCme4ITptJxbeBMRLj1hUf2j",
-	"3rHuUf87SLZY7cXTaWoXzrgmf9++g8TSl0Yt2fsUhzjQB5IsO1/gydubRYYb2Qdn8Qfec2l3ZcNXQ930",
-	"nm6/9qXTTeLBNdFEvpbZoK+PbReeutHDYfOEhwoHD+aP7Ivfq8ZsI6W5EBkQbpYMDhtoNWyoGsBPs9xm",
-	"alp3XAeZ+2LjbpEKDi/LfO6Mpl/FNu93wy/vKpnabGjUttm2xK6Jde1nU1sDN31pY1wD2pJ1VxbDnFds",
-	"Xm3xO9+D69yzP+F2CUYsOPcO0epaISE2LFeId7Xv+/p9gJYsy9AcEEu4kJbUx/MWn2UR4kY0Z/w30KkY",
-	"IOFNyuIUmTUh4yi3q5AWyI+wfN+/N3sqWhX1wEhgczbSJiHYkcEZbbN16YuU8OSW2sZhefrEdKI/INkU",
-	"UU30TrFcAKfObF0/+wtqZuwX0W61aTX3QP+3i8T2HONSMr26MIc5/r4DIkFOS+Mp/C0WG+7s44ZOk9ga",
-	"KpvlpxlcO3+9KZvLlClUjcZRTlbIk4fA70EFyJzZxqYKEIUCOGU8QYIjN+hGCrRmPFEj9IOQiIImLFNI",
-	"AaAqxaYiVqNKwlFSMgoqMjVDVH0lbH0FB/t4M/JhfCF8bNIk1i38sSoLU8u0MfU1zUvz5JlCF26FyQNM",
-	"el3XAvWO9WZQuwB5zWIwrnXajKSMj81YDL5M9V+ZFiROAR2Nxr0PLJfLEbGvR0Imkd+rol/PXpy+vDgN",
-	"j0bjUarzzCYgIHN1vvBf9odMokgtSZKANKK0SyIjHqazmkFLIQ7wNUjXtsZfj8ajsdNc4KRgeIKP7SNX",
-	"21ntilIgmU7/NL8TN5o2xmX91BnFE/wj6J/8EqPFroa0W4/G4woK4M5qm6sb0Xvl8gZnFnuNpplqW5yH",
-	"YWAKOXJdaqbKPCdyhSfYUYjiFOIPRi4kUcbQ3GJ8tQ7MT9pn7icgdDd3d0zIOsBRQaywCqEGxD0TSs/s",
-	"7Qp/QeQ7QVd3Jubexbd11zeZxG59jzD3L5ENoV3a8cCizLIV8mk2ImjmhwfITQ/80L7tNG0/Ycj/vb0y",
-	"9XqDkiNj25noL7Pp5V9b6BnAHHQmJWI82kisdoJ5Ybd02vb3BO6OseQDw7xrercPcCNioIjxEXpZZhny",
-	"UziUA+EKXZ5fzkyi7YZ1xg45AAW6YYaGAMQ4WjKdugwAEU5RKxWusHWINve+OG1w7WDezp2jOufYh3tv",
-	"hnWv2G+dmD0w/ru9+W8kYTHKGP+AFHDt6xb5THmkVEsdduGa7z5nhM4W9gGiAhR/phF8ZEoHiOm6QPXe",
-	"ZYQuU0A+v0MuHTO65Z4Qpzwx4WZLqcCWWgp03Ux6ptz9FKcqCSoLRBCHpX05Qmf2MF8MI9Y0oZC/Zugo",
-	"U6MhvXR39XrFW1c19WG6qO9X+x4trGzcCDnYxezSrsNCQw1TN0aUxY1jRFk8VIzYMkR+2phJSJjSII2x",
-	"DsQF5zmuW0W2MTlftZgU+GR8fGekd+9ED1Fu8UQ5xCnhTOWGlvqyrSXm+cMRY8psp9IJuwZehcOO5xkw",
-	"hLI4MHpa57QzepZFPS06xA6qYdq9msDm7PURMqSBoecAfPXNJBvkdgC1bMTWg6d+NwhKZO1mdRNs3KDz",
-	"QRDqzlSfnpcyQaMsWlnLBkaO/hoeVIOyHSVbxBv5Orh0de9rOzrN9cm7h2PoFvbjwHBAlLCkmgzN3Q9G",
-	"7tapu2W7AUx9A3doaQOP7txfDav/vl9WAd9kchFtpi27kdoYzdwTZlsGQGuP26Pk/TYQteREBzL94Uq+",
-	"X8G3eEOEo/pvVFxazTgi1PYrbXeRJz6KCel+/K0KUhutTaMJcSoU8M0b6G7EMkJvmE09OEUExYIvmMz9",
-	"XyrZD/jc3rdImXJrklK6moEKpERLtZo/rWlpkj0piu0oZb8qteYu96hKA9OdR1UlSw9yMkKeaZMZTisg",
-	"bEQk3YTQVoopUWgOplbzJaPBy5RqhFJpKqvbNZQcJVb56vmBB7n952Z9nI0uhW0ywwN6C7snS/etBzvH",
-	"WU+q03Darwp8i8GAv6vPYCwctuw+ANvKv0QSFOj9YHbGYPeI3+C47VEtuaIIWUk1ttyL1fY5Is3s3W44",
-	"xOSrZk/b4n3XpjL6HqIbVUw9U9k+JPndL/kPJXmDwWeLqGa4OR6NR+OQwvXey/jV9oE5ZA8lz1x1gUr5",
-	"4VQXox9Bo+taCpVA688YNft3AAAA//8AChWclD8AAA==",
+	"H4sIAAAAAAAC/+y9e3fbNvYo+lWwdOaumf5Gkp1H0zZnzTpXcZzWedljOcm8cjwQCUmoKYAlQNua3nz3",
+	"u7DxIEiCL9lOnE77R2ORIF57Y2O/96+jiG9SzgiTYvT015GI1mSD4c9ZFJFUHmcrzOh/sKScHbFLKskp",
+	"+SUnQqomOI6peoGTk4ynJJOUiNHTJU4EGY9S79GvI0mjCwIfxUREGU3Vd6OnozN4jvgSyTVBVI0AYyGy",
+	"wTQZjUfkGm/ShIyejnhtKk8fRo++XTxZPppEjxc/TB5/Tx5NfvjuezyJH8f7ywfx44fk4ePReCS3qepA",
+	"yIyy1ejTp/EoI7/kNCPx6Ok/7dQ+unZ88TOJ5OjTeDSLN5SdYCGueBafEkHkbqvnsFz48w8ZWY6ejv7X",
+	"XrHxe2bX9451s1MS04xE8ozDXMOzOuUJGTiLzHxSbCmJqeRZfYfGo1yQTNTB9TbfLEimwAUN0BWVa4Ac",
+	"9O1B6/FD1yllkqxIVtt384ke6WPbOnfbdLvcygrwhlh0q0662I8Nvn5N2EquR08ffvvteLShzP5+MB6l",
+	"WEqSqd7+7z/x5D+zyT/2Jz+cTz7++Q+dyAZDti5WnBKRciZ2gS78QSXZdKKaG25UYBjOMrwNzrgFPnMi",
+	"iwOyC5hS83UAVOQK2bcWZApbxmiTyxwnyRaR6yjJBb0kGhNt65+wWJcAO5fZPlvBRP8Xz+LJD4//v/9n",
+	"VAVr7RCUuqtNTz2102KNU6UMfiyibJtKtOTZBkvEM/v85KcDpAd075YIZyvOHnYvs7TEPzzEf3iw/4d0",
+	"e/kdyX+g/K/RC/Z6/p/v8j3CyPXDhyePjk/j9ZP/PHnw4Mn7n7/Fjy7nP/N9fv0CP8hDFCAjl/yCzIkQ",
+	"lnTFZInzRDo4lrfjFNojnCSwMGE+9HejGGbBeUIwa6Fvc7piR+wNkWseD8SoiLMlXeWAv1WgfVgTuSYZ",
+	"TGkDnSMqEGF4kZDYAsV2AFdNYNLjkfmguX9NHiPMkKArpjp2pFIPO0ZUojUWSHK0KIYkMcIsRoxLFFOh",
+	"Z4UlynIm6YYE57Jxe9RM6ewkTFsfb9KMX9KYZJMVlet80UnBXBfeNhcb8rEPPHcjFDff8zD++YsbtIxd",
+	"KbWezEBa7Q/cSbPtCI3LeCdINnDW+BJLnL3LEvWjRi0WmJ0SLDhrestIPJNB2DFHH9AVFki3HaMNFUKR",
+	"RVrQD0QF+6M0LUbjkaaYo6ejGEsyKR+Q6uDvmKRJy/gLzBC5TmlGRG1s9Y4KlJJsgxVoeg8dZQRLu/B+",
+	"nxgia9m7+ntDFbyXHi2IqUgTvFVHP/i15qr9yVg+O9z0PcnokjaNRuNSV3lO41BPVMwYZ9sNz0W4nwQL",
+	"OSeE1cHzGguJ1FYVOKDOtibVPEMZWWZErEms3tPM3jq9AZTwCDds9IZIHGOJm4+JzHISOGDpmjOiGeVg",
+	"x9779u21lDnAh5/YV/7ZQAllF2or+GhcUJba+GXKMQ4wjh2fVIgNAL3AdA9Fy/g49kiI2/kqnoW3p3ws",
+	"7JT9HSpjmQe9VhK4KwVn5Foe5JngWR00+rm61ldEGubwWqIUr0hBWLgmOgrx4U2rCNb/klBr6oRXh8AF",
+	"+zLnmXy2LTF9JRATlm9UX6VnhpL4MP8YWNcsj6l8zVdD759Ihrb7w5orwqyOu6YCCEfq1bg4GTxDmCGs",
+	"FkeFzLDkildQYIDm6jkSJMqIvzLDr8Lb4DJ2oO3kkrDAHTjL5ZowSSOj+bjUV0zBoimSN6Es1GVfEpzO",
+	"4jgjQtyI1JWn/ZxITBPH4MO0xyihF5pYq49xAQnADgUKON+IaUVCLgzDC02yDEi6zDN9v9cQlOcy4vpq",
+	"s3ASeRSpdY1HS0yTPAvjnILmbGV2P/j2KMBYHj33pZdilYrW4gXP5VhxCBeMX5VunDAQuqimBbtd49hg",
+	"vA88fyFdNM6csl1JXMJXA4iPGSx0vUgucdKmSSJMZpQItMEyWttTuaSJ1HS9Q4ukux/r+YY24hkGkraj",
+	"zKE5wlbOtcQ5hthFhSSG8PdmTDLHTFdH3ZaufMstc5Zs0SUVdJEQdfeUqJ0o60JSvOnSfVQ22cwmtL0H",
+	"IAWekoTjeEdUi9aYrUKC3SG7pBlnG7WHlzijiqsQ6GrNhda4XOIkJ7ALROGNIiaDOB+6YjzrP7BcY4nM",
+	"ZNEil0rUVOIIQRksX8FBrskWXRCSGoZUT1FJ71Ytkl3SSH0hJM6kGDDfCkzsrhXLCIIHKMyxumIeHiSU",
+	"sB012DhJ+BWJTy2v6NDpnyOzpJGaXsrVmj62LWpD2ZF++aAOkYr0412BbpCAxOSBzv/mFKajMKP4uqrY",
+	"rfW2yjCTZ9uUBLjuH9U7zXJHsJegbMgFsdwehrsISX5BmJii53o16gHCuVzzzJgvziMeE6XwM/0YRXpm",
+	"FP/o3emR0NciNy3Oo4zEhEmKEwEfwhUr1yQTU3S2JnpMpSgEtECwDF+1BQNAo0PT5pBZLt0DZn20EjQd",
+	"31dvNh7Vlxi8i1sRwG7Bu4wGAAD7Uqh54OTp9iD2oCtLkw14FOME8No4bdBxStjRc3TAGVNHpLT4tZSp",
+	"eLq3h9N0ah5PI77Zi3CSLHB0UdqK4rrPaAgtqysTFzQ9UNTRsoBtytSySgtnxBN7JfeXqBbFc2lIIBZK",
+	"ADT4QTMU6QHH8GhJMyEnKc7kFuE0TQzDKYJaxSCq9J535Yg4tNT8uXdIClOSmmHMr9hERDwlMeKMiG6F",
+	"XVkuLFGpvuRwtzsLPtZMY0FxdrNHjk1vcy2D1IlmlfLbsSsftizYM53uRv+Z0Sg1q5h982yJ35hFG9Jp",
+	"UmtfMSvLktXlnczObp29O1SvtEAWY+lWeTI7m6r/CXfwQJohlyQzTGBvFq+v1OV20kJhtNlOUiy1NBBP",
+	"Flv9CKfpJEroKGRS6QbfyexsjGLvwlIP1WeK5FApkJ2uUYpmwHhxVjagupl13LOf2mG505GkrRLcyexs",
+	"NB5+VAtT77/+tfjn/uQHPFl+/PX7T//612Lifj7+1Pi3/9WDh+qzECqkJBNqjTMgjWeKMgZ0fvd3BSHZ",
+	"NrSm0BF+jiU+vFac2mAzn9qIgSqYXTTy/Iop9t6YPqocyWt1WGwbQDUCqzEymSCKREQEUWDH3KQ116Y+",
+	"jziTmDKBsL3ktUcKMICGQiG8lATUWGueZ2OnWtRDIbzClMENikEw4iy4kj7SrOmRChQTmGhvetZTFSUk",
+	"lnmnRqHAirluX1LT7KBqMR+78X1UaEfLuZuw5X5TwmItzDtwGv1TSQor1vycKPHjgMdkR9oWuw4CCmcl",
+	"SyjQ6UaKnQICnvIkcaKCMYyA1XmTC5BaL0gqPcXnjbkYg15HrE3bI0jEWWyEKB4Tzd1e4oQC3+pjG2Xy",
+	"yeOABmgMf2aXIbXSG8roJt8gFhzQ7BBswBWmahfkFSEM9krxz5lmI0S/aSic6oCJaoIYITGAhKh5Wy+D",
+	"S7BuGKWvMQIUQPjw/OWzyZuXP52Fdtr/9F1Gw2TJXHztw1iRR/MPIO3oTeox7IFB/mHDI8qiJI+tog82",
+	"CGTFXtP6P3bP/9KyQTUZwR0eD2b1XWxeoI/bHvYF6QYMBtfdbjxp21HXncN2OT05WmyR2Zy92j7eioOk",
+	"N6PmFYOtbrvbknGqDHmk3VsHEMW09E8z6OChW++hvr8YJXFQuO04uMaCpffWHwn+Vm5f6mqOsCBAvKz+",
+	"refxDZjjDELafQjt8mFCLvGu3rdcpvWlHjOiTevOcW1FGMmwLNaNC8sUh80fIzv1kl+G8mM6Oz47QW9e",
+	"zKzGqbQdDx4+evztk1GLj13QkJoRJhv86hrngcPucQ0egD3kksMs49mO9zaYtALCpXqsjzEolSlo0ZbU",
+	"ILannNFGMc8uaUS0ScYTMlEX2WRBJpRNjOpjYk3j1gg/ISxOOWW+YX5ijJtgk5vgJCM43qpOckFqjy8L",
+	"I/ySZwsax4RNsGdqB3LIcDJRWlaSTeyMKYNbfaK784BiX5jL1jkDTBiXdh2jAjMmkvOJWPNM+g8pm6zp",
+	"Ip0okXSBhVY/W7/pSk+wV+VHitPO04nnqpAzu1K7Peof/VlptXryWswtlgJ+KBNQannPjW958UCdxPEo",
+	"wkz1KwiLJ2Ljd3tFFurQsYkgUZ5RuZ1ckK0Pus0ST6TuhXH4a+JYOPhl4abM4JegeFFfbFO9A0ueMzgY",
+	"mpzEkyjBdDNxBKmYiZAYbj6u5jNxXoJV6Aq8SSaZPR2FS4abh3ZK8d+oebinCRZyYgzcE+emZ3unsdvS",
+	"knZ5UrDgIuFXk1ibYPUt7X0DsufE3QS2W4CsuSwNZ1zaHQd5+yDFsvTbdqT1b04RV+6E2SkTr6HbtxwI",
+	"jJuqPiWlMZWhfKIZ2fohLZ2OhLNV9dkVwRf+M2OBnKgTkEVYkNDLPE2bX8Z0RWXohdhuFjypnM6YsG1C",
+	"RekDK+pOnNcZ55MNZtuJx3hbZEh4dFGCWoRTGa2xepKGj3NGfgZbgH/m7XbCA7uN5JrqweCp21RFTCZa",
+	"Ag6CG6wq3m+DXxaGBX00kQW+TrTUYdGy1MR8Zrt3GkLtVMOBBLi9y1lMEnpJstJTNzXwtXJOVxpNcLby",
+	"UT6hGyonGcHRWm/0JuUCTMgTmeFLovrLqLjYTgoPFAeMio+0I1CVsxy0ABEh8CrAff2UbzBDy4wSFiu3",
+	"d7gmbetWXUKln7OzE6Rfmk7MYe9wJnC6gWJM+DzIkR1tjIpLkt0dDGjRSfxsW1+Jcm+iAhXNfLlpjOiU",
+	"TH33mmXh0mSN/1N0BOocQaSVPK8nayzyDE/80SeLLYLLAJjbjEQ8iwuXeJzHVKKEr0pMFQz0/7I1F3JK",
+	"eXdARTgk51jpyeAsI7mmAsJyxuhqTaO101VwVoraKYUVTNEsScKvgD83ZKLshlEsohyZ0KRDKsOpHR/A",
+	"yrITv4jbFMAv58dv0QeyQPAe/enlh7NvQqfC6+TQV8n01Gh0qea0k2Flf/yJN8zA9N6wdTyTquNDyzAP",
+	"2DTn1FzbiQb2u+REc4XBqZzCFCpii0YhfWcgd2fUhkkoC6D1a1rg7ILH2yIaQZ9d9dea4FirqQ7m75XT",
+	"ExHGkZegB930CgbuoFFmY8ULA33fpZPFPwvOPOnCPYjEZZB0ex3eRCTq71pWRY2QG48FXWe4ogfkOu4r",
+	"A33apxcQ2a5IRny8GSNBjAtjD681byJ22LHdmSAcmcy4SEm0o/uODFOUmWeK99zpzQPJEXXjhvAemp2r",
+	"x+drGvJsVX409ghA47H2+JQcJZxfICpRnqIlFpL4Mq4mH+eWrTKzMr8/dsbzNpqa/F3ckTyDTNWqp9J7",
+	"R4XRaWtNEfiqGP+0ujoKrl0xzPv2J7jB9Y3trjzftSLkP0uuA3qgMxteYV2IjCsjZU53LiiLdBuS8mjd",
+	"U0mPZedgKuCHCpGT+BbGEwFO8Eh1nrXvj8dP5oteXsB68gui5C6h4y1aDkevcwHGwjQjwjiMllDJyfK9",
+	"Tkhhez0vtes8OWaY0NF5+eHVYI/BVYDgJCueUbnewPouyFatDkiCuhxLd+/p/GFYYxhll0Fl4SVs6eGB",
+	"6rbs8XoyaeiKBP0+4AZSfZ3OZ/XOZn+dPQv1dRHyP3hFtujoebC53IabQ8vyRsxCHQTI+Rse50kuKlMP",
+	"ubsHsJxJwhTDnwuHmlr1VIpDCPV3Xe/tbyjiPIspw7ICldrXgW34e9+vK/ir9lQvbwzop4HSgM5zMvQS",
+	"hTn05VvUgely4YUOQ9N782J2sMZJQtiKnOCt8izYOd/Gzskv3izxKYn4Jcm2yj4hDni+s7tcpnh0pibQ",
+	"wl1lZjRjFwY2a40viXayJkwTim3ZWv1gvzvRhBu8zzJ3XqHXR9DYAo4TwUV6/AGiTEiCwdiBtU3FqC4c",
+	"1hXn8buLXx5uJtfp40x2e6DWNsWfb8PGnHGZat/P3djOqNnG1m1rKuQlreAuWzw3S7wnuUz3bEe97E1V",
+	"T8omm6b2EJ1Z/e2Oq/d8ROu3GI+JO+PdLYpECJ5Jq+mC9L2M282pSjNScmiX3DFJa2LdIUiMwClXTNHx",
+	"hkrFtkuOlpTFiOeOWym7OiyIdkYOMryMsyi8aM//u7zYTt/sEDunJl3uhqeE0RilGVfCNmqMftbWjyGu",
+	"uP7M7dC9UOsG/sedCYU8R+cjtuQedpy6VXRiSQ2mdQfzKTpaag+3MYpsChBreTTuaXCcTXskiAxiRmHD",
+	"a/S1s02KCUo+LohFyU6kbaw6ABTE6yl6y6XWhS4HrbARvwLEfg7PvdNjSJwzAnkxBxohtXlMoaQLpP24",
+	"e2SQHcbMrw7zZrz0cOUWid2gEJ3eJ87vtXlFN3DFMVEu7Y7FuhF4oTBALSYLpbmPilrX1+z+fy6c/38F",
+	"neD5bY4XvJZntYPjeVl4l3TlcFmbWHCUc2NTDyhhT14dHOoebJv+w9nDW35vzhta4xhh3TpyN2xTqJeT",
+	"0FtDm/KMPaVELp+mOMMb8RSM4k+hA7CtPwUJe2LjXPoHQ1lidp6H3PhOvYAwp8MIrflmkHL3ZAXvUhwR",
+	"JIhas6JiCRWAhdrKIrnx5CMO/TztSiX4rWSf8dgNKpx1RnItemZjHcZl9hKimqySpNaV2RNjTHeKnVrc",
+	"ILKhiWGlj/r4vJeO1FcEcTvHwEEJheN1Dt5DW1Raae9hXVKDIBpr3AU0HqYv8g5QJ/29gTmsgEyTy/E5",
+	"7e1z7CNpoaXt73kcN+HJ0fM6jhi1ni+4DD2bWjvaGz9085JSsTr6HePIjuTE0JI4REy61at28s8Izko2",
+	"xkZNZ0l/6nVWwqlWPv7o+YEyS+3AK7UYLNWb88vWtEAtOYtYU94n8CU6Zx1JiUyDjvFTGsk8C49jFOjt",
+	"m68aBXf0VW8yYeF9/CqIgTrI96DkojKQ8pSub+dDGZTTwQRzLvK0MEP2z0EAXJJjU85N5rZdeyso8s5d",
+	"WAp3LuhKKc3OcbI6hzQGu3cJRphg05+vLoTlfWovrVvhzRakpaCdv7YX9E2moDe0FYvKTc4V/t0UGdQN",
+	"RNmStw1ctUtrSI2b8L+2lNAoHlRbYNi8tX1xMADawGlsOhR9t7zHEQ0Ss1ou56H6ZP/D1siYSCXOmdgP",
+	"SilL2/za/aDwoYKxH0XZEEjoOzaCndd8NDCesEnsbgw37xny0hmnePOI90b3t1aVnR+qr9oHoxmZTqjn",
+	"549qzCx9WyncHddSMZiqx9Xs7eBvCOrk0sb8zNdsKjZUrn2nwe5clLvnT7+lTXcqudbdPQ26N6qn2pyz",
+	"ISCRUKbUkVXsMRwNv2Je4rnxSH8T5nJyueDXhxYsQ7gbKckmla0J3tWxBCi6UD3YBDjK5vsGR6odYqx7",
+	"xg4nWMjDtpCaqqyjp2wjD4qci03zyEhEU9qUJ85cWMF3akMSHAqGPDNvnOtTRpidTL3KATzRQTfb4Unk",
+	"ivkXs/XmNi4g729mEK8BuV5ATDOg2M7+fvBxb7u5j9QBnsYEWbfgrR7PGFZ5nsQmR5dxlm/AWRtP0t2x",
+	"ihuGEwHWgrJpoMkCXUSrmPmP7baEtl4lerjBfdzvxPXJBXAyOyvcwVhhN6HSZDyJORG3dJ/f6/wc6qi8",
+	"EyTu3C1FHFVjd9ZNJvJbTwqzW4aXcK6WHjSmxm804O2uRCLFsj+JUAvpsoFBh6FJnhIcU0aE2DlZIYku",
+	"Wnw126fuRi+yWlSUZPAcyA2O1igminQQFm0RDExiE/Nhwx7HSGxkCl6mP1/JkM9nv3wbtYk1RcaY9bdu",
+	"bT1jBr8IuKkXWH+q/RdvYKrLvB5CdhR4qwMnvpIkOqUVhbZ7HtGNY/4qxymjG5xtw/o7pzN1m3DFs6D/",
+	"BEjc3UoD3axxipZfq6Y5kEFxYnAIWFGxyrOqV5XYIqKbpzilT01P4unD6f5Tx/0MUSbRzVlQCz8/OHpj",
+	"pltz4cwZ/SUnTKfwvXkUW9Hx4/3u9A12h9xATZD6MeN52rCwh9N9tFLvxwiDxh4kmlyup+qHmKKZlBld",
+	"5NL6tGEdHpFQcICAMCwoKGbyPRc5EypoUS5gsEvtpoGMh1mVS3fm9T9FR3qaSmTzIlT7DKrltlAOyyKG",
+	"RDk3+ovpdfspSL2BzkP4qfiHfj1IPPD4mKZPI54ROD4aX3b3Uwknig/g5GsqSo6nZZQ5JYLnWTSg+JXr",
+	"OLSD0MMJyU5wyS/PDxS6AckpLWUY5ZE4k0csJtfhWUEi7FMilM29TYwBfA9m2+4RH+tISWm00uQqOzj2",
+	"4NMEZIPO9TtCI0hwe9zd1BqsYbJfwZo7CWX7PfbGnKyKy73NimhGzcxix4gGSUdQbusvtSnZ4g2PnXWu",
+	"f5UTq+UNGVlgxmc1tuBdU2BscH8syS6vcIk3NGmuR6PnL0nYbWxFLwlr+LZpGicKr49t7YH6hHjghsNx",
+	"PEYZ2fBLoqPg0gQrEEKGH8oEYYLaABy3O6ZVOKeNDNSLczckuLqkCmL62gG8Q2ueWBcF7yq1LZXYTTap",
+	"LAdkuLigvsfjrcuazpflsWqngaejj2177PHp5R12mz+MIFcAF+S9dqe7pvcb3Fbespr2xVbVauCfTKx6",
+	"G6Pk189g2r+JXEuFf5whs/5xf26qT7yiTsBWFE2rZIHN8nDVuV6VpkSDuUCUnWTUX0ZkUcu2B7AobqRG",
+	"QVjUMx2Y2P3eSNaoVyTXOolRjzIgxkFF52ySW6ddDrsYqss2bBOobEL4vrgFlpB2Lqlp8JunhBzKjlot",
+	"Vld7QLsbsq/vTDaCYQb1sGRyGEDKYvcWfOHbu7rzwbWyxGred8ERh4tH/fYZYh3Uf2/4YVPB7kZ5Q24z",
+	"JUg/zZp2KoxzNaAfyqXuLZ5pj17Tk93klx/uscbfX/VR3LVuGt/fldxtSpcSdtS2rQXBdwtrFcXpaCVn",
+	"pllYSoBKqq5Y4I6hIToXWcOpONOOwAuJKSMxWmZcB7ybr9AVjVdETpENyNHnw74tpcz1SrjYXM6eo1WB",
+	"c/tTunnzS/y39cv58q9vry5/OTp59J/jH9L0Hy//jv/xwzb+awg5Klxc0d1LvmZovtFB+S1lMysiDtJv",
+	"xkjk0VpxbDqvyDKbHMxK0yWsXCTgUbkixMPbqZcAFU/04mBFxuptnujl1VGkGWngmr9ZufMGL5qZcUSv",
+	"OwTs6jPTnFh1VkqpujEZsx+pYJkMR6bs2pDa6Y+6eBo7STenj323eLcCy8tOpjMUYf9pfIv05Si+gTWL",
+	"xmcdQQbGz9+4uRQOLrZuh5L7/DyyQQ83G4Vb4YzUY5OUQ1/bsAR7bdspeNSLLktv/IoGeozdXbrUZr5L",
+	"jWOXXxTXty6qdapBVpyvEtLt/e9JbHanmxGykh9gV/Nk0UM4E7PTH5bSAxRR8gAKlV4Z/K+UXI+r2cq6",
+	"0gG4lBCVywqe11ynbA11P4SuGGujkwM8JfvfP9x/HH03ebyPl5PHjx89nuDvSDx59CB6gvGj7/CjH/ZL",
+	"rM7/tV9O/+cPnbKQS59b2r9WWKm+v3CS7J6Zr79meKi9agbDzvWYfmN1cPqWwDG7ZjAsIULALfhfzZl+",
+	"Nj5pt4uot39wHbbzjTi+WxrVN/V+uVB95ZT5ZZqbFFt/1p1/9/0P3WfBG6yTfpR367/6HOzMJ+0C3LFV",
+	"bh9OHzx5jMzhuQWIt8Da8GIHJo+LSlPaJeH1STJUz2ZQu1HbFPdkiJd5Z0fnlQQY1aou7pcFBhk8To/A",
+	"5CHdufw3dfdEUuVKfe5Ecaeg/CRxmzIqkNiYsIib/HMZUgFlCvcoZ1M0U+y9rabGYgH5h0BLmxlXfi+j",
+	"kQF7vZJGJ77qJTdj6nz25vXsYD4cQU9Jgrfzu9lQNSlfSi73/gwL8uSx21obi2exrIcJq7JHpeHG/sqa",
+	"9+2DKWlxd/oSyD7EN1RKU4LbZHamSaJrBAueXFoqj1FMBUgTimajIs8H+pO6Py/I9hurx/bJ/C3wGp96",
+	"bFEByXLT8eh6suIT8zDNuOQRT6Yn+SKh0SuyPXDLMNtsrwLvw4nOOuwVErX9jKzPwmhF5TpfQFjhirtq",
+	"JHvuD/fFp9rkb1IBqoDCMMf3hm0pdmMmBMlKCdnvckM8ZE0zEmnfnlD23ufu/dihqbHB6sKQtr5/GXeB",
+	"QwnKfztjZCm3UgGFpuP8Lr0FHejvIsrnEFG+UhVwsYKh+Ya92t9lS/SGeMUH+huY/drifocNFSN+t6YE",
+	"rSnjzxDKrvHmZozG70Tp3ulNfJDenDGC+uKUs8/FGb1LB3JGQeH2rhgjuxufhS/iPSk6TpLj5ejpP4fd",
+	"c4OOOaPRBasR6NsiRR/7GZN5Jo+z2IrCthqLOrF+jn/4BQ9DMXPzK6pcWv30A7upFP30EL1TbowRy1Um",
+	"Po4SYqNY/PfiNnJyqCEUoaygeAOHUVlIiKgoQ8ePRtbe0bZMN3hFgqXg/3pqss3q3YLc3SZzNZQlhTCB",
+	"d6evSzujHj6FPvdStvrfCxDYx/T9s+PTq/1XP674bDabvZ2/Wx++W6k/D9X/nh3M/q7+Xb6I5i/VH8/f",
+	"JYd/fX/6+OHm7cXfT9bL51ezg/XVj7Mn++TJBXz37OXpu28Ps4uXq9XqL38JJ1ST6bwhA6m/FhP3Lq2/",
+	"aLcJbPbs4Pnhix9/Onr56vWbt8cnfz2dn717/+Fvf/+H1iX2KL5l9rw0yxCArQf2EL4R6uMZiAaKTQyO",
+	"rP9cbONnu+nhxfvWnHBBH+O40W5wrxzkqHC+YF0J936b/HnFVNBmJ2rHguy2ZK+qJ6I7ouVsJ/5JKx+j",
+	"KtKOdRoDH9QOrt5ejytmqtDK7TKbyM8sjuemdu8rsr2XSrHPyvv5DFfFbpnq9SDbxMlDtvhxrQLNZjvZ",
+	"5guqH99ImdUMqt3YgjiYt9utYkcX4brPVuNuvrWbyJe3toc0bt47OJM3uWvryf3NzHWrxsvD8upC8gyv",
+	"yBRHG10KQn8n9vrs7d638YPlQzxN2apzF4pZN23Gcyzx4bU9N0M2JI+pfM1X/SM1ZuaLcBCTTkg4hFux",
+	"ZQe6ho03lNkIEWsu6j9r9aW19IZmbvwuh3XovDA7rg9vW4r1+qvwxh97IGmENtEF4XcvDMMZMzLlzWwF",
+	"v2ulh2qldanwI1bU06lGRqqqw6aGNdKpPk1qek8010naPHeb1PNY6fY/LU1h3KIE09iWkF1TNu6eN/FT",
+	"x2x2uiRj9THlbB6tSZwnHfm0rAkMviIxylli6xLZjtTrCLOIJEnvxKIVWITm1AQKMH0dQNr03eDByNXh",
+	"PTueYdj7O+Qm3botc8Li956a+wYejOSr26L2E/xmuCjHuHQ76e+JaqiO5ppstPYouxh96hgWQt+HFZcF",
+	"h5wNyVYEpepr7UejM9qp87epZL3Qke2vyFaXmZdcawdxRkxahXjUtjzVuFhUQldr2bgqLzSEyN8tPff7",
+	"mIxHGbnkF2TusXdO321gU5GZlNsTzyUiEAVh+LJyThdbG9gxCxlRWJdQdqG2fMm1JXiKZskV3hYwAEDN",
+	"3p39dH4ym88/HJ8+Pz89nB+enZ8evj9+dXg+P5zPj47fzlUn4fpkg479SaE8uOGdcdLmy6lSeqSfw5+z",
+	"MpHey76JCqRnTIbJNQ1LZZX92KUuZJN7ckmMufMUrbRS7/FeaU39OKzmLG1QAsh3LyxWs6IywYt+6Ue9",
+	"DtpTkPoAek3ZxY6Mat6koQhWDLTYZ87aIuNX+uxJiISjLNeZfHyf2z+KShWgFK9IUM0BSfb8ipe6JsKe",
+	"7WlPb+T/AffVv1xfX3duaZ4lnZu3cyLXW1YNNETuNQvnu+VPiGioHPeBUp9pXZq+hsrpfRVrAHX4MPqR",
+	"8KMTl5QVxBJTfKYSo/easzgcjekXUKzc6GmqUwUZP2B/SuNSAcC/TX7CIs/wRB27ia7lWNQBLGax4Qut",
+	"A2mYxXuSiaCrunnhNHV2ZsWmDJrbxPYXmOPD6ePpg+AUec5kFgDX0fy4ZIE1DW8Zgj8Gq7/3qYgBhEOd",
+	"XjCc9k4J3SdTt12eaWtlZajIavNu3FrpDTPYHwWK8ixTIM78bBj32NKXzuI4IyKQDuboBGH9rlxnswW9",
+	"y+vcfzTdnz548Gj63c4JxMv4oSyJMK4DX2XwfqBUnc5WwXLQilwirN7ttuY3/D80SfDet9N99Ke/PXjw",
+	"v9FryvJrdP39k/Mnj78ZXr2gwPQO6r7r7XSnambXedAJyJpjlJ5po2cDOvXC5YIqmDhCaIxv15O1pppQ",
+	"D2RiSsQWM0npKwIadF35TtFWWGhkhOkFPC4+UFxFuflhQi5tEsqKJLymwgmsaIO3ttwkIuYblJJsQ/Wy",
+	"xyZZuQqc4Azq+Sp0JlJSthJT9IJnSCd9FkgQgix/E/NITK08urfKaUwEMD17dpSJN8po3L22IyYzLlKt",
+	"YT9whbIrdzs8VzkLMIutb4sgcF2BjHj09uz0eH5yeHB2dPz2/OD10eHbs3PTvLnB/PDg9PCsNEssaFSf",
+	"pMq41aqB8OeiUgienx2/OnzbvX6FbNTUJIREDLpgidEWjEzVKl8DYFDtrXryR4HmugVUvU08RtR9UU9a",
+	"b4qsSo5mhScQGY1HCY2IOaZmlFmKozVR+RBrA1xdXU0xvJ7ybLVnvhV7r48ODt/ODycPp/vTtdzo/H0k",
+	"24jjpRnZdKKMgld4tSKZQiVosqe2h8rELRBmOBqPLi2LM3ow3Z/uaz0HYTilo6ejR/BI27vhqO5Nr0iS",
+	"TC4Yv2J7qrrZ9Geh+aOVPrzcZodUDNzoRyI/kCR5pZq/vLoQLwVnXi006PLh/r4FkUFQL1htz3avCVEX",
+	"mXr54dWcSA37gCbvA1ko5RzSbcYjkW90eviRdpNVJmJRLllRLcEpTDHRNCPA1IEuJRfqsGOGsNhuNkRm",
+	"NEKmYhvCyYpnVK43CgB4JRSFVBUKPqoJlLZTV0CfRNVqjZ07ewwflqs83uEmh4pKBnZcNytSsjjnkvLO",
+	"m2YH2hToYtK2KOZRvvHu5FqQnoEEvsQUvCM9vZaqSXp+cnr8/uj54en54dvZs9eHzz11loEDlHQ1kIB7",
+	"ZQ+MnpPEGKKbdh4urJmzj6rzkeENkSDu/bOWGhlfg/2uUEsRJjOqZWEdizoa61vvl5xk24ISJXRD5Wjs",
+	"wcUpDR/ugyOV6nj09MH+Ppj7zK9Qur6Wcj7FZMQFTRumwpdLQRrm4g++32fw46Icr1EG6ynghdJ4SnXd",
+	"2oSmgamoV0dxaSodBbP6zwBwjQqldWWyYXz7rhi+YAWNwTQwhY93eCIdKjp2MHAeoRFK+MoutsSOAd6W",
+	"GLF/fvz00T+oKkFlfa8IwrbfMdpwYNMjdWrBCc87a3C+SmdNE7q9jNj8dykXgfN2woU+cJrinOrmd7ib",
+	"/jhtG1qigEgvg8QDd1UPY8R0vz9dtwszpVjTFapjdEXlWp0QjDICOpAxyqDWzMrrQCXtJMCd6ZhJ+9aw",
+	"dhlZkoywSB23FaasFUSKXk+024jY+1X/cRR/6qSNhR+QODQfdVHJQqzWw9jDB652xdkreitEDm25608N",
+	"7vIoFisPoYx7MwRFfiT63AlXEgkzs0n6x9ZSy2ZA6izUe0XVuFbw6dzUuubdDrcbfP0ZL7e7hGdL+b8A",
+	"fHU7swMhergryS0lC+cwp5bKfmNEKOQ1X5AI54KUc+NlRAnjWp+xQbzUaos0iiDJOdoo1ILylzXccs46",
+	"dRz7Ff49ij/tZcSoJzsIu97XQ/3ZKXzUn1gYY22IVugO7y2pOH7VhkqwHeiXnOQkVn71ERFimSfJdiAO",
+	"/VX1gLCFaylrvEWkwnoTvhJC0Abe+eGe1pSJHlA+hg8OTHsNFCLkMx5vb+/qBiXa8awYyZpbP336VMWD",
+	"T3fJQwQm0sJJQAuUkRUVkmQ3A/ip6UXdEnoCJXWmYilWRJaFWuAsfM1n4TQuEJQN19kYzFvDSlChJTCX",
+	"T8fmuKwgT13MKiPP3q/W5vPJeceROiZpl7s6Lh2Yj/sTDT1cmGpERW/NZOP+kAmDOtY38AZ4o7e3hjVT",
+	"NCthCk4yguOtTbxqzLmRxeANpsw43uRM6oLYW2OO6YUaLnamlUPR2Q3uUqRyo7TtPjQYIwFu1SqdFCDR",
+	"jpd8ZmthFOXkgI1f8yu0sVye0MXSoCimxuZNgPEbdxHjYv9unwi7Ab4Q7W0/MGpitgT/TY7LLI5tBUDJ",
+	"fZAJjqiT3KByk/GbzoQmovDNJhcS4UTA1VtYWK2RWJuIfbOC6gQoMTD8mnq3svwwm71f1T99yarGdx1F",
+	"1kpKT82yTZ9BQmpq+X0NRBSWc4skVINYZ9kqn2VTXItK/VY794G90xRCFIgqn0r4wJZFMq7eIBwVNSdd",
+	"YbcUZ05JaluZJDCGpkS4kBCISaDWiDdGhTXRPv/dpHjuOfHfPUkujdYG07nJG2eWcVtEWlS7vSpKSG0B",
+	"WN5hVhCvvbdemM59M1MXpQr6S/BKQBPjZTwETHu/6j/gqKd5iPbnAXjpf7qOe3kvx1Cbygr6LvpjjLCN",
+	"KR0jPw5kAu0qz8RG7Z0JlAQsNuLpxPmt2vSM1MVP+/nK6wRnY9fSTHIKRa0bTsdwNol+d3Q7+jD4Qrdk",
+	"bR7dh8iU4Buq4jwEhFcwjqmAP3HlGCEs7SlQyGXIImVCYhaRcUnvGZM04dspMghcqh3nHT0h8daO136Q",
+	"4GbupHJQrXa4Lgw6/5J2noM8E8EMquSS8lxYd8vQrCL4dNR2ZXfaVfT6QbrERWkpQxCqZSWn6N//82/d",
+	"Cq7LrRfwZsq9//t/nMH+3w3TthqhG8/a3qGae9N2IVugODCueXXjYZX7vhWsqPCsnbABjlyFpuDRxxtP",
+	"w7LIWKqTi5cS7jAqkHGwCmKM8WFaysoc+sWjDZvYgix5RvrO6Rm0vrNJOVbNkpyx2jXGm2yIHmWqQcqL",
+	"shgw+KXJy6Ce08wesdZJVFNDDJnJC0oSQFLBM+nNZbFtGEy1e7YdjYdcT0B05/rDwBzUG8SzuNFSbN/1",
+	"G7LIRnXH1lq3tDb+9V1TNb6d7bYAoDHiJttEsjUdqgghU7EPUDjFK8p0EmZNt/VFoBk4E1pyLc3FUpR7",
+	"hpWAlEqka2Xvl47rd69IttGhuIBtOdoYC2HrbfwCzred4UIxcWE0ceFA/eCnR4eJ6CGGMIs8kkROhMwI",
+	"3pRRxpGjBWU4C+Wk+Kz8obfKNjTVzdCSMirWNuu0w4Y1FlU65RusNNQHM5RmTIPPcC2C68+GrhTKsJUR",
+	"vRkHycveilr7ovAA5qWEGNUFSkmmLl3irGZYoLfPJ+AxBidAtSy2w7WHe1Ggg/l7e1C00yrK+JWSMV2h",
+	"KO9T54U7RTaAU00G+J2MoAuSQpI0KsZoEWVb9YvFCGcrzh76DY33ojq6mlDgTLNS6plirBeaixprTSFl",
+	"iEqB+BVDMsNMYHAJ/d+WPVtzocUsc284JS+5VsQDBrygadqHk977VfvnfNpbYNZT7wRLeAefPcOsvx4/",
+	"F02yoPMR+hpNf88wQwld3lAZ9ZouNR1eYFYojHZRFt9f8Ny+eP4Mw3LvpeoaSMgCM3YzxFDohU1YrMcM",
+	"aHMNtiprkMW1ylJFLitpyLCW1r9/ip7puRi+HJSMVrDnmY3XKH+FrtY0IQ4vE6wrbPcmKp5HUl9uQWOu",
+	"55jz26cvfbyQbD3Dm1qboZOySxJoorHENjum9uu0OCcIQQDWEjCH4IAWngbC33z03365ABGx4ueNjB1O",
+	"macLzrSTiud2xEHEwjMh+6rzbsfFCsboD4chzCH7HV8svhB2Y3QxWmBc4F6n02IFiHRj6gjKgZA88j78",
+	"TTMv3kK/IBNTzMJPrRnySPccS0rbPtw/VrE0fm/KJB+ZfNP61gExEUzWixxidcCBRTswWR8VklHCIqIF",
+	"xVJ/Ec50kMSaIBeK6CFkPFlsUZRgugFC6AyuLmZ1ikrbYux8Oi9LRiKexX4WaeNRP+R0+Nnk+h+NEz91",
+	"22/2XBj0kdVqPfeKuz8pkrjImxDauVG/+SkE7SEwCg7KUJpghW3kWioTNo3W2iNFzTrZFu6ArpOUJzTa",
+	"gkLZKgdAHWGUhFpZEVbG6Bu/pJIRWyHJZhf03oMcR7shOaTK+i/A9GBqsPuJ65SBr6C2NDGbwUorocAh",
+	"+QYH4cj13XgeGhlWmIyeBnjJY51VS3I4nVgnV3KOD4D12kSG0SJTKrchuO1cHvujtPXf+62j8v12I8Rx",
+	"fKtOhCZ/YNlJUOtgKfNcyaboCLyvKYuS3GccSl5fBvZlR2/jtmuz+THE2WBUHeZUWMXaPv6Fnwlzx01+",
+	"jdpN77fh16jXckMlj+qi7Nfo4WrVNdHCzWeDbfLL3phmKfGeptETnCTDSGSRIkV9P0uS/3pR3u6IufZu",
+	"iBL+zVncm97lqqmT4gBt/f8yLZoiyPjjP4OZ1ZKGjsM0zDmAEBRhl2XVxLYtttaHGjLvYYFUpoVABIKZ",
+	"eRsq5izh0cUw7Hunv/lde0QypPfvZvim99MqG01/oFXWnkk2XNGEuVnNIpaSbFIpPOZSM3qmnX3fQJk8",
+	"BfVezK+YjVRvchUs9O7PbeserrUkRrZzJGl0QZocdtzL+3H5VCo+BMD/vGYE8HBdW8thVgd6OpPnVKRc",
+	"UEmr06gu61M5iYjdbYS1BAuO/BpuhSyrd8/ZJ8wn77Kk8I6EtlQKE2vtYYWu3KORgqhUO3u23H4zTXgO",
+	"DQ9Uu7u09bhR2g6iblXJtWnrqZc3c66e6j0KfaSDUP50+uIAff/k4fffKOchtX1Q2k1/oLbGZXo2zyRX",
+	"OoQE2e3T9B42XJ1Nc7Dhy3pyUcKkVluoV6XU0hX/It15GVDSZpfqgpROQ3U30ow3wheSZ8ztf4K3QJca",
+	"vL21MFEn1EWmJAXEoj4R9FnEMgDY1lggnCq3G5M0TwNiit5Zc44GpNlnoMXWSdhHtYlJowZaJ5Hwq4k6",
+	"tIgufbxSSCXQEgvtoIp111QhzCVOOlADUGnbBzd08uY7RY5yfuh7Je1a6mGBCinsGO280kPZ9WoysO7U",
+	"9LktqIgj3AVloBKZ6mZCRQBgZsswKgaw8G6vUQjEVTTeGifLImtNkZ+tZooyqALJ0RTUNc6YNHzt2GLW",
+	"eUeIYnr/UhQEokUqde87xY0iSWJfXAlJGxMNigbY/VEU6j3M4rGhEVsdLPvmxcwTJXRBUoVO1r8F3Km1",
+	"Tq/wUuGijwlITWXiFggGIC0Tu2elPqhAYs0ziVSiDl8cNs3LmOYKyfVCOVtkeXTnGFArRh2KS1+rE8lW",
+	"Oq/EMGhrBgQX4WFYCJLBYZbc7mwzJhgYajhEbh6QAFgxkJG04RWk+KSoEScCYBmPHCjCEOp1k1QAdadX",
+	"ShlSX/Rq+XJkQy87jEl3cfTbMadynSx5doWzGPrx0KdJtHyhm6uFOsTpEaNtr09QJStqOHbJsI3MQ1mR",
+	"khy+oUxIguNqSPGthj41mf61xV4ndHVZtIMJgWc+pzhs8APOL7wEdub4ubBWMPhEmKE1T+KaCr1pPrrT",
+	"0Q6yeDXZMKndGWDHKkvMRRZ5rfOfnFojUnWtmw1GgihUUXiaUOFE4JKZwLBALds4KqFJeOaWqhhdcAY3",
+	"MVMbe4VF4XE4DmFWr6GVzmGiiwMMaC8mpdLGvfUK7z2mo4KxuBBfeAa/VCZdiFG+3qI/nWWYLOkFWhbH",
+	"dozYirJruLTOzcffBHxN4HBir+JSCdVtkAHPigYR4F4pU/KL49MPs9Pn5/Dj4Pj41dHh+dvZm8MpOnbi",
+	"XTmtqn8KK/TB4J2Nwb7ewvEwS3NXaWqiWgoi6JM4Q/XWBCdy/Z82SveTafIF9eQ6jTMVSE+3KgPrGaJo",
+	"TaILb7m6MXjUqx2rL+4nguP21d3yRNSOb5Z4LyM6qe5Esb2twc5vlvjUND6AtncIhepYBzxvz5NVJK3N",
+	"GWRptutCel2DmAObVpFVO1XiQrnjXkLjZok7gim+5N62biu5qiwYqJL2uQ1k+NpJzD8lK8LUjmimZMgm",
+	"F14iNqTKha9zRkQNBhbrJZdpL9ffN0t8xmXqPH7vgiEvjfGFOPEhSAFS8iZPJJ0scSR55gMGGOhIUoC1",
+	"cVgoA/M2UWdmRkKdc7J6yR4HtYQkFjU7KKMC4I+26R3CyR+nE0YmGZ9dQjyUCurPELYdgfuD5mw03wOb",
+	"b4oddUEguM2Q5G3PqnZbNxkS+81cyw4R59g471YsFNr8kPAr0LfYSMkm2cXs7zmwgv2Sx0TapNMpaDRl",
+	"HKxMQb88p8NyDo7rddmONPB03cmCm5MchD4QAcEZw6WZtOeldXq2w/M8oz03yNVsS9NSyTalpl1gzat0",
+	"LWee4ogEJBcR8ZSIYkXGCQrp8glTdAweppc4yXUKLWbejJEpeT62Qa4sNgUScWaFIcldf5Q1yn6VDYIZ",
+	"9dwZPRc7FdRQazSQ+SHFv+REL0t7Rqp9LKdfbJqe1ORqACq9h2Gq7mVHzwvvenUD64yPShuPsJQ4uhAN",
+	"M2AmMeiAGZy8OjjUB7nQ4IHN8bsnj55803SQeEzOXfubD1jku0q2aP7w2yd9CEp5EucuK1UIG1SfPfw1",
+	"Hu0/rAsHp+6c81DRC/Xo+PToHzOoyqNqNvLMJw/qNFvzKyJZxism+dfGD2eQvFwp5lEmy7aC0hS9N365",
+	"IkC8MxdQGLu5Cp+Wwd/aqOOK79l71pQHpCsmtBqHakUfFhfCEjuaoUjtLJNgV6wfo9quVMuFtPH4tQvs",
+	"LnhJnaDVjfKlTIbVWbSWShCaWmjg8gwpjGy4rYZxMG4CCFsA1qx9RTE57bV4UDIXAjL5ypOuk+SFR1ju",
+	"xJdeasg8RUfLknncJCtTe1LYIvTFhrZEI795jyi0FkRWcms4nKYKkdWld0UFmEgz44+hmrdtc7gQDvy9",
+	"R13tsHbRCfC9KDTWG+GvJ1dXVxPluDbJs4QwRTXjATFmbsQvFeTmTaAlO4pfgU2BLk8CtrBgnbYqmuti",
+	"aLTUobkQnzz0nHBsvslqRJxRUnr1KdVlBtw9cTZTMA2MjQeF9ipU41AJunDRijE93GwAWayXTStnH6xK",
+	"p8ts/XR2doKgmFxd9rihpeDjZ8JeTTm/pDNQaQY9IzRDGb8r+shQKCaox6up6DvzzWvcfvLd4x8U9AEL",
+	"H08ffzNG5FpXw2lQygNtgyHBw28CRDUG044bUzd3HZUc2n549I06Ku4lZkHhkmdFT57TczFG4CMzTplH",
+	"+qaUWN/XWxiPqEZ0V9N0/or+/tGyd5VJfNV8cNXEbaHEVrn8nW14l4h59PwAPKjVOMF895huRHu0cBu3",
+	"UOFQ7do95vTUuz2j2mj+TY3LeWrh9YpeemjZtO/ZCjODGx2BX8elpndaNcMb6UtRJW8KwZKC3vueOdzb",
+	"cEGvWx1xHyDGIlfXQi9IxDdE2ExaJZ1iGaIBKO9RdkklEXsKN1I5AOhH+sOZ/u6OYu2gc39YPeo9xQOY",
+	"nNVCq5nfCA304hUa0KJfydHPnLIwcnjtnF8FWhDCtGbG3JClUjutGulu7BFXVEbrAVgz1x/ckXMRdH4P",
+	"CEa3T/NMM7j+biK9mTfCGb0DhYK8MkIj0G/udsSz1YTqYOnSM3C48O8rLEtzmqIZYnmSlB4exSgh+NKM",
+	"wSt3TRg9qyFTZUT9tdz9J0v3BqBuiQzFhvz1j6TyJxCOqCpP8T7GPt8fSvyqXbx1dLBHsH7bedKLRIJv",
+	"CGekifoqTguoqvJiSbb6FtaxXRC1JUI4AL4qGgvRhihbv9COv9zrwmujn3RQ5xTLNnb5BMu7ZJJPZmet",
+	"ttsTG29p5LczJ6bsxjS7FMINHf/pZHb2TTPR05emkZWUWlaQ5NLYiJlym3JG4rHLx0NdkXwPEmrX29Wv",
+	"duPvikk+mZ190YpyMH6L55J3AIsM7mGw7WaLtzxzuE+NCTWImROz92uK+xV5O8EKkkNKup3MzsLEPsXy",
+	"q42eDe9xv+jt9nAKHbzdBsRejGsBXkgJ1OrWd6pb3OFmnkItZCJET+c+mPPo03j07f6jzzuJmVR8l5Cg",
+	"l4pJSlhMWLRVk8qZK2hfUa65nrW/39im/Bcu3+YCC6K1t/M34BmSXRqWUz17+eEMDCFKiXphvLuKsQa6",
+	"MbZCs/eOf427orBdRHSzd/lw78eM52mrP+U8opv3D027rljwg6M3Jid/cREi8gvSvfKsj/lZf99gbzbB",
+	"c2/xBvr914jEVPLsX6M+LggPJmonlRUtJteWPEBZY2vZaPQ/yOSR+ihc4ubB0Jo29TI7mSlf4ArtjBGW",
+	"uvzyg/39RkN9zhqq7pQL7ez3KrRTcbTHUmZ0kUvtVAJSFuQrqBRMMIA2jGkfAJNr7ZUxcwM0ANv0eeMb",
+	"TSH7nwfK5RHdAM4rzrGNEEKjYJmL0adxAY/bntshWPZDt4M6gsS8rdyo6kPNOQ2s4SkQdPtwuo9WsF4j",
+	"vpBfcpwo13u9YoE4Q/4JLSX690iRWnQHH1whO/0Y4psAuh87/OBORw+gVoOW+CtCLcdwg4JH0YDYVWMi",
+	"JXQxlAVQDK43sEVQ0EdSKZBHD8qIVL/R9n6FXnrx6j6q/ai/qrMFj+u3vYZPuOrmVwSf1pqfn7OSp6MK",
+	"fViRRkDtf8YTemax9asCONi4C+CV6DyuUPog0e4n0ML3mm1l3un2ozDLgu7KA+mA7EApNoaFyiWiHjdh",
+	"zB1eJjDuIBVLI2kx1Sq/XtKiskPyzCTPcywiUAwA9hQZ9snLswcXRBDtGmrCfgEYD2AY9j87w/DVY80p",
+	"SRNs0vHcCGd8tuBdV5FUjUa9qqTevZir7tNCxl3whSsa+rukO1jS/SzCokKcLlmxsSLi1ykqmiKznnCY",
+	"EcHzLCJt8qFFbeUIJ0nGcHIUF8mqxVQHiNxYcrQH+U7vgXfaEPVl5MZi8DqW6ZKBgnL2NV8EJ3YRpWS9",
+	"Ja8UG+xPpXCYBdhEBZJZDsWhsHC1ZYsa7sLEIG3LOQRsqUVAQLpiPOt1sbhUq32lTS/Rai9ZE4D6GxA1",
+	"0wpIx3pRihxSHTUApdMlEMhS3k0qh4uKrbu8//mO5JkzWn91YmKR06ZG5W8gG951suBumbCKGvdKItz/",
+	"zLfFVy8yvIMFgPeNb7dwuilbvk1RFf3EGKFFpWzGcMHzMyJSf3bjdwS6gcx5ywgEzALYZ/ewn8OphYOF",
+	"1kXCp7tMsOdG8QjUV5DEd27TowsIcLGL8NhEE82SJK6c+79ds39r7acpdIYSLME7HsVEN6H/AU8RBWxT",
+	"57N44QMY4DQajwq4lsANnOqkX10zDfNSjsE7hXslm+FXkVfRQ4ciIHaK3iqnYHP+0IZgJkyGVD9zJiMk",
+	"JnEDFkEUkpdToQBADdQappjFBVxLMKdxjzBCDewj0/QuwXwU/wYydpfAhFmRxoEvJKZMBzBhxDD4sc+f",
+	"v7JsppXmxiihFwT9yPkqIUh1NzmC8LNSz7NUZfkoiAcVzvjKoWD+hckGLvCGoCu8haIc6ksLfDve3q/2",
+	"r08hHPIjqcyXtRxnfRCokg3pThGpMtZXQjGGY1c5DZSfStTLu+xKpbWlcvLTeNRQoMgt5CGA5DLtCXeV",
+	"YOmu4a3G+O3C+S6Baa+GhAihuYA+YD3xvoKl3ymAa6PdywCNN3hFI6C9LjLNZLzW13VfeG/a+4H8Fjno",
+	"2DiBdBVQtA5yNAEPuSD2LtAXBE91EVy+UOWa1FWhn+DEcZULuEZiW3LRS9YN5UWty2aemjgqzbqaunha",
+	"82grRcDMbNVSmJmYhhBR/QE2ex8BW1BTbMRQxJxvxGdDy/lG3EukPGYESbrxKnJWcEon5TI2r/4kiQ/p",
+	"978XZfd6XpMVVDq+4xuzPtxv6PL08kgPwlJALVPLLAT+NqjLfkCWdwvV2dn9FZ6CAnEbjekX8+RBR1aA",
+	"EhBw2nwqNIhMU/tvl3uF7wsKSVecHNcQIFW87ZPAcEVlghd9vChaUk8VBaMMcoO0aCu3deShPOOj9qyT",
+	"m+1EJZ7UCSdltJ7YL02C0j5VYMtJP2xqJI+Gj8Yjcp0mIGsucSJIeNLGfdPWay6mTSXR7ENlOm5+OMsw",
+	"aIGF3MLylOFmVJ/t86bqq+FJhyZp9Myng8s4PNfuxyUPxaFjFx7Mw8ZWCQp3XnECHw8b8OX8+C0yuZ7Q",
+	"hkgcY4l3HN9+PmwGc4kl8aoVAlchcabzYnPNoEMdLadv9XLVOW2OXJv0uIrQUpYTlzPIXEGLjF85TTCk",
+	"lvMz3ZVt+7tlHB2c4dJfwR9FJb2SzgZZSm95xstpHFX6A1MmYUFZLErEx/Rvlj12JWUhN2RRnc7Z0Qen",
+	"yRyP5kROTImQIQk1bSkkUdHhlQl7Wetmt6DI1VW4ZTAIsY4qYZ3jchZMu3KbE6mnEi5wuxUJfwdfcwf2",
+	"y6/lutOH06Xh1qfTxzJVAcVW3Gw/NzdIAj2r5x1z9MKmu6zsTyB/7TCyBNbEocPY5KpDbpvil4U42Xno",
+	"c7/vWy8f1EVjbrGKUCfpLPFNN87wC0fMHutaNZlmGlTMAuKSWPX2WpMKkSsnq1TbC6Q4z0hjgt4adRp3",
+	"C0D3k+wouadXivn7gIyfIWOmkSYrQHoBHHG3XPkljwjkirTQN5Fgml6V0/kCTttf5xsek7+o3TpXCGwM",
+	"cMbC9oysIWUTPFN9/Hh4hvzM+j3uaoE3SfedPFetOg7C71Le71Le71LeDaS8nUShusd1u/hzC2QtKIpA",
+	"Vo3Zm9f1CXXJJPUVNAonVIqCTlKBFEksOpodzFslFSB1NeK3h6NexhtFAmeRGH3We07t6Oxgfj+vNwB3",
+	"UUc14kzkG5JBXhWo8n4/WcIGNHBHtNdl+KY40ENcRvEmseP8+XqTdGx3U8Yid1DcnAOAEU2Nx842Zev/",
+	"IK+QbXGaa+ey32b2K1Wtd7JUqfquSx9/USvSrpWym0thmwMB5kuF7mDGp8JCS3tY7VD02i8hgVFMBbjx",
+	"qBxIXm5x9KcUC3FBtt/49s4QglTKZVeQpFe17DKu/F4s+8bWxyoOtcKtUqxaNeDgyG9/5elgB908/VwO",
+	"uu/Se+GgO8wEWdRlCzrl6qMOUDHTgXNPWGwkise3mMUOVHpdiJenaEOiNWZUbNRcYvDxJ7GezA+fbzLv",
+	"XOkFzUforSq7TwTsunna03VZy4Ftrst5OuAGzNPPcAO+S+/BDehPYtcb0AOUR51q4AncOHk6/MYpYHPn",
+	"N8679H7cOL28zFGeeldMjwumnPymBqXK/dLD6//sDr39T7VccQ+c/XvcEjBVUmSvLFWorCbHNPJSqGkB",
+	"Hvtbv57Ynz9fSR88xRlSjyAGtfhzD19iibPuAG5Fq2e67R3upjdKKP0qvHE5tAblztWrMBXv1YkhMdJr",
+	"70qAbVophemKE1GyuRbqetssIH7BlreFVFb2tumc0A1ekb3/Ke+mCwteUIZBiRUQTD/fcegFQAuAYRB8",
+	"B1/5e90CuJO3P47Ry5PDH4E/+PHoBYLd014QtvgIeAzo1LEZERD+JjlaUqnLCc7ez85mp+fzo38cQi8m",
+	"MN/4XEScLekqV0+Mb6l6j1ekhjWVpA2COHWg7VFNzWW29Yukq/b1lHEGodwZVnqCCblOedbhPKig8xxL",
+	"fKjb3iEeeKME8EC/sVavATmx2+rU2/qjSO+E3Xa1OeWcCm2H3XxLBajKNoukALg69yoXk1J3pjxJtMux",
+	"Ub84U/bRc5QzSROja3ZmEyf5FwMYPteIAuYD15EXg2P2SUfhMnKlMKMfOuz9qv81OTqaNGVlvDg0n/TP",
+	"4k4sPgUsqKTo7X7mcu+DqkOLs0tzvLHMdTh1FStD+HdgccW0Fa6mNUZirT5O6CWJbW1QVSfPUsRNGzp4",
+	"Ubzd1KEU8nsXPFtllM8UgN0e2mDyzHhh0Lsn6ffWVg/ShuDtGI77hgoItTaJfTL9x5+tkDo2mdRVE25u",
+	"nDUXhFVjtHSh6in6QEH1wGKE9d1kq4DqAUyAgsmiToV/fwEyIeGXvavGdlcxqcknujd2fS3u0R/vUpA8",
+	"htWJL8Gk2X1/TdlF69E4fV1wL4yQGHLYLYgNf4DIm8IhtY/L58CSXaBKL1DPmpjKFLRyzDr9LQMrKd/j",
+	"hsj6o9R9cj3bZ/lxBKn71DHTAdTjYg8vCEl15i5TDqxmY6sZg3ewn2EvjYbvR2LOsZX0uo4rtLvLe0AN",
+	"8IUkd38CzfgPLRTmqs/iPLkVZnVu+tIMqh2hhTWYJYkh3zrfSkmm0PKLLi5jA4+AoWQat37WmKXZUlN+",
+	"16KnTa6GNceirg+XyBhHBKUko+p2mQEiK+yMMItIUp45FaVD5MeYtjAm8H5PF+ztRkawDBzoxneHkd4o",
+	"94IzgfkgvUeFvDRFM3uvGwpRsi8AqNZY1GtbKuEBx3FGhNixjpSeCeBdEL5G4V6HsyAsnvjTnNRCxKv2",
+	"c+v+WFqcXjZeYe0PiW15XyxRTGMVEXrpovaomSTaEjlFL1xEADJGAS2BTWyeRMMkbVVHcJKgreR8ssFs",
+	"OzHbL1QzKEyNDdon2mcCM603OHwzO3p9/v7w9OjF0cHs7Oj47fnp4fzw7fPzg+Pj18+PP7xFgkRcrQ6v",
+	"uIoPbcF4tQvvvfXfZYh8+6D3Mij5sG5d0xAPFDYcjx4//IzWrVloWg5nPFKJMhIRJpNt2cvnlMhsO5kt",
+	"JclCh0MjkOToClOJFmTJM2BlQGLATIf/1GcQ8mkrkgZXfYaIzVAb7qnr5PteOm3JCRXilXx07ga37RAw",
+	"5pdgN94UHkD1nA8hn8WiYkhq5jxIYQrpASUkFC933qT+UkXnQTeFwJ9yQ7KVGVoX2v/u0fdPvnlqFJ5a",
+	"mQpt4rEuUgtpjIVNfa9GUk4qutInM/lNSSIIynTmOVOuPc8ywqT+uoVhUBlHPNOF58FQe7aXEUF6aEM9",
+	"Zw0i7xD1SuPcC87CzgjBThW8Rc0UZfSqKC190IcFsQkZAryhZUJqNKRipNdAXXNGJjq0vje/eKI+egvf",
+	"3DnXWBvrXt6VJ36GggBLGchx0MhE+tkObs5JljN8hCZCResUnPHELEuXJ8AXRCCyXJJIaiFdq9gtuxdA",
+	"PtVlB+b1ckkIIsWdeia0jPi1IONAe2Cv5ByNyGIQpZRfuxELrK5T10Iu3Tfmlegyr5y4hp9Jsddeado2",
+	"cgn++E1rTJdVbdWOW8vRmp81PZXrrKJk7vZUcOv7KtTLX+YAmhWgnBlQ3Vyv9Q66qitedX21Rlu9wxlX",
+	"3MbNyUrktghbgoU0Jo+CS1a3lOQB/9ghiLWnRuxB1auYpRTo/2XGC2O2OC3CU343YPRQ+oIhQ2GZltCr",
+	"Z6SRUn42s4VnrrgTo0TTcXRZxDvu0Lltd8eoZcdp1e5EkHY3pJDf8QLF4R6D5gDbSsFJR/4saZFHQTtm",
+	"UCmcZF2B1dWaRmvDAglde0TzT56NQaOAcVqsA9G0CoFxTxsjJjhJuulpsdfqm1mSjL7YjWincouV+hvM",
+	"NnWYjo2vhyINqU1X5rt9iin6sCas9AwmWsQUEAZu/OPyd4gKkZPYaghtphhjNTK2ocUW/QRRZQhoksrw",
+	"TJJkGNR/NX/1qgfkg35uv+vvcGSG+mMDhodvVeGNcz9dkXrh5y2ipzvrPUyPpQ0WFUAANjU4Kgaxxjn4",
+	"4zjuJhLW4X4Wx6N7G/owzKHBeAFpf77CA9+L7RsiOlWiKMpb3Fdh8VkiKMBBOI7nZqGvyPaLKimap9N2",
+	"Dj0g4Ti+0VHUwxX+xa0YseTZcJSohGwU2NDEajn4txLjMxpdENlm9w+lVpDwVU+5Rk8VTHxPr81/fVKE",
+	"nG1TJ265AYOzUT21zoXlG7WnsCS3L/DrQPvYOd0y8T0HLgm4w4paRl5Pw21NDoxcPScq+FtTZR3PwXMm",
+	"revJAXhcjD7eVu5OvSWFbtfTh3ZmdukDth0zvXzZBFn2HCLp4fVia0wcf6obQcfmlVEk+i6X41LidZMK",
+	"AerE+xaUb7RcZ8aLMNNaa5uNmrPeSRl2ls383NRVIiHMDrZQCaEBeSPirO5BnY/7JFNjSEqEy9mTeo9+",
+	"HXmTKpBtf7o/3Z/E5DJEGDx0/af7vDhH2owZIvFmcQWXA9kZKqYx5eZ96XbB20fL7Hz69P8PAMtSDB+g",
+	"0gEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
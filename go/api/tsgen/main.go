@@ -0,0 +1,631 @@
+// tsgen generates the TypeScript client of the API from its OpenAPI document, so the
+// client is always in line with the server:
+//
+//	go run ./go/api/tsgen -spec go/api/openapi.yaml -out clients/typescript/src/client.gen.ts
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var (
+	errUnsupported = errors.New("unsupported")
+	identifierRe   = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	pathParamRe    = regexp.MustCompile(`{([^{}]+)}`)
+)
+
+// methods in the order the operations of a path are generated in.
+var methods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"} //nolint:gochecknoglobals
+
+// runtimeNames are declared by the runtime of the client, schemas can't use them.
+var runtimeNames = map[string]struct{}{ //nolint:gochecknoglobals
+	"APIRequest":    {},
+	"Client":        {},
+	"ClientOptions": {},
+	"FetchError":    {},
+	"FetchResponse": {},
+}
+
+func main() {
+	spec := flag.String("spec", "go/api/openapi.yaml", "OpenAPI document of the API")
+	out := flag.String("out", "clients/typescript/src/client.gen.ts", "file to write the client to")
+	flag.Parse()
+
+	doc, err := openapi3.NewLoader().LoadFromFile(*spec)
+	if err != nil {
+		log.Fatalf("error loading %s: %v", *spec, err)
+	}
+
+	code, err := generate(doc)
+	if err != nil {
+		log.Fatalf("error generating client: %v", err)
+	}
+
+	if err := os.WriteFile(*out, code, 0o644); err != nil { //nolint:gosec,mnd
+		log.Fatalf("error writing %s: %v", *out, err)
+	}
+}
+
+func generate(doc *openapi3.T) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by tsgen from the OpenAPI document of the API. DO NOT EDIT.\n")
+	buf.WriteString("/* eslint-disable */\n\n")
+
+	if err := writeSchemas(&buf, doc); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(runtimeCode)
+
+	if err := writeClient(&buf, doc); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeSchemas(buf *bytes.Buffer, doc *openapi3.T) error {
+	for _, name := range sortedKeys(doc.Components.Schemas) {
+		if _, ok := runtimeNames[name]; ok {
+			return fmt.Errorf("%w: schema %s has the name of a type of the client", errUnsupported, name)
+		}
+
+		ref := doc.Components.Schemas[name]
+		buf.WriteString(comment("", ref.Value.Description))
+		if isInterface(ref) {
+			fmt.Fprintf(buf, "export interface %s %s\n\n", name, objectType(ref.Value, ""))
+		} else {
+			fmt.Fprintf(buf, "export type %s = %s;\n\n", name, typeOf(ref, ""))
+		}
+	}
+
+	return nil
+}
+
+func isInterface(ref *openapi3.SchemaRef) bool {
+	s := ref.Value
+	return ref.Ref == "" && s.Type != nil && s.Type.Is("object") &&
+		len(s.Properties) > 0 && len(s.AllOf) == 0 && !s.Nullable
+}
+
+func comment(indent, text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(indent + "/**\n")
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.ReplaceAll(strings.TrimRight(line, " "), "*/", `*\/`)
+		if line == "" {
+			b.WriteString(indent + " *\n")
+		} else {
+			b.WriteString(indent + " * " + line + "\n")
+		}
+	}
+	b.WriteString(indent + " */\n")
+
+	return b.String()
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`).Replace(s) + "'"
+}
+
+func propertyName(name string) string {
+	if identifierRe.MatchString(name) {
+		return name
+	}
+	return quote(name)
+}
+
+// access is the expression reading the property name of obj, which may be undefined.
+func access(obj, name string) string {
+	if identifierRe.MatchString(name) {
+		return obj + "?." + name
+	}
+	return obj + "?.[" + quote(name) + "]"
+}
+
+func typeOf(ref *openapi3.SchemaRef, indent string) string {
+	if ref == nil {
+		return "unknown"
+	}
+	if ref.Ref != "" {
+		return refName(ref.Ref)
+	}
+
+	t := valueType(ref.Value, indent)
+	if ref.Value.Nullable {
+		t += " | null"
+	}
+	return t
+}
+
+func joinTypes(refs openapi3.SchemaRefs, indent, sep string) string {
+	types := make([]string, len(refs))
+	for i, ref := range refs {
+		types[i] = typeOf(ref, indent)
+	}
+	return strings.Join(types, sep)
+}
+
+func valueType(s *openapi3.Schema, indent string) string { //nolint:cyclop
+	switch {
+	case len(s.AllOf) > 0:
+		return joinTypes(s.AllOf, indent, " & ")
+	case len(s.OneOf) > 0:
+		return joinTypes(s.OneOf, indent, " | ")
+	case len(s.AnyOf) > 0:
+		return joinTypes(s.AnyOf, indent, " | ")
+	case len(s.Enum) > 0:
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			if str, ok := v.(string); ok {
+				values[i] = quote(str)
+			} else {
+				values[i] = fmt.Sprint(v)
+			}
+		}
+		return strings.Join(values, " | ")
+	case s.Type == nil:
+		return "unknown"
+	case s.Type.Is("string") && s.Format == "binary":
+		return "Blob"
+	case s.Type.Is("string"):
+		return "string"
+	case s.Type.Is("integer"), s.Type.Is("number"):
+		return "number"
+	case s.Type.Is("boolean"):
+		return "boolean"
+	case s.Type.Is("array"):
+		return "Array<" + typeOf(s.Items, indent) + ">"
+	case s.Type.Is("object"):
+		return objectType(s, indent)
+	default:
+		return "unknown"
+	}
+}
+
+func objectType(s *openapi3.Schema, indent string) string {
+	if len(s.Properties) == 0 {
+		if s.AdditionalProperties.Schema != nil {
+			return "Record<string, " + typeOf(s.AdditionalProperties.Schema, indent) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+
+	required := make(map[string]struct{}, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = struct{}{}
+	}
+
+	inner := indent + "  "
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range sortedKeys(s.Properties) {
+		prop := s.Properties[name]
+		optional := "?"
+		if _, ok := required[name]; ok {
+			optional = ""
+		}
+
+		if prop.Ref == "" {
+			b.WriteString(comment(inner, prop.Value.Description))
+		}
+		b.WriteString(inner + propertyName(name) + optional + ": " + typeOf(prop, inner) + ";\n")
+	}
+	if s.AdditionalProperties.Schema != nil ||
+		(s.AdditionalProperties.Has != nil && *s.AdditionalProperties.Has) {
+		b.WriteString(inner + "[key: string]: unknown;\n")
+	}
+	b.WriteString(indent + "}")
+
+	return b.String()
+}
+
+// operationName is the name oapi-codegen gives to operations without an id, in camel case,
+// so the methods of the Go and TypeScript clients match.
+func operationName(method, path string) string {
+	var b strings.Builder
+	capNext := false
+	for _, r := range strings.ToLower(method) + "-" + path {
+		switch {
+		case unicode.IsUpper(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case unicode.IsLower(r) && capNext:
+			b.WriteRune(unicode.ToUpper(r))
+		case unicode.IsLower(r):
+			b.WriteRune(r)
+		}
+		capNext = !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	return b.String()
+}
+
+type operation struct {
+	name        string
+	method      string
+	path        string
+	op          *openapi3.Operation
+	pathParams  []*openapi3.Parameter
+	otherParams []*openapi3.Parameter
+}
+
+func getParameters(item *openapi3.PathItem, op *openapi3.Operation) map[string]*openapi3.Parameter {
+	params := make(map[string]*openapi3.Parameter)
+	for _, refs := range []openapi3.Parameters{item.Parameters, op.Parameters} {
+		for _, ref := range refs {
+			params[ref.Value.In+":"+ref.Value.Name] = ref.Value
+		}
+	}
+	return params
+}
+
+func newOperation(path, method string, item *openapi3.PathItem, op *openapi3.Operation) (operation, error) {
+	params := getParameters(item, op)
+
+	o := operation{
+		name:        operationName(method, path),
+		method:      method,
+		path:        path,
+		op:          op,
+		pathParams:  nil,
+		otherParams: nil,
+	}
+
+	for _, match := range pathParamRe.FindAllStringSubmatch(path, -1) {
+		p, ok := params[openapi3.ParameterInPath+":"+match[1]]
+		if !ok {
+			return o, fmt.Errorf("%w: %s %s doesn't define path parameter %s", errUnsupported, method, path, match[1])
+		}
+		if !identifierRe.MatchString(p.Name) {
+			return o, fmt.Errorf("%w: %s %s has path parameter %s", errUnsupported, method, path, p.Name)
+		}
+		o.pathParams = append(o.pathParams, p)
+	}
+
+	for _, key := range sortedKeys(params) {
+		p := params[key]
+		switch p.In {
+		case openapi3.ParameterInQuery, openapi3.ParameterInHeader:
+			o.otherParams = append(o.otherParams, p)
+		case openapi3.ParameterInPath:
+		default:
+			return o, fmt.Errorf("%w: %s %s has %s parameter %s", errUnsupported, method, path, p.In, p.Name)
+		}
+	}
+
+	return o, nil
+}
+
+// bodyContentTypes in order of preference.
+var bodyContentTypes = []string{ //nolint:gochecknoglobals
+	"application/json",
+	"application/scim+json",
+	"application/x-www-form-urlencoded",
+	"application/octet-stream",
+}
+
+func (o operation) body() (string, *openapi3.MediaType) {
+	if o.op.RequestBody == nil {
+		return "", nil
+	}
+
+	content := o.op.RequestBody.Value.Content
+	for _, contentType := range bodyContentTypes {
+		if media, ok := content[contentType]; ok {
+			return contentType, media
+		}
+	}
+	for _, contentType := range sortedKeys(content) {
+		return contentType, content[contentType]
+	}
+
+	return "", nil
+}
+
+func isJSON(contentType string) bool {
+	return contentType == "application/json" || strings.HasSuffix(contentType, "+json")
+}
+
+// responseType is the type of the body of the first successful response.
+func (o operation) responseType() string {
+	responses := o.op.Responses.Map()
+	for _, code := range sortedKeys(responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+
+		content := responses[code].Value.Content
+		if len(content) == 0 {
+			return "void"
+		}
+		for _, contentType := range sortedKeys(content) {
+			if isJSON(contentType) {
+				return typeOf(content[contentType].Schema, "      ")
+			}
+		}
+		return "string"
+	}
+
+	return "void"
+}
+
+func (o operation) paramsType() (string, bool) {
+	required := false
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, p := range o.otherParams {
+		optional := "?"
+		if p.Required {
+			optional = ""
+			required = true
+		}
+		b.WriteString(comment("        ", p.Description))
+		b.WriteString("        " + propertyName(p.Name) + optional + ": " + typeOf(p.Schema, "        ") + ";\n")
+	}
+	b.WriteString("      }")
+
+	return b.String(), required
+}
+
+func (o operation) pathExpr() string {
+	return "`" + pathParamRe.ReplaceAllString(o.path, "$${encodeURIComponent(String($1))}") + "`"
+}
+
+func (o operation) paramsExpr(in string) string {
+	var values []string
+	for _, p := range o.otherParams {
+		if p.In == in {
+			values = append(values, propertyName(p.Name)+": "+access("params", p.Name))
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "{ " + strings.Join(values, ", ") + " }"
+}
+
+func (o operation) write(buf *bytes.Buffer) {
+	summary := o.op.Summary
+	if summary == "" {
+		summary = o.op.Description
+	}
+	buf.WriteString(comment("    ", summary+"\n\n`"+o.method+" "+o.path+"`"))
+
+	args := make([]string, 0, len(o.pathParams)+3) //nolint:mnd
+	for _, p := range o.pathParams {
+		args = append(args, p.Name+": "+typeOf(p.Schema, "      "))
+	}
+
+	contentType, media := o.body()
+	if media != nil {
+		t := "BodyInit"
+		if isJSON(contentType) || contentType == "application/x-www-form-urlencoded" {
+			t = typeOf(media.Schema, "      ")
+		}
+		if o.op.RequestBody.Value.Required {
+			args = append(args, "body: "+t)
+		} else {
+			args = append(args, "body?: "+t)
+		}
+	}
+
+	if len(o.otherParams) > 0 {
+		t, required := o.paramsType()
+		if required {
+			args = append(args, "params: "+t)
+		} else {
+			args = append(args, "params?: "+t)
+		}
+	}
+	args = append(args, "options?: RequestInit")
+
+	response := o.responseType()
+
+	fmt.Fprintf(buf, "    %s: (\n", o.name)
+	for _, arg := range args {
+		buf.WriteString("      " + arg + ",\n")
+	}
+	fmt.Fprintf(buf, "    ): Promise<FetchResponse<%s>> =>\n", response)
+	fmt.Fprintf(buf, "      request<%s>(\n", response)
+	buf.WriteString("        {\n")
+	fmt.Fprintf(buf, "          method: %s,\n", quote(o.method))
+	fmt.Fprintf(buf, "          path: %s,\n", o.pathExpr())
+	if query := o.paramsExpr(openapi3.ParameterInQuery); query != "" {
+		fmt.Fprintf(buf, "          query: %s,\n", query)
+	}
+	if headers := o.paramsExpr(openapi3.ParameterInHeader); headers != "" {
+		fmt.Fprintf(buf, "          headers: %s,\n", headers)
+	}
+	if media != nil {
+		buf.WriteString("          body,\n")
+	}
+	// wildcards, like image/*, are left to the body or the options of the request
+	if media != nil && !strings.Contains(contentType, "*") {
+		fmt.Fprintf(buf, "          contentType: %s,\n", quote(contentType))
+	}
+	buf.WriteString("        },\n")
+	buf.WriteString("        options,\n")
+	buf.WriteString("      ),\n\n")
+}
+
+func writeClient(buf *bytes.Buffer, doc *openapi3.T) error {
+	buf.WriteString("/**\n * Creates a client of the API at `baseURL`.\n */\n")
+	buf.WriteString("export const createClient = (clientOptions: ClientOptions) => {\n")
+	buf.WriteString("  const request = createRequest(clientOptions);\n\n")
+	buf.WriteString("  return {\n")
+
+	paths := doc.Paths.Map()
+	for _, path := range sortedKeys(paths) {
+		item := paths[path]
+		for _, method := range methods {
+			op := item.GetOperation(method)
+			if op == nil {
+				continue
+			}
+
+			o, err := newOperation(path, method, item, op)
+			if err != nil {
+				return err
+			}
+			o.write(buf)
+		}
+	}
+
+	buf.Truncate(buf.Len() - 1)
+	buf.WriteString("  };\n};\n\n")
+	buf.WriteString("export type Client = ReturnType<typeof createClient>;\n")
+
+	return nil
+}
+
+// runtimeCode is the code the operations of the client share.
+const runtimeCode = `export interface ClientOptions {
+  /**
+   * URL of the API, i.e. https://auth.example.com/v1
+   */
+  baseURL: string;
+  /**
+   * Headers sent with every request, i.e. the admin secret or the access token of the user.
+   */
+  headers?: Record<string, string>;
+  /**
+   * Implementation of fetch to send the requests with, the global one by default.
+   */
+  fetch?: typeof fetch;
+}
+
+export interface FetchResponse<T> {
+  body: T;
+  status: number;
+  headers: Headers;
+}
+
+/**
+ * Thrown for responses with a status of 400 or more, with their decoded body.
+ */
+export class FetchError extends Error {
+  constructor(
+    public readonly body: unknown,
+    public readonly status: number,
+    public readonly headers: Headers,
+  ) {
+    super(FetchError.messageOf(body, status));
+    this.name = 'FetchError';
+  }
+
+  private static messageOf(body: unknown, status: number): string {
+    const message = (body as { message?: unknown } | null | undefined)?.message;
+    if (typeof message === 'string') {
+      return message;
+    }
+    return ` + "`request failed with status ${status}`" + `;
+  }
+}
+
+interface APIRequest {
+  method: string;
+  path: string;
+  query?: Record<string, unknown>;
+  headers?: Record<string, unknown>;
+  body?: unknown;
+  contentType?: string;
+}
+
+const formBody = (body: unknown): URLSearchParams => {
+  const form = new URLSearchParams();
+  for (const [key, value] of Object.entries(body as Record<string, unknown>)) {
+    if (value !== undefined && value !== null) {
+      form.append(key, String(value));
+    }
+  }
+  return form;
+};
+
+const encodeBody = (req: APIRequest): BodyInit | undefined => {
+  if (req.body === undefined) {
+    return undefined;
+  }
+  if (req.contentType === 'application/x-www-form-urlencoded') {
+    return formBody(req.body);
+  }
+  if (req.contentType?.endsWith('json')) {
+    return JSON.stringify(req.body);
+  }
+  return req.body as BodyInit;
+};
+
+const createRequest =
+  (clientOptions: ClientOptions) =>
+  async <T>(req: APIRequest, options?: RequestInit): Promise<FetchResponse<T>> => {
+    const url = new URL(clientOptions.baseURL.replace(/\/$/, '') + req.path);
+    for (const [key, value] of Object.entries(req.query ?? {})) {
+      for (const v of Array.isArray(value) ? value : [value]) {
+        if (v !== undefined && v !== null) {
+          url.searchParams.append(key, String(v));
+        }
+      }
+    }
+
+    const headers = new Headers(clientOptions.headers);
+    for (const [key, value] of Object.entries(req.headers ?? {})) {
+      if (value !== undefined && value !== null) {
+        headers.set(key, String(value));
+      }
+    }
+    new Headers(options?.headers).forEach((value, key) => headers.set(key, value));
+    if (req.body !== undefined && req.contentType && !headers.has('Content-Type')) {
+      headers.set('Content-Type', req.contentType);
+    }
+
+    const res = await (clientOptions.fetch ?? fetch)(url.toString(), {
+      ...options,
+      method: req.method,
+      headers,
+      body: encodeBody(req),
+    });
+
+    const text = await res.text();
+    let body: unknown = text === '' ? undefined : text;
+    if (text !== '' && (res.headers.get('Content-Type') ?? '').includes('json')) {
+      body = JSON.parse(text);
+    }
+
+    if (res.status >= 400) {
+      throw new FetchError(body, res.status, res.headers);
+    }
+
+    return { body: body as T, status: res.status, headers: res.headers };
+  };
+
+`
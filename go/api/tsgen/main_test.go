@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	doc, err := openapi3.NewLoader().LoadFromFile("../openapi.yaml")
+	if err != nil {
+		t.Fatalf("error loading spec: %v", err)
+	}
+
+	got, err := generate(doc)
+	if err != nil {
+		t.Fatalf("error generating client: %v", err)
+	}
+
+	want, err := os.ReadFile("../../../clients/typescript/src/client.gen.ts")
+	if err != nil {
+		t.Fatalf("error reading client: %v", err)
+	}
+
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("the client is out of date, run go generate (-want +got):\n%s", diff)
+	}
+}
+
+func TestOperationName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{method: "GET", path: "/.well-known/jwks.json", want: "getWellKnownJwksJson"},
+		{method: "POST", path: "/signin/email-password", want: "postSigninEmailPassword"},
+		{method: "DELETE", path: "/admin/users/{userId}/roles/{role}", want: "deleteAdminUsersUserIdRolesRole"},
+		{method: "GET", path: "/scim/v2/Users", want: "getScimV2Users"},
+		{method: "HEAD", path: "/healthz", want: "headHealthz"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.want, func(t *testing.T) {
+			t.Parallel()
+
+			if got := operationName(tc.method, tc.path); got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
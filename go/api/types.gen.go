@@ -13,31 +13,169 @@ import (
 )
 
 const (
-	BearerAuthScopes         = "BearerAuth.Scopes"
-	BearerAuthElevatedScopes = "BearerAuthElevated.Scopes"
+	AdminSecretScopes         = "AdminSecret.Scopes"
+	BearerAuthScopes          = "BearerAuth.Scopes"
+	BearerAuthElevatedScopes  = "BearerAuthElevated.Scopes"
+	IntrospectionClientScopes = "IntrospectionClient.Scopes"
+	ScimTokenScopes           = "ScimToken.Scopes"
+)
+
+// Defines values for AdminUsersSortBy.
+const (
+	CreatedAt   AdminUsersSortBy = "createdAt"
+	DisplayName AdminUsersSortBy = "displayName"
+	Email       AdminUsersSortBy = "email"
+)
+
+// Defines values for AuditLogActor.
+const (
+	AuditLogActorAdmin AuditLogActor = "admin"
+	AuditLogActorUser  AuditLogActor = "user"
+)
+
+// Defines values for AuditLogOutcome.
+const (
+	Failure AuditLogOutcome = "failure"
+	Success AuditLogOutcome = "success"
+)
+
+// Defines values for CreateOAuth2ClientRequestGrantTypes.
+const (
+	CreateOAuth2ClientRequestGrantTypesAuthorizationCode CreateOAuth2ClientRequestGrantTypes = "authorization_code"
+	CreateOAuth2ClientRequestGrantTypesClientCredentials CreateOAuth2ClientRequestGrantTypes = "client_credentials"
+)
+
+// Defines values for DataExportStatus.
+const (
+	Completed DataExportStatus = "completed"
+	Failed    DataExportStatus = "failed"
+	Pending   DataExportStatus = "pending"
 )
 
 // Defines values for ErrorResponseError.
 const (
+	AccessDenied                    ErrorResponseError = "access-denied"
+	AuthorizationPending            ErrorResponseError = "authorization-pending"
+	AvatarTooLarge                  ErrorResponseError = "avatar-too-large"
+	CannotSendSms                   ErrorResponseError = "cannot-send-sms"
+	ClientNotFound                  ErrorResponseError = "client-not-found"
+	DataExportNotFound              ErrorResponseError = "data-export-not-found"
 	DefaultRoleMustBeInAllowedRoles ErrorResponseError = "default-role-must-be-in-allowed-roles"
 	DisabledEndpoint                ErrorResponseError = "disabled-endpoint"
+	DisabledMfaTotp                 ErrorResponseError = "disabled-mfa-totp"
+	DisabledSignInMethod            ErrorResponseError = "disabled-sign-in-method"
 	DisabledUser                    ErrorResponseError = "disabled-user"
+	DisposableEmail                 ErrorResponseError = "disposable-email"
+	ElevatedClaimRequired           ErrorResponseError = "elevated-claim-required"
 	EmailAlreadyInUse               ErrorResponseError = "email-already-in-use"
 	EmailAlreadyVerified            ErrorResponseError = "email-already-verified"
+	EmailNotFound                   ErrorResponseError = "email-not-found"
+	ExpiredToken                    ErrorResponseError = "expired-token"
 	ForbiddenAnonymous              ErrorResponseError = "forbidden-anonymous"
+	ForbiddenOrganizationRole       ErrorResponseError = "forbidden-organization-role"
+	ForbiddenRole                   ErrorResponseError = "forbidden-role"
+	ImpossibleTravel                ErrorResponseError = "impossible-travel"
 	InternalServerError             ErrorResponseError = "internal-server-error"
+	InvalidAccessToken              ErrorResponseError = "invalid-access-token"
+	InvalidAvatar                   ErrorResponseError = "invalid-avatar"
+	InvalidCaptcha                  ErrorResponseError = "invalid-captcha"
+	InvalidClient                   ErrorResponseError = "invalid-client"
+	InvalidConfiguration            ErrorResponseError = "invalid-configuration"
 	InvalidEmailPassword            ErrorResponseError = "invalid-email-password"
+	InvalidGrant                    ErrorResponseError = "invalid-grant"
+	InvalidIdToken                  ErrorResponseError = "invalid-id-token"
+	InvalidMetadata                 ErrorResponseError = "invalid-metadata"
+	InvalidOtp                      ErrorResponseError = "invalid-otp"
 	InvalidPat                      ErrorResponseError = "invalid-pat"
 	InvalidRefreshToken             ErrorResponseError = "invalid-refresh-token"
 	InvalidRequest                  ErrorResponseError = "invalid-request"
+	InvalidSamlResponse             ErrorResponseError = "invalid-saml-response"
+	InvalidState                    ErrorResponseError = "invalid-state"
+	InvalidSubjectToken             ErrorResponseError = "invalid-subject-token"
+	InvalidTicket                   ErrorResponseError = "invalid-ticket"
+	InvalidUserCode                 ErrorResponseError = "invalid-user-code"
+	InvalidWebauthnSecurityKey      ErrorResponseError = "invalid-webauthn-security-key"
+	IpNotAllowed                    ErrorResponseError = "ip-not-allowed"
+	LastSignInMethod                ErrorResponseError = "last-sign-in-method"
 	LocaleNotAllowed                ErrorResponseError = "locale-not-allowed"
+	MfaTypeNotFound                 ErrorResponseError = "mfa-type-not-found"
+	NoTotpSecret                    ErrorResponseError = "no-totp-secret"
+	OauthProviderError              ErrorResponseError = "oauth-provider-error"
+	OrganizationNotFound            ErrorResponseError = "organization-not-found"
+	PasswordContainsEmail           ErrorResponseError = "password-contains-email"
+	PasswordInDenylist              ErrorResponseError = "password-in-denylist"
 	PasswordInHibpDatabase          ErrorResponseError = "password-in-hibp-database"
+	PasswordMissingDigit            ErrorResponseError = "password-missing-digit"
+	PasswordMissingLowercase        ErrorResponseError = "password-missing-lowercase"
+	PasswordMissingSymbol           ErrorResponseError = "password-missing-symbol"
+	PasswordMissingUppercase        ErrorResponseError = "password-missing-uppercase"
+	PasswordTooLong                 ErrorResponseError = "password-too-long"
 	PasswordTooShort                ErrorResponseError = "password-too-short"
+	PasswordTooWeak                 ErrorResponseError = "password-too-weak"
+	PatNotFound                     ErrorResponseError = "pat-not-found"
+	PhoneNumberAlreadyInUse         ErrorResponseError = "phone-number-already-in-use"
+	ProviderAlreadyLinked           ErrorResponseError = "provider-already-linked"
+	ProviderNotLinked               ErrorResponseError = "provider-not-linked"
 	RedirectToNotAllowed            ErrorResponseError = "redirectTo-not-allowed"
+	RiskySignIn                     ErrorResponseError = "risky-sign-in"
+	RoleAlreadyExists               ErrorResponseError = "role-already-exists"
+	RoleInUse                       ErrorResponseError = "role-in-use"
 	RoleNotAllowed                  ErrorResponseError = "role-not-allowed"
+	RoleNotFound                    ErrorResponseError = "role-not-found"
+	SessionLimitReached             ErrorResponseError = "session-limit-reached"
+	SessionNotFound                 ErrorResponseError = "session-not-found"
+	SignInLocked                    ErrorResponseError = "sign-in-locked"
 	SignupDisabled                  ErrorResponseError = "signup-disabled"
+	SignupRejected                  ErrorResponseError = "signup-rejected"
+	SlowDown                        ErrorResponseError = "slow-down"
+	TooManyRequests                 ErrorResponseError = "too-many-requests"
+	TotpAlreadyActive               ErrorResponseError = "totp-already-active"
+	UnauthorizedClient              ErrorResponseError = "unauthorized-client"
+	UndeliverableEmail              ErrorResponseError = "undeliverable-email"
 	UnverifiedUser                  ErrorResponseError = "unverified-user"
 	UserNotAnonymous                ErrorResponseError = "user-not-anonymous"
+	UserNotFound                    ErrorResponseError = "user-not-found"
+)
+
+// Defines values for ImportUsersFormat.
+const (
+	Csv    ImportUsersFormat = "csv"
+	Ndjson ImportUsersFormat = "ndjson"
+)
+
+// Defines values for IntrospectRequestTokenTypeHint.
+const (
+	IntrospectRequestTokenTypeHintAccessToken  IntrospectRequestTokenTypeHint = "access_token"
+	IntrospectRequestTokenTypeHintRefreshToken IntrospectRequestTokenTypeHint = "refresh_token"
+)
+
+// Defines values for IntrospectResponseTokenType.
+const (
+	IntrospectResponseTokenTypeAccessToken         IntrospectResponseTokenType = "access_token"
+	IntrospectResponseTokenTypePersonalAccessToken IntrospectResponseTokenType = "personal_access_token"
+	IntrospectResponseTokenTypeRefreshToken        IntrospectResponseTokenType = "refresh_token"
+)
+
+// Defines values for OAuth2TokenRequestGrantType.
+const (
+	OAuth2TokenRequestGrantTypeAuthorizationCode                        OAuth2TokenRequestGrantType = "authorization_code"
+	OAuth2TokenRequestGrantTypeClientCredentials                        OAuth2TokenRequestGrantType = "client_credentials"
+	OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange OAuth2TokenRequestGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+// Defines values for OAuth2TokenRequestSubjectTokenType.
+const (
+	OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken OAuth2TokenRequestSubjectTokenType = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// Defines values for OAuth2TokenResponseIssuedTokenType.
+const (
+	OAuth2TokenResponseIssuedTokenTypeUrnIetfParamsOauthTokenTypeAccessToken OAuth2TokenResponseIssuedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// Defines values for OAuth2TokenResponseTokenType.
+const (
+	Bearer OAuth2TokenResponseTokenType = "Bearer"
 )
 
 // Defines values for OKResponse.
@@ -45,256 +183,1923 @@ const (
 	OK OKResponse = "OK"
 )
 
+// Defines values for OrganizationRole.
+const (
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+	OrganizationRoleOwner  OrganizationRole = "owner"
+)
+
+// Defines values for ReadinessStatus.
+const (
+	Error ReadinessStatus = "error"
+	Ok    ReadinessStatus = "ok"
+)
+
+// Defines values for SignInIdTokenRequestProvider.
+const (
+	Apple  SignInIdTokenRequestProvider = "apple"
+	Google SignInIdTokenRequestProvider = "google"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
 // Defines values for UserDeanonymizeRequestSignInMethod.
 const (
 	EmailPassword UserDeanonymizeRequestSignInMethod = "email-password"
 	Passwordless  UserDeanonymizeRequestSignInMethod = "passwordless"
 )
 
-// CreatePATRequest defines model for CreatePATRequest.
-type CreatePATRequest struct {
-	// ExpiresAt Expiration date of the PAT
-	ExpiresAt time.Time               `json:"expiresAt"`
-	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
-}
+// Defines values for UserMfaRequestActiveMfaType.
+const (
+	Empty UserMfaRequestActiveMfaType = ""
+	Totp  UserMfaRequestActiveMfaType = "totp"
+)
 
-// CreatePATResponse defines model for CreatePATResponse.
-type CreatePATResponse struct {
-	// Id ID of the PAT
-	Id string `json:"id"`
+// Defines values for GetVerifyParamsType.
+const (
+	AccountDeletionCancel GetVerifyParamsType = "accountDeletionCancel"
+	EmailChangeRevert     GetVerifyParamsType = "emailChangeRevert"
+	EmailConfirmChange    GetVerifyParamsType = "emailConfirmChange"
+	EmailVerify           GetVerifyParamsType = "emailVerify"
+	NewDeviceRevoke       GetVerifyParamsType = "newDeviceRevoke"
+	PasswordReset         GetVerifyParamsType = "passwordReset"
+	SigninPasswordless    GetVerifyParamsType = "signinPasswordless"
+)
 
-	// PersonalAccessToken PAT
-	PersonalAccessToken string `json:"personalAccessToken"`
+// AcceptOrganizationInviteRequest defines model for AcceptOrganizationInviteRequest.
+type AcceptOrganizationInviteRequest struct {
+	// Ticket Ticket of the invitation email
+	Ticket string `json:"ticket"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	// Error Error code that identifies the application error
-	Error ErrorResponseError `json:"error"`
+// AdminPasswordResetRequest defines model for AdminPasswordResetRequest.
+type AdminPasswordResetRequest struct {
+	Options *OptionsRedirectTo `json:"options,omitempty"`
+}
 
-	// Message Human friendly error message
-	Message string `json:"message"`
+// AdminRole defines model for AdminRole.
+type AdminRole struct {
+	Role string `json:"role"`
 
-	// Status HTTP status error code
-	Status int `json:"status"`
+	// Users Number of users with the role
+	Users int `json:"users"`
 }
 
-// ErrorResponseError Error code that identifies the application error
-type ErrorResponseError string
+// AdminRoleRequest defines model for AdminRoleRequest.
+type AdminRoleRequest struct {
+	// Role Name of the role
+	Role string `json:"role"`
+}
 
-// MFAChallengePayload defines model for MFAChallengePayload.
-type MFAChallengePayload struct {
-	Ticket string `json:"ticket"`
+// AdminRolesResponse defines model for AdminRolesResponse.
+type AdminRolesResponse struct {
+	Roles []AdminRole `json:"roles"`
 }
 
-// OKResponse defines model for OKResponse.
-type OKResponse string
+// AdminSetPasswordRequest defines model for AdminSetPasswordRequest.
+type AdminSetPasswordRequest struct {
+	// Password New password of the user, mutually exclusive with passwordHash
+	Password *string `json:"password,omitempty"`
 
-// OptionsRedirectTo defines model for OptionsRedirectTo.
-type OptionsRedirectTo struct {
-	RedirectTo *string `json:"redirectTo,omitempty"`
-}
+	// PasswordHash Hash of the new password of the user, in the bcrypt format or in the PHC string format of argon2, mutually exclusive with password
+	PasswordHash *string `json:"passwordHash,omitempty"`
 
-// RefreshTokenRequest defines model for RefreshTokenRequest.
-type RefreshTokenRequest struct {
-	// RefreshToken Refresh Token
-	RefreshToken string `json:"refreshToken"`
+	// RevokeSessions Revoke all the sessions of the user
+	RevokeSessions *bool `json:"revokeSessions,omitempty"`
 }
 
-// Session defines model for Session.
-type Session struct {
-	AccessToken          string `json:"accessToken"`
-	AccessTokenExpiresIn int64  `json:"accessTokenExpiresIn"`
+// AdminSignInMethod defines model for AdminSignInMethod.
+type AdminSignInMethod struct {
+	// Configured Whether the method is enabled in the configuration
+	Configured bool `json:"configured"`
 
-	// RefreshToken Refresh token during authentication or when refreshing the JWT
-	RefreshToken string `json:"refreshToken"`
+	// Enabled Whether users can sign in with the method, it has to be configured and not disabled at runtime
+	Enabled bool `json:"enabled"`
 
-	// RefreshTokenId Refresh token id
-	RefreshTokenId string `json:"refreshTokenId"`
-	User           *User  `json:"user,omitempty"`
+	// Method Name of the sign in method
+	Method string `json:"method"`
 }
 
-// SessionPayload defines model for SessionPayload.
-type SessionPayload struct {
-	Session *Session `json:"session,omitempty"`
+// AdminSignInMethodRequest defines model for AdminSignInMethodRequest.
+type AdminSignInMethodRequest struct {
+	// Enabled Whether users can sign in with the method
+	Enabled bool `json:"enabled"`
 }
 
-// SignInEmailPasswordRequest defines model for SignInEmailPasswordRequest.
-type SignInEmailPasswordRequest struct {
-	// Email A valid email
-	Email openapi_types.Email `json:"email"`
-
-	// Password A password of minimum 3 characters
-	Password string `json:"password"`
+// AdminSignInMethodsResponse defines model for AdminSignInMethodsResponse.
+type AdminSignInMethodsResponse struct {
+	Methods []AdminSignInMethod `json:"methods"`
 }
 
-// SignInEmailPasswordResponse defines model for SignInEmailPasswordResponse.
-type SignInEmailPasswordResponse struct {
-	Mfa     *MFAChallengePayload `json:"mfa,omitempty"`
-	Session *Session             `json:"session,omitempty"`
-}
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	AvatarUrl string  `json:"avatarUrl"`
+	BanReason *string `json:"banReason,omitempty"`
 
-// SignInPATRequest defines model for SignInPATRequest.
-type SignInPATRequest struct {
-	// PersonalAccessToken PAT
-	PersonalAccessToken string `json:"personalAccessToken"`
+	// BannedAt When the user was banned, missing if the user isn't banned
+	BannedAt *time.Time `json:"bannedAt,omitempty"`
+
+	// BannedUntil When the ban expires, missing if the ban is permanent
+	BannedUntil   *time.Time           `json:"bannedUntil,omitempty"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	DefaultRole   string               `json:"defaultRole"`
+	Disabled      bool                 `json:"disabled"`
+	DisplayName   string               `json:"displayName"`
+	Email         *openapi_types.Email `json:"email,omitempty"`
+	EmailVerified bool                 `json:"emailVerified"`
+	Id            openapi_types.UUID   `json:"id"`
+	IsAnonymous   bool                 `json:"isAnonymous"`
+
+	// LastSeen Last time the user signed in or refreshed their session
+	LastSeen            *time.Time             `json:"lastSeen,omitempty"`
+	Locale              string                 `json:"locale"`
+	Metadata            map[string]interface{} `json:"metadata"`
+	PhoneNumber         *string                `json:"phoneNumber,omitempty"`
+	PhoneNumberVerified bool                   `json:"phoneNumberVerified"`
+
+	// Providers Providers the user is linked to
+	Providers []string `json:"providers"`
+	Roles     []string `json:"roles"`
 }
 
-// SignInPasswordlessEmailRequest defines model for SignInPasswordlessEmailRequest.
-type SignInPasswordlessEmailRequest struct {
-	// Email A valid email
-	Email   openapi_types.Email `json:"email"`
-	Options *SignUpOptions      `json:"options,omitempty"`
+// AdminUsersResponse defines model for AdminUsersResponse.
+type AdminUsersResponse struct {
+	// NextCursor Cursor to get the next page, missing on the last page
+	NextCursor *string     `json:"nextCursor,omitempty"`
+	Users      []AdminUser `json:"users"`
 }
 
-// SignUpEmailPasswordRequest defines model for SignUpEmailPasswordRequest.
-type SignUpEmailPasswordRequest struct {
-	// Email A valid email
-	Email   openapi_types.Email `json:"email"`
-	Options *SignUpOptions      `json:"options,omitempty"`
+// AdminUsersSortBy defines model for AdminUsersSortBy.
+type AdminUsersSortBy string
 
-	// Password A password of minimum 3 characters
-	Password string `json:"password"`
-}
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Actor Who performed the action, the user or an administrator using the admin secret
+	Actor     AuditLogActor `json:"actor"`
+	CreatedAt time.Time     `json:"createdAt"`
 
-// SignUpOptions defines model for SignUpOptions.
-type SignUpOptions struct {
-	AllowedRoles *[]string `json:"allowedRoles,omitempty"`
-	DefaultRole  *string   `json:"defaultRole,omitempty"`
-	DisplayName  *string   `json:"displayName,omitempty"`
+	// Event Authentication event
+	Event     string             `json:"event"`
+	Id        openapi_types.UUID `json:"id"`
+	IpAddress string             `json:"ipAddress"`
 
-	// Locale A two-characters locale
-	Locale     *string                 `json:"locale,omitempty"`
-	Metadata   *map[string]interface{} `json:"metadata,omitempty"`
-	RedirectTo *string                 `json:"redirectTo,omitempty"`
-}
+	// Metadata Details of the event, like the operation, the email or phone number used and the error returned
+	Metadata  map[string]interface{} `json:"metadata"`
+	Outcome   AuditLogOutcome        `json:"outcome"`
+	UserAgent string                 `json:"userAgent"`
 
-// SignUpWebauthnRequest defines model for SignUpWebauthnRequest.
-type SignUpWebauthnRequest struct {
-	// Email A valid email
-	Email   openapi_types.Email `json:"email"`
-	Options *SignUpOptions      `json:"options,omitempty"`
+	// UserId ID of the user the event is about, if known
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
 }
 
-// SignUpWebauthnResponse defines model for SignUpWebauthnResponse.
-type SignUpWebauthnResponse = protocol.PublicKeyCredentialCreationOptions
+// AuditLogActor Who performed the action, the user or an administrator using the admin secret
+type AuditLogActor string
 
-// SignUpWebauthnVerifyRequest defines model for SignUpWebauthnVerifyRequest.
-type SignUpWebauthnVerifyRequest struct {
-	Credential *protocol.CredentialCreationResponse `json:"credential,omitempty"`
-	Options    *struct {
-		AllowedRoles *[]string `json:"allowedRoles,omitempty"`
-		DefaultRole  *string   `json:"defaultRole,omitempty"`
-		DisplayName  *string   `json:"displayName,omitempty"`
+// AuditLogOutcome defines model for AuditLog.Outcome.
+type AuditLogOutcome string
 
-		// Locale A two-characters locale
-		Locale     *string                 `json:"locale,omitempty"`
-		Metadata   *map[string]interface{} `json:"metadata,omitempty"`
-		Nickname   *string                 `json:"nickname,omitempty"`
-		RedirectTo *string                 `json:"redirectTo,omitempty"`
-	} `json:"options,omitempty"`
-	AdditionalProperties map[string]interface{} `json:"-"`
+// AuditLogsResponse defines model for AuditLogsResponse.
+type AuditLogsResponse struct {
+	Logs []AuditLog `json:"logs"`
+
+	// Total Number of entries matching the filters
+	Total int `json:"total"`
 }
 
-// User defines model for User.
-type User struct {
-	AvatarUrl   string    `json:"avatarUrl"`
-	CreatedAt   time.Time `json:"createdAt"`
-	DefaultRole string    `json:"defaultRole"`
-	DisplayName string    `json:"displayName"`
+// BanUserRequest defines model for BanUserRequest.
+type BanUserRequest struct {
+	// ExpiresAt When the ban expires, the ban is permanent if missing
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
-	// Email A valid email
-	Email         *openapi_types.Email `json:"email,omitempty"`
-	EmailVerified bool                 `json:"emailVerified"`
+	// Reason Why the user is banned, only visible to administrators
+	Reason string `json:"reason"`
+}
 
-	// Id Id of the user
-	Id          string `json:"id"`
-	IsAnonymous bool   `json:"isAnonymous"`
+// ConfigReloadResponse defines model for ConfigReloadResponse.
+type ConfigReloadResponse struct {
+	// Changed Environment variables whose new value is being used
+	Changed []string `json:"changed"`
 
-	// Locale A two-characters locale
-	Locale              string                 `json:"locale"`
-	Metadata            map[string]interface{} `json:"metadata"`
-	PhoneNumber         string                 `json:"phoneNumber"`
-	PhoneNumberVerified bool                   `json:"phoneNumberVerified"`
-	Roles               []string               `json:"roles"`
+	// Ignored Environment variables that changed but can't be reloaded, they keep their value until the service restarts
+	Ignored []string `json:"ignored"`
 }
 
-// UserDeanonymizeRequest defines model for UserDeanonymizeRequest.
-type UserDeanonymizeRequest struct {
-	// Connection Deprecated, will be ignored
-	// Deprecated:
-	Connection *string `json:"connection,omitempty"`
+// CreateOAuth2ClientRequest defines model for CreateOAuth2ClientRequest.
+type CreateOAuth2ClientRequest struct {
+	AllowedRoles []string `json:"allowedRoles"`
+	DefaultRole  string   `json:"defaultRole"`
+	Description  *string  `json:"description,omitempty"`
 
-	// Email A valid email
-	Email   openapi_types.Email `json:"email"`
-	Options *SignUpOptions      `json:"options,omitempty"`
+	// GrantTypes Grants the client can use to get access tokens. Defaults to authorization_code for clients with redirect URIs and to client_credentials for the others. The token exchange grant is enabled with tokenExchangeEnabled
+	GrantTypes *[]CreateOAuth2ClientRequestGrantTypes `json:"grantTypes,omitempty"`
 
-	// Password A password of minimum 3 characters
-	Password *string `json:"password,omitempty"`
+	// RedirectUris URIs users can be redirected to when the client signs them in with OpenID Connect
+	RedirectUris *[]string `json:"redirectUris,omitempty"`
 
-	// SignInMethod Which sign-in method to use
-	SignInMethod UserDeanonymizeRequestSignInMethod `json:"signInMethod"`
+	// SkipConsent Whether users are signed in to the client without being asked for their consent, for first-party applications
+	SkipConsent *bool `json:"skipConsent,omitempty"`
+
+	// TokenExchangeEnabled Whether the client can exchange the access tokens of users for down-scoped ones
+	TokenExchangeEnabled *bool `json:"tokenExchangeEnabled,omitempty"`
 }
 
-// UserDeanonymizeRequestSignInMethod Which sign-in method to use
-type UserDeanonymizeRequestSignInMethod string
+// CreateOAuth2ClientRequestGrantTypes defines model for CreateOAuth2ClientRequest.GrantTypes.
+type CreateOAuth2ClientRequestGrantTypes string
 
-// UserEmailChangeRequest defines model for UserEmailChangeRequest.
-type UserEmailChangeRequest struct {
-	// NewEmail A valid email
-	NewEmail openapi_types.Email `json:"newEmail"`
-	Options  *OptionsRedirectTo  `json:"options,omitempty"`
+// CreateOAuth2ClientResponse defines model for CreateOAuth2ClientResponse.
+type CreateOAuth2ClientResponse struct {
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
 }
 
-// UserEmailSendVerificationEmailRequest defines model for UserEmailSendVerificationEmailRequest.
-type UserEmailSendVerificationEmailRequest struct {
-	// Email A valid email
-	Email   openapi_types.Email `json:"email"`
-	Options *OptionsRedirectTo  `json:"options,omitempty"`
+// CreateOrganizationRequest defines model for CreateOrganizationRequest.
+type CreateOrganizationRequest struct {
+	// Name Name of the organization
+	Name string `json:"name"`
 }
 
-// UserPasswordResetRequest defines model for UserPasswordResetRequest.
-type UserPasswordResetRequest struct {
-	// Email A valid email
-	Email   openapi_types.Email `json:"email"`
-	Options *OptionsRedirectTo  `json:"options,omitempty"`
+// CreatePATRequest defines model for CreatePATRequest.
+type CreatePATRequest struct {
+	// ExpiresAt Expiration date of the PAT. PATs without one never expire
+	ExpiresAt *time.Time              `json:"expiresAt,omitempty"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Name Name of the PAT, defaults to the name in its metadata if there is one
+	Name *string `json:"name,omitempty"`
 }
 
-// PostPatJSONRequestBody defines body for PostPat for application/json ContentType.
-type PostPatJSONRequestBody = CreatePATRequest
+// CreatePATResponse defines model for CreatePATResponse.
+type CreatePATResponse struct {
+	// Id ID of the PAT
+	Id string `json:"id"`
 
-// PostSigninEmailPasswordJSONRequestBody defines body for PostSigninEmailPassword for application/json ContentType.
-type PostSigninEmailPasswordJSONRequestBody = SignInEmailPasswordRequest
+	// PersonalAccessToken PAT
+	PersonalAccessToken string `json:"personalAccessToken"`
+}
 
-// PostSigninPasswordlessEmailJSONRequestBody defines body for PostSigninPasswordlessEmail for application/json ContentType.
-type PostSigninPasswordlessEmailJSONRequestBody = SignInPasswordlessEmailRequest
+// DataExport defines model for DataExport.
+type DataExport struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
 
-// PostSigninPatJSONRequestBody defines body for PostSigninPat for application/json ContentType.
-type PostSigninPatJSONRequestBody = SignInPATRequest
+	// DownloadUrl URL to download the export, only set once it is completed. The URL contains a signed ticket and expires after an hour, get the export again for a new one
+	DownloadUrl *string `json:"downloadUrl,omitempty"`
 
-// PostSignupEmailPasswordJSONRequestBody defines body for PostSignupEmailPassword for application/json ContentType.
-type PostSignupEmailPasswordJSONRequestBody = SignUpEmailPasswordRequest
+	// ExpiresAt When the export is deleted
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Status    DataExportStatus   `json:"status"`
+	UserId    openapi_types.UUID `json:"userId"`
+}
 
-// PostSignupWebauthnJSONRequestBody defines body for PostSignupWebauthn for application/json ContentType.
-type PostSignupWebauthnJSONRequestBody = SignUpWebauthnRequest
+// DataExportStatus defines model for DataExportStatus.
+type DataExportStatus string
 
-// PostSignupWebauthnVerifyJSONRequestBody defines body for PostSignupWebauthnVerify for application/json ContentType.
-type PostSignupWebauthnVerifyJSONRequestBody = SignUpWebauthnVerifyRequest
+// DeviceCodeResponse defines model for DeviceCodeResponse.
+type DeviceCodeResponse struct {
+	// DeviceCode Code the device uses to poll for the session, it must be kept secret
+	DeviceCode string `json:"deviceCode"`
 
-// PostTokenJSONRequestBody defines body for PostToken for application/json ContentType.
-type PostTokenJSONRequestBody = RefreshTokenRequest
+	// ExpiresIn Number of seconds the codes are valid for
+	ExpiresIn int64 `json:"expiresIn"`
 
-// PostUserDeanonymizeJSONRequestBody defines body for PostUserDeanonymize for application/json ContentType.
-type PostUserDeanonymizeJSONRequestBody = UserDeanonymizeRequest
+	// Interval Minimum number of seconds the device must wait between polling requests
+	Interval int64 `json:"interval"`
 
-// PostUserEmailChangeJSONRequestBody defines body for PostUserEmailChange for application/json ContentType.
-type PostUserEmailChangeJSONRequestBody = UserEmailChangeRequest
+	// UserCode Code the user needs to enter in the verification page
+	UserCode string `json:"userCode"`
 
-// PostUserEmailSendVerificationEmailJSONRequestBody defines body for PostUserEmailSendVerificationEmail for application/json ContentType.
-type PostUserEmailSendVerificationEmailJSONRequestBody = UserEmailSendVerificationEmailRequest
+	// VerificationUri URL of the verification page
+	VerificationUri string `json:"verificationUri"`
 
-// PostUserPasswordResetJSONRequestBody defines body for PostUserPasswordReset for application/json ContentType.
-type PostUserPasswordResetJSONRequestBody = UserPasswordResetRequest
+	// VerificationUriComplete URL of the verification page including the user code
+	VerificationUriComplete string `json:"verificationUriComplete"`
+}
+
+// DeviceTokenRequest defines model for DeviceTokenRequest.
+type DeviceTokenRequest struct {
+	// DeviceCode Device code returned by /device/code
+	DeviceCode string `json:"deviceCode"`
+}
+
+// DeviceVerifyRequest defines model for DeviceVerifyRequest.
+type DeviceVerifyRequest struct {
+	// Approve Whether the user approves the device, if false the device is denied
+	Approve bool `json:"approve"`
+
+	// UserCode Code displayed by the device, the dash and case are ignored
+	UserCode string `json:"userCode"`
+}
+
+// ElevateRequest defines model for ElevateRequest.
+type ElevateRequest struct {
+	// Otp One time password generated by the authenticator app, required if the user has TOTP MFA enabled
+	Otp *string `json:"otp,omitempty"`
+
+	// Password Current password of the user, required if the user has a password
+	Password *string `json:"password,omitempty"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Error Error code that identifies the application error
+	Error ErrorResponseError `json:"error"`
+
+	// Message Human friendly error message
+	Message string `json:"message"`
+
+	// Status HTTP status error code
+	Status int `json:"status"`
+}
+
+// ErrorResponseError Error code that identifies the application error
+type ErrorResponseError string
+
+// ImpersonateUserRequest defines model for ImpersonateUserRequest.
+type ImpersonateUserRequest struct {
+	// ImpersonatedBy Who is impersonating the user, i.e. the email of the administrator. It is set in the x-hasura-impersonated-by claim and recorded in the audit log
+	ImpersonatedBy string `json:"impersonatedBy"`
+
+	// Role Only allow this role, which must be one of the roles of the user. All the roles of the user are allowed if missing
+	Role *string `json:"role,omitempty"`
+}
+
+// ImpersonationResponse defines model for ImpersonationResponse.
+type ImpersonationResponse struct {
+	// AccessToken JSON Web Token (JWT)
+	AccessToken          string `json:"accessToken"`
+	AccessTokenExpiresIn int64  `json:"accessTokenExpiresIn"`
+	User                 User   `json:"user"`
+}
+
+// ImportUserError defines model for ImportUserError.
+type ImportUserError struct {
+	Email *string `json:"email,omitempty"`
+
+	// Error Why the user wasn't imported
+	Error string `json:"error"`
+
+	// Line Line of the body with the user, the header of CSV files is line 1
+	Line int `json:"line"`
+}
+
+// ImportUsersFormat defines model for ImportUsersFormat.
+type ImportUsersFormat string
+
+// ImportUsersResponse defines model for ImportUsersResponse.
+type ImportUsersResponse struct {
+	Errors []ImportUserError `json:"errors"`
+
+	// Imported Number of users imported
+	Imported int `json:"imported"`
+
+	// Skipped Number of users that weren't imported, see errors
+	Skipped int `json:"skipped"`
+}
+
+// IntrospectRequest defines model for IntrospectRequest.
+type IntrospectRequest struct {
+	// Token Access token or refresh token to introspect
+	Token string `json:"token"`
+
+	// TokenTypeHint Type of the token, used to look it up faster
+	TokenTypeHint *IntrospectRequestTokenTypeHint `json:"token_type_hint,omitempty"`
+}
+
+// IntrospectRequestTokenTypeHint Type of the token, used to look it up faster
+type IntrospectRequestTokenTypeHint string
+
+// IntrospectResponse defines model for IntrospectResponse.
+type IntrospectResponse struct {
+	// Active Whether the token is valid and can be used
+	Active bool `json:"active"`
+
+	// Claims Hasura claims of the access token
+	Claims *map[string]interface{} `json:"claims,omitempty"`
+
+	// Exp Time the token expires, in seconds since the epoch
+	Exp *int64 `json:"exp,omitempty"`
+
+	// Iat Time the token was issued, in seconds since the epoch
+	Iat *int64 `json:"iat,omitempty"`
+
+	// Iss Issuer of the access token
+	Iss *string `json:"iss,omitempty"`
+
+	// Sub ID of the user the token belongs to
+	Sub *string `json:"sub,omitempty"`
+
+	// TokenType Type of the token, only present if the token is active
+	TokenType *IntrospectResponseTokenType `json:"token_type,omitempty"`
+}
+
+// IntrospectResponseTokenType Type of the token, only present if the token is active
+type IntrospectResponseTokenType string
+
+// JWK defines model for JWK.
+type JWK struct {
+	// Alg Algorithm the key is used with
+	Alg string `json:"alg"`
+
+	// Crv Curve of EC keys
+	Crv *string `json:"crv,omitempty"`
+
+	// E Exponent of RSA keys
+	E *string `json:"e,omitempty"`
+
+	// Kid Key ID
+	Kid string `json:"kid"`
+
+	// Kty Key type
+	Kty string `json:"kty"`
+
+	// N Modulus of RSA keys
+	N *string `json:"n,omitempty"`
+
+	// Use Intended use of the key
+	Use string `json:"use"`
+
+	// X X coordinate of EC keys
+	X *string `json:"x,omitempty"`
+
+	// Y Y coordinate of EC keys
+	Y *string `json:"y,omitempty"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// MFAChallengePayload defines model for MFAChallengePayload.
+type MFAChallengePayload struct {
+	Ticket string `json:"ticket"`
+}
+
+// MfaRecoveryCodesCountResponse defines model for MfaRecoveryCodesCountResponse.
+type MfaRecoveryCodesCountResponse struct {
+	// Remaining Number of recovery codes that haven't been used yet
+	Remaining int `json:"remaining"`
+}
+
+// MfaRecoveryCodesResponse defines model for MfaRecoveryCodesResponse.
+type MfaRecoveryCodesResponse struct {
+	// RecoveryCodes One-time recovery codes that can be used instead of a TOTP code
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// MfaTotpEnableRequest defines model for MfaTotpEnableRequest.
+type MfaTotpEnableRequest struct {
+	// Code Code generated by the authenticator app with the secret returned by /mfa/totp/generate
+	Code string `json:"code"`
+}
+
+// OAuth2AuthorizeRequest defines model for OAuth2AuthorizeRequest.
+type OAuth2AuthorizeRequest struct {
+	ClientId            string  `json:"clientId"`
+	CodeChallenge       *string `json:"codeChallenge,omitempty"`
+	CodeChallengeMethod *string `json:"codeChallengeMethod,omitempty"`
+
+	// Consent Whether the user allows the client to access the requested scopes. Omit it to find out if the user needs to be asked
+	Consent     *bool   `json:"consent,omitempty"`
+	Nonce       *string `json:"nonce,omitempty"`
+	RedirectUri string  `json:"redirectUri"`
+	Scope       string  `json:"scope"`
+	State       *string `json:"state,omitempty"`
+}
+
+// OAuth2AuthorizeResponse defines model for OAuth2AuthorizeResponse.
+type OAuth2AuthorizeResponse struct {
+	Client OAuth2ClientInfo `json:"client"`
+
+	// ConsentRequired Whether the user needs to be asked for their consent. If it is, call the endpoint again with consent set
+	ConsentRequired bool `json:"consentRequired"`
+
+	// RedirectTo URL to redirect the user to, with the authorization code or an error. Not set if the user needs to be asked for their consent
+	RedirectTo *string `json:"redirectTo,omitempty"`
+
+	// Scopes Scopes requested by the client
+	Scopes []string `json:"scopes"`
+}
+
+// OAuth2ClientInfo defines model for OAuth2ClientInfo.
+type OAuth2ClientInfo struct {
+	ClientId    string `json:"clientId"`
+	Description string `json:"description"`
+}
+
+// OAuth2TokenRequest defines model for OAuth2TokenRequest.
+type OAuth2TokenRequest struct {
+	// ClientId ID of the client, if not sent in the authorization header
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret Secret of the client, if not sent in the authorization header
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// Code Authorization code, required by the authorization code grant
+	Code *string `json:"code,omitempty"`
+
+	// CodeVerifier PKCE code verifier, required by the authorization code grant if the authorization request had a code challenge
+	CodeVerifier *string                     `json:"code_verifier,omitempty"`
+	GrantType    OAuth2TokenRequestGrantType `json:"grant_type"`
+
+	// RedirectUri Redirect URI of the authorization request, required by the authorization code grant
+	RedirectUri *string `json:"redirect_uri,omitempty"`
+
+	// Scope Space separated list of roles to include in the access token. Defaults to all the roles the client is allowed to use or, when exchanging a token, to all the roles of the subject token
+	Scope *string `json:"scope,omitempty"`
+
+	// SubjectToken Access token of the user to exchange, required by the token exchange grant
+	SubjectToken *string `json:"subject_token,omitempty"`
+
+	// SubjectTokenType Type of the subject token, required by the token exchange grant
+	SubjectTokenType *OAuth2TokenRequestSubjectTokenType `json:"subject_token_type,omitempty"`
+}
+
+// OAuth2TokenRequestGrantType defines model for OAuth2TokenRequest.GrantType.
+type OAuth2TokenRequestGrantType string
+
+// OAuth2TokenRequestSubjectTokenType Type of the subject token, required by the token exchange grant
+type OAuth2TokenRequestSubjectTokenType string
+
+// OAuth2TokenResponse defines model for OAuth2TokenResponse.
+type OAuth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn Number of seconds the access token is valid for
+	ExpiresIn int64 `json:"expires_in"`
+
+	// IdToken ID token of the user, only returned by the authorization code grant
+	IdToken *string `json:"id_token,omitempty"`
+
+	// IssuedTokenType Type of the issued token, only returned by the token exchange grant
+	IssuedTokenType *OAuth2TokenResponseIssuedTokenType `json:"issued_token_type,omitempty"`
+
+	// Scope Space separated list of roles included in the access token
+	Scope     string                       `json:"scope"`
+	TokenType OAuth2TokenResponseTokenType `json:"token_type"`
+}
+
+// OAuth2TokenResponseIssuedTokenType Type of the issued token, only returned by the token exchange grant
+type OAuth2TokenResponseIssuedTokenType string
+
+// OAuth2TokenResponseTokenType defines model for OAuth2TokenResponse.TokenType.
+type OAuth2TokenResponseTokenType string
+
+// OIDCUserInfo defines model for OIDCUserInfo.
+type OIDCUserInfo struct {
+	Email               *string `json:"email,omitempty"`
+	EmailVerified       *bool   `json:"email_verified,omitempty"`
+	Locale              *string `json:"locale,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	PhoneNumber         *string `json:"phone_number,omitempty"`
+	PhoneNumberVerified *bool   `json:"phone_number_verified,omitempty"`
+	Picture             *string `json:"picture,omitempty"`
+	Sub                 string  `json:"sub"`
+}
+
+// OKResponse defines model for OKResponse.
+type OKResponse string
+
+// OpenIDConfiguration defines model for OpenIDConfiguration.
+type OpenIDConfiguration struct {
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	Issuer                            string   `json:"issuer"`
+	JwksUri                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+}
+
+// OptionsRedirectTo defines model for OptionsRedirectTo.
+type OptionsRedirectTo struct {
+	RedirectTo *string `json:"redirectTo,omitempty"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	// CreatedAt When the organization was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ID of the organization
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Name of the organization
+	Name string `json:"name"`
+
+	// Role Role of a member in an organization
+	Role OrganizationRole `json:"role"`
+}
+
+// OrganizationInviteRequest defines model for OrganizationInviteRequest.
+type OrganizationInviteRequest struct {
+	// Email Email the invitation is sent to
+	Email   openapi_types.Email `json:"email"`
+	Options *OptionsRedirectTo  `json:"options,omitempty"`
+
+	// Role Role of a member in an organization
+	Role *OrganizationRole `json:"role,omitempty"`
+}
+
+// OrganizationRole Role of a member in an organization
+type OrganizationRole string
+
+// OutboxEmail defines model for OutboxEmail.
+type OutboxEmail struct {
+	// Attempts Number of times sending the email was attempted
+	Attempts  int                `json:"attempts"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastError Error returned by the email provider the last time
+	LastError string `json:"lastError"`
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject"`
+
+	// Template Template used to render the email
+	Template string `json:"template"`
+}
+
+// OutboxFailedEmailsResponse defines model for OutboxFailedEmailsResponse.
+type OutboxFailedEmailsResponse struct {
+	Emails []OutboxEmail `json:"emails"`
+
+	// Failed Number of emails that couldn't be delivered
+	Failed int `json:"failed"`
+
+	// Pending Number of emails waiting to be sent
+	Pending int `json:"pending"`
+}
+
+// PAT defines model for PAT.
+type PAT struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the PAT expires, not set if it never does
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id ID of the PAT
+	Id string `json:"id"`
+
+	// LastUsedAt When the PAT was last used to sign in
+	LastUsedAt *time.Time              `json:"lastUsedAt,omitempty"`
+	Metadata   *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Name Name of the PAT
+	Name string `json:"name"`
+}
+
+// PATsResponse defines model for PATsResponse.
+type PATsResponse struct {
+	Pats []PAT `json:"pats"`
+}
+
+// ReadinessResponse defines model for ReadinessResponse.
+type ReadinessResponse struct {
+	// Checks Status of each dependency checked, i.e. database, smtp and jwt
+	Checks map[string]ReadinessStatus `json:"checks"`
+	Status ReadinessStatus            `json:"status"`
+}
+
+// ReadinessStatus defines model for ReadinessStatus.
+type ReadinessStatus string
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	// RefreshToken Refresh Token
+	RefreshToken string `json:"refreshToken"`
+}
+
+// ScimEmail defines model for ScimEmail.
+type ScimEmail struct {
+	Primary *bool   `json:"primary,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Value   string  `json:"value"`
+}
+
+// ScimError defines model for ScimError.
+type ScimError struct {
+	// Detail Human friendly error message
+	Detail  *string  `json:"detail,omitempty"`
+	Schemas []string `json:"schemas"`
+
+	// ScimType SCIM error type
+	ScimType *string `json:"scimType,omitempty"`
+
+	// Status HTTP status error code
+	Status string `json:"status"`
+}
+
+// ScimGroup SCIM 2.0 group, a role of auth.roles. Attributes that aren't listed are accepted and ignored
+type ScimGroup struct {
+	// DisplayName Name of the role
+	DisplayName string `json:"displayName"`
+
+	// Id ID of the group, the name of the role. Ignored in requests
+	Id *string `json:"id,omitempty"`
+
+	// Members Users that have the role
+	Members *[]ScimMember `json:"members,omitempty"`
+
+	// Meta Metadata of the resource, ignored in requests
+	Meta    *ScimMeta `json:"meta,omitempty"`
+	Schemas *[]string `json:"schemas,omitempty"`
+}
+
+// ScimGroupListResponse defines model for ScimGroupListResponse.
+type ScimGroupListResponse struct {
+	Resources    []ScimGroup `json:"Resources"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Schemas      []string    `json:"schemas"`
+	StartIndex   int         `json:"startIndex"`
+
+	// TotalResults Number of groups matching the filter
+	TotalResults int `json:"totalResults"`
+}
+
+// ScimMember defines model for ScimMember.
+type ScimMember struct {
+	Display *string `json:"display,omitempty"`
+
+	// Value ID of the user or the group
+	Value string `json:"value"`
+}
+
+// ScimMeta Metadata of the resource, ignored in requests
+type ScimMeta struct {
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     *string    `json:"location,omitempty"`
+	ResourceType *string    `json:"resourceType,omitempty"`
+}
+
+// ScimName defines model for ScimName.
+type ScimName struct {
+	FamilyName *string `json:"familyName,omitempty"`
+	Formatted  *string `json:"formatted,omitempty"`
+	GivenName  *string `json:"givenName,omitempty"`
+}
+
+// ScimPatchOperation defines model for ScimPatchOperation.
+type ScimPatchOperation struct {
+	// Op add, remove or replace, case insensitive
+	Op string `json:"op"`
+
+	// Path Attribute to update, the value holds the attributes to update if empty
+	Path *string `json:"path,omitempty"`
+
+	// Value New value of the attribute
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// ScimPatchRequest defines model for ScimPatchRequest.
+type ScimPatchRequest struct {
+	Operations []ScimPatchOperation `json:"Operations"`
+	Schemas    *[]string            `json:"schemas,omitempty"`
+}
+
+// ScimUser SCIM 2.0 user. Attributes that aren't listed, like the ones of extension schemas, are accepted and ignored
+type ScimUser struct {
+	// Active Whether the user can sign in, defaults to true
+	Active      *bool   `json:"active,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Emails Emails of the user, the primary one replaces the userName as the email of the user
+	Emails *[]ScimEmail `json:"emails,omitempty"`
+
+	// ExternalId ID of the user in the identity provider
+	ExternalId *string `json:"externalId,omitempty"`
+
+	// Groups Roles of the user, ignored in requests
+	Groups *[]ScimMember `json:"groups,omitempty"`
+
+	// Id ID of the user, ignored in requests
+	Id *string `json:"id,omitempty"`
+
+	// Meta Metadata of the resource, ignored in requests
+	Meta    *ScimMeta `json:"meta,omitempty"`
+	Name    *ScimName `json:"name,omitempty"`
+	Schemas *[]string `json:"schemas,omitempty"`
+
+	// UserName Email of the user
+	UserName string `json:"userName"`
+}
+
+// ScimUserListResponse defines model for ScimUserListResponse.
+type ScimUserListResponse struct {
+	Resources    []ScimUser `json:"Resources"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Schemas      []string   `json:"schemas"`
+	StartIndex   int        `json:"startIndex"`
+
+	// TotalResults Number of users matching the filter
+	TotalResults int `json:"totalResults"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	AccessToken          string `json:"accessToken"`
+	AccessTokenExpiresIn int64  `json:"accessTokenExpiresIn"`
+
+	// RefreshToken Refresh token during authentication or when refreshing the JWT
+	RefreshToken string `json:"refreshToken"`
+
+	// RefreshTokenId Refresh token id
+	RefreshTokenId string `json:"refreshTokenId"`
+	User           *User  `json:"user,omitempty"`
+}
+
+// SessionPayload defines model for SessionPayload.
+type SessionPayload struct {
+	Session *Session `json:"session,omitempty"`
+}
+
+// SignInAnonymousRequest defines model for SignInAnonymousRequest.
+type SignInAnonymousRequest struct {
+	// CaptchaToken Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+	CaptchaToken *string `json:"captchaToken,omitempty"`
+	DisplayName  *string `json:"displayName,omitempty"`
+
+	// Locale A locale, such as en or fr-CA
+	Locale   *string                 `json:"locale,omitempty"`
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// SignInEmailPasswordRequest defines model for SignInEmailPasswordRequest.
+type SignInEmailPasswordRequest struct {
+	// Email A valid email
+	Email openapi_types.Email `json:"email"`
+
+	// Password A password of minimum 3 characters
+	Password string `json:"password"`
+}
+
+// SignInEmailPasswordResponse defines model for SignInEmailPasswordResponse.
+type SignInEmailPasswordResponse struct {
+	Mfa     *MFAChallengePayload `json:"mfa,omitempty"`
+	Session *Session             `json:"session,omitempty"`
+}
+
+// SignInIdTokenRequest defines model for SignInIdTokenRequest.
+type SignInIdTokenRequest struct {
+	// IdToken ID token issued by the provider to the native application
+	IdToken string `json:"idToken"`
+
+	// Nonce Nonce used when requesting the ID token. Required if the ID token contains a nonce
+	Nonce    *string                      `json:"nonce,omitempty"`
+	Options  *SignUpOptions               `json:"options,omitempty"`
+	Provider SignInIdTokenRequestProvider `json:"provider"`
+}
+
+// SignInIdTokenRequestProvider defines model for SignInIdTokenRequest.Provider.
+type SignInIdTokenRequestProvider string
+
+// SignInMfaRecoveryCodeRequest defines model for SignInMfaRecoveryCodeRequest.
+type SignInMfaRecoveryCodeRequest struct {
+	// RecoveryCode One of the recovery codes generated when MFA was activated
+	RecoveryCode string `json:"recoveryCode"`
+
+	// Ticket Ticket returned by the sign in challenge
+	Ticket string `json:"ticket"`
+}
+
+// SignInMfaTotpRequest defines model for SignInMfaTotpRequest.
+type SignInMfaTotpRequest struct {
+	// Otp One time password generated by the authenticator app
+	Otp string `json:"otp"`
+
+	// Ticket Ticket returned by the sign in challenge
+	Ticket string `json:"ticket"`
+}
+
+// SignInPATRequest defines model for SignInPATRequest.
+type SignInPATRequest struct {
+	// PersonalAccessToken PAT
+	PersonalAccessToken string `json:"personalAccessToken"`
+}
+
+// SignInPasswordlessEmailRequest defines model for SignInPasswordlessEmailRequest.
+type SignInPasswordlessEmailRequest struct {
+	// CaptchaToken Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+	CaptchaToken *string `json:"captchaToken,omitempty"`
+
+	// Email A valid email
+	Email   openapi_types.Email `json:"email"`
+	Options *SignUpOptions      `json:"options,omitempty"`
+}
+
+// SignInPasswordlessSmsOtpRequest defines model for SignInPasswordlessSmsOtpRequest.
+type SignInPasswordlessSmsOtpRequest struct {
+	// Otp One time password
+	Otp string `json:"otp"`
+
+	// PhoneNumber Phone number of the user
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// SignInPasswordlessSmsRequest defines model for SignInPasswordlessSmsRequest.
+type SignInPasswordlessSmsRequest struct {
+	// CaptchaToken Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+	CaptchaToken *string        `json:"captchaToken,omitempty"`
+	Options      *SignUpOptions `json:"options,omitempty"`
+
+	// PhoneNumber Phone number of the user, in the E.164 format
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// SignInProviderCallbackForm defines model for SignInProviderCallbackForm.
+type SignInProviderCallbackForm struct {
+	// Code Authorization code returned by the provider
+	Code *string `json:"code,omitempty"`
+
+	// Error Error returned by the provider
+	Error *string `json:"error,omitempty"`
+
+	// ErrorDescription Description of the error returned by the provider
+	ErrorDescription *string `json:"error_description,omitempty"`
+
+	// IdToken ID token returned by the provider
+	IdToken *string `json:"id_token,omitempty"`
+
+	// State State generated when the sign in was started
+	State string `json:"state"`
+
+	// User JSON encoded user information. Apple only sends it the first time the user authorizes the application
+	User                 *string                `json:"user,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SignInSAMLACSForm defines model for SignInSAMLACSForm.
+type SignInSAMLACSForm struct {
+	// RelayState State generated when the sign in was started
+	RelayState string `json:"RelayState"`
+
+	// SAMLResponse Base64 encoded response of the identity provider
+	SAMLResponse         string                 `json:"SAMLResponse"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SignInWebauthnRequest defines model for SignInWebauthnRequest.
+type SignInWebauthnRequest struct {
+	// Email A valid email. If omitted, the user will be resolved from a discoverable credential (passkey) during verification
+	Email *openapi_types.Email `json:"email,omitempty"`
+}
+
+// SignInWebauthnResponse defines model for SignInWebauthnResponse.
+type SignInWebauthnResponse = protocol.PublicKeyCredentialRequestOptions
+
+// SignInWebauthnVerifyRequest defines model for SignInWebauthnVerifyRequest.
+type SignInWebauthnVerifyRequest struct {
+	Credential protocol.CredentialAssertionResponse `json:"credential"`
+
+	// Email Deprecated, will be ignored. The user is resolved from the challenge
+	// Deprecated:
+	Email *openapi_types.Email `json:"email,omitempty"`
+}
+
+// SignOutRequest defines model for SignOutRequest.
+type SignOutRequest struct {
+	// All Sign out of every session of the user, requires an access token
+	All *bool `json:"all,omitempty"`
+
+	// RefreshToken Refresh token of the session to sign out of
+	RefreshToken string `json:"refreshToken"`
+}
+
+// SignUpEmailPasswordRequest defines model for SignUpEmailPasswordRequest.
+type SignUpEmailPasswordRequest struct {
+	// CaptchaToken Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+	CaptchaToken *string `json:"captchaToken,omitempty"`
+
+	// Email A valid email
+	Email   openapi_types.Email `json:"email"`
+	Options *SignUpOptions      `json:"options,omitempty"`
+
+	// Password A password of minimum 3 characters
+	Password string `json:"password"`
+}
+
+// SignUpOptions defines model for SignUpOptions.
+type SignUpOptions struct {
+	AllowedRoles *[]string `json:"allowedRoles,omitempty"`
+	DefaultRole  *string   `json:"defaultRole,omitempty"`
+	DisplayName  *string   `json:"displayName,omitempty"`
+
+	// Locale A locale, such as en or fr-CA
+	Locale     *string                 `json:"locale,omitempty"`
+	Metadata   *map[string]interface{} `json:"metadata,omitempty"`
+	RedirectTo *string                 `json:"redirectTo,omitempty"`
+}
+
+// SignUpWebauthnRequest defines model for SignUpWebauthnRequest.
+type SignUpWebauthnRequest struct {
+	// CaptchaToken Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+	CaptchaToken *string `json:"captchaToken,omitempty"`
+
+	// Email A valid email
+	Email   openapi_types.Email `json:"email"`
+	Options *SignUpOptions      `json:"options,omitempty"`
+}
+
+// SignUpWebauthnResponse defines model for SignUpWebauthnResponse.
+type SignUpWebauthnResponse = protocol.PublicKeyCredentialCreationOptions
+
+// SignUpWebauthnVerifyRequest defines model for SignUpWebauthnVerifyRequest.
+type SignUpWebauthnVerifyRequest struct {
+	Credential *protocol.CredentialCreationResponse `json:"credential,omitempty"`
+	Options    *struct {
+		AllowedRoles *[]string `json:"allowedRoles,omitempty"`
+		DefaultRole  *string   `json:"defaultRole,omitempty"`
+		DisplayName  *string   `json:"displayName,omitempty"`
+
+		// Locale A locale, such as en or fr-CA
+		Locale     *string                 `json:"locale,omitempty"`
+		Metadata   *map[string]interface{} `json:"metadata,omitempty"`
+		Nickname   *string                 `json:"nickname,omitempty"`
+		RedirectTo *string                 `json:"redirectTo,omitempty"`
+	} `json:"options,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// SwitchOrganizationRequest defines model for SwitchOrganizationRequest.
+type SwitchOrganizationRequest struct {
+	// OrganizationId ID of the organization, null to leave the organizations
+	OrganizationId *openapi_types.UUID `json:"organizationId"`
+}
+
+// TotpGenerateResponse defines model for TotpGenerateResponse.
+type TotpGenerateResponse struct {
+	// ImageUrl QR code of the TOTP secret as a data URL
+	ImageUrl string `json:"imageUrl"`
+
+	// TotpSecret TOTP secret to be stored in the authenticator app
+	TotpSecret string `json:"totpSecret"`
+}
+
+// User defines model for User.
+type User struct {
+	AvatarUrl   string    `json:"avatarUrl"`
+	CreatedAt   time.Time `json:"createdAt"`
+	DefaultRole string    `json:"defaultRole"`
+	DisplayName string    `json:"displayName"`
+
+	// Email A valid email
+	Email         *openapi_types.Email `json:"email,omitempty"`
+	EmailVerified bool                 `json:"emailVerified"`
+
+	// Id Id of the user
+	Id          string `json:"id"`
+	IsAnonymous bool   `json:"isAnonymous"`
+
+	// Locale A locale, such as en or fr-CA
+	Locale              string                 `json:"locale"`
+	Metadata            map[string]interface{} `json:"metadata"`
+	PhoneNumber         string                 `json:"phoneNumber"`
+	PhoneNumberVerified bool                   `json:"phoneNumberVerified"`
+	Roles               []string               `json:"roles"`
+}
+
+// UserAddSecurityKeyVerifyRequest defines model for UserAddSecurityKeyVerifyRequest.
+type UserAddSecurityKeyVerifyRequest struct {
+	Credential protocol.CredentialCreationResponse `json:"credential"`
+
+	// Nickname Optional nickname for the security key
+	Nickname *string `json:"nickname,omitempty"`
+}
+
+// UserAddSecurityKeyVerifyResponse defines model for UserAddSecurityKeyVerifyResponse.
+type UserAddSecurityKeyVerifyResponse struct {
+	// Id Security key id
+	Id string `json:"id"`
+
+	// Nickname Nickname of the security key
+	Nickname *string `json:"nickname,omitempty"`
+}
+
+// UserAvatar defines model for UserAvatar.
+type UserAvatar struct {
+	// AvatarUrl URL of the avatar of the user
+	AvatarUrl string `json:"avatarUrl"`
+}
+
+// UserDataExport defines model for UserDataExport.
+type UserDataExport struct {
+	AuditLogs  []AuditLog     `json:"auditLogs"`
+	ExportedAt time.Time      `json:"exportedAt"`
+	Profile    AdminUser      `json:"profile"`
+	Providers  []UserProvider `json:"providers"`
+	Sessions   []UserSession  `json:"sessions"`
+}
+
+// UserDeanonymizeRequest defines model for UserDeanonymizeRequest.
+type UserDeanonymizeRequest struct {
+	// Connection Deprecated, will be ignored
+	// Deprecated:
+	Connection *string `json:"connection,omitempty"`
+
+	// Email A valid email
+	Email   openapi_types.Email `json:"email"`
+	Options *SignUpOptions      `json:"options,omitempty"`
+
+	// Password A password of minimum 3 characters
+	Password *string `json:"password,omitempty"`
+
+	// SignInMethod Which sign-in method to use
+	SignInMethod UserDeanonymizeRequestSignInMethod `json:"signInMethod"`
+}
+
+// UserDeanonymizeRequestSignInMethod Which sign-in method to use
+type UserDeanonymizeRequestSignInMethod string
+
+// UserDeleteRequest defines model for UserDeleteRequest.
+type UserDeleteRequest struct {
+	Options *OptionsRedirectTo `json:"options,omitempty"`
+}
+
+// UserDeleteResponse defines model for UserDeleteResponse.
+type UserDeleteResponse struct {
+	// DeletionScheduledAt When the user is deleted unless the deletion is cancelled
+	DeletionScheduledAt time.Time `json:"deletionScheduledAt"`
+}
+
+// UserEmailChangeRequest defines model for UserEmailChangeRequest.
+type UserEmailChangeRequest struct {
+	// NewEmail A valid email
+	NewEmail openapi_types.Email `json:"newEmail"`
+	Options  *OptionsRedirectTo  `json:"options,omitempty"`
+}
+
+// UserEmailSendVerificationEmailRequest defines model for UserEmailSendVerificationEmailRequest.
+type UserEmailSendVerificationEmailRequest struct {
+	// Email A valid email
+	Email   openapi_types.Email `json:"email"`
+	Options *OptionsRedirectTo  `json:"options,omitempty"`
+}
+
+// UserMetadata defines model for UserMetadata.
+type UserMetadata map[string]interface{}
+
+// UserMetadataPatch JSON merge patch applied to the metadata of the user. Keys set to null are removed
+type UserMetadataPatch map[string]interface{}
+
+// UserMfaRequest defines model for UserMfaRequest.
+type UserMfaRequest struct {
+	// ActiveMfaType Multi-factor authentication type, an empty string deactivates it
+	ActiveMfaType *UserMfaRequestActiveMfaType `json:"activeMfaType,omitempty"`
+
+	// Code Code of the authenticator app, to confirm it's set up
+	Code string `json:"code"`
+}
+
+// UserMfaRequestActiveMfaType Multi-factor authentication type, an empty string deactivates it
+type UserMfaRequestActiveMfaType string
+
+// UserPasswordRequest defines model for UserPasswordRequest.
+type UserPasswordRequest struct {
+	// NewPassword The new password
+	NewPassword string `json:"newPassword"`
+
+	// Ticket Ticket of a password reset link, to set the password without an access token
+	Ticket *string `json:"ticket,omitempty"`
+}
+
+// UserPasswordResetRequest defines model for UserPasswordResetRequest.
+type UserPasswordResetRequest struct {
+	// CaptchaToken Token obtained from the captcha widget. Required when captcha verification is enabled for the endpoint
+	CaptchaToken *string `json:"captchaToken,omitempty"`
+
+	// Email A valid email
+	Email   openapi_types.Email `json:"email"`
+	Options *OptionsRedirectTo  `json:"options,omitempty"`
+
+	// RevokeSessions Sign out every session of the user once the password reset link is followed. Always enabled when AUTH_PASSWORD_RESET_REVOKE_SESSIONS is set
+	RevokeSessions *bool `json:"revokeSessions,omitempty"`
+}
+
+// UserPhoneNumberChangeRequest defines model for UserPhoneNumberChangeRequest.
+type UserPhoneNumberChangeRequest struct {
+	// NewPhoneNumber New phone number of the user, in the E.164 format
+	NewPhoneNumber string `json:"newPhoneNumber"`
+}
+
+// UserPhoneNumberChangeVerifyRequest defines model for UserPhoneNumberChangeVerifyRequest.
+type UserPhoneNumberChangeVerifyRequest struct {
+	// Otp One time password sent to the new phone number
+	Otp string `json:"otp"`
+}
+
+// UserProvider defines model for UserProvider.
+type UserProvider struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+
+	// Provider Name of the OAuth provider
+	Provider string `json:"provider"`
+}
+
+// UserProviderLinkResponse defines model for UserProviderLinkResponse.
+type UserProviderLinkResponse struct {
+	// Url URL the user needs to be sent to in the browser, it continues to the provider's authorization page
+	Url string `json:"url"`
+}
+
+// UserProviderTokensRequest defines model for UserProviderTokensRequest.
+type UserProviderTokensRequest struct {
+	// ProviderId Id of the provider
+	ProviderId string `json:"providerId"`
+
+	// UserId Id of the user
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// UserProvidersResponse defines model for UserProvidersResponse.
+type UserProvidersResponse struct {
+	Providers []UserProvider `json:"providers"`
+}
+
+// UserSession defines model for UserSession.
+type UserSession struct {
+	// City City the session was last used from, if a GeoIP database is configured
+	City *string `json:"city,omitempty"`
+
+	// ClientId App that started the session, sent in the X-Hasura-Auth-Client header
+	ClientId *string `json:"clientId,omitempty"`
+
+	// ClientVersion Version of the app that last used the session, sent in the X-Hasura-Auth-Client-Version header
+	ClientVersion *string `json:"clientVersion,omitempty"`
+
+	// Country ISO code of the country the session was last used from, if a GeoIP database is configured
+	Country *string `json:"country,omitempty"`
+
+	// CreatedAt When the user signed in
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt When the session expires unless it is refreshed
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id ID of the session's current refresh token
+	Id string `json:"id"`
+
+	// IpAddress IP address of the client that last used the session
+	IpAddress *string `json:"ipAddress,omitempty"`
+
+	// LastUsedAt When the user signed in or last refreshed the session
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// UserAgent User agent of the client that last used the session
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// UserSessionsResponse defines model for UserSessionsResponse.
+type UserSessionsResponse struct {
+	Sessions []UserSession `json:"sessions"`
+}
+
+// VerifyTokenRequest defines model for VerifyTokenRequest.
+type VerifyTokenRequest struct {
+	// Token Access token to verify
+	Token *string `json:"token,omitempty"`
+}
+
+// GetAdminAuditLogsParams defines parameters for GetAdminAuditLogs.
+type GetAdminAuditLogsParams struct {
+	// Limit Maximum number of entries to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of entries to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// UserId Only return the entries about this user
+	UserId *openapi_types.UUID `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// Event Only return the entries of this event
+	Event *string `form:"event,omitempty" json:"event,omitempty"`
+}
+
+// GetAdminEmailsFailedParams defines parameters for GetAdminEmailsFailed.
+type GetAdminEmailsFailedParams struct {
+	// Limit Maximum number of emails to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAdminUsersParams defines parameters for GetAdminUsers.
+type GetAdminUsersParams struct {
+	// Limit Maximum number of users to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Cursor returned by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Email Only return the users with a matching email, case insensitive. `*` matches any characters, i.e. `*@nhost.io`
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// Role Only return the users allowed to use this role
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Provider Only return the users linked to this provider, i.e. github
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+
+	// CreatedAfter Only return the users created at or after this time
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only return the users created before this time
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// Disabled Only return the users that are disabled, or not
+	Disabled *bool `form:"disabled,omitempty" json:"disabled,omitempty"`
+
+	// EmailVerified Only return the users that verified their email, or not
+	EmailVerified *bool `form:"emailVerified,omitempty" json:"emailVerified,omitempty"`
+
+	// SortBy Field to sort the users by
+	SortBy *AdminUsersSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// Order Sort order
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// PostAdminUsersImportParams defines parameters for PostAdminUsersImport.
+type PostAdminUsersImportParams struct {
+	// Format Format of the body
+	Format *ImportUsersFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetDataExportDownloadParams defines parameters for GetDataExportDownload.
+type GetDataExportDownloadParams struct {
+	// Ticket Signed download ticket
+	Ticket string `form:"ticket" json:"ticket"`
+}
+
+// GetForwardAuthVerifyParams defines parameters for GetForwardAuthVerify.
+type GetForwardAuthVerifyParams struct {
+	// Role Role the user must have, it is returned in X-Hasura-Role instead of the default role
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Authorization Access token of the user as a bearer token
+	Authorization *string `json:"Authorization,omitempty"`
+
+	// Cookie Cookies of the request, one of them can hold the access token
+	Cookie *string `json:"Cookie,omitempty"`
+}
+
+// GetOauthAuthorizeParams defines parameters for GetOauthAuthorize.
+type GetOauthAuthorizeParams struct {
+	// ResponseType Only the authorization code flow is supported
+	ResponseType string `form:"response_type" json:"response_type"`
+
+	// ClientId ID of the client
+	ClientId string `form:"client_id" json:"client_id"`
+
+	// RedirectUri URI to redirect the user to, it must be registered for the client
+	RedirectUri string `form:"redirect_uri" json:"redirect_uri"`
+
+	// Scope Space separated list of scopes, it must include openid. Other values than openid, profile, email and phone are roles to include in the access token
+	Scope string `form:"scope" json:"scope"`
+
+	// State Opaque value sent back to the client
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Nonce Value included in the ID token to prevent replay attacks
+	Nonce *string `form:"nonce,omitempty" json:"nonce,omitempty"`
+
+	// CodeChallenge PKCE code challenge (RFC 7636)
+	CodeChallenge *string `form:"code_challenge,omitempty" json:"code_challenge,omitempty"`
+
+	// CodeChallengeMethod PKCE code challenge method, only S256 is supported
+	CodeChallengeMethod *string `form:"code_challenge_method,omitempty" json:"code_challenge_method,omitempty"`
+}
+
+// PostOauthTokenParams defines parameters for PostOauthToken.
+type PostOauthTokenParams struct {
+	// Authorization Client ID and secret using HTTP basic authentication
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// GetScimV2GroupsParams defines parameters for GetScimV2Groups.
+type GetScimV2GroupsParams struct {
+	// Filter SCIM filter, only the eq operator is supported
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex 1-based index of the first result
+	StartIndex *int `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Maximum number of results to return, at most 100
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+
+	// ExcludedAttributes Comma separated attributes to leave out of the response, only members is supported
+	ExcludedAttributes *string `form:"excludedAttributes,omitempty" json:"excludedAttributes,omitempty"`
+}
+
+// GetScimV2UsersParams defines parameters for GetScimV2Users.
+type GetScimV2UsersParams struct {
+	// Filter SCIM filter, only the eq operator is supported
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex 1-based index of the first result
+	StartIndex *int `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Maximum number of results to return, at most 100
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetSigninProviderProviderParams defines parameters for GetSigninProviderProvider.
+type GetSigninProviderProviderParams struct {
+	// RedirectTo URL to redirect the user to once the sign in is completed
+	RedirectTo *string `form:"redirectTo,omitempty" json:"redirectTo,omitempty"`
+
+	// AllowedRoles Roles of the user if it is signing up
+	AllowedRoles *[]string `form:"allowedRoles,omitempty" json:"allowedRoles,omitempty"`
+
+	// DefaultRole Default role of the user if it is signing up
+	DefaultRole *string `form:"defaultRole,omitempty" json:"defaultRole,omitempty"`
+
+	// DisplayName Display name of the user if it is signing up
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty"`
+
+	// Locale Locale of the user if it is signing up
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Metadata JSON encoded metadata of the user if it is signing up
+	Metadata *string `form:"metadata,omitempty" json:"metadata,omitempty"`
+
+	// State State returned when starting to link or deanonymize with the provider, the flow continues in the user's browser. The other parameters are ignored
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// GetSigninProviderProviderCallbackParams defines parameters for GetSigninProviderProviderCallback.
+type GetSigninProviderProviderCallbackParams struct {
+	// State State generated when the sign in was started
+	State string `form:"state" json:"state"`
+
+	// Code Authorization code returned by the provider
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// Error Error returned by the provider
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+
+	// ErrorDescription Description of the error returned by the provider
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty"`
+
+	// Cookie Cookies of the request, one of them binds the sign in to the browser
+	Cookie *string `json:"Cookie,omitempty"`
+}
+
+// PostSigninProviderProviderCallbackParams defines parameters for PostSigninProviderProviderCallback.
+type PostSigninProviderProviderCallbackParams struct {
+	// Cookie Cookies of the request, one of them binds the sign in to the browser
+	Cookie *string `json:"Cookie,omitempty"`
+}
+
+// GetSigninSamlParams defines parameters for GetSigninSaml.
+type GetSigninSamlParams struct {
+	// RedirectTo URL to redirect the user to once the sign in is completed
+	RedirectTo *string `form:"redirectTo,omitempty" json:"redirectTo,omitempty"`
+
+	// AllowedRoles Roles of the user if it is signing up
+	AllowedRoles *[]string `form:"allowedRoles,omitempty" json:"allowedRoles,omitempty"`
+
+	// DefaultRole Default role of the user if it is signing up
+	DefaultRole *string `form:"defaultRole,omitempty" json:"defaultRole,omitempty"`
+
+	// DisplayName Display name of the user if it is signing up
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty"`
+
+	// Locale Locale of the user if it is signing up
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty"`
+
+	// Metadata JSON encoded metadata of the user if it is signing up
+	Metadata *string `form:"metadata,omitempty" json:"metadata,omitempty"`
+}
+
+// GetVerifyParams defines parameters for GetVerify.
+type GetVerifyParams struct {
+	// Ticket Ticket sent to the user's email
+	Ticket string `form:"ticket" json:"ticket"`
+
+	// Type Type of the ticket
+	Type GetVerifyParamsType `form:"type" json:"type"`
+
+	// RedirectTo URL to redirect the user to once the ticket has been verified
+	RedirectTo string `form:"redirectTo" json:"redirectTo"`
+}
+
+// GetVerifyParamsType defines parameters for GetVerify.
+type GetVerifyParamsType string
+
+// PostAdminOauth2ClientsJSONRequestBody defines body for PostAdminOauth2Clients for application/json ContentType.
+type PostAdminOauth2ClientsJSONRequestBody = CreateOAuth2ClientRequest
+
+// PostAdminRolesJSONRequestBody defines body for PostAdminRoles for application/json ContentType.
+type PostAdminRolesJSONRequestBody = AdminRoleRequest
+
+// PutAdminSignInMethodsMethodJSONRequestBody defines body for PutAdminSignInMethodsMethod for application/json ContentType.
+type PutAdminSignInMethodsMethodJSONRequestBody = AdminSignInMethodRequest
+
+// PostAdminUsersUserIdBanJSONRequestBody defines body for PostAdminUsersUserIdBan for application/json ContentType.
+type PostAdminUsersUserIdBanJSONRequestBody = BanUserRequest
+
+// PostAdminUsersUserIdImpersonateJSONRequestBody defines body for PostAdminUsersUserIdImpersonate for application/json ContentType.
+type PostAdminUsersUserIdImpersonateJSONRequestBody = ImpersonateUserRequest
+
+// PostAdminUsersUserIdPasswordJSONRequestBody defines body for PostAdminUsersUserIdPassword for application/json ContentType.
+type PostAdminUsersUserIdPasswordJSONRequestBody = AdminSetPasswordRequest
+
+// PostAdminUsersUserIdPasswordResetJSONRequestBody defines body for PostAdminUsersUserIdPasswordReset for application/json ContentType.
+type PostAdminUsersUserIdPasswordResetJSONRequestBody = AdminPasswordResetRequest
+
+// PostAdminUsersUserIdRolesJSONRequestBody defines body for PostAdminUsersUserIdRoles for application/json ContentType.
+type PostAdminUsersUserIdRolesJSONRequestBody = AdminRoleRequest
+
+// PostDeviceTokenJSONRequestBody defines body for PostDeviceToken for application/json ContentType.
+type PostDeviceTokenJSONRequestBody = DeviceTokenRequest
+
+// PostDeviceVerifyJSONRequestBody defines body for PostDeviceVerify for application/json ContentType.
+type PostDeviceVerifyJSONRequestBody = DeviceVerifyRequest
+
+// PostElevateJSONRequestBody defines body for PostElevate for application/json ContentType.
+type PostElevateJSONRequestBody = ElevateRequest
+
+// PostElevateWebauthnVerifyJSONRequestBody defines body for PostElevateWebauthnVerify for application/json ContentType.
+type PostElevateWebauthnVerifyJSONRequestBody = SignInWebauthnVerifyRequest
+
+// PostMfaTotpEnableJSONRequestBody defines body for PostMfaTotpEnable for application/json ContentType.
+type PostMfaTotpEnableJSONRequestBody = MfaTotpEnableRequest
+
+// PostOauthAuthorizeJSONRequestBody defines body for PostOauthAuthorize for application/json ContentType.
+type PostOauthAuthorizeJSONRequestBody = OAuth2AuthorizeRequest
+
+// PostOauthIntrospectFormdataRequestBody defines body for PostOauthIntrospect for application/x-www-form-urlencoded ContentType.
+type PostOauthIntrospectFormdataRequestBody = IntrospectRequest
+
+// PostOauthTokenFormdataRequestBody defines body for PostOauthToken for application/x-www-form-urlencoded ContentType.
+type PostOauthTokenFormdataRequestBody = OAuth2TokenRequest
+
+// PostOrganizationsJSONRequestBody defines body for PostOrganizations for application/json ContentType.
+type PostOrganizationsJSONRequestBody = CreateOrganizationRequest
+
+// PostOrganizationsInvitesAcceptJSONRequestBody defines body for PostOrganizationsInvitesAccept for application/json ContentType.
+type PostOrganizationsInvitesAcceptJSONRequestBody = AcceptOrganizationInviteRequest
+
+// PostOrganizationsSwitchJSONRequestBody defines body for PostOrganizationsSwitch for application/json ContentType.
+type PostOrganizationsSwitchJSONRequestBody = SwitchOrganizationRequest
+
+// PostOrganizationsOrganizationIdInvitesJSONRequestBody defines body for PostOrganizationsOrganizationIdInvites for application/json ContentType.
+type PostOrganizationsOrganizationIdInvitesJSONRequestBody = OrganizationInviteRequest
+
+// PostPatJSONRequestBody defines body for PostPat for application/json ContentType.
+type PostPatJSONRequestBody = CreatePATRequest
+
+// PostScimV2GroupsApplicationScimPlusJSONRequestBody defines body for PostScimV2Groups for application/scim+json ContentType.
+type PostScimV2GroupsApplicationScimPlusJSONRequestBody = ScimGroup
+
+// PatchScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2GroupsGroupId for application/scim+json ContentType.
+type PatchScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// PutScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody defines body for PutScimV2GroupsGroupId for application/scim+json ContentType.
+type PutScimV2GroupsGroupIdApplicationScimPlusJSONRequestBody = ScimGroup
+
+// PostScimV2UsersApplicationScimPlusJSONRequestBody defines body for PostScimV2Users for application/scim+json ContentType.
+type PostScimV2UsersApplicationScimPlusJSONRequestBody = ScimUser
+
+// PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2UsersUserId for application/scim+json ContentType.
+type PatchScimV2UsersUserIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody defines body for PutScimV2UsersUserId for application/scim+json ContentType.
+type PutScimV2UsersUserIdApplicationScimPlusJSONRequestBody = ScimUser
+
+// PostSigninAnonymousJSONRequestBody defines body for PostSigninAnonymous for application/json ContentType.
+type PostSigninAnonymousJSONRequestBody = SignInAnonymousRequest
+
+// PostSigninEmailPasswordJSONRequestBody defines body for PostSigninEmailPassword for application/json ContentType.
+type PostSigninEmailPasswordJSONRequestBody = SignInEmailPasswordRequest
+
+// PostSigninIdtokenJSONRequestBody defines body for PostSigninIdtoken for application/json ContentType.
+type PostSigninIdtokenJSONRequestBody = SignInIdTokenRequest
+
+// PostSigninMfaRecoveryCodeJSONRequestBody defines body for PostSigninMfaRecoveryCode for application/json ContentType.
+type PostSigninMfaRecoveryCodeJSONRequestBody = SignInMfaRecoveryCodeRequest
+
+// PostSigninMfaTotpJSONRequestBody defines body for PostSigninMfaTotp for application/json ContentType.
+type PostSigninMfaTotpJSONRequestBody = SignInMfaTotpRequest
+
+// PostSigninPasswordlessEmailJSONRequestBody defines body for PostSigninPasswordlessEmail for application/json ContentType.
+type PostSigninPasswordlessEmailJSONRequestBody = SignInPasswordlessEmailRequest
+
+// PostSigninPasswordlessSmsJSONRequestBody defines body for PostSigninPasswordlessSms for application/json ContentType.
+type PostSigninPasswordlessSmsJSONRequestBody = SignInPasswordlessSmsRequest
+
+// PostSigninPasswordlessSmsOtpJSONRequestBody defines body for PostSigninPasswordlessSmsOtp for application/json ContentType.
+type PostSigninPasswordlessSmsOtpJSONRequestBody = SignInPasswordlessSmsOtpRequest
+
+// PostSigninPatJSONRequestBody defines body for PostSigninPat for application/json ContentType.
+type PostSigninPatJSONRequestBody = SignInPATRequest
+
+// PostSigninProviderProviderCallbackFormdataRequestBody defines body for PostSigninProviderProviderCallback for application/x-www-form-urlencoded ContentType.
+type PostSigninProviderProviderCallbackFormdataRequestBody = SignInProviderCallbackForm
+
+// PostSigninSamlAcsFormdataRequestBody defines body for PostSigninSamlAcs for application/x-www-form-urlencoded ContentType.
+type PostSigninSamlAcsFormdataRequestBody = SignInSAMLACSForm
+
+// PostSigninWebauthnJSONRequestBody defines body for PostSigninWebauthn for application/json ContentType.
+type PostSigninWebauthnJSONRequestBody = SignInWebauthnRequest
+
+// PostSigninWebauthnVerifyJSONRequestBody defines body for PostSigninWebauthnVerify for application/json ContentType.
+type PostSigninWebauthnVerifyJSONRequestBody = SignInWebauthnVerifyRequest
+
+// PostSignoutJSONRequestBody defines body for PostSignout for application/json ContentType.
+type PostSignoutJSONRequestBody = SignOutRequest
+
+// PostSignupEmailPasswordJSONRequestBody defines body for PostSignupEmailPassword for application/json ContentType.
+type PostSignupEmailPasswordJSONRequestBody = SignUpEmailPasswordRequest
+
+// PostSignupWebauthnJSONRequestBody defines body for PostSignupWebauthn for application/json ContentType.
+type PostSignupWebauthnJSONRequestBody = SignUpWebauthnRequest
+
+// PostSignupWebauthnVerifyJSONRequestBody defines body for PostSignupWebauthnVerify for application/json ContentType.
+type PostSignupWebauthnVerifyJSONRequestBody = SignUpWebauthnVerifyRequest
+
+// PostTokenJSONRequestBody defines body for PostToken for application/json ContentType.
+type PostTokenJSONRequestBody = RefreshTokenRequest
+
+// PostTokenVerifyJSONRequestBody defines body for PostTokenVerify for application/json ContentType.
+type PostTokenVerifyJSONRequestBody = VerifyTokenRequest
+
+// PostUserDeanonymizeJSONRequestBody defines body for PostUserDeanonymize for application/json ContentType.
+type PostUserDeanonymizeJSONRequestBody = UserDeanonymizeRequest
+
+// PostUserDeanonymizeProviderProviderJSONRequestBody defines body for PostUserDeanonymizeProviderProvider for application/json ContentType.
+type PostUserDeanonymizeProviderProviderJSONRequestBody = SignUpOptions
+
+// PostUserDeleteJSONRequestBody defines body for PostUserDelete for application/json ContentType.
+type PostUserDeleteJSONRequestBody = UserDeleteRequest
+
+// PostUserEmailChangeJSONRequestBody defines body for PostUserEmailChange for application/json ContentType.
+type PostUserEmailChangeJSONRequestBody = UserEmailChangeRequest
+
+// PostUserEmailSendVerificationEmailJSONRequestBody defines body for PostUserEmailSendVerificationEmail for application/json ContentType.
+type PostUserEmailSendVerificationEmailJSONRequestBody = UserEmailSendVerificationEmailRequest
+
+// PatchUserMetadataJSONRequestBody defines body for PatchUserMetadata for application/json ContentType.
+type PatchUserMetadataJSONRequestBody = UserMetadataPatch
+
+// PostUserMfaJSONRequestBody defines body for PostUserMfa for application/json ContentType.
+type PostUserMfaJSONRequestBody = UserMfaRequest
+
+// PostUserPasswordJSONRequestBody defines body for PostUserPassword for application/json ContentType.
+type PostUserPasswordJSONRequestBody = UserPasswordRequest
+
+// PostUserPasswordResetJSONRequestBody defines body for PostUserPasswordReset for application/json ContentType.
+type PostUserPasswordResetJSONRequestBody = UserPasswordResetRequest
+
+// PostUserPhoneNumberChangeJSONRequestBody defines body for PostUserPhoneNumberChange for application/json ContentType.
+type PostUserPhoneNumberChangeJSONRequestBody = UserPhoneNumberChangeRequest
+
+// PostUserPhoneNumberChangeVerifyJSONRequestBody defines body for PostUserPhoneNumberChangeVerify for application/json ContentType.
+type PostUserPhoneNumberChangeVerifyJSONRequestBody = UserPhoneNumberChangeVerifyRequest
+
+// PostUserProviderTokensJSONRequestBody defines body for PostUserProviderTokens for application/json ContentType.
+type PostUserProviderTokensJSONRequestBody = UserProviderTokensRequest
+
+// PostUserProvidersProviderLinkJSONRequestBody defines body for PostUserProvidersProviderLink for application/json ContentType.
+type PostUserProvidersProviderLinkJSONRequestBody = OptionsRedirectTo
+
+// PostUserWebauthnVerifyJSONRequestBody defines body for PostUserWebauthnVerify for application/json ContentType.
+type PostUserWebauthnVerifyJSONRequestBody = UserAddSecurityKeyVerifyRequest
+
+// Getter for additional properties for SignInProviderCallbackForm. Returns the specified
+// element and whether it was found
+func (a SignInProviderCallbackForm) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for SignInProviderCallbackForm
+func (a *SignInProviderCallbackForm) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for SignInProviderCallbackForm to handle AdditionalProperties
+func (a *SignInProviderCallbackForm) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["code"]; found {
+		err = json.Unmarshal(raw, &a.Code)
+		if err != nil {
+			return fmt.Errorf("error reading 'code': %w", err)
+		}
+		delete(object, "code")
+	}
+
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
+
+	if raw, found := object["error_description"]; found {
+		err = json.Unmarshal(raw, &a.ErrorDescription)
+		if err != nil {
+			return fmt.Errorf("error reading 'error_description': %w", err)
+		}
+		delete(object, "error_description")
+	}
+
+	if raw, found := object["id_token"]; found {
+		err = json.Unmarshal(raw, &a.IdToken)
+		if err != nil {
+			return fmt.Errorf("error reading 'id_token': %w", err)
+		}
+		delete(object, "id_token")
+	}
+
+	if raw, found := object["state"]; found {
+		err = json.Unmarshal(raw, &a.State)
+		if err != nil {
+			return fmt.Errorf("error reading 'state': %w", err)
+		}
+		delete(object, "state")
+	}
+
+	if raw, found := object["user"]; found {
+		err = json.Unmarshal(raw, &a.User)
+		if err != nil {
+			return fmt.Errorf("error reading 'user': %w", err)
+		}
+		delete(object, "user")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SignInProviderCallbackForm to handle AdditionalProperties
+func (a SignInProviderCallbackForm) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Code != nil {
+		object["code"], err = json.Marshal(a.Code)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'code': %w", err)
+		}
+	}
+
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
+	}
+
+	if a.ErrorDescription != nil {
+		object["error_description"], err = json.Marshal(a.ErrorDescription)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error_description': %w", err)
+		}
+	}
+
+	if a.IdToken != nil {
+		object["id_token"], err = json.Marshal(a.IdToken)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'id_token': %w", err)
+		}
+	}
+
+	object["state"], err = json.Marshal(a.State)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'state': %w", err)
+	}
+
+	if a.User != nil {
+		object["user"], err = json.Marshal(a.User)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'user': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for SignInSAMLACSForm. Returns the specified
+// element and whether it was found
+func (a SignInSAMLACSForm) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for SignInSAMLACSForm
+func (a *SignInSAMLACSForm) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for SignInSAMLACSForm to handle AdditionalProperties
+func (a *SignInSAMLACSForm) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["RelayState"]; found {
+		err = json.Unmarshal(raw, &a.RelayState)
+		if err != nil {
+			return fmt.Errorf("error reading 'RelayState': %w", err)
+		}
+		delete(object, "RelayState")
+	}
+
+	if raw, found := object["SAMLResponse"]; found {
+		err = json.Unmarshal(raw, &a.SAMLResponse)
+		if err != nil {
+			return fmt.Errorf("error reading 'SAMLResponse': %w", err)
+		}
+		delete(object, "SAMLResponse")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SignInSAMLACSForm to handle AdditionalProperties
+func (a SignInSAMLACSForm) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["RelayState"], err = json.Marshal(a.RelayState)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'RelayState': %w", err)
+	}
+
+	object["SAMLResponse"], err = json.Marshal(a.SAMLResponse)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'SAMLResponse': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
 // Getter for additional properties for SignUpWebauthnVerifyRequest. Returns the specified
 // element and whether it was found
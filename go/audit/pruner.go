@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+type PrunerDB interface {
+	DeleteAuditLogsBefore(ctx context.Context, createdAt pgtype.Timestamptz) (int64, error)
+}
+
+// Pruner deletes the entries of the audit log older than the retention period.
+type Pruner struct {
+	db        PrunerDB
+	retention time.Duration
+	logger    *slog.Logger
+}
+
+func NewPruner(db PrunerDB, retention time.Duration, logger *slog.Logger) *Pruner {
+	return &Pruner{
+		db:        db,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Prune deletes the entries older than the retention period and returns how many were
+// deleted.
+func (p *Pruner) Prune(ctx context.Context) (int64, error) {
+	n, err := p.db.DeleteAuditLogsBefore(ctx, sql.TimestampTz(time.Now().Add(-p.retention)))
+	if err != nil {
+		return 0, fmt.Errorf("error deleting audit logs: %w", err)
+	}
+
+	return n, nil
+}
+
+// Run prunes the audit log right away and then every interval until the context is done.
+func (p *Pruner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := p.Prune(ctx)
+		if err != nil {
+			p.logger.Error("error pruning audit log", slog.String("error", err.Error()))
+		} else if n > 0 {
+			p.logger.Info("pruned audit log", slog.Int64("deleted", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/audit"
+)
+
+type fakePrunerDB struct {
+	createdAt pgtype.Timestamptz
+	deleted   int64
+	err       error
+}
+
+func (db *fakePrunerDB) DeleteAuditLogsBefore(
+	_ context.Context, createdAt pgtype.Timestamptz,
+) (int64, error) {
+	db.createdAt = createdAt
+	return db.deleted, db.err
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	errDB := errors.New("db is down") //nolint:goerr113
+
+	cases := []struct {
+		name        string
+		db          *fakePrunerDB
+		expected    int64
+		expectedErr error
+	}{
+		{
+			name:        "success",
+			db:          &fakePrunerDB{createdAt: pgtype.Timestamptz{}, deleted: 3, err: nil},
+			expected:    3,
+			expectedErr: nil,
+		},
+		{
+			name:        "error",
+			db:          &fakePrunerDB{createdAt: pgtype.Timestamptz{}, deleted: 0, err: errDB},
+			expected:    0,
+			expectedErr: errDB,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pruner := audit.NewPruner(tc.db, 24*time.Hour, slog.Default())
+
+			n, err := pruner.Prune(context.Background())
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if n != tc.expected {
+				t.Errorf("expected %d deleted, got %d", tc.expected, n)
+			}
+
+			cutoff := time.Now().Add(-24 * time.Hour)
+			if d := tc.db.createdAt.Time.Sub(cutoff).Abs(); d > time.Minute {
+				t.Errorf("unexpected cutoff %v, expected around %v", tc.db.createdAt.Time, cutoff)
+			}
+		})
+	}
+}
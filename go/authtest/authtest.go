@@ -0,0 +1,375 @@
+// Package authtest runs the hasura-auth API in process, against a throwaway schema of a
+// PostgreSQL database, so applications can write integration tests against it without
+// mocks of their own. Emails and SMS messages are captured instead of being sent.
+package authtest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/migrate"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/migrations"
+	ginmiddleware "github.com/oapi-codegen/gin-middleware"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// EnvPostgresConnection is the environment variable with the connection URI of the
+// database the servers are created in when Options.Postgres isn't set.
+const EnvPostgresConnection = "AUTHTEST_POSTGRES_CONNECTION"
+
+// Options of the server, the zero value is a server with the defaults of hasura-auth.
+type Options struct {
+	// Postgres is the connection URI of the database, the value of
+	// AUTHTEST_POSTGRES_CONNECTION if empty. Tests are skipped if there is none.
+	Postgres string
+	// Config changes the configuration of the server before it starts.
+	Config func(config *controller.Config)
+	// Logger gets the logs of the server, they are discarded if nil.
+	Logger *slog.Logger
+}
+
+// Server is a hasura-auth server running in process for the duration of a test. Each
+// server migrates its own schema, which is dropped when the test ends, so tests using
+// different servers can run in parallel against the same database.
+type Server struct {
+	// URL of the API, i.e. http://127.0.0.1:41234
+	URL string
+	// AdminSecret is the secret of the admin endpoints, sent in x-hasura-admin-secret.
+	AdminSecret string
+	// Emails captures the emails sent.
+	Emails *Outbox
+	// SMS captures the SMS messages sent.
+	SMS *Outbox
+	// DB runs the queries of hasura-auth against the schema of the server.
+	DB *sql.Queries
+	// Pool is the connection to the database, the tables are in Schema, not in auth.
+	Pool   *pgxpool.Pool
+	Schema string
+
+	config    controller.Config
+	jwtGetter *controller.JWTGetter
+	server    *httptest.Server
+}
+
+func randomHex(t testing.TB, n int) string {
+	t.Helper()
+
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("failed to generate random bytes: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// defaultConfig is the configuration of hasura-auth with its default values.
+func defaultConfig(serverURL *url.URL, jwtSecret, adminSecret string) controller.Config {
+	clientURL, _ := url.Parse("http://localhost:3000")
+
+	return controller.Config{ //nolint:exhaustruct
+		HasuraAdminSecret:          adminSecret,
+		ClientURL:                  clientURL,
+		ServerURL:                  serverURL,
+		DefaultAllowedRoles:        []string{"user", "me"},
+		DefaultRole:                "user",
+		DefaultLocale:              "en",
+		AllowedLocales:             []string{"en"},
+		AvatarProvider:             controller.AvatarProviderNone,
+		GravatarDefault:            "blank",
+		GravatarRating:             "g",
+		AvatarSize:                 256, //nolint:mnd
+		PasswordMinLength:          3,   //nolint:mnd
+		PasswordHashAlgorithm:      controller.PasswordHashAlgorithmBcrypt,
+		RefreshTokenExpiresIn:      2592000, //nolint:mnd
+		AccessTokenExpiresIn:       900,     //nolint:mnd
+		ElevatedTokenExpiresIn:     300,     //nolint:mnd
+		RefreshTokenExpiration:     controller.RefreshTokenExpirationSliding,
+		SessionLimitPolicy:         controller.SessionLimitPolicyEvictOldest,
+		ImpossibleTravelPolicy:     controller.ImpossibleTravelPolicyOff,
+		DisposableEmailCheck:       controller.DisposableEmailCheckOff,
+		JWTSecret:                  jwtSecret,
+		WebauthnRPName:             "authtest",
+		WebauhtnAttestationTimeout: time.Minute,
+		TokenExchangeExpiresIn:     300, //nolint:mnd
+		EmailVerificationCooldown:  time.Minute,
+	}
+}
+
+// migrateSchema creates the schema and applies the migrations of hasura-auth in it.
+func migrateSchema(ctx context.Context, connection, schema string, logger *slog.Logger) error {
+	conn, err := pgx.Connect(ctx, connection)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer conn.Close(ctx)
+
+	all, err := migrate.Load(migrations.FS)
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	if _, err := migrate.NewMigrator(conn, all, schema, logger).Apply(ctx); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	return nil
+}
+
+func newRouter(ctrl *controller.Controller, logger *slog.Logger) (*gin.Engine, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(api.OpenAPISchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI schema: %w", err)
+	}
+	doc.AddServer(&openapi3.Server{URL: "/"}) //nolint:exhaustruct
+
+	router := gin.New()
+	if err := middleware.SetTrustedProxies(router, nil); err != nil {
+		return nil, fmt.Errorf("problem setting trusted proxies: %w", err)
+	}
+	router.Use(gin.Recovery(), middleware.Logger(logger), middleware.Client())
+
+	handler := api.NewStrictHandler(ctrl, []api.StrictMiddlewareFunc{
+		ctrl.Audit,
+		ctrl.Metrics,
+		ctrl.RequiresElevation,
+		ctrl.Captcha,
+		ctrl.RateLimit,
+	})
+	mw := api.MiddlewareFunc(ginmiddleware.OapiRequestValidatorWithOptions(
+		doc,
+		&ginmiddleware.Options{ //nolint:exhaustruct
+			Options: openapi3filter.Options{ //nolint:exhaustruct
+				AuthenticationFunc: ctrl.AuthenticationFunc,
+			},
+			SilenceServersWarning: true,
+		},
+	))
+	api.RegisterHandlersWithOptions(router, handler, api.GinServerOptions{
+		BaseURL:      "",
+		Middlewares:  []api.MiddlewareFunc{mw},
+		ErrorHandler: nil,
+	})
+
+	return router, nil
+}
+
+// New starts a server for the test, it is stopped and its schema dropped when the test
+// ends. The test is skipped if there is no database to run it against.
+func New(t testing.TB, opts Options) *Server { //nolint:funlen
+	t.Helper()
+
+	connection := opts.Postgres
+	if connection == "" {
+		connection = os.Getenv(EnvPostgresConnection)
+	}
+	if connection == "" {
+		t.Skipf("%s isn't set, skipping test against hasura-auth", EnvPostgresConnection)
+	}
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	gin.SetMode(gin.ReleaseMode)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	pool, err := pgxpool.New(ctx, connection)
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	schema := "authtest_" + randomHex(t, 8) //nolint:mnd
+	t.Cleanup(func() {
+		_, err := pool.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+schema+" CASCADE")
+		if err != nil {
+			t.Errorf("failed to drop schema %s: %v", schema, err)
+		}
+		pool.Close()
+	})
+
+	if err := migrateSchema(ctx, connection, schema, logger); err != nil {
+		t.Fatalf("failed to migrate schema %s: %v", schema, err)
+	}
+
+	db := sql.New(sql.NewSchemaDBTX(sql.NewTxDBTX(pool, pool), schema))
+
+	server := httptest.NewUnstartedServer(nil)
+	serverURL := &url.URL{Scheme: "http", Host: server.Listener.Addr().String()} //nolint:exhaustruct
+
+	jwtSecret := fmt.Sprintf(`{"type":"HS256","key":"%s"}`, randomHex(t, 32)) //nolint:mnd
+	config := defaultConfig(serverURL, jwtSecret, randomHex(t, 16))           //nolint:mnd
+	if opts.Config != nil {
+		opts.Config(&config)
+	}
+
+	jwtGetter, err := controller.NewJWTGetter(
+		[]byte(config.JWTSecret),
+		nil,
+		time.Duration(config.AccessTokenExpiresIn)*time.Second,
+		nil,
+		"",
+		false,
+		db,
+	)
+	if err != nil {
+		t.Fatalf("failed to create jwt getter: %v", err)
+	}
+
+	outbox := NewOutbox()
+	sms := NewOutbox()
+	ctrl, err := controller.New(config, controller.Dependencies{ //nolint:exhaustruct
+		DB:        db,
+		JWTGetter: jwtGetter,
+		Emailer:   outbox,
+		SMS:       sms,
+		Version:   "authtest",
+	})
+	if err != nil {
+		t.Fatalf("failed to create controller: %v", err)
+	}
+
+	router, err := newRouter(ctrl, logger)
+	if err != nil {
+		t.Fatalf("failed to create router: %v", err)
+	}
+
+	server.Config.Handler = router
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return &Server{
+		URL:         server.URL,
+		AdminSecret: config.HasuraAdminSecret,
+		Emails:      outbox,
+		SMS:         sms,
+		DB:          db,
+		Pool:        pool,
+		Schema:      schema,
+		config:      config,
+		jwtGetter:   jwtGetter,
+		server:      server,
+	}
+}
+
+// Client returns an HTTP client for the server.
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// Config returns the configuration of the server.
+func (s *Server) Config() controller.Config {
+	return s.config
+}
+
+// JWTGetter returns what signs and validates the access tokens of the server.
+func (s *Server) JWTGetter() *controller.JWTGetter {
+	return s.jwtGetter
+}
+
+// AccessToken mints an access token for the user with the roles, signed like the ones the
+// server issues. All the roles are allowed, the first one is the default role. The user
+// doesn't need to exist unless the token is sent to the server.
+func (s *Server) AccessToken(t testing.TB, userID uuid.UUID, roles ...string) string {
+	t.Helper()
+
+	if len(roles) == 0 {
+		roles = []string{s.config.DefaultRole}
+	}
+
+	token, _, err := s.jwtGetter.GetToken(
+		context.Background(), userID, false, roles, roles[0], nil, slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("failed to mint access token: %v", err)
+	}
+
+	return token
+}
+
+// NewRequest returns a request to the endpoint of the server, at path, with body encoded as
+// JSON if not nil.
+func (s *Server) NewRequest(t testing.TB, method, path string, body any) *http.Request {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("failed to encode request: %v", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), method, s.URL+path, reader)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req
+}
+
+// Do sends the request and decodes the response into response, if not nil and the request
+// succeeded. It returns the status of the response.
+func (s *Server) Do(t testing.TB, req *http.Request, response any) int {
+	t.Helper()
+
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if response != nil && resp.StatusCode < http.StatusMultipleChoices {
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+	}
+
+	return resp.StatusCode
+}
+
+// SignUp signs up a user with email and password and returns their session. The email
+// the user is sent, if any, is in Emails.
+func (s *Server) SignUp(t testing.TB, email, password string) *api.Session {
+	t.Helper()
+
+	req := s.NewRequest(t, http.MethodPost, "/signup/email-password", api.SignUpEmailPasswordRequest{
+		CaptchaToken: nil,
+		Email:        types.Email(email),
+		Options:      nil,
+		Password:     password,
+	})
+
+	var payload api.SessionPayload
+	if status := s.Do(t, req, &payload); status != http.StatusOK {
+		t.Fatalf("failed to sign up %s: status %d", email, status)
+	}
+
+	return payload.Session
+}
@@ -0,0 +1,99 @@
+package authtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/authtest"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+func TestOutbox(t *testing.T) {
+	t.Parallel()
+
+	outbox := authtest.NewOutbox()
+	ctx := context.Background()
+
+	_ = outbox.SendEmail(
+		ctx, "jane@acme.com", "en", notifications.TemplateNameEmailVerify,
+		notifications.TemplateData{Link: "https://acme.com/verify?ticket=1"}, //nolint:exhaustruct
+	)
+	_ = outbox.SendSMS(
+		ctx, "+123456789", "en", notifications.TemplateNameSigninPasswordlessSMS,
+		notifications.TemplateData{Code: "123456"}, //nolint:exhaustruct
+	)
+	_ = outbox.SendEmail(
+		ctx, "jane@acme.com", "en", notifications.TemplateNameEmailVerify,
+		notifications.TemplateData{Link: "https://acme.com/verify?ticket=2"}, //nolint:exhaustruct
+	)
+
+	if got := len(outbox.Messages()); got != 3 {
+		t.Fatalf("expected 3 messages, got %d", got)
+	}
+
+	got, ok := outbox.Last("jane@acme.com", notifications.TemplateNameEmailVerify)
+	if !ok {
+		t.Fatal("expected a message")
+	}
+	want := authtest.Message{
+		To:       "jane@acme.com",
+		Locale:   "en",
+		Template: notifications.TemplateNameEmailVerify,
+		Data:     notifications.TemplateData{Link: "https://acme.com/verify?ticket=2"}, //nolint:exhaustruct
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected message (-want +got):\n%s", diff)
+	}
+
+	if _, ok := outbox.Last("john@acme.com", notifications.TemplateNameEmailVerify); ok {
+		t.Error("expected no message to john@acme.com")
+	}
+
+	outbox.Reset()
+	if got := len(outbox.Messages()); got != 0 {
+		t.Errorf("expected no messages after reset, got %d", got)
+	}
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	s := authtest.New(t, authtest.Options{}) //nolint:exhaustruct
+
+	session := s.SignUp(t, "jane@acme.com", "Str0ngPassw0rd!")
+	if session == nil || session.User == nil {
+		t.Fatal("expected a session")
+	}
+	if _, err := s.JWTGetter().Validate(session.AccessToken); err != nil {
+		t.Errorf("expected a valid access token: %v", err)
+	}
+
+	userID, err := uuid.Parse(session.User.Id)
+	if err != nil {
+		t.Fatalf("unexpected user id: %v", err)
+	}
+
+	req := s.NewRequest(t, http.MethodGet, "/user/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+s.AccessToken(t, userID, "user"))
+	var sessions api.UserSessionsResponse
+	if status := s.Do(t, req, &sessions); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if len(sessions.Sessions) != 1 {
+		t.Errorf("expected 1 session, got %d", len(sessions.Sessions))
+	}
+
+	req = s.NewRequest(t, http.MethodGet, "/admin/users", nil)
+	req.Header.Set("X-Hasura-Admin-Secret", s.AdminSecret)
+	var users api.AdminUsersResponse
+	if status := s.Do(t, req, &users); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if len(users.Users) != 1 {
+		t.Errorf("expected 1 user, got %d", len(users.Users))
+	}
+}
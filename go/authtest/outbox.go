@@ -0,0 +1,96 @@
+package authtest
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+// Message is an email or an SMS message sent by the server.
+type Message struct {
+	To       string
+	Locale   string
+	Template notifications.TemplateName
+	Data     notifications.TemplateData
+}
+
+// Outbox captures the messages sent by the server instead of delivering them. It is both
+// the Emailer and the SMSSender of the controller.
+type Outbox struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewOutbox() *Outbox {
+	return &Outbox{
+		mu:       sync.Mutex{},
+		messages: nil,
+	}
+}
+
+func (o *Outbox) send(to, locale string, template notifications.TemplateName, data notifications.TemplateData) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.messages = append(o.messages, Message{
+		To:       to,
+		Locale:   locale,
+		Template: template,
+		Data:     data,
+	})
+}
+
+func (o *Outbox) SendEmail(
+	_ context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	o.send(to, locale, templateName, data)
+	return nil
+}
+
+func (o *Outbox) SendSMS(
+	_ context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	o.send(to, locale, templateName, data)
+	return nil
+}
+
+// Messages returns the messages sent so far, oldest first.
+func (o *Outbox) Messages() []Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return slices.Clone(o.messages)
+}
+
+// Last returns the last message sent to the recipient with the template, i.e. to get the
+// link or the code of a sign in.
+func (o *Outbox) Last(to string, template notifications.TemplateName) (Message, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := len(o.messages) - 1; i >= 0; i-- {
+		if o.messages[i].To == to && o.messages[i].Template == template {
+			return o.messages[i], true
+		}
+	}
+
+	return Message{}, false //nolint:exhaustruct
+}
+
+// Reset drops the messages sent so far.
+func (o *Outbox) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.messages = nil
+}
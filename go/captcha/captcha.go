@@ -0,0 +1,118 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	ProviderTurnstile = "turnstile"
+	ProviderHCaptcha  = "hcaptcha"
+	ProviderRecaptcha = "recaptcha"
+)
+
+var ErrUnsupportedProvider = errors.New("unsupported captcha provider")
+
+func verifyURL(provider string) (string, error) {
+	switch provider {
+	case ProviderTurnstile:
+		return "https://challenges.cloudflare.com/turnstile/v0/siteverify", nil
+	case ProviderHCaptcha:
+		return "https://api.hcaptcha.com/siteverify", nil
+	case ProviderRecaptcha:
+		return "https://www.google.com/recaptcha/api/siteverify", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedProvider, provider)
+	}
+}
+
+// Verifier checks captcha tokens against the siteverify endpoint of the provider. Turnstile,
+// hCaptcha and reCAPTCHA share the same request and response format.
+type Verifier struct {
+	url      string
+	secret   string
+	minScore float64
+	cl       *http.Client
+}
+
+// NewVerifier returns a verifier for the given provider. minScore only applies to providers
+// returning a score, like reCAPTCHA v3, and tokens scoring below it are rejected.
+func NewVerifier(provider string, secret string, minScore float64) (*Verifier, error) {
+	u, err := verifyURL(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verifier{
+		url:      u,
+		secret:   secret,
+		minScore: minScore,
+		cl:       &http.Client{}, //nolint:exhaustruct
+	}, nil
+}
+
+type verifyResponse struct {
+	Success    bool     `json:"success"`
+	Score      *float64 `json:"score,omitempty"`
+	ErrorCodes []string `json:"error-codes,omitempty"` //nolint:tagliatelle
+}
+
+// Verify returns true if the provider accepts the token. remoteIP is optional and only
+// sent so the provider can use it in its own checks.
+func (v *Verifier) Verify(ctx context.Context, token string, remoteIP string) (bool, error) {
+	form := url.Values{
+		"secret":   []string{v.secret},
+		"response": []string{token},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return false, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.cl.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return false, fmt.Errorf( //nolint:goerr113
+			"unexpected status code verifying captcha: %d: %s", resp.StatusCode, string(b),
+		)
+	}
+
+	var res verifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return false, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	// the secret being wrong is a configuration problem and not the user's fault
+	if slices.Contains(res.ErrorCodes, "invalid-input-secret") {
+		return false, fmt.Errorf("invalid captcha secret: %v", res.ErrorCodes) //nolint:goerr113
+	}
+
+	if !res.Success {
+		return false, nil
+	}
+
+	if res.Score != nil && *res.Score < v.minScore {
+		return false, nil
+	}
+
+	return true, nil
+}
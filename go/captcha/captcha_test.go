@@ -0,0 +1,139 @@
+package captcha_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/captcha"
+)
+
+func TestNewVerifier(t *testing.T) {
+	t.Parallel()
+
+	for _, provider := range []string{
+		captcha.ProviderTurnstile, captcha.ProviderHCaptcha, captcha.ProviderRecaptcha,
+	} {
+		if _, err := captcha.NewVerifier(provider, "secret", 0); err != nil {
+			t.Errorf("unexpected error for %s: %v", provider, err)
+		}
+	}
+
+	if _, err := captcha.NewVerifier("unknown", "secret", 0); !errors.Is(
+		err, captcha.ErrUnsupportedProvider,
+	) {
+		t.Errorf("expected ErrUnsupportedProvider, got %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		remoteIP    string
+		minScore    float64
+		status      int
+		response    string
+		expected    bool
+		expectedErr bool
+	}{
+		{
+			name:        "success",
+			remoteIP:    "192.168.1.1",
+			minScore:    0,
+			status:      http.StatusOK,
+			response:    `{"success":true}`,
+			expected:    true,
+			expectedErr: false,
+		},
+		{
+			name:        "invalid token",
+			remoteIP:    "",
+			minScore:    0,
+			status:      http.StatusOK,
+			response:    `{"success":false,"error-codes":["invalid-input-response"]}`,
+			expected:    false,
+			expectedErr: false,
+		},
+		{
+			name:        "score above minimum",
+			remoteIP:    "",
+			minScore:    0.5,
+			status:      http.StatusOK,
+			response:    `{"success":true,"score":0.9}`,
+			expected:    true,
+			expectedErr: false,
+		},
+		{
+			name:        "score below minimum",
+			remoteIP:    "",
+			minScore:    0.5,
+			status:      http.StatusOK,
+			response:    `{"success":true,"score":0.1}`,
+			expected:    false,
+			expectedErr: false,
+		},
+		{
+			name:        "invalid secret",
+			remoteIP:    "",
+			minScore:    0,
+			status:      http.StatusOK,
+			response:    `{"success":false,"error-codes":["invalid-input-secret"]}`,
+			expected:    false,
+			expectedErr: true,
+		},
+		{
+			name:        "server error",
+			remoteIP:    "",
+			minScore:    0,
+			status:      http.StatusInternalServerError,
+			response:    `oops`,
+			expected:    false,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if err := r.ParseForm(); err != nil {
+						t.Errorf("error parsing form: %s", err)
+					}
+					if got := r.PostForm.Get("secret"); got != "my-secret" {
+						t.Errorf("unexpected secret: %s", got)
+					}
+					if got := r.PostForm.Get("response"); got != "my-token" {
+						t.Errorf("unexpected response: %s", got)
+					}
+					if got := r.PostForm.Get("remoteip"); got != tc.remoteIP {
+						t.Errorf("unexpected remoteip: %s", got)
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			v, err := captcha.NewVerifier(captcha.ProviderTurnstile, "my-secret", tc.minScore)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			v.SetURL(server.URL)
+
+			got, err := v.Verify(context.Background(), "my-token", tc.remoteIP)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
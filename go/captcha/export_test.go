@@ -0,0 +1,5 @@
+package captcha
+
+func (v *Verifier) SetURL(url string) {
+	v.url = url
+}
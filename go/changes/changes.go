@@ -0,0 +1,164 @@
+// Package changes subscribes to the changes of the rows hasura-auth caches, notified by
+// PostgreSQL on the hasura_auth_changes channel, so every instance can invalidate its
+// caches as soon as another one, or anything else, changes the database.
+package changes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Channel is the channel the auth.notify_change trigger notifies the changes on.
+const Channel = "hasura_auth_changes"
+
+// reconnectBackoff is how long the listener waits before connecting again after losing
+// its connection.
+const reconnectBackoff = 5 * time.Second
+
+// Change is a change to a row of table. UserID is the id of the user the row belongs to,
+// if any. Changes without table nor user mean any row may have changed, i.e. changes were
+// missed while the listener was disconnected.
+type Change struct {
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+	UserID string `json:"userId"`
+}
+
+// Handler is called with the changes of the tables it subscribed to. Handlers run one after
+// the other as the notifications arrive so they should return quickly.
+type Handler func(ctx context.Context, change Change)
+
+// Conn is a connection listening to notifications, i.e. a *pgx.Conn.
+type Conn interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	WaitForNotification(ctx context.Context) (*pgconn.Notification, error)
+	Close(ctx context.Context) error
+}
+
+// Connect opens a connection to listen to notifications.
+type Connect func(ctx context.Context) (Conn, error)
+
+// NewConnect returns a Connect opening connections to the database of connString.
+// Notifications need a session of their own so they can't go through a pool.
+func NewConnect(connString string) Connect {
+	return func(ctx context.Context) (Conn, error) {
+		conn, err := pgx.Connect(ctx, connString)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to database: %w", err)
+		}
+		return conn, nil
+	}
+}
+
+// Listener dispatches the changes to the tables of schema to the handlers subscribed to
+// them.
+type Listener struct {
+	connect  Connect
+	schema   string
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	logger   *slog.Logger
+}
+
+func NewListener(connect Connect, schema string, logger *slog.Logger) *Listener {
+	return &Listener{
+		connect:  connect,
+		schema:   schema,
+		mu:       sync.RWMutex{},
+		handlers: make(map[string][]Handler),
+		logger:   logger,
+	}
+}
+
+// Subscribe calls handler with the changes to table.
+func (l *Listener) Subscribe(table string, handler Handler) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handlers[table] = append(l.handlers[table], handler)
+}
+
+// Dispatch calls the handlers subscribed to the table of the change, or all of them for
+// changes without table.
+func (l *Listener) Dispatch(ctx context.Context, change Change) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if change.Table == "" {
+		for _, handlers := range l.handlers {
+			for _, handler := range handlers {
+				handler(ctx, change)
+			}
+		}
+		return
+	}
+
+	for _, handler := range l.handlers[change.Table] {
+		handler(ctx, change)
+	}
+}
+
+func (l *Listener) dispatchPayload(ctx context.Context, payload string) {
+	var change Change
+	if err := json.Unmarshal([]byte(payload), &change); err != nil {
+		l.logger.Warn(
+			"error decoding change notification",
+			slog.String("payload", payload),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if change.Schema != l.schema {
+		return
+	}
+
+	l.Dispatch(ctx, change)
+}
+
+// Listen listens to the changes until the connection fails or the context is done.
+func (l *Listener) Listen(ctx context.Context) error {
+	conn, err := l.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.WithoutCancel(ctx)) //nolint:errcheck
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return fmt.Errorf("error listening to %s: %w", Channel, err)
+	}
+
+	// changes made before listening are missed
+	l.Dispatch(ctx, Change{Schema: l.schema, Table: "", UserID: ""})
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("error waiting for notification: %w", err)
+		}
+
+		l.dispatchPayload(ctx, notification.Payload)
+	}
+}
+
+// Run listens to the changes until the context is done, connecting again every time the
+// connection fails.
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		if err := l.Listen(ctx); err != nil && ctx.Err() == nil {
+			l.logger.Error("error listening to changes", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectBackoff):
+		}
+	}
+}
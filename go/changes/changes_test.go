@@ -0,0 +1,132 @@
+package changes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nhost/hasura-auth/go/changes"
+)
+
+var errConnClosed = errors.New("conn closed")
+
+// fakeConn returns the notifications with payloads and then fails.
+type fakeConn struct {
+	payloads []string
+	listened []string
+}
+
+func (c *fakeConn) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	c.listened = append(c.listened, sql)
+	return pgconn.CommandTag{}, nil
+}
+
+func (c *fakeConn) WaitForNotification(context.Context) (*pgconn.Notification, error) {
+	if len(c.payloads) == 0 {
+		return nil, errConnClosed
+	}
+
+	payload := c.payloads[0]
+	c.payloads = c.payloads[1:]
+	return &pgconn.Notification{PID: 1, Channel: changes.Channel, Payload: payload}, nil
+}
+
+func (c *fakeConn) Close(context.Context) error {
+	return nil
+}
+
+func TestListener(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		schema   string
+		payloads []string
+		expected map[string][]changes.Change
+	}{
+		{
+			name:     "everything is invalidated on connect",
+			schema:   "auth",
+			payloads: nil,
+			expected: map[string][]changes.Change{
+				"roles":      {{Schema: "auth", Table: "", UserID: ""}},
+				"user_roles": {{Schema: "auth", Table: "", UserID: ""}},
+			},
+		},
+		{
+			name:   "changes go to the handlers of their table",
+			schema: "auth",
+			payloads: []string{
+				`{"schema":"auth","table":"user_roles","userId":"db477732-48fa-4289-b694-2886a646b6eb"}`,
+				`{"schema":"auth","table":"roles","userId":null}`,
+				`{"schema":"auth","table":"users","userId":"db477732-48fa-4289-b694-2886a646b6eb"}`,
+			},
+			expected: map[string][]changes.Change{
+				"roles": {
+					{Schema: "auth", Table: "", UserID: ""},
+					{Schema: "auth", Table: "roles", UserID: ""},
+				},
+				"user_roles": {
+					{Schema: "auth", Table: "", UserID: ""},
+					{
+						Schema: "auth",
+						Table:  "user_roles",
+						UserID: "db477732-48fa-4289-b694-2886a646b6eb",
+					},
+				},
+			},
+		},
+		{
+			name:   "other schemas and invalid payloads are ignored",
+			schema: "shop_auth",
+			payloads: []string{
+				`{"schema":"auth","table":"roles","userId":null}`,
+				`not json`,
+				`{"schema":"shop_auth","table":"roles","userId":null}`,
+			},
+			expected: map[string][]changes.Change{
+				"roles": {
+					{Schema: "shop_auth", Table: "", UserID: ""},
+					{Schema: "shop_auth", Table: "roles", UserID: ""},
+				},
+				"user_roles": {{Schema: "shop_auth", Table: "", UserID: ""}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &fakeConn{payloads: tc.payloads, listened: nil}
+			listener := changes.NewListener(
+				func(context.Context) (changes.Conn, error) { return conn, nil },
+				tc.schema,
+				slog.New(slog.NewTextHandler(io.Discard, nil)),
+			)
+
+			got := make(map[string][]changes.Change)
+			for _, table := range []string{"roles", "user_roles"} {
+				listener.Subscribe(table, func(_ context.Context, change changes.Change) {
+					got[table] = append(got[table], change)
+				})
+			}
+
+			if err := listener.Listen(context.Background()); !errors.Is(err, errConnClosed) {
+				t.Fatalf("expected error %v, got %v", errConnClosed, err)
+			}
+
+			if diff := cmp.Diff([]string{"LISTEN " + changes.Channel}, conn.listened); diff != "" {
+				t.Errorf("unexpected statements (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected changes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagAccountDeletionEnabled         = "account-deletion-enabled"
+	flagAccountDeletionGracePeriodDays = "account-deletion-grace-period-days"
+	flagAccountDeletionMode            = "account-deletion-mode"
+)
+
+// accountDeletionInterval is how often the users whose grace period is over are deleted.
+const accountDeletionInterval = 10 * time.Minute
+
+func accountDeletionFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagAccountDeletionEnabled,
+			Usage:    "Allow users to delete their own account",
+			Value:    false,
+			Category: "account-deletion",
+			EnvVars:  []string{"AUTH_ACCOUNT_DELETION_ENABLED"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagAccountDeletionGracePeriodDays,
+			Usage:    "Days users can cancel the deletion of their account for before it is deleted",
+			Value:    30, //nolint:mnd
+			Category: "account-deletion",
+			EnvVars:  []string{"AUTH_ACCOUNT_DELETION_GRACE_PERIOD_DAYS"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagAccountDeletionMode,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					string(controller.AccountDeletionModeDelete),
+					string(controller.AccountDeletionModeAnonymize),
+				},
+				Default: string(controller.AccountDeletionModeDelete),
+			},
+			Usage:    "Delete the users or only erase their personal data, keeping their rows so tables referencing auth.users stay valid", //nolint:lll
+			Category: "account-deletion",
+			EnvVars:  []string{"AUTH_ACCOUNT_DELETION_MODE"},
+		},
+	}
+}
+
+// startAccountDeleter deletes the users whose grace period is over in the background. It
+// runs even if account deletion is disabled so deletions already scheduled go through.
+func startAccountDeleter(cCtx *cli.Context, db *sql.Queries, logger *slog.Logger) error {
+	if cCtx.Int(flagAccountDeletionGracePeriodDays) <= 0 {
+		return errors.New("account deletion grace period days must be positive") //nolint:goerr113
+	}
+
+	deleter := controller.NewAccountDeleter(
+		db,
+		controller.AccountDeletionMode(GetEnumValue(cCtx, flagAccountDeletionMode)),
+		logger.With(slog.String("component", "account-deletion")),
+	)
+	go deleter.Run(cCtx.Context, accountDeletionInterval)
+
+	return nil
+}
@@ -0,0 +1,613 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagAdminURL            = "url"
+	flagAdminJSON           = "json"
+	flagAdminLimit          = "limit"
+	flagAdminCursor         = "cursor"
+	flagAdminEmail          = "email"
+	flagAdminRole           = "role"
+	flagAdminProvider       = "provider"
+	flagAdminPassword       = "password"
+	flagAdminDisplayName    = "display-name"
+	flagAdminDefaultRole    = "default-role"
+	flagAdminRoles          = "roles"
+	flagAdminEmailVerified  = "email-verified"
+	flagAdminReason         = "reason"
+	flagAdminUntil          = "until"
+	flagAdminUser           = "user"
+	flagAdminImpersonatedBy = "impersonated-by"
+)
+
+// adminRequestTimeout is how long a request of the admin CLI may take.
+const adminRequestTimeout = 30 * time.Second
+
+var errAdminRequest = errors.New("admin request failed")
+
+// adminClient calls the admin endpoints, either of a running server or of a router built
+// in process from the configuration.
+type adminClient struct {
+	baseURL string
+	secret  string
+	client  *http.Client
+}
+
+// handlerTransport serves the requests with handler instead of sending them.
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	t.handler.ServeHTTP(rec, req)
+	return rec.Result(), nil
+}
+
+// rootContext returns the context of the root command, which has the flags of the server.
+func rootContext(cCtx *cli.Context) *cli.Context {
+	root := cCtx
+	for _, c := range cCtx.Lineage() {
+		if c.Command != nil {
+			root = c
+		}
+	}
+	return root
+}
+
+// getAdminClient returns a client of the server at the admin url, or of a router using the
+// database directly if there is none, along with a function releasing it.
+func getAdminClient(cCtx *cli.Context) (*adminClient, func(), error) {
+	if baseURL := cCtx.String(flagAdminURL); baseURL != "" {
+		return &adminClient{
+			baseURL: strings.TrimSuffix(baseURL, "/"),
+			secret:  cCtx.String(flagHasuraAdminSecret),
+			client:  &http.Client{Timeout: adminRequestTimeout}, //nolint:exhaustruct
+		}, func() {}, nil
+	}
+
+	config, err := newServeConfig(rootContext(cCtx))
+	if err != nil {
+		return nil, nil, err
+	}
+	c, _, err := config.load(cCtx.Context, config.file)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err = overrideContext(c, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// workers started by the router stop once the command is done
+	ctx, cancel := context.WithCancel(cCtx.Context)
+	c.Context = ctx
+
+	// the output of the commands goes to stdout, only problems are logged
+	gin.SetMode(gin.ReleaseMode)
+	logger := slog.New(slog.NewTextHandler(cCtx.App.ErrWriter, &slog.HandlerOptions{
+		AddSource:   false,
+		Level:       slog.LevelWarn,
+		ReplaceAttr: middleware.RedactAttr,
+	}))
+
+	db, closeDB, err := getDB(c, logger)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	router, _, err := getRouter(c, sql.New(db), nil, logger)
+	if err != nil {
+		closeDB()
+		cancel()
+		return nil, nil, err
+	}
+
+	client := &adminClient{
+		baseURL: "http://localhost" + strings.TrimSuffix(c.String(flagAPIPrefix), "/"),
+		secret:  c.String(flagHasuraAdminSecret),
+		client: &http.Client{ //nolint:exhaustruct
+			Transport: handlerTransport{handler: router},
+			Timeout:   adminRequestTimeout,
+		},
+	}
+
+	return client, func() {
+		cancel()
+		closeDB()
+	}, nil
+}
+
+// do sends the request and decodes the response into response, if not nil. Bodies that
+// aren't readers are sent as JSON.
+func (c *adminClient) do(
+	ctx context.Context, method, path string, query url.Values, body any, response any,
+) error {
+	contentType := "application/json"
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		contentType = "application/octet-stream"
+		reader = b
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Hasura-Admin-Secret", c.secret)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		var errResp api.ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp.Error == "" {
+			return fmt.Errorf("%w: %s", errAdminRequest, resp.Status)
+		}
+		return fmt.Errorf("%w: %s: %s", errAdminRequest, errResp.Error, errResp.Message)
+	}
+
+	if response == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// adminAction runs action with a client, the response it returns is printed as JSON with
+// --json and with print otherwise.
+func adminAction[T any](
+	action func(cCtx *cli.Context, client *adminClient) (T, error),
+	print func(w io.Writer, response T),
+) cli.ActionFunc {
+	return func(cCtx *cli.Context) error {
+		client, closeClient, err := getAdminClient(cCtx)
+		if err != nil {
+			return err
+		}
+		defer closeClient()
+
+		response, err := action(cCtx, client)
+		if err != nil {
+			return err
+		}
+
+		if cCtx.Bool(flagAdminJSON) {
+			enc := json.NewEncoder(cCtx.App.Writer)
+			enc.SetIndent("", "  ")
+			return enc.Encode(response) //nolint:wrapcheck
+		}
+
+		print(cCtx.App.Writer, response)
+		return nil
+	}
+}
+
+// adminArg returns the only argument of the command, named name in the errors.
+func adminArg(cCtx *cli.Context, name string) (string, error) {
+	if cCtx.NArg() != 1 {
+		return "", fmt.Errorf("expected the %s as the only argument", name) //nolint:goerr113
+	}
+	return cCtx.Args().First(), nil
+}
+
+func printOK(w io.Writer, _ api.OKResponse) {
+	fmt.Fprintln(w, "OK")
+}
+
+func printUsers(w io.Writer, response api.AdminUsersResponse) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd
+	fmt.Fprintln(tw, "ID\tEMAIL\tDISPLAY NAME\tDEFAULT ROLE\tROLES\tSTATUS\tCREATED AT")
+	for _, user := range response.Users {
+		email := ""
+		if user.Email != nil {
+			email = string(*user.Email)
+		}
+
+		status := "active"
+		switch {
+		case user.Disabled:
+			status = "disabled"
+		case user.BannedAt != nil:
+			status = "banned"
+		}
+
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			user.Id, email, user.DisplayName, user.DefaultRole, strings.Join(user.Roles, ","),
+			status, user.CreatedAt.Format(time.RFC3339),
+		)
+	}
+	_ = tw.Flush()
+
+	if response.NextCursor != nil {
+		fmt.Fprintf(w, "\nnext page: --%s %s\n", flagAdminCursor, *response.NextCursor)
+	}
+}
+
+func usersList(cCtx *cli.Context, client *adminClient) (api.AdminUsersResponse, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(cCtx.Int(flagAdminLimit)))
+	for param, flagName := range map[string]string{
+		"cursor":   flagAdminCursor,
+		"email":    flagAdminEmail,
+		"role":     flagAdminRole,
+		"provider": flagAdminProvider,
+	} {
+		if value := cCtx.String(flagName); value != "" {
+			query.Set(param, value)
+		}
+	}
+
+	var response api.AdminUsersResponse
+	err := client.do(cCtx.Context, http.MethodGet, "/admin/users", query, nil, &response)
+	return response, err
+}
+
+// adminImportUser is the line of the import creating the user, see ImportUser.
+type adminImportUser struct {
+	ID            uuid.UUID `json:"id"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"emailVerified"`
+	DisplayName   string    `json:"displayName,omitempty"`
+	DefaultRole   string    `json:"defaultRole,omitempty"`
+	Roles         []string  `json:"roles,omitempty"`
+}
+
+type adminCreatedUser struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// usersCreate imports the user so it gets the same defaults as imported users, then sets
+// its password so the password policy applies.
+func usersCreate(cCtx *cli.Context, client *adminClient) (adminCreatedUser, error) {
+	user := adminImportUser{
+		ID:            uuid.New(),
+		Email:         cCtx.String(flagAdminEmail),
+		EmailVerified: cCtx.Bool(flagAdminEmailVerified),
+		DisplayName:   cCtx.String(flagAdminDisplayName),
+		DefaultRole:   cCtx.String(flagAdminDefaultRole),
+		Roles:         cCtx.StringSlice(flagAdminRoles),
+	}
+	line, err := json.Marshal(user)
+	if err != nil {
+		return adminCreatedUser{}, fmt.Errorf("failed to encode user: %w", err)
+	}
+
+	var imported api.ImportUsersResponse
+	if err := client.do(
+		cCtx.Context,
+		http.MethodPost,
+		"/admin/users/import",
+		url.Values{"format": []string{string(api.Ndjson)}},
+		bytes.NewReader(line),
+		&imported,
+	); err != nil {
+		return adminCreatedUser{}, err
+	}
+	if imported.Imported == 0 {
+		if len(imported.Errors) > 0 {
+			return adminCreatedUser{}, fmt.Errorf("%w: %s", errAdminRequest, imported.Errors[0].Error)
+		}
+		return adminCreatedUser{}, fmt.Errorf("%w: user not imported", errAdminRequest)
+	}
+
+	if password := cCtx.String(flagAdminPassword); password != "" {
+		if err := client.do(
+			cCtx.Context,
+			http.MethodPost,
+			"/admin/users/"+user.ID.String()+"/password",
+			nil,
+			api.AdminSetPasswordRequest{Password: &password, PasswordHash: nil, RevokeSessions: nil},
+			nil,
+		); err != nil {
+			return adminCreatedUser{}, fmt.Errorf("user %s created without a password: %w", user.ID, err)
+		}
+	}
+
+	return adminCreatedUser{ID: user.ID}, nil
+}
+
+func usersBan(cCtx *cli.Context, client *adminClient) (api.OKResponse, error) {
+	userID, err := adminArg(cCtx, "user id")
+	if err != nil {
+		return "", err
+	}
+
+	var response api.OKResponse
+	err = client.do(
+		cCtx.Context,
+		http.MethodPost,
+		"/admin/users/"+url.PathEscape(userID)+"/ban",
+		nil,
+		api.BanUserRequest{Reason: cCtx.String(flagAdminReason), ExpiresAt: cCtx.Timestamp(flagAdminUntil)},
+		&response,
+	)
+	return response, err
+}
+
+func sessionsRevoke(cCtx *cli.Context, client *adminClient) (api.OKResponse, error) {
+	userID, err := adminArg(cCtx, "user id")
+	if err != nil {
+		return "", err
+	}
+
+	var response api.OKResponse
+	err = client.do(
+		cCtx.Context,
+		http.MethodPost,
+		"/admin/users/"+url.PathEscape(userID)+"/sessions/revoke-all",
+		nil,
+		nil,
+		&response,
+	)
+	return response, err
+}
+
+// rolesAdd creates the role, or adds it to the user with --user.
+func rolesAdd(cCtx *cli.Context, client *adminClient) (api.OKResponse, error) {
+	role, err := adminArg(cCtx, "role")
+	if err != nil {
+		return "", err
+	}
+
+	path := "/admin/roles"
+	if userID := cCtx.String(flagAdminUser); userID != "" {
+		path = "/admin/users/" + url.PathEscape(userID) + "/roles"
+	}
+
+	var response api.OKResponse
+	err = client.do(
+		cCtx.Context, http.MethodPost, path, nil, api.AdminRoleRequest{Role: role}, &response,
+	)
+	return response, err
+}
+
+func tokenMint(cCtx *cli.Context, client *adminClient) (api.ImpersonationResponse, error) {
+	userID, err := adminArg(cCtx, "user id")
+	if err != nil {
+		return api.ImpersonationResponse{}, err
+	}
+
+	var role *string
+	if r := cCtx.String(flagAdminRole); r != "" {
+		role = &r
+	}
+
+	var response api.ImpersonationResponse
+	err = client.do(
+		cCtx.Context,
+		http.MethodPost,
+		"/admin/users/"+url.PathEscape(userID)+"/impersonate",
+		nil,
+		api.ImpersonateUserRequest{ImpersonatedBy: cCtx.String(flagAdminImpersonatedBy), Role: role},
+		&response,
+	)
+	return response, err
+}
+
+func adminUsersCommand() *cli.Command {
+	return &cli.Command{ //nolint: exhaustruct
+		Name:  "users",
+		Usage: "Manage users",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List the users, optionally filtered",
+				Flags: []cli.Flag{
+					&cli.IntFlag{ //nolint: exhaustruct
+						Name:  flagAdminLimit,
+						Usage: "Maximum number of users to return",
+						Value: 20, //nolint:mnd
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminCursor,
+						Usage: "Cursor of the page to return, printed after the previous page",
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminEmail,
+						Usage: "Only list the users with a matching email, * matches any characters",
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminRole,
+						Usage: "Only list the users allowed to use this role",
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminProvider,
+						Usage: "Only list the users linked to this provider",
+					},
+				},
+				Action: adminAction(usersList, printUsers),
+			},
+			{
+				Name:  "create",
+				Usage: "Create a user and print its id",
+				Flags: []cli.Flag{
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:     flagAdminEmail,
+						Usage:    "Email of the user",
+						Required: true,
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:    flagAdminPassword,
+						Usage:   "Password of the user, it must comply with the password policy. The user can't sign in with a password if missing", //nolint:lll
+						EnvVars: []string{"AUTH_ADMIN_USER_PASSWORD"},
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminDisplayName,
+						Usage: "Display name of the user, the email if missing",
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminDefaultRole,
+						Usage: "Default role of the user, the default role if missing",
+					},
+					&cli.StringSliceFlag{ //nolint: exhaustruct
+						Name:  flagAdminRoles,
+						Usage: "Roles of the user, the default allowed roles if missing",
+					},
+					&cli.BoolFlag{ //nolint: exhaustruct
+						Name:  flagAdminEmailVerified,
+						Usage: "Mark the email of the user as verified",
+					},
+				},
+				Action: adminAction(usersCreate, func(w io.Writer, user adminCreatedUser) {
+					fmt.Fprintln(w, user.ID)
+				}),
+			},
+			{
+				Name:      "ban",
+				Usage:     "Ban a user and revoke all their sessions",
+				ArgsUsage: " <user-id>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:     flagAdminReason,
+						Usage:    "Why the user is banned, only visible to administrators",
+						Required: true,
+					},
+					&cli.TimestampFlag{ //nolint: exhaustruct
+						Name:   flagAdminUntil,
+						Usage:  "When the ban expires in RFC 3339 format, the ban is permanent if missing",
+						Layout: time.RFC3339,
+					},
+				},
+				Action: adminAction(usersBan, printOK),
+			},
+		},
+	}
+}
+
+func adminSessionsCommand() *cli.Command {
+	return &cli.Command{ //nolint: exhaustruct
+		Name:  "sessions",
+		Usage: "Manage sessions",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "revoke",
+				Usage:     "Revoke all the sessions of a user, including personal access tokens",
+				ArgsUsage: " <user-id>",
+				Action:    adminAction(sessionsRevoke, printOK),
+			},
+		},
+	}
+}
+
+func adminRolesCommand() *cli.Command {
+	return &cli.Command{ //nolint: exhaustruct
+		Name:  "roles",
+		Usage: "Manage roles",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "add",
+				Usage:     "Create a role, or add it to a user with --user",
+				ArgsUsage: " <role>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminUser,
+						Usage: "ID of the user to add the role to, the role must exist",
+					},
+				},
+				Action: adminAction(rolesAdd, printOK),
+			},
+		},
+	}
+}
+
+func adminTokenCommand() *cli.Command {
+	return &cli.Command{ //nolint: exhaustruct
+		Name:  "token",
+		Usage: "Issue tokens",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "mint",
+				Usage:     "Print an access token to act as a user, the impersonation is recorded in the audit log",
+				ArgsUsage: " <user-id>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:     flagAdminImpersonatedBy,
+						Usage:    "Who is impersonating the user, i.e. the email of the administrator",
+						Required: true,
+					},
+					&cli.StringFlag{ //nolint: exhaustruct
+						Name:  flagAdminRole,
+						Usage: "Only allow this role, all the roles of the user are allowed if missing",
+					},
+				},
+				Action: adminAction(tokenMint, func(w io.Writer, response api.ImpersonationResponse) {
+					fmt.Fprintln(w, response.AccessToken)
+				}),
+			},
+		},
+	}
+}
+
+func CommandAdmin() *cli.Command {
+	return &cli.Command{ //nolint: exhaustruct
+		Name:  "admin",
+		Usage: "Manage users, sessions, roles and tokens with the admin API",
+		Description: "Calls the admin API of the server at --url with the admin secret. Without --url the " +
+			"admin API is served in process from the configuration, using the database directly",
+		Flags: []cli.Flag{
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:    flagAdminURL,
+				Usage:   "URL of the server including the API prefix, i.e. https://auth.example.com/v1. The database is used directly if missing", //nolint:lll
+				EnvVars: []string{"AUTH_ADMIN_URL"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:  flagAdminJSON,
+				Usage: "Print the responses as JSON",
+			},
+		},
+		Subcommands: []*cli.Command{
+			adminUsersCommand(),
+			adminSessionsCommand(),
+			adminRolesCommand(),
+			adminTokenCommand(),
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/audit"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagAuditLogEnabled       = "audit-log-enabled"
+	flagAuditLogRetentionDays = "audit-log-retention-days"
+)
+
+// auditLogPruneInterval is how often entries older than the retention period are deleted.
+const auditLogPruneInterval = time.Hour
+
+func auditFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagAuditLogEnabled,
+			Usage:    "Record sign ups, sign ins, account changes and admin actions in the auth.audit_logs table", //nolint:lll
+			Value:    false,
+			Category: "audit",
+			EnvVars:  []string{"AUTH_AUDIT_LOG_ENABLED"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagAuditLogRetentionDays,
+			Usage:    "Days entries of the audit log are kept for. 0 keeps them forever",
+			Value:    90, //nolint:mnd
+			Category: "audit",
+			EnvVars:  []string{"AUTH_AUDIT_LOG_RETENTION_DAYS"},
+		},
+	}
+}
+
+// startAuditLogPruner deletes the entries of the audit log older than the retention period
+// in the background.
+func startAuditLogPruner(cCtx *cli.Context, db *sql.Queries, logger *slog.Logger) {
+	days := cCtx.Int(flagAuditLogRetentionDays)
+	if !cCtx.Bool(flagAuditLogEnabled) || days <= 0 {
+		return
+	}
+
+	pruner := audit.NewPruner(
+		db,
+		time.Duration(days)*24*time.Hour,
+		logger.With(slog.String("component", "audit")),
+	)
+	go pruner.Run(cCtx.Context, auditLogPruneInterval)
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/storage"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagAvatarProvider    = "avatar-provider"
+	flagLibravatarDefault = "libravatar-default"
+	flagDiceBearStyle     = "dicebear-style"
+	flagAvatarURLTemplate = "avatar-url-template"
+
+	flagAvatarStorage                = "avatar-storage"
+	flagAvatarStorageURL             = "avatar-storage-url"
+	flagAvatarStoragePublicURL       = "avatar-storage-public-url"
+	flagAvatarStorageBucket          = "avatar-storage-bucket"
+	flagAvatarStorageRegion          = "avatar-storage-region"
+	flagAvatarStorageAccessKeyID     = "avatar-storage-access-key-id"
+	flagAvatarStorageSecretAccessKey = "avatar-storage-secret-access-key"
+	flagAvatarUploadMaxSize          = "avatar-upload-max-size"
+	flagAvatarSize                   = "avatar-size"
+
+	avatarStorageNone  = "none"
+	avatarStorageS3    = "s3"
+	avatarStorageNhost = "nhost"
+)
+
+var errMissingAvatarStorageSettings = errors.New("the avatar storage needs a url and a bucket")
+
+func avatarFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagAvatarProvider,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					controller.AvatarProviderGravatar,
+					controller.AvatarProviderLibravatar,
+					controller.AvatarProviderDiceBear,
+					controller.AvatarProviderTemplate,
+					controller.AvatarProviderNone,
+				},
+				Default: controller.AvatarProviderGravatar,
+			},
+			Usage:    "Service the avatars of new users are taken from",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_AVATAR_PROVIDER"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagLibravatarDefault,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					"identicon",
+					"monsterid",
+					"wavatar",
+					"retro",
+					"robohash",
+					"pagan",
+					"mm",
+					"404",
+				},
+				Default: "retro",
+			},
+			Usage:    "Libravatar default, the image shown to users without an avatar",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_LIBRAVATAR_DEFAULT"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagDiceBearStyle,
+			Usage:    "Style of the avatars generated by DiceBear, see https://www.dicebear.com/styles",
+			Value:    "identicon",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_DICEBEAR_STYLE"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarURLTemplate,
+			Usage:    "URL of the avatars for the template provider, ${md5} and ${sha256} are replaced with hashes of the email",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_AVATAR_URL_TEMPLATE"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagAvatarStorage,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum:    []string{avatarStorageNone, avatarStorageS3, avatarStorageNhost},
+				Default: avatarStorageNone,
+			},
+			Usage:    "Object storage the avatars uploaded by the users are stored in. Uploads are disabled with none",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarStorageURL,
+			Usage:    "Endpoint of the S3-compatible storage or URL of Nhost storage, i.e. https://local.storage.nhost.run/v1",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarStoragePublicURL,
+			Usage:    "URL the avatars stored in S3 are served from, i.e. a CDN. Defaults to the url of the bucket",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE_PUBLIC_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarStorageBucket,
+			Usage:    "Bucket the avatars are stored in",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE_BUCKET"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarStorageRegion,
+			Usage:    "Region of the S3 bucket",
+			Value:    "us-east-1",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE_REGION"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarStorageAccessKeyID,
+			Usage:    "Access key ID of the S3 bucket",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE_ACCESS_KEY_ID"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagAvatarStorageSecretAccessKey,
+			Usage:    "Secret access key of the S3 bucket",
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_STORAGE_SECRET_ACCESS_KEY"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagAvatarUploadMaxSize,
+			Usage:    "Maximum size in bytes of the avatars uploaded by the users",
+			Value:    5 * 1024 * 1024, //nolint:mnd
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_UPLOAD_MAX_SIZE"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagAvatarSize,
+			Usage:    "Uploaded avatars are scaled down to fit in a square of this many pixels",
+			Value:    256, //nolint:mnd
+			Category: "avatars",
+			EnvVars:  []string{"AUTH_AVATAR_SIZE"},
+		},
+	}
+}
+
+// getAvatarStorage returns the storage of the uploaded avatars, or nil if uploads are
+// disabled.
+func getAvatarStorage(cCtx *cli.Context) (controller.AvatarStorage, error) { //nolint:ireturn
+	kind := GetEnumValue(cCtx, flagAvatarStorage)
+	if kind == avatarStorageNone || kind == "" {
+		return nil, nil
+	}
+
+	url := cCtx.String(flagAvatarStorageURL)
+	bucket := cCtx.String(flagAvatarStorageBucket)
+	if url == "" || bucket == "" {
+		return nil, errMissingAvatarStorageSettings
+	}
+
+	if kind == avatarStorageNhost {
+		return storage.NewNhost(url, bucket, cCtx.String(flagHasuraAdminSecret)), nil
+	}
+
+	s3, err := storage.NewS3(
+		url,
+		cCtx.String(flagAvatarStoragePublicURL),
+		bucket,
+		cCtx.String(flagAvatarStorageRegion),
+		cCtx.String(flagAvatarStorageAccessKeyID),
+		cCtx.String(flagAvatarStorageSecretAccessKey),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating s3 avatar storage: %w", err)
+	}
+
+	return s3, nil
+}
+
+// registerAvatarBodyDecoders lets the request validator accept the images uploaded as
+// avatars, they are validated by the handler.
+func registerAvatarBodyDecoders() {
+	for _, contentType := range []string{"image/png", "image/jpeg", "image/gif"} {
+		openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.FileBodyDecoder)
+	}
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/captcha"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagCaptchaEnabled   = "captcha-enabled"
+	flagCaptchaProvider  = "captcha-provider"
+	flagCaptchaSecret    = "captcha-secret"
+	flagCaptchaMinScore  = "captcha-min-score"
+	flagCaptchaEndpoints = "captcha-endpoints"
+)
+
+func captchaFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagCaptchaEnabled,
+			Usage:    "Require a captcha token on the endpoints set in captcha-endpoints",
+			Value:    false,
+			Category: "captcha",
+			EnvVars:  []string{"AUTH_CAPTCHA_ENABLED"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagCaptchaProvider,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					captcha.ProviderTurnstile,
+					captcha.ProviderHCaptcha,
+					captcha.ProviderRecaptcha,
+				},
+				Default: captcha.ProviderTurnstile,
+			},
+			Usage:    "Provider used to verify the captcha tokens",
+			Category: "captcha",
+			EnvVars:  []string{"AUTH_CAPTCHA_PROVIDER"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagCaptchaSecret,
+			Usage:    "Secret key given by the captcha provider",
+			Category: "captcha",
+			EnvVars:  []string{"AUTH_CAPTCHA_SECRET"},
+		},
+		&cli.Float64Flag{ //nolint: exhaustruct
+			Name:     flagCaptchaMinScore,
+			Usage:    "Minimum score, between 0 and 1, for providers returning one like reCAPTCHA v3",
+			Value:    0.5, //nolint:mnd
+			Category: "captcha",
+			EnvVars:  []string{"AUTH_CAPTCHA_MIN_SCORE"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:  flagCaptchaEndpoints,
+			Usage: "Comma-separated list of endpoints requiring a captcha token",
+			Value: cli.NewStringSlice(
+				"/signin/anonymous",
+				"/signin/passwordless/email",
+				"/signin/passwordless/sms",
+				"/signup/email-password",
+				"/signup/webauthn",
+				"/user/password/reset",
+			),
+			Category: "captcha",
+			EnvVars:  []string{"AUTH_CAPTCHA_ENDPOINTS"},
+		},
+	}
+}
+
+func getCaptchaVerifier(cCtx *cli.Context) (controller.CaptchaVerifier, error) {
+	if !cCtx.Bool(flagCaptchaEnabled) {
+		return nil, nil //nolint:nilnil
+	}
+
+	if cCtx.String(flagCaptchaSecret) == "" {
+		return nil, errors.New("captcha secret is required") //nolint:goerr113
+	}
+
+	verifier, err := captcha.NewVerifier(
+		GetEnumValue(cCtx, flagCaptchaProvider),
+		cCtx.String(flagCaptchaSecret),
+		cCtx.Float64(flagCaptchaMinScore),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating captcha verifier: %w", err)
+	}
+
+	return verifier, nil
+}
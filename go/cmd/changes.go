@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/changes"
+	"github.com/urfave/cli/v2"
+)
+
+const flagChangeNotificationsEnabled = "change-notifications-enabled"
+
+func changeNotificationFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagChangeNotificationsEnabled,
+			Usage:    "Listen to the changes notified by the database to invalidate the caches, like the templates stored in the database, as soon as they change. Needs a connection of its own, not through a pooler in transaction mode", //nolint:lll
+			Category: "postgres",
+			EnvVars:  []string{"AUTH_CHANGE_NOTIFICATIONS_ENABLED"},
+		},
+	}
+}
+
+// getChangeListener returns the listener of the changes to the database of cCtx, or nil if
+// they aren't listened to. It listens until the context of cCtx is done.
+func getChangeListener(cCtx *cli.Context, logger *slog.Logger) *changes.Listener {
+	if !cCtx.Bool(flagChangeNotificationsEnabled) {
+		return nil
+	}
+
+	listener := changes.NewListener(
+		changes.NewConnect(cCtx.String(flagPostgresConnection)),
+		cCtx.String(flagPostgresSchema),
+		logger.With(slog.String("component", "changes")),
+	)
+	go listener.Run(cCtx.Context)
+
+	return listener
+}
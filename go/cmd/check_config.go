@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/urfave/cli/v2"
+)
+
+const flagCheckConfig = "check-config"
+
+// checkConfigTimeout is how long connecting to the database and to the SMTP server may
+// take when checking the configuration.
+const checkConfigTimeout = 10 * time.Second
+
+var errInvalidConfig = errors.New("invalid configuration")
+
+func checkConfigFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagCheckConfig,
+			Usage:    "Build every component from the configuration, report all the errors found and exit without serving", //nolint:lll
+			Category: "server",
+			EnvVars:  []string{"AUTH_CHECK_CONFIG"},
+		},
+	}
+}
+
+// configErrors flattens the errors joined in err so each of them is reported on its own.
+func configErrors(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error }) //nolint:errorlint
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, configErrors(e)...)
+	}
+	return errs
+}
+
+// configChecker collects the errors of the components built while checking the
+// configuration.
+type configChecker struct {
+	errs []error
+}
+
+// check records err, if any, as an error of the component.
+func (c *configChecker) check(component string, err error) bool {
+	if err == nil {
+		return true
+	}
+	c.errs = append(c.errs, &configError{Path: component, Err: err})
+	return false
+}
+
+// pingDB connects to the database of the connection string of the flag.
+func pingDB(ctx context.Context, cCtx *cli.Context, flagName string) error {
+	pool, err := getDBPool(cCtx, cCtx.String(flagName))
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, checkConfigTimeout)
+	defer cancel()
+	if err := pool.Ping(ctx); err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return nil
+}
+
+func checkSMTP(ctx context.Context, cCtx *cli.Context, logger *slog.Logger) error {
+	if cCtx.String(flagSMTPHost) == "postmark" || GetEnumValue(cCtx, flagEmailProvider) != "smtp" {
+		return nil
+	}
+
+	provider, err := getSMTPProvider(cCtx, logger)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkConfigTimeout)
+	defer cancel()
+	return provider.Verify(ctx) //nolint:wrapcheck
+}
+
+// checkDBComponents builds the components reading from the database and the controller.
+func checkDBComponents( //nolint:funlen
+	cCtx *cli.Context, db *sql.Queries, config controller.Config, configOK bool,
+	checker *configChecker, logger *slog.Logger,
+) {
+	listener := getChangeListener(cCtx, logger)
+
+	emailer, err := getEmailer(cCtx, db, listener, logger)
+	emailerOK := checker.check("email", err)
+
+	smsSender, err := getSMSSender(cCtx, db, listener, logger)
+	smsOK := checker.check("sms", err)
+
+	jwtGetter, err := getJWTGetter(cCtx, db)
+	jwtOK := checker.check("jwt", err)
+
+	oauthProviders, err := getOAuthProviders(cCtx)
+	providersOK := checker.check("oauth providers", err)
+
+	samlServiceProvider, err := getSAMLServiceProvider(cCtx)
+	samlOK := checker.check("saml", err)
+
+	hibpClient, err := getHIBPClient(cCtx, logger)
+	hibpOK := checker.check("hibp", err)
+
+	rateLimiter, err := getRateLimiter(cCtx)
+	rateLimitOK := checker.check("rate limiter", err)
+
+	captchaVerifier, err := getCaptchaVerifier(cCtx)
+	captchaOK := checker.check("captcha", err)
+
+	webhookSender, err := getWebhookSender(cCtx, db, logger)
+	webhooksOK := checker.check("webhooks", err)
+
+	preSignUpHook, err := getPreSignUpHook(cCtx)
+	preSignUpOK := checker.check("pre sign up hook", err)
+
+	avatarStorage, err := getAvatarStorage(cCtx)
+	avatarOK := checker.check("avatar storage", err)
+
+	sessionDB, _, err := getSessionStore(cCtx, db)
+	sessionsOK := checker.check("session store", err)
+
+	geoIP, err := getGeoIP(cCtx)
+	geoIPOK := checker.check("geoip", err)
+
+	riskEngine, err := getRiskEngine(cCtx)
+	riskOK := checker.check("risk engine", err)
+
+	for _, ok := range []bool{
+		configOK, emailerOK, smsOK, jwtOK, providersOK, samlOK, hibpOK, rateLimitOK,
+		captchaOK, webhooksOK, preSignUpOK, avatarOK, sessionsOK, geoIPOK, riskOK,
+	} {
+		if !ok {
+			return
+		}
+	}
+
+	_, err = controller.New(config, controller.Dependencies{
+		DB:               getRoleCachedDB(cCtx, sessionDB, listener, logger),
+		JWTGetter:        jwtGetter,
+		Emailer:          emailer,
+		SMS:              smsSender,
+		HIBP:             hibpClient,
+		OAuthProviders:   oauthProviders,
+		IDTokenProviders: getIDTokenProviders(cCtx),
+		SAML:             samlServiceProvider,
+		RateLimiter:      rateLimiter,
+		Captcha:          captchaVerifier,
+		Webhooks:         webhookSender,
+		PreSignUpHook:    preSignUpHook,
+		DisposableEmails: getDisposableEmails(cCtx, logger),
+		AvatarStorage:    avatarStorage,
+		GeoIP:            geoIP,
+		RiskEngine:       riskEngine,
+		ConfigReloader:   nil,
+		SignInMethods:    getSignInMethodFlags(db, listener),
+		Version:          cCtx.App.Version,
+	})
+	checker.check("controller", err)
+}
+
+// checkConfig builds every component the server needs from the configuration like when
+// serving, connecting to the database and the SMTP server, and reports all the errors
+// found instead of stopping at the first one.
+func checkConfig(cCtx *cli.Context, logger *slog.Logger) error {
+	ctx, cancel := context.WithCancel(cCtx.Context)
+	defer cancel()
+
+	c, err := overrideContext(cCtx, nil)
+	if err != nil {
+		return err
+	}
+	// components start their workers on the context, they stop once the check is done
+	c.Context = ctx
+
+	checker := &configChecker{errs: nil}
+
+	config, err := getConfig(c)
+	configOK := err == nil
+	if !configOK {
+		checker.errs = append(checker.errs, configErrors(err)...)
+	}
+
+	if proxies := c.StringSlice(flagTrustedProxies); len(proxies) > 0 {
+		checker.check(flagEnvName(c, flagTrustedProxies), gin.New().SetTrustedProxies(proxies))
+	}
+
+	_, err = getIPFilterRules(c)
+	checker.check("ip filter", err)
+
+	checker.check("smtp", checkSMTP(ctx, c, logger))
+
+	if filename := c.String(flagSeedFile); filename != "" {
+		_, err = readSeedFile(filename)
+		checker.check(flagEnvName(c, flagSeedFile), err)
+	}
+
+	for _, flagName := range []string{flagPostgresConnection, flagPostgresReplicaConnection} {
+		if c.String(flagName) != "" {
+			checker.check(flagEnvName(c, flagName), pingDB(ctx, c, flagName))
+		}
+	}
+
+	// the components only need the queries, they don't connect until they are used
+	db, closeDB, err := getDB(c, logger)
+	if checker.check("database", err) {
+		checkDBComponents(c, sql.New(db), config, configOK, checker, logger)
+		closeDB()
+	}
+
+	if len(checker.errs) > 0 {
+		for _, err := range checker.errs {
+			fmt.Fprintln(cCtx.App.ErrWriter, err)
+		}
+		return fmt.Errorf("%w, %d problems found", errInvalidConfig, len(checker.errs))
+	}
+
+	fmt.Fprintln(cCtx.App.Writer, "configuration is valid")
+	return nil
+}
@@ -1,23 +1,77 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"os"
 	"slices"
+	"time"
 
 	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/urfave/cli/v2"
 )
 
-func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
+// configError is an invalid configuration value, Path is the environment variable it was
+// read from.
+type configError struct {
+	Path string
+	Err  error
+}
+
+func (e *configError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *configError) Unwrap() error {
+	return e.Err
+}
+
+// flagEnvName returns the environment variable the flag is read from, the first one when
+// none of them is set.
+func flagEnvName(cCtx *cli.Context, flagName string) string {
+	for _, f := range cCtx.Command.Flags {
+		docFlag, ok := f.(cli.DocGenerationFlag)
+		if !ok || f.Names()[0] != flagName || len(docFlag.GetEnvVars()) == 0 {
+			continue
+		}
+
+		for _, name := range docFlag.GetEnvVars() {
+			if _, ok := os.LookupEnv(name); ok {
+				return name
+			}
+		}
+		return docFlag.GetEnvVars()[0]
+	}
+	return flagName
+}
+
+// fieldError returns err as an error of the value of the flag.
+func fieldError(cCtx *cli.Context, flagName string, err error) error {
+	return &configError{Path: flagEnvName(cCtx, flagName), Err: err}
+}
+
+// elementError returns err as an error of the element i of the list of the flag.
+func elementError(cCtx *cli.Context, flagName string, i int, err error) error {
+	return &configError{Path: fmt.Sprintf("%s[%d]", flagEnvName(cCtx, flagName), i), Err: err}
+}
+
+// getConfig returns the configuration of the controller. Every invalid value is reported,
+// joined in the error.
+func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen,cyclop
+	var errs []error
+
 	serverURL, err := url.Parse(cCtx.String(flagServerURL))
 	if err != nil {
-		return controller.Config{}, fmt.Errorf("problem parsing server url: %w", err)
+		errs = append(errs, fieldError(cCtx, flagServerURL, err))
+		serverURL = &url.URL{} //nolint:exhaustruct
 	}
 
 	clientURL, err := url.Parse(cCtx.String(flagClientURL))
 	if err != nil {
-		return controller.Config{}, fmt.Errorf("problem parsing client url: %w", err)
+		errs = append(errs, fieldError(cCtx, flagClientURL, err))
+		clientURL = &url.URL{} //nolint:exhaustruct
 	}
 
 	allowedRedirectURLs := make([]string, 0, len(cCtx.StringSlice(flagAllowRedirectURLs)))
@@ -45,6 +99,13 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 	}
 	allowedLocales = slices.DeleteFunc(allowedLocales, func(s string) bool { return s == "" })
 
+	localeFallbacks, err := notifications.ParseLocaleFallbacks(
+		cCtx.StringSlice(flagLocaleFallbacks),
+	)
+	if err != nil {
+		errs = append(errs, fieldError(cCtx, flagLocaleFallbacks, err))
+	}
+
 	allowedDomains := cCtx.StringSlice(flagAllowedEmailDomains)
 	allowedDomains = slices.DeleteFunc(allowedDomains, func(s string) bool { return s == "" })
 	blockedDomains := cCtx.StringSlice(flagBlockedEmailDomains)
@@ -54,6 +115,95 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 	blockedEmails := cCtx.StringSlice(flagBlockedEmails)
 	blockedEmails = slices.DeleteFunc(blockedEmails, func(s string) bool { return s == "" })
 
+	passwordMinScore := cCtx.Int(flagPasswordMinScore)
+	if passwordMinScore < 0 || passwordMinScore > 4 {
+		errs = append(errs, fieldError(
+			cCtx, flagPasswordMinScore, errors.New("must be between 0 and 4"), //nolint:goerr113
+		))
+	}
+
+	passwordCharacterClasses := cCtx.StringSlice(flagPasswordCharacterClasses)
+	passwordCharacterClasses = slices.DeleteFunc(
+		passwordCharacterClasses, func(s string) bool { return s == "" },
+	)
+	passwordDenylist := cCtx.StringSlice(flagPasswordDenylist)
+	passwordDenylist = slices.DeleteFunc(passwordDenylist, func(s string) bool { return s == "" })
+
+	argon2Memory, argon2Iterations, argon2Parallelism, err := getArgon2Params(cCtx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	passwordPeppers, err := getPasswordPeppers(cCtx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	clientTokenLifetimes, err := getClientTokenLifetimes(cCtx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if cCtx.Int(flagSessionLimit) < 0 {
+		errs = append(errs, fieldError(
+			cCtx, flagSessionLimit, errors.New("can't be negative"), //nolint:goerr113
+		))
+	}
+
+	if cCtx.Int(flagRefreshTokenMaxLifetime) < 0 {
+		errs = append(errs, fieldError(
+			cCtx, flagRefreshTokenMaxLifetime, errors.New("can't be negative"), //nolint:goerr113
+		))
+	}
+
+	if cCtx.Int(flagEmailVerificationResendCooldown) < 0 {
+		errs = append(errs, fieldError(
+			cCtx, flagEmailVerificationResendCooldown, errors.New("can't be negative"), //nolint:goerr113
+		))
+	}
+
+	if err := validateImpossibleTravel(cCtx); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateRiskThresholds(cCtx); err != nil {
+		errs = append(errs, err)
+	}
+
+	anonymousDefaultRole, anonymousAllowedRoles, err := signInMethodRoles(
+		cCtx, flagAnonymousDefaultRole, flagAnonymousAllowedRoles,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	providerDefaultRole, providerAllowedRoles, err := signInMethodRoles(
+		cCtx, flagProviderDefaultRole, flagProviderAllowedRoles,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	passwordlessDefaultRole, passwordlessAllowedRoles, err := signInMethodRoles(
+		cCtx, flagPasswordlessDefaultRole, flagPasswordlessAllowedRoles,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	emailPasswordDefaultRole, emailPasswordAllowedRoles, err := signInMethodRoles(
+		cCtx, flagEmailPasswordDefaultRole, flagEmailPasswordAllowedRoles,
+	)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return controller.Config{}, err
+	}
+
+	smsTestPhoneNumbers := cCtx.StringSlice(flagSMSTestPhoneNumbers)
+	smsTestPhoneNumbers = slices.DeleteFunc(
+		smsTestPhoneNumbers, func(s string) bool { return s == "" },
+	)
+
 	webauhtnRPID := cCtx.String(flagWebauthnRPID)
 	if webauhtnRPID == "" {
 		webauhtnRPID = clientURL.Hostname()
@@ -70,6 +220,16 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 		webauhtnRPOrigins = append(webauhtnRPOrigins, cCtx.String(flagClientURL))
 	}
 
+	deviceVerificationURL := cCtx.String(flagDeviceVerificationURL)
+	if deviceVerificationURL == "" {
+		deviceVerificationURL = clientURL.JoinPath("device").String()
+	}
+
+	oidcProviderAuthURL := cCtx.String(flagOIDCProviderAuthorizationURL)
+	if oidcProviderAuthURL == "" {
+		oidcProviderAuthURL = clientURL.JoinPath("oauth", "authorize").String()
+	}
+
 	return controller.Config{
 		HasuraGraphqlURL:           cCtx.String(flagGraphqlURL),
 		HasuraAdminSecret:          cCtx.String(flagHasuraAdminSecret),
@@ -87,21 +247,104 @@ func getConfig(cCtx *cli.Context) (controller.Config, error) { //nolint:funlen
 		DefaultRole:                defaultRole,
 		DefaultLocale:              defaultLocale,
 		AllowedLocales:             allowedLocales,
+		LocaleFallbacks:            localeFallbacks,
 		GravatarEnabled:            cCtx.Bool(flagGravatarEnabled),
 		GravatarDefault:            GetEnumValue(cCtx, flagGravatarDefault),
 		GravatarRating:             cCtx.String(flagGravatarRating),
+		AvatarProvider:             GetEnumValue(cCtx, flagAvatarProvider),
+		LibravatarDefault:          GetEnumValue(cCtx, flagLibravatarDefault),
+		DiceBearStyle:              cCtx.String(flagDiceBearStyle),
+		AvatarURLTemplate:          cCtx.String(flagAvatarURLTemplate),
+		AvatarUploadMaxSize:        cCtx.Int(flagAvatarUploadMaxSize),
+		AvatarSize:                 cCtx.Int(flagAvatarSize),
 		PasswordMinLength:          cCtx.Int(flagPasswordMinLength),
 		PasswordHIBPEnabled:        cCtx.Bool(flagPasswordHIBPEnabled),
+		PasswordMaxLength:          cCtx.Int(flagPasswordMaxLength),
+		PasswordMinScore:           passwordMinScore,
+		PasswordCharacterClasses:   passwordCharacterClasses,
+		PasswordDenylist:           passwordDenylist,
+		PasswordRejectEmail:        cCtx.Bool(flagPasswordRejectEmail),
+		PasswordHashAlgorithm:      GetEnumValue(cCtx, flagPasswordHashAlgorithm),
+		PasswordArgon2Memory:       argon2Memory,
+		PasswordArgon2Iterations:   argon2Iterations,
+		PasswordArgon2Parallelism:  argon2Parallelism,
+		PasswordPeppers:            passwordPeppers,
+		RevokeSessionsOnReset:      cCtx.Bool(flagPasswordResetRevokeSessions),
+		NotifyNewDeviceSignIn:      cCtx.Bool(flagNewDeviceNotificationEnabled),
 		RefreshTokenExpiresIn:      cCtx.Int(flagRefreshTokenExpiresIn),
 		AccessTokenExpiresIn:       cCtx.Int(flagAccessTokensExpiresIn),
+		ElevatedTokenExpiresIn:     cCtx.Int(flagElevatedTokenExpiresIn),
+		ClientTokenLifetimes:       clientTokenLifetimes,
+		RefreshTokenExpiration:     GetEnumValue(cCtx, flagRefreshTokenExpiration),
+		RefreshTokenMaxLifetime:    cCtx.Int(flagRefreshTokenMaxLifetime),
+		SessionLimit:               cCtx.Int(flagSessionLimit),
+		SessionLimitPolicy:         GetEnumValue(cCtx, flagSessionLimitPolicy),
+		ImpossibleTravelPolicy:     GetEnumValue(cCtx, flagImpossibleTravelPolicy),
+		ImpossibleTravelMaxSpeed:   cCtx.Int(flagImpossibleTravelMaxSpeed),
+		RiskMFAThreshold:           cCtx.Int(flagRiskMFAThreshold),
+		RiskBlockThreshold:         cCtx.Int(flagRiskBlockThreshold),
 		JWTSecret:                  cCtx.String(flagHasuraGraphqlJWTSecret),
 		RequireEmailVerification:   cCtx.Bool(flagEmailSigninEmailVerifiedRequired),
 		ServerURL:                  serverURL,
 		EmailPasswordlessEnabled:   cCtx.Bool(flagEmailPasswordlessEnabled),
+		AnonymousUsersEnabled:      cCtx.Bool(flagAnonymousUsersEnabled),
+		MfaEnabled:                 cCtx.Bool(flagMfaEnabled),
+		MfaTotpIssuer:              cCtx.String(flagMfaTotpIssuer),
+		SMSPasswordlessEnabled:     cCtx.Bool(flagSMSPasswordlessEnabled),
+		SMSTestPhoneNumbers:        smsTestPhoneNumbers,
 		WebauthnEnabled:            cCtx.Bool(flagWebauthnEnabled),
 		WebauthnRPID:               webauhtnRPID,
 		WebauthnRPName:             webauhtnRPName,
 		WebauthnRPOrigins:          webauhtnRPOrigins,
 		WebauhtnAttestationTimeout: cCtx.Duration(flagWebauthnAttestationTimeout),
+		DeviceAuthorizationEnabled: cCtx.Bool(flagDeviceAuthorizationEnabled),
+		DeviceVerificationURL:      deviceVerificationURL,
+		DeviceCodeExpiresIn:        cCtx.Int(flagDeviceCodeExpiresIn),
+		DevicePollingInterval:      cCtx.Int(flagDevicePollingInterval),
+		IntrospectionClientID:      cCtx.String(flagIntrospectionClientID),
+		IntrospectionClientSecret:  cCtx.String(flagIntrospectionClientSecret),
+		TokenExchangeExpiresIn:     cCtx.Int(flagTokenExchangeExpiresIn),
+		RateLimitInterval:          cCtx.Int(flagRateLimitInterval),
+		RateLimitSigninIP:          cCtx.Int(flagRateLimitSigninIP),
+		RateLimitSigninIdentifier:  cCtx.Int(flagRateLimitSigninIdentifier),
+		RateLimitSignupIP:          cCtx.Int(flagRateLimitSignupIP),
+		RateLimitSignupIdentifier:  cCtx.Int(flagRateLimitSignupIdentifier),
+		RateLimitResetIP:           cCtx.Int(flagRateLimitResetIP),
+		RateLimitResetIdentifier:   cCtx.Int(flagRateLimitResetIdentifier),
+		RateLimitEmailIP:           cCtx.Int(flagRateLimitEmailIP),
+		RateLimitEmailIdentifier:   cCtx.Int(flagRateLimitEmailIdentifier),
+		RateLimitSMSIP:             cCtx.Int(flagRateLimitSMSIP),
+		RateLimitSMSIdentifier:     cCtx.Int(flagRateLimitSMSIdentifier),
+		LockoutEnabled:             cCtx.Bool(flagLockoutEnabled),
+		LockoutBackoffAfter:        cCtx.Int(flagLockoutBackoffAfter),
+		LockoutBackoffDelay:        cCtx.Int(flagLockoutBackoffDelay),
+		LockoutThreshold:           cCtx.Int(flagLockoutThreshold),
+		LockoutIPThreshold:         cCtx.Int(flagLockoutIPThreshold),
+		LockoutDuration:            cCtx.Int(flagLockoutDuration),
+		CaptchaEndpoints:           cCtx.StringSlice(flagCaptchaEndpoints),
+		AuditLogEnabled:            cCtx.Bool(flagAuditLogEnabled),
+		AccountDeletionEnabled:     cCtx.Bool(flagAccountDeletionEnabled),
+		AccountDeletionGracePeriod: cCtx.Int(flagAccountDeletionGracePeriodDays),
+		ScimToken:                  cCtx.String(flagScimToken),
+		OIDCProviderEnabled:        cCtx.Bool(flagOIDCProviderEnabled),
+		OIDCProviderAuthURL:        oidcProviderAuthURL,
+		ForwardAuthEnabled:         cCtx.Bool(flagForwardAuthEnabled),
+		ForwardAuthCookieName:      cCtx.String(flagForwardAuthCookieName),
+		TenantID:                   cCtx.String(flagTenantID),
+		OrganizationsEnabled:       cCtx.Bool(flagOrganizationsEnabled),
+		UserMetadataSchema:         cCtx.String(flagUserMetadataSchema),
+		AnonymousDefaultRole:       anonymousDefaultRole,
+		AnonymousAllowedRoles:      anonymousAllowedRoles,
+		ProviderDefaultRole:        providerDefaultRole,
+		ProviderAllowedRoles:       providerAllowedRoles,
+		PasswordlessDefaultRole:    passwordlessDefaultRole,
+		PasswordlessAllowedRoles:   passwordlessAllowedRoles,
+		EmailPasswordDefaultRole:   emailPasswordDefaultRole,
+		EmailPasswordAllowedRoles:  emailPasswordAllowedRoles,
+		DisposableEmailCheck:       GetEnumValue(cCtx, flagDisposableEmailCheck),
+		EmailMXCheckEnabled:        cCtx.Bool(flagEmailMXCheckEnabled),
+		EmailMXCheckTimeout:        time.Duration(cCtx.Int(flagEmailMXCheckTimeout)) * time.Millisecond,
+		EmailMXCheckCacheTTL:       time.Duration(cCtx.Int(flagEmailMXCheckCacheTTL)) * time.Second,
+		EmailVerificationCooldown:  time.Duration(cCtx.Int(flagEmailVerificationResendCooldown)) * time.Second,
 	}, nil
 }
@@ -9,7 +9,7 @@ import (
 func cors() gin.HandlerFunc {
 	f := func(c *gin.Context, origin string) {
 		c.Header("Access-Control-Allow-Origin", origin)
-		c.Header("Access-Control-Allow-Methods", "POST, GET")
+		c.Header("Access-Control-Allow-Methods", "POST, GET, PATCH")
 		headers := c.Request.Header.Get("Access-Control-Request-Headers")
 		c.Header("Access-Control-Allow-Headers", headers)
 		c.Header("Access-Control-Allow-Credentials", "true")
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagDataExportInterval      = "data-export-interval"
+	flagDataExportRetentionDays = "data-export-retention-days"
+)
+
+func dataExportFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagDataExportInterval,
+			Usage:    "Interval in seconds to check for pending data exports",
+			Value:    10, //nolint:mnd
+			Category: "data-export",
+			EnvVars:  []string{"AUTH_DATA_EXPORT_INTERVAL"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagDataExportRetentionDays,
+			Usage:    "Days completed data exports can be downloaded for before they are deleted",
+			Value:    7, //nolint:mnd
+			Category: "data-export",
+			EnvVars:  []string{"AUTH_DATA_EXPORT_RETENTION_DAYS"},
+		},
+	}
+}
+
+// startDataExporter assembles the requested data exports in the background.
+func startDataExporter(cCtx *cli.Context, db *sql.Queries, logger *slog.Logger) error {
+	interval := cCtx.Int(flagDataExportInterval)
+	if interval <= 0 {
+		return errors.New("data export interval must be positive") //nolint:goerr113
+	}
+	days := cCtx.Int(flagDataExportRetentionDays)
+	if days <= 0 {
+		return errors.New("data export retention days must be positive") //nolint:goerr113
+	}
+
+	// the sessions of the users are exported from wherever they are kept
+	exportDB, _, err := getSessionStore(cCtx, db)
+	if err != nil {
+		return err
+	}
+
+	exporter := controller.NewDataExporter(
+		exportDB,
+		time.Duration(days)*24*time.Hour,
+		logger.With(slog.String("component", "data-export")),
+	)
+	go exporter.Run(cCtx.Context, time.Duration(interval)*time.Second)
+
+	return nil
+}
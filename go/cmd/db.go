@@ -2,9 +2,13 @@ package cmd
 
 import (
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/tracing"
 	"github.com/urfave/cli/v2"
 )
 
@@ -16,13 +20,54 @@ const (
 	poolMinHealthCheckPeriod = time.Minute
 )
 
-func getDBPool(cCtx *cli.Context) (*pgxpool.Pool, error) {
-	config, err := pgxpool.ParseConfig(cCtx.String(flagPostgresConnection))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse database config: %w", err)
+const (
+	flagPostgresPoolMaxConns          = "postgres-pool-max-conns"
+	flagPostgresPoolMinConns          = "postgres-pool-min-conns"
+	flagPostgresPoolMaxConnLifetime   = "postgres-pool-max-conn-lifetime"
+	flagPostgresPoolMaxConnIdleTime   = "postgres-pool-max-conn-idle-time"
+	flagPostgresPoolHealthCheckPeriod = "postgres-pool-health-check-period"
+)
+
+func dbPoolFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagPostgresPoolMaxConns,
+			Usage:    "Maximum number of connections of each database pool. If not set, at least 4",
+			Category: "postgres",
+			EnvVars:  []string{"POSTGRES_POOL_MAX_CONNS"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagPostgresPoolMinConns,
+			Usage:    "Number of connections each database pool keeps open. If not set, at least 1",
+			Category: "postgres",
+			EnvVars:  []string{"POSTGRES_POOL_MIN_CONNS"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagPostgresPoolMaxConnLifetime,
+			Usage:    "Seconds after which connections are closed and replaced. If not set, at least 3600",
+			Category: "postgres",
+			EnvVars:  []string{"POSTGRES_POOL_MAX_CONN_LIFETIME"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagPostgresPoolMaxConnIdleTime,
+			Usage:    "Seconds after which idle connections are closed. If not set, at least 1800",
+			Category: "postgres",
+			EnvVars:  []string{"POSTGRES_POOL_MAX_CONN_IDLE_TIME"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagPostgresPoolHealthCheckPeriod,
+			Usage:    "Seconds between the health checks of the idle connections. If not set, at least 60",
+			Category: "postgres",
+			EnvVars:  []string{"POSTGRES_POOL_HEALTH_CHECK_PERIOD"},
+		},
 	}
+}
 
-	if config.MaxConns < poolMinMaxConns { //
+// setDBPoolConfig applies the pool settings of the flags, overriding the ones of the
+// connection URI. Settings that aren't set anywhere are raised to safe minimums as the
+// defaults of pgx are too low for a busy service.
+func setDBPoolConfig(cCtx *cli.Context, config *pgxpool.Config) {
+	if config.MaxConns < poolMinMaxConns {
 		config.MaxConns = poolMinMaxConns
 	}
 	if config.MinConns < poolMinMinConns {
@@ -38,6 +83,43 @@ func getDBPool(cCtx *cli.Context) (*pgxpool.Pool, error) {
 		config.HealthCheckPeriod = poolMinHealthCheckPeriod
 	}
 
+	if n := cCtx.Int(flagPostgresPoolMaxConns); n > 0 {
+		config.MaxConns = int32(n) //nolint:gosec
+	}
+	if n := cCtx.Int(flagPostgresPoolMinConns); n > 0 {
+		config.MinConns = int32(n) //nolint:gosec
+	}
+	if config.MinConns > config.MaxConns {
+		config.MinConns = config.MaxConns
+	}
+	if n := cCtx.Int(flagPostgresPoolMaxConnLifetime); n > 0 {
+		config.MaxConnLifetime = time.Duration(n) * time.Second
+	}
+	if n := cCtx.Int(flagPostgresPoolMaxConnIdleTime); n > 0 {
+		config.MaxConnIdleTime = time.Duration(n) * time.Second
+	}
+	if n := cCtx.Int(flagPostgresPoolHealthCheckPeriod); n > 0 {
+		config.HealthCheckPeriod = time.Duration(n) * time.Second
+	}
+}
+
+func getDBPool(cCtx *cli.Context, connection string) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database config: %w", err)
+	}
+
+	setDBPoolConfig(cCtx, config)
+
+	if tracingEnabled(cCtx) {
+		config.ConnConfig.Tracer = tracing.NewQueryTracer()
+	}
+
+	// the default value of auth.users.tenant_id
+	if tenantID := cCtx.String(flagTenantID); tenantID != "" {
+		config.ConnConfig.RuntimeParams["hasura_auth.tenant_id"] = tenantID
+	}
+
 	pool, err := pgxpool.NewWithConfig(cCtx.Context, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
@@ -45,3 +127,49 @@ func getDBPool(cCtx *cli.Context) (*pgxpool.Pool, error) {
 
 	return pool, nil
 }
+
+// getDB returns the connection the queries are sent through along with a function closing
+// its database pools.
+func getDB(cCtx *cli.Context, logger *slog.Logger) (sql.DBTX, func(), error) {
+	pool, err := getDBPool(cCtx, cCtx.String(flagPostgresConnection))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create database pool: %w", err)
+	}
+
+	var db sql.DBTX = pool
+	closeDB := pool.Close
+	if metricsEnabled(cCtx) {
+		metrics.ObserveDBPool("primary", cCtx.String(flagTenantID), pool)
+	}
+
+	if connection := cCtx.String(flagPostgresReplicaConnection); connection != "" {
+		replica, err := getDBPool(cCtx, connection)
+		if err != nil {
+			pool.Close()
+			return nil, nil, fmt.Errorf("failed to create read replica database pool: %w", err)
+		}
+
+		if metricsEnabled(cCtx) {
+			metrics.ObserveDBPool("replica", cCtx.String(flagTenantID), replica)
+		}
+
+		db = sql.NewReplicaDBTX(pool, replica, logger)
+		closeDB = func() {
+			replica.Close()
+			pool.Close()
+		}
+	}
+
+	// transactions always run in the primary
+	db = sql.NewTxDBTX(db, pool)
+
+	if schema := cCtx.String(flagPostgresSchema); schema != sql.DefaultSchema {
+		db = sql.NewSchemaDBTX(db, schema)
+	}
+
+	if metricsEnabled(cCtx) {
+		db = metrics.NewDBTX(db)
+	}
+
+	return db, closeDB, nil
+}
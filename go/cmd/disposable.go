@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/disposable"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagDisposableEmailCheck           = "disposable-email-check"
+	flagDisposableEmailDomainsURL      = "disposable-email-domains-url"
+	flagDisposableEmailRefreshInterval = "disposable-email-domains-refresh-interval"
+)
+
+func disposableEmailFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagDisposableEmailCheck,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					controller.DisposableEmailCheckOff,
+					controller.DisposableEmailCheckFlag,
+					controller.DisposableEmailCheckReject,
+				},
+				Default: controller.DisposableEmailCheckOff,
+			},
+			Usage:    "What to do with sign ups using the email of a disposable email provider, flag only logs them",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_DISPOSABLE_EMAIL_CHECK"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagDisposableEmailDomainsURL,
+			Usage:    "URL of a list of disposable email domains, one per line, added to the bundled ones",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_DISPOSABLE_EMAIL_DOMAINS_URL"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagDisposableEmailRefreshInterval,
+			Usage:    "Interval in seconds to download the list of disposable email domains again",
+			Value:    86400, //nolint:mnd
+			Category: "signup",
+			EnvVars:  []string{"AUTH_DISPOSABLE_EMAIL_DOMAINS_REFRESH_INTERVAL"},
+		},
+	}
+}
+
+// getDisposableEmails returns the list of disposable email domains, downloading the
+// custom list, if any, in the background. It returns nil if the check is off.
+func getDisposableEmails(
+	cCtx *cli.Context, logger *slog.Logger,
+) controller.DisposableEmailChecker {
+	if GetEnumValue(cCtx, flagDisposableEmailCheck) == controller.DisposableEmailCheckOff {
+		return nil
+	}
+
+	list := disposable.New(
+		cCtx.String(flagDisposableEmailDomainsURL),
+		logger.With(slog.String("component", "disposable-email")),
+	)
+	if cCtx.String(flagDisposableEmailDomainsURL) != "" {
+		interval := time.Duration(cCtx.Int(flagDisposableEmailRefreshInterval)) * time.Second
+		go list.Run(cCtx.Context, max(interval, time.Minute))
+	}
+
+	return list
+}
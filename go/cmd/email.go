@@ -1,25 +1,33 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net/smtp"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/nhost/hasura-auth/go/changes"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/mailgun"
 	"github.com/nhost/hasura-auth/go/notifications/postmark"
+	"github.com/nhost/hasura-auth/go/notifications/sendgrid"
+	"github.com/nhost/hasura-auth/go/notifications/ses"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/tracing"
 	"github.com/urfave/cli/v2"
 )
 
-func getSMTPEmailer(cCtx *cli.Context, logger *slog.Logger) (*notifications.Email, error) {
-	headers := make(map[string]string)
-	if cCtx.String(flagSMTPAPIHedaer) != "" {
-		headers["X-SMTPAPI"] = cCtx.String(flagSMTPAPIHedaer)
-	}
-
+func getTemplates(
+	cCtx *cli.Context,
+	db notifications.TemplatesDB,
+	listener *changes.Listener,
+	logger *slog.Logger,
+) (*notifications.Templates, error) {
 	var templatesPath string
 	for _, p := range []string{
 		cCtx.String(flagEmailTemplatesPath),
@@ -34,15 +42,64 @@ func getSMTPEmailer(cCtx *cli.Context, logger *slog.Logger) (*notifications.Emai
 		return nil, errors.New("templates path not found") //nolint:goerr113
 	}
 
-	templates, err := notifications.NewTemplatesFromFilesystem(
-		templatesPath,
+	sources := []notifications.TemplateSource{
+		notifications.NewFilesystemTemplateSource(templatesPath),
+	}
+	if cCtx.String(flagEmailTemplatesOverridesPath) != "" {
+		sources = append(
+			sources,
+			notifications.NewFilesystemTemplateSource(cCtx.String(flagEmailTemplatesOverridesPath)),
+		)
+	}
+	if cCtx.Bool(flagEmailTemplatesFromDatabase) {
+		sources = append(sources, notifications.NewDatabaseTemplateSource(db))
+	}
+
+	fallbacks, err := notifications.ParseLocaleFallbacks(cCtx.StringSlice(flagLocaleFallbacks))
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing locale fallbacks: %w", err)
+	}
+
+	templates, err := notifications.NewTemplates(
+		cCtx.Context,
 		cCtx.String(flagDefaultLocale),
-		logger.With(slog.String("component", "mailer")),
+		fallbacks,
+		logger,
+		sources...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("problem creating templates: %w", err)
 	}
 
+	if interval := cCtx.Int(flagEmailTemplatesReloadInterval); interval > 0 {
+		go templates.Watch(cCtx.Context, time.Duration(interval)*time.Second)
+	}
+
+	if listener != nil && cCtx.Bool(flagEmailTemplatesFromDatabase) {
+		listener.Subscribe("email_templates", func(ctx context.Context, _ changes.Change) {
+			if err := templates.Reload(ctx); err != nil {
+				logger.Error("error reloading templates", slog.String("error", err.Error()))
+			}
+		})
+	}
+
+	return templates, nil
+}
+
+// emailRetryBackoff is how long we wait before retrying to send an email the first time,
+// the wait doubles after every retry. emailOutboxBackoff is the same for emails sent
+// through the outbox.
+const (
+	emailRetryBackoff  = 500 * time.Millisecond
+	emailOutboxBackoff = 30 * time.Second
+)
+
+func getSMTPProvider(cCtx *cli.Context, logger *slog.Logger) (*notifications.SMTP, error) {
+	headers := make(map[string]string)
+	if cCtx.String(flagSMTPAPIHedaer) != "" {
+		headers["X-SMTPAPI"] = cCtx.String(flagSMTPAPIHedaer)
+	}
+
 	host := cCtx.String(flagSMTPHost)
 	user := cCtx.String(flagSMTPUser)
 	password := cCtx.String(flagSMTPPassword)
@@ -59,24 +116,100 @@ func getSMTPEmailer(cCtx *cli.Context, logger *slog.Logger) (*notifications.Emai
 		return nil, errors.New("unsupported auth method") //nolint:goerr113
 	}
 
-	return notifications.NewEmail(
+	return notifications.NewSMTP(
 		cCtx.String(flagSMTPHost),
 		uint16(cCtx.Uint(flagSMTPPort)),
 		cCtx.Bool(flagSMTPSecure),
 		auth,
 		cCtx.String(flagSMTPSender),
 		headers,
-		templates,
 	), nil
 }
 
+func getEmailProvider( //nolint:ireturn
+	cCtx *cli.Context,
+	logger *slog.Logger,
+) (notifications.EmailProvider, error) {
+	sender := cCtx.String(flagSMTPSender)
+
+	switch GetEnumValue(cCtx, flagEmailProvider) {
+	case "smtp":
+		return getSMTPProvider(cCtx, logger)
+	case "ses":
+		if cCtx.String(flagEmailSESRegion) == "" {
+			return nil, errors.New("ses region is required") //nolint:goerr113
+		}
+		return ses.New(
+			cCtx.String(flagEmailSESRegion),
+			cCtx.String(flagEmailSESAccessKeyID),
+			cCtx.String(flagEmailSESSecretAccessKey),
+			sender,
+		), nil
+	case "sendgrid":
+		return sendgrid.New(cCtx.String(flagEmailAPIKey), sender), nil
+	case "mailgun":
+		if cCtx.String(flagEmailMailgunDomain) == "" {
+			return nil, errors.New("mailgun domain is required") //nolint:goerr113
+		}
+		return mailgun.New(
+			cCtx.String(flagEmailAPIKey),
+			cCtx.String(flagEmailMailgunDomain),
+			GetEnumValue(cCtx, flagEmailMailgunRegion),
+			sender,
+		), nil
+	case "postmark":
+		return postmark.New(sender, cCtx.String(flagEmailAPIKey)), nil
+	default:
+		return nil, errors.New("unsupported email provider") //nolint:goerr113
+	}
+}
+
 func getEmailer( //nolint:ireturn
 	cCtx *cli.Context,
+	db *sql.Queries,
+	listener *changes.Listener,
 	logger *slog.Logger,
 ) (controller.Emailer, error) {
+	// postmark as smtp host uses the templates stored in postmark instead of ours
 	if cCtx.String(flagSMTPHost) == "postmark" {
 		return postmark.New(cCtx.String(flagSMTPSender), cCtx.String(flagSMTPPassword)), nil
 	}
 
-	return getSMTPEmailer(cCtx, logger)
+	provider, err := getEmailProvider(cCtx, logger)
+	if err != nil {
+		return nil, err
+	}
+	if tracingEnabled(cCtx) {
+		provider = tracing.NewEmailProvider(provider)
+	}
+
+	templates, err := getTemplates(
+		cCtx, db, listener, logger.With(slog.String("component", "mailer")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if cCtx.Bool(flagEmailOutboxEnabled) {
+		if cCtx.Int(flagEmailOutboxInterval) <= 0 {
+			return nil, errors.New("email outbox interval must be positive") //nolint:goerr113
+		}
+
+		outbox := notifications.NewOutbox(
+			db,
+			provider,
+			cCtx.Int(flagEmailOutboxMaxAttempts),
+			emailOutboxBackoff,
+			logger.With(slog.String("component", "outbox")),
+		)
+		go outbox.Run(
+			cCtx.Context, time.Duration(cCtx.Int(flagEmailOutboxInterval))*time.Second,
+		)
+		return notifications.NewEmail(outbox, templates), nil
+	}
+
+	return notifications.NewEmail(
+		notifications.NewRetry(provider, cCtx.Int(flagEmailMaxRetries), emailRetryBackoff),
+		templates,
+	), nil
 }
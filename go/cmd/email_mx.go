@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagEmailMXCheckEnabled  = "email-mx-check-enabled"
+	flagEmailMXCheckTimeout  = "email-mx-check-timeout"
+	flagEmailMXCheckCacheTTL = "email-mx-check-cache-ttl"
+)
+
+func emailMXFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagEmailMXCheckEnabled,
+			Usage:    "Reject emails whose domain doesn't have MX records before sending them any email",
+			Category: "signup",
+			EnvVars:  []string{"AUTH_EMAIL_MX_CHECK_ENABLED"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagEmailMXCheckTimeout,
+			Usage:    "Milliseconds to wait for the MX records of a domain before accepting the email",
+			Value:    2000, //nolint:mnd
+			Category: "signup",
+			EnvVars:  []string{"AUTH_EMAIL_MX_CHECK_TIMEOUT"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagEmailMXCheckCacheTTL,
+			Usage:    "Seconds the result of the MX records lookup of a domain is cached for",
+			Value:    3600, //nolint:mnd
+			Category: "signup",
+			EnvVars:  []string{"AUTH_EMAIL_MX_CHECK_CACHE_TTL"},
+		},
+	}
+}
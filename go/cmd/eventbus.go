@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/eventbus"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagEventBusKafkaBrokers = "event-bus-kafka-brokers"
+	flagEventBusKafkaTopic   = "event-bus-kafka-topic"
+	flagEventBusNATSURL      = "event-bus-nats-url"
+	flagEventBusNATSSubject  = "event-bus-nats-subject"
+)
+
+func eventBusFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagEventBusKafkaBrokers,
+			Usage:    "Comma-separated list of Kafka brokers, i.e. kafka-1:9092,kafka-2:9092. If set, every event is published to event-bus-kafka-topic", //nolint:lll
+			Category: "event bus",
+			EnvVars:  []string{"AUTH_EVENT_BUS_KAFKA_BROKERS"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagEventBusKafkaTopic,
+			Usage:    "Kafka topic the events are published to",
+			Value:    "hasura-auth.events",
+			Category: "event bus",
+			EnvVars:  []string{"AUTH_EVENT_BUS_KAFKA_TOPIC"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagEventBusNATSURL,
+			Usage:    "NATS server url, i.e. nats://nats:4222. If set, every event is published to <event-bus-nats-subject>.<event>", //nolint:lll
+			Category: "event bus",
+			EnvVars:  []string{"AUTH_EVENT_BUS_NATS_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagEventBusNATSSubject,
+			Usage:    "Prefix of the NATS subjects the events are published to",
+			Value:    "hasura-auth",
+			Category: "event bus",
+			EnvVars:  []string{"AUTH_EVENT_BUS_NATS_SUBJECT"},
+		},
+	}
+}
+
+func getEventBusPublisher(cCtx *cli.Context, logger *slog.Logger) (*eventbus.Publisher, error) {
+	brokers := cCtx.StringSlice(flagEventBusKafkaBrokers)
+	natsURL := cCtx.String(flagEventBusNATSURL)
+
+	var broker eventbus.Broker
+	switch {
+	case len(brokers) > 0 && natsURL != "":
+		return nil, errors.New( //nolint:goerr113
+			"only one of event-bus-kafka-brokers and event-bus-nats-url can be set",
+		)
+	case len(brokers) > 0:
+		broker = eventbus.NewKafka(brokers, cCtx.String(flagEventBusKafkaTopic))
+	case natsURL != "":
+		var err error
+		broker, err = eventbus.NewNATS(natsURL, cCtx.String(flagEventBusNATSSubject))
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	default:
+		return nil, nil //nolint:nilnil
+	}
+
+	return eventbus.NewPublisher(broker, logger.With(slog.String("component", "eventbus"))), nil
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import "github.com/urfave/cli/v2"
+
+const (
+	flagForwardAuthEnabled    = "forward-auth-enabled"
+	flagForwardAuthCookieName = "forward-auth-cookie-name"
+)
+
+func forwardAuthFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagForwardAuthEnabled,
+			Usage:    "Enable the /forward-auth/verify endpoint for reverse proxies",
+			Value:    false,
+			Category: "forward-auth",
+			EnvVars:  []string{"AUTH_FORWARD_AUTH_ENABLED"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagForwardAuthCookieName,
+			Usage:    "Cookie the access token is read from if the request has no Authorization header",
+			Category: "forward-auth",
+			EnvVars:  []string{"AUTH_FORWARD_AUTH_COOKIE_NAME"},
+		},
+	}
+}
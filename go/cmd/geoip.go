@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/geoip"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagGeoIPDatabase            = "geoip-database"
+	flagImpossibleTravelPolicy   = "impossible-travel-policy"
+	flagImpossibleTravelMaxSpeed = "impossible-travel-max-speed"
+)
+
+func geoIPFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagGeoIPDatabase,
+			Usage:    "Path to a MaxMind DB file, i.e. GeoLite2 City, to record the approximate location of sessions",
+			Category: "security",
+			EnvVars:  []string{"AUTH_GEOIP_DATABASE"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagImpossibleTravelPolicy,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					controller.ImpossibleTravelPolicyOff,
+					controller.ImpossibleTravelPolicyNotify,
+					controller.ImpossibleTravelPolicyDeny,
+				},
+				Default: controller.ImpossibleTravelPolicyOff,
+			},
+			Usage:    "What happens when users sign in from too far away from where they last used a session: they are emailed about it and, with deny, the sign in is rejected", //nolint:lll
+			Category: "security",
+			EnvVars:  []string{"AUTH_IMPOSSIBLE_TRAVEL_POLICY"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagImpossibleTravelMaxSpeed,
+			Usage:    "Speed in km/h above which traveling between two sign ins is considered impossible",
+			Value:    1000, //nolint:mnd
+			Category: "security",
+			EnvVars:  []string{"AUTH_IMPOSSIBLE_TRAVEL_MAX_SPEED"},
+		},
+	}
+}
+
+func validateImpossibleTravel(cCtx *cli.Context) error {
+	if GetEnumValue(cCtx, flagImpossibleTravelPolicy) == controller.ImpossibleTravelPolicyOff {
+		return nil
+	}
+
+	if cCtx.String(flagGeoIPDatabase) == "" {
+		return fieldError(cCtx, flagGeoIPDatabase, errors.New( //nolint:goerr113
+			"impossible travel detection requires a geoip database",
+		))
+	}
+	if cCtx.Int(flagImpossibleTravelMaxSpeed) <= 0 {
+		return fieldError(
+			cCtx, flagImpossibleTravelMaxSpeed, errors.New("must be positive"), //nolint:goerr113
+		)
+	}
+
+	return nil
+}
+
+// getGeoIP loads the GeoIP database, if any. It returns nil if there isn't one.
+func getGeoIP(cCtx *cli.Context) (controller.GeoIPLocator, error) { //nolint:ireturn
+	path := cCtx.String(flagGeoIPDatabase)
+	if path == "" {
+		return nil, nil
+	}
+
+	reader, err := geoip.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("problem loading geoip database: %w", err)
+	}
+
+	return reader, nil
+}
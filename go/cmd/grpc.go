@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/grpcapi"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+const flagGRPCPort = "grpc-port"
+
+func grpcFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagGRPCPort,
+			Usage:    "Port to serve the gRPC API on. The gRPC API is disabled if empty",
+			Category: "grpc",
+			EnvVars:  []string{"AUTH_GRPC_PORT"},
+		},
+	}
+}
+
+func grpcEnabled(cCtx *cli.Context) bool {
+	return cCtx.String(flagGRPCPort) != ""
+}
+
+// getGRPCServer returns the server of the gRPC API, or nil if it is disabled. It is served
+// on its own port so it can be kept on the internal network.
+func getGRPCServer(
+	cCtx *cli.Context, ctrl *controller.Controller, logger *slog.Logger,
+) *grpc.Server {
+	if !grpcEnabled(cCtx) {
+		return nil
+	}
+
+	grpcServer := controller.NewGRPCServer(ctrl)
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			middleware.GRPCLogger(logger),
+			middleware.GRPCRecovery,
+			grpcServer.AuthenticationInterceptor,
+		),
+	)
+	grpcapi.RegisterAuthServiceServer(server, grpcServer)
+
+	return server
+}
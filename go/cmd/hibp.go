@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/hibp"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	hibpCacheMaxEntries = 1000
+
+	flagHIBPBloomFilterInput             = "input"
+	flagHIBPBloomFilterOutput            = "output"
+	flagHIBPBloomFilterFalsePositiveRate = "false-positive-rate"
+)
+
+func getHIBPClient( //nolint:ireturn
+	cCtx *cli.Context, logger *slog.Logger,
+) (controller.HIBPClient, error) {
+	if !cCtx.Bool(flagPasswordHIBPEnabled) {
+		return hibp.NewClient(), nil
+	}
+
+	if path := cCtx.String(flagPasswordHIBPBloomFilter); path != "" {
+		logger.Info("loading hibp bloom filter", slog.String("path", path))
+		filter, err := hibp.LoadBloomFilter(path)
+		if err != nil {
+			return nil, fmt.Errorf("problem loading hibp bloom filter: %w", err)
+		}
+		return filter, nil
+	}
+
+	if ttl := cCtx.Int(flagPasswordHIBPCacheTTL); ttl > 0 {
+		return hibp.NewCachedClient(
+			hibp.NewClient(), time.Duration(ttl)*time.Second, hibpCacheMaxEntries,
+		), nil
+	}
+
+	return hibp.NewClient(), nil
+}
+
+func countLines(path string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("error opening file: %w", err)
+	}
+	defer f.Close()
+
+	var n uint64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		n++
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("error reading file: %w", err)
+	}
+
+	return n, nil
+}
+
+func buildHIBPBloomFilter(cCtx *cli.Context) error {
+	input := cCtx.String(flagHIBPBloomFilterInput)
+	rate := cCtx.Float64(flagHIBPBloomFilterFalsePositiveRate)
+	if rate <= 0 || rate >= 1 {
+		return errors.New("false positive rate must be between 0 and 1") //nolint:goerr113
+	}
+
+	n, err := countLines(input)
+	if err != nil {
+		return err
+	}
+
+	filter := hibp.NewBloomFilter(n, rate)
+
+	in, err := os.Open(input)
+	if err != nil {
+		return fmt.Errorf("error opening input: %w", err)
+	}
+	defer in.Close()
+
+	if err := filter.AddHashes(in); err != nil {
+		return fmt.Errorf("error adding hashes: %w", err)
+	}
+
+	out, err := os.Create(cCtx.String(flagHIBPBloomFilterOutput))
+	if err != nil {
+		return fmt.Errorf("error creating output: %w", err)
+	}
+	defer out.Close()
+
+	w := bufio.NewWriter(out)
+	if _, err := filter.WriteTo(w); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("error writing output: %w", err)
+	}
+
+	return nil
+}
+
+func CommandHIBPBloomFilter() *cli.Command {
+	return &cli.Command{ //nolint: exhaustruct
+		Name:  "hibp-bloom-filter",
+		Usage: "Build a bloom filter from the SHA-1 Pwned Passwords list to check passwords offline",
+		Flags: []cli.Flag{
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagHIBPBloomFilterInput,
+				Usage:    "File with one SHA-1 hash per line, as downloaded from haveibeenpwned.com",
+				Required: true,
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagHIBPBloomFilterOutput,
+				Usage:    "Path where the bloom filter is written",
+				Required: true,
+			},
+			&cli.Float64Flag{ //nolint: exhaustruct
+				Name:  flagHIBPBloomFilterFalsePositiveRate,
+				Usage: "Rate of passwords wrongly reported as pwned",
+				Value: 0.001, //nolint:mnd
+			},
+		},
+		Action: buildHIBPBloomFilter,
+	}
+}
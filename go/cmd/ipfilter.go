@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagIPAllowList         = "ip-allow-list"
+	flagIPDenyList          = "ip-deny-list"
+	flagIPEndpointAllowList = "ip-endpoint-allow-list"
+	flagIPEndpointDenyList  = "ip-endpoint-deny-list"
+)
+
+func ipFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagTrustedProxies,
+			Usage:    "Comma-separated list of CIDRs of the proxies trusted to set X-Forwarded-For. None are trusted if empty", //nolint:lll
+			Category: "ip-filter",
+			EnvVars:  []string{"AUTH_TRUSTED_PROXIES"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagIPAllowList,
+			Usage:    "Comma-separated list of IPs or CIDRs allowed to reach any endpoint",
+			Category: "ip-filter",
+			EnvVars:  []string{"AUTH_IP_ALLOW_LIST"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagIPDenyList,
+			Usage:    "Comma-separated list of IPs or CIDRs denied from every endpoint",
+			Category: "ip-filter",
+			EnvVars:  []string{"AUTH_IP_DENY_LIST"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagIPEndpointAllowList,
+			Usage:    "Comma-separated list of path=cidr, only the CIDRs given for a path can reach it, i.e. /admin=10.0.0.0/8", //nolint:lll
+			Category: "ip-filter",
+			EnvVars:  []string{"AUTH_IP_ENDPOINT_ALLOW_LIST"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagIPEndpointDenyList,
+			Usage:    "Comma-separated list of path=cidr, the CIDRs given for a path can't reach it",
+			Category: "ip-filter",
+			EnvVars:  []string{"AUTH_IP_ENDPOINT_DENY_LIST"},
+		},
+	}
+}
+
+// getEndpointIPLists groups the path=cidr entries by path keeping the order in which the
+// paths first appear.
+func getEndpointIPLists(entries []string) ([]string, map[string][]string, error) {
+	paths := make([]string, 0, len(entries))
+	cidrs := make(map[string][]string, len(entries))
+	for _, entry := range entries {
+		path, cidr, ok := strings.Cut(entry, "=")
+		if !ok || path == "" || cidr == "" {
+			return nil, nil, fmt.Errorf( //nolint:goerr113
+				"invalid endpoint ip list entry %s, expected path=cidr", entry,
+			)
+		}
+
+		if _, found := cidrs[path]; !found {
+			paths = append(paths, path)
+		}
+		cidrs[path] = append(cidrs[path], cidr)
+	}
+
+	return paths, cidrs, nil
+}
+
+func getIPFilterRules(cCtx *cli.Context) ([]middleware.IPRule, error) {
+	rules := make([]middleware.IPRule, 0)
+
+	allow, err := middleware.ParsePrefixes(cCtx.StringSlice(flagIPAllowList))
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing ip allow list: %w", err)
+	}
+	deny, err := middleware.ParsePrefixes(cCtx.StringSlice(flagIPDenyList))
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing ip deny list: %w", err)
+	}
+	if len(allow) > 0 || len(deny) > 0 {
+		rules = append(rules, middleware.IPRule{Path: "", Allow: allow, Deny: deny})
+	}
+
+	allowPaths, allowCIDRs, err := getEndpointIPLists(cCtx.StringSlice(flagIPEndpointAllowList))
+	if err != nil {
+		return nil, err
+	}
+	denyPaths, denyCIDRs, err := getEndpointIPLists(cCtx.StringSlice(flagIPEndpointDenyList))
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(allowPaths)+len(denyPaths))
+	for _, path := range slices.Concat(allowPaths, denyPaths) {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		allow, err := middleware.ParsePrefixes(allowCIDRs[path])
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing ip allow list of %s: %w", path, err)
+		}
+		deny, err := middleware.ParsePrefixes(denyCIDRs[path])
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing ip deny list of %s: %w", path, err)
+		}
+		rules = append(rules, middleware.IPRule{Path: path, Allow: allow, Deny: deny})
+	}
+
+	return rules, nil
+}
@@ -10,7 +10,13 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-func getJWTGetter(cCtx *cli.Context, db controller.DBClient) (*controller.JWTGetter, error) {
+// customClaimsRequestTimeout is how long the GraphQL queries for the custom claims may
+// take, they block the issuing of access tokens.
+const customClaimsRequestTimeout = 10 * time.Second
+
+func getCustomClaimer( //nolint:ireturn,funlen,cyclop
+	cCtx *cli.Context, db controller.DBClient,
+) (controller.CustomClaimer, error) {
 	var rawClaims map[string]string
 
 	if cCtx.String(flagCustomClaims) != "" {
@@ -19,25 +25,104 @@ func getJWTGetter(cCtx *cli.Context, db controller.DBClient) (*controller.JWTGet
 		}
 	}
 
-	var customClaimer controller.CustomClaimer
-	var err error
+	httpClient := &http.Client{Timeout: customClaimsRequestTimeout} //nolint:exhaustruct
+
+	var customClaimers controller.CustomClaimers
 	if len(rawClaims) > 0 {
-		customClaimer, err = controller.NewCustomClaims(
+		customClaimer, err := controller.NewCustomClaims(
 			rawClaims,
-			&http.Client{}, //nolint:exhaustruct
+			httpClient,
 			cCtx.String(flagGraphqlURL),
 			controller.CustomClaimerAddAdminSecret(cCtx.String(flagHasuraAdminSecret)),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error creating custom claimer: %w", err)
 		}
+		customClaimers = append(customClaimers, customClaimer)
+	}
+
+	if cCtx.String(flagCustomClaimsQuery) != "" {
+		var rawQueryClaims map[string]string
+		if cCtx.String(flagCustomClaimsQueryPaths) != "" {
+			if err := json.Unmarshal(
+				[]byte(cCtx.String(flagCustomClaimsQueryPaths)), &rawQueryClaims,
+			); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal custom claims query paths: %w", err)
+			}
+		}
+
+		queryClaimer, err := controller.NewCustomClaimsFromQuery(
+			cCtx.String(flagCustomClaimsQuery),
+			rawQueryClaims,
+			httpClient,
+			cCtx.String(flagGraphqlURL),
+			controller.CustomClaimerAddAdminSecret(cCtx.String(flagHasuraAdminSecret)),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating custom claims query claimer: %w", err)
+		}
+		customClaimers = append(customClaimers, queryClaimer)
+	}
+
+	var rawMetadataClaims map[string]string
+	if cCtx.String(flagMetadataClaims) != "" {
+		if err := json.Unmarshal(
+			[]byte(cCtx.String(flagMetadataClaims)), &rawMetadataClaims,
+		); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata claims: %w", err)
+		}
+	}
+
+	if len(rawMetadataClaims) > 0 {
+		metadataClaimer, err := controller.NewMetadataClaims(rawMetadataClaims, db)
+		if err != nil {
+			return nil, fmt.Errorf("error creating metadata claimer: %w", err)
+		}
+		customClaimers = append(customClaimers, metadataClaimer)
+	}
+
+	claimsHook, err := getClaimsHook(cCtx)
+	if err != nil {
+		return nil, err
+	}
+	if claimsHook != nil {
+		customClaimers = append(customClaimers, claimsHook)
+	}
+
+	if cCtx.Bool(flagOrganizationsEnabled) {
+		customClaimers = append(customClaimers, controller.NewOrganizationClaims(db))
+	}
+
+	// last so the other claimers can't override it
+	if tenantID := cCtx.String(flagTenantID); tenantID != "" {
+		customClaimers = append(customClaimers, controller.TenantClaims(tenantID))
+	}
+
+	var customClaimer controller.CustomClaimer
+	switch len(customClaimers) {
+	case 0:
+	case 1:
+		customClaimer = customClaimers[0]
+	default:
+		customClaimer = customClaimers
+	}
+
+	return customClaimer, nil
+}
+
+func getJWTGetter(cCtx *cli.Context, db controller.DBClient) (*controller.JWTGetter, error) {
+	customClaimer, err := getCustomClaimer(cCtx, db)
+	if err != nil {
+		return nil, err
 	}
 
 	jwtGetter, err := controller.NewJWTGetter(
 		[]byte(cCtx.String(flagHasuraGraphqlJWTSecret)),
+		[]byte(cCtx.String(flagJWTRetiredSecrets)),
 		time.Duration(cCtx.Int(flagAccessTokensExpiresIn))*time.Second,
 		customClaimer,
 		cCtx.String(flagRequireElevatedClaim),
+		cCtx.Bool(flagAccessTokenRevocationEnabled),
 		db,
 	)
 	if err != nil {
@@ -0,0 +1,59 @@
+package cmd
+
+import "github.com/urfave/cli/v2"
+
+const (
+	flagLockoutEnabled      = "lockout-enabled"
+	flagLockoutBackoffAfter = "lockout-backoff-after"
+	flagLockoutBackoffDelay = "lockout-backoff-delay"
+	flagLockoutThreshold    = "lockout-threshold"
+	flagLockoutIPThreshold  = "lockout-ip-threshold"
+	flagLockoutDuration     = "lockout-duration"
+)
+
+func lockoutFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagLockoutEnabled,
+			Usage:    "Delay and lock out email and password sign ins after failed attempts",
+			Value:    false,
+			Category: "lockout",
+			EnvVars:  []string{"AUTH_LOCKOUT_ENABLED"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagLockoutBackoffAfter,
+			Usage:    "Number of failed attempts of a user allowed before sign ins are delayed",
+			Value:    3, //nolint:mnd
+			Category: "lockout",
+			EnvVars:  []string{"AUTH_LOCKOUT_BACKOFF_AFTER"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagLockoutBackoffDelay,
+			Usage:    "Initial delay in seconds, doubled with every further failed attempt",
+			Value:    1,
+			Category: "lockout",
+			EnvVars:  []string{"AUTH_LOCKOUT_BACKOFF_DELAY"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagLockoutThreshold,
+			Usage:    "Number of failed attempts after which a user is locked out. 0 disables it",
+			Value:    10, //nolint:mnd
+			Category: "lockout",
+			EnvVars:  []string{"AUTH_LOCKOUT_THRESHOLD"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagLockoutIPThreshold,
+			Usage:    "Number of failed attempts after which an IP is locked out. 0 disables it",
+			Value:    100, //nolint:mnd
+			Category: "lockout",
+			EnvVars:  []string{"AUTH_LOCKOUT_IP_THRESHOLD"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagLockoutDuration,
+			Usage:    "Seconds a lockout lasts and after which failed attempts are forgotten",
+			Value:    900, //nolint:mnd
+			Category: "lockout",
+			EnvVars:  []string{"AUTH_LOCKOUT_DURATION"},
+		},
+	}
+}
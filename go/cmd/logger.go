@@ -8,10 +8,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/lmittmann/tint"
+	"github.com/nhost/hasura-auth/go/middleware"
 	"github.com/urfave/cli/v2"
 )
 
-func getLogger(debug bool, formatTEXT bool) *slog.Logger {
+func getLogger(debug bool, formatTEXT bool, redact bool) *slog.Logger {
 	var logLevel slog.Level
 	var addSource bool
 	if debug {
@@ -24,6 +25,11 @@ func getLogger(debug bool, formatTEXT bool) *slog.Logger {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	var replaceAttr func(groups []string, a slog.Attr) slog.Attr
+	if redact {
+		replaceAttr = middleware.RedactAttr
+	}
+
 	var handler slog.Handler
 	if formatTEXT {
 		handler = tint.NewHandler(os.Stdout, &tint.Options{
@@ -31,13 +37,13 @@ func getLogger(debug bool, formatTEXT bool) *slog.Logger {
 			Level:       logLevel,
 			TimeFormat:  time.StampMilli,
 			NoColor:     false,
-			ReplaceAttr: nil,
+			ReplaceAttr: replaceAttr,
 		})
 	} else {
 		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 			AddSource:   addSource,
 			Level:       logLevel,
-			ReplaceAttr: nil,
+			ReplaceAttr: replaceAttr,
 		})
 	}
 
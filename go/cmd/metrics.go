@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/urfave/cli/v2"
+)
+
+const flagMetricsPort = "metrics-port"
+
+func metricsFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagMetricsPort,
+			Usage:    "Port to serve the Prometheus metrics on at /metrics. Metrics are disabled if empty", //nolint:lll
+			Category: "metrics",
+			EnvVars:  []string{"AUTH_METRICS_PORT"},
+		},
+	}
+}
+
+func metricsEnabled(cCtx *cli.Context) bool {
+	return cCtx.String(flagMetricsPort) != ""
+}
+
+// getMetricsServer returns the server of the metrics, or nil if they are disabled. They
+// are served on their own port so they aren't exposed with the API.
+func getMetricsServer(cCtx *cli.Context) *http.Server {
+	if !metricsEnabled(cCtx) {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	return &http.Server{ //nolint:exhaustruct
+		Addr:              ":" + cCtx.String(flagMetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second, //nolint:mnd
+	}
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import "github.com/urfave/cli/v2"
+
+const (
+	flagOIDCProviderEnabled          = "oidc-provider-enabled"
+	flagOIDCProviderAuthorizationURL = "oidc-provider-authorization-url"
+)
+
+func oidcProviderFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagOIDCProviderEnabled,
+			Usage:    "Let registered OAuth2 clients sign in users with OpenID Connect",
+			Value:    false,
+			Category: "oidc-provider",
+			EnvVars:  []string{"AUTH_OIDC_PROVIDER_ENABLED"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagOIDCProviderAuthorizationURL,
+			Usage:    "URL of the page that signs in users and asks for their consent, defaults to {{AUTH_CLIENT_URL}}/oauth/authorize", //nolint:lll
+			Category: "oidc-provider",
+			EnvVars:  []string{"AUTH_OIDC_PROVIDER_AUTHORIZATION_URL"},
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package cmd
+
+import "github.com/urfave/cli/v2"
+
+const flagOrganizationsEnabled = "organizations-enabled"
+
+func organizationFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagOrganizationsEnabled,
+			Usage:    "Enable the organizations endpoints and the x-hasura-org-id and x-hasura-org-role claims",
+			Value:    false,
+			Category: "organizations",
+			EnvVars:  []string{"AUTH_ORGANIZATIONS_ENABLED"},
+		},
+	}
+}
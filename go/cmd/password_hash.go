@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagPasswordHashAlgorithm     = "password-hash-algorithm"
+	flagPasswordArgon2Memory      = "password-argon2-memory"
+	flagPasswordArgon2Iterations  = "password-argon2-iterations"
+	flagPasswordArgon2Parallelism = "password-argon2-parallelism"
+	flagPasswordPeppers           = "password-peppers"
+	flagPasswordPeppersFile       = "password-peppers-file"
+
+	passwordPepperMinLength = 16
+)
+
+func passwordHashFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagPasswordHashAlgorithm,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					controller.PasswordHashAlgorithmBcrypt,
+					controller.PasswordHashAlgorithmArgon2id,
+				},
+				Default: controller.PasswordHashAlgorithmBcrypt,
+			},
+			Usage:    "Algorithm new passwords are hashed with. With argon2id, bcrypt hashes are replaced when users sign in", //nolint:lll
+			Category: "security",
+			EnvVars:  []string{"AUTH_PASSWORD_HASH_ALGORITHM"},
+		},
+		&cli.UintFlag{ //nolint: exhaustruct
+			Name:     flagPasswordArgon2Memory,
+			Usage:    "Memory in KiB used to hash each password with argon2id",
+			Value:    19456, //nolint:mnd
+			Category: "security",
+			EnvVars:  []string{"AUTH_PASSWORD_ARGON2_MEMORY"},
+		},
+		&cli.UintFlag{ //nolint: exhaustruct
+			Name:     flagPasswordArgon2Iterations,
+			Usage:    "Number of passes over the memory to hash each password with argon2id",
+			Value:    2, //nolint:mnd
+			Category: "security",
+			EnvVars:  []string{"AUTH_PASSWORD_ARGON2_ITERATIONS"},
+		},
+		&cli.UintFlag{ //nolint: exhaustruct
+			Name:     flagPasswordArgon2Parallelism,
+			Usage:    "Number of threads used to hash each password with argon2id",
+			Value:    1,
+			Category: "security",
+			EnvVars:  []string{"AUTH_PASSWORD_ARGON2_PARALLELISM"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagPasswordPeppers,
+			Usage:    "Comma-separated list of id:secret peppers passwords are peppered with before hashing them. New passwords use the first one", //nolint:lll
+			Category: "security",
+			EnvVars:  []string{"AUTH_PASSWORD_PEPPERS"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagPasswordPeppersFile,
+			Usage:    "File with one id:secret pepper per line, i.e. mounted by a secrets manager. They are added after the ones of password-peppers", //nolint:lll
+			Category: "security",
+			EnvVars:  []string{"AUTH_PASSWORD_PEPPERS_FILE"},
+		},
+	}
+}
+
+// getArgon2Params returns the memory, iterations and parallelism argon2id hashes are
+// generated with.
+func getArgon2Params(cCtx *cli.Context) (uint32, uint32, uint8, error) {
+	memory := cCtx.Uint(flagPasswordArgon2Memory)
+	iterations := cCtx.Uint(flagPasswordArgon2Iterations)
+	parallelism := cCtx.Uint(flagPasswordArgon2Parallelism)
+
+	if parallelism == 0 || parallelism > math.MaxUint8 {
+		return 0, 0, 0, fieldError(
+			cCtx, flagPasswordArgon2Parallelism, errors.New("must be between 1 and 255"), //nolint:goerr113
+		)
+	}
+	if iterations == 0 || iterations > controller.Argon2MaxIterations {
+		return 0, 0, 0, fieldError(cCtx, flagPasswordArgon2Iterations, fmt.Errorf( //nolint:goerr113
+			"must be between 1 and %d", controller.Argon2MaxIterations,
+		))
+	}
+	// argon2 needs at least 8 KiB per thread and hashes requiring more than
+	// Argon2MaxMemory aren't verified
+	if memory < 8*parallelism || memory > controller.Argon2MaxMemory {
+		return 0, 0, 0, fieldError(cCtx, flagPasswordArgon2Memory, fmt.Errorf( //nolint:goerr113
+			"must be between %d and %d KiB", 8*parallelism, controller.Argon2MaxMemory,
+		))
+	}
+
+	return uint32(memory), uint32(iterations), uint8(parallelism), nil //nolint:gosec
+}
+
+var errInvalidPasswordPepper = errors.New("invalid password pepper")
+
+// getPasswordPeppers returns the peppers of the flag followed by the ones of the file.
+func getPasswordPeppers(cCtx *cli.Context) ([]controller.PasswordPepper, error) {
+	entries := cCtx.StringSlice(flagPasswordPeppers)
+	if filename := cCtx.String(flagPasswordPeppersFile); filename != "" {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fieldError(
+				cCtx, flagPasswordPeppersFile, fmt.Errorf("failed to read password peppers file: %w", err),
+			)
+		}
+		entries = append(entries, strings.Split(string(b), "\n")...)
+	}
+
+	peppers := make([]controller.PasswordPepper, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, secret, ok := strings.Cut(entry, ":")
+		if !ok || id == "" || strings.Contains(id, "$") {
+			return nil, fieldError(cCtx, flagPasswordPeppers, fmt.Errorf(
+				"%w: peppers must be id:secret and ids can't contain $", errInvalidPasswordPepper,
+			))
+		}
+		if len(secret) < passwordPepperMinLength {
+			return nil, fieldError(cCtx, flagPasswordPeppers, fmt.Errorf(
+				"%w: the secret of %s must be at least %d characters long",
+				errInvalidPasswordPepper, id, passwordPepperMinLength,
+			))
+		}
+		if slices.ContainsFunc(peppers, func(p controller.PasswordPepper) bool {
+			return p.ID == id
+		}) {
+			return nil, fieldError(
+				cCtx, flagPasswordPeppers, fmt.Errorf("%w: duplicated pepper %s", errInvalidPasswordPepper, id),
+			)
+		}
+
+		peppers = append(peppers, controller.PasswordPepper{ID: id, Secret: secret})
+	}
+
+	return peppers, nil
+}
@@ -0,0 +1,391 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/urfave/cli/v2"
+)
+
+func providerNames() []string {
+	names := make([]string, 0, len(providers.Presets))
+	for name := range providers.Presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func providerFlagName(provider, option string) string {
+	return "provider-" + provider + "-" + option
+}
+
+func providerEnvVar(provider, option string) string {
+	return "AUTH_PROVIDER_" + strings.ToUpper(provider) + "_" +
+		strings.ToUpper(strings.ReplaceAll(option, "-", "_"))
+}
+
+func providerFlags() []cli.Flag {
+	names := providerNames()
+	flags := make([]cli.Flag, 0, len(names)*4) //nolint:mnd
+	for _, name := range names {
+		flags = append(flags,
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     providerFlagName(name, "enabled"),
+				Usage:    "Enable " + name + " OAuth provider",
+				Value:    false,
+				Category: "oauth",
+				EnvVars:  []string{providerEnvVar(name, "enabled")},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     providerFlagName(name, "client-id"),
+				Usage:    name + " OAuth client ID",
+				Category: "oauth",
+				EnvVars:  []string{providerEnvVar(name, "client-id")},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     providerFlagName(name, "client-secret"),
+				Usage:    name + " OAuth client secret",
+				Category: "oauth",
+				EnvVars:  []string{providerEnvVar(name, "client-secret")},
+			},
+			&cli.StringSliceFlag{ //nolint: exhaustruct
+				Name:     providerFlagName(name, "scope"),
+				Usage:    name + " OAuth scopes, defaults to the provider's recommended scopes",
+				Category: "oauth",
+				EnvVars:  []string{providerEnvVar(name, "scope")},
+			},
+		)
+	}
+	flags = append(flags, appleFlags()...)
+	flags = append(flags, azureADFlags()...)
+	flags = append(flags, oidcFlags()...)
+	return append(flags, idTokenFlags()...)
+}
+
+// idTokenFlags configures the providers that can be used with /signin/idtoken.
+func idTokenFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("google", "id-token-audiences"),
+			Usage:    "Client IDs of native applications using Google Sign-In, in addition to the provider's client ID", //nolint:lll
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("google", "id-token-audiences")},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "id-token-audiences"),
+			Usage:    "Bundle IDs of native applications using Sign in with Apple, in addition to the provider's client ID", //nolint:lll
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "id-token-audiences")},
+		},
+	}
+}
+
+func appleFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "enabled"),
+			Usage:    "Enable Sign in with Apple",
+			Value:    false,
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "enabled")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "client-id"),
+			Usage:    "Apple service ID",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "client-id")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "team-id"),
+			Usage:    "Apple team ID",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "team-id")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "key-id"),
+			Usage:    "ID of the key used to sign the client secret",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "key-id")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "private-key"),
+			Usage:    "Contents of the .p8 key file used to sign the client secret, either base64 encoded or in PEM format", //nolint:lll
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "private-key")},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("apple", "scope"),
+			Usage:    "Sign in with Apple scopes",
+			Value:    cli.NewStringSlice("name", "email"),
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("apple", "scope")},
+		},
+	}
+}
+
+func azureADFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("azuread", "enabled"),
+			Usage:    "Enable Microsoft Entra ID (Azure AD) OAuth provider",
+			Value:    false,
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("azuread", "enabled")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("azuread", "client-id"),
+			Usage:    "Microsoft Entra ID application (client) ID",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("azuread", "client-id")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("azuread", "client-secret"),
+			Usage:    "Microsoft Entra ID client secret",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("azuread", "client-secret")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("azuread", "tenant"),
+			Usage:    "Tenant used to sign in: common, organizations, consumers or a tenant ID",
+			Value:    "common",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("azuread", "tenant")},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("azuread", "allowed-tenants"),
+			Usage:    "Only allow users from these tenant IDs to sign in, useful with the common and organizations tenants", //nolint:lll
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("azuread", "allowed-tenants")},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("azuread", "scope"),
+			Usage:    "Microsoft Entra ID scopes",
+			Value:    cli.NewStringSlice("openid", "email", "profile"),
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("azuread", "scope")},
+		},
+	}
+}
+
+func oidcFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("oidc", "enabled"),
+			Usage:    "Enable generic OpenID Connect provider",
+			Value:    false,
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("oidc", "enabled")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("oidc", "issuer"),
+			Usage:    "OpenID Connect issuer URL, used to fetch the discovery document",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("oidc", "issuer")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("oidc", "client-id"),
+			Usage:    "OpenID Connect client ID",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("oidc", "client-id")},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("oidc", "client-secret"),
+			Usage:    "OpenID Connect client secret",
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("oidc", "client-secret")},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     providerFlagName("oidc", "scope"),
+			Usage:    "OpenID Connect scopes, openid is always requested",
+			Value:    cli.NewStringSlice("openid", "email", "profile"),
+			Category: "oauth",
+			EnvVars:  []string{providerEnvVar("oidc", "scope")},
+		},
+	}
+}
+
+// decodeApplePrivateKey accepts the key file either base64 encoded or in PEM format,
+// in which case new lines might have been escaped to fit the key in a single line.
+func decodeApplePrivateKey(key string) ([]byte, error) {
+	if strings.Contains(key, "-----BEGIN") {
+		return []byte(strings.ReplaceAll(key, `\n`, "\n")), nil
+	}
+
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding apple private key: %w", err)
+	}
+	return b, nil
+}
+
+func getAppleProvider(cCtx *cli.Context, serverURL *url.URL) (*providers.Apple, error) {
+	for _, option := range []string{"client-id", "team-id", "key-id", "private-key"} {
+		if cCtx.String(providerFlagName("apple", option)) == "" {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"provider apple is enabled but %s is missing", option,
+			)
+		}
+	}
+
+	key, err := decodeApplePrivateKey(cCtx.String(providerFlagName("apple", "private-key")))
+	if err != nil {
+		return nil, err
+	}
+
+	apple, err := providers.NewApple(
+		cCtx.String(providerFlagName("apple", "client-id")),
+		cCtx.String(providerFlagName("apple", "team-id")),
+		cCtx.String(providerFlagName("apple", "key-id")),
+		key,
+		serverURL.JoinPath("signin", "provider", "apple", "callback").String(),
+		cCtx.StringSlice(providerFlagName("apple", "scope")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating apple provider: %w", err)
+	}
+
+	return apple, nil
+}
+
+func getOIDCProvider(cCtx *cli.Context, serverURL *url.URL) (*providers.OIDC, error) {
+	for _, option := range []string{"issuer", "client-id", "client-secret"} {
+		if cCtx.String(providerFlagName("oidc", option)) == "" {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"provider oidc is enabled but %s is missing", option,
+			)
+		}
+	}
+
+	discovery, err := providers.DiscoverOIDC(
+		cCtx.Context, cCtx.String(providerFlagName("oidc", "issuer")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating oidc provider: %w", err)
+	}
+
+	return providers.NewOIDC(
+		discovery,
+		cCtx.String(providerFlagName("oidc", "client-id")),
+		cCtx.String(providerFlagName("oidc", "client-secret")),
+		serverURL.JoinPath("signin", "provider", "oidc", "callback").String(),
+		cCtx.StringSlice(providerFlagName("oidc", "scope")),
+	), nil
+}
+
+func getOAuthProviders(cCtx *cli.Context) (map[string]providers.Provider, error) {
+	serverURL, err := url.Parse(cCtx.String(flagServerURL))
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing server url: %w", err)
+	}
+
+	oauthProviders := make(map[string]providers.Provider)
+	for _, name := range providerNames() {
+		if !cCtx.Bool(providerFlagName(name, "enabled")) {
+			continue
+		}
+
+		clientID := cCtx.String(providerFlagName(name, "client-id"))
+		clientSecret := cCtx.String(providerFlagName(name, "client-secret"))
+		if clientID == "" || clientSecret == "" {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"provider %s is enabled but client id or secret are missing", name,
+			)
+		}
+
+		oauthProviders[name] = providers.NewOAuth2(
+			providers.Presets[name],
+			clientID,
+			clientSecret,
+			serverURL.JoinPath("signin", "provider", name, "callback").String(),
+			cCtx.StringSlice(providerFlagName(name, "scope")),
+		)
+	}
+
+	if cCtx.Bool(providerFlagName("apple", "enabled")) {
+		apple, err := getAppleProvider(cCtx, serverURL)
+		if err != nil {
+			return nil, err
+		}
+		oauthProviders["apple"] = apple
+	}
+
+	if cCtx.Bool(providerFlagName("azuread", "enabled")) {
+		clientID := cCtx.String(providerFlagName("azuread", "client-id"))
+		clientSecret := cCtx.String(providerFlagName("azuread", "client-secret"))
+		if clientID == "" || clientSecret == "" {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"provider azuread is enabled but client id or secret are missing",
+			)
+		}
+
+		oauthProviders["azuread"] = providers.NewMicrosoft(
+			clientID,
+			clientSecret,
+			cCtx.String(providerFlagName("azuread", "tenant")),
+			cCtx.StringSlice(providerFlagName("azuread", "allowed-tenants")),
+			serverURL.JoinPath("signin", "provider", "azuread", "callback").String(),
+			cCtx.StringSlice(providerFlagName("azuread", "scope")),
+		)
+	}
+
+	if cCtx.Bool(providerFlagName("oidc", "enabled")) {
+		oidc, err := getOIDCProvider(cCtx, serverURL)
+		if err != nil {
+			return nil, err
+		}
+		oauthProviders["oidc"] = oidc
+	}
+
+	return oauthProviders, nil
+}
+
+// getIDTokenProviders returns the providers whose ID tokens are accepted by /signin/idtoken.
+// They are enabled along with the OAuth provider and accept tokens issued to its client ID
+// and to the native applications' client IDs.
+func getIDTokenProviders(cCtx *cli.Context) map[string]providers.IDTokenProvider {
+	idTokenProviders := make(map[string]providers.IDTokenProvider)
+	audiences := func(name string) []string {
+		return append(
+			[]string{cCtx.String(providerFlagName(name, "client-id"))},
+			cCtx.StringSlice(providerFlagName(name, "id-token-audiences"))...,
+		)
+	}
+
+	if cCtx.Bool(providerFlagName("google", "enabled")) {
+		idTokenProviders["google"] = providers.NewGoogleIDToken(audiences("google"))
+	}
+
+	if cCtx.Bool(providerFlagName("apple", "enabled")) {
+		idTokenProviders["apple"] = providers.NewAppleIDToken(audiences("apple"))
+	}
+
+	return idTokenProviders
+}
+
+// nodejsProviderFallback forwards the provider sign in flow to the nodejs server
+// for providers that haven't been enabled in the go server. It needs to run
+// before the generated handlers as they validate the parameters of the request.
+func nodejsProviderFallback(
+	oauthProviders map[string]providers.Provider,
+	nodejsHandler gin.HandlerFunc,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasSuffix(c.FullPath(), "/signin/provider/:provider") &&
+			!strings.HasSuffix(c.FullPath(), "/signin/provider/:provider/callback") {
+			return
+		}
+
+		if _, ok := oauthProviders[c.Param("provider")]; ok {
+			return
+		}
+
+		nodejsHandler(c)
+		c.Abort()
+	}
+}
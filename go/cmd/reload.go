@@ -0,0 +1,449 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/redis/go-redis/v9"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagConfigFile          = "config-file"
+	flagConfigReloadEnabled = "config-reload-enabled"
+
+	// configDrainTimeout is how long the background workers of a router replaced by a
+	// reload keep running, so the requests it is still serving can finish.
+	configDrainTimeout = 30 * time.Second
+)
+
+var (
+	errInvalidConfigFile   = errors.New("invalid config file")
+	errConfigReloadTenants = errors.New(
+		"the configuration can't be reloaded, nor the secrets refreshed, with a tenants file",
+	)
+)
+
+// reloadEnvPrefixes are the environment variables that can be changed without a restart.
+var reloadEnvPrefixes = []string{ //nolint:gochecknoglobals
+	"AUTH_EMAIL_TEMPLATES_",
+	"AUTH_ACCESS_CONTROL_ALLOWED_REDIRECT_URLS",
+	"AUTH_RATE_LIMIT_",
+	"AUTH_PROVIDER_",
+}
+
+func reloadFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagConfigFile,
+			Usage:    "File with environment variables, one KEY=VALUE per line, overriding the ones of the process",
+			Category: "server",
+			EnvVars:  []string{"AUTH_CONFIG_FILE"},
+		},
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagConfigReloadEnabled,
+			Usage:    "Reload the configuration that can be changed without a restart on SIGHUP or with the /admin/config/reload endpoint", //nolint:lll
+			Value:    false,
+			Category: "server",
+			EnvVars:  []string{"AUTH_CONFIG_RELOAD_ENABLED"},
+		},
+	}
+}
+
+func reloadEnvAllowed(name string) bool {
+	for _, prefix := range reloadEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readConfigFile returns the environment variables of the config file. Empty lines and
+// the ones starting with # are skipped, values can be quoted.
+func readConfigFile(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	defer f.Close()
+
+	env := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: line %d: expected KEY=VALUE", errInvalidConfigFile, n)
+		}
+
+		value = strings.TrimSpace(value)
+		switch {
+		case len(value) > 1 && value[0] == '"' && value[len(value)-1] == '"':
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", errInvalidConfigFile, n, err)
+			}
+		case len(value) > 1 && value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		}
+
+		env[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	return env, nil
+}
+
+// serveConfig is the configuration serve runs with: the flags of the command, overridden
+// by the config file, with their secret references resolved.
+type serveConfig struct {
+	base *cli.Context
+	// file is the environment of the config file in use
+	file map[string]string
+	// secrets are the values of the secret references in use, keyed by flag
+	secrets map[string]string
+}
+
+func newServeConfig(cCtx *cli.Context) (*serveConfig, error) {
+	c := &serveConfig{
+		base:    cCtx,
+		file:    nil,
+		secrets: nil,
+	}
+
+	if filename := cCtx.String(flagConfigFile); filename != "" {
+		file, err := readConfigFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		c.file = file
+	}
+
+	return c, nil
+}
+
+// load returns a context with the flags set by the environment of file, and the ones
+// set to a secret reference resolved, along with the values of the secrets.
+func (c *serveConfig) load(
+	ctx context.Context, file map[string]string,
+) (*cli.Context, map[string]string, error) {
+	cCtx := c.base
+	if len(file) > 0 {
+		flags := envFlags(c.base)
+		values := make(map[string]string, len(file))
+		for name, value := range file {
+			if flagName, ok := flags[name]; ok {
+				values[flagName] = value
+			}
+		}
+
+		var err error
+		cCtx, err = overrideContext(c.base, values)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errInvalidConfigFile, err)
+		}
+	}
+
+	return resolveSecrets(ctx, cCtx)
+}
+
+// env returns the environment of the config file, for the Node.js server.
+func (c *serveConfig) env() []string {
+	env := make([]string, 0, len(c.file))
+	for name, value := range c.file {
+		env = append(env, name+"="+value)
+	}
+	slices.Sort(env)
+	return env
+}
+
+// reloadsConfig reports if serve needs a configReloader, either because the configuration can
+// be reloaded or because the secrets are refreshed.
+func (c *serveConfig) reloadsConfig(cCtx *cli.Context) bool {
+	return cCtx.Bool(flagConfigReloadEnabled) ||
+		cCtx.Int(flagSecretsRefreshInterval) > 0 && len(c.secrets) > 0
+}
+
+// mergeConfigFile returns the environment of the config file to use after it changed from
+// current to next, along with the variables that changed and the ones that changed but
+// can't be reloaded. Those keep their current value.
+func mergeConfigFile(
+	current, next map[string]string,
+) (map[string]string, []string, []string) {
+	names := make([]string, 0, len(current)+len(next))
+	for name := range current {
+		names = append(names, name)
+	}
+	for name := range next {
+		if _, ok := current[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	merged := make(map[string]string, len(next))
+	for name, value := range current {
+		merged[name] = value
+	}
+
+	changed := make([]string, 0)
+	ignored := make([]string, 0)
+	for _, name := range names {
+		value, ok := next[name]
+		if currentValue, currentOK := current[name]; ok == currentOK && value == currentValue {
+			continue
+		}
+
+		if !reloadEnvAllowed(name) {
+			ignored = append(ignored, name)
+			continue
+		}
+
+		if ok {
+			merged[name] = value
+		} else {
+			delete(merged, name)
+		}
+		changed = append(changed, name)
+	}
+
+	return merged, changed, ignored
+}
+
+// reloadableHandler serves the requests with the latest router it was given. Requests in
+// flight keep being served by the router they started with.
+type reloadableHandler struct {
+	current atomic.Pointer[http.Handler]
+}
+
+func newReloadableHandler(handler http.Handler) *reloadableHandler {
+	h := &reloadableHandler{} //nolint:exhaustruct
+	h.Store(handler)
+	return h
+}
+
+func (h *reloadableHandler) Store(handler http.Handler) {
+	h.current.Store(&handler)
+}
+
+func (h *reloadableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*h.current.Load()).ServeHTTP(w, r)
+}
+
+// newRedisClient returns a client closed once ctx is done, so the ones of the routers
+// replaced by a reload don't leak.
+func newRedisClient(ctx context.Context, opts *redis.Options) *redis.Client {
+	client := redis.NewClient(opts)
+	context.AfterFunc(ctx, func() { _ = client.Close() })
+	return client
+}
+
+// configReloader rebuilds the router of serve when its configuration changes. Routers run
+// their background workers, like the email outbox, with a context of their own that is
+// cancelled once they are replaced.
+type configReloader struct {
+	config  *serveConfig
+	db      *sql.Queries
+	logger  *slog.Logger
+	ctx     context.Context //nolint:containedctx
+	handler *reloadableHandler
+
+	mu sync.Mutex
+	// cancel stops the background workers of the current router
+	cancel context.CancelFunc
+}
+
+// router returns a router for cCtx. Its background workers stop when the returned
+// function is called.
+func (r *configReloader) router(
+	cCtx *cli.Context,
+) (*gin.Engine, *controller.Controller, context.CancelFunc, error) {
+	c, err := overrideContext(cCtx, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	c.Context = ctx
+
+	router, ctrl, err := getRouter(c, r.db, r, r.logger)
+	if err != nil {
+		cancel()
+		return nil, nil, nil, err
+	}
+
+	return router, ctrl, cancel, nil
+}
+
+// startConfigReloader returns the router of serve, rebuilt every time the configuration is
+// reloaded, and the controller of the first one.
+func startConfigReloader(
+	cCtx *cli.Context, config *serveConfig, db *sql.Queries, logger *slog.Logger,
+) (http.Handler, *controller.Controller, error) {
+	if cCtx.String(flagTenantsFile) != "" {
+		return nil, nil, errConfigReloadTenants
+	}
+
+	r := &configReloader{ //nolint:exhaustruct
+		config: config,
+		db:     db,
+		logger: logger,
+		ctx:    cCtx.Context,
+	}
+
+	router, ctrl, cancel, err := r.router(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.cancel = cancel
+	r.handler = newReloadableHandler(router)
+
+	if cCtx.Bool(flagConfigReloadEnabled) {
+		go r.reloadOnSIGHUP()
+	}
+
+	interval := time.Duration(cCtx.Int(flagSecretsRefreshInterval)) * time.Second
+	if interval > 0 && len(config.secrets) > 0 {
+		go r.refreshSecrets(interval)
+	}
+
+	return r.handler, ctrl, nil
+}
+
+// Reload reads the config file again and resolves the secret references again. Variables
+// of the config file that can't be reloaded keep their current value.
+func (r *configReloader) Reload(ctx context.Context) ([]string, []string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file := r.config.file
+	changed := make([]string, 0)
+	ignored := make([]string, 0)
+	if filename := r.config.base.String(flagConfigFile); filename != "" {
+		next, err := readConfigFile(filename)
+		if err != nil {
+			return nil, nil, err
+		}
+		file, changed, ignored = mergeConfigFile(r.config.file, next)
+	}
+
+	changed, err := r.reload(ctx, file, changed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changed, ignored, nil
+}
+
+// reload replaces the router with one using the environment of file if it, or any of
+// the secrets, changed. It returns the variables that changed.
+func (r *configReloader) reload(
+	ctx context.Context, file map[string]string, changed []string,
+) ([]string, error) {
+	cCtx, secrets, err := r.config.load(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range secrets {
+		if current, ok := r.config.secrets[name]; !ok || current != value {
+			changed = append(changed, flagEnvName(r.config.base, name))
+		}
+	}
+	slices.Sort(changed)
+	changed = slices.Compact(changed)
+
+	if len(changed) == 0 {
+		return changed, nil
+	}
+
+	router, _, cancel, err := r.router(cCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.handler.Store(router)
+	time.AfterFunc(configDrainTimeout, r.cancel)
+	r.cancel = cancel
+	r.config.file = file
+	r.config.secrets = secrets
+
+	return changed, nil
+}
+
+func (r *configReloader) reloadOnSIGHUP() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ch:
+		}
+
+		changed, ignored, err := r.Reload(r.ctx)
+		if err != nil {
+			r.logger.Error(
+				"problem reloading the configuration", slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		r.logger.Info(
+			"configuration reloaded",
+			slog.Any("changed", changed),
+			slog.Any("ignored", ignored),
+		)
+	}
+}
+
+// refreshSecrets resolves the secret references every interval and reloads the
+// configuration if any of them changed. Refreshes that fail keep the current values.
+func (r *configReloader) refreshSecrets(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		r.mu.Lock()
+		changed, err := r.reload(r.ctx, r.config.file, nil)
+		r.mu.Unlock()
+
+		switch {
+		case err != nil:
+			r.logger.Warn("problem refreshing secrets", slog.String("error", err.Error()))
+		case len(changed) > 0:
+			r.logger.Info("secrets changed, configuration reloaded", slog.Any("changed", changed))
+		}
+	}
+}
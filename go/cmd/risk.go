@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/risk"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagRiskEngine         = "risk-engine"
+	flagRiskRiskyNetworks  = "risk-risky-networks"
+	flagRiskWebhookURL     = "risk-webhook-url"
+	flagRiskWebhookSecret  = "risk-webhook-secret"
+	flagRiskWebhookTimeout = "risk-webhook-timeout"
+	flagRiskMFAThreshold   = "risk-mfa-threshold"
+	flagRiskBlockThreshold = "risk-block-threshold"
+)
+
+// Values of the risk-engine flag.
+const (
+	riskEngineOff       = "off"
+	riskEngineHeuristic = "heuristic"
+	riskEngineWebhook   = "webhook"
+)
+
+func riskFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagRiskEngine,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum:    []string{riskEngineOff, riskEngineHeuristic, riskEngineWebhook},
+				Default: riskEngineOff,
+			},
+			Usage:    "Engine scoring the risk of sign ins with email and password or SMS OTP: the built-in heuristic or an external one called with risk-webhook-url", //nolint:lll
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_ENGINE"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagRiskRiskyNetworks,
+			Usage:    "Comma-separated list of networks in CIDR notation the heuristic risk engine considers risky, i.e. Tor exit nodes or hosting providers", //nolint:lll
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_RISKY_NETWORKS"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagRiskWebhookURL,
+			Usage:    "URL the sign in attempts are posted to so it responds with their risk score",
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_WEBHOOK_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagRiskWebhookSecret,
+			Usage:    "Secret used to sign the risk webhook requests with HMAC-SHA256. Defaults to webhook-secret",
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_WEBHOOK_SECRET"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagRiskWebhookTimeout,
+			Usage:    "Seconds to wait for the risk webhook to respond before allowing the sign in",
+			Value:    5, //nolint:mnd
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_WEBHOOK_TIMEOUT"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagRiskMFAThreshold,
+			Usage:    "Risk score, from 0 to 100, from which sign ins require multi-factor authentication. 0 disables it",
+			Value:    50, //nolint:mnd
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_MFA_THRESHOLD"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagRiskBlockThreshold,
+			Usage:    "Risk score, from 0 to 100, from which sign ins are blocked. 0 disables it",
+			Value:    80, //nolint:mnd
+			Category: "security",
+			EnvVars:  []string{"AUTH_RISK_BLOCK_THRESHOLD"},
+		},
+	}
+}
+
+func validateRiskThresholds(cCtx *cli.Context) error {
+	var errs []error
+	for _, flag := range []string{flagRiskMFAThreshold, flagRiskBlockThreshold} {
+		if v := cCtx.Int(flag); v < 0 || v > risk.MaxScore {
+			errs = append(errs, fieldError(
+				cCtx, flag, fmt.Errorf("must be between 0 and %d", risk.MaxScore), //nolint:goerr113
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// getRiskEngine returns the engine scoring the risk of sign ins, nil if it is off.
+func getRiskEngine(cCtx *cli.Context) (controller.RiskEngine, error) { //nolint:ireturn
+	switch GetEnumValue(cCtx, flagRiskEngine) {
+	case riskEngineHeuristic:
+		var networks []netip.Prefix
+		for _, network := range cCtx.StringSlice(flagRiskRiskyNetworks) {
+			prefix, err := netip.ParsePrefix(network)
+			if err != nil {
+				return nil, fmt.Errorf("problem parsing risky network %q: %w", network, err)
+			}
+			networks = append(networks, prefix.Masked())
+		}
+		return risk.NewHeuristic(networks), nil
+	case riskEngineWebhook:
+		url := cCtx.String(flagRiskWebhookURL)
+		if url == "" {
+			return nil, errors.New("the webhook risk engine requires a url") //nolint:goerr113
+		}
+
+		secret := hookSecret(cCtx, flagRiskWebhookSecret)
+		if secret == "" {
+			return nil, errors.New("risk webhook secret is required") //nolint:goerr113
+		}
+
+		return risk.NewWebhook(
+			url, secret, time.Duration(cCtx.Int(flagRiskWebhookTimeout))*time.Second,
+		), nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/changes"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagRoleCacheTTL        = "role-cache-ttl"
+	flagRoleCacheMaxEntries = "role-cache-max-entries"
+)
+
+func roleCacheFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagRoleCacheTTL,
+			Usage:    "Seconds the roles of a user are cached for when issuing sessions. Instances evict the roles changed by other instances only if change notifications are enabled. 0 disables the cache", //nolint:lll
+			Value:    0,
+			Category: "postgres",
+			EnvVars:  []string{"AUTH_ROLE_CACHE_TTL"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagRoleCacheMaxEntries,
+			Usage:    "Maximum number of users whose roles are cached",
+			Value:    10000, //nolint:mnd
+			Category: "postgres",
+			EnvVars:  []string{"AUTH_ROLE_CACHE_MAX_ENTRIES"},
+		},
+	}
+}
+
+// getRoleCachedDB returns db with the roles of the users cached if the cache is enabled.
+// The roles are evicted as the listener, if any, notifies their changes.
+func getRoleCachedDB( //nolint:ireturn
+	cCtx *cli.Context, db controller.DBClient, listener *changes.Listener, logger *slog.Logger,
+) controller.DBClient {
+	ttl := cCtx.Int(flagRoleCacheTTL)
+	if ttl <= 0 {
+		return db
+	}
+
+	cached := controller.NewRoleCachedDB(
+		db, time.Duration(ttl)*time.Second, cCtx.Int(flagRoleCacheMaxEntries),
+	)
+
+	if listener == nil {
+		logger.Warn(
+			"roles are cached without change notifications, " +
+				"roles changed by other instances are only seen once they expire",
+		)
+		return cached
+	}
+
+	listener.Subscribe("user_roles", func(_ context.Context, change changes.Change) {
+		userID, err := uuid.Parse(change.UserID)
+		if err != nil {
+			cached.InvalidateAll()
+			return
+		}
+		cached.Invalidate(userID)
+	})
+	listener.Subscribe("roles", func(_ context.Context, _ changes.Change) {
+		cached.InvalidateAll()
+	})
+
+	return cached
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagAnonymousDefaultRole      = "anonymous-default-role"
+	flagAnonymousAllowedRoles     = "anonymous-default-allowed-roles"
+	flagProviderDefaultRole       = "provider-default-role"
+	flagProviderAllowedRoles      = "provider-default-allowed-roles"
+	flagPasswordlessDefaultRole   = "passwordless-default-role"
+	flagPasswordlessAllowedRoles  = "passwordless-default-allowed-roles"
+	flagEmailPasswordDefaultRole  = "email-password-default-role"
+	flagEmailPasswordAllowedRoles = "email-password-default-allowed-roles"
+)
+
+var errMissingDefaultRole = errors.New("allowed roles require a default role")
+
+func signInMethodRoleFlags(
+	method string, flagDefaultRole string, flagAllowedRoles string, envPrefix string,
+) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagDefaultRole,
+			Usage:    fmt.Sprintf("Default role for users signing up with %s. Defaults to the default user role", method),
+			Category: "signup",
+			EnvVars:  []string{envPrefix + "_USER_DEFAULT_ROLE"},
+		},
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagAllowedRoles,
+			Usage:    fmt.Sprintf("Comma-separated list of allowed roles for users signing up with %s", method),
+			Category: "signup",
+			EnvVars:  []string{envPrefix + "_USER_DEFAULT_ALLOWED_ROLES"},
+		},
+	}
+}
+
+func roleFlags() []cli.Flag {
+	return slices.Concat(
+		signInMethodRoleFlags(
+			"anonymous sign in", flagAnonymousDefaultRole, flagAnonymousAllowedRoles, "AUTH_ANONYMOUS",
+		),
+		signInMethodRoleFlags(
+			"OAuth, SAML or id tokens", flagProviderDefaultRole, flagProviderAllowedRoles, "AUTH_PROVIDER",
+		),
+		signInMethodRoleFlags(
+			"passwordless methods and security keys",
+			flagPasswordlessDefaultRole,
+			flagPasswordlessAllowedRoles,
+			"AUTH_PASSWORDLESS",
+		),
+		signInMethodRoleFlags(
+			"email and password",
+			flagEmailPasswordDefaultRole,
+			flagEmailPasswordAllowedRoles,
+			"AUTH_EMAIL_PASSWORD",
+		),
+	)
+}
+
+// signInMethodRoles returns the roles of a sign in method. Like the global roles the
+// default role is always allowed.
+func signInMethodRoles(
+	cCtx *cli.Context, flagDefaultRole string, flagAllowedRoles string,
+) (string, []string, error) {
+	defaultRole := cCtx.String(flagDefaultRole)
+	allowedRoles := cCtx.StringSlice(flagAllowedRoles)
+	allowedRoles = slices.DeleteFunc(allowedRoles, func(s string) bool { return s == "" })
+
+	if defaultRole == "" {
+		if len(allowedRoles) > 0 {
+			return "", nil, fieldError(cCtx, flagDefaultRole, errMissingDefaultRole)
+		}
+		return "", nil, nil
+	}
+
+	if !slices.Contains(allowedRoles, defaultRole) {
+		allowedRoles = append(allowedRoles, defaultRole)
+	}
+
+	return defaultRole, allowedRoles, nil
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagSAMLEnabled              = "saml-enabled"
+	flagSAMLIDPMetadataURL       = "saml-idp-metadata-url"
+	flagSAMLIDPMetadata          = "saml-idp-metadata"
+	flagSAMLEntityID             = "saml-entity-id"
+	flagSAMLCertificate          = "saml-certificate"
+	flagSAMLPrivateKey           = "saml-private-key"
+	flagSAMLAttributeEmail       = "saml-attribute-email"
+	flagSAMLAttributeDisplayName = "saml-attribute-display-name"
+	flagSAMLAttributeRoles       = "saml-attribute-roles"
+	flagSAMLTrustEmail           = "saml-trust-email"
+)
+
+func samlFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagSAMLEnabled,
+			Usage:    "Enable sign in with a SAML 2.0 identity provider",
+			Value:    false,
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_ENABLED"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLIDPMetadataURL,
+			Usage:    "URL of the identity provider metadata",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_IDP_METADATA_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLIDPMetadata,
+			Usage:    "Identity provider metadata, takes precedence over the metadata URL",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_IDP_METADATA"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLEntityID,
+			Usage:    "Entity ID of the service provider, defaults to the URL of its metadata",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_ENTITY_ID"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLCertificate,
+			Usage:    "Certificate of the service provider in PEM format",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_CERTIFICATE"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLPrivateKey,
+			Usage:    "RSA private key of the service provider in PEM format",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_PRIVATE_KEY"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLAttributeEmail,
+			Usage:    "Assertion attribute containing the user's email",
+			Value:    "email",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_ATTRIBUTE_EMAIL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLAttributeDisplayName,
+			Usage:    "Assertion attribute containing the user's display name",
+			Value:    "displayName",
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_ATTRIBUTE_DISPLAY_NAME"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSAMLAttributeRoles,
+			Usage:    "Assertion attribute containing the roles of users signing up, roles not in default-allowed-roles are ignored", //nolint:lll
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_ATTRIBUTE_ROLES"},
+		},
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagSAMLTrustEmail,
+			Usage:    "Consider emails asserted by the identity provider verified, allowing to link them to existing users", //nolint:lll
+			Value:    false,
+			Category: "saml",
+			EnvVars:  []string{"AUTH_SAML_TRUST_EMAIL"},
+		},
+	}
+}
+
+// decodePEM allows passing PEM files in a single line by escaping the new lines.
+func decodePEM(s string) []byte {
+	return []byte(strings.ReplaceAll(s, `\n`, "\n"))
+}
+
+func getSAMLServiceProvider( //nolint:ireturn
+	cCtx *cli.Context,
+) (controller.SAMLServiceProvider, error) {
+	if !cCtx.Bool(flagSAMLEnabled) {
+		return nil, nil //nolint:nilnil
+	}
+
+	for _, flag := range []string{flagSAMLCertificate, flagSAMLPrivateKey} {
+		if cCtx.String(flag) == "" {
+			return nil, fmt.Errorf("saml is enabled but %s is missing", flag) //nolint:goerr113
+		}
+	}
+
+	serverURL, err := url.Parse(cCtx.String(flagServerURL))
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing server url: %w", err)
+	}
+	metadataURL := serverURL.JoinPath("signin", "saml", "metadata")
+
+	idpMetadata := []byte(cCtx.String(flagSAMLIDPMetadata))
+	switch {
+	case len(idpMetadata) > 0:
+	case cCtx.String(flagSAMLIDPMetadataURL) != "":
+		idpMetadata, err = providers.FetchSAMLMetadata(
+			cCtx.Context, cCtx.String(flagSAMLIDPMetadataURL),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("problem getting saml identity provider metadata: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf( //nolint:goerr113
+			"saml is enabled but neither %s nor %s are set",
+			flagSAMLIDPMetadata, flagSAMLIDPMetadataURL,
+		)
+	}
+
+	entityID := cCtx.String(flagSAMLEntityID)
+	if entityID == "" {
+		entityID = metadataURL.String()
+	}
+
+	sp, err := providers.NewSAML(
+		entityID,
+		metadataURL,
+		serverURL.JoinPath("signin", "saml", "acs"),
+		idpMetadata,
+		decodePEM(cCtx.String(flagSAMLCertificate)),
+		decodePEM(cCtx.String(flagSAMLPrivateKey)),
+		providers.SAMLAttributeMapping{
+			Email:       cCtx.String(flagSAMLAttributeEmail),
+			DisplayName: cCtx.String(flagSAMLAttributeDisplayName),
+			Roles:       cCtx.String(flagSAMLAttributeRoles),
+		},
+		cCtx.Bool(flagSAMLTrustEmail),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating saml service provider: %w", err)
+	}
+
+	return sp, nil
+}
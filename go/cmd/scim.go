@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagScimToken = "scim-token"
+)
+
+func scimFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagScimToken,
+			Usage:    "Bearer token identity providers use to authenticate to the SCIM 2.0 endpoints. The endpoints are disabled if empty",
+			Category: "scim",
+			EnvVars:  []string{"AUTH_SCIM_TOKEN"},
+		},
+	}
+}
+
+// registerScimBodyDecoder lets the request validator decode the bodies of the SCIM
+// endpoints, they are sent as application/scim+json.
+func registerScimBodyDecoder() {
+	openapi3filter.RegisterBodyDecoder("application/scim+json", openapi3filter.JSONBodyDecoder)
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/secrets"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagVaultAddr                 = "vault-addr"
+	flagVaultToken                = "vault-token"
+	flagVaultNamespace            = "vault-namespace"
+	flagSecretsAWSRegion          = "secrets-aws-region"
+	flagSecretsAWSAccessKeyID     = "secrets-aws-access-key-id"
+	flagSecretsAWSSecretAccessKey = "secrets-aws-secret-access-key"
+	flagSecretsAWSSessionToken    = "secrets-aws-session-token"
+	flagSecretsGCPAccessToken     = "secrets-gcp-access-token"
+	flagSecretsRefreshInterval    = "secrets-refresh-interval"
+)
+
+func secretsFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagVaultAddr,
+			Usage:    "Address of the HashiCorp Vault server vault: references are read from",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_VAULT_ADDR", "VAULT_ADDR"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagVaultToken,
+			Usage:    "Token used to read vault: references",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_VAULT_TOKEN", "VAULT_TOKEN"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagVaultNamespace,
+			Usage:    "Vault Enterprise namespace vault: references are read from",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_VAULT_NAMESPACE", "VAULT_NAMESPACE"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSecretsAWSRegion,
+			Usage:    "AWS region aws-secretsmanager: references are read from, unless they are ARNs",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_SECRETS_AWS_REGION", "AWS_REGION"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSecretsAWSAccessKeyID,
+			Usage:    "AWS access key ID used to read aws-secretsmanager: references",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_SECRETS_AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY_ID"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSecretsAWSSecretAccessKey,
+			Usage:    "AWS secret access key used to read aws-secretsmanager: references",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_SECRETS_AWS_SECRET_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSecretsAWSSessionToken,
+			Usage:    "AWS session token of temporary credentials used to read aws-secretsmanager: references",
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_SECRETS_AWS_SESSION_TOKEN", "AWS_SESSION_TOKEN"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSecretsGCPAccessToken,
+			Usage:    "OAuth2 access token used to read gcp-secretmanager: references. Defaults to the ones of the service account of the instance", //nolint:lll
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_SECRETS_GCP_ACCESS_TOKEN", "GOOGLE_OAUTH_ACCESS_TOKEN"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagSecretsRefreshInterval,
+			Usage:    "Interval in seconds to resolve the secret references again and reload the configuration if they changed. 0 disables it", //nolint:lll
+			Value:    0,
+			Category: "secrets",
+			EnvVars:  []string{"AUTH_SECRETS_REFRESH_INTERVAL"},
+		},
+	}
+}
+
+// envFlags returns the names of the flags of cCtx keyed by their environment variables.
+func envFlags(cCtx *cli.Context) map[string]string {
+	flags := make(map[string]string)
+	for _, f := range cCtx.Command.Flags {
+		docFlag, ok := f.(cli.DocGenerationFlag)
+		if !ok {
+			continue
+		}
+		for _, name := range docFlag.GetEnvVars() {
+			flags[name] = f.Names()[0]
+		}
+	}
+	return flags
+}
+
+// overrideContext returns a context where the flags are set to the values, keyed by flag
+// name. The other flags are looked up in cCtx.
+func overrideContext(cCtx *cli.Context, values map[string]string) (*cli.Context, error) {
+	set := flag.NewFlagSet(cCtx.Command.Name, flag.ContinueOnError)
+	for _, f := range cCtx.Command.Flags {
+		name := f.Names()[0]
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+
+		if err := f.Apply(set); err != nil {
+			return nil, fmt.Errorf("failed to apply flag %s: %w", name, err)
+		}
+		if err := set.Set(name, value); err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", name, err)
+		}
+	}
+
+	c := cli.NewContext(cCtx.App, set, cCtx)
+	c.Command = cCtx.Command
+
+	return c, nil
+}
+
+func getSecretsResolver(cCtx *cli.Context) *secrets.Resolver {
+	providers := map[string]secrets.Provider{
+		secrets.ProviderGCP: secrets.NewGCP(cCtx.String(flagSecretsGCPAccessToken)),
+	}
+	if addr := cCtx.String(flagVaultAddr); addr != "" {
+		providers[secrets.ProviderVault] = secrets.NewVault(
+			addr, cCtx.String(flagVaultToken), cCtx.String(flagVaultNamespace),
+		)
+	}
+	if accessKeyID := cCtx.String(flagSecretsAWSAccessKeyID); accessKeyID != "" {
+		providers[secrets.ProviderAWS] = secrets.NewAWS(
+			cCtx.String(flagSecretsAWSRegion),
+			accessKeyID,
+			cCtx.String(flagSecretsAWSSecretAccessKey),
+			cCtx.String(flagSecretsAWSSessionToken),
+		)
+	}
+
+	return secrets.NewResolver(providers)
+}
+
+// secretRefs returns the string flags of cCtx set to a secret reference, i.e.
+// vault:secret/data/auth#key, instead of their value.
+func secretRefs(cCtx *cli.Context) map[string]string {
+	providerFlags := make(map[string]struct{})
+	for _, f := range secretsFlags() {
+		providerFlags[f.Names()[0]] = struct{}{}
+	}
+
+	refs := make(map[string]string)
+	for _, f := range cCtx.Command.Flags {
+		if _, ok := f.(*cli.StringFlag); !ok {
+			continue
+		}
+
+		name := f.Names()[0]
+		if _, ok := providerFlags[name]; ok {
+			continue
+		}
+		if value := cCtx.String(name); secrets.IsReference(value) {
+			refs[name] = value
+		}
+	}
+
+	return refs
+}
+
+// resolveSecrets returns a context where the flags of cCtx set to a reference are set to
+// the value of the secret, along with the values. Without references cCtx is returned as
+// is.
+func resolveSecrets(
+	ctx context.Context, cCtx *cli.Context,
+) (*cli.Context, map[string]string, error) {
+	refs := secretRefs(cCtx)
+	if len(refs) == 0 {
+		return cCtx, nil, nil
+	}
+
+	values, err := getSecretsResolver(cCtx).ResolveAll(ctx, refs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("problem resolving secrets: %w", err)
+	}
+
+	c, err := overrideContext(cCtx, values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, values, nil
+}
+
+// logSecrets logs the flags whose value was resolved from a secret reference, never the
+// values.
+func logSecrets(logger *slog.Logger, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	logger.Info("secrets resolved", slog.Any("flags", names))
+}
+
+// secretsEnv replaces the environment variables set to a reference by the value of their
+// flag in cCtx.
+func secretsEnv(cCtx *cli.Context, env []string) []string {
+	flags := envFlags(cCtx)
+	for i, v := range env {
+		name, value, _ := strings.Cut(v, "=")
+		if flagName, ok := flags[name]; ok && secrets.IsReference(value) {
+			env[i] = name + "=" + cCtx.String(flagName)
+		}
+	}
+
+	return env
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/urfave/cli/v2"
+)
+
+const flagSeedFile = "seed-file"
+
+func seedFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagSeedFile,
+			Usage:    "JSON file with the roles, users and sessions to create on startup if they don't exist, for development environments and CI", //nolint:lll
+			Category: "server",
+			EnvVars:  []string{"AUTH_SEED_FILE"},
+		},
+	}
+}
+
+func readSeedFile(filename string) (controller.Seed, error) {
+	var seed controller.Seed
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return seed, fmt.Errorf("failed to read seed file: %w", err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&seed); err != nil {
+		return seed, fmt.Errorf("%w: %w", controller.ErrInvalidSeed, err)
+	}
+
+	return seed, nil
+}
+
+// applySeed creates what is missing of the seed file, if there is one.
+func applySeed(cCtx *cli.Context, ctrl *controller.Controller, logger *slog.Logger) error {
+	filename := cCtx.String(flagSeedFile)
+	if filename == "" {
+		return nil
+	}
+
+	seed, err := readSeedFile(filename)
+	if err != nil {
+		return err
+	}
+
+	logger = logger.With(slog.String("component", "seed"), slog.String("file", filename))
+	if err := ctrl.Seed(cCtx.Context, seed, logger); err != nil {
+		return fmt.Errorf("failed to apply seed: %w", err)
+	}
+
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,11 +18,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
-	"github.com/nhost/hasura-auth/go/hibp"
+	"github.com/nhost/hasura-auth/go/metrics"
 	"github.com/nhost/hasura-auth/go/middleware"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/tracing"
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -28,9 +32,12 @@ const (
 	flagPort                             = "port"
 	flagDebug                            = "debug"
 	flagLogFormatTEXT                    = "log-format-text"
+	flagLogDisableRedaction              = "log-disable-redaction"
 	flagTrustedProxies                   = "trusted-proxies"
 	flagPostgresConnection               = "postgres"
 	flagPostgresMigrationsConnection     = "postgres-migrations"
+	flagPostgresReplicaConnection        = "postgres-replica"
+	flagPostgresSchema                   = "postgres-schema"
 	flagNodeServerPath                   = "node-server-path"
 	flagDisableSignup                    = "disable-signup"
 	flagConcealErrors                    = "conceal-errors"
@@ -38,6 +45,7 @@ const (
 	flagDefaultRole                      = "default-role"
 	flagDefaultLocale                    = "default-locale"
 	flagAllowedLocales                   = "allowed-locales"
+	flagLocaleFallbacks                  = "locale-fallbacks"
 	flagDisableNewUsers                  = "disable-new-users"
 	flagGravatarEnabled                  = "gravatar-enabled"
 	flagGravatarDefault                  = "gravatar-default"
@@ -45,7 +53,9 @@ const (
 	flagRefreshTokenExpiresIn            = "refresh-token-expires-in"
 	flagAccessTokensExpiresIn            = "access-tokens-expires-in"
 	flagHasuraGraphqlJWTSecret           = "hasura-graphql-jwt-secret" //nolint:gosec
+	flagJWTRetiredSecrets                = "jwt-retired-secrets"       //nolint:gosec
 	flagEmailSigninEmailVerifiedRequired = "email-verification-required"
+	flagEmailVerificationResendCooldown  = "email-verification-resend-cooldown"
 	flagSMTPHost                         = "smtp-host"
 	flagSMTPPort                         = "smtp-port"
 	flagSMTPSecure                       = "smtp-secure"
@@ -54,27 +64,73 @@ const (
 	flagSMTPSender                       = "smtp-sender"
 	flagSMTPAPIHedaer                    = "smtp-api-header"
 	flagSMTPAuthMethod                   = "smtp-auth-method"
+	flagEmailProvider                    = "email-provider"
+	flagEmailAPIKey                      = "email-api-key" //nolint:gosec
+	flagEmailMailgunDomain               = "email-mailgun-domain"
+	flagEmailMailgunRegion               = "email-mailgun-region"
+	flagEmailSESRegion                   = "email-ses-region"
+	flagEmailSESAccessKeyID              = "email-ses-access-key-id"
+	flagEmailSESSecretAccessKey          = "email-ses-secret-access-key" //nolint:gosec
+	flagEmailMaxRetries                  = "email-max-retries"
+	flagEmailOutboxEnabled               = "email-outbox-enabled"
+	flagEmailOutboxMaxAttempts           = "email-outbox-max-attempts"
+	flagEmailOutboxInterval              = "email-outbox-interval"
 	flagClientURL                        = "client-url"
 	flagServerURL                        = "server-url"
 	flagAllowRedirectURLs                = "allow-redirect-urls"
 	flagEnableChangeEnv                  = "enable-change-env"
 	flagCustomClaims                     = "custom-claims"
+	flagMetadataClaims                   = "metadata-claims"
+	flagCustomClaimsQuery                = "custom-claims-query"
+	flagCustomClaimsQueryPaths           = "custom-claims-query-paths"
 	flagGraphqlURL                       = "graphql-url"
 	flagHasuraAdminSecret                = "hasura-admin-secret" //nolint:gosec
 	flagPasswordMinLength                = "password-min-length"
 	flagPasswordHIBPEnabled              = "password-hibp-enabled"
+	flagPasswordHIBPBloomFilter          = "password-hibp-bloom-filter"
+	flagPasswordHIBPCacheTTL             = "password-hibp-cache-ttl"
+	flagPasswordMaxLength                = "password-max-length"
+	flagPasswordMinScore                 = "password-min-score"
+	flagPasswordCharacterClasses         = "password-required-character-classes"
+	flagPasswordDenylist                 = "password-denylist"
+	flagPasswordRejectEmail              = "password-reject-email"
+	flagPasswordResetRevokeSessions      = "password-reset-revoke-sessions"
+	flagNewDeviceNotificationEnabled     = "new-device-notification-enabled"
 	flagEmailTemplatesPath               = "templates-path"
+	flagEmailTemplatesOverridesPath      = "templates-overrides-path"
+	flagEmailTemplatesFromDatabase       = "templates-from-database"
+	flagEmailTemplatesReloadInterval     = "templates-reload-interval"
 	flagBlockedEmailDomains              = "block-email-domains"
 	flagBlockedEmails                    = "block-emails"
 	flagAllowedEmailDomains              = "allowed-email-domains"
 	flagAllowedEmails                    = "allowed-emails"
 	flagEmailPasswordlessEnabled         = "email-passwordless-enabled"
+	flagAnonymousUsersEnabled            = "anonymous-users-enabled"
 	flagRequireElevatedClaim             = "require-elevated-claim"
+	flagMfaEnabled                       = "mfa-enabled"
+	flagMfaTotpIssuer                    = "mfa-totp-issuer"
 	flagWebauthnEnabled                  = "webauthn-enabled"
 	flagWebauhtnRPName                   = "webauthn-rp-name"
 	flagWebauthnRPID                     = "webauthn-rp-id"
 	flagWebauthnRPOrigins                = "webauthn-rp-origins"
 	flagWebauthnAttestationTimeout       = "webauthn-attestation-timeout"
+	flagSMSPasswordlessEnabled           = "sms-passwordless-enabled"
+	flagSMSProvider                      = "sms-provider"
+	flagSMSTestPhoneNumbers              = "sms-test-phone-numbers"
+	flagSMSTwilioAccountSid              = "sms-twilio-account-sid"
+	flagSMSTwilioAuthToken               = "sms-twilio-auth-token" //nolint:gosec
+	flagSMSTwilioMessagingServiceID      = "sms-twilio-messaging-service-id"
+	flagSMSWebhookURL                    = "sms-webhook-url"
+	flagSMSWebhookSecret                 = "sms-webhook-secret" //nolint:gosec
+	flagDeviceAuthorizationEnabled       = "device-authorization-enabled"
+	flagDeviceVerificationURL            = "device-verification-url"
+	flagDeviceCodeExpiresIn              = "device-code-expires-in"
+	flagDevicePollingInterval            = "device-polling-interval"
+	flagAccessTokenRevocationEnabled     = "access-token-revocation-enabled"
+	flagIntrospectionClientID            = "introspection-client-id"
+	flagIntrospectionClientSecret        = "introspection-client-secret" //nolint:gosec
+	flagTokenExchangeExpiresIn           = "token-exchange-expires-in"
+	flagElevatedTokenExpiresIn           = "elevated-access-token-expires-in"
 )
 
 func CommandServe() *cli.Command { //nolint:funlen,maintidx
@@ -82,7 +138,7 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 		Name:  "serve",
 		Usage: "Serve the application",
 		//nolint:lll
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagAPIPrefix,
 				Usage:    "prefix for all routes",
@@ -109,6 +165,12 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "general",
 				EnvVars:  []string{"AUTH_LOG_FORMAT_TEXT"},
 			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagLogDisableRedaction,
+				Usage:    "Log passwords, tokens and emails instead of redacting them. Only meant for debugging",
+				Category: "general",
+				EnvVars:  []string{"AUTH_LOG_DISABLE_REDACTION"},
+			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagPostgresConnection,
 				Usage:    "PostgreSQL connection URI: https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING",
@@ -122,6 +184,19 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "postgres",
 				EnvVars:  []string{"POSTGRES_MIGRATIONS_CONNECTION"},
 			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagPostgresReplicaConnection,
+				Usage:    "PostgreSQL connection URI of a read replica. If set, read heavy queries are sent to it",
+				Category: "postgres",
+				EnvVars:  []string{"POSTGRES_REPLICA_CONNECTION"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagPostgresSchema,
+				Usage:    "Schema of the auth tables. Schemas other than auth require the migrations to be applied by the Go server", //nolint:lll
+				Value:    sql.DefaultSchema,
+				Category: "postgres",
+				EnvVars:  []string{"POSTGRES_SCHEMA"},
+			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagNodeServerPath,
 				Usage:    "Path to the node server",
@@ -171,6 +246,12 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Value:    cli.NewStringSlice("en"),
 				EnvVars:  []string{"AUTH_LOCALE_ALLOWED_LOCALES"},
 			},
+			&cli.StringSliceFlag{ //nolint: exhaustruct
+				Name:     flagLocaleFallbacks,
+				Usage:    "Comma-separated list of locale fallbacks in the form locale:fallback, i.e. pt-BR:pt-PT. Locales without a fallback use their parent locale, fr-CA falls back to fr, and then the default locale",
+				Category: "signup",
+				EnvVars:  []string{"AUTH_LOCALE_FALLBACKS"},
+			},
 			&cli.BoolFlag{ //nolint: exhaustruct
 				Name:     flagDisableNewUsers,
 				Usage:    "If set, new users will be disabled after finishing registration and won't be able to sign in",
@@ -234,11 +315,17 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagHasuraGraphqlJWTSecret,
-				Usage:    "Key used for generating JWTs. Must be `HMAC-SHA`-based and the same as configured in Hasura. More info: https://hasura.io/docs/latest/graphql/core/auth/authentication/jwt.html#running-with-jwt",
+				Usage:    "Key used for generating JWTs. Must be the same as configured in Hasura. More info: https://hasura.io/docs/latest/graphql/core/auth/authentication/jwt.html#running-with-jwt",
 				Required: true,
 				Category: "jwt",
 				EnvVars:  []string{"HASURA_GRAPHQL_JWT_SECRET"},
 			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagJWTRetiredSecrets,
+				Usage:    "JSON array of keys, in the same format as HASURA_GRAPHQL_JWT_SECRET, that no longer sign JWTs but are still accepted until the tokens they signed expire",
+				Category: "jwt",
+				EnvVars:  []string{"AUTH_JWT_RETIRED_SECRETS"},
+			},
 			&cli.BoolFlag{ //nolint: exhaustruct
 				Name:     flagEmailSigninEmailVerifiedRequired,
 				Usage:    "Require email to be verified for email signin",
@@ -246,6 +333,13 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Value:    true,
 				EnvVars:  []string{"AUTH_EMAIL_SIGNIN_EMAIL_VERIFIED_REQUIRED"},
 			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagEmailVerificationResendCooldown,
+				Usage:    "Seconds to wait before sending another verification email to the same user. 0 disables it",
+				Value:    60, //nolint:mnd
+				Category: "signup",
+				EnvVars:  []string{"AUTH_EMAIL_VERIFICATION_RESEND_COOLDOWN"},
+			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagSMTPHost,
 				Usage:    "SMTP Host. If the host is 'postmark' then the Postmark API will be used. Use AUTH_SMTP_PASS as the server token, other SMTP options are ignored",
@@ -303,6 +397,92 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "smtp",
 				EnvVars:  []string{"AUTH_SMTP_AUTH_METHOD"},
 			},
+			&cli.GenericFlag{ //nolint: exhaustruct
+				Name: flagEmailProvider,
+				Value: &EnumValue{ //nolint: exhaustruct
+					Enum: []string{
+						"smtp",
+						"ses",
+						"sendgrid",
+						"mailgun",
+						"postmark",
+					},
+					Default: "smtp",
+				},
+				Usage:    "Provider used to send emails. Emails are sent from AUTH_SMTP_SENDER with every provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_PROVIDER"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagEmailAPIKey,
+				Usage:    "API key of the sendgrid and mailgun providers or server token of the postmark provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_API_KEY"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagEmailMailgunDomain,
+				Usage:    "Domain emails are sent from when using the mailgun provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_MAILGUN_DOMAIN"},
+			},
+			&cli.GenericFlag{ //nolint: exhaustruct
+				Name: flagEmailMailgunRegion,
+				Value: &EnumValue{ //nolint: exhaustruct
+					Enum: []string{
+						"us",
+						"eu",
+					},
+					Default: "us",
+				},
+				Usage:    "Region of the mailgun domain",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_MAILGUN_REGION"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagEmailSESRegion,
+				Usage:    "AWS region used by the ses provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_SES_REGION", "AWS_REGION"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagEmailSESAccessKeyID,
+				Usage:    "AWS access key ID used by the ses provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_SES_ACCESS_KEY_ID", "AWS_ACCESS_KEY_ID"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagEmailSESSecretAccessKey,
+				Usage:    "AWS secret access key used by the ses provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_SES_SECRET_ACCESS_KEY", "AWS_SECRET_ACCESS_KEY"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagEmailMaxRetries,
+				Usage:    "Number of times sending an email is retried when the provider fails with a temporary error, like a rate limit",
+				Value:    2, //nolint:mnd
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_MAX_RETRIES"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagEmailOutboxEnabled,
+				Usage:    "Store emails in the auth.email_outbox table and send them in the background so requests don't wait for the email provider",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_OUTBOX_ENABLED"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagEmailOutboxMaxAttempts,
+				Usage:    "Number of times the outbox tries to send an email failing with a temporary error before marking it as failed",
+				Value:    5, //nolint:mnd
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_OUTBOX_MAX_ATTEMPTS"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagEmailOutboxInterval,
+				Usage:    "Interval in seconds to check the outbox for emails to send",
+				Value:    5, //nolint:mnd
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_OUTBOX_INTERVAL"},
+			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagClientURL,
 				Usage:    "URL of your frontend application. Used to redirect users to the right page once actions based on emails or OAuth succeed",
@@ -333,6 +513,24 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "jwt",
 				EnvVars:  []string{"AUTH_JWT_CUSTOM_CLAIMS"},
 			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagMetadataClaims,
+				Usage:    "Custom claims taken from the user's metadata, as a JSON object mapping each claim to a path in the metadata",
+				Category: "jwt",
+				EnvVars:  []string{"AUTH_JWT_METADATA_CLAIMS"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagCustomClaimsQuery,
+				Usage:    "GraphQL query run with the admin secret to get custom claims, the user ID is passed in the $id variable",
+				Category: "jwt",
+				EnvVars:  []string{"AUTH_JWT_CUSTOM_CLAIMS_QUERY"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagCustomClaimsQueryPaths,
+				Usage:    "JSON object mapping each claim to a path in the result of the custom claims query. If empty, every field of the result is used as a claim",
+				Category: "jwt",
+				EnvVars:  []string{"AUTH_JWT_CUSTOM_CLAIMS_QUERY_PATHS"},
+			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagGraphqlURL,
 				Usage:    "Hasura GraphQL endpoint. Required for custom claims",
@@ -358,6 +556,63 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "signup",
 				EnvVars:  []string{"AUTH_PASSWORD_HIBP_ENABLED"},
 			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagPasswordHIBPBloomFilter,
+				Usage:    "Path to a bloom filter built with the hibp-bloom-filter command. Passwords are checked against it instead of the Pwned Passwords API",
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_HIBP_BLOOM_FILTER"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagPasswordHIBPCacheTTL,
+				Usage:    "Cache Pwned Passwords API responses for this number of seconds. 0 disables the cache",
+				Value:    0,
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_HIBP_CACHE_TTL"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagPasswordMaxLength,
+				Usage:    "Maximum password length. 0 means no limit",
+				Value:    0,
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_MAX_LENGTH"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagPasswordMinScore,
+				Usage:    "Minimum zxcvbn strength score of passwords, from 0 (disabled) to 4",
+				Value:    0,
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_MIN_SCORE"},
+			},
+			&cli.StringSliceFlag{ //nolint: exhaustruct
+				Name:     flagPasswordCharacterClasses,
+				Usage:    "Comma-separated list of character classes passwords must contain: lowercase, uppercase, digit, symbol", //nolint:lll
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_REQUIRED_CHARACTER_CLASSES"},
+			},
+			&cli.StringSliceFlag{ //nolint: exhaustruct
+				Name:     flagPasswordDenylist,
+				Usage:    "Comma-separated list of terms, like your company name, passwords can't contain",
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_DENYLIST"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagPasswordRejectEmail,
+				Usage:    "Reject passwords containing the email of the user",
+				Category: "signup",
+				EnvVars:  []string{"AUTH_PASSWORD_REJECT_EMAIL"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagPasswordResetRevokeSessions,
+				Usage:    "Sign out every session of the user when a password reset link is followed",
+				Category: "security",
+				EnvVars:  []string{"AUTH_PASSWORD_RESET_REVOKE_SESSIONS"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagNewDeviceNotificationEnabled,
+				Usage:    "Email users when they sign in from an IP address and user agent not seen before with a link to sign out every session", //nolint:lll
+				Category: "security",
+				EnvVars:  []string{"AUTH_NEW_DEVICE_NOTIFICATION_ENABLED"},
+			},
 			&cli.StringFlag{ //nolint: exhaustruct
 				Name:     flagEmailTemplatesPath,
 				Usage:    "Path to the email templates. Default to included ones if path isn't found",
@@ -365,27 +620,46 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "email",
 				EnvVars:  []string{"AUTH_EMAIL_TEMPLATES_PATH"},
 			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagEmailTemplatesOverridesPath,
+				Usage:    "Path to a directory with the same layout as the templates path. Templates found there override the ones in the templates path",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_TEMPLATES_OVERRIDES_PATH"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagEmailTemplatesFromDatabase,
+				Usage:    "Override templates with the ones stored in the auth.email_templates table",
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_TEMPLATES_FROM_DATABASE"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagEmailTemplatesReloadInterval,
+				Usage:    "Interval in seconds to reload the templates so changes are picked up without restarting the service. 0 disables reloading",
+				Value:    0,
+				Category: "email",
+				EnvVars:  []string{"AUTH_EMAIL_TEMPLATES_RELOAD_INTERVAL"},
+			},
 			&cli.StringSliceFlag{ //nolint: exhaustruct
 				Name:     flagBlockedEmailDomains,
-				Usage:    "Comma-separated list of email domains that cannot register",
+				Usage:    "Comma-separated list of email domains that cannot register. Accepts wildcards and /regexps/",
 				Category: "signup",
 				EnvVars:  []string{"AUTH_ACCESS_CONTROL_BLOCKED_EMAIL_DOMAINS"},
 			},
 			&cli.StringSliceFlag{ //nolint: exhaustruct
 				Name:     flagBlockedEmails,
-				Usage:    "Comma-separated list of email domains that cannot register",
+				Usage:    "Comma-separated list of emails that cannot register. Accepts wildcards and /regexps/",
 				Category: "signup",
 				EnvVars:  []string{"AUTH_ACCESS_CONTROL_BLOCKED_EMAILS"},
 			},
 			&cli.StringSliceFlag{ //nolint: exhaustruct
 				Name:     flagAllowedEmailDomains,
-				Usage:    "Comma-separated list of email domains that can register",
+				Usage:    "Comma-separated list of email domains that can register. Accepts wildcards and /regexps/",
 				Category: "signup",
 				EnvVars:  []string{"AUTH_ACCESS_CONTROL_ALLOWED_EMAIL_DOMAINS"},
 			},
 			&cli.StringSliceFlag{ //nolint: exhaustruct
 				Name:     flagAllowedEmails,
-				Usage:    "Comma-separated list of emails that can register",
+				Usage:    "Comma-separated list of emails that can register. Accepts wildcards and /regexps/",
 				Category: "signup",
 				EnvVars:  []string{"AUTH_ACCESS_CONTROL_ALLOWED_EMAILS"},
 			},
@@ -396,6 +670,13 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "signin",
 				EnvVars:  []string{"AUTH_EMAIL_PASSWORDLESS_ENABLED"},
 			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagAnonymousUsersEnabled,
+				Usage:    "Enables users to register as an anonymous user",
+				Value:    false,
+				Category: "signin",
+				EnvVars:  []string{"AUTH_ANONYMOUS_USERS_ENABLED"},
+			},
 			&cli.GenericFlag{ //nolint: exhaustruct
 				Name: flagRequireElevatedClaim,
 				Value: &EnumValue{ //nolint: exhaustruct
@@ -410,6 +691,34 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "security",
 				EnvVars:  []string{"AUTH_REQUIRE_ELEVATED_CLAIM"},
 			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagElevatedTokenExpiresIn,
+				Usage:    "Number of seconds access tokens carrying the x-hasura-auth-elevated claim are valid for",
+				Value:    300, //nolint:mnd
+				Category: "security",
+				EnvVars:  []string{"AUTH_ELEVATED_ACCESS_TOKEN_EXPIRES_IN"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagAccessTokenRevocationEnabled,
+				Usage:    "Reject access tokens issued before the user's sessions were revoked. Requires a database query for every request authenticated with an access token",
+				Value:    false,
+				Category: "security",
+				EnvVars:  []string{"AUTH_ACCESS_TOKEN_REVOCATION_ENABLED"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagMfaEnabled,
+				Usage:    "Enables users to use Multi Factor Authentication",
+				Value:    false,
+				Category: "mfa",
+				EnvVars:  []string{"AUTH_MFA_ENABLED"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagMfaTotpIssuer,
+				Usage:    "The name of the One Time Password (OTP) issuer. Probably your app's name",
+				Value:    "hasura-auth",
+				Category: "mfa",
+				EnvVars:  []string{"AUTH_MFA_TOTP_ISSUER"},
+			},
 			&cli.BoolFlag{ //nolint: exhaustruct
 				Name:     flagWebauthnEnabled,
 				Usage:    "When enabled, passwordless Webauthn authentication can be done via device supported strong authenticators like fingerprint, Face ID, etc.",
@@ -442,13 +751,154 @@ func CommandServe() *cli.Command { //nolint:funlen,maintidx
 				Category: "webauthn",
 				EnvVars:  []string{"AUTH_WEBAUTHN_ATTESTATION_TIMEOUT"},
 			},
-		},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagSMSPasswordlessEnabled,
+				Usage:    "Enables passwordless authentication by SMS. An SMS provider must be configured",
+				Value:    false,
+				Category: "signin",
+				EnvVars:  []string{"AUTH_SMS_PASSWORDLESS_ENABLED"},
+			},
+			&cli.GenericFlag{ //nolint: exhaustruct
+				Name: flagSMSProvider,
+				Value: &EnumValue{ //nolint: exhaustruct
+					Enum: []string{
+						"twilio",
+						"webhook",
+					},
+					Default: "twilio",
+				},
+				Usage:    "SMS provider used to send one time passwords",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_PROVIDER"},
+			},
+			&cli.StringSliceFlag{ //nolint: exhaustruct
+				Name:     flagSMSTestPhoneNumbers,
+				Usage:    "Comma separated list of test phone numbers which can be used without any provider set. The verification code can be found in the logs upon sign in",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_TEST_PHONE_NUMBERS"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagSMSTwilioAccountSid,
+				Usage:    "Twilio account SID",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_TWILIO_ACCOUNT_SID"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagSMSTwilioAuthToken,
+				Usage:    "Twilio auth token",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_TWILIO_AUTH_TOKEN"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagSMSTwilioMessagingServiceID,
+				Usage:    "Twilio messaging service ID",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_TWILIO_MESSAGING_SERVICE_ID"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagSMSWebhookURL,
+				Usage:    "URL the SMS messages are POSTed to when the webhook provider is used",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_WEBHOOK_URL"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagSMSWebhookSecret,
+				Usage:    "Secret sent in the X-Webhook-Secret header when the webhook provider is used",
+				Category: "sms",
+				EnvVars:  []string{"AUTH_SMS_WEBHOOK_SECRET"},
+			},
+			&cli.BoolFlag{ //nolint: exhaustruct
+				Name:     flagDeviceAuthorizationEnabled,
+				Usage:    "Enables the device authorization grant for devices without a browser, like CLIs or TVs",
+				Value:    false,
+				Category: "device",
+				EnvVars:  []string{"AUTH_DEVICE_AUTHORIZATION_ENABLED"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagDeviceVerificationURL,
+				Usage:    "URL of the page where users enter the user code to approve a device, defaults to {{AUTH_CLIENT_URL}}/device",
+				Category: "device",
+				EnvVars:  []string{"AUTH_DEVICE_VERIFICATION_URL"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagDeviceCodeExpiresIn,
+				Usage:    "Expiration time of device and user codes in seconds",
+				Value:    900, //nolint:mnd
+				Category: "device",
+				EnvVars:  []string{"AUTH_DEVICE_CODE_EXPIRES_IN"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagDevicePollingInterval,
+				Usage:    "Minimum number of seconds devices must wait between polling requests",
+				Value:    5, //nolint:mnd
+				Category: "device",
+				EnvVars:  []string{"AUTH_DEVICE_POLLING_INTERVAL"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagIntrospectionClientID,
+				Usage:    "Client ID used to authenticate to the token introspection endpoint",
+				Category: "introspection",
+				EnvVars:  []string{"AUTH_INTROSPECTION_CLIENT_ID"},
+			},
+			&cli.StringFlag{ //nolint: exhaustruct
+				Name:     flagIntrospectionClientSecret,
+				Usage:    "Client secret used to authenticate to the token introspection endpoint. The endpoint is disabled if empty",
+				Category: "introspection",
+				EnvVars:  []string{"AUTH_INTROSPECTION_CLIENT_SECRET"},
+			},
+			&cli.IntFlag{ //nolint: exhaustruct
+				Name:     flagTokenExchangeExpiresIn,
+				Usage:    "Maximum number of seconds access tokens obtained with the token exchange grant are valid for",
+				Value:    300, //nolint:mnd
+				Category: "oauth2",
+				EnvVars:  []string{"AUTH_TOKEN_EXCHANGE_EXPIRES_IN"},
+			},
+		}, slices.Concat(
+			providerFlags(),
+			samlFlags(),
+			rateLimitFlags(),
+			lockoutFlags(),
+			captchaFlags(),
+			ipFilterFlags(),
+			auditFlags(),
+			dataExportFlags(),
+			accountDeletionFlags(),
+			webhookFlags(),
+			eventBusFlags(),
+			metricsFlags(),
+			tracingFlags(),
+			grpcFlags(),
+			scimFlags(),
+			oidcProviderFlags(),
+			forwardAuthFlags(),
+			tenantFlags(),
+			organizationFlags(),
+			userMetadataFlags(),
+			roleFlags(),
+			disposableEmailFlags(),
+			emailMXFlags(),
+			avatarFlags(),
+			dbPoolFlags(),
+			migrationFlags(),
+			changeNotificationFlags(),
+			roleCacheFlags(),
+			sessionStoreFlags(),
+			passwordHashFlags(),
+			tokenLifetimesFlags(),
+			sessionLimitFlags(),
+			geoIPFlags(),
+			riskFlags(),
+			secretsFlags(),
+			reloadFlags(),
+			checkConfigFlags(),
+			seedFlags(),
+		)...),
 		Action: serve,
 	}
 }
 
-func getNodeServer(cCtx *cli.Context) *exec.Cmd {
-	env := os.Environ()
+func getNodeServer(cCtx *cli.Context, config *serveConfig) *exec.Cmd {
+	env := append(os.Environ(), config.env()...)
 	found := false
 	authPort := strconv.Itoa(cCtx.Int(flagPort) + 1)
 	for i, v := range env {
@@ -460,6 +910,7 @@ func getNodeServer(cCtx *cli.Context) *exec.Cmd {
 	if !found {
 		env = append(env, "AUTH_PORT="+authPort)
 	}
+	env = secretsEnv(cCtx, env)
 	env = append(env, "NODE_EXTRA_CA_CERTS=/etc/ssl/certs/ca-bundle.crt")
 	env = append(env, "PWD="+cCtx.String(flagNodeServerPath))
 	env = append(env, "AUTH_VERSION="+cCtx.App.Version)
@@ -468,6 +919,10 @@ func getNodeServer(cCtx *cli.Context) *exec.Cmd {
 		env = append(env, "NODE_ENV=development")
 	}
 
+	if cCtx.Bool(flagApplyMigrations) {
+		env = append(env, "AUTH_SKIP_MIGRATIONS=true")
+	}
+
 	if cCtx.String(flagPostgresMigrationsConnection) != "" {
 		for i, v := range env {
 			if strings.HasPrefix(v, "HASURA_GRAPHQL_DATABASE_URL=") {
@@ -486,56 +941,180 @@ func getNodeServer(cCtx *cli.Context) *exec.Cmd {
 	return cmd
 }
 
-func getGoServer( //nolint:funlen
-	cCtx *cli.Context, db *sql.Queries, logger *slog.Logger,
-) (*http.Server, error) {
+// getRouter returns the router serving the API with the configuration of cCtx. reloader
+// serves the /admin/config/reload endpoint, it is disabled if nil.
+func getRouter( //nolint:funlen,cyclop
+	cCtx *cli.Context, db *sql.Queries, reloader controller.ConfigReloader, logger *slog.Logger,
+) (*gin.Engine, *controller.Controller, error) {
 	router := gin.New()
+	if err := middleware.SetTrustedProxies(router, cCtx.StringSlice(flagTrustedProxies)); err != nil {
+		return nil, nil, fmt.Errorf("problem setting trusted proxies: %w", err)
+	}
+
+	ipFilterRules, err := getIPFilterRules(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	loader := openapi3.NewLoader()
 	doc, err := loader.LoadFromData(api.OpenAPISchema)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load OpenAPI schema: %w", err)
+		return nil, nil, fmt.Errorf("failed to load OpenAPI schema: %w", err)
 	}
 	doc.AddServer(&openapi3.Server{ //nolint:exhaustruct
 		URL: cCtx.String(flagAPIPrefix),
 	})
 
+	if tracingEnabled(cCtx) {
+		router.ContextWithFallback = true
+		router.Use(tracing.Middleware())
+	}
+
 	router.Use(
 		// ginmiddleware.OapiRequestValidator(doc),
 		gin.Recovery(),
 		cors(),
 		middleware.Logger(logger),
+		middleware.Client(),
+		middleware.IPFilter(cCtx.String(flagAPIPrefix), ipFilterRules),
 	)
 
-	emailer, err := getEmailer(cCtx, logger)
+	listener := getChangeListener(cCtx, logger)
+
+	emailer, err := getEmailer(cCtx, db, listener, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("problem creating emailer: %w", err)
+	}
+	if metricsEnabled(cCtx) {
+		emailer = metrics.NewEmailer(emailer)
+	}
+
+	smsSender, err := getSMSSender(cCtx, db, listener, logger)
 	if err != nil {
-		return nil, fmt.Errorf("problem creating emailer: %w", err)
+		return nil, nil, fmt.Errorf("problem creating sms sender: %w", err)
 	}
 
 	config, err := getConfig(cCtx)
 	if err != nil {
-		return nil, fmt.Errorf("problem creating config: %w", err)
+		return nil, nil, fmt.Errorf("problem creating config: %w", err)
 	}
 
 	jwtGetter, err := getJWTGetter(cCtx, db)
 	if err != nil {
-		return nil, fmt.Errorf("problem creating jwt getter: %w", err)
+		return nil, nil, fmt.Errorf("problem creating jwt getter: %w", err)
+	}
+
+	oauthProviders, err := getOAuthProviders(cCtx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("problem creating oauth providers: %w", err)
+	}
+
+	samlServiceProvider, err := getSAMLServiceProvider(cCtx)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	ctrl, err := controller.New(db, config, jwtGetter, emailer, hibp.NewClient(), cCtx.App.Version)
+	hibpClient, err := getHIBPClient(cCtx, logger)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create controller: %w", err)
+		return nil, nil, err
 	}
-	handler := api.NewStrictHandler(ctrl, []api.StrictMiddlewareFunc{})
+	if metricsEnabled(cCtx) {
+		hibpClient = metrics.NewHIBPClient(hibpClient)
+	}
+	if tracingEnabled(cCtx) {
+		hibpClient = tracing.NewHIBPClient(hibpClient)
+	}
+
+	rateLimiter, err := getRateLimiter(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	captchaVerifier, err := getCaptchaVerifier(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhookSender, err := getWebhookSender(cCtx, db, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	preSignUpHook, err := getPreSignUpHook(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatarStorage, err := getAvatarStorage(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sessionDB, sessionStore, err := getSessionStore(cCtx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	geoIP, err := getGeoIP(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	riskEngine, err := getRiskEngine(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctrl, err := controller.New(config, controller.Dependencies{
+		DB:               getRoleCachedDB(cCtx, sessionDB, listener, logger),
+		JWTGetter:        jwtGetter,
+		Emailer:          emailer,
+		SMS:              smsSender,
+		HIBP:             hibpClient,
+		OAuthProviders:   oauthProviders,
+		IDTokenProviders: getIDTokenProviders(cCtx),
+		SAML:             samlServiceProvider,
+		RateLimiter:      rateLimiter,
+		Captcha:          captchaVerifier,
+		Webhooks:         webhookSender,
+		PreSignUpHook:    preSignUpHook,
+		DisposableEmails: getDisposableEmails(cCtx, logger),
+		AvatarStorage:    avatarStorage,
+		GeoIP:            geoIP,
+		RiskEngine:       riskEngine,
+		ConfigReloader:   reloader,
+		SignInMethods:    getSignInMethodFlags(db, listener),
+		Version:          cCtx.App.Version,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	handler := api.NewStrictHandler(ctrl, []api.StrictMiddlewareFunc{
+		ctrl.Audit,
+		ctrl.Metrics,
+		ctrl.RequiresElevation,
+		ctrl.Captcha,
+		ctrl.RateLimit,
+	})
+	registerScimBodyDecoder()
+	registerAvatarBodyDecoders()
 	mw := api.MiddlewareFunc(ginmiddleware.OapiRequestValidatorWithOptions(
 		doc,
 		&ginmiddleware.Options{ //nolint:exhaustruct
 			Options: openapi3filter.Options{ //nolint:exhaustruct
-				AuthenticationFunc: jwtGetter.MiddlewareFunc,
+				AuthenticationFunc: ctrl.AuthenticationFunc,
 			},
 			SilenceServersWarning: true,
 		},
 	))
+
+	nodejsHandler, err := nodejsHandler()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create nodejs handler: %w", err)
+	}
+
+	router.Use(nodejsProviderFallback(oauthProviders, nodejsHandler))
 	api.RegisterHandlersWithOptions(
 		router,
 		handler,
@@ -546,34 +1125,98 @@ func getGoServer( //nolint:funlen
 		},
 	)
 
-	nodejsHandler, err := nodejsHandler()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create nodejs handler: %w", err)
-	}
 	router.NoRoute(nodejsHandler)
 
+	if sessionStore != nil {
+		router.POST(
+			cCtx.String(flagAPIPrefix)+"/signout",
+			sessionStoreSignout(sessionStore, jwtGetter, nodejsHandler),
+		)
+	}
+
 	if cCtx.Bool(flagEnableChangeEnv) {
 		router.POST(cCtx.String(flagAPIPrefix)+"/change-env", ctrl.PostChangeEnv(nodejsHandler))
 	}
 
+	return router, ctrl, nil
+}
+
+func getGoServer(
+	cCtx *cli.Context, db *sql.Queries, config *serveConfig, logger *slog.Logger,
+) (*http.Server, *grpc.Server, error) {
+	var (
+		router http.Handler
+		ctrl   *controller.Controller
+		err    error
+	)
+	if config.reloadsConfig(cCtx) {
+		router, ctrl, err = startConfigReloader(cCtx, config, db, logger)
+	} else {
+		router, ctrl, err = getRouter(cCtx, db, nil, logger)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := applySeed(cCtx, ctrl, logger); err != nil {
+		return nil, nil, err
+	}
+
+	startAuditLogPruner(cCtx, db, logger)
+	if err := startDataExporter(cCtx, db, logger); err != nil {
+		return nil, nil, err
+	}
+	if err := startAccountDeleter(cCtx, db, logger); err != nil {
+		return nil, nil, err
+	}
+
+	handler, closeTenants, err := getTenantsHandler(cCtx, router, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	server := &http.Server{ //nolint:exhaustruct
 		Addr:              ":" + cCtx.String(flagPort),
-		Handler:           router,
+		Handler:           handler,
 		ReadHeaderTimeout: 5 * time.Second, //nolint:mnd
 	}
+	server.RegisterOnShutdown(closeTenants)
 
-	return server, nil
+	return server, getGRPCServer(cCtx, ctrl, logger), nil
 }
 
 func serve(cCtx *cli.Context) error {
-	logger := getLogger(cCtx.Bool(flagDebug), cCtx.Bool(flagLogFormatTEXT))
+	logger := getLogger(
+		cCtx.Bool(flagDebug),
+		cCtx.Bool(flagLogFormatTEXT),
+		!cCtx.Bool(flagLogDisableRedaction),
+	)
 	logger.Info(cCtx.App.Name + " v" + cCtx.App.Version)
 	logFlags(logger, cCtx)
 
+	config, err := newServeConfig(cCtx)
+	if err != nil {
+		return err
+	}
+	cCtx, config.secrets, err = config.load(cCtx.Context, config.file)
+	if err != nil {
+		return err
+	}
+	logSecrets(logger, config.secrets)
+
+	if cCtx.Bool(flagCheckConfig) {
+		return checkConfig(cCtx, logger)
+	}
+
 	ctx, cancel := context.WithCancel(cCtx.Context)
 	defer cancel()
 
-	nodeServer := getNodeServer(cCtx)
+	// before the node server starts so it doesn't apply them at the same time
+	if err := checkMigrations(cCtx, logger); err != nil {
+		return err
+	}
+
+	nodeServer := getNodeServer(cCtx, config)
 	go func() {
 		defer cancel()
 		if err := nodeServer.Run(); err != nil {
@@ -581,13 +1224,23 @@ func serve(cCtx *cli.Context) error {
 		}
 	}()
 
-	pool, err := getDBPool(cCtx)
+	shutdownTracing, err := setupTracing(cCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.WithoutCancel(ctx)); err != nil {
+			logger.Error("failed to flush traces", slog.String("error", err.Error()))
+		}
+	}()
+
+	db, closeDB, err := getDB(cCtx, logger)
 	if err != nil {
-		return fmt.Errorf("failed to create database pool: %w", err)
+		return err
 	}
-	defer pool.Close()
+	defer closeDB()
 
-	server, err := getGoServer(cCtx, sql.New(pool), logger)
+	server, grpcServer, err := getGoServer(cCtx, sql.New(db), config, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
@@ -599,6 +1252,31 @@ func serve(cCtx *cli.Context) error {
 		}
 	}()
 
+	if metricsServer := getMetricsServer(cCtx); metricsServer != nil {
+		go func() {
+			defer cancel()
+			if err := metricsServer.ListenAndServe(); err != nil {
+				logger.Error("metrics server failed", slog.String("error", err.Error()))
+			}
+		}()
+		defer metricsServer.Close()
+	}
+
+	if grpcServer != nil {
+		listener, err := net.Listen("tcp", ":"+cCtx.String(flagGRPCPort))
+		if err != nil {
+			return fmt.Errorf("failed to listen for grpc: %w", err)
+		}
+
+		go func() {
+			defer cancel()
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Error("grpc server failed", slog.String("error", err.Error()))
+			}
+		}()
+		defer grpcServer.GracefulStop()
+	}
+
 	<-ctx.Done()
 
 	logger.Info("shutting down server")
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagSessionLimit       = "session-limit"
+	flagSessionLimitPolicy = "session-limit-policy"
+)
+
+func sessionLimitFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagSessionLimit,
+			Usage:    "Maximum number of active sessions per user. 0 for no limit",
+			Value:    0,
+			Category: "signin",
+			EnvVars:  []string{"AUTH_SESSION_LIMIT"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagSessionLimitPolicy,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					controller.SessionLimitPolicyEvictOldest,
+					controller.SessionLimitPolicyReject,
+				},
+				Default: controller.SessionLimitPolicyEvictOldest,
+			},
+			Usage:    "What happens when users with session-limit active sessions sign in again: their oldest sessions are signed out or the sign in is rejected", //nolint:lll
+			Category: "signin",
+			EnvVars:  []string{"AUTH_SESSION_LIMIT_POLICY"},
+		},
+	}
+}
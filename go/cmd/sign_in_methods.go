@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/changes"
+	"github.com/nhost/hasura-auth/go/controller"
+)
+
+// signInMethodFlagsTTL is how long the sign in methods enabled or disabled at runtime are
+// cached for. Instances see the changes made by other instances right away only if change
+// notifications are enabled.
+const signInMethodFlagsTTL = 10 * time.Second
+
+// getSignInMethodFlags returns the flags of the sign in methods, dropped as the listener,
+// if any, notifies their changes.
+func getSignInMethodFlags(
+	db controller.DBClientSignInMethods, listener *changes.Listener,
+) *controller.SignInMethodFlags {
+	flags := controller.NewSignInMethodFlags(db, signInMethodFlagsTTL)
+
+	if listener != nil {
+		listener.Subscribe("sign_in_methods", func(_ context.Context, _ changes.Change) {
+			flags.Invalidate()
+		})
+	}
+
+	return flags
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/changes"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/smswebhook"
+	"github.com/nhost/hasura-auth/go/notifications/twilio"
+	"github.com/urfave/cli/v2"
+)
+
+func getSMSSender( //nolint:ireturn
+	cCtx *cli.Context,
+	db notifications.TemplatesDB,
+	listener *changes.Listener,
+	logger *slog.Logger,
+) (controller.SMSSender, error) {
+	if !cCtx.Bool(flagSMSPasswordlessEnabled) {
+		return nil, nil //nolint:nilnil
+	}
+
+	var provider notifications.SMSProvider
+	switch GetEnumValue(cCtx, flagSMSProvider) {
+	case "twilio":
+		provider = twilio.New(
+			cCtx.String(flagSMSTwilioAccountSid),
+			cCtx.String(flagSMSTwilioAuthToken),
+			cCtx.String(flagSMSTwilioMessagingServiceID),
+		)
+	case "webhook":
+		if cCtx.String(flagSMSWebhookURL) == "" {
+			return nil, errors.New("sms webhook url is required") //nolint:goerr113
+		}
+		provider = smswebhook.New(
+			cCtx.String(flagSMSWebhookURL),
+			cCtx.String(flagSMSWebhookSecret),
+		)
+	default:
+		return nil, errors.New("unsupported sms provider") //nolint:goerr113
+	}
+
+	templates, err := getTemplates(
+		cCtx, db, listener, logger.With(slog.String("component", "sms")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications.NewSMS(provider, templates), nil
+}
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagTenantID     = "tenant-id"
+	flagTenantsFile  = "tenants-file"
+	flagTenantHeader = "tenant-header"
+)
+
+var errInvalidTenants = errors.New("invalid tenants file")
+
+// tenantEnvPrefixes are the environment variables a tenant can override. Everything else
+// is shared by all the tenants.
+var tenantEnvPrefixes = []string{ //nolint:gochecknoglobals
+	"HASURA_GRAPHQL_JWT_SECRET",
+	"AUTH_JWT_RETIRED_SECRETS",
+	"AUTH_SMTP_",
+	"AUTH_PROVIDER_",
+	"AUTH_CLIENT_URL",
+	"AUTH_SERVER_URL",
+	"AUTH_ACCESS_CONTROL_ALLOWED_REDIRECT_URLS",
+	"AUTH_AVATAR_STORAGE",
+}
+
+func tenantFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagTenantID,
+			Usage:    "Tenant the users and tokens of this deployment belong to",
+			Category: "tenants",
+			EnvVars:  []string{"AUTH_TENANT_ID"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagTenantsFile,
+			Usage:    "JSON file with the tenants served by this deployment and their configuration",
+			Category: "tenants",
+			EnvVars:  []string{"AUTH_TENANTS_FILE"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagTenantHeader,
+			Usage:    "Header selecting the tenant of a request by id. If not set tenants are selected by hostname only",
+			Category: "tenants",
+			EnvVars:  []string{"AUTH_TENANT_HEADER"},
+		},
+	}
+}
+
+type tenant struct {
+	ID    string            `json:"id"`
+	Hosts []string          `json:"hosts"`
+	Env   map[string]string `json:"env"`
+}
+
+func tenantEnvAllowed(name string) bool {
+	for _, prefix := range tenantEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func getTenants(cCtx *cli.Context) ([]tenant, error) {
+	filename := cCtx.String(flagTenantsFile)
+	if filename == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tenants file: %w", err)
+	}
+
+	var tenants []tenant
+	if err := json.Unmarshal(b, &tenants); err != nil {
+		return nil, fmt.Errorf("failed to parse tenants file: %w", err)
+	}
+
+	ids := make(map[string]struct{}, len(tenants))
+	hosts := make(map[string]struct{})
+	for _, t := range tenants {
+		if t.ID == "" {
+			return nil, fmt.Errorf("%w: tenants need an id", errInvalidTenants)
+		}
+		if _, ok := ids[t.ID]; ok || t.ID == cCtx.String(flagTenantID) {
+			return nil, fmt.Errorf("%w: duplicated tenant %s", errInvalidTenants, t.ID)
+		}
+		ids[t.ID] = struct{}{}
+
+		for _, host := range t.Hosts {
+			host = strings.ToLower(host)
+			if _, ok := hosts[host]; ok {
+				return nil, fmt.Errorf("%w: duplicated host %s", errInvalidTenants, host)
+			}
+			hosts[host] = struct{}{}
+		}
+
+		for name := range t.Env {
+			if !tenantEnvAllowed(name) {
+				return nil, fmt.Errorf(
+					"%w: %s can't be set per tenant", errInvalidTenants, name,
+				)
+			}
+		}
+	}
+
+	return tenants, nil
+}
+
+// tenantContext returns a context where the flags set by the environment of the tenant
+// are overridden. The other flags are looked up in cCtx.
+func tenantContext(cCtx *cli.Context, t tenant) (*cli.Context, error) {
+	env := make(map[string]string, len(t.Env)+1)
+	for name, value := range t.Env {
+		env[name] = value
+	}
+	env["AUTH_TENANT_ID"] = t.ID
+
+	set := flag.NewFlagSet(t.ID, flag.ContinueOnError)
+	for _, f := range cCtx.Command.Flags {
+		docFlag, ok := f.(cli.DocGenerationFlag)
+		if !ok {
+			continue
+		}
+
+		for _, name := range docFlag.GetEnvVars() {
+			value, ok := env[name]
+			if !ok {
+				continue
+			}
+
+			if err := f.Apply(set); err != nil {
+				return nil, fmt.Errorf("failed to apply flag %s: %w", f.Names()[0], err)
+			}
+			if err := set.Set(f.Names()[0], value); err != nil {
+				return nil, fmt.Errorf("%w: invalid value for %s: %w", errInvalidTenants, name, err)
+			}
+			break
+		}
+	}
+
+	return cli.NewContext(cCtx.App, set, cCtx), nil
+}
+
+// tenantRouter sends the requests to the router of their tenant. The tenant is selected
+// by the tenant header, if configured, or by hostname. Requests that don't match any
+// tenant are served by the default router.
+type tenantRouter struct {
+	header        string
+	byID          map[string]http.Handler
+	byHost        map[string]http.Handler
+	defaultRouter http.Handler
+}
+
+func requestHost(r *http.Request) string {
+	host := r.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+func (tr *tenantRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if tr.header != "" {
+		if id := r.Header.Get(tr.header); id != "" {
+			router, ok := tr.byID[id]
+			if !ok {
+				http.Error(w, "tenant not found", http.StatusNotFound)
+				return
+			}
+			router.ServeHTTP(w, r)
+			return
+		}
+	}
+
+	if router, ok := tr.byHost[requestHost(r)]; ok {
+		router.ServeHTTP(w, r)
+		return
+	}
+
+	tr.defaultRouter.ServeHTTP(w, r)
+}
+
+// getTenantsHandler returns the handler serving the tenants of the tenants file along
+// with a function closing their database pools. If there are no tenants the default
+// router is returned as is.
+func getTenantsHandler(
+	cCtx *cli.Context, defaultRouter http.Handler, logger *slog.Logger,
+) (http.Handler, func(), error) {
+	tenants, err := getTenants(cCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closers := make([]func(), 0, len(tenants))
+	closeTenants := func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+
+	if len(tenants) == 0 {
+		return defaultRouter, closeTenants, nil
+	}
+
+	tr := &tenantRouter{
+		header:        cCtx.String(flagTenantHeader),
+		byID:          make(map[string]http.Handler, len(tenants)),
+		byHost:        make(map[string]http.Handler),
+		defaultRouter: defaultRouter,
+	}
+
+	for _, t := range tenants {
+		tenantCtx, err := tenantContext(cCtx, t)
+		if err != nil {
+			closeTenants()
+			return nil, nil, err
+		}
+
+		db, closeDB, err := getDB(tenantCtx, logger)
+		if err != nil {
+			closeTenants()
+			return nil, nil, fmt.Errorf("tenant %s: %w", t.ID, err)
+		}
+		closers = append(closers, closeDB)
+
+		router, _, err := getRouter(
+			tenantCtx, sql.New(db), nil, logger.With(slog.String("tenant_id", t.ID)),
+		)
+		if err != nil {
+			closeTenants()
+			return nil, nil, fmt.Errorf("failed to create router for tenant %s: %w", t.ID, err)
+		}
+
+		tr.byID[t.ID] = router
+		for _, host := range t.Hosts {
+			tr.byHost[strings.ToLower(host)] = router
+		}
+
+		logger.Info("serving tenant", slog.String("tenant_id", t.ID))
+	}
+
+	return tr, closeTenants, nil
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagClientTokenLifetimes    = "client-token-lifetimes"
+	flagRefreshTokenExpiration  = "refresh-token-expiration"
+	flagRefreshTokenMaxLifetime = "refresh-token-max-lifetime"
+)
+
+func tokenLifetimesFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagClientTokenLifetimes,
+			Usage:    "Comma-separated list of client:refreshSeconds:accessSeconds giving the sessions of the clients sending them in the X-Hasura-Auth-Client header their own token lifetimes", //nolint:lll
+			Category: "jwt",
+			EnvVars:  []string{"AUTH_CLIENT_TOKEN_LIFETIMES"},
+		},
+		&cli.GenericFlag{ //nolint: exhaustruct
+			Name: flagRefreshTokenExpiration,
+			Value: &EnumValue{ //nolint: exhaustruct
+				Enum: []string{
+					controller.RefreshTokenExpirationSliding,
+					controller.RefreshTokenExpirationFixed,
+				},
+				Default: controller.RefreshTokenExpirationSliding,
+			},
+			Usage:    "With sliding, sessions are extended every time they are refreshed. With fixed, they expire a refresh token lifetime after signing in", //nolint:lll
+			Category: "jwt",
+			EnvVars:  []string{"AUTH_REFRESH_TOKEN_EXPIRATION"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagRefreshTokenMaxLifetime,
+			Usage:    "Maximum number of seconds sliding sessions can be extended to after signing in. 0 for no maximum",
+			Value:    0,
+			Category: "jwt",
+			EnvVars:  []string{"AUTH_REFRESH_TOKEN_MAX_LIFETIME"},
+		},
+	}
+}
+
+var errInvalidClientTokenLifetimes = errors.New("invalid client token lifetimes")
+
+func parseTokenLifetime(client, value string) (int, error) {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0, fmt.Errorf(
+			"%w: the lifetimes of %s must be a positive number of seconds",
+			errInvalidClientTokenLifetimes, client,
+		)
+	}
+	return seconds, nil
+}
+
+// getClientTokenLifetimes returns the token lifetimes of each client.
+func getClientTokenLifetimes(cCtx *cli.Context) (map[string]controller.TokenLifetimes, error) {
+	entries := cCtx.StringSlice(flagClientTokenLifetimes)
+
+	lifetimes := make(map[string]controller.TokenLifetimes, len(entries))
+	for i, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" { //nolint:mnd
+			return nil, elementError(cCtx, flagClientTokenLifetimes, i, fmt.Errorf(
+				"%w: lifetimes must be client:refreshSeconds:accessSeconds",
+				errInvalidClientTokenLifetimes,
+			))
+		}
+
+		client := parts[0]
+		if _, ok := lifetimes[client]; ok {
+			return nil, elementError(cCtx, flagClientTokenLifetimes, i, fmt.Errorf(
+				"%w: duplicated client %s", errInvalidClientTokenLifetimes, client,
+			))
+		}
+
+		refreshTokenExpiresIn, err := parseTokenLifetime(client, parts[1])
+		if err != nil {
+			return nil, elementError(cCtx, flagClientTokenLifetimes, i, err)
+		}
+		accessTokenExpiresIn, err := parseTokenLifetime(client, parts[2])
+		if err != nil {
+			return nil, elementError(cCtx, flagClientTokenLifetimes, i, err)
+		}
+
+		lifetimes[client] = controller.TokenLifetimes{
+			RefreshTokenExpiresIn: refreshTokenExpiresIn,
+			AccessTokenExpiresIn:  accessTokenExpiresIn,
+		}
+	}
+
+	return lifetimes, nil
+}
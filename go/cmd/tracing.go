@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/tracing"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagTracingEnabled     = "tracing-enabled"
+	flagTracingEndpoint    = "tracing-endpoint"
+	flagTracingSampleRatio = "tracing-sample-ratio"
+)
+
+func tracingFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagTracingEnabled,
+			Usage:    "Trace the requests with OpenTelemetry and export the spans with OTLP",
+			Value:    false,
+			Category: "tracing",
+			EnvVars:  []string{"AUTH_TRACING_ENABLED"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagTracingEndpoint,
+			Usage:    "OTLP/HTTP endpoint the spans are exported to, i.e. http://otel-collector:4318/v1/traces. Defaults to the OTEL_EXPORTER_OTLP_* environment variables", //nolint:lll
+			Category: "tracing",
+			EnvVars:  []string{"AUTH_TRACING_ENDPOINT"},
+		},
+		&cli.Float64Flag{ //nolint: exhaustruct
+			Name:     flagTracingSampleRatio,
+			Usage:    "Ratio of the traces started by the service that are sampled, from 0 to 1",
+			Value:    1,
+			Category: "tracing",
+			EnvVars:  []string{"AUTH_TRACING_SAMPLE_RATIO"},
+		},
+	}
+}
+
+func tracingEnabled(cCtx *cli.Context) bool {
+	return cCtx.Bool(flagTracingEnabled)
+}
+
+// setupTracing configures the exporter of the spans and returns the function flushing the
+// pending ones on shutdown. It does nothing if tracing is disabled.
+func setupTracing(cCtx *cli.Context) (func(context.Context) error, error) {
+	if !tracingEnabled(cCtx) {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	shutdown, err := tracing.Setup(
+		cCtx.Context,
+		cCtx.String(flagTracingEndpoint),
+		cCtx.Float64(flagTracingSampleRatio),
+		cCtx.App.Version,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem setting up tracing: %w", err)
+	}
+
+	return shutdown, nil
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import "github.com/urfave/cli/v2"
+
+const flagUserMetadataSchema = "user-metadata-schema"
+
+func userMetadataFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagUserMetadataSchema,
+			Usage:    "JSON schema the metadata of the users must match. Uses the schema object of OpenAPI 3.0",
+			Category: "user metadata",
+			EnvVars:  []string{"AUTH_USER_METADATA_SCHEMA"},
+		},
+	}
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagWebhookEndpoints      = "webhook-endpoints"
+	flagWebhookSecret         = "webhook-secret"
+	flagWebhookTimeout        = "webhook-timeout"
+	flagWebhookMaxAttempts    = "webhook-max-attempts"
+	flagWebhookOutboxEnabled  = "webhook-outbox-enabled"
+	flagWebhookOutboxInterval = "webhook-outbox-interval"
+	flagPreSignUpHookURL      = "pre-signup-hook-url"
+	flagPreSignUpHookSecret   = "pre-signup-hook-secret"
+	flagPreSignUpHookTimeout  = "pre-signup-hook-timeout"
+	flagClaimsHookURL         = "claims-hook-url"
+	flagClaimsHookSecret      = "claims-hook-secret"
+	flagClaimsHookTimeout     = "claims-hook-timeout"
+	flagClaimsHookRequired    = "claims-hook-required"
+)
+
+// webhookBackoff is how long the first retry of a failed delivery waits, it doubles with
+// every attempt.
+const webhookBackoff = time.Second
+
+func webhookFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{ //nolint: exhaustruct
+			Name:     flagWebhookEndpoints,
+			Usage:    "Comma-separated list of event=url, the events are posted to the url. Use * as event to receive all of them, i.e. user.created=https://crm.acme.com/hooks", //nolint:lll
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_WEBHOOK_ENDPOINTS"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagWebhookSecret,
+			Usage:    "Secret used to sign the webhook requests with HMAC-SHA256",
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_WEBHOOK_SECRET"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagWebhookTimeout,
+			Usage:    "Seconds to wait for a webhook endpoint to respond",
+			Value:    10, //nolint:mnd
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_WEBHOOK_TIMEOUT"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagWebhookMaxAttempts,
+			Usage:    "Number of times a webhook delivery is attempted before giving up",
+			Value:    5, //nolint:mnd
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_WEBHOOK_MAX_ATTEMPTS"},
+		},
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagWebhookOutboxEnabled,
+			Usage:    "Store webhook deliveries in the auth.webhook_outbox table, in the same transaction as the change they are about, and send them in the background", //nolint:lll
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_WEBHOOK_OUTBOX_ENABLED"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagWebhookOutboxInterval,
+			Usage:    "Interval in seconds to check the outbox for webhook deliveries to send",
+			Value:    5, //nolint:mnd
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_WEBHOOK_OUTBOX_INTERVAL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagPreSignUpHookURL,
+			Usage:    "URL users are posted to before signing up so it can accept, reject or change them",
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_PRE_SIGNUP_HOOK_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagPreSignUpHookSecret,
+			Usage:    "Secret used to sign the pre sign up hook requests with HMAC-SHA256. Defaults to webhook-secret",
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_PRE_SIGNUP_HOOK_SECRET"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagPreSignUpHookTimeout,
+			Usage:    "Seconds to wait for the pre sign up hook to respond before rejecting the sign up",
+			Value:    5, //nolint:mnd
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_PRE_SIGNUP_HOOK_TIMEOUT"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagClaimsHookURL,
+			Usage:    "URL asked for the custom claims of the user every time an access token is issued",
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_CLAIMS_HOOK_URL"},
+		},
+		&cli.StringFlag{ //nolint: exhaustruct
+			Name:     flagClaimsHookSecret,
+			Usage:    "Secret used to sign the claims hook requests with HMAC-SHA256. Defaults to webhook-secret",
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_CLAIMS_HOOK_SECRET"},
+		},
+		&cli.IntFlag{ //nolint: exhaustruct
+			Name:     flagClaimsHookTimeout,
+			Usage:    "Seconds to wait for the claims hook to respond before issuing the token without its claims",
+			Value:    5, //nolint:mnd
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_CLAIMS_HOOK_TIMEOUT"},
+		},
+		&cli.BoolFlag{ //nolint: exhaustruct
+			Name:     flagClaimsHookRequired,
+			Usage:    "Fail issuing access tokens when the claims hook fails instead of issuing them without custom claims",
+			Value:    false,
+			Category: "webhooks",
+			EnvVars:  []string{"AUTH_CLAIMS_HOOK_REQUIRED"},
+		},
+	}
+}
+
+// getWebhookSender returns the sender of the events to the webhooks and to the event bus,
+// or nil if none of them is configured.
+func getWebhookSender(
+	cCtx *cli.Context, db *sql.Queries, logger *slog.Logger,
+) (controller.WebhookSender, error) {
+	var senders controller.WebhookSenders
+
+	webhookSender, err := getWebhookEndpointsSender(cCtx, logger)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case webhookSender == nil:
+	case cCtx.Bool(flagWebhookOutboxEnabled):
+		if cCtx.Int(flagWebhookOutboxInterval) <= 0 {
+			return nil, errors.New("webhook outbox interval must be positive") //nolint:goerr113
+		}
+
+		outbox := webhooks.NewOutbox(
+			db, webhookSender, logger.With(slog.String("component", "webhook-outbox")),
+		)
+		go outbox.Run(
+			cCtx.Context, time.Duration(cCtx.Int(flagWebhookOutboxInterval))*time.Second,
+		)
+		senders = append(senders, outbox)
+	default:
+		senders = append(senders, webhookSender)
+	}
+
+	publisher, err := getEventBusPublisher(cCtx, logger)
+	if err != nil {
+		return nil, err
+	}
+	if publisher != nil {
+		senders = append(senders, publisher)
+	}
+
+	switch len(senders) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return senders[0], nil
+	default:
+		return senders, nil
+	}
+}
+
+func getWebhookEndpointsSender(
+	cCtx *cli.Context, logger *slog.Logger,
+) (*webhooks.Sender, error) {
+	entries := cCtx.StringSlice(flagWebhookEndpoints)
+	if len(entries) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	if cCtx.String(flagWebhookSecret) == "" {
+		return nil, errors.New("webhook secret is required") //nolint:goerr113
+	}
+
+	endpoints, err := webhooks.ParseEndpoints(entries)
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing webhook endpoints: %w", err)
+	}
+
+	return webhooks.NewSender(
+		endpoints,
+		cCtx.String(flagWebhookSecret),
+		time.Duration(cCtx.Int(flagWebhookTimeout))*time.Second,
+		max(cCtx.Int(flagWebhookMaxAttempts), 1),
+		webhookBackoff,
+		logger.With(slog.String("component", "webhooks")),
+	), nil
+}
+
+// hookSecret returns the secret set in flag, falling back to the one of the webhooks.
+func hookSecret(cCtx *cli.Context, flag string) string {
+	if secret := cCtx.String(flag); secret != "" {
+		return secret
+	}
+	return cCtx.String(flagWebhookSecret)
+}
+
+func getPreSignUpHook(cCtx *cli.Context) (controller.PreSignUpHook, error) {
+	url := cCtx.String(flagPreSignUpHookURL)
+	if url == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	secret := hookSecret(cCtx, flagPreSignUpHookSecret)
+	if secret == "" {
+		return nil, errors.New("pre sign up hook secret is required") //nolint:goerr113
+	}
+
+	return webhooks.NewPreSignUpHook(
+		url, secret, time.Duration(cCtx.Int(flagPreSignUpHookTimeout))*time.Second,
+	), nil
+}
+
+// getClaimsHook returns the claims hook, if any, failing to issue access tokens when it
+// fails if claims-hook-required is set.
+func getClaimsHook(cCtx *cli.Context) (controller.CustomClaimer, error) { //nolint:ireturn
+	url := cCtx.String(flagClaimsHookURL)
+	if url == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	secret := hookSecret(cCtx, flagClaimsHookSecret)
+	if secret == "" {
+		return nil, errors.New("claims hook secret is required") //nolint:goerr113
+	}
+
+	hook := webhooks.NewClaimsHook(
+		url, secret, time.Duration(cCtx.Int(flagClaimsHookTimeout))*time.Second,
+	)
+	if cCtx.Bool(flagClaimsHookRequired) {
+		return controller.RequiredClaims{CustomClaimer: hook}, nil
+	}
+
+	return hook, nil
+}
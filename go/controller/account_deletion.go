@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+type AccountDeletionDB interface {
+	AnonymizeScheduledUsers(ctx context.Context) (int64, error)
+	DeleteScheduledUsers(ctx context.Context) (int64, error)
+}
+
+type AccountDeletionMode string
+
+const (
+	// AccountDeletionModeDelete deletes the users and everything stored about them.
+	AccountDeletionModeDelete AccountDeletionMode = "delete"
+	// AccountDeletionModeAnonymize erases the personal data of the users and disables them
+	// instead, keeping their rows so tables referencing auth.users stay valid.
+	AccountDeletionModeAnonymize AccountDeletionMode = "anonymize"
+)
+
+// AccountDeleter deletes the users whose deletion grace period is over.
+type AccountDeleter struct {
+	db     AccountDeletionDB
+	mode   AccountDeletionMode
+	logger *slog.Logger
+}
+
+func NewAccountDeleter(
+	db AccountDeletionDB, mode AccountDeletionMode, logger *slog.Logger,
+) *AccountDeleter {
+	return &AccountDeleter{
+		db:     db,
+		mode:   mode,
+		logger: logger,
+	}
+}
+
+// Delete deletes, or anonymizes, the users scheduled for deletion before now and returns
+// how many were deleted.
+func (d *AccountDeleter) Delete(ctx context.Context) (int64, error) {
+	if d.mode == AccountDeletionModeAnonymize {
+		n, err := d.db.AnonymizeScheduledUsers(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("error anonymizing scheduled users: %w", err)
+		}
+		return n, nil
+	}
+
+	n, err := d.db.DeleteScheduledUsers(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting scheduled users: %w", err)
+	}
+
+	return n, nil
+}
+
+// Run deletes the scheduled users right away and then every interval until the context is
+// done.
+func (d *AccountDeleter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := d.Delete(ctx)
+		if err != nil {
+			d.logger.Error("error deleting scheduled users", logError(err))
+		} else if n > 0 {
+			d.logger.Info(
+				"deleted scheduled users",
+				slog.Int64("deleted", n), slog.String("mode", string(d.mode)),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
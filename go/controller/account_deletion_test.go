@@ -0,0 +1,77 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/controller"
+)
+
+type fakeAccountDeletionDB struct {
+	deleted    int64
+	anonymized int64
+	err        error
+}
+
+func (db *fakeAccountDeletionDB) DeleteScheduledUsers(_ context.Context) (int64, error) {
+	return db.deleted, db.err
+}
+
+func (db *fakeAccountDeletionDB) AnonymizeScheduledUsers(_ context.Context) (int64, error) {
+	return db.anonymized, db.err
+}
+
+func TestAccountDeleterDelete(t *testing.T) {
+	t.Parallel()
+
+	errDB := errors.New("db is down") //nolint:goerr113
+
+	cases := []struct {
+		name        string
+		mode        controller.AccountDeletionMode
+		db          *fakeAccountDeletionDB
+		expected    int64
+		expectedErr error
+	}{
+		{
+			name:        "delete",
+			mode:        controller.AccountDeletionModeDelete,
+			db:          &fakeAccountDeletionDB{deleted: 2, anonymized: 0, err: nil},
+			expected:    2,
+			expectedErr: nil,
+		},
+		{
+			name:        "anonymize",
+			mode:        controller.AccountDeletionModeAnonymize,
+			db:          &fakeAccountDeletionDB{deleted: 0, anonymized: 3, err: nil},
+			expected:    3,
+			expectedErr: nil,
+		},
+		{
+			name:        "error",
+			mode:        controller.AccountDeletionModeDelete,
+			db:          &fakeAccountDeletionDB{deleted: 0, anonymized: 0, err: errDB},
+			expected:    0,
+			expectedErr: errDB,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			deleter := controller.NewAccountDeleter(tc.db, tc.mode, slog.Default())
+
+			n, err := deleter.Delete(context.Background())
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if n != tc.expected {
+				t.Errorf("expected %d deleted, got %d", tc.expected, n)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+type auditEvent string
+
+const (
+	auditSignUp            auditEvent = "sign-up"
+	auditSignIn            auditEvent = "sign-in"
+	auditElevate           auditEvent = "elevate"
+	auditVerify            auditEvent = "verify"
+	auditPasswordReset     auditEvent = "password-reset"
+	auditMFAChange         auditEvent = "mfa-change"
+	auditEmailChange       auditEvent = "email-change"
+	auditPhoneNumberChange auditEvent = "phone-number-change"
+	auditDeanonymize       auditEvent = "deanonymize"
+	auditSessionRevoke     auditEvent = "session-revoke"
+	auditPATChange         auditEvent = "pat-change"
+	auditProviderUnlink    auditEvent = "provider-unlink"
+	auditDataExport        auditEvent = "data-export"
+	auditAccountDeletion   auditEvent = "account-deletion"
+	auditAdminAction       auditEvent = "admin-action"
+	auditImpersonation     auditEvent = "impersonation"
+	auditSessionLimit      auditEvent = "session-limit"
+	auditImpossibleTravel  auditEvent = "impossible-travel"
+	auditRiskySignIn       auditEvent = "risky-sign-in"
+)
+
+// auditedOperations are the operations recorded in the audit log and the event they are
+// recorded as. Operations only reading data aren't recorded.
+var auditedOperations = map[string]auditEvent{ //nolint:gochecknoglobals
+	"PostSigninAnonymous":                   auditSignUp,
+	"PostSignupEmailPassword":               auditSignUp,
+	"PostSignupWebauthnVerify":              auditSignUp,
+	"PostDeviceToken":                       auditSignIn,
+	"PostSigninEmailPassword":               auditSignIn,
+	"PostSigninIdtoken":                     auditSignIn,
+	"PostSigninMfaRecoveryCode":             auditSignIn,
+	"PostSigninMfaTotp":                     auditSignIn,
+	"PostSigninPasswordlessSmsOtp":          auditSignIn,
+	"PostSigninPat":                         auditSignIn,
+	"PostSigninWebauthnVerify":              auditSignIn,
+	"PostElevate":                           auditElevate,
+	"PostElevateWebauthnVerify":             auditElevate,
+	"GetVerify":                             auditVerify,
+	"PostUserPasswordReset":                 auditPasswordReset,
+	"PostMfaTotpEnable":                     auditMFAChange,
+	"PostMfaRecoveryCodes":                  auditMFAChange,
+	"PostUserEmailChange":                   auditEmailChange,
+	"PostUserPhoneNumberChangeVerify":       auditPhoneNumberChange,
+	"PostUserDeanonymize":                   auditDeanonymize,
+	"PostUserSessionsRevokeAll":             auditSessionRevoke,
+	"DeleteUserSessionsSessionId":           auditSessionRevoke,
+	"PostPat":                               auditPATChange,
+	"DeletePatPatId":                        auditPATChange,
+	"DeleteUserProvidersProvider":           auditProviderUnlink,
+	"PostUserDataExport":                    auditDataExport,
+	"PostUserDelete":                        auditAccountDeletion,
+	"PostAdminUsersImport":                  auditAdminAction,
+	"PostAdminUsersUserIdSessionsRevokeAll": auditAdminAction,
+	"PostAdminUsersUserIdUnlock":            auditAdminAction,
+	"PostAdminUsersUserIdDisable":           auditAdminAction,
+	"PostAdminUsersUserIdEnable":            auditAdminAction,
+	"PostAdminUsersUserIdBan":               auditAdminAction,
+	"DeleteAdminUsersUserIdBan":             auditAdminAction,
+	"PostAdminUsersUserIdImpersonate":       auditImpersonation,
+	"PostAdminUsersUserIdPassword":          auditAdminAction,
+	"PostAdminUsersUserIdPasswordReset":     auditAdminAction,
+	"PostAdminUsersUserIdDataExport":        auditAdminAction,
+	"PostAdminUsersUserIdRoles":             auditAdminAction,
+	"DeleteAdminUsersUserIdRolesRole":       auditAdminAction,
+	"PostAdminRoles":                        auditAdminAction,
+	"DeleteAdminRolesRole":                  auditAdminAction,
+	"PostAdminOauth2Clients":                auditAdminAction,
+	"DeleteAdminOauth2ClientsClientId":      auditAdminAction,
+	"PostAdminEmailsEmailIdRetry":           auditAdminAction,
+	"PostScimV2Users":                       auditAdminAction,
+	"PutScimV2UsersUserId":                  auditAdminAction,
+	"PatchScimV2UsersUserId":                auditAdminAction,
+	"DeleteScimV2UsersUserId":               auditAdminAction,
+	"PostScimV2Groups":                      auditAdminAction,
+	"PutScimV2GroupsGroupId":                auditAdminAction,
+	"PatchScimV2GroupsGroupId":              auditAdminAction,
+	"DeleteScimV2GroupsGroupId":             auditAdminAction,
+}
+
+// mandatoryAuditedOperations are recorded even if the audit log is disabled. Their
+// response is only sent if it was recorded successfully.
+var mandatoryAuditedOperations = map[string]bool{ //nolint:gochecknoglobals
+	"PostAdminUsersUserIdImpersonate": true,
+}
+
+// auditSession returns the session returned by the operations signing the user in.
+func auditSession(response any) *api.Session { //nolint:cyclop
+	switch r := response.(type) {
+	case api.PostDeviceToken200JSONResponse:
+		return r.Session
+	case api.PostSigninAnonymous200JSONResponse:
+		return r.Session
+	case api.PostSigninEmailPassword200JSONResponse:
+		return r.Session
+	case api.PostSigninIdtoken200JSONResponse:
+		return r.Session
+	case api.PostSigninMfaRecoveryCode200JSONResponse:
+		return r.Session
+	case api.PostSigninMfaTotp200JSONResponse:
+		return r.Session
+	case api.PostSigninPasswordlessSmsOtp200JSONResponse:
+		return r.Session
+	case api.PostSigninPat200JSONResponse:
+		return r.Session
+	case api.PostSigninWebauthnVerify200JSONResponse:
+		return r.Session
+	case api.PostSignupEmailPassword200JSONResponse:
+		return r.Session
+	case api.PostSignupWebauthnVerify200JSONResponse:
+		return r.Session
+	}
+	return nil
+}
+
+// auditUserID returns the user the request is about: the user signed in by the request,
+// the user of the access token or the user an administrator acted on.
+func (ctrl *Controller) auditUserID( //nolint:cyclop
+	ctx context.Context, request any, response any,
+) pgtype.UUID {
+	switch r := request.(type) {
+	case api.PostAdminUsersUserIdSessionsRevokeAllRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdUnlockRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdDisableRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdEnableRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdBanRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.DeleteAdminUsersUserIdBanRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdImpersonateRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdPasswordRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdPasswordResetRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdRolesRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.DeleteAdminUsersUserIdRolesRoleRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PostAdminUsersUserIdDataExportRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PutScimV2UsersUserIdRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.PatchScimV2UsersUserIdRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	case api.DeleteScimV2UsersUserIdRequestObject:
+		return pgtype.UUID{Bytes: r.UserId, Valid: true}
+	}
+
+	if user, ok := response.(api.PostScimV2Users201ApplicationScimPlusJSONResponse); ok {
+		if id, err := uuid.Parse(deptr(user.Id)); err == nil {
+			return pgtype.UUID{Bytes: id, Valid: true}
+		}
+	}
+
+	if session := auditSession(response); session != nil && session.User != nil {
+		if id, err := uuid.Parse(session.User.Id); err == nil {
+			return pgtype.UUID{Bytes: id, Valid: true}
+		}
+	}
+
+	if jwtToken, ok := ctrl.wf.jwtGetter.FromContext(ctx); ok {
+		sub, err := jwtToken.Claims.GetSubject()
+		if err != nil {
+			return pgtype.UUID{} //nolint:exhaustruct
+		}
+		if id, err := uuid.Parse(sub); err == nil {
+			return pgtype.UUID{Bytes: id, Valid: true}
+		}
+	}
+
+	return pgtype.UUID{} //nolint:exhaustruct
+}
+
+// auditErrorCode returns the error the operation failed with, or an empty string if it
+// succeeded. Errors of GetVerify are sent as a redirect.
+func auditErrorCode(response any, err error) string {
+	if err != nil {
+		return string(api.InternalServerError)
+	}
+
+	switch r := response.(type) {
+	case ErrorResponse:
+		return string(r.Error)
+	case ScimErrorResponse:
+		if r.ScimType != nil {
+			return *r.ScimType
+		}
+		return "scim-error-" + r.Status
+	case api.GetVerify302Response:
+		location, err := url.Parse(r.Headers.Location)
+		if err != nil {
+			return ""
+		}
+		return location.Query().Get("error")
+	}
+
+	return ""
+}
+
+func auditMetadata( //nolint:cyclop
+	operationID string, request any, errorCode string,
+) map[string]any {
+	metadata := map[string]any{
+		"operation": operationID,
+	}
+
+	if identifier := rateLimitIdentifier(request); identifier != "" {
+		metadata["identifier"] = identifier
+	}
+
+	switch r := request.(type) {
+	case api.GetVerifyRequestObject:
+		metadata["type"] = string(r.Params.Type)
+	case api.PostAdminUsersUserIdImpersonateRequestObject:
+		metadata["impersonatedBy"] = r.Body.ImpersonatedBy
+		if r.Body.Role != nil {
+			metadata["role"] = *r.Body.Role
+		}
+	case api.PostAdminUsersUserIdRolesRequestObject:
+		metadata["role"] = r.Body.Role
+	case api.DeleteAdminUsersUserIdRolesRoleRequestObject:
+		metadata["role"] = r.Role
+	case api.PostAdminRolesRequestObject:
+		metadata["role"] = r.Body.Role
+	case api.DeleteAdminRolesRoleRequestObject:
+		metadata["role"] = r.Role
+	case api.PostScimV2GroupsRequestObject:
+		metadata["role"] = r.Body.DisplayName
+	case api.PutScimV2GroupsGroupIdRequestObject:
+		metadata["role"] = r.GroupId
+	case api.PatchScimV2GroupsGroupIdRequestObject:
+		metadata["role"] = r.GroupId
+	case api.DeleteScimV2GroupsGroupIdRequestObject:
+		metadata["role"] = r.GroupId
+	}
+
+	if errorCode != "" {
+		metadata["error"] = errorCode
+	}
+
+	return metadata
+}
+
+func (ctrl *Controller) recordAuditLog(
+	ctx context.Context,
+	event auditEvent,
+	operationID string,
+	request any,
+	response any,
+	errorCode string,
+	logger *slog.Logger,
+) error {
+	outcome := api.Success
+	if errorCode != "" {
+		outcome = api.Failure
+	}
+
+	actor := api.AuditLogActorUser
+	if event == auditAdminAction || event == auditImpersonation {
+		actor = api.AuditLogActorAdmin
+	}
+
+	metadata, err := json.Marshal(auditMetadata(operationID, request, errorCode))
+	if err != nil {
+		logger.Error("error marshalling audit log metadata", logError(err))
+		return fmt.Errorf("error marshalling audit log metadata: %w", err)
+	}
+
+	client := middleware.ClientInfoFromContext(ctx)
+	if err := ctrl.wf.db.InsertAuditLog(ctx, sql.InsertAuditLogParams{
+		Event:     string(event),
+		Outcome:   string(outcome),
+		Actor:     string(actor),
+		UserID:    ctrl.auditUserID(ctx, request, response),
+		IpAddress: sql.NullableText(client.IP),
+		UserAgent: sql.NullableText(client.UserAgent),
+		Metadata:  metadata,
+	}); err != nil {
+		logger.Error("error inserting audit log", logError(err))
+		return fmt.Errorf("error inserting audit log: %w", err)
+	}
+
+	return nil
+}
+
+// Audit is a strict middleware that records the outcome of the operations listed in
+// auditedOperations in the auth.audit_logs table. Errors recording the entry are logged
+// and don't fail the request, except for the mandatoryAuditedOperations.
+func (ctrl *Controller) Audit(
+	f api.StrictHandlerFunc,
+	operationID string,
+) api.StrictHandlerFunc {
+	event, ok := auditedOperations[operationID]
+	mandatory := mandatoryAuditedOperations[operationID]
+	if !ok || (!ctrl.config.AuditLogEnabled && !mandatory) {
+		return f
+	}
+
+	return func(ctx *gin.Context, request any) (any, error) {
+		response, err := f(ctx, request)
+
+		errorCode := auditErrorCode(response, err)
+		if recordErr := ctrl.recordAuditLog(
+			ctx,
+			event,
+			operationID,
+			request,
+			response,
+			errorCode,
+			middleware.LoggerFromContext(ctx),
+		); recordErr != nil && mandatory && errorCode == "" {
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+
+		return response, err
+	}
+}
+
+// recordAuditEvent records events that happen as part of other operations, like the
+// sessions evicted when signing in, if the audit log is enabled. Errors are only logged.
+func (wf *Workflows) recordAuditEvent(
+	ctx context.Context,
+	event auditEvent,
+	userID uuid.UUID,
+	errorCode string,
+	metadata map[string]any,
+	logger *slog.Logger,
+) {
+	if !wf.config.AuditLogEnabled {
+		return
+	}
+
+	outcome := api.Success
+	if errorCode != "" {
+		outcome = api.Failure
+		metadata["error"] = errorCode
+	}
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		logger.Error("error marshalling audit log metadata", logError(err))
+		return
+	}
+
+	client := middleware.ClientInfoFromContext(ctx)
+	if err := wf.db.InsertAuditLog(ctx, sql.InsertAuditLogParams{
+		Event:     string(event),
+		Outcome:   string(outcome),
+		Actor:     string(api.AuditLogActorUser),
+		UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+		IpAddress: sql.NullableText(client.IP),
+		UserAgent: sql.NullableText(client.UserAgent),
+		Metadata:  b,
+	}); err != nil {
+		logger.Error("error inserting audit log", logError(err))
+	}
+}
@@ -0,0 +1,278 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAudit(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	auditConfig := func() *controller.Config {
+		cfg := getConfig()
+		cfg.AuditLogEnabled = true
+		return cfg
+	}
+
+	signinResponse := api.PostSigninEmailPassword200JSONResponse{
+		Session: &api.Session{ //nolint:exhaustruct
+			User: &api.User{ //nolint:exhaustruct
+				Id: userID.String(),
+			},
+		},
+		Mfa: nil,
+	}
+
+	invalidEmailPassword := controller.ErrorResponse{
+		Status:  http.StatusUnauthorized,
+		Error:   "invalid-email-password",
+		Message: "Incorrect email or password",
+	}
+
+	cases := []struct {
+		name        string
+		config      func() *controller.Config
+		db          func(ctrl *gomock.Controller) controller.DBClient
+		operationID string
+		request     any
+		response    any
+		expected    any
+	}{
+		{
+			name:   "sign in",
+			config: auditConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertAuditLog(gomock.Any(), sql.InsertAuditLogParams{
+					Event:     "sign-in",
+					Outcome:   "success",
+					Actor:     "user",
+					UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+					IpAddress: sql.Text("192.168.1.1"),
+					UserAgent: sql.Text("test"),
+					Metadata: []byte(
+						`{"identifier":"jane@acme.com","operation":"PostSigninEmailPassword"}`,
+					),
+				}).Return(nil)
+				return mock
+			},
+			operationID: "PostSigninEmailPassword",
+			request:     signinEmailPasswordRequest("Jane@acme.com"),
+			response:    signinResponse,
+			expected:    nil,
+		},
+		{
+			name:   "sign in failed",
+			config: auditConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertAuditLog(gomock.Any(), sql.InsertAuditLogParams{
+					Event:     "sign-in",
+					Outcome:   "failure",
+					Actor:     "user",
+					UserID:    pgtype.UUID{}, //nolint:exhaustruct
+					IpAddress: sql.Text("192.168.1.1"),
+					UserAgent: sql.Text("test"),
+					Metadata: []byte(
+						`{"error":"invalid-email-password","identifier":"jane@acme.com","operation":"PostSigninEmailPassword"}`, //nolint:lll
+					),
+				}).Return(nil)
+				return mock
+			},
+			operationID: "PostSigninEmailPassword",
+			request:     signinEmailPasswordRequest("jane@acme.com"),
+			response:    invalidEmailPassword,
+			expected:    nil,
+		},
+		{
+			name:   "admin action",
+			config: auditConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertAuditLog(gomock.Any(), sql.InsertAuditLogParams{
+					Event:     "admin-action",
+					Outcome:   "success",
+					Actor:     "admin",
+					UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+					IpAddress: sql.Text("192.168.1.1"),
+					UserAgent: sql.Text("test"),
+					Metadata:  []byte(`{"operation":"PostAdminUsersUserIdUnlock"}`),
+				}).Return(nil)
+				return mock
+			},
+			operationID: "PostAdminUsersUserIdUnlock",
+			request:     api.PostAdminUsersUserIdUnlockRequestObject{UserId: userID},
+			response:    api.PostAdminUsersUserIdUnlock200JSONResponse(api.OK),
+			expected:    nil,
+		},
+		{
+			name:   "insert error doesn't fail the request",
+			config: auditConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New("connection refused")) //nolint:goerr113
+				return mock
+			},
+			operationID: "PostSigninEmailPassword",
+			request:     signinEmailPasswordRequest("jane@acme.com"),
+			response:    signinResponse,
+			expected:    nil,
+		},
+		{
+			name:   "operation not audited",
+			config: auditConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			operationID: "PostToken",
+			request:     api.PostTokenRequestObject{Body: nil},
+			response:    api.PostToken200JSONResponse{}, //nolint:exhaustruct
+			expected:    nil,
+		},
+		{
+			name:   "audit log disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			operationID: "PostSigninEmailPassword",
+			request:     signinEmailPasswordRequest("jane@acme.com"),
+			response:    signinResponse,
+			expected:    nil,
+		},
+		{
+			name:   "impersonation is recorded with the audit log disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertAuditLog(gomock.Any(), sql.InsertAuditLogParams{
+					Event:     "impersonation",
+					Outcome:   "success",
+					Actor:     "admin",
+					UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+					IpAddress: sql.Text("192.168.1.1"),
+					UserAgent: sql.Text("test"),
+					Metadata: []byte(
+						`{"impersonatedBy":"admin@acme.com","operation":"PostAdminUsersUserIdImpersonate","role":"user"}`, //nolint:lll
+					),
+				}).Return(nil)
+				return mock
+			},
+			operationID: "PostAdminUsersUserIdImpersonate",
+			request: api.PostAdminUsersUserIdImpersonateRequestObject{
+				UserId: userID,
+				Body: &api.ImpersonateUserRequest{
+					ImpersonatedBy: "admin@acme.com",
+					Role:           ptr("user"),
+				},
+			},
+			response: api.PostAdminUsersUserIdImpersonate200JSONResponse{ //nolint:exhaustruct
+				AccessToken: "token",
+			},
+			expected: nil,
+		},
+		{
+			name:   "impersonation fails if it can't be recorded",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().InsertAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New("connection refused")) //nolint:goerr113
+				return mock
+			},
+			operationID: "PostAdminUsersUserIdImpersonate",
+			request: api.PostAdminUsersUserIdImpersonateRequestObject{
+				UserId: userID,
+				Body: &api.ImpersonateUserRequest{
+					ImpersonatedBy: "admin@acme.com",
+					Role:           ptr("user"),
+				},
+			},
+			response: api.PostAdminUsersUserIdImpersonate200JSONResponse{ //nolint:exhaustruct
+				AccessToken: "token",
+			},
+			expected: controller.ErrorResponse{
+				Status:  http.StatusInternalServerError,
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			handler := c.Audit(
+				func(_ *gin.Context, _ any) (any, error) {
+					return tc.response, nil
+				},
+				tc.operationID,
+			)
+
+			ginCtx, engine := gin.CreateTestContext(httptest.NewRecorder())
+			engine.ContextWithFallback = true
+			ginCtx.Request = httptest.NewRequest(http.MethodPost, "/", nil).WithContext(
+				middleware.ClientInfoToContext(
+					context.Background(),
+					middleware.ClientInfo{IP: "192.168.1.1", UserAgent: "test"},
+				),
+			)
+
+			resp, err := handler(ginCtx, tc.request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected := tc.expected
+			if expected == nil {
+				expected = tc.response
+			}
+
+			if diff := cmp.Diff(expected, resp); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
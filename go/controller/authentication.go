@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+// AuthenticationFunc verifies the security scheme of the endpoint. Endpoints using the
+// AdminSecret scheme need the x-hasura-admin-secret header, the ones using the
+// IntrospectionClient scheme the introspection client credentials in a basic
+// authorization header, the ones using the ScimToken scheme the SCIM token as bearer
+// token, the rest are authenticated with an access token.
+func (ctrl *Controller) AuthenticationFunc(
+	ctx context.Context, input *openapi3filter.AuthenticationInput,
+) error {
+	switch input.SecuritySchemeName {
+	case "AdminSecret":
+		adminSecret := input.RequestValidationInput.Request.Header.Get("X-Hasura-Admin-Secret")
+		if !secretMatches(adminSecret, ctrl.config.HasuraAdminSecret) {
+			return ErrInvalidAdminSecret
+		}
+		return nil
+	case "IntrospectionClient":
+		clientID, clientSecret, ok := input.RequestValidationInput.Request.BasicAuth()
+		if !ok ||
+			!secretMatches(clientID, ctrl.config.IntrospectionClientID) ||
+			!secretMatches(clientSecret, ctrl.config.IntrospectionClientSecret) {
+			return ErrInvalidClientSecret
+		}
+		return nil
+	case "ScimToken":
+		token, _ := strings.CutPrefix(
+			input.RequestValidationInput.Request.Header.Get("Authorization"), "Bearer ",
+		)
+		if !secretMatches(token, ctrl.config.ScimToken) {
+			return ErrInvalidScimToken
+		}
+		return nil
+	default:
+		return ctrl.wf.jwtGetter.MiddlewareFunc(ctx, input)
+	}
+}
+
+// secretMatches compares the secrets in constant time, an empty expected secret never
+// matches so endpoints are disabled when their secret isn't configured.
+func secretMatches(secret, expected string) bool {
+	return expected != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(expected)) == 1
+}
@@ -0,0 +1,301 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthenticationFuncAdminSecret(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		adminSecret string
+		header      http.Header
+		expectedErr error
+	}{
+		{
+			name:        "valid admin secret",
+			adminSecret: "my-admin-secret",
+			header:      http.Header{"X-Hasura-Admin-Secret": []string{"my-admin-secret"}},
+			expectedErr: nil,
+		},
+		{
+			name:        "wrong admin secret",
+			adminSecret: "my-admin-secret",
+			header:      http.Header{"X-Hasura-Admin-Secret": []string{"not-my-admin-secret"}},
+			expectedErr: controller.ErrInvalidAdminSecret,
+		},
+		{
+			name:        "missing admin secret",
+			adminSecret: "my-admin-secret",
+			header:      http.Header{},
+			expectedErr: controller.ErrInvalidAdminSecret,
+		},
+		{
+			name:        "admin secret not configured",
+			adminSecret: "",
+			header:      http.Header{"X-Hasura-Admin-Secret": []string{""}},
+			expectedErr: controller.ErrInvalidAdminSecret,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				func() *controller.Config {
+					cfg := getConfig()
+					cfg.HasuraAdminSecret = tc.adminSecret
+					return cfg
+				},
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    nil,
+				},
+			)
+
+			err := c.AuthenticationFunc(
+				context.Background(),
+				&openapi3filter.AuthenticationInput{
+					RequestValidationInput: &openapi3filter.RequestValidationInput{ //nolint:exhaustruct
+						Request: &http.Request{Header: tc.header}, //nolint:exhaustruct
+					},
+					SecuritySchemeName: "AdminSecret",
+					SecurityScheme:     nil,
+					Scopes:             []string{},
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("err = %v; want %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticationFuncIntrospectionClient(t *testing.T) {
+	t.Parallel()
+
+	withBasicAuth := func(clientID, clientSecret string) http.Header {
+		r := &http.Request{Header: http.Header{}} //nolint:exhaustruct
+		r.SetBasicAuth(clientID, clientSecret)
+		return r.Header
+	}
+
+	cases := []struct {
+		name         string
+		clientSecret string
+		header       http.Header
+		expectedErr  error
+	}{
+		{
+			name:         "valid client credentials",
+			clientSecret: "my-client-secret",
+			header:       withBasicAuth("my-client", "my-client-secret"),
+			expectedErr:  nil,
+		},
+		{
+			name:         "wrong client secret",
+			clientSecret: "my-client-secret",
+			header:       withBasicAuth("my-client", "not-my-client-secret"),
+			expectedErr:  controller.ErrInvalidClientSecret,
+		},
+		{
+			name:         "wrong client id",
+			clientSecret: "my-client-secret",
+			header:       withBasicAuth("not-my-client", "my-client-secret"),
+			expectedErr:  controller.ErrInvalidClientSecret,
+		},
+		{
+			name:         "missing credentials",
+			clientSecret: "my-client-secret",
+			header:       http.Header{},
+			expectedErr:  controller.ErrInvalidClientSecret,
+		},
+		{
+			name:         "client secret not configured",
+			clientSecret: "",
+			header:       withBasicAuth("my-client", ""),
+			expectedErr:  controller.ErrInvalidClientSecret,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				func() *controller.Config {
+					cfg := getConfig()
+					cfg.IntrospectionClientID = "my-client"
+					cfg.IntrospectionClientSecret = tc.clientSecret
+					return cfg
+				},
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    nil,
+				},
+			)
+
+			err := c.AuthenticationFunc(
+				context.Background(),
+				&openapi3filter.AuthenticationInput{
+					RequestValidationInput: &openapi3filter.RequestValidationInput{ //nolint:exhaustruct
+						Request: &http.Request{Header: tc.header}, //nolint:exhaustruct
+					},
+					SecuritySchemeName: "IntrospectionClient",
+					SecurityScheme:     nil,
+					Scopes:             []string{},
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("err = %v; want %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticationFuncScimToken(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		scimToken     string
+		authorization string
+		expectedErr   error
+	}{
+		{
+			name:          "valid token",
+			scimToken:     "my-scim-token",
+			authorization: "Bearer my-scim-token",
+			expectedErr:   nil,
+		},
+		{
+			name:          "wrong token",
+			scimToken:     "my-scim-token",
+			authorization: "Bearer not-my-scim-token",
+			expectedErr:   controller.ErrInvalidScimToken,
+		},
+		{
+			name:          "missing token",
+			scimToken:     "my-scim-token",
+			authorization: "",
+			expectedErr:   controller.ErrInvalidScimToken,
+		},
+		{
+			name:          "token not configured",
+			scimToken:     "",
+			authorization: "Bearer ",
+			expectedErr:   controller.ErrInvalidScimToken,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				func() *controller.Config {
+					cfg := getConfig()
+					cfg.ScimToken = tc.scimToken
+					return cfg
+				},
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    nil,
+				},
+			)
+
+			err := c.AuthenticationFunc(
+				context.Background(),
+				&openapi3filter.AuthenticationInput{
+					RequestValidationInput: &openapi3filter.RequestValidationInput{ //nolint:exhaustruct
+						Request: &http.Request{ //nolint:exhaustruct
+							Header: http.Header{"Authorization": []string{tc.authorization}},
+						},
+					},
+					SecuritySchemeName: "ScimToken",
+					SecurityScheme:     nil,
+					Scopes:             []string{},
+				},
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("err = %v; want %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
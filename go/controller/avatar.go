@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Values of AvatarProvider, the service the avatars of new users are taken from.
+const (
+	AvatarProviderNone       = "none"
+	AvatarProviderGravatar   = "gravatar"
+	AvatarProviderLibravatar = "libravatar"
+	AvatarProviderDiceBear   = "dicebear"
+	// AvatarProviderTemplate builds the URL from AvatarURLTemplate.
+	AvatarProviderTemplate = "template"
+)
+
+var (
+	ErrUnknownAvatarProvider    = errors.New("unknown avatar provider")
+	ErrMissingAvatarURLTemplate = errors.New("the template avatar provider needs a URL template")
+)
+
+// AvatarProvider returns the URL of the avatar given to new users from their email. An
+// empty URL means the users don't get an avatar.
+type AvatarProvider interface {
+	AvatarURL(email string) string
+}
+
+// NewAvatarProvider returns the avatar provider selected in the configuration. Disabling
+// gravatar disables the avatars if gravatar is the selected provider.
+func NewAvatarProvider(config *Config) (AvatarProvider, error) { //nolint:ireturn
+	switch config.AvatarProvider {
+	case AvatarProviderGravatar, "":
+		if !config.GravatarEnabled {
+			return NoAvatar{}, nil
+		}
+		return Gravatar{Default: config.GravatarDefault, Rating: config.GravatarRating}, nil
+	case AvatarProviderLibravatar:
+		return Libravatar{Default: config.LibravatarDefault}, nil
+	case AvatarProviderDiceBear:
+		return DiceBear{Style: config.DiceBearStyle}, nil
+	case AvatarProviderTemplate:
+		if config.AvatarURLTemplate == "" {
+			return nil, ErrMissingAvatarURLTemplate
+		}
+		return AvatarURLTemplate{Template: config.AvatarURLTemplate}, nil
+	case AvatarProviderNone:
+		return NoAvatar{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAvatarProvider, config.AvatarProvider)
+	}
+}
+
+func emailMD5(email string) string {
+	h := md5.Sum([]byte(strings.ToLower(strings.TrimSpace(email)))) //nolint:gosec
+	return hex.EncodeToString(h[:])
+}
+
+func emailSHA256(email string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(h[:])
+}
+
+// NoAvatar doesn't give avatars to the users.
+type NoAvatar struct{}
+
+func (NoAvatar) AvatarURL(string) string {
+	return ""
+}
+
+// Gravatar returns the gravatar of the email. Default is the image shown if there is none,
+// i.e. identicon, and Rating the highest rating of the images allowed, g, pg, r or x.
+type Gravatar struct {
+	Default string
+	Rating  string
+}
+
+func (g Gravatar) AvatarURL(email string) string {
+	return fmt.Sprintf(
+		"https://www.gravatar.com/avatar/%s?d=%s&r=%s", emailMD5(email), g.Default, g.Rating,
+	)
+}
+
+// Libravatar returns the avatar of the email on libravatar.org, a federated alternative to
+// gravatar. Default is the image shown if there is none, it takes the same values as gravatar.
+type Libravatar struct {
+	Default string
+}
+
+func (l Libravatar) AvatarURL(email string) string {
+	return fmt.Sprintf(
+		"https://seccdn.libravatar.org/avatar/%s?d=%s", emailSHA256(email), l.Default,
+	)
+}
+
+// DiceBear generates an avatar with the given style, i.e. identicon or shapes. The avatar is
+// seeded with a hash of the email so every user gets their own and emails aren't exposed.
+type DiceBear struct {
+	Style string
+}
+
+func (d DiceBear) AvatarURL(email string) string {
+	return fmt.Sprintf(
+		"https://api.dicebear.com/9.x/%s/svg?seed=%s", url.PathEscape(d.Style), emailSHA256(email),
+	)
+}
+
+// AvatarURLTemplate builds the URL from a template where ${md5} and ${sha256} are replaced
+// with the hashes of the lowercased email, i.e. https://avatars.acme.com/${sha256}.png.
+type AvatarURLTemplate struct {
+	Template string
+}
+
+func (t AvatarURLTemplate) AvatarURL(email string) string {
+	return strings.NewReplacer(
+		"${md5}", emailMD5(email),
+		"${sha256}", emailSHA256(email),
+	).Replace(t.Template)
+}
@@ -0,0 +1,131 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/controller"
+)
+
+func TestAvatarProvider(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		config   func(c *controller.Config)
+		email    string
+		expected string
+	}{
+		{
+			name: "gravatar",
+			config: func(c *controller.Config) {
+				c.AvatarProvider = controller.AvatarProviderGravatar
+				c.GravatarEnabled = true
+				c.GravatarDefault = "retro"
+				c.GravatarRating = "g"
+			},
+			email:    "test@example.com",
+			expected: "https://www.gravatar.com/avatar/55502f40dc8b7c769880b10874abc9d0?d=retro&r=g",
+		},
+		{
+			name: "gravatar disabled",
+			config: func(c *controller.Config) {
+				c.AvatarProvider = controller.AvatarProviderGravatar
+				c.GravatarEnabled = false
+				c.GravatarDefault = "retro"
+				c.GravatarRating = "g"
+			},
+			email:    "test@example.com",
+			expected: "",
+		},
+		{
+			name: "libravatar",
+			config: func(c *controller.Config) {
+				c.AvatarProvider = controller.AvatarProviderLibravatar
+				c.LibravatarDefault = "identicon"
+			},
+			email:    "Test@Example.com",
+			expected: "https://seccdn.libravatar.org/avatar/973dfe463ec85785f5f95af5ba3906eedb2d931c24e69824a89ea65dba4e813b?d=identicon", //nolint:lll
+		},
+		{
+			name: "dicebear",
+			config: func(c *controller.Config) {
+				c.AvatarProvider = controller.AvatarProviderDiceBear
+				c.DiceBearStyle = "shapes"
+			},
+			email:    "test@example.com",
+			expected: "https://api.dicebear.com/9.x/shapes/svg?seed=973dfe463ec85785f5f95af5ba3906eedb2d931c24e69824a89ea65dba4e813b", //nolint:lll
+		},
+		{
+			name: "template",
+			config: func(c *controller.Config) {
+				c.AvatarProvider = controller.AvatarProviderTemplate
+				c.AvatarURLTemplate = "https://avatars.acme.com/${md5}.png?fallback=${sha256}"
+			},
+			email:    "test@example.com",
+			expected: "https://avatars.acme.com/55502f40dc8b7c769880b10874abc9d0.png?fallback=973dfe463ec85785f5f95af5ba3906eedb2d931c24e69824a89ea65dba4e813b", //nolint:lll
+		},
+		{
+			name: "none",
+			config: func(c *controller.Config) {
+				c.AvatarProvider = controller.AvatarProviderNone
+				c.GravatarEnabled = true
+			},
+			email:    "test@example.com",
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := getConfig()
+			tc.config(config)
+
+			provider, err := controller.NewAvatarProvider(config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := provider.AvatarURL(tc.email)
+			if got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAvatarProviderInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		provider    string
+		expectedErr error
+	}{
+		{
+			name:        "unknown provider",
+			provider:    "myspace",
+			expectedErr: controller.ErrUnknownAvatarProvider,
+		},
+		{
+			name:        "template without template",
+			provider:    controller.AvatarProviderTemplate,
+			expectedErr: controller.ErrMissingAvatarURLTemplate,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := getConfig()
+			config.AvatarProvider = tc.provider
+
+			if _, err := controller.NewAvatarProvider(config); !errors.Is(err, tc.expectedErr) {
+				t.Errorf("expected %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
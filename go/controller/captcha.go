@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+// captchaOperations are the operations that can require a captcha token, with the path
+// used to enable them in the configuration.
+var captchaOperations = map[string]string{ //nolint:gochecknoglobals
+	"PostSigninAnonymous":         "/signin/anonymous",
+	"PostSigninPasswordlessEmail": "/signin/passwordless/email",
+	"PostSigninPasswordlessSms":   "/signin/passwordless/sms",
+	"PostSignupEmailPassword":     "/signup/email-password",
+	"PostSignupWebauthn":          "/signup/webauthn",
+	"PostUserPasswordReset":       "/user/password/reset",
+}
+
+func validateCaptchaEndpoints(endpoints []string) error {
+	for _, endpoint := range endpoints {
+		found := false
+		for _, path := range captchaOperations {
+			if path == endpoint {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unsupported captcha endpoint: %s", endpoint) //nolint:goerr113
+		}
+	}
+	return nil
+}
+
+func captchaToken(request any) string {
+	switch r := request.(type) {
+	case api.PostSigninAnonymousRequestObject:
+		if r.Body != nil {
+			return deptr(r.Body.CaptchaToken)
+		}
+	case api.PostSigninPasswordlessEmailRequestObject:
+		return deptr(r.Body.CaptchaToken)
+	case api.PostSigninPasswordlessSmsRequestObject:
+		return deptr(r.Body.CaptchaToken)
+	case api.PostSignupEmailPasswordRequestObject:
+		return deptr(r.Body.CaptchaToken)
+	case api.PostSignupWebauthnRequestObject:
+		return deptr(r.Body.CaptchaToken)
+	case api.PostUserPasswordResetRequestObject:
+		return deptr(r.Body.CaptchaToken)
+	}
+	return ""
+}
+
+// Captcha is a strict middleware that rejects requests to the operations listed in
+// captchaOperations unless they include a captcha token accepted by the provider.
+func (ctrl *Controller) Captcha(
+	f api.StrictHandlerFunc,
+	operationID string,
+) api.StrictHandlerFunc {
+	endpoint, ok := captchaOperations[operationID]
+	if !ok || ctrl.captcha == nil || !slices.Contains(ctrl.config.CaptchaEndpoints, endpoint) {
+		return f
+	}
+
+	return func(ctx *gin.Context, request any) (any, error) {
+		logger := middleware.LoggerFromContext(ctx)
+
+		token := captchaToken(request)
+		if token == "" {
+			logger.Warn("missing captcha token")
+			return ctrl.sendError(ErrInvalidCaptcha), nil
+		}
+
+		valid, err := ctrl.captcha.Verify(ctx, token, middleware.ClientInfoFromContext(ctx).IP)
+		if err != nil {
+			logger.Error("error verifying captcha token", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+
+		if !valid {
+			logger.Warn("invalid captcha token")
+			return ctrl.sendError(ErrInvalidCaptcha), nil
+		}
+
+		return f(ctx, request)
+	}
+}
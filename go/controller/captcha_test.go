@@ -0,0 +1,219 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCaptcha(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	next := "next handler called"
+
+	captchaConfig := func() *controller.Config {
+		cfg := getConfig()
+		cfg.CaptchaEndpoints = []string{"/signup/email-password", "/signin/anonymous"}
+		return cfg
+	}
+
+	signupRequest := func(token *string) api.PostSignupEmailPasswordRequestObject {
+		return api.PostSignupEmailPasswordRequestObject{
+			Body: &api.PostSignupEmailPasswordJSONRequestBody{
+				Email:        "jane@acme.com",
+				Password:     "password",
+				Options:      nil,
+				CaptchaToken: token,
+			},
+		}
+	}
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		captcha          func(ctrl *gomock.Controller) controller.CaptchaVerifier
+		operationID      string
+		request          any
+		expectedResponse any
+	}{
+		{
+			name:   "valid token",
+			config: captchaConfig,
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				mock := mock.NewMockCaptchaVerifier(ctrl)
+				mock.EXPECT().Verify(gomock.Any(), "my-token", "192.168.1.1").Return(true, nil)
+				return mock
+			},
+			operationID:      "PostSignupEmailPassword",
+			request:          signupRequest(ptr("my-token")),
+			expectedResponse: next,
+		},
+		{
+			name:   "invalid token",
+			config: captchaConfig,
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				mock := mock.NewMockCaptchaVerifier(ctrl)
+				mock.EXPECT().Verify(gomock.Any(), "my-token", "192.168.1.1").Return(false, nil)
+				return mock
+			},
+			operationID: "PostSignupEmailPassword",
+			request:     signupRequest(ptr("my-token")),
+			expectedResponse: controller.ErrorResponse{
+				Status:  400,
+				Error:   "invalid-captcha",
+				Message: "Invalid or missing captcha token",
+			},
+		},
+		{
+			name:   "missing token",
+			config: captchaConfig,
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				return mock.NewMockCaptchaVerifier(ctrl)
+			},
+			operationID: "PostSignupEmailPassword",
+			request:     signupRequest(nil),
+			expectedResponse: controller.ErrorResponse{
+				Status:  400,
+				Error:   "invalid-captcha",
+				Message: "Invalid or missing captcha token",
+			},
+		},
+		{
+			name:   "missing body",
+			config: captchaConfig,
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				return mock.NewMockCaptchaVerifier(ctrl)
+			},
+			operationID: "PostSigninAnonymous",
+			request:     api.PostSigninAnonymousRequestObject{Body: nil},
+			expectedResponse: controller.ErrorResponse{
+				Status:  400,
+				Error:   "invalid-captcha",
+				Message: "Invalid or missing captcha token",
+			},
+		},
+		{
+			name:   "provider error",
+			config: captchaConfig,
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				mock := mock.NewMockCaptchaVerifier(ctrl)
+				mock.EXPECT().Verify(
+					gomock.Any(), "my-token", "192.168.1.1",
+				).Return(false, errors.New("connection refused")) //nolint:goerr113
+				return mock
+			},
+			operationID: "PostSignupEmailPassword",
+			request:     signupRequest(ptr("my-token")),
+			expectedResponse: controller.ErrorResponse{
+				Status:  500,
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+			},
+		},
+		{
+			name: "endpoint not enforced",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.CaptchaEndpoints = []string{"/signin/anonymous"}
+				return cfg
+			},
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				return mock.NewMockCaptchaVerifier(ctrl)
+			},
+			operationID:      "PostSignupEmailPassword",
+			request:          signupRequest(nil),
+			expectedResponse: next,
+		},
+		{
+			name:   "operation not supported",
+			config: captchaConfig,
+			captcha: func(ctrl *gomock.Controller) controller.CaptchaVerifier {
+				return mock.NewMockCaptchaVerifier(ctrl)
+			},
+			operationID:      "PostSigninEmailPassword",
+			request:          signinEmailPasswordRequest("jane@acme.com"),
+			expectedResponse: next,
+		},
+		{
+			name:   "disabled",
+			config: captchaConfig,
+			captcha: func(_ *gomock.Controller) controller.CaptchaVerifier {
+				return nil
+			},
+			operationID:      "PostSignupEmailPassword",
+			request:          signupRequest(nil),
+			expectedResponse: next,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				tc.config,
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          tc.captcha(ctrl),
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    nil,
+				},
+			)
+
+			handler := c.Captcha(
+				func(_ *gin.Context, _ any) (any, error) {
+					return next, nil
+				},
+				tc.operationID,
+			)
+
+			ginCtx, engine := gin.CreateTestContext(httptest.NewRecorder())
+			engine.ContextWithFallback = true
+			ginCtx.Request = httptest.NewRequest(http.MethodPost, "/", nil).WithContext(
+				middleware.ClientInfoToContext(
+					context.Background(),
+					middleware.ClientInfo{IP: "192.168.1.1", UserAgent: "test"},
+				),
+			)
+
+			resp, err := handler(ginCtx, tc.request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedResponse, resp); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -62,12 +62,18 @@ func (ctrl *Controller) PostChangeEnv(fn gin.HandlerFunc) gin.HandlerFunc { //no
 			ctrl.config.BlockedEmails != nil ||
 			ctrl.config.AllowedEmailDomains != nil ||
 			ctrl.config.AllowedEmails != nil {
-			ctrl.wf.ValidateEmail = ValidateEmail(
+			emailValidator, err := ValidateEmail(
 				ctrl.config.BlockedEmailDomains,
 				ctrl.config.BlockedEmails,
 				ctrl.config.AllowedEmailDomains,
 				ctrl.config.AllowedEmails,
 			)
+			if err != nil {
+				_ = c.Error(err)
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			ctrl.wf.ValidateEmail = emailValidator
 		}
 
 		fn(c)
@@ -6,6 +6,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/nhost/hasura-auth/go/notifications"
 )
 
 type stringlice []string
@@ -25,6 +27,12 @@ func (s *stringlice) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+type fallbacks = notifications.LocaleFallbacks
+
+type peppers = []PasswordPepper
+
+type lifetimes = map[string]TokenLifetimes
+
 type Config struct {
 	HasuraGraphqlURL           string        `json:"HASURA_GRAPHQL_GRAPHQL_URL"`
 	HasuraAdminSecret          string        `json:"HASURA_GRAPHQL_ADMIN_SECRET"`
@@ -42,22 +50,205 @@ type Config struct {
 	DefaultRole                string        `json:"AUTH_DEFAULT_ROLE"`
 	DefaultLocale              string        `json:"AUTH_DEFAULT_LOCALE"`
 	AllowedLocales             stringlice    `json:"AUTH_LOCALE_ALLOWED_LOCALES"`
+	LocaleFallbacks            fallbacks     `json:"AUTH_LOCALE_FALLBACKS"`
 	GravatarEnabled            bool          `json:"AUTH_GRAVATAR_ENABLED"`
 	GravatarDefault            string        `json:"AUTH_GRAVATAR_DEFAULT"`
 	GravatarRating             string        `json:"AUTH_GRAVATAR_RATING"`
+	AvatarProvider             string        `json:"AUTH_AVATAR_PROVIDER"`
+	LibravatarDefault          string        `json:"AUTH_LIBRAVATAR_DEFAULT"`
+	DiceBearStyle              string        `json:"AUTH_DICEBEAR_STYLE"`
+	AvatarURLTemplate          string        `json:"AUTH_AVATAR_URL_TEMPLATE"`
+	AvatarUploadMaxSize        int           `json:"AUTH_AVATAR_UPLOAD_MAX_SIZE"`
+	AvatarSize                 int           `json:"AUTH_AVATAR_SIZE"`
 	PasswordMinLength          int           `json:"AUTH_PASSWORD_MIN_LENGTH"`
 	PasswordHIBPEnabled        bool          `json:"AUTH_PASSWORD_HIBP_ENABLED"`
+	PasswordMaxLength          int           `json:"AUTH_PASSWORD_MAX_LENGTH"`
+	PasswordMinScore           int           `json:"AUTH_PASSWORD_MIN_SCORE"`
+	PasswordCharacterClasses   stringlice    `json:"AUTH_PASSWORD_REQUIRED_CHARACTER_CLASSES"`
+	PasswordDenylist           stringlice    `json:"AUTH_PASSWORD_DENYLIST"`
+	PasswordRejectEmail        bool          `json:"AUTH_PASSWORD_REJECT_EMAIL"`
+	PasswordHashAlgorithm      string        `json:"AUTH_PASSWORD_HASH_ALGORITHM"`
+	PasswordArgon2Memory       uint32        `json:"AUTH_PASSWORD_ARGON2_MEMORY"`
+	PasswordArgon2Iterations   uint32        `json:"AUTH_PASSWORD_ARGON2_ITERATIONS"`
+	PasswordArgon2Parallelism  uint8         `json:"AUTH_PASSWORD_ARGON2_PARALLELISM"`
+	PasswordPeppers            peppers       `json:"AUTH_PASSWORD_PEPPERS"`
+	RevokeSessionsOnReset      bool          `json:"AUTH_PASSWORD_RESET_REVOKE_SESSIONS"`
+	NotifyNewDeviceSignIn      bool          `json:"AUTH_NEW_DEVICE_NOTIFICATION_ENABLED"`
 	RefreshTokenExpiresIn      int           `json:"AUTH_REFRESH_TOKEN_EXPIRES_IN"`
 	AccessTokenExpiresIn       int           `json:"AUTH_ACCESS_TOKEN_EXPIRES_IN"`
+	ElevatedTokenExpiresIn     int           `json:"AUTH_ELEVATED_ACCESS_TOKEN_EXPIRES_IN"`
+	ClientTokenLifetimes       lifetimes     `json:"AUTH_CLIENT_TOKEN_LIFETIMES"`
+	RefreshTokenExpiration     string        `json:"AUTH_REFRESH_TOKEN_EXPIRATION"`
+	RefreshTokenMaxLifetime    int           `json:"AUTH_REFRESH_TOKEN_MAX_LIFETIME"`
+	SessionLimit               int           `json:"AUTH_SESSION_LIMIT"`
+	SessionLimitPolicy         string        `json:"AUTH_SESSION_LIMIT_POLICY"`
+	ImpossibleTravelPolicy     string        `json:"AUTH_IMPOSSIBLE_TRAVEL_POLICY"`
+	ImpossibleTravelMaxSpeed   int           `json:"AUTH_IMPOSSIBLE_TRAVEL_MAX_SPEED"`
+	RiskMFAThreshold           int           `json:"AUTH_RISK_MFA_THRESHOLD"`
+	RiskBlockThreshold         int           `json:"AUTH_RISK_BLOCK_THRESHOLD"`
 	JWTSecret                  string        `json:"HASURA_GRAPHQL_JWT_SECRET"`
 	RequireEmailVerification   bool          `json:"AUTH_EMAIL_SIGNIN_EMAIL_VERIFIED_REQUIRED"`
 	ServerURL                  *url.URL      `json:"AUTH_SERVER_URL"`
 	EmailPasswordlessEnabled   bool          `json:"AUTH_EMAIL_PASSWORDLESS_ENABLED"`
+	AnonymousUsersEnabled      bool          `json:"AUTH_ANONYMOUS_USERS_ENABLED"`
+	MfaEnabled                 bool          `json:"AUTH_MFA_ENABLED"`
+	MfaTotpIssuer              string        `json:"AUTH_MFA_TOTP_ISSUER"`
+	SMSPasswordlessEnabled     bool          `json:"AUTH_SMS_PASSWORDLESS_ENABLED"`
+	SMSTestPhoneNumbers        stringlice    `json:"AUTH_SMS_TEST_PHONE_NUMBERS"`
 	WebauthnEnabled            bool          `json:"AUTH_WEBAUTHN_ENABLED"`
 	WebauthnRPID               string        `json:"AUTH_WEBAUTHN_RPID"`
 	WebauthnRPName             string        `json:"AUTH_WEBAUTHN_RPNAME"`
 	WebauthnRPOrigins          []string      `json:"AUTH_WEBAUTHN_RP_ORIGINS"`
 	WebauhtnAttestationTimeout time.Duration `json:"AUTH_WEBAUTHN_ATTESTATION_TIMEOUT"`
+	DeviceAuthorizationEnabled bool          `json:"AUTH_DEVICE_AUTHORIZATION_ENABLED"`
+	DeviceVerificationURL      string        `json:"AUTH_DEVICE_VERIFICATION_URL"`
+	DeviceCodeExpiresIn        int           `json:"AUTH_DEVICE_CODE_EXPIRES_IN"`
+	DevicePollingInterval      int           `json:"AUTH_DEVICE_POLLING_INTERVAL"`
+	IntrospectionClientID      string        `json:"AUTH_INTROSPECTION_CLIENT_ID"`
+	IntrospectionClientSecret  string        `json:"AUTH_INTROSPECTION_CLIENT_SECRET"`
+	TokenExchangeExpiresIn     int           `json:"AUTH_TOKEN_EXCHANGE_EXPIRES_IN"`
+	RateLimitInterval          int           `json:"AUTH_RATE_LIMIT_INTERVAL"`
+	RateLimitSigninIP          int           `json:"AUTH_RATE_LIMIT_SIGNIN_IP"`
+	RateLimitSigninIdentifier  int           `json:"AUTH_RATE_LIMIT_SIGNIN_IDENTIFIER"`
+	RateLimitSignupIP          int           `json:"AUTH_RATE_LIMIT_SIGNUP_IP"`
+	RateLimitSignupIdentifier  int           `json:"AUTH_RATE_LIMIT_SIGNUP_IDENTIFIER"`
+	RateLimitResetIP           int           `json:"AUTH_RATE_LIMIT_PASSWORD_RESET_IP"`
+	RateLimitResetIdentifier   int           `json:"AUTH_RATE_LIMIT_PASSWORD_RESET_IDENTIFIER"`
+	RateLimitEmailIP           int           `json:"AUTH_RATE_LIMIT_EMAIL_IP"`
+	RateLimitEmailIdentifier   int           `json:"AUTH_RATE_LIMIT_EMAIL_IDENTIFIER"`
+	RateLimitSMSIP             int           `json:"AUTH_RATE_LIMIT_SMS_IP"`
+	RateLimitSMSIdentifier     int           `json:"AUTH_RATE_LIMIT_SMS_IDENTIFIER"`
+	LockoutEnabled             bool          `json:"AUTH_LOCKOUT_ENABLED"`
+	LockoutBackoffAfter        int           `json:"AUTH_LOCKOUT_BACKOFF_AFTER"`
+	LockoutBackoffDelay        int           `json:"AUTH_LOCKOUT_BACKOFF_DELAY"`
+	LockoutThreshold           int           `json:"AUTH_LOCKOUT_THRESHOLD"`
+	LockoutIPThreshold         int           `json:"AUTH_LOCKOUT_IP_THRESHOLD"`
+	LockoutDuration            int           `json:"AUTH_LOCKOUT_DURATION"`
+	CaptchaEndpoints           []string      `json:"AUTH_CAPTCHA_ENDPOINTS"`
+	AuditLogEnabled            bool          `json:"AUTH_AUDIT_LOG_ENABLED"`
+	AccountDeletionEnabled     bool          `json:"AUTH_ACCOUNT_DELETION_ENABLED"`
+	AccountDeletionGracePeriod int           `json:"AUTH_ACCOUNT_DELETION_GRACE_PERIOD_DAYS"`
+	ScimToken                  string        `json:"AUTH_SCIM_TOKEN"`
+	OIDCProviderEnabled        bool          `json:"AUTH_OIDC_PROVIDER_ENABLED"`
+	OIDCProviderAuthURL        string        `json:"AUTH_OIDC_PROVIDER_AUTHORIZATION_URL"`
+	ForwardAuthEnabled         bool          `json:"AUTH_FORWARD_AUTH_ENABLED"`
+	ForwardAuthCookieName      string        `json:"AUTH_FORWARD_AUTH_COOKIE_NAME"`
+	TenantID                   string        `json:"AUTH_TENANT_ID"`
+	OrganizationsEnabled       bool          `json:"AUTH_ORGANIZATIONS_ENABLED"`
+	UserMetadataSchema         string        `json:"AUTH_USER_METADATA_SCHEMA"`
+	AnonymousDefaultRole       string        `json:"AUTH_ANONYMOUS_USER_DEFAULT_ROLE"`
+	AnonymousAllowedRoles      []string      `json:"AUTH_ANONYMOUS_USER_DEFAULT_ALLOWED_ROLES"`
+	ProviderDefaultRole        string        `json:"AUTH_PROVIDER_USER_DEFAULT_ROLE"`
+	ProviderAllowedRoles       []string      `json:"AUTH_PROVIDER_USER_DEFAULT_ALLOWED_ROLES"`
+	PasswordlessDefaultRole    string        `json:"AUTH_PASSWORDLESS_USER_DEFAULT_ROLE"`
+	PasswordlessAllowedRoles   []string      `json:"AUTH_PASSWORDLESS_USER_DEFAULT_ALLOWED_ROLES"`
+	EmailPasswordDefaultRole   string        `json:"AUTH_EMAIL_PASSWORD_USER_DEFAULT_ROLE"`
+	EmailPasswordAllowedRoles  []string      `json:"AUTH_EMAIL_PASSWORD_USER_DEFAULT_ALLOWED_ROLES"`
+	DisposableEmailCheck       string        `json:"AUTH_DISPOSABLE_EMAIL_CHECK"`
+	EmailMXCheckEnabled        bool          `json:"AUTH_EMAIL_MX_CHECK_ENABLED"`
+	EmailMXCheckTimeout        time.Duration `json:"AUTH_EMAIL_MX_CHECK_TIMEOUT"`
+	EmailMXCheckCacheTTL       time.Duration `json:"AUTH_EMAIL_MX_CHECK_CACHE_TTL"`
+	EmailVerificationCooldown  time.Duration `json:"AUTH_EMAIL_VERIFICATION_RESEND_COOLDOWN"`
+}
+
+// Values of DisposableEmailCheck, what happens to the users signing up with the email of a
+// disposable email provider.
+const (
+	DisposableEmailCheckOff = "off"
+	// DisposableEmailCheckFlag lets the users sign up but logs and counts them.
+	DisposableEmailCheckFlag = "flag"
+	// DisposableEmailCheckReject rejects the sign up.
+	DisposableEmailCheckReject = "reject"
+)
+
+// Values of PasswordHashAlgorithm, how the passwords of the users are hashed. Passwords
+// hashed with either are verified regardless of the one in use.
+const (
+	PasswordHashAlgorithmBcrypt = "bcrypt"
+	// PasswordHashAlgorithmArgon2id hashes new passwords with argon2id and re-hashes the
+	// ones hashed otherwise when users sign in with them.
+	PasswordHashAlgorithmArgon2id = "argon2id"
+)
+
+// Values of RefreshTokenExpiration, when the refresh tokens of a session expire.
+const (
+	// RefreshTokenExpirationSliding extends the session every time it is refreshed, up to
+	// RefreshTokenMaxLifetime seconds after it started if set.
+	RefreshTokenExpirationSliding = "sliding"
+	// RefreshTokenExpirationFixed keeps the expiration of the first refresh token of the
+	// session, users have to sign in again when it expires.
+	RefreshTokenExpirationFixed = "fixed"
+)
+
+// Values of SessionLimitPolicy, what happens when users with SessionLimit active sessions
+// sign in again.
+const (
+	// SessionLimitPolicyEvictOldest signs the user out of their oldest sessions.
+	SessionLimitPolicyEvictOldest = "evict-oldest"
+	// SessionLimitPolicyReject rejects the sign in until the user signs out of a session.
+	SessionLimitPolicyReject = "reject"
+)
+
+// Values of ImpossibleTravelPolicy, what happens when users sign in from too far away from
+// where they last used a session for them to have traveled there.
+const (
+	ImpossibleTravelPolicyOff = "off"
+	// ImpossibleTravelPolicyNotify lets the user sign in and emails them about it.
+	ImpossibleTravelPolicyNotify = "notify"
+	// ImpossibleTravelPolicyDeny rejects the sign in and emails the user about it.
+	ImpossibleTravelPolicyDeny = "deny"
+)
+
+// PasswordPepper is a secret passwords are peppered with before being hashed. The id is
+// kept along with the hash so the pepper can be rotated.
+type PasswordPepper struct {
+	ID     string
+	Secret string
+}
+
+// TokenLifetimes are the seconds the refresh and access tokens of the sessions started by
+// a client are valid for.
+type TokenLifetimes struct {
+	RefreshTokenExpiresIn int
+	AccessTokenExpiresIn  int
+}
+
+// SignInMethod groups the ways users can sign up that can be given their own roles.
+type SignInMethod string
+
+const (
+	SignInMethodAnonymous     SignInMethod = "anonymous"
+	SignInMethodProvider      SignInMethod = "provider"
+	SignInMethodPasswordless  SignInMethod = "passwordless"
+	SignInMethodEmailPassword SignInMethod = "email-password"
+)
+
+// SignInMethodRoles returns the default role and the allowed roles of the users signing up
+// with the method. Methods without roles of their own use DefaultRole and
+// DefaultAllowedRoles, except anonymous users that get the anonymous role.
+func (c *Config) SignInMethodRoles(method SignInMethod) (string, []string) {
+	var (
+		defaultRole  string
+		allowedRoles []string
+	)
+	switch method {
+	case SignInMethodAnonymous:
+		if c.AnonymousDefaultRole == "" {
+			return RoleAnonymous, []string{RoleAnonymous}
+		}
+		defaultRole, allowedRoles = c.AnonymousDefaultRole, c.AnonymousAllowedRoles
+	case SignInMethodProvider:
+		defaultRole, allowedRoles = c.ProviderDefaultRole, c.ProviderAllowedRoles
+	case SignInMethodPasswordless:
+		defaultRole, allowedRoles = c.PasswordlessDefaultRole, c.PasswordlessAllowedRoles
+	case SignInMethodEmailPassword:
+		defaultRole, allowedRoles = c.EmailPasswordDefaultRole, c.EmailPasswordAllowedRoles
+	}
+
+	if defaultRole == "" {
+		return c.DefaultRole, c.DefaultAllowedRoles
+	}
+	return defaultRole, allowedRoles
 }
 
 func (c *Config) UnmarshalJSON(b []byte) error {
@@ -8,8 +8,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/geoip"
 	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/nhost/hasura-auth/go/ratelimit"
+	"github.com/nhost/hasura-auth/go/risk"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
 )
 
 const (
@@ -39,12 +44,47 @@ type Emailer interface {
 	) error
 }
 
+type SMSSender interface {
+	SendSMS(
+		ctx context.Context,
+		to string,
+		locale string,
+		templateName notifications.TemplateName,
+		data notifications.TemplateData,
+	) error
+}
+
 type DBClientGetUser interface {
 	GetUser(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
 	GetUserByEmail(ctx context.Context, email pgtype.Text) (sql.AuthUser, error)
+	GetUserByPhoneNumber(ctx context.Context, phoneNumber pgtype.Text) (sql.AuthUser, error)
+	GetUserByPersonalAccessTokenHash(ctx context.Context, tokenHash string) (sql.AuthUser, error)
+	GetUserByProviderID(
+		ctx context.Context, arg sql.GetUserByProviderIDParams,
+	) (sql.AuthUser, error)
 	GetUserByRefreshTokenHash(
 		ctx context.Context, arg sql.GetUserByRefreshTokenHashParams,
 	) (sql.AuthUser, error)
+	GetUserByTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error)
+}
+
+type DBClientOrganizations interface {
+	AcceptOrganizationInvite(
+		ctx context.Context, arg sql.AcceptOrganizationInviteParams,
+	) (sql.AcceptOrganizationInviteRow, error)
+	GetOrganizationMember(
+		ctx context.Context, arg sql.GetOrganizationMemberParams,
+	) (sql.GetOrganizationMemberRow, error)
+	GetUserActiveOrganizationMember(
+		ctx context.Context, id uuid.UUID,
+	) (sql.GetUserActiveOrganizationMemberRow, error)
+	InsertOrganization(
+		ctx context.Context, arg sql.InsertOrganizationParams,
+	) (sql.InsertOrganizationRow, error)
+	UpdateUserActiveOrganization(
+		ctx context.Context, arg sql.UpdateUserActiveOrganizationParams,
+	) (sql.AuthUser, error)
+	UpsertOrganizationInvite(ctx context.Context, arg sql.UpsertOrganizationInviteParams) error
 }
 
 type DBClientInsertUser interface {
@@ -56,62 +96,291 @@ type DBClientInsertUser interface {
 		ctx context.Context,
 		arg sql.InsertUserWithSecurityKeyAndRefreshTokenParams,
 	) (sql.InsertUserWithSecurityKeyAndRefreshTokenRow, error)
+	InsertUserWithUserProvider(
+		ctx context.Context, arg sql.InsertUserWithUserProviderParams,
+	) (sql.InsertUserWithUserProviderRow, error)
 }
 
 type DBClientUpdateUser interface {
+	UpdateUserActiveMFAType(ctx context.Context, arg sql.UpdateUserActiveMFATypeParams) error
 	UpdateUserChangeEmail(
 		ctx context.Context,
 		arg sql.UpdateUserChangeEmailParams,
 	) (sql.AuthUser, error)
+	UpdateUserChangePhoneNumber(
+		ctx context.Context, arg sql.UpdateUserChangePhoneNumberParams,
+	) error
+	UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
+	UpdateUserConfirmChangePhoneNumber(
+		ctx context.Context, arg sql.UpdateUserConfirmChangePhoneNumberParams,
+	) (sql.AuthUser, error)
+	UpdateUserConfirmNewPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
+	UpdateUserConsumeOTP(
+		ctx context.Context, arg sql.UpdateUserConsumeOTPParams,
+	) (sql.AuthUser, error)
+	UpdateUserConsumeTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error)
 	UpdateUserDeanonymize(ctx context.Context, arg sql.UpdateUserDeanonymizeParams) error
+	UpdateUserDeanonymizeWithUserProvider(
+		ctx context.Context, arg sql.UpdateUserDeanonymizeWithUserProviderParams,
+	) (uuid.UUID, error)
+	UpdateUserDisabled(ctx context.Context, arg sql.UpdateUserDisabledParams) (int64, error)
 	UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error)
+	UpdateUserLockedUntil(ctx context.Context, arg sql.UpdateUserLockedUntilParams) error
+	UpdateUserMetadata(ctx context.Context, arg sql.UpdateUserMetadataParams) error
+	UpdateUserAvatarURL(ctx context.Context, arg sql.UpdateUserAvatarURLParams) error
+	UpdateUserOTPHash(ctx context.Context, arg sql.UpdateUserOTPHashParams) (uuid.UUID, error)
+	UpdateUserOTPHashFailedAttempts(
+		ctx context.Context, arg sql.UpdateUserOTPHashFailedAttemptsParams,
+	) (int32, error)
+	UpdateUserPasswordHash(
+		ctx context.Context, arg sql.UpdateUserPasswordHashParams,
+	) (int64, error)
+	UpdateUserRehashPassword(
+		ctx context.Context, arg sql.UpdateUserRehashPasswordParams,
+	) (int64, error)
 	UpdateUserTicket(ctx context.Context, arg sql.UpdateUserTicketParams) (uuid.UUID, error)
+	UpdateUserTicketFailedAttempts(
+		ctx context.Context, arg sql.UpdateUserTicketFailedAttemptsParams,
+	) (int32, error)
+	UpdateUserTotpSecret(ctx context.Context, arg sql.UpdateUserTotpSecretParams) error
+	UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
+	UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
 	InsertUserWithSecurityKey(
 		ctx context.Context, arg sql.InsertUserWithSecurityKeyParams,
 	) (uuid.UUID, error)
+	RecordUserSignInFailure(
+		ctx context.Context, arg sql.RecordUserSignInFailureParams,
+	) (int32, error)
+	ResetUserSignInFailures(ctx context.Context, id uuid.UUID) (int64, error)
 }
 
 type DBClient interface {
 	DBClientGetUser
+	DBClientSignInMethods
 	DBClientInsertUser
 	DBClientUpdateUser
+	DBClientOrganizations
+	DataExportDB
 
+	ApproveDeviceCode(ctx context.Context, arg sql.ApproveDeviceCodeParams) (uuid.UUID, error)
+	BanUser(ctx context.Context, arg sql.BanUserParams) (int64, error)
+	CancelUserDeletion(ctx context.Context, ticket string) (sql.AuthUser, error)
+	CountAuditLogs(ctx context.Context, arg sql.CountAuditLogsParams) (int64, error)
+	CountEmailOutbox(ctx context.Context) ([]sql.CountEmailOutboxRow, error)
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	CountScimGroups(ctx context.Context, role pgtype.Text) (int64, error)
+	CountScimUsers(ctx context.Context, arg sql.CountScimUsersParams) (int64, error)
 	CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteDeviceCode(ctx context.Context, id uuid.UUID) (pgtype.UUID, error)
+	DeleteExpiredDeviceCodes(ctx context.Context) error
+	DenyDeviceCode(ctx context.Context, userCode string) (uuid.UUID, error)
+	DeleteOAuth2Client(ctx context.Context, clientID string) (int64, error)
+	DeleteOAuth2AuthorizationCode(
+		ctx context.Context, arg sql.DeleteOAuth2AuthorizationCodeParams,
+	) (sql.AuthOauth2AuthorizationCode, error)
+	DeleteProviderRequest(ctx context.Context, id uuid.UUID) ([]byte, error)
+	DeleteRecoveryCode(ctx context.Context, arg sql.DeleteRecoveryCodeParams) (uuid.UUID, error)
+	DeleteRole(ctx context.Context, role string) (int64, error)
+	DeleteRefreshTokenFamilyByRotatedHash(
+		ctx context.Context, refreshTokenHash string,
+	) ([]sql.DeleteRefreshTokenFamilyByRotatedHashRow, error)
 	DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error
+	DeleteUser(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteUserPersonalAccessToken(
+		ctx context.Context, arg sql.DeleteUserPersonalAccessTokenParams,
+	) (int64, error)
+	DeleteUserProvider(ctx context.Context, arg sql.DeleteUserProviderParams) (uuid.UUID, error)
+	ConsumeEmailChangeRevert(ctx context.Context, ticket string) (sql.AuthEmailChangeRevert, error)
+	DeleteUserEmailChangeReverts(ctx context.Context, userID uuid.UUID) error
+	ConsumeNewDeviceSignIn(ctx context.Context, ticket string) (sql.AuthNewDeviceSignIn, error)
+	DeleteUserNewDeviceSignIns(ctx context.Context, userID uuid.UUID) error
 	DeleteUserRoles(ctx context.Context, userID uuid.UUID) error
+	DeleteUserRole(ctx context.Context, arg sql.DeleteUserRoleParams) error
+	DeleteUserSession(ctx context.Context, arg sql.DeleteUserSessionParams) ([]uuid.UUID, error)
+	GetAuditLogs(ctx context.Context, arg sql.GetAuditLogsParams) ([]sql.AuthAuditLog, error)
+	GetDataExport(ctx context.Context, id uuid.UUID) (sql.AuthDataExport, error)
+	GetDeviceCode(ctx context.Context, deviceCodeHash string) (sql.AuthDeviceCode, error)
+	GetFailedEmailOutbox(ctx context.Context, limit int32) ([]sql.AuthEmailOutbox, error)
+	GetIPLockedUntil(ctx context.Context, ip string) (pgtype.Timestamptz, error)
+	GetIPSignInFailures(ctx context.Context, arg sql.GetIPSignInFailuresParams) (int32, error)
+	GetOAuth2Client(ctx context.Context, clientID string) (sql.AuthOauth2Client, error)
+	GetOAuth2ConsentScopes(ctx context.Context, arg sql.GetOAuth2ConsentScopesParams) ([]string, error)
+	GetPendingUserDataExport(ctx context.Context, userID uuid.UUID) (sql.AuthDataExport, error)
+	GetPersonalAccessTokenByHash(
+		ctx context.Context, tokenHash string,
+	) (sql.AuthPersonalAccessToken, error)
+	GetRoles(ctx context.Context) ([]sql.GetRolesRow, error)
+	GetRoleUsers(ctx context.Context, role string) ([]sql.GetRoleUsersRow, error)
+	GetScimGroups(ctx context.Context, arg sql.GetScimGroupsParams) ([]string, error)
+	GetScimUsers(ctx context.Context, arg sql.GetScimUsersParams) ([]sql.GetScimUsersRow, error)
+	GetSecurityKeys(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserSecurityKey, error)
+	GetRefreshTokenByHash(
+		ctx context.Context, refreshTokenHash string,
+	) (sql.AuthRefreshToken, error)
+	GetUserPersonalAccessTokens(
+		ctx context.Context, userID uuid.UUID,
+	) ([]sql.AuthPersonalAccessToken, error)
+	GetUserProviders(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserProvider, error)
 	GetUserRoles(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserRole, error)
+	GetUserSessions(ctx context.Context, userID uuid.UUID) ([]sql.AuthRefreshToken, error)
+	GetUsers(ctx context.Context, arg sql.GetUsersParams) ([]sql.GetUsersRow, error)
+	ImportUsers(ctx context.Context, arg sql.ImportUsersParams) ([]uuid.UUID, error)
+	InsertAuditLog(ctx context.Context, arg sql.InsertAuditLogParams) error
+	InsertDataExport(
+		ctx context.Context, arg sql.InsertDataExportParams,
+	) (sql.AuthDataExport, error)
+	InsertDeviceCode(ctx context.Context, arg sql.InsertDeviceCodeParams) (uuid.UUID, error)
+	InsertEmailChangeRevert(ctx context.Context, arg sql.InsertEmailChangeRevertParams) error
+	InsertNewDeviceSignIn(ctx context.Context, arg sql.InsertNewDeviceSignInParams) error
+	InsertOAuth2Client(ctx context.Context, arg sql.InsertOAuth2ClientParams) error
+	InsertOAuth2AuthorizationCode(ctx context.Context, arg sql.InsertOAuth2AuthorizationCodeParams) error
+	InsertPersonalAccessToken(
+		ctx context.Context, arg sql.InsertPersonalAccessTokenParams,
+	) (uuid.UUID, error)
+	InsertProviderRequest(ctx context.Context, arg sql.InsertProviderRequestParams) error
 	InsertRefreshtoken(ctx context.Context, arg sql.InsertRefreshtokenParams) (uuid.UUID, error)
-	RefreshTokenAndGetUserRoles(
+	InsertRole(ctx context.Context, role string) (int64, error)
+	InsertSecurityKey(ctx context.Context, arg sql.InsertSecurityKeyParams) (uuid.UUID, error)
+	InsertTokenExchange(ctx context.Context, arg sql.InsertTokenExchangeParams) error
+	InsertUserProvider(ctx context.Context, arg sql.InsertUserProviderParams) (uuid.UUID, error)
+	InsertUserRole(ctx context.Context, arg sql.InsertUserRoleParams) error
+	LockUserSessions(ctx context.Context, userID uuid.UUID) error
+	Ping(ctx context.Context) error
+	RecordIPSignInFailure(ctx context.Context, arg sql.RecordIPSignInFailureParams) (int32, error)
+	RefreshTokenDeviceFingerprintExists(
+		ctx context.Context, arg sql.RefreshTokenDeviceFingerprintExistsParams,
+	) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, arg sql.ReplaceRecoveryCodesParams) error
+	RetryEmailOutbox(ctx context.Context, id uuid.UUID) (int64, error)
+	RevokeUserSessions(ctx context.Context, id uuid.UUID) (int64, error)
+	RotateRefreshTokenAndGetUserRoles(
 		ctx context.Context,
-		arg sql.RefreshTokenAndGetUserRolesParams,
-	) ([]sql.RefreshTokenAndGetUserRolesRow, error)
+		arg sql.RotateRefreshTokenAndGetUserRolesParams,
+	) ([]sql.RotateRefreshTokenAndGetUserRolesRow, error)
+	ScheduleUserDeletion(
+		ctx context.Context, arg sql.ScheduleUserDeletionParams,
+	) (sql.AuthUser, error)
+	UnbanUser(ctx context.Context, id uuid.UUID) (int64, error)
+	UpdateDeviceCodeLastPolled(ctx context.Context, id uuid.UUID) error
+	UpdateIPLockedUntil(ctx context.Context, arg sql.UpdateIPLockedUntilParams) error
+	UpdateProviderSession(ctx context.Context, arg sql.UpdateProviderSessionParams) error
+	UpdateUserRevertEmailChange(
+		ctx context.Context, arg sql.UpdateUserRevertEmailChangeParams,
+	) (sql.AuthUser, error)
+	UpdateScimUser(ctx context.Context, arg sql.UpdateScimUserParams) (uuid.UUID, error)
+	UpdateSecurityKeyCounter(ctx context.Context, arg sql.UpdateSecurityKeyCounterParams) error
+	UpsertOAuth2Consent(ctx context.Context, arg sql.UpsertOAuth2ConsentParams) error
+}
+
+type SAMLServiceProvider interface {
+	Metadata() ([]byte, error)
+	AuthenticationRequestURL(relayState string) (string, string, error)
+	ParseResponse(samlResponse string, requestID string) (providers.Profile, []string, error)
+}
+
+type RateLimiter interface {
+	Allow(
+		ctx context.Context, key string, limit int, interval time.Duration,
+	) (ratelimit.Result, error)
+}
+
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token string, remoteIP string) (bool, error)
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, event webhooks.Event)
+}
+
+type PreSignUpHook interface {
+	Call(
+		ctx context.Context, request webhooks.PreSignUpRequest,
+	) (webhooks.PreSignUpResponse, error)
+}
+
+// DisposableEmailChecker reports if an email belongs to a disposable email provider.
+type DisposableEmailChecker interface {
+	IsDisposable(email string) bool
+}
+
+// AvatarStorage stores the avatars uploaded by the users. Delete ignores the URLs that
+// weren't returned by Upload.
+type AvatarStorage interface {
+	Upload(ctx context.Context, userID uuid.UUID, contentType string, body []byte) (string, error)
+	Delete(ctx context.Context, avatarURL string) error
+}
+
+// GeoIPLocator returns the approximate location of an IP address. ok is false if it isn't
+// known.
+type GeoIPLocator interface {
+	Locate(ip string) (location geoip.Location, ok bool, err error)
+}
+
+// RiskEngine scores how risky a sign in attempt is, from 0 to risk.MaxScore.
+type RiskEngine interface {
+	Score(ctx context.Context, signals risk.Signals) (int, error)
+}
+
+// ConfigReloader reloads the configuration of the service. It returns the environment
+// variables whose new value is being used and the ones that changed but need a restart.
+type ConfigReloader interface {
+	Reload(ctx context.Context) (changed []string, ignored []string, err error)
 }
 
 type Controller struct {
-	wf       *Workflows
-	config   Config
-	Webauthn *Webauthn
-	version  string
-}
-
-func New(
-	db DBClient,
-	config Config,
-	jwtGetter *JWTGetter,
-	emailer Emailer,
-	hibp HIBPClient,
-	version string,
-) (*Controller, error) {
-	validator, err := NewWorkflows(
-		&config,
-		*jwtGetter,
-		db,
-		hibp,
-		emailer,
-		GravatarURLFunc(
-			config.GravatarEnabled, config.GravatarDefault, config.GravatarRating,
-		),
-	)
+	wf               *Workflows
+	config           Config
+	Webauthn         *Webauthn
+	oauthProviders   map[string]providers.Provider
+	idTokenProviders map[string]providers.IDTokenProvider
+	saml             SAMLServiceProvider
+	rateLimiter      RateLimiter
+	captcha          CaptchaVerifier
+	configReloader   ConfigReloader
+	signInMethods    *SignInMethodFlags
+	version          string
+}
+
+// Dependencies are the clients and services the controller relies on. DB and JWTGetter
+// are required, the features using any of the others are disabled if they are nil.
+type Dependencies struct {
+	DB        DBClient
+	JWTGetter *JWTGetter
+	Emailer   Emailer
+	SMS       SMSSender
+	HIBP      HIBPClient
+	// OAuthProviders are the enabled providers by name, IDTokenProviders the ones that
+	// also sign in users with an ID token issued to the client.
+	OAuthProviders   map[string]providers.Provider
+	IDTokenProviders map[string]providers.IDTokenProvider
+	SAML             SAMLServiceProvider
+	RateLimiter      RateLimiter
+	Captcha          CaptchaVerifier
+	Webhooks         WebhookSender
+	PreSignUpHook    PreSignUpHook
+	DisposableEmails DisposableEmailChecker
+	AvatarStorage    AvatarStorage
+	GeoIP            GeoIPLocator
+	RiskEngine       RiskEngine
+	ConfigReloader   ConfigReloader
+	SignInMethods    *SignInMethodFlags
+	// Version of the service, reported by the version endpoint.
+	Version string
+}
+
+func New(config Config, deps Dependencies) (*Controller, error) {
+	if deps.Captcha != nil {
+		if err := validateCaptchaEndpoints(config.CaptchaEndpoints); err != nil {
+			return nil, err
+		}
+	}
+
+	avatars, err := NewAvatarProvider(&config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating avatar provider: %w", err)
+	}
+
+	validator, err := NewWorkflows(&config, deps, avatars)
 	if err != nil {
 		return nil, fmt.Errorf("error creating validator: %w", err)
 	}
@@ -125,9 +394,16 @@ func New(
 	}
 
 	return &Controller{
-		config:   config,
-		wf:       validator,
-		Webauthn: wa,
-		version:  version,
+		config:           config,
+		wf:               validator,
+		Webauthn:         wa,
+		oauthProviders:   deps.OAuthProviders,
+		idTokenProviders: deps.IDTokenProviders,
+		saml:             deps.SAML,
+		rateLimiter:      deps.RateLimiter,
+		captcha:          deps.Captcha,
+		configReloader:   deps.ConfigReloader,
+		signInMethods:    deps.SignInMethods,
+		version:          deps.Version,
 	}, nil
 }
@@ -105,8 +105,27 @@ func (j jsonPath) IsArrary() bool {
 	return strings.Contains(j.path, "[]") || strings.Contains(j.path, "[*]")
 }
 
+func parseJSONPaths(rawClaims map[string]string) (map[string]jsonPath, error) {
+	jsonPaths := make(map[string]jsonPath)
+	for name, val := range rawClaims {
+		j := jsonpath.New(name)
+		jpath := fmt.Sprintf("{ .%s }", strings.ReplaceAll(val, "[]", "[*]"))
+		if err := j.Parse(jpath); err != nil {
+			return nil, fmt.Errorf("failed to parse jsonpath for claim '%s': %w", name, err)
+		}
+		jsonPaths[name] = jsonPath{
+			path:  val,
+			jpath: j,
+		}
+	}
+	return jsonPaths, nil
+}
+
 type CustomClaims struct {
-	graphqlQuery       string
+	graphqlQuery string
+	// dataField is the field of the response's data the claims are extracted from, the
+	// whole data is used if empty
+	dataField          string
 	jsonPaths          map[string]jsonPath
 	httpclient         *http.Client
 	graphqlURL         string
@@ -126,20 +145,14 @@ func NewCustomClaims(
 	requestInterceptor ...RequestInterceptor,
 ) (*CustomClaims, error) {
 	claims := make(map[string]any)
-	jsonPaths := make(map[string]jsonPath)
-	for name, val := range rawClaims {
+	for _, val := range rawClaims {
 		parts := strings.Split(val, ".")
 		claims = mergeMaps(claims, parseClaims(parts))
+	}
 
-		j := jsonpath.New(name)
-		jpath := fmt.Sprintf("{ .%s }", strings.ReplaceAll(val, "[]", "[*]"))
-		if err := j.Parse(jpath); err != nil {
-			return nil, fmt.Errorf("failed to parse jsonpath for claim '%s': %w", name, err)
-		}
-		jsonPaths[name] = jsonPath{
-			path:  val,
-			jpath: j,
-		}
+	jsonPaths, err := parseJSONPaths(rawClaims)
+	if err != nil {
+		return nil, err
 	}
 
 	query := fmt.Sprintf(
@@ -149,6 +162,32 @@ func NewCustomClaims(
 
 	return &CustomClaims{
 		graphqlQuery:       query,
+		dataField:          "user",
+		jsonPaths:          jsonPaths,
+		httpclient:         httpclient,
+		graphqlURL:         graphqlURL,
+		requestInterceptor: requestInterceptor,
+	}, nil
+}
+
+// NewCustomClaimsFromQuery returns a claimer that runs the given GraphQL query, with the
+// user ID in the `$id` variable, to get the claims. rawClaims maps each claim to a path in
+// the query's result, if it is empty every field of the result is used as a claim.
+func NewCustomClaimsFromQuery(
+	query string,
+	rawClaims map[string]string,
+	httpclient *http.Client,
+	graphqlURL string,
+	requestInterceptor ...RequestInterceptor,
+) (*CustomClaims, error) {
+	jsonPaths, err := parseJSONPaths(rawClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomClaims{
+		graphqlQuery:       query,
+		dataField:          "",
 		jsonPaths:          jsonPaths,
 		httpclient:         httpclient,
 		graphqlURL:         graphqlURL,
@@ -160,7 +199,7 @@ func (c *CustomClaims) GraphQLQuery() string {
 	return c.graphqlQuery
 }
 
-func (c *CustomClaims) getClaimsBackwardsCompatibility(data any, path []string) any {
+func getClaimsBackwardsCompatibility(data any, path []string) any {
 	if len(path) == 0 {
 		return data
 	}
@@ -172,13 +211,13 @@ func (c *CustomClaims) getClaimsBackwardsCompatibility(data any, path []string)
 	case reflect.Map:
 		for _, key := range value.MapKeys() {
 			if key.String() == curPath {
-				return c.getClaimsBackwardsCompatibility(value.MapIndex(key).Interface(), path[1:])
+				return getClaimsBackwardsCompatibility(value.MapIndex(key).Interface(), path[1:])
 			}
 		}
 	case reflect.Slice:
 		got := make([]any, value.Len())
 		for i := range value.Len() {
-			got[i] = c.getClaimsBackwardsCompatibility(value.Index(i).Interface(), path)
+			got[i] = getClaimsBackwardsCompatibility(value.Index(i).Interface(), path)
 		}
 		return got
 	default:
@@ -189,11 +228,23 @@ func (c *CustomClaims) getClaimsBackwardsCompatibility(data any, path []string)
 }
 
 func (c *CustomClaims) ExtractClaims(data any) (map[string]any, error) {
+	if len(c.jsonPaths) == 0 {
+		claims, ok := data.(map[string]any)
+		if !ok {
+			return nil, errors.New("failed to extract claims from response") //nolint:goerr113
+		}
+		return claims, nil
+	}
+
+	return extractClaims(c.jsonPaths, data), nil
+}
+
+func extractClaims(jsonPaths map[string]jsonPath, data any) map[string]any {
 	claims := make(map[string]any)
-	for name, j := range c.jsonPaths {
+	for name, j := range jsonPaths {
 		var got any
 		if strings.HasSuffix(j.path, "[]") {
-			got = c.getClaimsBackwardsCompatibility(data, strings.Split(j.path, "."))
+			got = getClaimsBackwardsCompatibility(data, strings.Split(j.path, "."))
 		} else {
 			v, err := j.jpath.FindResults(data)
 			if err != nil {
@@ -213,7 +264,7 @@ func (c *CustomClaims) ExtractClaims(data any) (map[string]any, error) {
 		}
 		claims[name] = got
 	}
-	return claims, nil
+	return claims
 }
 
 func (c *CustomClaims) makeRequest(ctx context.Context, userID string) (map[string]any, error) {
@@ -277,14 +328,20 @@ func (c *CustomClaims) GetClaims(ctx context.Context, userID string) (map[string
 		return nil, err
 	}
 
+	if errs, ok := data["errors"]; ok {
+		return nil, fmt.Errorf("graphql query returned errors: %v", errs) //nolint:goerr113
+	}
+
 	data, ok := data["data"].(map[string]any)
 	if !ok {
 		return nil, errors.New("failed to extract data from response") //nolint:goerr113
 	}
 
-	data, ok = data["user"].(map[string]any)
-	if !ok {
-		return nil, errors.New("failed to extract user data from response") //nolint:goerr113
+	if c.dataField != "" {
+		data, ok = data[c.dataField].(map[string]any)
+		if !ok {
+			return nil, errors.New("failed to extract user data from response") //nolint:goerr113
+		}
 	}
 
 	return c.ExtractClaims(data)
@@ -1,6 +1,10 @@
 package controller_test
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -98,3 +102,109 @@ func TestCustomClaims(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomClaimsFromQuery(t *testing.T) {
+	t.Parallel()
+
+	const query = `query GetTeams($id: uuid!) { teams(where: {members: {userId: {_eq: $id}}}) { id } }`
+
+	response := map[string]any{
+		"data": map[string]any{
+			"teams": []any{
+				map[string]any{"id": "team-1"},
+				map[string]any{"id": "team-2"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name         string
+		claims       map[string]string
+		response     map[string]any
+		expectedData map[string]any
+		expectedErr  bool
+	}{
+		{
+			name:     "no paths",
+			claims:   nil,
+			response: response,
+			expectedData: map[string]any{
+				"teams": []any{
+					map[string]any{"id": "team-1"},
+					map[string]any{"id": "team-2"},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name:     "with paths",
+			claims:   map[string]string{"team-ids": "teams[].id"},
+			response: response,
+			expectedData: map[string]any{
+				"team-ids": []any{"team-1", "team-2"},
+			},
+			expectedErr: false,
+		},
+		{
+			name:   "graphql errors",
+			claims: nil,
+			response: map[string]any{
+				"errors": []any{map[string]any{"message": "field not found"}},
+			},
+			expectedData: nil,
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Hasura-Admin-Secret") != "secret" {
+					t.Errorf("missing admin secret")
+				}
+
+				var body struct {
+					Query     string         `json:"query"`
+					Variables map[string]any `json:"variables"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+				}
+				if body.Query != query {
+					t.Errorf("unexpected query: %s", body.Query)
+				}
+				if body.Variables["id"] != "db477732-48fa-4289-b694-2886a646b6eb" {
+					t.Errorf("unexpected variables: %v", body.Variables)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(tc.response)
+			}))
+			defer server.Close()
+
+			c, err := controller.NewCustomClaimsFromQuery(
+				query,
+				tc.claims,
+				server.Client(),
+				server.URL,
+				controller.CustomClaimerAddAdminSecret("secret"),
+			)
+			if err != nil {
+				t.Fatalf("failed to get custom claims: %v", err)
+			}
+
+			gotData, err := c.GetClaims(
+				context.Background(), "db477732-48fa-4289-b694-2886a646b6eb",
+			)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedData, gotData); diff != "" {
+				t.Fatalf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
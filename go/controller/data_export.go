@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	dataExportBatchSize = 10
+	// dataExportLease is how long a claimed export is hidden from other workers while it
+	// is being assembled. Exports that fail are retried once the lease expires.
+	dataExportLease       = 5 * time.Minute
+	dataExportMaxAttempts = 3
+)
+
+type DataExportDB interface {
+	ClaimDataExports(
+		ctx context.Context, arg sql.ClaimDataExportsParams,
+	) ([]sql.AuthDataExport, error)
+	CompleteDataExport(ctx context.Context, arg sql.CompleteDataExportParams) error
+	FailDataExportAttempt(ctx context.Context, arg sql.FailDataExportAttemptParams) error
+	DeleteExpiredDataExports(ctx context.Context) (int64, error)
+	GetUser(ctx context.Context, id uuid.UUID) (sql.AuthUser, error)
+	GetUserRoles(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserRole, error)
+	GetUserProviders(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserProvider, error)
+	GetUserSessions(ctx context.Context, userID uuid.UUID) ([]sql.AuthRefreshToken, error)
+	GetUserAuditLogs(ctx context.Context, userID pgtype.UUID) ([]sql.AuthAuditLog, error)
+}
+
+// DataExporter assembles the exports requested with the data export endpoints in the
+// background. Completed exports are kept for the retention period and then deleted.
+type DataExporter struct {
+	db        DataExportDB
+	retention time.Duration
+	logger    *slog.Logger
+}
+
+func NewDataExporter(db DataExportDB, retention time.Duration, logger *slog.Logger) *DataExporter {
+	return &DataExporter{
+		db:        db,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Run processes the pending exports right away and then every interval until the
+// context is done.
+func (e *DataExporter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Process(ctx); err != nil {
+			e.logger.Error("error processing data exports", logError(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Process deletes the expired exports and assembles the pending ones.
+func (e *DataExporter) Process(ctx context.Context) error {
+	n, err := e.db.DeleteExpiredDataExports(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting expired data exports: %w", err)
+	}
+	if n > 0 {
+		e.logger.Info("deleted expired data exports", slog.Int64("deleted", n))
+	}
+
+	exports, err := e.db.ClaimDataExports(ctx, sql.ClaimDataExportsParams{
+		LeaseExpiresAt: sql.TimestampTz(time.Now().Add(dataExportLease)),
+		BatchSize:      dataExportBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error claiming data exports: %w", err)
+	}
+
+	for _, export := range exports {
+		if err := e.export(ctx, export); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *DataExporter) export(ctx context.Context, export sql.AuthDataExport) error {
+	logger := e.logger.With(
+		slog.String("export_id", export.ID.String()),
+		slog.String("user_id", export.UserID.String()),
+		slog.Int("attempt", int(export.Attempts)+1),
+	)
+
+	data, assembleErr := e.assemble(ctx, export.UserID)
+	if assembleErr != nil {
+		logger.Error("error assembling data export", logError(assembleErr))
+		if err := e.db.FailDataExportAttempt(ctx, sql.FailDataExportAttemptParams{
+			ID:          export.ID,
+			LastError:   sql.Text(assembleErr.Error()),
+			MaxAttempts: dataExportMaxAttempts,
+			ExpiresAt:   sql.TimestampTz(time.Now().Add(e.retention)),
+		}); err != nil {
+			return fmt.Errorf("error marking data export attempt as failed: %w", err)
+		}
+		return nil
+	}
+
+	if err := e.db.CompleteDataExport(ctx, sql.CompleteDataExportParams{
+		ID:        export.ID,
+		Data:      data,
+		ExpiresAt: sql.TimestampTz(time.Now().Add(e.retention)),
+	}); err != nil {
+		return fmt.Errorf("error completing data export: %w", err)
+	}
+
+	logger.Info("data export completed")
+
+	return nil
+}
+
+// assemble returns everything stored about the user as a JSON document. Secrets like the
+// password hash and the refresh tokens aren't part of it.
+func (e *DataExporter) assemble(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	user, err := e.db.GetUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+
+	userRoles, err := e.db.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user roles: %w", err)
+	}
+
+	userProviders, err := e.db.GetUserProviders(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user providers: %w", err)
+	}
+
+	refreshTokens, err := e.db.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user sessions: %w", err)
+	}
+
+	logs, err := e.db.GetUserAuditLogs(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("error getting user audit logs: %w", err)
+	}
+
+	export := api.UserDataExport{
+		ExportedAt: time.Now(),
+		Profile:    api.AdminUser{}, //nolint:exhaustruct
+		Providers:  make([]api.UserProvider, len(userProviders)),
+		Sessions:   make([]api.UserSession, len(refreshTokens)),
+		AuditLogs:  make([]api.AuditLog, len(logs)),
+	}
+
+	roles := make([]string, len(userRoles))
+	for i, r := range userRoles {
+		roles[i] = r.Role
+	}
+
+	providers := make([]string, len(userProviders))
+	for i, p := range userProviders {
+		providers[i] = p.ProviderID
+		export.Providers[i] = api.UserProvider{
+			Id:        p.ID.String(),
+			Provider:  p.ProviderID,
+			CreatedAt: p.CreatedAt.Time,
+		}
+	}
+
+	export.Profile, err = adminUserFromAuthUser(user, roles, providers)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, token := range refreshTokens {
+		export.Sessions[i] = sessionFromRefreshToken(token)
+	}
+
+	for i, log := range logs {
+		export.AuditLogs[i], err = auditLogFromAuthAuditLog(log)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := json.Marshal(export)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling data export: %w", err)
+	}
+
+	return b, nil
+}
+
+func adminUserFromAuthUser(
+	user sql.AuthUser, roles []string, providers []string,
+) (api.AdminUser, error) {
+	metadata := map[string]any{}
+	if len(user.Metadata) > 0 {
+		if err := json.Unmarshal(user.Metadata, &metadata); err != nil {
+			return api.AdminUser{}, fmt.Errorf("error unmarshalling metadata: %w", err)
+		}
+	}
+
+	profile := api.AdminUser{
+		Id:                  user.ID,
+		CreatedAt:           user.CreatedAt.Time,
+		LastSeen:            nil,
+		Disabled:            user.Disabled,
+		DisplayName:         user.DisplayName,
+		AvatarUrl:           user.AvatarUrl,
+		Locale:              user.Locale,
+		Email:               pgtypeTextToOAPIEmail(user.Email),
+		EmailVerified:       user.EmailVerified,
+		PhoneNumber:         nil,
+		PhoneNumberVerified: user.PhoneNumberVerified,
+		DefaultRole:         user.DefaultRole,
+		Roles:               roles,
+		Providers:           providers,
+		IsAnonymous:         user.IsAnonymous,
+		Metadata:            metadata,
+		BannedAt:            nil,
+		BannedUntil:         nil,
+		BanReason:           nil,
+	}
+
+	if user.LastSeen.Valid {
+		profile.LastSeen = ptr(user.LastSeen.Time)
+	}
+	if user.PhoneNumber.Valid {
+		profile.PhoneNumber = ptr(user.PhoneNumber.String)
+	}
+	if user.BannedAt.Valid {
+		profile.BannedAt = ptr(user.BannedAt.Time)
+		profile.BanReason = ptr(user.BanReason.String)
+		if user.BannedUntil.Valid {
+			profile.BannedUntil = ptr(user.BannedUntil.Time)
+		}
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,122 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDataExporterProcess(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	exportID := uuid.MustParse("e8c4b5a2-3f1d-4c6e-9a7b-2d5f8e1c4b3a")
+
+	errDB := errors.New("db is down") //nolint:goerr113
+
+	cases := []struct {
+		name string
+		db   func(ctrl *gomock.Controller) controller.DataExportDB
+	}{
+		{
+			name: "success",
+			db: func(ctrl *gomock.Controller) controller.DataExportDB {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteExpiredDataExports(gomock.Any()).Return(int64(1), nil)
+				mock.EXPECT().ClaimDataExports(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthDataExport{getDataExport(exportID, userID, api.Pending)}, nil,
+				)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{{UserID: userID, Role: "user"}}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUserProviders(gomock.Any(), userID).Return(nil, nil)
+				mock.EXPECT().GetUserSessions(gomock.Any(), userID).Return(nil, nil)
+				mock.EXPECT().GetUserAuditLogs(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+				mock.EXPECT().CompleteDataExport(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg sql.CompleteDataExportParams) error {
+						if arg.ID != exportID {
+							t.Errorf("unexpected export completed: %s", arg.ID)
+						}
+
+						var export api.UserDataExport
+						if err := json.Unmarshal(arg.Data, &export); err != nil {
+							t.Fatalf("failed to unmarshal data export: %v", err)
+						}
+						if export.Profile.Id != userID {
+							t.Errorf("unexpected user exported: %s", export.Profile.Id)
+						}
+
+						expiresAt := time.Now().Add(7 * 24 * time.Hour)
+						if d := arg.ExpiresAt.Time.Sub(expiresAt).Abs(); d > time.Minute {
+							t.Errorf("unexpected expiration %v", arg.ExpiresAt.Time)
+						}
+
+						return nil
+					},
+				)
+
+				return mock
+			},
+		},
+
+		{
+			name: "attempt failed",
+			db: func(ctrl *gomock.Controller) controller.DataExportDB {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteExpiredDataExports(gomock.Any()).Return(int64(0), nil)
+				mock.EXPECT().ClaimDataExports(gomock.Any(), gomock.Any()).Return(
+					[]sql.AuthDataExport{getDataExport(exportID, userID, api.Pending)}, nil,
+				)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{}, errDB, //nolint:exhaustruct
+				)
+
+				mock.EXPECT().FailDataExportAttempt(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg sql.FailDataExportAttemptParams) error {
+						if arg.ID != exportID {
+							t.Errorf("unexpected export failed: %s", arg.ID)
+						}
+						if arg.MaxAttempts != 3 {
+							t.Errorf("unexpected max attempts: %d", arg.MaxAttempts)
+						}
+						if !arg.LastError.Valid || arg.LastError.String == "" {
+							t.Error("expected the error to be recorded")
+						}
+						return nil
+					},
+				)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			exporter := controller.NewDataExporter(tc.db(ctrl), 7*24*time.Hour, slog.Default())
+			if err := exporter.Process(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteAdminOauth2ClientsClientId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeleteAdminOauth2ClientsClientIdRequestObject,
+) (api.DeleteAdminOauth2ClientsClientIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("client_id", request.ClientId))
+
+	n, err := ctrl.wf.db.DeleteOAuth2Client(ctx, request.ClientId)
+	if err != nil {
+		logger.Error("error deleting oauth2 client", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if n == 0 {
+		logger.Warn("oauth2 client not found")
+		return ctrl.sendError(ErrClientNotFound), nil
+	}
+
+	logger.Info("oauth2 client deleted")
+
+	return api.DeleteAdminOauth2ClientsClientId200JSONResponse(api.OK), nil
+}
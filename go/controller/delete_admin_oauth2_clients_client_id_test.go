@@ -0,0 +1,101 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteAdminOauth2ClientsClientId(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	clientID := "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"
+
+	cases := []testRequest[
+		api.DeleteAdminOauth2ClientsClientIdRequestObject,
+		api.DeleteAdminOauth2ClientsClientIdResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteOAuth2Client(gomock.Any(), clientID).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminOauth2ClientsClientIdRequestObject{
+				ClientId: clientID,
+			},
+			expectedResponse: api.DeleteAdminOauth2ClientsClientId200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "client not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteOAuth2Client(gomock.Any(), clientID).Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminOauth2ClientsClientIdRequestObject{
+				ClientId: clientID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "client-not-found",
+				Message: "Client not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.DeleteAdminOauth2ClientsClientId,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteAdminRolesRole( //nolint:ireturn
+	ctx context.Context,
+	request api.DeleteAdminRolesRoleRequestObject,
+) (api.DeleteAdminRolesRoleResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.Role))
+
+	if apiErr := ctrl.wf.DeleteRole(ctx, request.Role, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.DeleteAdminRolesRole200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,148 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteAdminRolesRole(t *testing.T) {
+	t.Parallel()
+
+	cases := []testRequest[
+		api.DeleteAdminRolesRoleRequestObject,
+		api.DeleteAdminRolesRoleResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteRole(gomock.Any(), "editor").Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminRolesRoleRequestObject{
+				Role: "editor",
+			},
+			expectedResponse: api.DeleteAdminRolesRole200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "default role of a user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteRole(gomock.Any(), "editor").Return(int64(0), errors.New( //nolint:goerr113
+					`ERROR: update or delete on table "roles" violates foreign key constraint "fk_default_role" on table "users" (SQLSTATE 23503)`, //nolint:lll
+				))
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminRolesRoleRequestObject{
+				Role: "editor",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-in-use",
+				Message: "Role is in use",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "default allowed role",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminRolesRoleRequestObject{
+				Role: "me",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-in-use",
+				Message: "Role is in use",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "role not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteRole(gomock.Any(), "editor").Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminRolesRoleRequestObject{
+				Role: "editor",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-found",
+				Message: "Role not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.DeleteAdminRolesRole,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteAdminUsersUserIdBan( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeleteAdminUsersUserIdBanRequestObject,
+) (api.DeleteAdminUsersUserIdBanResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if apiErr := ctrl.wf.UnbanUser(ctx, request.UserId, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.DeleteAdminUsersUserIdBan200JSONResponse(api.OK), nil
+}
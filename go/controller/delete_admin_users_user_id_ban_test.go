@@ -0,0 +1,102 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteAdminUsersUserIdBan(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[
+		api.DeleteAdminUsersUserIdBanRequestObject,
+		api.DeleteAdminUsersUserIdBanResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UnbanUser(gomock.Any(), userID).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminUsersUserIdBanRequestObject{
+				UserId: userID,
+			},
+			expectedResponse: api.DeleteAdminUsersUserIdBan200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UnbanUser(gomock.Any(), userID).Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminUsersUserIdBanRequestObject{
+				UserId: userID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "user-not-found",
+				Message: "User not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.DeleteAdminUsersUserIdBan,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteAdminUsersUserIdRolesRole( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeleteAdminUsersUserIdRolesRoleRequestObject,
+) (api.DeleteAdminUsersUserIdRolesRoleResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if apiErr := ctrl.wf.RemoveUserRole(
+		ctx, request.UserId, request.Role, logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.DeleteAdminUsersUserIdRolesRole200JSONResponse(api.OK), nil
+}
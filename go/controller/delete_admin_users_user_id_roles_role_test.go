@@ -0,0 +1,139 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteAdminUsersUserIdRolesRole(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[
+		api.DeleteAdminUsersUserIdRolesRoleRequestObject,
+		api.DeleteAdminUsersUserIdRolesRoleResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUserRole(gomock.Any(), sql.DeleteUserRoleParams{
+					UserID: userID,
+					Role:   "me",
+				}).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminUsersUserIdRolesRoleRequestObject{
+				UserId: userID,
+				Role:   "me",
+			},
+			expectedResponse: api.DeleteAdminUsersUserIdRolesRole200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "default role",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminUsersUserIdRolesRoleRequestObject{
+				UserId: userID,
+				Role:   "user",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-in-use",
+				Message: "Role is in use",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteAdminUsersUserIdRolesRoleRequestObject{
+				UserId: userID,
+				Role:   "me",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "user-not-found",
+				Message: "User not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.DeleteAdminUsersUserIdRolesRole,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
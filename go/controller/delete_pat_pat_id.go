@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) DeletePatPatId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeletePatPatIdRequestObject,
+) (api.DeletePatPatIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("pat_id", request.PatId.String()))
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	n, err := ctrl.wf.db.DeleteUserPersonalAccessToken(
+		ctx, sql.DeleteUserPersonalAccessTokenParams{
+			ID:     request.PatId,
+			UserID: user.ID,
+		},
+	)
+	if err != nil {
+		logger.Error("error deleting personal access token", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if n == 0 {
+		logger.Warn("personal access token not found")
+		return ctrl.sendError(ErrPATNotFound), nil
+	}
+
+	return api.DeletePatPatId200JSONResponse(api.OK), nil
+}
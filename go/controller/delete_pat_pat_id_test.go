@@ -0,0 +1,117 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeletePatPatId(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	patID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+
+	cases := []testRequest[api.DeletePatPatIdRequestObject, api.DeletePatPatIdResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUserPersonalAccessToken(
+					gomock.Any(), sql.DeleteUserPersonalAccessTokenParams{
+						ID:     patID,
+						UserID: userID,
+					},
+				).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeletePatPatIdRequestObject{
+				PatId: patID,
+			},
+			expectedResponse: api.DeletePatPatId200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "pat not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUserPersonalAccessToken(
+					gomock.Any(), sql.DeleteUserPersonalAccessTokenParams{
+						ID:     patID,
+						UserID: userID,
+					},
+				).Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeletePatPatIdRequestObject{
+				PatId: patID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "pat-not-found",
+				Message: "Personal access token not found",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.DeletePatPatId, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteScimV2GroupsGroupId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeleteScimV2GroupsGroupIdRequestObject,
+) (api.DeleteScimV2GroupsGroupIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.GroupId))
+
+	if apiErr := ctrl.wf.DeleteRole(ctx, request.GroupId, logger); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.DeleteScimV2GroupsGroupId204Response{}, nil
+}
@@ -0,0 +1,113 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteScimV2GroupsGroupId(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	cases := []testRequest[api.DeleteScimV2GroupsGroupIdRequestObject, api.DeleteScimV2GroupsGroupIdResponseObject]{ //nolint:lll
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteRole(gomock.Any(), "editor").Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:          nil,
+			hibp:             nil,
+			customClaimer:    nil,
+			request:          api.DeleteScimV2GroupsGroupIdRequestObject{GroupId: "editor"},
+			expectedResponse: api.DeleteScimV2GroupsGroupId204Response{},
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "default role",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.DeleteScimV2GroupsGroupIdRequestObject{GroupId: "user"},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "409",
+				ScimType: nil,
+				Detail:   ptr("Role is in use"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteRole(gomock.Any(), "editor").Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.DeleteScimV2GroupsGroupIdRequestObject{GroupId: "editor"},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "404",
+				ScimType: nil,
+				Detail:   ptr("Role not found"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.DeleteScimV2GroupsGroupId,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
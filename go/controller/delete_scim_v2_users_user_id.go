@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteScimV2UsersUserId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeleteScimV2UsersUserIdRequestObject,
+) (api.DeleteScimV2UsersUserIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if apiErr := ctrl.wf.DeleteUser(ctx, request.UserId, logger); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.DeleteScimV2UsersUserId204Response{}, nil
+}
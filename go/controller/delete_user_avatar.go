@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteUserAvatar( //nolint:ireturn
+	ctx context.Context,
+	_ api.DeleteUserAvatarRequestObject,
+) (api.DeleteUserAvatarResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	avatarURL, apiErr := ctrl.wf.DeleteAvatar(ctx, user, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.DeleteUserAvatar200JSONResponse{AvatarUrl: avatarURL}, nil
+}
@@ -0,0 +1,138 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteUserAvatar(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	user := getSigninUser(userID)
+	user.AvatarUrl = testAvatarURL
+
+	gravatarURL := "https://www.gravatar.com/avatar/a6b55dc639dd4151e97efbc42ee1a28b?d=blank&r=g"
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		avatarStorage    func(ctrl *gomock.Controller) *mock.MockAvatarStorage
+		expectedResponse api.DeleteUserAvatarResponseObject
+	}{
+		{
+			name: "back to gravatar",
+			config: func() *controller.Config {
+				config := getAvatarConfig()
+				config.GravatarEnabled = true
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				mock.EXPECT().UpdateUserAvatarURL(
+					gomock.Any(),
+					sql.UpdateUserAvatarURLParams{
+						ID:        userID,
+						AvatarUrl: gravatarURL,
+					},
+				).Return(nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				mock := mock.NewMockAvatarStorage(ctrl)
+
+				mock.EXPECT().Delete(gomock.Any(), testAvatarURL).Return(nil)
+
+				return mock
+			},
+			expectedResponse: api.DeleteUserAvatar200JSONResponse{
+				AvatarUrl: gravatarURL,
+			},
+		},
+
+		{
+			name:   "no avatar provider",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				mock.EXPECT().UpdateUserAvatarURL(
+					gomock.Any(),
+					sql.UpdateUserAvatarURLParams{
+						ID:        userID,
+						AvatarUrl: "",
+					},
+				).Return(nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				mock := mock.NewMockAvatarStorage(ctrl)
+
+				mock.EXPECT().Delete(gomock.Any(), testAvatarURL).Return(
+					errors.New("access denied"), //nolint:goerr113
+				)
+
+				return mock
+			},
+			expectedResponse: api.DeleteUserAvatar200JSONResponse{
+				AvatarUrl: "",
+			},
+		},
+
+		{
+			name:   "uploads disabled",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				return mock
+			},
+			avatarStorage: nil,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{ //nolint:exhaustruct
+				avatarStorage: tc.avatarStorage,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), webauthnUserJWT(userID)())
+			assertRequest(
+				ctx,
+				t,
+				c.DeleteUserAvatar,
+				api.DeleteUserAvatarRequestObject{},
+				tc.expectedResponse,
+			)
+		})
+	}
+}
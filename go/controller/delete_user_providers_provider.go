@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) DeleteUserProvidersProvider( //nolint:ireturn
+	ctx context.Context,
+	request api.DeleteUserProvidersProviderRequestObject,
+) (api.DeleteUserProvidersProviderResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", request.Provider))
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if apiErr := ctrl.wf.UnlinkProvider(ctx, user, request.Provider, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.DeleteUserProvidersProvider200JSONResponse(api.OK), nil
+}
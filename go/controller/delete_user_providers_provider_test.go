@@ -0,0 +1,270 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteUserProvidersProvider(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	userProvider := func(providerID string) sql.AuthUserProvider {
+		return sql.AuthUserProvider{ //nolint:exhaustruct
+			ID:             uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c"),
+			UserID:         userID,
+			ProviderID:     providerID,
+			ProviderUserID: "1234567",
+		}
+	}
+
+	passwordlessUser := func() sql.AuthUser {
+		user := getSigninUser(userID)
+		user.PasswordHash = pgtype.Text{} //nolint:exhaustruct
+		return user
+	}
+
+	deleteUserProvider := func(mock *mock.MockDBClient) {
+		mock.EXPECT().DeleteUserProvider(
+			gomock.Any(),
+			sql.DeleteUserProviderParams{
+				UserID:     userID,
+				ProviderID: "github",
+			},
+		).Return(uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c"), nil)
+	}
+
+	cases := []testRequest[api.DeleteUserProvidersProviderRequestObject, api.DeleteUserProvidersProviderResponseObject]{ //nolint:lll
+		{
+			name:   "user with password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserProvider{userProvider("github")}, nil)
+
+				deleteUserProvider(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserProvidersProviderRequestObject{
+				Provider: "github",
+			},
+			expectedResponse: api.DeleteUserProvidersProvider200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "user with another provider",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(passwordlessUser(), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return(
+					[]sql.AuthUserProvider{userProvider("github"), userProvider("gitlab")}, nil,
+				)
+
+				deleteUserProvider(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserProvidersProviderRequestObject{
+				Provider: "github",
+			},
+			expectedResponse: api.DeleteUserProvidersProvider200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "user with security keys",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(passwordlessUser(), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserProvider{userProvider("github")}, nil)
+
+				mock.EXPECT().CountSecurityKeysUser(
+					gomock.Any(), userID,
+				).Return(int64(1), nil)
+
+				deleteUserProvider(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserProvidersProviderRequestObject{
+				Provider: "github",
+			},
+			expectedResponse: api.DeleteUserProvidersProvider200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name: "user with magic link",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.EmailPasswordlessEnabled = true
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(passwordlessUser(), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserProvider{userProvider("github")}, nil)
+
+				deleteUserProvider(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserProvidersProviderRequestObject{
+				Provider: "github",
+			},
+			expectedResponse: api.DeleteUserProvidersProvider200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "last sign in method",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(passwordlessUser(), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserProvider{userProvider("github")}, nil)
+
+				mock.EXPECT().CountSecurityKeysUser(
+					gomock.Any(), userID,
+				).Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserProvidersProviderRequestObject{
+				Provider: "github",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "last-sign-in-method",
+				Message: "Cannot remove the last sign in method of the user",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "provider not linked",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserProvider{userProvider("gitlab")}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserProvidersProviderRequestObject{
+				Provider: "github",
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "provider-not-linked",
+				Message: "Provider is not linked to the user",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.DeleteUserProvidersProvider, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) DeleteUserSessionsSessionId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.DeleteUserSessionsSessionIdRequestObject,
+) (api.DeleteUserSessionsSessionIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("session_id", request.SessionId.String()))
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	deleted, err := ctrl.wf.db.DeleteUserSession(ctx, sql.DeleteUserSessionParams{
+		ID:     request.SessionId,
+		UserID: user.ID,
+	})
+	if err != nil {
+		logger.Error("error deleting user session", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if len(deleted) == 0 {
+		logger.Warn("session not found")
+		return ctrl.sendError(ErrSessionNotFound), nil
+	}
+
+	return api.DeleteUserSessionsSessionId200JSONResponse(api.OK), nil
+}
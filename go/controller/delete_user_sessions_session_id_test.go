@@ -0,0 +1,123 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteUserSessionsSessionId(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	sessionID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+
+	cases := []testRequest[
+		api.DeleteUserSessionsSessionIdRequestObject, api.DeleteUserSessionsSessionIdResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUserSession(
+					gomock.Any(), sql.DeleteUserSessionParams{
+						ID:     sessionID,
+						UserID: userID,
+					},
+				).Return([]uuid.UUID{
+					sessionID, uuid.MustParse("5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"),
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserSessionsSessionIdRequestObject{
+				SessionId: sessionID,
+			},
+			expectedResponse: api.DeleteUserSessionsSessionId200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "session not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUserSession(
+					gomock.Any(), sql.DeleteUserSessionParams{
+						ID:     sessionID,
+						UserID: userID,
+					},
+				).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.DeleteUserSessionsSessionIdRequestObject{
+				SessionId: sessionID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "session-not-found",
+				Message: "Session not found",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.DeleteUserSessionsSessionId, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+// elevatedOperations are the operations that require the access token to carry the
+// x-hasura-auth-elevated claim on top of the security scheme declared in the
+// openapi spec. The value indicates if users without security keys can skip the check,
+// which is needed so they can register their first key.
+var elevatedOperations = map[string]bool{ //nolint:gochecknoglobals
+	"PostUserWebauthnAdd": true,
+}
+
+func (ctrl *Controller) verifyElevation( //nolint:cyclop
+	ctx context.Context,
+	bypassIfNoKeys bool,
+	logger *slog.Logger,
+) *APIError {
+	mode := ctrl.wf.jwtGetter.elevatedClaimMode
+	if mode == "disabled" || !ctrl.config.WebauthnEnabled {
+		return nil
+	}
+
+	jwtToken, ok := ctrl.wf.jwtGetter.FromContext(ctx)
+	if !ok {
+		logger.Error(
+			"jwt token not found in context, this should not be possilble due to middleware",
+		)
+		return ErrInternalServerError
+	}
+
+	sub, err := jwtToken.Claims.GetSubject()
+	if err != nil {
+		logger.Error("error getting user id from jwt token", logError(err))
+		return ErrInvalidRequest
+	}
+
+	if ctrl.wf.jwtGetter.GetCustomClaim(jwtToken, "x-hasura-auth-elevated") == sub {
+		return nil
+	}
+
+	if mode == "recommended" || bypassIfNoKeys {
+		userID, err := uuid.Parse(sub)
+		if err != nil {
+			logger.Error("error parsing user id from jwt token's subject", logError(err))
+			return ErrInvalidRequest
+		}
+
+		n, err := ctrl.wf.db.CountSecurityKeysUser(ctx, userID)
+		if err != nil {
+			logger.Error("error counting security keys", logError(err))
+			return ErrInternalServerError
+		}
+
+		if n == 0 {
+			return nil
+		}
+	}
+
+	logger.Warn("elevated claim required")
+	return ErrElevationRequired
+}
+
+// RequiresElevation is a strict middleware that rejects requests to the operations
+// listed in elevatedOperations unless the user recently elevated their session
+// with a security key.
+func (ctrl *Controller) RequiresElevation(
+	f api.StrictHandlerFunc,
+	operationID string,
+) api.StrictHandlerFunc {
+	bypassIfNoKeys, ok := elevatedOperations[operationID]
+	if !ok {
+		return f
+	}
+
+	return func(ctx *gin.Context, request any) (any, error) {
+		logger := middleware.LoggerFromContext(ctx)
+
+		if apiErr := ctrl.verifyElevation(ctx, bypassIfNoKeys, logger); apiErr != nil {
+			return ctrl.sendError(apiErr), nil
+		}
+
+		return f(ctx, request)
+	}
+}
@@ -0,0 +1,197 @@
+package controller_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func elevatedUserJWT(userID uuid.UUID) func() *jwt.Token {
+	return func() *jwt.Token {
+		token := webauthnUserJWT(userID)()
+		claims := token.Claims.(jwt.MapClaims)                                  //nolint:forcetypeassert
+		customClaims := claims["https://hasura.io/jwt/claims"].(map[string]any) //nolint:forcetypeassert
+		customClaims["x-hasura-auth-elevated"] = userID.String()
+		return token
+	}
+}
+
+func TestRequiresElevation(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	next := "next handler called"
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		jwtTokenFn       func() *jwt.Token
+		operationID      string
+		expectedResponse any
+	}{
+		{
+			name:   "operation doesn't require elevation",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			jwtTokenFn:       webauthnUserJWT(userID),
+			operationID:      "PostUserWebauthnVerify",
+			expectedResponse: next,
+		},
+
+		{
+			name:   "elevated",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			jwtTokenFn:       elevatedUserJWT(userID),
+			operationID:      "PostUserWebauthnAdd",
+			expectedResponse: next,
+		},
+
+		{
+			name:   "not elevated, user has no security keys",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountSecurityKeysUser(
+					gomock.Any(), userID,
+				).Return(int64(0), nil)
+
+				return mock
+			},
+			jwtTokenFn:       webauthnUserJWT(userID),
+			operationID:      "PostUserWebauthnAdd",
+			expectedResponse: next,
+		},
+
+		{
+			name:   "not elevated, user has security keys",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountSecurityKeysUser(
+					gomock.Any(), userID,
+				).Return(int64(1), nil)
+
+				return mock
+			},
+			jwtTokenFn:  webauthnUserJWT(userID),
+			operationID: "PostUserWebauthnAdd",
+			expectedResponse: controller.ErrorResponse{
+				Error:   "elevated-claim-required",
+				Message: "Elevated claim is required",
+				Status:  403,
+			},
+		},
+
+		{
+			name:   "elevated claim for another user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountSecurityKeysUser(
+					gomock.Any(), userID,
+				).Return(int64(1), nil)
+
+				return mock
+			},
+			jwtTokenFn: func() *jwt.Token {
+				token := elevatedUserJWT(uuid.MustParse("8e5b3c1a-4c2d-4d9e-9f0a-6b7c8d9e0f1a"))()
+				claims := token.Claims.(jwt.MapClaims) //nolint:forcetypeassert
+				claims["sub"] = userID.String()
+				return token
+			},
+			operationID: "PostUserWebauthnAdd",
+			expectedResponse: controller.ErrorResponse{
+				Error:   "elevated-claim-required",
+				Message: "Elevated claim is required",
+				Status:  403,
+			},
+		},
+
+		{
+			name: "webauthn disabled",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.WebauthnEnabled = false
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			jwtTokenFn:       webauthnUserJWT(userID),
+			operationID:      "PostUserWebauthnAdd",
+			expectedResponse: next,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ginCtx, engine := gin.CreateTestContext(httptest.NewRecorder())
+			engine.ContextWithFallback = true
+			ginCtx.Request = httptest.NewRequest(http.MethodPost, "/", nil).WithContext(
+				jwtGetter.ToContext(context.Background(), tc.jwtTokenFn()),
+			)
+
+			handler := c.RequiresElevation(
+				func(_ *gin.Context, _ any) (any, error) {
+					return next, nil
+				},
+				tc.operationID,
+			)
+
+			resp, err := handler(ginCtx, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedResponse, resp); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/nhost/hasura-auth/go/api"
@@ -13,36 +14,130 @@ import (
 
 type APIError struct {
 	t api.ErrorResponseError
+	// message replaces the default message of the error when set
+	message string
 }
 
 func (e *APIError) Error() string {
 	return fmt.Sprintf("API error: %s", e.t)
 }
 
-var ErrElevatedClaimRequired = errors.New("elevated-claim-required")
+var (
+	ErrElevatedClaimRequired = errors.New("elevated-claim-required")
+	ErrAccessTokenRevoked    = errors.New("access token was revoked")
+	ErrInvalidAdminSecret    = errors.New("invalid admin secret")
+	ErrInvalidClientSecret   = errors.New("invalid client id or secret")
+	ErrInvalidScimToken      = errors.New("invalid scim token")
+)
 
 var (
-	ErrUserEmailNotFound               = &APIError{api.InvalidEmailPassword}
-	ErrEmailAlreadyInUse               = &APIError{api.EmailAlreadyInUse}
-	ErrForbiddenAnonymous              = &APIError{api.ForbiddenAnonymous}
-	ErrInternalServerError             = &APIError{api.InternalServerError}
-	ErrInvalidEmailPassword            = &APIError{api.InvalidEmailPassword}
-	ErrPasswordTooShort                = &APIError{api.PasswordTooShort}
-	ErrPasswordInHibpDatabase          = &APIError{api.PasswordInHibpDatabase}
-	ErrRoleNotAllowed                  = &APIError{api.RoleNotAllowed}
-	ErrDefaultRoleMustBeInAllowedRoles = &APIError{api.DefaultRoleMustBeInAllowedRoles}
-	ErrRedirecToNotAllowed             = &APIError{api.RedirectToNotAllowed}
-	ErrDisabledUser                    = &APIError{api.DisabledUser}
-	ErrUnverifiedUser                  = &APIError{api.UnverifiedUser}
-	ErrUserNotAnonymous                = &APIError{api.UserNotAnonymous}
-	ErrInvalidPat                      = &APIError{api.InvalidPat}
-	ErrInvalidRequest                  = &APIError{api.InvalidRequest}
-	ErrSignupDisabled                  = &APIError{api.SignupDisabled}
-	ErrDisabledEndpoint                = &APIError{api.DisabledEndpoint}
-	ErrEmailAlreadyVerified            = &APIError{api.EmailAlreadyVerified}
-	ErrInvalidRefreshToken             = &APIError{api.InvalidRefreshToken}
+	ErrUserEmailNotFound               = &APIError{api.InvalidEmailPassword, ""}
+	ErrUserPhoneNumberNotFound         = &APIError{api.InvalidOtp, ""}
+	ErrEmailAlreadyInUse               = &APIError{api.EmailAlreadyInUse, ""}
+	ErrForbiddenAnonymous              = &APIError{api.ForbiddenAnonymous, ""}
+	ErrInternalServerError             = &APIError{api.InternalServerError, ""}
+	ErrInvalidEmailPassword            = &APIError{api.InvalidEmailPassword, ""}
+	ErrPasswordTooShort                = &APIError{api.PasswordTooShort, ""}
+	ErrPasswordInHibpDatabase          = &APIError{api.PasswordInHibpDatabase, ""}
+	ErrRoleNotAllowed                  = &APIError{api.RoleNotAllowed, ""}
+	ErrDefaultRoleMustBeInAllowedRoles = &APIError{api.DefaultRoleMustBeInAllowedRoles, ""}
+	ErrRedirecToNotAllowed             = &APIError{api.RedirectToNotAllowed, ""}
+	ErrDisabledUser                    = &APIError{api.DisabledUser, ""}
+	ErrUnverifiedUser                  = &APIError{api.UnverifiedUser, ""}
+	ErrUserNotAnonymous                = &APIError{api.UserNotAnonymous, ""}
+	ErrInvalidPat                      = &APIError{api.InvalidPat, ""}
+	ErrInvalidRequest                  = &APIError{api.InvalidRequest, ""}
+	ErrSignupDisabled                  = &APIError{api.SignupDisabled, ""}
+	ErrDisabledEndpoint                = &APIError{api.DisabledEndpoint, ""}
+	ErrEmailAlreadyVerified            = &APIError{api.EmailAlreadyVerified, ""}
+	ErrInvalidRefreshToken             = &APIError{api.InvalidRefreshToken, ""}
+	ErrInvalidTicket                   = &APIError{api.InvalidTicket, ""}
+	ErrInvalidOTP                      = &APIError{api.InvalidOtp, ""}
+	ErrCannotSendSMS                   = &APIError{api.CannotSendSms, ""}
+	ErrInvalidWebauthnSecurityKey      = &APIError{api.InvalidWebauthnSecurityKey, ""}
+	ErrDisabledMfaTotp                 = &APIError{api.DisabledMfaTotp, ""}
+	ErrNoTotpSecret                    = &APIError{api.NoTotpSecret, ""}
+	ErrTotpAlreadyActive               = &APIError{api.TotpAlreadyActive, ""}
+	ErrMfaTypeNotFound                 = &APIError{api.MfaTypeNotFound, ""}
+	ErrElevationRequired               = &APIError{api.ElevatedClaimRequired, ""}
+	ErrInvalidState                    = &APIError{api.InvalidState, ""}
+	ErrOauthProviderError              = &APIError{api.OauthProviderError, ""}
+	ErrInvalidSAMLResponse             = &APIError{api.InvalidSamlResponse, ""}
+	ErrProviderAlreadyLinked           = &APIError{api.ProviderAlreadyLinked, ""}
+	ErrProviderNotLinked               = &APIError{api.ProviderNotLinked, ""}
+	ErrLastSignInMethod                = &APIError{api.LastSignInMethod, ""}
+	ErrInvalidIDToken                  = &APIError{api.InvalidIdToken, ""}
+	ErrAuthorizationPending            = &APIError{api.AuthorizationPending, ""}
+	ErrSlowDown                        = &APIError{api.SlowDown, ""}
+	ErrExpiredToken                    = &APIError{api.ExpiredToken, ""}
+	ErrAccessDenied                    = &APIError{api.AccessDenied, ""}
+	ErrInvalidUserCode                 = &APIError{api.InvalidUserCode, ""}
+	ErrSessionNotFound                 = &APIError{api.SessionNotFound, ""}
+	ErrUserNotFound                    = &APIError{api.UserNotFound, ""}
+	ErrPATNotFound                     = &APIError{api.PatNotFound, ""}
+	ErrInvalidClient                   = &APIError{api.InvalidClient, ""}
+	ErrClientNotFound                  = &APIError{api.ClientNotFound, ""}
+	ErrUnauthorizedClient              = &APIError{api.UnauthorizedClient, ""}
+	ErrInvalidSubjectToken             = &APIError{api.InvalidSubjectToken, ""}
+	ErrEmailNotFound                   = &APIError{api.EmailNotFound, ""}
+	ErrPhoneNumberAlreadyInUse         = &APIError{api.PhoneNumberAlreadyInUse, ""}
+	ErrPasswordTooLong                 = &APIError{api.PasswordTooLong, ""}
+	ErrPasswordTooWeak                 = &APIError{api.PasswordTooWeak, ""}
+	ErrPasswordMissingLowercase        = &APIError{api.PasswordMissingLowercase, ""}
+	ErrPasswordMissingUppercase        = &APIError{api.PasswordMissingUppercase, ""}
+	ErrPasswordMissingDigit            = &APIError{api.PasswordMissingDigit, ""}
+	ErrPasswordMissingSymbol           = &APIError{api.PasswordMissingSymbol, ""}
+	ErrPasswordInDenylist              = &APIError{api.PasswordInDenylist, ""}
+	ErrPasswordContainsEmail           = &APIError{api.PasswordContainsEmail, ""}
+	ErrTooManyRequests                 = &APIError{api.TooManyRequests, ""}
+	ErrSignInLocked                    = &APIError{api.SignInLocked, ""}
+	ErrInvalidCaptcha                  = &APIError{api.InvalidCaptcha, ""}
+	ErrIPNotAllowed                    = &APIError{api.IpNotAllowed, ""}
+	ErrSignupRejected                  = &APIError{api.SignupRejected, ""}
+	ErrRoleNotFound                    = &APIError{api.RoleNotFound, ""}
+	ErrRoleAlreadyExists               = &APIError{api.RoleAlreadyExists, ""}
+	ErrRoleInUse                       = &APIError{api.RoleInUse, ""}
+	ErrDataExportNotFound              = &APIError{api.DataExportNotFound, ""}
+	ErrInvalidGrant                    = &APIError{api.InvalidGrant, ""}
+	ErrInvalidAccessToken              = &APIError{api.InvalidAccessToken, ""}
+	ErrForbiddenRole                   = &APIError{api.ForbiddenRole, ""}
+	ErrOrganizationNotFound            = &APIError{api.OrganizationNotFound, ""}
+	ErrForbiddenOrganizationRole       = &APIError{api.ForbiddenOrganizationRole, ""}
+	ErrInvalidMetadata                 = &APIError{api.InvalidMetadata, ""}
+	ErrDisposableEmail                 = &APIError{api.DisposableEmail, ""}
+	ErrUndeliverableEmail              = &APIError{api.UndeliverableEmail, ""}
+	ErrInvalidAvatar                   = &APIError{api.InvalidAvatar, ""}
+	ErrAvatarTooLarge                  = &APIError{api.AvatarTooLarge, ""}
+	ErrSessionLimitReached             = &APIError{api.SessionLimitReached, ""}
+	ErrImpossibleTravel                = &APIError{api.ImpossibleTravel, ""}
+	ErrRiskySignIn                     = &APIError{api.RiskySignIn, ""}
+	ErrDisabledSignInMethod            = &APIError{api.DisabledSignInMethod, ""}
 )
 
+// ErrRiskySignInWithoutMFA is ErrRiskySignIn for the sign ins that would have been allowed
+// with multi-factor authentication.
+var ErrRiskySignInWithoutMFA = &APIError{
+	api.RiskySignIn, "Sign in denied, it looks too risky without multi-factor authentication",
+}
+
+// invalidConfigurationError is the error sent when the configuration couldn't be
+// reloaded, with the reason.
+func invalidConfigurationError(err error) *APIError {
+	return &APIError{api.InvalidConfiguration, "The configuration is invalid: " + err.Error()}
+}
+
+// signupRejectedError is ErrSignupRejected with the message returned by the pre sign up
+// hook, if any.
+func signupRejectedError(message string) *APIError {
+	return &APIError{api.SignupRejected, message}
+}
+
+// invalidMetadataError is ErrInvalidMetadata with the reason the metadata doesn't match the
+// schema.
+func invalidMetadataError(reason string) *APIError {
+	return &APIError{api.InvalidMetadata, reason}
+}
+
 func logError(err error) slog.Attr {
 	return slog.String("error", err.Error())
 }
@@ -63,16 +158,52 @@ func (response ErrorResponse) VisitPostSigninEmailPasswordResponse(w http.Respon
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitPostSigninIdtokenResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninAnonymousResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninMfaRecoveryCodeResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninMfaTotpResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitPostSigninPasswordlessEmailResponse(
 	w http.ResponseWriter,
 ) error {
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitPostSigninPasswordlessSmsResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninPasswordlessSmsOtpResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitPostSigninPatResponse(w http.ResponseWriter) error {
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitPostSigninWebauthnResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninWebauthnVerifyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitPostUserEmailChangeResponse(w http.ResponseWriter) error {
 	return response.visit(w)
 }
@@ -95,6 +226,20 @@ func (response ErrorResponse) VisitPostUserDeanonymizeResponse(w http.ResponseWr
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitPostUserDeanonymizeProviderProviderResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserWebauthnAddResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserWebauthnVerifyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitPostSignupWebauthnResponse(w http.ResponseWriter) error {
 	return response.visit(w)
 }
@@ -103,10 +248,346 @@ func (response ErrorResponse) VisitPostSignupWebauthnVerifyResponse(w http.Respo
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitGetMfaTotpGenerateResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostMfaTotpEnableResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostMfaRecoveryCodesResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
 func (response ErrorResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
 	return response.visit(w)
 }
 
+func (response ErrorResponse) VisitGetVerifyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostElevateResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostElevateWebauthnResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostDeviceCodeResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostDeviceTokenResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostDeviceVerifyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostElevateWebauthnVerifyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetSigninProviderProviderResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetSigninProviderProviderCallbackResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninProviderProviderCallbackResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetSigninSamlResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetSigninSamlMetadataResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostSigninSamlAcsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetUserProvidersResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteUserProvidersProviderResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserProvidersProviderLinkResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetUserSessionsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteUserSessionsSessionIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserSessionsRevokeAllResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdSessionsRevokeAllResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdUnlockResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdImpersonateResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdPasswordResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdPasswordResetResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdDisableResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdEnableResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdBanResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteAdminUsersUserIdBanResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdRolesResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteAdminUsersUserIdRolesRoleResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOauthIntrospectResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetPatResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeletePatPatIdResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOauthTokenResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetOauthAuthorizeResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOauthAuthorizeResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetOauthUserinfoResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetWellKnownOpenidConfigurationResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetForwardAuthVerifyResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOrganizationsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOrganizationsInvitesAcceptResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOrganizationsSwitchResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPatchUserMetadataResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPutUserAvatarResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteUserAvatarResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostOrganizationsOrganizationIdInvitesResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminOauth2ClientsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteAdminOauth2ClientsClientIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetAdminEmailsFailedResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetAdminAuditLogsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersImportResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetAdminRolesResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminRolesResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitDeleteAdminRolesRoleResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserDeleteResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserDataExportResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetUserDataExportExportIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetDataExportDownloadResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminUsersUserIdDataExportResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetAdminDataExportsExportIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminEmailsEmailIdRetryResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostAdminConfigReloadResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitGetAdminSignInMethodsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPutAdminSignInMethodsMethodResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserPhoneNumberChangeResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ErrorResponse) VisitPostUserPhoneNumberChangeVerifyResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
 func isSensitive(err api.ErrorResponseError) bool {
 	switch err {
 	case
@@ -115,22 +596,84 @@ func isSensitive(err api.ErrorResponseError) bool {
 		api.EmailAlreadyVerified,
 		api.ForbiddenAnonymous,
 		api.InvalidEmailPassword,
+		api.InvalidIdToken,
 		api.InvalidPat,
+		api.PhoneNumberAlreadyInUse,
 		api.RoleNotAllowed,
+		api.SignInLocked,
 		api.SignupDisabled,
+		api.SignupRejected,
 		api.UnverifiedUser,
 		api.InvalidRefreshToken:
 		return true
 	case
+		api.AccessDenied,
+		api.AuthorizationPending,
+		api.CannotSendSms,
+		api.ClientNotFound,
+		api.DataExportNotFound,
 		api.DefaultRoleMustBeInAllowedRoles,
 		api.DisabledEndpoint,
+		api.DisabledMfaTotp,
+		api.ElevatedClaimRequired,
+		api.EmailNotFound,
+		api.ExpiredToken,
 		api.InternalServerError,
+		api.InvalidCaptcha,
+		api.InvalidClient,
+		api.InvalidGrant,
+		api.InvalidAccessToken,
+		api.ForbiddenRole,
+		api.OrganizationNotFound,
+		api.ForbiddenOrganizationRole,
+		api.InvalidMetadata,
+		api.DisposableEmail,
+		api.UndeliverableEmail,
+		api.InvalidAvatar,
+		api.AvatarTooLarge,
+		api.SessionLimitReached,
+		api.ImpossibleTravel,
+		api.RiskySignIn,
+		api.InvalidConfiguration,
+		api.DisabledSignInMethod,
+		api.InvalidOtp,
 		api.InvalidRequest,
+		api.InvalidSamlResponse,
+		api.InvalidSubjectToken,
+		api.InvalidState,
+		api.InvalidTicket,
+		api.InvalidUserCode,
+		api.InvalidWebauthnSecurityKey,
+		api.IpNotAllowed,
+		api.LastSignInMethod,
 		api.LocaleNotAllowed,
-		api.PasswordTooShort,
+		api.MfaTypeNotFound,
+		api.NoTotpSecret,
+		api.OauthProviderError,
+		api.PasswordContainsEmail,
+		api.PasswordInDenylist,
 		api.PasswordInHibpDatabase,
+		api.PasswordMissingDigit,
+		api.PasswordMissingLowercase,
+		api.PasswordMissingSymbol,
+		api.PasswordMissingUppercase,
+		api.PasswordTooLong,
+		api.PasswordTooShort,
+		api.PasswordTooWeak,
+		api.PatNotFound,
+		api.ProviderAlreadyLinked,
+		api.ProviderNotLinked,
 		api.RedirectToNotAllowed,
-		api.UserNotAnonymous:
+		api.RoleAlreadyExists,
+		api.RoleInUse,
+		api.RoleNotFound,
+		api.SessionNotFound,
+		api.SlowDown,
+		api.TooManyRequests,
+		api.TotpAlreadyActive,
+		api.UnauthorizedClient,
+		api.UserNotAnonymous,
+		api.UserNotFound:
 		return false
 	}
 	return false
@@ -150,6 +693,12 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 	}
 
 	switch err.t {
+	case api.CannotSendSms:
+		return ErrorResponse{
+			Status:  http.StatusInternalServerError,
+			Error:   err.t,
+			Message: "Error sending SMS",
+		}
 	case api.DefaultRoleMustBeInAllowedRoles:
 		return ErrorResponse{
 			Status:  http.StatusBadRequest,
@@ -168,6 +717,18 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 			Error:   err.t,
 			Message: "This endpoint is disabled",
 		}
+	case api.DisabledMfaTotp:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "MFA TOTP is not enabled for this user",
+		}
+	case api.ElevatedClaimRequired:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Elevated claim is required",
+		}
 	case api.EmailAlreadyInUse:
 		return ErrorResponse{
 			Status:  http.StatusConflict,
@@ -204,6 +765,12 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 			Error:   err.t,
 			Message: "Invalid or expired personal access token",
 		}
+	case api.InvalidOtp:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Invalid or expired OTP",
+		}
 	case api.InvalidRequest:
 	case api.LocaleNotAllowed:
 		return ErrorResponse{
@@ -211,6 +778,24 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 			Error:   err.t,
 			Message: "Locale not allowed",
 		}
+	case api.MfaTypeNotFound:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "There is no active MFA set for the user",
+		}
+	case api.NoTotpSecret:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "OTP secret is not set for user",
+		}
+	case api.OauthProviderError:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Error communicating with the OAuth provider",
+		}
 	case api.PasswordInHibpDatabase:
 		return ErrorResponse{
 			Status:  http.StatusBadRequest,
@@ -223,6 +808,92 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 			Error:   err.t,
 			Message: "Password is too short",
 		}
+	case api.PasswordTooLong:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password is too long",
+		}
+	case api.PasswordTooWeak:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password is too weak",
+		}
+	case api.PasswordMissingLowercase:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password must contain a lowercase letter",
+		}
+	case api.PasswordMissingUppercase:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password must contain an uppercase letter",
+		}
+	case api.PasswordMissingDigit:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password must contain a digit",
+		}
+	case api.PasswordMissingSymbol:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password must contain a symbol",
+		}
+	case api.PasswordInDenylist:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password contains a forbidden term",
+		}
+	case api.PasswordContainsEmail:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Password must not contain the email",
+		}
+	case api.IpNotAllowed:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Requests from this IP address are not allowed",
+		}
+	case api.InvalidCaptcha:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid or missing captcha token",
+		}
+	case api.SignupRejected:
+		message := "Sign up was rejected"
+		if err.message != "" {
+			message = err.message
+		}
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: message,
+		}
+	case api.SignInLocked:
+		return ErrorResponse{
+			Status:  http.StatusTooManyRequests,
+			Error:   err.t,
+			Message: "Too many failed sign in attempts, try again later",
+		}
+	case api.TooManyRequests:
+		message := "Too many requests, try again later"
+		if err.message != "" {
+			message = err.message
+		}
+		return ErrorResponse{
+			Status:  http.StatusTooManyRequests,
+			Error:   err.t,
+			Message: message,
+		}
 	case api.RedirectToNotAllowed:
 		return ErrorResponse{
 			Status:  http.StatusBadRequest,
@@ -241,6 +912,12 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 			Error:   err.t,
 			Message: "Sign up is disabled.",
 		}
+	case api.TotpAlreadyActive:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "TOTP MFA already active",
+		}
 	case api.UnverifiedUser:
 		return ErrorResponse{
 			Status:  http.StatusUnauthorized,
@@ -253,21 +930,301 @@ func (ctrl *Controller) sendError( //nolint:funlen,cyclop
 			Error:   err.t,
 			Message: "Logged in user is not anonymous",
 		}
+	case api.InvalidIdToken:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Invalid or expired ID token",
+		}
 	case api.InvalidRefreshToken:
 		return ErrorResponse{
 			Status:  http.StatusUnauthorized,
 			Error:   err.t,
 			Message: "Invalid or expired refresh token",
 		}
+	case api.InvalidSamlResponse:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid SAML response",
+		}
+	case api.InvalidState:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid or expired OAuth state",
+		}
+	case api.InvalidTicket:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Invalid or expired verification ticket",
+		}
+	case api.InvalidWebauthnSecurityKey:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Invalid WebAuthn security key",
+		}
+	case api.ProviderAlreadyLinked:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Provider account already linked",
+		}
+	case api.ProviderNotLinked:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Provider is not linked to the user",
+		}
+	case api.LastSignInMethod:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Cannot remove the last sign in method of the user",
+		}
+	case api.AuthorizationPending:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "The user hasn't approved the device yet",
+		}
+	case api.SlowDown:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "The device is polling too frequently, slow down",
+		}
+	case api.ExpiredToken:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid or expired device code",
+		}
+	case api.AccessDenied:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "The user denied the device",
+		}
+	case api.InvalidUserCode:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid or expired user code",
+		}
+	case api.SessionNotFound:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Session not found",
+		}
+	case api.PatNotFound:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Personal access token not found",
+		}
+	case api.InvalidClient:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Invalid client credentials",
+		}
+	case api.ClientNotFound:
+		return ErrorResponse{
+			Status:  http.StatusNotFound,
+			Error:   err.t,
+			Message: "Client not found",
+		}
+	case api.UnauthorizedClient:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Client is not allowed to use this grant type",
+		}
+	case api.InvalidSubjectToken:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid or expired subject token",
+		}
+	case api.EmailNotFound:
+		return ErrorResponse{
+			Status:  http.StatusNotFound,
+			Error:   err.t,
+			Message: "Email not found",
+		}
+	case api.PhoneNumberAlreadyInUse:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Phone number already in use",
+		}
+	case api.UserNotFound:
+		return ErrorResponse{
+			Status:  http.StatusNotFound,
+			Error:   err.t,
+			Message: "User not found",
+		}
+	case api.RoleNotFound:
+		return ErrorResponse{
+			Status:  http.StatusNotFound,
+			Error:   err.t,
+			Message: "Role not found",
+		}
+	case api.RoleAlreadyExists:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Role already exists",
+		}
+	case api.RoleInUse:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "Role is in use",
+		}
+	case api.DataExportNotFound:
+		return ErrorResponse{
+			Status:  http.StatusNotFound,
+			Error:   err.t,
+			Message: "Data export not found",
+		}
+	case api.InvalidGrant:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Invalid, expired or already used authorization code",
+		}
+	case api.InvalidAccessToken:
+		return ErrorResponse{
+			Status:  http.StatusUnauthorized,
+			Error:   err.t,
+			Message: "Missing, invalid or expired access token",
+		}
+	case api.ForbiddenRole:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "The user doesn't have the required role",
+		}
+	case api.OrganizationNotFound:
+		return ErrorResponse{
+			Status:  http.StatusNotFound,
+			Error:   err.t,
+			Message: "Organization not found",
+		}
+	case api.ForbiddenOrganizationRole:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "The user's role in the organization doesn't allow this operation",
+		}
+	case api.DisposableEmail:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "Email addresses of disposable email providers aren't allowed",
+		}
+	case api.UndeliverableEmail:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "The domain of the email can't receive emails",
+		}
+	case api.InvalidAvatar:
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: "The avatar must be a PNG, JPEG or GIF image",
+		}
+	case api.AvatarTooLarge:
+		return ErrorResponse{
+			Status:  http.StatusRequestEntityTooLarge,
+			Error:   err.t,
+			Message: "The avatar is too large",
+		}
+	case api.SessionLimitReached:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Too many active sessions, sign out of one of them first",
+		}
+	case api.ImpossibleTravel:
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: "Sign in denied, it comes from too far away from where you last signed in",
+		}
+	case api.RiskySignIn:
+		message := "Sign in denied, it looks too risky"
+		if err.message != "" {
+			message = err.message
+		}
+		return ErrorResponse{
+			Status:  http.StatusForbidden,
+			Error:   err.t,
+			Message: message,
+		}
+	case api.InvalidMetadata:
+		message := "The metadata doesn't match the schema"
+		if err.message != "" {
+			message = err.message
+		}
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: message,
+		}
+	case api.InvalidConfiguration:
+		message := "The configuration is invalid"
+		if err.message != "" {
+			message = err.message
+		}
+		return ErrorResponse{
+			Status:  http.StatusBadRequest,
+			Error:   err.t,
+			Message: message,
+		}
+	case api.DisabledSignInMethod:
+		return ErrorResponse{
+			Status:  http.StatusConflict,
+			Error:   err.t,
+			Message: "This sign in method is disabled",
+		}
 	}
 
 	return invalidRequest
 }
 
+// sessionAPIError returns the error to send when a session couldn't be created, the
+// *APIError it failed with, i.e. ErrSessionLimitReached or ErrImpossibleTravel, or an
+// internal server error.
+func sessionAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return ErrInternalServerError
+}
+
 func (ctrl *Controller) respondWithError(err *APIError) ErrorResponse {
 	return ctrl.sendError(err)
 }
 
+func (ctrl *Controller) sendRedirectError(redirectTo *url.URL, err *APIError) string {
+	errResponse := ctrl.sendError(err)
+
+	query := redirectTo.Query()
+	query.Set("error", string(errResponse.Error))
+	query.Set("errorDescription", errResponse.Message)
+	redirectTo.RawQuery = query.Encode()
+
+	return redirectTo.String()
+}
+
 func sqlErrIsDuplicatedEmail(err error, logger *slog.Logger) *APIError {
 	if err == nil {
 		return nil
@@ -280,5 +1237,16 @@ func sqlErrIsDuplicatedEmail(err error, logger *slog.Logger) *APIError {
 	}
 
 	logger.Error("error inserting user", logError(err))
-	return &APIError{api.InternalServerError}
+	return &APIError{api.InternalServerError, ""}
+}
+
+func sqlErrIsDuplicatedPhoneNumber(err error, logger *slog.Logger) *APIError {
+	if strings.Contains(err.Error(), "SQLSTATE 23505") &&
+		strings.Contains(err.Error(), "\"users_phone_number_key\"") {
+		logger.Error("phone number already in use", logError(err))
+		return ErrPhoneNumberAlreadyInUse
+	}
+
+	logger.Error("error updating user", logError(err))
+	return ErrInternalServerError
 }
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const defaultAuditLogsLimit = 20
+
+func auditLogFromAuthAuditLog(log sql.AuthAuditLog) (api.AuditLog, error) {
+	var metadata map[string]any
+	if err := json.Unmarshal(log.Metadata, &metadata); err != nil {
+		return api.AuditLog{}, fmt.Errorf("error unmarshalling metadata: %w", err)
+	}
+
+	var userID *uuid.UUID
+	if log.UserID.Valid {
+		userID = ptr(uuid.UUID(log.UserID.Bytes))
+	}
+
+	return api.AuditLog{
+		Id:        log.ID,
+		CreatedAt: log.CreatedAt.Time,
+		Event:     log.Event,
+		Outcome:   api.AuditLogOutcome(log.Outcome),
+		Actor:     api.AuditLogActor(log.Actor),
+		UserId:    userID,
+		IpAddress: log.IpAddress.String,
+		UserAgent: log.UserAgent.String,
+		Metadata:  metadata,
+	}, nil
+}
+
+func (ctrl *Controller) GetAdminAuditLogs( //nolint:ireturn
+	ctx context.Context,
+	request api.GetAdminAuditLogsRequestObject,
+) (api.GetAdminAuditLogsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	limit := defaultAuditLogsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	offset := 0
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	var userID pgtype.UUID
+	if request.Params.UserId != nil {
+		userID = pgtype.UUID{Bytes: *request.Params.UserId, Valid: true}
+	}
+
+	var event pgtype.Text
+	if request.Params.Event != nil {
+		event = sql.Text(*request.Params.Event)
+	}
+
+	total, err := ctrl.wf.db.CountAuditLogs(ctx, sql.CountAuditLogsParams{
+		UserID: userID,
+		Event:  event,
+	})
+	if err != nil {
+		logger.Error("error counting audit logs", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	logs, err := ctrl.wf.db.GetAuditLogs(ctx, sql.GetAuditLogsParams{
+		UserID:    userID,
+		Event:     event,
+		RowOffset: int32(offset), //nolint:gosec
+		RowLimit:  int32(limit),  //nolint:gosec
+	})
+	if err != nil {
+		logger.Error("error getting audit logs", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp := api.AuditLogsResponse{
+		Total: int(total),
+		Logs:  make([]api.AuditLog, len(logs)),
+	}
+	for i, log := range logs {
+		resp.Logs[i], err = auditLogFromAuthAuditLog(log)
+		if err != nil {
+			logger.Error("error converting audit log", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+	}
+
+	return api.GetAdminAuditLogs200JSONResponse(resp), nil
+}
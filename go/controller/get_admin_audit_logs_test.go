@@ -0,0 +1,165 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdminAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	logID := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []testRequest[
+		api.GetAdminAuditLogsRequestObject,
+		api.GetAdminAuditLogsResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountAuditLogs(gomock.Any(), sql.CountAuditLogsParams{
+					UserID: pgtype.UUID{}, //nolint:exhaustruct
+					Event:  pgtype.Text{}, //nolint:exhaustruct
+				}).Return(int64(1), nil)
+
+				mock.EXPECT().GetAuditLogs(gomock.Any(), sql.GetAuditLogsParams{
+					UserID:    pgtype.UUID{}, //nolint:exhaustruct
+					Event:     pgtype.Text{}, //nolint:exhaustruct
+					RowOffset: 0,
+					RowLimit:  20,
+				}).Return(
+					[]sql.AuthAuditLog{
+						{
+							ID:        logID,
+							CreatedAt: sql.TimestampTz(createdAt),
+							Event:     "sign-in",
+							Outcome:   "failure",
+							Actor:     "user",
+							UserID:    pgtype.UUID{}, //nolint:exhaustruct
+							IpAddress: sql.Text("192.168.1.1"),
+							UserAgent: sql.Text("test"),
+							Metadata:  []byte(`{"error":"invalid-email-password"}`),
+						},
+					}, nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminAuditLogsRequestObject{
+				Params: api.GetAdminAuditLogsParams{
+					Limit:  nil,
+					Offset: nil,
+					UserId: nil,
+					Event:  nil,
+				},
+			},
+			expectedResponse: api.GetAdminAuditLogs200JSONResponse{
+				Total: 1,
+				Logs: []api.AuditLog{
+					{
+						Id:        logID,
+						CreatedAt: createdAt,
+						Event:     "sign-in",
+						Outcome:   api.Failure,
+						Actor:     api.AuditLogActorUser,
+						UserId:    nil,
+						IpAddress: "192.168.1.1",
+						UserAgent: "test",
+						Metadata:  map[string]any{"error": "invalid-email-password"},
+					},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "filtered",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountAuditLogs(gomock.Any(), sql.CountAuditLogsParams{
+					UserID: pgtype.UUID{Bytes: userID, Valid: true},
+					Event:  sql.Text("sign-in"),
+				}).Return(int64(12), nil)
+
+				mock.EXPECT().GetAuditLogs(gomock.Any(), sql.GetAuditLogsParams{
+					UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+					Event:     sql.Text("sign-in"),
+					RowOffset: 10,
+					RowLimit:  5,
+				}).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminAuditLogsRequestObject{
+				Params: api.GetAdminAuditLogsParams{
+					Limit:  ptr(5),
+					Offset: ptr(10),
+					UserId: ptr(userID),
+					Event:  ptr("sign-in"),
+				},
+			},
+			expectedResponse: api.GetAdminAuditLogs200JSONResponse{
+				Total: 12,
+				Logs:  []api.AuditLog{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetAdminAuditLogs,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetAdminDataExportsExportId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.GetAdminDataExportsExportIdRequestObject,
+) (api.GetAdminDataExportsExportIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	export, apiErr := ctrl.wf.GetDataExport(ctx, request.ExportId, nil, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	resp, apiErr := ctrl.wf.DataExportResponse(export, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.GetAdminDataExportsExportId200JSONResponse(resp), nil
+}
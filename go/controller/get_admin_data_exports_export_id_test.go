@@ -0,0 +1,112 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdminDataExportsExportId(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	exportID := uuid.MustParse("e8c4b5a2-3f1d-4c6e-9a7b-2d5f8e1c4b3a")
+
+	cases := []testRequest[
+		api.GetAdminDataExportsExportIdRequestObject,
+		api.GetAdminDataExportsExportIdResponseObject,
+	]{
+		{
+			name:   "completed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					getDataExport(exportID, userID, api.Completed), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminDataExportsExportIdRequestObject{
+				ExportId: exportID,
+			},
+			expectedResponse: api.GetAdminDataExportsExportId200JSONResponse(
+				getAPIDataExport(exportID, userID, api.Completed),
+			),
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					sql.AuthDataExport{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminDataExportsExportIdRequestObject{
+				ExportId: exportID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "data-export-not-found",
+				Message: "Data export not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetAdminDataExportsExportId,
+				tc.request, tc.expectedResponse,
+				cmpDataExport(),
+			)
+		})
+	}
+}
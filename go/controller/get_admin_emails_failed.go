@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const defaultFailedEmailsLimit = 20
+
+func outboxEmailFromEmailOutbox(email sql.AuthEmailOutbox) (api.OutboxEmail, error) {
+	var headers map[string]string
+	if err := json.Unmarshal(email.Headers, &headers); err != nil {
+		return api.OutboxEmail{}, fmt.Errorf("error unmarshalling headers: %w", err)
+	}
+
+	return api.OutboxEmail{
+		Id:        email.ID,
+		CreatedAt: email.CreatedAt.Time,
+		Recipient: email.Recipient,
+		Subject:   email.Subject,
+		Template:  headers["X-Email-Template"],
+		Attempts:  int(email.Attempts),
+		LastError: email.LastError.String,
+	}, nil
+}
+
+func (ctrl *Controller) GetAdminEmailsFailed( //nolint:ireturn
+	ctx context.Context,
+	request api.GetAdminEmailsFailedRequestObject,
+) (api.GetAdminEmailsFailedResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	limit := defaultFailedEmailsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	counts, err := ctrl.wf.db.CountEmailOutbox(ctx)
+	if err != nil {
+		logger.Error("error counting emails in outbox", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	emails, err := ctrl.wf.db.GetFailedEmailOutbox(ctx, int32(limit)) //nolint:gosec
+	if err != nil {
+		logger.Error("error getting failed emails", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp := api.OutboxFailedEmailsResponse{
+		Pending: 0,
+		Failed:  0,
+		Emails:  make([]api.OutboxEmail, len(emails)),
+	}
+	for _, c := range counts {
+		switch c.Status {
+		case "pending":
+			resp.Pending = int(c.Count)
+		case "failed":
+			resp.Failed = int(c.Count)
+		}
+	}
+	for i, email := range emails {
+		resp.Emails[i], err = outboxEmailFromEmailOutbox(email)
+		if err != nil {
+			logger.Error("error converting failed email", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+	}
+
+	return api.GetAdminEmailsFailed200JSONResponse(resp), nil
+}
@@ -0,0 +1,143 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdminEmailsFailed(t *testing.T) {
+	t.Parallel()
+
+	emailID := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []testRequest[
+		api.GetAdminEmailsFailedRequestObject,
+		api.GetAdminEmailsFailedResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountEmailOutbox(gomock.Any()).Return(
+					[]sql.CountEmailOutboxRow{
+						{Status: "pending", Count: 3},
+						{Status: "failed", Count: 1},
+					}, nil,
+				)
+
+				mock.EXPECT().GetFailedEmailOutbox(gomock.Any(), int32(20)).Return(
+					[]sql.AuthEmailOutbox{
+						{
+							ID:            emailID,
+							CreatedAt:     sql.TimestampTz(createdAt),
+							Recipient:     "jane@acme.com",
+							Subject:       "Verify your email",
+							Body:          "body",
+							Headers:       []byte(`{"X-Email-Template":"email-verify"}`),
+							Status:        "failed",
+							Attempts:      5,
+							NextAttemptAt: sql.TimestampTz(createdAt),
+							LastError:     sql.Text("email permanent failure: invalid recipient"),
+						},
+					}, nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminEmailsFailedRequestObject{
+				Params: api.GetAdminEmailsFailedParams{Limit: nil},
+			},
+			expectedResponse: api.GetAdminEmailsFailed200JSONResponse{
+				Pending: 3,
+				Failed:  1,
+				Emails: []api.OutboxEmail{
+					{
+						Id:        emailID,
+						CreatedAt: createdAt,
+						Recipient: "jane@acme.com",
+						Subject:   "Verify your email",
+						Template:  "email-verify",
+						Attempts:  5,
+						LastError: "email permanent failure: invalid recipient",
+					},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "empty outbox",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountEmailOutbox(gomock.Any()).Return(nil, nil)
+
+				mock.EXPECT().GetFailedEmailOutbox(gomock.Any(), int32(5)).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminEmailsFailedRequestObject{
+				Params: api.GetAdminEmailsFailedParams{Limit: ptr(5)},
+			},
+			expectedResponse: api.GetAdminEmailsFailed200JSONResponse{
+				Pending: 0,
+				Failed:  0,
+				Emails:  []api.OutboxEmail{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetAdminEmailsFailed,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
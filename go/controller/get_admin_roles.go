@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetAdminRoles( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetAdminRolesRequestObject,
+) (api.GetAdminRolesResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	roles, err := ctrl.wf.db.GetRoles(ctx)
+	if err != nil {
+		logger.Error("error getting roles", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp := api.AdminRolesResponse{
+		Roles: make([]api.AdminRole, len(roles)),
+	}
+	for i, role := range roles {
+		resp.Roles[i] = api.AdminRole{
+			Role:  role.Role,
+			Users: int(role.Users),
+		}
+	}
+
+	return api.GetAdminRoles200JSONResponse(resp), nil
+}
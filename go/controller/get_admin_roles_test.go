@@ -0,0 +1,83 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdminRoles(t *testing.T) {
+	t.Parallel()
+
+	cases := []testRequest[
+		api.GetAdminRolesRequestObject,
+		api.GetAdminRolesResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRoles(gomock.Any()).Return([]sql.GetRolesRow{
+					{Role: "editor", Users: 0},
+					{Role: "me", Users: 3},
+					{Role: "user", Users: 3},
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetAdminRolesRequestObject{},
+			expectedResponse: api.GetAdminRoles200JSONResponse{
+				Roles: []api.AdminRole{
+					{Role: "editor", Users: 0},
+					{Role: "me", Users: 3},
+					{Role: "user", Users: 3},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetAdminRoles,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"slices"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+// adminSignInMethod returns the method as enabled if it is configured and its flag, if
+// any, enables it.
+func adminSignInMethod(method string, configured bool, flags map[string]bool) api.AdminSignInMethod {
+	enabled, ok := flags[method]
+	return api.AdminSignInMethod{
+		Method:     method,
+		Configured: configured,
+		Enabled:    configured && (!ok || enabled),
+	}
+}
+
+func (ctrl *Controller) GetAdminSignInMethods( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetAdminSignInMethodsRequestObject,
+) (api.GetAdminSignInMethodsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	var flags map[string]bool
+	if ctrl.signInMethods != nil {
+		var err error
+		flags, err = ctrl.signInMethods.Flags(ctx)
+		if err != nil {
+			logger.Error("error getting sign in method flags", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+	}
+
+	configured := ctrl.configuredSignInMethods()
+	methods := make([]string, 0, len(configured))
+	for method := range configured {
+		methods = append(methods, method)
+	}
+	slices.Sort(methods)
+
+	resp := api.AdminSignInMethodsResponse{
+		Methods: make([]api.AdminSignInMethod, len(methods)),
+	}
+	for i, method := range methods {
+		resp.Methods[i] = adminSignInMethod(method, configured[method], flags)
+	}
+
+	return api.GetAdminSignInMethods200JSONResponse(resp), nil
+}
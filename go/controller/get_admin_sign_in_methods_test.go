@@ -0,0 +1,102 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdminSignInMethods(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		signInMethods    func(ctrl *gomock.Controller) *controller.SignInMethodFlags
+		expectedResponse api.GetAdminSignInMethodsResponseObject
+	}{
+		{
+			name: "with flags",
+			signInMethods: func(ctrl *gomock.Controller) *controller.SignInMethodFlags {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetSignInMethods(gomock.Any()).Return(
+					[]sql.AuthSignInMethod{
+						{Method: "anonymous", Enabled: true}, //nolint:exhaustruct
+						{Method: "webauthn", Enabled: false}, //nolint:exhaustruct
+					},
+					nil,
+				)
+				return controller.NewSignInMethodFlags(mock, time.Minute)
+			},
+			expectedResponse: api.GetAdminSignInMethods200JSONResponse{
+				Methods: []api.AdminSignInMethod{
+					{Method: "anonymous", Configured: false, Enabled: false},
+					{Method: "email-password", Configured: true, Enabled: true},
+					{Method: "passwordless-email", Configured: false, Enabled: false},
+					{Method: "passwordless-sms", Configured: false, Enabled: false},
+					{Method: "webauthn", Configured: true, Enabled: false},
+				},
+			},
+		},
+
+		{
+			name:          "without flags",
+			signInMethods: func(*gomock.Controller) *controller.SignInMethodFlags { return nil },
+			expectedResponse: api.GetAdminSignInMethods200JSONResponse{
+				Methods: []api.AdminSignInMethod{
+					{Method: "anonymous", Configured: false, Enabled: false},
+					{Method: "email-password", Configured: true, Enabled: true},
+					{Method: "passwordless-email", Configured: false, Enabled: false},
+					{Method: "passwordless-sms", Configured: false, Enabled: false},
+					{Method: "webauthn", Configured: true, Enabled: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				getConfig,
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    tc.signInMethods(ctrl),
+				},
+			)
+
+			assertRequest(
+				context.Background(), t, c.GetAdminSignInMethods,
+				api.GetAdminSignInMethodsRequestObject{}, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	defaultAdminUsersLimit = 20
+	// maxAdminUsersLimit is the maximum of the limit parameter, enforced by the OpenAPI
+	// schema for HTTP requests.
+	maxAdminUsersLimit = 100
+)
+
+// adminUsersSortColumns are the values of the sort_by parameter of the GetUsers query.
+var adminUsersSortColumns = map[api.AdminUsersSortBy]string{ //nolint:gochecknoglobals
+	api.CreatedAt:   "created_at",
+	api.Email:       "email",
+	api.DisplayName: "display_name",
+}
+
+// adminUsersCursor is the position of the last user of a page. It is sent to the client
+// base64 encoded and is only valid for the sorting it was created with.
+type adminUsersCursor struct {
+	SortBy api.AdminUsersSortBy `json:"s"`
+	Order  api.SortOrder        `json:"o"`
+	Value  string               `json:"v"`
+	ID     uuid.UUID            `json:"id"`
+}
+
+func (c adminUsersCursor) encode() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeAdminUsersCursor(s string) (adminUsersCursor, error) {
+	var c adminUsersCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("error decoding cursor: %w", err)
+	}
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("error unmarshalling cursor: %w", err)
+	}
+
+	return c, nil
+}
+
+// adminUsersEmailPattern converts the email filter to an ILIKE pattern where `*` matches
+// any characters and everything else is matched literally.
+func adminUsersEmailPattern(email string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`%`, `\%`,
+		`_`, `\_`,
+		`*`, `%`,
+	).Replace(email)
+}
+
+func adminUsersCursorValue(sortBy api.AdminUsersSortBy, user sql.GetUsersRow) string {
+	switch sortBy {
+	case api.Email:
+		return user.Email.String
+	case api.DisplayName:
+		return user.DisplayName
+	case api.CreatedAt:
+	}
+	return user.CreatedAt.Time.Format(time.RFC3339Nano)
+}
+
+func adminUserFromGetUsersRow(user sql.GetUsersRow) (api.AdminUser, error) { //nolint:cyclop
+	metadata := map[string]any{}
+	if len(user.Metadata) > 0 {
+		if err := json.Unmarshal(user.Metadata, &metadata); err != nil {
+			return api.AdminUser{}, fmt.Errorf("error unmarshalling metadata: %w", err)
+		}
+	}
+
+	var lastSeen *time.Time
+	if user.LastSeen.Valid {
+		lastSeen = ptr(user.LastSeen.Time)
+	}
+
+	var phoneNumber *string
+	if user.PhoneNumber.Valid {
+		phoneNumber = ptr(user.PhoneNumber.String)
+	}
+
+	var bannedAt, bannedUntil *time.Time
+	var banReason *string
+	if user.BannedAt.Valid {
+		bannedAt = ptr(user.BannedAt.Time)
+		banReason = ptr(user.BanReason.String)
+		if user.BannedUntil.Valid {
+			bannedUntil = ptr(user.BannedUntil.Time)
+		}
+	}
+
+	return api.AdminUser{
+		Id:                  user.ID,
+		CreatedAt:           user.CreatedAt.Time,
+		LastSeen:            lastSeen,
+		Disabled:            user.Disabled,
+		DisplayName:         user.DisplayName,
+		AvatarUrl:           user.AvatarUrl,
+		Locale:              user.Locale,
+		Email:               pgtypeTextToOAPIEmail(user.Email),
+		EmailVerified:       user.EmailVerified,
+		PhoneNumber:         phoneNumber,
+		PhoneNumberVerified: user.PhoneNumberVerified,
+		DefaultRole:         user.DefaultRole,
+		Roles:               user.Roles,
+		Providers:           user.Providers,
+		IsAnonymous:         user.IsAnonymous,
+		Metadata:            metadata,
+		BannedAt:            bannedAt,
+		BannedUntil:         bannedUntil,
+		BanReason:           banReason,
+	}, nil
+}
+
+func getUsersParams( //nolint:cyclop
+	params api.GetAdminUsersParams,
+) (sql.GetUsersParams, api.AdminUsersSortBy, api.SortOrder, error) {
+	sortBy := api.CreatedAt
+	if params.SortBy != nil {
+		sortBy = *params.SortBy
+	}
+
+	order := api.Desc
+	if params.Order != nil {
+		order = *params.Order
+	}
+
+	limit := defaultAdminUsersLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	arg := sql.GetUsersParams{ //nolint:exhaustruct
+		SortBy:     adminUsersSortColumns[sortBy],
+		Descending: order == api.Desc,
+		RowLimit:   int32(limit) + 1, //nolint:gosec
+	}
+
+	if params.Email != nil {
+		arg.Email = sql.Text(adminUsersEmailPattern(*params.Email))
+	}
+	if params.Role != nil {
+		arg.Role = sql.Text(*params.Role)
+	}
+	if params.Provider != nil {
+		arg.Provider = sql.Text(*params.Provider)
+	}
+	if params.CreatedAfter != nil {
+		arg.CreatedAfter = sql.TimestampTz(*params.CreatedAfter)
+	}
+	if params.CreatedBefore != nil {
+		arg.CreatedBefore = sql.TimestampTz(*params.CreatedBefore)
+	}
+	if params.Disabled != nil {
+		arg.Disabled = pgtype.Bool{Bool: *params.Disabled, Valid: true}
+	}
+	if params.EmailVerified != nil {
+		arg.EmailVerified = pgtype.Bool{Bool: *params.EmailVerified, Valid: true}
+	}
+
+	if params.Cursor == nil {
+		return arg, sortBy, order, nil
+	}
+
+	cursor, err := decodeAdminUsersCursor(*params.Cursor)
+	if err != nil {
+		return arg, sortBy, order, err
+	}
+	if cursor.SortBy != sortBy || cursor.Order != order {
+		return arg, sortBy, order, fmt.Errorf( //nolint:goerr113
+			"cursor was created for a different sorting",
+		)
+	}
+
+	arg.AfterID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+	if sortBy == api.CreatedAt {
+		afterCreatedAt, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return arg, sortBy, order, fmt.Errorf("error parsing cursor: %w", err)
+		}
+		arg.AfterCreatedAt = sql.TimestampTz(afterCreatedAt)
+	} else {
+		arg.AfterText = sql.Text(cursor.Value)
+	}
+
+	return arg, sortBy, order, nil
+}
+
+func (ctrl *Controller) GetAdminUsers( //nolint:ireturn
+	ctx context.Context,
+	request api.GetAdminUsersRequestObject,
+) (api.GetAdminUsersResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	arg, sortBy, order, err := getUsersParams(request.Params)
+	if err != nil {
+		logger.Warn("invalid cursor", logError(err))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	users, err := ctrl.wf.db.GetUsers(ctx, arg)
+	if err != nil {
+		logger.Error("error getting users", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	resp := api.AdminUsersResponse{
+		NextCursor: nil,
+		Users:      make([]api.AdminUser, 0, len(users)),
+	}
+
+	if len(users) == int(arg.RowLimit) {
+		users = users[:len(users)-1]
+		last := users[len(users)-1]
+
+		cursor, err := adminUsersCursor{
+			SortBy: sortBy,
+			Order:  order,
+			Value:  adminUsersCursorValue(sortBy, last),
+			ID:     last.ID,
+		}.encode()
+		if err != nil {
+			logger.Error("error encoding cursor", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+		resp.NextCursor = &cursor
+	}
+
+	for _, user := range users {
+		u, err := adminUserFromGetUsersRow(user)
+		if err != nil {
+			logger.Error("error converting user", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+		resp.Users = append(resp.Users, u)
+	}
+
+	return api.GetAdminUsers200JSONResponse(resp), nil
+}
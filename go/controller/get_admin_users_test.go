@@ -0,0 +1,314 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func getAdminUsersRow(id uuid.UUID, email string, createdAt time.Time) sql.GetUsersRow {
+	return sql.GetUsersRow{
+		ID:                  id,
+		CreatedAt:           sql.TimestampTz(createdAt),
+		LastSeen:            pgtype.Timestamptz{}, //nolint:exhaustruct
+		Disabled:            false,
+		DisplayName:         "Jane",
+		AvatarUrl:           "",
+		Locale:              "en",
+		Email:               sql.Text(email),
+		EmailVerified:       true,
+		PhoneNumber:         pgtype.Text{}, //nolint:exhaustruct
+		PhoneNumberVerified: false,
+		DefaultRole:         "user",
+		IsAnonymous:         false,
+		Metadata:            []byte(`{"plan":"pro"}`),
+		BannedAt:            pgtype.Timestamptz{}, //nolint:exhaustruct
+		BannedUntil:         pgtype.Timestamptz{}, //nolint:exhaustruct
+		BanReason:           pgtype.Text{},        //nolint:exhaustruct
+		Roles:               []string{"me", "user"},
+		Providers:           []string{"github"},
+	}
+}
+
+func getAdminUser(id uuid.UUID, email string, createdAt time.Time) api.AdminUser {
+	return api.AdminUser{
+		Id:                  id,
+		CreatedAt:           createdAt,
+		LastSeen:            nil,
+		Disabled:            false,
+		DisplayName:         "Jane",
+		AvatarUrl:           "",
+		Locale:              "en",
+		Email:               ptr(types.Email(email)),
+		EmailVerified:       true,
+		PhoneNumber:         nil,
+		PhoneNumberVerified: false,
+		DefaultRole:         "user",
+		Roles:               []string{"me", "user"},
+		Providers:           []string{"github"},
+		IsAnonymous:         false,
+		Metadata:            map[string]any{"plan": "pro"},
+		BannedAt:            nil,
+		BannedUntil:         nil,
+		BanReason:           nil,
+	}
+}
+
+func TestGetAdminUsers(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID1 := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+	userID2 := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	userID3 := uuid.MustParse("ff7c6e35-5db1-4d5a-a8a5-ecbe5b4e2b43")
+	createdAt1 := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	createdAt2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	createdAt3 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []testRequest[api.GetAdminUsersRequestObject, api.GetAdminUsersResponseObject]{
+		{
+			name:   "last page",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUsers(gomock.Any(), sql.GetUsersParams{ //nolint:exhaustruct
+					SortBy:     "created_at",
+					Descending: true,
+					RowLimit:   21,
+				}).Return([]sql.GetUsersRow{
+					getAdminUsersRow(userID1, "john@acme.com", createdAt1),
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminUsersRequestObject{
+				Params: api.GetAdminUsersParams{}, //nolint:exhaustruct
+			},
+			expectedResponse: api.GetAdminUsers200JSONResponse{
+				NextCursor: nil,
+				Users: []api.AdminUser{
+					getAdminUser(userID1, "john@acme.com", createdAt1),
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "first page",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUsers(gomock.Any(), sql.GetUsersParams{ //nolint:exhaustruct
+					SortBy:     "created_at",
+					Descending: true,
+					RowLimit:   3,
+				}).Return([]sql.GetUsersRow{
+					getAdminUsersRow(userID1, "john@acme.com", createdAt1),
+					getAdminUsersRow(userID2, "jane@acme.com", createdAt2),
+					getAdminUsersRow(userID3, "jim@acme.com", createdAt3),
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminUsersRequestObject{
+				Params: api.GetAdminUsersParams{ //nolint:exhaustruct
+					Limit: ptr(2),
+				},
+			},
+			expectedResponse: api.GetAdminUsers200JSONResponse{
+				NextCursor: ptr(
+					"eyJzIjoiY3JlYXRlZEF0IiwibyI6ImRlc2MiLCJ2IjoiMjAyNC0wMS0wMlQwMDowMDowMFoiLCJpZCI6ImRiNDc3NzMyLTQ4ZmEtNDI4OS1iNjk0LTI4ODZhNjQ2YjZlYiJ9", //nolint:lll
+				),
+				Users: []api.AdminUser{
+					getAdminUser(userID1, "john@acme.com", createdAt1),
+					getAdminUser(userID2, "jane@acme.com", createdAt2),
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "next page",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUsers(gomock.Any(), sql.GetUsersParams{ //nolint:exhaustruct
+					SortBy:         "created_at",
+					Descending:     true,
+					AfterID:        pgtype.UUID{Bytes: userID2, Valid: true},
+					AfterCreatedAt: sql.TimestampTz(createdAt2),
+					RowLimit:       3,
+				}).Return([]sql.GetUsersRow{
+					getAdminUsersRow(userID3, "jim@acme.com", createdAt3),
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminUsersRequestObject{
+				Params: api.GetAdminUsersParams{ //nolint:exhaustruct
+					Limit:  ptr(2),
+					Cursor: ptr("eyJzIjoiY3JlYXRlZEF0IiwibyI6ImRlc2MiLCJ2IjoiMjAyNC0wMS0wMlQwMDowMDowMFoiLCJpZCI6ImRiNDc3NzMyLTQ4ZmEtNDI4OS1iNjk0LTI4ODZhNjQ2YjZlYiJ9"), //nolint:lll
+				},
+			},
+			expectedResponse: api.GetAdminUsers200JSONResponse{
+				NextCursor: nil,
+				Users: []api.AdminUser{
+					getAdminUser(userID3, "jim@acme.com", createdAt3),
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "filtered and sorted by email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUsers(gomock.Any(), sql.GetUsersParams{
+					Email:          sql.Text(`j\_%@acme.com`),
+					Role:           sql.Text("editor"),
+					Provider:       sql.Text("github"),
+					CreatedAfter:   sql.TimestampTz(createdAt3),
+					CreatedBefore:  sql.TimestampTz(createdAt1),
+					Disabled:       pgtype.Bool{Bool: false, Valid: true},
+					EmailVerified:  pgtype.Bool{Bool: true, Valid: true},
+					AfterID:        pgtype.UUID{Bytes: userID2, Valid: true},
+					SortBy:         "email",
+					Descending:     false,
+					AfterCreatedAt: pgtype.Timestamptz{}, //nolint:exhaustruct
+					AfterText:      sql.Text("jane@acme.com"),
+					RowLimit:       21,
+				}).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminUsersRequestObject{
+				Params: api.GetAdminUsersParams{
+					Limit:         nil,
+					Cursor:        ptr("eyJzIjoiZW1haWwiLCJvIjoiYXNjIiwidiI6ImphbmVAYWNtZS5jb20iLCJpZCI6ImRiNDc3NzMyLTQ4ZmEtNDI4OS1iNjk0LTI4ODZhNjQ2YjZlYiJ9"), //nolint:lll
+					Email:         ptr("j_*@acme.com"),
+					Role:          ptr("editor"),
+					Provider:      ptr("github"),
+					CreatedAfter:  ptr(createdAt3),
+					CreatedBefore: ptr(createdAt1),
+					Disabled:      ptr(false),
+					EmailVerified: ptr(true),
+					SortBy:        ptr(api.Email),
+					Order:         ptr(api.Asc),
+				},
+			},
+			expectedResponse: api.GetAdminUsers200JSONResponse{
+				NextCursor: nil,
+				Users:      []api.AdminUser{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "cursor of a different sorting",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminUsersRequestObject{
+				Params: api.GetAdminUsersParams{ //nolint:exhaustruct
+					Cursor: ptr("eyJzIjoiZW1haWwiLCJvIjoiYXNjIiwidiI6ImphbmVAYWNtZS5jb20iLCJpZCI6ImRiNDc3NzMyLTQ4ZmEtNDI4OS1iNjk0LTI4ODZhNjQ2YjZlYiJ9"), //nolint:lll
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "invalid cursor",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetAdminUsersRequestObject{
+				Params: api.GetAdminUsersParams{ //nolint:exhaustruct
+					Cursor: ptr("not a cursor"),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetAdminUsers,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetDataExportDownload( //nolint:ireturn
+	ctx context.Context,
+	request api.GetDataExportDownloadRequestObject,
+) (api.GetDataExportDownloadResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	exportID, err := ctrl.wf.jwtGetter.ValidateDataExportTicket(request.Params.Ticket)
+	if err != nil {
+		logger.Warn("invalid data export ticket", logError(err))
+		return ctrl.sendError(ErrInvalidTicket), nil
+	}
+	logger = logger.With(slog.String("export_id", exportID.String()))
+
+	export, apiErr := ctrl.wf.GetDataExport(ctx, exportID, nil, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if export.Status != string(api.Completed) {
+		logger.Warn("data export isn't completed")
+		return ctrl.sendError(ErrDataExportNotFound), nil
+	}
+
+	var data api.UserDataExport
+	if err := json.Unmarshal(export.Data, &data); err != nil {
+		logger.Error("error unmarshalling data export", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	return api.GetDataExportDownload200JSONResponse{
+		Body: data,
+		Headers: api.GetDataExportDownload200ResponseHeaders{
+			ContentDisposition: `attachment; filename="data-export-` + exportID.String() + `.json"`,
+		},
+	}, nil
+}
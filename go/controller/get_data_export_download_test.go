@@ -0,0 +1,236 @@
+package controller_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDataExportDownload(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	exportID := uuid.MustParse("e8c4b5a2-3f1d-4c6e-9a7b-2d5f8e1c4b3a")
+
+	jwtGetter, err := controller.NewJWTGetter(
+		jwtSecret, nil, 900*time.Second, nil, "", false, nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create jwt getter: %v", err)
+	}
+
+	ticket, err := jwtGetter.GetDataExportTicket(exportID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("failed to get ticket: %v", err)
+	}
+
+	expiredTicket, err := jwtGetter.GetDataExportTicket(exportID, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("failed to get ticket: %v", err)
+	}
+
+	accessToken, _, err := jwtGetter.GetToken(
+		context.Background(), userID, false, []string{"user"}, "user", nil, slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("failed to get access token: %v", err)
+	}
+
+	cases := []testRequest[
+		api.GetDataExportDownloadRequestObject,
+		api.GetDataExportDownloadResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					getDataExport(exportID, userID, api.Completed), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetDataExportDownloadRequestObject{
+				Params: api.GetDataExportDownloadParams{Ticket: ticket},
+			},
+			expectedResponse: api.GetDataExportDownload200JSONResponse{
+				Body: api.UserDataExport{
+					ExportedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+					Profile: api.AdminUser{
+						Id:                  userID,
+						CreatedAt:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+						LastSeen:            nil,
+						Disabled:            false,
+						DisplayName:         "Jane Doe",
+						AvatarUrl:           "",
+						Locale:              "en",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						PhoneNumber:         nil,
+						PhoneNumberVerified: false,
+						DefaultRole:         "user",
+						Roles:               []string{"user"},
+						Providers:           []string{},
+						IsAnonymous:         false,
+						Metadata:            map[string]any{},
+						BannedAt:            nil,
+						BannedUntil:         nil,
+						BanReason:           nil,
+					},
+					Providers: []api.UserProvider{},
+					Sessions:  []api.UserSession{},
+					AuditLogs: []api.AuditLog{},
+				},
+				Headers: api.GetDataExportDownload200ResponseHeaders{
+					ContentDisposition: `attachment; filename="data-export-e8c4b5a2-3f1d-4c6e-9a7b-2d5f8e1c4b3a.json"`, //nolint:lll
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "not completed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					getDataExport(exportID, userID, api.Failed), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetDataExportDownloadRequestObject{
+				Params: api.GetDataExportDownloadParams{Ticket: ticket},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "data-export-not-found",
+				Message: "Data export not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "export expired",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					sql.AuthDataExport{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetDataExportDownloadRequestObject{
+				Params: api.GetDataExportDownloadParams{Ticket: ticket},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "data-export-not-found",
+				Message: "Data export not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "expired ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetDataExportDownloadRequestObject{
+				Params: api.GetDataExportDownloadParams{Ticket: expiredTicket},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid or expired verification ticket",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "access token as ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetDataExportDownloadRequestObject{
+				Params: api.GetDataExportDownloadParams{Ticket: accessToken},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid or expired verification ticket",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetDataExportDownload,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+// forwardAuthAccessToken returns the access token of the request the proxy is verifying,
+// from the Authorization header or, if it isn't set, from the configured cookie.
+func (ctrl *Controller) forwardAuthAccessToken(params api.GetForwardAuthVerifyParams) string {
+	if params.Authorization != nil {
+		token, _ := strings.CutPrefix(*params.Authorization, "Bearer ")
+		return token
+	}
+
+	if ctrl.config.ForwardAuthCookieName == "" || params.Cookie == nil {
+		return ""
+	}
+
+	// reuse the cookie parser of net/http, the header comes from the proxied request
+	r := &http.Request{Header: http.Header{"Cookie": {*params.Cookie}}} //nolint:exhaustruct
+	cookie, err := r.Cookie(ctrl.config.ForwardAuthCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+func (ctrl *Controller) GetForwardAuthVerify( //nolint:ireturn
+	ctx context.Context,
+	request api.GetForwardAuthVerifyRequestObject,
+) (api.GetForwardAuthVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.ForwardAuthEnabled {
+		logger.Warn("forward auth is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	accessToken := ctrl.forwardAuthAccessToken(request.Params)
+	if accessToken == "" {
+		logger.Warn("missing access token")
+		return ctrl.sendError(ErrInvalidAccessToken), nil
+	}
+
+	token, err := ctrl.wf.jwtGetter.ValidateAccessToken(ctx, accessToken)
+	if err != nil {
+		logger.Warn("invalid access token", logError(err))
+		return ctrl.sendError(ErrInvalidAccessToken), nil
+	}
+
+	// tokens issued to clients with the client credentials grant don't identify a user
+	userID, err := ctrl.wf.jwtGetter.GetUserID(token)
+	if err != nil {
+		logger.Warn("access token doesn't belong to a user", logError(err))
+		return ctrl.sendError(ErrInvalidAccessToken), nil
+	}
+	logger = logger.With(slog.String("user_id", userID.String()))
+
+	allowedRoles, role := ctrl.subjectTokenRoles(token)
+	if request.Params.Role != nil {
+		if !slices.Contains(allowedRoles, *request.Params.Role) {
+			logger.Warn(
+				"user doesn't have the required role", slog.String("role", *request.Params.Role),
+			)
+			return ctrl.sendError(ErrForbiddenRole), nil
+		}
+		role = *request.Params.Role
+	}
+
+	return api.GetForwardAuthVerify200Response{
+		Headers: api.GetForwardAuthVerify200ResponseHeaders{
+			XHasuraUserId:          userID.String(),
+			XHasuraRole:            role,
+			XHasuraAllowedRoles:    strings.Join(allowedRoles, ","),
+			XHasuraUserIsAnonymous: strconv.FormatBool(ctrl.wf.jwtGetter.IsAnonymous(token)),
+		},
+	}, nil
+}
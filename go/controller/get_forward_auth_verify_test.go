@@ -0,0 +1,266 @@
+package controller_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetForwardAuthVerify(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	jwtGetter, err := controller.NewJWTGetter(jwtSecret, nil, 15*time.Minute, nil, "", false, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt getter: %v", err)
+	}
+	accessToken, _, err := jwtGetter.GetToken(
+		context.Background(), userID, false, []string{"user", "me"}, "user", nil,
+		slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("failed to get access token: %v", err)
+	}
+	clientToken, _, err := jwtGetter.GetClientToken("my-client", []string{"service"}, "service")
+	if err != nil {
+		t.Fatalf("failed to get client token: %v", err)
+	}
+
+	config := func() *controller.Config {
+		cfg := getConfig()
+		cfg.ForwardAuthEnabled = true
+		cfg.ForwardAuthCookieName = "accessToken"
+		return cfg
+	}
+	configWithoutCookie := func() *controller.Config {
+		cfg := getConfig()
+		cfg.ForwardAuthEnabled = true
+		return cfg
+	}
+	db := func(ctrl *gomock.Controller) controller.DBClient {
+		return mock.NewMockDBClient(ctrl)
+	}
+	success := api.GetForwardAuthVerify200Response{
+		Headers: api.GetForwardAuthVerify200ResponseHeaders{
+			XHasuraUserId:          userID.String(),
+			XHasuraRole:            "user",
+			XHasuraAllowedRoles:    "user,me",
+			XHasuraUserIsAnonymous: "false",
+		},
+	}
+	invalidAccessToken := controller.ErrorResponse{
+		Error:   "invalid-access-token",
+		Message: "Missing, invalid or expired access token",
+		Status:  401,
+	}
+
+	cases := []testRequest[api.GetForwardAuthVerifyRequestObject, api.GetForwardAuthVerifyResponseObject]{ //nolint:lll
+		{
+			name:          "bearer token",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: ptr("Bearer " + accessToken),
+					Cookie:        nil,
+				},
+			},
+			expectedResponse: success,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:          "cookie",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: nil,
+					Cookie:        ptr("theme=dark; accessToken=" + accessToken),
+				},
+			},
+			expectedResponse: success,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:          "cookie not configured",
+			config:        configWithoutCookie,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: nil,
+					Cookie:        ptr("accessToken=" + accessToken),
+				},
+			},
+			expectedResponse: invalidAccessToken,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:          "required role",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          ptr("me"),
+					Authorization: ptr("Bearer " + accessToken),
+					Cookie:        nil,
+				},
+			},
+			expectedResponse: api.GetForwardAuthVerify200Response{
+				Headers: api.GetForwardAuthVerify200ResponseHeaders{
+					XHasuraUserId:          userID.String(),
+					XHasuraRole:            "me",
+					XHasuraAllowedRoles:    "user,me",
+					XHasuraUserIsAnonymous: "false",
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:          "missing role",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          ptr("admin"),
+					Authorization: ptr("Bearer " + accessToken),
+					Cookie:        nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-role",
+				Message: "The user doesn't have the required role",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:          "missing access token",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: nil,
+					Cookie:        ptr("theme=dark"),
+				},
+			},
+			expectedResponse: invalidAccessToken,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:          "invalid access token",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: ptr("Bearer not-a-jwt"),
+					Cookie:        nil,
+				},
+			},
+			expectedResponse: invalidAccessToken,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:          "client token",
+			config:        config,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: ptr("Bearer " + clientToken),
+					Cookie:        nil,
+				},
+			},
+			expectedResponse: invalidAccessToken,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:          "disabled",
+			config:        getConfig,
+			db:            db,
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetForwardAuthVerifyRequestObject{
+				Params: api.GetForwardAuthVerifyParams{
+					Role:          nil,
+					Authorization: ptr("Bearer " + accessToken),
+					Cookie:        nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			assertRequest(
+				context.Background(), t, c.GetForwardAuthVerify, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetMfaRecoveryCodes( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetMfaRecoveryCodesRequestObject,
+) (api.GetMfaRecoveryCodesResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.MfaEnabled {
+		logger.Warn("mfa is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	count, apiErr := ctrl.wf.CountRecoveryCodes(ctx, user.ID, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.GetMfaRecoveryCodes200JSONResponse{
+		Remaining: int(count),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetMfaRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.MfaEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.GetMfaRecoveryCodesRequestObject, api.GetMfaRecoveryCodesResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().CountRecoveryCodes(
+					gomock.Any(), userID,
+				).Return(int64(7), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetMfaRecoveryCodesRequestObject{},
+			expectedResponse: api.GetMfaRecoveryCodes200JSONResponse{
+				Remaining: 7,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name: "mfa disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.MfaEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetMfaRecoveryCodesRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.GetMfaRecoveryCodes, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetMfaTotpGenerate( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetMfaTotpGenerateRequestObject,
+) (api.GetMfaTotpGenerateResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.MfaEnabled {
+		logger.Warn("mfa is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	// the secret is replaced right away, doing it while TOTP is active would let anyone
+	// with an access token swap the second factor of the user for one they know
+	if user.ActiveMfaType.String == MFATypeTOTP {
+		logger.Warn("totp mfa already active")
+		return ctrl.sendError(ErrTotpAlreadyActive), nil
+	}
+
+	secret, imageURL, err := generateTOTP(ctrl.config.MfaTotpIssuer, user.ID.String())
+	if err != nil {
+		logger.Error("error generating totp secret", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if apiErr := ctrl.wf.UpdateUserTotpSecret(ctx, user.ID, secret, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.GetMfaTotpGenerate200JSONResponse{
+		ImageUrl:   imageURL,
+		TotpSecret: secret,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package controller_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetMfaTotpGenerate(t *testing.T) {
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.MfaEnabled = true
+		config.MfaTotpIssuer = "hasura-auth"
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.GetMfaTotpGenerateRequestObject, api.GetMfaTotpGenerateResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserTotpSecret(
+					gomock.Any(),
+					cmpDBParams(
+						sql.UpdateUserTotpSecretParams{
+							ID:         userID,
+							TotpSecret: sql.Text(""),
+						},
+						cmpopts.IgnoreFields(sql.UpdateUserTotpSecretParams{}, "TotpSecret"), //nolint:exhaustruct
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetMfaTotpGenerateRequestObject{},
+			expectedResponse: api.GetMfaTotpGenerate200JSONResponse{
+				ImageUrl:   "",
+				TotpSecret: "",
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name: "mfa disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.MfaEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetMfaTotpGenerateRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "totp already active",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.ActiveMfaType = sql.Text("totp")
+				user.TotpSecret = sql.Text("secret")
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetMfaTotpGenerateRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "totp-already-active",
+				Message: "TOTP MFA already active",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "anonymous user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.IsAnonymous = true
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetMfaTotpGenerateRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-anonymous",
+				Message: "Forbidden, user is anonymous.",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.GetMfaTotpGenerate, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.GetMfaTotpGenerate200JSONResponse{}, //nolint:exhaustruct
+					"ImageUrl", "TotpSecret",
+				),
+			)
+
+			resp200, ok := resp.(api.GetMfaTotpGenerate200JSONResponse)
+			if !ok {
+				return
+			}
+
+			if len(resp200.TotpSecret) == 0 {
+				t.Errorf("expected a totp secret")
+			}
+
+			if !strings.HasPrefix(resp200.ImageUrl, "data:image/png;base64,") {
+				t.Errorf("unexpected image url: %s", resp200.ImageUrl)
+			}
+		})
+	}
+}
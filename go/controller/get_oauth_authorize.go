@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetOauthAuthorize( //nolint:ireturn
+	ctx context.Context,
+	request api.GetOauthAuthorizeRequestObject,
+) (api.GetOauthAuthorizeResponseObject, error) {
+	params := request.Params
+	logger := middleware.LoggerFromContext(ctx).With(slog.String("client_id", params.ClientId))
+
+	if !ctrl.config.OIDCProviderEnabled {
+		logger.Warn("oidc provider is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	// errors are only sent to the redirect URI once we know it belongs to the client
+	if _, apiErr := ctrl.wf.GetOIDCClient(
+		ctx, params.ClientId, params.RedirectUri, logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	redirectWithError := func(code, description string) api.GetOauthAuthorizeResponseObject {
+		logger.Warn("invalid authorization request", slog.String("error", description))
+		return api.GetOauthAuthorize302Response{
+			Headers: api.GetOauthAuthorize302ResponseHeaders{
+				Location: oidcRedirectURI(params.RedirectUri, map[string]string{
+					"error":             code,
+					"error_description": description,
+					"state":             deptr(params.State),
+				}),
+			},
+		}
+	}
+
+	if params.ResponseType != "code" {
+		return redirectWithError(
+			"unsupported_response_type", "Only the authorization code flow is supported",
+		), nil
+	}
+
+	if code, description := oidcAuthorizationRequestError(
+		strings.Fields(params.Scope),
+		deptr(params.CodeChallenge),
+		deptr(params.CodeChallengeMethod),
+	); code != "" {
+		return redirectWithError(code, description), nil
+	}
+
+	authURL, err := url.Parse(ctrl.config.OIDCProviderAuthURL)
+	if err != nil {
+		logger.Error("error parsing oidc provider authorization url", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", params.ResponseType)
+	query.Set("client_id", params.ClientId)
+	query.Set("redirect_uri", params.RedirectUri)
+	query.Set("scope", params.Scope)
+	for k, v := range map[string]*string{
+		"state":                 params.State,
+		"nonce":                 params.Nonce,
+		"code_challenge":        params.CodeChallenge,
+		"code_challenge_method": params.CodeChallengeMethod,
+	} {
+		if v != nil {
+			query.Set(k, *v)
+		}
+	}
+	authURL.RawQuery = query.Encode()
+
+	return api.GetOauthAuthorize302Response{
+		Headers: api.GetOauthAuthorize302ResponseHeaders{
+			Location: authURL.String(),
+		},
+	}, nil
+}
@@ -0,0 +1,279 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getOIDCClient(clientID string) sql.AuthOauth2Client {
+	return sql.AuthOauth2Client{ //nolint:exhaustruct
+		ClientID:     clientID,
+		Description:  "Dashboard",
+		DefaultRole:  "user",
+		AllowedRoles: []string{"user"},
+		RedirectUris: []string{"https://app.example.com/callback"},
+		GrantTypes:   []string{"authorization_code"},
+	}
+}
+
+func getOIDCProviderConfig() *controller.Config {
+	cfg := getConfig()
+	cfg.OIDCProviderEnabled = true
+	cfg.OIDCProviderAuthURL = "https://my-app.com/oauth/authorize"
+	return cfg
+}
+
+func TestGetOauthAuthorize(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	clientID := "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"
+	params := func(
+		responseType, redirectURI, scope string, codeChallengeMethod *string,
+	) api.GetOauthAuthorizeParams {
+		return api.GetOauthAuthorizeParams{
+			ResponseType:        responseType,
+			ClientId:            clientID,
+			RedirectUri:         redirectURI,
+			Scope:               scope,
+			State:               ptr("af0ifjsldkj"),
+			Nonce:               ptr("n-0S6_WzA2Mj"),
+			CodeChallenge:       nil,
+			CodeChallengeMethod: codeChallengeMethod,
+		}
+	}
+
+	cases := []testRequest[api.GetOauthAuthorizeRequestObject, api.GetOauthAuthorizeResponseObject]{
+		{
+			name:   "success",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					getOIDCClient(clientID), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("code", "https://app.example.com/callback", "openid email", nil),
+			},
+			expectedResponse: api.GetOauthAuthorize302Response{
+				Headers: api.GetOauthAuthorize302ResponseHeaders{
+					Location: "https://my-app.com/oauth/authorize?client_id=2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24&nonce=n-0S6_WzA2Mj&redirect_uri=https%3A%2F%2Fapp.example.com%2Fcallback&response_type=code&scope=openid+email&state=af0ifjsldkj", //nolint:lll
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "missing openid scope",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					getOIDCClient(clientID), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("code", "https://app.example.com/callback", "email", nil),
+			},
+			expectedResponse: api.GetOauthAuthorize302Response{
+				Headers: api.GetOauthAuthorize302ResponseHeaders{
+					Location: "https://app.example.com/callback?error=invalid_scope&error_description=The+openid+scope+is+required&state=af0ifjsldkj", //nolint:lll
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unsupported response type",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					getOIDCClient(clientID), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("token", "https://app.example.com/callback", "openid", nil),
+			},
+			expectedResponse: api.GetOauthAuthorize302Response{
+				Headers: api.GetOauthAuthorize302ResponseHeaders{
+					Location: "https://app.example.com/callback?error=unsupported_response_type&error_description=Only+the+authorization+code+flow+is+supported&state=af0ifjsldkj", //nolint:lll
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "code challenge method without code challenge",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					getOIDCClient(clientID), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params(
+					"code", "https://app.example.com/callback", "openid", ptr("plain"),
+				),
+			},
+			expectedResponse: api.GetOauthAuthorize302Response{
+				Headers: api.GetOauthAuthorize302ResponseHeaders{
+					Location: "https://app.example.com/callback?error=invalid_request&error_description=code_challenge_method+requires+a+code_challenge&state=af0ifjsldkj", //nolint:lll
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "redirect uri not registered",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					getOIDCClient(clientID), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("code", "https://evil.example.com/callback", "openid", nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "redirectTo-not-allowed",
+				Message: `The value of "options.redirectTo" is not allowed.`,
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code not allowed for client",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				client := getOIDCClient(clientID)
+				client.GrantTypes = []string{"client_credentials"}
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("code", "https://app.example.com/callback", "openid", nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unauthorized-client",
+				Message: "Client is not allowed to use this grant type",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unknown client",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					sql.AuthOauth2Client{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("code", "https://app.example.com/callback", "openid", nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "client-not-found",
+				Message: "Client not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetOauthAuthorizeRequestObject{
+				Params: params("code", "https://app.example.com/callback", "openid", nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			assertRequest(
+				context.Background(), t, c.GetOauthAuthorize, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) GetOauthUserinfo( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetOauthUserinfoRequestObject,
+) (api.GetOauthUserinfoResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OIDCProviderEnabled {
+		logger.Warn("oidc provider is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	// tokens issued to clients only give access to the scopes the user allowed, the
+	// user's own tokens to all of them
+	scopes := oidcScopes
+	jwtToken, _ := ctrl.wf.jwtGetter.FromContext(ctx)
+	if clientID := ctrl.wf.jwtGetter.GetCustomClaim(jwtToken, "x-hasura-client-id"); clientID != "" {
+		var err error
+		scopes, err = ctrl.wf.db.GetOAuth2ConsentScopes(ctx, sql.GetOAuth2ConsentScopesParams{
+			UserID:   user.ID,
+			ClientID: clientID,
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			logger.Error("error getting oauth2 consent", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+	}
+
+	return api.GetOauthUserinfo200JSONResponse(oidcUserInfo(user, scopes)), nil
+}
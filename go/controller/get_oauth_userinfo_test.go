@@ -0,0 +1,172 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func oidcClientUserJWT(userID uuid.UUID, clientID string) func() *jwt.Token {
+	return func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           userID.String(),
+					"x-hasura-user-is-anonymous": "false",
+					"x-hasura-client-id":         clientID,
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": userID.String(),
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+}
+
+func TestGetOauthUserinfo(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	clientID := "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"
+	consentParams := sql.GetOAuth2ConsentScopesParams{
+		UserID:   userID,
+		ClientID: clientID,
+	}
+
+	cases := []testRequest[api.GetOauthUserinfoRequestObject, api.GetOauthUserinfoResponseObject]{
+		{
+			name:   "user token",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetOauthUserinfoRequestObject{},
+			expectedResponse: api.GetOauthUserinfo200JSONResponse{
+				Sub:                 userID.String(),
+				Name:                ptr("Jane Doe"),
+				Picture:             nil,
+				Locale:              ptr("en"),
+				Email:               ptr("jane@acme.com"),
+				EmailVerified:       ptr(true),
+				PhoneNumber:         nil,
+				PhoneNumberVerified: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "client token",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2ConsentScopes(gomock.Any(), consentParams).Return(
+					[]string{"openid", "email"}, nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetOauthUserinfoRequestObject{},
+			expectedResponse: api.GetOauthUserinfo200JSONResponse{
+				Sub:                 userID.String(),
+				Name:                nil,
+				Picture:             nil,
+				Locale:              nil,
+				Email:               ptr("jane@acme.com"),
+				EmailVerified:       ptr(true),
+				PhoneNumber:         nil,
+				PhoneNumberVerified: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  oidcClientUserJWT(userID, clientID),
+		},
+
+		{
+			name:   "client token without consent",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2ConsentScopes(gomock.Any(), consentParams).Return(
+					nil, pgx.ErrNoRows,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetOauthUserinfoRequestObject{},
+			expectedResponse: api.GetOauthUserinfo200JSONResponse{ //nolint:exhaustruct
+				Sub: userID.String(),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  oidcClientUserJWT(userID, clientID),
+		},
+
+		{
+			name:   "disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetOauthUserinfoRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.GetOauthUserinfo, tc.request, tc.expectedResponse)
+		})
+	}
+}
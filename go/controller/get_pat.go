@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func patFromPersonalAccessToken(token sql.AuthPersonalAccessToken) (api.PAT, error) {
+	pat := api.PAT{
+		Id:         token.ID.String(),
+		Name:       token.Name,
+		CreatedAt:  token.CreatedAt.Time,
+		ExpiresAt:  nil,
+		LastUsedAt: nil,
+		Metadata:   nil,
+	}
+	if token.ExpiresAt.Valid {
+		pat.ExpiresAt = ptr(token.ExpiresAt.Time)
+	}
+	if token.LastUsedAt.Valid {
+		pat.LastUsedAt = ptr(token.LastUsedAt.Time)
+	}
+	if len(token.Metadata) > 0 {
+		var metadata map[string]any
+		if err := json.Unmarshal(token.Metadata, &metadata); err != nil {
+			return api.PAT{}, fmt.Errorf("error unmarshalling metadata: %w", err)
+		}
+		pat.Metadata = &metadata
+	}
+	return pat, nil
+}
+
+func (ctrl *Controller) GetPat( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetPatRequestObject,
+) (api.GetPatResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	tokens, err := ctrl.wf.db.GetUserPersonalAccessTokens(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting personal access tokens", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	pats := make([]api.PAT, len(tokens))
+	for i, token := range tokens {
+		pats[i], err = patFromPersonalAccessToken(token)
+		if err != nil {
+			logger.Error("error converting personal access token", logError(err))
+			return ctrl.sendError(ErrInternalServerError), nil
+		}
+	}
+
+	return api.GetPat200JSONResponse{
+		Pats: pats,
+	}, nil
+}
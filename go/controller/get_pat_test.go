@@ -0,0 +1,143 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPat(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []testRequest[api.GetPatRequestObject, api.GetPatResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserPersonalAccessTokens(
+					gomock.Any(), userID,
+				).Return([]sql.AuthPersonalAccessToken{
+					{
+						ID:         uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+						CreatedAt:  sql.TimestampTz(createdAt.Add(time.Hour)),
+						UserID:     userID,
+						Name:       "ci",
+						TokenHash:  "\\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310",
+						ExpiresAt:  sql.TimestampTz(createdAt.Add(365 * 24 * time.Hour)),
+						LastUsedAt: sql.TimestampTz(createdAt.Add(2 * time.Hour)),
+						Metadata:   []byte(`{"used-by":"my-app-cli"}`),
+					},
+					{ //nolint:exhaustruct
+						ID:        uuid.MustParse("5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"),
+						CreatedAt: sql.TimestampTz(createdAt),
+						UserID:    userID,
+						Name:      "",
+						TokenHash: "\\x0d9b8a1c6b1f5b7e1f6f4d1f5a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b",
+					},
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetPatRequestObject{},
+			expectedResponse: api.GetPat200JSONResponse{
+				Pats: []api.PAT{
+					{
+						Id:         "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+						Name:       "ci",
+						CreatedAt:  createdAt.Add(time.Hour),
+						ExpiresAt:  ptr(createdAt.Add(365 * 24 * time.Hour)),
+						LastUsedAt: ptr(createdAt.Add(2 * time.Hour)),
+						Metadata:   &map[string]any{"used-by": "my-app-cli"},
+					},
+					{
+						Id:         "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b",
+						Name:       "",
+						CreatedAt:  createdAt,
+						ExpiresAt:  nil,
+						LastUsedAt: nil,
+						Metadata:   nil,
+					},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "no tokens",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserPersonalAccessTokens(
+					gomock.Any(), userID,
+				).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetPatRequestObject{},
+			expectedResponse: api.GetPat200JSONResponse{
+				Pats: []api.PAT{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.GetPat, tc.request, tc.expectedResponse)
+		})
+	}
+}
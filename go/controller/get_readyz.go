@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+// readinessCheckTimeout is how long each dependency has to respond to its check.
+const readinessCheckTimeout = 2 * time.Second
+
+// readinessChecks returns the checks of the dependencies needed to serve requests. The
+// SMTP server is only checked when the emails are sent with SMTP, other providers return
+// ErrPingNotSupported and aren't reported.
+func (ctrl *Controller) readinessChecks() map[string]func(ctx context.Context) error {
+	return map[string]func(ctx context.Context) error{
+		"database": ctrl.wf.db.Ping,
+		"smtp": func(ctx context.Context) error {
+			return notifications.Ping(ctx, ctrl.wf.email) //nolint:wrapcheck
+		},
+		"jwt": func(context.Context) error {
+			return ctrl.wf.jwtGetter.CheckSigningKey()
+		},
+	}
+}
+
+// readiness runs the checks concurrently and reports if all of them succeeded.
+func (ctrl *Controller) readiness(ctx context.Context) (api.ReadinessResponse, bool) {
+	logger := middleware.LoggerFromContext(ctx)
+	ctx = context.WithoutCancel(ctx)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	response := api.ReadinessResponse{
+		Status: api.Ok,
+		Checks: map[string]api.ReadinessStatus{},
+	}
+	for name, check := range ctrl.readinessChecks() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+			defer cancel()
+
+			err := check(ctx)
+			if errors.Is(err, notifications.ErrPingNotSupported) {
+				return
+			}
+
+			status := api.Ok
+			if err != nil {
+				logger.Warn(
+					"readiness check failed",
+					slog.String("check", name),
+					logError(err),
+				)
+				status = api.Error
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			response.Checks[name] = status
+			if status != api.Ok {
+				response.Status = api.Error
+			}
+		}()
+	}
+	wg.Wait()
+
+	return response, response.Status == api.Ok
+}
+
+func (ctrl *Controller) GetReadyz( //nolint:ireturn
+	ctx context.Context, _ api.GetReadyzRequestObject,
+) (api.GetReadyzResponseObject, error) {
+	response, ready := ctrl.readiness(ctx)
+	if !ready {
+		return api.GetReadyz503JSONResponse(response), nil
+	}
+	return api.GetReadyz200JSONResponse(response), nil
+}
+
+func (ctrl *Controller) HeadReadyz( //nolint:ireturn
+	ctx context.Context, _ api.HeadReadyzRequestObject,
+) (api.HeadReadyzResponseObject, error) {
+	if _, ready := ctrl.readiness(ctx); !ready {
+		return api.HeadReadyz503Response{}, nil
+	}
+	return api.HeadReadyz200Response{}, nil
+}
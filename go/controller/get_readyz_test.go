@@ -0,0 +1,97 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetReadyz(t *testing.T) {
+	t.Parallel()
+
+	cases := []testRequest[api.GetReadyzRequestObject, api.GetReadyzResponseObject]{
+		{
+			name:   "ready",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().Ping(gomock.Any()).Return(nil)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetReadyzRequestObject{},
+			expectedResponse: api.GetReadyz200JSONResponse{
+				Status: api.Ok,
+				Checks: map[string]api.ReadinessStatus{
+					"database": api.Ok,
+					"jwt":      api.Ok,
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "database down",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().Ping(gomock.Any()).Return(errors.New("connection refused")) //nolint:goerr113
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetReadyzRequestObject{},
+			expectedResponse: api.GetReadyz503JSONResponse{
+				Status: api.Error,
+				Checks: map[string]api.ReadinessStatus{
+					"database": api.Error,
+					"jwt":      api.Ok,
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetReadyz,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// scimGroupsFilter returns the role selected by the filter, if any.
+func scimGroupsFilter(filter *string) (pgtype.Text, bool) {
+	if filter == nil || *filter == "" {
+		return pgtype.Text{}, true //nolint:exhaustruct
+	}
+
+	attribute, value, ok := parseScimFilter(*filter)
+	if !ok || (attribute != "displayname" && attribute != "id") {
+		return pgtype.Text{}, false //nolint:exhaustruct
+	}
+
+	return sql.Text(value), true
+}
+
+func scimExcludesMembers(excludedAttributes *string) bool {
+	return slices.ContainsFunc(
+		strings.Split(deptr(excludedAttributes), ","),
+		func(attribute string) bool {
+			return strings.EqualFold(strings.TrimSpace(attribute), "members")
+		},
+	)
+}
+
+func (ctrl *Controller) GetScimV2Groups( //nolint:ireturn
+	ctx context.Context,
+	request api.GetScimV2GroupsRequestObject,
+) (api.GetScimV2GroupsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	role, ok := scimGroupsFilter(request.Params.Filter)
+	if !ok {
+		logger.Warn(
+			"unsupported scim filter", slog.String("filter", deptr(request.Params.Filter)),
+		)
+		return scimError(
+			http.StatusBadRequest,
+			scimTypeInvalidFilter,
+			"Only eq filters on displayName are supported",
+		), nil
+	}
+	offset, limit := scimPage(request.Params.StartIndex, request.Params.Count)
+
+	total, err := ctrl.wf.db.CountScimGroups(ctx, role)
+	if err != nil {
+		logger.Error("error counting roles", logError(err))
+		return ctrl.sendScimError(ErrInternalServerError), nil
+	}
+
+	roles, err := ctrl.wf.db.GetScimGroups(ctx, sql.GetScimGroupsParams{
+		Role:      role,
+		RowOffset: offset,
+		RowLimit:  limit,
+	})
+	if err != nil {
+		logger.Error("error getting roles", logError(err))
+		return ctrl.sendScimError(ErrInternalServerError), nil
+	}
+
+	excludeMembers := scimExcludesMembers(request.Params.ExcludedAttributes)
+
+	resp := api.ScimGroupListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: int(total),
+		StartIndex:   int(offset) + 1,
+		ItemsPerPage: len(roles),
+		Resources:    make([]api.ScimGroup, len(roles)),
+	}
+	for i, role := range roles {
+		if excludeMembers {
+			resp.Resources[i] = ctrl.scimGroup(role, nil)
+			continue
+		}
+
+		members, err := ctrl.wf.db.GetRoleUsers(ctx, role)
+		if err != nil {
+			logger.Error("error getting role users", logError(err))
+			return ctrl.sendScimError(ErrInternalServerError), nil
+		}
+		if members == nil {
+			members = []sql.GetRoleUsersRow{}
+		}
+		resp.Resources[i] = ctrl.scimGroup(role, members)
+	}
+
+	return api.GetScimV2Groups200ApplicationScimPlusJSONResponse(resp), nil
+}
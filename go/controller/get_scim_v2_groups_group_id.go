@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetScimV2GroupsGroupId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.GetScimV2GroupsGroupIdRequestObject,
+) (api.GetScimV2GroupsGroupIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.GroupId))
+
+	group, apiErr := ctrl.getScimGroup(ctx, request.GroupId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.GetScimV2GroupsGroupId200ApplicationScimPlusJSONResponse(group), nil
+}
@@ -0,0 +1,185 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getScimGroup(role string, members ...api.ScimMember) api.ScimGroup {
+	groupMembers := append([]api.ScimMember{}, members...)
+	return api.ScimGroup{
+		Schemas:     &[]string{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+		Id:          ptr(role),
+		DisplayName: role,
+		Members:     &groupMembers,
+		Meta: &api.ScimMeta{
+			ResourceType: ptr("Group"),
+			Created:      nil,
+			LastModified: nil,
+			Location:     ptr("https://local.auth.nhost.run/scim/v2/Groups/" + role),
+		},
+	}
+}
+
+func TestGetScimV2Groups(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.GetScimV2GroupsRequestObject, api.GetScimV2GroupsResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimGroups(gomock.Any(), sql.Text("editor")).
+					Return(int64(1), nil)
+
+				mock.EXPECT().GetScimGroups(gomock.Any(), sql.GetScimGroupsParams{
+					Role:      sql.Text("editor"),
+					RowOffset: 0,
+					RowLimit:  100,
+				}).Return([]string{"editor"}, nil)
+
+				mock.EXPECT().GetRoleUsers(gomock.Any(), "editor").Return(
+					[]sql.GetRoleUsersRow{
+						{ID: userID, DisplayName: "", Email: sql.Text("jane@acme.com")},
+					}, nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetScimV2GroupsRequestObject{
+				Params: api.GetScimV2GroupsParams{ //nolint:exhaustruct
+					Filter: ptr(`displayName eq "editor"`),
+				},
+			},
+			expectedResponse: api.GetScimV2Groups200ApplicationScimPlusJSONResponse{
+				Schemas:      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+				TotalResults: 1,
+				StartIndex:   1,
+				ItemsPerPage: 1,
+				Resources: []api.ScimGroup{
+					getScimGroup(
+						"editor",
+						api.ScimMember{Value: userID.String(), Display: ptr("jane@acme.com")},
+					),
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "exclude members",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimGroups(
+					gomock.Any(), pgtype.Text{}, //nolint:exhaustruct
+				).Return(int64(2), nil)
+
+				mock.EXPECT().GetScimGroups(gomock.Any(), sql.GetScimGroupsParams{
+					Role:      pgtype.Text{}, //nolint:exhaustruct
+					RowOffset: 0,
+					RowLimit:  100,
+				}).Return([]string{"me", "user"}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetScimV2GroupsRequestObject{
+				Params: api.GetScimV2GroupsParams{ //nolint:exhaustruct
+					ExcludedAttributes: ptr("members"),
+				},
+			},
+			expectedResponse: api.GetScimV2Groups200ApplicationScimPlusJSONResponse{
+				Schemas:      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+				TotalResults: 2,
+				StartIndex:   1,
+				ItemsPerPage: 2,
+				Resources: func() []api.ScimGroup {
+					me := getScimGroup("me")
+					me.Members = nil
+					user := getScimGroup("user")
+					user.Members = nil
+					return []api.ScimGroup{me, user}
+				}(),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unsupported filter",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetScimV2GroupsRequestObject{
+				Params: api.GetScimV2GroupsParams{ //nolint:exhaustruct
+					Filter: ptr(`displayName sw "edit"`),
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "400",
+				ScimType: ptr("invalidFilter"),
+				Detail:   ptr("Only eq filters on displayName are supported"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetScimV2Groups,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
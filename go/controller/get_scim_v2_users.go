@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// scimUsersFilter returns the parameters of GetScimUsers selecting the users that match
+// the filter.
+func scimUsersFilter(filter *string) (sql.GetScimUsersParams, bool) {
+	params := sql.GetScimUsersParams{} //nolint:exhaustruct
+	if filter == nil || *filter == "" {
+		return params, true
+	}
+
+	attribute, value, ok := parseScimFilter(*filter)
+	if !ok {
+		return params, false
+	}
+
+	switch attribute {
+	case "id":
+		id, err := uuid.Parse(value)
+		if err != nil {
+			// no user can match, the nil uuid is never used as an id
+			id = uuid.Nil
+		}
+		params.ID = pgtype.UUID{Bytes: id, Valid: true}
+	case "username", "emails", "emails.value":
+		params.Email = sql.Text(value)
+	case "externalid":
+		params.ExternalID = sql.Text(value)
+	default:
+		return params, false
+	}
+
+	return params, true
+}
+
+func (ctrl *Controller) GetScimV2Users( //nolint:ireturn
+	ctx context.Context,
+	request api.GetScimV2UsersRequestObject,
+) (api.GetScimV2UsersResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	params, ok := scimUsersFilter(request.Params.Filter)
+	if !ok {
+		logger.Warn(
+			"unsupported scim filter", slog.String("filter", deptr(request.Params.Filter)),
+		)
+		return scimError(
+			http.StatusBadRequest,
+			scimTypeInvalidFilter,
+			"Only eq filters on userName, externalId and emails.value are supported",
+		), nil
+	}
+	params.RowOffset, params.RowLimit = scimPage(request.Params.StartIndex, request.Params.Count)
+
+	total, err := ctrl.wf.db.CountScimUsers(ctx, sql.CountScimUsersParams{
+		ID:         params.ID,
+		Email:      params.Email,
+		ExternalID: params.ExternalID,
+	})
+	if err != nil {
+		logger.Error("error counting scim users", logError(err))
+		return ctrl.sendScimError(ErrInternalServerError), nil
+	}
+
+	users, err := ctrl.wf.db.GetScimUsers(ctx, params)
+	if err != nil {
+		logger.Error("error getting scim users", logError(err))
+		return ctrl.sendScimError(ErrInternalServerError), nil
+	}
+
+	resp := api.ScimUserListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: int(total),
+		StartIndex:   int(params.RowOffset) + 1,
+		ItemsPerPage: len(users),
+		Resources:    make([]api.ScimUser, len(users)),
+	}
+	for i, user := range users {
+		resp.Resources[i] = ctrl.scimUser(user)
+	}
+
+	return api.GetScimV2Users200ApplicationScimPlusJSONResponse(resp), nil
+}
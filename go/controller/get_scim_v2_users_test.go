@@ -0,0 +1,199 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getScimUsersRow(userID uuid.UUID) sql.GetScimUsersRow {
+	return sql.GetScimUsersRow{
+		ID:          userID,
+		CreatedAt:   sql.TimestampTz(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+		UpdatedAt:   sql.TimestampTz(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)),
+		Disabled:    false,
+		DisplayName: "Jane Doe",
+		Email:       sql.Text("jane@acme.com"),
+		ExternalID:  sql.Text("00u1ab2cd3"),
+		Roles:       []string{"me", "user"},
+	}
+}
+
+func getScimUser(userID uuid.UUID) api.ScimUser {
+	return api.ScimUser{
+		Schemas:     &[]string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+		Id:          ptr(userID.String()),
+		ExternalId:  ptr("00u1ab2cd3"),
+		UserName:    "jane@acme.com",
+		Name:        nil,
+		DisplayName: ptr("Jane Doe"),
+		Active:      ptr(true),
+		Emails: &[]api.ScimEmail{
+			{Value: "jane@acme.com", Type: ptr("work"), Primary: ptr(true)},
+		},
+		Groups: &[]api.ScimMember{
+			{Value: "me", Display: ptr("me")},
+			{Value: "user", Display: ptr("user")},
+		},
+		Meta: &api.ScimMeta{
+			ResourceType: ptr("User"),
+			Created:      ptr(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+			LastModified: ptr(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)),
+			Location:     ptr("https://local.auth.nhost.run/scim/v2/Users/" + userID.String()),
+		},
+	}
+}
+
+func TestGetScimV2Users(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.GetScimV2UsersRequestObject, api.GetScimV2UsersResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimUsers(
+					gomock.Any(), sql.CountScimUsersParams{}, //nolint:exhaustruct
+				).Return(int64(1), nil)
+
+				mock.EXPECT().GetScimUsers(
+					gomock.Any(), sql.GetScimUsersParams{ //nolint:exhaustruct
+						RowOffset: 0,
+						RowLimit:  100,
+					},
+				).Return([]sql.GetScimUsersRow{getScimUsersRow(userID)}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetScimV2UsersRequestObject{
+				Params: api.GetScimV2UsersParams{}, //nolint:exhaustruct
+			},
+			expectedResponse: api.GetScimV2Users200ApplicationScimPlusJSONResponse{
+				Schemas:      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+				TotalResults: 1,
+				StartIndex:   1,
+				ItemsPerPage: 1,
+				Resources:    []api.ScimUser{getScimUser(userID)},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "filter by userName",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimUsers(
+					gomock.Any(), sql.CountScimUsersParams{ //nolint:exhaustruct
+						Email: sql.Text("bob@acme.com"),
+					},
+				).Return(int64(3), nil)
+
+				mock.EXPECT().GetScimUsers(
+					gomock.Any(), sql.GetScimUsersParams{
+						ID:         pgtype.UUID{}, //nolint:exhaustruct
+						Email:      sql.Text("bob@acme.com"),
+						ExternalID: pgtype.Text{}, //nolint:exhaustruct
+						RowOffset:  1,
+						RowLimit:   2,
+					},
+				).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetScimV2UsersRequestObject{
+				Params: api.GetScimV2UsersParams{
+					Filter:     ptr(`userName Eq "bob@acme.com"`),
+					StartIndex: ptr(2),
+					Count:      ptr(2),
+				},
+			},
+			expectedResponse: api.GetScimV2Users200ApplicationScimPlusJSONResponse{
+				Schemas:      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+				TotalResults: 3,
+				StartIndex:   2,
+				ItemsPerPage: 0,
+				Resources:    []api.ScimUser{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unsupported filter",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetScimV2UsersRequestObject{
+				Params: api.GetScimV2UsersParams{ //nolint:exhaustruct
+					Filter: ptr(`userName sw "bob"`),
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "400",
+				ScimType: ptr("invalidFilter"),
+				Detail:   ptr("Only eq filters on userName, externalId and emails.value are supported"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetScimV2Users,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetScimV2UsersUserId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.GetScimV2UsersUserIdRequestObject,
+) (api.GetScimV2UsersUserIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	user, apiErr := ctrl.wf.GetScimUser(ctx, request.UserId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.GetScimV2UsersUserId200ApplicationScimPlusJSONResponse(ctrl.scimUser(user)), nil
+}
@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/providers"
+	"golang.org/x/oauth2"
+)
+
+const (
+	providerNonceCookieName   = "nhostAuthProviderNonce"
+	providerNonceCookieMaxAge = 10 * time.Minute
+)
+
+// providerNonceCookie returns the cookie that binds a sign in with a provider to the
+// browser that started it.
+func (ctrl *Controller) providerNonceCookie(nonce string) string {
+	// Apple posts the callback from its own site so, when possible, the cookie needs to
+	// be sent with cross-site requests
+	secure := ctrl.config.ServerURL.Scheme == "https"
+	sameSite := http.SameSiteLaxMode
+	if secure {
+		sameSite = http.SameSiteNoneMode
+	}
+
+	cookie := &http.Cookie{ //nolint:exhaustruct
+		Name:     providerNonceCookieName,
+		Value:    nonce,
+		Path:     path.Join("/", ctrl.config.ServerURL.Path, "signin/provider"),
+		MaxAge:   int(providerNonceCookieMaxAge.Seconds()),
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: sameSite,
+	}
+
+	return cookie.String()
+}
+
+// providerNonceFromCookie returns the nonce sent back to the callback, if any.
+func providerNonceFromCookie(cookies *string) string {
+	if cookies == nil {
+		return ""
+	}
+
+	r := &http.Request{Header: http.Header{"Cookie": {*cookies}}} //nolint:exhaustruct
+	cookie, err := r.Cookie(providerNonceCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// providerContinueURL returns the URL the user is sent to in the browser to continue a
+// flow started with an API call, like linking a provider.
+func (ctrl *Controller) providerContinueURL(providerID string, state uuid.UUID) string {
+	u := ctrl.config.ServerURL.JoinPath("signin/provider", providerID)
+	u.RawQuery = url.Values{"state": {state.String()}}.Encode()
+	return u.String()
+}
+
+// getSigninProviderRedirectTo returns the URL the user is sent back to once the sign
+// in is completed, or fails, if it is allowed.
+func (ctrl *Controller) getSigninProviderRedirectTo(
+	redirectTo *string,
+	logger *slog.Logger,
+) (*url.URL, *APIError) {
+	if redirectTo == nil {
+		return ptr(*ctrl.config.ClientURL), nil
+	}
+
+	if !ctrl.wf.redirectURLValidator(*redirectTo) {
+		logger.Warn("redirect URL not allowed")
+		return nil, ErrRedirecToNotAllowed
+	}
+
+	u, err := url.Parse(*redirectTo)
+	if err != nil {
+		logger.Error("error parsing redirect URL", logError(err))
+		return nil, ErrInvalidRequest
+	}
+
+	return u, nil
+}
+
+func (ctrl *Controller) getSigninProviderValidateRequest(
+	params api.GetSigninProviderProviderParams,
+	logger *slog.Logger,
+) (api.SignUpOptions, *APIError) {
+	options := api.SignUpOptions{
+		AllowedRoles: params.AllowedRoles,
+		DefaultRole:  params.DefaultRole,
+		DisplayName:  params.DisplayName,
+		Locale:       params.Locale,
+		Metadata:     nil,
+		RedirectTo:   params.RedirectTo,
+	}
+
+	if params.Metadata != nil {
+		var metadata map[string]any
+		if err := json.Unmarshal([]byte(*params.Metadata), &metadata); err != nil {
+			logger.Warn("error unmarshalling metadata", logError(err))
+			return api.SignUpOptions{}, ErrInvalidRequest //nolint:exhaustruct
+		}
+		options.Metadata = &metadata
+	}
+
+	return ctrl.validateProviderSignUpOptions(options, logger)
+}
+
+// validateProviderSignUpOptions validates the options of a provider sign in. The defaults
+// are only applied once we get the user's profile from the provider.
+func (ctrl *Controller) validateProviderSignUpOptions(
+	options api.SignUpOptions,
+	logger *slog.Logger,
+) (api.SignUpOptions, *APIError) {
+	// we validate a copy so the defaults aren't applied yet
+	validated := options
+	if _, apiErr := ctrl.wf.ValidateSignUpOptions(&validated, "", SignInMethodProvider, logger); apiErr != nil {
+		return api.SignUpOptions{}, apiErr //nolint:exhaustruct
+	}
+	options.RedirectTo = validated.RedirectTo
+
+	return options, nil
+}
+
+func (ctrl *Controller) GetSigninProviderProvider( //nolint:ireturn
+	ctx context.Context,
+	request api.GetSigninProviderProviderRequestObject,
+) (api.GetSigninProviderProviderResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", request.Provider))
+
+	redirectTo, apiErr := ctrl.getSigninProviderRedirectTo(request.Params.RedirectTo, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	redirectWithError := func(apiErr *APIError) api.GetSigninProviderProviderResponseObject {
+		return api.GetSigninProviderProvider302Response{
+			Headers: api.GetSigninProviderProvider302ResponseHeaders{
+				Location: ctrl.sendRedirectError(redirectTo, apiErr),
+			},
+		}
+	}
+
+	provider, ok := ctrl.oauthProviders[request.Provider]
+	if !ok {
+		logger.Warn("provider is not enabled")
+		return redirectWithError(ErrDisabledEndpoint), nil
+	}
+
+	if request.Params.State != nil {
+		return ctrl.getSigninProviderContinue(
+			ctx, provider, *request.Params.State, redirectWithError, logger,
+		), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(
+		ctx, providerSignInMethod(request.Provider), logger,
+	); apiErr != nil {
+		return redirectWithError(apiErr), nil
+	}
+
+	options, apiErr := ctrl.getSigninProviderValidateRequest(request.Params, logger)
+	if apiErr != nil {
+		return redirectWithError(apiErr), nil
+	}
+
+	return ctrl.getSigninProviderRedirect(
+		ctx,
+		provider,
+		providerRequest{
+			CodeVerifier:      oauth2.GenerateVerifier(),
+			Options:           options,
+			SAMLRequestID:     "",
+			LinkUserID:        nil,
+			DeanonymizeUserID: nil,
+			NonceHash:         "",
+		},
+		redirectWithError,
+		logger,
+	), nil
+}
+
+// getSigninProviderContinue continues in the browser a link or a deanonymization started
+// with an API call. The pending request is replaced by one bound to the browser.
+func (ctrl *Controller) getSigninProviderContinue(
+	ctx context.Context,
+	provider providers.Provider,
+	state string,
+	redirectWithError func(apiErr *APIError) api.GetSigninProviderProviderResponseObject,
+	logger *slog.Logger,
+) api.GetSigninProviderProviderResponseObject {
+	providerRequest, apiErr := ctrl.wf.ConsumeProviderRequest(ctx, state, logger)
+	if apiErr != nil {
+		return redirectWithError(apiErr)
+	}
+
+	if providerRequest.NonceHash != "" ||
+		(providerRequest.LinkUserID == nil && providerRequest.DeanonymizeUserID == nil) {
+		logger.Warn("provider request can't be continued in the browser")
+		return redirectWithError(ErrInvalidState)
+	}
+
+	return ctrl.getSigninProviderRedirect(ctx, provider, providerRequest, redirectWithError, logger)
+}
+
+// getSigninProviderRedirect stores the provider request, bound to the browser with a
+// nonce cookie, and redirects the user to the provider.
+func (ctrl *Controller) getSigninProviderRedirect(
+	ctx context.Context,
+	provider providers.Provider,
+	providerRequest providerRequest,
+	redirectWithError func(apiErr *APIError) api.GetSigninProviderProviderResponseObject,
+	logger *slog.Logger,
+) api.GetSigninProviderProviderResponseObject {
+	nonce := oauth2.GenerateVerifier()
+	providerRequest.NonceHash = oauth2.S256ChallengeFromVerifier(nonce)
+
+	state := uuid.New()
+	if apiErr := ctrl.wf.InsertProviderRequest(ctx, state, providerRequest, logger); apiErr != nil {
+		return redirectWithError(apiErr)
+	}
+
+	return api.GetSigninProviderProvider302Response{
+		Headers: api.GetSigninProviderProvider302ResponseHeaders{
+			Location:  provider.AuthorizationURL(state.String(), providerRequest.CodeVerifier),
+			SetCookie: ctrl.providerNonceCookie(nonce),
+		},
+	}
+}
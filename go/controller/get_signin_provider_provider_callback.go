@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// providerCallbackParams are the parameters the provider sends back to the callback,
+// either as query parameters or posted as a form.
+type providerCallbackParams struct {
+	Code             *string
+	Error            *string
+	ErrorDescription *string
+	// User is only sent by Apple, the first time the user authorizes the application
+	User *string
+	// Nonce is read from the cookie set when the user was sent to the provider
+	Nonce string
+}
+
+func (ctrl *Controller) getSigninProviderCallbackSignIn(
+	ctx context.Context,
+	providerID string,
+	params providerCallbackParams,
+	providerRequest providerRequest,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	provider, ok := ctrl.oauthProviders[providerID]
+	if !ok {
+		logger.Warn("provider is not enabled")
+		return sql.AuthUser{}, ErrDisabledEndpoint //nolint:exhaustruct
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, providerSignInMethod(providerID), logger); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	// the state alone could be replayed from another browser to sign the user in as
+	// someone else, or to link someone else's account
+	if providerRequest.NonceHash == "" ||
+		!verifyCodeChallenge(providerRequest.NonceHash, params.Nonce) {
+		logger.Warn("nonce cookie doesn't match the provider request")
+		return sql.AuthUser{}, ErrInvalidState //nolint:exhaustruct
+	}
+
+	if params.Error != nil {
+		logger.Warn(
+			"provider returned an error",
+			slog.String("error", *params.Error),
+			slog.String("error_description", deptr(params.ErrorDescription)),
+		)
+		return sql.AuthUser{}, ErrOauthProviderError //nolint:exhaustruct
+	}
+
+	if params.Code == nil {
+		logger.Warn("provider didn't return an authorization code")
+		return sql.AuthUser{}, ErrOauthProviderError //nolint:exhaustruct
+	}
+
+	token, err := provider.Exchange(ctx, *params.Code, providerRequest.CodeVerifier)
+	if err != nil {
+		logger.Warn("error exchanging authorization code", logError(err))
+		return sql.AuthUser{}, ErrOauthProviderError //nolint:exhaustruct
+	}
+
+	profile, err := provider.GetProfile(ctx, token)
+	if err != nil {
+		logger.Warn("error getting profile", logError(err))
+		return sql.AuthUser{}, ErrOauthProviderError //nolint:exhaustruct
+	}
+
+	if profile.Name == "" && params.User != nil {
+		profile.Name = providers.AppleUserName(*params.User)
+	}
+
+	if providerRequest.DeanonymizeUserID != nil {
+		return ctrl.wf.DeanonymizeUserWithProvider(
+			ctx,
+			*providerRequest.DeanonymizeUserID,
+			providerID,
+			profile,
+			token,
+			providerRequest.Options,
+			logger,
+		)
+	}
+
+	if providerRequest.LinkUserID != nil {
+		return ctrl.wf.LinkProvider(
+			ctx, *providerRequest.LinkUserID, providerID, profile, token, logger,
+		)
+	}
+
+	return ctrl.wf.SignInWithProvider(
+		ctx, providerID, profile, token, providerRequest.Options, logger,
+	)
+}
+
+// providerCallback completes the sign in and returns the URL the user needs to be
+// redirected to. signIn authenticates the user with the provider request stored when the
+// sign in was started.
+func (ctrl *Controller) providerCallback(
+	ctx context.Context,
+	state string,
+	signIn func(providerRequest providerRequest) (sql.AuthUser, *APIError),
+	logger *slog.Logger,
+) string {
+	providerRequest, apiErr := ctrl.wf.ConsumeProviderRequest(ctx, state, logger)
+	if apiErr != nil {
+		return ctrl.sendRedirectError(ptr(*ctrl.config.ClientURL), apiErr)
+	}
+
+	// the redirect URL was validated when the sign in was started
+	redirectTo, err := url.Parse(deptr(providerRequest.Options.RedirectTo))
+	if err != nil {
+		logger.Error("error parsing redirect URL", logError(err))
+		return ctrl.sendRedirectError(ptr(*ctrl.config.ClientURL), ErrInternalServerError)
+	}
+
+	user, apiErr := signIn(providerRequest)
+	if apiErr != nil {
+		return ctrl.sendRedirectError(redirectTo, apiErr)
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if apiErr := ctrl.wf.CheckSignInRisk(ctx, user, logger); apiErr != nil {
+		return ctrl.sendRedirectError(redirectTo, apiErr)
+	}
+
+	ctrl.wf.NotifyNewDeviceSignIn(ctx, user, logger)
+
+	refreshToken := uuid.New().String()
+	if _, apiErr := ctrl.wf.InsertRefreshtoken(
+		ctx, user.ID, refreshToken, ctrl.wf.sessionLifetimes(ctx), sql.RefreshTokenTypeRegular, nil, logger,
+	); apiErr != nil {
+		return ctrl.sendRedirectError(redirectTo, apiErr)
+	}
+
+	ctrl.wf.SendWebhook(ctx, webhooks.EventUserSignedIn, webhookUser(user))
+
+	query := redirectTo.Query()
+	query.Set("refreshToken", refreshToken)
+	redirectTo.RawQuery = query.Encode()
+
+	return redirectTo.String()
+}
+
+func (ctrl *Controller) GetSigninProviderProviderCallback( //nolint:ireturn
+	ctx context.Context,
+	request api.GetSigninProviderProviderCallbackRequestObject,
+) (api.GetSigninProviderProviderCallbackResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", request.Provider))
+
+	return api.GetSigninProviderProviderCallback302Response{
+		Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+			Location: ctrl.providerCallback(
+				ctx,
+				request.Params.State,
+				func(providerRequest providerRequest) (sql.AuthUser, *APIError) {
+					return ctrl.getSigninProviderCallbackSignIn(
+						ctx,
+						request.Provider,
+						providerCallbackParams{
+							Code:             request.Params.Code,
+							Error:            request.Params.Error,
+							ErrorDescription: request.Params.ErrorDescription,
+							User:             nil,
+							Nonce:            providerNonceFromCookie(request.Params.Cookie),
+						},
+						providerRequest,
+						logger,
+					)
+				},
+				logger,
+			),
+		},
+	}, nil
+}
@@ -0,0 +1,933 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	providersmock "github.com/nhost/hasura-auth/go/providers/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+)
+
+//nolint:lll
+type testSigninProviderCallbackRequest struct {
+	testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]
+	providers func(ctrl *gomock.Controller) map[string]providers.Provider
+}
+
+func TestGetSigninProviderProviderCallback(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	state := uuid.MustParse("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1")
+
+	token := &oauth2.Token{ //nolint:exhaustruct
+		AccessToken:  "my-access-token",
+		RefreshToken: "my-refresh-token",
+	}
+
+	profile := providers.Profile{
+		ID:            "1234567",
+		Email:         "jane@acme.com",
+		EmailVerified: true,
+		PrivateEmail:  false,
+		Name:          "Jane Doe",
+		AvatarURL:     "https://gitlab.com/avatar.png",
+		Locale:        "",
+	}
+
+	deleteProviderRequest := func(mock *mock.MockDBClient) {
+		mock.EXPECT().DeleteProviderRequest(
+			gomock.Any(), state,
+		).Return(
+			[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+			nil,
+		)
+	}
+
+	insertRefreshToken := func(mock *mock.MockDBClient) {
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: "asdadasdasdasd",
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"), nil)
+	}
+
+	gitlab := func(profile providers.Profile) func(mock *providersmock.MockProvider) {
+		return func(mock *providersmock.MockProvider) {
+			mock.EXPECT().Exchange(
+				gomock.Any(), "my-code", "my-verifier",
+			).Return(token, nil)
+
+			mock.EXPECT().GetProfile(
+				gomock.Any(), token,
+			).Return(profile, nil)
+		}
+	}
+
+	request := api.GetSigninProviderProviderCallbackRequestObject{
+		Provider: "gitlab",
+		Params: api.GetSigninProviderProviderCallbackParams{
+			State:            state.String(),
+			Code:             ptr("my-code"),
+			Error:            nil,
+			ErrorDescription: nil,
+			Cookie:           ptr("theme=dark; nhostAuthProviderNonce=my-nonce"),
+		},
+	}
+
+	cases := []testSigninProviderCallbackRequest{
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "existing provider user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().UpdateProviderSession(
+						gomock.Any(),
+						cmpDBParams(sql.UpdateProviderSessionParams{
+							AccessToken:    "my-access-token",
+							RefreshToken:   sql.Text("my-refresh-token"),
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						}),
+					).Return(nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "existing provider user without new tokens",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(getSigninUser(userID), nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(func(mock *providersmock.MockProvider) {
+				emptyToken := &oauth2.Token{} //nolint:exhaustruct
+
+				mock.EXPECT().Exchange(
+					gomock.Any(), "my-code", "my-verifier",
+				).Return(emptyToken, nil)
+
+				mock.EXPECT().GetProfile(
+					gomock.Any(), emptyToken,
+				).Return(profile, nil)
+			}),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "link to existing user with the same email",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().InsertUserProvider(
+						gomock.Any(),
+						cmpDBParams(sql.InsertUserProviderParams{
+							UserID:         userID,
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+							AccessToken:    "my-access-token",
+							RefreshToken:   sql.Text("my-refresh-token"),
+						}),
+					).Return(uuid.MustParse("8ae1a8a4-0e7c-4f5b-a7a8-2c0b2f4c5e2d"), nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "existing user with the same email but not verified by the provider",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(getSigninUser(userID), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=email-already-in-use&errorDescription=Email+already+in+use",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(providers.Profile{
+				ID:            "1234567",
+				Email:         "jane@acme.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "",
+				Locale:        "",
+			})),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "new user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().InsertUserWithUserProvider(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertUserWithUserProviderParams{
+								ID:             uuid.UUID{},
+								Disabled:       false,
+								DisplayName:    "Jane Doe",
+								AvatarUrl:      "https://gitlab.com/avatar.png",
+								Email:          sql.Text("jane@acme.com"),
+								EmailVerified:  true,
+								Locale:         "en",
+								DefaultRole:    "user",
+								Metadata:       []byte(`{"picture":"https://gitlab.com/avatar.png"}`),
+								Roles:          []string{"user", "me"},
+								ProviderID:     "gitlab",
+								ProviderUserID: "1234567",
+								AccessToken:    "my-access-token",
+								RefreshToken:   sql.Text("my-refresh-token"),
+							},
+							cmpopts.IgnoreFields(sql.InsertUserWithUserProviderParams{}, "ID"), //nolint:exhaustruct
+						),
+					).Return(sql.InsertUserWithUserProviderRow{
+						UserID:    userID,
+						CreatedAt: sql.TimestampTz(time.Now()),
+					}, nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "new user with the locale returned by the provider",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().InsertUserWithUserProvider(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertUserWithUserProviderParams{
+								ID:             uuid.UUID{},
+								Disabled:       false,
+								DisplayName:    "Jane Doe",
+								AvatarUrl:      "https://lh3.googleusercontent.com/a/jane",
+								Email:          sql.Text("jane@acme.com"),
+								EmailVerified:  true,
+								Locale:         "es",
+								DefaultRole:    "user",
+								Metadata:       []byte(`{"locale":"es","picture":"https://lh3.googleusercontent.com/a/jane"}`),
+								Roles:          []string{"user", "me"},
+								ProviderID:     "gitlab",
+								ProviderUserID: "1234567",
+								AccessToken:    "my-access-token",
+								RefreshToken:   sql.Text("my-refresh-token"),
+							},
+							cmpopts.IgnoreFields(sql.InsertUserWithUserProviderParams{}, "ID"), //nolint:exhaustruct
+						),
+					).Return(sql.InsertUserWithUserProviderRow{
+						UserID:    userID,
+						CreatedAt: sql.TimestampTz(time.Now()),
+					}, nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(providers.Profile{
+				ID:            "1234567",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://lh3.googleusercontent.com/a/jane",
+				Locale:        "es",
+			})),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name: "new user but signup disabled",
+				config: func() *controller.Config {
+					config := getConfig()
+					config.DisableSignup = true
+					return config
+				},
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=signup-disabled&errorDescription=Sign+up+is+disabled.",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "disabled user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					user := getSigninUser(userID)
+					user.Disabled = true
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(user, nil)
+
+					mock.EXPECT().UpdateProviderSession(
+						gomock.Any(), gomock.Any(),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=disabled-user&errorDescription=User+is+disabled",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "link provider to the user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), state,
+					).Return(
+						[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"linkUserId":"db477732-48fa-4289-b694-2886a646b6eb","nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+						nil,
+					)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserProviders(
+						gomock.Any(), userID,
+					).Return([]sql.AuthUserProvider{}, nil)
+
+					mock.EXPECT().InsertUserProvider(
+						gomock.Any(),
+						cmpDBParams(sql.InsertUserProviderParams{
+							UserID:         userID,
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+							AccessToken:    "my-access-token",
+							RefreshToken:   sql.Text("my-refresh-token"),
+						}),
+					).Return(uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c"), nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "link provider already linked to another user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), state,
+					).Return(
+						[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"linkUserId":"db477732-48fa-4289-b694-2886a646b6eb","nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+						nil,
+					)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(
+						getSigninUser(uuid.MustParse("8f3b1a2c-5d4e-4f6a-9b7c-0d1e2f3a4b5c")), nil,
+					)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=provider-already-linked&errorDescription=Provider+account+already+linked",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "deanonymize anonymous user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), state,
+					).Return(
+						[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"deanonymizeUserId":"db477732-48fa-4289-b694-2886a646b6eb","nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+						nil,
+					)
+
+					anonymousUser := getSigninUser(userID)
+					anonymousUser.IsAnonymous = true
+					anonymousUser.Email = pgtype.Text{} //nolint:exhaustruct
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(anonymousUser, nil)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().DeleteUserRoles(gomock.Any(), userID).Return(nil)
+
+					mock.EXPECT().UpdateUserDeanonymizeWithUserProvider(
+						gomock.Any(),
+						cmpDBParams(sql.UpdateUserDeanonymizeWithUserProviderParams{
+							Roles:          []string{"user", "me"},
+							Email:          sql.Text("jane@acme.com"),
+							EmailVerified:  true,
+							AvatarUrl:      "https://gitlab.com/avatar.png",
+							DefaultRole:    "user",
+							DisplayName:    "Jane Doe",
+							Locale:         "en",
+							Metadata:       []byte(`{"picture":"https://gitlab.com/avatar.png"}`),
+							ID:             userID,
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+							AccessToken:    "my-access-token",
+							RefreshToken:   sql.Text("my-refresh-token"),
+						}),
+					).Return(userID, nil)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					insertRefreshToken(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "deanonymize with an email already in use",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), state,
+					).Return(
+						[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"deanonymizeUserId":"db477732-48fa-4289-b694-2886a646b6eb","nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+						nil,
+					)
+
+					anonymousUser := getSigninUser(userID)
+					anonymousUser.IsAnonymous = true
+					anonymousUser.Email = pgtype.Text{} //nolint:exhaustruct
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(anonymousUser, nil)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "gitlab",
+							ProviderUserID: "1234567",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(
+						getSigninUser(uuid.MustParse("8f3b1a2c-5d4e-4f6a-9b7c-0d1e2f3a4b5c")), nil,
+					)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=email-already-in-use&errorDescription=Email+already+in+use",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(gitlab(profile)),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "nonce cookie missing",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					deleteProviderRequest(mock)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderCallbackRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderCallbackParams{
+						State:            state.String(),
+						Code:             ptr("my-code"),
+						Error:            nil,
+						ErrorDescription: nil,
+						Cookie:           nil,
+					},
+				},
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=invalid-state&errorDescription=Invalid+or+expired+OAuth+state",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "nonce cookie from another browser",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					deleteProviderRequest(mock)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderCallbackRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderCallbackParams{
+						State:            state.String(),
+						Code:             ptr("my-code"),
+						Error:            nil,
+						ErrorDescription: nil,
+						Cookie:           ptr("nhostAuthProviderNonce=another-nonce"),
+					},
+				},
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=invalid-state&errorDescription=Invalid+or+expired+OAuth+state",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "invalid state",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), state,
+					).Return(nil, pgx.ErrNoRows)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=invalid-state&errorDescription=Invalid+or+expired+OAuth+state",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "provider returned an error",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					deleteProviderRequest(mock)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderCallbackRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderCallbackParams{
+						State:            state.String(),
+						Code:             nil,
+						Error:            ptr("access_denied"),
+						ErrorDescription: ptr("The user denied the request"),
+						Cookie:           ptr("nhostAuthProviderNonce=my-nonce"),
+					},
+				},
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=oauth-provider-error&errorDescription=Error+communicating+with+the+OAuth+provider",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderCallbackRequestObject, api.GetSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "exchange fails",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					deleteProviderRequest(mock)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.GetSigninProviderProviderCallback302Response{
+					Headers: api.GetSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=oauth-provider-error&errorDescription=Error+communicating+with+the+OAuth+provider",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(func(mock *providersmock.MockProvider) {
+				mock.EXPECT().Exchange(
+					gomock.Any(), "my-code", "my-verifier",
+				).Return(nil, errors.New("invalid_grant")) //nolint:goerr113
+			}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        tc.providers,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetSigninProviderProviderCallback, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast(
+					[]string{".Location"}, cmp.Comparer(cmpRedirectLocation),
+				),
+			)
+		})
+	}
+}
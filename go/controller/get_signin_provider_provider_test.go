@@ -0,0 +1,419 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	providersmock "github.com/nhost/hasura-auth/go/providers/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+// cmpProviderRequest compares the stored provider requests ignoring the code verifier,
+// which is random, as long as it is set.
+func cmpProviderRequest(x, y []byte) bool {
+	var mx, my map[string]any
+	if err := json.Unmarshal(x, &mx); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(y, &my); err != nil {
+		return false
+	}
+
+	if mx["codeVerifier"] == "" || my["codeVerifier"] == "" {
+		return false
+	}
+	delete(mx, "codeVerifier")
+	delete(my, "codeVerifier")
+
+	if (mx["nonceHash"] == nil) != (my["nonceHash"] == nil) {
+		return false
+	}
+	delete(mx, "nonceHash")
+	delete(my, "nonceHash")
+
+	return cmp.Equal(mx, my)
+}
+
+var (
+	randomStateRegexp = regexp.MustCompile(`state=[^&]+`)
+	randomNonceRegexp = regexp.MustCompile(`nhostAuthProviderNonce=[^;]+`)
+)
+
+// cmpRandomState compares URLs ignoring the value of the state, which is random.
+var cmpRandomState = cmp.Comparer(func(x, y string) bool { //nolint:gochecknoglobals
+	return randomStateRegexp.ReplaceAllString(x, "state=xxx") ==
+		randomStateRegexp.ReplaceAllString(y, "state=xxx")
+})
+
+// cmpRandomNonce compares cookies ignoring the value of the nonce, which is random.
+var cmpRandomNonce = cmp.Comparer(func(x, y string) bool { //nolint:gochecknoglobals
+	return randomNonceRegexp.ReplaceAllString(x, "nhostAuthProviderNonce=xxx") ==
+		randomNonceRegexp.ReplaceAllString(y, "nhostAuthProviderNonce=xxx")
+})
+
+func gitlabProvider(
+	fn func(mock *providersmock.MockProvider),
+) func(ctrl *gomock.Controller) map[string]providers.Provider {
+	return func(ctrl *gomock.Controller) map[string]providers.Provider {
+		mock := providersmock.NewMockProvider(ctrl)
+		if fn != nil {
+			fn(mock)
+		}
+		return map[string]providers.Provider{"gitlab": mock}
+	}
+}
+
+type testSigninProviderRequest struct {
+	testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]
+	providers func(ctrl *gomock.Controller) map[string]providers.Provider
+}
+
+func TestGetSigninProviderProvider(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	authorizationURL := func(mock *providersmock.MockProvider) {
+		mock.EXPECT().AuthorizationURL(
+			gomock.Any(), gomock.Any(),
+		).Return("https://gitlab.com/oauth/authorize?state=xxx")
+	}
+
+	cases := []testSigninProviderRequest{
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "simple",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().InsertProviderRequest(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertProviderRequestParams{ //nolint:exhaustruct
+								Options: []byte(`{"codeVerifier":"xxx","options":{"redirectTo":"http://localhost:3000"},"nonceHash":"xxx"}`),
+							},
+							cmpopts.IgnoreFields(sql.InsertProviderRequestParams{}, "ID"), //nolint:exhaustruct
+							testhelpers.FilterPathLast(
+								[]string{".Options"}, cmp.Comparer(cmpProviderRequest),
+							),
+						),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params:   api.GetSigninProviderProviderParams{}, //nolint:exhaustruct
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location:  "https://gitlab.com/oauth/authorize?state=xxx",
+						SetCookie: "nhostAuthProviderNonce=xxx; Path=/signin/provider; Max-Age=600; HttpOnly; Secure; SameSite=None",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(authorizationURL),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name: "with options",
+				config: func() *controller.Config {
+					config := getConfig()
+					config.AllowedRedirectURLs = []string{"http://localhost:3000/redirect"}
+					return config
+				},
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().InsertProviderRequest(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertProviderRequestParams{ //nolint:exhaustruct
+								Options: []byte(`{"codeVerifier":"xxx","options":{"allowedRoles":["me"],"defaultRole":"me","displayName":"Jane","locale":"es","metadata":{"key":"value"},"redirectTo":"http://localhost:3000/redirect"},"nonceHash":"xxx"}`), //nolint:lll
+							},
+							cmpopts.IgnoreFields(sql.InsertProviderRequestParams{}, "ID"), //nolint:exhaustruct
+							testhelpers.FilterPathLast(
+								[]string{".Options"}, cmp.Comparer(cmpProviderRequest),
+							),
+						),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderParams{
+						RedirectTo:   ptr("http://localhost:3000/redirect"),
+						AllowedRoles: &[]string{"me"},
+						DefaultRole:  ptr("me"),
+						DisplayName:  ptr("Jane"),
+						Locale:       ptr("es"),
+						Metadata:     ptr(`{"key":"value"}`),
+					},
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location:  "https://gitlab.com/oauth/authorize?state=xxx",
+						SetCookie: "nhostAuthProviderNonce=xxx; Path=/signin/provider; Max-Age=600; HttpOnly; Secure; SameSite=None",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(authorizationURL),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "provider not enabled",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "github",
+					Params:   api.GetSigninProviderProviderParams{}, //nolint:exhaustruct
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location: "http://localhost:3000?error=disabled-endpoint&errorDescription=This+endpoint+is+disabled",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "role not allowed",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderParams{ //nolint:exhaustruct
+						AllowedRoles: &[]string{"admin"},
+					},
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location: "http://localhost:3000?error=role-not-allowed&errorDescription=Role+not+allowed",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "invalid metadata",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderParams{ //nolint:exhaustruct
+						Metadata: ptr(`not-json`),
+					},
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location: "http://localhost:3000?error=invalid-request&errorDescription=The+request+payload+is+incorrect",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "continue linking in the browser",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), uuid.MustParse("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1"),
+					).Return(
+						[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"linkUserId":"db477732-48fa-4289-b694-2886a646b6eb"}`), //nolint:lll
+						nil,
+					)
+
+					mock.EXPECT().InsertProviderRequest(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertProviderRequestParams{ //nolint:exhaustruct
+								Options: []byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"linkUserId":"db477732-48fa-4289-b694-2886a646b6eb","nonceHash":"xxx"}`), //nolint:lll
+							},
+							cmpopts.IgnoreFields(sql.InsertProviderRequestParams{}, "ID"), //nolint:exhaustruct
+							testhelpers.FilterPathLast(
+								[]string{".Options"}, cmp.Comparer(cmpProviderRequest),
+							),
+						),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderParams{ //nolint:exhaustruct
+						State: ptr("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1"),
+					},
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location:  "https://gitlab.com/oauth/authorize?state=xxx",
+						SetCookie: "nhostAuthProviderNonce=xxx; Path=/signin/provider; Max-Age=600; HttpOnly; Secure; SameSite=None",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(func(mock *providersmock.MockProvider) {
+				mock.EXPECT().AuthorizationURL(
+					gomock.Any(), "my-verifier",
+				).Return("https://gitlab.com/oauth/authorize?state=xxx")
+			}),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "continue a sign in already bound to a browser",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), uuid.MustParse("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1"),
+					).Return(
+						[]byte(`{"codeVerifier":"my-verifier","options":{"redirectTo":"http://localhost:3000"},"linkUserId":"db477732-48fa-4289-b694-2886a646b6eb","nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+						nil,
+					)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderParams{ //nolint:exhaustruct
+						State: ptr("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1"),
+					},
+				},
+				expectedResponse: api.GetSigninProviderProvider302Response{
+					Headers: api.GetSigninProviderProvider302ResponseHeaders{
+						Location:  "http://localhost:3000?error=invalid-state&errorDescription=Invalid+or+expired+OAuth+state",
+						SetCookie: "",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninProviderProviderRequestObject, api.GetSigninProviderProviderResponseObject]{ //nolint:lll
+				name:   "redirectTo not allowed",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninProviderProviderRequestObject{
+					Provider: "gitlab",
+					Params: api.GetSigninProviderProviderParams{ //nolint:exhaustruct
+						RedirectTo: ptr("https://evil.com"),
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "redirectTo-not-allowed",
+					Message: `The value of "options.redirectTo" is not allowed.`,
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: gitlabProvider(nil),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        tc.providers,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetSigninProviderProvider, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast([]string{".SetCookie"}, cmpRandomNonce),
+			)
+		})
+	}
+}
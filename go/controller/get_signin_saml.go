@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetSigninSaml( //nolint:ireturn
+	ctx context.Context,
+	request api.GetSigninSamlRequestObject,
+) (api.GetSigninSamlResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", samlProviderID))
+
+	redirectTo, apiErr := ctrl.getSigninProviderRedirectTo(request.Params.RedirectTo, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	redirectWithError := func(apiErr *APIError) api.GetSigninSamlResponseObject {
+		return api.GetSigninSaml302Response{
+			Headers: api.GetSigninSaml302ResponseHeaders{
+				Location: ctrl.sendRedirectError(redirectTo, apiErr),
+			},
+		}
+	}
+
+	if ctrl.saml == nil {
+		logger.Warn("saml is disabled")
+		return redirectWithError(ErrDisabledEndpoint), nil
+	}
+
+	options, apiErr := ctrl.getSigninProviderValidateRequest(
+		api.GetSigninProviderProviderParams{
+			RedirectTo:   request.Params.RedirectTo,
+			AllowedRoles: request.Params.AllowedRoles,
+			DefaultRole:  request.Params.DefaultRole,
+			DisplayName:  request.Params.DisplayName,
+			Locale:       request.Params.Locale,
+			Metadata:     request.Params.Metadata,
+			State:        nil,
+		},
+		logger,
+	)
+	if apiErr != nil {
+		return redirectWithError(apiErr), nil
+	}
+
+	state := uuid.New()
+	authURL, requestID, err := ctrl.saml.AuthenticationRequestURL(state.String())
+	if err != nil {
+		logger.Error("error creating saml authentication request", logError(err))
+		return redirectWithError(ErrInternalServerError), nil
+	}
+
+	if apiErr := ctrl.wf.InsertProviderRequest(
+		ctx,
+		state,
+		providerRequest{
+			CodeVerifier:  "",
+			Options:       options,
+			SAMLRequestID: requestID,
+			LinkUserID:    nil,
+		},
+		logger,
+	); apiErr != nil {
+		return redirectWithError(apiErr), nil
+	}
+
+	return api.GetSigninSaml302Response{
+		Headers: api.GetSigninSaml302ResponseHeaders{
+			Location: authURL,
+		},
+	}, nil
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetSigninSamlMetadata( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetSigninSamlMetadataRequestObject,
+) (api.GetSigninSamlMetadataResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if ctrl.saml == nil {
+		logger.Warn("saml is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	metadata, err := ctrl.saml.Metadata()
+	if err != nil {
+		logger.Error("error generating saml metadata", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	return api.GetSigninSamlMetadata200ApplicationsamlmetadataXmlResponse{
+		Body:          bytes.NewReader(metadata),
+		ContentLength: int64(len(metadata)),
+	}, nil
+}
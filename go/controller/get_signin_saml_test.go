@@ -0,0 +1,179 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+type testSigninSAMLRequest struct {
+	testRequest[api.GetSigninSamlRequestObject, api.GetSigninSamlResponseObject]
+	saml func(ctrl *gomock.Controller) *mock.MockSAMLServiceProvider
+}
+
+func TestGetSigninSaml(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	authenticationRequestURL := func(ctrl *gomock.Controller) *mock.MockSAMLServiceProvider {
+		mock := mock.NewMockSAMLServiceProvider(ctrl)
+		mock.EXPECT().AuthenticationRequestURL(
+			gomock.Any(),
+		).Return("https://idp.acme.com/sso?SAMLRequest=xxx", "id-1234", nil)
+		return mock
+	}
+
+	cases := []testSigninSAMLRequest{
+		{
+			testRequest: testRequest[api.GetSigninSamlRequestObject, api.GetSigninSamlResponseObject]{
+				name:   "simple",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().InsertProviderRequest(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertProviderRequestParams{ //nolint:exhaustruct
+								Options: []byte(`{"codeVerifier":"","options":{"redirectTo":"http://localhost:3000"},"samlRequestId":"id-1234"}`), //nolint:lll
+							},
+							cmpopts.IgnoreFields(sql.InsertProviderRequestParams{}, "ID"), //nolint:exhaustruct
+						),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninSamlRequestObject{
+					Params: api.GetSigninSamlParams{}, //nolint:exhaustruct
+				},
+				expectedResponse: api.GetSigninSaml302Response{
+					Headers: api.GetSigninSaml302ResponseHeaders{
+						Location: "https://idp.acme.com/sso?SAMLRequest=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: authenticationRequestURL,
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninSamlRequestObject, api.GetSigninSamlResponseObject]{
+				name:   "saml disabled",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninSamlRequestObject{
+					Params: api.GetSigninSamlParams{}, //nolint:exhaustruct
+				},
+				expectedResponse: api.GetSigninSaml302Response{
+					Headers: api.GetSigninSaml302ResponseHeaders{
+						Location: "http://localhost:3000?error=disabled-endpoint&errorDescription=This+endpoint+is+disabled",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: nil,
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninSamlRequestObject, api.GetSigninSamlResponseObject]{
+				name:   "role not allowed",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninSamlRequestObject{
+					Params: api.GetSigninSamlParams{ //nolint:exhaustruct
+						AllowedRoles: &[]string{"admin"},
+					},
+				},
+				expectedResponse: api.GetSigninSaml302Response{
+					Headers: api.GetSigninSaml302ResponseHeaders{
+						Location: "http://localhost:3000?error=role-not-allowed&errorDescription=Role+not+allowed",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: mock.NewMockSAMLServiceProvider,
+		},
+
+		{
+			testRequest: testRequest[api.GetSigninSamlRequestObject, api.GetSigninSamlResponseObject]{
+				name:   "redirectTo not allowed",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.GetSigninSamlRequestObject{
+					Params: api.GetSigninSamlParams{ //nolint:exhaustruct
+						RedirectTo: ptr("https://evil.com"),
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "redirectTo-not-allowed",
+					Message: `The value of "options.redirectTo" is not allowed.`,
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: mock.NewMockSAMLServiceProvider,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             tc.saml,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetSigninSaml, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetUserDataExportExportId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.GetUserDataExportExportIdRequestObject,
+) (api.GetUserDataExportExportIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	export, apiErr := ctrl.wf.GetDataExport(ctx, request.ExportId, &user.ID, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	resp, apiErr := ctrl.wf.DataExportResponse(export, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.GetUserDataExportExportId200JSONResponse(resp), nil
+}
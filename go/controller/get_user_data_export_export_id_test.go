@@ -0,0 +1,294 @@
+package controller_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+const dataExportDownloadURL = "https://local.auth.nhost.run/data-export/download?ticket="
+
+func getDataExport(id, userID uuid.UUID, status api.DataExportStatus) sql.AuthDataExport {
+	createdAt := time.Now().Add(-time.Hour)
+
+	export := sql.AuthDataExport{
+		ID:            id,
+		CreatedAt:     sql.TimestampTz(createdAt),
+		UserID:        userID,
+		RequestedBy:   "user",
+		Status:        string(status),
+		Attempts:      0,
+		NextAttemptAt: sql.TimestampTz(createdAt),
+		LastError:     pgtype.Text{}, //nolint:exhaustruct
+		Data:          nil,
+		CompletedAt:   pgtype.Timestamptz{}, //nolint:exhaustruct
+		ExpiresAt:     pgtype.Timestamptz{}, //nolint:exhaustruct
+	}
+
+	if status == api.Completed {
+		export.Data = []byte(`{"exportedAt":"2024-05-01T10:00:00Z","profile":{"id":"` +
+			userID.String() + `","createdAt":"2024-01-01T00:00:00Z","disabled":false,` +
+			`"displayName":"Jane Doe","avatarUrl":"","locale":"en","email":"jane@acme.com",` +
+			`"emailVerified":true,"phoneNumberVerified":false,"defaultRole":"user",` +
+			`"roles":["user"],"providers":[],"isAnonymous":false,"metadata":{}},` +
+			`"providers":[],"sessions":[],"auditLogs":[]}`)
+		export.CompletedAt = sql.TimestampTz(createdAt.Add(time.Minute))
+		export.ExpiresAt = sql.TimestampTz(createdAt.Add(7 * 24 * time.Hour))
+	}
+
+	return export
+}
+
+func getAPIDataExport(
+	id, userID uuid.UUID, status api.DataExportStatus,
+) api.DataExport {
+	export := getDataExport(id, userID, status)
+
+	resp := api.DataExport{
+		Id:          id,
+		UserId:      userID,
+		Status:      status,
+		CreatedAt:   export.CreatedAt.Time,
+		CompletedAt: nil,
+		ExpiresAt:   nil,
+		DownloadUrl: nil,
+	}
+
+	if status == api.Completed {
+		resp.CompletedAt = &export.CompletedAt.Time
+		resp.ExpiresAt = &export.ExpiresAt.Time
+		resp.DownloadUrl = ptr(dataExportDownloadURL)
+	}
+
+	return resp
+}
+
+// cmpDataExport only compares the download URLs up to the ticket, which is signed when
+// the response is sent, and the times of the export approximately.
+func cmpDataExport() cmp.Options {
+	return cmp.Options{
+		testhelpers.FilterPathLast(
+			[]string{".DownloadUrl"},
+			cmp.Comparer(func(x, y *string) bool {
+				if x == nil || y == nil {
+					return x == y
+				}
+				return strings.HasPrefix(*x, dataExportDownloadURL) &&
+					strings.HasPrefix(*y, dataExportDownloadURL)
+			}),
+		),
+		testhelpers.FilterPathLast(
+			[]string{".CompletedAt", "*"}, cmpopts.EquateApproxTime(time.Minute),
+		),
+		testhelpers.FilterPathLast(
+			[]string{".ExpiresAt", "*"}, cmpopts.EquateApproxTime(time.Minute),
+		),
+	}
+}
+
+func dataExportTicket(t *testing.T, downloadURL *string) string {
+	t.Helper()
+
+	if downloadURL == nil {
+		t.Fatal("expected a download url")
+	}
+
+	u, err := url.Parse(*downloadURL)
+	if err != nil {
+		t.Fatalf("failed to parse download url: %v", err)
+	}
+
+	return u.Query().Get("ticket")
+}
+
+func TestGetUserDataExportExportId(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	exportID := uuid.MustParse("e8c4b5a2-3f1d-4c6e-9a7b-2d5f8e1c4b3a")
+
+	cases := []testRequest[
+		api.GetUserDataExportExportIdRequestObject,
+		api.GetUserDataExportExportIdResponseObject,
+	]{
+		{
+			name:   "pending",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					getDataExport(exportID, userID, api.Pending), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetUserDataExportExportIdRequestObject{
+				ExportId: exportID,
+			},
+			expectedResponse: api.GetUserDataExportExportId200JSONResponse(
+				getAPIDataExport(exportID, userID, api.Pending),
+			),
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "completed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					getDataExport(exportID, userID, api.Completed), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetUserDataExportExportIdRequestObject{
+				ExportId: exportID,
+			},
+			expectedResponse: api.GetUserDataExportExportId200JSONResponse(
+				getAPIDataExport(exportID, userID, api.Completed),
+			),
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "export of another user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					getDataExport(
+						exportID,
+						uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"),
+						api.Completed,
+					), nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetUserDataExportExportIdRequestObject{
+				ExportId: exportID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "data-export-not-found",
+				Message: "Data export not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetDataExport(gomock.Any(), exportID).Return(
+					sql.AuthDataExport{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.GetUserDataExportExportIdRequestObject{
+				ExportId: exportID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "data-export-not-found",
+				Message: "Data export not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.GetUserDataExportExportId,
+				tc.request, tc.expectedResponse,
+				cmpDataExport(),
+			)
+
+			resp200, ok := resp.(api.GetUserDataExportExportId200JSONResponse)
+			if !ok || resp200.Status != api.Completed {
+				return
+			}
+
+			id, err := jwtGetter.ValidateDataExportTicket(
+				dataExportTicket(t, resp200.DownloadUrl),
+			)
+			if err != nil {
+				t.Fatalf("failed to validate ticket: %v", err)
+			}
+			if id != exportID {
+				t.Errorf("expected ticket of export %s, got %s", exportID, id)
+			}
+		})
+	}
+}
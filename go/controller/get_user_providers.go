@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetUserProviders( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetUserProvidersRequestObject,
+) (api.GetUserProvidersResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	userProviders, err := ctrl.wf.db.GetUserProviders(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user providers", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	providers := make([]api.UserProvider, len(userProviders))
+	for i, p := range userProviders {
+		providers[i] = api.UserProvider{
+			Id:        p.ID.String(),
+			Provider:  p.ProviderID,
+			CreatedAt: p.CreatedAt.Time,
+		}
+	}
+
+	return api.GetUserProviders200JSONResponse{
+		Providers: providers,
+	}, nil
+}
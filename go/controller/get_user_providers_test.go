@@ -0,0 +1,134 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserProviders(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []testRequest[api.GetUserProvidersRequestObject, api.GetUserProvidersResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserProvider{
+					{ //nolint:exhaustruct
+						ID:             uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c"),
+						CreatedAt:      sql.TimestampTz(createdAt),
+						UserID:         userID,
+						ProviderID:     "github",
+						ProviderUserID: "1234567",
+					},
+					{ //nolint:exhaustruct
+						ID:             uuid.MustParse("5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"),
+						CreatedAt:      sql.TimestampTz(createdAt.Add(time.Hour)),
+						UserID:         userID,
+						ProviderID:     "gitlab",
+						ProviderUserID: "7654321",
+					},
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetUserProvidersRequestObject{},
+			expectedResponse: api.GetUserProviders200JSONResponse{
+				Providers: []api.UserProvider{
+					{
+						Id:        "a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c",
+						Provider:  "github",
+						CreatedAt: createdAt,
+					},
+					{
+						Id:        "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b",
+						Provider:  "gitlab",
+						CreatedAt: createdAt.Add(time.Hour),
+					},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "no providers",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserProviders(
+					gomock.Any(), userID,
+				).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetUserProvidersRequestObject{},
+			expectedResponse: api.GetUserProviders200JSONResponse{
+				Providers: []api.UserProvider{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.GetUserProviders, tc.request, tc.expectedResponse)
+		})
+	}
+}
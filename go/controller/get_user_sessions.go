@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func sessionFromRefreshToken(token sql.AuthRefreshToken) api.UserSession {
+	session := api.UserSession{
+		Id:            token.ID.String(),
+		CreatedAt:     token.CreatedAt.Time,
+		ExpiresAt:     token.ExpiresAt.Time,
+		LastUsedAt:    nil,
+		IpAddress:     nil,
+		UserAgent:     nil,
+		ClientId:      nil,
+		ClientVersion: nil,
+		Country:       nil,
+		City:          nil,
+	}
+	if token.LastUsedAt.Valid {
+		session.LastUsedAt = ptr(token.LastUsedAt.Time)
+	}
+	if token.IpAddress.Valid {
+		session.IpAddress = ptr(token.IpAddress.String)
+	}
+	if token.UserAgent.Valid {
+		session.UserAgent = ptr(token.UserAgent.String)
+	}
+	if token.ClientID.Valid {
+		session.ClientId = ptr(token.ClientID.String)
+	}
+	if token.ClientVersion.Valid {
+		session.ClientVersion = ptr(token.ClientVersion.String)
+	}
+	if token.Country.Valid {
+		session.Country = ptr(token.Country.String)
+	}
+	if token.City.Valid {
+		session.City = ptr(token.City.String)
+	}
+	return session
+}
+
+func (ctrl *Controller) GetUserSessions( //nolint:ireturn
+	ctx context.Context,
+	_ api.GetUserSessionsRequestObject,
+) (api.GetUserSessionsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	refreshTokens, err := ctrl.wf.db.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user sessions", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	sessions := make([]api.UserSession, len(refreshTokens))
+	for i, token := range refreshTokens {
+		sessions[i] = sessionFromRefreshToken(token)
+	}
+
+	return api.GetUserSessions200JSONResponse{
+		Sessions: sessions,
+	}, nil
+}
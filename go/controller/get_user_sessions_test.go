@@ -0,0 +1,155 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserSessions(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []testRequest[api.GetUserSessionsRequestObject, api.GetUserSessionsResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserSessions(
+					gomock.Any(), userID,
+				).Return([]sql.AuthRefreshToken{
+					{ //nolint:exhaustruct
+						ID:            uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+						CreatedAt:     sql.TimestampTz(createdAt.Add(time.Hour)),
+						ExpiresAt:     sql.TimestampTz(createdAt.Add(31 * 24 * time.Hour)),
+						UserID:        userID,
+						Type:          sql.RefreshTokenTypeRegular,
+						LastUsedAt:    sql.TimestampTz(createdAt.Add(24 * time.Hour)),
+						IpAddress:     sql.Text("203.0.113.7"),
+						UserAgent:     sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+						ClientID:      sql.Text("web"),
+						ClientVersion: sql.Text("3.2.0"),
+						Country:       sql.Text("GB"),
+						City:          sql.Text("London"),
+					},
+					{ //nolint:exhaustruct
+						ID:        uuid.MustParse("5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"),
+						CreatedAt: sql.TimestampTz(createdAt),
+						ExpiresAt: sql.TimestampTz(createdAt.Add(30 * 24 * time.Hour)),
+						UserID:    userID,
+						Type:      sql.RefreshTokenTypeRegular,
+					},
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetUserSessionsRequestObject{},
+			expectedResponse: api.GetUserSessions200JSONResponse{
+				Sessions: []api.UserSession{
+					{
+						Id:            "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+						CreatedAt:     createdAt.Add(time.Hour),
+						ExpiresAt:     createdAt.Add(31 * 24 * time.Hour),
+						LastUsedAt:    ptr(createdAt.Add(24 * time.Hour)),
+						IpAddress:     ptr("203.0.113.7"),
+						UserAgent:     ptr("Mozilla/5.0 (X11; Linux x86_64)"),
+						ClientId:      ptr("web"),
+						ClientVersion: ptr("3.2.0"),
+						Country:       ptr("GB"),
+						City:          ptr("London"),
+					},
+					{
+						Id:            "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b",
+						CreatedAt:     createdAt,
+						ExpiresAt:     createdAt.Add(30 * 24 * time.Hour),
+						LastUsedAt:    nil,
+						IpAddress:     nil,
+						UserAgent:     nil,
+						ClientId:      nil,
+						ClientVersion: nil,
+						Country:       nil,
+						City:          nil,
+					},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "no sessions",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserSessions(
+					gomock.Any(), userID,
+				).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.GetUserSessionsRequestObject{},
+			expectedResponse: api.GetUserSessions200JSONResponse{
+				Sessions: []api.UserSession{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.GetUserSessions, tc.request, tc.expectedResponse)
+		})
+	}
+}
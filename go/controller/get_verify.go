@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func (ctrl *Controller) getVerifyValidateRequest(
+	request api.GetVerifyRequestObject,
+	logger *slog.Logger,
+) (*url.URL, *APIError) {
+	if !ctrl.wf.redirectURLValidator(request.Params.RedirectTo) {
+		logger.Warn("redirect URL not allowed")
+		return nil, ErrRedirecToNotAllowed
+	}
+
+	redirectTo, err := url.Parse(request.Params.RedirectTo)
+	if err != nil {
+		logger.Error("error parsing redirect URL", logError(err))
+		return nil, ErrInvalidRequest
+	}
+
+	return redirectTo, nil
+}
+
+// verifyTicketTypes are the types of tickets each type of link accepts, so a ticket can
+// only be used for what it was issued for.
+var verifyTicketTypes = map[api.GetVerifyParamsType][]TicketType{ //nolint:gochecknoglobals
+	api.AccountDeletionCancel: {TicketTypeAccountDeletionCancel},
+	api.EmailChangeRevert:     {TicketTypeEmailChangeRevert},
+	api.EmailConfirmChange:    {TicketTypeEmailConfirmChange},
+	api.EmailVerify:           {TicketTypeVerifyEmail},
+	api.NewDeviceRevoke:       {TicketTypeNewDeviceRevoke},
+	api.PasswordReset:         {TicketTypePasswordReset, TicketTypePasswordResetRevokeSessions},
+	api.SigninPasswordless:    {TicketTypePasswordLessEmail},
+}
+
+func ticketMatchesType(ticket string, ticketType api.GetVerifyParamsType) bool {
+	for _, t := range verifyTicketTypes[ticketType] {
+		if strings.HasPrefix(ticket, string(t)+":") {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctrl *Controller) getVerifyProcessTicket( //nolint:cyclop,funlen
+	ctx context.Context,
+	ticketType api.GetVerifyParamsType,
+	ticket string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	if !ticketMatchesType(ticket, ticketType) {
+		logger.Warn("ticket doesn't match the type of the link")
+		return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+	}
+
+	if ticketType == api.SigninPasswordless && !ctrl.config.EmailPasswordlessEnabled {
+		logger.Warn("email passwordless signin is disabled")
+		return sql.AuthUser{}, ErrDisabledEndpoint //nolint:exhaustruct
+	}
+
+	if ticketType == api.SigninPasswordless {
+		if apiErr := ctrl.checkSignInMethod(ctx, signInMethodPasswordlessEmail, logger); apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+	}
+
+	if ticketType == api.EmailChangeRevert {
+		user, apiErr := ctrl.wf.RevertEmailChange(ctx, ticket, logger)
+		if apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+		if user.Disabled || userBanned(user) {
+			logger.Warn("user is disabled or banned")
+			return sql.AuthUser{}, ErrDisabledUser //nolint:exhaustruct
+		}
+		return user, nil
+	}
+
+	if ticketType == api.NewDeviceRevoke {
+		return ctrl.wf.RevokeNewDeviceSessions(ctx, ticket, logger)
+	}
+
+	if ticketType == api.AccountDeletionCancel {
+		user, apiErr := ctrl.wf.CancelAccountDeletion(ctx, ticket, logger)
+		if apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+		if user.Disabled || userBanned(user) {
+			logger.Warn("user is disabled or banned")
+			return sql.AuthUser{}, ErrDisabledUser //nolint:exhaustruct
+		}
+		return user, nil
+	}
+
+	user, apiErr := ctrl.wf.ConsumeTicket(ctx, ticket, logger)
+	if apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	var err error
+	switch ticketType {
+	case api.EmailVerify, api.SigninPasswordless:
+		user, err = ctrl.wf.db.UpdateUserVerifyEmail(ctx, user.ID)
+	case api.EmailConfirmChange:
+		if !user.NewEmail.Valid || user.NewEmail.String == "" {
+			logger.Warn("user doesn't have an email change pending")
+			return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+		}
+
+		exists, apiErr := ctrl.wf.UserByEmailExists(ctx, user.NewEmail.String, logger)
+		if apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+		if exists {
+			logger.Warn("new email already in use")
+			return sql.AuthUser{}, ErrEmailAlreadyInUse //nolint:exhaustruct
+		}
+
+		user, err = ctrl.wf.db.UpdateUserConfirmChangeEmail(ctx, user.ID)
+		if err != nil {
+			return sql.AuthUser{}, sqlErrIsDuplicatedEmail(err, logger) //nolint:exhaustruct
+		}
+	case api.PasswordReset:
+		// the user will be redirected as signed in with a new session
+		if strings.HasPrefix(ticket, string(TicketTypePasswordResetRevokeSessions)+":") {
+			if apiErr := ctrl.wf.RevokeSessions(ctx, user.ID, logger); apiErr != nil {
+				return sql.AuthUser{}, apiErr //nolint:exhaustruct
+			}
+		}
+	default:
+		logger.Warn("unknown ticket type", slog.String("type", string(ticketType)))
+		return sql.AuthUser{}, ErrInvalidRequest //nolint:exhaustruct
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user not found")
+		return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error updating user", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if user.Disabled || userBanned(user) || user.DeletionScheduledAt.Valid {
+		logger.Warn("user is disabled, banned or scheduled for deletion")
+		return sql.AuthUser{}, ErrDisabledUser //nolint:exhaustruct
+	}
+
+	switch ticketType { //nolint:exhaustive
+	case api.EmailVerify:
+		ctrl.wf.SendWebhook(ctx, webhooks.EventUserEmailVerified, webhookUser(user))
+	case api.EmailConfirmChange:
+		ctrl.wf.SendWebhook(ctx, webhooks.EventUserEmailChanged, webhookUser(user))
+	case api.PasswordReset:
+		ctrl.wf.SendWebhook(ctx, webhooks.EventUserPasswordReset, webhookUser(user))
+	}
+
+	return user, nil
+}
+
+func (ctrl *Controller) GetVerify( //nolint:ireturn
+	ctx context.Context,
+	request api.GetVerifyRequestObject,
+) (api.GetVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("type", string(request.Params.Type)))
+
+	redirectTo, apiErr := ctrl.getVerifyValidateRequest(request, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.getVerifyProcessTicket(
+		ctx, request.Params.Type, request.Params.Ticket, logger,
+	)
+	if apiErr != nil {
+		return api.GetVerify302Response{
+			Headers: api.GetVerify302ResponseHeaders{
+				Location: ctrl.sendRedirectError(redirectTo, apiErr),
+			},
+		}, nil
+	}
+
+	if apiErr := ctrl.wf.CheckSignInRisk(ctx, user, logger); apiErr != nil {
+		return api.GetVerify302Response{
+			Headers: api.GetVerify302ResponseHeaders{
+				Location: ctrl.sendRedirectError(redirectTo, apiErr),
+			},
+		}, nil
+	}
+
+	refreshToken := uuid.New().String()
+	if _, apiErr := ctrl.wf.InsertRefreshtoken(
+		ctx, user.ID, refreshToken, ctrl.wf.sessionLifetimes(ctx), sql.RefreshTokenTypeRegular, nil, logger,
+	); apiErr != nil {
+		return api.GetVerify302Response{
+			Headers: api.GetVerify302ResponseHeaders{
+				Location: ctrl.sendRedirectError(redirectTo, apiErr),
+			},
+		}, nil
+	}
+
+	ctrl.wf.SendWebhook(ctx, webhooks.EventUserSignedIn, webhookUser(user))
+
+	query := redirectTo.Query()
+	query.Set("refreshToken", refreshToken)
+	query.Set("type", string(request.Params.Type))
+	redirectTo.RawQuery = query.Encode()
+
+	return api.GetVerify302Response{
+		Headers: api.GetVerify302ResponseHeaders{
+			Location: redirectTo.String(),
+		},
+	}, nil
+}
@@ -0,0 +1,864 @@
+package controller_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/risk"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func cmpRedirectLocation(x, y string) bool {
+	ux, err := url.Parse(x)
+	if err != nil {
+		return false
+	}
+
+	uy, err := url.Parse(y)
+	if err != nil {
+		return false
+	}
+
+	qx := ux.Query()
+	qy := uy.Query()
+	if (qx.Get("refreshToken") == "") != (qy.Get("refreshToken") == "") {
+		return false
+	}
+	qx.Del("refreshToken")
+	qy.Del("refreshToken")
+	ux.RawQuery = ""
+	uy.RawQuery = ""
+
+	return ux.String() == uy.String() && qx.Encode() == qy.Encode()
+}
+
+func TestGetVerify(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+
+	insertRefreshToken := func(mock *mock.MockDBClient) {
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: "asdadasdasdasd",
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"), nil)
+	}
+
+	cases := []testRequest[api.GetVerifyRequestObject, api.GetVerifyResponseObject]{
+		{
+			name: "signin passwordless",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.EmailPasswordlessEnabled = true
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("passwordlessEmail:xxx"),
+				).Return(user, nil)
+
+				mock.EXPECT().UpdateUserVerifyEmail(
+					gomock.Any(),
+					userID,
+				).Return(getSigninUser(userID), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "passwordlessEmail:xxx",
+					Type:       api.SigninPasswordless,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?refreshToken=xxx&type=signinPasswordless",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "signin passwordless disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "passwordlessEmail:xxx",
+					Type:       api.SigninPasswordless,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=disabled-endpoint&errorDescription=This+endpoint+is+disabled", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "verify email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("verifyEmail:xxx"),
+				).Return(user, nil)
+
+				mock.EXPECT().UpdateUserVerifyEmail(
+					gomock.Any(),
+					userID,
+				).Return(getSigninUser(userID), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "verifyEmail:xxx",
+					Type:       api.EmailVerify,
+					RedirectTo: "http://localhost:3000/verified",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000/verified?refreshToken=xxx&type=emailVerify",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "confirm email change",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.NewEmail = sql.Text("janenew@acme.com")
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("emailConfirmChange:xxx"),
+				).Return(user, nil)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("janenew@acme.com"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				updatedUser := getSigninUser(userID)
+				updatedUser.Email = sql.Text("janenew@acme.com")
+				mock.EXPECT().UpdateUserConfirmChangeEmail(
+					gomock.Any(),
+					userID,
+				).Return(updatedUser, nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "emailConfirmChange:xxx",
+					Type:       api.EmailConfirmChange,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?refreshToken=xxx&type=emailConfirmChange",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "confirm email change, email already in use",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.NewEmail = sql.Text("janenew@acme.com")
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("emailConfirmChange:xxx"),
+				).Return(user, nil)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("janenew@acme.com"),
+				).Return(getSigninUser(uuid.New()), nil)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "emailConfirmChange:xxx",
+					Type:       api.EmailConfirmChange,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=email-already-in-use&errorDescription=Email+already+in+use", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "confirm email change, ticket of another type",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "verifyEmail:xxx",
+					Type:       api.EmailConfirmChange,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "confirm email change, no email change pending",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("emailConfirmChange:xxx"),
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "emailConfirmChange:xxx",
+					Type:       api.EmailConfirmChange,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "password reset, ticket of another type",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "mfaTotp:xxx",
+					Type:       api.PasswordReset,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "revert email change",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().ConsumeEmailChangeRevert(
+					gomock.Any(),
+					"emailChangeRevert:xxx",
+				).Return(sql.AuthEmailChangeRevert{ //nolint:exhaustruct
+					UserID:   userID,
+					Ticket:   "emailChangeRevert:xxx",
+					OldEmail: "jane@acme.com",
+				}, nil)
+
+				mock.EXPECT().UpdateUserRevertEmailChange(
+					gomock.Any(),
+					sql.UpdateUserRevertEmailChangeParams{
+						ID:    userID,
+						Email: sql.Text("jane@acme.com"),
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DeleteUserEmailChangeReverts(
+					gomock.Any(),
+					userID,
+				).Return(nil)
+
+				mock.EXPECT().RevokeUserSessions(
+					gomock.Any(),
+					userID,
+				).Return(int64(1), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "emailChangeRevert:xxx",
+					Type:       api.EmailChangeRevert,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?refreshToken=xxx&type=emailChangeRevert",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "revert email change, expired ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().ConsumeEmailChangeRevert(
+					gomock.Any(),
+					"emailChangeRevert:xxx",
+				).Return(sql.AuthEmailChangeRevert{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "emailChangeRevert:xxx",
+					Type:       api.EmailChangeRevert,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "new device revoke",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().ConsumeNewDeviceSignIn(
+					gomock.Any(),
+					"newDeviceRevoke:xxx",
+				).Return(sql.AuthNewDeviceSignIn{ //nolint:exhaustruct
+					UserID: userID,
+					Ticket: "newDeviceRevoke:xxx",
+				}, nil)
+
+				mock.EXPECT().DeleteUserNewDeviceSignIns(
+					gomock.Any(),
+					userID,
+				).Return(nil)
+
+				mock.EXPECT().RevokeUserSessions(
+					gomock.Any(),
+					userID,
+				).Return(int64(1), nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getSigninUser(userID), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "newDeviceRevoke:xxx",
+					Type:       api.NewDeviceRevoke,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?refreshToken=xxx&type=newDeviceRevoke",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "account deletion cancel",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CancelUserDeletion(
+					gomock.Any(),
+					"accountDeletionCancel:xxx",
+				).Return(getSigninUser(userID), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "accountDeletionCancel:xxx",
+					Type:       api.AccountDeletionCancel,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?refreshToken=xxx&type=accountDeletionCancel",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "account deletion cancel, user already deleted",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CancelUserDeletion(
+					gomock.Any(),
+					"accountDeletionCancel:xxx",
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "accountDeletionCancel:xxx",
+					Type:       api.AccountDeletionCancel,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "new device revoke, expired ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().ConsumeNewDeviceSignIn(
+					gomock.Any(),
+					"newDeviceRevoke:xxx",
+				).Return(sql.AuthNewDeviceSignIn{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "newDeviceRevoke:xxx",
+					Type:       api.NewDeviceRevoke,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "password reset",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("passwordReset:xxx"),
+				).Return(getSigninUser(userID), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "passwordReset:xxx",
+					Type:       api.PasswordReset,
+					RedirectTo: "http://localhost:3000/reset",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000/reset?refreshToken=xxx&type=passwordReset",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "password reset revoking sessions",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("passwordResetRevokeSessions:xxx"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RevokeUserSessions(
+					gomock.Any(),
+					userID,
+				).Return(int64(1), nil)
+
+				insertRefreshToken(mock)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "passwordResetRevokeSessions:xxx",
+					Type:       api.PasswordReset,
+					RedirectTo: "http://localhost:3000/reset",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000/reset?refreshToken=xxx&type=passwordReset",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "invalid or already used ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("verifyEmail:xxx"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "verifyEmail:xxx",
+					Type:       api.EmailVerify,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=invalid-ticket&errorDescription=Invalid+or+expired+verification+ticket", //nolint:lll
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "user disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.Disabled = true
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(),
+					sql.Text("passwordReset:xxx"),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "passwordReset:xxx",
+					Type:       api.PasswordReset,
+					RedirectTo: "http://localhost:3000",
+				},
+			},
+			expectedResponse: api.GetVerify302Response{
+				Headers: api.GetVerify302ResponseHeaders{
+					Location: "http://localhost:3000?error=disabled-user&errorDescription=User+is+disabled",
+				},
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "wrong redirectTo",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.GetVerifyRequestObject{
+				Params: api.GetVerifyParams{
+					Ticket:     "verifyEmail:xxx",
+					Type:       api.EmailVerify,
+					RedirectTo: "https://evil.com",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "redirectTo-not-allowed",
+				Message: `The value of "options.redirectTo" is not allowed.`,
+				Status:  400,
+			},
+			emailer:       nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.GetVerify, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast(
+					[]string{".Location"}, cmp.Comparer(cmpRedirectLocation),
+				),
+			)
+		})
+	}
+}
+
+func TestGetVerifyRisk(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+
+	ctrl := gomock.NewController(t)
+
+	config := func() *controller.Config {
+		cfg := getConfig()
+		cfg.EmailPasswordlessEnabled = true
+		cfg.RiskBlockThreshold = 80
+		return cfg
+	}
+
+	db := func(ctrl *gomock.Controller) controller.DBClient {
+		mock := mock.NewMockDBClient(ctrl)
+
+		mock.EXPECT().UpdateUserConsumeTicket(
+			gomock.Any(),
+			sql.Text("passwordlessEmail:xxx"),
+		).Return(getSigninUser(userID), nil)
+
+		mock.EXPECT().UpdateUserVerifyEmail(
+			gomock.Any(),
+			userID,
+		).Return(getSigninUser(userID), nil)
+
+		mock.EXPECT().RefreshTokenDeviceFingerprintExists(
+			gomock.Any(), gomock.Any(),
+		).Return(true, nil)
+
+		return mock
+	}
+
+	engine := &fakeRiskEngine{score: 90, err: nil, signals: risk.Signals{}} //nolint:exhaustruct
+	c, _ := getController(t, ctrl, config, db, getControllerOpts{
+		customClaimer:    nil,
+		emailer:          nil,
+		hibp:             nil,
+		sms:              nil,
+		providers:        nil,
+		idTokenProviders: nil,
+		saml:             nil,
+		rateLimiter:      nil,
+		captcha:          nil,
+		webhooks:         nil,
+		preSignUpHook:    nil,
+		disposableEmails: nil,
+		avatarStorage:    nil,
+		geoIP:            nil,
+		riskEngine:       engine,
+		configReloader:   nil,
+		signInMethods:    nil,
+	})
+
+	assertRequest(
+		context.Background(), t, c.GetVerify,
+		api.GetVerifyRequestObject{
+			Params: api.GetVerifyParams{
+				Ticket:     "passwordlessEmail:xxx",
+				Type:       api.SigninPasswordless,
+				RedirectTo: "http://localhost:3000",
+			},
+		},
+		api.GetVerifyResponseObject(api.GetVerify302Response{
+			Headers: api.GetVerify302ResponseHeaders{
+				Location: "http://localhost:3000?error=risky-sign-in&errorDescription=Sign+in+denied%2C+it+looks+too+risky", //nolint:lll
+			},
+		}),
+		testhelpers.FilterPathLast(
+			[]string{".Location"}, cmp.Comparer(cmpRedirectLocation),
+		),
+	)
+
+	if engine.signals.UserID != userID.String() {
+		t.Errorf("unexpected signals: %#v", engine.signals)
+	}
+}
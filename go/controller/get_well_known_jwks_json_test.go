@@ -0,0 +1,46 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetWellKnownJwksJson(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	cases := []testRequest[api.GetWellKnownJwksJsonRequestObject, api.GetWellKnownJwksJsonResponseObject]{ //nolint:lll
+		{
+			name:   "hmac secrets are not published",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    nil,
+			request:       api.GetWellKnownJwksJsonRequestObject{},
+			expectedResponse: api.GetWellKnownJwksJson200JSONResponse{
+				Keys: []api.JWK{},
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			assertRequest(context.Background(), t, c.GetWellKnownJwksJson, tc.request, tc.expectedResponse)
+		})
+	}
+}
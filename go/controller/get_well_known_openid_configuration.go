@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) GetWellKnownOpenidConfiguration( //nolint:ireturn
+	ctx context.Context, _ api.GetWellKnownOpenidConfigurationRequestObject,
+) (api.GetWellKnownOpenidConfigurationResponseObject, error) {
+	if !ctrl.config.OIDCProviderEnabled {
+		middleware.LoggerFromContext(ctx).Warn("oidc provider is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	serverURL := ctrl.config.ServerURL
+
+	return api.GetWellKnownOpenidConfiguration200JSONResponse{
+		Issuer:                           serverURL.String(),
+		AuthorizationEndpoint:            serverURL.JoinPath("oauth", "authorize").String(),
+		TokenEndpoint:                    serverURL.JoinPath("oauth", "token").String(),
+		UserinfoEndpoint:                 serverURL.JoinPath("oauth", "userinfo").String(),
+		JwksUri:                          serverURL.JoinPath(".well-known", "jwks.json").String(),
+		ResponseTypesSupported:           []string{"code"},
+		SubjectTypesSupported:            []string{"public"},
+		IdTokenSigningAlgValuesSupported: []string{ctrl.wf.jwtGetter.SigningAlgorithm()},
+		ScopesSupported:                  oidcScopes,
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "nonce", "name", "picture", "locale",
+			"email", "email_verified", "phone_number", "phone_number_verified",
+		},
+		GrantTypesSupported:               []string{string(api.OAuth2TokenRequestGrantTypeAuthorizationCode)},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{codeChallengeMethodS256},
+	}, nil
+}
@@ -0,0 +1,89 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetWellKnownOpenidConfiguration(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	cases := []testRequest[api.GetWellKnownOpenidConfigurationRequestObject, api.GetWellKnownOpenidConfigurationResponseObject]{ //nolint:lll
+		{
+			name: "success",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.OIDCProviderEnabled = true
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    nil,
+			request:       api.GetWellKnownOpenidConfigurationRequestObject{},
+			expectedResponse: api.GetWellKnownOpenidConfiguration200JSONResponse{
+				Issuer:                           "https://local.auth.nhost.run",
+				AuthorizationEndpoint:            "https://local.auth.nhost.run/oauth/authorize",
+				TokenEndpoint:                    "https://local.auth.nhost.run/oauth/token",
+				UserinfoEndpoint:                 "https://local.auth.nhost.run/oauth/userinfo",
+				JwksUri:                          "https://local.auth.nhost.run/.well-known/jwks.json",
+				ResponseTypesSupported:           []string{"code"},
+				SubjectTypesSupported:            []string{"public"},
+				IdTokenSigningAlgValuesSupported: []string{"HS256"},
+				ScopesSupported:                  []string{"openid", "profile", "email", "phone"},
+				ClaimsSupported: []string{
+					"sub", "iss", "aud", "exp", "iat", "nonce", "name", "picture", "locale",
+					"email", "email_verified", "phone_number", "phone_number_verified",
+				},
+				GrantTypesSupported: []string{"authorization_code"},
+				TokenEndpointAuthMethodsSupported: []string{
+					"client_secret_basic", "client_secret_post",
+				},
+				CodeChallengeMethodsSupported: []string{"S256"},
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    nil,
+			request:       api.GetWellKnownOpenidConfigurationRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			assertRequest(
+				context.Background(), t, c.GetWellKnownOpenidConfiguration,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,526 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/grpcapi"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/oapi-codegen/runtime/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// grpcErrorDomain is the domain of the google.rpc.ErrorInfo details sent with the errors.
+const grpcErrorDomain = "hasura-auth"
+
+// grpcAdminMethods are the methods that require the admin secret.
+var grpcAdminMethods = map[string]bool{ //nolint:gochecknoglobals
+	grpcapi.AuthService_ListUsers_FullMethodName:          true,
+	grpcapi.AuthService_DisableUser_FullMethodName:        true,
+	grpcapi.AuthService_EnableUser_FullMethodName:         true,
+	grpcapi.AuthService_RevokeUserSessions_FullMethodName: true,
+}
+
+// GRPCServer serves the gRPC API by calling the handlers of the HTTP API so both behave
+// the same.
+type GRPCServer struct {
+	grpcapi.UnimplementedAuthServiceServer
+
+	ctrl *Controller
+}
+
+func NewGRPCServer(ctrl *Controller) *GRPCServer {
+	return &GRPCServer{
+		UnimplementedAuthServiceServer: grpcapi.UnimplementedAuthServiceServer{},
+		ctrl:                           ctrl,
+	}
+}
+
+// AuthenticationInterceptor verifies the x-hasura-admin-secret metadata of the calls to the
+// admin methods.
+func (s *GRPCServer) AuthenticationInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if grpcAdminMethods[info.FullMethod] {
+		var adminSecret string
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get("x-hasura-admin-secret"); len(values) > 0 {
+			adminSecret = values[0]
+		}
+
+		if !secretMatches(adminSecret, s.ctrl.config.HasuraAdminSecret) {
+			middleware.LoggerFromContext(ctx).Warn("invalid admin secret")
+			return nil, status.Error(codes.Unauthenticated, ErrInvalidAdminSecret.Error())
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// grpcError converts the error response of the HTTP API to a gRPC status carrying the
+// error code of the HTTP API as the reason of a google.rpc.ErrorInfo detail.
+func grpcError(response ErrorResponse) error {
+	st := status.New(grpcCode(response.Status), response.Message)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{ //nolint:exhaustruct
+		Reason: string(response.Error),
+		Domain: grpcErrorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// grpcUnexpectedResponse is returned when the handler of the HTTP API returns a response
+// that can't be converted to the response of the gRPC method.
+func grpcUnexpectedResponse(ctx context.Context, response any) error {
+	middleware.LoggerFromContext(ctx).Error(
+		"unexpected response", logError(fmt.Errorf("unexpected response type %T", response)), //nolint:goerr113
+	)
+	return status.Error(codes.Internal, "unexpected response")
+}
+
+// observe records the audit log entry and the metrics of the operation of the HTTP API the
+// call maps to, like the Audit and Metrics middlewares do for HTTP requests. It returns
+// false if the call must fail because a mandatory audit log entry couldn't be recorded.
+func (s *GRPCServer) observe(
+	ctx context.Context, operationID string, request, response any, err error,
+) bool {
+	if method, ok := signInMethods[operationID]; ok {
+		metrics.SignIns.WithLabelValues(method, metricsOutcome(response, err)).Inc()
+	} else if operationID == "PostToken" {
+		metrics.TokenRefreshes.WithLabelValues(metricsOutcome(response, err)).Inc()
+	}
+
+	event, ok := auditedOperations[operationID]
+	mandatory := mandatoryAuditedOperations[operationID]
+	if !ok || (!s.ctrl.config.AuditLogEnabled && !mandatory) {
+		return true
+	}
+
+	errorCode := auditErrorCode(response, err)
+	recordErr := s.ctrl.recordAuditLog(
+		ctx,
+		event,
+		operationID,
+		request,
+		response,
+		errorCode,
+		middleware.LoggerFromContext(ctx),
+	)
+
+	return recordErr == nil || !mandatory || errorCode != ""
+}
+
+// grpcCall calls the handler of the HTTP API with the request and returns its response.
+// Error responses are converted to gRPC errors.
+func grpcCall[Req, Res any](
+	ctx context.Context,
+	s *GRPCServer,
+	operationID string,
+	request Req,
+	handler func(context.Context, Req) (Res, error),
+) (any, error) {
+	response, err := handler(ctx, request)
+
+	if !s.observe(ctx, operationID, request, response, err) {
+		return nil, grpcError(s.ctrl.sendError(ErrInternalServerError))
+	}
+
+	if err != nil {
+		middleware.LoggerFromContext(ctx).Error("error handling request", logError(err))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if errResponse, ok := any(response).(ErrorResponse); ok {
+		return nil, grpcError(errResponse)
+	}
+
+	return response, nil
+}
+
+func grpcParseUserID(userID string) (types.UUID, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return types.UUID{}, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return id, nil
+}
+
+func grpcTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func grpcStruct(m map[string]any) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		return nil, fmt.Errorf("error converting to struct: %w", err)
+	}
+	return s, nil
+}
+
+func grpcUser(user *api.User) (*grpcapi.User, error) {
+	if user == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	metadata, err := grpcStruct(user.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcapi.User{ //nolint:exhaustruct
+		Id:                  user.Id,
+		DisplayName:         user.DisplayName,
+		AvatarUrl:           user.AvatarUrl,
+		Locale:              user.Locale,
+		Email:               string(deptr(user.Email)),
+		EmailVerified:       user.EmailVerified,
+		PhoneNumber:         user.PhoneNumber,
+		PhoneNumberVerified: user.PhoneNumberVerified,
+		DefaultRole:         user.DefaultRole,
+		Roles:               user.Roles,
+		IsAnonymous:         user.IsAnonymous,
+		Disabled:            false,
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+		LastSeen:            nil,
+		Providers:           nil,
+		Metadata:            metadata,
+	}, nil
+}
+
+func grpcAdminUser(user api.AdminUser) (*grpcapi.User, error) {
+	metadata, err := grpcStruct(user.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcapi.User{ //nolint:exhaustruct
+		Id:                  user.Id.String(),
+		DisplayName:         user.DisplayName,
+		AvatarUrl:           user.AvatarUrl,
+		Locale:              user.Locale,
+		Email:               string(deptr(user.Email)),
+		EmailVerified:       user.EmailVerified,
+		PhoneNumber:         deptr(user.PhoneNumber),
+		PhoneNumberVerified: user.PhoneNumberVerified,
+		DefaultRole:         user.DefaultRole,
+		Roles:               user.Roles,
+		IsAnonymous:         user.IsAnonymous,
+		Disabled:            user.Disabled,
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+		LastSeen:            grpcTimestamp(user.LastSeen),
+		Providers:           user.Providers,
+		Metadata:            metadata,
+	}, nil
+}
+
+func grpcSession(session *api.Session) (*grpcapi.Session, error) {
+	if session == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	user, err := grpcUser(session.User)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcapi.Session{ //nolint:exhaustruct
+		AccessToken:          session.AccessToken,
+		AccessTokenExpiresIn: session.AccessTokenExpiresIn,
+		RefreshToken:         session.RefreshToken,
+		RefreshTokenId:       session.RefreshTokenId,
+		User:                 user,
+	}, nil
+}
+
+func (s *GRPCServer) SignInEmailPassword(
+	ctx context.Context, in *grpcapi.SignInEmailPasswordRequest,
+) (*grpcapi.SignInEmailPasswordResponse, error) {
+	response, err := grpcCall(
+		ctx,
+		s,
+		"PostSigninEmailPassword",
+		api.PostSigninEmailPasswordRequestObject{
+			Body: &api.SignInEmailPasswordRequest{
+				Email:    types.Email(in.GetEmail()),
+				Password: in.GetPassword(),
+			},
+		},
+		s.ctrl.PostSigninEmailPassword,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := response.(api.PostSigninEmailPassword200JSONResponse)
+	if !ok {
+		return nil, grpcUnexpectedResponse(ctx, response)
+	}
+
+	session, err := grpcSession(r.Session)
+	if err != nil {
+		return nil, grpcUnexpectedResponse(ctx, err)
+	}
+
+	var mfaTicket string
+	if r.Mfa != nil {
+		mfaTicket = r.Mfa.Ticket
+	}
+
+	return &grpcapi.SignInEmailPasswordResponse{ //nolint:exhaustruct
+		Session:   session,
+		MfaTicket: mfaTicket,
+	}, nil
+}
+
+func (s *GRPCServer) RefreshToken(
+	ctx context.Context, in *grpcapi.RefreshTokenRequest,
+) (*grpcapi.Session, error) {
+	response, err := grpcCall(
+		ctx,
+		s,
+		"PostToken",
+		api.PostTokenRequestObject{
+			Body: &api.RefreshTokenRequest{
+				RefreshToken: in.GetRefreshToken(),
+			},
+		},
+		s.ctrl.PostToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := response.(api.PostToken200JSONResponse)
+	if !ok {
+		return nil, grpcUnexpectedResponse(ctx, response)
+	}
+
+	session := api.Session(r)
+	grpcSession, err := grpcSession(&session)
+	if err != nil {
+		return nil, grpcUnexpectedResponse(ctx, err)
+	}
+
+	return grpcSession, nil
+}
+
+func (s *GRPCServer) VerifyAccessToken(
+	ctx context.Context, in *grpcapi.VerifyAccessTokenRequest,
+) (*grpcapi.VerifyAccessTokenResponse, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	token, err := s.ctrl.wf.jwtGetter.ValidateAccessToken(ctx, in.GetAccessToken())
+	if err != nil {
+		logger.Warn("invalid access token", logError(err))
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	userID, err := s.ctrl.wf.jwtGetter.GetUserID(token)
+	if err != nil {
+		logger.Warn("invalid access token", logError(err))
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, grpcUnexpectedResponse(ctx, token.Claims)
+	}
+
+	claimsStruct, err := grpcStruct(claims)
+	if err != nil {
+		return nil, grpcUnexpectedResponse(ctx, err)
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		expiresAt = timestamppb.New(exp.Time)
+	}
+
+	return &grpcapi.VerifyAccessTokenResponse{ //nolint:exhaustruct
+		UserId:    userID.String(),
+		ExpiresAt: expiresAt,
+		Claims:    claimsStruct,
+	}, nil
+}
+
+func grpcListUsersParams(in *grpcapi.ListUsersRequest) (api.GetAdminUsersParams, error) {
+	params := api.GetAdminUsersParams{ //nolint:exhaustruct
+		Disabled:      in.Disabled,
+		EmailVerified: in.EmailVerified,
+	}
+
+	if limit := int(in.GetLimit()); limit != 0 {
+		if limit < 1 || limit > maxAdminUsersLimit {
+			return api.GetAdminUsersParams{}, status.Errorf( //nolint:exhaustruct
+				codes.InvalidArgument, "limit must be between 1 and %d", maxAdminUsersLimit,
+			)
+		}
+		params.Limit = &limit
+	}
+	if in.GetCursor() != "" {
+		params.Cursor = ptr(in.GetCursor())
+	}
+	if in.GetEmail() != "" {
+		params.Email = ptr(in.GetEmail())
+	}
+	if in.GetRole() != "" {
+		params.Role = ptr(in.GetRole())
+	}
+	if in.GetProvider() != "" {
+		params.Provider = ptr(in.GetProvider())
+	}
+	if in.GetCreatedAfter() != nil {
+		params.CreatedAfter = ptr(in.GetCreatedAfter().AsTime())
+	}
+	if in.GetCreatedBefore() != nil {
+		params.CreatedBefore = ptr(in.GetCreatedBefore().AsTime())
+	}
+
+	return params, nil
+}
+
+func (s *GRPCServer) ListUsers(
+	ctx context.Context, in *grpcapi.ListUsersRequest,
+) (*grpcapi.ListUsersResponse, error) {
+	params, err := grpcListUsersParams(in)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := grpcCall(
+		ctx,
+		s,
+		"GetAdminUsers",
+		api.GetAdminUsersRequestObject{Params: params},
+		s.ctrl.GetAdminUsers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := response.(api.GetAdminUsers200JSONResponse)
+	if !ok {
+		return nil, grpcUnexpectedResponse(ctx, response)
+	}
+
+	users := make([]*grpcapi.User, 0, len(r.Users))
+	for _, user := range r.Users {
+		u, err := grpcAdminUser(user)
+		if err != nil {
+			return nil, grpcUnexpectedResponse(ctx, err)
+		}
+		users = append(users, u)
+	}
+
+	return &grpcapi.ListUsersResponse{ //nolint:exhaustruct
+		Users:      users,
+		NextCursor: deptr(r.NextCursor),
+	}, nil
+}
+
+func (s *GRPCServer) DisableUser(
+	ctx context.Context, in *grpcapi.DisableUserRequest,
+) (*emptypb.Empty, error) {
+	userID, err := grpcParseUserID(in.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := grpcCall(
+		ctx,
+		s,
+		"PostAdminUsersUserIdDisable",
+		api.PostAdminUsersUserIdDisableRequestObject{UserId: userID},
+		s.ctrl.PostAdminUsersUserIdDisable,
+	); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) EnableUser(
+	ctx context.Context, in *grpcapi.EnableUserRequest,
+) (*emptypb.Empty, error) {
+	userID, err := grpcParseUserID(in.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := grpcCall(
+		ctx,
+		s,
+		"PostAdminUsersUserIdEnable",
+		api.PostAdminUsersUserIdEnableRequestObject{UserId: userID},
+		s.ctrl.PostAdminUsersUserIdEnable,
+	); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) RevokeUserSessions(
+	ctx context.Context, in *grpcapi.RevokeUserSessionsRequest,
+) (*emptypb.Empty, error) {
+	userID, err := grpcParseUserID(in.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := grpcCall(
+		ctx,
+		s,
+		"PostAdminUsersUserIdSessionsRevokeAll",
+		api.PostAdminUsersUserIdSessionsRevokeAllRequestObject{UserId: userID},
+		s.ctrl.PostAdminUsersUserIdSessionsRevokeAll,
+	); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
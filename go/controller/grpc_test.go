@@ -0,0 +1,452 @@
+package controller_test
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/grpcapi"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func getGRPCClient(t *testing.T, c *controller.Controller) grpcapi.AuthServiceClient {
+	t.Helper()
+
+	grpcServer := controller.NewGRPCServer(c)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			middleware.GRPCLogger(slog.Default()),
+			middleware.GRPCRecovery,
+			grpcServer.AuthenticationInterceptor,
+		),
+	)
+	grpcapi.RegisterAuthServiceServer(server, grpcServer)
+
+	listener := bufconn.Listen(1024 * 1024) //nolint:mnd
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to create grpc client: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return grpcapi.NewAuthServiceClient(conn)
+}
+
+// grpcContext returns a context with a timeout so a call doesn't hang forever if the mock
+// fails the test from the goroutine of the server.
+func grpcContext(t *testing.T) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second) //nolint:mnd
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func assertGRPCError(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("expected a grpc status, got %v", err)
+	}
+	if st.Code() != code {
+		t.Fatalf("expected code %s, got %s: %s", code, st.Code(), st.Message())
+	}
+
+	var got string
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			got = info.GetReason()
+		}
+	}
+	if got != reason {
+		t.Fatalf("expected reason %q, got %q", reason, got)
+	}
+}
+
+func TestGRPCServerSignInEmailPassword(t *testing.T) {
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []struct {
+		name             string
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		request          *grpcapi.SignInEmailPasswordRequest
+		expectedResponse *grpcapi.SignInEmailPasswordResponse
+		expectedCode     codes.Code
+		expectedReason   string
+	}{
+		{
+			name: "success",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+						IpAddress:        sql.Text("bufconn"),
+						UserAgent:        sql.Text("grpc-go/" + grpc.Version),
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			request: &grpcapi.SignInEmailPasswordRequest{ //nolint:exhaustruct
+				Email:    "jane@acme.com",
+				Password: "password",
+			},
+			expectedResponse: &grpcapi.SignInEmailPasswordResponse{ //nolint:exhaustruct
+				Session: &grpcapi.Session{ //nolint:exhaustruct
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       refreshTokenID.String(),
+					User: &grpcapi.User{ //nolint:exhaustruct
+						Id:            userID.String(),
+						DisplayName:   "Jane Doe",
+						Locale:        "en",
+						Email:         "jane@acme.com",
+						EmailVerified: true,
+						DefaultRole:   "user",
+						Roles:         []string{"user", "me"},
+						Metadata:      &structpb.Struct{}, //nolint:exhaustruct
+					},
+				},
+			},
+			expectedCode:   codes.OK,
+			expectedReason: "",
+		},
+
+		{
+			name: "wrong password",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			request: &grpcapi.SignInEmailPasswordRequest{ //nolint:exhaustruct
+				Email:    "jane@acme.com",
+				Password: "wrongpassword",
+			},
+			expectedResponse: nil,
+			expectedCode:     codes.Unauthenticated,
+			expectedReason:   "invalid-email-password",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, getConfig, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          mock.NewMockEmailer,
+				hibp:             mock.NewMockHIBPClient,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+			client := getGRPCClient(t, c)
+
+			resp, err := client.SignInEmailPassword(grpcContext(t), tc.request)
+			if tc.expectedCode != codes.OK {
+				assertGRPCError(t, err, tc.expectedCode, tc.expectedReason)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(
+				resp, tc.expectedResponse,
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&grpcapi.Session{}, "access_token", "refresh_token"), //nolint:exhaustruct,lll
+				protocmp.IgnoreFields(&grpcapi.User{}, "created_at"),                       //nolint:exhaustruct
+			); diff != "" {
+				t.Fatalf("unexpected response: %s", diff)
+			}
+
+			verified, err := client.VerifyAccessToken(
+				grpcContext(t),
+				&grpcapi.VerifyAccessTokenRequest{ //nolint:exhaustruct
+					AccessToken: resp.GetSession().GetAccessToken(),
+				},
+			)
+			if err != nil {
+				t.Fatalf("unexpected error verifying the access token: %v", err)
+			}
+			if verified.GetUserId() != userID.String() {
+				t.Fatalf("unexpected user id: %s", verified.GetUserId())
+			}
+		})
+	}
+}
+
+func TestGRPCServerVerifyAccessToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	c, _ := getController(
+		t,
+		ctrl,
+		getConfig,
+		func(ctrl *gomock.Controller) controller.DBClient {
+			return mock.NewMockDBClient(ctrl)
+		},
+		getControllerOpts{
+			customClaimer:    nil,
+			emailer:          nil,
+			hibp:             nil,
+			sms:              nil,
+			providers:        nil,
+			idTokenProviders: nil,
+			saml:             nil,
+			rateLimiter:      nil,
+			captcha:          nil,
+			webhooks:         nil,
+			preSignUpHook:    nil,
+			disposableEmails: nil,
+			avatarStorage:    nil,
+			geoIP:            nil,
+			riskEngine:       nil,
+			configReloader:   nil,
+			signInMethods:    nil,
+		},
+	)
+	client := getGRPCClient(t, c)
+
+	_, err := client.VerifyAccessToken(
+		grpcContext(t),
+		&grpcapi.VerifyAccessTokenRequest{ //nolint:exhaustruct
+			AccessToken: "not-a-token",
+		},
+	)
+	assertGRPCError(t, err, codes.Unauthenticated, "")
+}
+
+func TestGRPCServerDisableUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []struct {
+		name           string
+		db             func(ctrl *gomock.Controller) controller.DBClient
+		adminSecret    string
+		userID         string
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			name: "success",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UpdateUserDisabled(gomock.Any(), sql.UpdateUserDisabledParams{
+					Disabled: true,
+					ID:       userID,
+				}).Return(int64(1), nil)
+
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(1), nil)
+
+				return mock
+			},
+			adminSecret:    "nhost-admin-secret",
+			userID:         userID.String(),
+			expectedCode:   codes.OK,
+			expectedReason: "",
+		},
+
+		{
+			name: "user not found",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().UpdateUserDisabled(gomock.Any(), sql.UpdateUserDisabledParams{
+					Disabled: true,
+					ID:       userID,
+				}).Return(int64(0), nil)
+
+				return mock
+			},
+			adminSecret:    "nhost-admin-secret",
+			userID:         userID.String(),
+			expectedCode:   codes.NotFound,
+			expectedReason: "user-not-found",
+		},
+
+		{
+			name: "invalid user id",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			adminSecret:    "nhost-admin-secret",
+			userID:         "not-a-uuid",
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: "",
+		},
+
+		{
+			name: "wrong admin secret",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			adminSecret:    "wrong",
+			userID:         userID.String(),
+			expectedCode:   codes.Unauthenticated,
+			expectedReason: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, getConfig, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+			client := getGRPCClient(t, c)
+
+			ctx := metadata.AppendToOutgoingContext(
+				grpcContext(t), "x-hasura-admin-secret", tc.adminSecret,
+			)
+			_, err := client.DisableUser(ctx, &grpcapi.DisableUserRequest{ //nolint:exhaustruct
+				UserId: tc.userID,
+			})
+			if tc.expectedCode == codes.OK {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			assertGRPCError(t, err, tc.expectedCode, tc.expectedReason)
+		})
+	}
+}
+
+func TestGRPCServerListUsersLimit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	c, _ := getController(
+		t,
+		ctrl,
+		getConfig,
+		func(ctrl *gomock.Controller) controller.DBClient {
+			return mock.NewMockDBClient(ctrl)
+		},
+		getControllerOpts{
+			customClaimer:    nil,
+			emailer:          nil,
+			hibp:             nil,
+			sms:              nil,
+			providers:        nil,
+			idTokenProviders: nil,
+			saml:             nil,
+			rateLimiter:      nil,
+			captcha:          nil,
+			webhooks:         nil,
+			preSignUpHook:    nil,
+			disposableEmails: nil,
+			avatarStorage:    nil,
+			geoIP:            nil,
+			riskEngine:       nil,
+			configReloader:   nil,
+			signInMethods:    nil,
+		},
+	)
+	client := getGRPCClient(t, c)
+
+	ctx := metadata.AppendToOutgoingContext(
+		grpcContext(t), "x-hasura-admin-secret", "nhost-admin-secret",
+	)
+	_, err := client.ListUsers(ctx, &grpcapi.ListUsersRequest{ //nolint:exhaustruct
+		Limit: 101,
+	})
+	assertGRPCError(t, err, codes.InvalidArgument, "")
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nhost/hasura-auth/go/api"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ecCoordinate encodes the coordinate padded to the size of the curve, as required by
+// RFC 7518.
+func ecCoordinate(key *ecdsa.PublicKey, v *big.Int) string {
+	size := (key.Curve.Params().BitSize + 7) / 8 //nolint:mnd
+	return b64(v.FillBytes(make([]byte, size)))
+}
+
+func toJWK(key any, alg, kid string) (api.JWK, error) {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return api.JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: alg,
+			Kid: kid,
+			N:   ptr(b64(key.N.Bytes())),
+			E:   ptr(b64(big.NewInt(int64(key.E)).Bytes())),
+			Crv: nil,
+			X:   nil,
+			Y:   nil,
+		}, nil
+	case *ecdsa.PublicKey:
+		return api.JWK{
+			Kty: "EC",
+			Use: "sig",
+			Alg: alg,
+			Kid: kid,
+			N:   nil,
+			E:   nil,
+			Crv: ptr(key.Curve.Params().Name),
+			X:   ptr(ecCoordinate(key, key.X)),
+			Y:   ptr(ecCoordinate(key, key.Y)),
+		}, nil
+	default:
+		return api.JWK{}, fmt.Errorf("%w: %T", ErrUnsupportedJWTType, key) //nolint:exhaustruct
+	}
+}
+
+// jwkThumbprint returns the RFC 7638 thumbprint of the key, used as key ID when none is
+// configured.
+func jwkThumbprint(key any) (string, error) {
+	jwk, err := toJWK(key, "", "")
+	if err != nil {
+		return "", err
+	}
+
+	// members must be in lexicographic order, which is how json encodes maps
+	var members map[string]string
+	switch jwk.Kty {
+	case "RSA":
+		members = map[string]string{"e": deptr(jwk.E), "kty": jwk.Kty, "n": deptr(jwk.N)}
+	default:
+		members = map[string]string{
+			"crv": deptr(jwk.Crv), "kty": jwk.Kty, "x": deptr(jwk.X), "y": deptr(jwk.Y),
+		}
+	}
+
+	b, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling jwk: %w", err)
+	}
+
+	sum := sha256.Sum256(b)
+	return b64(sum[:]), nil
+}
+
+// jwks returns the public keys used to verify the tokens. Shared secrets are never
+// published so HMAC keys are skipped.
+func jwks(keys []jwtVerificationKey) ([]api.JWK, error) {
+	keySet := make([]api.JWK, 0, len(keys))
+	for _, k := range keys {
+		if _, ok := k.method.(*jwt.SigningMethodHMAC); ok {
+			continue
+		}
+
+		jwk, err := toJWK(k.key, k.method.Alg(), k.kid)
+		if err != nil {
+			return nil, err
+		}
+		keySet = append(keySet, jwk)
+	}
+
+	return keySet, nil
+}
+
+func (j *JWTGetter) JWKs() []api.JWK {
+	return j.jwks
+}
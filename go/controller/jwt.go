@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,13 +16,33 @@ import (
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
 )
 
 const jwtContextKey = "nhost/auth/jwt"
 
+// dataExportAudience is the audience of the tickets to download data exports, tokens with
+// it are rejected as access tokens.
+const dataExportAudience = "hasura-auth:data-export"
+
+var (
+	ErrUnsupportedJWTType    = errors.New("unsupported jwt type")
+	ErrJWTKeyMismatch        = errors.New("jwt key doesn't match the signing key")
+	ErrDuplicateJWTKeyID     = errors.New("duplicate jwt key id")
+	ErrUnknownJWTKey         = errors.New("token is signed with an unknown key")
+	ErrUnexpectedJWTAudience = errors.New("token has an unexpected audience")
+)
+
 type JWTSecret struct {
-	Key             string `json:"key"`
+	// Key is the shared secret for HMAC algorithms and the PEM encoded public key for
+	// asymmetric ones
+	Key string `json:"key"`
+	// SigningKey is the PEM encoded private key used to sign tokens with asymmetric
+	// algorithms, it is ignored by Hasura
+	SigningKey      string `json:"signing_key"`
+	KeyID           string `json:"kid"`
 	Type            string `json:"type"`
 	Issuer          string `json:"issuer"`
 	ClaimsNamespace string `json:"claims_namespace"`
@@ -51,19 +72,31 @@ type CustomClaimer interface {
 type JWTGetter struct {
 	claimsNamespace      string
 	issuer               string
-	signingKey           []byte
+	signingKey           any
+	keyID                string
 	method               jwt.SigningMethod
+	verificationKeys     []jwtVerificationKey
+	validMethods         []string
+	jwks                 []api.JWK
 	customClaimer        CustomClaimer
 	accessTokenExpiresIn time.Duration
 	elevatedClaimMode    string
-	db                   DBClient
+	// accessTokenRevocation rejects access tokens issued before the user's sessions were
+	// revoked, at the cost of a database query per authenticated request
+	accessTokenRevocation bool
+	db                    DBClient
 }
 
+// NewJWTGetter returns a JWTGetter signing tokens with jwtSecretb. retiredJWTSecretsb is
+// an optional JSON array of secrets that no longer sign tokens but are still accepted, so
+// tokens signed before rotating the secret remain valid until they expire.
 func NewJWTGetter(
 	jwtSecretb []byte,
+	retiredJWTSecretsb []byte,
 	accessTokenExpiresIn time.Duration,
 	customClaimer CustomClaimer,
 	elevatedClaimMode string,
+	accessTokenRevocation bool,
 	db DBClient,
 ) (*JWTGetter, error) {
 	jwtSecret, err := decodeJWTSecret(jwtSecretb)
@@ -72,19 +105,205 @@ func NewJWTGetter(
 	}
 
 	method := jwt.GetSigningMethod(jwtSecret.Type)
+	signingKey, verifyKey, err := parseJWTKeys(method, jwtSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := newJWTVerificationKey(method, jwtSecret.KeyID, verifyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationKeys, err := parseRetiredJWTSecrets(retiredJWTSecretsb)
+	if err != nil {
+		return nil, err
+	}
+	verificationKeys = append([]jwtVerificationKey{current}, verificationKeys...)
+
+	validMethods := make([]string, 0, len(verificationKeys))
+	kids := make(map[string]struct{}, len(verificationKeys))
+	for _, k := range verificationKeys {
+		if !slices.Contains(validMethods, k.method.Alg()) {
+			validMethods = append(validMethods, k.method.Alg())
+		}
+
+		if k.kid == "" {
+			continue
+		}
+		if _, ok := kids[k.kid]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateJWTKeyID, k.kid)
+		}
+		kids[k.kid] = struct{}{}
+	}
+
+	keySet, err := jwks(verificationKeys)
+	if err != nil {
+		return nil, err
+	}
 
 	return &JWTGetter{
-		claimsNamespace:      jwtSecret.ClaimsNamespace,
-		issuer:               jwtSecret.Issuer,
-		signingKey:           []byte(jwtSecret.Key),
-		method:               method,
-		customClaimer:        customClaimer,
-		accessTokenExpiresIn: accessTokenExpiresIn,
-		elevatedClaimMode:    elevatedClaimMode,
-		db:                   db,
+		claimsNamespace:       jwtSecret.ClaimsNamespace,
+		issuer:                jwtSecret.Issuer,
+		signingKey:            signingKey,
+		keyID:                 current.kid,
+		method:                method,
+		verificationKeys:      verificationKeys,
+		validMethods:          validMethods,
+		jwks:                  keySet,
+		customClaimer:         customClaimer,
+		accessTokenExpiresIn:  accessTokenExpiresIn,
+		elevatedClaimMode:     elevatedClaimMode,
+		accessTokenRevocation: accessTokenRevocation,
+		db:                    db,
+	}, nil
+}
+
+// parseJWTKeys returns the keys used to sign and verify tokens with the given method.
+func parseJWTKeys(method jwt.SigningMethod, jwtSecret JWTSecret) (any, any, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return []byte(jwtSecret.Key), []byte(jwtSecret.Key), nil
+	case *jwt.SigningMethodRSA:
+		signingKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(jwtSecret.SigningKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing jwt signing key: %w", err)
+		}
+		if jwtSecret.Key == "" {
+			return signingKey, &signingKey.PublicKey, nil
+		}
+		verifyKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(jwtSecret.Key))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing jwt key: %w", err)
+		}
+		if !verifyKey.Equal(&signingKey.PublicKey) {
+			return nil, nil, ErrJWTKeyMismatch
+		}
+		return signingKey, verifyKey, nil
+	case *jwt.SigningMethodECDSA:
+		signingKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(jwtSecret.SigningKey))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing jwt signing key: %w", err)
+		}
+		if jwtSecret.Key == "" {
+			return signingKey, &signingKey.PublicKey, nil
+		}
+		verifyKey, err := jwt.ParseECPublicKeyFromPEM([]byte(jwtSecret.Key))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing jwt key: %w", err)
+		}
+		if !verifyKey.Equal(&signingKey.PublicKey) {
+			return nil, nil, ErrJWTKeyMismatch
+		}
+		return signingKey, verifyKey, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedJWTType, jwtSecret.Type)
+	}
+}
+
+// jwtVerificationKey is a key tokens are verified with, either the current signing key or
+// a retired one.
+type jwtVerificationKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    any
+}
+
+// newJWTVerificationKey defaults the key ID of asymmetric keys to their thumbprint, HMAC
+// keys only have a key ID if it is configured.
+func newJWTVerificationKey(
+	method jwt.SigningMethod, kid string, key any,
+) (jwtVerificationKey, error) {
+	if _, ok := method.(*jwt.SigningMethodHMAC); !ok && kid == "" {
+		var err error
+		kid, err = jwkThumbprint(key)
+		if err != nil {
+			return jwtVerificationKey{}, err //nolint:exhaustruct
+		}
+	}
+
+	return jwtVerificationKey{
+		kid:    kid,
+		method: method,
+		key:    key,
 	}, nil
 }
 
+// parseRetiredJWTKey parses a retired secret, only the public key is needed for
+// asymmetric algorithms.
+func parseRetiredJWTKey(jwtSecret JWTSecret) (jwtVerificationKey, error) {
+	method := jwt.GetSigningMethod(jwtSecret.Type)
+
+	var key any
+	var err error
+	switch method.(type) {
+	case *jwt.SigningMethodHMAC:
+		key = []byte(jwtSecret.Key)
+	case *jwt.SigningMethodRSA:
+		if jwtSecret.Key == "" {
+			_, key, err = parseJWTKeys(method, jwtSecret)
+		} else {
+			key, err = jwt.ParseRSAPublicKeyFromPEM([]byte(jwtSecret.Key))
+		}
+	case *jwt.SigningMethodECDSA:
+		if jwtSecret.Key == "" {
+			_, key, err = parseJWTKeys(method, jwtSecret)
+		} else {
+			key, err = jwt.ParseECPublicKeyFromPEM([]byte(jwtSecret.Key))
+		}
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnsupportedJWTType, jwtSecret.Type)
+	}
+	if err != nil {
+		return jwtVerificationKey{}, fmt.Errorf("error parsing retired jwt key: %w", err) //nolint:exhaustruct,lll
+	}
+
+	return newJWTVerificationKey(method, jwtSecret.KeyID, key)
+}
+
+func parseRetiredJWTSecrets(retiredJWTSecretsb []byte) ([]jwtVerificationKey, error) {
+	if len(retiredJWTSecretsb) == 0 {
+		return nil, nil
+	}
+
+	var secrets []JWTSecret
+	if err := json.Unmarshal(retiredJWTSecretsb, &secrets); err != nil {
+		return nil, fmt.Errorf("error unmarshalling retired jwt secrets: %w", err)
+	}
+
+	keys := make([]jwtVerificationKey, len(secrets))
+	for i, secret := range secrets {
+		key, err := parseRetiredJWTKey(secret)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	return keys, nil
+}
+
+// verificationKeySet returns the keys the token may have been signed with. Tokens with a
+// kid header are verified with the key with that ID, tokens without one, like the ones
+// issued before a kid was configured, with every key of the same algorithm.
+func (j *JWTGetter) verificationKeySet(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	keySet := jwt.VerificationKeySet{Keys: []jwt.VerificationKey{}}
+	for _, k := range j.verificationKeys {
+		if k.method.Alg() != token.Method.Alg() || (kid != "" && k.kid != kid) {
+			continue
+		}
+		keySet.Keys = append(keySet.Keys, k.key)
+	}
+
+	if len(keySet.Keys) == 0 {
+		return nil, ErrUnknownJWTKey
+	}
+
+	return keySet, nil
+}
+
 func pgEncode(v any) (string, error) {
 	if v == nil {
 		return "null", nil
@@ -118,16 +337,75 @@ func (j *JWTGetter) GetToken(
 	isAnonymous bool,
 	allowedRoles []string,
 	defaultRole string,
+	extraClaims map[string]any,
+	logger *slog.Logger,
+) (string, int64, error) {
+	return j.getToken(
+		ctx, userID, isAnonymous, allowedRoles, defaultRole, extraClaims,
+		j.accessTokenExpiresIn, logger,
+	)
+}
+
+// GetTokenExpiringIn is like GetToken but the token expires after expiresIn instead of the
+// usual access token expiration.
+func (j *JWTGetter) GetTokenExpiringIn(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAnonymous bool,
+	allowedRoles []string,
+	defaultRole string,
+	expiresIn time.Duration,
+	logger *slog.Logger,
+) (string, int64, error) {
+	return j.getToken(
+		ctx, userID, isAnonymous, allowedRoles, defaultRole, nil, expiresIn, logger,
+	)
+}
+
+// GetElevatedToken returns an access token carrying the x-hasura-auth-elevated claim.
+// Elevated tokens expire after expiresIn, or after the usual access token expiration if
+// it is shorter.
+func (j *JWTGetter) GetElevatedToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAnonymous bool,
+	allowedRoles []string,
+	defaultRole string,
+	expiresIn time.Duration,
+	logger *slog.Logger,
+) (string, int64, error) {
+	return j.getToken(
+		ctx,
+		userID,
+		isAnonymous,
+		allowedRoles,
+		defaultRole,
+		map[string]any{"x-hasura-auth-elevated": userID.String()},
+		min(expiresIn, j.accessTokenExpiresIn),
+		logger,
+	)
+}
+
+func (j *JWTGetter) getToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAnonymous bool,
+	allowedRoles []string,
+	defaultRole string,
+	extraClaims map[string]any,
+	expiresIn time.Duration,
 	logger *slog.Logger,
 ) (string, int64, error) {
 	now := time.Now()
-	iat := now.Unix()
-	exp := now.Add(j.accessTokenExpiresIn).Unix()
+	middleware.SetUserID(ctx, userID.String())
 
 	var customClaims map[string]any
 	var err error
 	if j.customClaimer != nil {
 		customClaims, err = j.customClaimer.GetClaims(ctx, userID.String())
+		if errors.Is(err, ErrRequiredClaims) {
+			return "", 0, fmt.Errorf("error getting custom claims: %w", err)
+		}
 		if err != nil {
 			logger.Error("error getting custom claims", slog.String("error", err.Error()))
 			customClaims = map[string]any{}
@@ -141,6 +419,10 @@ func (j *JWTGetter) GetToken(
 		"x-hasura-user-is-anonymous": strconv.FormatBool(isAnonymous),
 	}
 
+	for k, v := range extraClaims {
+		c[k] = v
+	}
+
 	for k, v := range customClaims {
 		value, err := pgEncode(v)
 		if err != nil {
@@ -149,36 +431,165 @@ func (j *JWTGetter) GetToken(
 
 		k = strings.ToLower("x-hasura-" + k)
 		if _, ok := c[k]; ok {
-			// we do not allow custom claims to overwrite the default claims
+			// custom claims can only add claims, the ones set by hasura-auth decide who the
+			// user is and what they can do so they can't be overwritten
+			logger.Warn("custom claim ignored, it can't override a default claim", slog.String("claim", k))
 			continue
 		}
 		c[k] = value
 	}
 
-	// Create the Claims
-	claims := &jwt.MapClaims{
-		"sub":             userID.String(),
+	ss, err := j.sign(j.newClaims(userID.String(), c, now, expiresIn))
+	if err != nil {
+		return "", 0, err
+	}
+
+	return ss, int64(expiresIn.Seconds()), nil
+}
+
+// GetClientToken returns an access token for an OAuth2 client authenticated with the
+// client credentials grant. The token isn't tied to a user so it doesn't have the
+// x-hasura-user-id claim, the client is identified by x-hasura-client-id instead.
+func (j *JWTGetter) GetClientToken(
+	clientID string, allowedRoles []string, defaultRole string,
+) (string, int64, error) {
+	ss, err := j.sign(j.newClaims(clientID, map[string]any{
+		"x-hasura-allowed-roles": allowedRoles,
+		"x-hasura-default-role":  defaultRole,
+		"x-hasura-client-id":     clientID,
+	}, time.Now(), j.accessTokenExpiresIn))
+	if err != nil {
+		return "", 0, err
+	}
+
+	return ss, int64(j.accessTokenExpiresIn.Seconds()), nil
+}
+
+// GetExchangedToken returns a copy of the subject token restricted to the given roles
+// for the token exchange grant (RFC 8693). The token expires after expiresIn or when the
+// subject token does, whatever happens first, and identifies the client that requested
+// it in the act claim. The elevated claim isn't carried over.
+func (j *JWTGetter) GetExchangedToken(
+	subject *jwt.Token,
+	actor string,
+	allowedRoles []string,
+	defaultRole string,
+	expiresIn time.Duration,
+) (string, int64, error) {
+	subjectClaims, ok := subject.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", 0, errors.New("unexpected claims type") //nolint:goerr113
+	}
+	sub, err := subjectClaims.GetSubject()
+	if err != nil {
+		return "", 0, fmt.Errorf("error getting subject: %w", err)
+	}
+	subjectExp, err := subjectClaims.GetExpirationTime()
+	if err != nil || subjectExp == nil {
+		return "", 0, fmt.Errorf("error getting expiration time: %w", err)
+	}
+
+	subjectHasuraClaims, _ := subjectClaims[j.claimsNamespace].(map[string]any)
+	c := make(map[string]any, len(subjectHasuraClaims))
+	for k, v := range subjectHasuraClaims {
+		c[k] = v
+	}
+	c["x-hasura-allowed-roles"] = allowedRoles
+	c["x-hasura-default-role"] = defaultRole
+	delete(c, "x-hasura-auth-elevated")
+
+	now := time.Now()
+	if remaining := subjectExp.Sub(now); remaining < expiresIn {
+		expiresIn = remaining
+	}
+
+	claims := j.newClaims(sub, c, now, expiresIn)
+	act := map[string]any{"sub": actor}
+	if previous, ok := subjectClaims["act"]; ok {
+		act["act"] = previous
+	}
+	claims["act"] = act
+
+	ss, err := j.sign(claims)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return ss, int64(expiresIn.Seconds()), nil
+}
+
+// GetIDToken returns an OpenID Connect ID token for the client with the claims of the user.
+// Its audience is the client so it isn't accepted as an access token.
+func (j *JWTGetter) GetIDToken(
+	issuer string, clientID string, userID uuid.UUID, userClaims map[string]any, nonce string,
+) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss": issuer,
+		"sub": userID.String(),
+		"aud": clientID,
+		"iat": now.Unix(),
+		"exp": now.Add(j.accessTokenExpiresIn).Unix(),
+	}
+	for k, v := range userClaims {
+		claims[k] = v
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	return j.sign(claims)
+}
+
+// SigningAlgorithm returns the algorithm tokens are signed with.
+func (j *JWTGetter) SigningAlgorithm() string {
+	return j.method.Alg()
+}
+
+func (j *JWTGetter) newClaims(
+	sub string, hasuraClaims map[string]any, now time.Time, expiresIn time.Duration,
+) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":             sub,
 		"iss":             j.issuer,
-		"iat":             iat,
-		"exp":             exp,
-		j.claimsNamespace: c,
+		"iat":             now.Unix(),
+		"exp":             now.Add(expiresIn).Unix(),
+		j.claimsNamespace: hasuraClaims,
+	}
+}
+
+// CheckSigningKey signs a short lived token and verifies it to check the keys are usable.
+func (j *JWTGetter) CheckSigningKey() error {
+	ss, err := j.sign(j.newClaims("", map[string]any{}, time.Now(), time.Minute))
+	if err != nil {
+		return err
 	}
+
+	if _, err := j.Validate(ss); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (j *JWTGetter) sign(claims jwt.MapClaims) (string, error) {
 	token := jwt.NewWithClaims(j.method, claims)
+	if j.keyID != "" {
+		token.Header["kid"] = j.keyID
+	}
 	ss, err := token.SignedString(j.signingKey)
 	if err != nil {
-		return "", 0, fmt.Errorf("error signing token: %w", err)
+		return "", fmt.Errorf("error signing token: %w", err)
 	}
 
-	return ss, int64(j.accessTokenExpiresIn.Seconds()), nil
+	return ss, nil
 }
 
 func (j *JWTGetter) Validate(accessToken string) (*jwt.Token, error) {
 	jwtToken, err := jwt.Parse(
 		accessToken,
-		func(_ *jwt.Token) (interface{}, error) {
-			return j.signingKey, nil
-		},
-		jwt.WithValidMethods([]string{j.method.Alg()}),
+		j.verificationKeySet,
+		jwt.WithValidMethods(j.validMethods),
 		jwt.WithIssuer(j.issuer),
 		jwt.WithIssuedAt(),
 		jwt.WithExpirationRequired(),
@@ -186,9 +597,56 @@ func (j *JWTGetter) Validate(accessToken string) (*jwt.Token, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error parsing token: %w", err)
 	}
+
+	// tickets and ID tokens are signed with the same keys but aren't access tokens, access
+	// tokens never have an audience
+	if aud, err := jwtToken.Claims.GetAudience(); err == nil && len(aud) > 0 {
+		return nil, ErrUnexpectedJWTAudience
+	}
+
 	return jwtToken, nil
 }
 
+// GetDataExportTicket returns a ticket to download the export that is valid until
+// expiresAt.
+func (j *JWTGetter) GetDataExportTicket(exportID uuid.UUID, expiresAt time.Time) (string, error) {
+	return j.sign(jwt.MapClaims{
+		"sub": exportID.String(),
+		"iss": j.issuer,
+		"aud": dataExportAudience,
+		"iat": time.Now().Unix(),
+		"exp": expiresAt.Unix(),
+	})
+}
+
+// ValidateDataExportTicket returns the ID of the export the ticket gives access to.
+func (j *JWTGetter) ValidateDataExportTicket(ticket string) (uuid.UUID, error) {
+	jwtToken, err := jwt.Parse(
+		ticket,
+		j.verificationKeySet,
+		jwt.WithValidMethods(j.validMethods),
+		jwt.WithIssuer(j.issuer),
+		jwt.WithAudience(dataExportAudience),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("error parsing ticket: %w", err)
+	}
+
+	sub, err := jwtToken.Claims.GetSubject()
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("error getting export id from subject: %w", err)
+	}
+
+	exportID, err := uuid.Parse(sub)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("error parsing export id: %w", err)
+	}
+
+	return exportID, nil
+}
+
 func (j *JWTGetter) FromContext(ctx context.Context) (*jwt.Token, bool) {
 	token, ok := ctx.Value(jwtContextKey).(*jwt.Token)
 	if !ok { //nolint:nestif
@@ -243,6 +701,66 @@ func (j *JWTGetter) verifyElevatedClaim(ctx context.Context, token *jwt.Token) (
 	return elevatedClaim == u, nil
 }
 
+// verifyNotRevoked checks the token was issued after the user's sessions were last
+// revoked. Tokens issued to OAuth2 clients aren't tied to a user and are never revoked.
+func (j *JWTGetter) verifyNotRevoked(ctx context.Context, token *jwt.Token) error {
+	if j.GetCustomClaim(token, "x-hasura-client-id") != "" &&
+		j.GetCustomClaim(token, "x-hasura-user-id") == "" {
+		return nil
+	}
+
+	sub, err := token.Claims.GetSubject()
+	if err != nil {
+		return fmt.Errorf("error getting user id from subject: %w", err)
+	}
+	userID, err := uuid.Parse(sub)
+	if err != nil {
+		return fmt.Errorf("error parsing user id: %w", err)
+	}
+
+	issuedAt, err := token.Claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return fmt.Errorf("error getting issued at: %w", err)
+	}
+
+	user, err := j.db.GetUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error getting user: %w", err)
+	}
+
+	// iat has a precision of seconds, tokens issued during the same second the sessions
+	// were revoked are accepted
+	if user.TokensValidAfter.Valid &&
+		issuedAt.Unix() < user.TokensValidAfter.Time.Unix() {
+		return ErrAccessTokenRevoked
+	}
+
+	return nil
+}
+
+// ValidateAccessToken validates the token and, if access token revocation is enabled,
+// checks it wasn't revoked.
+func (j *JWTGetter) ValidateAccessToken(
+	ctx context.Context, accessToken string,
+) (*jwt.Token, error) {
+	jwtToken, err := j.Validate(accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("error validating token: %w", err)
+	}
+
+	if !jwtToken.Valid {
+		return nil, errors.New("invalid token") //nolint:goerr113
+	}
+
+	if j.accessTokenRevocation {
+		if err := j.verifyNotRevoked(ctx, jwtToken); err != nil {
+			return nil, err
+		}
+	}
+
+	return jwtToken, nil
+}
+
 func (j *JWTGetter) MiddlewareFunc(
 	ctx context.Context, input *openapi3filter.AuthenticationInput,
 ) error {
@@ -252,13 +770,9 @@ func (j *JWTGetter) MiddlewareFunc(
 		return errors.New("invalid authorization header") //nolint:goerr113
 	}
 
-	jwtToken, err := j.Validate(parts[1])
+	jwtToken, err := j.ValidateAccessToken(ctx, parts[1])
 	if err != nil {
-		return fmt.Errorf("error validating token: %w", err)
-	}
-
-	if !jwtToken.Valid {
-		return errors.New("invalid token") //nolint:goerr113
+		return err
 	}
 
 	if input.SecuritySchemeName == "BearerAuthElevated" {
@@ -273,6 +787,9 @@ func (j *JWTGetter) MiddlewareFunc(
 
 	c := ginmiddleware.GetGinContext(ctx)
 	c.Set(jwtContextKey, jwtToken)
+	if sub, err := jwtToken.Claims.GetSubject(); err == nil {
+		middleware.SetUserID(c, sub)
+	}
 
 	return nil
 }
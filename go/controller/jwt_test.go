@@ -3,6 +3,13 @@ package controller_test
 import (
 	"context"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -15,8 +22,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
 	"go.uber.org/mock/gomock"
 )
@@ -233,7 +242,9 @@ func TestGetJWTFunc(t *testing.T) {
 			if tc.customClaimer != nil {
 				customClaimer = tc.customClaimer(ctrl)
 			}
-			jwtGetter, err := controller.NewJWTGetter(tc.key, tc.expiresIn, customClaimer, "", nil)
+			jwtGetter, err := controller.NewJWTGetter(
+				tc.key, nil, tc.expiresIn, customClaimer, "", false, nil,
+			)
 			if err != nil {
 				t.Fatalf("GetJWTFunc() err = %v; want nil", err)
 			}
@@ -244,6 +255,7 @@ func TestGetJWTFunc(t *testing.T) {
 				false,
 				tc.allowedRoles,
 				tc.defaultRole,
+				nil,
 				slog.Default(),
 			)
 			if err != nil {
@@ -269,7 +281,480 @@ func TestGetJWTFunc(t *testing.T) {
 	}
 }
 
+func TestJWTGetterCustomClaimsError(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("585e21fc-3664-4d03-8539-69945342a4f4")
+
+	cases := []struct {
+		name          string
+		customClaimer func(claimer controller.CustomClaimer) controller.CustomClaimer
+		expectedErr   error
+	}{
+		{
+			name: "issued without custom claims",
+			customClaimer: func(claimer controller.CustomClaimer) controller.CustomClaimer {
+				return claimer
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "required",
+			customClaimer: func(claimer controller.CustomClaimer) controller.CustomClaimer {
+				return controller.CustomClaimers{
+					controller.TenantClaims("acme"),
+					controller.RequiredClaims{CustomClaimer: claimer},
+				}
+			},
+			expectedErr: controller.ErrRequiredClaims,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockCustomClaimer := mock.NewMockCustomClaimer(ctrl)
+			mockCustomClaimer.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+				nil, errors.New("hook unavailable"), //nolint:goerr113
+			)
+
+			jwtGetter, err := controller.NewJWTGetter(
+				jwtSecret, nil, time.Hour, tc.customClaimer(mockCustomClaimer), "", false, nil,
+			)
+			if err != nil {
+				t.Fatalf("NewJWTGetter() err = %v; want nil", err)
+			}
+
+			accessToken, _, err := jwtGetter.GetToken(
+				context.Background(), userID, false, []string{"user"}, "user", nil, slog.Default(),
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("GetToken() err = %v; want %v", err, tc.expectedErr)
+			}
+			if tc.expectedErr != nil {
+				return
+			}
+
+			decodedToken, err := jwtGetter.Validate(accessToken)
+			if err != nil {
+				t.Fatalf("Validate() err = %v; want nil", err)
+			}
+
+			claims, _ := decodedToken.Claims.(jwt.MapClaims)["https://hasura.io/jwt/claims"].(map[string]any)
+			if len(claims) != 4 || claims["x-hasura-user-id"] != userID.String() {
+				t.Errorf("unexpected claims: %v", claims)
+			}
+		})
+	}
+}
+
 //nolint:dupl
+func pemEncode(t *testing.T, typ string, der []byte, err error) string {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("error marshalling key: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}))
+}
+
+func asymmetricJWTSecret(t *testing.T, typ string, secret map[string]string) []byte {
+	t.Helper()
+
+	secret["type"] = typ
+	b, err := json.Marshal(secret)
+	if err != nil {
+		t.Fatalf("error marshalling jwt secret: %v", err)
+	}
+
+	return b
+}
+
+func TestJWTGetterAsymmetricKeys(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	rsaPrivate := pemEncode(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey), nil)
+	rsaPublicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	rsaPublic := pemEncode(t, "PUBLIC KEY", rsaPublicDER, err)
+	otherRSAPublicDER, err := x509.MarshalPKIXPublicKey(&otherRSAKey.PublicKey)
+	otherRSAPublic := pemEncode(t, "PUBLIC KEY", otherRSAPublicDER, err)
+	ecPrivateDER, err := x509.MarshalECPrivateKey(ecKey)
+	ecPrivate := pemEncode(t, "EC PRIVATE KEY", ecPrivateDER, err)
+
+	cases := []struct {
+		name        string
+		secret      []byte
+		expectedErr error
+		expectedKid string
+		expectedKty string
+	}{
+		{
+			name: "RS256 with public key and kid",
+			secret: asymmetricJWTSecret(t, "RS256", map[string]string{
+				"key":         rsaPublic,
+				"signing_key": rsaPrivate,
+				"kid":         "my-key",
+			}),
+			expectedErr: nil,
+			expectedKid: "my-key",
+			expectedKty: "RSA",
+		},
+		{
+			name: "RS256 without public key",
+			secret: asymmetricJWTSecret(t, "RS256", map[string]string{
+				"signing_key": rsaPrivate,
+			}),
+			expectedErr: nil,
+			expectedKid: "",
+			expectedKty: "RSA",
+		},
+		{
+			name: "ES256",
+			secret: asymmetricJWTSecret(t, "ES256", map[string]string{
+				"signing_key": ecPrivate,
+			}),
+			expectedErr: nil,
+			expectedKid: "",
+			expectedKty: "EC",
+		},
+		{
+			name: "public key doesn't match",
+			secret: asymmetricJWTSecret(t, "RS256", map[string]string{
+				"key":         otherRSAPublic,
+				"signing_key": rsaPrivate,
+			}),
+			expectedErr: controller.ErrJWTKeyMismatch,
+			expectedKid: "",
+			expectedKty: "",
+		},
+		{
+			name: "unsupported type",
+			secret: asymmetricJWTSecret(t, "XX256", map[string]string{
+				"key": "secret",
+			}),
+			expectedErr: controller.ErrUnsupportedJWTType,
+			expectedKid: "",
+			expectedKty: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			jwtGetter, err := controller.NewJWTGetter(
+				tc.secret, nil, time.Hour, nil, "disabled", false, nil,
+			)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+
+			jwks := jwtGetter.JWKs()
+			if len(jwks) != 1 {
+				t.Fatalf("expected one key, got %d", len(jwks))
+			}
+			if jwks[0].Kty != tc.expectedKty {
+				t.Errorf("unexpected key type: %s", jwks[0].Kty)
+			}
+			if tc.expectedKid != "" && jwks[0].Kid != tc.expectedKid {
+				t.Errorf("unexpected key id: %s", jwks[0].Kid)
+			}
+			if jwks[0].Kid == "" {
+				t.Errorf("missing key id")
+			}
+
+			accessToken, _, err := jwtGetter.GetToken(
+				context.Background(),
+				uuid.MustParse("585e21fc-3664-4d03-8539-69945342a4f4"),
+				false,
+				[]string{"user"},
+				"user",
+				nil,
+				slog.Default(),
+			)
+			if err != nil {
+				t.Fatalf("error getting token: %v", err)
+			}
+
+			token, err := jwtGetter.Validate(accessToken)
+			if err != nil {
+				t.Fatalf("error validating token: %v", err)
+			}
+
+			if token.Header["kid"] != jwks[0].Kid {
+				t.Errorf("unexpected kid header: %v", token.Header["kid"])
+			}
+			if token.Header["alg"] != jwks[0].Alg {
+				t.Errorf("unexpected alg header: %v", token.Header["alg"])
+			}
+		})
+	}
+}
+
+func TestJWTGetterKeyRotation(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	rsaPrivate := pemEncode(t, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey), nil)
+	rsaPublicDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	rsaPublic := pemEncode(t, "PUBLIC KEY", rsaPublicDER, err)
+	ecPrivateDER, err := x509.MarshalECPrivateKey(ecKey)
+	ecPrivate := pemEncode(t, "EC PRIVATE KEY", ecPrivateDER, err)
+
+	rsaSecret := asymmetricJWTSecret(t, "RS256", map[string]string{"signing_key": rsaPrivate})
+	ecSecret := asymmetricJWTSecret(t, "ES256", map[string]string{
+		"signing_key": ecPrivate,
+		"kid":         "new-key",
+	})
+	hmacSecret := []byte(`{"type":"HS256", "key":"a-different-secret-that-is-at-least-32-characters-long","kid":"new-key"}`) //nolint:lll
+
+	cases := []struct {
+		name          string
+		oldSecret     []byte
+		newSecret     []byte
+		retired       []byte
+		expectedValid bool
+		expectedJWKs  int
+	}{
+		{
+			name:          "hmac secret retired",
+			oldSecret:     jwtSecret,
+			newSecret:     hmacSecret,
+			retired:       []byte("[" + string(jwtSecret) + "]"),
+			expectedValid: true,
+			expectedJWKs:  0,
+		},
+		{
+			name:          "hmac secret removed",
+			oldSecret:     jwtSecret,
+			newSecret:     hmacSecret,
+			retired:       nil,
+			expectedValid: false,
+			expectedJWKs:  0,
+		},
+		{
+			name:      "rsa key retired",
+			oldSecret: rsaSecret,
+			newSecret: ecSecret,
+			retired: []byte(
+				`[` + string(asymmetricJWTSecret(t, "RS256", map[string]string{"key": rsaPublic})) + `]`,
+			),
+			expectedValid: true,
+			expectedJWKs:  2, //nolint:mnd
+		},
+		{
+			name:          "rsa key removed",
+			oldSecret:     rsaSecret,
+			newSecret:     ecSecret,
+			retired:       nil,
+			expectedValid: false,
+			expectedJWKs:  1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			oldJWTGetter, err := controller.NewJWTGetter(
+				tc.oldSecret, nil, time.Hour, nil, "disabled", false, nil,
+			)
+			if err != nil {
+				t.Fatalf("error creating jwt getter: %v", err)
+			}
+
+			accessToken, _, err := oldJWTGetter.GetToken(
+				context.Background(),
+				uuid.MustParse("585e21fc-3664-4d03-8539-69945342a4f4"),
+				false,
+				[]string{"user"},
+				"user",
+				nil,
+				slog.Default(),
+			)
+			if err != nil {
+				t.Fatalf("error getting token: %v", err)
+			}
+
+			newJWTGetter, err := controller.NewJWTGetter(
+				tc.newSecret, tc.retired, time.Hour, nil, "disabled", false, nil,
+			)
+			if err != nil {
+				t.Fatalf("error creating jwt getter: %v", err)
+			}
+
+			if _, err := newJWTGetter.Validate(accessToken); (err == nil) != tc.expectedValid {
+				t.Errorf("unexpected error validating token: %v", err)
+			}
+
+			if n := len(newJWTGetter.JWKs()); n != tc.expectedJWKs {
+				t.Errorf("unexpected number of published keys: %d", n)
+			}
+
+			newAccessToken, _, err := newJWTGetter.GetToken(
+				context.Background(),
+				uuid.MustParse("585e21fc-3664-4d03-8539-69945342a4f4"),
+				false,
+				[]string{"user"},
+				"user",
+				nil,
+				slog.Default(),
+			)
+			if err != nil {
+				t.Fatalf("error getting token: %v", err)
+			}
+
+			token, err := newJWTGetter.Validate(newAccessToken)
+			if err != nil {
+				t.Fatalf("error validating token: %v", err)
+			}
+			if token.Header["kid"] != "new-key" {
+				t.Errorf("unexpected kid header: %v", token.Header["kid"])
+			}
+		})
+	}
+}
+
+func TestJWTGetterDuplicateKeyID(t *testing.T) {
+	t.Parallel()
+
+	_, err := controller.NewJWTGetter(
+		[]byte(`{"type":"HS256", "key":"a-secret-that-is-at-least-32-characters-long","kid":"k1"}`),
+		[]byte(`[{"type":"HS256", "key":"another-secret-that-is-at-least-32-characters","kid":"k1"}]`),
+		time.Hour, nil, "disabled", false, nil,
+	)
+	if !errors.Is(err, controller.ErrDuplicateJWTKeyID) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestJWTGetterClientToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	// client tokens aren't tied to a user so revocation doesn't look anything up
+	jwtGetter, err := controller.NewJWTGetter(
+		jwtSecret, nil, time.Hour, nil, "disabled", true, mock.NewMockDBClient(ctrl),
+	)
+	if err != nil {
+		t.Fatalf("error creating jwt getter: %v", err)
+	}
+
+	accessToken, expiresIn, err := jwtGetter.GetClientToken(
+		"my-client", []string{"service", "reports"}, "service",
+	)
+	if err != nil {
+		t.Fatalf("error getting client token: %v", err)
+	}
+	if expiresIn != 3600 {
+		t.Errorf("unexpected expires in: %d", expiresIn)
+	}
+
+	token, err := jwtGetter.ValidateAccessToken(context.Background(), accessToken)
+	if err != nil {
+		t.Fatalf("error validating client token: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		jwt.MapClaims{
+			"sub": "my-client",
+			"iss": "hasura-auth",
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles": []any{"service", "reports"},
+				"x-hasura-default-role":  "service",
+				"x-hasura-client-id":     "my-client",
+			},
+		},
+		token.Claims,
+		cmpopts.IgnoreMapEntries(func(k string, _ any) bool {
+			return k == "iat" || k == "exp"
+		}),
+	); diff != "" {
+		t.Errorf("unexpected claims (-want +got):\n%s", diff)
+	}
+}
+
+func TestJWTGetterDataExportTicket(t *testing.T) {
+	t.Parallel()
+
+	jwtGetter, err := controller.NewJWTGetter(jwtSecret, nil, time.Hour, nil, "", false, nil)
+	if err != nil {
+		t.Fatalf("error creating jwt getter: %v", err)
+	}
+
+	exportID := uuid.MustParse("e8c4b5a2-3f1d-4c6e-9a7b-2d5f8e1c4b3a")
+	ticket, err := jwtGetter.GetDataExportTicket(exportID, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("error getting data export ticket: %v", err)
+	}
+
+	id, err := jwtGetter.ValidateDataExportTicket(ticket)
+	if err != nil {
+		t.Fatalf("error validating data export ticket: %v", err)
+	}
+	if id != exportID {
+		t.Errorf("unexpected export id: %s", id)
+	}
+
+	// tickets are signed with the same key but must not be accepted as access tokens
+	if _, err := jwtGetter.ValidateAccessToken(
+		context.Background(), ticket,
+	); !errors.Is(err, controller.ErrUnexpectedJWTAudience) {
+		t.Errorf("expected ticket to be rejected as access token, got %v", err)
+	}
+}
+
+func TestJWTGetterIDToken(t *testing.T) {
+	t.Parallel()
+
+	jwtGetter, err := controller.NewJWTGetter(jwtSecret, nil, time.Hour, nil, "", false, nil)
+	if err != nil {
+		t.Fatalf("error creating jwt getter: %v", err)
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	idToken, err := jwtGetter.GetIDToken(
+		"https://local.auth.nhost.run", "my-client", userID,
+		map[string]any{"email": "jane@acme.com"}, "n-0S6_WzA2Mj",
+	)
+	if err != nil {
+		t.Fatalf("error getting id token: %v", err)
+	}
+
+	// ID tokens are signed with the same key but must not be accepted as access tokens
+	if _, err := jwtGetter.ValidateAccessToken(context.Background(), idToken); err == nil {
+		t.Error("expected id token to be rejected as access token")
+	}
+}
+
 func TestMiddlewareFunc(t *testing.T) { //nolint:maintidx
 	t.Parallel()
 
@@ -661,7 +1146,7 @@ func TestMiddlewareFunc(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			jwtGetter, err := controller.NewJWTGetter(
-				jwtSecret, time.Hour, nil, tc.elevatedMode, tc.db(ctrl),
+				jwtSecret, nil, time.Hour, nil, tc.elevatedMode, false, tc.db(ctrl),
 			)
 			if err != nil {
 				t.Fatalf("GetJWTFunc() err = %v; want nil", err)
@@ -689,3 +1174,81 @@ func TestMiddlewareFunc(t *testing.T) { //nolint:maintidx
 		})
 	}
 }
+
+func TestMiddlewareFuncAccessTokenRevocation(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("f90782de-f0a3-41fe-b778-01e4f80c2413")
+
+	cases := []struct {
+		name             string
+		tokensValidAfter pgtype.Timestamptz
+		expectedErr      error
+	}{
+		{
+			name:             "never revoked",
+			tokensValidAfter: pgtype.Timestamptz{}, //nolint:exhaustruct
+			expectedErr:      nil,
+		},
+		{
+			name:             "revoked before the token was issued",
+			tokensValidAfter: sql.TimestampTz(time.Now().Add(-time.Hour)),
+			expectedErr:      nil,
+		},
+		{
+			name:             "revoked after the token was issued",
+			tokensValidAfter: sql.TimestampTz(time.Now().Add(time.Hour)),
+			expectedErr:      controller.ErrAccessTokenRevoked,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			db := mock.NewMockDBClient(ctrl)
+			user := getSigninUser(userID)
+			user.TokensValidAfter = tc.tokensValidAfter
+			db.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+			jwtGetter, err := controller.NewJWTGetter(
+				jwtSecret, nil, time.Hour, nil, "disabled", true, db,
+			)
+			if err != nil {
+				t.Fatalf("GetJWTFunc() err = %v; want nil", err)
+			}
+
+			accessToken, _, err := jwtGetter.GetToken(
+				context.Background(), userID, false, []string{"user"}, "user", nil,
+				slog.Default(),
+			)
+			if err != nil {
+				t.Fatalf("GetToken() err = %v; want nil", err)
+			}
+
+			//nolint
+			ctx := context.WithValue(
+				context.Background(),
+				ginmiddleware.GinContextKey,
+				&gin.Context{},
+			)
+			err = jwtGetter.MiddlewareFunc(ctx, &openapi3filter.AuthenticationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{ //nolint:exhaustruct
+					Request: &http.Request{ //nolint:exhaustruct
+						Header: http.Header{
+							"Authorization": []string{"Bearer " + accessToken},
+						},
+					},
+				},
+				SecuritySchemeName: "BearerAuth",
+				SecurityScheme:     nil,
+				Scopes:             []string{},
+			})
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("err = %v; want %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
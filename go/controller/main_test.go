@@ -15,6 +15,7 @@ import (
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
 	"github.com/nhost/hasura-auth/go/testhelpers"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/crypto/bcrypt"
@@ -134,7 +135,13 @@ func cmpDBParams(
 			[]string{".ExpiresAt", "time()"}, cmpopts.EquateApproxTime(time.Minute),
 		),
 		testhelpers.FilterPathLast(
-			[]string{".RefreshTokenHash", "text()"},
+			[]string{".RefreshTokenHash"},
+			cmp.Comparer(func(x, y string) bool {
+				return x != "" || y != ""
+			}),
+		),
+		testhelpers.FilterPathLast(
+			[]string{".TokenHash"},
 			cmp.Comparer(func(x, y string) bool {
 				return x != "" || y != ""
 			}),
@@ -160,9 +167,23 @@ type testRequest[T, U any] struct {
 }
 
 type getControllerOpts struct {
-	customClaimer func(*gomock.Controller) controller.CustomClaimer
-	emailer       func(*gomock.Controller) *mock.MockEmailer
-	hibp          func(*gomock.Controller) *mock.MockHIBPClient
+	customClaimer    func(*gomock.Controller) controller.CustomClaimer
+	emailer          func(*gomock.Controller) *mock.MockEmailer
+	hibp             func(*gomock.Controller) *mock.MockHIBPClient
+	sms              func(*gomock.Controller) *mock.MockSMSSender
+	providers        func(*gomock.Controller) map[string]providers.Provider
+	idTokenProviders func(*gomock.Controller) map[string]providers.IDTokenProvider
+	saml             func(*gomock.Controller) *mock.MockSAMLServiceProvider
+	rateLimiter      controller.RateLimiter
+	captcha          controller.CaptchaVerifier
+	webhooks         func(*gomock.Controller) *mock.MockWebhookSender
+	preSignUpHook    func(*gomock.Controller) *mock.MockPreSignUpHook
+	disposableEmails controller.DisposableEmailChecker
+	avatarStorage    func(*gomock.Controller) *mock.MockAvatarStorage
+	geoIP            controller.GeoIPLocator
+	riskEngine       controller.RiskEngine
+	configReloader   controller.ConfigReloader
+	signInMethods    *controller.SignInMethodFlags
 }
 
 func getController(
@@ -183,9 +204,11 @@ func getController(
 
 	jwtGetter, err := controller.NewJWTGetter(
 		jwtSecret,
+		nil,
 		time.Second*time.Duration(config.AccessTokenExpiresIn),
 		cc,
 		"",
+		false,
 		nil,
 	)
 	if err != nil {
@@ -202,14 +225,62 @@ func getController(
 		hibp = opts.hibp(ctrl)
 	}
 
-	c, err := controller.New(
-		db(ctrl),
-		config,
-		jwtGetter,
-		emailer,
-		hibp,
-		"dev",
-	)
+	var sms controller.SMSSender
+	if opts.sms != nil {
+		sms = opts.sms(ctrl)
+	}
+
+	var oauthProviders map[string]providers.Provider
+	if opts.providers != nil {
+		oauthProviders = opts.providers(ctrl)
+	}
+
+	var idTokenProviders map[string]providers.IDTokenProvider
+	if opts.idTokenProviders != nil {
+		idTokenProviders = opts.idTokenProviders(ctrl)
+	}
+
+	var saml controller.SAMLServiceProvider
+	if opts.saml != nil {
+		saml = opts.saml(ctrl)
+	}
+
+	var webhookSender controller.WebhookSender
+	if opts.webhooks != nil {
+		webhookSender = opts.webhooks(ctrl)
+	}
+
+	var preSignUpHook controller.PreSignUpHook
+	if opts.preSignUpHook != nil {
+		preSignUpHook = opts.preSignUpHook(ctrl)
+	}
+
+	var avatarStorage controller.AvatarStorage
+	if opts.avatarStorage != nil {
+		avatarStorage = opts.avatarStorage(ctrl)
+	}
+
+	c, err := controller.New(config, controller.Dependencies{
+		DB:               db(ctrl),
+		JWTGetter:        jwtGetter,
+		Emailer:          emailer,
+		SMS:              sms,
+		HIBP:             hibp,
+		OAuthProviders:   oauthProviders,
+		IDTokenProviders: idTokenProviders,
+		SAML:             saml,
+		RateLimiter:      opts.rateLimiter,
+		Captcha:          opts.captcha,
+		Webhooks:         webhookSender,
+		PreSignUpHook:    preSignUpHook,
+		DisposableEmails: opts.disposableEmails,
+		AvatarStorage:    avatarStorage,
+		GeoIP:            opts.geoIP,
+		RiskEngine:       opts.riskEngine,
+		ConfigReloader:   opts.configReloader,
+		SignInMethods:    opts.signInMethods,
+		Version:          "dev",
+	})
 	if err != nil {
 		t.Fatalf("failed to create controller: %v", err)
 	}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// MetadataClaims takes the custom claims from the user's metadata. Unlike CustomClaims it
+// reads the user from the database so it doesn't depend on the Hasura GraphQL Engine.
+type MetadataClaims struct {
+	jsonPaths map[string]jsonPath
+	db        DBClientGetUser
+}
+
+// NewMetadataClaims returns a claimer mapping each claim to a path in the metadata, for
+// instance `{"org-id": "org_id"}` sets `x-hasura-org-id` to the `org_id` key of the
+// metadata. Paths support the same syntax as the ones used by CustomClaims.
+func NewMetadataClaims(rawClaims map[string]string, db DBClientGetUser) (*MetadataClaims, error) {
+	jsonPaths, err := parseJSONPaths(rawClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MetadataClaims{
+		jsonPaths: jsonPaths,
+		db:        db,
+	}, nil
+}
+
+func (c *MetadataClaims) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user id: %w", err)
+	}
+
+	user, err := c.db.GetUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var metadata any = map[string]any{}
+	if len(user.Metadata) > 0 {
+		if err := json.Unmarshal(user.Metadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal user metadata: %w", err)
+		}
+	}
+
+	return extractClaims(c.jsonPaths, metadata), nil
+}
+
+// CustomClaimers combines several claimers, if more than one returns the same claim the
+// last one takes precedence.
+type CustomClaimers []CustomClaimer
+
+func (c CustomClaimers) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	claims := make(map[string]any)
+	for _, claimer := range c {
+		got, err := claimer.GetClaims(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range got {
+			claims[k] = v
+		}
+	}
+	return claims, nil
+}
+
+// ErrRequiredClaims is returned by the claimers of RequiredClaims when they fail, which
+// fails issuing the access token instead of issuing it without the custom claims.
+var ErrRequiredClaims = errors.New("required custom claims failed")
+
+// RequiredClaims wraps a claimer whose claims are required, i.e. because Hasura
+// permissions depend on them, so access tokens aren't issued when it fails.
+type RequiredClaims struct {
+	CustomClaimer
+}
+
+func (c RequiredClaims) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	claims, err := c.CustomClaimer.GetClaims(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRequiredClaims, err)
+	}
+	return claims, nil
+}
+
+// TenantClaims sets `x-hasura-tenant-id` to the tenant of the deployment so Hasura
+// permissions can keep the data of the tenants apart.
+type TenantClaims string
+
+func (c TenantClaims) GetClaims(_ context.Context, _ string) (map[string]any, error) {
+	return map[string]any{"tenant-id": string(c)}, nil
+}
+
+// OrganizationClaims sets `x-hasura-org-id` and `x-hasura-org-role` to the active
+// organization of the user and their role in it. Users without an active organization, or
+// that are no longer members of it, don't get the claims.
+type OrganizationClaims struct {
+	db DBClientOrganizations
+}
+
+func NewOrganizationClaims(db DBClientOrganizations) *OrganizationClaims {
+	return &OrganizationClaims{db: db}
+}
+
+func (c *OrganizationClaims) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user id: %w", err)
+	}
+
+	member, err := c.db.GetUserActiveOrganizationMember(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return map[string]any{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active organization: %w", err)
+	}
+
+	return map[string]any{
+		"org-id":   member.OrganizationID.String(),
+		"org-role": member.Role,
+	}, nil
+}
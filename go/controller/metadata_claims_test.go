@@ -0,0 +1,199 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMetadataClaims(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []struct {
+		name     string
+		claims   map[string]string
+		metadata []byte
+		expected map[string]any
+	}{
+		{
+			name: "claims",
+			claims: map[string]string{
+				"org-id":       "org_id",
+				"plan":         "billing.plan",
+				"team-ids[]":   "teams[].id",
+				"first-team":   "teams[0].id",
+				"nonexistent":  "nonexistent",
+				"whole-object": "billing",
+			},
+			metadata: []byte(
+				`{"org_id":"acme","billing":{"plan":"pro"},"teams":[{"id":"a"},{"id":"b"}]}`,
+			),
+			expected: map[string]any{
+				"org-id":       "acme",
+				"plan":         "pro",
+				"team-ids[]":   []any{"a", "b"},
+				"first-team":   "a",
+				"nonexistent":  nil,
+				"whole-object": map[string]any{"plan": "pro"},
+			},
+		},
+		{
+			name: "no metadata",
+			claims: map[string]string{
+				"org-id": "org_id",
+			},
+			metadata: nil,
+			expected: map[string]any{
+				"org-id": nil,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			db := mock.NewMockDBClient(ctrl)
+			user := getSigninUser(userID)
+			user.Metadata = tc.metadata
+			db.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+			claimer, err := controller.NewMetadataClaims(tc.claims, db)
+			if err != nil {
+				t.Fatalf("NewMetadataClaims() err = %v; want nil", err)
+			}
+
+			got, err := claimer.GetClaims(context.Background(), userID.String())
+			if err != nil {
+				t.Fatalf("GetClaims() err = %v; want nil", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected claims (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomClaimers(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	ctrl := gomock.NewController(t)
+
+	first := mock.NewMockCustomClaimer(ctrl)
+	first.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+		map[string]any{"org-id": "from-graphql", "plan": "free"}, nil,
+	)
+
+	second := mock.NewMockCustomClaimer(ctrl)
+	second.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+		map[string]any{"org-id": "from-metadata"}, nil,
+	)
+
+	got, err := controller.CustomClaimers{first, second}.GetClaims(
+		context.Background(), userID.String(),
+	)
+	if err != nil {
+		t.Fatalf("GetClaims() err = %v; want nil", err)
+	}
+
+	if diff := cmp.Diff(
+		map[string]any{"org-id": "from-metadata", "plan": "free"}, got,
+	); diff != "" {
+		t.Errorf("unexpected claims (-want +got):\n%s", diff)
+	}
+}
+
+func TestTenantClaims(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	ctrl := gomock.NewController(t)
+
+	graphql := mock.NewMockCustomClaimer(ctrl)
+	graphql.EXPECT().GetClaims(gomock.Any(), userID.String()).Return(
+		map[string]any{"tenant-id": "globex", "plan": "free"}, nil,
+	)
+
+	got, err := controller.CustomClaimers{graphql, controller.TenantClaims("acme")}.GetClaims(
+		context.Background(), userID.String(),
+	)
+	if err != nil {
+		t.Fatalf("GetClaims() err = %v; want nil", err)
+	}
+
+	if diff := cmp.Diff(
+		map[string]any{"tenant-id": "acme", "plan": "free"}, got,
+	); diff != "" {
+		t.Errorf("unexpected claims (-want +got):\n%s", diff)
+	}
+}
+
+func TestOrganizationClaims(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	organizationID := uuid.MustParse("7a2f5a0c-3b1e-4f0a-9d4e-5c6b7a8d9e0f")
+
+	cases := []struct {
+		name     string
+		member   sql.GetUserActiveOrganizationMemberRow
+		err      error
+		expected map[string]any
+	}{
+		{
+			name: "active organization",
+			member: sql.GetUserActiveOrganizationMemberRow{
+				OrganizationID: organizationID,
+				Role:           "admin",
+			},
+			err: nil,
+			expected: map[string]any{
+				"org-id":   "7a2f5a0c-3b1e-4f0a-9d4e-5c6b7a8d9e0f",
+				"org-role": "admin",
+			},
+		},
+		{
+			name:     "no active organization",
+			member:   sql.GetUserActiveOrganizationMemberRow{}, //nolint:exhaustruct
+			err:      pgx.ErrNoRows,
+			expected: map[string]any{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			db := mock.NewMockDBClientOrganizations(ctrl)
+			db.EXPECT().GetUserActiveOrganizationMember(gomock.Any(), userID).Return(tc.member, tc.err)
+
+			got, err := controller.NewOrganizationClaims(db).GetClaims(
+				context.Background(), userID.String(),
+			)
+			if err != nil {
+				t.Fatalf("GetClaims() err = %v; want nil", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected claims (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
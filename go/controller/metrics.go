@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
+)
+
+// signInMethods are the operations counted as sign in attempts and the method they are
+// counted as.
+var signInMethods = map[string]string{ //nolint:gochecknoglobals
+	"PostDeviceToken":              "device-code",
+	"PostSigninAnonymous":          "anonymous",
+	"PostSigninEmailPassword":      "email-password",
+	"PostSigninIdtoken":            "idtoken",
+	"PostSigninMfaRecoveryCode":    "mfa-recovery-code",
+	"PostSigninMfaTotp":            "mfa-totp",
+	"PostSigninPasswordlessSmsOtp": "passwordless-sms",
+	"PostSigninPat":                "pat",
+	"PostSigninWebauthnVerify":     "webauthn",
+}
+
+// metricsOutcome returns success or the error the operation failed with.
+func metricsOutcome(response any, err error) string {
+	if code := auditErrorCode(response, err); code != "" {
+		return code
+	}
+	return metrics.OutcomeSuccess
+}
+
+// Metrics is a strict middleware that counts the sign in attempts and the token refreshes
+// by outcome.
+func (ctrl *Controller) Metrics(
+	f api.StrictHandlerFunc,
+	operationID string,
+) api.StrictHandlerFunc {
+	method, isSignIn := signInMethods[operationID]
+	if !isSignIn && operationID != "PostToken" {
+		return f
+	}
+
+	return func(ctx *gin.Context, request any) (any, error) {
+		response, err := f(ctx, request)
+
+		if isSignIn {
+			metrics.SignIns.WithLabelValues(method, metricsOutcome(response, err)).Inc()
+		} else {
+			metrics.TokenRefreshes.WithLabelValues(metricsOutcome(response, err)).Inc()
+		}
+
+		return response, err
+	}
+}
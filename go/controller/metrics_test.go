@@ -0,0 +1,122 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		operationID string
+		response    any
+		counter     prometheus.Counter
+	}{
+		{
+			name:        "sign in",
+			operationID: "PostSigninEmailPassword",
+			response: api.PostSigninEmailPassword200JSONResponse{
+				Session: nil,
+				Mfa:     nil,
+			},
+			counter: metrics.SignIns.WithLabelValues("email-password", "success"),
+		},
+		{
+			name:        "sign in failed",
+			operationID: "PostSigninPat",
+			response: controller.ErrorResponse{
+				Status:  http.StatusUnauthorized,
+				Error:   "invalid-pat",
+				Message: "Invalid or expired personal access token",
+			},
+			counter: metrics.SignIns.WithLabelValues("pat", "invalid-pat"),
+		},
+		{
+			name:        "token refresh failed",
+			operationID: "PostToken",
+			response: controller.ErrorResponse{
+				Status:  http.StatusUnauthorized,
+				Error:   "invalid-refresh-token",
+				Message: "Invalid or expired refresh token",
+			},
+			counter: metrics.TokenRefreshes.WithLabelValues("invalid-refresh-token"),
+		},
+		{
+			name:        "not counted",
+			operationID: "GetVersion",
+			response:    api.GetVersion200JSONResponse{Version: "dev"},
+			counter:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, getConfig, func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			}, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			handler := c.Metrics(
+				func(_ *gin.Context, _ any) (any, error) {
+					return tc.response, nil
+				},
+				tc.operationID,
+			)
+
+			var before float64
+			if tc.counter != nil {
+				before = testutil.ToFloat64(tc.counter)
+			}
+
+			ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			resp, err := handler(ginCtx, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.response, resp); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+
+			if tc.counter != nil {
+				if got := testutil.ToFloat64(tc.counter) - before; got != 1 {
+					t.Errorf("expected the counter to be incremented once, got %v", got)
+				}
+			}
+		})
+	}
+}
@@ -12,11 +12,17 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	pgtype "github.com/jackc/pgx/v5/pgtype"
+	geoip "github.com/nhost/hasura-auth/go/geoip"
 	notifications "github.com/nhost/hasura-auth/go/notifications"
+	providers "github.com/nhost/hasura-auth/go/providers"
+	ratelimit "github.com/nhost/hasura-auth/go/ratelimit"
+	risk "github.com/nhost/hasura-auth/go/risk"
 	sql "github.com/nhost/hasura-auth/go/sql"
+	webhooks "github.com/nhost/hasura-auth/go/webhooks"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,6 +63,43 @@ func (mr *MockEmailerMockRecorder) SendEmail(ctx, to, locale, templateName, data
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockEmailer)(nil).SendEmail), ctx, to, locale, templateName, data)
 }
 
+// MockSMSSender is a mock of SMSSender interface.
+type MockSMSSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockSMSSenderMockRecorder
+}
+
+// MockSMSSenderMockRecorder is the mock recorder for MockSMSSender.
+type MockSMSSenderMockRecorder struct {
+	mock *MockSMSSender
+}
+
+// NewMockSMSSender creates a new mock instance.
+func NewMockSMSSender(ctrl *gomock.Controller) *MockSMSSender {
+	mock := &MockSMSSender{ctrl: ctrl}
+	mock.recorder = &MockSMSSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSMSSender) EXPECT() *MockSMSSenderMockRecorder {
+	return m.recorder
+}
+
+// SendSMS mocks base method.
+func (m *MockSMSSender) SendSMS(ctx context.Context, to, locale string, templateName notifications.TemplateName, data notifications.TemplateData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSMS", ctx, to, locale, templateName, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendSMS indicates an expected call of SendSMS.
+func (mr *MockSMSSenderMockRecorder) SendSMS(ctx, to, locale, templateName, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSMS", reflect.TypeOf((*MockSMSSender)(nil).SendSMS), ctx, to, locale, templateName, data)
+}
+
 // MockDBClientGetUser is a mock of DBClientGetUser interface.
 type MockDBClientGetUser struct {
 	ctrl     *gomock.Controller
@@ -110,6 +153,51 @@ func (mr *MockDBClientGetUserMockRecorder) GetUserByEmail(ctx, email any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByEmail), ctx, email)
 }
 
+// GetUserByPersonalAccessTokenHash mocks base method.
+func (m *MockDBClientGetUser) GetUserByPersonalAccessTokenHash(ctx context.Context, tokenHash string) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPersonalAccessTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPersonalAccessTokenHash indicates an expected call of GetUserByPersonalAccessTokenHash.
+func (mr *MockDBClientGetUserMockRecorder) GetUserByPersonalAccessTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPersonalAccessTokenHash", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByPersonalAccessTokenHash), ctx, tokenHash)
+}
+
+// GetUserByPhoneNumber mocks base method.
+func (m *MockDBClientGetUser) GetUserByPhoneNumber(ctx context.Context, phoneNumber pgtype.Text) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhoneNumber indicates an expected call of GetUserByPhoneNumber.
+func (mr *MockDBClientGetUserMockRecorder) GetUserByPhoneNumber(ctx, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhoneNumber", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByPhoneNumber), ctx, phoneNumber)
+}
+
+// GetUserByProviderID mocks base method.
+func (m *MockDBClientGetUser) GetUserByProviderID(ctx context.Context, arg sql.GetUserByProviderIDParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByProviderID", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByProviderID indicates an expected call of GetUserByProviderID.
+func (mr *MockDBClientGetUserMockRecorder) GetUserByProviderID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByProviderID", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByProviderID), ctx, arg)
+}
+
 // GetUserByRefreshTokenHash mocks base method.
 func (m *MockDBClientGetUser) GetUserByRefreshTokenHash(ctx context.Context, arg sql.GetUserByRefreshTokenHashParams) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +213,133 @@ func (mr *MockDBClientGetUserMockRecorder) GetUserByRefreshTokenHash(ctx, arg an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByRefreshTokenHash", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByRefreshTokenHash), ctx, arg)
 }
 
+// GetUserByTicket mocks base method.
+func (m *MockDBClientGetUser) GetUserByTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByTicket", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByTicket indicates an expected call of GetUserByTicket.
+func (mr *MockDBClientGetUserMockRecorder) GetUserByTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByTicket", reflect.TypeOf((*MockDBClientGetUser)(nil).GetUserByTicket), ctx, ticket)
+}
+
+// MockDBClientOrganizations is a mock of DBClientOrganizations interface.
+type MockDBClientOrganizations struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBClientOrganizationsMockRecorder
+}
+
+// MockDBClientOrganizationsMockRecorder is the mock recorder for MockDBClientOrganizations.
+type MockDBClientOrganizationsMockRecorder struct {
+	mock *MockDBClientOrganizations
+}
+
+// NewMockDBClientOrganizations creates a new mock instance.
+func NewMockDBClientOrganizations(ctrl *gomock.Controller) *MockDBClientOrganizations {
+	mock := &MockDBClientOrganizations{ctrl: ctrl}
+	mock.recorder = &MockDBClientOrganizationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBClientOrganizations) EXPECT() *MockDBClientOrganizationsMockRecorder {
+	return m.recorder
+}
+
+// AcceptOrganizationInvite mocks base method.
+func (m *MockDBClientOrganizations) AcceptOrganizationInvite(ctx context.Context, arg sql.AcceptOrganizationInviteParams) (sql.AcceptOrganizationInviteRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrganizationInvite", ctx, arg)
+	ret0, _ := ret[0].(sql.AcceptOrganizationInviteRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrganizationInvite indicates an expected call of AcceptOrganizationInvite.
+func (mr *MockDBClientOrganizationsMockRecorder) AcceptOrganizationInvite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrganizationInvite", reflect.TypeOf((*MockDBClientOrganizations)(nil).AcceptOrganizationInvite), ctx, arg)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockDBClientOrganizations) GetOrganizationMember(ctx context.Context, arg sql.GetOrganizationMemberParams) (sql.GetOrganizationMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", ctx, arg)
+	ret0, _ := ret[0].(sql.GetOrganizationMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockDBClientOrganizationsMockRecorder) GetOrganizationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockDBClientOrganizations)(nil).GetOrganizationMember), ctx, arg)
+}
+
+// GetUserActiveOrganizationMember mocks base method.
+func (m *MockDBClientOrganizations) GetUserActiveOrganizationMember(ctx context.Context, id uuid.UUID) (sql.GetUserActiveOrganizationMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserActiveOrganizationMember", ctx, id)
+	ret0, _ := ret[0].(sql.GetUserActiveOrganizationMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserActiveOrganizationMember indicates an expected call of GetUserActiveOrganizationMember.
+func (mr *MockDBClientOrganizationsMockRecorder) GetUserActiveOrganizationMember(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserActiveOrganizationMember", reflect.TypeOf((*MockDBClientOrganizations)(nil).GetUserActiveOrganizationMember), ctx, id)
+}
+
+// InsertOrganization mocks base method.
+func (m *MockDBClientOrganizations) InsertOrganization(ctx context.Context, arg sql.InsertOrganizationParams) (sql.InsertOrganizationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrganization", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertOrganizationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOrganization indicates an expected call of InsertOrganization.
+func (mr *MockDBClientOrganizationsMockRecorder) InsertOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganization", reflect.TypeOf((*MockDBClientOrganizations)(nil).InsertOrganization), ctx, arg)
+}
+
+// UpdateUserActiveOrganization mocks base method.
+func (m *MockDBClientOrganizations) UpdateUserActiveOrganization(ctx context.Context, arg sql.UpdateUserActiveOrganizationParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserActiveOrganization", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserActiveOrganization indicates an expected call of UpdateUserActiveOrganization.
+func (mr *MockDBClientOrganizationsMockRecorder) UpdateUserActiveOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserActiveOrganization", reflect.TypeOf((*MockDBClientOrganizations)(nil).UpdateUserActiveOrganization), ctx, arg)
+}
+
+// UpsertOrganizationInvite mocks base method.
+func (m *MockDBClientOrganizations) UpsertOrganizationInvite(ctx context.Context, arg sql.UpsertOrganizationInviteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOrganizationInvite", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertOrganizationInvite indicates an expected call of UpsertOrganizationInvite.
+func (mr *MockDBClientOrganizationsMockRecorder) UpsertOrganizationInvite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOrganizationInvite", reflect.TypeOf((*MockDBClientOrganizations)(nil).UpsertOrganizationInvite), ctx, arg)
+}
+
 // MockDBClientInsertUser is a mock of DBClientInsertUser interface.
 type MockDBClientInsertUser struct {
 	ctrl     *gomock.Controller
@@ -193,6 +408,21 @@ func (mr *MockDBClientInsertUserMockRecorder) InsertUserWithSecurityKeyAndRefres
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithSecurityKeyAndRefreshToken", reflect.TypeOf((*MockDBClientInsertUser)(nil).InsertUserWithSecurityKeyAndRefreshToken), ctx, arg)
 }
 
+// InsertUserWithUserProvider mocks base method.
+func (m *MockDBClientInsertUser) InsertUserWithUserProvider(ctx context.Context, arg sql.InsertUserWithUserProviderParams) (sql.InsertUserWithUserProviderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserWithUserProvider", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertUserWithUserProviderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserWithUserProvider indicates an expected call of InsertUserWithUserProvider.
+func (mr *MockDBClientInsertUserMockRecorder) InsertUserWithUserProvider(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithUserProvider", reflect.TypeOf((*MockDBClientInsertUser)(nil).InsertUserWithUserProvider), ctx, arg)
+}
+
 // MockDBClientUpdateUser is a mock of DBClientUpdateUser interface.
 type MockDBClientUpdateUser struct {
 	ctrl     *gomock.Controller
@@ -231,336 +461,2810 @@ func (mr *MockDBClientUpdateUserMockRecorder) InsertUserWithSecurityKey(ctx, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithSecurityKey", reflect.TypeOf((*MockDBClientUpdateUser)(nil).InsertUserWithSecurityKey), ctx, arg)
 }
 
-// UpdateUserChangeEmail mocks base method.
-func (m *MockDBClientUpdateUser) UpdateUserChangeEmail(ctx context.Context, arg sql.UpdateUserChangeEmailParams) (sql.AuthUser, error) {
+// RecordUserSignInFailure mocks base method.
+func (m *MockDBClientUpdateUser) RecordUserSignInFailure(ctx context.Context, arg sql.RecordUserSignInFailureParams) (int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserChangeEmail", ctx, arg)
-	ret0, _ := ret[0].(sql.AuthUser)
+	ret := m.ctrl.Call(m, "RecordUserSignInFailure", ctx, arg)
+	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserChangeEmail indicates an expected call of UpdateUserChangeEmail.
-func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserChangeEmail(ctx, arg any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserChangeEmail", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserChangeEmail), ctx, arg)
-}
-
-// UpdateUserDeanonymize mocks base method.
-func (m *MockDBClientUpdateUser) UpdateUserDeanonymize(ctx context.Context, arg sql.UpdateUserDeanonymizeParams) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserDeanonymize", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdateUserDeanonymize indicates an expected call of UpdateUserDeanonymize.
-func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserDeanonymize(ctx, arg any) *gomock.Call {
+// RecordUserSignInFailure indicates an expected call of RecordUserSignInFailure.
+func (mr *MockDBClientUpdateUserMockRecorder) RecordUserSignInFailure(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeanonymize", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserDeanonymize), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUserSignInFailure", reflect.TypeOf((*MockDBClientUpdateUser)(nil).RecordUserSignInFailure), ctx, arg)
 }
 
-// UpdateUserLastSeen mocks base method.
-func (m *MockDBClientUpdateUser) UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error) {
+// ResetUserSignInFailures mocks base method.
+func (m *MockDBClientUpdateUser) ResetUserSignInFailures(ctx context.Context, id uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserLastSeen", ctx, id)
-	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret := m.ctrl.Call(m, "ResetUserSignInFailures", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserLastSeen indicates an expected call of UpdateUserLastSeen.
-func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserLastSeen(ctx, id any) *gomock.Call {
+// ResetUserSignInFailures indicates an expected call of ResetUserSignInFailures.
+func (mr *MockDBClientUpdateUserMockRecorder) ResetUserSignInFailures(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastSeen", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserLastSeen), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUserSignInFailures", reflect.TypeOf((*MockDBClientUpdateUser)(nil).ResetUserSignInFailures), ctx, id)
 }
 
-// UpdateUserTicket mocks base method.
-func (m *MockDBClientUpdateUser) UpdateUserTicket(ctx context.Context, arg sql.UpdateUserTicketParams) (uuid.UUID, error) {
+// UpdateUserActiveMFAType mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserActiveMFAType(ctx context.Context, arg sql.UpdateUserActiveMFATypeParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserTicket", ctx, arg)
-	ret0, _ := ret[0].(uuid.UUID)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateUserActiveMFAType", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// UpdateUserTicket indicates an expected call of UpdateUserTicket.
-func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserTicket(ctx, arg any) *gomock.Call {
+// UpdateUserActiveMFAType indicates an expected call of UpdateUserActiveMFAType.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserActiveMFAType(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTicket", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserTicket), ctx, arg)
-}
-
-// MockDBClient is a mock of DBClient interface.
-type MockDBClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockDBClientMockRecorder
-}
-
-// MockDBClientMockRecorder is the mock recorder for MockDBClient.
-type MockDBClientMockRecorder struct {
-	mock *MockDBClient
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserActiveMFAType", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserActiveMFAType), ctx, arg)
 }
 
-// NewMockDBClient creates a new mock instance.
-func NewMockDBClient(ctrl *gomock.Controller) *MockDBClient {
-	mock := &MockDBClient{ctrl: ctrl}
-	mock.recorder = &MockDBClientMockRecorder{mock}
-	return mock
+// UpdateUserAvatarURL mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserAvatarURL(ctx context.Context, arg sql.UpdateUserAvatarURLParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserAvatarURL", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockDBClient) EXPECT() *MockDBClientMockRecorder {
-	return m.recorder
+// UpdateUserAvatarURL indicates an expected call of UpdateUserAvatarURL.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserAvatarURL(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAvatarURL", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserAvatarURL), ctx, arg)
 }
 
-// CountSecurityKeysUser mocks base method.
-func (m *MockDBClient) CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+// UpdateUserChangeEmail mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserChangeEmail(ctx context.Context, arg sql.UpdateUserChangeEmailParams) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountSecurityKeysUser", ctx, userID)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "UpdateUserChangeEmail", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountSecurityKeysUser indicates an expected call of CountSecurityKeysUser.
-func (mr *MockDBClientMockRecorder) CountSecurityKeysUser(ctx, userID any) *gomock.Call {
+// UpdateUserChangeEmail indicates an expected call of UpdateUserChangeEmail.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserChangeEmail(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSecurityKeysUser", reflect.TypeOf((*MockDBClient)(nil).CountSecurityKeysUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserChangeEmail", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserChangeEmail), ctx, arg)
 }
 
-// DeleteRefreshTokens mocks base method.
-func (m *MockDBClient) DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+// UpdateUserChangePhoneNumber mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserChangePhoneNumber(ctx context.Context, arg sql.UpdateUserChangePhoneNumberParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRefreshTokens", ctx, userID)
+	ret := m.ctrl.Call(m, "UpdateUserChangePhoneNumber", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRefreshTokens indicates an expected call of DeleteRefreshTokens.
-func (mr *MockDBClientMockRecorder) DeleteRefreshTokens(ctx, userID any) *gomock.Call {
+// UpdateUserChangePhoneNumber indicates an expected call of UpdateUserChangePhoneNumber.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserChangePhoneNumber(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokens", reflect.TypeOf((*MockDBClient)(nil).DeleteRefreshTokens), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserChangePhoneNumber", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserChangePhoneNumber), ctx, arg)
 }
 
-// DeleteUserRoles mocks base method.
-func (m *MockDBClient) DeleteUserRoles(ctx context.Context, userID uuid.UUID) error {
+// UpdateUserConfirmChangeEmail mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserRoles", ctx, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateUserConfirmChangeEmail", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUserRoles indicates an expected call of DeleteUserRoles.
-func (mr *MockDBClientMockRecorder) DeleteUserRoles(ctx, userID any) *gomock.Call {
+// UpdateUserConfirmChangeEmail indicates an expected call of UpdateUserConfirmChangeEmail.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserConfirmChangeEmail(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserRoles", reflect.TypeOf((*MockDBClient)(nil).DeleteUserRoles), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConfirmChangeEmail", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserConfirmChangeEmail), ctx, id)
 }
 
-// GetUser mocks base method.
-func (m *MockDBClient) GetUser(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+// UpdateUserConfirmChangePhoneNumber mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserConfirmChangePhoneNumber(ctx context.Context, arg sql.UpdateUserConfirmChangePhoneNumberParams) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret := m.ctrl.Call(m, "UpdateUserConfirmChangePhoneNumber", ctx, arg)
 	ret0, _ := ret[0].(sql.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockDBClientMockRecorder) GetUser(ctx, id any) *gomock.Call {
+// UpdateUserConfirmChangePhoneNumber indicates an expected call of UpdateUserConfirmChangePhoneNumber.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserConfirmChangePhoneNumber(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockDBClient)(nil).GetUser), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConfirmChangePhoneNumber", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserConfirmChangePhoneNumber), ctx, arg)
 }
 
-// GetUserByEmail mocks base method.
-func (m *MockDBClient) GetUserByEmail(ctx context.Context, email pgtype.Text) (sql.AuthUser, error) {
+// UpdateUserConfirmNewPhoneNumber mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserConfirmNewPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret := m.ctrl.Call(m, "UpdateUserConfirmNewPhoneNumber", ctx, id)
 	ret0, _ := ret[0].(sql.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockDBClientMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+// UpdateUserConfirmNewPhoneNumber indicates an expected call of UpdateUserConfirmNewPhoneNumber.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserConfirmNewPhoneNumber(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockDBClient)(nil).GetUserByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConfirmNewPhoneNumber", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserConfirmNewPhoneNumber), ctx, id)
 }
 
-// GetUserByRefreshTokenHash mocks base method.
-func (m *MockDBClient) GetUserByRefreshTokenHash(ctx context.Context, arg sql.GetUserByRefreshTokenHashParams) (sql.AuthUser, error) {
+// UpdateUserConsumeOTP mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserConsumeOTP(ctx context.Context, arg sql.UpdateUserConsumeOTPParams) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByRefreshTokenHash", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateUserConsumeOTP", ctx, arg)
 	ret0, _ := ret[0].(sql.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByRefreshTokenHash indicates an expected call of GetUserByRefreshTokenHash.
-func (mr *MockDBClientMockRecorder) GetUserByRefreshTokenHash(ctx, arg any) *gomock.Call {
+// UpdateUserConsumeOTP indicates an expected call of UpdateUserConsumeOTP.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserConsumeOTP(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByRefreshTokenHash", reflect.TypeOf((*MockDBClient)(nil).GetUserByRefreshTokenHash), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConsumeOTP", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserConsumeOTP), ctx, arg)
 }
 
-// GetUserRoles mocks base method.
-func (m *MockDBClient) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserRole, error) {
+// UpdateUserConsumeTicket mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserConsumeTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserRoles", ctx, userID)
-	ret0, _ := ret[0].([]sql.AuthUserRole)
+	ret := m.ctrl.Call(m, "UpdateUserConsumeTicket", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserRoles indicates an expected call of GetUserRoles.
-func (mr *MockDBClientMockRecorder) GetUserRoles(ctx, userID any) *gomock.Call {
+// UpdateUserConsumeTicket indicates an expected call of UpdateUserConsumeTicket.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserConsumeTicket(ctx, ticket any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockDBClient)(nil).GetUserRoles), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConsumeTicket", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserConsumeTicket), ctx, ticket)
 }
 
-// InsertRefreshtoken mocks base method.
-func (m *MockDBClient) InsertRefreshtoken(ctx context.Context, arg sql.InsertRefreshtokenParams) (uuid.UUID, error) {
+// UpdateUserDeanonymize mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserDeanonymize(ctx context.Context, arg sql.UpdateUserDeanonymizeParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertRefreshtoken", ctx, arg)
-	ret0, _ := ret[0].(uuid.UUID)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateUserDeanonymize", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// InsertRefreshtoken indicates an expected call of InsertRefreshtoken.
-func (mr *MockDBClientMockRecorder) InsertRefreshtoken(ctx, arg any) *gomock.Call {
+// UpdateUserDeanonymize indicates an expected call of UpdateUserDeanonymize.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserDeanonymize(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRefreshtoken", reflect.TypeOf((*MockDBClient)(nil).InsertRefreshtoken), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeanonymize", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserDeanonymize), ctx, arg)
 }
 
-// InsertUser mocks base method.
-func (m *MockDBClient) InsertUser(ctx context.Context, arg sql.InsertUserParams) (sql.InsertUserRow, error) {
+// UpdateUserDeanonymizeWithUserProvider mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserDeanonymizeWithUserProvider(ctx context.Context, arg sql.UpdateUserDeanonymizeWithUserProviderParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertUser", ctx, arg)
-	ret0, _ := ret[0].(sql.InsertUserRow)
+	ret := m.ctrl.Call(m, "UpdateUserDeanonymizeWithUserProvider", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InsertUser indicates an expected call of InsertUser.
-func (mr *MockDBClientMockRecorder) InsertUser(ctx, arg any) *gomock.Call {
+// UpdateUserDeanonymizeWithUserProvider indicates an expected call of UpdateUserDeanonymizeWithUserProvider.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserDeanonymizeWithUserProvider(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUser", reflect.TypeOf((*MockDBClient)(nil).InsertUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeanonymizeWithUserProvider", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserDeanonymizeWithUserProvider), ctx, arg)
 }
 
-// InsertUserWithRefreshToken mocks base method.
-func (m *MockDBClient) InsertUserWithRefreshToken(ctx context.Context, arg sql.InsertUserWithRefreshTokenParams) (sql.InsertUserWithRefreshTokenRow, error) {
+// UpdateUserDisabled mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserDisabled(ctx context.Context, arg sql.UpdateUserDisabledParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertUserWithRefreshToken", ctx, arg)
-	ret0, _ := ret[0].(sql.InsertUserWithRefreshTokenRow)
+	ret := m.ctrl.Call(m, "UpdateUserDisabled", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InsertUserWithRefreshToken indicates an expected call of InsertUserWithRefreshToken.
-func (mr *MockDBClientMockRecorder) InsertUserWithRefreshToken(ctx, arg any) *gomock.Call {
+// UpdateUserDisabled indicates an expected call of UpdateUserDisabled.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserDisabled(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithRefreshToken", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithRefreshToken), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDisabled", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserDisabled), ctx, arg)
 }
 
-// InsertUserWithSecurityKey mocks base method.
-func (m *MockDBClient) InsertUserWithSecurityKey(ctx context.Context, arg sql.InsertUserWithSecurityKeyParams) (uuid.UUID, error) {
+// UpdateUserLastSeen mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertUserWithSecurityKey", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateUserLastSeen", ctx, id)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserLastSeen indicates an expected call of UpdateUserLastSeen.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserLastSeen(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastSeen", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserLastSeen), ctx, id)
+}
+
+// UpdateUserLockedUntil mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserLockedUntil(ctx context.Context, arg sql.UpdateUserLockedUntilParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserLockedUntil", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserLockedUntil indicates an expected call of UpdateUserLockedUntil.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserLockedUntil(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLockedUntil", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserLockedUntil), ctx, arg)
+}
+
+// UpdateUserMetadata mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserMetadata(ctx context.Context, arg sql.UpdateUserMetadataParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserMetadata", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserMetadata indicates an expected call of UpdateUserMetadata.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserMetadata(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserMetadata", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserMetadata), ctx, arg)
+}
+
+// UpdateUserOTPHash mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserOTPHash(ctx context.Context, arg sql.UpdateUserOTPHashParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserOTPHash", ctx, arg)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InsertUserWithSecurityKey indicates an expected call of InsertUserWithSecurityKey.
-func (mr *MockDBClientMockRecorder) InsertUserWithSecurityKey(ctx, arg any) *gomock.Call {
+// UpdateUserOTPHash indicates an expected call of UpdateUserOTPHash.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserOTPHash(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithSecurityKey", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithSecurityKey), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserOTPHash", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserOTPHash), ctx, arg)
 }
 
-// InsertUserWithSecurityKeyAndRefreshToken mocks base method.
-func (m *MockDBClient) InsertUserWithSecurityKeyAndRefreshToken(ctx context.Context, arg sql.InsertUserWithSecurityKeyAndRefreshTokenParams) (sql.InsertUserWithSecurityKeyAndRefreshTokenRow, error) {
+// UpdateUserOTPHashFailedAttempts mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserOTPHashFailedAttempts(ctx context.Context, arg sql.UpdateUserOTPHashFailedAttemptsParams) (int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertUserWithSecurityKeyAndRefreshToken", ctx, arg)
-	ret0, _ := ret[0].(sql.InsertUserWithSecurityKeyAndRefreshTokenRow)
+	ret := m.ctrl.Call(m, "UpdateUserOTPHashFailedAttempts", ctx, arg)
+	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InsertUserWithSecurityKeyAndRefreshToken indicates an expected call of InsertUserWithSecurityKeyAndRefreshToken.
-func (mr *MockDBClientMockRecorder) InsertUserWithSecurityKeyAndRefreshToken(ctx, arg any) *gomock.Call {
+// UpdateUserOTPHashFailedAttempts indicates an expected call of UpdateUserOTPHashFailedAttempts.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserOTPHashFailedAttempts(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithSecurityKeyAndRefreshToken", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithSecurityKeyAndRefreshToken), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserOTPHashFailedAttempts", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserOTPHashFailedAttempts), ctx, arg)
 }
 
-// RefreshTokenAndGetUserRoles mocks base method.
-func (m *MockDBClient) RefreshTokenAndGetUserRoles(ctx context.Context, arg sql.RefreshTokenAndGetUserRolesParams) ([]sql.RefreshTokenAndGetUserRolesRow, error) {
+// UpdateUserPasswordHash mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserPasswordHash(ctx context.Context, arg sql.UpdateUserPasswordHashParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokenAndGetUserRoles", ctx, arg)
-	ret0, _ := ret[0].([]sql.RefreshTokenAndGetUserRolesRow)
+	ret := m.ctrl.Call(m, "UpdateUserPasswordHash", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshTokenAndGetUserRoles indicates an expected call of RefreshTokenAndGetUserRoles.
-func (mr *MockDBClientMockRecorder) RefreshTokenAndGetUserRoles(ctx, arg any) *gomock.Call {
+// UpdateUserPasswordHash indicates an expected call of UpdateUserPasswordHash.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserPasswordHash(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenAndGetUserRoles", reflect.TypeOf((*MockDBClient)(nil).RefreshTokenAndGetUserRoles), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordHash", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserPasswordHash), ctx, arg)
 }
 
-// UpdateUserChangeEmail mocks base method.
-func (m *MockDBClient) UpdateUserChangeEmail(ctx context.Context, arg sql.UpdateUserChangeEmailParams) (sql.AuthUser, error) {
+// UpdateUserRehashPassword mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserRehashPassword(ctx context.Context, arg sql.UpdateUserRehashPasswordParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserChangeEmail", ctx, arg)
-	ret0, _ := ret[0].(sql.AuthUser)
+	ret := m.ctrl.Call(m, "UpdateUserRehashPassword", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserChangeEmail indicates an expected call of UpdateUserChangeEmail.
-func (mr *MockDBClientMockRecorder) UpdateUserChangeEmail(ctx, arg any) *gomock.Call {
+// UpdateUserRehashPassword indicates an expected call of UpdateUserRehashPassword.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserRehashPassword(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserChangeEmail", reflect.TypeOf((*MockDBClient)(nil).UpdateUserChangeEmail), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRehashPassword", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserRehashPassword), ctx, arg)
 }
 
-// UpdateUserDeanonymize mocks base method.
-func (m *MockDBClient) UpdateUserDeanonymize(ctx context.Context, arg sql.UpdateUserDeanonymizeParams) error {
+// UpdateUserTicket mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserTicket(ctx context.Context, arg sql.UpdateUserTicketParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserDeanonymize", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateUserTicket", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTicket indicates an expected call of UpdateUserTicket.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserTicket(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTicket", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserTicket), ctx, arg)
+}
+
+// UpdateUserTicketFailedAttempts mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserTicketFailedAttempts(ctx context.Context, arg sql.UpdateUserTicketFailedAttemptsParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTicketFailedAttempts", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTicketFailedAttempts indicates an expected call of UpdateUserTicketFailedAttempts.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserTicketFailedAttempts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTicketFailedAttempts", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserTicketFailedAttempts), ctx, arg)
+}
+
+// UpdateUserTotpSecret mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserTotpSecret(ctx context.Context, arg sql.UpdateUserTotpSecretParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTotpSecret", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserDeanonymize indicates an expected call of UpdateUserDeanonymize.
-func (mr *MockDBClientMockRecorder) UpdateUserDeanonymize(ctx, arg any) *gomock.Call {
+// UpdateUserTotpSecret indicates an expected call of UpdateUserTotpSecret.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserTotpSecret(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeanonymize", reflect.TypeOf((*MockDBClient)(nil).UpdateUserDeanonymize), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTotpSecret", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserTotpSecret), ctx, arg)
 }
 
-// UpdateUserLastSeen mocks base method.
-func (m *MockDBClient) UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error) {
+// UpdateUserVerifyEmail mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserLastSeen", ctx, id)
-	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret := m.ctrl.Call(m, "UpdateUserVerifyEmail", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserLastSeen indicates an expected call of UpdateUserLastSeen.
-func (mr *MockDBClientMockRecorder) UpdateUserLastSeen(ctx, id any) *gomock.Call {
+// UpdateUserVerifyEmail indicates an expected call of UpdateUserVerifyEmail.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserVerifyEmail(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastSeen", reflect.TypeOf((*MockDBClient)(nil).UpdateUserLastSeen), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyEmail", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserVerifyEmail), ctx, id)
 }
 
-// UpdateUserTicket mocks base method.
-func (m *MockDBClient) UpdateUserTicket(ctx context.Context, arg sql.UpdateUserTicketParams) (uuid.UUID, error) {
+// UpdateUserVerifyPhoneNumber mocks base method.
+func (m *MockDBClientUpdateUser) UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserTicket", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateUserVerifyPhoneNumber", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserVerifyPhoneNumber indicates an expected call of UpdateUserVerifyPhoneNumber.
+func (mr *MockDBClientUpdateUserMockRecorder) UpdateUserVerifyPhoneNumber(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyPhoneNumber", reflect.TypeOf((*MockDBClientUpdateUser)(nil).UpdateUserVerifyPhoneNumber), ctx, id)
+}
+
+// MockDBClient is a mock of DBClient interface.
+type MockDBClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDBClientMockRecorder
+}
+
+// MockDBClientMockRecorder is the mock recorder for MockDBClient.
+type MockDBClientMockRecorder struct {
+	mock *MockDBClient
+}
+
+// NewMockDBClient creates a new mock instance.
+func NewMockDBClient(ctrl *gomock.Controller) *MockDBClient {
+	mock := &MockDBClient{ctrl: ctrl}
+	mock.recorder = &MockDBClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDBClient) EXPECT() *MockDBClientMockRecorder {
+	return m.recorder
+}
+
+// AcceptOrganizationInvite mocks base method.
+func (m *MockDBClient) AcceptOrganizationInvite(ctx context.Context, arg sql.AcceptOrganizationInviteParams) (sql.AcceptOrganizationInviteRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrganizationInvite", ctx, arg)
+	ret0, _ := ret[0].(sql.AcceptOrganizationInviteRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrganizationInvite indicates an expected call of AcceptOrganizationInvite.
+func (mr *MockDBClientMockRecorder) AcceptOrganizationInvite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrganizationInvite", reflect.TypeOf((*MockDBClient)(nil).AcceptOrganizationInvite), ctx, arg)
+}
+
+// ApproveDeviceCode mocks base method.
+func (m *MockDBClient) ApproveDeviceCode(ctx context.Context, arg sql.ApproveDeviceCodeParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveDeviceCode", ctx, arg)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUserTicket indicates an expected call of UpdateUserTicket.
-func (mr *MockDBClientMockRecorder) UpdateUserTicket(ctx, arg any) *gomock.Call {
+// ApproveDeviceCode indicates an expected call of ApproveDeviceCode.
+func (mr *MockDBClientMockRecorder) ApproveDeviceCode(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTicket", reflect.TypeOf((*MockDBClient)(nil).UpdateUserTicket), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveDeviceCode", reflect.TypeOf((*MockDBClient)(nil).ApproveDeviceCode), ctx, arg)
+}
+
+// BanUser mocks base method.
+func (m *MockDBClient) BanUser(ctx context.Context, arg sql.BanUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockDBClientMockRecorder) BanUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockDBClient)(nil).BanUser), ctx, arg)
+}
+
+// CancelUserDeletion mocks base method.
+func (m *MockDBClient) CancelUserDeletion(ctx context.Context, ticket string) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserDeletion", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUserDeletion indicates an expected call of CancelUserDeletion.
+func (mr *MockDBClientMockRecorder) CancelUserDeletion(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserDeletion", reflect.TypeOf((*MockDBClient)(nil).CancelUserDeletion), ctx, ticket)
+}
+
+// ClaimDataExports mocks base method.
+func (m *MockDBClient) ClaimDataExports(ctx context.Context, arg sql.ClaimDataExportsParams) ([]sql.AuthDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDataExports", ctx, arg)
+	ret0, _ := ret[0].([]sql.AuthDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDataExports indicates an expected call of ClaimDataExports.
+func (mr *MockDBClientMockRecorder) ClaimDataExports(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDataExports", reflect.TypeOf((*MockDBClient)(nil).ClaimDataExports), ctx, arg)
+}
+
+// CompleteDataExport mocks base method.
+func (m *MockDBClient) CompleteDataExport(ctx context.Context, arg sql.CompleteDataExportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataExport", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockDBClientMockRecorder) CompleteDataExport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*MockDBClient)(nil).CompleteDataExport), ctx, arg)
+}
+
+// ConsumeEmailChangeRevert mocks base method.
+func (m *MockDBClient) ConsumeEmailChangeRevert(ctx context.Context, ticket string) (sql.AuthEmailChangeRevert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeEmailChangeRevert", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthEmailChangeRevert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeEmailChangeRevert indicates an expected call of ConsumeEmailChangeRevert.
+func (mr *MockDBClientMockRecorder) ConsumeEmailChangeRevert(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeEmailChangeRevert", reflect.TypeOf((*MockDBClient)(nil).ConsumeEmailChangeRevert), ctx, ticket)
+}
+
+// ConsumeNewDeviceSignIn mocks base method.
+func (m *MockDBClient) ConsumeNewDeviceSignIn(ctx context.Context, ticket string) (sql.AuthNewDeviceSignIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeNewDeviceSignIn", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthNewDeviceSignIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeNewDeviceSignIn indicates an expected call of ConsumeNewDeviceSignIn.
+func (mr *MockDBClientMockRecorder) ConsumeNewDeviceSignIn(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeNewDeviceSignIn", reflect.TypeOf((*MockDBClient)(nil).ConsumeNewDeviceSignIn), ctx, ticket)
+}
+
+// CountAuditLogs mocks base method.
+func (m *MockDBClient) CountAuditLogs(ctx context.Context, arg sql.CountAuditLogsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAuditLogs", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAuditLogs indicates an expected call of CountAuditLogs.
+func (mr *MockDBClientMockRecorder) CountAuditLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAuditLogs", reflect.TypeOf((*MockDBClient)(nil).CountAuditLogs), ctx, arg)
+}
+
+// CountEmailOutbox mocks base method.
+func (m *MockDBClient) CountEmailOutbox(ctx context.Context) ([]sql.CountEmailOutboxRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEmailOutbox", ctx)
+	ret0, _ := ret[0].([]sql.CountEmailOutboxRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEmailOutbox indicates an expected call of CountEmailOutbox.
+func (mr *MockDBClientMockRecorder) CountEmailOutbox(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEmailOutbox", reflect.TypeOf((*MockDBClient)(nil).CountEmailOutbox), ctx)
+}
+
+// CountRecoveryCodes mocks base method.
+func (m *MockDBClient) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRecoveryCodes", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRecoveryCodes indicates an expected call of CountRecoveryCodes.
+func (mr *MockDBClientMockRecorder) CountRecoveryCodes(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRecoveryCodes", reflect.TypeOf((*MockDBClient)(nil).CountRecoveryCodes), ctx, userID)
+}
+
+// CountScimGroups mocks base method.
+func (m *MockDBClient) CountScimGroups(ctx context.Context, role pgtype.Text) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountScimGroups", ctx, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountScimGroups indicates an expected call of CountScimGroups.
+func (mr *MockDBClientMockRecorder) CountScimGroups(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountScimGroups", reflect.TypeOf((*MockDBClient)(nil).CountScimGroups), ctx, role)
+}
+
+// CountScimUsers mocks base method.
+func (m *MockDBClient) CountScimUsers(ctx context.Context, arg sql.CountScimUsersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountScimUsers", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountScimUsers indicates an expected call of CountScimUsers.
+func (mr *MockDBClientMockRecorder) CountScimUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountScimUsers", reflect.TypeOf((*MockDBClient)(nil).CountScimUsers), ctx, arg)
+}
+
+// CountSecurityKeysUser mocks base method.
+func (m *MockDBClient) CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSecurityKeysUser", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSecurityKeysUser indicates an expected call of CountSecurityKeysUser.
+func (mr *MockDBClientMockRecorder) CountSecurityKeysUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSecurityKeysUser", reflect.TypeOf((*MockDBClient)(nil).CountSecurityKeysUser), ctx, userID)
+}
+
+// DeleteDeviceCode mocks base method.
+func (m *MockDBClient) DeleteDeviceCode(ctx context.Context, id uuid.UUID) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceCode", ctx, id)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeviceCode indicates an expected call of DeleteDeviceCode.
+func (mr *MockDBClientMockRecorder) DeleteDeviceCode(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceCode", reflect.TypeOf((*MockDBClient)(nil).DeleteDeviceCode), ctx, id)
+}
+
+// DeleteExpiredDataExports mocks base method.
+func (m *MockDBClient) DeleteExpiredDataExports(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredDataExports", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredDataExports indicates an expected call of DeleteExpiredDataExports.
+func (mr *MockDBClientMockRecorder) DeleteExpiredDataExports(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredDataExports", reflect.TypeOf((*MockDBClient)(nil).DeleteExpiredDataExports), ctx)
+}
+
+// DeleteExpiredDeviceCodes mocks base method.
+func (m *MockDBClient) DeleteExpiredDeviceCodes(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredDeviceCodes", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredDeviceCodes indicates an expected call of DeleteExpiredDeviceCodes.
+func (mr *MockDBClientMockRecorder) DeleteExpiredDeviceCodes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredDeviceCodes", reflect.TypeOf((*MockDBClient)(nil).DeleteExpiredDeviceCodes), ctx)
+}
+
+// DeleteOAuth2AuthorizationCode mocks base method.
+func (m *MockDBClient) DeleteOAuth2AuthorizationCode(ctx context.Context, arg sql.DeleteOAuth2AuthorizationCodeParams) (sql.AuthOauth2AuthorizationCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOAuth2AuthorizationCode", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthOauth2AuthorizationCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOAuth2AuthorizationCode indicates an expected call of DeleteOAuth2AuthorizationCode.
+func (mr *MockDBClientMockRecorder) DeleteOAuth2AuthorizationCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuth2AuthorizationCode", reflect.TypeOf((*MockDBClient)(nil).DeleteOAuth2AuthorizationCode), ctx, arg)
+}
+
+// DeleteOAuth2Client mocks base method.
+func (m *MockDBClient) DeleteOAuth2Client(ctx context.Context, clientID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOAuth2Client", ctx, clientID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOAuth2Client indicates an expected call of DeleteOAuth2Client.
+func (mr *MockDBClientMockRecorder) DeleteOAuth2Client(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOAuth2Client", reflect.TypeOf((*MockDBClient)(nil).DeleteOAuth2Client), ctx, clientID)
+}
+
+// DeleteProviderRequest mocks base method.
+func (m *MockDBClient) DeleteProviderRequest(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProviderRequest", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProviderRequest indicates an expected call of DeleteProviderRequest.
+func (mr *MockDBClientMockRecorder) DeleteProviderRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProviderRequest", reflect.TypeOf((*MockDBClient)(nil).DeleteProviderRequest), ctx, id)
+}
+
+// DeleteRecoveryCode mocks base method.
+func (m *MockDBClient) DeleteRecoveryCode(ctx context.Context, arg sql.DeleteRecoveryCodeParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryCode", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecoveryCode indicates an expected call of DeleteRecoveryCode.
+func (mr *MockDBClientMockRecorder) DeleteRecoveryCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryCode", reflect.TypeOf((*MockDBClient)(nil).DeleteRecoveryCode), ctx, arg)
+}
+
+// DeleteRefreshTokenFamilyByRotatedHash mocks base method.
+func (m *MockDBClient) DeleteRefreshTokenFamilyByRotatedHash(ctx context.Context, refreshTokenHash string) ([]sql.DeleteRefreshTokenFamilyByRotatedHashRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRefreshTokenFamilyByRotatedHash", ctx, refreshTokenHash)
+	ret0, _ := ret[0].([]sql.DeleteRefreshTokenFamilyByRotatedHashRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRefreshTokenFamilyByRotatedHash indicates an expected call of DeleteRefreshTokenFamilyByRotatedHash.
+func (mr *MockDBClientMockRecorder) DeleteRefreshTokenFamilyByRotatedHash(ctx, refreshTokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokenFamilyByRotatedHash", reflect.TypeOf((*MockDBClient)(nil).DeleteRefreshTokenFamilyByRotatedHash), ctx, refreshTokenHash)
+}
+
+// DeleteRefreshTokens mocks base method.
+func (m *MockDBClient) DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRefreshTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRefreshTokens indicates an expected call of DeleteRefreshTokens.
+func (mr *MockDBClientMockRecorder) DeleteRefreshTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRefreshTokens", reflect.TypeOf((*MockDBClient)(nil).DeleteRefreshTokens), ctx, userID)
+}
+
+// DeleteRole mocks base method.
+func (m *MockDBClient) DeleteRole(ctx context.Context, role string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockDBClientMockRecorder) DeleteRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockDBClient)(nil).DeleteRole), ctx, role)
+}
+
+// DeleteUser mocks base method.
+func (m *MockDBClient) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockDBClientMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockDBClient)(nil).DeleteUser), ctx, id)
+}
+
+// DeleteUserEmailChangeReverts mocks base method.
+func (m *MockDBClient) DeleteUserEmailChangeReverts(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserEmailChangeReverts", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserEmailChangeReverts indicates an expected call of DeleteUserEmailChangeReverts.
+func (mr *MockDBClientMockRecorder) DeleteUserEmailChangeReverts(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserEmailChangeReverts", reflect.TypeOf((*MockDBClient)(nil).DeleteUserEmailChangeReverts), ctx, userID)
+}
+
+// DeleteUserNewDeviceSignIns mocks base method.
+func (m *MockDBClient) DeleteUserNewDeviceSignIns(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserNewDeviceSignIns", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserNewDeviceSignIns indicates an expected call of DeleteUserNewDeviceSignIns.
+func (mr *MockDBClientMockRecorder) DeleteUserNewDeviceSignIns(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserNewDeviceSignIns", reflect.TypeOf((*MockDBClient)(nil).DeleteUserNewDeviceSignIns), ctx, userID)
+}
+
+// DeleteUserPersonalAccessToken mocks base method.
+func (m *MockDBClient) DeleteUserPersonalAccessToken(ctx context.Context, arg sql.DeleteUserPersonalAccessTokenParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserPersonalAccessToken", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserPersonalAccessToken indicates an expected call of DeleteUserPersonalAccessToken.
+func (mr *MockDBClientMockRecorder) DeleteUserPersonalAccessToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPersonalAccessToken", reflect.TypeOf((*MockDBClient)(nil).DeleteUserPersonalAccessToken), ctx, arg)
+}
+
+// DeleteUserProvider mocks base method.
+func (m *MockDBClient) DeleteUserProvider(ctx context.Context, arg sql.DeleteUserProviderParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserProvider", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserProvider indicates an expected call of DeleteUserProvider.
+func (mr *MockDBClientMockRecorder) DeleteUserProvider(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserProvider", reflect.TypeOf((*MockDBClient)(nil).DeleteUserProvider), ctx, arg)
+}
+
+// DeleteUserRole mocks base method.
+func (m *MockDBClient) DeleteUserRole(ctx context.Context, arg sql.DeleteUserRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserRole", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserRole indicates an expected call of DeleteUserRole.
+func (mr *MockDBClientMockRecorder) DeleteUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserRole", reflect.TypeOf((*MockDBClient)(nil).DeleteUserRole), ctx, arg)
+}
+
+// DeleteUserRoles mocks base method.
+func (m *MockDBClient) DeleteUserRoles(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserRoles", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserRoles indicates an expected call of DeleteUserRoles.
+func (mr *MockDBClientMockRecorder) DeleteUserRoles(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserRoles", reflect.TypeOf((*MockDBClient)(nil).DeleteUserRoles), ctx, userID)
+}
+
+// DeleteUserSession mocks base method.
+func (m *MockDBClient) DeleteUserSession(ctx context.Context, arg sql.DeleteUserSessionParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSession", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserSession indicates an expected call of DeleteUserSession.
+func (mr *MockDBClientMockRecorder) DeleteUserSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*MockDBClient)(nil).DeleteUserSession), ctx, arg)
+}
+
+// DenyDeviceCode mocks base method.
+func (m *MockDBClient) DenyDeviceCode(ctx context.Context, userCode string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyDeviceCode", ctx, userCode)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenyDeviceCode indicates an expected call of DenyDeviceCode.
+func (mr *MockDBClientMockRecorder) DenyDeviceCode(ctx, userCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyDeviceCode", reflect.TypeOf((*MockDBClient)(nil).DenyDeviceCode), ctx, userCode)
+}
+
+// FailDataExportAttempt mocks base method.
+func (m *MockDBClient) FailDataExportAttempt(ctx context.Context, arg sql.FailDataExportAttemptParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailDataExportAttempt", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailDataExportAttempt indicates an expected call of FailDataExportAttempt.
+func (mr *MockDBClientMockRecorder) FailDataExportAttempt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailDataExportAttempt", reflect.TypeOf((*MockDBClient)(nil).FailDataExportAttempt), ctx, arg)
+}
+
+// GetAuditLogs mocks base method.
+func (m *MockDBClient) GetAuditLogs(ctx context.Context, arg sql.GetAuditLogsParams) ([]sql.AuthAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogs", ctx, arg)
+	ret0, _ := ret[0].([]sql.AuthAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogs indicates an expected call of GetAuditLogs.
+func (mr *MockDBClientMockRecorder) GetAuditLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogs", reflect.TypeOf((*MockDBClient)(nil).GetAuditLogs), ctx, arg)
+}
+
+// GetDataExport mocks base method.
+func (m *MockDBClient) GetDataExport(ctx context.Context, id uuid.UUID) (sql.AuthDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataExport", ctx, id)
+	ret0, _ := ret[0].(sql.AuthDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockDBClientMockRecorder) GetDataExport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*MockDBClient)(nil).GetDataExport), ctx, id)
+}
+
+// GetDeviceCode mocks base method.
+func (m *MockDBClient) GetDeviceCode(ctx context.Context, deviceCodeHash string) (sql.AuthDeviceCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceCode", ctx, deviceCodeHash)
+	ret0, _ := ret[0].(sql.AuthDeviceCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceCode indicates an expected call of GetDeviceCode.
+func (mr *MockDBClientMockRecorder) GetDeviceCode(ctx, deviceCodeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceCode", reflect.TypeOf((*MockDBClient)(nil).GetDeviceCode), ctx, deviceCodeHash)
+}
+
+// GetFailedEmailOutbox mocks base method.
+func (m *MockDBClient) GetFailedEmailOutbox(ctx context.Context, limit int32) ([]sql.AuthEmailOutbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedEmailOutbox", ctx, limit)
+	ret0, _ := ret[0].([]sql.AuthEmailOutbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedEmailOutbox indicates an expected call of GetFailedEmailOutbox.
+func (mr *MockDBClientMockRecorder) GetFailedEmailOutbox(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedEmailOutbox", reflect.TypeOf((*MockDBClient)(nil).GetFailedEmailOutbox), ctx, limit)
+}
+
+// GetIPLockedUntil mocks base method.
+func (m *MockDBClient) GetIPLockedUntil(ctx context.Context, ip string) (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPLockedUntil", ctx, ip)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPLockedUntil indicates an expected call of GetIPLockedUntil.
+func (mr *MockDBClientMockRecorder) GetIPLockedUntil(ctx, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPLockedUntil", reflect.TypeOf((*MockDBClient)(nil).GetIPLockedUntil), ctx, ip)
+}
+
+// GetIPSignInFailures mocks base method.
+func (m *MockDBClient) GetIPSignInFailures(ctx context.Context, arg sql.GetIPSignInFailuresParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPSignInFailures", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPSignInFailures indicates an expected call of GetIPSignInFailures.
+func (mr *MockDBClientMockRecorder) GetIPSignInFailures(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSignInFailures", reflect.TypeOf((*MockDBClient)(nil).GetIPSignInFailures), ctx, arg)
+}
+
+// GetOAuth2Client mocks base method.
+func (m *MockDBClient) GetOAuth2Client(ctx context.Context, clientID string) (sql.AuthOauth2Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuth2Client", ctx, clientID)
+	ret0, _ := ret[0].(sql.AuthOauth2Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuth2Client indicates an expected call of GetOAuth2Client.
+func (mr *MockDBClientMockRecorder) GetOAuth2Client(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2Client", reflect.TypeOf((*MockDBClient)(nil).GetOAuth2Client), ctx, clientID)
+}
+
+// GetOAuth2ConsentScopes mocks base method.
+func (m *MockDBClient) GetOAuth2ConsentScopes(ctx context.Context, arg sql.GetOAuth2ConsentScopesParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOAuth2ConsentScopes", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOAuth2ConsentScopes indicates an expected call of GetOAuth2ConsentScopes.
+func (mr *MockDBClientMockRecorder) GetOAuth2ConsentScopes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOAuth2ConsentScopes", reflect.TypeOf((*MockDBClient)(nil).GetOAuth2ConsentScopes), ctx, arg)
+}
+
+// GetOrganizationMember mocks base method.
+func (m *MockDBClient) GetOrganizationMember(ctx context.Context, arg sql.GetOrganizationMemberParams) (sql.GetOrganizationMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMember", ctx, arg)
+	ret0, _ := ret[0].(sql.GetOrganizationMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMember indicates an expected call of GetOrganizationMember.
+func (mr *MockDBClientMockRecorder) GetOrganizationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMember", reflect.TypeOf((*MockDBClient)(nil).GetOrganizationMember), ctx, arg)
+}
+
+// GetPendingUserDataExport mocks base method.
+func (m *MockDBClient) GetPendingUserDataExport(ctx context.Context, userID uuid.UUID) (sql.AuthDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingUserDataExport", ctx, userID)
+	ret0, _ := ret[0].(sql.AuthDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingUserDataExport indicates an expected call of GetPendingUserDataExport.
+func (mr *MockDBClientMockRecorder) GetPendingUserDataExport(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingUserDataExport", reflect.TypeOf((*MockDBClient)(nil).GetPendingUserDataExport), ctx, userID)
+}
+
+// GetPersonalAccessTokenByHash mocks base method.
+func (m *MockDBClient) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (sql.AuthPersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalAccessTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(sql.AuthPersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalAccessTokenByHash indicates an expected call of GetPersonalAccessTokenByHash.
+func (mr *MockDBClientMockRecorder) GetPersonalAccessTokenByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalAccessTokenByHash", reflect.TypeOf((*MockDBClient)(nil).GetPersonalAccessTokenByHash), ctx, tokenHash)
+}
+
+// GetRefreshTokenByHash mocks base method.
+func (m *MockDBClient) GetRefreshTokenByHash(ctx context.Context, refreshTokenHash string) (sql.AuthRefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenByHash", ctx, refreshTokenHash)
+	ret0, _ := ret[0].(sql.AuthRefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenByHash indicates an expected call of GetRefreshTokenByHash.
+func (mr *MockDBClientMockRecorder) GetRefreshTokenByHash(ctx, refreshTokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenByHash", reflect.TypeOf((*MockDBClient)(nil).GetRefreshTokenByHash), ctx, refreshTokenHash)
+}
+
+// GetRoleUsers mocks base method.
+func (m *MockDBClient) GetRoleUsers(ctx context.Context, role string) ([]sql.GetRoleUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleUsers", ctx, role)
+	ret0, _ := ret[0].([]sql.GetRoleUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleUsers indicates an expected call of GetRoleUsers.
+func (mr *MockDBClientMockRecorder) GetRoleUsers(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleUsers", reflect.TypeOf((*MockDBClient)(nil).GetRoleUsers), ctx, role)
+}
+
+// GetRoles mocks base method.
+func (m *MockDBClient) GetRoles(ctx context.Context) ([]sql.GetRolesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]sql.GetRolesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockDBClientMockRecorder) GetRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockDBClient)(nil).GetRoles), ctx)
+}
+
+// GetScimGroups mocks base method.
+func (m *MockDBClient) GetScimGroups(ctx context.Context, arg sql.GetScimGroupsParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScimGroups", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScimGroups indicates an expected call of GetScimGroups.
+func (mr *MockDBClientMockRecorder) GetScimGroups(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScimGroups", reflect.TypeOf((*MockDBClient)(nil).GetScimGroups), ctx, arg)
+}
+
+// GetScimUsers mocks base method.
+func (m *MockDBClient) GetScimUsers(ctx context.Context, arg sql.GetScimUsersParams) ([]sql.GetScimUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScimUsers", ctx, arg)
+	ret0, _ := ret[0].([]sql.GetScimUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScimUsers indicates an expected call of GetScimUsers.
+func (mr *MockDBClientMockRecorder) GetScimUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScimUsers", reflect.TypeOf((*MockDBClient)(nil).GetScimUsers), ctx, arg)
+}
+
+// GetSecurityKeys mocks base method.
+func (m *MockDBClient) GetSecurityKeys(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserSecurityKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityKeys", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthUserSecurityKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityKeys indicates an expected call of GetSecurityKeys.
+func (mr *MockDBClientMockRecorder) GetSecurityKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityKeys", reflect.TypeOf((*MockDBClient)(nil).GetSecurityKeys), ctx, userID)
+}
+
+// GetSignInMethods mocks base method.
+func (m *MockDBClient) GetSignInMethods(ctx context.Context) ([]sql.AuthSignInMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignInMethods", ctx)
+	ret0, _ := ret[0].([]sql.AuthSignInMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignInMethods indicates an expected call of GetSignInMethods.
+func (mr *MockDBClientMockRecorder) GetSignInMethods(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignInMethods", reflect.TypeOf((*MockDBClient)(nil).GetSignInMethods), ctx)
+}
+
+// GetUser mocks base method.
+func (m *MockDBClient) GetUser(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockDBClientMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockDBClient)(nil).GetUser), ctx, id)
+}
+
+// GetUserActiveOrganizationMember mocks base method.
+func (m *MockDBClient) GetUserActiveOrganizationMember(ctx context.Context, id uuid.UUID) (sql.GetUserActiveOrganizationMemberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserActiveOrganizationMember", ctx, id)
+	ret0, _ := ret[0].(sql.GetUserActiveOrganizationMemberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserActiveOrganizationMember indicates an expected call of GetUserActiveOrganizationMember.
+func (mr *MockDBClientMockRecorder) GetUserActiveOrganizationMember(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserActiveOrganizationMember", reflect.TypeOf((*MockDBClient)(nil).GetUserActiveOrganizationMember), ctx, id)
+}
+
+// GetUserAuditLogs mocks base method.
+func (m *MockDBClient) GetUserAuditLogs(ctx context.Context, userID pgtype.UUID) ([]sql.AuthAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAuditLogs", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAuditLogs indicates an expected call of GetUserAuditLogs.
+func (mr *MockDBClientMockRecorder) GetUserAuditLogs(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAuditLogs", reflect.TypeOf((*MockDBClient)(nil).GetUserAuditLogs), ctx, userID)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockDBClient) GetUserByEmail(ctx context.Context, email pgtype.Text) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockDBClientMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockDBClient)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByPersonalAccessTokenHash mocks base method.
+func (m *MockDBClient) GetUserByPersonalAccessTokenHash(ctx context.Context, tokenHash string) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPersonalAccessTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPersonalAccessTokenHash indicates an expected call of GetUserByPersonalAccessTokenHash.
+func (mr *MockDBClientMockRecorder) GetUserByPersonalAccessTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPersonalAccessTokenHash", reflect.TypeOf((*MockDBClient)(nil).GetUserByPersonalAccessTokenHash), ctx, tokenHash)
+}
+
+// GetUserByPhoneNumber mocks base method.
+func (m *MockDBClient) GetUserByPhoneNumber(ctx context.Context, phoneNumber pgtype.Text) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhoneNumber", ctx, phoneNumber)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhoneNumber indicates an expected call of GetUserByPhoneNumber.
+func (mr *MockDBClientMockRecorder) GetUserByPhoneNumber(ctx, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhoneNumber", reflect.TypeOf((*MockDBClient)(nil).GetUserByPhoneNumber), ctx, phoneNumber)
+}
+
+// GetUserByProviderID mocks base method.
+func (m *MockDBClient) GetUserByProviderID(ctx context.Context, arg sql.GetUserByProviderIDParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByProviderID", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByProviderID indicates an expected call of GetUserByProviderID.
+func (mr *MockDBClientMockRecorder) GetUserByProviderID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByProviderID", reflect.TypeOf((*MockDBClient)(nil).GetUserByProviderID), ctx, arg)
+}
+
+// GetUserByRefreshTokenHash mocks base method.
+func (m *MockDBClient) GetUserByRefreshTokenHash(ctx context.Context, arg sql.GetUserByRefreshTokenHashParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByRefreshTokenHash", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByRefreshTokenHash indicates an expected call of GetUserByRefreshTokenHash.
+func (mr *MockDBClientMockRecorder) GetUserByRefreshTokenHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByRefreshTokenHash", reflect.TypeOf((*MockDBClient)(nil).GetUserByRefreshTokenHash), ctx, arg)
+}
+
+// GetUserByTicket mocks base method.
+func (m *MockDBClient) GetUserByTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByTicket", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByTicket indicates an expected call of GetUserByTicket.
+func (mr *MockDBClientMockRecorder) GetUserByTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByTicket", reflect.TypeOf((*MockDBClient)(nil).GetUserByTicket), ctx, ticket)
+}
+
+// GetUserPersonalAccessTokens mocks base method.
+func (m *MockDBClient) GetUserPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]sql.AuthPersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPersonalAccessTokens", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthPersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPersonalAccessTokens indicates an expected call of GetUserPersonalAccessTokens.
+func (mr *MockDBClientMockRecorder) GetUserPersonalAccessTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPersonalAccessTokens", reflect.TypeOf((*MockDBClient)(nil).GetUserPersonalAccessTokens), ctx, userID)
+}
+
+// GetUserProviders mocks base method.
+func (m *MockDBClient) GetUserProviders(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProviders", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthUserProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProviders indicates an expected call of GetUserProviders.
+func (mr *MockDBClientMockRecorder) GetUserProviders(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProviders", reflect.TypeOf((*MockDBClient)(nil).GetUserProviders), ctx, userID)
+}
+
+// GetUserRoles mocks base method.
+func (m *MockDBClient) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]sql.AuthUserRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRoles", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthUserRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockDBClientMockRecorder) GetUserRoles(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockDBClient)(nil).GetUserRoles), ctx, userID)
+}
+
+// GetUserSessions mocks base method.
+func (m *MockDBClient) GetUserSessions(ctx context.Context, userID uuid.UUID) ([]sql.AuthRefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, userID)
+	ret0, _ := ret[0].([]sql.AuthRefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockDBClientMockRecorder) GetUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockDBClient)(nil).GetUserSessions), ctx, userID)
+}
+
+// GetUsers mocks base method.
+func (m *MockDBClient) GetUsers(ctx context.Context, arg sql.GetUsersParams) ([]sql.GetUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, arg)
+	ret0, _ := ret[0].([]sql.GetUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockDBClientMockRecorder) GetUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockDBClient)(nil).GetUsers), ctx, arg)
+}
+
+// ImportUsers mocks base method.
+func (m *MockDBClient) ImportUsers(ctx context.Context, arg sql.ImportUsersParams) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUsers", ctx, arg)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUsers indicates an expected call of ImportUsers.
+func (mr *MockDBClientMockRecorder) ImportUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUsers", reflect.TypeOf((*MockDBClient)(nil).ImportUsers), ctx, arg)
+}
+
+// InsertAuditLog mocks base method.
+func (m *MockDBClient) InsertAuditLog(ctx context.Context, arg sql.InsertAuditLogParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAuditLog", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAuditLog indicates an expected call of InsertAuditLog.
+func (mr *MockDBClientMockRecorder) InsertAuditLog(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditLog", reflect.TypeOf((*MockDBClient)(nil).InsertAuditLog), ctx, arg)
+}
+
+// InsertDataExport mocks base method.
+func (m *MockDBClient) InsertDataExport(ctx context.Context, arg sql.InsertDataExportParams) (sql.AuthDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDataExport", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertDataExport indicates an expected call of InsertDataExport.
+func (mr *MockDBClientMockRecorder) InsertDataExport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDataExport", reflect.TypeOf((*MockDBClient)(nil).InsertDataExport), ctx, arg)
+}
+
+// InsertDeviceCode mocks base method.
+func (m *MockDBClient) InsertDeviceCode(ctx context.Context, arg sql.InsertDeviceCodeParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDeviceCode", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertDeviceCode indicates an expected call of InsertDeviceCode.
+func (mr *MockDBClientMockRecorder) InsertDeviceCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDeviceCode", reflect.TypeOf((*MockDBClient)(nil).InsertDeviceCode), ctx, arg)
+}
+
+// InsertEmailChangeRevert mocks base method.
+func (m *MockDBClient) InsertEmailChangeRevert(ctx context.Context, arg sql.InsertEmailChangeRevertParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEmailChangeRevert", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertEmailChangeRevert indicates an expected call of InsertEmailChangeRevert.
+func (mr *MockDBClientMockRecorder) InsertEmailChangeRevert(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEmailChangeRevert", reflect.TypeOf((*MockDBClient)(nil).InsertEmailChangeRevert), ctx, arg)
+}
+
+// InsertNewDeviceSignIn mocks base method.
+func (m *MockDBClient) InsertNewDeviceSignIn(ctx context.Context, arg sql.InsertNewDeviceSignInParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNewDeviceSignIn", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertNewDeviceSignIn indicates an expected call of InsertNewDeviceSignIn.
+func (mr *MockDBClientMockRecorder) InsertNewDeviceSignIn(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNewDeviceSignIn", reflect.TypeOf((*MockDBClient)(nil).InsertNewDeviceSignIn), ctx, arg)
+}
+
+// InsertOAuth2AuthorizationCode mocks base method.
+func (m *MockDBClient) InsertOAuth2AuthorizationCode(ctx context.Context, arg sql.InsertOAuth2AuthorizationCodeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOAuth2AuthorizationCode", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertOAuth2AuthorizationCode indicates an expected call of InsertOAuth2AuthorizationCode.
+func (mr *MockDBClientMockRecorder) InsertOAuth2AuthorizationCode(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOAuth2AuthorizationCode", reflect.TypeOf((*MockDBClient)(nil).InsertOAuth2AuthorizationCode), ctx, arg)
+}
+
+// InsertOAuth2Client mocks base method.
+func (m *MockDBClient) InsertOAuth2Client(ctx context.Context, arg sql.InsertOAuth2ClientParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOAuth2Client", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertOAuth2Client indicates an expected call of InsertOAuth2Client.
+func (mr *MockDBClientMockRecorder) InsertOAuth2Client(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOAuth2Client", reflect.TypeOf((*MockDBClient)(nil).InsertOAuth2Client), ctx, arg)
+}
+
+// InsertOrganization mocks base method.
+func (m *MockDBClient) InsertOrganization(ctx context.Context, arg sql.InsertOrganizationParams) (sql.InsertOrganizationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOrganization", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertOrganizationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertOrganization indicates an expected call of InsertOrganization.
+func (mr *MockDBClientMockRecorder) InsertOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrganization", reflect.TypeOf((*MockDBClient)(nil).InsertOrganization), ctx, arg)
+}
+
+// InsertPersonalAccessToken mocks base method.
+func (m *MockDBClient) InsertPersonalAccessToken(ctx context.Context, arg sql.InsertPersonalAccessTokenParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPersonalAccessToken", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPersonalAccessToken indicates an expected call of InsertPersonalAccessToken.
+func (mr *MockDBClientMockRecorder) InsertPersonalAccessToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPersonalAccessToken", reflect.TypeOf((*MockDBClient)(nil).InsertPersonalAccessToken), ctx, arg)
+}
+
+// InsertProviderRequest mocks base method.
+func (m *MockDBClient) InsertProviderRequest(ctx context.Context, arg sql.InsertProviderRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertProviderRequest", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertProviderRequest indicates an expected call of InsertProviderRequest.
+func (mr *MockDBClientMockRecorder) InsertProviderRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertProviderRequest", reflect.TypeOf((*MockDBClient)(nil).InsertProviderRequest), ctx, arg)
+}
+
+// InsertRefreshtoken mocks base method.
+func (m *MockDBClient) InsertRefreshtoken(ctx context.Context, arg sql.InsertRefreshtokenParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRefreshtoken", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertRefreshtoken indicates an expected call of InsertRefreshtoken.
+func (mr *MockDBClientMockRecorder) InsertRefreshtoken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRefreshtoken", reflect.TypeOf((*MockDBClient)(nil).InsertRefreshtoken), ctx, arg)
+}
+
+// InsertRole mocks base method.
+func (m *MockDBClient) InsertRole(ctx context.Context, role string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRole", ctx, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertRole indicates an expected call of InsertRole.
+func (mr *MockDBClientMockRecorder) InsertRole(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRole", reflect.TypeOf((*MockDBClient)(nil).InsertRole), ctx, role)
+}
+
+// InsertSecurityKey mocks base method.
+func (m *MockDBClient) InsertSecurityKey(ctx context.Context, arg sql.InsertSecurityKeyParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSecurityKey", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSecurityKey indicates an expected call of InsertSecurityKey.
+func (mr *MockDBClientMockRecorder) InsertSecurityKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSecurityKey", reflect.TypeOf((*MockDBClient)(nil).InsertSecurityKey), ctx, arg)
+}
+
+// InsertTokenExchange mocks base method.
+func (m *MockDBClient) InsertTokenExchange(ctx context.Context, arg sql.InsertTokenExchangeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTokenExchange", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTokenExchange indicates an expected call of InsertTokenExchange.
+func (mr *MockDBClientMockRecorder) InsertTokenExchange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTokenExchange", reflect.TypeOf((*MockDBClient)(nil).InsertTokenExchange), ctx, arg)
+}
+
+// InsertUser mocks base method.
+func (m *MockDBClient) InsertUser(ctx context.Context, arg sql.InsertUserParams) (sql.InsertUserRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUser", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertUserRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUser indicates an expected call of InsertUser.
+func (mr *MockDBClientMockRecorder) InsertUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUser", reflect.TypeOf((*MockDBClient)(nil).InsertUser), ctx, arg)
+}
+
+// InsertUserProvider mocks base method.
+func (m *MockDBClient) InsertUserProvider(ctx context.Context, arg sql.InsertUserProviderParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserProvider", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserProvider indicates an expected call of InsertUserProvider.
+func (mr *MockDBClientMockRecorder) InsertUserProvider(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserProvider", reflect.TypeOf((*MockDBClient)(nil).InsertUserProvider), ctx, arg)
+}
+
+// InsertUserRole mocks base method.
+func (m *MockDBClient) InsertUserRole(ctx context.Context, arg sql.InsertUserRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserRole", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertUserRole indicates an expected call of InsertUserRole.
+func (mr *MockDBClientMockRecorder) InsertUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserRole", reflect.TypeOf((*MockDBClient)(nil).InsertUserRole), ctx, arg)
+}
+
+// InsertUserWithRefreshToken mocks base method.
+func (m *MockDBClient) InsertUserWithRefreshToken(ctx context.Context, arg sql.InsertUserWithRefreshTokenParams) (sql.InsertUserWithRefreshTokenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserWithRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertUserWithRefreshTokenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserWithRefreshToken indicates an expected call of InsertUserWithRefreshToken.
+func (mr *MockDBClientMockRecorder) InsertUserWithRefreshToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithRefreshToken", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithRefreshToken), ctx, arg)
+}
+
+// InsertUserWithSecurityKey mocks base method.
+func (m *MockDBClient) InsertUserWithSecurityKey(ctx context.Context, arg sql.InsertUserWithSecurityKeyParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserWithSecurityKey", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserWithSecurityKey indicates an expected call of InsertUserWithSecurityKey.
+func (mr *MockDBClientMockRecorder) InsertUserWithSecurityKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithSecurityKey", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithSecurityKey), ctx, arg)
+}
+
+// InsertUserWithSecurityKeyAndRefreshToken mocks base method.
+func (m *MockDBClient) InsertUserWithSecurityKeyAndRefreshToken(ctx context.Context, arg sql.InsertUserWithSecurityKeyAndRefreshTokenParams) (sql.InsertUserWithSecurityKeyAndRefreshTokenRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserWithSecurityKeyAndRefreshToken", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertUserWithSecurityKeyAndRefreshTokenRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserWithSecurityKeyAndRefreshToken indicates an expected call of InsertUserWithSecurityKeyAndRefreshToken.
+func (mr *MockDBClientMockRecorder) InsertUserWithSecurityKeyAndRefreshToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithSecurityKeyAndRefreshToken", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithSecurityKeyAndRefreshToken), ctx, arg)
+}
+
+// InsertUserWithUserProvider mocks base method.
+func (m *MockDBClient) InsertUserWithUserProvider(ctx context.Context, arg sql.InsertUserWithUserProviderParams) (sql.InsertUserWithUserProviderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserWithUserProvider", ctx, arg)
+	ret0, _ := ret[0].(sql.InsertUserWithUserProviderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserWithUserProvider indicates an expected call of InsertUserWithUserProvider.
+func (mr *MockDBClientMockRecorder) InsertUserWithUserProvider(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserWithUserProvider", reflect.TypeOf((*MockDBClient)(nil).InsertUserWithUserProvider), ctx, arg)
+}
+
+// LockUserSessions mocks base method.
+func (m *MockDBClient) LockUserSessions(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUserSessions indicates an expected call of LockUserSessions.
+func (mr *MockDBClientMockRecorder) LockUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserSessions", reflect.TypeOf((*MockDBClient)(nil).LockUserSessions), ctx, userID)
+}
+
+// Ping mocks base method.
+func (m *MockDBClient) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockDBClientMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockDBClient)(nil).Ping), ctx)
+}
+
+// RecordIPSignInFailure mocks base method.
+func (m *MockDBClient) RecordIPSignInFailure(ctx context.Context, arg sql.RecordIPSignInFailureParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordIPSignInFailure", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordIPSignInFailure indicates an expected call of RecordIPSignInFailure.
+func (mr *MockDBClientMockRecorder) RecordIPSignInFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordIPSignInFailure", reflect.TypeOf((*MockDBClient)(nil).RecordIPSignInFailure), ctx, arg)
+}
+
+// RecordUserSignInFailure mocks base method.
+func (m *MockDBClient) RecordUserSignInFailure(ctx context.Context, arg sql.RecordUserSignInFailureParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUserSignInFailure", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordUserSignInFailure indicates an expected call of RecordUserSignInFailure.
+func (mr *MockDBClientMockRecorder) RecordUserSignInFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUserSignInFailure", reflect.TypeOf((*MockDBClient)(nil).RecordUserSignInFailure), ctx, arg)
+}
+
+// RefreshTokenDeviceFingerprintExists mocks base method.
+func (m *MockDBClient) RefreshTokenDeviceFingerprintExists(ctx context.Context, arg sql.RefreshTokenDeviceFingerprintExistsParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokenDeviceFingerprintExists", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokenDeviceFingerprintExists indicates an expected call of RefreshTokenDeviceFingerprintExists.
+func (mr *MockDBClientMockRecorder) RefreshTokenDeviceFingerprintExists(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenDeviceFingerprintExists", reflect.TypeOf((*MockDBClient)(nil).RefreshTokenDeviceFingerprintExists), ctx, arg)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockDBClient) ReplaceRecoveryCodes(ctx context.Context, arg sql.ReplaceRecoveryCodesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockDBClientMockRecorder) ReplaceRecoveryCodes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockDBClient)(nil).ReplaceRecoveryCodes), ctx, arg)
+}
+
+// ResetUserSignInFailures mocks base method.
+func (m *MockDBClient) ResetUserSignInFailures(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetUserSignInFailures", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetUserSignInFailures indicates an expected call of ResetUserSignInFailures.
+func (mr *MockDBClientMockRecorder) ResetUserSignInFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUserSignInFailures", reflect.TypeOf((*MockDBClient)(nil).ResetUserSignInFailures), ctx, id)
+}
+
+// RetryEmailOutbox mocks base method.
+func (m *MockDBClient) RetryEmailOutbox(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryEmailOutbox", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryEmailOutbox indicates an expected call of RetryEmailOutbox.
+func (mr *MockDBClientMockRecorder) RetryEmailOutbox(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryEmailOutbox", reflect.TypeOf((*MockDBClient)(nil).RetryEmailOutbox), ctx, id)
+}
+
+// RevokeUserSessions mocks base method.
+func (m *MockDBClient) RevokeUserSessions(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessions", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserSessions indicates an expected call of RevokeUserSessions.
+func (mr *MockDBClientMockRecorder) RevokeUserSessions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessions", reflect.TypeOf((*MockDBClient)(nil).RevokeUserSessions), ctx, id)
+}
+
+// RotateRefreshTokenAndGetUserRoles mocks base method.
+func (m *MockDBClient) RotateRefreshTokenAndGetUserRoles(ctx context.Context, arg sql.RotateRefreshTokenAndGetUserRolesParams) ([]sql.RotateRefreshTokenAndGetUserRolesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshTokenAndGetUserRoles", ctx, arg)
+	ret0, _ := ret[0].([]sql.RotateRefreshTokenAndGetUserRolesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshTokenAndGetUserRoles indicates an expected call of RotateRefreshTokenAndGetUserRoles.
+func (mr *MockDBClientMockRecorder) RotateRefreshTokenAndGetUserRoles(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshTokenAndGetUserRoles", reflect.TypeOf((*MockDBClient)(nil).RotateRefreshTokenAndGetUserRoles), ctx, arg)
+}
+
+// ScheduleUserDeletion mocks base method.
+func (m *MockDBClient) ScheduleUserDeletion(ctx context.Context, arg sql.ScheduleUserDeletionParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleUserDeletion", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleUserDeletion indicates an expected call of ScheduleUserDeletion.
+func (mr *MockDBClientMockRecorder) ScheduleUserDeletion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserDeletion", reflect.TypeOf((*MockDBClient)(nil).ScheduleUserDeletion), ctx, arg)
+}
+
+// UnbanUser mocks base method.
+func (m *MockDBClient) UnbanUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanUser", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnbanUser indicates an expected call of UnbanUser.
+func (mr *MockDBClientMockRecorder) UnbanUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockDBClient)(nil).UnbanUser), ctx, id)
+}
+
+// UpdateDeviceCodeLastPolled mocks base method.
+func (m *MockDBClient) UpdateDeviceCodeLastPolled(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeviceCodeLastPolled", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDeviceCodeLastPolled indicates an expected call of UpdateDeviceCodeLastPolled.
+func (mr *MockDBClientMockRecorder) UpdateDeviceCodeLastPolled(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceCodeLastPolled", reflect.TypeOf((*MockDBClient)(nil).UpdateDeviceCodeLastPolled), ctx, id)
+}
+
+// UpdateIPLockedUntil mocks base method.
+func (m *MockDBClient) UpdateIPLockedUntil(ctx context.Context, arg sql.UpdateIPLockedUntilParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIPLockedUntil", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIPLockedUntil indicates an expected call of UpdateIPLockedUntil.
+func (mr *MockDBClientMockRecorder) UpdateIPLockedUntil(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPLockedUntil", reflect.TypeOf((*MockDBClient)(nil).UpdateIPLockedUntil), ctx, arg)
+}
+
+// UpdateProviderSession mocks base method.
+func (m *MockDBClient) UpdateProviderSession(ctx context.Context, arg sql.UpdateProviderSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProviderSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProviderSession indicates an expected call of UpdateProviderSession.
+func (mr *MockDBClientMockRecorder) UpdateProviderSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProviderSession", reflect.TypeOf((*MockDBClient)(nil).UpdateProviderSession), ctx, arg)
+}
+
+// UpdateScimUser mocks base method.
+func (m *MockDBClient) UpdateScimUser(ctx context.Context, arg sql.UpdateScimUserParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScimUser", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScimUser indicates an expected call of UpdateScimUser.
+func (mr *MockDBClientMockRecorder) UpdateScimUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScimUser", reflect.TypeOf((*MockDBClient)(nil).UpdateScimUser), ctx, arg)
+}
+
+// UpdateSecurityKeyCounter mocks base method.
+func (m *MockDBClient) UpdateSecurityKeyCounter(ctx context.Context, arg sql.UpdateSecurityKeyCounterParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurityKeyCounter", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecurityKeyCounter indicates an expected call of UpdateSecurityKeyCounter.
+func (mr *MockDBClientMockRecorder) UpdateSecurityKeyCounter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityKeyCounter", reflect.TypeOf((*MockDBClient)(nil).UpdateSecurityKeyCounter), ctx, arg)
+}
+
+// UpdateUserActiveMFAType mocks base method.
+func (m *MockDBClient) UpdateUserActiveMFAType(ctx context.Context, arg sql.UpdateUserActiveMFATypeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserActiveMFAType", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserActiveMFAType indicates an expected call of UpdateUserActiveMFAType.
+func (mr *MockDBClientMockRecorder) UpdateUserActiveMFAType(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserActiveMFAType", reflect.TypeOf((*MockDBClient)(nil).UpdateUserActiveMFAType), ctx, arg)
+}
+
+// UpdateUserActiveOrganization mocks base method.
+func (m *MockDBClient) UpdateUserActiveOrganization(ctx context.Context, arg sql.UpdateUserActiveOrganizationParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserActiveOrganization", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserActiveOrganization indicates an expected call of UpdateUserActiveOrganization.
+func (mr *MockDBClientMockRecorder) UpdateUserActiveOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserActiveOrganization", reflect.TypeOf((*MockDBClient)(nil).UpdateUserActiveOrganization), ctx, arg)
+}
+
+// UpdateUserAvatarURL mocks base method.
+func (m *MockDBClient) UpdateUserAvatarURL(ctx context.Context, arg sql.UpdateUserAvatarURLParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserAvatarURL", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserAvatarURL indicates an expected call of UpdateUserAvatarURL.
+func (mr *MockDBClientMockRecorder) UpdateUserAvatarURL(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAvatarURL", reflect.TypeOf((*MockDBClient)(nil).UpdateUserAvatarURL), ctx, arg)
+}
+
+// UpdateUserChangeEmail mocks base method.
+func (m *MockDBClient) UpdateUserChangeEmail(ctx context.Context, arg sql.UpdateUserChangeEmailParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserChangeEmail", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserChangeEmail indicates an expected call of UpdateUserChangeEmail.
+func (mr *MockDBClientMockRecorder) UpdateUserChangeEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserChangeEmail", reflect.TypeOf((*MockDBClient)(nil).UpdateUserChangeEmail), ctx, arg)
+}
+
+// UpdateUserChangePhoneNumber mocks base method.
+func (m *MockDBClient) UpdateUserChangePhoneNumber(ctx context.Context, arg sql.UpdateUserChangePhoneNumberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserChangePhoneNumber", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserChangePhoneNumber indicates an expected call of UpdateUserChangePhoneNumber.
+func (mr *MockDBClientMockRecorder) UpdateUserChangePhoneNumber(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserChangePhoneNumber", reflect.TypeOf((*MockDBClient)(nil).UpdateUserChangePhoneNumber), ctx, arg)
+}
+
+// UpdateUserConfirmChangeEmail mocks base method.
+func (m *MockDBClient) UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserConfirmChangeEmail", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserConfirmChangeEmail indicates an expected call of UpdateUserConfirmChangeEmail.
+func (mr *MockDBClientMockRecorder) UpdateUserConfirmChangeEmail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConfirmChangeEmail", reflect.TypeOf((*MockDBClient)(nil).UpdateUserConfirmChangeEmail), ctx, id)
+}
+
+// UpdateUserConfirmChangePhoneNumber mocks base method.
+func (m *MockDBClient) UpdateUserConfirmChangePhoneNumber(ctx context.Context, arg sql.UpdateUserConfirmChangePhoneNumberParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserConfirmChangePhoneNumber", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserConfirmChangePhoneNumber indicates an expected call of UpdateUserConfirmChangePhoneNumber.
+func (mr *MockDBClientMockRecorder) UpdateUserConfirmChangePhoneNumber(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConfirmChangePhoneNumber", reflect.TypeOf((*MockDBClient)(nil).UpdateUserConfirmChangePhoneNumber), ctx, arg)
+}
+
+// UpdateUserConfirmNewPhoneNumber mocks base method.
+func (m *MockDBClient) UpdateUserConfirmNewPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserConfirmNewPhoneNumber", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserConfirmNewPhoneNumber indicates an expected call of UpdateUserConfirmNewPhoneNumber.
+func (mr *MockDBClientMockRecorder) UpdateUserConfirmNewPhoneNumber(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConfirmNewPhoneNumber", reflect.TypeOf((*MockDBClient)(nil).UpdateUserConfirmNewPhoneNumber), ctx, id)
+}
+
+// UpdateUserConsumeOTP mocks base method.
+func (m *MockDBClient) UpdateUserConsumeOTP(ctx context.Context, arg sql.UpdateUserConsumeOTPParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserConsumeOTP", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserConsumeOTP indicates an expected call of UpdateUserConsumeOTP.
+func (mr *MockDBClientMockRecorder) UpdateUserConsumeOTP(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConsumeOTP", reflect.TypeOf((*MockDBClient)(nil).UpdateUserConsumeOTP), ctx, arg)
+}
+
+// UpdateUserConsumeTicket mocks base method.
+func (m *MockDBClient) UpdateUserConsumeTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserConsumeTicket", ctx, ticket)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserConsumeTicket indicates an expected call of UpdateUserConsumeTicket.
+func (mr *MockDBClientMockRecorder) UpdateUserConsumeTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserConsumeTicket", reflect.TypeOf((*MockDBClient)(nil).UpdateUserConsumeTicket), ctx, ticket)
+}
+
+// UpdateUserDeanonymize mocks base method.
+func (m *MockDBClient) UpdateUserDeanonymize(ctx context.Context, arg sql.UpdateUserDeanonymizeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDeanonymize", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDeanonymize indicates an expected call of UpdateUserDeanonymize.
+func (mr *MockDBClientMockRecorder) UpdateUserDeanonymize(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeanonymize", reflect.TypeOf((*MockDBClient)(nil).UpdateUserDeanonymize), ctx, arg)
+}
+
+// UpdateUserDeanonymizeWithUserProvider mocks base method.
+func (m *MockDBClient) UpdateUserDeanonymizeWithUserProvider(ctx context.Context, arg sql.UpdateUserDeanonymizeWithUserProviderParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDeanonymizeWithUserProvider", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserDeanonymizeWithUserProvider indicates an expected call of UpdateUserDeanonymizeWithUserProvider.
+func (mr *MockDBClientMockRecorder) UpdateUserDeanonymizeWithUserProvider(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDeanonymizeWithUserProvider", reflect.TypeOf((*MockDBClient)(nil).UpdateUserDeanonymizeWithUserProvider), ctx, arg)
+}
+
+// UpdateUserDisabled mocks base method.
+func (m *MockDBClient) UpdateUserDisabled(ctx context.Context, arg sql.UpdateUserDisabledParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDisabled", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserDisabled indicates an expected call of UpdateUserDisabled.
+func (mr *MockDBClientMockRecorder) UpdateUserDisabled(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDisabled", reflect.TypeOf((*MockDBClient)(nil).UpdateUserDisabled), ctx, arg)
+}
+
+// UpdateUserLastSeen mocks base method.
+func (m *MockDBClient) UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserLastSeen", ctx, id)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserLastSeen indicates an expected call of UpdateUserLastSeen.
+func (mr *MockDBClientMockRecorder) UpdateUserLastSeen(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastSeen", reflect.TypeOf((*MockDBClient)(nil).UpdateUserLastSeen), ctx, id)
+}
+
+// UpdateUserLockedUntil mocks base method.
+func (m *MockDBClient) UpdateUserLockedUntil(ctx context.Context, arg sql.UpdateUserLockedUntilParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserLockedUntil", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserLockedUntil indicates an expected call of UpdateUserLockedUntil.
+func (mr *MockDBClientMockRecorder) UpdateUserLockedUntil(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLockedUntil", reflect.TypeOf((*MockDBClient)(nil).UpdateUserLockedUntil), ctx, arg)
+}
+
+// UpdateUserMetadata mocks base method.
+func (m *MockDBClient) UpdateUserMetadata(ctx context.Context, arg sql.UpdateUserMetadataParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserMetadata", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserMetadata indicates an expected call of UpdateUserMetadata.
+func (mr *MockDBClientMockRecorder) UpdateUserMetadata(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserMetadata", reflect.TypeOf((*MockDBClient)(nil).UpdateUserMetadata), ctx, arg)
+}
+
+// UpdateUserOTPHash mocks base method.
+func (m *MockDBClient) UpdateUserOTPHash(ctx context.Context, arg sql.UpdateUserOTPHashParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserOTPHash", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserOTPHash indicates an expected call of UpdateUserOTPHash.
+func (mr *MockDBClientMockRecorder) UpdateUserOTPHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserOTPHash", reflect.TypeOf((*MockDBClient)(nil).UpdateUserOTPHash), ctx, arg)
+}
+
+// UpdateUserOTPHashFailedAttempts mocks base method.
+func (m *MockDBClient) UpdateUserOTPHashFailedAttempts(ctx context.Context, arg sql.UpdateUserOTPHashFailedAttemptsParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserOTPHashFailedAttempts", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserOTPHashFailedAttempts indicates an expected call of UpdateUserOTPHashFailedAttempts.
+func (mr *MockDBClientMockRecorder) UpdateUserOTPHashFailedAttempts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserOTPHashFailedAttempts", reflect.TypeOf((*MockDBClient)(nil).UpdateUserOTPHashFailedAttempts), ctx, arg)
+}
+
+// UpdateUserPasswordHash mocks base method.
+func (m *MockDBClient) UpdateUserPasswordHash(ctx context.Context, arg sql.UpdateUserPasswordHashParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPasswordHash", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserPasswordHash indicates an expected call of UpdateUserPasswordHash.
+func (mr *MockDBClientMockRecorder) UpdateUserPasswordHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPasswordHash", reflect.TypeOf((*MockDBClient)(nil).UpdateUserPasswordHash), ctx, arg)
+}
+
+// UpdateUserRehashPassword mocks base method.
+func (m *MockDBClient) UpdateUserRehashPassword(ctx context.Context, arg sql.UpdateUserRehashPasswordParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRehashPassword", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRehashPassword indicates an expected call of UpdateUserRehashPassword.
+func (mr *MockDBClientMockRecorder) UpdateUserRehashPassword(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRehashPassword", reflect.TypeOf((*MockDBClient)(nil).UpdateUserRehashPassword), ctx, arg)
+}
+
+// UpdateUserRevertEmailChange mocks base method.
+func (m *MockDBClient) UpdateUserRevertEmailChange(ctx context.Context, arg sql.UpdateUserRevertEmailChangeParams) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRevertEmailChange", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRevertEmailChange indicates an expected call of UpdateUserRevertEmailChange.
+func (mr *MockDBClientMockRecorder) UpdateUserRevertEmailChange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRevertEmailChange", reflect.TypeOf((*MockDBClient)(nil).UpdateUserRevertEmailChange), ctx, arg)
+}
+
+// UpdateUserTicket mocks base method.
+func (m *MockDBClient) UpdateUserTicket(ctx context.Context, arg sql.UpdateUserTicketParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTicket", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTicket indicates an expected call of UpdateUserTicket.
+func (mr *MockDBClientMockRecorder) UpdateUserTicket(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTicket", reflect.TypeOf((*MockDBClient)(nil).UpdateUserTicket), ctx, arg)
+}
+
+// UpdateUserTicketFailedAttempts mocks base method.
+func (m *MockDBClient) UpdateUserTicketFailedAttempts(ctx context.Context, arg sql.UpdateUserTicketFailedAttemptsParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTicketFailedAttempts", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTicketFailedAttempts indicates an expected call of UpdateUserTicketFailedAttempts.
+func (mr *MockDBClientMockRecorder) UpdateUserTicketFailedAttempts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTicketFailedAttempts", reflect.TypeOf((*MockDBClient)(nil).UpdateUserTicketFailedAttempts), ctx, arg)
+}
+
+// UpdateUserTotpSecret mocks base method.
+func (m *MockDBClient) UpdateUserTotpSecret(ctx context.Context, arg sql.UpdateUserTotpSecretParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTotpSecret", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserTotpSecret indicates an expected call of UpdateUserTotpSecret.
+func (mr *MockDBClientMockRecorder) UpdateUserTotpSecret(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTotpSecret", reflect.TypeOf((*MockDBClient)(nil).UpdateUserTotpSecret), ctx, arg)
+}
+
+// UpdateUserVerifyEmail mocks base method.
+func (m *MockDBClient) UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserVerifyEmail", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserVerifyEmail indicates an expected call of UpdateUserVerifyEmail.
+func (mr *MockDBClientMockRecorder) UpdateUserVerifyEmail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyEmail", reflect.TypeOf((*MockDBClient)(nil).UpdateUserVerifyEmail), ctx, id)
+}
+
+// UpdateUserVerifyPhoneNumber mocks base method.
+func (m *MockDBClient) UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserVerifyPhoneNumber", ctx, id)
+	ret0, _ := ret[0].(sql.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserVerifyPhoneNumber indicates an expected call of UpdateUserVerifyPhoneNumber.
+func (mr *MockDBClientMockRecorder) UpdateUserVerifyPhoneNumber(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserVerifyPhoneNumber", reflect.TypeOf((*MockDBClient)(nil).UpdateUserVerifyPhoneNumber), ctx, id)
+}
+
+// UpsertOAuth2Consent mocks base method.
+func (m *MockDBClient) UpsertOAuth2Consent(ctx context.Context, arg sql.UpsertOAuth2ConsentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOAuth2Consent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertOAuth2Consent indicates an expected call of UpsertOAuth2Consent.
+func (mr *MockDBClientMockRecorder) UpsertOAuth2Consent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOAuth2Consent", reflect.TypeOf((*MockDBClient)(nil).UpsertOAuth2Consent), ctx, arg)
+}
+
+// UpsertOrganizationInvite mocks base method.
+func (m *MockDBClient) UpsertOrganizationInvite(ctx context.Context, arg sql.UpsertOrganizationInviteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOrganizationInvite", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertOrganizationInvite indicates an expected call of UpsertOrganizationInvite.
+func (mr *MockDBClientMockRecorder) UpsertOrganizationInvite(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOrganizationInvite", reflect.TypeOf((*MockDBClient)(nil).UpsertOrganizationInvite), ctx, arg)
+}
+
+// UpsertSignInMethod mocks base method.
+func (m *MockDBClient) UpsertSignInMethod(ctx context.Context, arg sql.UpsertSignInMethodParams) (sql.AuthSignInMethod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSignInMethod", ctx, arg)
+	ret0, _ := ret[0].(sql.AuthSignInMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSignInMethod indicates an expected call of UpsertSignInMethod.
+func (mr *MockDBClientMockRecorder) UpsertSignInMethod(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSignInMethod", reflect.TypeOf((*MockDBClient)(nil).UpsertSignInMethod), ctx, arg)
+}
+
+// MockSAMLServiceProvider is a mock of SAMLServiceProvider interface.
+type MockSAMLServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockSAMLServiceProviderMockRecorder
+}
+
+// MockSAMLServiceProviderMockRecorder is the mock recorder for MockSAMLServiceProvider.
+type MockSAMLServiceProviderMockRecorder struct {
+	mock *MockSAMLServiceProvider
+}
+
+// NewMockSAMLServiceProvider creates a new mock instance.
+func NewMockSAMLServiceProvider(ctrl *gomock.Controller) *MockSAMLServiceProvider {
+	mock := &MockSAMLServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockSAMLServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSAMLServiceProvider) EXPECT() *MockSAMLServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthenticationRequestURL mocks base method.
+func (m *MockSAMLServiceProvider) AuthenticationRequestURL(relayState string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticationRequestURL", relayState)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthenticationRequestURL indicates an expected call of AuthenticationRequestURL.
+func (mr *MockSAMLServiceProviderMockRecorder) AuthenticationRequestURL(relayState any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticationRequestURL", reflect.TypeOf((*MockSAMLServiceProvider)(nil).AuthenticationRequestURL), relayState)
+}
+
+// Metadata mocks base method.
+func (m *MockSAMLServiceProvider) Metadata() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metadata")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Metadata indicates an expected call of Metadata.
+func (mr *MockSAMLServiceProviderMockRecorder) Metadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockSAMLServiceProvider)(nil).Metadata))
+}
+
+// ParseResponse mocks base method.
+func (m *MockSAMLServiceProvider) ParseResponse(samlResponse, requestID string) (providers.Profile, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseResponse", samlResponse, requestID)
+	ret0, _ := ret[0].(providers.Profile)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ParseResponse indicates an expected call of ParseResponse.
+func (mr *MockSAMLServiceProviderMockRecorder) ParseResponse(samlResponse, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseResponse", reflect.TypeOf((*MockSAMLServiceProvider)(nil).ParseResponse), samlResponse, requestID)
+}
+
+// MockRateLimiter is a mock of RateLimiter interface.
+type MockRateLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimiterMockRecorder
+}
+
+// MockRateLimiterMockRecorder is the mock recorder for MockRateLimiter.
+type MockRateLimiterMockRecorder struct {
+	mock *MockRateLimiter
+}
+
+// NewMockRateLimiter creates a new mock instance.
+func NewMockRateLimiter(ctrl *gomock.Controller) *MockRateLimiter {
+	mock := &MockRateLimiter{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimiter) EXPECT() *MockRateLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit int, interval time.Duration) (ratelimit.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key, limit, interval)
+	ret0, _ := ret[0].(ratelimit.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimiterMockRecorder) Allow(ctx, key, limit, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimiter)(nil).Allow), ctx, key, limit, interval)
+}
+
+// MockCaptchaVerifier is a mock of CaptchaVerifier interface.
+type MockCaptchaVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaptchaVerifierMockRecorder
+}
+
+// MockCaptchaVerifierMockRecorder is the mock recorder for MockCaptchaVerifier.
+type MockCaptchaVerifierMockRecorder struct {
+	mock *MockCaptchaVerifier
+}
+
+// NewMockCaptchaVerifier creates a new mock instance.
+func NewMockCaptchaVerifier(ctrl *gomock.Controller) *MockCaptchaVerifier {
+	mock := &MockCaptchaVerifier{ctrl: ctrl}
+	mock.recorder = &MockCaptchaVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaptchaVerifier) EXPECT() *MockCaptchaVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockCaptchaVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, token, remoteIP)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockCaptchaVerifierMockRecorder) Verify(ctx, token, remoteIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockCaptchaVerifier)(nil).Verify), ctx, token, remoteIP)
+}
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockWebhookSender) Send(ctx context.Context, event webhooks.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Send", ctx, event)
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, event)
+}
+
+// MockPreSignUpHook is a mock of PreSignUpHook interface.
+type MockPreSignUpHook struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreSignUpHookMockRecorder
+}
+
+// MockPreSignUpHookMockRecorder is the mock recorder for MockPreSignUpHook.
+type MockPreSignUpHookMockRecorder struct {
+	mock *MockPreSignUpHook
+}
+
+// NewMockPreSignUpHook creates a new mock instance.
+func NewMockPreSignUpHook(ctrl *gomock.Controller) *MockPreSignUpHook {
+	mock := &MockPreSignUpHook{ctrl: ctrl}
+	mock.recorder = &MockPreSignUpHookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreSignUpHook) EXPECT() *MockPreSignUpHookMockRecorder {
+	return m.recorder
+}
+
+// Call mocks base method.
+func (m *MockPreSignUpHook) Call(ctx context.Context, request webhooks.PreSignUpRequest) (webhooks.PreSignUpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", ctx, request)
+	ret0, _ := ret[0].(webhooks.PreSignUpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockPreSignUpHookMockRecorder) Call(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockPreSignUpHook)(nil).Call), ctx, request)
+}
+
+// MockDisposableEmailChecker is a mock of DisposableEmailChecker interface.
+type MockDisposableEmailChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisposableEmailCheckerMockRecorder
+}
+
+// MockDisposableEmailCheckerMockRecorder is the mock recorder for MockDisposableEmailChecker.
+type MockDisposableEmailCheckerMockRecorder struct {
+	mock *MockDisposableEmailChecker
+}
+
+// NewMockDisposableEmailChecker creates a new mock instance.
+func NewMockDisposableEmailChecker(ctrl *gomock.Controller) *MockDisposableEmailChecker {
+	mock := &MockDisposableEmailChecker{ctrl: ctrl}
+	mock.recorder = &MockDisposableEmailCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisposableEmailChecker) EXPECT() *MockDisposableEmailCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsDisposable mocks base method.
+func (m *MockDisposableEmailChecker) IsDisposable(email string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDisposable", email)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDisposable indicates an expected call of IsDisposable.
+func (mr *MockDisposableEmailCheckerMockRecorder) IsDisposable(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDisposable", reflect.TypeOf((*MockDisposableEmailChecker)(nil).IsDisposable), email)
+}
+
+// MockAvatarStorage is a mock of AvatarStorage interface.
+type MockAvatarStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvatarStorageMockRecorder
+}
+
+// MockAvatarStorageMockRecorder is the mock recorder for MockAvatarStorage.
+type MockAvatarStorageMockRecorder struct {
+	mock *MockAvatarStorage
+}
+
+// NewMockAvatarStorage creates a new mock instance.
+func NewMockAvatarStorage(ctrl *gomock.Controller) *MockAvatarStorage {
+	mock := &MockAvatarStorage{ctrl: ctrl}
+	mock.recorder = &MockAvatarStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvatarStorage) EXPECT() *MockAvatarStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAvatarStorage) Delete(ctx context.Context, avatarURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, avatarURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAvatarStorageMockRecorder) Delete(ctx, avatarURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAvatarStorage)(nil).Delete), ctx, avatarURL)
+}
+
+// Upload mocks base method.
+func (m *MockAvatarStorage) Upload(ctx context.Context, userID uuid.UUID, contentType string, body []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, userID, contentType, body)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAvatarStorageMockRecorder) Upload(ctx, userID, contentType, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAvatarStorage)(nil).Upload), ctx, userID, contentType, body)
+}
+
+// MockGeoIPLocator is a mock of GeoIPLocator interface.
+type MockGeoIPLocator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeoIPLocatorMockRecorder
+}
+
+// MockGeoIPLocatorMockRecorder is the mock recorder for MockGeoIPLocator.
+type MockGeoIPLocatorMockRecorder struct {
+	mock *MockGeoIPLocator
+}
+
+// NewMockGeoIPLocator creates a new mock instance.
+func NewMockGeoIPLocator(ctrl *gomock.Controller) *MockGeoIPLocator {
+	mock := &MockGeoIPLocator{ctrl: ctrl}
+	mock.recorder = &MockGeoIPLocatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGeoIPLocator) EXPECT() *MockGeoIPLocatorMockRecorder {
+	return m.recorder
+}
+
+// Locate mocks base method.
+func (m *MockGeoIPLocator) Locate(ip string) (geoip.Location, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locate", ip)
+	ret0, _ := ret[0].(geoip.Location)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Locate indicates an expected call of Locate.
+func (mr *MockGeoIPLocatorMockRecorder) Locate(ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locate", reflect.TypeOf((*MockGeoIPLocator)(nil).Locate), ip)
+}
+
+// MockRiskEngine is a mock of RiskEngine interface.
+type MockRiskEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockRiskEngineMockRecorder
+}
+
+// MockRiskEngineMockRecorder is the mock recorder for MockRiskEngine.
+type MockRiskEngineMockRecorder struct {
+	mock *MockRiskEngine
+}
+
+// NewMockRiskEngine creates a new mock instance.
+func NewMockRiskEngine(ctrl *gomock.Controller) *MockRiskEngine {
+	mock := &MockRiskEngine{ctrl: ctrl}
+	mock.recorder = &MockRiskEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRiskEngine) EXPECT() *MockRiskEngineMockRecorder {
+	return m.recorder
+}
+
+// Score mocks base method.
+func (m *MockRiskEngine) Score(ctx context.Context, signals risk.Signals) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Score", ctx, signals)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Score indicates an expected call of Score.
+func (mr *MockRiskEngineMockRecorder) Score(ctx, signals any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Score", reflect.TypeOf((*MockRiskEngine)(nil).Score), ctx, signals)
+}
+
+// MockConfigReloader is a mock of ConfigReloader interface.
+type MockConfigReloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigReloaderMockRecorder
+}
+
+// MockConfigReloaderMockRecorder is the mock recorder for MockConfigReloader.
+type MockConfigReloaderMockRecorder struct {
+	mock *MockConfigReloader
+}
+
+// NewMockConfigReloader creates a new mock instance.
+func NewMockConfigReloader(ctrl *gomock.Controller) *MockConfigReloader {
+	mock := &MockConfigReloader{ctrl: ctrl}
+	mock.recorder = &MockConfigReloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigReloader) EXPECT() *MockConfigReloaderMockRecorder {
+	return m.recorder
+}
+
+// Reload mocks base method.
+func (m *MockConfigReloader) Reload(ctx context.Context) ([]string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockConfigReloaderMockRecorder) Reload(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockConfigReloader)(nil).Reload), ctx)
 }
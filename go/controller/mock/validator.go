@@ -8,3 +8,49 @@
 
 // Package mock is a generated GoMock package.
 package mock
+
+import (
+	context "context"
+	net "net"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMXResolver is a mock of MXResolver interface.
+type MockMXResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockMXResolverMockRecorder
+}
+
+// MockMXResolverMockRecorder is the mock recorder for MockMXResolver.
+type MockMXResolverMockRecorder struct {
+	mock *MockMXResolver
+}
+
+// NewMockMXResolver creates a new mock instance.
+func NewMockMXResolver(ctrl *gomock.Controller) *MockMXResolver {
+	mock := &MockMXResolver{ctrl: ctrl}
+	mock.recorder = &MockMXResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMXResolver) EXPECT() *MockMXResolverMockRecorder {
+	return m.recorder
+}
+
+// LookupMX mocks base method.
+func (m *MockMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupMX", ctx, name)
+	ret0, _ := ret[0].([]*net.MX)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupMX indicates an expected call of LookupMX.
+func (mr *MockMXResolverMockRecorder) LookupMX(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupMX", reflect.TypeOf((*MockMXResolver)(nil).LookupMX), ctx, name)
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	oidcScopeOpenID  = "openid"
+	oidcScopeProfile = "profile"
+	oidcScopeEmail   = "email"
+	oidcScopePhone   = "phone"
+
+	codeChallengeMethodS256 = "S256"
+)
+
+// oidcScopes are the scopes giving access to the claims of the user, any other scope is a
+// role to include in the access token.
+var oidcScopes = []string{ //nolint:gochecknoglobals
+	oidcScopeOpenID, oidcScopeProfile, oidcScopeEmail, oidcScopePhone,
+}
+
+// oidcScopeRoles returns the scopes that are roles.
+func oidcScopeRoles(scopes []string) []string {
+	roles := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(oidcScopes, scope) {
+			roles = append(roles, scope)
+		}
+	}
+	return roles
+}
+
+// oidcAuthorizationRequestError returns the error code and description (RFC 6749 section
+// 4.1.2.1) of an invalid authorization request, or an empty code if it is valid.
+func oidcAuthorizationRequestError(
+	scopes []string, codeChallenge string, codeChallengeMethod string,
+) (string, string) {
+	if !slices.Contains(scopes, oidcScopeOpenID) {
+		return "invalid_scope", "The openid scope is required"
+	}
+
+	switch {
+	case codeChallenge == "" && codeChallengeMethod != "":
+		return "invalid_request", "code_challenge_method requires a code_challenge"
+	case codeChallenge != "" && codeChallengeMethod != codeChallengeMethodS256:
+		return "invalid_request", "Only the S256 code challenge method is supported"
+	}
+
+	return "", ""
+}
+
+// oidcRedirectURI adds the parameters to the redirect URI of the client.
+func oidcRedirectURI(redirectURI string, params map[string]string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		// the redirect URI is registered for the client so this can't happen
+		return redirectURI
+	}
+
+	query := u.Query()
+	for k, v := range params {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// oidcUserInfo returns the standard claims of the user the scopes give access to.
+func oidcUserInfo(user sql.AuthUser, scopes []string) api.OIDCUserInfo {
+	info := api.OIDCUserInfo{ //nolint:exhaustruct
+		Sub: user.ID.String(),
+	}
+
+	if slices.Contains(scopes, oidcScopeProfile) {
+		info.Name = ptr(user.DisplayName)
+		info.Locale = ptr(user.Locale)
+		if user.AvatarUrl != "" {
+			info.Picture = ptr(user.AvatarUrl)
+		}
+	}
+
+	if slices.Contains(scopes, oidcScopeEmail) && user.Email.Valid {
+		info.Email = ptr(user.Email.String)
+		info.EmailVerified = ptr(user.EmailVerified)
+	}
+
+	if slices.Contains(scopes, oidcScopePhone) && user.PhoneNumber.Valid {
+		info.PhoneNumber = ptr(user.PhoneNumber.String)
+		info.PhoneNumberVerified = ptr(user.PhoneNumberVerified)
+	}
+
+	return info
+}
+
+// oidcIDTokenClaims returns the claims of the ID token other than the registered ones.
+func oidcIDTokenClaims(user sql.AuthUser, scopes []string) (map[string]any, error) {
+	b, err := json.Marshal(oidcUserInfo(user, scopes))
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling user info: %w", err)
+	}
+
+	var claims map[string]any
+	if err := json.Unmarshal(b, &claims); err != nil {
+		return nil, fmt.Errorf("error unmarshalling user info: %w", err)
+	}
+	delete(claims, "sub")
+
+	return claims, nil
+}
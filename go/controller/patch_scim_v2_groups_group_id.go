@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+var errScimGroupRename = errors.New("groups can't be renamed")
+
+// scimPatchMemberPath returns the member selected by a path like
+// members[value eq "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"].
+func scimPatchMemberPath(path string) (uuid.UUID, bool, error) {
+	match := scimMembersPathRegexp.FindStringSubmatch(path)
+	if match == nil {
+		return uuid.UUID{}, false, nil
+	}
+
+	attribute, value, ok := parseScimFilter(match[1])
+	if !ok || attribute != "value" {
+		return uuid.UUID{}, true, fmt.Errorf("%w: unsupported path %s", errInvalidScimValue, path)
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.UUID{}, true, fmt.Errorf(
+			"%w: member %s isn't a user id", errInvalidScimValue, value,
+		)
+	}
+
+	return id, true, nil
+}
+
+// patchScimGroupMembers applies an operation on the members of the group.
+func (ctrl *Controller) patchScimGroupMembers(
+	ctx context.Context, role string, op string, value *any, logger *slog.Logger,
+) (*APIError, error) {
+	userIDs, err := scimPatchMembers(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "add":
+		for _, userID := range userIDs {
+			if apiErr := ctrl.wf.AddUserRole(
+				ctx, userID, role, logger.With(slog.String("user_id", userID.String())),
+			); apiErr != nil {
+				return apiErr, nil
+			}
+		}
+	case "remove":
+		if value == nil {
+			return ctrl.wf.SetScimGroupMembers(ctx, role, nil, logger), nil
+		}
+		for _, userID := range userIDs {
+			if apiErr := ctrl.wf.RemoveUserRole(
+				ctx, userID, role, logger.With(slog.String("user_id", userID.String())),
+			); apiErr != nil {
+				return apiErr, nil
+			}
+		}
+	case "replace":
+		return ctrl.wf.SetScimGroupMembers(ctx, role, userIDs, logger), nil
+	}
+
+	return nil, nil
+}
+
+// patchScimGroup applies an operation of a patch request to the group. Only the members
+// can be updated, other attributes are ignored.
+func (ctrl *Controller) patchScimGroup(
+	ctx context.Context, role string, operation api.ScimPatchOperation, logger *slog.Logger,
+) (*APIError, error) {
+	op := strings.ToLower(operation.Op)
+	if op != "add" && op != "replace" && op != "remove" {
+		return nil, fmt.Errorf("%w: unknown operation %s", errInvalidScimValue, operation.Op)
+	}
+
+	path := deptr(operation.Path)
+	switch {
+	case path == "":
+		values, ok := deptr(operation.Value).(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: operations without path need an object as value", errInvalidScimValue,
+			)
+		}
+		for attribute, value := range values {
+			switch strings.ToLower(attribute) {
+			case "displayname":
+				if value != role {
+					return nil, errScimGroupRename
+				}
+			case "members":
+				return ctrl.patchScimGroupMembers(ctx, role, op, &value, logger)
+			}
+		}
+	case strings.EqualFold(path, "displayName"):
+		if deptr(operation.Value) != role {
+			return nil, errScimGroupRename
+		}
+	case strings.EqualFold(path, "members"):
+		return ctrl.patchScimGroupMembers(ctx, role, op, operation.Value, logger)
+	default:
+		userID, ok, err := scimPatchMemberPath(path)
+		if err != nil || !ok {
+			return nil, err
+		}
+		if op != "remove" {
+			return nil, fmt.Errorf(
+				"%w: members can only be selected to be removed", errInvalidScimValue,
+			)
+		}
+		return ctrl.wf.RemoveUserRole(
+			ctx, userID, role, logger.With(slog.String("user_id", userID.String())),
+		), nil
+	}
+
+	return nil, nil
+}
+
+func (ctrl *Controller) PatchScimV2GroupsGroupId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PatchScimV2GroupsGroupIdRequestObject,
+) (api.PatchScimV2GroupsGroupIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.GroupId))
+
+	if _, apiErr := ctrl.wf.GetScimGroupMembers(ctx, request.GroupId, logger); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	for _, operation := range request.Body.Operations {
+		apiErr, err := ctrl.patchScimGroup(ctx, request.GroupId, operation, logger)
+		switch {
+		case errors.Is(err, errScimGroupRename):
+			logger.Warn("scim groups can't be renamed")
+			return scimError(
+				http.StatusBadRequest, scimTypeMutability, "Groups can't be renamed",
+			), nil
+		case err != nil:
+			logger.Warn("invalid scim patch request", logError(err))
+			return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+		case apiErr != nil:
+			return ctrl.sendScimError(apiErr), nil
+		}
+	}
+
+	return api.PatchScimV2GroupsGroupId204Response{}, nil
+}
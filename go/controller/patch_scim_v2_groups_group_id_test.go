@@ -0,0 +1,205 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatchScimV2GroupsGroupId(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	anyValue := func(v any) *any {
+		return &v
+	}
+
+	cases := []testRequest[api.PatchScimV2GroupsGroupIdRequestObject, api.PatchScimV2GroupsGroupIdResponseObject]{ //nolint:lll
+		{
+			name:   "add members",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimGroups(gomock.Any(), sql.Text("editor")).
+					Return(int64(1), nil)
+				mock.EXPECT().GetRoleUsers(gomock.Any(), "editor").Return(nil, nil)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().InsertUserRole(gomock.Any(), sql.InsertUserRoleParams{
+					UserID: userID,
+					Role:   "editor",
+				}).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2GroupsGroupIdRequestObject{
+				GroupId: "editor",
+				Body: &api.ScimPatchRequest{
+					Schemas: &[]string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+					Operations: []api.ScimPatchOperation{
+						{Op: "Add", Path: ptr("members"), Value: anyValue([]any{
+							map[string]any{"value": userID.String()},
+						})},
+					},
+				},
+			},
+			expectedResponse: api.PatchScimV2GroupsGroupId204Response{},
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "remove member by path",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimGroups(gomock.Any(), sql.Text("editor")).
+					Return(int64(1), nil)
+				mock.EXPECT().GetRoleUsers(gomock.Any(), "editor").Return(
+					[]sql.GetRoleUsersRow{
+						{ID: userID, DisplayName: "Jane Doe", Email: sql.Text("jane@acme.com")},
+					}, nil,
+				)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().DeleteUserRole(gomock.Any(), sql.DeleteUserRoleParams{
+					UserID: userID,
+					Role:   "editor",
+				}).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2GroupsGroupIdRequestObject{
+				GroupId: "editor",
+				Body: &api.ScimPatchRequest{
+					Schemas: nil,
+					Operations: []api.ScimPatchOperation{
+						{
+							Op:    "remove",
+							Path:  ptr(`members[value eq "` + userID.String() + `"]`),
+							Value: nil,
+						},
+					},
+				},
+			},
+			expectedResponse: api.PatchScimV2GroupsGroupId204Response{},
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "rename",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimGroups(gomock.Any(), sql.Text("editor")).
+					Return(int64(1), nil)
+				mock.EXPECT().GetRoleUsers(gomock.Any(), "editor").Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2GroupsGroupIdRequestObject{
+				GroupId: "editor",
+				Body: &api.ScimPatchRequest{
+					Schemas: nil,
+					Operations: []api.ScimPatchOperation{
+						{Op: "replace", Path: nil, Value: anyValue(map[string]any{
+							"displayName": "editors",
+						})},
+					},
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "400",
+				ScimType: ptr("mutability"),
+				Detail:   ptr("Groups can't be renamed"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "group not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().CountScimGroups(gomock.Any(), sql.Text("editor")).
+					Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2GroupsGroupIdRequestObject{
+				GroupId: "editor",
+				Body: &api.ScimPatchRequest{
+					Schemas:    nil,
+					Operations: []api.ScimPatchOperation{},
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "404",
+				ScimType: nil,
+				Detail:   ptr("Role not found"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PatchScimV2GroupsGroupId,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
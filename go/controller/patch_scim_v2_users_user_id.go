@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PatchScimV2UsersUserId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PatchScimV2UsersUserIdRequestObject,
+) (api.PatchScimV2UsersUserIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	user, apiErr := ctrl.wf.GetScimUser(ctx, request.UserId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	// the emails are left out so changing the userName changes the email of the user
+	current := ctrl.scimUser(user)
+	current.Emails = nil
+
+	patched, err := scimPatchUser(current, request.Body.Operations)
+	if errors.Is(err, errInvalidScimValue) {
+		logger.Warn("invalid scim patch request", logError(err))
+		return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+	}
+	if err != nil {
+		logger.Error("error patching scim user", logError(err))
+		return ctrl.sendScimError(ErrInternalServerError), nil
+	}
+
+	input, err := scimUserInputFromUser(patched)
+	if err != nil {
+		logger.Warn("invalid scim user", logError(err))
+		return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+	}
+
+	if apiErr := ctrl.wf.UpdateScimUser(ctx, user, input, logger); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	user, apiErr = ctrl.wf.GetScimUser(ctx, request.UserId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse(ctrl.scimUser(user)), nil
+}
@@ -0,0 +1,227 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatchScimV2UsersUserId(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	anyValue := func(v any) *any {
+		return &v
+	}
+
+	cases := []testRequest[api.PatchScimV2UsersUserIdRequestObject, api.PatchScimV2UsersUserIdResponseObject]{ //nolint:lll
+		{
+			name:   "disable user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{getScimUsersRow(userID)}, nil)
+
+				mock.EXPECT().UpdateScimUser(
+					gomock.Any(), sql.UpdateScimUserParams{
+						Email:       sql.Text("jane@acme.com"),
+						DisplayName: "Jane Doe",
+						Disabled:    true,
+						ID:          userID,
+						ExternalID:  sql.Text("00u1ab2cd3"),
+					},
+				).Return(userID, nil)
+
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(1), nil)
+
+				user := getScimUsersRow(userID)
+				user.Disabled = true
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{user}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2UsersUserIdRequestObject{
+				UserId: userID,
+				Body: &api.ScimPatchRequest{
+					Schemas: &[]string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+					Operations: []api.ScimPatchOperation{
+						{Op: "Replace", Path: ptr("active"), Value: anyValue("False")},
+					},
+				},
+			},
+			expectedResponse: func() api.PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse {
+				user := getScimUser(userID)
+				user.Active = ptr(false)
+				return api.PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse(user)
+			}(),
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "replace without path",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{getScimUsersRow(userID)}, nil)
+
+				mock.EXPECT().UpdateScimUser(
+					gomock.Any(), sql.UpdateScimUserParams{
+						Email:       sql.Text("jane.doe@acme.com"),
+						DisplayName: "Jane D.",
+						Disabled:    false,
+						ID:          userID,
+						ExternalID:  sql.Text("00u1ab2cd3"),
+					},
+				).Return(userID, nil)
+
+				user := getScimUsersRow(userID)
+				user.Email = sql.Text("jane.doe@acme.com")
+				user.DisplayName = "Jane D."
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{user}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2UsersUserIdRequestObject{
+				UserId: userID,
+				Body: &api.ScimPatchRequest{
+					Schemas: nil,
+					Operations: []api.ScimPatchOperation{
+						{Op: "replace", Path: nil, Value: anyValue(map[string]any{
+							"displayName": "Jane D.",
+							"userName":    "jane.doe@acme.com",
+						})},
+					},
+				},
+			},
+			expectedResponse: func() api.PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse {
+				user := getScimUser(userID)
+				user.UserName = "jane.doe@acme.com"
+				user.DisplayName = ptr("Jane D.")
+				user.Emails = &[]api.ScimEmail{
+					{Value: "jane.doe@acme.com", Type: ptr("work"), Primary: ptr(true)},
+				}
+				return api.PatchScimV2UsersUserId200ApplicationScimPlusJSONResponse(user)
+			}(),
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unknown operation",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{getScimUsersRow(userID)}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2UsersUserIdRequestObject{
+				UserId: userID,
+				Body: &api.ScimPatchRequest{
+					Schemas: nil,
+					Operations: []api.ScimPatchOperation{
+						{Op: "move", Path: ptr("active"), Value: anyValue(false)},
+					},
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "400",
+				ScimType: ptr("invalidValue"),
+				Detail:   ptr("invalid value: unknown operation move"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PatchScimV2UsersUserIdRequestObject{
+				UserId: userID,
+				Body: &api.ScimPatchRequest{
+					Schemas: nil,
+					Operations: []api.ScimPatchOperation{
+						{Op: "replace", Path: ptr("active"), Value: anyValue(false)},
+					},
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "404",
+				ScimType: nil,
+				Detail:   ptr("User not found"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PatchScimV2UsersUserId,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PatchUserMetadata( //nolint:ireturn
+	ctx context.Context,
+	request api.PatchUserMetadataRequestObject,
+) (api.PatchUserMetadataResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	metadata, apiErr := ctrl.wf.UpdateUserMetadata(ctx, user, *request.Body, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PatchUserMetadata200JSONResponse(metadata), nil
+}
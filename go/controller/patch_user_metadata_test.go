@@ -0,0 +1,201 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getUserMetadataSchemaConfig() *controller.Config {
+	config := getConfig()
+	config.UserMetadataSchema = `{
+		"type": "object",
+		"properties": {
+			"theme": {"type": "string", "enum": ["light", "dark"]},
+			"notifications": {
+				"type": "object",
+				"properties": {"email": {"type": "boolean"}}
+			}
+		}
+	}`
+	return config
+}
+
+func TestPatchUserMetadata(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	userWithMetadata := func(metadata string) sql.AuthUser {
+		user := getSigninUser(userID)
+		user.Metadata = []byte(metadata)
+		return user
+	}
+
+	cases := []testRequest[api.PatchUserMetadataRequestObject, api.PatchUserMetadataResponseObject]{
+		{
+			name:   "merge",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(userWithMetadata(
+					`{"theme":"dark","language":"en","notifications":{"email":true,"sms":true}}`,
+				), nil)
+
+				mock.EXPECT().UpdateUserMetadata(
+					gomock.Any(),
+					sql.UpdateUserMetadataParams{
+						ID:       userID,
+						Metadata: []byte(`{"notifications":{"email":false},"theme":"light"}`),
+					},
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PatchUserMetadataRequestObject{
+				Body: &api.UserMetadataPatch{
+					"theme":    "light",
+					"language": nil,
+					"notifications": map[string]any{
+						"email": false,
+						"sms":   nil,
+					},
+				},
+			},
+			expectedResponse: api.PatchUserMetadata200JSONResponse{
+				"theme": "light",
+				"notifications": map[string]any{
+					"email": false,
+				},
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "replace object",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(userWithMetadata(`{"tags":{"a":1}}`), nil)
+
+				mock.EXPECT().UpdateUserMetadata(
+					gomock.Any(),
+					sql.UpdateUserMetadataParams{
+						ID:       userID,
+						Metadata: []byte(`{"tags":["a","b"]}`),
+					},
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PatchUserMetadataRequestObject{
+				Body: &api.UserMetadataPatch{
+					"tags": []any{"a", "b"},
+				},
+			},
+			expectedResponse: api.PatchUserMetadata200JSONResponse{
+				"tags": []any{"a", "b"},
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "matches schema",
+			config: getUserMetadataSchemaConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserMetadata(
+					gomock.Any(),
+					sql.UpdateUserMetadataParams{
+						ID:       userID,
+						Metadata: []byte(`{"theme":"dark"}`),
+					},
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PatchUserMetadataRequestObject{
+				Body: &api.UserMetadataPatch{
+					"theme": "dark",
+				},
+			},
+			expectedResponse: api.PatchUserMetadata200JSONResponse{
+				"theme": "dark",
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "doesn't match schema",
+			config: getUserMetadataSchemaConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(userWithMetadata(`{"theme":"dark"}`), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PatchUserMetadataRequestObject{
+				Body: &api.UserMetadataPatch{
+					"notifications": map[string]any{
+						"email": "yes",
+					},
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-metadata",
+				Message: "notifications/email: value must be a boolean",
+				Status:  400,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.PatchUserMetadata, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminConfigReload( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostAdminConfigReloadRequestObject,
+) (api.PostAdminConfigReloadResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if ctrl.configReloader == nil {
+		logger.Warn("configuration reload is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	changed, ignored, err := ctrl.configReloader.Reload(ctx)
+	if err != nil {
+		logger.Warn("problem reloading the configuration", logError(err))
+		return ctrl.sendError(invalidConfigurationError(err)), nil
+	}
+
+	logger.Info(
+		"configuration reloaded",
+		slog.Any("changed", changed),
+		slog.Any("ignored", ignored),
+	)
+
+	return api.PostAdminConfigReload200JSONResponse{
+		Changed: changed,
+		Ignored: ignored,
+	}, nil
+}
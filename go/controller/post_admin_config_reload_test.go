@@ -0,0 +1,123 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+type fakeConfigReloader struct {
+	changed []string
+	ignored []string
+	err     error
+}
+
+func (f fakeConfigReloader) Reload(context.Context) ([]string, []string, error) {
+	return f.changed, f.ignored, f.err
+}
+
+func TestPostAdminConfigReload(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		reloader         controller.ConfigReloader
+		expectedResponse api.PostAdminConfigReloadResponseObject
+	}{
+		{
+			name: "success",
+			reloader: fakeConfigReloader{
+				changed: []string{"AUTH_ACCESS_CONTROL_ALLOWED_REDIRECT_URLS"},
+				ignored: []string{"AUTH_PORT"},
+				err:     nil,
+			},
+			expectedResponse: api.PostAdminConfigReload200JSONResponse{
+				Changed: []string{"AUTH_ACCESS_CONTROL_ALLOWED_REDIRECT_URLS"},
+				Ignored: []string{"AUTH_PORT"},
+			},
+		},
+
+		{
+			name: "nothing changed",
+			reloader: fakeConfigReloader{
+				changed: []string{},
+				ignored: []string{},
+				err:     nil,
+			},
+			expectedResponse: api.PostAdminConfigReload200JSONResponse{
+				Changed: []string{},
+				Ignored: []string{},
+			},
+		},
+
+		{
+			name: "invalid configuration",
+			reloader: fakeConfigReloader{
+				changed: nil,
+				ignored: nil,
+				err:     errors.New("config file: line 3: missing ="), //nolint:goerr113
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-configuration",
+				Message: "The configuration is invalid: config file: line 3: missing =",
+				Status:  400,
+			},
+		},
+
+		{
+			name:     "disabled",
+			reloader: nil,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				getConfig,
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   tc.reloader,
+					signInMethods:    nil,
+				},
+			)
+
+			assertRequest(
+				context.Background(), t, c.PostAdminConfigReload,
+				api.PostAdminConfigReloadRequestObject{}, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminEmailsEmailIdRetry( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminEmailsEmailIdRetryRequestObject,
+) (api.PostAdminEmailsEmailIdRetryResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("email_id", request.EmailId.String()))
+
+	n, err := ctrl.wf.db.RetryEmailOutbox(ctx, request.EmailId)
+	if err != nil {
+		logger.Error("error queueing email", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if n == 0 {
+		logger.Warn("failed email not found")
+		return ctrl.sendError(ErrEmailNotFound), nil
+	}
+
+	logger.Info("failed email queued to be sent again")
+
+	return api.PostAdminEmailsEmailIdRetry200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,102 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminEmailsEmailIdRetry(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	emailID := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+
+	cases := []testRequest[
+		api.PostAdminEmailsEmailIdRetryRequestObject,
+		api.PostAdminEmailsEmailIdRetryResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().RetryEmailOutbox(gomock.Any(), emailID).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminEmailsEmailIdRetryRequestObject{
+				EmailId: emailID,
+			},
+			expectedResponse: api.PostAdminEmailsEmailIdRetry200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "email not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().RetryEmailOutbox(gomock.Any(), emailID).Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminEmailsEmailIdRetryRequestObject{
+				EmailId: emailID,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "email-not-found",
+				Message: "Email not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostAdminEmailsEmailIdRetry,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
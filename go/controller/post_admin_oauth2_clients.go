@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// oauth2ClientGrantTypes returns the grant types of the request or, if it has none,
+// authorization_code for clients with redirect URIs and client_credentials for the others.
+func oauth2ClientGrantTypes(
+	grantTypes *[]api.CreateOAuth2ClientRequestGrantTypes, redirectURIs []string,
+) []string {
+	if grantTypes == nil {
+		if len(redirectURIs) > 0 {
+			return []string{string(api.CreateOAuth2ClientRequestGrantTypesAuthorizationCode)}
+		}
+		return []string{string(api.CreateOAuth2ClientRequestGrantTypesClientCredentials)}
+	}
+
+	types := make([]string, 0, len(*grantTypes))
+	for _, grantType := range *grantTypes {
+		if !slices.Contains(types, string(grantType)) {
+			types = append(types, string(grantType))
+		}
+	}
+	return types
+}
+
+func (ctrl *Controller) PostAdminOauth2Clients( //nolint:ireturn
+	ctx context.Context,
+	request api.PostAdminOauth2ClientsRequestObject,
+) (api.PostAdminOauth2ClientsResponseObject, error) {
+	clientID := uuid.NewString()
+	logger := middleware.LoggerFromContext(ctx).With(slog.String("client_id", clientID))
+
+	if !slices.Contains(request.Body.AllowedRoles, request.Body.DefaultRole) {
+		logger.Warn("default role is not in allowed roles")
+		return ctrl.sendError(ErrDefaultRoleMustBeInAllowedRoles), nil
+	}
+
+	clientSecret, err := generateClientSecret()
+	if err != nil {
+		logger.Error("error generating client secret", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	redirectURIs := deptr(request.Body.RedirectUris)
+	if redirectURIs == nil {
+		redirectURIs = []string{}
+	}
+
+	grantTypes := oauth2ClientGrantTypes(request.Body.GrantTypes, redirectURIs)
+	if slices.Contains(
+		grantTypes, string(api.CreateOAuth2ClientRequestGrantTypesAuthorizationCode),
+	) && len(redirectURIs) == 0 {
+		logger.Warn("authorization code grant without redirect uris")
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	if err := ctrl.wf.db.InsertOAuth2Client(ctx, sql.InsertOAuth2ClientParams{
+		ClientID:             clientID,
+		ClientSecretHash:     hashRefreshToken([]byte(clientSecret)),
+		Description:          deptr(request.Body.Description),
+		DefaultRole:          request.Body.DefaultRole,
+		AllowedRoles:         request.Body.AllowedRoles,
+		TokenExchangeEnabled: deptr(request.Body.TokenExchangeEnabled),
+		RedirectUris:         redirectURIs,
+		SkipConsent:          deptr(request.Body.SkipConsent),
+		GrantTypes:           grantTypes,
+	}); err != nil {
+		// the default role references auth.roles
+		if sqlErrIsForeignKeyViolation(err) {
+			logger.Warn("default role doesn't exist", logError(err))
+			return ctrl.sendError(ErrRoleNotAllowed), nil
+		}
+		logger.Error("error inserting oauth2 client", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	logger.Info("oauth2 client registered")
+
+	return api.PostAdminOauth2Clients200JSONResponse{
+		ClientId:     clientID,
+		ClientSecret: clientSecret,
+	}, nil
+}
@@ -0,0 +1,311 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminOauth2Clients(t *testing.T) {
+	t.Parallel()
+
+	cases := []testRequest[
+		api.PostAdminOauth2ClientsRequestObject, api.PostAdminOauth2ClientsResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertOAuth2Client(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertOAuth2ClientParams{
+							ClientID:             "",
+							ClientSecretHash:     "",
+							Description:          "Reporting service",
+							DefaultRole:          "service",
+							AllowedRoles:         []string{"service", "reports"},
+							TokenExchangeEnabled: true,
+							RedirectUris:         []string{},
+							SkipConsent:          false,
+							GrantTypes:           []string{"client_credentials"},
+						},
+						cmpopts.IgnoreFields(
+							sql.InsertOAuth2ClientParams{}, //nolint:exhaustruct
+							"ClientID", "ClientSecretHash",
+						),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminOauth2ClientsRequestObject{
+				Body: &api.CreateOAuth2ClientRequest{
+					Description:          ptr("Reporting service"),
+					DefaultRole:          "service",
+					AllowedRoles:         []string{"service", "reports"},
+					TokenExchangeEnabled: ptr(true),
+					RedirectUris:         nil,
+					SkipConsent:          nil,
+					GrantTypes:           nil,
+				},
+			},
+			expectedResponse: api.PostAdminOauth2Clients200JSONResponse{
+				ClientId:     "",
+				ClientSecret: "",
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "openid connect client",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertOAuth2Client(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertOAuth2ClientParams{
+							ClientID:             "",
+							ClientSecretHash:     "",
+							Description:          "Dashboard",
+							DefaultRole:          "user",
+							AllowedRoles:         []string{"user"},
+							TokenExchangeEnabled: false,
+							RedirectUris:         []string{"https://dashboard.example.com/callback"},
+							SkipConsent:          true,
+							GrantTypes:           []string{"authorization_code"},
+						},
+						cmpopts.IgnoreFields(
+							sql.InsertOAuth2ClientParams{}, //nolint:exhaustruct
+							"ClientID", "ClientSecretHash",
+						),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminOauth2ClientsRequestObject{
+				Body: &api.CreateOAuth2ClientRequest{
+					Description:          ptr("Dashboard"),
+					DefaultRole:          "user",
+					AllowedRoles:         []string{"user"},
+					TokenExchangeEnabled: nil,
+					RedirectUris:         &[]string{"https://dashboard.example.com/callback"},
+					SkipConsent:          ptr(true),
+					GrantTypes:           nil,
+				},
+			},
+			expectedResponse: api.PostAdminOauth2Clients200JSONResponse{
+				ClientId:     "",
+				ClientSecret: "",
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+		{
+			name:   "grant types",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertOAuth2Client(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertOAuth2ClientParams{
+							ClientID:             "",
+							ClientSecretHash:     "",
+							Description:          "Dashboard",
+							DefaultRole:          "user",
+							AllowedRoles:         []string{"user"},
+							TokenExchangeEnabled: false,
+							RedirectUris:         []string{"https://dashboard.example.com/callback"},
+							SkipConsent:          false,
+							GrantTypes:           []string{"authorization_code", "client_credentials"},
+						},
+						cmpopts.IgnoreFields(
+							sql.InsertOAuth2ClientParams{}, //nolint:exhaustruct
+							"ClientID", "ClientSecretHash",
+						),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminOauth2ClientsRequestObject{
+				Body: &api.CreateOAuth2ClientRequest{
+					Description:          ptr("Dashboard"),
+					DefaultRole:          "user",
+					AllowedRoles:         []string{"user"},
+					TokenExchangeEnabled: nil,
+					RedirectUris:         &[]string{"https://dashboard.example.com/callback"},
+					SkipConsent:          nil,
+					GrantTypes: &[]api.CreateOAuth2ClientRequestGrantTypes{
+						api.CreateOAuth2ClientRequestGrantTypesAuthorizationCode,
+						api.CreateOAuth2ClientRequestGrantTypesClientCredentials,
+						api.CreateOAuth2ClientRequestGrantTypesAuthorizationCode,
+					},
+				},
+			},
+			expectedResponse: api.PostAdminOauth2Clients200JSONResponse{
+				ClientId:     "",
+				ClientSecret: "",
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code without redirect uris",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminOauth2ClientsRequestObject{
+				Body: &api.CreateOAuth2ClientRequest{
+					Description:          nil,
+					DefaultRole:          "user",
+					AllowedRoles:         []string{"user"},
+					TokenExchangeEnabled: nil,
+					RedirectUris:         nil,
+					SkipConsent:          nil,
+					GrantTypes: &[]api.CreateOAuth2ClientRequestGrantTypes{
+						api.CreateOAuth2ClientRequestGrantTypesAuthorizationCode,
+					},
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "default role not in allowed roles",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminOauth2ClientsRequestObject{
+				Body: &api.CreateOAuth2ClientRequest{
+					Description:          nil,
+					DefaultRole:          "admin",
+					AllowedRoles:         []string{"service", "reports"},
+					TokenExchangeEnabled: nil,
+					RedirectUris:         nil,
+					SkipConsent:          nil,
+					GrantTypes:           nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "default-role-must-be-in-allowed-roles",
+				Message: "Default role must be in allowed roles",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "role doesn't exist",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertOAuth2Client(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New( //nolint:goerr113
+					`ERROR: insert or update on table "oauth2_clients" violates foreign key constraint "fk_default_role" (SQLSTATE 23503)`, //nolint:lll
+				))
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminOauth2ClientsRequestObject{
+				Body: &api.CreateOAuth2ClientRequest{
+					Description:          nil,
+					DefaultRole:          "service",
+					AllowedRoles:         []string{"service"},
+					TokenExchangeEnabled: nil,
+					RedirectUris:         nil,
+					SkipConsent:          nil,
+					GrantTypes:           nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostAdminOauth2Clients, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.PostAdminOauth2Clients200JSONResponse{}, //nolint:exhaustruct
+					"ClientId", "ClientSecret",
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminRoles( //nolint:ireturn
+	ctx context.Context,
+	request api.PostAdminRolesRequestObject,
+) (api.PostAdminRolesResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.Body.Role))
+
+	if apiErr := ctrl.wf.CreateRole(ctx, request.Body.Role, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminRoles200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// importUsersBatchSize is how many users are inserted by each transaction of an import.
+const importUsersBatchSize = 100
+
+type importUsersBatch struct {
+	params sql.ImportUsersParams
+	lines  map[uuid.UUID]int
+	emails map[uuid.UUID]string
+}
+
+func newImportUsersBatch() *importUsersBatch {
+	return &importUsersBatch{
+		params: sql.ImportUsersParams{}, //nolint:exhaustruct
+		lines:  make(map[uuid.UUID]int, importUsersBatchSize),
+		emails: make(map[uuid.UUID]string, importUsersBatchSize),
+	}
+}
+
+func (b *importUsersBatch) add(
+	line int, id uuid.UUID, user importUser, metadata []byte, createdAt time.Time,
+) {
+	b.lines[id] = line
+	b.emails[id] = user.Email
+
+	b.params.Ids = append(b.params.Ids, id)
+	b.params.CreatedAts = append(b.params.CreatedAts, sql.TimestampTz(createdAt))
+	b.params.Emails = append(b.params.Emails, user.Email)
+	b.params.EmailVerified = append(b.params.EmailVerified, user.EmailVerified)
+	b.params.PasswordHashes = append(b.params.PasswordHashes, user.PasswordHash)
+	b.params.PhoneNumbers = append(b.params.PhoneNumbers, user.PhoneNumber)
+	b.params.PhoneNumberVerified = append(b.params.PhoneNumberVerified, user.PhoneNumberVerified)
+	b.params.DisplayNames = append(b.params.DisplayNames, user.DisplayName)
+	b.params.AvatarUrls = append(b.params.AvatarUrls, user.AvatarURL)
+	b.params.Locales = append(b.params.Locales, user.Locale)
+	b.params.DefaultRoles = append(b.params.DefaultRoles, user.DefaultRole)
+	b.params.Metadata = append(b.params.Metadata, metadata)
+	b.params.Disabled = append(b.params.Disabled, user.Disabled)
+	for _, role := range user.Roles {
+		b.params.RoleUserIds = append(b.params.RoleUserIds, id)
+		b.params.Roles = append(b.params.Roles, role)
+	}
+}
+
+func validImportPasswordHash(hash string) bool {
+	return hash == "" || validPasswordHash(hash)
+}
+
+// prepareImportUser validates the user and sets the defaults of the missing properties.
+func (ctrl *Controller) prepareImportUser(user importUser, roles []string) (importUser, error) {
+	address, err := mail.ParseAddress(user.Email)
+	if err != nil || address.Address != user.Email {
+		return user, errors.New("invalid email") //nolint:goerr113
+	}
+
+	if !validImportPasswordHash(user.PasswordHash) {
+		return user, errors.New("password hash isn't a bcrypt or argon2 hash") //nolint:goerr113
+	}
+
+	if user.DisplayName == "" {
+		user.DisplayName = user.Email
+	}
+	if user.Locale == "" {
+		user.Locale = ctrl.config.DefaultLocale
+	}
+	if user.DefaultRole == "" {
+		user.DefaultRole = ctrl.config.DefaultRole
+	}
+	if user.Roles == nil {
+		user.Roles = ctrl.config.DefaultAllowedRoles
+	}
+	if user.Metadata == nil {
+		user.Metadata = map[string]any{}
+	}
+
+	if !slices.Contains(user.Roles, user.DefaultRole) {
+		return user, errors.New("default role must be one of the roles") //nolint:goerr113
+	}
+	for _, role := range user.Roles {
+		if !slices.Contains(roles, role) {
+			return user, fmt.Errorf("role %s doesn't exist", role) //nolint:goerr113
+		}
+	}
+
+	return user, nil
+}
+
+func (ctrl *Controller) insertImportUsersBatch(
+	ctx context.Context,
+	batch *importUsersBatch,
+	resp *api.ImportUsersResponse,
+	logger *slog.Logger,
+) {
+	if len(batch.params.Ids) == 0 {
+		return
+	}
+
+	inserted, err := ctrl.wf.db.ImportUsers(ctx, batch.params)
+	if err != nil {
+		logger.Error("error importing users", logError(err))
+	}
+
+	for _, id := range inserted {
+		delete(batch.lines, id)
+	}
+	resp.Imported += len(inserted)
+
+	reason := "user already exists"
+	if err != nil {
+		reason = "error inserting the user"
+	}
+	for _, id := range batch.params.Ids {
+		line, ok := batch.lines[id]
+		if !ok {
+			continue
+		}
+		resp.Skipped++
+		resp.Errors = append(resp.Errors, api.ImportUserError{
+			Line:  line,
+			Email: ptr(batch.emails[id]),
+			Error: reason,
+		})
+	}
+}
+
+func (ctrl *Controller) importUsersReader(
+	request api.PostAdminUsersImportRequestObject,
+) (importUsersReader, error) {
+	if request.Params.Format != nil && *request.Params.Format == api.Csv {
+		return newCSVUsersReader(request.Body)
+	}
+	return newNDJSONUsersReader(request.Body), nil
+}
+
+func (ctrl *Controller) PostAdminUsersImport( //nolint:ireturn,funlen
+	ctx context.Context,
+	request api.PostAdminUsersImportRequestObject,
+) (api.PostAdminUsersImportResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	reader, err := ctrl.importUsersReader(request)
+	if err != nil {
+		logger.Warn("invalid import", logError(err))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	catalog, err := ctrl.wf.db.GetRoles(ctx)
+	if err != nil {
+		logger.Error("error getting roles", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+	roles := make([]string, len(catalog))
+	for i, role := range catalog {
+		roles[i] = role.Role
+	}
+
+	resp := api.ImportUsersResponse{
+		Imported: 0,
+		Skipped:  0,
+		Errors:   []api.ImportUserError{},
+	}
+	skip := func(line int, email string, err error) {
+		var e *string
+		if email != "" {
+			e = &email
+		}
+		resp.Skipped++
+		resp.Errors = append(resp.Errors, api.ImportUserError{
+			Line:  line,
+			Email: e,
+			Error: err.Error(),
+		})
+	}
+
+	batch := newImportUsersBatch()
+	for {
+		line, user, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var recordErr *importRecordError
+		if errors.As(err, &recordErr) {
+			skip(line, user.Email, recordErr)
+			continue
+		}
+		if err != nil {
+			logger.Warn("error reading import", logError(err))
+			return ctrl.sendError(ErrInvalidRequest), nil
+		}
+
+		user, err = ctrl.prepareImportUser(user, roles)
+		if err != nil {
+			skip(line, user.Email, err)
+			continue
+		}
+
+		metadata, err := json.Marshal(user.Metadata)
+		if err != nil {
+			skip(line, user.Email, fmt.Errorf("invalid metadata: %w", err))
+			continue
+		}
+
+		id := deptr(user.ID)
+		if user.ID == nil {
+			id = uuid.New()
+		}
+		if _, ok := batch.lines[id]; ok {
+			skip(line, user.Email, errors.New("duplicated id")) //nolint:goerr113
+			continue
+		}
+
+		createdAt := time.Now()
+		if user.CreatedAt != nil {
+			createdAt = *user.CreatedAt
+		}
+
+		batch.add(line, id, user, metadata, createdAt)
+		if len(batch.params.Ids) == importUsersBatchSize {
+			ctrl.insertImportUsersBatch(ctx, batch, &resp, logger)
+			batch = newImportUsersBatch()
+		}
+	}
+	ctrl.insertImportUsersBatch(ctx, batch, &resp, logger)
+
+	logger.Info(
+		"users imported",
+		slog.Int("imported", resp.Imported),
+		slog.Int("skipped", resp.Skipped),
+	)
+
+	return api.PostAdminUsersImport200JSONResponse(resp), nil
+}
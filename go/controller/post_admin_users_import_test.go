@@ -0,0 +1,238 @@
+package controller_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminUsersImport(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID1 := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+	userID2 := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	createdAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	argon2Hash := "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHRzb21lc2FsdA$gduXp+Z6iReEolmbyHn5V8s1EtJzmEvZfYoY/Fn/AeI" //nolint:lll
+	bcryptHash := "$2a$10$WiE5Qp9CSyDr35watk7t3epxwri50m8zNvjM2sgZCRlYebePbeufq"
+
+	getRoles := func(mock *mock.MockDBClient) {
+		mock.EXPECT().GetRoles(gomock.Any()).Return([]sql.GetRolesRow{
+			{Role: "editor", Users: 0},
+			{Role: "me", Users: 0},
+			{Role: "user", Users: 0},
+		}, nil)
+	}
+
+	cases := []testRequest[
+		api.PostAdminUsersImportRequestObject,
+		api.PostAdminUsersImportResponseObject,
+	]{
+		{
+			name:   "ndjson",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getRoles(mock)
+
+				mock.EXPECT().ImportUsers(gomock.Any(), sql.ImportUsersParams{
+					Ids:                 []uuid.UUID{userID1, userID2},
+					CreatedAts:          []pgtype.Timestamptz{sql.TimestampTz(createdAt), sql.TimestampTz(createdAt)},
+					Emails:              []string{"john@acme.com", "jane@acme.com"},
+					EmailVerified:       []bool{true, false},
+					PasswordHashes:      []string{argon2Hash, bcryptHash},
+					PhoneNumbers:        []string{"", "+34600000000"},
+					PhoneNumberVerified: []bool{false, true},
+					DisplayNames:        []string{"john@acme.com", "Jane"},
+					AvatarUrls:          []string{"", ""},
+					Locales:             []string{"en", "es"},
+					DefaultRoles:        []string{"user", "editor"},
+					Metadata:            [][]byte{[]byte(`{}`), []byte(`{"plan":"pro"}`)},
+					Disabled:            []bool{false, true},
+					RoleUserIds:         []uuid.UUID{userID1, userID1, userID2},
+					Roles:               []string{"user", "me", "editor"},
+				}).Return([]uuid.UUID{userID1, userID2}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersImportRequestObject{
+				Params: api.PostAdminUsersImportParams{
+					Format: nil,
+				},
+				Body: strings.NewReader(
+					`{"id":"2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24","email":"john@acme.com","emailVerified":true,"passwordHash":"` + argon2Hash + `","createdAt":"2020-01-02T03:04:05Z"}` + "\n" + //nolint:lll
+						"\n" +
+						`{"id":"db477732-48fa-4289-b694-2886a646b6eb","email":"jane@acme.com","passwordHash":"` + bcryptHash + `","phoneNumber":"+34600000000","phoneNumberVerified":true,"displayName":"Jane","locale":"es","defaultRole":"editor","roles":["editor"],"metadata":{"plan":"pro"},"createdAt":"2020-01-02T03:04:05Z","disabled":true}` + "\n", //nolint:lll
+				),
+			},
+			expectedResponse: api.PostAdminUsersImport200JSONResponse{
+				Imported: 2,
+				Skipped:  0,
+				Errors:   []api.ImportUserError{},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "csv with invalid and existing users",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getRoles(mock)
+
+				mock.EXPECT().ImportUsers(gomock.Any(), sql.ImportUsersParams{
+					Ids:                 []uuid.UUID{userID1},
+					CreatedAts:          []pgtype.Timestamptz{sql.TimestampTz(createdAt)},
+					Emails:              []string{"john@acme.com"},
+					EmailVerified:       []bool{true},
+					PasswordHashes:      []string{bcryptHash},
+					PhoneNumbers:        []string{""},
+					PhoneNumberVerified: []bool{false},
+					DisplayNames:        []string{"John"},
+					AvatarUrls:          []string{""},
+					Locales:             []string{"en"},
+					DefaultRoles:        []string{"user"},
+					Metadata:            [][]byte{[]byte(`{"plan":"pro"}`)},
+					Disabled:            []bool{false},
+					RoleUserIds:         []uuid.UUID{userID1, userID1},
+					Roles:               []string{"user", "me"},
+				}).Return([]uuid.UUID{}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersImportRequestObject{
+				Params: api.PostAdminUsersImportParams{
+					Format: ptr(api.Csv),
+				},
+				Body: strings.NewReader(
+					"id,email,emailVerified,passwordHash,displayName,roles,metadata,createdAt\n" +
+						"2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24,john@acme.com,true," + bcryptHash + `,John,user me,"{""plan"":""pro""}",2020-01-02T03:04:05Z` + "\n" + //nolint:lll
+						",not an email,,,,,,\n" +
+						",jane@acme.com,,notahash,,,,\n" +
+						",jim@acme.com,,,,admin,,\n",
+				),
+			},
+			expectedResponse: api.PostAdminUsersImport200JSONResponse{
+				Imported: 0,
+				Skipped:  4,
+				Errors: []api.ImportUserError{
+					{Line: 3, Email: ptr("not an email"), Error: "invalid email"},
+					{Line: 4, Email: ptr("jane@acme.com"), Error: "password hash isn't a bcrypt or argon2 hash"},
+					{Line: 5, Email: ptr("jim@acme.com"), Error: "default role must be one of the roles"},
+					{Line: 2, Email: ptr("john@acme.com"), Error: "user already exists"},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "invalid json",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getRoles(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersImportRequestObject{
+				Params: api.PostAdminUsersImportParams{
+					Format: ptr(api.Ndjson),
+				},
+				Body: strings.NewReader(
+					`{"email":"john@acme.com","unknown":true}` + "\n" +
+						`{"email":"jane@acme.com","roles":["admin","user"]}`,
+				),
+			},
+			expectedResponse: api.PostAdminUsersImport200JSONResponse{
+				Imported: 0,
+				Skipped:  2,
+				Errors: []api.ImportUserError{
+					{Line: 1, Email: ptr("john@acme.com"), Error: `invalid JSON: json: unknown field "unknown"`},
+					{Line: 2, Email: ptr("jane@acme.com"), Error: "role admin doesn't exist"},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unknown csv column",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersImportRequestObject{
+				Params: api.PostAdminUsersImportParams{
+					Format: ptr(api.Csv),
+				},
+				Body: strings.NewReader("email,password\njohn@acme.com,password\n"),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostAdminUsersImport,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
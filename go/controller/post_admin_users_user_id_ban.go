@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminUsersUserIdBan( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminUsersUserIdBanRequestObject,
+) (api.PostAdminUsersUserIdBanResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if request.Body.ExpiresAt != nil && !request.Body.ExpiresAt.After(time.Now()) {
+		logger.Warn("ban expiration is in the past")
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	if apiErr := ctrl.wf.BanUser(
+		ctx, request.UserId, request.Body.Reason, request.Body.ExpiresAt, logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminUsersUserIdBan200JSONResponse(api.OK), nil
+}
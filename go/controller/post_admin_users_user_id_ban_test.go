@@ -0,0 +1,148 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminUsersUserIdBan(t *testing.T) { //nolint:revive,stylecheck
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	cases := []testRequest[
+		api.PostAdminUsersUserIdBanRequestObject,
+		api.PostAdminUsersUserIdBanResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().BanUser(gomock.Any(), sql.BanUserParams{
+					BannedUntil: sql.TimestampTz(expiresAt),
+					BanReason:   sql.Text("spam"),
+					ID:          userID,
+				}).Return(int64(1), nil)
+
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdBanRequestObject{
+				UserId: userID,
+				Body: &api.BanUserRequest{
+					Reason:    "spam",
+					ExpiresAt: ptr(expiresAt),
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdBan200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().BanUser(gomock.Any(), sql.BanUserParams{
+					BannedUntil: sql.TimestampTz(expiresAt),
+					BanReason:   sql.Text("spam"),
+					ID:          userID,
+				}).Return(int64(0), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdBanRequestObject{
+				UserId: userID,
+				Body: &api.BanUserRequest{
+					Reason:    "spam",
+					ExpiresAt: ptr(expiresAt),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "user-not-found",
+				Message: "User not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "expiration in the past",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdBanRequestObject{
+				UserId: userID,
+				Body: &api.BanUserRequest{
+					Reason:    "spam",
+					ExpiresAt: ptr(time.Now().Add(-time.Hour)),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostAdminUsersUserIdBan,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
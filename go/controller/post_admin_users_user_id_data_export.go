@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminUsersUserIdDataExport( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminUsersUserIdDataExportRequestObject,
+) (api.PostAdminUsersUserIdDataExportResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if _, apiErr := ctrl.wf.adminGetUser(ctx, request.UserId, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	export, apiErr := ctrl.wf.RequestDataExport(
+		ctx, request.UserId, dataExportRequestedByAdmin, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	resp, apiErr := ctrl.wf.DataExportResponse(export, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminUsersUserIdDataExport200JSONResponse(resp), nil
+}
@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminUsersUserIdEnable( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminUsersUserIdEnableRequestObject,
+) (api.PostAdminUsersUserIdEnableResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if apiErr := ctrl.wf.EnableUser(ctx, request.UserId, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminUsersUserIdEnable200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminUsersUserIdImpersonate( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminUsersUserIdImpersonateRequestObject,
+) (api.PostAdminUsersUserIdImpersonateResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	resp, apiErr := ctrl.wf.Impersonate(
+		ctx, request.UserId, request.Body.ImpersonatedBy, request.Body.Role, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminUsersUserIdImpersonate200JSONResponse(*resp), nil
+}
@@ -0,0 +1,237 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminUsersUserIdImpersonate(t *testing.T) { //nolint:revive,stylecheck,maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	impersonateUser := func(ctrl *gomock.Controller) *mock.MockDBClient {
+		mock := mock.NewMockDBClient(ctrl)
+
+		mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "user"}, //nolint:exhaustruct
+			{UserID: userID, Role: "me"},   //nolint:exhaustruct
+		}, nil)
+
+		return mock
+	}
+
+	impersonatedUser := func(defaultRole string, roles []string) api.User {
+		return api.User{
+			AvatarUrl:           "",
+			CreatedAt:           time.Now(),
+			DefaultRole:         defaultRole,
+			DisplayName:         "Jane Doe",
+			Email:               ptr(types.Email("jane@acme.com")),
+			EmailVerified:       true,
+			Id:                  userID.String(),
+			IsAnonymous:         false,
+			Locale:              "en",
+			Metadata:            map[string]any{},
+			PhoneNumber:         "",
+			PhoneNumberVerified: false,
+			Roles:               roles,
+		}
+	}
+
+	impersonationJWT := func(defaultRole string, roles []any) *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     roles,
+					"x-hasura-default-role":      defaultRole,
+					"x-hasura-impersonated-by":   "admin@acme.com",
+					"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	cases := []testRequest[
+		api.PostAdminUsersUserIdImpersonateRequestObject,
+		api.PostAdminUsersUserIdImpersonateResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return impersonateUser(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdImpersonateRequestObject{
+				UserId: userID,
+				Body: &api.ImpersonateUserRequest{
+					ImpersonatedBy: "admin@acme.com",
+					Role:           nil,
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdImpersonate200JSONResponse{
+				AccessToken:          "",
+				AccessTokenExpiresIn: 900,
+				User:                 impersonatedUser("user", []string{"user", "me"}),
+			},
+			expectedJWT: impersonationJWT("user", []any{"user", "me"}),
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "reduced role",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return impersonateUser(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdImpersonateRequestObject{
+				UserId: userID,
+				Body: &api.ImpersonateUserRequest{
+					ImpersonatedBy: "admin@acme.com",
+					Role:           ptr("me"),
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdImpersonate200JSONResponse{
+				AccessToken:          "",
+				AccessTokenExpiresIn: 900,
+				User:                 impersonatedUser("me", []string{"me"}),
+			},
+			expectedJWT: impersonationJWT("me", []any{"me"}),
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "role not allowed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return impersonateUser(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdImpersonateRequestObject{
+				UserId: userID,
+				Body: &api.ImpersonateUserRequest{
+					ImpersonatedBy: "admin@acme.com",
+					Role:           ptr("admin"),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdImpersonateRequestObject{
+				UserId: userID,
+				Body: &api.ImpersonateUserRequest{
+					ImpersonatedBy: "admin@acme.com",
+					Role:           nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "user-not-found",
+				Message: "User not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostAdminUsersUserIdImpersonate,
+				tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.PostAdminUsersUserIdImpersonate200JSONResponse{}, //nolint:exhaustruct
+					"AccessToken",
+				),
+			)
+
+			if resp200, ok := resp.(api.PostAdminUsersUserIdImpersonate200JSONResponse); ok {
+				assertSession(t, jwtGetter, &api.Session{ //nolint:exhaustruct
+					AccessToken: resp200.AccessToken,
+				}, tc.expectedJWT)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminUsersUserIdPassword( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminUsersUserIdPasswordRequestObject,
+) (api.PostAdminUsersUserIdPasswordResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	if apiErr := ctrl.wf.SetUserPassword(
+		ctx,
+		request.UserId,
+		request.Body.Password,
+		request.Body.PasswordHash,
+		deptr(request.Body.RevokeSessions),
+		logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminUsersUserIdPassword200JSONResponse(api.OK), nil
+}
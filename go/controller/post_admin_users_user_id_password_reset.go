@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostAdminUsersUserIdPasswordReset( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostAdminUsersUserIdPasswordResetRequestObject,
+) (api.PostAdminUsersUserIdPasswordResetResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	options, apiErr := ctrl.wf.ValidateOptionsRedirectTo(request.Body.Options, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.ForcePasswordReset(
+		ctx, request.UserId, deptr(options.RedirectTo), logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostAdminUsersUserIdPasswordReset200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,225 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminUsersUserIdPasswordReset(t *testing.T) { //nolint:revive,stylecheck,maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[
+		api.PostAdminUsersUserIdPasswordResetRequestObject,
+		api.PostAdminUsersUserIdPasswordResetResponseObject,
+	]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserPasswordHash(
+					gomock.Any(),
+					sql.UpdateUserPasswordHashParams{
+						PasswordHash: pgtype.Text{}, //nolint:exhaustruct
+						ID:           userID,
+					},
+				).Return(int64(1), nil)
+
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(1), nil)
+
+				mock.EXPECT().UpdateUserTicket(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserTicketParams{
+						ID:              userID,
+						Ticket:          sql.Text("passwordReset:xxx"),
+						TicketExpiresAt: sql.TimestampTz(time.Now().Add(24 * time.Hour)),
+					}),
+				).Return(userID, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNamePasswordReset,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordReset%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=passwordReset", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordReset:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordResetRequestObject{
+				UserId: userID,
+				Body: &api.AdminPasswordResetRequest{
+					Options: nil,
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdPasswordReset200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "user without email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.Email = pgtype.Text{} //nolint:exhaustruct
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordResetRequestObject{
+				UserId: userID,
+				Body: &api.AdminPasswordResetRequest{
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "redirectTo not allowed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordResetRequestObject{
+				UserId: userID,
+				Body: &api.AdminPasswordResetRequest{
+					Options: &api.OptionsRedirectTo{
+						RedirectTo: ptr("https://evil.com"),
+					},
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "redirectTo-not-allowed",
+				Message: `The value of "options.redirectTo" is not allowed.`,
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordResetRequestObject{
+				UserId: userID,
+				Body: &api.AdminPasswordResetRequest{
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "user-not-found",
+				Message: "User not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostAdminUsersUserIdPasswordReset,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
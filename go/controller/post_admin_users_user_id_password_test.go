@@ -0,0 +1,374 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostAdminUsersUserIdPassword(t *testing.T) { //nolint:revive,stylecheck,maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	passwordHash := "$2a$10$WiE5Qp9CSyDr35watk7t3epxwri50m8zNvjM2sgZCRlYebePbeufq"
+	argon2Hash := "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHRzb21lc2FsdA$gduXp+Z6iReEolmbyHn5V8s1EtJzmEvZfYoY/Fn/AeI" //nolint:lll
+
+	cases := []testRequest[
+		api.PostAdminUsersUserIdPasswordRequestObject,
+		api.PostAdminUsersUserIdPasswordResponseObject,
+	]{
+		{
+			name:   "password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserPasswordHash(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserPasswordHashParams{
+						PasswordHash: pgtype.Text{}, //nolint:exhaustruct
+						ID:           userID,
+					}),
+				).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       ptr("password"),
+					PasswordHash:   nil,
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdPassword200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "password hash and revoke sessions",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserPasswordHash(
+					gomock.Any(),
+					sql.UpdateUserPasswordHashParams{
+						PasswordHash: sql.Text(passwordHash),
+						ID:           userID,
+					},
+				).Return(int64(1), nil)
+
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       nil,
+					PasswordHash:   ptr(passwordHash),
+					RevokeSessions: ptr(true),
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdPassword200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "password too short",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       ptr("p"),
+					PasswordHash:   nil,
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "password-too-short",
+				Message: "Password is too short",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "invalid password hash",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       nil,
+					PasswordHash:   ptr("not a hash"),
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "argon2 password hash",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserPasswordHash(
+					gomock.Any(),
+					sql.UpdateUserPasswordHashParams{
+						PasswordHash: sql.Text(argon2Hash),
+						ID:           userID,
+					},
+				).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       nil,
+					PasswordHash:   ptr(argon2Hash),
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: api.PostAdminUsersUserIdPassword200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "argon2 password hash requiring too much memory",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       nil,
+					PasswordHash:   ptr("$argon2id$v=19$m=4194304,t=3,p=4$c29tZXNhbHRzb21lc2FsdA$gduXp+Z6iReEolmbyHn5V8s1EtJzmEvZfYoY/Fn/AeI"), //nolint:lll
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "argon2 password hash requiring too many passes",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       nil,
+					PasswordHash:   ptr("$argon2id$v=19$m=65536,t=1000000,p=4$c29tZXNhbHRzb21lc2FsdA$gduXp+Z6iReEolmbyHn5V8s1EtJzmEvZfYoY/Fn/AeI"), //nolint:lll
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "argon2 password hash with a key too long",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       nil,
+					PasswordHash:   ptr("$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHRzb21lc2FsdA$a2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2s"), //nolint:lll
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "password and password hash",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       ptr("password"),
+					PasswordHash:   ptr(passwordHash),
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostAdminUsersUserIdPasswordRequestObject{
+				UserId: userID,
+				Body: &api.AdminSetPasswordRequest{
+					Password:       ptr("password"),
+					PasswordHash:   nil,
+					RevokeSessions: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "user-not-found",
+				Message: "User not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostAdminUsersUserIdPassword,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
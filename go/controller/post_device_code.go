@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostDeviceCode( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostDeviceCodeRequestObject,
+) (api.PostDeviceCodeResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.DeviceAuthorizationEnabled {
+		logger.Warn("device authorization is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	verificationURL, err := url.Parse(ctrl.config.DeviceVerificationURL)
+	if err != nil {
+		logger.Error("error parsing device verification URL", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	deviceCode, userCode, apiErr := ctrl.wf.InsertDeviceCode(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	verificationURLComplete := *verificationURL
+	query := verificationURLComplete.Query()
+	query.Set("userCode", userCode)
+	verificationURLComplete.RawQuery = query.Encode()
+
+	return api.PostDeviceCode200JSONResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationUri:         verificationURL.String(),
+		VerificationUriComplete: verificationURLComplete.String(),
+		ExpiresIn:               int64(ctrl.config.DeviceCodeExpiresIn),
+		Interval:                int64(ctrl.config.DevicePollingInterval),
+	}, nil
+}
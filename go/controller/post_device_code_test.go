@@ -0,0 +1,149 @@
+package controller_test
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getDeviceConfig() *controller.Config {
+	config := getConfig()
+	config.DeviceAuthorizationEnabled = true
+	config.DeviceVerificationURL = "http://localhost:3000/device"
+	config.DeviceCodeExpiresIn = 900
+	config.DevicePollingInterval = 5
+	return config
+}
+
+func TestPostDeviceCode(t *testing.T) {
+	t.Parallel()
+
+	cases := []testRequest[api.PostDeviceCodeRequestObject, api.PostDeviceCodeResponseObject]{
+		{
+			name:   "simple",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().DeleteExpiredDeviceCodes(gomock.Any()).Return(nil)
+
+				mock.EXPECT().InsertDeviceCode(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertDeviceCodeParams{
+							DeviceCodeHash: "",
+							UserCode:       "",
+							ExpiresAt:      sql.TimestampTz(time.Now().Add(15 * time.Minute)),
+						},
+						cmpopts.IgnoreFields(
+							sql.InsertDeviceCodeParams{}, //nolint:exhaustruct
+							"DeviceCodeHash", "UserCode",
+						),
+					),
+				).Return(uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c"), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.PostDeviceCodeRequestObject{},
+			expectedResponse: api.PostDeviceCode200JSONResponse{
+				DeviceCode:              "",
+				UserCode:                "",
+				VerificationUri:         "http://localhost:3000/device",
+				VerificationUriComplete: "",
+				ExpiresIn:               900,
+				Interval:                5,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:          "disabled",
+			config:        getConfig,
+			db:            func(ctrl *gomock.Controller) controller.DBClient { return mock.NewMockDBClient(ctrl) },
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.PostDeviceCodeRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	userCodeRegexp := regexp.MustCompile(`^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$`)
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostDeviceCode, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.PostDeviceCode200JSONResponse{}, //nolint:exhaustruct
+					"DeviceCode", "UserCode", "VerificationUriComplete",
+				),
+			)
+
+			resp200, ok := resp.(api.PostDeviceCode200JSONResponse)
+			if !ok {
+				return
+			}
+
+			if err := uuid.Validate(resp200.DeviceCode); err != nil {
+				t.Errorf("device code is not a uuid: %s", resp200.DeviceCode)
+			}
+
+			if !userCodeRegexp.MatchString(resp200.UserCode) {
+				t.Errorf("unexpected user code format: %s", resp200.UserCode)
+			}
+
+			u, err := url.Parse(resp200.VerificationUriComplete)
+			if err != nil {
+				t.Fatalf("error parsing verification uri complete: %v", err)
+			}
+			if u.Query().Get("userCode") != resp200.UserCode {
+				t.Errorf("unexpected verification uri complete: %s", resp200.VerificationUriComplete)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostDeviceToken( //nolint:ireturn
+	ctx context.Context,
+	request api.PostDeviceTokenRequestObject,
+) (api.PostDeviceTokenResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.DeviceAuthorizationEnabled {
+		logger.Warn("device authorization is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.PollDeviceCode(ctx, request.Body.DeviceCode, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostDeviceToken200JSONResponse{Session: session}, nil
+}
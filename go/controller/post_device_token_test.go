@@ -0,0 +1,346 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostDeviceToken(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	deviceCodeID := uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c")
+	deviceCode := "1fb17604-86c7-444e-b337-09a644465f2d"
+	hashedDeviceCode := `\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310`
+
+	request := api.PostDeviceTokenRequestObject{
+		Body: &api.DeviceTokenRequest{
+			DeviceCode: deviceCode,
+		},
+	}
+
+	getDeviceCode := func(fn func(dc *sql.AuthDeviceCode)) func(mock *mock.MockDBClient) {
+		return func(mock *mock.MockDBClient) {
+			dc := sql.AuthDeviceCode{
+				ID:             deviceCodeID,
+				CreatedAt:      sql.TimestampTz(time.Now().Add(-time.Minute)),
+				ExpiresAt:      sql.TimestampTz(time.Now().Add(14 * time.Minute)),
+				DeviceCodeHash: hashedDeviceCode,
+				UserCode:       "WDJBMJHT",
+				UserID:         pgtype.UUID{}, //nolint:exhaustruct
+				Denied:         false,
+				LastPolledAt:   pgtype.Timestamptz{}, //nolint:exhaustruct
+			}
+			fn(&dc)
+
+			mock.EXPECT().GetDeviceCode(gomock.Any(), hashedDeviceCode).Return(dc, nil)
+		}
+	}
+
+	cases := []testRequest[api.PostDeviceTokenRequestObject, api.PostDeviceTokenResponseObject]{
+		{
+			name:   "approved",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getDeviceCode(func(dc *sql.AuthDeviceCode) {
+					dc.UserID = pgtype.UUID{Bytes: userID, Valid: true}
+				})(mock)
+
+				mock.EXPECT().DeleteDeviceCode(
+					gomock.Any(), deviceCodeID,
+				).Return(pgtype.UUID{Bytes: userID, Valid: true}, nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: api.PostDeviceToken200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+		},
+
+		{
+			name:   "pending",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getDeviceCode(func(*sql.AuthDeviceCode) {})(mock)
+
+				mock.EXPECT().UpdateDeviceCodeLastPolled(gomock.Any(), deviceCodeID).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "authorization-pending",
+				Message: "The user hasn't approved the device yet",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "polling too frequently",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getDeviceCode(func(dc *sql.AuthDeviceCode) {
+					dc.LastPolledAt = sql.TimestampTz(time.Now().Add(-time.Second))
+				})(mock)
+
+				mock.EXPECT().UpdateDeviceCodeLastPolled(gomock.Any(), deviceCodeID).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "slow-down",
+				Message: "The device is polling too frequently, slow down",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "denied",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getDeviceCode(func(dc *sql.AuthDeviceCode) {
+					dc.Denied = true
+				})(mock)
+
+				mock.EXPECT().DeleteDeviceCode(
+					gomock.Any(), deviceCodeID,
+				).Return(pgtype.UUID{}, nil) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "access-denied",
+				Message: "The user denied the device",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "expired",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getDeviceCode(func(dc *sql.AuthDeviceCode) {
+					dc.UserID = pgtype.UUID{Bytes: userID, Valid: true}
+					dc.ExpiresAt = sql.TimestampTz(time.Now().Add(-time.Minute))
+				})(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "expired-token",
+				Message: "Invalid or expired device code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unknown device code",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetDeviceCode(
+					gomock.Any(), hashedDeviceCode,
+				).Return(sql.AuthDeviceCode{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "expired-token",
+				Message: "Invalid or expired device code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "already used",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				getDeviceCode(func(dc *sql.AuthDeviceCode) {
+					dc.UserID = pgtype.UUID{Bytes: userID, Valid: true}
+				})(mock)
+
+				mock.EXPECT().DeleteDeviceCode(
+					gomock.Any(), deviceCodeID,
+				).Return(pgtype.UUID{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       request,
+			expectedResponse: controller.ErrorResponse{
+				Error:   "expired-token",
+				Message: "Invalid or expired device code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostDeviceToken, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostDeviceToken200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostDeviceVerify( //nolint:ireturn
+	ctx context.Context,
+	request api.PostDeviceVerifyRequestObject,
+) (api.PostDeviceVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.DeviceAuthorizationEnabled {
+		logger.Warn("device authorization is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if apiErr := ctrl.wf.VerifyDeviceCode(
+		ctx, request.Body.UserCode, user.ID, request.Body.Approve, logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostDeviceVerify200JSONResponse(api.OK), nil
+}
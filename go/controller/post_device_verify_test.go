@@ -0,0 +1,179 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostDeviceVerify(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	deviceCodeID := uuid.MustParse("a0c4a5f1-7b2d-4c3e-9f6a-1d2e3f4a5b6c")
+
+	cases := []testRequest[api.PostDeviceVerifyRequestObject, api.PostDeviceVerifyResponseObject]{
+		{
+			name:   "approve",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().ApproveDeviceCode(
+					gomock.Any(),
+					sql.ApproveDeviceCodeParams{
+						UserCode: "WDJBMJHT",
+						UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+					},
+				).Return(deviceCodeID, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostDeviceVerifyRequestObject{
+				Body: &api.DeviceVerifyRequest{
+					UserCode: "wdjb-mjht",
+					Approve:  true,
+				},
+			},
+			expectedResponse: api.PostDeviceVerify200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "deny",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().DenyDeviceCode(
+					gomock.Any(), "WDJBMJHT",
+				).Return(deviceCodeID, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostDeviceVerifyRequestObject{
+				Body: &api.DeviceVerifyRequest{
+					UserCode: "WDJB-MJHT",
+					Approve:  false,
+				},
+			},
+			expectedResponse: api.PostDeviceVerify200JSONResponse(api.OK),
+			expectedJWT:      nil,
+			jwtTokenFn:       webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "invalid user code",
+			config: getDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().ApproveDeviceCode(
+					gomock.Any(),
+					sql.ApproveDeviceCodeParams{
+						UserCode: "WDJBMJHT",
+						UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+					},
+				).Return(uuid.UUID{}, pgx.ErrNoRows)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostDeviceVerifyRequestObject{
+				Body: &api.DeviceVerifyRequest{
+					UserCode: "WDJB-MJHT",
+					Approve:  true,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-user-code",
+				Message: "Invalid or expired user code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:          "disabled",
+			config:        getConfig,
+			db:            func(ctrl *gomock.Controller) controller.DBClient { return mock.NewMockDBClient(ctrl) },
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostDeviceVerifyRequestObject{
+				Body: &api.DeviceVerifyRequest{
+					UserCode: "WDJB-MJHT",
+					Approve:  true,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.PostDeviceVerify, tc.request, tc.expectedResponse)
+		})
+	}
+}
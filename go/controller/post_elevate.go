@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// verifyElevationFactors checks the password and TOTP code against the ones the user has
+// set up. Every factor the user has must be verified, users without any of them need to
+// elevate their session with a security key instead.
+func (ctrl *Controller) verifyElevationFactors(
+	user sql.AuthUser, request api.ElevateRequest, logger *slog.Logger,
+) *APIError {
+	hasPassword := user.PasswordHash.Valid && user.PasswordHash.String != ""
+	hasTOTP := user.ActiveMfaType.String == MFATypeTOTP
+
+	if !hasPassword && !hasTOTP {
+		logger.Warn("user doesn't have a password or mfa to verify")
+		return ErrInvalidRequest
+	}
+
+	if hasPassword && !ctrl.wf.VerifyUserPassword(
+		deptr(request.Password), user.PasswordHash.String,
+	) {
+		logger.Warn("password doesn't match")
+		return ErrInvalidEmailPassword
+	}
+
+	if hasTOTP {
+		if !user.TotpSecret.Valid || user.TotpSecret.String == "" {
+			logger.Warn("user doesn't have a totp secret")
+			return ErrNoTotpSecret
+		}
+
+		if !verifyTOTP(deptr(request.Otp), user.TotpSecret.String) {
+			logger.Warn("invalid totp code")
+			return ErrInvalidOTP
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *Controller) PostElevate( //nolint:ireturn
+	ctx context.Context,
+	request api.PostElevateRequestObject,
+) (api.PostElevateResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if user.IsAnonymous {
+		logger.Warn("anonymous users can't elevate their session")
+		return ctrl.sendError(ErrForbiddenAnonymous), nil
+	}
+
+	ip := middleware.ClientInfoFromContext(ctx).IP
+	if apiErr := ctrl.wf.CheckIPSignInLockout(ctx, ip, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	if apiErr := ctrl.wf.CheckUserSignInLockout(user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	// wrong factors count against the lockout of the user like failed sign ins, otherwise
+	// a stolen access token could be used to guess them without limits
+	if apiErr := ctrl.verifyElevationFactors(user, *request.Body, logger); apiErr != nil {
+		if errors.Is(apiErr, ErrInvalidEmailPassword) || errors.Is(apiErr, ErrInvalidOTP) {
+			if apiErr := ctrl.wf.RecordSignInFailure(ctx, &user, ip, logger); apiErr != nil {
+				return ctrl.sendError(apiErr), nil
+			}
+		}
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.ResetSignInFailures(ctx, user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewElevatedSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	logger.Info("session elevated")
+
+	return api.PostElevate200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
@@ -0,0 +1,484 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostElevate(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	code := getTotpCode(t)
+
+	mfaUser := func() sql.AuthUser {
+		user := getSigninUser(userID)
+		user.TotpSecret = sql.Text(testTotpSecret)
+		user.ActiveMfaType = sql.Text("totp")
+		return user
+	}
+
+	noPasswordUser := func() sql.AuthUser {
+		user := getSigninUser(userID)
+		user.PasswordHash = pgtype.Text{} //nolint:exhaustruct
+		return user
+	}
+
+	anonymousUser := func() sql.AuthUser {
+		user := getSigninUser(userID)
+		user.IsAnonymous = true
+		return user
+	}
+
+	elevatedSession := &api.Session{
+		AccessToken:          "",
+		AccessTokenExpiresIn: 300,
+		RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+		RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+		User: &api.User{
+			AvatarUrl:           "",
+			CreatedAt:           time.Now(),
+			DefaultRole:         "user",
+			DisplayName:         "Jane Doe",
+			Email:               ptr(types.Email("jane@acme.com")),
+			EmailVerified:       true,
+			Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+			IsAnonymous:         false,
+			Locale:              "en",
+			Metadata:            map[string]any{},
+			PhoneNumber:         "",
+			PhoneNumberVerified: false,
+			Roles:               []string{"user", "me"},
+		},
+	}
+
+	elevatedJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(300 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-auth-elevated":     "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "false",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	cases := []testRequest[api.PostElevateRequestObject, api.PostElevateResponseObject]{
+		{
+			name:   "password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("password"),
+					Otp:      nil,
+				},
+			},
+			expectedResponse: api.PostElevate200JSONResponse{
+				Mfa:     nil,
+				Session: elevatedSession,
+			},
+			expectedJWT: elevatedJWT,
+		},
+
+		{
+			name:   "password and totp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(mfaUser(), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("password"),
+					Otp:      ptr(code),
+				},
+			},
+			expectedResponse: api.PostElevate200JSONResponse{
+				Mfa:     nil,
+				Session: elevatedSession,
+			},
+			expectedJWT: elevatedJWT,
+		},
+
+		{
+			name:   "wrong password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("wrong-password"),
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "wrong password counts against the lockout",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RecordUserSignInFailure(
+					gomock.Any(),
+					cmpDBParams(sql.RecordUserSignInFailureParams{
+						ID:            userID,
+						FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("wrong-password"),
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "user locked out",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.FailedSignInAttempts = 10
+				user.LockedUntil = sql.TimestampTz(time.Now().Add(time.Minute))
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("password"),
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "sign-in-locked",
+				Message: "Too many failed sign in attempts, try again later",
+				Status:  429,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "missing password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: nil,
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "missing totp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(mfaUser(), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("password"),
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "wrong totp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(mfaUser(), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: ptr("password"),
+					Otp:      ptr("000000"),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "no password or mfa",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(noPasswordUser(), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: nil,
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "anonymous user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(anonymousUser(), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostElevateRequestObject{
+				Body: &api.ElevateRequest{
+					Password: nil,
+					Otp:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-anonymous",
+				Message: "Forbidden, user is anonymous.",
+				Status:  403,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.PostElevate, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostElevate200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
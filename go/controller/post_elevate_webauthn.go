@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostElevateWebauthn( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostElevateWebauthnRequestObject,
+) (api.PostElevateWebauthnResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.WebauthnEnabled {
+		logger.Warn("webauthn is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	webauthnUser, apiErr := ctrl.wf.GetWebauthnUser(ctx, user, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	assertion, apiErr := ctrl.Webauthn.BeginLogin(webauthnUser, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	return api.PostElevateWebauthn200JSONResponse(assertion.Response), nil
+}
@@ -0,0 +1,160 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostElevateWebauthn(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.PostElevateWebauthnRequestObject, api.PostElevateWebauthnResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetSecurityKeys(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserSecurityKey{
+					{
+						ID:                  uuid.MustParse("307b758d-c0b0-4ce3-894b-f8ddec753c29"),
+						UserID:              userID,
+						CredentialID:        "bXktY3JlZGVudGlhbC1pZA",
+						CredentialPublicKey: []byte("public-key"),
+						Counter:             3,
+						Transports:          "",
+						Nickname:            sql.Text("my-key"),
+					},
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request:       api.PostElevateWebauthnRequestObject{},
+			expectedResponse: api.PostElevateWebauthn200JSONResponse{
+				Challenge:      []byte{},
+				Timeout:        60000,
+				RelyingPartyID: "react-apollo.example.nhost.io",
+				AllowedCredentials: []protocol.CredentialDescriptor{
+					{
+						Type:            "public-key",
+						CredentialID:    []byte("my-credential-id"),
+						Transport:       nil,
+						AttestationType: "",
+					},
+				},
+				UserVerification: "preferred",
+				Extensions:       nil,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "user has no security keys",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetSecurityKeys(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserSecurityKey{}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request:       api.PostElevateWebauthnRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name: "webauthn disabled",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.WebauthnEnabled = false
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request:       api.PostElevateWebauthnRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			//nolint:exhaustruct
+			assertRequest(
+				ctx, t, c.PostElevateWebauthn, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(api.PostElevateWebauthn200JSONResponse{}, "Challenge"),
+			)
+		})
+	}
+}
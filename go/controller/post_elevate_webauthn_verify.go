@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostElevateWebauthnVerify( //nolint:ireturn
+	ctx context.Context,
+	request api.PostElevateWebauthnVerifyRequestObject,
+) (api.PostElevateWebauthnVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.WebauthnEnabled {
+		logger.Warn("webauthn is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	credData, err := request.Body.Credential.Parse()
+	if err != nil {
+		logger.Warn("error parsing credential data", logError(err))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	cred, _, apiErr := ctrl.Webauthn.FinishLogin(
+		credData,
+		func(userID uuid.UUID) (WebauthnUser, *APIError) {
+			if userID != user.ID {
+				logger.Warn("challenge doesn't belong to the authenticated user")
+				return WebauthnUser{}, ErrInvalidRequest //nolint:exhaustruct
+			}
+
+			return ctrl.wf.GetWebauthnUser(ctx, user, logger)
+		},
+		logger,
+	)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.UpdateSecurityKeyCounter(ctx, user.ID, cred, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewElevatedSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostElevateWebauthnVerify200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
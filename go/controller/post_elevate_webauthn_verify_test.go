@@ -0,0 +1,279 @@
+package controller_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+type testElevateWebauthnVerifyRequest struct {
+	testRequest[api.PostElevateWebauthnVerifyRequestObject, api.PostElevateWebauthnVerifyResponseObject]
+	savedChallenge controller.WebauthnChallenge
+}
+
+func TestPostElevateWebauthnVerify(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	otherUserID := uuid.MustParse("8e5b3c1a-4c2d-4d9e-9f0a-6b7c8d9e0f1a")
+	challenge := "zznztjvFVUM0E2p8ZV6shXEcw2f4tbz5RrfZWk4VPXI"
+
+	authenticator := newWebauthnTestAuthenticator(t)
+
+	userChallenge := func(userID uuid.UUID) controller.WebauthnChallenge {
+		return controller.WebauthnChallenge{
+			Session: webauthn.SessionData{
+				Challenge:            challenge,
+				UserID:               []byte(userID.String()),
+				AllowedCredentialIDs: [][]byte{authenticator.credentialID},
+				Expires:              time.Now().Add(time.Minute),
+				UserVerification:     "preferred",
+				Extensions:           nil,
+			},
+			User: controller.WebauthnUser{ //nolint:exhaustruct
+				ID:    userID,
+				Name:  "Jane Doe",
+				Email: "jane@acme.com",
+			},
+			Options: nil,
+		}
+	}
+
+	cases := []testElevateWebauthnVerifyRequest{
+		{
+			testRequest: testRequest[api.PostElevateWebauthnVerifyRequestObject, api.PostElevateWebauthnVerifyResponseObject]{ //nolint:lll
+				name:   "success",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetSecurityKeys(
+						gomock.Any(), userID,
+					).Return(
+						[]sql.AuthUserSecurityKey{authenticator.securityKey(t, userID, 3)}, nil,
+					)
+
+					mock.EXPECT().UpdateSecurityKeyCounter(
+						gomock.Any(),
+						sql.UpdateSecurityKeyCounterParams{
+							Counter:      4,
+							UserID:       userID,
+							CredentialID: base64.RawURLEncoding.EncodeToString(authenticator.credentialID),
+						},
+					).Return(nil)
+
+					mock.EXPECT().GetUserRoles(
+						gomock.Any(), userID,
+					).Return([]sql.AuthUserRole{
+						{UserID: userID, Role: "user"}, //nolint:exhaustruct
+						{UserID: userID, Role: "me"},   //nolint:exhaustruct
+					}, nil)
+
+					mock.EXPECT().InsertRefreshtoken(
+						gomock.Any(),
+						cmpDBParams(sql.InsertRefreshtokenParams{
+							UserID:           userID,
+							RefreshTokenHash: "",
+							ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+							Type:             sql.RefreshTokenTypeRegular,
+							Metadata:         nil,
+						}),
+					).Return(refreshTokenID, nil)
+
+					mock.EXPECT().UpdateUserLastSeen(
+						gomock.Any(), userID,
+					).Return(sql.TimestampTz(time.Now()), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				jwtTokenFn:    webauthnUserJWT(userID),
+				request: api.PostElevateWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: api.PostElevateWebauthnVerify200JSONResponse{
+					Mfa: nil,
+					Session: &api.Session{
+						AccessToken:          "",
+						AccessTokenExpiresIn: 300,
+						RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+						RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+						User: &api.User{
+							AvatarUrl:           "",
+							CreatedAt:           time.Now(),
+							DefaultRole:         "user",
+							DisplayName:         "Jane Doe",
+							Email:               ptr(types.Email("jane@acme.com")),
+							EmailVerified:       true,
+							Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+							IsAnonymous:         false,
+							Locale:              "en",
+							Metadata:            map[string]any{},
+							PhoneNumber:         "",
+							PhoneNumberVerified: false,
+							Roles:               []string{"user", "me"},
+						},
+					},
+				},
+				expectedJWT: &jwt.Token{
+					Raw:    "",
+					Method: jwt.SigningMethodHS256,
+					Header: map[string]any{
+						"alg": "HS256",
+						"typ": "JWT",
+					},
+					Claims: jwt.MapClaims{
+						"exp": float64(time.Now().Add(300 * time.Second).Unix()),
+						"https://hasura.io/jwt/claims": map[string]any{
+							"x-hasura-allowed-roles":     []any{"user", "me"},
+							"x-hasura-auth-elevated":     "db477732-48fa-4289-b694-2886a646b6eb",
+							"x-hasura-default-role":      "user",
+							"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+							"x-hasura-user-is-anonymous": "false",
+						},
+						"iat": float64(time.Now().Unix()),
+						"iss": "hasura-auth",
+						"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+					},
+					Signature: []byte{},
+					Valid:     true,
+				},
+			},
+			savedChallenge: userChallenge(userID),
+		},
+
+		{
+			testRequest: testRequest[api.PostElevateWebauthnVerifyRequestObject, api.PostElevateWebauthnVerifyResponseObject]{ //nolint:lll
+				name:   "challenge belongs to another user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				jwtTokenFn:    webauthnUserJWT(userID),
+				request: api.PostElevateWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(otherUserID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-request",
+					Message: "The request payload is incorrect",
+					Status:  400,
+				},
+				expectedJWT: nil,
+			},
+			savedChallenge: userChallenge(otherUserID),
+		},
+
+		{
+			testRequest: testRequest[api.PostElevateWebauthnVerifyRequestObject, api.PostElevateWebauthnVerifyResponseObject]{ //nolint:lll
+				name: "webauthn disabled",
+				config: func() *controller.Config {
+					c := getConfig()
+					c.WebauthnEnabled = false
+					return c
+				},
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				jwtTokenFn:    webauthnUserJWT(userID),
+				request: api.PostElevateWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-endpoint",
+					Message: "This endpoint is disabled",
+					Status:  409,
+				},
+				expectedJWT: nil,
+			},
+			savedChallenge: userChallenge(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			if c.Webauthn != nil {
+				c.Webauthn.SaveChallenge(tc.savedChallenge.Session.Challenge, tc.savedChallenge)
+			}
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.PostElevateWebauthnVerify, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostElevateWebauthnVerify200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
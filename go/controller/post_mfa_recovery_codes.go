@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostMfaRecoveryCodes( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostMfaRecoveryCodesRequestObject,
+) (api.PostMfaRecoveryCodesResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.MfaEnabled {
+		logger.Warn("mfa is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if !user.ActiveMfaType.Valid || user.ActiveMfaType.String == "" {
+		logger.Warn("user doesn't have mfa active")
+		return ctrl.sendError(ErrMfaTypeNotFound), nil
+	}
+
+	recoveryCodes, apiErr := ctrl.wf.GenerateRecoveryCodes(ctx, user.ID, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostMfaRecoveryCodes200JSONResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
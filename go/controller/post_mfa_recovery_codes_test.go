@@ -0,0 +1,165 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostMfaRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.MfaEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.PostMfaRecoveryCodesRequestObject, api.PostMfaRecoveryCodesResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.ActiveMfaType = sql.Text("totp")
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				mock.EXPECT().ReplaceRecoveryCodes(
+					gomock.Any(),
+					cmpDBParams(
+						sql.ReplaceRecoveryCodesParams{
+							UserID:     userID,
+							CodeHashes: nil,
+						},
+						cmpopts.IgnoreFields(sql.ReplaceRecoveryCodesParams{}, "CodeHashes"), //nolint:exhaustruct
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.PostMfaRecoveryCodesRequestObject{},
+			expectedResponse: api.PostMfaRecoveryCodes200JSONResponse{
+				RecoveryCodes: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "mfa not active",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.PostMfaRecoveryCodesRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "mfa-type-not-found",
+				Message: "There is no active MFA set for the user",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name: "mfa disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.MfaEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request:       api.PostMfaRecoveryCodesRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.PostMfaRecoveryCodes, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.PostMfaRecoveryCodes200JSONResponse{}, "RecoveryCodes", //nolint:exhaustruct
+				),
+			)
+
+			resp200, ok := resp.(api.PostMfaRecoveryCodes200JSONResponse)
+			if !ok {
+				return
+			}
+
+			seen := make(map[string]struct{}, len(resp200.RecoveryCodes))
+			for _, code := range resp200.RecoveryCodes {
+				if len(code) != 11 {
+					t.Errorf("unexpected recovery code format: %s", code)
+				}
+				seen[code] = struct{}{}
+			}
+			if len(seen) != 10 {
+				t.Errorf("expected 10 unique recovery codes, got %d", len(seen))
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostMfaTotpEnable( //nolint:ireturn
+	ctx context.Context,
+	request api.PostMfaTotpEnableRequestObject,
+) (api.PostMfaTotpEnableResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.MfaEnabled {
+		logger.Warn("mfa is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if user.ActiveMfaType.String == MFATypeTOTP {
+		logger.Warn("totp mfa already active")
+		return ctrl.sendError(ErrTotpAlreadyActive), nil
+	}
+
+	if !user.TotpSecret.Valid || user.TotpSecret.String == "" {
+		logger.Warn("user doesn't have a totp secret")
+		return ctrl.sendError(ErrNoTotpSecret), nil
+	}
+
+	if !verifyTOTP(request.Body.Code, user.TotpSecret.String) {
+		logger.Warn("invalid totp code")
+		return ctrl.sendError(ErrInvalidOTP), nil
+	}
+
+	if apiErr := ctrl.wf.UpdateUserActiveMFAType(
+		ctx, user.ID, MFATypeTOTP, logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	recoveryCodes, apiErr := ctrl.wf.GenerateRecoveryCodes(ctx, user.ID, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostMfaTotpEnable200JSONResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
@@ -0,0 +1,255 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/mock/gomock"
+)
+
+const testTotpSecret = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+
+func getTotpCode(t *testing.T) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(testTotpSecret, time.Now())
+	if err != nil {
+		t.Fatalf("error generating totp code: %v", err)
+	}
+
+	return code
+}
+
+func TestPostMfaTotpEnable(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.MfaEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	getUser := func() sql.AuthUser {
+		user := getSigninUser(userID)
+		user.TotpSecret = sql.Text(testTotpSecret)
+		return user
+	}
+
+	code := getTotpCode(t)
+
+	cases := []testRequest[api.PostMfaTotpEnableRequestObject, api.PostMfaTotpEnableResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserActiveMFAType(
+					gomock.Any(),
+					sql.UpdateUserActiveMFATypeParams{
+						ID:            userID,
+						ActiveMfaType: sql.Text("totp"),
+					},
+				).Return(nil)
+
+				mock.EXPECT().ReplaceRecoveryCodes(
+					gomock.Any(),
+					cmpDBParams(
+						sql.ReplaceRecoveryCodesParams{
+							UserID:     userID,
+							CodeHashes: nil,
+						},
+						cmpopts.IgnoreFields(sql.ReplaceRecoveryCodesParams{}, "CodeHashes"), //nolint:exhaustruct
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostMfaTotpEnableRequestObject{
+				Body: &api.PostMfaTotpEnableJSONRequestBody{
+					Code: code,
+				},
+			},
+			expectedResponse: api.PostMfaTotpEnable200JSONResponse{
+				RecoveryCodes: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "wrong code",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getUser(), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostMfaTotpEnableRequestObject{
+				Body: &api.PostMfaTotpEnableJSONRequestBody{
+					Code: "not-a-code",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "no totp secret",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostMfaTotpEnableRequestObject{
+				Body: &api.PostMfaTotpEnableJSONRequestBody{
+					Code: code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "no-totp-secret",
+				Message: "OTP secret is not set for user",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "totp already active",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.ActiveMfaType = sql.Text("totp")
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostMfaTotpEnableRequestObject{
+				Body: &api.PostMfaTotpEnableJSONRequestBody{
+					Code: code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "totp-already-active",
+				Message: "TOTP MFA already active",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name: "mfa disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.MfaEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostMfaTotpEnableRequestObject{
+				Body: &api.PostMfaTotpEnableJSONRequestBody{
+					Code: code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.PostMfaTotpEnable, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.PostMfaTotpEnable200JSONResponse{}, "RecoveryCodes", //nolint:exhaustruct
+				),
+			)
+
+			resp200, ok := resp.(api.PostMfaTotpEnable200JSONResponse)
+			if ok && len(resp200.RecoveryCodes) != 10 {
+				t.Errorf("expected 10 recovery codes, got %d", len(resp200.RecoveryCodes))
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostOauthAuthorize( //nolint:ireturn,funlen
+	ctx context.Context,
+	request api.PostOauthAuthorizeRequestObject,
+) (api.PostOauthAuthorizeResponseObject, error) {
+	body := request.Body
+	logger := middleware.LoggerFromContext(ctx).With(slog.String("client_id", body.ClientId))
+
+	if !ctrl.config.OIDCProviderEnabled {
+		logger.Warn("oidc provider is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	client, apiErr := ctrl.wf.GetOIDCClient(ctx, body.ClientId, body.RedirectUri, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	scopes := strings.Fields(body.Scope)
+	resp := api.PostOauthAuthorize200JSONResponse{
+		Client: api.OAuth2ClientInfo{
+			ClientId:    client.ClientID,
+			Description: client.Description,
+		},
+		Scopes:          scopes,
+		ConsentRequired: false,
+		RedirectTo:      nil,
+	}
+
+	redirectWithError := func(code, description string) api.PostOauthAuthorizeResponseObject {
+		resp.RedirectTo = ptr(oidcRedirectURI(body.RedirectUri, map[string]string{
+			"error":             code,
+			"error_description": description,
+			"state":             deptr(body.State),
+		}))
+		return resp
+	}
+
+	if code, description := oidcAuthorizationRequestError(
+		scopes, deptr(body.CodeChallenge), deptr(body.CodeChallengeMethod),
+	); code != "" {
+		logger.Warn("invalid authorization request", slog.String("error", description))
+		return redirectWithError(code, description), nil
+	}
+
+	allowedRoles, apiErr := ctrl.wf.GetUserAllowedRoles(ctx, user.ID, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	if _, _, apiErr := scopedRoles(
+		allowedRoles, user.DefaultRole, ptr(strings.Join(oidcScopeRoles(scopes), " ")),
+	); apiErr != nil {
+		logger.Warn("client requested a role the user doesn't have")
+		return redirectWithError("invalid_scope", "The user doesn't have the requested roles"), nil
+	}
+
+	consentRequired, apiErr := ctrl.wf.OAuth2ConsentRequired(
+		ctx, client, user.ID, scopes, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	switch {
+	case !consentRequired:
+	case body.Consent == nil:
+		resp.ConsentRequired = true
+		return resp, nil
+	case !*body.Consent:
+		logger.Info("user denied the authorization request")
+		return redirectWithError("access_denied", "The user denied the request"), nil
+	}
+
+	code, apiErr := ctrl.wf.GrantOAuth2AuthorizationCode(ctx, oauth2AuthorizationRequest{
+		clientID:      client.ClientID,
+		userID:        user.ID,
+		redirectURI:   body.RedirectUri,
+		scopes:        scopes,
+		nonce:         deptr(body.Nonce),
+		codeChallenge: deptr(body.CodeChallenge),
+	}, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	resp.RedirectTo = ptr(oidcRedirectURI(body.RedirectUri, map[string]string{
+		"code":  code,
+		"state": deptr(body.State),
+	}))
+
+	return resp, nil
+}
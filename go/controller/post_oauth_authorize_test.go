@@ -0,0 +1,331 @@
+package controller_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostOauthAuthorize(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	clientID := "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"
+	client := getOIDCClient(clientID)
+	firstPartyClient := client
+	firstPartyClient.SkipConsent = true
+
+	body := func(scope string, consent *bool) *api.PostOauthAuthorizeJSONRequestBody {
+		return &api.PostOauthAuthorizeJSONRequestBody{
+			ClientId:            clientID,
+			RedirectUri:         "https://app.example.com/callback",
+			Scope:               scope,
+			State:               ptr("af0ifjsldkj"),
+			Nonce:               ptr("n-0S6_WzA2Mj"),
+			CodeChallenge:       nil,
+			CodeChallengeMethod: nil,
+			Consent:             consent,
+		}
+	}
+	clientInfo := api.OAuth2ClientInfo{
+		ClientId:    clientID,
+		Description: "Dashboard",
+	}
+	userRoles := []sql.AuthUserRole{
+		{UserID: userID, Role: "user"}, //nolint:exhaustruct
+		{UserID: userID, Role: "me"},   //nolint:exhaustruct
+	}
+	consentParams := sql.GetOAuth2ConsentScopesParams{
+		UserID:   userID,
+		ClientID: clientID,
+	}
+
+	cases := []testRequest[api.PostOauthAuthorizeRequestObject, api.PostOauthAuthorizeResponseObject]{
+		{
+			name:   "consent required",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(userRoles, nil)
+				mock.EXPECT().GetOAuth2ConsentScopes(gomock.Any(), consentParams).Return(
+					nil, pgx.ErrNoRows,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: body("openid email", nil),
+			},
+			expectedResponse: api.PostOauthAuthorize200JSONResponse{
+				Client:          clientInfo,
+				Scopes:          []string{"openid", "email"},
+				ConsentRequired: true,
+				RedirectTo:      nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "consent given",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(userRoles, nil)
+				mock.EXPECT().GetOAuth2ConsentScopes(gomock.Any(), consentParams).Return(
+					[]string{"openid"}, nil,
+				).Times(2)
+				mock.EXPECT().UpsertOAuth2Consent(
+					gomock.Any(),
+					sql.UpsertOAuth2ConsentParams{
+						UserID:   userID,
+						ClientID: clientID,
+						Scopes:   []string{"openid", "email", "me"},
+					},
+				).Return(nil)
+				mock.EXPECT().InsertOAuth2AuthorizationCode(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertOAuth2AuthorizationCodeParams{
+							CodeHash:      "",
+							ClientID:      clientID,
+							UserID:        userID,
+							RedirectUri:   "https://app.example.com/callback",
+							Scopes:        []string{"openid", "email", "me"},
+							Nonce:         sql.Text("n-0S6_WzA2Mj"),
+							CodeChallenge: pgtype.Text{}, //nolint:exhaustruct
+							ExpiresAt:     sql.TimestampTz(time.Now().Add(10 * time.Minute)),
+						},
+						cmpopts.IgnoreFields(
+							sql.InsertOAuth2AuthorizationCodeParams{}, //nolint:exhaustruct
+							"CodeHash",
+						),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: body("openid email me", ptr(true)),
+			},
+			expectedResponse: api.PostOauthAuthorize200JSONResponse{
+				Client:          clientInfo,
+				Scopes:          []string{"openid", "email", "me"},
+				ConsentRequired: false,
+				RedirectTo:      ptr("https://app.example.com/callback?code="),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "consent denied",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(userRoles, nil)
+				mock.EXPECT().GetOAuth2ConsentScopes(gomock.Any(), consentParams).Return(
+					nil, pgx.ErrNoRows,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: body("openid", ptr(false)),
+			},
+			expectedResponse: api.PostOauthAuthorize200JSONResponse{
+				Client:          clientInfo,
+				Scopes:          []string{"openid"},
+				ConsentRequired: false,
+				RedirectTo: ptr(
+					"https://app.example.com/callback?error=access_denied&error_description=The+user+denied+the+request&state=af0ifjsldkj", //nolint:lll
+				),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "first party client",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(
+					firstPartyClient, nil,
+				)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(userRoles, nil)
+				mock.EXPECT().GetOAuth2ConsentScopes(gomock.Any(), consentParams).Return(
+					nil, pgx.ErrNoRows,
+				)
+				mock.EXPECT().UpsertOAuth2Consent(
+					gomock.Any(),
+					sql.UpsertOAuth2ConsentParams{
+						UserID:   userID,
+						ClientID: clientID,
+						Scopes:   []string{"openid"},
+					},
+				).Return(nil)
+				mock.EXPECT().InsertOAuth2AuthorizationCode(gomock.Any(), gomock.Any()).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: body("openid", nil),
+			},
+			expectedResponse: api.PostOauthAuthorize200JSONResponse{
+				Client:          clientInfo,
+				Scopes:          []string{"openid"},
+				ConsentRequired: false,
+				RedirectTo:      ptr("https://app.example.com/callback?code="),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "role the user doesn't have",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(userRoles, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: body("openid admin", ptr(true)),
+			},
+			expectedResponse: api.PostOauthAuthorize200JSONResponse{
+				Client:          clientInfo,
+				Scopes:          []string{"openid", "admin"},
+				ConsentRequired: false,
+				RedirectTo: ptr(
+					"https://app.example.com/callback?error=invalid_scope&error_description=The+user+doesn%27t+have+the+requested+roles&state=af0ifjsldkj", //nolint:lll
+				),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "redirect uri not registered",
+			config: getOIDCProviderConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: &api.PostOauthAuthorizeJSONRequestBody{
+					ClientId:            clientID,
+					RedirectUri:         "https://evil.example.com/callback",
+					Scope:               "openid",
+					State:               nil,
+					Nonce:               nil,
+					CodeChallenge:       nil,
+					CodeChallengeMethod: nil,
+					Consent:             ptr(true),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "redirectTo-not-allowed",
+				Message: `The value of "options.redirectTo" is not allowed.`,
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthAuthorizeRequestObject{
+				Body: body("openid", ptr(true)),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostOauthAuthorize, tc.request, tc.expectedResponse,
+				// the code is random, only the redirect URI up to it is compared
+				cmp.Transformer("redirectTo", func(s *string) *string {
+					if s == nil {
+						return nil
+					}
+					if before, _, ok := strings.Cut(*s, "?code="); ok {
+						return ptr(before + "?code=")
+					}
+					return s
+				}),
+			)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func inactiveToken() api.IntrospectResponse {
+	return api.IntrospectResponse{ //nolint:exhaustruct
+		Active: false,
+	}
+}
+
+func (ctrl *Controller) introspectAccessToken(
+	ctx context.Context, token string, logger *slog.Logger,
+) (api.IntrospectResponse, bool) {
+	jwtToken, err := ctrl.wf.jwtGetter.ValidateAccessToken(ctx, token)
+	if err != nil {
+		logger.Debug("token is not a valid access token", logError(err))
+		return inactiveToken(), false
+	}
+
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok {
+		return inactiveToken(), false
+	}
+
+	resp := api.IntrospectResponse{
+		Active:    true,
+		TokenType: ptr(api.IntrospectResponseTokenTypeAccessToken),
+		Sub:       nil,
+		Iss:       nil,
+		Iat:       nil,
+		Exp:       nil,
+		Claims:    nil,
+	}
+	if sub, err := claims.GetSubject(); err == nil {
+		resp.Sub = ptr(sub)
+	}
+	if iss, err := claims.GetIssuer(); err == nil {
+		resp.Iss = ptr(iss)
+	}
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		resp.Iat = ptr(iat.Unix())
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		resp.Exp = ptr(exp.Unix())
+	}
+	if hasuraClaims, ok := claims[ctrl.wf.jwtGetter.claimsNamespace].(map[string]any); ok {
+		resp.Claims = &hasuraClaims
+	}
+
+	return resp, true
+}
+
+// userIsActive returns false for users that can't refresh their session, e.g. disabled
+// ones, so their tokens are reported as inactive.
+func (ctrl *Controller) userIsActive(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) (bool, *APIError) {
+	if _, apiErr := ctrl.wf.GetUser(ctx, userID, logger); apiErr != nil {
+		if errors.Is(apiErr, ErrInternalServerError) {
+			return false, apiErr
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (ctrl *Controller) introspectPersonalAccessToken(
+	ctx context.Context, token string, logger *slog.Logger,
+) (api.IntrospectResponse, bool, *APIError) {
+	pat, err := ctrl.wf.db.GetPersonalAccessTokenByHash(ctx, hashRefreshToken([]byte(token)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return inactiveToken(), false, nil
+	}
+	if err != nil {
+		logger.Error("error getting personal access token", logError(err))
+		return inactiveToken(), false, ErrInternalServerError
+	}
+
+	if active, apiErr := ctrl.userIsActive(ctx, pat.UserID, logger); !active {
+		return inactiveToken(), false, apiErr
+	}
+
+	resp := api.IntrospectResponse{
+		Active:    true,
+		TokenType: ptr(api.IntrospectResponseTokenTypePersonalAccessToken),
+		Sub:       ptr(pat.UserID.String()),
+		Iss:       nil,
+		Iat:       ptr(pat.CreatedAt.Time.Unix()),
+		Exp:       nil,
+		Claims:    nil,
+	}
+	if pat.ExpiresAt.Valid {
+		resp.Exp = ptr(pat.ExpiresAt.Time.Unix())
+	}
+
+	return resp, true, nil
+}
+
+// introspectRefreshToken looks the token up among the refresh tokens and, if it isn't
+// one, among the personal access tokens.
+func (ctrl *Controller) introspectRefreshToken(
+	ctx context.Context, token string, logger *slog.Logger,
+) (api.IntrospectResponse, bool, *APIError) {
+	refreshToken, err := ctrl.wf.db.GetRefreshTokenByHash(
+		ctx, hashRefreshToken([]byte(token)),
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ctrl.introspectPersonalAccessToken(ctx, token, logger)
+	}
+	if err != nil {
+		logger.Error("error getting refresh token", logError(err))
+		return inactiveToken(), false, ErrInternalServerError
+	}
+
+	if active, apiErr := ctrl.userIsActive(ctx, refreshToken.UserID, logger); !active {
+		return inactiveToken(), false, apiErr
+	}
+
+	return api.IntrospectResponse{
+		Active:    true,
+		TokenType: ptr(api.IntrospectResponseTokenTypeRefreshToken),
+		Sub:       ptr(refreshToken.UserID.String()),
+		Iss:       nil,
+		Iat:       ptr(refreshToken.CreatedAt.Time.Unix()),
+		Exp:       ptr(refreshToken.ExpiresAt.Time.Unix()),
+		Claims:    nil,
+	}, true, nil
+}
+
+func (ctrl *Controller) PostOauthIntrospect( //nolint:ireturn
+	ctx context.Context,
+	request api.PostOauthIntrospectRequestObject,
+) (api.PostOauthIntrospectResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	refreshFirst := request.Body.TokenTypeHint != nil &&
+		*request.Body.TokenTypeHint == api.IntrospectRequestTokenTypeHintRefreshToken
+
+	if !refreshFirst {
+		if resp, ok := ctrl.introspectAccessToken(ctx, request.Body.Token, logger); ok {
+			return api.PostOauthIntrospect200JSONResponse(resp), nil
+		}
+	}
+
+	resp, ok, apiErr := ctrl.introspectRefreshToken(ctx, request.Body.Token, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	if ok || !refreshFirst {
+		return api.PostOauthIntrospect200JSONResponse(resp), nil
+	}
+
+	resp, _ = ctrl.introspectAccessToken(ctx, request.Body.Token, logger)
+	return api.PostOauthIntrospect200JSONResponse(resp), nil
+}
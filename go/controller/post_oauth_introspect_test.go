@@ -0,0 +1,313 @@
+package controller_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostOauthIntrospect(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	refreshToken := "1fb17604-86c7-444e-b337-09a644465f2d"
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	accessToken := func(t *testing.T, jwtGetter *controller.JWTGetter) string {
+		t.Helper()
+
+		token, _, err := jwtGetter.GetToken(
+			context.Background(), userID, false, []string{"user", "me"}, "user", nil,
+			slog.Default(),
+		)
+		if err != nil {
+			t.Fatalf("error getting token: %v", err)
+		}
+		return token
+	}
+
+	activeAccessToken := api.PostOauthIntrospect200JSONResponse{
+		Active:    true,
+		TokenType: ptr(api.IntrospectResponseTokenTypeAccessToken),
+		Sub:       ptr(userID.String()),
+		Iss:       ptr("hasura-auth"),
+		Iat:       nil,
+		Exp:       nil,
+		Claims: &map[string]any{
+			"x-hasura-allowed-roles":     []any{"user", "me"},
+			"x-hasura-default-role":      "user",
+			"x-hasura-user-id":           userID.String(),
+			"x-hasura-user-is-anonymous": "false",
+		},
+	}
+
+	inactive := api.PostOauthIntrospect200JSONResponse{ //nolint:exhaustruct
+		Active: false,
+	}
+
+	cases := []struct {
+		name             string
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		token            func(t *testing.T, jwtGetter *controller.JWTGetter) string
+		tokenTypeHint    *api.IntrospectRequestTokenTypeHint
+		expectedResponse api.PostOauthIntrospectResponseObject
+		ignoreTimes      bool
+	}{
+		{
+			name: "access token",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			token:            accessToken,
+			tokenTypeHint:    nil,
+			expectedResponse: activeAccessToken,
+			ignoreTimes:      true,
+		},
+		{
+			name: "access token with refresh token hint",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRefreshTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthRefreshToken{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetPersonalAccessTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthPersonalAccessToken{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			token:            accessToken,
+			tokenTypeHint:    ptr(api.IntrospectRequestTokenTypeHintRefreshToken),
+			expectedResponse: activeAccessToken,
+			ignoreTimes:      true,
+		},
+		{
+			name: "refresh token",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRefreshTokenByHash(
+					gomock.Any(),
+					"\\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310",
+				).Return(sql.AuthRefreshToken{ //nolint:exhaustruct
+					ID:        uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+					CreatedAt: sql.TimestampTz(createdAt),
+					ExpiresAt: sql.TimestampTz(createdAt.Add(30 * 24 * time.Hour)),
+					UserID:    userID,
+					Type:      sql.RefreshTokenTypeRegular,
+				}, nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			token: func(*testing.T, *controller.JWTGetter) string {
+				return refreshToken
+			},
+			tokenTypeHint: ptr(api.IntrospectRequestTokenTypeHintRefreshToken),
+			expectedResponse: api.PostOauthIntrospect200JSONResponse{
+				Active:    true,
+				TokenType: ptr(api.IntrospectResponseTokenTypeRefreshToken),
+				Sub:       ptr(userID.String()),
+				Iss:       nil,
+				Iat:       ptr(createdAt.Unix()),
+				Exp:       ptr(createdAt.Add(30 * 24 * time.Hour).Unix()),
+				Claims:    nil,
+			},
+			ignoreTimes: false,
+		},
+		{
+			name: "personal access token",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRefreshTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthRefreshToken{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetPersonalAccessTokenByHash(
+					gomock.Any(),
+					"\\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310",
+				).Return(sql.AuthPersonalAccessToken{ //nolint:exhaustruct
+					ID:        uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+					CreatedAt: sql.TimestampTz(createdAt),
+					ExpiresAt: sql.TimestampTz(createdAt.Add(365 * 24 * time.Hour)),
+					UserID:    userID,
+					Name:      "my-pat",
+				}, nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			token: func(*testing.T, *controller.JWTGetter) string {
+				return refreshToken
+			},
+			tokenTypeHint: nil,
+			expectedResponse: api.PostOauthIntrospect200JSONResponse{
+				Active:    true,
+				TokenType: ptr(api.IntrospectResponseTokenTypePersonalAccessToken),
+				Sub:       ptr(userID.String()),
+				Iss:       nil,
+				Iat:       ptr(createdAt.Unix()),
+				Exp:       ptr(createdAt.Add(365 * 24 * time.Hour).Unix()),
+				Claims:    nil,
+			},
+			ignoreTimes: false,
+		},
+		{
+			name: "personal access token without expiration",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRefreshTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthRefreshToken{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetPersonalAccessTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthPersonalAccessToken{ //nolint:exhaustruct
+					ID:        uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+					CreatedAt: sql.TimestampTz(createdAt),
+					UserID:    userID,
+				}, nil)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			token: func(*testing.T, *controller.JWTGetter) string {
+				return refreshToken
+			},
+			tokenTypeHint: ptr(api.IntrospectRequestTokenTypeHintRefreshToken),
+			expectedResponse: api.PostOauthIntrospect200JSONResponse{
+				Active:    true,
+				TokenType: ptr(api.IntrospectResponseTokenTypePersonalAccessToken),
+				Sub:       ptr(userID.String()),
+				Iss:       nil,
+				Iat:       ptr(createdAt.Unix()),
+				Exp:       nil,
+				Claims:    nil,
+			},
+			ignoreTimes: false,
+		},
+		{
+			name: "refresh token of a disabled user",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRefreshTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthRefreshToken{ //nolint:exhaustruct
+					ID:        uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"),
+					CreatedAt: sql.TimestampTz(createdAt),
+					ExpiresAt: sql.TimestampTz(createdAt.Add(30 * 24 * time.Hour)),
+					UserID:    userID,
+					Type:      sql.RefreshTokenTypeRegular,
+				}, nil)
+
+				user := getSigninUser(userID)
+				user.Disabled = true
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+				return mock
+			},
+			token: func(*testing.T, *controller.JWTGetter) string {
+				return refreshToken
+			},
+			tokenTypeHint:    nil,
+			expectedResponse: inactive,
+			ignoreTimes:      false,
+		},
+		{
+			name: "unknown token",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetRefreshTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthRefreshToken{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetPersonalAccessTokenByHash(
+					gomock.Any(), gomock.Any(),
+				).Return(sql.AuthPersonalAccessToken{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			token: func(*testing.T, *controller.JWTGetter) string {
+				return "not-a-token"
+			},
+			tokenTypeHint:    ptr(api.IntrospectRequestTokenTypeHintRefreshToken),
+			expectedResponse: inactive,
+			ignoreTimes:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, getConfig, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			var opts []cmp.Option
+			if tc.ignoreTimes {
+				opts = append(opts, cmpopts.IgnoreFields(
+					api.PostOauthIntrospect200JSONResponse{}, "Iat", "Exp", //nolint:exhaustruct
+				))
+			}
+
+			assertRequest(
+				context.Background(),
+				t,
+				c.PostOauthIntrospect,
+				api.PostOauthIntrospectRequestObject{
+					Body: &api.PostOauthIntrospectFormdataRequestBody{
+						Token:         tc.token(t, jwtGetter),
+						TokenTypeHint: tc.tokenTypeHint,
+					},
+				},
+				tc.expectedResponse,
+				opts...,
+			)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// clientCredentials returns the credentials of the client from the basic authorization
+// header or, if the header isn't set, from the request body (RFC 6749 section 2.3.1).
+func clientCredentials(request api.PostOauthTokenRequestObject) (string, string, bool) {
+	if request.Params.Authorization == nil {
+		if request.Body.ClientId == nil || request.Body.ClientSecret == nil {
+			return "", "", false
+		}
+		return *request.Body.ClientId, *request.Body.ClientSecret, true
+	}
+
+	encoded, ok := strings.CutPrefix(*request.Params.Authorization, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	id, secret, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return "", "", false
+	}
+
+	// the client id and secret are form encoded before being base64 encoded
+	id, err = url.QueryUnescape(id)
+	if err != nil {
+		return "", "", false
+	}
+	secret, err = url.QueryUnescape(secret)
+	if err != nil {
+		return "", "", false
+	}
+
+	return id, secret, true
+}
+
+func (ctrl *Controller) authenticateOAuth2Client(
+	ctx context.Context, clientID, clientSecret string, logger *slog.Logger,
+) (sql.AuthOauth2Client, *APIError) {
+	client, err := ctrl.wf.db.GetOAuth2Client(ctx, clientID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("oauth2 client not found")
+		return sql.AuthOauth2Client{}, ErrInvalidClient //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting oauth2 client", logError(err))
+		return sql.AuthOauth2Client{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if !secretMatches(hashRefreshToken([]byte(clientSecret)), client.ClientSecretHash) {
+		logger.Warn("invalid client secret")
+		return sql.AuthOauth2Client{}, ErrInvalidClient //nolint:exhaustruct
+	}
+
+	return client, nil
+}
+
+// scopedRoles returns the roles requested in the scope and the default role among them.
+// An empty scope requests all the allowed roles.
+func scopedRoles(
+	allowedRoles []string, defaultRole string, scope *string,
+) ([]string, string, *APIError) {
+	roles := strings.Fields(deptr(scope))
+	if len(roles) == 0 {
+		return allowedRoles, defaultRole, nil
+	}
+
+	for _, role := range roles {
+		if !slices.Contains(allowedRoles, role) {
+			return nil, "", ErrRoleNotAllowed
+		}
+	}
+
+	if slices.Contains(roles, defaultRole) {
+		return roles, defaultRole, nil
+	}
+	return roles, roles[0], nil
+}
+
+func (ctrl *Controller) postOauthTokenClientCredentials(
+	client sql.AuthOauth2Client, request api.PostOauthTokenRequestObject, logger *slog.Logger,
+) (api.PostOauthTokenResponseObject, *APIError) {
+	roles, defaultRole, apiErr := scopedRoles(
+		client.AllowedRoles, client.DefaultRole, request.Body.Scope,
+	)
+	if apiErr != nil {
+		logger.Warn("client requested a role it isn't allowed to use")
+		return nil, apiErr
+	}
+
+	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetClientToken(
+		client.ClientID, roles, defaultRole,
+	)
+	if err != nil {
+		logger.Error("error getting access token", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	return api.PostOauthToken200JSONResponse{
+		AccessToken:     accessToken,
+		TokenType:       api.Bearer,
+		ExpiresIn:       expiresIn,
+		Scope:           strings.Join(roles, " "),
+		IssuedTokenType: nil,
+		IdToken:         nil,
+	}, nil
+}
+
+// subjectTokenRoles returns the allowed roles and the default role of the subject token.
+func (ctrl *Controller) subjectTokenRoles(token *jwt.Token) ([]string, string) {
+	claims, _ := token.Claims.(jwt.MapClaims)
+	hasuraClaims, _ := claims[ctrl.wf.jwtGetter.claimsNamespace].(map[string]any)
+	allowedRoles, _ := hasuraClaims["x-hasura-allowed-roles"].([]any)
+
+	roles := make([]string, 0, len(allowedRoles))
+	for _, role := range allowedRoles {
+		if role, ok := role.(string); ok {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, ctrl.wf.jwtGetter.GetCustomClaim(token, "x-hasura-default-role")
+}
+
+func (ctrl *Controller) postOauthTokenTokenExchange(
+	ctx context.Context,
+	client sql.AuthOauth2Client,
+	request api.PostOauthTokenRequestObject,
+	logger *slog.Logger,
+) (api.PostOauthTokenResponseObject, *APIError) {
+	if request.Body.SubjectToken == nil || request.Body.SubjectTokenType == nil {
+		logger.Warn("missing subject token")
+		return nil, ErrInvalidRequest
+	}
+
+	subject, err := ctrl.wf.jwtGetter.ValidateAccessToken(ctx, *request.Body.SubjectToken)
+	if err != nil {
+		logger.Warn("invalid subject token", logError(err))
+		return nil, ErrInvalidSubjectToken
+	}
+
+	// tokens issued to clients can't be exchanged, only the ones of users
+	userID, err := ctrl.wf.jwtGetter.GetUserID(subject)
+	if err != nil {
+		logger.Warn("subject token doesn't belong to a user", logError(err))
+		return nil, ErrInvalidSubjectToken
+	}
+	logger = logger.With(slog.String("user_id", userID.String()))
+
+	subjectRoles, subjectDefaultRole := ctrl.subjectTokenRoles(subject)
+	roles, defaultRole, apiErr := scopedRoles(
+		subjectRoles, subjectDefaultRole, request.Body.Scope,
+	)
+	if apiErr != nil {
+		logger.Warn("client requested a role the subject token doesn't have")
+		return nil, apiErr
+	}
+
+	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetExchangedToken(
+		subject,
+		client.ClientID,
+		roles,
+		defaultRole,
+		time.Duration(ctrl.config.TokenExchangeExpiresIn)*time.Second,
+	)
+	if err != nil {
+		logger.Error("error getting exchanged access token", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	if err := ctrl.wf.db.InsertTokenExchange(ctx, sql.InsertTokenExchangeParams{
+		ClientID:  client.ClientID,
+		UserID:    userID,
+		Roles:     roles,
+		ExpiresAt: sql.TimestampTz(time.Now().Add(time.Duration(expiresIn) * time.Second)),
+	}); err != nil {
+		logger.Error("error recording token exchange", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	logger.Info("access token exchanged")
+
+	return api.PostOauthToken200JSONResponse{
+		AccessToken: accessToken,
+		TokenType:   api.Bearer,
+		ExpiresIn:   expiresIn,
+		Scope:       strings.Join(roles, " "),
+		IssuedTokenType: ptr(
+			api.OAuth2TokenResponseIssuedTokenTypeUrnIetfParamsOauthTokenTypeAccessToken,
+		),
+		IdToken: nil,
+	}, nil
+}
+
+func (ctrl *Controller) postOauthTokenAuthorizationCode( //nolint:funlen
+	ctx context.Context,
+	client sql.AuthOauth2Client,
+	request api.PostOauthTokenRequestObject,
+	logger *slog.Logger,
+) (api.PostOauthTokenResponseObject, *APIError) {
+	if !ctrl.config.OIDCProviderEnabled {
+		logger.Warn("oidc provider is disabled")
+		return nil, ErrDisabledEndpoint
+	}
+
+	if request.Body.Code == nil || request.Body.RedirectUri == nil {
+		logger.Warn("missing code or redirect uri")
+		return nil, ErrInvalidRequest
+	}
+
+	code, apiErr := ctrl.wf.ConsumeOAuth2AuthorizationCode(
+		ctx, client.ClientID, *request.Body.Code, logger,
+	)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	logger = logger.With(slog.String("user_id", code.UserID.String()))
+
+	if code.RedirectUri != *request.Body.RedirectUri {
+		logger.Warn("redirect uri doesn't match the authorization request")
+		return nil, ErrInvalidGrant
+	}
+
+	if code.CodeChallenge.Valid &&
+		!verifyCodeChallenge(code.CodeChallenge.String, deptr(request.Body.CodeVerifier)) {
+		logger.Warn("invalid code verifier")
+		return nil, ErrInvalidGrant
+	}
+
+	// the user might have been disabled since the code was granted
+	user, apiErr := ctrl.wf.GetUser(ctx, code.UserID, logger)
+	if apiErr != nil {
+		return nil, ErrInvalidGrant
+	}
+
+	allowedRoles, apiErr := ctrl.wf.GetUserAllowedRoles(ctx, user.ID, logger)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	roles, defaultRole, apiErr := scopedRoles(
+		allowedRoles, user.DefaultRole, ptr(strings.Join(oidcScopeRoles(code.Scopes), " ")),
+	)
+	if apiErr != nil {
+		logger.Warn("user no longer has the requested roles")
+		return nil, ErrInvalidGrant
+	}
+
+	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetToken(
+		ctx,
+		user.ID,
+		user.IsAnonymous,
+		roles,
+		defaultRole,
+		map[string]any{"x-hasura-client-id": client.ClientID},
+		logger,
+	)
+	if err != nil {
+		logger.Error("error getting access token", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	userClaims, err := oidcIDTokenClaims(user, code.Scopes)
+	if err != nil {
+		logger.Error("error getting id token claims", logError(err))
+		return nil, ErrInternalServerError
+	}
+	idToken, err := ctrl.wf.jwtGetter.GetIDToken(
+		ctrl.config.ServerURL.String(), client.ClientID, user.ID, userClaims, code.Nonce.String,
+	)
+	if err != nil {
+		logger.Error("error getting id token", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	logger.Info("authorization code exchanged")
+
+	return api.PostOauthToken200JSONResponse{
+		AccessToken:     accessToken,
+		TokenType:       api.Bearer,
+		ExpiresIn:       expiresIn,
+		Scope:           strings.Join(code.Scopes, " "),
+		IssuedTokenType: nil,
+		IdToken:         &idToken,
+	}, nil
+}
+
+// oauth2ClientGrantAllowed reports if the client can use the grant type. Token exchange is
+// enabled on its own, with tokenExchangeEnabled, the other grants are in its grant types.
+func oauth2ClientGrantAllowed(
+	client sql.AuthOauth2Client, grantType api.OAuth2TokenRequestGrantType,
+) bool {
+	if grantType == api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange {
+		return client.TokenExchangeEnabled
+	}
+	return slices.Contains(client.GrantTypes, string(grantType))
+}
+
+func (ctrl *Controller) PostOauthToken( //nolint:ireturn
+	ctx context.Context,
+	request api.PostOauthTokenRequestObject,
+) (api.PostOauthTokenResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("grant_type", string(request.Body.GrantType)))
+
+	clientID, clientSecret, ok := clientCredentials(request)
+	if !ok {
+		logger.Warn("missing or malformed client credentials")
+		return ctrl.sendError(ErrInvalidClient), nil
+	}
+	logger = logger.With(slog.String("client_id", clientID))
+
+	client, apiErr := ctrl.authenticateOAuth2Client(ctx, clientID, clientSecret, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if !oauth2ClientGrantAllowed(client, request.Body.GrantType) {
+		logger.Warn("client is not allowed to use the grant type")
+		return ctrl.sendError(ErrUnauthorizedClient), nil
+	}
+
+	var resp api.PostOauthTokenResponseObject
+	switch request.Body.GrantType {
+	case api.OAuth2TokenRequestGrantTypeClientCredentials:
+		resp, apiErr = ctrl.postOauthTokenClientCredentials(client, request, logger)
+	case api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange:
+		resp, apiErr = ctrl.postOauthTokenTokenExchange(ctx, client, request, logger)
+	case api.OAuth2TokenRequestGrantTypeAuthorizationCode:
+		resp, apiErr = ctrl.postOauthTokenAuthorizationCode(ctx, client, request, logger)
+	default:
+		logger.Warn("unsupported grant type")
+		apiErr = ErrInvalidRequest
+	}
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return resp, nil
+}
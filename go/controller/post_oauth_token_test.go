@@ -0,0 +1,1065 @@
+package controller_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostOauthToken(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	clientID := "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"
+	clientSecret := "1fb17604-86c7-444e-b337-09a644465f2d"
+	client := sql.AuthOauth2Client{ //nolint:exhaustruct
+		ClientID:         clientID,
+		ClientSecretHash: "\\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310",
+		DefaultRole:      "service",
+		AllowedRoles:     []string{"service", "reports"},
+		GrantTypes:       []string{"client_credentials"},
+	}
+	basicAuth := "Basic " + base64.StdEncoding.EncodeToString(
+		[]byte(clientID+":"+clientSecret),
+	)
+
+	clientJWT := func(roles []any, defaultRole string) *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{"alg": "HS256", "typ": "JWT"},
+			Claims: jwt.MapClaims{
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles": roles,
+					"x-hasura-default-role":  defaultRole,
+					"x-hasura-client-id":     clientID,
+				},
+				"iss": "hasura-auth",
+				"sub": clientID,
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	exchangeClient := client
+	exchangeClient.TokenExchangeEnabled = true
+
+	subjectJWTGetter, err := controller.NewJWTGetter(
+		jwtSecret, nil, 15*time.Minute, nil, "", false, nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create jwt getter: %v", err)
+	}
+	subjectToken, _, err := subjectJWTGetter.GetToken(
+		context.Background(), userID, false, []string{"user", "me", "editor"}, "user", nil,
+		slog.Default(),
+	)
+	if err != nil {
+		t.Fatalf("failed to get subject token: %v", err)
+	}
+	clientToken, _, err := subjectJWTGetter.GetClientToken(clientID, []string{"service"}, "service")
+	if err != nil {
+		t.Fatalf("failed to get client token: %v", err)
+	}
+
+	exchangedJWT := func(roles []any, defaultRole string) *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{"alg": "HS256", "typ": "JWT"},
+			Claims: jwt.MapClaims{
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     roles,
+					"x-hasura-default-role":      defaultRole,
+					"x-hasura-user-id":           userID.String(),
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"act": map[string]any{"sub": clientID},
+				"iss": "hasura-auth",
+				"sub": userID.String(),
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	oidcClient := client
+	oidcClient.RedirectUris = []string{"https://app.example.com/callback"}
+	oidcClient.GrantTypes = []string{"authorization_code"}
+	oidcConfig := func() *controller.Config {
+		cfg := getConfig()
+		cfg.OIDCProviderEnabled = true
+		return cfg
+	}
+	authorizationCode := "8f2ac1e4-3f5b-4b8e-9d0a-6c1f2e3d4b5a"
+	authorizationCodeHash := sha256.Sum256([]byte(authorizationCode))
+	codeVerifier := "dBjftJeZ4CVP-mJ92K9eWa2wD0qB5YPyXiQb5GQoZjQ"
+	codeChallenge := sha256.Sum256([]byte(codeVerifier))
+	storedCode := func(challenge string) sql.AuthOauth2AuthorizationCode {
+		return sql.AuthOauth2AuthorizationCode{ //nolint:exhaustruct
+			ExpiresAt:     sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+			ClientID:      clientID,
+			UserID:        userID,
+			RedirectUri:   "https://app.example.com/callback",
+			Scopes:        []string{"openid", "profile"},
+			Nonce:         sql.Text("n-0S6_WzA2Mj"),
+			CodeChallenge: sql.NullableText(challenge),
+		}
+	}
+	deleteCodeParams := sql.DeleteOAuth2AuthorizationCodeParams{
+		CodeHash: "\\x" + hex.EncodeToString(authorizationCodeHash[:]),
+		ClientID: clientID,
+	}
+	authorizationCodeBody := func(verifier *string) *api.PostOauthTokenFormdataRequestBody {
+		return &api.PostOauthTokenFormdataRequestBody{
+			GrantType:        api.OAuth2TokenRequestGrantTypeAuthorizationCode,
+			ClientId:         nil,
+			ClientSecret:     nil,
+			Scope:            nil,
+			SubjectToken:     nil,
+			SubjectTokenType: nil,
+			Code:             ptr(authorizationCode),
+			CodeVerifier:     verifier,
+			RedirectUri:      ptr("https://app.example.com/callback"),
+		}
+	}
+	userJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{"alg": "HS256", "typ": "JWT"},
+		Claims: jwt.MapClaims{
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           userID.String(),
+				"x-hasura-user-is-anonymous": "false",
+				"x-hasura-client-id":         clientID,
+			},
+			"iss": "hasura-auth",
+			"sub": userID.String(),
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	cases := []testRequest[api.PostOauthTokenRequestObject, api.PostOauthTokenResponseObject]{
+		{
+			name:   "basic authentication",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: api.PostOauthToken200JSONResponse{
+				AccessToken:     "",
+				TokenType:       api.Bearer,
+				ExpiresIn:       900,
+				Scope:           "service reports",
+				IssuedTokenType: nil,
+				IdToken:         nil,
+			},
+			expectedJWT: clientJWT([]any{"service", "reports"}, "service"),
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "credentials in body with scope",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: nil,
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         ptr(clientID),
+					ClientSecret:     ptr(clientSecret),
+					Scope:            ptr("reports"),
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: api.PostOauthToken200JSONResponse{
+				AccessToken:     "",
+				TokenType:       api.Bearer,
+				ExpiresIn:       900,
+				Scope:           "reports",
+				IssuedTokenType: nil,
+				IdToken:         nil,
+			},
+			expectedJWT: clientJWT([]any{"reports"}, "reports"),
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "role not allowed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            ptr("service admin"),
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "wrong secret",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: nil,
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         ptr(clientID),
+					ClientSecret:     ptr("wrong-secret"),
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-client",
+				Message: "Invalid client credentials",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "unknown client",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(
+					gomock.Any(), clientID,
+				).Return(sql.AuthOauth2Client{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-client",
+				Message: "Invalid client credentials",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "missing credentials",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr("Bearer " + clientSecret),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         ptr(clientID),
+					ClientSecret:     ptr(clientSecret),
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-client",
+				Message: "Invalid client credentials",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(exchangeClient, nil)
+
+				mock.EXPECT().InsertTokenExchange(
+					gomock.Any(),
+					cmpDBParams(sql.InsertTokenExchangeParams{
+						ClientID:  clientID,
+						UserID:    userID,
+						Roles:     []string{"user", "me", "editor"},
+						ExpiresAt: sql.TimestampTz(time.Now().Add(300 * time.Second)),
+					}),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     ptr(subjectToken),
+					SubjectTokenType: ptr(api.OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken),
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: api.PostOauthToken200JSONResponse{
+				AccessToken: "",
+				TokenType:   api.Bearer,
+				ExpiresIn:   300,
+				Scope:       "user me editor",
+				IssuedTokenType: ptr(
+					api.OAuth2TokenResponseIssuedTokenTypeUrnIetfParamsOauthTokenTypeAccessToken,
+				),
+				IdToken: nil,
+			},
+			expectedJWT: exchangedJWT([]any{"user", "me", "editor"}, "user"),
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange with scope",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(exchangeClient, nil)
+
+				mock.EXPECT().InsertTokenExchange(
+					gomock.Any(),
+					cmpDBParams(sql.InsertTokenExchangeParams{
+						ClientID:  clientID,
+						UserID:    userID,
+						Roles:     []string{"me"},
+						ExpiresAt: sql.TimestampTz(time.Now().Add(300 * time.Second)),
+					}),
+				).Return(nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            ptr("me"),
+					SubjectToken:     ptr(subjectToken),
+					SubjectTokenType: ptr(api.OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken),
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: api.PostOauthToken200JSONResponse{
+				AccessToken: "",
+				TokenType:   api.Bearer,
+				ExpiresIn:   300,
+				Scope:       "me",
+				IssuedTokenType: ptr(
+					api.OAuth2TokenResponseIssuedTokenTypeUrnIetfParamsOauthTokenTypeAccessToken,
+				),
+				IdToken: nil,
+			},
+			expectedJWT: exchangedJWT([]any{"me"}, "me"),
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange with role the subject doesn't have",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(exchangeClient, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            ptr("me admin"),
+					SubjectToken:     ptr(subjectToken),
+					SubjectTokenType: ptr(api.OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken),
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange not enabled for client",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     ptr(subjectToken),
+					SubjectTokenType: ptr(api.OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken),
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unauthorized-client",
+				Message: "Client is not allowed to use this grant type",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "client credentials not allowed for client",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeClientCredentials,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unauthorized-client",
+				Message: "Client is not allowed to use this grant type",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange missing subject token",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(exchangeClient, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange invalid subject token",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(exchangeClient, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     ptr("not-a-jwt"),
+					SubjectTokenType: ptr(api.OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken),
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-subject-token",
+				Message: "Invalid or expired subject token",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "token exchange with client token",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(exchangeClient, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeUrnIetfParamsOauthGrantTypeTokenExchange,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     ptr(clientToken),
+					SubjectTokenType: ptr(api.OAuth2TokenRequestSubjectTokenTypeUrnIetfParamsOauthTokenTypeAccessToken),
+					Code:             nil,
+					CodeVerifier:     nil,
+					RedirectUri:      nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-subject-token",
+				Message: "Invalid or expired subject token",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code",
+			config: oidcConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				mock.EXPECT().DeleteOAuth2AuthorizationCode(
+					gomock.Any(), deleteCodeParams,
+				).Return(storedCode(""), nil)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{
+						{UserID: userID, Role: "user"}, //nolint:exhaustruct
+						{UserID: userID, Role: "me"},   //nolint:exhaustruct
+					}, nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: authorizationCodeBody(nil),
+			},
+			expectedResponse: api.PostOauthToken200JSONResponse{
+				AccessToken:     "",
+				TokenType:       api.Bearer,
+				ExpiresIn:       900,
+				Scope:           "openid profile",
+				IssuedTokenType: nil,
+				IdToken:         ptr(""),
+			},
+			expectedJWT: userJWT,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code not allowed for client",
+			config: oidcConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(client, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: authorizationCodeBody(nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unauthorized-client",
+				Message: "Client is not allowed to use this grant type",
+				Status:  403,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code with pkce",
+			config: oidcConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				mock.EXPECT().DeleteOAuth2AuthorizationCode(
+					gomock.Any(), deleteCodeParams,
+				).Return(storedCode(base64.RawURLEncoding.EncodeToString(codeChallenge[:])), nil)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{
+						{UserID: userID, Role: "user"}, //nolint:exhaustruct
+						{UserID: userID, Role: "me"},   //nolint:exhaustruct
+					}, nil,
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: authorizationCodeBody(ptr(codeVerifier)),
+			},
+			expectedResponse: api.PostOauthToken200JSONResponse{
+				AccessToken:     "",
+				TokenType:       api.Bearer,
+				ExpiresIn:       900,
+				Scope:           "openid profile",
+				IssuedTokenType: nil,
+				IdToken:         ptr(""),
+			},
+			expectedJWT: userJWT,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code with wrong code verifier",
+			config: oidcConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				mock.EXPECT().DeleteOAuth2AuthorizationCode(
+					gomock.Any(), deleteCodeParams,
+				).Return(storedCode(base64.RawURLEncoding.EncodeToString(codeChallenge[:])), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: authorizationCodeBody(ptr("wrong-verifier")),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-grant",
+				Message: "Invalid, expired or already used authorization code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code already used",
+			config: oidcConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				mock.EXPECT().DeleteOAuth2AuthorizationCode(
+					gomock.Any(), deleteCodeParams,
+				).Return(sql.AuthOauth2AuthorizationCode{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: authorizationCodeBody(nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-grant",
+				Message: "Invalid, expired or already used authorization code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code with different redirect uri",
+			config: oidcConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				mock.EXPECT().DeleteOAuth2AuthorizationCode(
+					gomock.Any(), deleteCodeParams,
+				).Return(storedCode(""), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: &api.PostOauthTokenFormdataRequestBody{
+					GrantType:        api.OAuth2TokenRequestGrantTypeAuthorizationCode,
+					ClientId:         nil,
+					ClientSecret:     nil,
+					Scope:            nil,
+					SubjectToken:     nil,
+					SubjectTokenType: nil,
+					Code:             ptr(authorizationCode),
+					CodeVerifier:     nil,
+					RedirectUri:      ptr("https://evil.example.com/callback"),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-grant",
+				Message: "Invalid, expired or already used authorization code",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "authorization code oidc provider disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetOAuth2Client(gomock.Any(), clientID).Return(oidcClient, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostOauthTokenRequestObject{
+				Params: api.PostOauthTokenParams{
+					Authorization: ptr(basicAuth),
+				},
+				Body: authorizationCodeBody(nil),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostOauthToken, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(
+					api.PostOauthToken200JSONResponse{}, //nolint:exhaustruct
+					"AccessToken", "IdToken",
+				),
+			)
+
+			token, ok := resp.(api.PostOauthToken200JSONResponse)
+			if !ok {
+				return
+			}
+
+			expected, _ := tc.expectedResponse.(api.PostOauthToken200JSONResponse)
+			if (token.IdToken == nil) != (expected.IdToken == nil) {
+				t.Fatalf("unexpected id token: %v", token.IdToken)
+			}
+			if token.IdToken != nil {
+				assertIDToken(t, *token.IdToken, clientID, userID.String(), "n-0S6_WzA2Mj")
+			}
+
+			jwtToken, err := jwtGetter.Validate(token.AccessToken)
+			if err != nil {
+				t.Fatalf("failed to validate access token: %v", err)
+			}
+			if diff := cmp.Diff(
+				jwtToken,
+				tc.expectedJWT,
+				cmpopts.IgnoreFields(jwt.Token{}, "Raw", "Signature"), //nolint:exhaustruct
+				cmpopts.IgnoreMapEntries(func(k string, _ any) bool {
+					return k == "iat" || k == "exp"
+				}),
+			); diff != "" {
+				t.Fatalf("unexpected jwt: %s", diff)
+			}
+		})
+	}
+}
+
+func assertIDToken(t *testing.T, idToken, audience, subject, nonce string) {
+	t.Helper()
+
+	// the signature is the same as the access tokens', only the claims differ
+	token, _, err := jwt.NewParser().ParseUnverified(idToken, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("failed to parse id token: %v", err)
+	}
+
+	claims, _ := token.Claims.(jwt.MapClaims)
+	for k, v := range map[string]string{
+		"iss":   "https://local.auth.nhost.run",
+		"aud":   audience,
+		"sub":   subject,
+		"nonce": nonce,
+		"name":  "Jane Doe",
+	} {
+		if claims[k] != v {
+			t.Errorf("unexpected %s claim in id token: %v", k, claims[k])
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostOrganizations( //nolint:ireturn
+	ctx context.Context,
+	request api.PostOrganizationsRequestObject,
+) (api.PostOrganizationsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if user.IsAnonymous {
+		logger.Warn("anonymous users can't create organizations")
+		return ctrl.sendError(ErrForbiddenAnonymous), nil
+	}
+
+	name := strings.TrimSpace(request.Body.Name)
+	if name == "" {
+		logger.Warn("organization name is empty")
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	organization, apiErr := ctrl.wf.CreateOrganization(ctx, user.ID, name, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostOrganizations200JSONResponse(*organization), nil
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostOrganizationsInvitesAccept( //nolint:ireturn
+	ctx context.Context,
+	request api.PostOrganizationsInvitesAcceptRequestObject,
+) (api.PostOrganizationsInvitesAcceptResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	organization, apiErr := ctrl.wf.AcceptOrganizationInvite(
+		ctx, user, request.Body.Ticket, logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostOrganizationsInvitesAccept200JSONResponse(*organization), nil
+}
@@ -0,0 +1,153 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostOrganizationsInvitesAccept(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	organizationID := uuid.MustParse("7a2f5a0c-3b1e-4f0a-9d4e-5c6b7a8d9e0f")
+	ticket := "organizationInvite:3f1c5d2e-9a7b-4c6d-8e5f-1a2b3c4d5e6f"
+
+	cases := []testRequest[api.PostOrganizationsInvitesAcceptRequestObject, api.PostOrganizationsInvitesAcceptResponseObject]{ //nolint:lll
+		{
+			name:   "success",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().AcceptOrganizationInvite(
+					gomock.Any(),
+					sql.AcceptOrganizationInviteParams{
+						Ticket: ticket,
+						Email:  "jane@acme.com",
+						UserID: userID,
+					},
+				).Return(sql.AcceptOrganizationInviteRow{
+					ID:        organizationID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+					Name:      "Acme",
+					Role:      "admin",
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsInvitesAcceptRequestObject{
+				Body: &api.AcceptOrganizationInviteRequest{
+					Ticket: ticket,
+				},
+			},
+			expectedResponse: api.PostOrganizationsInvitesAccept200JSONResponse{
+				Id:        organizationID,
+				Name:      "Acme",
+				CreatedAt: time.Now(),
+				Role:      api.OrganizationRoleAdmin,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "invalid ticket",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().AcceptOrganizationInvite(
+					gomock.Any(),
+					sql.AcceptOrganizationInviteParams{
+						Ticket: ticket,
+						Email:  "jane@acme.com",
+						UserID: userID,
+					},
+				).Return(sql.AcceptOrganizationInviteRow{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsInvitesAcceptRequestObject{
+				Body: &api.AcceptOrganizationInviteRequest{
+					Ticket: ticket,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid or expired verification ticket",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "unverified email",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsInvitesAcceptRequestObject{
+				Body: &api.AcceptOrganizationInviteRequest{
+					Ticket: ticket,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unverified-user",
+				Message: "User is not verified.",
+				Status:  401,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostOrganizationsInvitesAccept, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostOrganizationsOrganizationIdInvites( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PostOrganizationsOrganizationIdInvitesRequestObject,
+) (api.PostOrganizationsOrganizationIdInvitesResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("organization_id", request.OrganizationId.String()))
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	role := deptr(request.Body.Role)
+	if role == "" {
+		role = api.OrganizationRoleMember
+	}
+	if _, ok := organizationRoleRanks[role]; !ok {
+		logger.Warn("unknown organization role", slog.String("invited_role", string(role)))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	options, apiErr := ctrl.wf.ValidateOptionsRedirectTo(request.Body.Options, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	member, apiErr := ctrl.wf.GetOrganizationMember(ctx, request.OrganizationId, user.ID, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.InviteOrganizationMember(
+		ctx,
+		member,
+		user.ID,
+		string(request.Body.Email),
+		role,
+		deptr(options.RedirectTo),
+		logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostOrganizationsOrganizationIdInvites200JSONResponse(api.OK), nil
+}
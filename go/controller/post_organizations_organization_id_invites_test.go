@@ -0,0 +1,286 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func getOrganizationMember(
+	organizationID uuid.UUID, role string,
+) sql.GetOrganizationMemberRow {
+	return sql.GetOrganizationMemberRow{
+		ID:        organizationID,
+		CreatedAt: sql.TimestampTz(time.Now()),
+		Name:      "Acme",
+		Role:      role,
+	}
+}
+
+func TestPostOrganizationsOrganizationIdInvites(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	organizationID := uuid.MustParse("7a2f5a0c-3b1e-4f0a-9d4e-5c6b7a8d9e0f")
+
+	cases := []testRequest[api.PostOrganizationsOrganizationIdInvitesRequestObject, api.PostOrganizationsOrganizationIdInvitesResponseObject]{ //nolint:lll
+		{
+			name:   "success",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: organizationID,
+						UserID:         userID,
+					},
+				).Return(getOrganizationMember(organizationID, "admin"), nil)
+
+				mock.EXPECT().UpsertOrganizationInvite(
+					gomock.Any(),
+					cmpDBParams(
+						sql.UpsertOrganizationInviteParams{
+							OrganizationID: organizationID,
+							Email:          "john@acme.com",
+							Role:           "member",
+							Ticket:         "organizationInvite:xxx",
+							InvitedBy:      pgtype.UUID{Bytes: userID, Valid: true},
+							ExpiresAt:      sql.TimestampTz(time.Now().Add(7 * 24 * time.Hour)),
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+					),
+				).Return(nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"john@acme.com",
+					"en",
+					notifications.TemplateNameOrganizationInvite,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "http://localhost:3000?ticket=organizationInvite%3A3f1c5d2e-9a7b-4c6d-8e5f-1a2b3c4d5e6f&type=organizationInvite", //nolint:lll
+							DisplayName:      "",
+							Email:            "john@acme.com",
+							NewEmail:         "",
+							Ticket:           "organizationInvite:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "Acme",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsOrganizationIdInvitesRequestObject{
+				OrganizationId: organizationID,
+				Body: &api.OrganizationInviteRequest{
+					Email:   "john@acme.com",
+					Role:    nil,
+					Options: nil,
+				},
+			},
+			expectedResponse: api.PostOrganizationsOrganizationIdInvites200JSONResponse(api.OK),
+			expectedJWT:      nil,
+		},
+
+		{
+			name:   "member can't invite",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: organizationID,
+						UserID:         userID,
+					},
+				).Return(getOrganizationMember(organizationID, "member"), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsOrganizationIdInvitesRequestObject{
+				OrganizationId: organizationID,
+				Body: &api.OrganizationInviteRequest{
+					Email:   "john@acme.com",
+					Role:    nil,
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-organization-role",
+				Message: "The user's role in the organization doesn't allow this operation",
+				Status:  403,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "admin can't invite owner",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: organizationID,
+						UserID:         userID,
+					},
+				).Return(getOrganizationMember(organizationID, "admin"), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsOrganizationIdInvitesRequestObject{
+				OrganizationId: organizationID,
+				Body: &api.OrganizationInviteRequest{
+					Email:   "john@acme.com",
+					Role:    ptr(api.OrganizationRoleOwner),
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-organization-role",
+				Message: "The user's role in the organization doesn't allow this operation",
+				Status:  403,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "not a member",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: organizationID,
+						UserID:         userID,
+					},
+				).Return(sql.GetOrganizationMemberRow{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsOrganizationIdInvitesRequestObject{
+				OrganizationId: organizationID,
+				Body: &api.OrganizationInviteRequest{
+					Email:   "john@acme.com",
+					Role:    nil,
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "organization-not-found",
+				Message: "Organization not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "unknown role",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsOrganizationIdInvitesRequestObject{
+				OrganizationId: organizationID,
+				Body: &api.OrganizationInviteRequest{
+					Email:   "john@acme.com",
+					Role:    ptr(api.OrganizationRole("superuser")),
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{ //nolint:exhaustruct
+				emailer: tc.emailer,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostOrganizationsOrganizationIdInvites, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostOrganizationsSwitch( //nolint:ireturn
+	ctx context.Context,
+	request api.PostOrganizationsSwitchRequestObject,
+) (api.PostOrganizationsSwitchResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.OrganizationsEnabled {
+		logger.Warn("organizations are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	user, apiErr = ctrl.wf.SwitchOrganization(ctx, user, request.Body.OrganizationId, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostOrganizationsSwitch200JSONResponse{
+		Session: session,
+	}, nil
+}
@@ -0,0 +1,238 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostOrganizationsSwitch(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	organizationID := uuid.MustParse("7a2f5a0c-3b1e-4f0a-9d4e-5c6b7a8d9e0f")
+
+	session := &api.Session{
+		AccessToken:          "",
+		AccessTokenExpiresIn: 900,
+		RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+		RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+		User: &api.User{
+			AvatarUrl:           "",
+			CreatedAt:           time.Now(),
+			DefaultRole:         "user",
+			DisplayName:         "Jane Doe",
+			Email:               ptr(types.Email("jane@acme.com")),
+			EmailVerified:       true,
+			Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+			IsAnonymous:         false,
+			Locale:              "en",
+			Metadata:            map[string]any{},
+			PhoneNumber:         "",
+			PhoneNumberVerified: false,
+			Roles:               []string{"user", "me"},
+		},
+	}
+
+	sessionJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "false",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	newSessionMocks := func(mock *mock.MockDBClient) {
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "user"}, //nolint:exhaustruct
+			{UserID: userID, Role: "me"},   //nolint:exhaustruct
+		}, nil)
+
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: "",
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(refreshTokenID, nil)
+
+		mock.EXPECT().UpdateUserLastSeen(
+			gomock.Any(), userID,
+		).Return(sql.TimestampTz(time.Now()), nil)
+	}
+
+	cases := []testRequest[api.PostOrganizationsSwitchRequestObject, api.PostOrganizationsSwitchResponseObject]{ //nolint:lll
+		{
+			name:   "switch",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: organizationID,
+						UserID:         userID,
+					},
+				).Return(getOrganizationMember(organizationID, "member"), nil)
+
+				user := getSigninUser(userID)
+				user.ActiveOrganizationID = pgtype.UUID{Bytes: organizationID, Valid: true}
+				mock.EXPECT().UpdateUserActiveOrganization(
+					gomock.Any(),
+					sql.UpdateUserActiveOrganizationParams{
+						ID:                   userID,
+						ActiveOrganizationID: pgtype.UUID{Bytes: organizationID, Valid: true},
+					},
+				).Return(user, nil)
+
+				newSessionMocks(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsSwitchRequestObject{
+				Body: &api.SwitchOrganizationRequest{
+					OrganizationId: ptr(organizationID),
+				},
+			},
+			expectedResponse: api.PostOrganizationsSwitch200JSONResponse{
+				Session: session,
+			},
+			expectedJWT: sessionJWT,
+		},
+
+		{
+			name:   "leave organizations",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().UpdateUserActiveOrganization(
+					gomock.Any(),
+					sql.UpdateUserActiveOrganizationParams{
+						ID:                   userID,
+						ActiveOrganizationID: pgtype.UUID{}, //nolint:exhaustruct
+					},
+				).Return(getSigninUser(userID), nil)
+
+				newSessionMocks(mock)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsSwitchRequestObject{
+				Body: &api.SwitchOrganizationRequest{
+					OrganizationId: nil,
+				},
+			},
+			expectedResponse: api.PostOrganizationsSwitch200JSONResponse{
+				Session: session,
+			},
+			expectedJWT: sessionJWT,
+		},
+
+		{
+			name:   "not a member",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetOrganizationMember(
+					gomock.Any(),
+					sql.GetOrganizationMemberParams{
+						OrganizationID: organizationID,
+						UserID:         userID,
+					},
+				).Return(sql.GetOrganizationMemberRow{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsSwitchRequestObject{
+				Body: &api.SwitchOrganizationRequest{
+					OrganizationId: ptr(organizationID),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "organization-not-found",
+				Message: "Organization not found",
+				Status:  404,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			resp := assertRequest(
+				ctx, t, c.PostOrganizationsSwitch, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostOrganizationsSwitch200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func getOrganizationsConfig() *controller.Config {
+	config := getConfig()
+	config.OrganizationsEnabled = true
+	return config
+}
+
+func TestPostOrganizations(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	organizationID := uuid.MustParse("7a2f5a0c-3b1e-4f0a-9d4e-5c6b7a8d9e0f")
+
+	cases := []testRequest[api.PostOrganizationsRequestObject, api.PostOrganizationsResponseObject]{
+		{
+			name:   "success",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().InsertOrganization(
+					gomock.Any(),
+					sql.InsertOrganizationParams{
+						Name:   "Acme",
+						UserID: userID,
+					},
+				).Return(sql.InsertOrganizationRow{
+					ID:        organizationID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+					Name:      "Acme",
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name: " Acme ",
+				},
+			},
+			expectedResponse: api.PostOrganizations200JSONResponse{
+				Id:        organizationID,
+				Name:      "Acme",
+				CreatedAt: time.Now(),
+				Role:      api.OrganizationRoleOwner,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "empty name",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name: "  ",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "anonymous user",
+			config: getOrganizationsConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.IsAnonymous = true
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name: "Acme",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "forbidden-anonymous",
+				Message: "Forbidden, user is anonymous.",
+				Status:  403,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostOrganizationsRequestObject{
+				Body: &api.CreateOrganizationRequest{
+					Name: "Acme",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{}) //nolint:exhaustruct
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(ctx, t, c.PostOrganizations, tc.request, tc.expectedResponse)
+		})
+	}
+}
@@ -6,9 +6,20 @@ import (
 	"github.com/google/uuid"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
-	"github.com/nhost/hasura-auth/go/sql"
 )
 
+func patName(request api.CreatePATRequest) string {
+	if request.Name != nil {
+		return *request.Name
+	}
+	if request.Metadata != nil {
+		if name, ok := (*request.Metadata)["name"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
 func (ctrl *Controller) PostPat( //nolint:ireturn
 	ctx context.Context, request api.PostPatRequestObject,
 ) (api.PostPatResponseObject, error) {
@@ -19,12 +30,12 @@ func (ctrl *Controller) PostPat( //nolint:ireturn
 	}
 
 	pat := uuid.New()
-	refreshTokenID, apiErr := ctrl.wf.InsertRefreshtoken(
+	patID, apiErr := ctrl.wf.InsertPersonalAccessToken(
 		ctx,
 		user.ID,
 		pat.String(),
+		patName(*request.Body),
 		request.Body.ExpiresAt,
-		sql.RefreshTokenTypePAT,
 		deptr(request.Body.Metadata),
 		logger,
 	)
@@ -33,7 +44,7 @@ func (ctrl *Controller) PostPat( //nolint:ireturn
 	}
 
 	return api.PostPat200JSONResponse{
-		Id:                  refreshTokenID.String(),
+		Id:                  patID.String(),
 		PersonalAccessToken: pat.String(),
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
@@ -20,7 +21,7 @@ func TestPostPat(t *testing.T) {
 	t.Parallel()
 
 	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
-	refreshTokenID := uuid.MustParse("5030DC8E-9813-40C5-8522-80B36D53607D")
+	patID := uuid.MustParse("5030DC8E-9813-40C5-8522-80B36D53607D")
 
 	jwtTokenFn := func() *jwt.Token {
 		return &jwt.Token{
@@ -69,28 +70,29 @@ func TestPostPat(t *testing.T) {
 					}, nil)
 
 				mock.EXPECT().
-					InsertRefreshtoken(
+					InsertPersonalAccessToken(
 						gomock.Any(),
-						cmpDBParams(sql.InsertRefreshtokenParams{
-							UserID:           userID,
-							RefreshTokenHash: sql.Text("asdadasdasdasd"),
-							ExpiresAt:        sql.TimestampTz(time.Now().Add(time.Hour)),
-							Type:             "pat",
-							Metadata:         nil,
+						cmpDBParams(sql.InsertPersonalAccessTokenParams{
+							UserID:    userID,
+							Name:      "",
+							TokenHash: "asdadasdasdasd",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+							Metadata:  nil,
 						})).
-					Return(refreshTokenID, nil)
+					Return(patID, nil)
 
 				return mock
 			},
 			jwtTokenFn: jwtTokenFn,
 			request: api.PostPatRequestObject{
 				Body: &api.CreatePATRequest{
-					ExpiresAt: time.Now().Add(time.Hour),
+					Name:      nil,
+					ExpiresAt: ptr(time.Now().Add(time.Hour)),
 					Metadata:  nil,
 				},
 			},
 			expectedResponse: api.PostPat200JSONResponse{
-				Id:                  refreshTokenID.String(),
+				Id:                  patID.String(),
 				PersonalAccessToken: "",
 			},
 		},
@@ -109,28 +111,111 @@ func TestPostPat(t *testing.T) {
 					}, nil)
 
 				mock.EXPECT().
-					InsertRefreshtoken(
+					InsertPersonalAccessToken(
 						gomock.Any(),
-						cmpDBParams(sql.InsertRefreshtokenParams{
-							UserID:           userID,
-							RefreshTokenHash: sql.Text("asdadasdasdasd"),
-							ExpiresAt:        sql.TimestampTz(time.Now().Add(time.Hour)),
-							Type:             "pat",
-							Metadata:         []byte(`{"key":"value"}`),
+						cmpDBParams(sql.InsertPersonalAccessTokenParams{
+							UserID:    userID,
+							Name:      "",
+							TokenHash: "asdadasdasdasd",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+							Metadata:  []byte(`{"key":"value"}`),
 						})).
-					Return(refreshTokenID, nil)
+					Return(patID, nil)
 
 				return mock
 			},
 			jwtTokenFn: jwtTokenFn,
 			request: api.PostPatRequestObject{
 				Body: &api.CreatePATRequest{
-					ExpiresAt: time.Now().Add(time.Hour),
+					Name:      nil,
+					ExpiresAt: ptr(time.Now().Add(time.Hour)),
 					Metadata:  ptr(map[string]any{"key": "value"}),
 				},
 			},
 			expectedResponse: api.PostPat200JSONResponse{
-				Id:                  refreshTokenID.String(),
+				Id:                  patID.String(),
+				PersonalAccessToken: "",
+			},
+		},
+
+		{
+			name:   "with name in metadata",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().
+					GetUser(gomock.Any(), userID).
+					Return(sql.AuthUser{ //nolint:exhaustruct
+						ID:    userID,
+						Email: sql.Text("jane@acme.com"),
+					}, nil)
+
+				mock.EXPECT().
+					InsertPersonalAccessToken(
+						gomock.Any(),
+						cmpDBParams(sql.InsertPersonalAccessTokenParams{
+							UserID:    userID,
+							Name:      "my-pat",
+							TokenHash: "asdadasdasdasd",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+							Metadata:  []byte(`{"name":"my-pat"}`),
+						})).
+					Return(patID, nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.PostPatRequestObject{
+				Body: &api.CreatePATRequest{
+					Name:      nil,
+					ExpiresAt: ptr(time.Now().Add(time.Hour)),
+					Metadata:  ptr(map[string]any{"name": "my-pat"}),
+				},
+			},
+			expectedResponse: api.PostPat200JSONResponse{
+				Id:                  patID.String(),
+				PersonalAccessToken: "",
+			},
+		},
+
+		{
+			name:   "with name and no expiration",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().
+					GetUser(gomock.Any(), userID).
+					Return(sql.AuthUser{ //nolint:exhaustruct
+						ID:    userID,
+						Email: sql.Text("jane@acme.com"),
+					}, nil)
+
+				mock.EXPECT().
+					InsertPersonalAccessToken(
+						gomock.Any(),
+						cmpDBParams(sql.InsertPersonalAccessTokenParams{
+							UserID:    userID,
+							Name:      "ci",
+							TokenHash: "asdadasdasdasd",
+							ExpiresAt: pgtype.Timestamptz{}, //nolint:exhaustruct
+							Metadata:  nil,
+						})).
+					Return(patID, nil)
+
+				return mock
+			},
+			jwtTokenFn: jwtTokenFn,
+			request: api.PostPatRequestObject{
+				Body: &api.CreatePATRequest{
+					Name:      ptr("ci"),
+					ExpiresAt: nil,
+					Metadata:  nil,
+				},
+			},
+			expectedResponse: api.PostPat200JSONResponse{
+				Id:                  patID.String(),
 				PersonalAccessToken: "",
 			},
 		},
@@ -154,7 +239,8 @@ func TestPostPat(t *testing.T) {
 			jwtTokenFn: jwtTokenFn,
 			request: api.PostPatRequestObject{
 				Body: &api.CreatePATRequest{
-					ExpiresAt: time.Now().Add(time.Hour),
+					Name:      nil,
+					ExpiresAt: ptr(time.Now().Add(time.Hour)),
 					Metadata:  nil,
 				},
 			},
@@ -187,7 +273,8 @@ func TestPostPat(t *testing.T) {
 			jwtTokenFn: jwtTokenFn,
 			request: api.PostPatRequestObject{
 				Body: &api.CreatePATRequest{
-					ExpiresAt: time.Now().Add(time.Hour),
+					Name:      nil,
+					ExpiresAt: ptr(time.Now().Add(time.Hour)),
 					Metadata:  nil,
 				},
 			},
@@ -206,9 +293,23 @@ func TestPostPat(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: nil,
-				emailer:       nil,
-				hibp:          nil,
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
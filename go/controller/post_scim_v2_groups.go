@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostScimV2Groups( //nolint:ireturn
+	ctx context.Context,
+	request api.PostScimV2GroupsRequestObject,
+) (api.PostScimV2GroupsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.Body.DisplayName))
+
+	userIDs, err := scimMemberIDs(deptr(request.Body.Members))
+	if err != nil {
+		logger.Warn("invalid scim group", logError(err))
+		return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+	}
+
+	if apiErr := ctrl.wf.CreateRole(ctx, request.Body.DisplayName, logger); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.SetScimGroupMembers(
+		ctx, request.Body.DisplayName, userIDs, logger,
+	); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	group, apiErr := ctrl.getScimGroup(ctx, request.Body.DisplayName, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.PostScimV2Groups201ApplicationScimPlusJSONResponse(group), nil
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostScimV2Users( //nolint:ireturn
+	ctx context.Context,
+	request api.PostScimV2UsersRequestObject,
+) (api.PostScimV2UsersResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_name", request.Body.UserName))
+
+	input, err := scimUserInputFromUser(*request.Body)
+	if err != nil {
+		logger.Warn("invalid scim user", logError(err))
+		return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+	}
+
+	userID, apiErr := ctrl.wf.ProvisionScimUser(ctx, input, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetScimUser(ctx, userID, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.PostScimV2Users201ApplicationScimPlusJSONResponse(ctrl.scimUser(user)), nil
+}
@@ -0,0 +1,270 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostScimV2Users(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.PostScimV2UsersRequestObject, api.PostScimV2UsersResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithUserProvider(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertUserWithUserProviderParams{
+							ID:             uuid.UUID{},
+							Disabled:       false,
+							DisplayName:    "Jane Doe",
+							AvatarUrl:      "",
+							Email:          sql.Text("jane@acme.com"),
+							EmailVerified:  true,
+							Locale:         "en",
+							DefaultRole:    "user",
+							Metadata:       []byte("{}"),
+							Roles:          []string{"user", "me"},
+							ProviderID:     "scim",
+							ProviderUserID: "00u1ab2cd3",
+							AccessToken:    "",
+							RefreshToken:   pgtype.Text{}, //nolint:exhaustruct
+						},
+						cmpopts.IgnoreFields(sql.InsertUserWithUserProviderParams{}, "ID"), //nolint:exhaustruct
+					),
+				).Return(sql.InsertUserWithUserProviderRow{
+					UserID:    userID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+				}, nil)
+
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{getScimUsersRow(userID)}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostScimV2UsersRequestObject{
+				Body: &api.ScimUser{ //nolint:exhaustruct
+					Schemas:    &[]string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+					ExternalId: ptr("00u1ab2cd3"),
+					UserName:   "jane@acme.com",
+					Name: &api.ScimName{ //nolint:exhaustruct
+						GivenName:  ptr("Jane"),
+						FamilyName: ptr("Doe"),
+					},
+					Active: ptr(true),
+				},
+			},
+			expectedResponse: api.PostScimV2Users201ApplicationScimPlusJSONResponse(
+				getScimUser(userID),
+			),
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "success without external id",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUser(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertUserParams{
+							ID:              uuid.UUID{},
+							Disabled:        true,
+							DisplayName:     "Jane",
+							AvatarUrl:       "",
+							Email:           sql.Text("jane@acme.com"),
+							PasswordHash:    pgtype.Text{}, //nolint:exhaustruct
+							Ticket:          pgtype.Text{}, //nolint:exhaustruct
+							TicketExpiresAt: sql.TimestampTz(time.Now()),
+							EmailVerified:   true,
+							Locale:          "en",
+							DefaultRole:     "user",
+							Metadata:        []byte("{}"),
+							Roles:           []string{"user", "me"},
+							PhoneNumber:     pgtype.Text{}, //nolint:exhaustruct
+							IsAnonymous:     false,
+						},
+						cmpopts.IgnoreFields(sql.InsertUserParams{}, "ID"), //nolint:exhaustruct
+					),
+				).Return(sql.InsertUserRow{
+					UserID:    userID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+				}, nil)
+
+				user := getScimUsersRow(userID)
+				user.DisplayName = "Jane"
+				user.Disabled = true
+				user.ExternalID = pgtype.Text{} //nolint:exhaustruct
+				mock.EXPECT().GetScimUsers(gomock.Any(), gomock.Any()).
+					Return([]sql.GetScimUsersRow{user}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostScimV2UsersRequestObject{
+				Body: &api.ScimUser{ //nolint:exhaustruct
+					UserName:    "jane@tenant.onmicrosoft.com",
+					DisplayName: ptr("Jane"),
+					Active:      ptr(false),
+					Emails: &[]api.ScimEmail{
+						{Value: "jane@acme.com", Type: ptr("work"), Primary: ptr(true)},
+					},
+				},
+			},
+			expectedResponse: func() api.PostScimV2Users201ApplicationScimPlusJSONResponse {
+				user := getScimUser(userID)
+				user.DisplayName = ptr("Jane")
+				user.Active = ptr(false)
+				user.ExternalId = nil
+				return api.PostScimV2Users201ApplicationScimPlusJSONResponse(user)
+			}(),
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "invalid email",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostScimV2UsersRequestObject{
+				Body: &api.ScimUser{ //nolint:exhaustruct
+					UserName: "jane",
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "400",
+				ScimType: ptr("invalidValue"),
+				Detail:   ptr("invalid value: jane isn't a valid email"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "email already in use",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUser(gomock.Any(), gomock.Any()).Return(
+					sql.InsertUserRow{}, //nolint:exhaustruct
+					errors.New(`ERROR: duplicate key value violates unique constraint "users_email_key" (SQLSTATE 23505)`), //nolint:goerr113,lll
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostScimV2UsersRequestObject{
+				Body: &api.ScimUser{ //nolint:exhaustruct
+					UserName: "jane@acme.com",
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "409",
+				ScimType: ptr("uniqueness"),
+				Detail:   ptr("Email already in use"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "external id already in use",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithUserProvider(gomock.Any(), gomock.Any()).Return(
+					sql.InsertUserWithUserProviderRow{}, //nolint:exhaustruct
+					errors.New(`ERROR: duplicate key value violates unique constraint "user_providers_provider_id_provider_user_id_key" (SQLSTATE 23505)`), //nolint:goerr113,lll
+				)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostScimV2UsersRequestObject{
+				Body: &api.ScimUser{ //nolint:exhaustruct
+					UserName:   "jane@acme.com",
+					ExternalId: ptr("00u1ab2cd3"),
+				},
+			},
+			expectedResponse: controller.ScimErrorResponse{
+				Schemas:  []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+				Status:   "409",
+				ScimType: ptr("uniqueness"),
+				Detail:   ptr("Provider account already linked"),
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostScimV2Users,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
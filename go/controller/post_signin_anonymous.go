@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) postSigninAnonymousValidateRequest(
+	request api.PostSigninAnonymousRequestObject,
+	logger *slog.Logger,
+) (*api.SignUpOptions, *APIError) {
+	if !ctrl.config.AnonymousUsersEnabled {
+		logger.Warn("anonymous users are disabled")
+		return nil, ErrDisabledEndpoint
+	}
+
+	options := &api.SignUpOptions{} //nolint:exhaustruct
+	if request.Body != nil {
+		options.DisplayName = request.Body.DisplayName
+		options.Locale = request.Body.Locale
+		options.Metadata = request.Body.Metadata
+	}
+
+	return ctrl.wf.ValidateSignUpOptions(options, "Anonymous User", SignInMethodAnonymous, logger)
+}
+
+func (ctrl *Controller) PostSigninAnonymous( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninAnonymousRequestObject,
+) (api.PostSigninAnonymousResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	options, apiErr := ctrl.postSigninAnonymousValidateRequest(request, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodAnonymous, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.SignUpUser(ctx, "", options, logger, SignupUserAnonymous())
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostSigninAnonymous200JSONResponse{Session: session}, nil
+}
@@ -0,0 +1,290 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostSigninAnonymous(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.AnonymousUsersEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+
+	insertUser := func(
+		mock *mock.MockDBClient, displayName string, locale string, metadata []byte,
+	) {
+		mock.EXPECT().InsertUser(
+			gomock.Any(),
+			cmpDBParams(sql.InsertUserParams{
+				ID:              uuid.UUID{},
+				Disabled:        false,
+				DisplayName:     displayName,
+				AvatarUrl:       "",
+				Email:           pgtype.Text{}, //nolint:exhaustruct
+				PasswordHash:    pgtype.Text{}, //nolint:exhaustruct
+				Ticket:          pgtype.Text{}, //nolint:exhaustruct
+				TicketExpiresAt: sql.TimestampTz(time.Now()),
+				EmailVerified:   false,
+				Locale:          locale,
+				DefaultRole:     "anonymous",
+				Metadata:        metadata,
+				Roles:           []string{"anonymous"},
+				PhoneNumber:     pgtype.Text{}, //nolint:exhaustruct
+				IsAnonymous:     true,
+			},
+				cmpopts.IgnoreFields(sql.InsertUserParams{}, "ID"), //nolint:exhaustruct
+			),
+		).Return(sql.InsertUserRow{
+			UserID:    userID,
+			CreatedAt: sql.TimestampTz(time.Now()),
+		}, nil)
+	}
+
+	newSession := func(mock *mock.MockDBClient) {
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "anonymous"}, //nolint:exhaustruct
+		}, nil)
+
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: "",
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(refreshTokenID, nil)
+
+		mock.EXPECT().UpdateUserLastSeen(
+			gomock.Any(), userID,
+		).Return(sql.TimestampTz(time.Now()), nil)
+	}
+
+	expectedJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"anonymous"},
+				"x-hasura-default-role":      "anonymous",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "true",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	cases := []testRequest[api.PostSigninAnonymousRequestObject, api.PostSigninAnonymousResponseObject]{ //nolint:lll
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				insertUser(mock, "Anonymous User", "en", []byte("null"))
+				newSession(mock)
+
+				return mock
+			},
+			request: api.PostSigninAnonymousRequestObject{
+				Body: nil,
+			},
+			expectedResponse: api.PostSigninAnonymous200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "anonymous",
+						DisplayName:         "Anonymous User",
+						Email:               nil,
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         true,
+						Locale:              "en",
+						Metadata:            nil,
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"anonymous"},
+					},
+				},
+			},
+			expectedJWT:   expectedJWT,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "with options",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				insertUser(mock, "Jane Doe", "es", []byte(`{"firstName":"Jane"}`))
+				newSession(mock)
+
+				return mock
+			},
+			request: api.PostSigninAnonymousRequestObject{
+				Body: &api.PostSigninAnonymousJSONRequestBody{
+					DisplayName:  ptr("Jane Doe"),
+					Locale:       ptr("es"),
+					Metadata:     &map[string]any{"firstName": "Jane"},
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: api.PostSigninAnonymous200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "anonymous",
+						DisplayName:         "Jane Doe",
+						Email:               nil,
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         true,
+						Locale:              "es",
+						Metadata:            map[string]any{"firstName": "Jane"},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"anonymous"},
+					},
+				},
+			},
+			expectedJWT:   expectedJWT,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name: "anonymous users disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.AnonymousUsersEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.PostSigninAnonymousRequestObject{
+				Body: nil,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name: "signup disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.DisableSignup = true
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			request: api.PostSigninAnonymousRequestObject{
+				Body: nil,
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "signup-disabled",
+				Message: "Sign up is disabled.",
+				Status:  403,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninAnonymous, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostSigninAnonymous200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
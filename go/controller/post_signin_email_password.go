@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"time"
 
@@ -15,7 +16,7 @@ func (ctrl *Controller) postSigninEmailPasswordWithTOTP( //nolint:ireturn
 	userID uuid.UUID,
 	logger *slog.Logger,
 ) (api.PostSigninEmailPasswordResponseObject, error) {
-	ticket := "mfaTotp:" + uuid.NewString()
+	ticket := generateTicket(TicketTypeMFATOTP)
 	expiresAt := time.Now().Add(In5Minutes)
 
 	if apiErr := ctrl.wf.SetTicket(ctx, userID, ticket, expiresAt, logger); apiErr != nil {
@@ -36,24 +37,63 @@ func (ctrl *Controller) PostSigninEmailPassword( //nolint:ireturn
 	logger := middleware.LoggerFromContext(ctx).
 		With(slog.String("email", string(request.Body.Email)))
 
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodEmailPassword, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	ip := middleware.ClientInfoFromContext(ctx).IP
+	if apiErr := ctrl.wf.CheckIPSignInLockout(ctx, ip, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
 	user, apiErr := ctrl.wf.GetUserByEmail(ctx, string(request.Body.Email), logger)
+	if errors.Is(apiErr, ErrUserEmailNotFound) {
+		if apiErr := ctrl.wf.RecordSignInFailure(ctx, nil, ip, logger); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+	}
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
 
-	if !verifyHashPassword(request.Body.Password, user.PasswordHash.String) {
+	if apiErr := ctrl.wf.CheckUserSignInLockout(user, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if !ctrl.wf.VerifyUserPassword(request.Body.Password, user.PasswordHash.String) {
 		logger.Warn("password doesn't match")
+		if apiErr := ctrl.wf.RecordSignInFailure(ctx, &user, ip, logger); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
 		return ctrl.sendError(ErrInvalidEmailPassword), nil
 	}
 
-	if user.ActiveMfaType.String == "totp" {
+	ctrl.wf.RehashPassword(ctx, user, request.Body.Password, logger)
+
+	if user.ActiveMfaType.String == MFATypeTOTP {
+		// NewSession checks the risk again once the second factor is verified but
+		// there's no point in challenging a sign in that would be blocked
+		if apiErr := ctrl.wf.CheckSignInRisk(ctx, user, logger); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+
+		if apiErr := ctrl.wf.ResetSignInFailures(ctx, user, logger); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+
 		return ctrl.postSigninEmailPasswordWithTOTP(ctx, user.ID, logger)
 	}
 
+	// NewSession checks the sign in risk, which accounts for the failures, so they are
+	// only reset once the session is issued
 	session, err := ctrl.wf.NewSession(ctx, user, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	if apiErr := ctrl.wf.ResetSignInFailures(ctx, user, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
 	}
 
 	return api.PostSigninEmailPassword200JSONResponse{
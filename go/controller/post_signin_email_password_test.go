@@ -2,21 +2,108 @@ package controller_test
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/geoip"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/risk"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
 )
 
+func getLockoutConfig() *controller.Config {
+	config := getConfig()
+	config.LockoutEnabled = true
+	config.LockoutBackoffAfter = 3
+	config.LockoutBackoffDelay = 1
+	config.LockoutThreshold = 10
+	config.LockoutIPThreshold = 100
+	config.LockoutDuration = 900
+	return config
+}
+
+func getNewDeviceConfig() *controller.Config {
+	config := getConfig()
+	config.NotifyNewDeviceSignIn = true
+	return config
+}
+
+func getArgon2idConfig() *controller.Config {
+	config := getConfig()
+	config.PasswordHashAlgorithm = controller.PasswordHashAlgorithmArgon2id
+	config.PasswordArgon2Memory = 65536
+	config.PasswordArgon2Iterations = 3
+	config.PasswordArgon2Parallelism = 4
+	return config
+}
+
+func getPepperConfig() *controller.Config {
+	config := getConfig()
+	config.PasswordPeppers = []controller.PasswordPepper{
+		{ID: "current", Secret: "current-pepper-secret"},
+		{ID: "previous", Secret: "previous-pepper-secret"},
+	}
+	return config
+}
+
+// pepperedPasswordHash returns the hash of the password peppered with the pepper of
+// getPepperConfig, or with an unknown one.
+func pepperedPasswordHash(pepperID string, password string) string {
+	mac := hmac.New(sha256.New, []byte(pepperID+"-pepper-secret"))
+	mac.Write([]byte(password))
+	hash, err := bcrypt.GenerateFromPassword(
+		[]byte(base64.RawStdEncoding.EncodeToString(mac.Sum(nil))), bcrypt.MinCost,
+	)
+	if err != nil {
+		panic(err)
+	}
+	return "$pepper$" + pepperID + string(hash)
+}
+
+// rehashedPassword matches the update replacing the old hash of the password of the user
+// with a new hash starting with prefix.
+func rehashedPassword(userID uuid.UUID, oldHash pgtype.Text, prefix string) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		arg, ok := x.(sql.UpdateUserRehashPasswordParams)
+		if !ok || arg.ID != userID || arg.OldPasswordHash != oldHash {
+			return false
+		}
+		return strings.HasPrefix(arg.PasswordHash.String, prefix)
+	})
+}
+
+func cmpLockoutTimes() []cmp.Option {
+	return []cmp.Option{
+		testhelpers.FilterPathLast(
+			[]string{".FailuresSince", "time()"}, cmpopts.EquateApproxTime(time.Minute),
+		),
+		testhelpers.FilterPathLast(
+			[]string{".LockedUntil", "time()"}, cmpopts.EquateApproxTime(time.Second),
+		),
+	}
+}
+
 func getSigninUser(userID uuid.UUID) sql.AuthUser {
 	//nolint:exhaustruct
 	return sql.AuthUser{
@@ -81,7 +168,7 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 					gomock.Any(),
 					cmpDBParams(sql.InsertRefreshtokenParams{
 						UserID:           userID,
-						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash: "",
 						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						Type:             sql.RefreshTokenTypeRegular,
 						Metadata:         nil,
@@ -153,14 +240,18 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 		},
 
 		{
-			name:   "with custom claims",
+			name:   "argon2 password hash",
 			config: getConfig,
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
+				user := getSigninUser(userID)
+				user.PasswordHash = sql.Text(
+					"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHRzb21lc2FsdA$gduXp+Z6iReEolmbyHn5V8s1EtJzmEvZfYoY/Fn/AeI", //nolint:lll
+				)
 				mock.EXPECT().GetUserByEmail(
 					gomock.Any(), sql.Text("jane@acme.com"),
-				).Return(getSigninUser(userID), nil)
+				).Return(user, nil)
 
 				mock.EXPECT().GetUserRoles(
 					gomock.Any(), userID,
@@ -173,7 +264,7 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 					gomock.Any(),
 					cmpDBParams(sql.InsertRefreshtokenParams{
 						UserID:           userID,
-						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash: "",
 						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						Type:             sql.RefreshTokenTypeRegular,
 						Metadata:         nil,
@@ -186,22 +277,9 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 
 				return mock
 			},
-			customClaimer: func(ctrl *gomock.Controller) controller.CustomClaimer {
-				mock := mock.NewMockCustomClaimer(ctrl)
-				mock.EXPECT().GetClaims(
-					gomock.Any(),
-					"db477732-48fa-4289-b694-2886a646b6eb",
-				).Return(map[string]any{
-					"claim1":      "value1",
-					"claim2":      "value2",
-					"claimArray":  []any{"value1", "value2"},
-					"claimObject": map[string]any{"key1": "value1", "key2": "value2"},
-					"claimNil":    nil,
-				}, nil)
-				return mock
-			},
-			hibp:    mock.NewMockHIBPClient,
-			emailer: mock.NewMockEmailer,
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
 			request: api.PostSigninEmailPasswordRequestObject{
 				Body: &api.PostSigninEmailPasswordJSONRequestBody{
 					Email:    "jane@acme.com",
@@ -244,11 +322,6 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 					"https://hasura.io/jwt/claims": map[string]any{
 						"x-hasura-allowed-roles":     []any{"user", "me"},
 						"x-hasura-default-role":      "user",
-						"x-hasura-claim1":            "value1",
-						"x-hasura-claim2":            "value2",
-						"x-hasura-claimarray":        `{"value1","value2"}`,
-						"x-hasura-claimnil":          "null",
-						"x-hasura-claimobject":       `{"key1":"value1","key2":"value2"}`,
 						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
 						"x-hasura-user-is-anonymous": "false",
 					},
@@ -263,79 +336,41 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 		},
 
 		{
-			name: "email not allowed",
-			config: func() *controller.Config {
-				cfg := getConfig()
-				cfg.AllowedEmails = []string{"asd@asd.com"}
-				return cfg
-			},
-			db: func(ctrl *gomock.Controller) controller.DBClient {
-				mock := mock.NewMockDBClient(ctrl)
-				mock.EXPECT().GetUserByEmail(
-					gomock.Any(), sql.Text("jane@acme.com"),
-				).Return(getSigninUser(userID), nil)
-				return mock
-			},
-			customClaimer: nil,
-			hibp:          mock.NewMockHIBPClient,
-			emailer:       mock.NewMockEmailer,
-			request: api.PostSigninEmailPasswordRequestObject{
-				Body: &api.PostSigninEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-				},
-			},
-			expectedResponse: controller.ErrorResponse{
-				Error:   "invalid-email-password",
-				Message: "Incorrect email or password",
-				Status:  401,
-			},
-			expectedJWT: nil,
-			jwtTokenFn:  nil,
-		},
-
-		{
-			name:   "user not found",
-			config: getConfig,
+			name:   "bcrypt password rehashed with argon2id",
+			config: getArgon2idConfig,
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
+				user := getSigninUser(userID)
 				mock.EXPECT().GetUserByEmail(
 					gomock.Any(), sql.Text("jane@acme.com"),
-				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+				).Return(user, nil)
 
-				return mock
-			},
-			customClaimer: nil,
-			hibp:          mock.NewMockHIBPClient,
-			emailer:       mock.NewMockEmailer,
-			request: api.PostSigninEmailPasswordRequestObject{
-				Body: &api.PostSigninEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-				},
-			},
-			expectedResponse: controller.ErrorResponse{
-				Error:   "invalid-email-password",
-				Message: "Incorrect email or password",
-				Status:  401,
-			},
-			expectedJWT: nil,
-			jwtTokenFn:  nil,
-		},
+				mock.EXPECT().UpdateUserRehashPassword(
+					gomock.Any(), rehashedPassword(userID, user.PasswordHash, "$argon2id$v=19$m=65536,t=3,p=4$"),
+				).Return(int64(1), nil)
 
-		{
-			name:   "disabled user",
-			config: getConfig,
-			db: func(ctrl *gomock.Controller) controller.DBClient {
-				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
 
-				user := getSigninUser(userID)
-				user.Disabled = true
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
 
-				mock.EXPECT().GetUserByEmail(
-					gomock.Any(), sql.Text("jane@acme.com"),
-				).Return(user, nil)
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
 
 				return mock
 			},
@@ -348,54 +383,65 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 					Password: "password",
 				},
 			},
-			expectedResponse: controller.ErrorResponse{
-				Error:   "disabled-user",
-				Message: "User is disabled",
-				Status:  401,
-			},
-			expectedJWT: nil,
-			jwtTokenFn:  nil,
-		},
-
-		{
-			name:   "wrong password",
-			config: getConfig,
-			db: func(ctrl *gomock.Controller) controller.DBClient {
-				mock := mock.NewMockDBClient(ctrl)
-
-				user := getSigninUser(userID)
-				mock.EXPECT().GetUserByEmail(
-					gomock.Any(), sql.Text("jane@acme.com"),
-				).Return(user, nil)
-
-				return mock
-			},
-			customClaimer: nil,
-			hibp:          mock.NewMockHIBPClient,
-			emailer:       mock.NewMockEmailer,
-			request: api.PostSigninEmailPasswordRequestObject{
-				Body: &api.PostSigninEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "wrongpassword",
+			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
 				},
 			},
-			expectedResponse: controller.ErrorResponse{
-				Error:   "invalid-email-password",
-				Message: "Incorrect email or password",
-				Status:  401,
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
 			},
-			expectedJWT: nil,
-			jwtTokenFn:  nil,
+			jwtTokenFn: nil,
 		},
 
 		{
-			name:   "user not verified but verification disabled",
-			config: getConfig,
+			name:   "argon2id password with the parameters of the config",
+			config: getArgon2idConfig,
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
 				user := getSigninUser(userID)
-				user.EmailVerified = false
+				user.PasswordHash = sql.Text(
+					"$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHRzb21lc2FsdA$gduXp+Z6iReEolmbyHn5V8s1EtJzmEvZfYoY/Fn/AeI", //nolint:lll
+				)
 				mock.EXPECT().GetUserByEmail(
 					gomock.Any(), sql.Text("jane@acme.com"),
 				).Return(user, nil)
@@ -411,7 +457,7 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 					gomock.Any(),
 					cmpDBParams(sql.InsertRefreshtokenParams{
 						UserID:           userID,
-						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash: "",
 						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						Type:             sql.RefreshTokenTypeRegular,
 						Metadata:         nil,
@@ -446,7 +492,7 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:         "user",
 						DisplayName:         "Jane Doe",
 						Email:               ptr(types.Email("jane@acme.com")),
-						EmailVerified:       false,
+						EmailVerified:       true,
 						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
 						IsAnonymous:         false,
 						Locale:              "en",
@@ -483,21 +529,39 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 		},
 
 		{
-			name: "user not verified",
-			config: func() *controller.Config {
-				cfg := getConfig()
-				cfg.RequireEmailVerification = true
-				return cfg
-			},
+			name:   "peppered password",
+			config: getPepperConfig,
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
 				user := getSigninUser(userID)
-				user.EmailVerified = false
+				user.PasswordHash = sql.Text(pepperedPasswordHash("current", "password"))
 				mock.EXPECT().GetUserByEmail(
 					gomock.Any(), sql.Text("jane@acme.com"),
 				).Return(user, nil)
 
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
 				return mock
 			},
 			customClaimer: nil,
@@ -506,38 +570,95 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 			request: api.PostSigninEmailPasswordRequestObject{
 				Body: &api.PostSigninEmailPasswordJSONRequestBody{
 					Email:    "jane@acme.com",
-					Password: "wrongpassword",
+					Password: "password",
 				},
 			},
-			expectedResponse: controller.ErrorResponse{
-				Error:   "unverified-user",
-				Message: "User is not verified.",
-				Status:  401,
-			},
-			expectedJWT: nil,
-			jwtTokenFn:  nil,
-		},
-
-		{
-			name:   "totp enabled",
-			config: getConfig,
+			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+		},
+
+		{
+			name:   "password peppered with a previous pepper rehashed",
+			config: getPepperConfig,
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
 				user := getSigninUser(userID)
-				user.ActiveMfaType = sql.Text("totp")
+				user.PasswordHash = sql.Text(pepperedPasswordHash("previous", "password"))
 				mock.EXPECT().GetUserByEmail(
 					gomock.Any(), sql.Text("jane@acme.com"),
 				).Return(user, nil)
 
-				mock.EXPECT().UpdateUserTicket(
+				mock.EXPECT().UpdateUserRehashPassword(
+					gomock.Any(), rehashedPassword(userID, user.PasswordHash, "$pepper$current$2a$"),
+				).Return(int64(1), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
 					gomock.Any(),
-					cmpDBParams(sql.UpdateUserTicketParams{
-						ID:              userID,
-						Ticket:          sql.Text("mfaTotp:xxxx"),
-						TicketExpiresAt: sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
 					}),
-				).Return(userID, nil)
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
 
 				return mock
 			},
@@ -551,35 +672,2247 @@ func TestPostSigninEmailPassword(t *testing.T) { //nolint:maintidx
 				},
 			},
 			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
-				Mfa: &api.MFAChallengePayload{
-					Ticket: "mfaTotp:xxxx",
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
 				},
-				Session: nil,
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+		},
+
+		{
+			name:   "password peppered with an unknown pepper",
+			config: getPepperConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.PasswordHash = sql.Text(pepperedPasswordHash("unknown", "password"))
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
 			},
 			expectedJWT: nil,
 			jwtTokenFn:  nil,
 		},
-	}
 
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
+		{
+			name:   "with custom claims",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
 
-			ctrl := gomock.NewController(t)
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
 
-			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: tc.customClaimer,
-				emailer:       nil,
-				hibp:          nil,
-			})
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
 
-			resp := assertRequest(
-				context.Background(), t, c.PostSigninEmailPassword, tc.request, tc.expectedResponse,
-			)
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
 
-			resp200, ok := resp.(api.PostSigninEmailPassword200JSONResponse)
-			if ok {
-				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			customClaimer: func(ctrl *gomock.Controller) controller.CustomClaimer {
+				mock := mock.NewMockCustomClaimer(ctrl)
+				mock.EXPECT().GetClaims(
+					gomock.Any(),
+					"db477732-48fa-4289-b694-2886a646b6eb",
+				).Return(map[string]any{
+					"claim1":      "value1",
+					"claim2":      "value2",
+					"claimArray":  []any{"value1", "value2"},
+					"claimObject": map[string]any{"key1": "value1", "key2": "value2"},
+					"claimNil":    nil,
+				}, nil)
+				return mock
+			},
+			hibp:    mock.NewMockHIBPClient,
+			emailer: mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-claim1":            "value1",
+						"x-hasura-claim2":            "value2",
+						"x-hasura-claimarray":        `{"value1","value2"}`,
+						"x-hasura-claimnil":          "null",
+						"x-hasura-claimobject":       `{"key1":"value1","key2":"value2"}`,
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+		},
+
+		{
+			name: "email not allowed",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.AllowedEmails = []string{"asd@asd.com"}
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "disabled user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.Disabled = true
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-user",
+				Message: "User is disabled",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user scheduled for deletion",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.DeletionScheduledAt = sql.TimestampTz(time.Now().Add(24 * time.Hour))
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-user",
+				Message: "User is disabled",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name: "user of another tenant",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.TenantID = "acme"
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.TenantID = sql.Text("globex")
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "wrong password",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "wrongpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not verified but verification disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+		},
+
+		{
+			name: "user not verified",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.RequireEmailVerification = true
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.EmailVerified = false
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "wrongpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "unverified-user",
+				Message: "User is not verified.",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "totp enabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.ActiveMfaType = sql.Text("totp")
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().UpdateUserTicket(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserTicketParams{
+						ID:              userID,
+						Ticket:          sql.Text("mfaTotp:xxxx"),
+						TicketExpiresAt: sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+					}),
+				).Return(userID, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
+				Mfa: &api.MFAChallengePayload{
+					Ticket: "mfaTotp:xxxx",
+				},
+				Session: nil,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    tc.customClaimer,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninEmailPassword, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostSigninEmailPassword200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
+
+func TestPostSigninEmailPasswordLockout(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.PostSigninEmailPasswordRequestObject, api.PostSigninEmailPasswordResponseObject]{
+		{
+			name:   "ip locked out",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetIPLockedUntil(
+					gomock.Any(), "192.168.1.1",
+				).Return(sql.TimestampTz(time.Now().Add(time.Minute)), nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "sign-in-locked",
+				Message: "Too many failed sign in attempts, try again later",
+				Status:  429,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user locked out",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetIPLockedUntil(
+					gomock.Any(), "192.168.1.1",
+				).Return(pgtype.Timestamptz{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				user := getSigninUser(userID)
+				user.FailedSignInAttempts = 10
+				user.LockedUntil = sql.TimestampTz(time.Now().Add(time.Minute))
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "sign-in-locked",
+				Message: "Too many failed sign in attempts, try again later",
+				Status:  429,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetIPLockedUntil(
+					gomock.Any(), "192.168.1.1",
+				).Return(sql.TimestampTz(time.Now().Add(-time.Minute)), nil)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().RecordIPSignInFailure(
+					gomock.Any(),
+					cmpDBParams(sql.RecordIPSignInFailureParams{
+						Ip:            "192.168.1.1",
+						FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(int32(100), nil)
+
+				mock.EXPECT().UpdateIPLockedUntil(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateIPLockedUntilParams{
+						Ip:          "192.168.1.1",
+						LockedUntil: sql.TimestampTz(time.Now().Add(900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "wrong password backs off",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetIPLockedUntil(
+					gomock.Any(), "192.168.1.1",
+				).Return(pgtype.Timestamptz{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RecordIPSignInFailure(
+					gomock.Any(),
+					cmpDBParams(sql.RecordIPSignInFailureParams{
+						Ip:            "192.168.1.1",
+						FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(int32(5), nil)
+
+				mock.EXPECT().RecordUserSignInFailure(
+					gomock.Any(),
+					cmpDBParams(sql.RecordUserSignInFailureParams{
+						ID:            userID,
+						FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(int32(6), nil)
+
+				mock.EXPECT().UpdateUserLockedUntil(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserLockedUntilParams{
+						ID:          userID,
+						LockedUntil: sql.TimestampTz(time.Now().Add(4 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "wrongpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "wrong password locks out user",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetIPLockedUntil(
+					gomock.Any(), "192.168.1.1",
+				).Return(pgtype.Timestamptz{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RecordIPSignInFailure(
+					gomock.Any(),
+					cmpDBParams(sql.RecordIPSignInFailureParams{
+						Ip:            "192.168.1.1",
+						FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(int32(10), nil)
+
+				mock.EXPECT().RecordUserSignInFailure(
+					gomock.Any(),
+					cmpDBParams(sql.RecordUserSignInFailureParams{
+						ID:            userID,
+						FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(int32(10), nil)
+
+				mock.EXPECT().UpdateUserLockedUntil(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserLockedUntilParams{
+						ID:          userID,
+						LockedUntil: sql.TimestampTz(time.Now().Add(900 * time.Second)),
+					}, cmpLockoutTimes()...),
+				).Return(nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNameAccountLocked,
+					notifications.TemplateData{
+						Link:             "",
+						DisplayName:      "Jane Doe",
+						Email:            "jane@acme.com",
+						NewEmail:         "",
+						Ticket:           "",
+						RedirectTo:       "",
+						Locale:           "en",
+						ServerURL:        "https://local.auth.nhost.run",
+						ClientURL:        "http://localhost:3000",
+						Code:             "",
+						IPAddress:        "",
+						UserAgent:        "",
+						OrganizationName: "",
+						Location:         "",
+					},
+				).Return(nil)
+
+				return mock
+			},
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "wrongpassword",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-email-password",
+				Message: "Incorrect email or password",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "success resets failures",
+			config: getLockoutConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetIPLockedUntil(
+					gomock.Any(), "192.168.1.1",
+				).Return(pgtype.Timestamptz{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				user := getSigninUser(userID)
+				user.FailedSignInAttempts = 4
+				user.LockedUntil = sql.TimestampTz(time.Now().Add(-time.Second))
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().ResetUserSignInFailures(gomock.Any(), userID).Return(int64(1), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+						IpAddress:        sql.Text("192.168.1.1"),
+						UserAgent:        pgtype.Text{}, //nolint:exhaustruct
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: api.PostSigninEmailPassword200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := middleware.ClientInfoToContext(
+				context.Background(), middleware.ClientInfo{IP: "192.168.1.1", UserAgent: ""},
+			)
+			assertRequest(ctx, t, c.PostSigninEmailPassword, tc.request, tc.expectedResponse)
+		})
+	}
+}
+
+func TestPostSigninEmailPasswordNewDevice(t *testing.T) {
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	session := api.PostSigninEmailPassword200JSONResponse{
+		Mfa: nil,
+		Session: &api.Session{
+			AccessToken:          "",
+			AccessTokenExpiresIn: 900,
+			RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+			RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+			User: &api.User{
+				AvatarUrl:           "",
+				CreatedAt:           time.Now(),
+				DefaultRole:         "user",
+				DisplayName:         "Jane Doe",
+				Email:               ptr(types.Email("jane@acme.com")),
+				EmailVerified:       true,
+				Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+				IsAnonymous:         false,
+				Locale:              "en",
+				Metadata:            map[string]any{},
+				PhoneNumber:         "",
+				PhoneNumberVerified: false,
+				Roles:               []string{"user", "me"},
+			},
+		},
+	}
+
+	cases := []testRequest[api.PostSigninEmailPasswordRequestObject, api.PostSigninEmailPasswordResponseObject]{
+		{
+			name:   "known device",
+			config: getNewDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.LastSeen = sql.TimestampTz(time.Now().Add(-24 * time.Hour))
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().RefreshTokenDeviceFingerprintExists(
+					gomock.Any(),
+					sql.RefreshTokenDeviceFingerprintExistsParams{
+						UserID:            userID,
+						DeviceFingerprint: sql.Text("e7a9cb33c50674c655bd077d625ecdfd"),
+					},
+				).Return(true, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+						IpAddress:        sql.Text("192.168.1.1"),
+						UserAgent:        sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: session,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "new device",
+			config: getNewDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.LastSeen = sql.TimestampTz(time.Now().Add(-24 * time.Hour))
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().RefreshTokenDeviceFingerprintExists(
+					gomock.Any(),
+					sql.RefreshTokenDeviceFingerprintExistsParams{
+						UserID:            userID,
+						DeviceFingerprint: sql.Text("e7a9cb33c50674c655bd077d625ecdfd"),
+					},
+				).Return(false, nil)
+
+				mock.EXPECT().InsertNewDeviceSignIn(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertNewDeviceSignInParams{
+							UserID:    userID,
+							Ticket:    "newDeviceRevoke:xxxxx",
+							IpAddress: sql.Text("192.168.1.1"),
+							UserAgent: sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+							ExpiresAt: sql.TimestampTz(time.Now().Add(7 * 24 * time.Hour)),
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+					),
+				).Return(nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+						IpAddress:        sql.Text("192.168.1.1"),
+						UserAgent:        sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNameNewDeviceSignIn,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=newDeviceRevoke%3A7b1f4a3e-0a8b-4c1e-9f0e-2f3a4b5c6d7e&type=newDeviceRevoke", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "newDeviceRevoke:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "192.168.1.1",
+							UserAgent:        "Mozilla/5.0 (X11; Linux x86_64)",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: session,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "first sign in",
+			config: getNewDeviceConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+						IpAddress:        sql.Text("192.168.1.1"),
+						UserAgent:        sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			hibp:          mock.NewMockHIBPClient,
+			emailer:       mock.NewMockEmailer,
+			request: api.PostSigninEmailPasswordRequestObject{
+				Body: &api.PostSigninEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+				},
+			},
+			expectedResponse: session,
+			expectedJWT:      nil,
+			jwtTokenFn:       nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := middleware.ClientInfoToContext(
+				context.Background(),
+				middleware.ClientInfo{IP: "192.168.1.1", UserAgent: "Mozilla/5.0 (X11; Linux x86_64)"},
+			)
+			assertRequest(ctx, t, c.PostSigninEmailPassword, tc.request, tc.expectedResponse)
+		})
+	}
+}
+
+func TestPostSigninEmailPasswordWebhook(t *testing.T) {
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	ctrl := gomock.NewController(t)
+
+	db := func(ctrl *gomock.Controller) controller.DBClient {
+		mock := mock.NewMockDBClient(ctrl)
+
+		mock.EXPECT().GetUserByEmail(
+			gomock.Any(), sql.Text("jane@acme.com"),
+		).Return(getSigninUser(userID), nil)
+
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "user"}, //nolint:exhaustruct
+			{UserID: userID, Role: "me"},   //nolint:exhaustruct
+		}, nil)
+
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(), gomock.Any(),
+		).Return(refreshTokenID, nil)
+
+		mock.EXPECT().UpdateUserLastSeen(
+			gomock.Any(), userID,
+		).Return(sql.TimestampTz(time.Now()), nil)
+
+		return mock
+	}
+
+	c, _ := getController(t, ctrl, getConfig, db, getControllerOpts{
+		customClaimer:    nil,
+		emailer:          nil,
+		hibp:             nil,
+		sms:              nil,
+		providers:        nil,
+		idTokenProviders: nil,
+		saml:             nil,
+		rateLimiter:      nil,
+		captcha:          nil,
+		webhooks: func(ctrl *gomock.Controller) *mock.MockWebhookSender {
+			mock := mock.NewMockWebhookSender(ctrl)
+
+			mock.EXPECT().Send(
+				gomock.Any(),
+				testhelpers.GomockCmpOpts(
+					webhooks.Event{
+						ID:        uuid.UUID{},
+						Type:      webhooks.EventUserSignedIn,
+						CreatedAt: time.Time{},
+						User: webhooks.User{
+							ID:          userID,
+							Email:       "jane@acme.com",
+							PhoneNumber: "",
+							DisplayName: "Jane Doe",
+							IsAnonymous: false,
+						},
+						IPAddress: "192.168.1.1",
+						UserAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+					},
+					cmpopts.IgnoreFields(webhooks.Event{}, "ID", "CreatedAt"), //nolint:exhaustruct
+				),
+			)
+
+			return mock
+		},
+		preSignUpHook:    nil,
+		disposableEmails: nil,
+		avatarStorage:    nil,
+		geoIP:            nil,
+		riskEngine:       nil,
+		configReloader:   nil,
+		signInMethods:    nil,
+	})
+
+	ctx := middleware.ClientInfoToContext(
+		context.Background(),
+		middleware.ClientInfo{IP: "192.168.1.1", UserAgent: "Mozilla/5.0 (X11; Linux x86_64)"},
+	)
+	if _, err := c.PostSigninEmailPassword(
+		ctx, signinEmailPasswordRequest("jane@acme.com"),
+	); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPostSigninEmailPasswordClientTokenLifetimes(t *testing.T) {
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []struct {
+		name                     string
+		client                   middleware.ClientInfo
+		expectedParams           sql.InsertRefreshtokenParams
+		expectedAccessTokenExpIn int64
+	}{
+		{
+			name: "client with its own lifetimes",
+			client: middleware.ClientInfo{
+				IP:                    "192.168.1.1",
+				UserAgent:             "acme-ios/1.0",
+				ID:                    "mobile",
+				Version:               "1.0.3",
+				RefreshTokenExpiresIn: 0,
+			},
+			expectedParams: sql.InsertRefreshtokenParams{
+				UserID:                userID,
+				RefreshTokenHash:      "",
+				ExpiresAt:             sql.TimestampTz(time.Now().Add(90 * 24 * time.Hour)),
+				Type:                  sql.RefreshTokenTypeRegular,
+				Metadata:              nil,
+				IpAddress:             sql.Text("192.168.1.1"),
+				UserAgent:             sql.Text("acme-ios/1.0"),
+				ClientID:              sql.Text("mobile"),
+				ClientVersion:         sql.Text("1.0.3"),
+				RefreshTokenExpiresIn: sql.NullableInt4(7776000),
+				AccessTokenExpiresIn:  sql.NullableInt4(3600),
+			},
+			expectedAccessTokenExpIn: 3600,
+		},
+		{
+			name: "client asking for a shorter refresh token",
+			client: middleware.ClientInfo{
+				IP:                    "192.168.1.1",
+				UserAgent:             "Mozilla/5.0 (X11; Linux x86_64)",
+				ID:                    "kiosk",
+				Version:               "",
+				RefreshTokenExpiresIn: 3600,
+			},
+			expectedParams: sql.InsertRefreshtokenParams{
+				UserID:                userID,
+				RefreshTokenHash:      "",
+				ExpiresAt:             sql.TimestampTz(time.Now().Add(time.Hour)),
+				Type:                  sql.RefreshTokenTypeRegular,
+				Metadata:              nil,
+				IpAddress:             sql.Text("192.168.1.1"),
+				UserAgent:             sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+				ClientID:              sql.Text("kiosk"),
+				ClientVersion:         sql.NullableText(""),
+				RefreshTokenExpiresIn: sql.NullableInt4(3600),
+				AccessTokenExpiresIn:  sql.NullableInt4(900),
+			},
+			expectedAccessTokenExpIn: 900,
+		},
+		{
+			name: "client asking for a longer refresh token",
+			client: middleware.ClientInfo{
+				IP:                    "192.168.1.1",
+				UserAgent:             "acme-ios/1.0",
+				ID:                    "mobile",
+				Version:               "",
+				RefreshTokenExpiresIn: 365 * 24 * 60 * 60,
+			},
+			expectedParams: sql.InsertRefreshtokenParams{
+				UserID:                userID,
+				RefreshTokenHash:      "",
+				ExpiresAt:             sql.TimestampTz(time.Now().Add(90 * 24 * time.Hour)),
+				Type:                  sql.RefreshTokenTypeRegular,
+				Metadata:              nil,
+				IpAddress:             sql.Text("192.168.1.1"),
+				UserAgent:             sql.Text("acme-ios/1.0"),
+				ClientID:              sql.Text("mobile"),
+				ClientVersion:         sql.NullableText(""),
+				RefreshTokenExpiresIn: sql.NullableInt4(7776000),
+				AccessTokenExpiresIn:  sql.NullableInt4(3600),
+			},
+			expectedAccessTokenExpIn: 3600,
+		},
+		{
+			name: "client with the default lifetimes",
+			client: middleware.ClientInfo{
+				IP:                    "192.168.1.1",
+				UserAgent:             "Mozilla/5.0 (X11; Linux x86_64)",
+				ID:                    "web",
+				Version:               "3.2.0",
+				RefreshTokenExpiresIn: 0,
+			},
+			expectedParams: sql.InsertRefreshtokenParams{
+				UserID:                userID,
+				RefreshTokenHash:      "",
+				ExpiresAt:             sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:                  sql.RefreshTokenTypeRegular,
+				Metadata:              nil,
+				IpAddress:             sql.Text("192.168.1.1"),
+				UserAgent:             sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+				ClientID:              sql.Text("web"),
+				ClientVersion:         sql.Text("3.2.0"),
+				RefreshTokenExpiresIn: sql.NullableInt4(0),
+				AccessTokenExpiresIn:  sql.NullableInt4(0),
+			},
+			expectedAccessTokenExpIn: 900,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			db := func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(), cmpDBParams(tc.expectedParams),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			}
+
+			config := func() *controller.Config {
+				config := getConfig()
+				config.ClientTokenLifetimes = map[string]controller.TokenLifetimes{
+					"mobile": {RefreshTokenExpiresIn: 7776000, AccessTokenExpiresIn: 3600},
+				}
+				return config
+			}
+
+			c, _ := getController(t, ctrl, config, db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp, err := c.PostSigninEmailPassword(
+				middleware.ClientInfoToContext(context.Background(), tc.client),
+				signinEmailPasswordRequest("jane@acme.com"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resp200, ok := resp.(api.PostSigninEmailPassword200JSONResponse)
+			if !ok {
+				t.Fatalf("unexpected response: %#v", resp)
+			}
+			if resp200.Session.AccessTokenExpiresIn != tc.expectedAccessTokenExpIn {
+				t.Errorf(
+					"expected access token to expire in %d, got %d",
+					tc.expectedAccessTokenExpIn, resp200.Session.AccessTokenExpiresIn,
+				)
+			}
+		})
+	}
+}
+
+func TestPostSigninEmailPasswordSessionLimit(t *testing.T) {
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	newerSessionID := uuid.MustParse("5b2c1c3e-7a1f-4b8e-9a55-0f6f2f0b8a11")
+	oldestSessionID := uuid.MustParse("8d7e6f50-2c3b-4a19-8e0d-1f2a3b4c5d6e")
+
+	sessions := []sql.AuthRefreshToken{
+		{ //nolint:exhaustruct
+			ID:        newerSessionID,
+			CreatedAt: sql.TimestampTz(time.Now().Add(-time.Hour)),
+			UserID:    userID,
+			Type:      sql.RefreshTokenTypeRegular,
+		},
+		{ //nolint:exhaustruct
+			ID:        oldestSessionID,
+			CreatedAt: sql.TimestampTz(time.Now().Add(-24 * time.Hour)),
+			UserID:    userID,
+			Type:      sql.RefreshTokenTypeRegular,
+		},
+	}
+
+	cases := []struct {
+		name           string
+		policy         string
+		db             func(ctrl *gomock.Controller) controller.DBClient
+		expectedStatus int
+	}{
+		{
+			name:   "oldest session evicted",
+			policy: controller.SessionLimitPolicyEvictOldest,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().LockUserSessions(gomock.Any(), userID).Return(nil)
+
+				mock.EXPECT().GetUserSessions(gomock.Any(), userID).Return(sessions, nil)
+
+				mock.EXPECT().DeleteUserSession(
+					gomock.Any(),
+					sql.DeleteUserSessionParams{ID: oldestSessionID, UserID: userID},
+				).Return([]uuid.UUID{oldestSessionID}, nil)
+
+				mock.EXPECT().InsertAuditLog(gomock.Any(), sql.InsertAuditLogParams{
+					Event:     "session-limit",
+					Outcome:   "success",
+					Actor:     "user",
+					UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+					IpAddress: sql.Text("192.168.1.1"),
+					UserAgent: sql.Text("test"),
+					Metadata: []byte(
+						`{"limit":2,"policy":"evict-oldest","sessionId":"` + oldestSessionID.String() + `"}`,
+					),
+				}).Return(nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(), gomock.Any(),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "sign in rejected",
+			policy: controller.SessionLimitPolicyReject,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().LockUserSessions(gomock.Any(), userID).Return(nil)
+
+				mock.EXPECT().GetUserSessions(gomock.Any(), userID).Return(sessions, nil)
+
+				mock.EXPECT().InsertAuditLog(gomock.Any(), sql.InsertAuditLogParams{
+					Event:     "session-limit",
+					Outcome:   "failure",
+					Actor:     "user",
+					UserID:    pgtype.UUID{Bytes: userID, Valid: true},
+					IpAddress: sql.Text("192.168.1.1"),
+					UserAgent: sql.Text("test"),
+					Metadata: []byte(
+						`{"error":"session-limit-reached","limit":2,"policy":"reject"}`,
+					),
+				}).Return(nil)
+
+				return mock
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "sessions can't be locked",
+			policy: controller.SessionLimitPolicyEvictOldest,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().LockUserSessions(gomock.Any(), userID).Return(
+					errors.New("canceling statement due to lock timeout"), //nolint:goerr113
+				)
+
+				return mock
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			config := func() *controller.Config {
+				config := getConfig()
+				config.AuditLogEnabled = true
+				config.SessionLimit = 2
+				config.SessionLimitPolicy = tc.policy
+				return config
+			}
+
+			c, _ := getController(t, ctrl, config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp, err := c.PostSigninEmailPassword(
+				middleware.ClientInfoToContext(
+					context.Background(),
+					middleware.ClientInfo{IP: "192.168.1.1", UserAgent: "test"},
+				),
+				signinEmailPasswordRequest("jane@acme.com"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			status := http.StatusOK
+			if errResp, ok := resp.(controller.ErrorResponse); ok {
+				status = errResp.Status
+			}
+			if status != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d: %#v", tc.expectedStatus, status, resp)
+			}
+		})
+	}
+}
+
+type fakeGeoIP map[string]geoip.Location
+
+func (f fakeGeoIP) Locate(ip string) (geoip.Location, bool, error) {
+	location, ok := f[ip]
+	return location, ok, nil
+}
+
+func TestPostSigninEmailPasswordImpossibleTravel(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	sessionID := uuid.MustParse("5b2c1c3e-7a1f-4b8e-9a55-0f6f2f0b8a11")
+
+	geoIP := fakeGeoIP{
+		"203.0.113.7": {
+			Country:        "GB",
+			City:           "London",
+			Latitude:       51.5142,
+			Longitude:      -0.0931,
+			HasCoordinates: true,
+		},
+	}
+
+	session := func(city, country string, latitude, longitude float64, lastUsed time.Duration) sql.AuthRefreshToken {
+		return sql.AuthRefreshToken{ //nolint:exhaustruct
+			ID:         sessionID,
+			CreatedAt:  sql.TimestampTz(time.Now().Add(-30 * 24 * time.Hour)),
+			LastUsedAt: sql.TimestampTz(time.Now().Add(-lastUsed)),
+			UserID:     userID,
+			Type:       sql.RefreshTokenTypeRegular,
+			Country:    sql.Text(country),
+			City:       sql.Text(city),
+			Latitude:   pgtype.Float8{Float64: latitude, Valid: true},
+			Longitude:  pgtype.Float8{Float64: longitude, Valid: true},
+		}
+	}
+
+	cases := []struct {
+		name           string
+		policy         string
+		lastSession    sql.AuthRefreshToken
+		expectedAlert  bool
+		expectedStatus int
+	}{
+		{
+			name:           "nearby sign in",
+			policy:         controller.ImpossibleTravelPolicyDeny,
+			lastSession:    session("Paris", "FR", 48.8566, 2.3522, 10*time.Minute),
+			expectedAlert:  false,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "enough time to travel",
+			policy:         controller.ImpossibleTravelPolicyDeny,
+			lastSession:    session("Sydney", "AU", -33.8688, 151.2093, 48*time.Hour),
+			expectedAlert:  false,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "impossible travel notified",
+			policy:         controller.ImpossibleTravelPolicyNotify,
+			lastSession:    session("Sydney", "AU", -33.8688, 151.2093, time.Hour),
+			expectedAlert:  true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "impossible travel denied",
+			policy:         controller.ImpossibleTravelPolicyDeny,
+			lastSession:    session("Sydney", "AU", -33.8688, 151.2093, time.Hour),
+			expectedAlert:  true,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			db := func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().GetUserSessions(
+					gomock.Any(), userID,
+				).Return([]sql.AuthRefreshToken{tc.lastSession}, nil)
+
+				if tc.expectedAlert {
+					mock.EXPECT().InsertNewDeviceSignIn(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertNewDeviceSignInParams{
+								UserID:    userID,
+								Ticket:    "newDeviceRevoke:xxxxx",
+								IpAddress: sql.Text("203.0.113.7"),
+								UserAgent: sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+								ExpiresAt: sql.TimestampTz(time.Now().Add(7 * 24 * time.Hour)),
+							},
+							testhelpers.FilterPathLast(
+								[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+						),
+					).Return(nil)
+				}
+
+				if tc.expectedStatus == http.StatusOK {
+					mock.EXPECT().InsertRefreshtoken(
+						gomock.Any(), cmpDBParams(sql.InsertRefreshtokenParams{
+							UserID:                userID,
+							RefreshTokenHash:      "",
+							ExpiresAt:             sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+							Type:                  sql.RefreshTokenTypeRegular,
+							Metadata:              nil,
+							IpAddress:             sql.Text("203.0.113.7"),
+							UserAgent:             sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+							ClientID:              sql.NullableText(""),
+							ClientVersion:         sql.NullableText(""),
+							Country:               sql.Text("GB"),
+							City:                  sql.Text("London"),
+							Latitude:              pgtype.Float8{Float64: 51.5142, Valid: true},
+							Longitude:             pgtype.Float8{Float64: -0.0931, Valid: true},
+							RefreshTokenExpiresIn: sql.NullableInt4(0),
+							AccessTokenExpiresIn:  sql.NullableInt4(0),
+						}),
+					).Return(refreshTokenID, nil)
+
+					mock.EXPECT().UpdateUserLastSeen(
+						gomock.Any(), userID,
+					).Return(sql.TimestampTz(time.Now()), nil)
+				}
+
+				return mock
+			}
+
+			config := func() *controller.Config {
+				config := getConfig()
+				config.ImpossibleTravelPolicy = tc.policy
+				config.ImpossibleTravelMaxSpeed = 1000
+				return config
+			}
+
+			c, _ := getController(t, ctrl, config, db, getControllerOpts{
+				customClaimer: nil,
+				emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+					mock := mock.NewMockEmailer(ctrl)
+					if !tc.expectedAlert {
+						return mock
+					}
+
+					mock.EXPECT().SendEmail(
+						gomock.Any(),
+						"jane@acme.com",
+						"en",
+						notifications.TemplateNameSuspiciousSignIn,
+						testhelpers.GomockCmpOpts(
+							notifications.TemplateData{
+								Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=newDeviceRevoke%3A7b1f4a3e-0a8b-4c1e-9f0e-2f3a4b5c6d7e&type=newDeviceRevoke", //nolint:lll
+								DisplayName:      "Jane Doe",
+								Email:            "jane@acme.com",
+								NewEmail:         "",
+								Ticket:           "newDeviceRevoke:xxx",
+								RedirectTo:       "http://localhost:3000",
+								Locale:           "en",
+								ServerURL:        "https://local.auth.nhost.run",
+								ClientURL:        "http://localhost:3000",
+								Code:             "",
+								IPAddress:        "203.0.113.7",
+								UserAgent:        "Mozilla/5.0 (X11; Linux x86_64)",
+								OrganizationName: "",
+								Location:         "London, GB",
+							},
+							testhelpers.FilterPathLast(
+								[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+							testhelpers.FilterPathLast(
+								[]string{".Link"}, cmp.Comparer(cmpLink)),
+						)).Return(nil)
+
+					return mock
+				},
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            geoIP,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp, err := c.PostSigninEmailPassword(
+				middleware.ClientInfoToContext(
+					context.Background(),
+					middleware.ClientInfo{ //nolint:exhaustruct
+						IP:        "203.0.113.7",
+						UserAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+					},
+				),
+				signinEmailPasswordRequest("jane@acme.com"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			status := http.StatusOK
+			if errResp, ok := resp.(controller.ErrorResponse); ok {
+				status = errResp.Status
+				if errResp.Error != api.ImpossibleTravel {
+					t.Errorf("unexpected error: %#v", errResp)
+				}
+			}
+			if status != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d: %#v", tc.expectedStatus, status, resp)
+			}
+		})
+	}
+}
+
+type fakeRiskEngine struct {
+	score   int
+	err     error
+	signals risk.Signals
+}
+
+func (f *fakeRiskEngine) Score(_ context.Context, signals risk.Signals) (int, error) {
+	f.signals = signals
+	return f.score, f.err
+}
+
+func TestPostSigninEmailPasswordRisk(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	signals := risk.Signals{
+		UserID:       userID.String(),
+		IPAddress:    "203.0.113.7",
+		UserAgent:    "Mozilla/5.0 (X11; Linux x86_64)",
+		Country:      "",
+		NewDevice:    true,
+		UserFailures: 0,
+		IPFailures:   0,
+	}
+
+	cases := []struct {
+		name            string
+		score           int
+		err             error
+		lockout         bool
+		totp            bool
+		expectedSignals risk.Signals
+		expectedStatus  int
+		expectedMessage string
+		expectedMFA     bool
+	}{
+		{
+			name:            "low risk",
+			score:           10,
+			err:             nil,
+			lockout:         false,
+			totp:            false,
+			expectedSignals: signals,
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "",
+			expectedMFA:     false,
+		},
+		{
+			name:            "engine error",
+			score:           0,
+			err:             errors.New("timeout"), //nolint:goerr113
+			lockout:         false,
+			totp:            false,
+			expectedSignals: signals,
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "",
+			expectedMFA:     false,
+		},
+		{
+			name:            "mfa required with totp",
+			score:           60,
+			err:             nil,
+			lockout:         false,
+			totp:            true,
+			expectedSignals: signals,
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "",
+			expectedMFA:     true,
+		},
+		{
+			name:            "mfa required without totp",
+			score:           60,
+			err:             nil,
+			lockout:         false,
+			totp:            false,
+			expectedSignals: signals,
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Sign in denied, it looks too risky without multi-factor authentication",
+			expectedMFA:     false,
+		},
+		{
+			name:            "blocked",
+			score:           90,
+			err:             nil,
+			lockout:         false,
+			totp:            true,
+			expectedSignals: signals,
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Sign in denied, it looks too risky",
+			expectedMFA:     false,
+		},
+		{
+			name:    "failure history",
+			score:   10,
+			err:     nil,
+			lockout: true,
+			totp:    false,
+			expectedSignals: risk.Signals{
+				UserID:       userID.String(),
+				IPAddress:    "203.0.113.7",
+				UserAgent:    "Mozilla/5.0 (X11; Linux x86_64)",
+				Country:      "",
+				NewDevice:    true,
+				UserFailures: 2,
+				IPFailures:   3,
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "",
+			expectedMFA:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			db := func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				if tc.totp {
+					user.ActiveMfaType = sql.Text(controller.MFATypeTOTP)
+				}
+				if tc.lockout {
+					user.FailedSignInAttempts = 2
+					user.LastFailedSignInAt = sql.TimestampTz(time.Now().Add(-time.Minute))
+
+					mock.EXPECT().GetIPLockedUntil(
+						gomock.Any(), "203.0.113.7",
+					).Return(pgtype.Timestamptz{}, pgx.ErrNoRows)
+
+					mock.EXPECT().GetIPSignInFailures(
+						gomock.Any(), cmpDBParams(sql.GetIPSignInFailuresParams{
+							Ip:            "203.0.113.7",
+							FailuresSince: sql.TimestampTz(time.Now().Add(-900 * time.Second)),
+						}, cmpLockoutTimes()...),
+					).Return(int32(3), nil)
+
+					mock.EXPECT().ResetUserSignInFailures(gomock.Any(), userID).Return(int64(1), nil)
+				}
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(), sql.Text("jane@acme.com"),
+				).Return(user, nil)
+
+				mock.EXPECT().RefreshTokenDeviceFingerprintExists(
+					gomock.Any(), sql.RefreshTokenDeviceFingerprintExistsParams{
+						UserID:            userID,
+						DeviceFingerprint: sql.Text("8caa767decc5837e0b8721a6a75727a1"),
+					},
+				).Return(false, nil)
+
+				if tc.expectedMFA {
+					mock.EXPECT().UpdateUserTicket(
+						gomock.Any(),
+						cmpDBParams(sql.UpdateUserTicketParams{
+							ID:              userID,
+							Ticket:          sql.Text("mfaTotp:xxxx"),
+							TicketExpiresAt: sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+						}),
+					).Return(userID, nil)
+				}
+
+				if tc.expectedStatus == http.StatusOK && !tc.expectedMFA {
+					mock.EXPECT().GetUserRoles(
+						gomock.Any(), userID,
+					).Return([]sql.AuthUserRole{
+						{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					}, nil)
+
+					mock.EXPECT().InsertRefreshtoken(
+						gomock.Any(), cmpDBParams(sql.InsertRefreshtokenParams{
+							UserID:                userID,
+							RefreshTokenHash:      "",
+							ExpiresAt:             sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+							Type:                  sql.RefreshTokenTypeRegular,
+							Metadata:              nil,
+							IpAddress:             sql.Text("203.0.113.7"),
+							UserAgent:             sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+							ClientID:              sql.NullableText(""),
+							ClientVersion:         sql.NullableText(""),
+							Country:               sql.NullableText(""),
+							City:                  sql.NullableText(""),
+							Latitude:              pgtype.Float8{},
+							Longitude:             pgtype.Float8{},
+							RefreshTokenExpiresIn: sql.NullableInt4(0),
+							AccessTokenExpiresIn:  sql.NullableInt4(0),
+						}),
+					).Return(refreshTokenID, nil)
+
+					mock.EXPECT().UpdateUserLastSeen(
+						gomock.Any(), userID,
+					).Return(sql.TimestampTz(time.Now()), nil)
+				}
+
+				return mock
+			}
+
+			config := func() *controller.Config {
+				config := getConfig()
+				if tc.lockout {
+					config = getLockoutConfig()
+				}
+				config.RiskMFAThreshold = 50
+				config.RiskBlockThreshold = 80
+				return config
+			}
+
+			engine := &fakeRiskEngine{score: tc.score, err: tc.err, signals: risk.Signals{}} //nolint:exhaustruct
+			c, _ := getController(t, ctrl, config, db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       engine,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp, err := c.PostSigninEmailPassword(
+				middleware.ClientInfoToContext(
+					context.Background(),
+					middleware.ClientInfo{ //nolint:exhaustruct
+						IP:        "203.0.113.7",
+						UserAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+					},
+				),
+				signinEmailPasswordRequest("jane@acme.com"),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedSignals, engine.signals); diff != "" {
+				t.Errorf("unexpected signals (-want +got):\n%s", diff)
+			}
+
+			switch resp := resp.(type) {
+			case controller.ErrorResponse:
+				if resp.Status != tc.expectedStatus || resp.Error != api.RiskySignIn ||
+					resp.Message != tc.expectedMessage {
+					t.Errorf("unexpected error: %#v", resp)
+				}
+			case api.PostSigninEmailPassword200JSONResponse:
+				if tc.expectedStatus != http.StatusOK || (resp.Mfa != nil) != tc.expectedMFA {
+					t.Errorf("unexpected response: %#v", resp)
+				}
+			default:
+				t.Errorf("unexpected response: %#v", resp)
 			}
 		})
 	}
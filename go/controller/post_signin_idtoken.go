@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"golang.org/x/oauth2"
+)
+
+func (ctrl *Controller) postSigninIdtokenValidateRequest(
+	request api.PostSigninIdtokenRequestObject,
+	logger *slog.Logger,
+) (api.SignUpOptions, *APIError) {
+	options := deptr(request.Body.Options)
+
+	// as with /signin/provider/{provider} we only validate a copy so the defaults are
+	// applied once we get the user's profile from the id token
+	validated := options
+	if _, apiErr := ctrl.wf.ValidateSignUpOptions(&validated, "", SignInMethodProvider, logger); apiErr != nil {
+		return api.SignUpOptions{}, apiErr //nolint:exhaustruct
+	}
+
+	return options, nil
+}
+
+func (ctrl *Controller) PostSigninIdtoken( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninIdtokenRequestObject,
+) (api.PostSigninIdtokenResponseObject, error) {
+	providerID := string(request.Body.Provider)
+	logger := middleware.LoggerFromContext(ctx).With(slog.String("provider", providerID))
+
+	provider, ok := ctrl.idTokenProviders[providerID]
+	if !ok {
+		logger.Warn("provider is not enabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, providerSignInMethod(providerID), logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	options, apiErr := ctrl.postSigninIdtokenValidateRequest(request, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	profile, err := provider.VerifyIDToken(
+		ctx, request.Body.IdToken, deptr(request.Body.Nonce),
+	)
+	if err != nil {
+		logger.Warn("error verifying id token", logError(err))
+		return ctrl.sendError(ErrInvalidIDToken), nil
+	}
+
+	// there is no provider session when the client obtains the id token directly
+	user, apiErr := ctrl.wf.SignInWithProvider(
+		ctx, providerID, profile, &oauth2.Token{}, options, logger, //nolint:exhaustruct
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostSigninIdtoken200JSONResponse{Session: session}, nil
+}
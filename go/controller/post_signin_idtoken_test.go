@@ -0,0 +1,377 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	providersmock "github.com/nhost/hasura-auth/go/providers/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+type testPostSigninIdtokenRequest struct {
+	testRequest[api.PostSigninIdtokenRequestObject, api.PostSigninIdtokenResponseObject]
+	idTokenProviders func(ctrl *gomock.Controller) map[string]providers.IDTokenProvider
+}
+
+func TestPostSigninIdtoken(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+
+	profile := providers.Profile{
+		ID:            "1234567890",
+		Email:         "jane@acme.com",
+		EmailVerified: true,
+		PrivateEmail:  false,
+		Name:          "Jane Doe",
+		AvatarURL:     "https://lh3.googleusercontent.com/jane",
+		Locale:        "",
+	}
+
+	request := api.PostSigninIdtokenRequestObject{
+		Body: &api.SignInIdTokenRequest{
+			Provider: api.Google,
+			IdToken:  "my-id-token",
+			Nonce:    ptr("my-nonce"),
+			Options:  nil,
+		},
+	}
+
+	google := func(
+		profile providers.Profile, err error,
+	) func(ctrl *gomock.Controller) map[string]providers.IDTokenProvider {
+		return func(ctrl *gomock.Controller) map[string]providers.IDTokenProvider {
+			mock := providersmock.NewMockIDTokenProvider(ctrl)
+			mock.EXPECT().VerifyIDToken(
+				gomock.Any(), "my-id-token", "my-nonce",
+			).Return(profile, err)
+			return map[string]providers.IDTokenProvider{"google": mock}
+		}
+	}
+
+	newSession := func(mock *mock.MockDBClient) {
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "user"}, //nolint:exhaustruct
+			{UserID: userID, Role: "me"},   //nolint:exhaustruct
+		}, nil)
+
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: "",
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(refreshTokenID, nil)
+
+		mock.EXPECT().UpdateUserLastSeen(
+			gomock.Any(), userID,
+		).Return(sql.TimestampTz(time.Now()), nil)
+	}
+
+	expectedJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "false",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	cases := []testPostSigninIdtokenRequest{
+		{
+			testRequest: testRequest[api.PostSigninIdtokenRequestObject, api.PostSigninIdtokenResponseObject]{
+				name:   "existing user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "google",
+							ProviderUserID: "1234567890",
+						},
+					).Return(getSigninUser(userID), nil)
+
+					newSession(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.PostSigninIdtoken200JSONResponse{
+					Session: &api.Session{
+						AccessToken:          "",
+						AccessTokenExpiresIn: 900,
+						RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+						RefreshToken:         "",
+						User: &api.User{
+							AvatarUrl:           "",
+							CreatedAt:           time.Now(),
+							DefaultRole:         "user",
+							DisplayName:         "Jane Doe",
+							Email:               ptr(types.Email("jane@acme.com")),
+							EmailVerified:       true,
+							Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+							IsAnonymous:         false,
+							Locale:              "en",
+							Metadata:            map[string]any{},
+							PhoneNumber:         "",
+							PhoneNumberVerified: false,
+							Roles:               []string{"user", "me"},
+						},
+					},
+				},
+				expectedJWT: expectedJWT,
+				jwtTokenFn:  nil,
+			},
+			idTokenProviders: google(profile, nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninIdtokenRequestObject, api.PostSigninIdtokenResponseObject]{
+				name:   "new user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "google",
+							ProviderUserID: "1234567890",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().InsertUserWithUserProvider(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertUserWithUserProviderParams{
+								ID:             uuid.UUID{},
+								Disabled:       false,
+								DisplayName:    "Jane Doe",
+								AvatarUrl:      "https://lh3.googleusercontent.com/jane",
+								Email:          sql.Text("jane@acme.com"),
+								EmailVerified:  true,
+								Locale:         "en",
+								DefaultRole:    "user",
+								Metadata:       []byte(`{"picture":"https://lh3.googleusercontent.com/jane"}`),
+								Roles:          []string{"user", "me"},
+								ProviderID:     "google",
+								ProviderUserID: "1234567890",
+								AccessToken:    "",
+								RefreshToken:   pgtype.Text{}, //nolint:exhaustruct
+							},
+							cmpopts.IgnoreFields(sql.InsertUserWithUserProviderParams{}, "ID"), //nolint:exhaustruct
+						),
+					).Return(sql.InsertUserWithUserProviderRow{
+						UserID:    userID,
+						CreatedAt: sql.TimestampTz(time.Now()),
+					}, nil)
+
+					newSession(mock)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.PostSigninIdtoken200JSONResponse{
+					Session: &api.Session{
+						AccessToken:          "",
+						AccessTokenExpiresIn: 900,
+						RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+						RefreshToken:         "",
+						User: &api.User{
+							AvatarUrl:           "https://lh3.googleusercontent.com/jane",
+							CreatedAt:           time.Now(),
+							DefaultRole:         "user",
+							DisplayName:         "Jane Doe",
+							Email:               ptr(types.Email("jane@acme.com")),
+							EmailVerified:       true,
+							Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+							IsAnonymous:         false,
+							Locale:              "en",
+							Metadata:            map[string]any{"picture": "https://lh3.googleusercontent.com/jane"},
+							PhoneNumber:         "",
+							PhoneNumberVerified: false,
+							Roles:               []string{"user", "me"},
+						},
+					},
+				},
+				expectedJWT: expectedJWT,
+				jwtTokenFn:  nil,
+			},
+			idTokenProviders: google(profile, nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninIdtokenRequestObject, api.PostSigninIdtokenResponseObject]{
+				name:          "invalid id token",
+				config:        getConfig,
+				db:            func(ctrl *gomock.Controller) controller.DBClient { return mock.NewMockDBClient(ctrl) },
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-id-token",
+					Message: "Invalid or expired ID token",
+					Status:  401,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			idTokenProviders: google(
+				providers.Profile{},         //nolint:exhaustruct
+				errors.New("token expired"), //nolint:goerr113
+			),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninIdtokenRequestObject, api.PostSigninIdtokenResponseObject]{
+				name:          "provider not enabled",
+				config:        getConfig,
+				db:            func(ctrl *gomock.Controller) controller.DBClient { return mock.NewMockDBClient(ctrl) },
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninIdtokenRequestObject{
+					Body: &api.SignInIdTokenRequest{
+						Provider: api.Apple,
+						IdToken:  "my-id-token",
+						Nonce:    nil,
+						Options:  nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-endpoint",
+					Message: "This endpoint is disabled",
+					Status:  409,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			idTokenProviders: func(ctrl *gomock.Controller) map[string]providers.IDTokenProvider {
+				return map[string]providers.IDTokenProvider{
+					"google": providersmock.NewMockIDTokenProvider(ctrl),
+				}
+			},
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninIdtokenRequestObject, api.PostSigninIdtokenResponseObject]{
+				name:          "role not allowed",
+				config:        getConfig,
+				db:            func(ctrl *gomock.Controller) controller.DBClient { return mock.NewMockDBClient(ctrl) },
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninIdtokenRequestObject{
+					Body: &api.SignInIdTokenRequest{
+						Provider: api.Google,
+						IdToken:  "my-id-token",
+						Nonce:    ptr("my-nonce"),
+						Options: &api.SignUpOptions{
+							AllowedRoles: &[]string{"admin"},
+							DefaultRole:  nil,
+							DisplayName:  nil,
+							Locale:       nil,
+							Metadata:     nil,
+							RedirectTo:   nil,
+						},
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "role-not-allowed",
+					Message: "Role not allowed",
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			idTokenProviders: func(ctrl *gomock.Controller) map[string]providers.IDTokenProvider {
+				return map[string]providers.IDTokenProvider{
+					"google": providersmock.NewMockIDTokenProvider(ctrl),
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: tc.idTokenProviders,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninIdtoken, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostSigninIdtoken200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostSigninMfaRecoveryCode( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninMfaRecoveryCodeRequestObject,
+) (api.PostSigninMfaRecoveryCodeResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserByMFATicket(ctx, request.Body.Ticket, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.ValidateUser(user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if user.ActiveMfaType.String != MFATypeTOTP {
+		logger.Warn("totp mfa not active for user")
+		return ctrl.sendError(ErrDisabledMfaTotp), nil
+	}
+
+	if apiErr := ctrl.wf.ConsumeRecoveryCode(
+		ctx, user.ID, request.Body.RecoveryCode, logger,
+	); apiErr != nil {
+		if errors.Is(apiErr, ErrInvalidOTP) {
+			if apiErr := ctrl.wf.RecordMFATicketFailure(
+				ctx, request.Body.Ticket, logger,
+			); apiErr != nil {
+				return ctrl.sendError(apiErr), nil
+			}
+		}
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr = ctrl.wf.ConsumeTicket(ctx, request.Body.Ticket, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostSigninMfaRecoveryCode200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostSigninMfaTotp( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninMfaTotpRequestObject,
+) (api.PostSigninMfaTotpResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserByMFATicket(ctx, request.Body.Ticket, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.ValidateUser(user, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	if user.ActiveMfaType.String != MFATypeTOTP {
+		logger.Warn("totp mfa not active for user")
+		return ctrl.sendError(ErrDisabledMfaTotp), nil
+	}
+
+	if !user.TotpSecret.Valid || user.TotpSecret.String == "" {
+		logger.Warn("user doesn't have a totp secret")
+		return ctrl.sendError(ErrNoTotpSecret), nil
+	}
+
+	if !verifyTOTP(request.Body.Otp, user.TotpSecret.String) {
+		logger.Warn("invalid totp code")
+		if apiErr := ctrl.wf.RecordMFATicketFailure(ctx, request.Body.Ticket, logger); apiErr != nil {
+			return ctrl.sendError(apiErr), nil
+		}
+		return ctrl.sendError(ErrInvalidOTP), nil
+	}
+
+	// the ticket is consumed only after the code has been verified so a typo
+	// doesn't force the user to sign in again, wrong codes are counted so it is
+	// invalidated after a few. Consuming it atomically makes sure the same
+	// challenge can't be answered twice.
+	user, apiErr = ctrl.wf.ConsumeTicket(ctx, request.Body.Ticket, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostSigninMfaTotp200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
@@ -0,0 +1,376 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostSigninMfaTotp(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	ticket := "mfaTotp:e08204c7-40af-4434-a7ed-31c6aa37a390"
+
+	getUser := func() sql.AuthUser {
+		user := getSigninUser(userID)
+		user.TotpSecret = sql.Text(testTotpSecret)
+		user.ActiveMfaType = sql.Text("totp")
+		user.Ticket = sql.Text(ticket)
+		user.TicketExpiresAt = sql.TimestampTz(time.Now().Add(time.Minute))
+		return user
+	}
+
+	code := getTotpCode(t)
+
+	cases := []testRequest[api.PostSigninMfaTotpRequestObject, api.PostSigninMfaTotpResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    code,
+				},
+			},
+			expectedResponse: api.PostSigninMfaTotp200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "wrong otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserTicketFailedAttempts(
+					gomock.Any(),
+					sql.UpdateUserTicketFailedAttemptsParams{
+						MaxAttempts: 5,
+						Ticket:      sql.Text(ticket),
+					},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    "000000",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "wrong otp invalidates the ticket",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserTicketFailedAttempts(
+					gomock.Any(),
+					sql.UpdateUserTicketFailedAttemptsParams{
+						MaxAttempts: 5,
+						Ticket:      sql.Text(ticket),
+					},
+				).Return(int32(5), nil)
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    "000000",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "ticket not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "ticket already used",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserConsumeTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-ticket",
+				Message: "Invalid or expired verification ticket",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "totp not active",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.ActiveMfaType = pgtype.Text{} //nolint:exhaustruct
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-mfa-totp",
+				Message: "MFA TOTP is not enabled for this user",
+				Status:  400,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "user disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.Disabled = true
+				mock.EXPECT().GetUserByTicket(
+					gomock.Any(), sql.Text(ticket),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostSigninMfaTotpRequestObject{
+				Body: &api.PostSigninMfaTotpJSONRequestBody{
+					Ticket: ticket,
+					Otp:    code,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-user",
+				Message: "User is disabled",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninMfaTotp, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostSigninMfaTotp200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
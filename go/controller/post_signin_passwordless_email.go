@@ -9,9 +9,11 @@ import (
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
 	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
 )
 
 func (ctrl *Controller) postSigninPasswordlessEmailValidateRequest(
+	ctx context.Context,
 	request api.PostSigninPasswordlessEmailRequestObject,
 	logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
@@ -20,13 +22,23 @@ func (ctrl *Controller) postSigninPasswordlessEmailValidateRequest(
 		return nil, ErrDisabledEndpoint
 	}
 
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodPasswordlessEmail, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
 	if !ctrl.wf.ValidateEmail(string(request.Body.Email)) {
 		logger.Warn("email didn't pass access control checks")
 		return nil, ErrInvalidEmailPassword
 	}
 
+	if apiErr := ctrl.wf.ValidateEmailDeliverability(
+		ctx, string(request.Body.Email), logger,
+	); apiErr != nil {
+		return nil, apiErr
+	}
+
 	options, apiErr := ctrl.wf.ValidateSignUpOptions(
-		request.Body.Options, string(request.Body.Email), logger,
+		request.Body.Options, string(request.Body.Email), SignInMethodPasswordless, logger,
 	)
 	if apiErr != nil {
 		return nil, apiErr
@@ -42,7 +54,7 @@ func (ctrl *Controller) PostSigninPasswordlessEmail( //nolint:ireturn
 	logger := middleware.LoggerFromContext(ctx).
 		With(slog.String("email", string(request.Body.Email)))
 
-	options, apiErr := ctrl.postSigninPasswordlessEmailValidateRequest(request, logger)
+	options, apiErr := ctrl.postSigninPasswordlessEmailValidateRequest(ctx, request, logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
@@ -55,16 +67,27 @@ func (ctrl *Controller) PostSigninPasswordlessEmail( //nolint:ireturn
 	case errors.Is(apiErr, ErrUserEmailNotFound):
 		logger.Info("user does not exist, creating user")
 
-		user, apiErr = ctrl.wf.SignUpUser(
-			ctx,
-			string(request.Body.Email),
-			options,
-			logger,
-			SignupUserWithTicket(ticket, expireAt),
-		)
+		// the user and their sign in email are stored together so a failure doesn't leave
+		// behind a user that can't sign in
+		apiErr = ctrl.wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+			user, apiErr = ctrl.wf.SignUpUser(
+				ctx,
+				string(request.Body.Email),
+				options,
+				logger,
+				SignupUserWithTicket(ticket, expireAt),
+			)
+			if apiErr != nil {
+				return apiErr
+			}
+
+			return ctrl.sendPasswordlessEmail(ctx, request, options, user, ticket, logger)
+		})
 		if apiErr != nil {
 			return ctrl.respondWithError(apiErr), nil
 		}
+
+		return api.PostSigninPasswordlessEmail200JSONResponse(api.OK), nil
 	case errors.Is(apiErr, ErrUnverifiedUser):
 		if apiErr = ctrl.wf.SetTicket(ctx, user.ID, ticket, expireAt, logger); apiErr != nil {
 			return ctrl.respondWithError(apiErr), nil
@@ -78,7 +101,22 @@ func (ctrl *Controller) PostSigninPasswordlessEmail( //nolint:ireturn
 		}
 	}
 
-	if err := ctrl.wf.SendEmail(
+	if err := ctrl.sendPasswordlessEmail(ctx, request, options, user, ticket, logger); err != nil {
+		return ctrl.sendError(err), nil
+	}
+
+	return api.PostSigninPasswordlessEmail200JSONResponse(api.OK), nil
+}
+
+func (ctrl *Controller) sendPasswordlessEmail(
+	ctx context.Context,
+	request api.PostSigninPasswordlessEmailRequestObject,
+	options *api.SignUpOptions,
+	user sql.AuthUser,
+	ticket string,
+	logger *slog.Logger,
+) *APIError {
+	return ctrl.wf.SendEmail(
 		ctx,
 		string(request.Body.Email),
 		user.Locale,
@@ -90,9 +128,5 @@ func (ctrl *Controller) PostSigninPasswordlessEmail( //nolint:ireturn
 		string(request.Body.Email),
 		"",
 		logger,
-	); err != nil {
-		return ctrl.sendError(err), nil
-	}
-
-	return api.PostSigninPasswordlessEmail200JSONResponse(api.OK), nil
+	)
 }
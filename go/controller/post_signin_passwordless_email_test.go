@@ -78,15 +78,20 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameSigninPasswordless,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "passwordlessEmail:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordlessEmail:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -99,8 +104,9 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSigninPasswordlessEmailRequestObject{
 				Body: &api.SignInPasswordlessEmailRequest{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSigninPasswordlessEmail200JSONResponse(api.OK),
@@ -127,8 +133,9 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSigninPasswordlessEmailRequestObject{
 				Body: &api.SignInPasswordlessEmailRequest{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -160,8 +167,9 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSigninPasswordlessEmailRequestObject{
 				Body: &api.SignInPasswordlessEmailRequest{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -201,6 +209,7 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 						Metadata:     nil,
 						RedirectTo:   nil,
 					},
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -261,15 +270,20 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameSigninPasswordless,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "passwordlessEmail:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordlessEmail:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -291,6 +305,99 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 						Metadata:     nil,
 						RedirectTo:   nil,
 					},
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: api.PostSigninPasswordlessEmail200JSONResponse(api.OK),
+			customClaimer:    nil,
+			hibp:             nil,
+			jwtTokenFn:       nil,
+			expectedJWT:      nil,
+		},
+
+		{
+			name:   "signup required - regional locale falls back to allowed parent",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient { //nolint:dupl
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().InsertUser(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserParams{
+						ID:              uuid.UUID{},
+						Disabled:        false,
+						DisplayName:     "jane@acme.com",
+						AvatarUrl:       "",
+						Email:           sql.Text("jane@acme.com"),
+						PasswordHash:    pgtype.Text{}, //nolint:exhaustruct
+						Ticket:          sql.Text("passwordlessEmail:xxx"),
+						TicketExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+						EmailVerified:   false,
+						Locale:          "es",
+						DefaultRole:     "user",
+						Metadata:        []byte("null"),
+						Roles:           []string{"user", "me"},
+					},
+						cmpopts.IgnoreFields(sql.InsertUserParams{}, "ID"), //nolint:exhaustruct
+					),
+				).Return(sql.InsertUserRow{
+					UserID:    userID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+				}, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"es",
+					notifications.TemplateNameSigninPasswordless,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordlessEmail:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "es",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessEmailRequestObject{
+				Body: &api.SignInPasswordlessEmailRequest{
+					Email: "jane@acme.com",
+					Options: &api.SignUpOptions{
+						AllowedRoles: nil,
+						DefaultRole:  nil,
+						DisplayName:  nil,
+						Locale:       ptr("es-AR"),
+						Metadata:     nil,
+						RedirectTo:   nil,
+					},
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSigninPasswordlessEmail200JSONResponse(api.OK),
@@ -322,6 +429,7 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 						Metadata:     nil,
 						RedirectTo:   ptr("https://evil.com"),
 					},
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -387,15 +495,20 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameSigninPasswordless,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Fmyapp&ticket=passwordlessEmail%3Ac2d0203a-2117-4445-bade-0ed8d5f44f4f&type=signinPasswordless", //nolint:lll
-							DisplayName: "Jane Doe",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "passwordlessEmail:xxx",
-							RedirectTo:  "http://myapp",
-							Locale:      "fr",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Fmyapp&ticket=passwordlessEmail%3Ac2d0203a-2117-4445-bade-0ed8d5f44f4f&type=signinPasswordless", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordlessEmail:xxx",
+							RedirectTo:       "http://myapp",
+							Locale:           "fr",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -417,6 +530,7 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 						Metadata:     &map[string]any{"asd": "asd"},
 						RedirectTo:   ptr("http://myapp"),
 					},
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSigninPasswordlessEmail200JSONResponse(api.OK),
@@ -450,8 +564,9 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSigninPasswordlessEmailRequestObject{
 				Body: &api.SignInPasswordlessEmailRequest{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -523,15 +638,20 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameSigninPasswordless,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "passwordlessEmail:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordlessEmail%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=signinPasswordless", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordlessEmail:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -544,8 +664,9 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSigninPasswordlessEmailRequestObject{
 				Body: &api.SignInPasswordlessEmailRequest{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSigninPasswordlessEmail200JSONResponse(api.OK),
@@ -601,8 +722,9 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSigninPasswordlessEmailRequestObject{
 				Body: &api.SignInPasswordlessEmailRequest{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:        "jane@acme.com",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -622,9 +744,23 @@ func TestPostSigninPasswordlessEmail(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: nil,
-				emailer:       tc.emailer,
-				hibp:          nil,
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			assertRequest(
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+func (ctrl *Controller) postSigninPasswordlessSmsValidateRequest(
+	request api.PostSigninPasswordlessSmsRequestObject,
+	logger *slog.Logger,
+) (*api.SignUpOptions, *APIError) {
+	if !ctrl.config.SMSPasswordlessEnabled {
+		logger.Warn("sms passwordless signin is disabled")
+		return nil, ErrDisabledEndpoint
+	}
+
+	if apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.PhoneNumber, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
+	options, apiErr := ctrl.wf.ValidateSignUpOptions(
+		request.Body.Options, request.Body.PhoneNumber, SignInMethodPasswordless, logger,
+	)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	return options, nil
+}
+
+func (ctrl *Controller) PostSigninPasswordlessSms( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninPasswordlessSmsRequestObject,
+) (api.PostSigninPasswordlessSmsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", request.Body.PhoneNumber))
+
+	options, apiErr := ctrl.postSigninPasswordlessSmsValidateRequest(request, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodPasswordlessSMS, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserByPhoneNumber(ctx, request.Body.PhoneNumber, logger)
+	switch {
+	case errors.Is(apiErr, ErrUserPhoneNumberNotFound):
+		logger.Info("user does not exist, creating user")
+
+		user, apiErr = ctrl.wf.SignUpUser(
+			ctx,
+			"",
+			options,
+			logger,
+			SignupUserWithPhoneNumber(request.Body.PhoneNumber),
+		)
+		if apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+	case apiErr != nil:
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	otp, otpHash, err := generateOTP()
+	if err != nil {
+		logger.Error("error generating otp", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if apiErr := ctrl.wf.SetOTP(
+		ctx, user.ID, otpHash, time.Now().Add(In5Minutes), OTPMethodSMS, logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if slices.Contains(ctrl.config.SMSTestPhoneNumbers, request.Body.PhoneNumber) {
+		logger.Info("test phone number, sms not sent", slog.String("code", otp))
+		return api.PostSigninPasswordlessSms200JSONResponse(api.OK), nil
+	}
+
+	if err := ctrl.wf.sms.SendSMS(
+		ctx,
+		request.Body.PhoneNumber,
+		user.Locale,
+		notifications.TemplateNameSigninPasswordlessSMS,
+		notifications.TemplateData{ //nolint:exhaustruct
+			DisplayName: user.DisplayName,
+			Locale:      user.Locale,
+			ServerURL:   ctrl.config.ServerURL.String(),
+			ClientURL:   ctrl.config.ClientURL.String(),
+			Code:        otp,
+		},
+	); err != nil {
+		logger.Error("error sending sms", logError(err))
+		return ctrl.sendError(ErrCannotSendSMS), nil
+	}
+
+	return api.PostSigninPasswordlessSms200JSONResponse(api.OK), nil
+}
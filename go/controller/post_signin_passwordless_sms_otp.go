@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostSigninPasswordlessSmsOtp( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninPasswordlessSmsOtpRequestObject,
+) (api.PostSigninPasswordlessSmsOtpResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("phoneNumber", request.Body.PhoneNumber))
+
+	if !ctrl.config.SMSPasswordlessEnabled {
+		logger.Warn("sms passwordless signin is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodPasswordlessSMS, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserByPhoneNumber(ctx, request.Body.PhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if user.OtpMethodLastUsed.String != OTPMethodSMS ||
+		!user.OtpHash.Valid ||
+		user.OtpHashExpiresAt.Time.Before(time.Now()) {
+		logger.Warn("user doesn't have a valid sms otp")
+		return ctrl.sendError(ErrInvalidOTP), nil
+	}
+
+	if !verifyHashPassword(request.Body.Otp, user.OtpHash.String) {
+		logger.Warn("otp doesn't match")
+		if apiErr := ctrl.wf.RecordOTPFailure(
+			ctx, user.ID, user.OtpHash.String, logger,
+		); apiErr != nil {
+			return ctrl.sendError(apiErr), nil
+		}
+		return ctrl.sendError(ErrInvalidOTP), nil
+	}
+
+	user, apiErr = ctrl.wf.ConsumeOTP(ctx, user.ID, user.OtpHash.String, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if user.ActiveMfaType.String == MFATypeTOTP {
+		// NewSession checks the risk again once the second factor is verified but
+		// there's no point in challenging a sign in that would be blocked
+		if apiErr := ctrl.wf.CheckSignInRisk(ctx, user, logger); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+
+		ticket := generateTicket(TicketTypeMFATOTP)
+		expiresAt := time.Now().Add(In5Minutes)
+		if apiErr := ctrl.wf.SetTicket(ctx, user.ID, ticket, expiresAt, logger); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+
+		return api.PostSigninPasswordlessSmsOtp200JSONResponse{
+			Mfa: &api.MFAChallengePayload{
+				Ticket: ticket,
+			},
+			Session: nil,
+		}, nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostSigninPasswordlessSmsOtp200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
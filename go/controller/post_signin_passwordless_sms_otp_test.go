@@ -0,0 +1,428 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostSigninPasswordlessSmsOtp(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.SMSPasswordlessEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+
+	// bcrypt hash of 123456
+	otpHash := "$2a$10$UrUnljBQYOsziBqvUWB1JeyeIfT7vdTyB7qpKNWaePwC6RBRBLXyu"
+
+	getUser := func() sql.AuthUser {
+		user := getSigninSMSUser(userID)
+		user.PhoneNumberVerified = false
+		user.OtpMethodLastUsed = sql.Text("sms")
+		user.OtpHash = sql.Text(otpHash)
+		user.OtpHashExpiresAt = sql.TimestampTz(time.Now().Add(time.Minute))
+		return user
+	}
+
+	cases := []testRequest[api.PostSigninPasswordlessSmsOtpRequestObject, api.PostSigninPasswordlessSmsOtpResponseObject]{ //nolint:lll
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserConsumeOTP(
+					gomock.Any(),
+					sql.UpdateUserConsumeOTPParams{
+						ID:      userID,
+						OtpHash: sql.Text(otpHash),
+					},
+				).Return(getSigninSMSUser(userID), nil)
+
+				mock.EXPECT().GetUserRoles(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserRole{
+					{UserID: userID, Role: "user"}, //nolint:exhaustruct
+					{UserID: userID, Role: "me"},   //nolint:exhaustruct
+				}, nil)
+
+				mock.EXPECT().InsertRefreshtoken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertRefreshtokenParams{
+						UserID:           userID,
+						RefreshTokenHash: "",
+						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+						Type:             sql.RefreshTokenTypeRegular,
+						Metadata:         nil,
+					}),
+				).Return(refreshTokenID, nil)
+
+				mock.EXPECT().UpdateUserLastSeen(
+					gomock.Any(), userID,
+				).Return(sql.TimestampTz(time.Now()), nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "123456",
+				},
+			},
+			expectedResponse: api.PostSigninPasswordlessSmsOtp200JSONResponse{
+				Mfa: nil,
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               nil,
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "+123456789",
+						PhoneNumberVerified: true,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "wrong otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserOTPHashFailedAttempts(
+					gomock.Any(),
+					sql.UpdateUserOTPHashFailedAttemptsParams{
+						MaxAttempts: 5,
+						ID:          userID,
+						OtpHash:     sql.Text(otpHash),
+					},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "654321",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "wrong otp invalidates the otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserOTPHashFailedAttempts(
+					gomock.Any(),
+					sql.UpdateUserOTPHashFailedAttemptsParams{
+						MaxAttempts: 5,
+						ID:          userID,
+						OtpHash:     sql.Text(otpHash),
+					},
+				).Return(int32(5), nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "654321",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "otp invalidated after too many failed attempts",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.OtpHash = pgtype.Text{} //nolint:exhaustruct
+				user.OtpHashFailedAttempts = 5
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "expired otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.OtpHashExpiresAt = sql.TimestampTz(time.Now().Add(-time.Minute))
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "otp already used",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserConsumeOTP(
+					gomock.Any(),
+					sql.UpdateUserConsumeOTPParams{
+						ID:      userID,
+						OtpHash: sql.Text(otpHash),
+					},
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+
+		{
+			name:   "user not found",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+		{
+			name: "user of another tenant",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.TenantID = "acme"
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.TenantID = sql.Text("globex")
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(), sql.Text("+123456789"),
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsOtpRequestObject{
+				Body: &api.PostSigninPasswordlessSmsOtpJSONRequestBody{
+					PhoneNumber: "+123456789",
+					Otp:         "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			expectedJWT:   nil,
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninPasswordlessSmsOtp, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostSigninPasswordlessSmsOtp200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func cmpNotEmpty(x, y string) bool {
+	return x != "" && y != ""
+}
+
+func getSigninSMSUser(userID uuid.UUID) sql.AuthUser {
+	user := getSigninUser(userID)
+	user.Email = pgtype.Text{}        //nolint:exhaustruct
+	user.PasswordHash = pgtype.Text{} //nolint:exhaustruct
+	user.PhoneNumber = sql.Text("+123456789")
+	user.PhoneNumberVerified = true
+	return user
+}
+
+func TestPostSigninPasswordlessSms(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.SMSPasswordlessEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+
+	updateUserOTPHash := func(mock *mock.MockDBClient) {
+		mock.EXPECT().UpdateUserOTPHash(
+			gomock.Any(),
+			cmpDBParams(
+				sql.UpdateUserOTPHashParams{
+					ID:                userID,
+					OtpHash:           sql.Text("hash"),
+					OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+					OtpMethodLastUsed: sql.Text("sms"),
+				},
+				testhelpers.FilterPathLast(
+					[]string{".OtpHash", "text()"}, cmp.Comparer(cmpNotEmpty),
+				),
+				testhelpers.FilterPathLast(
+					[]string{".OtpHashExpiresAt", "time()"}, cmpopts.EquateApproxTime(time.Minute),
+				),
+			),
+		).Return(userID, nil)
+	}
+
+	sendSMS := func(ctrl *gomock.Controller, err error) *mock.MockSMSSender {
+		mock := mock.NewMockSMSSender(ctrl)
+		mock.EXPECT().SendSMS(
+			gomock.Any(),
+			"+123456789",
+			"en",
+			notifications.TemplateNameSigninPasswordlessSMS,
+			testhelpers.GomockCmpOpts(
+				notifications.TemplateData{ //nolint:exhaustruct
+					DisplayName: "Jane Doe",
+					Locale:      "en",
+					ServerURL:   "https://local.auth.nhost.run",
+					ClientURL:   "http://localhost:3000",
+					Code:        "123456",
+				},
+				testhelpers.FilterPathLast(
+					[]string{".Code"}, cmp.Comparer(cmpNotEmpty),
+				),
+			),
+		).Return(err)
+		return mock
+	}
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		sms              func(ctrl *gomock.Controller) *mock.MockSMSSender
+		request          api.PostSigninPasswordlessSmsRequestObject
+		expectedResponse api.PostSigninPasswordlessSmsResponseObject
+	}{
+		{
+			name:   "signup required",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+123456789"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().InsertUser(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserParams{
+						ID:              uuid.UUID{},
+						Disabled:        false,
+						DisplayName:     "+123456789",
+						AvatarUrl:       "",
+						Email:           pgtype.Text{}, //nolint:exhaustruct
+						PasswordHash:    pgtype.Text{}, //nolint:exhaustruct
+						Ticket:          pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt: sql.TimestampTz(time.Now()),
+						EmailVerified:   false,
+						Locale:          "en",
+						DefaultRole:     "user",
+						Metadata:        []byte("null"),
+						Roles:           []string{"user", "me"},
+						PhoneNumber:     sql.Text("+123456789"),
+					},
+						cmpopts.IgnoreFields(sql.InsertUserParams{}, "ID"), //nolint:exhaustruct
+					),
+				).Return(sql.InsertUserRow{
+					UserID:    userID,
+					CreatedAt: sql.TimestampTz(time.Now()),
+				}, nil)
+
+				updateUserOTPHash(mock)
+
+				return mock
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				mock := mock.NewMockSMSSender(ctrl)
+				mock.EXPECT().SendSMS(
+					gomock.Any(),
+					"+123456789",
+					"en",
+					notifications.TemplateNameSigninPasswordlessSMS,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{ //nolint:exhaustruct
+							DisplayName: "+123456789",
+							Locale:      "en",
+							ServerURL:   "https://local.auth.nhost.run",
+							ClientURL:   "http://localhost:3000",
+							Code:        "123456",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Code"}, cmp.Comparer(cmpNotEmpty),
+						),
+					),
+				).Return(nil)
+				return mock
+			},
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "+123456789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: api.PostSigninPasswordlessSms200JSONResponse(api.OK),
+		},
+
+		{
+			name:   "existing user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+123456789"),
+				).Return(getSigninSMSUser(userID), nil)
+
+				updateUserOTPHash(mock)
+
+				return mock
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				return sendSMS(ctrl, nil)
+			},
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "+123456789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: api.PostSigninPasswordlessSms200JSONResponse(api.OK),
+		},
+
+		{
+			name: "test phone number",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.SMSTestPhoneNumbers = []string{"+123456789"}
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+123456789"),
+				).Return(getSigninSMSUser(userID), nil)
+
+				updateUserOTPHash(mock)
+
+				return mock
+			},
+			sms: mock.NewMockSMSSender,
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "+123456789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: api.PostSigninPasswordlessSms200JSONResponse(api.OK),
+		},
+
+		{
+			name:   "error sending sms",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+123456789"),
+				).Return(getSigninSMSUser(userID), nil)
+
+				updateUserOTPHash(mock)
+
+				return mock
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				return sendSMS(ctrl, errors.New("provider error")) //nolint:goerr113
+			},
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "+123456789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "cannot-send-sms",
+				Message: "Error sending SMS",
+				Status:  500,
+			},
+		},
+
+		{
+			name:   "user disabled",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninSMSUser(userID)
+				user.Disabled = true
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+123456789"),
+				).Return(user, nil)
+
+				return mock
+			},
+			sms: mock.NewMockSMSSender,
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "+123456789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-user",
+				Message: "User is disabled",
+				Status:  401,
+			},
+		},
+
+		{
+			name:   "phone number not in E.164 format",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			sms: mock.NewMockSMSSender,
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "123-456-789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+		},
+
+		{
+			name: "disabled endpoint",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.SMSPasswordlessEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			sms: mock.NewMockSMSSender,
+			request: api.PostSigninPasswordlessSmsRequestObject{
+				Body: &api.PostSigninPasswordlessSmsJSONRequestBody{
+					PhoneNumber:  "+123456789",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              tc.sms,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(),
+				t,
+				c.PostSigninPasswordlessSms,
+				tc.request,
+				tc.expectedResponse,
+			)
+		})
+	}
+}
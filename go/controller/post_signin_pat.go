@@ -5,7 +5,6 @@ import (
 
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
-	"github.com/nhost/hasura-auth/go/sql"
 )
 
 func (ctrl *Controller) PostSigninPat( //nolint:ireturn
@@ -14,11 +13,8 @@ func (ctrl *Controller) PostSigninPat( //nolint:ireturn
 ) (api.PostSigninPatResponseObject, error) {
 	logger := middleware.LoggerFromContext(ctx)
 
-	user, apiErr := ctrl.wf.GetUserByRefreshTokenHash(
-		ctx,
-		request.Body.PersonalAccessToken,
-		sql.RefreshTokenTypePAT,
-		logger,
+	user, apiErr := ctrl.wf.GetUserByPersonalAccessToken(
+		ctx, request.Body.PersonalAccessToken, logger,
 	)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
@@ -27,7 +23,7 @@ func (ctrl *Controller) PostSigninPat( //nolint:ireturn
 	session, err := ctrl.wf.NewSession(ctx, user, logger)
 	if err != nil {
 		logger.Error("error getting new session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+		return ctrl.sendError(sessionAPIError(err)), nil
 	}
 
 	return api.PostSigninPat200JSONResponse{
@@ -8,10 +8,10 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/risk"
 	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
@@ -33,12 +33,8 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
-				mock.EXPECT().GetUserByRefreshTokenHash(
-					gomock.Any(),
-					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedPat),
-						Type:             "pat",
-					},
+				mock.EXPECT().GetUserByPersonalAccessTokenHash(
+					gomock.Any(), hashedPat,
 				).Return(getSigninUser(userID), nil)
 
 				mock.EXPECT().GetUserRoles(
@@ -52,7 +48,7 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 					gomock.Any(),
 					cmpDBParams(sql.InsertRefreshtokenParams{
 						UserID:           userID,
-						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash: "",
 						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						Type:             sql.RefreshTokenTypeRegular,
 						Metadata:         nil,
@@ -127,12 +123,8 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
-				mock.EXPECT().GetUserByRefreshTokenHash(
-					gomock.Any(),
-					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedPat),
-						Type:             "pat",
-					},
+				mock.EXPECT().GetUserByPersonalAccessTokenHash(
+					gomock.Any(), hashedPat,
 				).Return(getSigninUser(userID), nil)
 
 				mock.EXPECT().GetUserRoles(
@@ -146,7 +138,7 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 					gomock.Any(),
 					cmpDBParams(sql.InsertRefreshtokenParams{
 						UserID:           userID,
-						RefreshTokenHash: pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash: "",
 						ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						Type:             sql.RefreshTokenTypeRegular,
 						Metadata:         nil,
@@ -243,12 +235,8 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
-				mock.EXPECT().GetUserByRefreshTokenHash(
-					gomock.Any(),
-					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedPat),
-						Type:             "pat",
-					},
+				mock.EXPECT().GetUserByPersonalAccessTokenHash(
+					gomock.Any(), hashedPat,
 				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
 
 				return mock
@@ -280,12 +268,8 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 			db: func(ctrl *gomock.Controller) controller.DBClient {
 				mock := mock.NewMockDBClient(ctrl)
 
-				mock.EXPECT().GetUserByRefreshTokenHash(
-					gomock.Any(),
-					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedPat),
-						Type:             "pat",
-					},
+				mock.EXPECT().GetUserByPersonalAccessTokenHash(
+					gomock.Any(), hashedPat,
 				).Return(getSigninUser(userID), nil)
 
 				return mock
@@ -316,12 +300,8 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 				user := getSigninUser(userID)
 				user.Disabled = true
 
-				mock.EXPECT().GetUserByRefreshTokenHash(
-					gomock.Any(),
-					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedPat),
-						Type:             "pat",
-					},
+				mock.EXPECT().GetUserByPersonalAccessTokenHash(
+					gomock.Any(), hashedPat,
 				).Return(user, nil)
 
 				return mock
@@ -356,12 +336,8 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 				user := getSigninUser(userID)
 				user.EmailVerified = false
 
-				mock.EXPECT().GetUserByRefreshTokenHash(
-					gomock.Any(),
-					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedPat),
-						Type:             "pat",
-					},
+				mock.EXPECT().GetUserByPersonalAccessTokenHash(
+					gomock.Any(), hashedPat,
 				).Return(user, nil)
 
 				return mock
@@ -391,9 +367,23 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: tc.customClaimer,
-				emailer:       nil,
-				hibp:          nil,
+				customClaimer:    tc.customClaimer,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			resp := assertRequest(
@@ -407,3 +397,72 @@ func TestPostSigninPat(t *testing.T) { //nolint:maintidx
 		})
 	}
 }
+
+func TestPostSigninPatRisk(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	pat := uuid.MustParse("1fb17604-86c7-444e-b337-09a644465f2d")
+	hashedPat := `\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310`
+
+	ctrl := gomock.NewController(t)
+
+	config := func() *controller.Config {
+		config := getConfig()
+		config.RiskBlockThreshold = 80
+		return config
+	}
+
+	db := func(ctrl *gomock.Controller) controller.DBClient {
+		mock := mock.NewMockDBClient(ctrl)
+
+		mock.EXPECT().GetUserByPersonalAccessTokenHash(
+			gomock.Any(), hashedPat,
+		).Return(getSigninUser(userID), nil)
+
+		mock.EXPECT().RefreshTokenDeviceFingerprintExists(
+			gomock.Any(), gomock.Any(),
+		).Return(false, nil)
+
+		return mock
+	}
+
+	engine := &fakeRiskEngine{score: 90, err: nil, signals: risk.Signals{}} //nolint:exhaustruct
+	c, _ := getController(t, ctrl, config, db, getControllerOpts{
+		customClaimer:    nil,
+		emailer:          nil,
+		hibp:             nil,
+		sms:              nil,
+		providers:        nil,
+		idTokenProviders: nil,
+		saml:             nil,
+		rateLimiter:      nil,
+		captcha:          nil,
+		webhooks:         nil,
+		preSignUpHook:    nil,
+		disposableEmails: nil,
+		avatarStorage:    nil,
+		geoIP:            nil,
+		riskEngine:       engine,
+		configReloader:   nil,
+		signInMethods:    nil,
+	})
+
+	assertRequest(
+		context.Background(), t, c.PostSigninPat,
+		api.PostSigninPatRequestObject{
+			Body: &api.SignInPATRequest{
+				PersonalAccessToken: pat.String(),
+			},
+		},
+		api.PostSigninPatResponseObject(controller.ErrorResponse{
+			Error:   "risky-sign-in",
+			Message: "Sign in denied, it looks too risky",
+			Status:  403,
+		}),
+	)
+
+	if engine.signals.UserID != userID.String() || !engine.signals.NewDevice {
+		t.Errorf("unexpected signals: %#v", engine.signals)
+	}
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) PostSigninProviderProviderCallback( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninProviderProviderCallbackRequestObject,
+) (api.PostSigninProviderProviderCallbackResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", request.Provider))
+
+	return api.PostSigninProviderProviderCallback302Response{
+		Headers: api.PostSigninProviderProviderCallback302ResponseHeaders{
+			Location: ctrl.providerCallback(
+				ctx,
+				request.Body.State,
+				func(providerRequest providerRequest) (sql.AuthUser, *APIError) {
+					return ctrl.getSigninProviderCallbackSignIn(
+						ctx,
+						request.Provider,
+						providerCallbackParams{
+							Code:             request.Body.Code,
+							Error:            request.Body.Error,
+							ErrorDescription: request.Body.ErrorDescription,
+							User:             request.Body.User,
+							Nonce:            providerNonceFromCookie(request.Params.Cookie),
+						},
+						providerRequest,
+						logger,
+					)
+				},
+				logger,
+			),
+		},
+	}, nil
+}
@@ -0,0 +1,294 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	providersmock "github.com/nhost/hasura-auth/go/providers/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+)
+
+//nolint:lll
+type testPostSigninProviderCallbackRequest struct {
+	testRequest[api.PostSigninProviderProviderCallbackRequestObject, api.PostSigninProviderProviderCallbackResponseObject]
+	providers func(ctrl *gomock.Controller) map[string]providers.Provider
+}
+
+func TestPostSigninProviderProviderCallback(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	state := uuid.MustParse("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1")
+
+	token := &oauth2.Token{ //nolint:exhaustruct
+		AccessToken:  "my-access-token",
+		RefreshToken: "my-refresh-token",
+	}
+
+	relayProfile := providers.Profile{
+		ID:            "001234.abcd",
+		Email:         "x2mhd8q@privaterelay.appleid.com",
+		EmailVerified: true,
+		PrivateEmail:  true,
+		Name:          "",
+		AvatarURL:     "",
+		Locale:        "",
+	}
+
+	apple := func(profile providers.Profile) func(ctrl *gomock.Controller) map[string]providers.Provider {
+		return func(ctrl *gomock.Controller) map[string]providers.Provider {
+			mock := providersmock.NewMockProvider(ctrl)
+
+			mock.EXPECT().Exchange(
+				gomock.Any(), "my-code", "",
+			).Return(token, nil)
+
+			mock.EXPECT().GetProfile(
+				gomock.Any(), token,
+			).Return(profile, nil)
+
+			return map[string]providers.Provider{"apple": mock}
+		}
+	}
+
+	deleteProviderRequest := func(mock *mock.MockDBClient) {
+		mock.EXPECT().DeleteProviderRequest(
+			gomock.Any(), state,
+		).Return(
+			[]byte(`{"codeVerifier":"","options":{"redirectTo":"http://localhost:3000"},"nonceHash":"d5hrcqhcGfLd8Bp_1r1toAUY-LmTwwIcLbevViJsM4o"}`), //nolint:lll
+			nil,
+		)
+	}
+
+	cases := []testPostSigninProviderCallbackRequest{
+		{
+			testRequest: testRequest[api.PostSigninProviderProviderCallbackRequestObject, api.PostSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "new user with the name posted by the provider",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "apple",
+							ProviderUserID: "001234.abcd",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("x2mhd8q@privaterelay.appleid.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().InsertUserWithUserProvider(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertUserWithUserProviderParams{
+								ID:             uuid.UUID{},
+								Disabled:       false,
+								DisplayName:    "Jane Doe",
+								AvatarUrl:      "",
+								Email:          sql.Text("x2mhd8q@privaterelay.appleid.com"),
+								EmailVerified:  true,
+								Locale:         "en",
+								DefaultRole:    "user",
+								Metadata:       []byte("null"),
+								Roles:          []string{"user", "me"},
+								ProviderID:     "apple",
+								ProviderUserID: "001234.abcd",
+								AccessToken:    "my-access-token",
+								RefreshToken:   sql.Text("my-refresh-token"),
+							},
+							cmpopts.IgnoreFields(sql.InsertUserWithUserProviderParams{}, "ID"), //nolint:exhaustruct
+						),
+					).Return(sql.InsertUserWithUserProviderRow{
+						UserID:    userID,
+						CreatedAt: sql.TimestampTz(time.Now()),
+					}, nil)
+
+					mock.EXPECT().InsertRefreshtoken(
+						gomock.Any(),
+						cmpDBParams(sql.InsertRefreshtokenParams{
+							UserID:           userID,
+							RefreshTokenHash: "asdadasdasdasd",
+							ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+							Type:             sql.RefreshTokenTypeRegular,
+							Metadata:         nil,
+						}),
+					).Return(uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninProviderProviderCallbackRequestObject{
+					Provider: "apple",
+					Params: api.PostSigninProviderProviderCallbackParams{
+						Cookie: ptr("nhostAuthProviderNonce=my-nonce"),
+					},
+					Body: &api.PostSigninProviderProviderCallbackFormdataRequestBody{
+						State:                state.String(),
+						Code:                 ptr("my-code"),
+						IdToken:              nil,
+						User:                 ptr(`{"name":{"firstName":"Jane","lastName":"Doe"}}`),
+						Error:                nil,
+						ErrorDescription:     nil,
+						AdditionalProperties: nil,
+					},
+				},
+				expectedResponse: api.PostSigninProviderProviderCallback302Response{
+					Headers: api.PostSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: apple(relayProfile),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninProviderProviderCallbackRequestObject, api.PostSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "private relay email is not linked to an existing user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "apple",
+							ProviderUserID: "001234.abcd",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					user := getSigninUser(userID)
+					user.Email = sql.Text("x2mhd8q@privaterelay.appleid.com")
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("x2mhd8q@privaterelay.appleid.com"),
+					).Return(user, nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninProviderProviderCallbackRequestObject{
+					Provider: "apple",
+					Params: api.PostSigninProviderProviderCallbackParams{
+						Cookie: ptr("nhostAuthProviderNonce=my-nonce"),
+					},
+					Body: &api.PostSigninProviderProviderCallbackFormdataRequestBody{
+						State:                state.String(),
+						Code:                 ptr("my-code"),
+						IdToken:              nil,
+						User:                 nil,
+						Error:                nil,
+						ErrorDescription:     nil,
+						AdditionalProperties: nil,
+					},
+				},
+				expectedResponse: api.PostSigninProviderProviderCallback302Response{
+					Headers: api.PostSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=email-already-in-use&errorDescription=Email+already+in+use",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: apple(relayProfile),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninProviderProviderCallbackRequestObject, api.PostSigninProviderProviderCallbackResponseObject]{ //nolint:lll
+				name:   "user cancelled",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					deleteProviderRequest(mock)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninProviderProviderCallbackRequestObject{
+					Provider: "apple",
+					Params: api.PostSigninProviderProviderCallbackParams{
+						Cookie: ptr("nhostAuthProviderNonce=my-nonce"),
+					},
+					Body: &api.PostSigninProviderProviderCallbackFormdataRequestBody{
+						State:                state.String(),
+						Code:                 nil,
+						IdToken:              nil,
+						User:                 nil,
+						Error:                ptr("user_cancelled_authorize"),
+						ErrorDescription:     nil,
+						AdditionalProperties: nil,
+					},
+				},
+				expectedResponse: api.PostSigninProviderProviderCallback302Response{
+					Headers: api.PostSigninProviderProviderCallback302ResponseHeaders{
+						Location: "http://localhost:3000?error=oauth-provider-error&errorDescription=Error+communicating+with+the+OAuth+provider",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			providers: func(ctrl *gomock.Controller) map[string]providers.Provider {
+				return map[string]providers.Provider{"apple": providersmock.NewMockProvider(ctrl)}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        tc.providers,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostSigninProviderProviderCallback, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast(
+					[]string{".Location"}, cmp.Comparer(cmpRedirectLocation),
+				),
+			)
+		})
+	}
+}
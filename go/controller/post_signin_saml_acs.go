@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+	"golang.org/x/oauth2"
+)
+
+// samlProviderID is the provider id of the users signed in with SAML.
+const samlProviderID = "saml"
+
+func (ctrl *Controller) postSigninSamlAcsSignIn(
+	ctx context.Context,
+	samlResponse string,
+	providerRequest providerRequest,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	if ctrl.saml == nil {
+		logger.Warn("saml is disabled")
+		return sql.AuthUser{}, ErrDisabledEndpoint //nolint:exhaustruct
+	}
+
+	profile, roles, err := ctrl.saml.ParseResponse(samlResponse, providerRequest.SAMLRequestID)
+	if err != nil {
+		logger.Warn("error parsing saml response", logError(err))
+		return sql.AuthUser{}, ErrInvalidSAMLResponse //nolint:exhaustruct
+	}
+
+	// SAML doesn't give us tokens to access the identity provider on behalf of the user
+	token := &oauth2.Token{} //nolint:exhaustruct
+
+	return ctrl.wf.SignInWithProvider(
+		ctx,
+		samlProviderID,
+		profile,
+		token,
+		ctrl.wf.samlSignUpOptions(providerRequest.Options, roles, logger),
+		logger,
+	)
+}
+
+func (ctrl *Controller) PostSigninSamlAcs( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninSamlAcsRequestObject,
+) (api.PostSigninSamlAcsResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", samlProviderID))
+
+	return api.PostSigninSamlAcs302Response{
+		Headers: api.PostSigninSamlAcs302ResponseHeaders{
+			Location: ctrl.providerCallback(
+				ctx,
+				request.Body.RelayState,
+				func(providerRequest providerRequest) (sql.AuthUser, *APIError) {
+					return ctrl.postSigninSamlAcsSignIn(
+						ctx, request.Body.SAMLResponse, providerRequest, logger,
+					)
+				},
+				logger,
+			),
+		},
+	}, nil
+}
@@ -0,0 +1,278 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+type testPostSigninSamlAcsRequest struct {
+	testRequest[api.PostSigninSamlAcsRequestObject, api.PostSigninSamlAcsResponseObject]
+	saml func(ctrl *gomock.Controller) *mock.MockSAMLServiceProvider
+}
+
+func TestPostSigninSamlAcs(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	state := uuid.MustParse("4ffe3f8a-6d0b-4e5c-9d1e-28b7f0a8a3a1")
+
+	profile := providers.Profile{
+		ID:            "00u1abcd",
+		Email:         "jane@acme.com",
+		EmailVerified: false,
+		PrivateEmail:  false,
+		Name:          "Jane Doe",
+		AvatarURL:     "",
+		Locale:        "",
+	}
+
+	request := api.PostSigninSamlAcsRequestObject{
+		Body: &api.PostSigninSamlAcsFormdataRequestBody{
+			SAMLResponse:         "my-saml-response",
+			RelayState:           state.String(),
+			AdditionalProperties: nil,
+		},
+	}
+
+	parseResponse := func(
+		profile providers.Profile, roles []string, err error,
+	) func(ctrl *gomock.Controller) *mock.MockSAMLServiceProvider {
+		return func(ctrl *gomock.Controller) *mock.MockSAMLServiceProvider {
+			mock := mock.NewMockSAMLServiceProvider(ctrl)
+			mock.EXPECT().ParseResponse(
+				"my-saml-response", "id-1234",
+			).Return(profile, roles, err)
+			return mock
+		}
+	}
+
+	deleteProviderRequest := func(mock *mock.MockDBClient) {
+		mock.EXPECT().DeleteProviderRequest(
+			gomock.Any(), state,
+		).Return(
+			[]byte(`{"codeVerifier":"","options":{"redirectTo":"http://localhost:3000"},"samlRequestId":"id-1234"}`),
+			nil,
+		)
+	}
+
+	cases := []testPostSigninSamlAcsRequest{
+		{
+			testRequest: testRequest[api.PostSigninSamlAcsRequestObject, api.PostSigninSamlAcsResponseObject]{
+				name:   "new user with the roles asserted by the identity provider",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "saml",
+							ProviderUserID: "00u1abcd",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().InsertUserWithUserProvider(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertUserWithUserProviderParams{
+								ID:             uuid.UUID{},
+								Disabled:       false,
+								DisplayName:    "Jane Doe",
+								AvatarUrl:      "",
+								Email:          sql.Text("jane@acme.com"),
+								EmailVerified:  false,
+								Locale:         "en",
+								DefaultRole:    "me",
+								Metadata:       []byte("null"),
+								Roles:          []string{"me"},
+								ProviderID:     "saml",
+								ProviderUserID: "00u1abcd",
+								AccessToken:    "",
+								RefreshToken:   pgtype.Text{}, //nolint:exhaustruct
+							},
+							cmpopts.IgnoreFields(sql.InsertUserWithUserProviderParams{}, "ID"), //nolint:exhaustruct
+						),
+					).Return(sql.InsertUserWithUserProviderRow{
+						UserID:    userID,
+						CreatedAt: sql.TimestampTz(time.Now()),
+					}, nil)
+
+					mock.EXPECT().InsertRefreshtoken(
+						gomock.Any(),
+						cmpDBParams(sql.InsertRefreshtokenParams{
+							UserID:           userID,
+							RefreshTokenHash: "asdadasdasdasd",
+							ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+							Type:             sql.RefreshTokenTypeRegular,
+							Metadata:         nil,
+						}),
+					).Return(uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c"), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.PostSigninSamlAcs302Response{
+					Headers: api.PostSigninSamlAcs302ResponseHeaders{
+						Location: "http://localhost:3000?refreshToken=xxx",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: parseResponse(profile, []string{"admin", "me"}, nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninSamlAcsRequestObject, api.PostSigninSamlAcsResponseObject]{
+				name:   "unverified email is not linked to an existing user",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					deleteProviderRequest(mock)
+
+					mock.EXPECT().GetUserByProviderID(
+						gomock.Any(),
+						sql.GetUserByProviderIDParams{
+							ProviderID:     "saml",
+							ProviderUserID: "00u1abcd",
+						},
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(getSigninUser(userID), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.PostSigninSamlAcs302Response{
+					Headers: api.PostSigninSamlAcs302ResponseHeaders{
+						Location: "http://localhost:3000?error=email-already-in-use&errorDescription=Email+already+in+use",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: parseResponse(profile, nil, nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninSamlAcsRequestObject, api.PostSigninSamlAcsResponseObject]{
+				name:   "invalid response",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					deleteProviderRequest(mock)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.PostSigninSamlAcs302Response{
+					Headers: api.PostSigninSamlAcs302ResponseHeaders{
+						Location: "http://localhost:3000?error=invalid-saml-response&errorDescription=Invalid+SAML+response",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: parseResponse(
+				providers.Profile{}, //nolint:exhaustruct
+				nil,
+				errors.New("signature verification failed"), //nolint:goerr113
+			),
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninSamlAcsRequestObject, api.PostSigninSamlAcsResponseObject]{
+				name:   "invalid state",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().DeleteProviderRequest(
+						gomock.Any(), state,
+					).Return(nil, pgx.ErrNoRows)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request:       request,
+				expectedResponse: api.PostSigninSamlAcs302Response{
+					Headers: api.PostSigninSamlAcs302ResponseHeaders{
+						Location: "http://localhost:3000?error=invalid-state&errorDescription=Invalid+or+expired+OAuth+state",
+					},
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			saml: mock.NewMockSAMLServiceProvider,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             tc.saml,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			assertRequest(
+				context.Background(), t, c.PostSigninSamlAcs, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast(
+					[]string{".Location"}, cmp.Comparer(cmpRedirectLocation),
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) postSigninWebauthnWithEmail(
+	ctx context.Context,
+	email string,
+	logger *slog.Logger,
+) (*protocol.CredentialAssertion, *APIError) {
+	user, apiErr := ctrl.wf.GetUserByEmail(ctx, email, logger)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	webauthnUser, apiErr := ctrl.wf.GetWebauthnUser(ctx, user, logger)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	return ctrl.Webauthn.BeginLogin(webauthnUser, logger)
+}
+
+func (ctrl *Controller) PostSigninWebauthn( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninWebauthnRequestObject,
+) (api.PostSigninWebauthnResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.WebauthnEnabled {
+		logger.Warn("webauthn is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodWebauthn, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	var (
+		assertion *protocol.CredentialAssertion
+		apiErr    *APIError
+	)
+	if request.Body.Email != nil {
+		logger = logger.With(slog.String("email", string(*request.Body.Email)))
+		assertion, apiErr = ctrl.postSigninWebauthnWithEmail(
+			ctx, string(*request.Body.Email), logger,
+		)
+	} else {
+		assertion, apiErr = ctrl.Webauthn.BeginDiscoverableLogin(logger)
+	}
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	return api.PostSigninWebauthn200JSONResponse(assertion.Response), nil
+}
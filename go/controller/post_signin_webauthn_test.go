@@ -0,0 +1,317 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+type testSigninWebauhtnRequest struct {
+	testRequest[api.PostSigninWebauthnRequestObject, api.PostSigninWebauthnResponseObject]
+	savedChallenge *controller.WebauthnChallenge
+}
+
+//nolint:dupl
+func TestPostSigninWebauthn(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	credentialID := []byte("my-credential-id")
+
+	securityKey := sql.AuthUserSecurityKey{
+		ID:                  uuid.MustParse("307b758d-c0b0-4ce3-894b-f8ddec753c29"),
+		UserID:              userID,
+		CredentialID:        "bXktY3JlZGVudGlhbC1pZA",
+		CredentialPublicKey: []byte("public-key"),
+		Counter:             3,
+		Transports:          "",
+		Nickname:            sql.Text("my-key"),
+	}
+
+	cases := []testSigninWebauhtnRequest{
+		{
+			testRequest: testRequest[api.PostSigninWebauthnRequestObject, api.PostSigninWebauthnResponseObject]{
+				name:   "with email",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetSecurityKeys(
+						gomock.Any(), userID,
+					).Return([]sql.AuthUserSecurityKey{securityKey}, nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnRequestObject{
+					Body: &api.SignInWebauthnRequest{
+						Email: ptr(types.Email("jane@acme.com")),
+					},
+				},
+				expectedResponse: api.PostSigninWebauthn200JSONResponse{
+					Challenge:      []byte{},
+					Timeout:        60000,
+					RelyingPartyID: "react-apollo.example.nhost.io",
+					AllowedCredentials: []protocol.CredentialDescriptor{
+						{
+							Type:            "public-key",
+							CredentialID:    credentialID,
+							Transport:       nil,
+							AttestationType: "",
+						},
+					},
+					UserVerification: "preferred",
+					Extensions:       nil,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: &controller.WebauthnChallenge{
+				Session: webauthn.SessionData{
+					Challenge:            "",
+					UserID:               []byte(userID.String()),
+					AllowedCredentialIDs: [][]byte{credentialID},
+					Expires:              time.Now().Add(time.Minute),
+					UserVerification:     "preferred",
+					Extensions:           nil,
+				},
+				User: controller.WebauthnUser{
+					ID:    userID,
+					Name:  "Jane Doe",
+					Email: "jane@acme.com",
+					Credentials: []webauthn.Credential{
+						{
+							ID:              credentialID,
+							PublicKey:       []byte("public-key"),
+							AttestationType: "",
+							Transport:       nil,
+							Flags:           webauthn.CredentialFlags{}, //nolint:exhaustruct
+							Authenticator: webauthn.Authenticator{ //nolint:exhaustruct
+								SignCount: 3,
+							},
+						},
+					},
+				},
+				Options: nil,
+			},
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnRequestObject, api.PostSigninWebauthnResponseObject]{
+				name:   "discoverable",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnRequestObject{
+					Body: &api.SignInWebauthnRequest{
+						Email: nil,
+					},
+				},
+				expectedResponse: api.PostSigninWebauthn200JSONResponse{
+					Challenge:          []byte{},
+					Timeout:            60000,
+					RelyingPartyID:     "react-apollo.example.nhost.io",
+					AllowedCredentials: nil,
+					UserVerification:   "preferred",
+					Extensions:         nil,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: &controller.WebauthnChallenge{
+				Session: webauthn.SessionData{
+					Challenge:            "",
+					UserID:               nil,
+					AllowedCredentialIDs: [][]byte{},
+					Expires:              time.Now().Add(time.Minute),
+					UserVerification:     "preferred",
+					Extensions:           nil,
+				},
+				User:    controller.WebauthnUser{}, //nolint:exhaustruct
+				Options: nil,
+			},
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnRequestObject, api.PostSigninWebauthnResponseObject]{
+				name:   "user has no security keys",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetSecurityKeys(
+						gomock.Any(), userID,
+					).Return([]sql.AuthUserSecurityKey{}, nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnRequestObject{
+					Body: &api.SignInWebauthnRequest{
+						Email: ptr(types.Email("jane@acme.com")),
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-request",
+					Message: "The request payload is incorrect",
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: nil,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnRequestObject, api.PostSigninWebauthnResponseObject]{
+				name:   "user not found",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUserByEmail(
+						gomock.Any(), sql.Text("jane@acme.com"),
+					).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnRequestObject{
+					Body: &api.SignInWebauthnRequest{
+						Email: ptr(types.Email("jane@acme.com")),
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-email-password",
+					Message: "Incorrect email or password",
+					Status:  401,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: nil,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnRequestObject, api.PostSigninWebauthnResponseObject]{
+				name: "webauthn disabled",
+				config: func() *controller.Config {
+					c := getConfig()
+					c.WebauthnEnabled = false
+					return c
+				},
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnRequestObject{
+					Body: &api.SignInWebauthnRequest{
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-endpoint",
+					Message: "This endpoint is disabled",
+					Status:  409,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			//nolint:exhaustruct
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninWebauthn, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(api.PostSigninWebauthn200JSONResponse{}, "Challenge"),
+			)
+
+			if !tc.config().WebauthnEnabled {
+				return
+			}
+
+			var gotSavedChallenge *controller.WebauthnChallenge
+			if resp200, ok := resp.(api.PostSigninWebauthn200JSONResponse); ok {
+				if ch, ok := c.Webauthn.Challenge(resp200.Challenge.String()); ok {
+					gotSavedChallenge = &ch
+				}
+			}
+
+			cmpOpts := cmp.Options{
+				testhelpers.FilterPathLast(
+					[]string{".Expires"}, cmpopts.EquateApproxTime(time.Minute),
+				),
+				cmpopts.IgnoreFields(
+					webauthn.SessionData{}, "Challenge", //nolint:exhaustruct
+				),
+			}
+
+			if diff := cmp.Diff(tc.savedChallenge, gotSavedChallenge, cmpOpts...); diff != "" {
+				t.Errorf("unexpected storage (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
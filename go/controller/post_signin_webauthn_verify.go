@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (ctrl *Controller) PostSigninWebauthnVerify( //nolint:ireturn
+	ctx context.Context,
+	request api.PostSigninWebauthnVerifyRequestObject,
+) (api.PostSigninWebauthnVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.WebauthnEnabled {
+		logger.Warn("webauthn is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodWebauthn, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	credData, err := request.Body.Credential.Parse()
+	if err != nil {
+		logger.Warn("error parsing credential data", logError(err))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	var user sql.AuthUser
+	cred, _, apiErr := ctrl.Webauthn.FinishLogin(
+		credData,
+		func(userID uuid.UUID) (WebauthnUser, *APIError) {
+			u, apiErr := ctrl.wf.GetUser(ctx, userID, logger)
+			if apiErr != nil {
+				return WebauthnUser{}, apiErr //nolint:exhaustruct
+			}
+			user = u
+
+			return ctrl.wf.GetWebauthnUser(ctx, user, logger)
+		},
+		logger,
+	)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if apiErr := ctrl.wf.UpdateSecurityKeyCounter(ctx, user.ID, cred, logger); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	session, err := ctrl.wf.NewSession(ctx, user, logger)
+	if err != nil {
+		logger.Error("error getting new session", logError(err))
+		return ctrl.sendError(sessionAPIError(err)), nil
+	}
+
+	return api.PostSigninWebauthnVerify200JSONResponse{
+		Session: session,
+		Mfa:     nil,
+	}, nil
+}
@@ -0,0 +1,540 @@
+package controller_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+type webauthnTestAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+}
+
+func newWebauthnTestAuthenticator(t *testing.T) webauthnTestAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return webauthnTestAuthenticator{
+		key:          key,
+		credentialID: []byte("my-credential-id"),
+	}
+}
+
+func (a webauthnTestAuthenticator) securityKey(
+	t *testing.T, userID uuid.UUID, counter int64,
+) sql.AuthUserSecurityKey {
+	t.Helper()
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.PublicKey.X.FillBytes(make([]byte, 32)), //nolint:mnd
+		YCoord: a.key.PublicKey.Y.FillBytes(make([]byte, 32)), //nolint:mnd
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sql.AuthUserSecurityKey{
+		ID:                  uuid.MustParse("307b758d-c0b0-4ce3-894b-f8ddec753c29"),
+		UserID:              userID,
+		CredentialID:        base64.RawURLEncoding.EncodeToString(a.credentialID),
+		CredentialPublicKey: publicKey,
+		Counter:             counter,
+		Transports:          "",
+		Nickname:            pgtype.Text{}, //nolint:exhaustruct
+	}
+}
+
+func (a webauthnTestAuthenticator) assertion(
+	t *testing.T, challenge string, userHandle []byte, counter uint32,
+) protocol.CredentialAssertionResponse {
+	t.Helper()
+
+	clientData, err := json.Marshal(map[string]any{
+		"type":      "webauthn.get",
+		"challenge": challenge,
+		"origin":    "https://react-apollo.example.nhost.io",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rpIDHash := sha256.Sum256([]byte("react-apollo.example.nhost.io"))
+	authData := append(rpIDHash[:], 0x05) //nolint:gocritic,mnd // user present and verified
+	authData = binary.BigEndian.AppendUint32(authData, counter)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b64 := base64.RawURLEncoding.EncodeToString
+	raw, err := json.Marshal(map[string]any{
+		"id":    b64(a.credentialID),
+		"rawId": b64(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    b64(clientData),
+			"authenticatorData": b64(authData),
+			"signature":         b64(signature),
+			"userHandle":        b64(userHandle),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resp protocol.CredentialAssertionResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	return resp
+}
+
+type testSigninWebauthnVerifyRequest struct {
+	testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]
+	savedChallenge controller.WebauthnChallenge
+}
+
+func TestPostSigninWebauthnVerify(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	challenge := "zznztjvFVUM0E2p8ZV6shXEcw2f4tbz5RrfZWk4VPXI"
+
+	authenticator := newWebauthnTestAuthenticator(t)
+	otherAuthenticator := newWebauthnTestAuthenticator(t)
+
+	discoverableChallenge := controller.WebauthnChallenge{
+		Session: webauthn.SessionData{
+			Challenge:            challenge,
+			UserID:               nil,
+			AllowedCredentialIDs: [][]byte{},
+			Expires:              time.Now().Add(time.Minute),
+			UserVerification:     "preferred",
+			Extensions:           nil,
+		},
+		User:    controller.WebauthnUser{}, //nolint:exhaustruct
+		Options: nil,
+	}
+
+	emailChallenge := controller.WebauthnChallenge{
+		Session: webauthn.SessionData{
+			Challenge:            challenge,
+			UserID:               []byte(userID.String()),
+			AllowedCredentialIDs: [][]byte{authenticator.credentialID},
+			Expires:              time.Now().Add(time.Minute),
+			UserVerification:     "preferred",
+			Extensions:           nil,
+		},
+		User: controller.WebauthnUser{ //nolint:exhaustruct
+			ID:    userID,
+			Name:  "Jane Doe",
+			Email: "jane@acme.com",
+		},
+		Options: nil,
+	}
+
+	successDB := func(ctrl *gomock.Controller) controller.DBClient {
+		mock := mock.NewMockDBClient(ctrl)
+
+		mock.EXPECT().GetUser(
+			gomock.Any(), userID,
+		).Return(getSigninUser(userID), nil)
+
+		mock.EXPECT().GetSecurityKeys(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserSecurityKey{authenticator.securityKey(t, userID, 3)}, nil)
+
+		mock.EXPECT().UpdateSecurityKeyCounter(
+			gomock.Any(),
+			sql.UpdateSecurityKeyCounterParams{
+				Counter:      4,
+				UserID:       userID,
+				CredentialID: base64.RawURLEncoding.EncodeToString(authenticator.credentialID),
+			},
+		).Return(nil)
+
+		mock.EXPECT().GetUserRoles(
+			gomock.Any(), userID,
+		).Return([]sql.AuthUserRole{
+			{UserID: userID, Role: "user"}, //nolint:exhaustruct
+			{UserID: userID, Role: "me"},   //nolint:exhaustruct
+		}, nil)
+
+		mock.EXPECT().InsertRefreshtoken(
+			gomock.Any(),
+			cmpDBParams(sql.InsertRefreshtokenParams{
+				UserID:           userID,
+				RefreshTokenHash: "",
+				ExpiresAt:        sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+				Type:             sql.RefreshTokenTypeRegular,
+				Metadata:         nil,
+			}),
+		).Return(refreshTokenID, nil)
+
+		mock.EXPECT().UpdateUserLastSeen(
+			gomock.Any(), userID,
+		).Return(sql.TimestampTz(time.Now()), nil)
+
+		return mock
+	}
+
+	successResponse := api.PostSigninWebauthnVerify200JSONResponse{
+		Mfa: nil,
+		Session: &api.Session{
+			AccessToken:          "",
+			AccessTokenExpiresIn: 900,
+			RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+			RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+			User: &api.User{
+				AvatarUrl:           "",
+				CreatedAt:           time.Now(),
+				DefaultRole:         "user",
+				DisplayName:         "Jane Doe",
+				Email:               ptr(types.Email("jane@acme.com")),
+				EmailVerified:       true,
+				Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+				IsAnonymous:         false,
+				Locale:              "en",
+				Metadata:            map[string]any{},
+				PhoneNumber:         "",
+				PhoneNumberVerified: false,
+				Roles:               []string{"user", "me"},
+			},
+		},
+	}
+
+	successJWT := &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "false",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+
+	cases := []testSigninWebauthnVerifyRequest{
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name:          "discoverable",
+				config:        getConfig,
+				db:            successDB,
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: successResponse,
+				expectedJWT:      successJWT,
+				jwtTokenFn:       nil,
+			},
+			savedChallenge: discoverableChallenge,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name:          "with email",
+				config:        getConfig,
+				db:            successDB,
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: ptr(types.Email("jane@acme.com")),
+					},
+				},
+				expectedResponse: successResponse,
+				expectedJWT:      successJWT,
+				jwtTokenFn:       nil,
+			},
+			savedChallenge: emailChallenge,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name:   "wrong key",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetSecurityKeys(
+						gomock.Any(), userID,
+					).Return(
+						[]sql.AuthUserSecurityKey{authenticator.securityKey(t, userID, 3)}, nil,
+					)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: otherAuthenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-webauthn-security-key",
+					Message: "Invalid WebAuthn security key",
+					Status:  401,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: discoverableChallenge,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name:   "counter didn't increase",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().GetSecurityKeys(
+						gomock.Any(), userID,
+					).Return(
+						[]sql.AuthUserSecurityKey{authenticator.securityKey(t, userID, 3)}, nil,
+					)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 3,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-webauthn-security-key",
+					Message: "Invalid WebAuthn security key",
+					Status:  401,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: discoverableChallenge,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name:   "user disabled",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					user := getSigninUser(userID)
+					user.Disabled = true
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(user, nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-user",
+					Message: "User is disabled",
+					Status:  401,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: discoverableChallenge,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name:   "unknown challenge",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, "unknown-challenge", []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "invalid-request",
+					Message: "The request payload is incorrect",
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: discoverableChallenge,
+		},
+
+		{
+			testRequest: testRequest[api.PostSigninWebauthnVerifyRequestObject, api.PostSigninWebauthnVerifyResponseObject]{ //nolint:lll
+				name: "webauthn disabled",
+				config: func() *controller.Config {
+					c := getConfig()
+					c.WebauthnEnabled = false
+					return c
+				},
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostSigninWebauthnVerifyRequestObject{
+					Body: &api.SignInWebauthnVerifyRequest{
+						Credential: authenticator.assertion(
+							t, challenge, []byte(userID.String()), 4,
+						),
+						Email: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-endpoint",
+					Message: "This endpoint is disabled",
+					Status:  409,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  nil,
+			},
+			savedChallenge: discoverableChallenge,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			if c.Webauthn != nil {
+				c.Webauthn.SaveChallenge(tc.savedChallenge.Session.Challenge, tc.savedChallenge)
+			}
+
+			resp := assertRequest(
+				context.Background(), t, c.PostSigninWebauthnVerify, tc.request, tc.expectedResponse,
+			)
+
+			resp200, ok := resp.(api.PostSigninWebauthnVerify200JSONResponse)
+			if ok {
+				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
+
+				if _, ok := c.Webauthn.Challenge(challenge); ok {
+					t.Errorf("challenge should've been removed")
+				}
+			}
+		})
+	}
+}
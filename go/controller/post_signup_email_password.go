@@ -20,16 +20,22 @@ func (ctrl *Controller) postSignupEmailPasswordValidateRequest(
 		return api.PostSignupEmailPasswordRequestObject{}, ErrSignupDisabled //nolint:exhaustruct
 	}
 
-	if err := ctrl.wf.ValidateSignupEmail(req.Body.Email, logger); err != nil {
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodEmailPassword, logger); apiErr != nil {
+		return api.PostSignupEmailPasswordRequestObject{}, apiErr //nolint:exhaustruct
+	}
+
+	if err := ctrl.wf.ValidateSignupEmail(ctx, req.Body.Email, logger); err != nil {
 		return api.PostSignupEmailPasswordRequestObject{}, err //nolint:exhaustruct
 	}
 
-	if err := ctrl.wf.ValidatePassword(ctx, req.Body.Password, logger); err != nil {
+	if err := ctrl.wf.ValidatePassword(
+		ctx, req.Body.Password, string(req.Body.Email), logger,
+	); err != nil {
 		return api.PostSignupEmailPasswordRequestObject{}, err //nolint:exhaustruct
 	}
 
 	options, err := ctrl.wf.ValidateSignUpOptions(
-		req.Body.Options, string(req.Body.Email), logger,
+		req.Body.Options, string(req.Body.Email), SignInMethodEmailPassword, logger,
 	)
 	if err != nil {
 		return api.PostSignupEmailPasswordRequestObject{}, err //nolint:exhaustruct
@@ -79,35 +85,37 @@ func (ctrl *Controller) postSignupEmailPasswordWithEmailVerificationOrUserDisabl
 ) (api.PostSignupEmailPasswordResponseObject, error) {
 	ticket := generateTicket(TicketTypeVerifyEmail)
 
-	if _, err := ctrl.wf.SignUpUser(
-		ctx,
-		email,
-		options,
-		logger,
-		SignupUserWithTicket(ticket, time.Now().Add(InAMonth)),
-		SignupUserWithPassword(password),
-	); err != nil {
-		return ctrl.respondWithError(err), nil
-	}
+	if apiErr := ctrl.wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		if _, err := ctrl.wf.SignUpUser(
+			ctx,
+			email,
+			options,
+			logger,
+			SignupUserWithTicket(ticket, time.Now().Add(InAMonth)),
+			ctrl.wf.SignupUserWithPassword(password),
+		); err != nil {
+			return err
+		}
 
-	if ctrl.config.DisableNewUsers {
-		return api.PostSignupEmailPassword200JSONResponse{Session: nil}, nil
-	}
+		if ctrl.config.DisableNewUsers {
+			return nil
+		}
 
-	if err := ctrl.wf.SendEmail(
-		ctx,
-		email,
-		deptr(options.Locale),
-		LinkTypeEmailVerify,
-		ticket,
-		deptr(options.RedirectTo),
-		notifications.TemplateNameEmailVerify,
-		deptr(options.DisplayName),
-		email,
-		"",
-		logger,
-	); err != nil {
-		return ctrl.sendError(err), nil
+		return ctrl.wf.SendEmail(
+			ctx,
+			email,
+			deptr(options.Locale),
+			LinkTypeEmailVerify,
+			ticket,
+			deptr(options.RedirectTo),
+			notifications.TemplateNameEmailVerify,
+			deptr(options.DisplayName),
+			email,
+			"",
+			logger,
+		)
+	}); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
 	}
 
 	return api.PostSignupEmailPassword200JSONResponse{Session: nil}, nil
@@ -121,17 +129,18 @@ func (ctrl *Controller) postSignupEmailPasswordWithoutEmailVerification( //nolin
 	logger *slog.Logger,
 ) (api.PostSignupEmailPasswordResponseObject, error) {
 	refreshToken := uuid.New()
-	expiresAt := time.Now().Add(time.Duration(ctrl.config.RefreshTokenExpiresIn) * time.Second)
+	lifetimes := ctrl.wf.sessionLifetimes(ctx)
 
 	userSession, resp, apiErr := ctrl.wf.SignupUserWithRefreshToken(
-		ctx, email, password, refreshToken, expiresAt, options, logger,
+		ctx, email, password, refreshToken, lifetimes, options, logger,
 	)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
 	}
 
-	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetToken(
-		ctx, resp.UserID, false, deptr(options.AllowedRoles), *options.DefaultRole, logger,
+	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetTokenExpiringIn(
+		ctx, resp.UserID, false, deptr(options.AllowedRoles), *options.DefaultRole,
+		lifetimes.accessTokenExpiration(), logger,
 	)
 	if err != nil {
 		logger.Error("error getting jwt", logError(err))
@@ -3,6 +3,7 @@ package controller_test
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -14,9 +15,11 @@ import (
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/controller"
 	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/disposable"
 	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/nhost/hasura-auth/go/testhelpers"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
 )
@@ -53,7 +56,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(insertResponse, nil)
@@ -71,9 +74,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
@@ -124,6 +128,104 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			jwtTokenFn: nil,
 		},
 
+		{
+			name: "sign in method roles",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.EmailPasswordDefaultRole = "customer"
+				config.EmailPasswordAllowedRoles = []string{"customer", "me"}
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserWithRefreshTokenParams{
+						Disabled:              false,
+						DisplayName:           "jane@acme.com",
+						AvatarUrl:             "",
+						Email:                 sql.Text("jane@acme.com"),
+						PasswordHash:          pgtype.Text{}, //nolint:exhaustruct
+						Ticket:                pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt:       sql.TimestampTz(time.Now()),
+						EmailVerified:         false,
+						Locale:                "en",
+						DefaultRole:           "customer",
+						Metadata:              []byte("null"),
+						Roles:                 []string{"customer", "me"},
+						RefreshTokenHash:      "",
+						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+					}),
+				).Return(insertResponse, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				return mock
+			},
+			hibp: func(ctrl *gomock.Controller) *mock.MockHIBPClient {
+				mock := mock.NewMockHIBPClient(ctrl)
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostSignupEmailPasswordRequestObject{
+				Body: &api.PostSignupEmailPasswordJSONRequestBody{
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "customer",
+						DisplayName:         "jane@acme.com",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            nil,
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"customer", "me"},
+					},
+				},
+			},
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"customer", "me"},
+						"x-hasura-default-role":      "customer",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			jwtTokenFn: nil,
+		},
+
 		{
 			name:   "simple with options",
 			config: getConfig,
@@ -145,7 +247,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "me",
 						Metadata:              []byte(`{"firstName":"Jane","lastName":"Doe"}`),
 						Roles:                 []string{"me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(insertResponse, nil)
@@ -176,6 +278,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						},
 						RedirectTo: ptr("http://localhost:3000"),
 					},
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
@@ -249,9 +352,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -311,9 +415,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -371,9 +476,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -404,7 +510,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(
@@ -425,9 +531,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -464,7 +571,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(
@@ -485,9 +592,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -522,9 +630,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "p",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "p",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -536,6 +645,44 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			jwtTokenFn:  nil,
 		},
 
+		{ //nolint:dupl
+			name: "password contains email",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.PasswordRejectEmail = true
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				return mock
+			},
+			hibp: func(ctrl *gomock.Controller) *mock.MockHIBPClient {
+				mock := mock.NewMockHIBPClient(ctrl)
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostSignupEmailPasswordRequestObject{
+				Body: &api.PostSignupEmailPasswordJSONRequestBody{
+					Email:        "jane@acme.com",
+					Password:     "jane@acme.com-password",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "password-contains-email",
+				Message: "Password must not contain the email",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
 		{
 			name: "hibp fail",
 			config: func() *controller.Config {
@@ -565,9 +712,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -604,7 +752,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(insertResponse, nil)
@@ -628,9 +776,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
@@ -710,6 +859,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						Metadata:     nil,
 						RedirectTo:   nil,
 					},
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -721,6 +871,93 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			jwtTokenFn:  nil,
 		},
 
+		{
+			name: "role not allowed for the sign in method",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.EmailPasswordDefaultRole = "customer"
+				config.EmailPasswordAllowedRoles = []string{"customer"}
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				return mock
+			},
+			hibp: func(ctrl *gomock.Controller) *mock.MockHIBPClient {
+				mock := mock.NewMockHIBPClient(ctrl)
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostSignupEmailPasswordRequestObject{
+				Body: &api.PostSignupEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+					Options: &api.SignUpOptions{
+						AllowedRoles: &[]string{"user"},
+						DefaultRole:  ptr("user"),
+						DisplayName:  nil,
+						Locale:       nil,
+						Metadata:     nil,
+						RedirectTo:   nil,
+					},
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "role-not-allowed",
+				Message: "Role not allowed",
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name:   "metadata doesn't match the schema",
+			config: getUserMetadataSchemaConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+				return mock
+			},
+			hibp: func(ctrl *gomock.Controller) *mock.MockHIBPClient {
+				mock := mock.NewMockHIBPClient(ctrl)
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostSignupEmailPasswordRequestObject{
+				Body: &api.PostSignupEmailPasswordJSONRequestBody{
+					Email:    "jane@acme.com",
+					Password: "password",
+					Options: &api.SignUpOptions{
+						AllowedRoles: nil,
+						DefaultRole:  nil,
+						DisplayName:  nil,
+						Locale:       nil,
+						Metadata:     &map[string]any{"theme": "blue"},
+						RedirectTo:   nil,
+					},
+					CaptchaToken: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-metadata",
+				Message: `theme: value is not one of the allowed values ["light","dark"]`,
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  nil,
+		},
+
 		{
 			name: "simple with gravatar",
 			config: func() *controller.Config {
@@ -746,7 +983,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(insertResponse, nil)
@@ -764,9 +1001,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
@@ -842,7 +1080,7 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 					}),
 				).Return(insertResponse, nil)
@@ -859,9 +1097,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
@@ -976,15 +1215,20 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailVerify,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3Ac2ee89db-095c-4904-b796-f6a507ee1260&type=emailVerify", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "verifyEmail:c2ee89db-095c-4904-b796-f6a507ee1260",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3Ac2ee89db-095c-4904-b796-f6a507ee1260&type=emailVerify", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "verifyEmail:c2ee89db-095c-4904-b796-f6a507ee1260",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -1002,9 +1246,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
@@ -1037,9 +1282,10 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			customClaimer: nil,
 			request: api.PostSignupEmailPasswordRequestObject{
 				Body: &api.PostSignupEmailPasswordJSONRequestBody{
-					Email:    "jane@acme.com",
-					Password: "password",
-					Options:  nil,
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -1059,9 +1305,23 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: tc.customClaimer,
-				emailer:       tc.emailer,
-				hibp:          tc.hibp,
+				customClaimer:    tc.customClaimer,
+				emailer:          tc.emailer,
+				hibp:             tc.hibp,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			resp := assertRequest(
@@ -1075,3 +1335,291 @@ func TestPostSignupEmailPassword(t *testing.T) { //nolint:maintidx
 		})
 	}
 }
+
+func TestPostSignupEmailPasswordPreSignUpHook(t *testing.T) {
+	t.Parallel()
+
+	refreshTokenID := uuid.MustParse("c3b747ef-76a9-4c56-8091-ed3e6b8afb2c")
+	userID := uuid.MustParse("DB477732-48FA-4289-B694-2886A646B6EB")
+
+	hookRequest := webhooks.PreSignUpRequest{
+		Email:        "jane@acme.com",
+		PhoneNumber:  "",
+		DisplayName:  "jane@acme.com",
+		Locale:       "en",
+		DefaultRole:  "user",
+		AllowedRoles: []string{"user", "me"},
+		Metadata:     nil,
+		Provider:     "",
+		IsAnonymous:  false,
+		IPAddress:    "",
+		UserAgent:    "",
+	}
+
+	cases := []struct {
+		name             string
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		preSignUpHook    func(ctrl *gomock.Controller) *mock.MockPreSignUpHook
+		expectedResponse api.PostSignupEmailPasswordResponseObject
+	}{
+		{
+			name: "accepted with changes",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().InsertUserWithRefreshToken(
+					gomock.Any(),
+					cmpDBParams(sql.InsertUserWithRefreshTokenParams{
+						Disabled:              false,
+						DisplayName:           "jane@acme.com",
+						AvatarUrl:             "",
+						Email:                 sql.Text("jane@acme.com"),
+						PasswordHash:          pgtype.Text{}, //nolint:exhaustruct
+						Ticket:                pgtype.Text{}, //nolint:exhaustruct
+						TicketExpiresAt:       sql.TimestampTz(time.Now()),
+						EmailVerified:         false,
+						Locale:                "en",
+						DefaultRole:           "customer",
+						Metadata:              []byte(`{"plan":"free"}`),
+						Roles:                 []string{"customer"},
+						RefreshTokenHash:      "",
+						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
+					}),
+				).Return(sql.InsertUserWithRefreshTokenRow{
+					UserID:         userID,
+					RefreshTokenID: refreshTokenID,
+				}, nil)
+
+				return mock
+			},
+			preSignUpHook: func(ctrl *gomock.Controller) *mock.MockPreSignUpHook {
+				mock := mock.NewMockPreSignUpHook(ctrl)
+
+				mock.EXPECT().Call(gomock.Any(), hookRequest).Return(webhooks.PreSignUpResponse{
+					Allow:        true,
+					Message:      "",
+					DefaultRole:  ptr("customer"),
+					AllowedRoles: &[]string{"customer"},
+					Metadata:     &map[string]any{"plan": "free"},
+				}, nil)
+
+				return mock
+			},
+			expectedResponse: api.PostSignupEmailPassword200JSONResponse{
+				Session: &api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshTokenId:       "c3b747ef-76a9-4c56-8091-ed3e6b8afb2c",
+					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "customer",
+						DisplayName:         "jane@acme.com",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       false,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{"plan": "free"},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"customer"},
+					},
+				},
+			},
+		},
+
+		{
+			name: "rejected",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			preSignUpHook: func(ctrl *gomock.Controller) *mock.MockPreSignUpHook {
+				mock := mock.NewMockPreSignUpHook(ctrl)
+
+				mock.EXPECT().Call(gomock.Any(), hookRequest).Return(webhooks.PreSignUpResponse{
+					Allow:        false,
+					Message:      "Sign ups are invite only",
+					DefaultRole:  nil,
+					AllowedRoles: nil,
+					Metadata:     nil,
+				}, nil)
+
+				return mock
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "signup-rejected",
+				Message: "Sign ups are invite only",
+				Status:  403,
+			},
+		},
+
+		{
+			name: "default role not allowed",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			preSignUpHook: func(ctrl *gomock.Controller) *mock.MockPreSignUpHook {
+				mock := mock.NewMockPreSignUpHook(ctrl)
+
+				mock.EXPECT().Call(gomock.Any(), hookRequest).Return(webhooks.PreSignUpResponse{
+					Allow:        true,
+					Message:      "",
+					DefaultRole:  ptr("admin"),
+					AllowedRoles: nil,
+					Metadata:     nil,
+				}, nil)
+
+				return mock
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+				Status:  500,
+			},
+		},
+
+		{
+			name: "hook unreachable",
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			preSignUpHook: func(ctrl *gomock.Controller) *mock.MockPreSignUpHook {
+				mock := mock.NewMockPreSignUpHook(ctrl)
+
+				mock.EXPECT().Call(gomock.Any(), hookRequest).Return(
+					webhooks.PreSignUpResponse{},     //nolint:exhaustruct
+					errors.New("connection refused"), //nolint:goerr113
+				)
+
+				return mock
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+				Status:  500,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, getConfig, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    tc.preSignUpHook,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			request := api.PostSignupEmailPasswordRequestObject{
+				Body: &api.PostSignupEmailPasswordJSONRequestBody{
+					Email:        "jane@acme.com",
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			}
+
+			assertRequest(
+				context.Background(), t, c.PostSignupEmailPassword, request, tc.expectedResponse,
+			)
+		})
+	}
+}
+
+func TestPostSignupEmailPasswordDisposableEmail(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		email            string
+		expectedResponse api.PostSignupEmailPasswordResponseObject
+	}{
+		{
+			name:  "disposable email",
+			email: "jane@mailinator.com",
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disposable-email",
+				Message: "Email addresses of disposable email providers aren't allowed",
+				Status:  400,
+			},
+		},
+
+		{
+			name:  "subdomain of a disposable email provider",
+			email: "jane@eu.mailinator.com",
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disposable-email",
+				Message: "Email addresses of disposable email providers aren't allowed",
+				Status:  400,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			config := func() *controller.Config {
+				c := getConfig()
+				c.DisposableEmailCheck = controller.DisposableEmailCheckReject
+				return c
+			}
+
+			c, _ := getController(t, ctrl, config, func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			}, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: disposable.New("", slog.Default()),
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			request := api.PostSignupEmailPasswordRequestObject{
+				Body: &api.PostSignupEmailPasswordJSONRequestBody{
+					Email:        types.Email(tc.email),
+					Password:     "password",
+					Options:      nil,
+					CaptchaToken: nil,
+				},
+			}
+
+			assertRequest(
+				context.Background(), t, c.PostSignupEmailPassword, request, tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 )
 
 func (ctrl *Controller) postSignupWebauthnValidateRequest(
+	ctx context.Context,
 	request api.PostSignupWebauthnRequestObject,
 	logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
@@ -23,14 +24,18 @@ func (ctrl *Controller) postSignupWebauthnValidateRequest(
 		return nil, ErrSignupDisabled
 	}
 
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodWebauthn, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
 	options, apiErr := ctrl.wf.ValidateSignUpOptions(
-		request.Body.Options, string(request.Body.Email), logger,
+		request.Body.Options, string(request.Body.Email), SignInMethodPasswordless, logger,
 	)
 	if apiErr != nil {
 		return nil, apiErr
 	}
 
-	if apiErr := ctrl.wf.ValidateSignupEmail(request.Body.Email, logger); apiErr != nil {
+	if apiErr := ctrl.wf.ValidateSignupEmail(ctx, request.Body.Email, logger); apiErr != nil {
 		return nil, apiErr
 	}
 
@@ -44,7 +49,7 @@ func (ctrl *Controller) PostSignupWebauthn( //nolint:ireturn
 	logger := middleware.LoggerFromContext(ctx).
 		With(slog.String("email", string(request.Body.Email)))
 
-	options, apiErr := ctrl.postSignupWebauthnValidateRequest(request, logger)
+	options, apiErr := ctrl.postSignupWebauthnValidateRequest(ctx, request, logger)
 	if apiErr != nil {
 		return ctrl.sendError(apiErr), nil
 	}
@@ -49,8 +49,9 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 				customClaimer: nil,
 				request: api.PostSignupWebauthnRequestObject{
 					Body: &api.PostSignupWebauthnJSONRequestBody{
-						Email:   "jane@acme.com",
-						Options: nil,
+						Email:        "jane@acme.com",
+						Options:      nil,
+						CaptchaToken: nil,
 					},
 				},
 				expectedResponse: api.PostSignupWebauthn200JSONResponse{
@@ -153,6 +154,7 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 							},
 							RedirectTo: ptr("http://localhost:3000/redirect"),
 						},
+						CaptchaToken: nil,
 					},
 				},
 				expectedResponse: api.PostSignupWebauthn200JSONResponse{
@@ -247,8 +249,9 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 				customClaimer: nil,
 				request: api.PostSignupWebauthnRequestObject{
 					Body: &api.PostSignupWebauthnJSONRequestBody{
-						Email:   "jane@acme.com",
-						Options: nil,
+						Email:        "jane@acme.com",
+						Options:      nil,
+						CaptchaToken: nil,
 					},
 				},
 				expectedResponse: controller.ErrorResponse{
@@ -285,8 +288,9 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 				customClaimer: nil,
 				request: api.PostSignupWebauthnRequestObject{
 					Body: &api.PostSignupWebauthnJSONRequestBody{
-						Email:   "jane@acme.com",
-						Options: nil,
+						Email:        "jane@acme.com",
+						Options:      nil,
+						CaptchaToken: nil,
 					},
 				},
 				expectedResponse: controller.ErrorResponse{
@@ -320,8 +324,9 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 				customClaimer: nil,
 				request: api.PostSignupWebauthnRequestObject{
 					Body: &api.PostSignupWebauthnJSONRequestBody{
-						Email:   "jane@acme.com",
-						Options: nil,
+						Email:        "jane@acme.com",
+						Options:      nil,
+						CaptchaToken: nil,
 					},
 				},
 				expectedResponse: api.PostSignupWebauthn200JSONResponse{
@@ -421,6 +426,7 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 							Metadata:     nil,
 							RedirectTo:   ptr("http://evil.com/redirect"),
 						},
+						CaptchaToken: nil,
 					},
 				},
 				expectedResponse: controller.ErrorResponse{
@@ -442,13 +448,27 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: tc.customClaimer,
-				emailer:       tc.emailer,
-				hibp:          tc.hibp,
+				customClaimer:    tc.customClaimer,
+				emailer:          tc.emailer,
+				hibp:             tc.hibp,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			//nolint:exhaustruct
-			_ = assertRequest(
+			resp := assertRequest(
 				context.Background(), t, c.PostSignupWebauthn, tc.request, tc.expectedResponse,
 				cmpopts.IgnoreFields(api.PostSignupWebauthn200JSONResponse{}, "Challenge"),
 				cmpopts.IgnoreFields(protocol.UserEntity{}, "ID"),
@@ -459,8 +479,8 @@ func TestPostSignupWebauthn(t *testing.T) { //nolint:maintidx
 			}
 
 			var gotSavedChallenge controller.WebauthnChallenge
-			for _, v := range c.Webauthn.Storage {
-				gotSavedChallenge = v
+			if resp200, ok := resp.(api.PostSignupWebauthn200JSONResponse); ok {
+				gotSavedChallenge, _ = c.Webauthn.Challenge(resp200.Challenge.String())
 			}
 
 			cmpOpts := cmp.Options{
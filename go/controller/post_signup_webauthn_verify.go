@@ -33,12 +33,17 @@ func (ctrl *Controller) postSignupWebauthnVerifyValidateRequest( //nolint:cyclop
 		return nil, nil, "", ErrInvalidRequest
 	}
 
-	ch, ok := ctrl.Webauthn.Storage[credData.Response.CollectedClientData.Challenge]
+	ch, ok := ctrl.Webauthn.Challenge(credData.Response.CollectedClientData.Challenge)
 	if !ok {
 		logger.Error("challenge not found")
 		return nil, nil, "", ErrInvalidRequest
 	}
 
+	if ch.Options == nil {
+		logger.Error("challenge wasn't issued for a sign up")
+		return nil, nil, "", ErrInvalidRequest
+	}
+
 	options := ch.Options
 	var apiErr *APIError
 	if request.Body.Options != nil { //nolint:nestif
@@ -66,7 +71,9 @@ func (ctrl *Controller) postSignupWebauthnVerifyValidateRequest( //nolint:cyclop
 			options.RedirectTo = request.Body.Options.RedirectTo
 		}
 
-		options, apiErr = ctrl.wf.ValidateSignUpOptions(options, ch.User.Email, logger)
+		options, apiErr = ctrl.wf.ValidateSignUpOptions(
+			options, ch.User.Email, SignInMethodPasswordless, logger,
+		)
 		if apiErr != nil {
 			return nil, nil, "", apiErr
 		}
@@ -94,6 +101,10 @@ func (ctrl *Controller) PostSignupWebauthnVerify( //nolint:ireturn
 		return ctrl.sendError(apiErr), nil
 	}
 
+	if apiErr := ctrl.checkSignInMethod(ctx, signInMethodWebauthn, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
 	credResult, webauthnUser, apiErr := ctrl.Webauthn.FinishRegistration(credData, logger)
 	if apiErr != nil {
 		return ctrl.sendError(apiErr), nil
@@ -121,39 +132,45 @@ func (ctrl *Controller) postSignupWebauthnVerifyWithEmailVerificationOrUserDisab
 	ticket := generateTicket(TicketTypeVerifyEmail)
 	expireAt := time.Now().Add(InAMonth)
 
-	if _, err := ctrl.wf.SignupUserWithSecurityKey(
-		ctx,
-		webauthnUser.ID,
-		webauthnUser.Email,
-		ticket,
-		expireAt,
-		options,
-		credResult.ID,
-		credResult.PublicKey,
-		nickname,
-		logger,
-	); err != nil {
-		return ctrl.respondWithError(err), nil
-	}
+	var sendErr *APIError
+	if apiErr := ctrl.wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		if _, err := ctrl.wf.SignupUserWithSecurityKey(
+			ctx,
+			webauthnUser.ID,
+			webauthnUser.Email,
+			ticket,
+			expireAt,
+			options,
+			credResult,
+			nickname,
+			logger,
+		); err != nil {
+			return err
+		}
 
-	if ctrl.config.DisableNewUsers {
-		return api.PostSignupWebauthnVerify200JSONResponse{Session: nil}, nil
-	}
+		if ctrl.config.DisableNewUsers {
+			return nil
+		}
 
-	if err := ctrl.wf.SendEmail(
-		ctx,
-		webauthnUser.Email,
-		deptr(options.Locale),
-		LinkTypeEmailVerify,
-		ticket,
-		deptr(options.RedirectTo),
-		notifications.TemplateNameEmailVerify,
-		deptr(options.DisplayName),
-		webauthnUser.Email,
-		"",
-		logger,
-	); err != nil {
-		return nil, err
+		sendErr = ctrl.wf.SendEmail(
+			ctx,
+			webauthnUser.Email,
+			deptr(options.Locale),
+			LinkTypeEmailVerify,
+			ticket,
+			deptr(options.RedirectTo),
+			notifications.TemplateNameEmailVerify,
+			deptr(options.DisplayName),
+			webauthnUser.Email,
+			"",
+			logger,
+		)
+		return sendErr
+	}); apiErr != nil {
+		if sendErr != nil {
+			return nil, sendErr
+		}
+		return ctrl.respondWithError(apiErr), nil
 	}
 
 	return api.PostSignupWebauthnVerify200JSONResponse{Session: nil}, nil
@@ -168,17 +185,16 @@ func (ctrl *Controller) postSignupWebauthnVerifyWithoutEmailVerificationOrUserDi
 	logger *slog.Logger,
 ) (api.PostSignupWebauthnVerifyResponseObject, error) {
 	refreshToken := uuid.New()
-	expiresAt := time.Now().Add(time.Duration(ctrl.config.RefreshTokenExpiresIn) * time.Second)
+	lifetimes := ctrl.wf.sessionLifetimes(ctx)
 
 	user, refreshTokenID, apiErr := ctrl.wf.SignupUserWithSecurityKeyAndRefreshToken(
 		ctx,
 		webauthnUser.ID,
 		webauthnUser.Email,
 		refreshToken,
-		expiresAt,
+		lifetimes,
 		options,
-		credResult.ID,
-		credResult.PublicKey,
+		credResult,
 		nickname,
 		logger,
 	)
@@ -186,8 +202,9 @@ func (ctrl *Controller) postSignupWebauthnVerifyWithoutEmailVerificationOrUserDi
 		return ctrl.sendError(apiErr), nil
 	}
 
-	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetToken(
-		ctx, webauthnUser.ID, false, deptr(options.AllowedRoles), *options.DefaultRole, logger,
+	accessToken, expiresIn, err := ctrl.wf.jwtGetter.GetTokenExpiringIn(
+		ctx, webauthnUser.ID, false, deptr(options.AllowedRoles), *options.DefaultRole,
+		lifetimes.accessTokenExpiration(), logger,
 	)
 	if err != nil {
 		logger.Error("error getting jwt", logError(err))
@@ -79,6 +79,7 @@ func webAuthnTouchID(
 	if err := json.Unmarshal(rawChallenge, &challenge); err != nil {
 		t.Fatal(err)
 	}
+	challenge.Session.Expires = time.Now().Add(time.Minute)
 
 	return resp, challenge
 }
@@ -144,6 +145,7 @@ func webAuthnWindowsHello(
 	if err := json.Unmarshal(rawChallenge, &challenge); err != nil {
 		t.Fatal(err)
 	}
+	challenge.Session.Expires = time.Now().Add(time.Minute)
 
 	return resp, challenge
 }
@@ -185,13 +187,14 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						CredentialID:          "LychOomEPgZu4XNwiDvzlP5hd1U",
 						CredentialPublicKey: []uint8{
 							0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20, 0x57, 0xe1, 0xb5, 0x82, 0xa0, 0x95, 0xc4, 0x1a, 0xf3, 0x65, 0x9d, 0xdd, 0xc2, 0x68, 0xcf, 0x66, 0x35, 0x25, 0x32, 0xa5, 0x86, 0x22, 0xfb, 0xf7, 0xc6, 0xc6, 0x08, 0x6d, 0xa9, 0xc9, 0x64, 0x7f, 0x22, 0x58, 0x20, 0xa3, 0x50, 0x94, 0x11, 0xb8, 0x27, 0x52, 0xae, 0x46, 0xec, 0x56, 0x3a, 0x3b, 0x3a, 0x6d, 0x71, 0x24, 0x10, 0x66, 0xae, 0xb2, 0x57, 0x75, 0xd5, 0xbb, 0x98, 0x8c, 0xd0, 0xc5, 0x91, 0x1f, 0x65, //nolint:lll
 						},
-						Nickname: pgtype.Text{}, //nolint:exhaustruct
+						Transports: "internal",
+						Nickname:   pgtype.Text{}, //nolint:exhaustruct
 					}),
 				).Return(insertResponse, nil)
 
@@ -281,13 +284,14 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						CredentialID:          "t4r2_E24k3bp-LwQUz5M2xazSsWfZpATRPtaelkfqfc",
 						CredentialPublicKey: []uint8{
 							0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20, 0x9c, 0xe4, 0x9a, 0x64, 0x2b, 0xd7, 0xe6, 0x3b, 0xd9, 0xc2, 0x35, 0xdd, 0x6b, 0x61, 0x0e, 0xe3, 0x77, 0xb1, 0x8e, 0xae, 0x8e, 0xf5, 0x38, 0x09, 0x21, 0x68, 0xde, 0x06, 0xc4, 0xfd, 0x83, 0x75, 0x22, 0x58, 0x20, 0xb0, 0xfa, 0x39, 0x07, 0xea, 0x14, 0x3e, 0xe2, 0x1a, 0xd8, 0xa0, 0xaf, 0x79, 0xf8, 0x2c, 0x9b, 0x1c, 0xc3, 0x65, 0xd2, 0x43, 0x5a, 0x3a, 0x11, 0x0d, 0xad, 0xef, 0xf7, 0x39, 0x93, 0x9e, 0xb5, //nolint:lll
 						},
-						Nickname: pgtype.Text{}, //nolint:exhaustruct
+						Transports: "internal",
+						Nickname:   pgtype.Text{}, //nolint:exhaustruct
 					}),
 				).Return(insertResponse, nil)
 
@@ -385,7 +389,8 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 						CredentialPublicKey: []uint8{
 							0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20, 0x57, 0xe1, 0xb5, 0x82, 0xa0, 0x95, 0xc4, 0x1a, 0xf3, 0x65, 0x9d, 0xdd, 0xc2, 0x68, 0xcf, 0x66, 0x35, 0x25, 0x32, 0xa5, 0x86, 0x22, 0xfb, 0xf7, 0xc6, 0xc6, 0x08, 0x6d, 0xa9, 0xc9, 0x64, 0x7f, 0x22, 0x58, 0x20, 0xa3, 0x50, 0x94, 0x11, 0xb8, 0x27, 0x52, 0xae, 0x46, 0xec, 0x56, 0x3a, 0x3b, 0x3a, 0x6d, 0x71, 0x24, 0x10, 0x66, 0xae, 0xb2, 0x57, 0x75, 0xd5, 0xbb, 0x98, 0x8c, 0xd0, 0xc5, 0x91, 0x1f, 0x65, //nolint:lll
 						},
-						Nickname: pgtype.Text{}, //nolint:exhaustruct
+						Transports: "internal",
+						Nickname:   pgtype.Text{}, //nolint:exhaustruct
 					}),
 				).Return(userID, nil)
 
@@ -401,15 +406,20 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailVerify,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3Ac2ee89db-095c-4904-b796-f6a507ee1260&type=emailVerify", //nolint:lll
-							DisplayName: "Jane Doe",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "verifyEmail:c2ee89db-095c-4904-b796-f6a507ee1260",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3Ac2ee89db-095c-4904-b796-f6a507ee1260&type=emailVerify", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "verifyEmail:c2ee89db-095c-4904-b796-f6a507ee1260",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -506,7 +516,8 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 						CredentialPublicKey: []uint8{
 							0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20, 0x57, 0xe1, 0xb5, 0x82, 0xa0, 0x95, 0xc4, 0x1a, 0xf3, 0x65, 0x9d, 0xdd, 0xc2, 0x68, 0xcf, 0x66, 0x35, 0x25, 0x32, 0xa5, 0x86, 0x22, 0xfb, 0xf7, 0xc6, 0xc6, 0x08, 0x6d, 0xa9, 0xc9, 0x64, 0x7f, 0x22, 0x58, 0x20, 0xa3, 0x50, 0x94, 0x11, 0xb8, 0x27, 0x52, 0xae, 0x46, 0xec, 0x56, 0x3a, 0x3b, 0x3a, 0x6d, 0x71, 0x24, 0x10, 0x66, 0xae, 0xb2, 0x57, 0x75, 0xd5, 0xbb, 0x98, 0x8c, 0xd0, 0xc5, 0x91, 0x1f, 0x65, //nolint:lll
 						},
-						Nickname: pgtype.Text{}, //nolint:exhaustruct
+						Transports: "internal",
+						Nickname:   pgtype.Text{}, //nolint:exhaustruct
 					}),
 				).Return(userID, nil)
 
@@ -597,13 +608,14 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 						DefaultRole:           "user",
 						Metadata:              []byte("null"),
 						Roles:                 []string{"user", "me"},
-						RefreshTokenHash:      pgtype.Text{}, //nolint:exhaustruct
+						RefreshTokenHash:      "",
 						RefreshTokenExpiresAt: sql.TimestampTz(time.Now().Add(30 * 24 * time.Hour)),
 						CredentialID:          "LychOomEPgZu4XNwiDvzlP5hd1U",
 						CredentialPublicKey: []uint8{
 							0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20, 0x57, 0xe1, 0xb5, 0x82, 0xa0, 0x95, 0xc4, 0x1a, 0xf3, 0x65, 0x9d, 0xdd, 0xc2, 0x68, 0xcf, 0x66, 0x35, 0x25, 0x32, 0xa5, 0x86, 0x22, 0xfb, 0xf7, 0xc6, 0xc6, 0x08, 0x6d, 0xa9, 0xc9, 0x64, 0x7f, 0x22, 0x58, 0x20, 0xa3, 0x50, 0x94, 0x11, 0xb8, 0x27, 0x52, 0xae, 0x46, 0xec, 0x56, 0x3a, 0x3b, 0x3a, 0x6d, 0x71, 0x24, 0x10, 0x66, 0xae, 0xb2, 0x57, 0x75, 0xd5, 0xbb, 0x98, 0x8c, 0xd0, 0xc5, 0x91, 0x1f, 0x65, //nolint:lll
 						},
-						Nickname: pgtype.Text{}, //nolint:exhaustruct
+						Transports: "internal",
+						Nickname:   pgtype.Text{}, //nolint:exhaustruct
 					}),
 				).Return(sql.InsertUserWithSecurityKeyAndRefreshTokenRow{}, //nolint:exhaustruct
 					errors.New(`ERROR: duplicate key value violates unique constraint "users_email_key" (SQLSTATE 23505)`), //nolint:goerr113,lll
@@ -664,17 +676,35 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: tc.customClaimer,
-				emailer:       tc.emailer,
-				hibp:          tc.hibp,
+				customClaimer:    tc.customClaimer,
+				emailer:          tc.emailer,
+				hibp:             tc.hibp,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			if !tc.config().WebauthnEnabled {
 				return
 			}
 
-			c.Webauthn.Storage["zznztjvFVUM0E2p8ZV6shXEcw2f4tbz5RrfZWk4VPXI"] = touchIDWebauthnChallenge
-			c.Webauthn.Storage["zv9lPTJpOlgxzlrKWl-tG7AdxeUIbCwxqV8MFZZNRdA"] = windowsHelloWebauthnChallenge
+			c.Webauthn.SaveChallenge(
+				"zznztjvFVUM0E2p8ZV6shXEcw2f4tbz5RrfZWk4VPXI", touchIDWebauthnChallenge,
+			)
+			c.Webauthn.SaveChallenge(
+				"zv9lPTJpOlgxzlrKWl-tG7AdxeUIbCwxqV8MFZZNRdA", windowsHelloWebauthnChallenge,
+			)
 
 			resp := assertRequest(
 				context.Background(),
@@ -688,7 +718,14 @@ func TestPostSignupWebauthnVerify(t *testing.T) { //nolint:maintidx
 			if ok {
 				assertSession(t, jwtGetter, resp200.Session, tc.expectedJWT)
 
-				if _, ok := c.Webauthn.Storage["zznztjvFVUM0E2p8ZV6shXEcw2f4tbz5RrfZWk4VPXI"]; ok {
+				credData, err := tc.request.Body.Credential.Parse()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if _, ok := c.Webauthn.Challenge(
+					credData.Response.CollectedClientData.Challenge,
+				); ok {
 					t.Errorf("challenge should've been removed")
 				}
 			}
@@ -30,10 +30,9 @@ func (ctrl *Controller) PostToken( //nolint:ireturn
 		}
 	}
 
-	session, err := ctrl.wf.UpdateSession(ctx, user, request.Body.RefreshToken, logger)
-	if err != nil {
-		logger.Error("error updating session", logError(err))
-		return ctrl.sendError(ErrInternalServerError), nil
+	session, apiErr := ctrl.wf.RotateSession(ctx, user, request.Body.RefreshToken, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
 	}
 
 	return api.PostToken200JSONResponse(*session), nil
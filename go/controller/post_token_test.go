@@ -58,6 +58,7 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 	token := uuid.MustParse("1fb17604-86c7-444e-b337-09a644465f2d")
 	tokenID := uuid.MustParse("1fb13604-86c7-4444-a337-09a644465f2d")
 	hashedToken := `\x9698157153010b858587119503cbeef0cf288f11775e51cdb6bfd65e930d9310`
+	familyID := uuid.MustParse("2c8f3a90-1f4b-4c3e-9a6d-7b5e0d1c2f3a")
 
 	cases := []testRequest[api.PostTokenRequestObject, api.PostTokenResponseObject]{
 		{
@@ -69,20 +70,24 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 				mock.EXPECT().GetUserByRefreshTokenHash(
 					gomock.Any(),
 					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedToken),
+						RefreshTokenHash: hashedToken,
 						Type:             "regular",
+						ReuseInterval:    10,
 					},
 				).Return(getSigninUser(userID), nil)
 
-				mock.EXPECT().RefreshTokenAndGetUserRoles(
+				mock.EXPECT().RotateRefreshTokenAndGetUserRoles(
 					gomock.Any(),
-					cmpDBParams(sql.RefreshTokenAndGetUserRolesParams{
-						RefreshTokenHash: sql.Text(hashedToken),
+					cmpDBParams(sql.RotateRefreshTokenAndGetUserRolesParams{
+						OldRefreshTokenHash: hashedToken,
+						ReuseInterval:       10,
+						RefreshTokenHash:    "asdadasdasdasd",
+						SlidingExpiration:   true,
 						ExpiresAt: sql.TimestampTz(
 							time.Now().Add(time.Duration(2592000) * time.Second),
 						),
 					}),
-				).Return([]sql.RefreshTokenAndGetUserRolesRow{
+				).Return([]sql.RotateRefreshTokenAndGetUserRolesRow{
 					{Role: sql.Text("user"), RefreshTokenID: tokenID},
 					{Role: sql.Text("me"), RefreshTokenID: tokenID},
 				}, nil)
@@ -98,7 +103,7 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 				api.Session{
 					AccessToken:          "",
 					AccessTokenExpiresIn: 900,
-					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					RefreshToken:         "",
 					RefreshTokenId:       "1fb13604-86c7-4444-a337-09a644465f2d",
 					User: &api.User{
 						AvatarUrl:           "",
@@ -144,6 +149,287 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 			hibp:          nil,
 			jwtTokenFn:    nil,
 		},
+		{
+			name: "fixed expiration",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.RefreshTokenExpiration = controller.RefreshTokenExpirationFixed
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RotateRefreshTokenAndGetUserRoles(
+					gomock.Any(),
+					cmpDBParams(sql.RotateRefreshTokenAndGetUserRolesParams{
+						OldRefreshTokenHash: hashedToken,
+						ReuseInterval:       10,
+						RefreshTokenHash:    "asdadasdasdasd",
+						SlidingExpiration:   false,
+						ExpiresAt: sql.TimestampTz(
+							time.Now().Add(time.Duration(2592000) * time.Second),
+						),
+					}),
+				).Return([]sql.RotateRefreshTokenAndGetUserRolesRow{
+					{Role: sql.Text("user"), RefreshTokenID: tokenID},
+					{Role: sql.Text("me"), RefreshTokenID: tokenID},
+				}, nil)
+
+				return mock
+			},
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: api.PostToken200JSONResponse(
+				api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshToken:         "",
+					RefreshTokenId:       "1fb13604-86c7-4444-a337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			),
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+		{
+			name: "sliding expiration with a max lifetime",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.RefreshTokenMaxLifetime = 86400
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RotateRefreshTokenAndGetUserRoles(
+					gomock.Any(),
+					cmpDBParams(sql.RotateRefreshTokenAndGetUserRolesParams{
+						OldRefreshTokenHash: hashedToken,
+						ReuseInterval:       10,
+						RefreshTokenHash:    "asdadasdasdasd",
+						SlidingExpiration:   true,
+						ExpiresAt: sql.TimestampTz(
+							time.Now().Add(time.Duration(86400) * time.Second),
+						),
+						MaxLifetime: sql.NullableInt4(86400),
+					}),
+				).Return([]sql.RotateRefreshTokenAndGetUserRolesRow{
+					{Role: sql.Text("user"), RefreshTokenID: tokenID},
+					{Role: sql.Text("me"), RefreshTokenID: tokenID},
+				}, nil)
+
+				return mock
+			},
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: api.PostToken200JSONResponse(
+				api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 900,
+					RefreshToken:         "",
+					RefreshTokenId:       "1fb13604-86c7-4444-a337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			),
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
+		{
+			name:   "refresh token with the lifetimes of its client",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RotateRefreshTokenAndGetUserRoles(
+					gomock.Any(),
+					cmpDBParams(sql.RotateRefreshTokenAndGetUserRolesParams{
+						OldRefreshTokenHash: hashedToken,
+						ReuseInterval:       10,
+						RefreshTokenHash:    "asdadasdasdasd",
+						SlidingExpiration:   true,
+						ExpiresAt: sql.TimestampTz(
+							time.Now().Add(time.Duration(2592000) * time.Second),
+						),
+					}),
+				).Return([]sql.RotateRefreshTokenAndGetUserRolesRow{
+					{
+						Role:                 sql.Text("user"),
+						RefreshTokenID:       tokenID,
+						AccessTokenExpiresIn: sql.NullableInt4(3600),
+					},
+					{
+						Role:                 sql.Text("me"),
+						RefreshTokenID:       tokenID,
+						AccessTokenExpiresIn: sql.NullableInt4(3600),
+					},
+				}, nil)
+
+				return mock
+			},
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: api.PostToken200JSONResponse(
+				api.Session{
+					AccessToken:          "",
+					AccessTokenExpiresIn: 3600,
+					RefreshToken:         "",
+					RefreshTokenId:       "1fb13604-86c7-4444-a337-09a644465f2d",
+					User: &api.User{
+						AvatarUrl:           "",
+						CreatedAt:           time.Now(),
+						DefaultRole:         "user",
+						DisplayName:         "Jane Doe",
+						Email:               ptr(types.Email("jane@acme.com")),
+						EmailVerified:       true,
+						Id:                  "db477732-48fa-4289-b694-2886a646b6eb",
+						IsAnonymous:         false,
+						Locale:              "en",
+						Metadata:            map[string]any{},
+						PhoneNumber:         "",
+						PhoneNumberVerified: false,
+						Roles:               []string{"user", "me"},
+					},
+				},
+			),
+			expectedJWT: &jwt.Token{
+				Raw:    "",
+				Method: jwt.SigningMethodHS256,
+				Header: map[string]any{
+					"alg": "HS256",
+					"typ": "JWT",
+				},
+				Claims: jwt.MapClaims{
+					"exp": float64(time.Now().Add(3600 * time.Second).Unix()),
+					"https://hasura.io/jwt/claims": map[string]any{
+						"x-hasura-allowed-roles":     []any{"user", "me"},
+						"x-hasura-default-role":      "user",
+						"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+						"x-hasura-user-is-anonymous": "false",
+					},
+					"iat": float64(time.Now().Unix()),
+					"iss": "hasura-auth",
+					"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+				},
+				Signature: []byte{},
+				Valid:     true,
+			},
+			customClaimer: nil,
+			emailer:       nil,
+			hibp:          nil,
+			jwtTokenFn:    nil,
+		},
 		{
 			name:   "anonymous user",
 			config: getConfig,
@@ -153,20 +439,24 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 				mock.EXPECT().GetUserByRefreshTokenHash(
 					gomock.Any(),
 					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedToken),
+						RefreshTokenHash: hashedToken,
 						Type:             "regular",
+						ReuseInterval:    10,
 					},
 				).Return(getAnonymousUser(userID), nil)
 
-				mock.EXPECT().RefreshTokenAndGetUserRoles(
+				mock.EXPECT().RotateRefreshTokenAndGetUserRoles(
 					gomock.Any(),
-					cmpDBParams(sql.RefreshTokenAndGetUserRolesParams{
-						RefreshTokenHash: sql.Text(hashedToken),
+					cmpDBParams(sql.RotateRefreshTokenAndGetUserRolesParams{
+						OldRefreshTokenHash: hashedToken,
+						ReuseInterval:       10,
+						RefreshTokenHash:    "asdadasdasdasd",
+						SlidingExpiration:   true,
 						ExpiresAt: sql.TimestampTz(
 							time.Now().Add(time.Duration(2592000) * time.Second),
 						),
 					}),
-				).Return([]sql.RefreshTokenAndGetUserRolesRow{
+				).Return([]sql.RotateRefreshTokenAndGetUserRolesRow{
 					{Role: sql.Text("anonymous"), RefreshTokenID: tokenID},
 				}, nil)
 
@@ -181,7 +471,7 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 				api.Session{
 					AccessToken:          "",
 					AccessTokenExpiresIn: 900,
-					RefreshToken:         "1fb17604-86c7-444e-b337-09a644465f2d",
+					RefreshToken:         "",
 					RefreshTokenId:       "1fb13604-86c7-4444-a337-09a644465f2d",
 					User: &api.User{
 						AvatarUrl:           "",
@@ -240,11 +530,108 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 				mock.EXPECT().GetUserByRefreshTokenHash(
 					gomock.Any(),
 					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedToken),
+						RefreshTokenHash: hashedToken,
 						Type:             "regular",
+						ReuseInterval:    10,
 					},
 				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
 
+				mock.EXPECT().DeleteRefreshTokenFamilyByRotatedHash(
+					gomock.Any(), hashedToken,
+				).Return(nil, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-refresh-token",
+				Message: "Invalid or expired refresh token",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			emailer:     nil,
+			hibp:        nil,
+			jwtTokenFn:  nil,
+		},
+		{
+			name:   "reused refresh token",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().DeleteRefreshTokenFamilyByRotatedHash(
+					gomock.Any(), hashedToken,
+				).Return([]sql.DeleteRefreshTokenFamilyByRotatedHashRow{
+					{UserID: userID, FamilyID: familyID},
+					{UserID: userID, FamilyID: familyID},
+				}, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-refresh-token",
+				Message: "Invalid or expired refresh token",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			emailer:     nil,
+			hibp:        nil,
+			jwtTokenFn:  nil,
+		},
+		{
+			name:   "refresh token rotated past the reuse interval",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().RotateRefreshTokenAndGetUserRoles(
+					gomock.Any(),
+					cmpDBParams(sql.RotateRefreshTokenAndGetUserRolesParams{
+						OldRefreshTokenHash: hashedToken,
+						ReuseInterval:       10,
+						RefreshTokenHash:    "asdadasdasdasd",
+						SlidingExpiration:   true,
+						ExpiresAt: sql.TimestampTz(
+							time.Now().Add(time.Duration(2592000) * time.Second),
+						),
+					}),
+				).Return(nil, nil)
+
+				mock.EXPECT().DeleteRefreshTokenFamilyByRotatedHash(
+					gomock.Any(), hashedToken,
+				).Return([]sql.DeleteRefreshTokenFamilyByRotatedHashRow{
+					{UserID: userID, FamilyID: familyID},
+				}, nil)
+
 				return mock
 			},
 			customClaimer: nil,
@@ -275,8 +662,88 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 				mock.EXPECT().GetUserByRefreshTokenHash(
 					gomock.Any(),
 					sql.GetUserByRefreshTokenHashParams{
-						RefreshTokenHash: sql.Text(hashedToken),
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-user",
+				Message: "User is disabled",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			emailer:     nil,
+			hibp:        nil,
+			jwtTokenFn:  nil,
+		},
+
+		{
+			name: "refresh token of a user of another tenant",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.TenantID = "acme"
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.TenantID = sql.Text("globex")
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
+						Type:             "regular",
+						ReuseInterval:    10,
+					},
+				).Return(user, nil)
+
+				return mock
+			},
+			customClaimer: nil,
+			request: api.PostTokenRequestObject{
+				Body: &api.RefreshTokenRequest{
+					RefreshToken: token.String(),
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-refresh-token",
+				Message: "Invalid or expired refresh token",
+				Status:  401,
+			},
+			expectedJWT: nil,
+			emailer:     nil,
+			hibp:        nil,
+			jwtTokenFn:  nil,
+		},
+		{
+			name:   "user banned",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getSigninUser(userID)
+				user.BannedAt = sql.TimestampTz(time.Now().Add(-time.Hour))
+				user.BannedUntil = sql.TimestampTz(time.Now().Add(time.Hour))
+				user.BanReason = sql.Text("spam")
+
+				mock.EXPECT().GetUserByRefreshTokenHash(
+					gomock.Any(),
+					sql.GetUserByRefreshTokenHashParams{
+						RefreshTokenHash: hashedToken,
 						Type:             "regular",
+						ReuseInterval:    10,
 					},
 				).Return(user, nil)
 
@@ -307,9 +774,23 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: tc.customClaimer,
-				emailer:       nil,
-				hibp:          nil,
+				customClaimer:    tc.customClaimer,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			//nolint:exhaustruct
@@ -322,6 +803,10 @@ func TestPostToken(t *testing.T) { //nolint:maintidx
 			if ok {
 				session := api.Session(resp200)
 				assertSession(t, jwtGetter, &session, tc.expectedJWT)
+
+				if session.RefreshToken == tc.request.Body.RefreshToken {
+					t.Errorf("refresh token was not rotated")
+				}
 			}
 		})
 	}
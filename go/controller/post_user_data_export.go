@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostUserDataExport( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostUserDataExportRequestObject,
+) (api.PostUserDataExportResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	export, apiErr := ctrl.wf.RequestDataExport(ctx, user.ID, dataExportRequestedByUser, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	resp, apiErr := ctrl.wf.DataExportResponse(export, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostUserDataExport200JSONResponse(resp), nil
+}
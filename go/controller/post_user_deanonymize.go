@@ -41,13 +41,19 @@ func (ctrl *Controller) postUserDeanonymizeValidateRequest( //nolint:cyclop
 		return uuid.UUID{}, "", nil, ErrInvalidRequest
 	} else if request.Body.SignInMethod == api.EmailPassword {
 		password = *request.Body.Password
-		if apiErr := ctrl.wf.ValidatePassword(ctx, password, logger); apiErr != nil {
+		if apiErr := ctrl.wf.ValidatePassword(
+			ctx, password, string(request.Body.Email), logger,
+		); apiErr != nil {
 			return uuid.UUID{}, "", nil, apiErr
 		}
 	}
 
+	method := SignInMethodEmailPassword
+	if request.Body.SignInMethod == api.Passwordless {
+		method = SignInMethodPasswordless
+	}
 	options, apiErr := ctrl.wf.ValidateSignUpOptions(
-		request.Body.Options, string(request.Body.Email), logger,
+		request.Body.Options, string(request.Body.Email), method, logger,
 	)
 	if apiErr != nil {
 		return uuid.UUID{}, "", nil, apiErr
@@ -58,6 +64,16 @@ func (ctrl *Controller) postUserDeanonymizeValidateRequest( //nolint:cyclop
 		return uuid.UUID{}, "", nil, ErrInvalidEmailPassword
 	}
 
+	if apiErr := ctrl.wf.checkDisposableEmail(string(request.Body.Email), logger); apiErr != nil {
+		return uuid.UUID{}, "", nil, apiErr
+	}
+
+	if apiErr := ctrl.wf.ValidateEmailDeliverability(
+		ctx, string(request.Body.Email), logger,
+	); apiErr != nil {
+		return uuid.UUID{}, "", nil, apiErr
+	}
+
 	exists, apiErr := ctrl.wf.UserByEmailExists(ctx, string(request.Body.Email), logger)
 	if apiErr != nil {
 		return uuid.UUID{}, "", nil, apiErr
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"golang.org/x/oauth2"
+)
+
+func (ctrl *Controller) PostUserDeanonymizeProviderProvider( //nolint:ireturn
+	ctx context.Context,
+	request api.PostUserDeanonymizeProviderProviderRequestObject,
+) (api.PostUserDeanonymizeProviderProviderResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", request.Provider))
+
+	if _, ok := ctrl.oauthProviders[request.Provider]; !ok {
+		logger.Warn("provider is not enabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	jwtToken, ok := ctrl.wf.jwtGetter.FromContext(ctx)
+	if !ok {
+		logger.Error(
+			"jwt token not found in context, this should not be possilble due to middleware",
+		)
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if !ctrl.wf.jwtGetter.IsAnonymous(jwtToken) {
+		logger.Warn("user is not anonymous")
+		return ctrl.sendError(ErrUserNotAnonymous), nil
+	}
+
+	userID, err := ctrl.wf.jwtGetter.GetUserID(jwtToken)
+	if err != nil {
+		logger.Error("error getting user id from jwt token", logError(err))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+	logger = logger.With(slog.String("user_id", userID.String()))
+
+	if apiErr := ctrl.checkSignInMethod(
+		ctx, providerSignInMethod(request.Provider), logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	options, apiErr := ctrl.validateProviderSignUpOptions(deptr(request.Body), logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	state := uuid.New()
+	if apiErr := ctrl.wf.InsertProviderRequest(
+		ctx,
+		state,
+		providerRequest{
+			CodeVerifier:      oauth2.GenerateVerifier(),
+			Options:           options,
+			SAMLRequestID:     "",
+			LinkUserID:        nil,
+			DeanonymizeUserID: &userID,
+		},
+		logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostUserDeanonymizeProviderProvider200JSONResponse{
+		// the user needs to continue in the browser so the sign in is bound to it
+		Url: ctrl.providerContinueURL(request.Provider, state),
+	}, nil
+}
@@ -0,0 +1,212 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+//nolint:lll
+type testPostUserDeanonymizeProviderProviderRequest struct {
+	testRequest[api.PostUserDeanonymizeProviderProviderRequestObject, api.PostUserDeanonymizeProviderProviderResponseObject]
+	providers func(ctrl *gomock.Controller) map[string]providers.Provider
+}
+
+func TestPostUserDeanonymizeProviderProvider(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	anonymousJWT := func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"anonymous"},
+					"x-hasura-default-role":      "anonymous",
+					"x-hasura-user-id":           userID.String(),
+					"x-hasura-user-is-anonymous": "true",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": userID.String(),
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+
+	cases := []testPostUserDeanonymizeProviderProviderRequest{
+		{
+			testRequest: testRequest[api.PostUserDeanonymizeProviderProviderRequestObject, api.PostUserDeanonymizeProviderProviderResponseObject]{ //nolint:lll
+				name:   "success",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().InsertProviderRequest(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertProviderRequestParams{ //nolint:exhaustruct
+								Options: []byte(`{"codeVerifier":"xxx","options":{"displayName":"Jane","redirectTo":"http://localhost:3000"},"deanonymizeUserId":"db477732-48fa-4289-b694-2886a646b6eb"}`), //nolint:lll
+							},
+							cmpopts.IgnoreFields(sql.InsertProviderRequestParams{}, "ID"), //nolint:exhaustruct
+							testhelpers.FilterPathLast(
+								[]string{".Options"}, cmp.Comparer(cmpProviderRequest),
+							),
+						),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserDeanonymizeProviderProviderRequestObject{
+					Provider: "gitlab",
+					Body: &api.PostUserDeanonymizeProviderProviderJSONRequestBody{ //nolint:exhaustruct
+						DisplayName: ptr("Jane"),
+					},
+				},
+				expectedResponse: api.PostUserDeanonymizeProviderProvider200JSONResponse{
+					Url: "https://local.auth.nhost.run/signin/provider/gitlab?state=xxx",
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  anonymousJWT,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostUserDeanonymizeProviderProviderRequestObject, api.PostUserDeanonymizeProviderProviderResponseObject]{ //nolint:lll
+				name:   "user is not anonymous",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserDeanonymizeProviderProviderRequestObject{
+					Provider: "gitlab",
+					Body:     &api.PostUserDeanonymizeProviderProviderJSONRequestBody{}, //nolint:exhaustruct
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "user-not-anonymous",
+					Message: "Logged in user is not anonymous",
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  webauthnUserJWT(userID),
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostUserDeanonymizeProviderProviderRequestObject, api.PostUserDeanonymizeProviderProviderResponseObject]{ //nolint:lll
+				name:   "provider not enabled",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserDeanonymizeProviderProviderRequestObject{
+					Provider: "github",
+					Body:     &api.PostUserDeanonymizeProviderProviderJSONRequestBody{}, //nolint:exhaustruct
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-endpoint",
+					Message: "This endpoint is disabled",
+					Status:  409,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  anonymousJWT,
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostUserDeanonymizeProviderProviderRequestObject, api.PostUserDeanonymizeProviderProviderResponseObject]{ //nolint:lll
+				name:   "redirectTo not allowed",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserDeanonymizeProviderProviderRequestObject{
+					Provider: "gitlab",
+					Body: &api.PostUserDeanonymizeProviderProviderJSONRequestBody{ //nolint:exhaustruct
+						RedirectTo: ptr("https://evil.com"),
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "redirectTo-not-allowed",
+					Message: `The value of "options.redirectTo" is not allowed.`,
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  anonymousJWT,
+			},
+			providers: gitlabProvider(nil),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        tc.providers,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostUserDeanonymizeProviderProvider, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast([]string{".Url"}, cmpRandomState),
+			)
+		})
+	}
+}
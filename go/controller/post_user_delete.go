@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostUserDelete( //nolint:ireturn
+	ctx context.Context,
+	request api.PostUserDeleteRequestObject,
+) (api.PostUserDeleteResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.AccountDeletionEnabled {
+		logger.Warn("account deletion is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	options, apiErr := ctrl.wf.ValidateOptionsRedirectTo(request.Body.Options, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	scheduledAt, apiErr := ctrl.wf.ScheduleAccountDeletion(
+		ctx, user, deptr(options.RedirectTo), logger,
+	)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostUserDelete200JSONResponse{
+		DeletionScheduledAt: scheduledAt,
+	}, nil
+}
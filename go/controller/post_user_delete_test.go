@@ -0,0 +1,202 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostUserDelete(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	scheduledAt := time.Now().Add(30 * 24 * time.Hour)
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.AccountDeletionEnabled = true
+		config.AccountDeletionGracePeriod = 30
+		return config
+	}
+
+	scheduleUserDeletion := func(mock *mock.MockDBClient, user sql.AuthUser) {
+		mock.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil)
+
+		user.DeletionScheduledAt = sql.TimestampTz(scheduledAt)
+		mock.EXPECT().ScheduleUserDeletion(
+			gomock.Any(),
+			cmpDBParams(
+				sql.ScheduleUserDeletionParams{
+					ID:                  userID,
+					Ticket:              "accountDeletionCancel:xxx",
+					DeletionScheduledAt: sql.TimestampTz(scheduledAt),
+				},
+				testhelpers.FilterPathLast([]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+				testhelpers.FilterPathLast(
+					[]string{".DeletionScheduledAt", "time()"},
+					cmpopts.EquateApproxTime(time.Minute),
+				),
+			),
+		).Return(user, nil)
+	}
+
+	cases := []testRequest[api.PostUserDeleteRequestObject, api.PostUserDeleteResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				scheduleUserDeletion(mock, getSigninUser(userID))
+
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(1), nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNameAccountDeletion,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=accountDeletionCancel%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=accountDeletionCancel", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "accountDeletionCancel:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostUserDeleteRequestObject{
+				Body: &api.UserDeleteRequest{
+					Options: nil,
+				},
+			},
+			expectedResponse: api.PostUserDelete200JSONResponse{
+				DeletionScheduledAt: scheduledAt,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name:   "redirectTo not allowed",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostUserDeleteRequestObject{
+				Body: &api.UserDeleteRequest{
+					Options: &api.OptionsRedirectTo{
+						RedirectTo: ptr("https://evil.com"),
+					},
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "redirectTo-not-allowed",
+				Message: `The value of "options.redirectTo" is not allowed.`,
+				Status:  400,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+
+		{
+			name: "disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.AccountDeletionEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			request: api.PostUserDeleteRequestObject{
+				Body: &api.UserDeleteRequest{
+					Options: nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+			jwtTokenFn:  webauthnUserJWT(userID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostUserDelete,
+				tc.request, tc.expectedResponse,
+				cmpopts.EquateApproxTime(time.Minute),
+			)
+		})
+	}
+}
@@ -24,6 +24,12 @@ func (ctrl *Controller) PostUserEmailChange( //nolint:ireturn
 		return ctrl.respondWithError(apiErr), nil
 	}
 
+	if apiErr := ctrl.wf.ValidateEmailDeliverability(
+		ctx, string(request.Body.NewEmail), logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
 	exists, apiErr := ctrl.wf.UserByEmailExists(ctx, string(request.Body.NewEmail), logger)
 	if apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
@@ -54,5 +60,17 @@ func (ctrl *Controller) PostUserEmailChange( //nolint:ireturn
 		return ctrl.sendError(err), nil
 	}
 
+	if updatedUser.Email.Valid {
+		if apiErr := ctrl.wf.NotifyEmailChange(
+			ctx,
+			updatedUser,
+			string(request.Body.NewEmail),
+			deptr(request.Body.Options.RedirectTo),
+			logger,
+		); apiErr != nil {
+			return ctrl.respondWithError(apiErr), nil
+		}
+	}
+
 	return api.PostUserEmailChange200JSONResponse(api.OK), nil
 }
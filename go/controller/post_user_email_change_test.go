@@ -83,6 +83,20 @@ func TestPostUserEmailChange(t *testing.T) { //nolint:maintidx
 					Ticket:      sql.Text("emailConfirmChange:xxxxx"),
 				}, nil)
 
+				mock.EXPECT().InsertEmailChangeRevert(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertEmailChangeRevertParams{
+							UserID:    uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+							Ticket:    "emailChangeRevert:xxxxx",
+							OldEmail:  "oldEmail@acme.com",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(7 * 24 * time.Hour)),
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+					),
+				).Return(nil)
+
 				return mock
 			},
 			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
@@ -95,15 +109,49 @@ func TestPostUserEmailChange(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailConfirmChange,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=emailConfirmChange%3A9bd37c9c-8f5b-4c19-af01-a729922c1952&type=emailConfirmChange", //nolint:lll
-							DisplayName: "Jane Doe",
-							Email:       "oldEmail@acme.com",
-							NewEmail:    "newEmail@acme.com",
-							Ticket:      "emailConfirmChange:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=emailConfirmChange%3A9bd37c9c-8f5b-4c19-af01-a729922c1952&type=emailConfirmChange", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "oldEmail@acme.com",
+							NewEmail:         "newEmail@acme.com",
+							Ticket:           "emailConfirmChange:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"oldEmail@acme.com",
+					"en",
+					notifications.TemplateNameEmailChangeNotify,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=emailChangeRevert%3A4c84b833-d330-49a6-b509-6c090959e249&type=emailChangeRevert", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "oldEmail@acme.com",
+							NewEmail:         "newEmail@acme.com",
+							Ticket:           "emailChangeRevert:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -244,6 +292,20 @@ func TestPostUserEmailChange(t *testing.T) { //nolint:maintidx
 					Ticket:      sql.Text("emailConfirmChange:xxxxx"),
 				}, nil)
 
+				mock.EXPECT().InsertEmailChangeRevert(
+					gomock.Any(),
+					cmpDBParams(
+						sql.InsertEmailChangeRevertParams{
+							UserID:    uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+							Ticket:    "emailChangeRevert:xxxxx",
+							OldEmail:  "oldEmail@acme.com",
+							ExpiresAt: sql.TimestampTz(time.Now().Add(7 * 24 * time.Hour)),
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+					),
+				).Return(nil)
+
 				return mock
 			},
 			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
@@ -256,15 +318,49 @@ func TestPostUserEmailChange(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailConfirmChange,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp%2Fredirect&ticket=emailConfirmChange%3A4c84b833-d330-49a6-b509-6c090959e249&type=emailConfirmChange", //nolint:lll
-							DisplayName: "Jane Doe",
-							Email:       "oldEmail@acme.com",
-							NewEmail:    "newEmail@acme.com",
-							Ticket:      "emailConfirmChange:xxx",
-							RedirectTo:  "https://myapp/redirect",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp%2Fredirect&ticket=emailConfirmChange%3A4c84b833-d330-49a6-b509-6c090959e249&type=emailConfirmChange", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "oldEmail@acme.com",
+							NewEmail:         "newEmail@acme.com",
+							Ticket:           "emailConfirmChange:xxx",
+							RedirectTo:       "https://myapp/redirect",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"oldEmail@acme.com",
+					"en",
+					notifications.TemplateNameEmailChangeNotify,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp%2Fredirect&ticket=emailChangeRevert%3A4c84b833-d330-49a6-b509-6c090959e249&type=emailChangeRevert", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "oldEmail@acme.com",
+							NewEmail:         "newEmail@acme.com",
+							Ticket:           "emailChangeRevert:xxx",
+							RedirectTo:       "https://myapp/redirect",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -328,9 +424,23 @@ func TestPostUserEmailChange(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: nil,
-				emailer:       tc.emailer,
-				hibp:          nil,
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
@@ -3,12 +3,16 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/nhost/hasura-auth/go/api"
 	"github.com/nhost/hasura-auth/go/middleware"
 	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
 )
 
 func (ctrl *Controller) PostUserEmailSendVerificationEmail( //nolint:ireturn
@@ -34,6 +38,11 @@ func (ctrl *Controller) PostUserEmailSendVerificationEmail( //nolint:ireturn
 		return ctrl.respondWithError(ErrEmailAlreadyVerified), nil
 	}
 
+	if wait := ctrl.verificationEmailCooldown(user); wait > 0 {
+		logger.Warn("verification email sent recently", slog.Duration("retry_after", wait))
+		return ctrl.verificationEmailCooldownResponse(wait), nil
+	}
+
 	ticket := generateTicket(TicketTypeVerifyEmail)
 	expireAt := time.Now().Add(In30Days)
 	if apiErr = ctrl.wf.SetTicket(ctx, user.ID, ticket, expireAt, logger); apiErr != nil {
@@ -58,3 +67,33 @@ func (ctrl *Controller) PostUserEmailSendVerificationEmail( //nolint:ireturn
 
 	return api.PostUserEmailSendVerificationEmail200JSONResponse(api.OK), nil
 }
+
+// verificationEmailCooldown returns how long the user has to wait before another
+// verification email is sent to them. Verification tickets expire In30Days after they are
+// sent, which tells when the last one was.
+func (ctrl *Controller) verificationEmailCooldown(user sql.AuthUser) time.Duration {
+	if ctrl.config.EmailVerificationCooldown <= 0 ||
+		!user.TicketExpiresAt.Valid ||
+		!strings.HasPrefix(user.Ticket.String, string(TicketTypeVerifyEmail)+":") {
+		return 0
+	}
+
+	sentAt := user.TicketExpiresAt.Time.Add(-In30Days)
+	return time.Until(sentAt.Add(ctrl.config.EmailVerificationCooldown))
+}
+
+func (ctrl *Controller) verificationEmailCooldownResponse(
+	wait time.Duration,
+) api.PostUserEmailSendVerificationEmail429JSONResponse {
+	seconds := int(math.Ceil(wait.Seconds()))
+
+	return api.PostUserEmailSendVerificationEmail429JSONResponse{
+		Body: api.ErrorResponse(ctrl.sendError(&APIError{
+			t:       api.TooManyRequests,
+			message: fmt.Sprintf("A verification email was sent recently, try again in %d seconds", seconds),
+		})),
+		Headers: api.PostUserEmailSendVerificationEmail429ResponseHeaders{
+			RetryAfter: seconds,
+		},
+	}
+}
@@ -68,15 +68,20 @@ func TestPostUserEmailSendVerificationEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailVerify,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3A55fa0d55-631c-490a-a744-b5feca4c22a1&type=emailVerify", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "verifyEmail:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3A55fa0d55-631c-490a-a744-b5feca4c22a1&type=emailVerify", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "verifyEmail:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -142,15 +147,20 @@ func TestPostUserEmailSendVerificationEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailVerify,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3A55fa0d55-631c-490a-a744-b5feca4c22a1&type=emailVerify", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "verifyEmail:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=verifyEmail%3A55fa0d55-631c-490a-a744-b5feca4c22a1&type=emailVerify", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "verifyEmail:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -216,15 +226,20 @@ func TestPostUserEmailSendVerificationEmail(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNameEmailVerify,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp.com%2Fverify&ticket=verifyEmail%3Ad108332c-1f95-43b3-ade2-6206316c8985&type=emailVerify", //nolint:lll
-							DisplayName: "jane@acme.com",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "verifyEmail:xxx",
-							RedirectTo:  "https://myapp.com/verify",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp.com%2Fverify&ticket=verifyEmail%3Ad108332c-1f95-43b3-ade2-6206316c8985&type=emailVerify", //nolint:lll
+							DisplayName:      "jane@acme.com",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "verifyEmail:xxx",
+							RedirectTo:       "https://myapp.com/verify",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -402,6 +417,118 @@ func TestPostUserEmailSendVerificationEmail(t *testing.T) { //nolint:maintidx
 			hibp:          nil,
 		},
 
+		{
+			name: "verification email sent recently",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.EmailVerificationCooldown = time.Minute
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:              userID,
+					DisplayName:     "jane@acme.com",
+					EmailVerified:   false,
+					Email:           sql.Text("jane@acme.com"),
+					Locale:          "en",
+					Ticket:          sql.Text("verifyEmail:55fa0d55-631c-490a-a744-b5feca4c22a1"),
+					TicketExpiresAt: sql.TimestampTz(time.Now().Add(720*time.Hour - 10*time.Second)),
+				}, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				return mock
+			},
+			request: api.PostUserEmailSendVerificationEmailRequestObject{
+				Body: &api.PostUserEmailSendVerificationEmailJSONRequestBody{
+					Email:   "jane@acme.com",
+					Options: nil,
+				},
+			},
+			expectedResponse: api.PostUserEmailSendVerificationEmail429JSONResponse{
+				Body: api.ErrorResponse{
+					Error:   "too-many-requests",
+					Message: "A verification email was sent recently, try again in 50 seconds",
+					Status:  429,
+				},
+				Headers: api.PostUserEmailSendVerificationEmail429ResponseHeaders{
+					RetryAfter: 50,
+				},
+			},
+			jwtTokenFn:    nil,
+			customClaimer: nil,
+			expectedJWT:   nil,
+			hibp:          nil,
+		},
+
+		{
+			name: "verification email sent before the cooldown",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.EmailVerificationCooldown = time.Minute
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:              userID,
+					DisplayName:     "jane@acme.com",
+					EmailVerified:   false,
+					Email:           sql.Text("jane@acme.com"),
+					Locale:          "en",
+					Ticket:          sql.Text("verifyEmail:55fa0d55-631c-490a-a744-b5feca4c22a1"),
+					TicketExpiresAt: sql.TimestampTz(time.Now().Add(720*time.Hour - 2*time.Minute)),
+				}, nil)
+
+				mock.EXPECT().UpdateUserTicket(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserTicketParams{
+						ID:              userID,
+						Ticket:          sql.Text("verifyEmail:xxx"),
+						TicketExpiresAt: sql.TimestampTz(time.Now().Add(720 * time.Hour)),
+					}),
+				).Return(userID, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNameEmailVerify,
+					gomock.Any(),
+				).Return(nil)
+
+				return mock
+			},
+			request: api.PostUserEmailSendVerificationEmailRequestObject{
+				Body: &api.PostUserEmailSendVerificationEmailJSONRequestBody{
+					Email:   "jane@acme.com",
+					Options: nil,
+				},
+			},
+			expectedResponse: api.PostUserEmailSendVerificationEmail200JSONResponse(api.OK),
+			jwtTokenFn:       nil,
+			customClaimer:    nil,
+			expectedJWT:      nil,
+			hibp:             nil,
+		},
+
 		{
 			name:   "random error",
 			config: getConfig,
@@ -445,9 +572,23 @@ func TestPostUserEmailSendVerificationEmail(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: nil,
-				emailer:       tc.emailer,
-				hibp:          nil,
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			assertRequest(
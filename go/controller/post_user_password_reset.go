@@ -33,7 +33,12 @@ func (ctrl *Controller) PostUserPasswordReset( //nolint:ireturn
 		return ctrl.respondWithError(apiErr), nil
 	}
 
-	ticket := generateTicket(TicketTypePasswordReset)
+	ticketType := TicketTypePasswordReset
+	if ctrl.config.RevokeSessionsOnReset || deptr(request.Body.RevokeSessions) {
+		ticketType = TicketTypePasswordResetRevokeSessions
+	}
+
+	ticket := generateTicket(ticketType)
 	expiresAt := time.Now().Add(time.Hour)
 	if apiErr := ctrl.wf.SetTicket(ctx, user.ID, ticket, expiresAt, logger); apiErr != nil {
 		return ctrl.respondWithError(apiErr), nil
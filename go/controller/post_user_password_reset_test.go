@@ -63,15 +63,20 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNamePasswordReset,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordReset%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=passwordReset", //nolint:lll
-							DisplayName: "Jane Doe",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "passwordReset:xxx",
-							RedirectTo:  "http://localhost:3000",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordReset%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=passwordReset", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordReset:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -84,8 +89,172 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostUserPasswordResetRequestObject{
 				Body: &api.PostUserPasswordResetJSONRequestBody{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
+				},
+			},
+			expectedResponse: api.PostUserPasswordReset200JSONResponse(api.OK),
+			customClaimer:    nil,
+			expectedJWT:      nil,
+			hibp:             nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name:   "revoke sessions",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:            userID,
+					Disabled:      false,
+					DisplayName:   "Jane Doe",
+					Locale:        "en",
+					Email:         sql.Text("jane@acme.com"),
+					EmailVerified: false,
+					IsAnonymous:   false,
+				}, nil)
+
+				mock.EXPECT().UpdateUserTicket(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserTicketParams{
+						ID:              userID,
+						Ticket:          sql.Text("passwordResetRevokeSessions:xxx"),
+						TicketExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+					}),
+				).Return(userID, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNamePasswordReset,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordResetRevokeSessions%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=passwordReset", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordResetRevokeSessions:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			request: api.PostUserPasswordResetRequestObject{
+				Body: &api.PostUserPasswordResetJSONRequestBody{
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: ptr(true),
+					CaptchaToken:   nil,
+				},
+			},
+			expectedResponse: api.PostUserPasswordReset200JSONResponse(api.OK),
+			customClaimer:    nil,
+			expectedJWT:      nil,
+			hibp:             nil,
+			jwtTokenFn:       nil,
+		},
+
+		{
+			name: "revoke sessions enabled in config",
+			config: func() *controller.Config {
+				cfg := getConfig()
+				cfg.RevokeSessionsOnReset = true
+				return cfg
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUserByEmail(
+					gomock.Any(),
+					sql.Text("jane@acme.com"),
+				).Return(sql.AuthUser{ //nolint:exhaustruct
+					ID:            userID,
+					Disabled:      false,
+					DisplayName:   "Jane Doe",
+					Locale:        "en",
+					Email:         sql.Text("jane@acme.com"),
+					EmailVerified: false,
+					IsAnonymous:   false,
+				}, nil)
+
+				mock.EXPECT().UpdateUserTicket(
+					gomock.Any(),
+					cmpDBParams(sql.UpdateUserTicketParams{
+						ID:              userID,
+						Ticket:          sql.Text("passwordResetRevokeSessions:xxx"),
+						TicketExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+					}),
+				).Return(userID, nil)
+
+				return mock
+			},
+			emailer: func(ctrl *gomock.Controller) *mock.MockEmailer {
+				mock := mock.NewMockEmailer(ctrl)
+
+				mock.EXPECT().SendEmail(
+					gomock.Any(),
+					"jane@acme.com",
+					"en",
+					notifications.TemplateNamePasswordReset,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=http%3A%2F%2Flocalhost%3A3000&ticket=passwordResetRevokeSessions%3Ab66123b7-ea8b-4afe-a875-f201a2f8b224&type=passwordReset", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordResetRevokeSessions:xxx",
+							RedirectTo:       "http://localhost:3000",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
+
+						testhelpers.FilterPathLast(
+							[]string{".Link"}, cmp.Comparer(cmpLink)),
+					)).Return(nil)
+
+				return mock
+			},
+			request: api.PostUserPasswordResetRequestObject{
+				Body: &api.PostUserPasswordResetJSONRequestBody{
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: api.PostUserPasswordReset200JSONResponse(api.OK),
@@ -139,15 +308,20 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 					notifications.TemplateNamePasswordReset,
 					testhelpers.GomockCmpOpts(
 						notifications.TemplateData{
-							Link:        "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp.com&ticket=passwordReset%3Adadf0554-f118-4446-bfb1-2487b05cf251&type=passwordReset", //nolint:lll
-							DisplayName: "Jane Doe",
-							Email:       "jane@acme.com",
-							NewEmail:    "",
-							Ticket:      "passwordReset:xxx",
-							RedirectTo:  "https://myapp.com",
-							Locale:      "en",
-							ServerURL:   "https://local.auth.nhost.run",
-							ClientURL:   "http://localhost:3000",
+							Link:             "https://local.auth.nhost.run/verify?redirectTo=https%3A%2F%2Fmyapp.com&ticket=passwordReset%3Adadf0554-f118-4446-bfb1-2487b05cf251&type=passwordReset", //nolint:lll
+							DisplayName:      "Jane Doe",
+							Email:            "jane@acme.com",
+							NewEmail:         "",
+							Ticket:           "passwordReset:xxx",
+							RedirectTo:       "https://myapp.com",
+							Locale:           "en",
+							ServerURL:        "https://local.auth.nhost.run",
+							ClientURL:        "http://localhost:3000",
+							Code:             "",
+							IPAddress:        "",
+							UserAgent:        "",
+							OrganizationName: "",
+							Location:         "",
 						},
 						testhelpers.FilterPathLast(
 							[]string{".Ticket"}, cmp.Comparer(cmpTicket)),
@@ -164,6 +338,8 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 					Options: &api.OptionsRedirectTo{
 						RedirectTo: ptr("https://myapp.com"),
 					},
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: api.PostUserPasswordReset200JSONResponse(api.OK),
@@ -190,6 +366,8 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 					Options: &api.OptionsRedirectTo{
 						RedirectTo: ptr("https://myapp.com"),
 					},
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -220,8 +398,10 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostUserPasswordResetRequestObject{
 				Body: &api.PostUserPasswordResetJSONRequestBody{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -254,8 +434,10 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostUserPasswordResetRequestObject{
 				Body: &api.PostUserPasswordResetJSONRequestBody{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -296,8 +478,10 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostUserPasswordResetRequestObject{
 				Body: &api.PostUserPasswordResetJSONRequestBody{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -342,8 +526,10 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 			},
 			request: api.PostUserPasswordResetRequestObject{
 				Body: &api.PostUserPasswordResetJSONRequestBody{
-					Email:   "jane@acme.com",
-					Options: nil,
+					Email:          "jane@acme.com",
+					Options:        nil,
+					RevokeSessions: nil,
+					CaptchaToken:   nil,
 				},
 			},
 			expectedResponse: controller.ErrorResponse{
@@ -365,9 +551,23 @@ func TestPostUserPasswordReset(t *testing.T) { //nolint:maintidx
 			ctrl := gomock.NewController(t)
 
 			c, _ := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
-				customClaimer: nil,
-				emailer:       tc.emailer,
-				hibp:          nil,
+				customClaimer:    nil,
+				emailer:          tc.emailer,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
 			})
 
 			assertRequest(
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+func (ctrl *Controller) PostUserPhoneNumberChange( //nolint:ireturn
+	ctx context.Context, request api.PostUserPhoneNumberChangeRequestObject,
+) (api.PostUserPhoneNumberChangeResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("newPhoneNumber", request.Body.NewPhoneNumber))
+
+	if !ctrl.config.SMSPasswordlessEnabled {
+		logger.Warn("sms is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	if apiErr := ctrl.wf.ValidatePhoneNumber(request.Body.NewPhoneNumber, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	exists, apiErr := ctrl.wf.UserByPhoneNumberExists(ctx, request.Body.NewPhoneNumber, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+	if exists {
+		logger.Warn("phone number already in use")
+		return ctrl.sendError(ErrPhoneNumberAlreadyInUse), nil
+	}
+
+	otp, otpHash, err := generateOTP()
+	if err != nil {
+		logger.Error("error generating otp", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	if apiErr := ctrl.wf.ChangePhoneNumber(
+		ctx, user.ID, request.Body.NewPhoneNumber, otpHash, time.Now().Add(In5Minutes), logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if slices.Contains(ctrl.config.SMSTestPhoneNumbers, request.Body.NewPhoneNumber) {
+		logger.Info("test phone number, sms not sent", slog.String("code", otp))
+		return api.PostUserPhoneNumberChange200JSONResponse(api.OK), nil
+	}
+
+	if err := ctrl.wf.sms.SendSMS(
+		ctx,
+		request.Body.NewPhoneNumber,
+		user.Locale,
+		notifications.TemplateNamePhoneChangeSMS,
+		notifications.TemplateData{ //nolint:exhaustruct
+			DisplayName: user.DisplayName,
+			Locale:      user.Locale,
+			ServerURL:   ctrl.config.ServerURL.String(),
+			ClientURL:   ctrl.config.ClientURL.String(),
+			Code:        otp,
+		},
+	); err != nil {
+		logger.Error("error sending sms", logError(err))
+		return ctrl.sendError(ErrCannotSendSMS), nil
+	}
+
+	return api.PostUserPhoneNumberChange200JSONResponse(api.OK), nil
+}
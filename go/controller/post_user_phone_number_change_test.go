@@ -0,0 +1,295 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func phoneNumberChangeJWTToken() *jwt.Token {
+	return &jwt.Token{
+		Raw:    "",
+		Method: jwt.SigningMethodHS256,
+		Header: map[string]any{
+			"alg": "HS256",
+			"typ": "JWT",
+		},
+		Claims: jwt.MapClaims{
+			"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+			"https://hasura.io/jwt/claims": map[string]any{
+				"x-hasura-allowed-roles":     []any{"user", "me"},
+				"x-hasura-default-role":      "user",
+				"x-hasura-user-id":           "db477732-48fa-4289-b694-2886a646b6eb",
+				"x-hasura-user-is-anonymous": "false",
+			},
+			"iat": float64(time.Now().Unix()),
+			"iss": "hasura-auth",
+			"sub": "db477732-48fa-4289-b694-2886a646b6eb",
+		},
+		Signature: []byte{},
+		Valid:     true,
+	}
+}
+
+func getPhoneNumberChangeUser(userID uuid.UUID) sql.AuthUser {
+	user := getSigninUser(userID)
+	user.PhoneNumber = sql.Text("+123456789")
+	user.PhoneNumberVerified = true
+	return user
+}
+
+func TestPostUserPhoneNumberChange(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.SMSPasswordlessEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	updateUserChangePhoneNumber := func(mock *mock.MockDBClient, newPhoneNumber string) {
+		mock.EXPECT().UpdateUserChangePhoneNumber(
+			gomock.Any(),
+			cmpDBParams(
+				sql.UpdateUserChangePhoneNumberParams{
+					ID:                userID,
+					OtpHash:           sql.Text("hash"),
+					OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(5 * time.Minute)),
+					OtpMethodLastUsed: sql.Text("smsPhoneChange"),
+					NewPhoneNumber:    sql.Text(newPhoneNumber),
+				},
+				testhelpers.FilterPathLast(
+					[]string{".OtpHash", "text()"}, cmp.Comparer(cmpNotEmpty),
+				),
+				testhelpers.FilterPathLast(
+					[]string{".OtpHashExpiresAt", "time()"}, cmpopts.EquateApproxTime(time.Minute),
+				),
+			),
+		).Return(nil)
+	}
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		sms              func(ctrl *gomock.Controller) *mock.MockSMSSender
+		request          api.PostUserPhoneNumberChangeRequestObject
+		expectedResponse api.PostUserPhoneNumberChangeResponseObject
+	}{
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getPhoneNumberChangeUser(userID), nil)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+987654321"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				updateUserChangePhoneNumber(mock, "+987654321")
+
+				return mock
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				mock := mock.NewMockSMSSender(ctrl)
+				mock.EXPECT().SendSMS(
+					gomock.Any(),
+					"+987654321",
+					"en",
+					notifications.TemplateNamePhoneChangeSMS,
+					testhelpers.GomockCmpOpts(
+						notifications.TemplateData{ //nolint:exhaustruct
+							DisplayName: "Jane Doe",
+							Locale:      "en",
+							ServerURL:   "https://local.auth.nhost.run",
+							ClientURL:   "http://localhost:3000",
+							Code:        "123456",
+						},
+						testhelpers.FilterPathLast(
+							[]string{".Code"}, cmp.Comparer(cmpNotEmpty),
+						),
+					),
+				).Return(nil)
+				return mock
+			},
+			request: api.PostUserPhoneNumberChangeRequestObject{
+				Body: &api.UserPhoneNumberChangeRequest{
+					NewPhoneNumber: "+987654321",
+				},
+			},
+			expectedResponse: api.PostUserPhoneNumberChange200JSONResponse(api.OK),
+		},
+
+		{
+			name: "test phone number",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.SMSTestPhoneNumbers = []string{"+987654321"}
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getPhoneNumberChangeUser(userID), nil)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+987654321"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				updateUserChangePhoneNumber(mock, "+987654321")
+
+				return mock
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				return mock.NewMockSMSSender(ctrl)
+			},
+			request: api.PostUserPhoneNumberChangeRequestObject{
+				Body: &api.UserPhoneNumberChangeRequest{
+					NewPhoneNumber: "+987654321",
+				},
+			},
+			expectedResponse: api.PostUserPhoneNumberChange200JSONResponse(api.OK),
+		},
+
+		{
+			name:   "phone number already in use",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getPhoneNumberChangeUser(userID), nil)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+987654321"),
+				).Return(getSigninSMSUser(uuid.New()), nil)
+
+				return mock
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				return mock.NewMockSMSSender(ctrl)
+			},
+			request: api.PostUserPhoneNumberChangeRequestObject{
+				Body: &api.UserPhoneNumberChangeRequest{
+					NewPhoneNumber: "+987654321",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "phone-number-already-in-use",
+				Message: "Phone number already in use",
+				Status:  409,
+			},
+		},
+
+		{
+			name:   "phone number not in E.164 format",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				return mock.NewMockSMSSender(ctrl)
+			},
+			request: api.PostUserPhoneNumberChangeRequestObject{
+				Body: &api.UserPhoneNumberChangeRequest{
+					NewPhoneNumber: "+0987654321",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+		},
+
+		{
+			name: "sms disabled",
+			config: func() *controller.Config {
+				config := getConfig()
+				config.SMSPasswordlessEnabled = false
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				return mock.NewMockDBClient(ctrl)
+			},
+			sms: func(ctrl *gomock.Controller) *mock.MockSMSSender {
+				return mock.NewMockSMSSender(ctrl)
+			},
+			request: api.PostUserPhoneNumberChangeRequestObject{
+				Body: &api.UserPhoneNumberChangeRequest{
+					NewPhoneNumber: "+987654321",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              tc.sms,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), phoneNumberChangeJWTToken())
+			assertRequest(
+				ctx,
+				t,
+				c.PostUserPhoneNumberChange,
+				tc.request,
+				tc.expectedResponse,
+			)
+		})
+	}
+}
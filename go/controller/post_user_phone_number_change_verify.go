@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostUserPhoneNumberChangeVerify( //nolint:ireturn
+	ctx context.Context, request api.PostUserPhoneNumberChangeVerifyRequestObject,
+) (api.PostUserPhoneNumberChangeVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.SMSPasswordlessEnabled {
+		logger.Warn("sms is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if user.OtpMethodLastUsed.String != OTPMethodSMSPhoneChange ||
+		!user.OtpHash.Valid ||
+		!user.NewPhoneNumber.Valid ||
+		user.OtpHashExpiresAt.Time.Before(time.Now()) {
+		logger.Warn("user doesn't have a valid phone number change otp")
+		return ctrl.sendError(ErrInvalidOTP), nil
+	}
+
+	if !verifyHashPassword(request.Body.Otp, user.OtpHash.String) {
+		logger.Warn("otp doesn't match")
+		if apiErr := ctrl.wf.RecordOTPFailure(
+			ctx, user.ID, user.OtpHash.String, logger,
+		); apiErr != nil {
+			return ctrl.sendError(apiErr), nil
+		}
+		return ctrl.sendError(ErrInvalidOTP), nil
+	}
+
+	exists, apiErr := ctrl.wf.UserByPhoneNumberExists(ctx, user.NewPhoneNumber.String, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+	if exists {
+		logger.Warn("new phone number already in use")
+		return ctrl.sendError(ErrPhoneNumberAlreadyInUse), nil
+	}
+
+	if _, apiErr := ctrl.wf.ConfirmChangePhoneNumber(
+		ctx, user.ID, user.OtpHash.String, logger,
+	); apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	logger.Info("phone number changed")
+
+	return api.PostUserPhoneNumberChangeVerify200JSONResponse(api.OK), nil
+}
@@ -0,0 +1,265 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostUserPhoneNumberChangeVerify(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.SMSPasswordlessEnabled = true
+		return config
+	}
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	// bcrypt hash of 123456
+	otpHash := "$2a$10$UrUnljBQYOsziBqvUWB1JeyeIfT7vdTyB7qpKNWaePwC6RBRBLXyu"
+
+	getUser := func() sql.AuthUser {
+		user := getPhoneNumberChangeUser(userID)
+		user.NewPhoneNumber = sql.Text("+987654321")
+		user.OtpMethodLastUsed = sql.Text("smsPhoneChange")
+		user.OtpHash = sql.Text(otpHash)
+		user.OtpHashExpiresAt = sql.TimestampTz(time.Now().Add(time.Minute))
+		return user
+	}
+
+	cases := []testRequest[api.PostUserPhoneNumberChangeVerifyRequestObject, api.PostUserPhoneNumberChangeVerifyResponseObject]{ //nolint:lll
+		{
+			name:   "simple",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getUser(), nil)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+987654321"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				user := getPhoneNumberChangeUser(userID)
+				user.PhoneNumber = sql.Text("+987654321")
+				mock.EXPECT().UpdateUserConfirmChangePhoneNumber(
+					gomock.Any(),
+					sql.UpdateUserConfirmChangePhoneNumberParams{
+						ID:      userID,
+						OtpHash: sql.Text(otpHash),
+					},
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostUserPhoneNumberChangeVerifyRequestObject{
+				Body: &api.UserPhoneNumberChangeVerifyRequest{
+					Otp: "123456",
+				},
+			},
+			expectedResponse: api.PostUserPhoneNumberChangeVerify200JSONResponse(api.OK),
+			emailer:          nil,
+			hibp:             nil,
+			customClaimer:    nil,
+			jwtTokenFn:       phoneNumberChangeJWTToken,
+			expectedJWT:      nil,
+		},
+
+		{
+			name:   "wrong otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getUser(), nil)
+
+				mock.EXPECT().UpdateUserOTPHashFailedAttempts(
+					gomock.Any(),
+					sql.UpdateUserOTPHashFailedAttemptsParams{
+						MaxAttempts: 5,
+						ID:          userID,
+						OtpHash:     sql.Text(otpHash),
+					},
+				).Return(int32(1), nil)
+
+				return mock
+			},
+			request: api.PostUserPhoneNumberChangeVerifyRequestObject{
+				Body: &api.UserPhoneNumberChangeVerifyRequest{
+					Otp: "654321",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    phoneNumberChangeJWTToken,
+			expectedJWT:   nil,
+		},
+
+		{
+			name:   "sign in otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.OtpMethodLastUsed = sql.Text("sms")
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostUserPhoneNumberChangeVerifyRequestObject{
+				Body: &api.UserPhoneNumberChangeVerifyRequest{
+					Otp: "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    phoneNumberChangeJWTToken,
+			expectedJWT:   nil,
+		},
+
+		{
+			name:   "expired otp",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				user := getUser()
+				user.OtpHashExpiresAt = sql.TimestampTz(time.Now().Add(-time.Minute))
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(user, nil)
+
+				return mock
+			},
+			request: api.PostUserPhoneNumberChangeVerifyRequestObject{
+				Body: &api.UserPhoneNumberChangeVerifyRequest{
+					Otp: "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-otp",
+				Message: "Invalid or expired OTP",
+				Status:  401,
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    phoneNumberChangeJWTToken,
+			expectedJWT:   nil,
+		},
+
+		{
+			name:   "phone number taken in the meantime",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(),
+					userID,
+				).Return(getUser(), nil)
+
+				mock.EXPECT().GetUserByPhoneNumber(
+					gomock.Any(),
+					sql.Text("+987654321"),
+				).Return(sql.AuthUser{}, pgx.ErrNoRows) //nolint:exhaustruct
+
+				mock.EXPECT().UpdateUserConfirmChangePhoneNumber(
+					gomock.Any(),
+					sql.UpdateUserConfirmChangePhoneNumberParams{
+						ID:      userID,
+						OtpHash: sql.Text(otpHash),
+					},
+				).Return(
+					sql.AuthUser{}, //nolint:exhaustruct
+					errors.New(`ERROR: duplicate key value violates unique constraint "users_phone_number_key" (SQLSTATE 23505)`), //nolint:goerr113,lll
+				)
+
+				return mock
+			},
+			request: api.PostUserPhoneNumberChangeVerifyRequestObject{
+				Body: &api.UserPhoneNumberChangeVerifyRequest{
+					Otp: "123456",
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "phone-number-already-in-use",
+				Message: "Phone number already in use",
+				Status:  409,
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    phoneNumberChangeJWTToken,
+			expectedJWT:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostUserPhoneNumberChangeVerify, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
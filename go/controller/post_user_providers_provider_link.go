@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"golang.org/x/oauth2"
+)
+
+func (ctrl *Controller) PostUserProvidersProviderLink( //nolint:ireturn
+	ctx context.Context,
+	request api.PostUserProvidersProviderLinkRequestObject,
+) (api.PostUserProvidersProviderLinkResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("provider", request.Provider))
+
+	if _, ok := ctrl.oauthProviders[request.Provider]; !ok {
+		logger.Warn("provider is not enabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	redirectTo, apiErr := ctrl.getSigninProviderRedirectTo(request.Body.RedirectTo, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	state := uuid.New()
+	if apiErr := ctrl.wf.InsertProviderRequest(
+		ctx,
+		state,
+		providerRequest{
+			CodeVerifier: oauth2.GenerateVerifier(),
+			Options: api.SignUpOptions{ //nolint:exhaustruct
+				RedirectTo: ptr(redirectTo.String()),
+			},
+			SAMLRequestID: "",
+			LinkUserID:    &user.ID,
+		},
+		logger,
+	); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostUserProvidersProviderLink200JSONResponse{
+		// the user needs to continue in the browser so the sign in is bound to it
+		Url: ctrl.providerContinueURL(request.Provider, state),
+	}, nil
+}
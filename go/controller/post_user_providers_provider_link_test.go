@@ -0,0 +1,170 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+//nolint:lll
+type testPostUserProvidersProviderLinkRequest struct {
+	testRequest[api.PostUserProvidersProviderLinkRequestObject, api.PostUserProvidersProviderLinkResponseObject]
+	providers func(ctrl *gomock.Controller) map[string]providers.Provider
+}
+
+func TestPostUserProvidersProviderLink(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testPostUserProvidersProviderLinkRequest{
+		{
+			testRequest: testRequest[api.PostUserProvidersProviderLinkRequestObject, api.PostUserProvidersProviderLinkResponseObject]{ //nolint:lll
+				name:   "success",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					mock.EXPECT().InsertProviderRequest(
+						gomock.Any(),
+						cmpDBParams(
+							sql.InsertProviderRequestParams{ //nolint:exhaustruct
+								Options: []byte(`{"codeVerifier":"xxx","options":{"redirectTo":"http://localhost:3000"},"linkUserId":"db477732-48fa-4289-b694-2886a646b6eb"}`), //nolint:lll
+							},
+							cmpopts.IgnoreFields(sql.InsertProviderRequestParams{}, "ID"), //nolint:exhaustruct
+							testhelpers.FilterPathLast(
+								[]string{".Options"}, cmp.Comparer(cmpProviderRequest),
+							),
+						),
+					).Return(nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserProvidersProviderLinkRequestObject{
+					Provider: "gitlab",
+					Body: &api.PostUserProvidersProviderLinkJSONRequestBody{
+						RedirectTo: nil,
+					},
+				},
+				expectedResponse: api.PostUserProvidersProviderLink200JSONResponse{
+					Url: "https://local.auth.nhost.run/signin/provider/gitlab?state=xxx",
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  webauthnUserJWT(userID),
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostUserProvidersProviderLinkRequestObject, api.PostUserProvidersProviderLinkResponseObject]{ //nolint:lll
+				name:   "provider not enabled",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserProvidersProviderLinkRequestObject{
+					Provider: "github",
+					Body: &api.PostUserProvidersProviderLinkJSONRequestBody{
+						RedirectTo: nil,
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "disabled-endpoint",
+					Message: "This endpoint is disabled",
+					Status:  409,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  webauthnUserJWT(userID),
+			},
+			providers: gitlabProvider(nil),
+		},
+
+		{
+			testRequest: testRequest[api.PostUserProvidersProviderLinkRequestObject, api.PostUserProvidersProviderLinkResponseObject]{ //nolint:lll
+				name:   "redirectTo not allowed",
+				config: getConfig,
+				db: func(ctrl *gomock.Controller) controller.DBClient {
+					mock := mock.NewMockDBClient(ctrl)
+
+					mock.EXPECT().GetUser(
+						gomock.Any(), userID,
+					).Return(getSigninUser(userID), nil)
+
+					return mock
+				},
+				emailer:       nil,
+				hibp:          nil,
+				customClaimer: nil,
+				request: api.PostUserProvidersProviderLinkRequestObject{
+					Provider: "gitlab",
+					Body: &api.PostUserProvidersProviderLinkJSONRequestBody{
+						RedirectTo: ptr("https://evil.com"),
+					},
+				},
+				expectedResponse: controller.ErrorResponse{
+					Error:   "redirectTo-not-allowed",
+					Message: `The value of "options.redirectTo" is not allowed.`,
+					Status:  400,
+				},
+				expectedJWT: nil,
+				jwtTokenFn:  webauthnUserJWT(userID),
+			},
+			providers: gitlabProvider(nil),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        tc.providers,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostUserProvidersProviderLink, tc.request, tc.expectedResponse,
+				testhelpers.FilterPathLast([]string{".Url"}, cmpRandomState),
+			)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostUserSessionsRevokeAll( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostUserSessionsRevokeAllRequestObject,
+) (api.PostUserSessionsRevokeAllResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	if apiErr := ctrl.wf.RevokeSessions(ctx, user.ID, logger); apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PostUserSessionsRevokeAll200JSONResponse(api.OK), nil
+}
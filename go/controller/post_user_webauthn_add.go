@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostUserWebauthnAdd( //nolint:ireturn
+	ctx context.Context,
+	_ api.PostUserWebauthnAddRequestObject,
+) (api.PostUserWebauthnAddResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.WebauthnEnabled {
+		logger.Warn("webauthn is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	webauthnUser, apiErr := ctrl.wf.GetWebauthnUser(ctx, user, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	creation, apiErr := ctrl.Webauthn.BeginRegistration(webauthnUser, nil, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	return api.PostUserWebauthnAdd200JSONResponse(creation.Response), nil
+}
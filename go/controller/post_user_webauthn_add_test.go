@@ -0,0 +1,192 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func webauthnUserJWT(userID uuid.UUID) func() *jwt.Token {
+	return func() *jwt.Token {
+		return &jwt.Token{
+			Raw:    "",
+			Method: jwt.SigningMethodHS256,
+			Header: map[string]any{
+				"alg": "HS256",
+				"typ": "JWT",
+			},
+			Claims: jwt.MapClaims{
+				"exp": float64(time.Now().Add(900 * time.Second).Unix()),
+				"https://hasura.io/jwt/claims": map[string]any{
+					"x-hasura-allowed-roles":     []any{"user", "me"},
+					"x-hasura-default-role":      "user",
+					"x-hasura-user-id":           userID.String(),
+					"x-hasura-user-is-anonymous": "false",
+				},
+				"iat": float64(time.Now().Unix()),
+				"iss": "hasura-auth",
+				"sub": userID.String(),
+			},
+			Signature: []byte{},
+			Valid:     true,
+		}
+	}
+}
+
+func TestPostUserWebauthnAdd(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	cases := []testRequest[api.PostUserWebauthnAddRequestObject, api.PostUserWebauthnAddResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().GetSecurityKeys(
+					gomock.Any(), userID,
+				).Return([]sql.AuthUserSecurityKey{
+					{
+						ID:                  uuid.MustParse("307b758d-c0b0-4ce3-894b-f8ddec753c29"),
+						UserID:              userID,
+						CredentialID:        "bXktY3JlZGVudGlhbC1pZA",
+						CredentialPublicKey: []byte("public-key"),
+						Counter:             3,
+						Transports:          "",
+						Nickname:            sql.Text("my-key"),
+					},
+				}, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request:       api.PostUserWebauthnAddRequestObject{},
+			expectedResponse: api.PostUserWebauthnAdd200JSONResponse{
+				RelyingParty: protocol.RelyingPartyEntity{
+					CredentialEntity: protocol.CredentialEntity{
+						Name: "React Apollo Example",
+						Icon: "",
+					},
+					ID: "react-apollo.example.nhost.io",
+				},
+				User: protocol.UserEntity{
+					CredentialEntity: protocol.CredentialEntity{
+						Name: "Jane Doe",
+						Icon: "",
+					},
+					DisplayName: "Jane Doe",
+					ID:          userID.String(),
+				},
+				Challenge: []byte{},
+				Parameters: []protocol.CredentialParameter{
+					{Type: "public-key", Algorithm: -7},
+					{Type: "public-key", Algorithm: -35},
+					{Type: "public-key", Algorithm: -36},
+					{Type: "public-key", Algorithm: -257},
+					{Type: "public-key", Algorithm: -258},
+					{Type: "public-key", Algorithm: -259},
+					{Type: "public-key", Algorithm: -37},
+					{Type: "public-key", Algorithm: -38},
+					{Type: "public-key", Algorithm: -39},
+					{Type: "public-key", Algorithm: -8},
+				},
+				Timeout: 60000,
+				CredentialExcludeList: []protocol.CredentialDescriptor{
+					{
+						Type:            "public-key",
+						CredentialID:    []byte("my-credential-id"),
+						Transport:       nil,
+						AttestationType: "",
+					},
+				},
+				AuthenticatorSelection: protocol.AuthenticatorSelection{
+					AuthenticatorAttachment: "",
+					RequireResidentKey:      ptr(false),
+					ResidentKey:             "preferred",
+					UserVerification:        "preferred",
+				},
+				Attestation: "indirect",
+				Extensions:  nil,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name: "webauthn disabled",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.WebauthnEnabled = false
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request:       api.PostUserWebauthnAddRequestObject{},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			//nolint:exhaustruct
+			assertRequest(
+				ctx, t, c.PostUserWebauthnAdd, tc.request, tc.expectedResponse,
+				cmpopts.IgnoreFields(api.PostUserWebauthnAdd200JSONResponse{}, "Challenge"),
+			)
+		})
+	}
+}
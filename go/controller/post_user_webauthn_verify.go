@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PostUserWebauthnVerify( //nolint:ireturn
+	ctx context.Context,
+	request api.PostUserWebauthnVerifyRequestObject,
+) (api.PostUserWebauthnVerifyResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	if !ctrl.config.WebauthnEnabled {
+		logger.Warn("webauthn is disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	credData, err := request.Body.Credential.Parse()
+	if err != nil {
+		logger.Warn("error parsing credential data", logError(err))
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	cred, webauthnUser, apiErr := ctrl.Webauthn.FinishRegistration(credData, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	if webauthnUser.ID != user.ID {
+		logger.Warn(
+			"webauthn challenge was issued for a different user",
+			slog.String("challenge_user_id", webauthnUser.ID.String()),
+		)
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	nickname := deptr(request.Body.Nickname)
+	keyID, apiErr := ctrl.wf.InsertSecurityKey(ctx, user.ID, cred, nickname, logger)
+	if apiErr != nil {
+		return ctrl.respondWithError(apiErr), nil
+	}
+
+	return api.PostUserWebauthnVerify200JSONResponse{
+		Id:       keyID.String(),
+		Nickname: request.Body.Nickname,
+	}, nil
+}
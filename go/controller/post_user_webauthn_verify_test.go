@@ -0,0 +1,165 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostUserWebauthnVerify(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("cf91d1bc-875e-49bc-897f-fbccf32ede11")
+	keyID := uuid.MustParse("307b758d-c0b0-4ce3-894b-f8ddec753c29")
+
+	touchIDRequest, touchIDWebauthnChallenge := webAuthnTouchID(t)
+
+	cases := []testRequest[api.PostUserWebauthnVerifyRequestObject, api.PostUserWebauthnVerifyResponseObject]{
+		{
+			name:   "success",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(
+					gomock.Any(), userID,
+				).Return(getSigninUser(userID), nil)
+
+				mock.EXPECT().InsertSecurityKey(
+					gomock.Any(),
+					sql.InsertSecurityKeyParams{
+						UserID:       userID,
+						CredentialID: "LychOomEPgZu4XNwiDvzlP5hd1U",
+						CredentialPublicKey: []uint8{
+							0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20, 0x57, 0xe1, 0xb5, 0x82, 0xa0, 0x95, 0xc4, 0x1a, 0xf3, 0x65, 0x9d, 0xdd, 0xc2, 0x68, 0xcf, 0x66, 0x35, 0x25, 0x32, 0xa5, 0x86, 0x22, 0xfb, 0xf7, 0xc6, 0xc6, 0x08, 0x6d, 0xa9, 0xc9, 0x64, 0x7f, 0x22, 0x58, 0x20, 0xa3, 0x50, 0x94, 0x11, 0xb8, 0x27, 0x52, 0xae, 0x46, 0xec, 0x56, 0x3a, 0x3b, 0x3a, 0x6d, 0x71, 0x24, 0x10, 0x66, 0xae, 0xb2, 0x57, 0x75, 0xd5, 0xbb, 0x98, 0x8c, 0xd0, 0xc5, 0x91, 0x1f, 0x65, //nolint:lll
+						},
+						Transports: "internal",
+						Nickname:   sql.Text("my-mac"),
+					},
+				).Return(keyID, nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostUserWebauthnVerifyRequestObject{
+				Body: &api.UserAddSecurityKeyVerifyRequest{
+					Credential: *touchIDRequest,
+					Nickname:   ptr("my-mac"),
+				},
+			},
+			expectedResponse: api.PostUserWebauthnVerify200JSONResponse{
+				Id:       keyID.String(),
+				Nickname: ptr("my-mac"),
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name:   "challenge for a different user",
+			config: getConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				otherUserID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+				mock.EXPECT().GetUser(
+					gomock.Any(), otherUserID,
+				).Return(getSigninUser(otherUserID), nil)
+
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn: webauthnUserJWT(
+				uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+			),
+			request: api.PostUserWebauthnVerifyRequestObject{
+				Body: &api.UserAddSecurityKeyVerifyRequest{
+					Credential: *touchIDRequest,
+					Nickname:   nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+			expectedJWT: nil,
+		},
+
+		{
+			name: "webauthn disabled",
+			config: func() *controller.Config {
+				c := getConfig()
+				c.WebauthnEnabled = false
+				return c
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				return mock
+			},
+			emailer:       nil,
+			hibp:          nil,
+			customClaimer: nil,
+			jwtTokenFn:    webauthnUserJWT(userID),
+			request: api.PostUserWebauthnVerifyRequestObject{
+				Body: &api.UserAddSecurityKeyVerifyRequest{
+					Credential: *touchIDRequest,
+					Nickname:   nil,
+				},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+			expectedJWT: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			if c.Webauthn != nil {
+				c.Webauthn.SaveChallenge("zznztjvFVUM0E2p8ZV6shXEcw2f4tbz5RrfZWk4VPXI", touchIDWebauthnChallenge)
+			}
+
+			ctx := jwtGetter.ToContext(context.Background(), tc.jwtTokenFn())
+			assertRequest(
+				ctx, t, c.PostUserWebauthnVerify, tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
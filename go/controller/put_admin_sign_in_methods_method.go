@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PutAdminSignInMethodsMethod( //nolint:ireturn
+	ctx context.Context,
+	request api.PutAdminSignInMethodsMethodRequestObject,
+) (api.PutAdminSignInMethodsMethodResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("method", request.Method))
+
+	if ctrl.signInMethods == nil {
+		logger.Warn("sign in method flags are disabled")
+		return ctrl.sendError(ErrDisabledEndpoint), nil
+	}
+
+	configured, ok := ctrl.configuredSignInMethods()[request.Method]
+	if !ok {
+		logger.Warn("unknown sign in method")
+		return ctrl.sendError(ErrInvalidRequest), nil
+	}
+
+	if err := ctrl.signInMethods.Set(ctx, request.Method, request.Body.Enabled); err != nil {
+		logger.Error("error updating sign in method", logError(err))
+		return ctrl.sendError(ErrInternalServerError), nil
+	}
+
+	logger.Info("sign in method updated", slog.Bool("enabled", request.Body.Enabled))
+
+	return api.PutAdminSignInMethodsMethod200JSONResponse(adminSignInMethod(
+		request.Method, configured, map[string]bool{request.Method: request.Body.Enabled},
+	)), nil
+}
@@ -0,0 +1,137 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutAdminSignInMethodsMethod(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		signInMethods    func(ctrl *gomock.Controller) *controller.SignInMethodFlags
+		request          api.PutAdminSignInMethodsMethodRequestObject
+		expectedResponse api.PutAdminSignInMethodsMethodResponseObject
+	}{
+		{
+			name: "disabled",
+			signInMethods: func(ctrl *gomock.Controller) *controller.SignInMethodFlags {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().UpsertSignInMethod(
+					gomock.Any(),
+					sql.UpsertSignInMethodParams{Method: "webauthn", Enabled: false},
+				).Return(sql.AuthSignInMethod{Method: "webauthn", Enabled: false}, nil) //nolint:exhaustruct
+				return controller.NewSignInMethodFlags(mock, time.Minute)
+			},
+			request: api.PutAdminSignInMethodsMethodRequestObject{
+				Method: "webauthn",
+				Body:   &api.AdminSignInMethodRequest{Enabled: false},
+			},
+			expectedResponse: api.PutAdminSignInMethodsMethod200JSONResponse{
+				Method:     "webauthn",
+				Configured: true,
+				Enabled:    false,
+			},
+		},
+
+		{
+			name: "enabled but not configured",
+			signInMethods: func(ctrl *gomock.Controller) *controller.SignInMethodFlags {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().UpsertSignInMethod(
+					gomock.Any(),
+					sql.UpsertSignInMethodParams{Method: "anonymous", Enabled: true},
+				).Return(sql.AuthSignInMethod{Method: "anonymous", Enabled: true}, nil) //nolint:exhaustruct
+				return controller.NewSignInMethodFlags(mock, time.Minute)
+			},
+			request: api.PutAdminSignInMethodsMethodRequestObject{
+				Method: "anonymous",
+				Body:   &api.AdminSignInMethodRequest{Enabled: true},
+			},
+			expectedResponse: api.PutAdminSignInMethodsMethod200JSONResponse{
+				Method:     "anonymous",
+				Configured: false,
+				Enabled:    false,
+			},
+		},
+
+		{
+			name: "unknown method",
+			signInMethods: func(ctrl *gomock.Controller) *controller.SignInMethodFlags {
+				return controller.NewSignInMethodFlags(mock.NewMockDBClient(ctrl), time.Minute)
+			},
+			request: api.PutAdminSignInMethodsMethodRequestObject{
+				Method: "provider-github",
+				Body:   &api.AdminSignInMethodRequest{Enabled: false},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-request",
+				Message: "The request payload is incorrect",
+				Status:  400,
+			},
+		},
+
+		{
+			name:          "without flags",
+			signInMethods: func(*gomock.Controller) *controller.SignInMethodFlags { return nil },
+			request: api.PutAdminSignInMethodsMethodRequestObject{
+				Method: "webauthn",
+				Body:   &api.AdminSignInMethodRequest{Enabled: false},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(
+				t,
+				ctrl,
+				getConfig,
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      nil,
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    tc.signInMethods(ctrl),
+				},
+			)
+
+			assertRequest(
+				context.Background(), t, c.PutAdminSignInMethodsMethod,
+				tc.request, tc.expectedResponse,
+			)
+		})
+	}
+}
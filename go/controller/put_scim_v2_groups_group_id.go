@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PutScimV2GroupsGroupId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PutScimV2GroupsGroupIdRequestObject,
+) (api.PutScimV2GroupsGroupIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("role", request.GroupId))
+
+	if request.Body.DisplayName != request.GroupId {
+		logger.Warn("scim groups can't be renamed")
+		return scimError(
+			http.StatusBadRequest, scimTypeMutability, "Groups can't be renamed",
+		), nil
+	}
+
+	userIDs, err := scimMemberIDs(deptr(request.Body.Members))
+	if err != nil {
+		logger.Warn("invalid scim group", logError(err))
+		return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+	}
+
+	if apiErr := ctrl.wf.SetScimGroupMembers(
+		ctx, request.GroupId, userIDs, logger,
+	); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	group, apiErr := ctrl.getScimGroup(ctx, request.GroupId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.PutScimV2GroupsGroupId200ApplicationScimPlusJSONResponse(group), nil
+}
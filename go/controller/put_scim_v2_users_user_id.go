@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PutScimV2UsersUserId( //nolint:ireturn,revive,stylecheck
+	ctx context.Context,
+	request api.PutScimV2UsersUserIdRequestObject,
+) (api.PutScimV2UsersUserIdResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx).
+		With(slog.String("user_id", request.UserId.String()))
+
+	input, err := scimUserInputFromUser(*request.Body)
+	if err != nil {
+		logger.Warn("invalid scim user", logError(err))
+		return scimError(http.StatusBadRequest, scimTypeInvalidValue, err.Error()), nil
+	}
+
+	user, apiErr := ctrl.wf.GetScimUser(ctx, request.UserId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	if apiErr := ctrl.wf.UpdateScimUser(ctx, user, input, logger); apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	user, apiErr = ctrl.wf.GetScimUser(ctx, request.UserId, logger)
+	if apiErr != nil {
+		return ctrl.sendScimError(apiErr), nil
+	}
+
+	return api.PutScimV2UsersUserId200ApplicationScimPlusJSONResponse(ctrl.scimUser(user)), nil
+}
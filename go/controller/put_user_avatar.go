@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func (ctrl *Controller) PutUserAvatar( //nolint:ireturn
+	ctx context.Context,
+	request api.PutUserAvatarRequestObject,
+) (api.PutUserAvatarResponseObject, error) {
+	logger := middleware.LoggerFromContext(ctx)
+
+	user, apiErr := ctrl.wf.GetUserFromJWTInContext(ctx, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+	logger = logger.With(slog.String("user_id", user.ID.String()))
+
+	// one byte over the limit is enough to know the avatar is too large
+	body, err := io.ReadAll(
+		io.LimitReader(request.Body, int64(ctrl.config.AvatarUploadMaxSize)+1),
+	)
+	if err != nil {
+		logger.Warn("error reading avatar", logError(err))
+		return ctrl.sendError(ErrInvalidAvatar), nil
+	}
+
+	avatarURL, apiErr := ctrl.wf.UploadAvatar(ctx, user, body, logger)
+	if apiErr != nil {
+		return ctrl.sendError(apiErr), nil
+	}
+
+	return api.PutUserAvatar200JSONResponse{AvatarUrl: avatarURL}, nil
+}
@@ -0,0 +1,265 @@
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+const testAvatarURL = "https://storage.acme.com/avatars/db477732-48fa-4289-b694-2886a646b6eb/1.png"
+
+func getAvatarConfig() *controller.Config {
+	config := getConfig()
+	config.AvatarUploadMaxSize = 1024 * 1024
+	config.AvatarSize = 64
+	return config
+}
+
+func testPNG(t *testing.T, width int, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := range width {
+		for y := range height {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 0, A: 255}) //nolint:gosec
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// pngOfSize matches PNG images of the given dimensions.
+func pngOfSize(width int, height int) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		b, ok := x.([]byte)
+		if !ok {
+			return false
+		}
+		cfg, err := png.DecodeConfig(bytes.NewReader(b))
+		return err == nil && cfg.Width == width && cfg.Height == height
+	})
+}
+
+func TestPutUserAvatar(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	userWithAvatar := func(avatarURL string) sql.AuthUser {
+		user := getSigninUser(userID)
+		user.AvatarUrl = avatarURL
+		return user
+	}
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		db               func(ctrl *gomock.Controller) controller.DBClient
+		avatarStorage    func(ctrl *gomock.Controller) *mock.MockAvatarStorage
+		body             []byte
+		expectedResponse api.PutUserAvatarResponseObject
+	}{
+		{
+			name:   "resized",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					userWithAvatar("https://storage.acme.com/avatars/old.png"), nil,
+				)
+
+				mock.EXPECT().UpdateUserAvatarURL(
+					gomock.Any(),
+					sql.UpdateUserAvatarURLParams{
+						ID:        userID,
+						AvatarUrl: testAvatarURL,
+					},
+				).Return(nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				mock := mock.NewMockAvatarStorage(ctrl)
+
+				mock.EXPECT().Upload(
+					gomock.Any(), userID, "image/png", pngOfSize(64, 32),
+				).Return(testAvatarURL, nil)
+
+				mock.EXPECT().Delete(
+					gomock.Any(), "https://storage.acme.com/avatars/old.png",
+				).Return(nil)
+
+				return mock
+			},
+			body: testPNG(t, 128, 64),
+			expectedResponse: api.PutUserAvatar200JSONResponse{
+				AvatarUrl: testAvatarURL,
+			},
+		},
+
+		{
+			name:   "small images are kept as they are",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(userWithAvatar(""), nil)
+
+				mock.EXPECT().UpdateUserAvatarURL(
+					gomock.Any(),
+					sql.UpdateUserAvatarURLParams{
+						ID:        userID,
+						AvatarUrl: testAvatarURL,
+					},
+				).Return(nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				mock := mock.NewMockAvatarStorage(ctrl)
+
+				mock.EXPECT().Upload(
+					gomock.Any(), userID, "image/png", pngOfSize(16, 48),
+				).Return(testAvatarURL, nil)
+
+				return mock
+			},
+			body: testPNG(t, 16, 48),
+			expectedResponse: api.PutUserAvatar200JSONResponse{
+				AvatarUrl: testAvatarURL,
+			},
+		},
+
+		{
+			name: "too large",
+			config: func() *controller.Config {
+				config := getAvatarConfig()
+				config.AvatarUploadMaxSize = 100
+				return config
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(userWithAvatar(""), nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				return mock.NewMockAvatarStorage(ctrl)
+			},
+			body: testPNG(t, 128, 128),
+			expectedResponse: controller.ErrorResponse{
+				Error:   "avatar-too-large",
+				Message: "The avatar is too large",
+				Status:  413,
+			},
+		},
+
+		{
+			name:   "not an image",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(userWithAvatar(""), nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				return mock.NewMockAvatarStorage(ctrl)
+			},
+			body: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+			expectedResponse: controller.ErrorResponse{
+				Error:   "invalid-avatar",
+				Message: "The avatar must be a PNG, JPEG or GIF image",
+				Status:  400,
+			},
+		},
+
+		{
+			name:   "storage error",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(userWithAvatar(""), nil)
+
+				return mock
+			},
+			avatarStorage: func(ctrl *gomock.Controller) *mock.MockAvatarStorage {
+				mock := mock.NewMockAvatarStorage(ctrl)
+
+				mock.EXPECT().Upload(
+					gomock.Any(), userID, "image/png", gomock.Any(),
+				).Return("", errors.New("access denied")) //nolint:goerr113
+
+				return mock
+			},
+			body: testPNG(t, 16, 16),
+			expectedResponse: controller.ErrorResponse{
+				Error:   "internal-server-error",
+				Message: "Internal server error",
+				Status:  500,
+			},
+		},
+
+		{
+			name:   "uploads disabled",
+			config: getAvatarConfig,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(userWithAvatar(""), nil)
+
+				return mock
+			},
+			avatarStorage: nil,
+			body:          testPNG(t, 16, 16),
+			expectedResponse: controller.ErrorResponse{
+				Error:   "disabled-endpoint",
+				Message: "This endpoint is disabled",
+				Status:  409,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(t, ctrl, tc.config, tc.db, getControllerOpts{ //nolint:exhaustruct
+				avatarStorage: tc.avatarStorage,
+			})
+
+			ctx := jwtGetter.ToContext(context.Background(), webauthnUserJWT(userID)())
+			assertRequest(
+				ctx,
+				t,
+				c.PutUserAvatar,
+				api.PutUserAvatarRequestObject{
+					ContentType: "image/png",
+					Body:        bytes.NewReader(tc.body),
+				},
+				tc.expectedResponse,
+			)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/ratelimit"
+)
+
+type rateLimitCategory string
+
+const (
+	rateLimitSignin        rateLimitCategory = "signin"
+	rateLimitSignup        rateLimitCategory = "signup"
+	rateLimitPasswordReset rateLimitCategory = "password-reset"
+	rateLimitEmail         rateLimitCategory = "email"
+	rateLimitSMS           rateLimitCategory = "sms"
+)
+
+// rateLimitedOperations are the operations counted against the rate limits of each
+// category. Operations sending emails or SMS are limited to avoid flooding the
+// recipients and the providers.
+var rateLimitedOperations = map[string]rateLimitCategory{ //nolint:gochecknoglobals
+	"PostSigninEmailPassword":            rateLimitSignin,
+	"PostSigninIdtoken":                  rateLimitSignin,
+	"PostSigninMfaRecoveryCode":          rateLimitSignin,
+	"PostSigninMfaTotp":                  rateLimitSignin,
+	"PostSigninPasswordlessSmsOtp":       rateLimitSignin,
+	"PostSigninPat":                      rateLimitSignin,
+	"PostSigninWebauthn":                 rateLimitSignin,
+	"PostSigninWebauthnVerify":           rateLimitSignin,
+	"PostElevate":                        rateLimitSignin,
+	"PostSigninAnonymous":                rateLimitSignup,
+	"PostSignupEmailPassword":            rateLimitSignup,
+	"PostSignupWebauthn":                 rateLimitSignup,
+	"PostUserPasswordReset":              rateLimitPasswordReset,
+	"PostSigninPasswordlessEmail":        rateLimitEmail,
+	"PostUserEmailChange":                rateLimitEmail,
+	"PostUserEmailSendVerificationEmail": rateLimitEmail,
+	"PostSigninPasswordlessSms":          rateLimitSMS,
+	"PostUserPhoneNumberChange":          rateLimitSMS,
+}
+
+// rateLimitLimits returns the number of requests allowed per interval for each IP and for
+// each identifier. A limit of 0 disables it.
+func (ctrl *Controller) rateLimitLimits(category rateLimitCategory) (int, int) {
+	switch category {
+	case rateLimitSignin:
+		return ctrl.config.RateLimitSigninIP, ctrl.config.RateLimitSigninIdentifier
+	case rateLimitSignup:
+		return ctrl.config.RateLimitSignupIP, ctrl.config.RateLimitSignupIdentifier
+	case rateLimitPasswordReset:
+		return ctrl.config.RateLimitResetIP, ctrl.config.RateLimitResetIdentifier
+	case rateLimitEmail:
+		return ctrl.config.RateLimitEmailIP, ctrl.config.RateLimitEmailIdentifier
+	case rateLimitSMS:
+		return ctrl.config.RateLimitSMSIP, ctrl.config.RateLimitSMSIdentifier
+	}
+	return 0, 0
+}
+
+// rateLimitIdentifier returns the email or phone number the request is about so attempts
+// against the same account are limited regardless of how many IPs they come from.
+func rateLimitIdentifier(request any) string { //nolint:cyclop
+	var identifier string
+	switch r := request.(type) {
+	case api.PostSigninEmailPasswordRequestObject:
+		identifier = string(r.Body.Email)
+	case api.PostSigninPasswordlessSmsOtpRequestObject:
+		identifier = r.Body.PhoneNumber
+	case api.PostSigninWebauthnRequestObject:
+		identifier = string(deptr(r.Body.Email))
+	case api.PostSignupEmailPasswordRequestObject:
+		identifier = string(r.Body.Email)
+	case api.PostSignupWebauthnRequestObject:
+		identifier = string(r.Body.Email)
+	case api.PostUserPasswordResetRequestObject:
+		identifier = string(r.Body.Email)
+	case api.PostSigninPasswordlessEmailRequestObject:
+		identifier = string(r.Body.Email)
+	case api.PostUserEmailChangeRequestObject:
+		identifier = string(r.Body.NewEmail)
+	case api.PostUserEmailSendVerificationEmailRequestObject:
+		identifier = string(r.Body.Email)
+	case api.PostSigninPasswordlessSmsRequestObject:
+		identifier = r.Body.PhoneNumber
+	case api.PostUserPhoneNumberChangeRequestObject:
+		identifier = r.Body.NewPhoneNumber
+	}
+	return strings.ToLower(identifier)
+}
+
+// rateLimitSubject returns the identifier the request is limited by. Requests that
+// verify the factors of a signed in user, like PostElevate, don't carry an email so they
+// are limited by the user id of their access token.
+func (ctrl *Controller) rateLimitSubject(ctx context.Context, request any) string {
+	if _, ok := request.(api.PostElevateRequestObject); !ok {
+		return rateLimitIdentifier(request)
+	}
+
+	token, ok := ctrl.wf.jwtGetter.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	sub, err := token.Claims.GetSubject()
+	if err != nil {
+		return ""
+	}
+
+	return "user:" + sub
+}
+
+// allowRequest counts the request against every limit that applies and returns the
+// result with the fewest remaining requests. Errors from the backend are logged and the
+// request is allowed so an outage of the backend doesn't take authentication down.
+func (ctrl *Controller) allowRequest(
+	ctx context.Context,
+	category rateLimitCategory,
+	ip string,
+	identifier string,
+	logger *slog.Logger,
+) (ratelimit.Result, bool) {
+	ipLimit, identifierLimit := ctrl.rateLimitLimits(category)
+	interval := time.Duration(ctrl.config.RateLimitInterval) * time.Second
+
+	keys := make(map[string]int, 2) //nolint:mnd
+	if ipLimit > 0 && ip != "" {
+		keys[string(category)+":ip:"+ip] = ipLimit
+	}
+	if identifierLimit > 0 && identifier != "" {
+		keys[string(category)+":identifier:"+identifier] = identifierLimit
+	}
+
+	var (
+		strictest ratelimit.Result
+		found     bool
+	)
+	for key, limit := range keys {
+		res, err := ctrl.rateLimiter.Allow(ctx, key, limit, interval)
+		if err != nil {
+			logger.Error("error checking rate limit, allowing request", logError(err))
+			continue
+		}
+
+		if !found || !res.Allowed || (strictest.Allowed && res.Remaining < strictest.Remaining) {
+			strictest = res
+			found = true
+		}
+	}
+
+	return strictest, found
+}
+
+func setRateLimitHeaders(ctx *gin.Context, res ratelimit.Result) {
+	reset := max(int(time.Until(res.Reset).Seconds()+1), 0)
+	ctx.Header("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+	ctx.Header("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	ctx.Header("X-RateLimit-Reset", strconv.Itoa(reset))
+	if !res.Allowed {
+		ctx.Header("Retry-After", strconv.Itoa(reset))
+	}
+}
+
+// RateLimit is a strict middleware that limits how many requests each IP and each email
+// or phone number can send to the operations listed in rateLimitedOperations.
+func (ctrl *Controller) RateLimit(
+	f api.StrictHandlerFunc,
+	operationID string,
+) api.StrictHandlerFunc {
+	category, ok := rateLimitedOperations[operationID]
+	if !ok || ctrl.rateLimiter == nil {
+		return f
+	}
+
+	return func(ctx *gin.Context, request any) (any, error) {
+		logger := middleware.LoggerFromContext(ctx)
+
+		res, ok := ctrl.allowRequest(
+			ctx,
+			category,
+			middleware.ClientInfoFromContext(ctx).IP,
+			ctrl.rateLimitSubject(ctx, request),
+			logger,
+		)
+		if !ok {
+			return f(ctx, request)
+		}
+
+		setRateLimitHeaders(ctx, res)
+
+		if !res.Allowed {
+			logger.Warn("rate limit exceeded", slog.String("category", string(category)))
+			metrics.RateLimitRejections.WithLabelValues(string(category)).Inc()
+			return ctrl.sendError(ErrTooManyRequests), nil
+		}
+
+		return f(ctx, request)
+	}
+}
@@ -0,0 +1,280 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/ratelimit"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func signinEmailPasswordRequest(email types.Email) api.PostSigninEmailPasswordRequestObject {
+	return api.PostSigninEmailPasswordRequestObject{
+		Body: &api.PostSigninEmailPasswordJSONRequestBody{
+			Email:    email,
+			Password: "password",
+		},
+	}
+}
+
+func TestRateLimit(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	next := "next handler called"
+
+	rateLimitConfig := func() *controller.Config {
+		cfg := getConfig()
+		cfg.RateLimitInterval = 300
+		cfg.RateLimitSigninIP = 3
+		cfg.RateLimitSigninIdentifier = 2
+		return cfg
+	}
+
+	cases := []struct {
+		name             string
+		config           func() *controller.Config
+		rateLimiter      func(ctrl *gomock.Controller) controller.RateLimiter
+		operationID      string
+		requests         []any
+		userID           string
+		expectedResponse any
+		expectedHeaders  http.Header
+	}{
+		{
+			name:   "within limits",
+			config: rateLimitConfig,
+			rateLimiter: func(_ *gomock.Controller) controller.RateLimiter {
+				return ratelimit.NewMemory()
+			},
+			userID:      "",
+			operationID: "PostSigninEmailPassword",
+			requests: []any{
+				signinEmailPasswordRequest("jane@acme.com"),
+			},
+			expectedResponse: next,
+			expectedHeaders: http.Header{
+				"X-Ratelimit-Limit":     []string{"2"},
+				"X-Ratelimit-Remaining": []string{"1"},
+				"X-Ratelimit-Reset":     []string{"300"},
+			},
+		},
+		{
+			name:   "identifier limit exceeded",
+			config: rateLimitConfig,
+			rateLimiter: func(_ *gomock.Controller) controller.RateLimiter {
+				return ratelimit.NewMemory()
+			},
+			userID:      "",
+			operationID: "PostSigninEmailPassword",
+			requests: []any{
+				signinEmailPasswordRequest("jane@acme.com"),
+				signinEmailPasswordRequest("Jane@Acme.com"),
+				signinEmailPasswordRequest("jane@acme.com"),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Status:  http.StatusTooManyRequests,
+				Error:   "too-many-requests",
+				Message: "Too many requests, try again later",
+			},
+			expectedHeaders: http.Header{
+				"Retry-After":           []string{"300"},
+				"X-Ratelimit-Limit":     []string{"2"},
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"300"},
+			},
+		},
+		{
+			name:   "ip limit exceeded",
+			config: rateLimitConfig,
+			rateLimiter: func(_ *gomock.Controller) controller.RateLimiter {
+				return ratelimit.NewMemory()
+			},
+			userID:      "",
+			operationID: "PostSigninEmailPassword",
+			requests: []any{
+				signinEmailPasswordRequest("jane@acme.com"),
+				signinEmailPasswordRequest("john@acme.com"),
+				signinEmailPasswordRequest("bob@acme.com"),
+				signinEmailPasswordRequest("alice@acme.com"),
+			},
+			expectedResponse: controller.ErrorResponse{
+				Status:  http.StatusTooManyRequests,
+				Error:   "too-many-requests",
+				Message: "Too many requests, try again later",
+			},
+			expectedHeaders: http.Header{
+				"Retry-After":           []string{"300"},
+				"X-Ratelimit-Limit":     []string{"3"},
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"300"},
+			},
+		},
+		{
+			name:   "elevate limited by user",
+			config: rateLimitConfig,
+			rateLimiter: func(_ *gomock.Controller) controller.RateLimiter {
+				return ratelimit.NewMemory()
+			},
+			userID:      "db477732-48fa-4289-b694-2886a646b6eb",
+			operationID: "PostElevate",
+			requests: []any{
+				api.PostElevateRequestObject{Body: &api.ElevateRequest{Password: ptr("a"), Otp: nil}},
+				api.PostElevateRequestObject{Body: &api.ElevateRequest{Password: ptr("b"), Otp: nil}},
+				api.PostElevateRequestObject{Body: &api.ElevateRequest{Password: ptr("c"), Otp: nil}},
+			},
+			expectedResponse: controller.ErrorResponse{
+				Status:  http.StatusTooManyRequests,
+				Error:   "too-many-requests",
+				Message: "Too many requests, try again later",
+			},
+			expectedHeaders: http.Header{
+				"Retry-After":           []string{"300"},
+				"X-Ratelimit-Limit":     []string{"2"},
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"300"},
+			},
+		},
+		{
+			name:   "limits disabled",
+			config: getConfig,
+			rateLimiter: func(_ *gomock.Controller) controller.RateLimiter {
+				return ratelimit.NewMemory()
+			},
+			userID:      "",
+			operationID: "PostSigninEmailPassword",
+			requests: []any{
+				signinEmailPasswordRequest("jane@acme.com"),
+				signinEmailPasswordRequest("jane@acme.com"),
+				signinEmailPasswordRequest("jane@acme.com"),
+			},
+			expectedResponse: next,
+			expectedHeaders:  http.Header{},
+		},
+		{
+			name:   "operation not rate limited",
+			config: rateLimitConfig,
+			rateLimiter: func(_ *gomock.Controller) controller.RateLimiter {
+				return ratelimit.NewMemory()
+			},
+			userID:      "",
+			operationID: "PostToken",
+			requests: []any{
+				api.PostTokenRequestObject{Body: nil},
+				api.PostTokenRequestObject{Body: nil},
+				api.PostTokenRequestObject{Body: nil},
+				api.PostTokenRequestObject{Body: nil},
+			},
+			expectedResponse: next,
+			expectedHeaders:  http.Header{},
+		},
+		{
+			name:   "backend error",
+			config: rateLimitConfig,
+			rateLimiter: func(ctrl *gomock.Controller) controller.RateLimiter {
+				mock := mock.NewMockRateLimiter(ctrl)
+				mock.EXPECT().Allow(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(
+					ratelimit.Result{},               //nolint:exhaustruct
+					errors.New("connection refused"), //nolint:goerr113
+				).Times(2)
+				return mock
+			},
+			userID:      "",
+			operationID: "PostSigninEmailPassword",
+			requests: []any{
+				signinEmailPasswordRequest("jane@acme.com"),
+			},
+			expectedResponse: next,
+			expectedHeaders:  http.Header{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, jwtGetter := getController(
+				t,
+				ctrl,
+				tc.config,
+				func(ctrl *gomock.Controller) controller.DBClient {
+					return mock.NewMockDBClient(ctrl)
+				},
+				getControllerOpts{
+					customClaimer:    nil,
+					emailer:          nil,
+					hibp:             nil,
+					sms:              nil,
+					providers:        nil,
+					idTokenProviders: nil,
+					saml:             nil,
+					rateLimiter:      tc.rateLimiter(ctrl),
+					captcha:          nil,
+					webhooks:         nil,
+					preSignUpHook:    nil,
+					disposableEmails: nil,
+					avatarStorage:    nil,
+					geoIP:            nil,
+					riskEngine:       nil,
+					configReloader:   nil,
+					signInMethods:    nil,
+				},
+			)
+
+			handler := c.RateLimit(
+				func(_ *gin.Context, _ any) (any, error) {
+					return next, nil
+				},
+				tc.operationID,
+			)
+
+			var (
+				resp     any
+				recorder *httptest.ResponseRecorder
+			)
+			for _, request := range tc.requests {
+				recorder = httptest.NewRecorder()
+				ginCtx, engine := gin.CreateTestContext(recorder)
+				engine.ContextWithFallback = true
+				reqCtx := middleware.ClientInfoToContext(
+					context.Background(),
+					middleware.ClientInfo{IP: "192.168.1.1", UserAgent: "test"},
+				)
+				if tc.userID != "" {
+					reqCtx = jwtGetter.ToContext(
+						reqCtx, webauthnUserJWT(uuid.MustParse(tc.userID))(),
+					)
+				}
+				ginCtx.Request = httptest.NewRequest(http.MethodPost, "/", nil).WithContext(reqCtx)
+
+				var err error
+				resp, err = handler(ginCtx, request)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(tc.expectedResponse, resp); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.expectedHeaders, recorder.Header()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/ttlcache"
+)
+
+// RoleCachedDB keeps the roles of the users in memory, for ttl, so sessions can be issued
+// without getting them from the database every time. The roles of a user are evicted when
+// they are changed through it and Invalidate evicts the ones changed elsewhere, i.e. by
+// another instance. At most maxEntries users are kept.
+//
+// Only the roles are cached, the users themselves are always read from the database so
+// disabled and banned users are rejected right away.
+type RoleCachedDB struct {
+	DBClient
+
+	cache *ttlcache.Cache[uuid.UUID, []sql.AuthUserRole]
+}
+
+func NewRoleCachedDB(db DBClient, ttl time.Duration, maxEntries int) *RoleCachedDB {
+	return &RoleCachedDB{
+		DBClient: db,
+		cache:    ttlcache.New[uuid.UUID, []sql.AuthUserRole](ttl, maxEntries),
+	}
+}
+
+// Invalidate evicts the roles of the user.
+func (c *RoleCachedDB) Invalidate(userID uuid.UUID) {
+	c.cache.Delete(userID)
+}
+
+// InvalidateAll evicts the roles of every user.
+func (c *RoleCachedDB) InvalidateAll() {
+	c.cache.Clear()
+}
+
+func (c *RoleCachedDB) GetUserRoles(
+	ctx context.Context, userID uuid.UUID,
+) ([]sql.AuthUserRole, error) {
+	// the generation is read first so roles changed while they are read aren't cached
+	generation := c.cache.Generation()
+	if roles, ok := c.cache.Get(userID); ok {
+		return slices.Clone(roles), nil
+	}
+
+	roles, err := c.DBClient.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	c.cache.SetIfUnchanged(userID, slices.Clone(roles), generation)
+
+	return roles, nil
+}
+
+func (c *RoleCachedDB) InsertUserRole(ctx context.Context, arg sql.InsertUserRoleParams) error {
+	defer c.Invalidate(arg.UserID)
+	return c.DBClient.InsertUserRole(ctx, arg) //nolint:wrapcheck
+}
+
+func (c *RoleCachedDB) DeleteUserRole(ctx context.Context, arg sql.DeleteUserRoleParams) error {
+	defer c.Invalidate(arg.UserID)
+	return c.DBClient.DeleteUserRole(ctx, arg) //nolint:wrapcheck
+}
+
+func (c *RoleCachedDB) DeleteUserRoles(ctx context.Context, userID uuid.UUID) error {
+	defer c.Invalidate(userID)
+	return c.DBClient.DeleteUserRoles(ctx, userID) //nolint:wrapcheck
+}
+
+func (c *RoleCachedDB) UpdateUserDeanonymize(
+	ctx context.Context, arg sql.UpdateUserDeanonymizeParams,
+) error {
+	defer c.Invalidate(uuid.UUID(arg.ID.Bytes))
+	return c.DBClient.UpdateUserDeanonymize(ctx, arg) //nolint:wrapcheck
+}
+
+func (c *RoleCachedDB) DeleteRole(ctx context.Context, role string) (int64, error) {
+	defer c.InvalidateAll()
+	return c.DBClient.DeleteRole(ctx, role) //nolint:wrapcheck
+}
+
+func (c *RoleCachedDB) ImportUsers(
+	ctx context.Context, arg sql.ImportUsersParams,
+) ([]uuid.UUID, error) {
+	defer c.InvalidateAll()
+	return c.DBClient.ImportUsers(ctx, arg) //nolint:wrapcheck
+}
+
+// InTx runs fn in a transaction if the wrapped client supports them. Roles changed in the
+// transaction are evicted again once it is done so roles read in the meantime, before the
+// changes were committed, aren't kept.
+func (c *RoleCachedDB) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	t, ok := c.DBClient.(sql.Transactor)
+	if !ok {
+		return fn(ctx)
+	}
+
+	generation := c.cache.Generation()
+	defer func() {
+		if c.cache.Generation() != generation {
+			c.InvalidateAll()
+		}
+	}()
+
+	return t.InTx(ctx, fn) //nolint:wrapcheck
+}
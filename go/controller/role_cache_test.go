@@ -0,0 +1,173 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRoleCachedDB(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+	otherUserID := uuid.MustParse("4cc8f7b2-0b8e-4e49-9e1c-bbd2bf5b4c8e")
+
+	roles := []sql.AuthUserRole{
+		{UserID: userID, Role: "user"}, //nolint:exhaustruct
+		{UserID: userID, Role: "me"},   //nolint:exhaustruct
+	}
+
+	cases := []struct {
+		name       string
+		ttl        time.Duration
+		maxEntries int
+		db         func(ctrl *gomock.Controller) controller.DBClient
+		run        func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB)
+	}{
+		{
+			name:       "cached",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(roles, nil).Times(1)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB) {
+				t.Helper()
+				for range 3 {
+					got, err := db.GetUserRoles(ctx, userID)
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if diff := cmp.Diff(roles, got); diff != "" {
+						t.Errorf("unexpected roles (-want +got):\n%s", diff)
+					}
+				}
+			},
+		},
+		{
+			name:       "expired",
+			ttl:        -time.Second,
+			maxEntries: 10,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(roles, nil).Times(2)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB) {
+				t.Helper()
+				_, _ = db.GetUserRoles(ctx, userID)
+				_, _ = db.GetUserRoles(ctx, userID)
+			},
+		},
+		{
+			name:       "evicted when full",
+			ttl:        time.Minute,
+			maxEntries: 1,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(roles, nil).Times(2)
+				mock.EXPECT().GetUserRoles(gomock.Any(), otherUserID).Return(nil, nil).Times(1)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB) {
+				t.Helper()
+				_, _ = db.GetUserRoles(ctx, userID)
+				_, _ = db.GetUserRoles(ctx, otherUserID)
+				_, _ = db.GetUserRoles(ctx, otherUserID)
+				_, _ = db.GetUserRoles(ctx, userID)
+			},
+		},
+		{
+			name:       "errors aren't cached",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(nil, context.Canceled)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(roles, nil)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB) {
+				t.Helper()
+				if _, err := db.GetUserRoles(ctx, userID); err == nil {
+					t.Fatal("expected error")
+				}
+				_, _ = db.GetUserRoles(ctx, userID)
+			},
+		},
+		{
+			name:       "invalidated by role mutations",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(roles, nil).Times(4)
+				mock.EXPECT().GetUserRoles(gomock.Any(), otherUserID).Return(nil, nil).Times(2)
+				mock.EXPECT().InsertUserRole(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().DeleteUserRole(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().DeleteRole(gomock.Any(), "editor").Return(int64(1), nil)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB) {
+				t.Helper()
+				_, _ = db.GetUserRoles(ctx, userID)
+				_, _ = db.GetUserRoles(ctx, otherUserID)
+
+				_ = db.InsertUserRole(
+					ctx, sql.InsertUserRoleParams{UserID: userID, Role: "editor"},
+				)
+				_, _ = db.GetUserRoles(ctx, userID)
+				_, _ = db.GetUserRoles(ctx, otherUserID)
+
+				_ = db.DeleteUserRole(
+					ctx, sql.DeleteUserRoleParams{UserID: userID, Role: "editor"},
+				)
+				_, _ = db.GetUserRoles(ctx, userID)
+
+				_, _ = db.DeleteRole(ctx, "editor")
+				_, _ = db.GetUserRoles(ctx, userID)
+				_, _ = db.GetUserRoles(ctx, otherUserID)
+			},
+		},
+		{
+			name:       "invalidated explicitly",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(roles, nil).Times(3)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, db *controller.RoleCachedDB) {
+				t.Helper()
+				_, _ = db.GetUserRoles(ctx, userID)
+				db.Invalidate(otherUserID)
+				_, _ = db.GetUserRoles(ctx, userID)
+				db.Invalidate(userID)
+				_, _ = db.GetUserRoles(ctx, userID)
+				db.InvalidateAll()
+				_, _ = db.GetUserRoles(ctx, userID)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			db := controller.NewRoleCachedDB(tc.db(ctrl), tc.ttl, tc.maxEntries)
+
+			tc.run(context.Background(), t, db)
+		})
+	}
+}
@@ -0,0 +1,494 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	scimSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	scimMaxCount = 100
+
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeMutability    = "mutability"
+	scimTypeUniqueness    = "uniqueness"
+)
+
+var errInvalidScimValue = errors.New("invalid value")
+
+// scimFilterRegexp matches the filters comparing an attribute to a string, the only
+// filters identity providers send to look for existing resources.
+var scimFilterRegexp = regexp.MustCompile( //nolint:gochecknoglobals
+	`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`,
+)
+
+// scimMembersPathRegexp matches the paths selecting some members of a group, i.e.
+// members[value eq "2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"].
+var scimMembersPathRegexp = regexp.MustCompile(`^(?i:members)\[(.*)\]$`) //nolint:gochecknoglobals
+
+// scimUserAttributes maps the attributes of a SCIM user, in lowercase, to the attributes
+// of api.ScimUser. Other attributes aren't stored and are ignored.
+var scimUserAttributes = map[string]string{ //nolint:gochecknoglobals
+	"username":        "userName",
+	"displayname":     "displayName",
+	"externalid":      "externalId",
+	"active":          "active",
+	"emails":          "emails",
+	"name":            "name",
+	"name.formatted":  "name.formatted",
+	"name.givenname":  "name.givenName",
+	"name.familyname": "name.familyName",
+}
+
+type ScimErrorResponse api.ScimError
+
+func (response ScimErrorResponse) visit(w http.ResponseWriter) error {
+	status, err := strconv.Atoi(response.Status)
+	if err != nil {
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(response) //nolint:wrapcheck
+}
+
+func (response ScimErrorResponse) VisitGetScimV2UsersResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitPostScimV2UsersResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitGetScimV2UsersUserIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitPutScimV2UsersUserIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitPatchScimV2UsersUserIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitDeleteScimV2UsersUserIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitGetScimV2GroupsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitPostScimV2GroupsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitGetScimV2GroupsGroupIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitPutScimV2GroupsGroupIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitPatchScimV2GroupsGroupIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func (response ScimErrorResponse) VisitDeleteScimV2GroupsGroupIdResponse(
+	w http.ResponseWriter,
+) error {
+	return response.visit(w)
+}
+
+func scimError(status int, scimType string, detail string) ScimErrorResponse {
+	response := ScimErrorResponse{
+		Schemas:  []string{scimSchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: nil,
+		Detail:   ptr(detail),
+	}
+	if scimType != "" {
+		response.ScimType = ptr(scimType)
+	}
+	return response
+}
+
+// sendScimError is sendError for the SCIM endpoints, conflicts are reported as uniqueness
+// errors.
+func (ctrl *Controller) sendScimError(err *APIError) ScimErrorResponse {
+	response := ctrl.sendError(err)
+
+	scimType := ""
+	if response.Status == http.StatusConflict && response.Error != api.RoleInUse {
+		scimType = scimTypeUniqueness
+	}
+
+	return scimError(response.Status, scimType, response.Message)
+}
+
+// parseScimFilter returns the attribute, in lowercase, and the value of a filter like
+// userName eq "bob@nhost.io".
+func parseScimFilter(filter string) (string, string, bool) {
+	match := scimFilterRegexp.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", false
+	}
+
+	value, err := strconv.Unquote(`"` + match[2] + `"`)
+	if err != nil {
+		return "", "", false
+	}
+
+	return strings.ToLower(match[1]), value, true
+}
+
+// scimPage returns the offset and limit of the page of a list request.
+func scimPage(startIndex *int, count *int) (int32, int32) {
+	offset := 0
+	if startIndex != nil && *startIndex > 1 {
+		offset = *startIndex - 1
+	}
+
+	limit := scimMaxCount
+	if count != nil && *count < scimMaxCount {
+		limit = *count
+	}
+
+	return int32(offset), int32(limit) //nolint:gosec
+}
+
+func (ctrl *Controller) scimLocation(resource string, id string) *string {
+	return ptr(ctrl.config.ServerURL.JoinPath("scim/v2", resource, id).String())
+}
+
+func (ctrl *Controller) scimUser(user sql.GetScimUsersRow) api.ScimUser {
+	groups := make([]api.ScimMember, len(user.Roles))
+	for i, role := range user.Roles {
+		groups[i] = api.ScimMember{
+			Value:   role,
+			Display: ptr(role),
+		}
+	}
+
+	var emails *[]api.ScimEmail
+	if user.Email.Valid {
+		emails = &[]api.ScimEmail{
+			{
+				Value:   user.Email.String,
+				Type:    ptr("work"),
+				Primary: ptr(true),
+			},
+		}
+	}
+
+	var externalID *string
+	if user.ExternalID.Valid {
+		externalID = ptr(user.ExternalID.String)
+	}
+
+	return api.ScimUser{
+		Schemas:     &[]string{scimSchemaUser},
+		Id:          ptr(user.ID.String()),
+		ExternalId:  externalID,
+		UserName:    user.Email.String,
+		Name:        nil,
+		DisplayName: ptr(user.DisplayName),
+		Active:      ptr(!user.Disabled),
+		Emails:      emails,
+		Groups:      &groups,
+		Meta: &api.ScimMeta{
+			ResourceType: ptr("User"),
+			Created:      ptr(user.CreatedAt.Time),
+			LastModified: ptr(user.UpdatedAt.Time),
+			Location:     ctrl.scimLocation("Users", user.ID.String()),
+		},
+	}
+}
+
+func (ctrl *Controller) scimGroup(role string, members []sql.GetRoleUsersRow) api.ScimGroup {
+	group := api.ScimGroup{
+		Schemas:     &[]string{scimSchemaGroup},
+		Id:          ptr(role),
+		DisplayName: role,
+		Members:     nil,
+		Meta: &api.ScimMeta{
+			ResourceType: ptr("Group"),
+			Created:      nil,
+			LastModified: nil,
+			Location:     ctrl.scimLocation("Groups", role),
+		},
+	}
+
+	if members != nil {
+		scimMembers := make([]api.ScimMember, len(members))
+		for i, member := range members {
+			display := member.DisplayName
+			if display == "" {
+				display = member.Email.String
+			}
+			scimMembers[i] = api.ScimMember{
+				Value:   member.ID.String(),
+				Display: ptr(display),
+			}
+		}
+		group.Members = &scimMembers
+	}
+
+	return group
+}
+
+func (ctrl *Controller) getScimGroup(
+	ctx context.Context, role string, logger *slog.Logger,
+) (api.ScimGroup, *APIError) {
+	members, apiErr := ctrl.wf.GetScimGroupMembers(ctx, role, logger)
+	if apiErr != nil {
+		return api.ScimGroup{}, apiErr //nolint:exhaustruct
+	}
+	if members == nil {
+		members = []sql.GetRoleUsersRow{}
+	}
+
+	return ctrl.scimGroup(role, members), nil
+}
+
+// scimUserDisplayName returns the display name of the user, built from its name if it
+// isn't set.
+func scimUserDisplayName(user api.ScimUser, email string) string {
+	if user.DisplayName != nil && *user.DisplayName != "" {
+		return *user.DisplayName
+	}
+
+	if user.Name != nil {
+		if user.Name.Formatted != nil && *user.Name.Formatted != "" {
+			return *user.Name.Formatted
+		}
+		name := strings.TrimSpace(deptr(user.Name.GivenName) + " " + deptr(user.Name.FamilyName))
+		if name != "" {
+			return name
+		}
+	}
+
+	return email
+}
+
+// scimUserInputFromUser returns the attributes of AuthUser set by the SCIM user. The
+// primary email replaces the userName as the email of the user.
+func scimUserInputFromUser(user api.ScimUser) (scimUserInput, error) {
+	email := user.UserName
+	for _, e := range deptr(user.Emails) {
+		if deptr(e.Primary) {
+			email = e.Value
+			break
+		}
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return scimUserInput{}, fmt.Errorf( //nolint:exhaustruct
+			"%w: %s isn't a valid email", errInvalidScimValue, email,
+		)
+	}
+
+	input := scimUserInput{
+		email:       email,
+		displayName: scimUserDisplayName(user, email),
+		externalID:  sql.NullableText(deptr(user.ExternalId)),
+		disabled:    user.Active != nil && !*user.Active,
+	}
+
+	return input, nil
+}
+
+// scimPatchAttribute returns the attribute of api.ScimUser the path of a patch operation
+// refers to, or an empty string if the attribute isn't stored.
+func scimPatchAttribute(path string) string {
+	path = strings.ToLower(path)
+	path = strings.TrimPrefix(path, strings.ToLower(scimSchemaUser)+":")
+
+	if path == "emails.value" || strings.HasPrefix(path, "emails[") {
+		return "emails.value"
+	}
+
+	return scimUserAttributes[path]
+}
+
+// scimPatchValue normalizes the value of an attribute. Some identity providers send
+// booleans as strings, i.e. "False".
+func scimPatchValue(attribute string, value any) (any, error) {
+	switch attribute {
+	case "active":
+		if s, ok := value.(string); ok {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, fmt.Errorf("%w: active must be a boolean", errInvalidScimValue)
+			}
+			return b, nil
+		}
+	case "emails.value":
+		return []any{map[string]any{"value": value, "type": "work", "primary": true}}, nil
+	}
+
+	return value, nil
+}
+
+func scimPatchSet(user map[string]any, path string, value any, remove bool) error {
+	attribute := scimPatchAttribute(path)
+	if attribute == "" {
+		return nil
+	}
+
+	value, err := scimPatchValue(attribute, value)
+	if err != nil {
+		return err
+	}
+	if attribute == "emails.value" {
+		attribute = "emails"
+	}
+
+	parent, child, ok := strings.Cut(attribute, ".")
+	if !ok {
+		if remove {
+			delete(user, attribute)
+		} else {
+			user[attribute] = value
+		}
+		return nil
+	}
+
+	name, _ := user[parent].(map[string]any)
+	if name == nil {
+		name = map[string]any{}
+	}
+	if remove {
+		delete(name, child)
+	} else {
+		name[child] = value
+	}
+	user[parent] = name
+
+	return nil
+}
+
+// scimPatchUser applies the operations of a patch request to the user. Operations on
+// attributes that aren't stored are ignored.
+func scimPatchUser(user api.ScimUser, operations []api.ScimPatchOperation) (api.ScimUser, error) {
+	b, err := json.Marshal(user)
+	if err != nil {
+		return api.ScimUser{}, fmt.Errorf("error marshalling user: %w", err) //nolint:exhaustruct
+	}
+	attributes := map[string]any{}
+	if err := json.Unmarshal(b, &attributes); err != nil {
+		return api.ScimUser{}, fmt.Errorf("error unmarshalling user: %w", err) //nolint:exhaustruct
+	}
+
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return api.ScimUser{}, fmt.Errorf( //nolint:exhaustruct
+				"%w: unknown operation %s", errInvalidScimValue, operation.Op,
+			)
+		}
+
+		var value any
+		if operation.Value != nil {
+			value = *operation.Value
+		}
+
+		if path := deptr(operation.Path); path != "" {
+			if err := scimPatchSet(attributes, path, value, op == "remove"); err != nil {
+				return api.ScimUser{}, err //nolint:exhaustruct
+			}
+			continue
+		}
+
+		values, ok := value.(map[string]any)
+		if !ok {
+			return api.ScimUser{}, fmt.Errorf( //nolint:exhaustruct
+				"%w: operations without path need an object as value", errInvalidScimValue,
+			)
+		}
+		for path, value := range values {
+			if err := scimPatchSet(attributes, path, value, op == "remove"); err != nil {
+				return api.ScimUser{}, err //nolint:exhaustruct
+			}
+		}
+	}
+
+	b, err = json.Marshal(attributes)
+	if err != nil {
+		return api.ScimUser{}, fmt.Errorf("error marshalling user: %w", err) //nolint:exhaustruct
+	}
+	var patched api.ScimUser
+	if err := json.Unmarshal(b, &patched); err != nil {
+		return api.ScimUser{}, fmt.Errorf("%w: %w", errInvalidScimValue, err) //nolint:exhaustruct
+	}
+
+	return patched, nil
+}
+
+// scimMemberIDs returns the IDs of the users in the members of a group.
+func scimMemberIDs(members []api.ScimMember) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		id, err := uuid.Parse(member.Value)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%w: member %s isn't a user id", errInvalidScimValue, member.Value,
+			)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// scimPatchMembers returns the members of the value of a patch operation.
+func scimPatchMembers(value *any) ([]uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(*value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling members: %w", err)
+	}
+	var members []api.ScimMember
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, fmt.Errorf("%w: members must be a list of members", errInvalidScimValue)
+	}
+
+	return scimMemberIDs(members)
+}
@@ -1,18 +1,181 @@
 package controller
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"strings"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// verifyHashPassword checks the password against a bcrypt hash, or an argon2 hash for users
+// imported from other providers.
 func verifyHashPassword(password, hash string) bool {
+	if strings.HasPrefix(hash, "$argon2") {
+		return verifyArgon2Password(password, hash)
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
 
+var errInvalidArgon2Hash = errors.New("invalid argon2 hash")
+
+// The most memory, in KiB, passes and key length, in bytes, an argon2 hash can require to
+// be verified so a hash can't exhaust the memory or the CPU of the service.
+const (
+	Argon2MaxMemory     = 1 << 20
+	Argon2MaxIterations = 16
+	Argon2MaxKeyLength  = 64
+)
+
+// argon2Hash is a hash in the PHC string format: $argon2id$v=19$m=65536,t=3,p=4$salt$hash.
+type argon2Hash struct {
+	variant string
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func parseArgon2Hash(hash string) (argon2Hash, error) {
+	var h argon2Hash
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" { //nolint:mnd
+		return h, errInvalidArgon2Hash
+	}
+
+	h.variant = parts[1]
+	if h.variant != "argon2id" && h.variant != "argon2i" {
+		return h, fmt.Errorf("%w: unsupported variant %s", errInvalidArgon2Hash, h.variant)
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return h, fmt.Errorf("%w: unsupported version %s", errInvalidArgon2Hash, parts[2])
+	}
+
+	var threads uint
+	if _, err := fmt.Sscanf(
+		parts[3], "m=%d,t=%d,p=%d", &h.memory, &h.time, &threads,
+	); err != nil || threads == 0 || threads > math.MaxUint8 || h.memory > Argon2MaxMemory ||
+		h.time == 0 || h.time > Argon2MaxIterations {
+		return h, fmt.Errorf("%w: invalid parameters %s", errInvalidArgon2Hash, parts[3])
+	}
+	h.threads = uint8(threads)
+
+	var err error
+	if h.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return h, fmt.Errorf("%w: invalid salt: %w", errInvalidArgon2Hash, err)
+	}
+	if h.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(h.key) == 0 ||
+		len(h.key) > Argon2MaxKeyLength {
+		return h, fmt.Errorf("%w: invalid key", errInvalidArgon2Hash)
+	}
+
+	return h, nil
+}
+
+// validPasswordHash returns whether the hash is a bcrypt hash or an argon2 hash that can be
+// verified within Argon2MaxMemory, Argon2MaxIterations and Argon2MaxKeyLength.
+func validPasswordHash(hash string) bool {
+	if _, err := parseArgon2Hash(hash); err == nil {
+		return true
+	}
+	_, err := bcrypt.Cost([]byte(hash))
+	return err == nil
+}
+
+func verifyArgon2Password(password, hash string) bool {
+	h, err := parseArgon2Hash(hash)
+	if err != nil {
+		return false
+	}
+
+	keyLen := uint32(len(h.key)) //nolint:gosec
+	var key []byte
+	if h.variant == "argon2id" {
+		key = argon2.IDKey([]byte(password), h.salt, h.time, h.memory, h.threads, keyLen)
+	} else {
+		key = argon2.Key([]byte(password), h.salt, h.time, h.memory, h.threads, keyLen)
+	}
+
+	return subtle.ConstantTimeCompare(key, h.key) == 1
+}
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// hashArgon2idPassword hashes the password with argon2id in the PHC string format.
+func hashArgon2idPassword(
+	password string, memory uint32, iterations uint32, threads uint8,
+) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("error generating salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, iterations, memory, threads, argon2KeyLength)
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		memory,
+		iterations,
+		threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// pepperedHashPrefix marks the hashes of peppered passwords, followed by the id of the
+// pepper and the hash itself: $pepper$<id>$2a$10$...
+const pepperedHashPrefix = "$pepper$"
+
+// pepperPassword returns the HMAC-SHA256 of the password keyed with the pepper, encoded so
+// it is short enough to be hashed with bcrypt.
+func pepperPassword(password string, pepper string) string {
+	mac := hmac.New(sha256.New, []byte(pepper))
+	mac.Write([]byte(password))
+	return base64.RawStdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func pepperedHash(pepperID string, hash string) string {
+	return pepperedHashPrefix + pepperID + hash
+}
+
+// parsePepperedHash returns the id of the pepper and the hash of a peppered hash, hashes
+// that aren't peppered are returned as they are.
+func parsePepperedHash(hash string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(hash, pepperedHashPrefix)
+	if !ok {
+		return "", hash, false
+	}
+
+	i := strings.Index(rest, "$")
+	if i <= 0 {
+		return "", hash, false
+	}
+
+	return rest[:i], rest[i:], true
+}
+
 func hashPassword(password string) (string, error) {
 	if password == "" {
 		return "", nil
@@ -29,3 +192,118 @@ func hashRefreshToken(token []byte) string {
 	hash := sha256.Sum256(token)
 	return "\\x" + hex.EncodeToString(hash[:])
 }
+
+func generateOTP() (string, string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000)) //nolint:mnd
+	if err != nil {
+		return "", "", fmt.Errorf("error generating OTP: %w", err)
+	}
+
+	otp := fmt.Sprintf("%06d", n.Int64())
+	hash, err := hashPassword(otp)
+	if err != nil {
+		return "", "", err
+	}
+
+	return otp, hash, nil
+}
+
+const (
+	recoveryCodesCount    = 10
+	recoveryCodeLength    = 10
+	recoveryCodeAlphabet  = "abcdefghjkmnpqrstuvwxyz23456789"
+	recoveryCodeSeparator = "-"
+)
+
+// generateRecoveryCodes returns a set of one-time recovery codes and their
+// hashes. Only the hashes should be persisted.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodesCount)
+	hashes := make([]string, recoveryCodesCount)
+
+	alphabetLength := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := range codes {
+		var b strings.Builder
+		for j := range recoveryCodeLength {
+			if j == recoveryCodeLength/2 {
+				b.WriteString(recoveryCodeSeparator)
+			}
+
+			n, err := rand.Int(rand.Reader, alphabetLength)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error generating recovery code: %w", err)
+			}
+			b.WriteByte(recoveryCodeAlphabet[n.Int64()])
+		}
+
+		codes[i] = b.String()
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode normalizes the code so it doesn't matter how the user
+// typed it before hashing it.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, recoveryCodeSeparator, "")
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
+
+const (
+	// user codes use the alphabet recommended by RFC 8628 which avoids vowels so codes can't
+	// spell words and characters that are easily confused
+	userCodeLength    = 8
+	userCodeAlphabet  = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeSeparator = "-"
+)
+
+// generateUserCode returns a user code for the device authorization grant formatted to be
+// displayed, i.e. WDJB-MJHT.
+func generateUserCode() (string, error) {
+	alphabetLength := big.NewInt(int64(len(userCodeAlphabet)))
+
+	var b strings.Builder
+	for i := range userCodeLength {
+		if i == userCodeLength/2 {
+			b.WriteString(userCodeSeparator)
+		}
+
+		n, err := rand.Int(rand.Reader, alphabetLength)
+		if err != nil {
+			return "", fmt.Errorf("error generating user code: %w", err)
+		}
+		b.WriteByte(userCodeAlphabet[n.Int64()])
+	}
+
+	return b.String(), nil
+}
+
+// normalizeUserCode removes the formatting of the user code so it doesn't matter how the
+// user typed it.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	return strings.ReplaceAll(code, userCodeSeparator, "")
+}
+
+const clientSecretLength = 32
+
+// generateClientSecret returns a random secret for an OAuth2 client.
+func generateClientSecret() (string, error) {
+	b := make([]byte, clientSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating client secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// verifyCodeChallenge checks the PKCE code verifier matches the S256 code challenge
+// (RFC 7636 section 4.6).
+func verifyCodeChallenge(challenge, verifier string) bool {
+	hash := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare(
+		[]byte(base64.RawURLEncoding.EncodeToString(hash[:])), []byte(challenge),
+	) == 1
+}
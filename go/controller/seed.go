@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+var ErrInvalidSeed = errors.New("invalid seed")
+
+// Seed has the roles, users and sessions development environments and CI start with.
+type Seed struct {
+	Roles []string   `json:"roles"`
+	Users []SeedUser `json:"users"`
+}
+
+// SeedUser is a user of the seed. Its id is required so the user is the same every time.
+// RefreshTokens are the refresh tokens of sessions of the user, so tests can get an
+// access token without signing in.
+type SeedUser struct {
+	ID            uuid.UUID      `json:"id"`
+	Email         string         `json:"email"`
+	Password      string         `json:"password"`
+	EmailVerified bool           `json:"emailVerified"`
+	DisplayName   string         `json:"displayName"`
+	Locale        string         `json:"locale"`
+	DefaultRole   string         `json:"defaultRole"`
+	Roles         []string       `json:"roles"`
+	Metadata      map[string]any `json:"metadata"`
+	RefreshTokens []uuid.UUID    `json:"refreshTokens"`
+}
+
+func (ctrl *Controller) seedRoles(ctx context.Context, seed Seed) ([]string, error) {
+	for _, role := range seed.Roles {
+		if _, err := ctrl.wf.db.InsertRole(ctx, role); err != nil {
+			return nil, fmt.Errorf("error inserting role %s: %w", role, err)
+		}
+	}
+
+	catalog, err := ctrl.wf.db.GetRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting roles: %w", err)
+	}
+	roles := make([]string, len(catalog))
+	for i, role := range catalog {
+		roles[i] = role.Role
+	}
+
+	return roles, nil
+}
+
+// seedSessions inserts the refresh tokens of the user that aren't valid anymore, i.e.
+// because they expired or were rotated.
+func (ctrl *Controller) seedSessions(ctx context.Context, user SeedUser, logger *slog.Logger) error {
+	for _, token := range user.RefreshTokens {
+		_, err := ctrl.wf.db.GetRefreshTokenByHash(ctx, hashRefreshToken([]byte(token.String())))
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("error getting refresh token: %w", err)
+		}
+
+		if _, apiErr := ctrl.wf.InsertRefreshtoken(
+			ctx,
+			user.ID,
+			token.String(),
+			ctrl.wf.sessionLifetimes(ctx),
+			sql.RefreshTokenTypeRegular,
+			nil,
+			logger,
+		); apiErr != nil {
+			return fmt.Errorf("error inserting refresh token of %s: %w", user.Email, apiErr)
+		}
+	}
+
+	return nil
+}
+
+// Seed creates the roles and users of the seed that don't exist yet, users that already
+// exist are left as they are. It can run on every start as its sessions are only added
+// back if they aren't valid anymore.
+func (ctrl *Controller) Seed(ctx context.Context, seed Seed, logger *slog.Logger) error { //nolint:funlen
+	roles, err := ctrl.seedRoles(ctx, seed)
+	if err != nil {
+		return err
+	}
+
+	batch := newImportUsersBatch()
+	for i, seedUser := range seed.Users {
+		if seedUser.ID == uuid.Nil {
+			return fmt.Errorf("%w: user %d doesn't have an id", ErrInvalidSeed, i)
+		}
+		if _, ok := batch.lines[seedUser.ID]; ok {
+			return fmt.Errorf("%w: user %s is duplicated", ErrInvalidSeed, seedUser.ID)
+		}
+
+		user, err := ctrl.prepareImportUser(importUser{ //nolint:exhaustruct
+			ID:            &seedUser.ID,
+			Email:         seedUser.Email,
+			EmailVerified: seedUser.EmailVerified,
+			DisplayName:   seedUser.DisplayName,
+			Locale:        seedUser.Locale,
+			DefaultRole:   seedUser.DefaultRole,
+			Roles:         seedUser.Roles,
+			Metadata:      seedUser.Metadata,
+		}, roles)
+		if err != nil {
+			return fmt.Errorf("%w: user %s: %w", ErrInvalidSeed, seedUser.ID, err)
+		}
+
+		// hashed after the checks of the import, they don't know about peppers
+		user.PasswordHash, err = ctrl.wf.hashUserPassword(seedUser.Password)
+		if err != nil {
+			return fmt.Errorf("error hashing password of %s: %w", seedUser.Email, err)
+		}
+
+		metadata, err := json.Marshal(user.Metadata)
+		if err != nil {
+			return fmt.Errorf("%w: user %s: invalid metadata: %w", ErrInvalidSeed, seedUser.ID, err)
+		}
+
+		batch.add(i, seedUser.ID, user, metadata, time.Now())
+	}
+
+	inserted := 0
+	if len(batch.params.Ids) > 0 {
+		ids, err := ctrl.wf.db.ImportUsers(ctx, batch.params)
+		if err != nil {
+			return fmt.Errorf("error inserting users: %w", err)
+		}
+		inserted = len(ids)
+	}
+
+	for _, user := range seed.Users {
+		if len(user.RefreshTokens) == 0 {
+			continue
+		}
+
+		// users whose email belongs to another user are skipped by the import
+		if _, err := ctrl.wf.db.GetUser(ctx, user.ID); err != nil {
+			return fmt.Errorf("error getting user %s, is its email used by another user? %w", user.ID, err)
+		}
+
+		if err := ctrl.seedSessions(ctx, user, logger); err != nil {
+			return err
+		}
+	}
+
+	logger.Info(
+		"seed applied",
+		slog.Int("roles", len(seed.Roles)),
+		slog.Int("users", len(seed.Users)),
+		slog.Int("inserted_users", inserted),
+	)
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestSeed(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	userID := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+	refreshToken := uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb")
+
+	seed := controller.Seed{
+		Roles: []string{"editor"},
+		Users: []controller.SeedUser{
+			{
+				ID:            userID,
+				Email:         "jane@acme.com",
+				Password:      "password",
+				EmailVerified: true,
+				DisplayName:   "Jane",
+				Locale:        "",
+				DefaultRole:   "editor",
+				Roles:         []string{"user", "editor"},
+				Metadata:      map[string]any{"plan": "pro"},
+				RefreshTokens: []uuid.UUID{refreshToken},
+			},
+		},
+	}
+
+	seedRoles := func(mock *mock.MockDBClient) {
+		mock.EXPECT().InsertRole(gomock.Any(), "editor").Return(int64(1), nil)
+		mock.EXPECT().GetRoles(gomock.Any()).Return([]sql.GetRolesRow{
+			{Role: "editor", Users: 0},
+			{Role: "me", Users: 0},
+			{Role: "user", Users: 0},
+		}, nil)
+	}
+
+	importUsers := func(mock *mock.MockDBClient, inserted []uuid.UUID) {
+		mock.EXPECT().ImportUsers(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, arg sql.ImportUsersParams) ([]uuid.UUID, error) {
+				if err := bcrypt.CompareHashAndPassword(
+					[]byte(arg.PasswordHashes[0]), []byte("password"),
+				); err != nil {
+					t.Errorf("unexpected password hash: %v", err)
+				}
+
+				if diff := cmp.Diff(
+					sql.ImportUsersParams{ //nolint:exhaustruct
+						Ids:                 []uuid.UUID{userID},
+						Emails:              []string{"jane@acme.com"},
+						EmailVerified:       []bool{true},
+						PhoneNumbers:        []string{""},
+						PhoneNumberVerified: []bool{false},
+						DisplayNames:        []string{"Jane"},
+						AvatarUrls:          []string{""},
+						Locales:             []string{"en"},
+						DefaultRoles:        []string{"editor"},
+						Metadata:            [][]byte{[]byte(`{"plan":"pro"}`)},
+						Disabled:            []bool{false},
+						RoleUserIds:         []uuid.UUID{userID, userID},
+						Roles:               []string{"user", "editor"},
+					},
+					arg,
+					cmpopts.IgnoreFields(sql.ImportUsersParams{}, "CreatedAts", "PasswordHashes"), //nolint:exhaustruct
+				); diff != "" {
+					t.Errorf("unexpected import (-want +got):\n%s", diff)
+				}
+
+				return inserted, nil
+			},
+		)
+	}
+
+	cases := []struct {
+		name    string
+		seed    controller.Seed
+		db      func(ctrl *gomock.Controller) controller.DBClient
+		wantErr error
+	}{
+		{
+			name: "new user",
+			seed: seed,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				seedRoles(mock)
+				importUsers(mock, []uuid.UUID{userID})
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetRefreshTokenByHash(gomock.Any(), gomock.Any()).Return(
+					sql.AuthRefreshToken{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+				mock.EXPECT().InsertRefreshtoken(gomock.Any(), cmpDBParams(
+					sql.InsertRefreshtokenParams{ //nolint:exhaustruct
+						UserID:           userID,
+						RefreshTokenHash: `\x` + "599fdbcf74512dc82de350b7a188b754650134a9ea7f65bc994a5fcf0e1edf9c",
+						Type:             sql.RefreshTokenTypeRegular,
+					},
+					cmpopts.IgnoreFields(sql.InsertRefreshtokenParams{}, "ExpiresAt"), //nolint:exhaustruct
+				)).Return(uuid.New(), nil)
+
+				return mock
+			},
+			wantErr: nil,
+		},
+		{
+			name: "existing user and session",
+			seed: seed,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				seedRoles(mock)
+				importUsers(mock, []uuid.UUID{})
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetRefreshTokenByHash(gomock.Any(), gomock.Any()).Return(
+					sql.AuthRefreshToken{}, nil, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			wantErr: nil,
+		},
+		{
+			name: "email of another user",
+			seed: seed,
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+
+				seedRoles(mock)
+				importUsers(mock, []uuid.UUID{})
+
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+
+				return mock
+			},
+			wantErr: pgx.ErrNoRows,
+		},
+		{
+			name: "missing id",
+			seed: controller.Seed{
+				Roles: []string{"editor"},
+				Users: []controller.SeedUser{{Email: "jane@acme.com"}}, //nolint:exhaustruct
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				seedRoles(mock)
+				return mock
+			},
+			wantErr: controller.ErrInvalidSeed,
+		},
+		{
+			name: "unknown role",
+			seed: controller.Seed{
+				Roles: []string{"editor"},
+				Users: []controller.SeedUser{
+					{ID: userID, Email: "jane@acme.com", Roles: []string{"admin"}, DefaultRole: "admin"}, //nolint:exhaustruct,lll
+				},
+			},
+			db: func(ctrl *gomock.Controller) controller.DBClient {
+				mock := mock.NewMockDBClient(ctrl)
+				seedRoles(mock)
+				return mock
+			},
+			wantErr: controller.ErrInvalidSeed,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			c, _ := getController(t, ctrl, getConfig, tc.db, getControllerOpts{
+				customClaimer:    nil,
+				emailer:          nil,
+				hibp:             nil,
+				sms:              nil,
+				providers:        nil,
+				idTokenProviders: nil,
+				saml:             nil,
+				rateLimiter:      nil,
+				captcha:          nil,
+				webhooks:         nil,
+				preSignUpHook:    nil,
+				disposableEmails: nil,
+				avatarStorage:    nil,
+				geoIP:            nil,
+				riskEngine:       nil,
+				configReloader:   nil,
+				signInMethods:    nil,
+			})
+
+			err := c.Seed(context.Background(), tc.seed, slog.Default())
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// Sign in methods that can be disabled at runtime, providers are "provider-" followed by
+// their id.
+const (
+	signInMethodEmailPassword     = "email-password"
+	signInMethodAnonymous         = "anonymous"
+	signInMethodPasswordlessEmail = "passwordless-email"
+	signInMethodPasswordlessSMS   = "passwordless-sms"
+	signInMethodWebauthn          = "webauthn"
+)
+
+func providerSignInMethod(providerID string) string {
+	return "provider-" + providerID
+}
+
+type DBClientSignInMethods interface {
+	GetSignInMethods(ctx context.Context) ([]sql.AuthSignInMethod, error)
+	UpsertSignInMethod(
+		ctx context.Context, arg sql.UpsertSignInMethodParams,
+	) (sql.AuthSignInMethod, error)
+}
+
+// SignInMethodFlags enables or disables sign in methods at runtime with the rows of
+// auth.sign_in_methods, overriding the configuration. The rows are kept in memory for ttl,
+// they are dropped when they are changed through it and Invalidate drops the ones changed
+// elsewhere, i.e. by another instance.
+type SignInMethodFlags struct {
+	db        DBClientSignInMethods
+	ttl       time.Duration
+	mu        sync.Mutex
+	flags     map[string]bool
+	expiresAt time.Time
+	// generation changes with every invalidation so flags read before it aren't cached
+	generation uint64
+}
+
+func NewSignInMethodFlags(db DBClientSignInMethods, ttl time.Duration) *SignInMethodFlags {
+	return &SignInMethodFlags{
+		db:         db,
+		ttl:        ttl,
+		mu:         sync.Mutex{},
+		flags:      nil,
+		expiresAt:  time.Time{},
+		generation: 0,
+	}
+}
+
+// Invalidate drops the flags so they are read again from the database.
+func (f *SignInMethodFlags) Invalidate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.flags = nil
+	f.generation++
+}
+
+// Flags returns whether the methods with a flag are enabled, keyed by method. The map
+// must not be modified.
+func (f *SignInMethodFlags) Flags(ctx context.Context) (map[string]bool, error) {
+	f.mu.Lock()
+	if f.flags != nil && time.Now().Before(f.expiresAt) {
+		flags := f.flags
+		f.mu.Unlock()
+		return flags, nil
+	}
+	generation := f.generation
+	f.mu.Unlock()
+
+	rows, err := f.db.GetSignInMethods(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sign in methods: %w", err)
+	}
+
+	flags := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		flags[row.Method] = row.Enabled
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if generation == f.generation {
+		f.flags = flags
+		f.expiresAt = time.Now().Add(f.ttl)
+	}
+
+	return flags, nil
+}
+
+// Set enables or disables the method.
+func (f *SignInMethodFlags) Set(ctx context.Context, method string, enabled bool) error {
+	if _, err := f.db.UpsertSignInMethod(ctx, sql.UpsertSignInMethodParams{
+		Method:  method,
+		Enabled: enabled,
+	}); err != nil {
+		return fmt.Errorf("error updating sign in method: %w", err)
+	}
+
+	f.Invalidate()
+
+	return nil
+}
+
+// configuredSignInMethods returns the sign in methods and whether they are enabled in the
+// configuration.
+func (ctrl *Controller) configuredSignInMethods() map[string]bool {
+	methods := map[string]bool{
+		signInMethodEmailPassword:     true,
+		signInMethodAnonymous:         ctrl.config.AnonymousUsersEnabled,
+		signInMethodPasswordlessEmail: ctrl.config.EmailPasswordlessEnabled,
+		signInMethodPasswordlessSMS:   ctrl.config.SMSPasswordlessEnabled,
+		signInMethodWebauthn:          ctrl.config.WebauthnEnabled,
+	}
+	for providerID := range ctrl.oauthProviders {
+		methods[providerSignInMethod(providerID)] = true
+	}
+	for providerID := range ctrl.idTokenProviders {
+		methods[providerSignInMethod(providerID)] = true
+	}
+
+	return methods
+}
+
+// checkSignInMethod returns ErrDisabledSignInMethod if the method was disabled at runtime.
+// Methods disabled in the configuration are rejected by their endpoints.
+func (ctrl *Controller) checkSignInMethod(
+	ctx context.Context, method string, logger *slog.Logger,
+) *APIError {
+	if ctrl.signInMethods == nil {
+		return nil
+	}
+
+	flags, err := ctrl.signInMethods.Flags(ctx)
+	if err != nil {
+		logger.Error("error getting sign in method flags", logError(err))
+		return ErrInternalServerError
+	}
+
+	if enabled, ok := flags[method]; ok && !enabled {
+		logger.Warn("sign in method is disabled", slog.String("method", method))
+		return ErrDisabledSignInMethod
+	}
+
+	return nil
+}
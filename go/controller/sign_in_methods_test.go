@@ -0,0 +1,201 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSignInMethodFlags(t *testing.T) {
+	t.Parallel()
+
+	rows := []sql.AuthSignInMethod{
+		{Method: "anonymous", Enabled: false},      //nolint:exhaustruct
+		{Method: "provider-github", Enabled: true}, //nolint:exhaustruct
+	}
+	flags := map[string]bool{
+		"anonymous":       false,
+		"provider-github": true,
+	}
+
+	cases := []struct {
+		name string
+		ttl  time.Duration
+		db   func(ctrl *gomock.Controller) controller.DBClientSignInMethods
+		run  func(ctx context.Context, t *testing.T, f *controller.SignInMethodFlags)
+	}{
+		{
+			name: "cached",
+			ttl:  time.Minute,
+			db: func(ctrl *gomock.Controller) controller.DBClientSignInMethods {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetSignInMethods(gomock.Any()).Return(rows, nil).Times(1)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, f *controller.SignInMethodFlags) {
+				t.Helper()
+				for range 3 {
+					got, err := f.Flags(ctx)
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if diff := cmp.Diff(flags, got); diff != "" {
+						t.Errorf("unexpected flags (-want +got):\n%s", diff)
+					}
+				}
+			},
+		},
+		{
+			name: "expired",
+			ttl:  -time.Second,
+			db: func(ctrl *gomock.Controller) controller.DBClientSignInMethods {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetSignInMethods(gomock.Any()).Return(rows, nil).Times(2)
+				return mock
+			},
+			run: func(ctx context.Context, _ *testing.T, f *controller.SignInMethodFlags) {
+				_, _ = f.Flags(ctx)
+				_, _ = f.Flags(ctx)
+			},
+		},
+		{
+			name: "invalidated",
+			ttl:  time.Minute,
+			db: func(ctrl *gomock.Controller) controller.DBClientSignInMethods {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetSignInMethods(gomock.Any()).Return(rows, nil).Times(2)
+				return mock
+			},
+			run: func(ctx context.Context, _ *testing.T, f *controller.SignInMethodFlags) {
+				_, _ = f.Flags(ctx)
+				f.Invalidate()
+				_, _ = f.Flags(ctx)
+			},
+		},
+		{
+			name: "set",
+			ttl:  time.Minute,
+			db: func(ctrl *gomock.Controller) controller.DBClientSignInMethods {
+				mock := mock.NewMockDBClient(ctrl)
+				mock.EXPECT().GetSignInMethods(gomock.Any()).Return(rows, nil).Times(1)
+				mock.EXPECT().UpsertSignInMethod(
+					gomock.Any(),
+					sql.UpsertSignInMethodParams{Method: "anonymous", Enabled: true},
+				).Return(sql.AuthSignInMethod{}, nil) //nolint:exhaustruct
+				mock.EXPECT().GetSignInMethods(gomock.Any()).Return(
+					[]sql.AuthSignInMethod{
+						{Method: "anonymous", Enabled: true},       //nolint:exhaustruct
+						{Method: "provider-github", Enabled: true}, //nolint:exhaustruct
+					},
+					nil,
+				).Times(1)
+				return mock
+			},
+			run: func(ctx context.Context, t *testing.T, f *controller.SignInMethodFlags) {
+				t.Helper()
+				_, _ = f.Flags(ctx)
+
+				if err := f.Set(ctx, "anonymous", true); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				got, err := f.Flags(ctx)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !got["anonymous"] {
+					t.Errorf("expected anonymous to be enabled")
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			tc.run(context.Background(), t, controller.NewSignInMethodFlags(tc.db(ctrl), tc.ttl))
+		})
+	}
+}
+
+func TestSignInMethodDisabled(t *testing.T) {
+	t.Parallel()
+
+	getConfig := func() *controller.Config {
+		config := getConfig()
+		config.AnonymousUsersEnabled = true
+		return config
+	}
+
+	expectedResponse := controller.ErrorResponse{
+		Error:   "disabled-sign-in-method",
+		Message: "This sign in method is disabled",
+		Status:  409,
+	}
+
+	ctrl := gomock.NewController(t)
+
+	flagsDB := mock.NewMockDBClient(ctrl)
+	flagsDB.EXPECT().GetSignInMethods(gomock.Any()).Return(
+		[]sql.AuthSignInMethod{
+			{Method: "anonymous", Enabled: false},      //nolint:exhaustruct
+			{Method: "email-password", Enabled: false}, //nolint:exhaustruct
+		},
+		nil,
+	).Times(1)
+
+	c, _ := getController(
+		t,
+		ctrl,
+		getConfig,
+		func(ctrl *gomock.Controller) controller.DBClient {
+			return mock.NewMockDBClient(ctrl)
+		},
+		getControllerOpts{
+			customClaimer:    nil,
+			emailer:          nil,
+			hibp:             nil,
+			sms:              nil,
+			providers:        nil,
+			idTokenProviders: nil,
+			saml:             nil,
+			rateLimiter:      nil,
+			captcha:          nil,
+			webhooks:         nil,
+			preSignUpHook:    nil,
+			disposableEmails: nil,
+			avatarStorage:    nil,
+			geoIP:            nil,
+			riskEngine:       nil,
+			configReloader:   nil,
+			signInMethods:    controller.NewSignInMethodFlags(flagsDB, time.Minute),
+		},
+	)
+
+	assertRequest(
+		context.Background(), t, c.PostSigninAnonymous,
+		api.PostSigninAnonymousRequestObject{Body: nil},
+		api.PostSigninAnonymousResponseObject(expectedResponse),
+	)
+
+	assertRequest(
+		context.Background(), t, c.PostSigninEmailPassword,
+		api.PostSigninEmailPasswordRequestObject{
+			Body: &api.SignInEmailPasswordRequest{
+				Email:    "jane@acme.com",
+				Password: "password",
+			},
+		},
+		api.PostSigninEmailPasswordResponseObject(expectedResponse),
+	)
+}
@@ -0,0 +1,247 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// importUsersMaxLineSize is the longest line of an ND-JSON import.
+const importUsersMaxLineSize = 1 << 20
+
+// importUser is a user of an import, see the ImportUser schema of the API.
+type importUser struct {
+	ID                  *uuid.UUID     `json:"id"`
+	Email               string         `json:"email"`
+	EmailVerified       bool           `json:"emailVerified"`
+	PasswordHash        string         `json:"passwordHash"`
+	PhoneNumber         string         `json:"phoneNumber"`
+	PhoneNumberVerified bool           `json:"phoneNumberVerified"`
+	DisplayName         string         `json:"displayName"`
+	AvatarURL           string         `json:"avatarUrl"`
+	Locale              string         `json:"locale"`
+	DefaultRole         string         `json:"defaultRole"`
+	Roles               []string       `json:"roles"`
+	Metadata            map[string]any `json:"metadata"`
+	CreatedAt           *time.Time     `json:"createdAt"`
+	Disabled            bool           `json:"disabled"`
+}
+
+// importRecordError is an error of a single user of the import, the import goes on with
+// the next one.
+type importRecordError struct {
+	err error
+}
+
+func (e *importRecordError) Error() string {
+	return e.err.Error()
+}
+
+func (e *importRecordError) Unwrap() error {
+	return e.err
+}
+
+// importUsersReader reads the users of an import one at a time. It returns io.EOF after the
+// last user, an *importRecordError if the user at the line is invalid or any other error if
+// the import can't go on.
+type importUsersReader interface {
+	Next() (int, importUser, error)
+}
+
+type ndjsonUsersReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newNDJSONUsersReader(r io.Reader) *ndjsonUsersReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), importUsersMaxLineSize)
+	return &ndjsonUsersReader{
+		scanner: scanner,
+		line:    0,
+	}
+}
+
+func (r *ndjsonUsersReader) Next() (int, importUser, error) {
+	var user importUser
+
+	for r.scanner.Scan() {
+		r.line++
+
+		b := bytes.TrimSpace(r.scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&user); err != nil {
+			return r.line, user, &importRecordError{fmt.Errorf("invalid JSON: %w", err)}
+		}
+
+		return r.line, user, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return r.line, user, fmt.Errorf("error reading line %d: %w", r.line+1, err)
+	}
+
+	return r.line, user, io.EOF
+}
+
+type csvUsersReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+func newCSVUsersReader(r io.Reader) (*csvUsersReader, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = false
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %w", err)
+	}
+
+	for _, column := range header {
+		if _, ok := csvUserSetters[column]; !ok {
+			return nil, fmt.Errorf("unknown column %q", column) //nolint:goerr113
+		}
+	}
+
+	return &csvUsersReader{
+		reader: reader,
+		header: header,
+	}, nil
+}
+
+func parseCSVBool(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean: %w", err)
+	}
+	return b, nil
+}
+
+// csvUserSetters set the property of the user of each column of a CSV import.
+var csvUserSetters = map[string]func(*importUser, string) error{ //nolint:gochecknoglobals
+	"id": func(u *importUser, s string) error {
+		if s == "" {
+			return nil
+		}
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return fmt.Errorf("invalid id: %w", err)
+		}
+		u.ID = &id
+		return nil
+	},
+	"email": func(u *importUser, s string) error {
+		u.Email = s
+		return nil
+	},
+	"emailVerified": func(u *importUser, s string) error {
+		var err error
+		u.EmailVerified, err = parseCSVBool(s)
+		return err
+	},
+	"passwordHash": func(u *importUser, s string) error {
+		u.PasswordHash = s
+		return nil
+	},
+	"phoneNumber": func(u *importUser, s string) error {
+		u.PhoneNumber = s
+		return nil
+	},
+	"phoneNumberVerified": func(u *importUser, s string) error {
+		var err error
+		u.PhoneNumberVerified, err = parseCSVBool(s)
+		return err
+	},
+	"displayName": func(u *importUser, s string) error {
+		u.DisplayName = s
+		return nil
+	},
+	"avatarUrl": func(u *importUser, s string) error {
+		u.AvatarURL = s
+		return nil
+	},
+	"locale": func(u *importUser, s string) error {
+		u.Locale = s
+		return nil
+	},
+	"defaultRole": func(u *importUser, s string) error {
+		u.DefaultRole = s
+		return nil
+	},
+	"roles": func(u *importUser, s string) error {
+		if roles := strings.Fields(s); len(roles) > 0 {
+			u.Roles = roles
+		}
+		return nil
+	},
+	"metadata": func(u *importUser, s string) error {
+		if s == "" {
+			return nil
+		}
+		if err := json.Unmarshal([]byte(s), &u.Metadata); err != nil {
+			return fmt.Errorf("invalid metadata: %w", err)
+		}
+		return nil
+	},
+	"createdAt": func(u *importUser, s string) error {
+		if s == "" {
+			return nil
+		}
+		createdAt, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return fmt.Errorf("invalid createdAt: %w", err)
+		}
+		u.CreatedAt = &createdAt
+		return nil
+	},
+	"disabled": func(u *importUser, s string) error {
+		var err error
+		u.Disabled, err = parseCSVBool(s)
+		return err
+	},
+}
+
+func (r *csvUsersReader) Next() (int, importUser, error) {
+	var user importUser
+
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return 0, user, io.EOF
+	}
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.StartLine, user, &importRecordError{err}
+	}
+	if err != nil {
+		return 0, user, fmt.Errorf("error reading CSV: %w", err)
+	}
+
+	line, _ := r.reader.FieldPos(0)
+
+	for i, value := range record {
+		if err := csvUserSetters[r.header[i]](&user, value); err != nil {
+			return line, user, &importRecordError{err}
+		}
+	}
+
+	return line, user, nil
+}
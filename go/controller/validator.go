@@ -2,14 +2,25 @@
 package controller
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"slices"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gobwas/glob"
+	"github.com/nbutton23/zxcvbn-go"
+	"github.com/nhost/hasura-auth/go/ttlcache"
 )
 
+var ErrUnknownCharacterClass = errors.New("unknown character class")
+
 func ValidateRedirectTo( //nolint:cyclop
 	allowedRedirectURLs []string,
 ) (
@@ -73,12 +84,88 @@ func ValidateRedirectTo( //nolint:cyclop
 	}, nil
 }
 
+// emailMatcher matches emails, or their domains, against a list of entries. Entries are
+// compared as they are unless they are a regular expression between slashes, i.e.
+// /^[a-z]+@acme\.com$/, or contain wildcards: * matches any number of characters, dots
+// included, and ? a single character.
+type emailMatcher struct {
+	exact    []string
+	patterns []func(s string) bool
+}
+
+func newEmailMatcher(entries []string) (emailMatcher, error) {
+	m := emailMatcher{
+		exact:    make([]string, 0, len(entries)),
+		patterns: make([]func(s string) bool, 0),
+	}
+
+	for _, entry := range entries {
+		switch {
+		case len(entry) > 2 && strings.HasPrefix(entry, "/") && strings.HasSuffix(entry, "/"):
+			re, err := regexp.Compile("^(?:" + entry[1:len(entry)-1] + ")$")
+			if err != nil {
+				return emailMatcher{}, fmt.Errorf("error compiling regexp %s: %w", entry, err)
+			}
+			m.patterns = append(m.patterns, re.MatchString)
+		case strings.ContainsAny(entry, "*?"):
+			g, err := glob.Compile(entry)
+			if err != nil {
+				return emailMatcher{}, fmt.Errorf("error compiling glob %s: %w", entry, err)
+			}
+			m.patterns = append(m.patterns, g.Match)
+		default:
+			m.exact = append(m.exact, entry)
+		}
+	}
+
+	return m, nil
+}
+
+func (m emailMatcher) empty() bool {
+	return len(m.exact) == 0 && len(m.patterns) == 0
+}
+
+func (m emailMatcher) match(s string) bool {
+	if slices.Contains(m.exact, s) {
+		return true
+	}
+
+	for _, match := range m.patterns {
+		if match(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateEmail returns a function that checks an email against the access control lists.
+// The lists accept the patterns of emailMatcher and are checked in order, the first one
+// matching decides: blocked emails, allowed emails, blocked domains and allowed domains.
+// Emails that don't match any list are only allowed if there are no allow lists.
 func ValidateEmail(
 	blockedEmailDomains []string,
 	blockedEmails []string,
 	allowedEmailDomains []string,
 	allowedEmails []string,
-) func(email string) bool {
+) (func(email string) bool, error) { //nolint:cyclop
+	blocked, err := newEmailMatcher(blockedEmails)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing blocked emails: %w", err)
+	}
+	allowed, err := newEmailMatcher(allowedEmails)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing allowed emails: %w", err)
+	}
+	blockedDomains, err := newEmailMatcher(blockedEmailDomains)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing blocked email domains: %w", err)
+	}
+	allowedDomains, err := newEmailMatcher(allowedEmailDomains)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing allowed email domains: %w", err)
+	}
+
 	return func(email string) bool {
 		parts := strings.Split(email, "@")
 		if len(parts) != 2 { //nolint:mnd
@@ -86,22 +173,252 @@ func ValidateEmail(
 		}
 		domain := parts[1]
 
-		if slices.Contains(blockedEmails, email) {
+		if blocked.match(email) {
 			return false
 		}
 
-		if slices.Contains(allowedEmails, email) {
+		if allowed.match(email) {
 			return true
 		}
 
-		if slices.Contains(blockedEmailDomains, domain) {
+		if blockedDomains.match(domain) {
 			return false
 		}
 
-		if slices.Contains(allowedEmailDomains, domain) {
+		if allowedDomains.match(domain) {
 			return true
 		}
 
-		return len(allowedEmailDomains) == 0 && len(allowedEmails) == 0
+		return allowedDomains.empty() && allowed.empty()
+	}, nil
+}
+
+// minEmailLocalPartLength is the shortest local part of an email that passwords are checked
+// against so addresses like a@acme.com don't reject every password with an a.
+const minEmailLocalPartLength = 3
+
+// PasswordPolicy holds the rules passwords are checked against. Zero values disable
+// the corresponding rule.
+type PasswordPolicy struct {
+	MinLength int
+	MaxLength int
+	// MinScore is the minimum zxcvbn score, from 0 (too guessable) to 4 (very unguessable).
+	MinScore int
+	// RequiredCharacterClasses can include lowercase, uppercase, digit and symbol.
+	RequiredCharacterClasses []string
+	// Denylist contains terms, such as the company name, passwords can't contain.
+	Denylist    []string
+	RejectEmail bool
+}
+
+type passwordRule func(password string, email string) *APIError
+
+func passwordCharacterClassRule(class string) (passwordRule, error) {
+	var (
+		is  func(rune) bool
+		err *APIError
+	)
+	switch class {
+	case "lowercase":
+		is, err = unicode.IsLower, ErrPasswordMissingLowercase
+	case "uppercase":
+		is, err = unicode.IsUpper, ErrPasswordMissingUppercase
+	case "digit":
+		is, err = unicode.IsDigit, ErrPasswordMissingDigit
+	case "symbol":
+		is = func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+		}
+		err = ErrPasswordMissingSymbol
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCharacterClass, class)
+	}
+
+	return func(password string, _ string) *APIError {
+		if !strings.ContainsFunc(password, is) {
+			return err
+		}
+		return nil
+	}, nil
+}
+
+func passwordContainsEmail(password string, email string) bool {
+	password = strings.ToLower(password)
+	email = strings.ToLower(email)
+	if email == "" {
+		return false
+	}
+
+	if strings.Contains(password, email) {
+		return true
+	}
+
+	localPart, _, _ := strings.Cut(email, "@")
+	return len(localPart) >= minEmailLocalPartLength && strings.Contains(password, localPart)
+}
+
+// ValidatePasswordPolicy returns a function that checks a password, and the email of the
+// user it belongs to, against every rule of the policy in order and returns the error of
+// the first rule it fails.
+func ValidatePasswordPolicy( //nolint:cyclop
+	policy PasswordPolicy,
+) (func(password string, email string) *APIError, error) {
+	rules := make([]passwordRule, 0)
+
+	if policy.MinLength > 0 {
+		rules = append(rules, func(password string, _ string) *APIError {
+			if len(password) < policy.MinLength {
+				return ErrPasswordTooShort
+			}
+			return nil
+		})
+	}
+
+	if policy.MaxLength > 0 {
+		rules = append(rules, func(password string, _ string) *APIError {
+			if len(password) > policy.MaxLength {
+				return ErrPasswordTooLong
+			}
+			return nil
+		})
+	}
+
+	for _, class := range policy.RequiredCharacterClasses {
+		rule, err := passwordCharacterClassRule(class)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if len(policy.Denylist) > 0 {
+		denylist := make([]string, len(policy.Denylist))
+		for i, term := range policy.Denylist {
+			denylist[i] = strings.ToLower(term)
+		}
+
+		rules = append(rules, func(password string, _ string) *APIError {
+			password = strings.ToLower(password)
+			if slices.ContainsFunc(denylist, func(term string) bool {
+				return strings.Contains(password, term)
+			}) {
+				return ErrPasswordInDenylist
+			}
+			return nil
+		})
+	}
+
+	if policy.RejectEmail {
+		rules = append(rules, func(password string, email string) *APIError {
+			if passwordContainsEmail(password, email) {
+				return ErrPasswordContainsEmail
+			}
+			return nil
+		})
+	}
+
+	if policy.MinScore > 0 {
+		rules = append(rules, func(password string, email string) *APIError {
+			userInputs := append([]string{email}, policy.Denylist...)
+			if zxcvbn.PasswordStrength(password, userInputs).Score < policy.MinScore {
+				return ErrPasswordTooWeak
+			}
+			return nil
+		})
+	}
+
+	return func(password string, email string) *APIError {
+		for _, rule := range rules {
+			if err := rule(password, email); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// ValidateMetadata returns a function that checks the metadata of a user against the schema,
+// a JSON encoded schema object of OpenAPI 3.0. An empty schema accepts any metadata.
+func ValidateMetadata(rawSchema string) (func(metadata map[string]any) *APIError, error) {
+	if rawSchema == "" {
+		return func(map[string]any) *APIError { return nil }, nil
+	}
+
+	var schema openapi3.Schema
+	if err := json.Unmarshal([]byte(rawSchema), &schema); err != nil {
+		return nil, fmt.Errorf("error parsing metadata schema: %w", err)
+	}
+	if err := schema.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid metadata schema: %w", err)
+	}
+
+	return func(metadata map[string]any) *APIError {
+		if metadata == nil {
+			metadata = map[string]any{}
+		}
+
+		err := schema.VisitJSON(metadata)
+		if err == nil {
+			return nil
+		}
+
+		var schemaErr *openapi3.SchemaError
+		if !errors.As(err, &schemaErr) {
+			return ErrInvalidMetadata
+		}
+		if path := schemaErr.JSONPointer(); len(path) > 0 {
+			return invalidMetadataError(
+				fmt.Sprintf("%s: %s", strings.Join(path, "/"), schemaErr.Reason),
+			)
+		}
+		return invalidMetadataError(schemaErr.Reason)
+	}, nil
+}
+
+// MXResolver looks up the MX records of a domain, net.Resolver implements it.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// mxCacheMaxEntries is the number of domains the results of ValidateEmailMX are kept for.
+const mxCacheMaxEntries = 10000
+
+// ValidateEmailMX returns a function that reports if the domain of an email has MX records,
+// domains without them or with a null MX record (RFC 7505) can't receive emails. Lookups
+// taking longer than timeout fail with an error and their result isn't cached, the others
+// are cached for cacheTTL.
+func ValidateEmailMX(
+	resolver MXResolver, timeout time.Duration, cacheTTL time.Duration,
+) func(ctx context.Context, email string) (bool, error) {
+	cache := ttlcache.New[string, bool](cacheTTL, mxCacheMaxEntries)
+
+	return func(ctx context.Context, email string) (bool, error) {
+		_, domain, ok := strings.Cut(email, "@")
+		if !ok || domain == "" {
+			return false, nil
+		}
+		domain = strings.ToLower(domain)
+
+		if deliverable, ok := cache.Get(domain); ok {
+			return deliverable, nil
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		records, err := resolver.LookupMX(ctx, domain)
+		var dnsErr *net.DNSError
+		switch {
+		case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+			records = nil
+		case err != nil:
+			return false, fmt.Errorf("error looking up mx records: %w", err)
+		}
+
+		deliverable := len(records) > 0 &&
+			!(len(records) == 1 && (records[0].Host == "." || records[0].Host == ""))
+		cache.Set(domain, deliverable)
+
+		return deliverable, nil
 	}
 }
@@ -1,12 +1,17 @@
 package controller_test
 
 import (
+	"context"
+	"errors"
+	"net"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"go.uber.org/mock/gomock"
 )
 
 func ptr[T any](x T) *T { return &x }
@@ -40,6 +45,7 @@ func getConfig() *controller.Config {
 		PasswordHIBPEnabled:      false,
 		RefreshTokenExpiresIn:    2592000,
 		AccessTokenExpiresIn:     900,
+		ElevatedTokenExpiresIn:   300,
 		JWTSecret:                `{"type":"HS256", "key":"5152fa850c02dc222631cca898ed1485821a70912a6e3649c49076912daa3b62182ba013315915d64f40cddfbb8b58eb5bd11ba225336a6af45bbae07ca873f3","issuer":"hasura-auth"}`,
 		RequireEmailVerification: false,
 		ServerURL:                serverURL,
@@ -51,6 +57,7 @@ func getConfig() *controller.Config {
 			"https://react-apollo.example.nhost.io",
 		},
 		WebauhtnAttestationTimeout: time.Minute,
+		TokenExchangeExpiresIn:     300,
 	}
 }
 
@@ -326,18 +333,120 @@ func TestValidateEmail(t *testing.T) {
 			email:          "good@acme.com",
 			expected:       false,
 		},
+		{
+			name:           "wildcard domain matches",
+			blockedDomains: []string{},
+			blockedEmails:  []string{},
+			allowedDomains: []string{"*.edu"},
+			allowedEmails:  []string{},
+			email:          "jane@cs.mit.edu",
+			expected:       true,
+		},
+		{
+			name:           "wildcard domain doesnt match",
+			blockedDomains: []string{},
+			blockedEmails:  []string{},
+			allowedDomains: []string{"*.edu"},
+			allowedEmails:  []string{},
+			email:          "jane@edu.com",
+			expected:       false,
+		},
+		{
+			name:           "wildcard email matches",
+			blockedDomains: []string{},
+			blockedEmails:  []string{},
+			allowedDomains: []string{},
+			allowedEmails:  []string{"*@*.partner.com"},
+			email:          "jane@eu.partner.com",
+			expected:       true,
+		},
+		{
+			name:           "wildcard email doesnt match the parent domain",
+			blockedDomains: []string{},
+			blockedEmails:  []string{},
+			allowedDomains: []string{},
+			allowedEmails:  []string{"*@*.partner.com"},
+			email:          "jane@partner.com",
+			expected:       false,
+		},
+		{
+			name:           "single character wildcard",
+			blockedDomains: []string{},
+			blockedEmails:  []string{"test?@acme.com"},
+			allowedDomains: []string{},
+			allowedEmails:  []string{},
+			email:          "test1@acme.com",
+			expected:       false,
+		},
+		{
+			name:           "regexp email matches",
+			blockedDomains: []string{},
+			blockedEmails:  []string{},
+			allowedDomains: []string{},
+			allowedEmails:  []string{"/[a-z]+\\.[a-z]+@acme\\.com/"},
+			email:          "jane.doe@acme.com",
+			expected:       true,
+		},
+		{
+			name:           "regexp is anchored",
+			blockedDomains: []string{},
+			blockedEmails:  []string{},
+			allowedDomains: []string{},
+			allowedEmails:  []string{"/[a-z]+\\.[a-z]+@acme\\.com/"},
+			email:          "jane.doe@acme.com.evil.com",
+			expected:       false,
+		},
+		{
+			name:           "regexp domain matches",
+			blockedDomains: []string{"/(.+\\.)?blocked\\.com/"},
+			blockedEmails:  []string{},
+			allowedDomains: []string{},
+			allowedEmails:  []string{},
+			email:          "jane@eu.blocked.com",
+			expected:       false,
+		},
+		{
+			name:           "precedence - exact allowed email over wildcard blocked domain",
+			blockedDomains: []string{"*.acme.com"},
+			blockedEmails:  []string{},
+			allowedDomains: []string{},
+			allowedEmails:  []string{"jane@eu.acme.com"},
+			email:          "jane@eu.acme.com",
+			expected:       true,
+		},
+		{
+			name:           "precedence - wildcard blocked email over exact allowed email",
+			blockedDomains: []string{},
+			blockedEmails:  []string{"*@acme.com"},
+			allowedDomains: []string{},
+			allowedEmails:  []string{"jane@acme.com"},
+			email:          "jane@acme.com",
+			expected:       false,
+		},
+		{
+			name:           "precedence - wildcard blocked domain over allowed domain",
+			blockedDomains: []string{"*.acme.com"},
+			blockedEmails:  []string{},
+			allowedDomains: []string{"eu.acme.com"},
+			allowedEmails:  []string{},
+			email:          "jane@eu.acme.com",
+			expected:       false,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			fn := controller.ValidateEmail(
+			fn, err := controller.ValidateEmail(
 				tc.blockedDomains,
 				tc.blockedEmails,
 				tc.allowedDomains,
 				tc.allowedEmails,
 			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
 			got := fn(tc.email)
 			if tc.expected != got {
 				t.Errorf(
@@ -350,3 +459,376 @@ func TestValidateEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEmailInvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{"/[a-z/", "[*.acme.com"} {
+		if _, err := controller.ValidateEmail(nil, nil, []string{pattern}, nil); err == nil {
+			t.Errorf("expected an error for %s", pattern)
+		}
+	}
+}
+
+func TestValidatePasswordPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		policy   controller.PasswordPolicy
+		password string
+		email    string
+		expected *controller.APIError
+	}{
+		{
+			name:     "empty policy",
+			policy:   controller.PasswordPolicy{}, //nolint:exhaustruct
+			password: "a",
+			email:    "jane@acme.com",
+			expected: nil,
+		},
+		{
+			name:     "too short",
+			policy:   controller.PasswordPolicy{MinLength: 9}, //nolint:exhaustruct
+			password: "password",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordTooShort,
+		},
+		{
+			name:     "too long",
+			policy:   controller.PasswordPolicy{MaxLength: 7}, //nolint:exhaustruct
+			password: "password",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordTooLong,
+		},
+		{
+			name: "character classes",
+			policy: controller.PasswordPolicy{ //nolint:exhaustruct
+				RequiredCharacterClasses: []string{"lowercase", "uppercase", "digit", "symbol"},
+			},
+			password: "Passw0rd!",
+			email:    "jane@acme.com",
+			expected: nil,
+		},
+		{
+			name: "missing lowercase",
+			policy: controller.PasswordPolicy{ //nolint:exhaustruct
+				RequiredCharacterClasses: []string{"lowercase"},
+			},
+			password: "PASSWORD",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordMissingLowercase,
+		},
+		{
+			name: "missing uppercase",
+			policy: controller.PasswordPolicy{ //nolint:exhaustruct
+				RequiredCharacterClasses: []string{"uppercase"},
+			},
+			password: "password",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordMissingUppercase,
+		},
+		{
+			name: "missing digit",
+			policy: controller.PasswordPolicy{ //nolint:exhaustruct
+				RequiredCharacterClasses: []string{"digit"},
+			},
+			password: "password",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordMissingDigit,
+		},
+		{
+			name: "missing symbol",
+			policy: controller.PasswordPolicy{ //nolint:exhaustruct
+				RequiredCharacterClasses: []string{"symbol"},
+			},
+			password: "pass word",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordMissingSymbol,
+		},
+		{
+			name:     "in denylist",
+			policy:   controller.PasswordPolicy{Denylist: []string{"Acme"}}, //nolint:exhaustruct
+			password: "myACMEpassword",
+			email:    "jane@example.com",
+			expected: controller.ErrPasswordInDenylist,
+		},
+		{
+			name:     "contains email",
+			policy:   controller.PasswordPolicy{RejectEmail: true}, //nolint:exhaustruct
+			password: "Jane@Acme.com1",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordContainsEmail,
+		},
+		{
+			name:     "contains email local part",
+			policy:   controller.PasswordPolicy{RejectEmail: true}, //nolint:exhaustruct
+			password: "janedoe2024",
+			email:    "janedoe@acme.com",
+			expected: controller.ErrPasswordContainsEmail,
+		},
+		{
+			name:     "short local part is ignored",
+			policy:   controller.PasswordPolicy{RejectEmail: true}, //nolint:exhaustruct
+			password: "jopassword",
+			email:    "jo@acme.com",
+			expected: nil,
+		},
+		{
+			name:     "too weak",
+			policy:   controller.PasswordPolicy{MinScore: 3}, //nolint:exhaustruct
+			password: "password1",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordTooWeak,
+		},
+		{
+			name:     "strong enough",
+			policy:   controller.PasswordPolicy{MinScore: 3}, //nolint:exhaustruct
+			password: "correct-horse-battery-staple",
+			email:    "jane@acme.com",
+			expected: nil,
+		},
+		{
+			name: "first failed rule is returned",
+			policy: controller.PasswordPolicy{ //nolint:exhaustruct
+				MaxLength:                4,
+				RequiredCharacterClasses: []string{"digit"},
+			},
+			password: "password",
+			email:    "jane@acme.com",
+			expected: controller.ErrPasswordTooLong,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fn, err := controller.ValidatePasswordPolicy(tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := fn(tc.password, tc.email); got != tc.expected {
+				t.Errorf("unexpected result: got %v, expected %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidatePasswordPolicyUnknownCharacterClass(t *testing.T) {
+	t.Parallel()
+
+	_, err := controller.ValidatePasswordPolicy(
+		controller.PasswordPolicy{ //nolint:exhaustruct
+			RequiredCharacterClasses: []string{"emoji"},
+		},
+	)
+	if !errors.Is(err, controller.ErrUnknownCharacterClass) {
+		t.Errorf("expected ErrUnknownCharacterClass, got %v", err)
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	t.Parallel()
+
+	schema := `{
+		"type": "object",
+		"required": ["plan"],
+		"additionalProperties": false,
+		"properties": {
+			"plan": {"type": "string", "enum": ["free", "pro"]},
+			"seats": {"type": "integer", "minimum": 1}
+		}
+	}`
+
+	cases := []struct {
+		name     string
+		schema   string
+		metadata map[string]any
+		valid    bool
+	}{
+		{
+			name:     "no schema",
+			schema:   "",
+			metadata: map[string]any{"anything": []any{1, "a"}},
+			valid:    true,
+		},
+		{
+			name:     "valid",
+			schema:   schema,
+			metadata: map[string]any{"plan": "pro", "seats": float64(3)},
+			valid:    true,
+		},
+		{
+			name:     "missing property",
+			schema:   schema,
+			metadata: nil,
+			valid:    false,
+		},
+		{
+			name:     "not allowed value",
+			schema:   schema,
+			metadata: map[string]any{"plan": "enterprise"},
+			valid:    false,
+		},
+		{
+			name:     "below minimum",
+			schema:   schema,
+			metadata: map[string]any{"plan": "free", "seats": float64(0)},
+			valid:    false,
+		},
+		{
+			name:     "additional property",
+			schema:   schema,
+			metadata: map[string]any{"plan": "free", "color": "red"},
+			valid:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fn, err := controller.ValidateMetadata(tc.schema)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := fn(tc.metadata) == nil; got != tc.valid {
+				t.Errorf("unexpected result: got valid %v, expected %v", got, tc.valid)
+			}
+		})
+	}
+}
+
+func TestValidateMetadataInvalidSchema(t *testing.T) {
+	t.Parallel()
+
+	if _, err := controller.ValidateMetadata(`{"type": "objects"}`); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestValidateEmailMX(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		email       string
+		resolver    func(ctrl *gomock.Controller) *mock.MockMXResolver
+		deliverable bool
+		expectedErr bool
+	}{
+		{
+			name:  "mx records",
+			email: "jane@Acme.com",
+			resolver: func(ctrl *gomock.Controller) *mock.MockMXResolver {
+				mock := mock.NewMockMXResolver(ctrl)
+				mock.EXPECT().LookupMX(gomock.Any(), "acme.com").Return(
+					[]*net.MX{{Host: "mx.acme.com.", Pref: 10}}, nil,
+				)
+				return mock
+			},
+			deliverable: true,
+			expectedErr: false,
+		},
+		{
+			name:  "null mx",
+			email: "jane@acme.com",
+			resolver: func(ctrl *gomock.Controller) *mock.MockMXResolver {
+				mock := mock.NewMockMXResolver(ctrl)
+				mock.EXPECT().LookupMX(gomock.Any(), "acme.com").Return(
+					[]*net.MX{{Host: ".", Pref: 0}}, nil,
+				)
+				return mock
+			},
+			deliverable: false,
+			expectedErr: false,
+		},
+		{
+			name:  "domain not found",
+			email: "jane@acme.con",
+			resolver: func(ctrl *gomock.Controller) *mock.MockMXResolver {
+				mock := mock.NewMockMXResolver(ctrl)
+				mock.EXPECT().LookupMX(gomock.Any(), "acme.con").Return(
+					nil, &net.DNSError{Err: "no such host", Name: "acme.con", IsNotFound: true}, //nolint:exhaustruct
+				)
+				return mock
+			},
+			deliverable: false,
+			expectedErr: false,
+		},
+		{
+			name:  "timeout",
+			email: "jane@acme.com",
+			resolver: func(ctrl *gomock.Controller) *mock.MockMXResolver {
+				mock := mock.NewMockMXResolver(ctrl)
+				mock.EXPECT().LookupMX(gomock.Any(), "acme.com").Return(
+					nil, &net.DNSError{Err: "i/o timeout", Name: "acme.com", IsTimeout: true}, //nolint:exhaustruct
+				)
+				return mock
+			},
+			deliverable: false,
+			expectedErr: true,
+		},
+		{
+			name:  "invalid email",
+			email: "jane",
+			resolver: func(ctrl *gomock.Controller) *mock.MockMXResolver {
+				return mock.NewMockMXResolver(ctrl)
+			},
+			deliverable: false,
+			expectedErr: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			fn := controller.ValidateEmailMX(tc.resolver(ctrl), time.Second, time.Minute)
+
+			deliverable, err := fn(context.Background(), tc.email)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if deliverable != tc.deliverable {
+				t.Errorf("unexpected result: got %v, expected %v", deliverable, tc.deliverable)
+			}
+		})
+	}
+}
+
+func TestValidateEmailMXCache(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	resolver := mock.NewMockMXResolver(ctrl)
+	resolver.EXPECT().LookupMX(gomock.Any(), "acme.com").Return(
+		nil, &net.DNSError{Err: "i/o timeout", Name: "acme.com", IsTimeout: true}, //nolint:exhaustruct
+	)
+	resolver.EXPECT().LookupMX(gomock.Any(), "acme.com").Return(
+		[]*net.MX{{Host: "mx.acme.com.", Pref: 10}}, nil,
+	).Times(1)
+
+	fn := controller.ValidateEmailMX(resolver, time.Second, time.Minute)
+
+	if _, err := fn(context.Background(), "jane@acme.com"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	// errors aren't cached but results are
+	for _, email := range []string{"jane@acme.com", "john@acme.com"} {
+		deliverable, err := fn(context.Background(), email)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !deliverable {
+			t.Errorf("expected %s to be deliverable", email)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/go-webauthn/webauthn/protocol"
@@ -12,9 +13,10 @@ import (
 )
 
 type WebauthnUser struct {
-	ID    uuid.UUID
-	Name  string
-	Email string
+	ID          uuid.UUID
+	Name        string
+	Email       string
+	Credentials []webauthn.Credential
 }
 
 func (u WebauthnUser) WebAuthnID() []byte {
@@ -30,7 +32,7 @@ func (u WebauthnUser) WebAuthnDisplayName() string {
 }
 
 func (u WebauthnUser) WebAuthnCredentials() []webauthn.Credential {
-	return nil
+	return u.Credentials
 }
 
 func (u WebauthnUser) WebAuthnIcon() string {
@@ -43,9 +45,15 @@ type WebauthnChallenge struct {
 	Options *api.SignUpOptions
 }
 
+// webauthnMaxChallenges caps the challenges waiting to be verified. The endpoints starting
+// a ceremony don't require authentication so the storage can't grow without limit.
+const webauthnMaxChallenges = 10000
+
 type Webauthn struct {
-	wa      *webauthn.WebAuthn
-	Storage map[string]WebauthnChallenge
+	wa *webauthn.WebAuthn
+
+	mu      sync.Mutex
+	storage map[string]WebauthnChallenge
 }
 
 func NewWebAuthn(config Config) (*Webauthn, error) {
@@ -81,22 +89,79 @@ func NewWebAuthn(config Config) (*Webauthn, error) {
 
 	return &Webauthn{
 		wa:      wa,
-		Storage: make(map[string]WebauthnChallenge),
+		mu:      sync.Mutex{},
+		storage: make(map[string]WebauthnChallenge),
 	}, nil
 }
 
+// cleanCache removes the expired challenges, w.mu must be held.
 func (w *Webauthn) cleanCache() {
-	toDelete := make([]string, 0, len(w.Storage))
-	for k, v := range w.Storage {
-		// if expired
-		if time.Now().After(v.Session.Expires) {
-			toDelete = append(toDelete, k)
+	now := time.Now()
+	for k, v := range w.storage {
+		if now.After(v.Session.Expires) {
+			delete(w.storage, k)
 		}
 	}
+}
+
+// SaveChallenge stores a challenge until it's verified or it expires. It returns false
+// if there are already too many challenges waiting to be verified.
+func (w *Webauthn) SaveChallenge(challenge string, ch WebauthnChallenge) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.cleanCache()
+
+	if len(w.storage) >= webauthnMaxChallenges {
+		return false
+	}
+
+	w.storage[challenge] = ch
+
+	return true
+}
+
+// Challenge returns the stored challenge unless it expired.
+func (w *Webauthn) Challenge(challenge string) (WebauthnChallenge, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ch, ok := w.storage[challenge]
+	if !ok || time.Now().After(ch.Session.Expires) {
+		return WebauthnChallenge{}, false //nolint:exhaustruct
+	}
+
+	return ch, true
+}
+
+// ConsumeChallenge returns the stored challenge and removes it so it can't be verified
+// twice.
+func (w *Webauthn) ConsumeChallenge(challenge string) (WebauthnChallenge, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ch, ok := w.storage[challenge]
+	if !ok {
+		return WebauthnChallenge{}, false //nolint:exhaustruct
+	}
+	delete(w.storage, challenge)
+
+	if time.Now().After(ch.Session.Expires) {
+		return WebauthnChallenge{}, false //nolint:exhaustruct
+	}
+
+	return ch, true
+}
 
-	for _, k := range toDelete {
-		delete(w.Storage, k)
+func (w *Webauthn) saveChallenge(
+	challenge string, ch WebauthnChallenge, logger *slog.Logger,
+) *APIError {
+	if !w.SaveChallenge(challenge, ch) {
+		logger.Warn("too many webauthn challenges waiting to be verified")
+		return ErrTooManyRequests
 	}
+
+	return nil
 }
 
 func (w *Webauthn) BeginRegistration(
@@ -104,18 +169,27 @@ func (w *Webauthn) BeginRegistration(
 	options *api.SignUpOptions,
 	logger *slog.Logger,
 ) (*protocol.CredentialCreation, *APIError) {
-	w.cleanCache()
+	opts := make([]webauthn.RegistrationOption, 0, 1)
+	if len(user.Credentials) > 0 {
+		exclusions := make([]protocol.CredentialDescriptor, len(user.Credentials))
+		for i, cred := range user.Credentials {
+			exclusions[i] = cred.Descriptor()
+		}
+		opts = append(opts, webauthn.WithExclusions(exclusions))
+	}
 
-	challenge, session, err := w.wa.BeginRegistration(user)
+	challenge, session, err := w.wa.BeginRegistration(user, opts...)
 	if err != nil {
 		logger.Info("failed to begin webauthn registration", logError(err))
 		return nil, ErrInternalServerError
 	}
 
-	w.Storage[challenge.Response.Challenge.String()] = WebauthnChallenge{
+	if apiErr := w.saveChallenge(challenge.Response.Challenge.String(), WebauthnChallenge{
 		Session: *session,
 		User:    user,
 		Options: options,
+	}, logger); apiErr != nil {
+		return nil, apiErr
 	}
 
 	return challenge, nil
@@ -125,7 +199,7 @@ func (w *Webauthn) FinishRegistration(
 	response *protocol.ParsedCredentialCreationData,
 	logger *slog.Logger,
 ) (*webauthn.Credential, WebauthnUser, *APIError) {
-	challenge, ok := w.Storage[response.Response.CollectedClientData.Challenge]
+	challenge, ok := w.ConsumeChallenge(response.Response.CollectedClientData.Challenge)
 	if !ok {
 		logger.Info("webauthn challenge not found")
 		return nil, WebauthnUser{}, ErrInvalidRequest //nolint:exhaustruct
@@ -137,7 +211,118 @@ func (w *Webauthn) FinishRegistration(
 		return nil, WebauthnUser{}, ErrInvalidRequest //nolint:exhaustruct
 	}
 
-	w.cleanCache()
-
 	return cred, challenge.User, nil
 }
+
+func (w *Webauthn) BeginLogin(
+	user WebauthnUser,
+	logger *slog.Logger,
+) (*protocol.CredentialAssertion, *APIError) {
+	if len(user.Credentials) == 0 {
+		logger.Info("user has no security keys")
+		return nil, ErrInvalidRequest
+	}
+
+	assertion, session, err := w.wa.BeginLogin(user)
+	if err != nil {
+		logger.Info("failed to begin webauthn login", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	if apiErr := w.saveChallenge(assertion.Response.Challenge.String(), WebauthnChallenge{
+		Session: *session,
+		User:    user,
+		Options: nil,
+	}, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
+	return assertion, nil
+}
+
+// BeginDiscoverableLogin starts a login where the user isn't known in advance.
+// The authenticator will pick a resident credential (passkey) and the user
+// will be resolved from its user handle when finishing the login.
+func (w *Webauthn) BeginDiscoverableLogin(
+	logger *slog.Logger,
+) (*protocol.CredentialAssertion, *APIError) {
+	assertion, session, err := w.wa.BeginDiscoverableLogin()
+	if err != nil {
+		logger.Info("failed to begin webauthn discoverable login", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	if apiErr := w.saveChallenge(assertion.Response.Challenge.String(), WebauthnChallenge{
+		Session: *session,
+		User:    WebauthnUser{}, //nolint:exhaustruct
+		Options: nil,
+	}, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
+	return assertion, nil
+}
+
+// FinishLogin validates the assertion against the stored challenge. getUserFn is
+// used to load the user and its credentials, either from the challenge or, for
+// discoverable logins, from the user handle returned by the authenticator.
+func (w *Webauthn) FinishLogin(
+	response *protocol.ParsedCredentialAssertionData,
+	getUserFn func(userID uuid.UUID) (WebauthnUser, *APIError),
+	logger *slog.Logger,
+) (*webauthn.Credential, WebauthnUser, *APIError) {
+	challenge, ok := w.ConsumeChallenge(response.Response.CollectedClientData.Challenge)
+	if !ok {
+		logger.Info("webauthn challenge not found")
+		return nil, WebauthnUser{}, ErrInvalidRequest //nolint:exhaustruct
+	}
+
+	var (
+		user   WebauthnUser
+		apiErr *APIError
+		cred   *webauthn.Credential
+		err    error
+	)
+	if challenge.Session.UserID == nil {
+		cred, err = w.wa.ValidateDiscoverableLogin(
+			func(_, userHandle []byte) (webauthn.User, error) {
+				userID, err := uuid.Parse(string(userHandle))
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse user handle: %w", err)
+				}
+
+				user, apiErr = getUserFn(userID)
+				if apiErr != nil {
+					return nil, apiErr
+				}
+
+				return user, nil
+			},
+			challenge.Session,
+			response,
+		)
+	} else {
+		user, apiErr = getUserFn(challenge.User.ID)
+		if apiErr != nil {
+			return nil, WebauthnUser{}, apiErr //nolint:exhaustruct
+		}
+
+		cred, err = w.wa.ValidateLogin(user, challenge.Session, response)
+	}
+
+	if apiErr != nil {
+		return nil, WebauthnUser{}, apiErr //nolint:exhaustruct
+	}
+
+	if err != nil {
+		logger.Info("failed to validate webauthn login", logError(err))
+		return nil, WebauthnUser{}, ErrInvalidWebauthnSecurityKey //nolint:exhaustruct
+	}
+
+	if cred.Authenticator.CloneWarning {
+		logger.Warn("webauthn signature counter didn't increase, the key might be cloned")
+		return nil, WebauthnUser{}, ErrInvalidWebauthnSecurityKey //nolint:exhaustruct
+	}
+
+	return cred, user, nil
+}
@@ -0,0 +1,150 @@
+package controller_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/nhost/hasura-auth/go/controller"
+)
+
+func webauthnChallenge(expires time.Time) controller.WebauthnChallenge {
+	return controller.WebauthnChallenge{ //nolint:exhaustruct
+		Session: webauthn.SessionData{ //nolint:exhaustruct
+			Expires: expires,
+		},
+	}
+}
+
+func TestWebauthnChallenges(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		run  func(t *testing.T, wa *controller.Webauthn)
+	}{
+		{
+			name: "consumed once",
+			run: func(t *testing.T, wa *controller.Webauthn) {
+				t.Helper()
+
+				if !wa.SaveChallenge("challenge", webauthnChallenge(time.Now().Add(time.Minute))) {
+					t.Fatal("challenge should've been saved")
+				}
+
+				if _, ok := wa.Challenge("challenge"); !ok {
+					t.Error("challenge should've been found")
+				}
+
+				if _, ok := wa.ConsumeChallenge("challenge"); !ok {
+					t.Error("challenge should've been consumed")
+				}
+
+				if _, ok := wa.ConsumeChallenge("challenge"); ok {
+					t.Error("challenge shouldn't be consumed twice")
+				}
+			},
+		},
+		{
+			name: "expired",
+			run: func(t *testing.T, wa *controller.Webauthn) {
+				t.Helper()
+
+				if !wa.SaveChallenge("challenge", webauthnChallenge(time.Now().Add(-time.Second))) {
+					t.Fatal("challenge should've been saved")
+				}
+
+				if _, ok := wa.Challenge("challenge"); ok {
+					t.Error("expired challenge shouldn't be found")
+				}
+
+				if _, ok := wa.ConsumeChallenge("challenge"); ok {
+					t.Error("expired challenge shouldn't be consumed")
+				}
+			},
+		},
+		{
+			name: "too many challenges",
+			run: func(t *testing.T, wa *controller.Webauthn) {
+				t.Helper()
+
+				expires := time.Now().Add(time.Minute)
+				for i := range 10000 {
+					if !wa.SaveChallenge(strconv.Itoa(i), webauthnChallenge(expires)) {
+						t.Fatalf("challenge %d should've been saved", i)
+					}
+				}
+
+				if wa.SaveChallenge("one-too-many", webauthnChallenge(expires)) {
+					t.Error("challenge shouldn't be saved when the storage is full")
+				}
+
+				if _, ok := wa.ConsumeChallenge("0"); !ok {
+					t.Fatal("challenge should've been consumed")
+				}
+
+				if !wa.SaveChallenge("one-too-many", webauthnChallenge(expires)) {
+					t.Error("challenge should've been saved after one was consumed")
+				}
+			},
+		},
+		{
+			name: "expired challenges make room",
+			run: func(t *testing.T, wa *controller.Webauthn) {
+				t.Helper()
+
+				expires := time.Now().Add(-time.Second)
+				for i := range 10000 {
+					wa.SaveChallenge(strconv.Itoa(i), webauthnChallenge(expires))
+				}
+
+				if !wa.SaveChallenge("challenge", webauthnChallenge(time.Now().Add(time.Minute))) {
+					t.Error("expired challenges should've been removed")
+				}
+			},
+		},
+		{
+			name: "concurrent",
+			run: func(t *testing.T, wa *controller.Webauthn) {
+				t.Helper()
+
+				expires := time.Now().Add(time.Minute)
+
+				var wg sync.WaitGroup
+				for i := range 100 {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+
+						challenge := strconv.Itoa(i)
+						wa.SaveChallenge(challenge, webauthnChallenge(expires))
+						wa.Challenge(challenge)
+						wa.ConsumeChallenge(challenge)
+					}()
+				}
+				wg.Wait()
+
+				for i := range 100 {
+					if _, ok := wa.Challenge(strconv.Itoa(i)); ok {
+						t.Errorf("challenge %d should've been consumed", i)
+					}
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			wa, err := controller.NewWebAuthn(*getConfig())
+			if err != nil {
+				t.Fatalf("failed to create webauthn: %v", err)
+			}
+
+			tc.run(t, wa)
+		})
+	}
+}
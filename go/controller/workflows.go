@@ -8,15 +8,19 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"slices"
 	"time"
 
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
 	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
 	"github.com/oapi-codegen/runtime/types"
 )
 
@@ -30,19 +34,22 @@ type Workflows struct {
 	db                   DBClient
 	hibp                 HIBPClient
 	email                Emailer
+	sms                  SMSSender
+	webhooks             WebhookSender
+	preSignUpHook        PreSignUpHook
+	disposableEmails     DisposableEmailChecker
+	avatarStorage        AvatarStorage
+	geoIP                GeoIPLocator
+	riskEngine           RiskEngine
 	redirectURLValidator func(redirectTo string) bool
 	ValidateEmail        func(email string) bool
-	gravatarURL          func(string) string
+	validateEmailMX      func(ctx context.Context, email string) (bool, error)
+	validatePassword     func(password string, email string) *APIError
+	validateMetadata     func(metadata map[string]any) *APIError
+	avatars              AvatarProvider
 }
 
-func NewWorkflows(
-	cfg *Config,
-	jwtGetter JWTGetter,
-	db DBClient,
-	hibp HIBPClient,
-	email Emailer,
-	gravatarURL func(string) string,
-) (*Workflows, error) {
+func NewWorkflows(cfg *Config, deps Dependencies, avatars AvatarProvider) (*Workflows, error) {
 	allowedURLs := make([]string, len(cfg.AllowedRedirectURLs)+1)
 	allowedURLs[0] = cfg.ClientURL.String()
 	for i, u := range cfg.AllowedRedirectURLs {
@@ -54,42 +61,105 @@ func NewWorkflows(
 		return nil, fmt.Errorf("error creating redirect URL wf: %w", err)
 	}
 
-	emailValidator := ValidateEmail(
+	emailValidator, err := ValidateEmail(
 		cfg.BlockedEmailDomains,
 		cfg.BlockedEmails,
 		cfg.AllowedEmailDomains,
 		cfg.AllowedEmails,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating email validator: %w", err)
+	}
+
+	var emailMXValidator func(ctx context.Context, email string) (bool, error)
+	if cfg.EmailMXCheckEnabled {
+		emailMXValidator = ValidateEmailMX(
+			net.DefaultResolver, cfg.EmailMXCheckTimeout, cfg.EmailMXCheckCacheTTL,
+		)
+	}
+
+	passwordValidator, err := ValidatePasswordPolicy(PasswordPolicy{
+		MinLength:                cfg.PasswordMinLength,
+		MaxLength:                cfg.PasswordMaxLength,
+		MinScore:                 cfg.PasswordMinScore,
+		RequiredCharacterClasses: cfg.PasswordCharacterClasses,
+		Denylist:                 cfg.PasswordDenylist,
+		RejectEmail:              cfg.PasswordRejectEmail,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating password policy: %w", err)
+	}
+
+	metadataValidator, err := ValidateMetadata(cfg.UserMetadataSchema)
+	if err != nil {
+		return nil, fmt.Errorf("error creating metadata validator: %w", err)
+	}
 
 	return &Workflows{
 		config:               cfg,
-		jwtGetter:            jwtGetter,
-		db:                   db,
-		hibp:                 hibp,
-		email:                email,
+		jwtGetter:            *deps.JWTGetter,
+		db:                   deps.DB,
+		hibp:                 deps.HIBP,
+		email:                deps.Emailer,
+		sms:                  deps.SMS,
+		webhooks:             deps.Webhooks,
+		preSignUpHook:        deps.PreSignUpHook,
+		disposableEmails:     deps.DisposableEmails,
+		avatarStorage:        deps.AvatarStorage,
+		geoIP:                deps.GeoIP,
+		riskEngine:           deps.RiskEngine,
 		redirectURLValidator: redirectURLValidator,
 		ValidateEmail:        emailValidator,
-		gravatarURL:          gravatarURL,
+		validateEmailMX:      emailMXValidator,
+		validatePassword:     passwordValidator,
+		validateMetadata:     metadataValidator,
+		avatars:              avatars,
 	}, nil
 }
 
 func (wf *Workflows) ValidateSignupEmail(
-	email types.Email, logger *slog.Logger,
+	ctx context.Context, email types.Email, logger *slog.Logger,
 ) *APIError {
 	if !wf.ValidateEmail(string(email)) {
 		logger.Warn("email didn't pass access control checks")
 		return ErrInvalidEmailPassword
 	}
 
+	return wf.ValidateEmailDeliverability(ctx, string(email), logger)
+}
+
+// ValidateEmailDeliverability checks the domain of the email has MX records before emails
+// are sent to it. If the lookup fails, i.e. it times out, the email is accepted so issues
+// with the DNS servers don't block the users.
+func (wf *Workflows) ValidateEmailDeliverability(
+	ctx context.Context, email string, logger *slog.Logger,
+) *APIError {
+	if wf.validateEmailMX == nil {
+		return nil
+	}
+
+	deliverable, err := wf.validateEmailMX(ctx, email)
+	if err != nil {
+		logger.Warn("error checking the mx records of the email domain", logError(err))
+		return nil
+	}
+	if !deliverable {
+		logger.Warn("email domain doesn't have mx records")
+		return ErrUndeliverableEmail
+	}
+
 	return nil
 }
 
 func (wf *Workflows) ValidatePassword(
-	ctx context.Context, password string, logger *slog.Logger,
+	ctx context.Context, password string, email string, logger *slog.Logger,
 ) *APIError {
-	if len(password) < wf.config.PasswordMinLength {
-		logger.Warn("password too short")
-		return ErrPasswordTooShort
+	if apiErr := wf.validatePassword(password, email); apiErr != nil {
+		logger.Warn(
+			"password doesn't comply with the password policy",
+			slog.String("rule", string(apiErr.t)),
+		)
+		return apiErr
 	}
 
 	if wf.config.PasswordHIBPEnabled {
@@ -105,22 +175,37 @@ func (wf *Workflows) ValidatePassword(
 	return nil
 }
 
+// allowedLocale returns the first allowed locale in the fallback chain of the locale so
+// fr-CA becomes fr if only the latter is allowed. The default locale is always allowed.
+func (wf *Workflows) allowedLocale(locale string) string {
+	for _, l := range wf.config.LocaleFallbacks.Chain(locale, wf.config.DefaultLocale) {
+		if slices.Contains(wf.config.AllowedLocales, l) {
+			return l
+		}
+	}
+	return wf.config.DefaultLocale
+}
+
+// ValidateSignUpOptions fills the options the user didn't set with their defaults and
+// checks the rest. The roles are the ones of the sign in method the user is signing up with.
 func (wf *Workflows) ValidateSignUpOptions( //nolint:cyclop
-	options *api.SignUpOptions, defaultName string, logger *slog.Logger,
+	options *api.SignUpOptions, defaultName string, method SignInMethod, logger *slog.Logger,
 ) (*api.SignUpOptions, *APIError) {
 	if options == nil {
 		options = &api.SignUpOptions{} //nolint:exhaustruct
 	}
 
+	defaultRole, defaultAllowedRoles := wf.config.SignInMethodRoles(method)
+
 	if options.DefaultRole == nil {
-		options.DefaultRole = ptr(wf.config.DefaultRole)
+		options.DefaultRole = ptr(defaultRole)
 	}
 
 	if options.AllowedRoles == nil {
-		options.AllowedRoles = ptr(wf.config.DefaultAllowedRoles)
+		options.AllowedRoles = ptr(defaultAllowedRoles)
 	} else {
 		for _, role := range deptr(options.AllowedRoles) {
-			if !slices.Contains(wf.config.DefaultAllowedRoles, role) {
+			if !slices.Contains(defaultAllowedRoles, role) {
 				logger.Warn("role not allowed", slog.String("role", role))
 				return nil, ErrRoleNotAllowed
 			}
@@ -140,11 +225,13 @@ func (wf *Workflows) ValidateSignUpOptions( //nolint:cyclop
 		options.Locale = ptr(wf.config.DefaultLocale)
 	}
 	if !slices.Contains(wf.config.AllowedLocales, deptr(options.Locale)) {
+		locale := wf.allowedLocale(deptr(options.Locale))
 		logger.Warn(
-			"locale not allowed, using default",
+			"locale not allowed, using fallback",
 			slog.String("locale", deptr(options.Locale)),
+			slog.String("fallback", locale),
 		)
-		options.Locale = ptr(wf.config.DefaultLocale)
+		options.Locale = ptr(locale)
 	}
 
 	if options.RedirectTo == nil {
@@ -154,13 +241,40 @@ func (wf *Workflows) ValidateSignUpOptions( //nolint:cyclop
 		return nil, ErrRedirecToNotAllowed
 	}
 
+	if options.Metadata != nil {
+		if apiErr := wf.ValidateMetadata(deptr(options.Metadata), logger); apiErr != nil {
+			return nil, apiErr
+		}
+	}
+
 	return options, nil
 }
 
+func (wf *Workflows) ValidateMetadata(metadata map[string]any, logger *slog.Logger) *APIError {
+	if apiErr := wf.validateMetadata(metadata); apiErr != nil {
+		logger.Warn("metadata doesn't match the schema", slog.String("reason", apiErr.message))
+		return apiErr
+	}
+	return nil
+}
+
+// userInTenant returns whether the user belongs to the tenant served. Users of other
+// tenants are stored in the same table, they don't exist for this one.
+func (wf *Workflows) userInTenant(user sql.AuthUser) bool {
+	return user.TenantID.String == wf.config.TenantID
+}
+
 func (wf *Workflows) ValidateUser(
 	user sql.AuthUser,
 	logger *slog.Logger,
 ) *APIError {
+	if !wf.userInTenant(user) {
+		logger.Warn(
+			"user belongs to another tenant", slog.String("tenant_id", user.TenantID.String),
+		)
+		return ErrInvalidEmailPassword
+	}
+
 	if !user.IsAnonymous && !wf.ValidateEmail(user.Email.String) {
 		logger.Warn("email didn't pass access control checks")
 		return ErrInvalidEmailPassword
@@ -171,6 +285,16 @@ func (wf *Workflows) ValidateUser(
 		return ErrDisabledUser
 	}
 
+	if userBanned(user) {
+		logger.Warn("user is banned", slog.String("ban_reason", user.BanReason.String))
+		return ErrDisabledUser
+	}
+
+	if user.DeletionScheduledAt.Valid {
+		logger.Warn("user is scheduled for deletion")
+		return ErrDisabledUser
+	}
+
 	if !user.EmailVerified && wf.config.RequireEmailVerification {
 		logger.Warn("user is unverified")
 		return ErrUnverifiedUser
@@ -224,6 +348,26 @@ func (wf *Workflows) GetUser(
 	return user, nil
 }
 
+// adminGetUser is like GetUser for the admin endpoints: the user isn't validated, so
+// disabled users can be managed too, and a missing user is reported as such.
+func (wf *Workflows) adminGetUser(
+	ctx context.Context,
+	id uuid.UUID,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.GetUser(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user not found")
+		return sql.AuthUser{}, ErrUserNotFound //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting user", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
 func (wf *Workflows) UserByEmailExists(
 	ctx context.Context,
 	email string,
@@ -273,15 +417,14 @@ func (wf *Workflows) GetUserByRefreshTokenHash(
 	user, err := wf.db.GetUserByRefreshTokenHash(
 		ctx,
 		sql.GetUserByRefreshTokenHashParams{
-			RefreshTokenHash: sql.Text(hashRefreshToken([]byte(refreshToken))),
+			RefreshTokenHash: hashRefreshToken([]byte(refreshToken)),
 			Type:             refreshTokenType,
+			ReuseInterval:    int32(refreshTokenReuseInterval.Seconds()),
 		},
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
 		logger.Error("could not find user by refresh token")
-		if refreshTokenType == sql.RefreshTokenTypePAT {
-			return sql.AuthUser{}, ErrInvalidPat //nolint:exhaustruct
-		}
+		wf.revokeReusedRefreshToken(ctx, refreshToken, logger)
 		return sql.AuthUser{}, ErrInvalidRefreshToken //nolint:exhaustruct
 	}
 	if err != nil {
@@ -289,6 +432,40 @@ func (wf *Workflows) GetUserByRefreshTokenHash(
 		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
 	}
 
+	// the query only returns users of the tenant but the session store may not, and
+	// callers ignore some of the errors of ValidateUser
+	if !wf.userInTenant(user) {
+		logger.Warn(
+			"refresh token of a user of another tenant",
+			slog.String("tenant_id", user.TenantID.String),
+		)
+		return sql.AuthUser{}, ErrInvalidRefreshToken //nolint:exhaustruct
+	}
+
+	if apiErr := wf.ValidateUser(user, logger); apiErr != nil {
+		return user, apiErr
+	}
+
+	return user, nil
+}
+
+// GetUserByPersonalAccessToken returns the owner of a PAT that hasn't expired and records
+// that the PAT was used.
+func (wf *Workflows) GetUserByPersonalAccessToken(
+	ctx context.Context,
+	pat string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.GetUserByPersonalAccessTokenHash(ctx, hashRefreshToken([]byte(pat)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("could not find user by personal access token")
+		return sql.AuthUser{}, ErrInvalidPat //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("could not get user by personal access token", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
 	if apiErr := wf.ValidateUser(user, logger); apiErr != nil {
 		return user, apiErr
 	}
@@ -304,21 +481,85 @@ func pgtypeTextToOAPIEmail(pgemail pgtype.Text) *types.Email {
 	return email
 }
 
-func (wf *Workflows) UpdateSession( //nolint:funlen
+// refreshTokenReuseInterval is how long a rotated refresh token is still accepted, so
+// clients refreshing the session concurrently aren't mistaken for a reuse.
+const refreshTokenReuseInterval = 10 * time.Second
+
+// revokeReusedRefreshToken deletes every token in the family of a refresh token that was
+// already rotated. A rotated token being presented again, after refreshTokenReuseInterval,
+// means it leaked, so none of the tokens derived from it can be trusted anymore.
+func (wf *Workflows) revokeReusedRefreshToken(
+	ctx context.Context,
+	refreshToken string,
+	logger *slog.Logger,
+) {
+	revoked, err := wf.db.DeleteRefreshTokenFamilyByRotatedHash(
+		ctx, hashRefreshToken([]byte(refreshToken)),
+	)
+	if err != nil {
+		logger.Error("error revoking refresh token family", logError(err))
+		return
+	}
+	if len(revoked) == 0 {
+		return
+	}
+
+	logger.Warn(
+		"refresh token reuse detected, revoked token family",
+		slog.String("security_event", "refresh_token_reuse"),
+		slog.String("user_id", revoked[0].UserID.String()),
+		slog.String("family_id", revoked[0].FamilyID.String()),
+		slog.Int("revoked_tokens", len(revoked)),
+	)
+}
+
+// RotateSession exchanges the refresh token for a new one in the same family and returns
+// a session with it. The old refresh token is kept, flagged as rotated, until the next
+// rotation so it can be detected if it is used again. Within refreshTokenReuseInterval it
+// can still be exchanged, concurrent requests get a new refresh token each.
+func (wf *Workflows) RotateSession( //nolint:funlen
 	ctx context.Context,
 	user sql.AuthUser,
 	refreshToken string,
 	logger *slog.Logger,
 ) (*api.Session, *APIError) {
-	userRoles, err := wf.db.RefreshTokenAndGetUserRoles(ctx, sql.RefreshTokenAndGetUserRolesParams{
-		RefreshTokenHash: sql.Text(hashRefreshToken([]byte(refreshToken))),
-		ExpiresAt: sql.TimestampTz(
-			time.Now().Add(time.Duration(wf.config.RefreshTokenExpiresIn) * time.Second),
-		),
-	})
-	if errors.Is(err, pgx.ErrNoRows) {
-		logger.Warn("invalid refresh token")
-		return &api.Session{}, ErrInvalidRefreshToken //nolint:exhaustruct
+	newRefreshToken := uuid.New().String()
+	client := middleware.ClientInfoFromContext(ctx)
+	// the lifetimes recorded on the refresh token, if any, are kept over these
+	lifetimes := wf.sessionLifetimes(ctx)
+	clientID, refreshTokenExpiresIn, accessTokenExpiresIn := lifetimes.columns()
+	country, city, latitude, longitude := locationColumns(wf.clientLocation(ctx, logger))
+	userRoles, err := wf.db.RotateRefreshTokenAndGetUserRoles(
+		ctx,
+		sql.RotateRefreshTokenAndGetUserRolesParams{
+			OldRefreshTokenHash:   hashRefreshToken([]byte(refreshToken)),
+			ReuseInterval:         int32(refreshTokenReuseInterval.Seconds()),
+			RefreshTokenHash:      hashRefreshToken([]byte(newRefreshToken)),
+			SlidingExpiration:     wf.config.RefreshTokenExpiration != RefreshTokenExpirationFixed,
+			ExpiresAt:             sql.TimestampTz(lifetimes.refreshTokenExpiresAt()),
+			MaxLifetime:           sql.NullableInt4(wf.config.RefreshTokenMaxLifetime),
+			IpAddress:             sql.NullableText(client.IP),
+			UserAgent:             sql.NullableText(client.UserAgent),
+			ClientID:              clientID,
+			ClientVersion:         sql.NullableText(client.Version),
+			Country:               country,
+			City:                  city,
+			Latitude:              latitude,
+			Longitude:             longitude,
+			RefreshTokenExpiresIn: refreshTokenExpiresIn,
+			AccessTokenExpiresIn:  accessTokenExpiresIn,
+		},
+	)
+	if err != nil {
+		logger.Error("error rotating refresh token", logError(err))
+		return nil, ErrInternalServerError
+	}
+	if len(userRoles) == 0 {
+		// the token was deleted or rotated for longer than the reuse interval after we
+		// looked it up
+		logger.Warn("refresh token was already rotated")
+		wf.revokeReusedRefreshToken(ctx, refreshToken, logger)
+		return nil, ErrInvalidRefreshToken
 	}
 
 	allowedRoles := make([]string, 0, len(userRoles))
@@ -332,8 +573,13 @@ func (wf *Workflows) UpdateSession( //nolint:funlen
 		allowedRoles = append(allowedRoles, user.DefaultRole)
 	}
 
-	accessToken, expiresIn, err := wf.jwtGetter.GetToken(
-		ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole, logger,
+	accessTokenExpiration := lifetimes.accessTokenExpiration()
+	if userRoles[0].AccessTokenExpiresIn.Valid {
+		accessTokenExpiration = time.Duration(userRoles[0].AccessTokenExpiresIn.Int32) * time.Second
+	}
+
+	accessToken, expiresIn, err := wf.jwtGetter.GetTokenExpiringIn(
+		ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole, accessTokenExpiration, logger,
 	)
 	if err != nil {
 		logger.Error("error getting jwt", logError(err))
@@ -351,7 +597,7 @@ func (wf *Workflows) UpdateSession( //nolint:funlen
 	return &api.Session{
 		AccessToken:          accessToken,
 		AccessTokenExpiresIn: expiresIn,
-		RefreshToken:         refreshToken,
+		RefreshToken:         newRefreshToken,
 		RefreshTokenId:       userRoles[0].RefreshTokenID.String(),
 		User: &api.User{
 			AvatarUrl:           user.AvatarUrl,
@@ -376,6 +622,32 @@ func (wf *Workflows) NewSession(
 	user sql.AuthUser,
 	logger *slog.Logger,
 ) (*api.Session, error) {
+	return wf.newSession(ctx, user, false, logger)
+}
+
+// NewElevatedSession is like NewSession but the access token carries the
+// x-hasura-auth-elevated claim so it can be used on endpoints that require the user
+// to have recently verified their identity. The access token expires after
+// AUTH_ELEVATED_ACCESS_TOKEN_EXPIRES_IN seconds, tokens obtained by refreshing the
+// session aren't elevated.
+func (wf *Workflows) NewElevatedSession(
+	ctx context.Context,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) (*api.Session, error) {
+	return wf.newSession(ctx, user, true, logger)
+}
+
+func (wf *Workflows) newSession(
+	ctx context.Context,
+	user sql.AuthUser,
+	elevated bool,
+	logger *slog.Logger,
+) (*api.Session, error) {
+	if apiErr := wf.CheckSignInRisk(ctx, user, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
 	userRoles, err := wf.db.GetUserRoles(ctx, user.ID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting roles by user id: %w", err)
@@ -385,10 +657,16 @@ func (wf *Workflows) NewSession(
 		allowedRoles[i] = role.Role
 	}
 
+	if apiErr := wf.checkImpossibleTravel(ctx, user, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
+	wf.NotifyNewDeviceSignIn(ctx, user, logger)
+
 	refreshToken := uuid.New()
-	expiresAt := time.Now().Add(time.Duration(wf.config.RefreshTokenExpiresIn) * time.Second)
+	lifetimes := wf.sessionLifetimes(ctx)
 	refreshTokenID, apiErr := wf.InsertRefreshtoken(
-		ctx, user.ID, refreshToken.String(), expiresAt, sql.RefreshTokenTypeRegular, nil, logger,
+		ctx, user.ID, refreshToken.String(), lifetimes, sql.RefreshTokenTypeRegular, nil, logger,
 	)
 	if apiErr != nil {
 		return nil, apiErr
@@ -398,9 +676,23 @@ func (wf *Workflows) NewSession(
 		return nil, fmt.Errorf("error updating user last seen: %w", err)
 	}
 
-	accessToken, expiresIn, err := wf.jwtGetter.GetToken(
-		ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole, logger,
-	)
+	if !elevated {
+		wf.SendWebhook(ctx, webhooks.EventUserSignedIn, webhookUser(user))
+	}
+
+	var accessToken string
+	var expiresIn int64
+	if elevated {
+		accessToken, expiresIn, err = wf.jwtGetter.GetElevatedToken(
+			ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole,
+			time.Duration(wf.config.ElevatedTokenExpiresIn)*time.Second, logger,
+		)
+	} else {
+		accessToken, expiresIn, err = wf.jwtGetter.GetTokenExpiringIn(
+			ctx, user.ID, user.IsAnonymous, allowedRoles, user.DefaultRole,
+			lifetimes.accessTokenExpiration(), logger,
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error getting jwt: %w", err)
 	}
@@ -424,7 +716,7 @@ func (wf *Workflows) NewSession(
 			Email:               pgtypeTextToOAPIEmail(user.Email),
 			EmailVerified:       user.EmailVerified,
 			Id:                  user.ID.String(),
-			IsAnonymous:         false,
+			IsAnonymous:         user.IsAnonymous,
 			Locale:              user.Locale,
 			Metadata:            metadata,
 			PhoneNumber:         user.PhoneNumber.String,
@@ -475,7 +767,7 @@ func (wf *Workflows) InsertRefreshtoken(
 	ctx context.Context,
 	userID uuid.UUID,
 	refreshToken string,
-	refreshTokenExpiresAt time.Time,
+	lifetimes sessionLifetimes,
 	refreshTokenType sql.RefreshTokenType,
 	metadata map[string]any,
 	logger *slog.Logger,
@@ -490,18 +782,110 @@ func (wf *Workflows) InsertRefreshtoken(
 		}
 	}
 
-	refreshTokenID, err := wf.db.InsertRefreshtoken(ctx, sql.InsertRefreshtokenParams{
-		UserID:           userID,
-		RefreshTokenHash: sql.Text(hashRefreshToken([]byte(refreshToken))),
-		ExpiresAt:        sql.TimestampTz(refreshTokenExpiresAt),
-		Type:             refreshTokenType,
-		Metadata:         b,
+	client := middleware.ClientInfoFromContext(ctx)
+	clientID, refreshTokenExpiresIn, accessTokenExpiresIn := lifetimes.columns()
+	country, city, latitude, longitude := locationColumns(wf.clientLocation(ctx, logger))
+	var refreshTokenID uuid.UUID
+	insert := func(ctx context.Context) *APIError {
+		refreshTokenID, err = wf.db.InsertRefreshtoken(ctx, sql.InsertRefreshtokenParams{
+			UserID:                userID,
+			RefreshTokenHash:      hashRefreshToken([]byte(refreshToken)),
+			ExpiresAt:             sql.TimestampTz(lifetimes.refreshTokenExpiresAt()),
+			Type:                  refreshTokenType,
+			Metadata:              b,
+			IpAddress:             sql.NullableText(client.IP),
+			UserAgent:             sql.NullableText(client.UserAgent),
+			ClientID:              clientID,
+			ClientVersion:         sql.NullableText(client.Version),
+			Country:               country,
+			City:                  city,
+			Latitude:              latitude,
+			Longitude:             longitude,
+			RefreshTokenExpiresIn: refreshTokenExpiresIn,
+			AccessTokenExpiresIn:  accessTokenExpiresIn,
+		})
+		if err != nil {
+			return ErrInternalServerError
+		}
+		return nil
+	}
+
+	if refreshTokenType == sql.RefreshTokenTypeRegular {
+		if apiErr := wf.insertWithinSessionLimit(ctx, userID, insert, logger); apiErr != nil {
+			return uuid.UUID{}, apiErr
+		}
+	} else if apiErr := insert(ctx); apiErr != nil {
+		return uuid.UUID{}, apiErr
+	}
+
+	return refreshTokenID, nil
+}
+
+// InsertPersonalAccessToken stores the hash of the PAT. A nil expiresAt creates a PAT that
+// never expires.
+func (wf *Workflows) InsertPersonalAccessToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	pat string,
+	name string,
+	expiresAt *time.Time,
+	metadata map[string]any,
+	logger *slog.Logger,
+) (uuid.UUID, *APIError) {
+	var b []byte
+	var err error
+	if metadata != nil {
+		b, err = json.Marshal(metadata)
+		if err != nil {
+			logger.Error("error marshalling metadata", logError(err))
+			return uuid.UUID{}, ErrInternalServerError
+		}
+	}
+
+	var expires pgtype.Timestamptz
+	if expiresAt != nil {
+		expires = sql.TimestampTz(*expiresAt)
+	}
+
+	patID, err := wf.db.InsertPersonalAccessToken(ctx, sql.InsertPersonalAccessTokenParams{
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashRefreshToken([]byte(pat)),
+		ExpiresAt: expires,
+		Metadata:  b,
 	})
 	if err != nil {
+		logger.Error("error inserting personal access token", logError(err))
 		return uuid.UUID{}, ErrInternalServerError
 	}
 
-	return refreshTokenID, nil
+	return patID, nil
+}
+
+// RevokeSessions deletes all the refresh tokens and personal access tokens of the user and
+// records the time so access tokens issued before it can be rejected.
+func (wf *Workflows) RevokeSessions(
+	ctx context.Context,
+	userID uuid.UUID,
+	logger *slog.Logger,
+) *APIError {
+	n, err := wf.db.RevokeUserSessions(ctx, userID)
+	if err != nil {
+		logger.Error("error revoking sessions", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	logger.Info("revoked all sessions")
+
+	wf.SendWebhook(ctx, webhooks.EventUserSessionsRevoked, webhooks.User{ //nolint:exhaustruct
+		ID: userID,
+	})
+
+	return nil
 }
 
 func (wf *Workflows) ChangeEmail(
@@ -530,6 +914,69 @@ func (wf *Workflows) ChangeEmail(
 	return user, nil
 }
 
+// mergePatch applies a JSON merge patch (RFC 7386) to the document. Keys set to null are
+// removed, objects are merged recursively and any other value replaces the current one.
+func mergePatch(document map[string]any, patch map[string]any) map[string]any {
+	if document == nil {
+		document = make(map[string]any, len(patch))
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(document, key)
+			continue
+		}
+
+		patchObject, ok := value.(map[string]any)
+		if !ok {
+			document[key] = value
+			continue
+		}
+
+		current, _ := document[key].(map[string]any)
+		document[key] = mergePatch(current, patchObject)
+	}
+
+	return document
+}
+
+// UpdateUserMetadata applies the merge patch to the metadata of the user and returns the
+// result. The patched metadata must match the metadata schema.
+func (wf *Workflows) UpdateUserMetadata(
+	ctx context.Context, user sql.AuthUser, patch map[string]any, logger *slog.Logger,
+) (map[string]any, *APIError) {
+	var metadata map[string]any
+	if len(user.Metadata) > 0 {
+		if err := json.Unmarshal(user.Metadata, &metadata); err != nil {
+			logger.Error("error unmarshalling user metadata", logError(err))
+			return nil, ErrInternalServerError
+		}
+	}
+
+	metadata = mergePatch(metadata, patch)
+	if apiErr := wf.ValidateMetadata(metadata, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		logger.Error("error marshalling user metadata", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	if err := wf.db.UpdateUserMetadata(ctx, sql.UpdateUserMetadataParams{
+		ID:       user.ID,
+		Metadata: b,
+	}); err != nil {
+		logger.Error("error updating user metadata", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	logger.Info("user metadata updated")
+
+	return metadata, nil
+}
+
 func (wf *Workflows) SendEmail(
 	ctx context.Context,
 	to string,
@@ -560,15 +1007,19 @@ func (wf *Workflows) SendEmail(
 		locale,
 		templateName,
 		notifications.TemplateData{
-			Link:        link,
-			DisplayName: displayName,
-			Email:       email,
-			NewEmail:    newEmail,
-			Ticket:      ticket,
-			RedirectTo:  redirectTo,
-			Locale:      locale,
-			ServerURL:   wf.config.ServerURL.String(),
-			ClientURL:   wf.config.ClientURL.String(),
+			Link:             link,
+			DisplayName:      displayName,
+			Email:            email,
+			NewEmail:         newEmail,
+			Ticket:           ticket,
+			RedirectTo:       redirectTo,
+			Locale:           locale,
+			ServerURL:        wf.config.ServerURL.String(),
+			ClientURL:        wf.config.ClientURL.String(),
+			IPAddress:        "",
+			UserAgent:        "",
+			OrganizationName: "",
+			Location:         "",
 		},
 	); err != nil {
 		logger.Error("problem sending email", logError(err))
@@ -578,6 +1029,8 @@ func (wf *Workflows) SendEmail(
 	return nil
 }
 
+const RoleAnonymous = "anonymous"
+
 type SignUpFn func(input *sql.InsertUserParams) error
 
 func SignupUserWithID(id uuid.UUID) SignUpFn {
@@ -595,9 +1048,9 @@ func SignupUserWithTicket(ticket string, expiresAt time.Time) SignUpFn {
 	}
 }
 
-func SignupUserWithPassword(password string) SignUpFn {
+func (wf *Workflows) SignupUserWithPassword(password string) SignUpFn {
 	return func(input *sql.InsertUserParams) error {
-		hashedPassword, err := hashPassword(password)
+		hashedPassword, err := wf.hashUserPassword(password)
 		if err != nil {
 			return fmt.Errorf("error hashing password: %w", err)
 		}
@@ -608,6 +1061,15 @@ func SignupUserWithPassword(password string) SignUpFn {
 	}
 }
 
+func SignupUserAnonymous() SignUpFn {
+	return func(input *sql.InsertUserParams) error {
+		input.IsAnonymous = true
+		input.Email = pgtype.Text{} //nolint:exhaustruct
+		input.AvatarUrl = ""
+		return nil
+	}
+}
+
 func (wf *Workflows) SignUpUser( //nolint:funlen
 	ctx context.Context,
 	email string,
@@ -626,13 +1088,13 @@ func (wf *Workflows) SignUpUser( //nolint:funlen
 		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
 	}
 
-	gravatarURL := wf.gravatarURL(email)
+	avatarURL := wf.avatars.AvatarURL(email)
 
 	input := sql.InsertUserParams{
 		ID:              uuid.New(),
 		Disabled:        wf.config.DisableNewUsers,
 		DisplayName:     deptr(options.DisplayName),
-		AvatarUrl:       gravatarURL,
+		AvatarUrl:       avatarURL,
 		Email:           sql.Text(email),
 		PasswordHash:    pgtype.Text{}, //nolint:exhaustruct
 		Ticket:          pgtype.Text{}, //nolint:exhaustruct
@@ -642,6 +1104,8 @@ func (wf *Workflows) SignUpUser( //nolint:funlen
 		DefaultRole:     deptr(options.DefaultRole),
 		Metadata:        metadata,
 		Roles:           deptr(options.AllowedRoles),
+		PhoneNumber:     pgtype.Text{}, //nolint:exhaustruct
+		IsAnonymous:     false,
 	}
 
 	for _, fn := range withInputFn {
@@ -651,9 +1115,60 @@ func (wf *Workflows) SignUpUser( //nolint:funlen
 		}
 	}
 
-	insertedUser, err := wf.db.InsertUser(ctx, input)
-	if err != nil {
-		return sql.AuthUser{}, sqlErrIsDuplicatedEmail(err, logger) //nolint:exhaustruct
+	if apiErr := wf.checkDisposableEmail(input.Email.String, logger); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	if wf.preSignUpHook != nil {
+		hookRequest := webhooks.PreSignUpRequest{
+			Email:        input.Email.String,
+			PhoneNumber:  input.PhoneNumber.String,
+			DisplayName:  input.DisplayName,
+			Locale:       input.Locale,
+			DefaultRole:  input.DefaultRole,
+			AllowedRoles: input.Roles,
+			Metadata:     deptr(options.Metadata),
+			Provider:     "",
+			IsAnonymous:  input.IsAnonymous,
+			IPAddress:    "",
+			UserAgent:    "",
+		}
+		if apiErr := wf.callPreSignUpHook(ctx, &hookRequest, logger); apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+
+		metadata, err = json.Marshal(hookRequest.Metadata)
+		if err != nil {
+			logger.Error("error marshaling metadata", logError(err))
+			return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+		}
+		input.DefaultRole = hookRequest.DefaultRole
+		input.Roles = hookRequest.AllowedRoles
+		input.Metadata = metadata
+	}
+
+	var insertedUser sql.InsertUserRow
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		insertedUser, err = wf.db.InsertUser(ctx, input)
+		if err != nil {
+			if input.PhoneNumber.Valid {
+				// i.e. the phone number of a user of another tenant
+				return sqlErrIsDuplicatedPhoneNumber(err, logger)
+			}
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		wf.SendWebhook(ctx, webhooks.EventUserCreated, webhooks.User{
+			ID:          insertedUser.UserID,
+			Email:       input.Email.String,
+			PhoneNumber: input.PhoneNumber.String,
+			DisplayName: input.DisplayName,
+			IsAnonymous: input.IsAnonymous,
+		})
+
+		return nil
+	}); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
 	}
 
 	if wf.config.DisableNewUsers {
@@ -663,15 +1178,17 @@ func (wf *Workflows) SignUpUser( //nolint:funlen
 
 	return sql.AuthUser{ //nolint:exhaustruct
 		ID:                  insertedUser.UserID,
+		CreatedAt:           insertedUser.CreatedAt,
 		Disabled:            wf.config.DisableNewUsers,
-		DisplayName:         deptr(options.DisplayName),
-		AvatarUrl:           gravatarURL,
-		Locale:              deptr(options.Locale),
-		Email:               sql.Text(email),
+		DisplayName:         input.DisplayName,
+		AvatarUrl:           input.AvatarUrl,
+		Locale:              input.Locale,
+		Email:               input.Email,
+		PhoneNumber:         input.PhoneNumber,
 		EmailVerified:       false,
 		PhoneNumberVerified: false,
-		DefaultRole:         deptr(options.DefaultRole),
-		IsAnonymous:         false,
+		DefaultRole:         input.DefaultRole,
+		IsAnonymous:         input.IsAnonymous,
 		Metadata:            metadata,
 	}, nil
 }
@@ -681,7 +1198,7 @@ func (wf *Workflows) SignupUserWithRefreshToken( //nolint:funlen
 	email string,
 	password string,
 	refreshToken uuid.UUID,
-	expiresAt time.Time,
+	lifetimes sessionLifetimes,
 	options *api.SignUpOptions,
 	logger *slog.Logger,
 ) (*api.User, sql.InsertUserWithRefreshTokenRow, *APIError) {
@@ -690,41 +1207,72 @@ func (wf *Workflows) SignupUserWithRefreshToken( //nolint:funlen
 		return nil, sql.InsertUserWithRefreshTokenRow{}, ErrSignupDisabled //nolint:exhaustruct
 	}
 
+	if apiErr := wf.preSignUp(ctx, email, "", options, logger); apiErr != nil {
+		return nil, sql.InsertUserWithRefreshTokenRow{}, apiErr //nolint:exhaustruct
+	}
+
 	metadata, err := json.Marshal(options.Metadata)
 	if err != nil {
 		logger.Error("error marshaling metadata", logError(err))
 		return nil, sql.InsertUserWithRefreshTokenRow{}, ErrInternalServerError //nolint:exhaustruct
 	}
 
-	gravatarURL := wf.gravatarURL(email)
+	avatarURL := wf.avatars.AvatarURL(email)
 
-	hashedPassword, err := hashPassword(password)
+	hashedPassword, err := wf.hashUserPassword(password)
 	if err != nil {
 		logger.Error("error hashing password", logError(err))
 		return nil, sql.InsertUserWithRefreshTokenRow{}, ErrInternalServerError //nolint:exhaustruct
 	}
 
-	resp, err := wf.db.InsertUserWithRefreshToken(
-		ctx, sql.InsertUserWithRefreshTokenParams{
-			Disabled:              wf.config.DisableNewUsers,
-			DisplayName:           deptr(options.DisplayName),
-			AvatarUrl:             gravatarURL,
-			Email:                 sql.Text(email),
-			PasswordHash:          sql.Text(hashedPassword),
-			Ticket:                pgtype.Text{}, //nolint:exhaustruct
-			TicketExpiresAt:       sql.TimestampTz(time.Now()),
-			EmailVerified:         false,
-			Locale:                deptr(options.Locale),
-			DefaultRole:           deptr(options.DefaultRole),
-			Metadata:              metadata,
-			Roles:                 deptr(options.AllowedRoles),
-			RefreshTokenHash:      sql.Text(hashRefreshToken([]byte(refreshToken.String()))),
-			RefreshTokenExpiresAt: sql.TimestampTz(expiresAt),
-		},
-	)
-	if err != nil {
-		return nil, sql.InsertUserWithRefreshTokenRow{}, //nolint:exhaustruct
-			sqlErrIsDuplicatedEmail(err, logger)
+	client := middleware.ClientInfoFromContext(ctx)
+	clientID, refreshTokenExpiresIn, accessTokenExpiresIn := lifetimes.columns()
+	country, city, latitude, longitude := locationColumns(wf.clientLocation(ctx, logger))
+	var resp sql.InsertUserWithRefreshTokenRow
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		resp, err = wf.db.InsertUserWithRefreshToken(
+			ctx, sql.InsertUserWithRefreshTokenParams{
+				Disabled:                  wf.config.DisableNewUsers,
+				DisplayName:               deptr(options.DisplayName),
+				AvatarUrl:                 avatarURL,
+				Email:                     sql.Text(email),
+				PasswordHash:              sql.Text(hashedPassword),
+				Ticket:                    pgtype.Text{}, //nolint:exhaustruct
+				TicketExpiresAt:           sql.TimestampTz(time.Now()),
+				EmailVerified:             false,
+				Locale:                    deptr(options.Locale),
+				DefaultRole:               deptr(options.DefaultRole),
+				Metadata:                  metadata,
+				Roles:                     deptr(options.AllowedRoles),
+				RefreshTokenHash:          hashRefreshToken([]byte(refreshToken.String())),
+				RefreshTokenExpiresAt:     sql.TimestampTz(lifetimes.refreshTokenExpiresAt()),
+				RefreshTokenIpAddress:     sql.NullableText(client.IP),
+				RefreshTokenUserAgent:     sql.NullableText(client.UserAgent),
+				RefreshTokenClientID:      clientID,
+				RefreshTokenClientVersion: sql.NullableText(client.Version),
+				RefreshTokenCountry:       country,
+				RefreshTokenCity:          city,
+				RefreshTokenLatitude:      latitude,
+				RefreshTokenLongitude:     longitude,
+				RefreshTokenExpiresIn:     refreshTokenExpiresIn,
+				AccessTokenExpiresIn:      accessTokenExpiresIn,
+			},
+		)
+		if err != nil {
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		wf.SendWebhook(ctx, webhooks.EventUserCreated, webhooks.User{
+			ID:          resp.UserID,
+			Email:       email,
+			PhoneNumber: "",
+			DisplayName: deptr(options.DisplayName),
+			IsAnonymous: false,
+		})
+
+		return nil
+	}); apiErr != nil {
+		return nil, sql.InsertUserWithRefreshTokenRow{}, apiErr //nolint:exhaustruct
 	}
 
 	if wf.config.DisableNewUsers {
@@ -733,7 +1281,7 @@ func (wf *Workflows) SignupUserWithRefreshToken( //nolint:funlen
 	}
 
 	return &api.User{
-		AvatarUrl:           gravatarURL,
+		AvatarUrl:           avatarURL,
 		CreatedAt:           time.Now(),
 		DefaultRole:         *options.DefaultRole,
 		DisplayName:         deptr(options.DisplayName),
@@ -775,7 +1323,7 @@ func (wf *Workflows) DeanonymizeUser(
 		}
 	}
 
-	hashedPassword, err := hashPassword(password)
+	hashedPassword, err := wf.hashUserPassword(password)
 	if err != nil {
 		logger.Error("error hashing password", logError(err))
 		return ErrInternalServerError
@@ -815,10 +1363,9 @@ func (wf *Workflows) SignupUserWithSecurityKeyAndRefreshToken( //nolint:funlen
 	userID uuid.UUID,
 	email string,
 	refreshToken uuid.UUID,
-	expiresAt time.Time,
+	lifetimes sessionLifetimes,
 	options *api.SignUpOptions,
-	credentialID []byte,
-	credentialPublicKey []byte,
+	credential *webauthn.Credential,
 	nickname string,
 	logger *slog.Logger,
 ) (*api.User, uuid.UUID, *APIError) {
@@ -827,37 +1374,70 @@ func (wf *Workflows) SignupUserWithSecurityKeyAndRefreshToken( //nolint:funlen
 		return nil, uuid.UUID{}, ErrSignupDisabled
 	}
 
+	if apiErr := wf.preSignUp(ctx, email, "", options, logger); apiErr != nil {
+		return nil, uuid.UUID{}, apiErr
+	}
+
 	metadata, err := json.Marshal(options.Metadata)
 	if err != nil {
 		logger.Error("error marshaling metadata", logError(err))
 		return nil, uuid.UUID{}, ErrInternalServerError
 	}
 
-	gravatarURL := wf.gravatarURL(email)
-
-	resp, err := wf.db.InsertUserWithSecurityKeyAndRefreshToken(
-		ctx, sql.InsertUserWithSecurityKeyAndRefreshTokenParams{
-			ID:                    userID,
-			Disabled:              wf.config.DisableNewUsers,
-			DisplayName:           deptr(options.DisplayName),
-			AvatarUrl:             gravatarURL,
-			Email:                 sql.Text(email),
-			Ticket:                pgtype.Text{}, //nolint:exhaustruct
-			TicketExpiresAt:       sql.TimestampTz(time.Now()),
-			EmailVerified:         false,
-			Locale:                deptr(options.Locale),
-			DefaultRole:           deptr(options.DefaultRole),
-			Metadata:              metadata,
-			Roles:                 deptr(options.AllowedRoles),
-			RefreshTokenHash:      sql.Text(hashRefreshToken([]byte(refreshToken.String()))),
-			RefreshTokenExpiresAt: sql.TimestampTz(expiresAt),
-			CredentialID:          base64.RawURLEncoding.EncodeToString(credentialID),
-			CredentialPublicKey:   credentialPublicKey,
-			Nickname:              sql.Text(nickname),
-		},
-	)
-	if err != nil {
-		return nil, uuid.UUID{}, sqlErrIsDuplicatedEmail(err, logger)
+	avatarURL := wf.avatars.AvatarURL(email)
+
+	client := middleware.ClientInfoFromContext(ctx)
+	clientID, refreshTokenExpiresIn, accessTokenExpiresIn := lifetimes.columns()
+	country, city, latitude, longitude := locationColumns(wf.clientLocation(ctx, logger))
+	var resp sql.InsertUserWithSecurityKeyAndRefreshTokenRow
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		resp, err = wf.db.InsertUserWithSecurityKeyAndRefreshToken(
+			ctx, sql.InsertUserWithSecurityKeyAndRefreshTokenParams{
+				ID:                        userID,
+				Disabled:                  wf.config.DisableNewUsers,
+				DisplayName:               deptr(options.DisplayName),
+				AvatarUrl:                 avatarURL,
+				Email:                     sql.Text(email),
+				Ticket:                    pgtype.Text{}, //nolint:exhaustruct
+				TicketExpiresAt:           sql.TimestampTz(time.Now()),
+				EmailVerified:             false,
+				Locale:                    deptr(options.Locale),
+				DefaultRole:               deptr(options.DefaultRole),
+				Metadata:                  metadata,
+				Roles:                     deptr(options.AllowedRoles),
+				RefreshTokenHash:          hashRefreshToken([]byte(refreshToken.String())),
+				RefreshTokenExpiresAt:     sql.TimestampTz(lifetimes.refreshTokenExpiresAt()),
+				RefreshTokenIpAddress:     sql.NullableText(client.IP),
+				RefreshTokenUserAgent:     sql.NullableText(client.UserAgent),
+				RefreshTokenClientID:      clientID,
+				RefreshTokenClientVersion: sql.NullableText(client.Version),
+				RefreshTokenCountry:       country,
+				RefreshTokenCity:          city,
+				RefreshTokenLatitude:      latitude,
+				RefreshTokenLongitude:     longitude,
+				RefreshTokenExpiresIn:     refreshTokenExpiresIn,
+				AccessTokenExpiresIn:      accessTokenExpiresIn,
+				CredentialID:              base64.RawURLEncoding.EncodeToString(credential.ID),
+				CredentialPublicKey:       credential.PublicKey,
+				Transports:                credentialTransports(credential),
+				Nickname:                  sql.Text(nickname),
+			},
+		)
+		if err != nil {
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		wf.SendWebhook(ctx, webhooks.EventUserCreated, webhooks.User{
+			ID:          userID,
+			Email:       email,
+			PhoneNumber: "",
+			DisplayName: deptr(options.DisplayName),
+			IsAnonymous: false,
+		})
+
+		return nil
+	}); apiErr != nil {
+		return nil, uuid.UUID{}, apiErr
 	}
 
 	if wf.config.DisableNewUsers {
@@ -866,7 +1446,7 @@ func (wf *Workflows) SignupUserWithSecurityKeyAndRefreshToken( //nolint:funlen
 	}
 
 	return &api.User{
-		AvatarUrl:           gravatarURL,
+		AvatarUrl:           avatarURL,
 		CreatedAt:           time.Now(),
 		DefaultRole:         *options.DefaultRole,
 		DisplayName:         deptr(options.DisplayName),
@@ -889,8 +1469,7 @@ func (wf *Workflows) SignupUserWithSecurityKey( //nolint:funlen
 	ticket string,
 	ticketExpiresAt time.Time,
 	options *api.SignUpOptions,
-	credentialID []byte,
-	credentialPublicKey []byte,
+	credential *webauthn.Credential,
 	nickname string,
 	logger *slog.Logger,
 ) (*api.User, *APIError) {
@@ -899,34 +1478,53 @@ func (wf *Workflows) SignupUserWithSecurityKey( //nolint:funlen
 		return nil, ErrSignupDisabled
 	}
 
+	if apiErr := wf.preSignUp(ctx, email, "", options, logger); apiErr != nil {
+		return nil, apiErr
+	}
+
 	metadata, err := json.Marshal(options.Metadata)
 	if err != nil {
 		logger.Error("error marshaling metadata", logError(err))
 		return nil, ErrInternalServerError
 	}
 
-	gravatarURL := wf.gravatarURL(email)
-
-	if _, err := wf.db.InsertUserWithSecurityKey(
-		ctx, sql.InsertUserWithSecurityKeyParams{
-			ID:                  userID,
-			Disabled:            wf.config.DisableNewUsers,
-			DisplayName:         deptr(options.DisplayName),
-			AvatarUrl:           gravatarURL,
-			Email:               sql.Text(email),
-			Ticket:              sql.Text(ticket),
-			TicketExpiresAt:     sql.TimestampTz(ticketExpiresAt),
-			EmailVerified:       false,
-			Locale:              deptr(options.Locale),
-			DefaultRole:         deptr(options.DefaultRole),
-			Metadata:            metadata,
-			Roles:               deptr(options.AllowedRoles),
-			CredentialID:        base64.RawURLEncoding.EncodeToString(credentialID),
-			CredentialPublicKey: credentialPublicKey,
-			Nickname:            sql.Text(nickname),
-		},
-	); err != nil {
-		return nil, sqlErrIsDuplicatedEmail(err, logger)
+	avatarURL := wf.avatars.AvatarURL(email)
+
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		if _, err := wf.db.InsertUserWithSecurityKey(
+			ctx, sql.InsertUserWithSecurityKeyParams{
+				ID:                  userID,
+				Disabled:            wf.config.DisableNewUsers,
+				DisplayName:         deptr(options.DisplayName),
+				AvatarUrl:           avatarURL,
+				Email:               sql.Text(email),
+				Ticket:              sql.Text(ticket),
+				TicketExpiresAt:     sql.TimestampTz(ticketExpiresAt),
+				EmailVerified:       false,
+				Locale:              deptr(options.Locale),
+				DefaultRole:         deptr(options.DefaultRole),
+				Metadata:            metadata,
+				Roles:               deptr(options.AllowedRoles),
+				CredentialID:        base64.RawURLEncoding.EncodeToString(credential.ID),
+				CredentialPublicKey: credential.PublicKey,
+				Transports:          credentialTransports(credential),
+				Nickname:            sql.Text(nickname),
+			},
+		); err != nil {
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		wf.SendWebhook(ctx, webhooks.EventUserCreated, webhooks.User{
+			ID:          userID,
+			Email:       email,
+			PhoneNumber: "",
+			DisplayName: deptr(options.DisplayName),
+			IsAnonymous: false,
+		})
+
+		return nil
+	}); apiErr != nil {
+		return nil, apiErr
 	}
 
 	if wf.config.DisableNewUsers {
@@ -935,7 +1533,7 @@ func (wf *Workflows) SignupUserWithSecurityKey( //nolint:funlen
 	}
 
 	return &api.User{
-		AvatarUrl:           gravatarURL,
+		AvatarUrl:           avatarURL,
 		CreatedAt:           time.Now(),
 		DefaultRole:         *options.DefaultRole,
 		DisplayName:         deptr(options.DisplayName),
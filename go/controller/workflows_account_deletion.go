@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// ScheduleAccountDeletion marks the user for deletion at the end of the grace period and
+// signs out every session. Users with an email address are sent a link to cancel the
+// deletion until then.
+func (wf *Workflows) ScheduleAccountDeletion(
+	ctx context.Context,
+	user sql.AuthUser,
+	redirectTo string,
+	logger *slog.Logger,
+) (time.Time, *APIError) {
+	ticket := generateTicket(TicketTypeAccountDeletionCancel)
+	gracePeriod := time.Duration(wf.config.AccountDeletionGracePeriod) * 24 * time.Hour
+	scheduledAt := time.Now().Add(gracePeriod)
+
+	user, err := wf.db.ScheduleUserDeletion(ctx, sql.ScheduleUserDeletionParams{
+		ID:                  user.ID,
+		Ticket:              ticket,
+		DeletionScheduledAt: sql.TimestampTz(scheduledAt),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user not found")
+		return time.Time{}, ErrUserNotFound
+	}
+	if err != nil {
+		logger.Error("error scheduling user deletion", logError(err))
+		return time.Time{}, ErrInternalServerError
+	}
+
+	if user.Email.Valid {
+		if apiErr := wf.SendEmail(
+			ctx,
+			user.Email.String,
+			user.Locale,
+			LinkTypeAccountDeletionCancel,
+			ticket,
+			redirectTo,
+			notifications.TemplateNameAccountDeletion,
+			user.DisplayName,
+			user.Email.String,
+			"",
+			logger,
+		); apiErr != nil {
+			return time.Time{}, apiErr
+		}
+	} else {
+		logger.Warn("user has no email, the deletion can't be cancelled")
+	}
+
+	if apiErr := wf.RevokeSessions(ctx, user.ID, logger); apiErr != nil {
+		return time.Time{}, apiErr
+	}
+
+	logger.Info("account deletion scheduled", slog.Time("deletion_scheduled_at", scheduledAt))
+
+	return scheduledAt, nil
+}
+
+// CancelAccountDeletion cancels the deletion the ticket was sent for as long as the user
+// hasn't been deleted yet.
+func (wf *Workflows) CancelAccountDeletion(
+	ctx context.Context,
+	ticket string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.CancelUserDeletion(ctx, ticket)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("ticket not found or expired")
+		return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error cancelling user deletion", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	logger.Info("account deletion cancelled")
+
+	return user, nil
+}
@@ -0,0 +1,192 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // registers the gif decoder
+	"image/jpeg"
+	"image/png"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	// maxAvatarPixels is the largest image, in pixels, that is decoded so small files
+	// describing huge images don't exhaust the memory.
+	maxAvatarPixels   = 40_000_000
+	avatarJPEGQuality = 90
+)
+
+var errUnsupportedAvatar = errors.New("unsupported image")
+
+// resizeImage scales the image down to width and height. Each pixel is the average of
+// the pixels of the image it covers.
+func resizeImage(src image.Image, width int, height int) *image.RGBA64 {
+	dst := image.NewRGBA64(image.Rect(0, 0, width, height))
+	b := src.Bounds()
+
+	for y := range height {
+		y0 := b.Min.Y + y*b.Dy()/height
+		y1 := max(b.Min.Y+(y+1)*b.Dy()/height, y0+1)
+		for x := range width {
+			x0 := b.Min.X + x*b.Dx()/width
+			x1 := max(b.Min.X+(x+1)*b.Dx()/width, x0+1)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					bl += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+
+			dst.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / n),  //nolint:gosec
+				G: uint16(g / n),  //nolint:gosec
+				B: uint16(bl / n), //nolint:gosec
+				A: uint16(a / n),  //nolint:gosec
+			})
+		}
+	}
+
+	return dst
+}
+
+// processAvatar checks the image is a PNG, JPEG or GIF and scales it down to fit in a
+// square of size pixels, keeping its aspect ratio. JPEGs are stored as JPEG and the others
+// as PNG, only the first frame of animated GIFs is kept.
+func processAvatar(body []byte, size int) ([]byte, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding image config: %w", err)
+	}
+	if format != "png" && format != "jpeg" && format != "gif" {
+		return nil, "", fmt.Errorf("%w: %s", errUnsupportedAvatar, format)
+	}
+	if cfg.Width*cfg.Height > maxAvatarPixels {
+		return nil, "", fmt.Errorf(
+			"%w: image of %dx%d pixels", errUnsupportedAvatar, cfg.Width, cfg.Height,
+		)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding image: %w", err)
+	}
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(height*size/width, 1)
+		} else {
+			width, height = max(width*size/height, 1), size
+		}
+		img = resizeImage(img, width, height)
+	}
+
+	buf := &bytes.Buffer{}
+	contentType := "image/png"
+	if format == "jpeg" {
+		contentType = "image/jpeg"
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: avatarJPEGQuality})
+	} else {
+		err = png.Encode(buf, img)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("error encoding image: %w", err)
+	}
+
+	return buf.Bytes(), contentType, nil
+}
+
+// deleteStoredAvatar deletes the avatar from the storage. Failing to do so only leaves an
+// orphaned object behind so it is logged and ignored.
+func (wf *Workflows) deleteStoredAvatar(
+	ctx context.Context, avatarURL string, logger *slog.Logger,
+) {
+	if avatarURL == "" {
+		return
+	}
+
+	if err := wf.avatarStorage.Delete(ctx, avatarURL); err != nil {
+		logger.Warn("error deleting avatar from the storage", logError(err))
+	}
+}
+
+// UploadAvatar validates and resizes the image, stores it and sets it as the avatar of
+// the user. It returns the URL of the new avatar.
+func (wf *Workflows) UploadAvatar(
+	ctx context.Context, user sql.AuthUser, body []byte, logger *slog.Logger,
+) (string, *APIError) {
+	if wf.avatarStorage == nil {
+		logger.Warn("avatar uploads are disabled")
+		return "", ErrDisabledEndpoint
+	}
+
+	if len(body) > wf.config.AvatarUploadMaxSize {
+		logger.Warn("avatar is too large", slog.Int("size", len(body)))
+		return "", ErrAvatarTooLarge
+	}
+
+	avatar, contentType, err := processAvatar(body, wf.config.AvatarSize)
+	if err != nil {
+		logger.Warn("invalid avatar", logError(err))
+		return "", ErrInvalidAvatar
+	}
+
+	avatarURL, err := wf.avatarStorage.Upload(ctx, user.ID, contentType, avatar)
+	if err != nil {
+		logger.Error("error uploading avatar", logError(err))
+		return "", ErrInternalServerError
+	}
+
+	if err := wf.db.UpdateUserAvatarURL(ctx, sql.UpdateUserAvatarURLParams{
+		ID:        user.ID,
+		AvatarUrl: avatarURL,
+	}); err != nil {
+		logger.Error("error updating user avatar url", logError(err))
+		wf.deleteStoredAvatar(ctx, avatarURL, logger)
+		return "", ErrInternalServerError
+	}
+
+	logger.Info("avatar uploaded")
+
+	wf.deleteStoredAvatar(ctx, user.AvatarUrl, logger)
+
+	return avatarURL, nil
+}
+
+// DeleteAvatar deletes the uploaded avatar of the user, who gets the avatar of the avatar
+// provider back. It returns the URL of that avatar.
+func (wf *Workflows) DeleteAvatar(
+	ctx context.Context, user sql.AuthUser, logger *slog.Logger,
+) (string, *APIError) {
+	if wf.avatarStorage == nil {
+		logger.Warn("avatar uploads are disabled")
+		return "", ErrDisabledEndpoint
+	}
+
+	avatarURL := wf.avatars.AvatarURL(user.Email.String)
+	if err := wf.db.UpdateUserAvatarURL(ctx, sql.UpdateUserAvatarURLParams{
+		ID:        user.ID,
+		AvatarUrl: avatarURL,
+	}); err != nil {
+		logger.Error("error updating user avatar url", logError(err))
+		return "", ErrInternalServerError
+	}
+
+	logger.Info("avatar deleted")
+
+	wf.deleteStoredAvatar(ctx, user.AvatarUrl, logger)
+
+	return avatarURL, nil
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// userBanned reports if the user was banned and the ban hasn't expired yet.
+func userBanned(user sql.AuthUser) bool {
+	if !user.BannedAt.Valid {
+		return false
+	}
+	return !user.BannedUntil.Valid || user.BannedUntil.Time.After(time.Now())
+}
+
+func (wf *Workflows) setUserDisabled(
+	ctx context.Context, userID uuid.UUID, disabled bool, logger *slog.Logger,
+) *APIError {
+	n, err := wf.db.UpdateUserDisabled(ctx, sql.UpdateUserDisabledParams{
+		Disabled: disabled,
+		ID:       userID,
+	})
+	if err != nil {
+		logger.Error("error updating user disabled", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// DisableUser disables the user and revokes their sessions so they are signed out right
+// away instead of when their access token expires.
+func (wf *Workflows) DisableUser(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	if apiErr := wf.setUserDisabled(ctx, userID, true, logger); apiErr != nil {
+		return apiErr
+	}
+
+	logger.Info("user disabled")
+
+	return wf.RevokeSessions(ctx, userID, logger)
+}
+
+// EnableUser enables a disabled user. A ban of the user isn't lifted.
+func (wf *Workflows) EnableUser(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	if apiErr := wf.setUserDisabled(ctx, userID, false, logger); apiErr != nil {
+		return apiErr
+	}
+
+	logger.Info("user enabled")
+
+	return nil
+}
+
+// BanUser bans the user until expiresAt, or permanently if it is nil, and revokes their
+// sessions.
+func (wf *Workflows) BanUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	reason string,
+	expiresAt *time.Time,
+	logger *slog.Logger,
+) *APIError {
+	var bannedUntil pgtype.Timestamptz
+	if expiresAt != nil {
+		bannedUntil = sql.TimestampTz(*expiresAt)
+	}
+
+	n, err := wf.db.BanUser(ctx, sql.BanUserParams{
+		BannedUntil: bannedUntil,
+		BanReason:   sql.Text(reason),
+		ID:          userID,
+	})
+	if err != nil {
+		logger.Error("error banning user", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	logger.Info("user banned", slog.String("ban_reason", reason))
+
+	return wf.RevokeSessions(ctx, userID, logger)
+}
+
+// UnbanUser lifts the ban of the user.
+func (wf *Workflows) UnbanUser(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	n, err := wf.db.UnbanUser(ctx, userID)
+	if err != nil {
+		logger.Error("error unbanning user", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	logger.Info("user ban lifted")
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// dataExportTicketExpiresIn is how long the download URL of a completed export is valid
+// for. A new one is returned every time the export is fetched.
+const dataExportTicketExpiresIn = time.Hour
+
+type dataExportRequester string
+
+const (
+	dataExportRequestedByUser  dataExportRequester = "user"
+	dataExportRequestedByAdmin dataExportRequester = "admin"
+)
+
+// RequestDataExport queues an export of the data stored about the user. If an export of
+// the user is already pending it is returned instead so repeated requests don't queue
+// more work.
+func (wf *Workflows) RequestDataExport(
+	ctx context.Context,
+	userID uuid.UUID,
+	requestedBy dataExportRequester,
+	logger *slog.Logger,
+) (sql.AuthDataExport, *APIError) {
+	export, err := wf.db.GetPendingUserDataExport(ctx, userID)
+	if err == nil {
+		logger.Info("data export already pending", slog.String("export_id", export.ID.String()))
+		return export, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("error getting pending data export", logError(err))
+		return sql.AuthDataExport{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	export, err = wf.db.InsertDataExport(ctx, sql.InsertDataExportParams{
+		UserID:      userID,
+		RequestedBy: string(requestedBy),
+	})
+	if err != nil {
+		logger.Error("error inserting data export", logError(err))
+		return sql.AuthDataExport{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	logger.Info("data export requested", slog.String("export_id", export.ID.String()))
+
+	return export, nil
+}
+
+// GetDataExport returns the export. If owner is set exports of other users aren't found.
+func (wf *Workflows) GetDataExport(
+	ctx context.Context,
+	exportID uuid.UUID,
+	owner *uuid.UUID,
+	logger *slog.Logger,
+) (sql.AuthDataExport, *APIError) {
+	export, err := wf.db.GetDataExport(ctx, exportID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("data export not found", slog.String("export_id", exportID.String()))
+		return sql.AuthDataExport{}, ErrDataExportNotFound //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting data export", logError(err))
+		return sql.AuthDataExport{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if owner != nil && export.UserID != *owner {
+		logger.Warn(
+			"data export belongs to another user", slog.String("export_id", exportID.String()),
+		)
+		return sql.AuthDataExport{}, ErrDataExportNotFound //nolint:exhaustruct
+	}
+
+	return export, nil
+}
+
+func (wf *Workflows) dataExportDownloadURL(export sql.AuthDataExport) (string, error) {
+	expiresAt := time.Now().Add(dataExportTicketExpiresIn)
+	if export.ExpiresAt.Valid && export.ExpiresAt.Time.Before(expiresAt) {
+		expiresAt = export.ExpiresAt.Time
+	}
+
+	ticket, err := wf.jwtGetter.GetDataExportTicket(export.ID, expiresAt)
+	if err != nil {
+		return "", err
+	}
+
+	serverURL := *wf.config.ServerURL
+	path, err := url.JoinPath(serverURL.Path, "data-export", "download")
+	if err != nil {
+		return "", fmt.Errorf("problem appending /data-export/download to server url: %w", err)
+	}
+	serverURL.Path = path
+
+	query := serverURL.Query()
+	query.Add("ticket", ticket)
+	serverURL.RawQuery = query.Encode()
+
+	return serverURL.String(), nil
+}
+
+// DataExportResponse returns the status of the export, with a download URL if it is
+// completed.
+func (wf *Workflows) DataExportResponse(
+	export sql.AuthDataExport, logger *slog.Logger,
+) (api.DataExport, *APIError) {
+	resp := api.DataExport{
+		Id:          export.ID,
+		UserId:      export.UserID,
+		Status:      api.DataExportStatus(export.Status),
+		CreatedAt:   export.CreatedAt.Time,
+		CompletedAt: nil,
+		ExpiresAt:   nil,
+		DownloadUrl: nil,
+	}
+	if export.CompletedAt.Valid {
+		resp.CompletedAt = ptr(export.CompletedAt.Time)
+	}
+	if export.ExpiresAt.Valid {
+		resp.ExpiresAt = ptr(export.ExpiresAt.Time)
+	}
+
+	if resp.Status == api.Completed {
+		downloadURL, err := wf.dataExportDownloadURL(export)
+		if err != nil {
+			logger.Error("error generating data export download url", logError(err))
+			return api.DataExport{}, ErrInternalServerError //nolint:exhaustruct
+		}
+		resp.DownloadUrl = &downloadURL
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// InsertDeviceCode starts a device authorization request and returns the device code and
+// the user code. Only the hash of the device code is stored as it is a bearer credential.
+// Expired requests are pruned first, user codes are only unique among the pending ones.
+func (wf *Workflows) InsertDeviceCode(
+	ctx context.Context,
+	logger *slog.Logger,
+) (string, string, *APIError) {
+	if err := wf.db.DeleteExpiredDeviceCodes(ctx); err != nil {
+		logger.Error("error deleting expired device codes", logError(err))
+		return "", "", ErrInternalServerError
+	}
+
+	userCode, err := generateUserCode()
+	if err != nil {
+		logger.Error("error generating user code", logError(err))
+		return "", "", ErrInternalServerError
+	}
+
+	deviceCode := uuid.New().String()
+	expiresAt := time.Now().Add(time.Duration(wf.config.DeviceCodeExpiresIn) * time.Second)
+	if _, err := wf.db.InsertDeviceCode(ctx, sql.InsertDeviceCodeParams{
+		DeviceCodeHash: hashRefreshToken([]byte(deviceCode)),
+		UserCode:       normalizeUserCode(userCode),
+		ExpiresAt:      sql.TimestampTz(expiresAt),
+	}); err != nil {
+		logger.Error("error inserting device code", logError(err))
+		return "", "", ErrInternalServerError
+	}
+
+	return deviceCode, userCode, nil
+}
+
+// VerifyDeviceCode approves, or denies, the device authorization request with the given
+// user code on behalf of the user.
+func (wf *Workflows) VerifyDeviceCode(
+	ctx context.Context,
+	userCode string,
+	userID uuid.UUID,
+	approve bool,
+	logger *slog.Logger,
+) *APIError {
+	var err error
+	if approve {
+		_, err = wf.db.ApproveDeviceCode(ctx, sql.ApproveDeviceCodeParams{
+			UserCode: normalizeUserCode(userCode),
+			UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+		})
+	} else {
+		_, err = wf.db.DenyDeviceCode(ctx, normalizeUserCode(userCode))
+	}
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		logger.Warn("user code not found, expired or already used")
+		return ErrInvalidUserCode
+	case err != nil:
+		logger.Error("error verifying device code", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// PollDeviceCode returns the user that approved the device authorization request. The
+// request is consumed so the session can only be obtained once, until the user approves it
+// an error describing the state of the request is returned instead.
+func (wf *Workflows) PollDeviceCode( //nolint:cyclop
+	ctx context.Context,
+	deviceCode string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	dc, err := wf.db.GetDeviceCode(ctx, hashRefreshToken([]byte(deviceCode)))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		logger.Warn("device code not found")
+		return sql.AuthUser{}, ErrExpiredToken //nolint:exhaustruct
+	case err != nil:
+		logger.Error("error getting device code", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if time.Now().After(dc.ExpiresAt.Time) {
+		logger.Warn("device code expired")
+		return sql.AuthUser{}, ErrExpiredToken //nolint:exhaustruct
+	}
+
+	if !dc.UserID.Valid && !dc.Denied {
+		if err := wf.db.UpdateDeviceCodeLastPolled(ctx, dc.ID); err != nil {
+			logger.Error("error updating device code", logError(err))
+			return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+		}
+
+		interval := time.Duration(wf.config.DevicePollingInterval) * time.Second
+		if dc.LastPolledAt.Valid && time.Since(dc.LastPolledAt.Time) < interval {
+			logger.Warn("device is polling too frequently")
+			return sql.AuthUser{}, ErrSlowDown //nolint:exhaustruct
+		}
+
+		return sql.AuthUser{}, ErrAuthorizationPending //nolint:exhaustruct
+	}
+
+	// deleting the request ensures concurrent polls can't both get a session
+	userID, err := wf.db.DeleteDeviceCode(ctx, dc.ID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		logger.Warn("device code already used")
+		return sql.AuthUser{}, ErrExpiredToken //nolint:exhaustruct
+	case err != nil:
+		logger.Error("error deleting device code", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if dc.Denied {
+		logger.Warn("user denied the device")
+		return sql.AuthUser{}, ErrAccessDenied //nolint:exhaustruct
+	}
+
+	return wf.GetUser(ctx, uuid.UUID(userID.Bytes), logger.With(
+		slog.String("user_id", uuid.UUID(userID.Bytes).String()),
+	))
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/geoip"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	earthRadius = 6371 // km
+	// impossibleTravelMinDistance is the distance in km under which sign ins are never
+	// considered impossible travel, the locations of GeoIP databases being approximate.
+	impossibleTravelMinDistance = 500
+)
+
+// clientLocation returns the approximate location of the client of the request. It is
+// empty if there is no GeoIP database or it doesn't know the IP address.
+func (wf *Workflows) clientLocation(ctx context.Context, logger *slog.Logger) geoip.Location {
+	client := middleware.ClientInfoFromContext(ctx)
+	if wf.geoIP == nil || client.IP == "" {
+		return geoip.Location{} //nolint:exhaustruct
+	}
+
+	location, _, err := wf.geoIP.Locate(client.IP)
+	if err != nil {
+		logger.Warn("error locating the client", logError(err))
+		return geoip.Location{} //nolint:exhaustruct
+	}
+
+	return location
+}
+
+// locationColumns returns the country, city, latitude and longitude recorded on the
+// refresh tokens of a session, NULL if unknown.
+func locationColumns(l geoip.Location) (pgtype.Text, pgtype.Text, pgtype.Float8, pgtype.Float8) {
+	return sql.NullableText(l.Country),
+		sql.NullableText(l.City),
+		pgtype.Float8{Float64: l.Latitude, Valid: l.HasCoordinates},
+		pgtype.Float8{Float64: l.Longitude, Valid: l.HasCoordinates}
+}
+
+func formatLocation(l geoip.Location) string {
+	if l.City == "" {
+		return l.Country
+	}
+	return l.City + ", " + l.Country
+}
+
+// distance returns the great-circle distance in km between two points given in degrees.
+func distance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 } //nolint:mnd
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + //nolint:mnd
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2) //nolint:mnd
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(a)) //nolint:mnd
+}
+
+// lastLocatedSession returns the session with coordinates the user used last.
+func lastLocatedSession(sessions []sql.AuthRefreshToken) (sql.AuthRefreshToken, time.Time, bool) {
+	var last sql.AuthRefreshToken
+	var lastUsedAt time.Time
+	for _, session := range sessions {
+		if !session.Latitude.Valid || !session.Longitude.Valid {
+			continue
+		}
+
+		usedAt := session.CreatedAt.Time
+		if session.LastUsedAt.Valid {
+			usedAt = session.LastUsedAt.Time
+		}
+		if usedAt.After(lastUsedAt) {
+			last, lastUsedAt = session, usedAt
+		}
+	}
+
+	return last, lastUsedAt, !lastUsedAt.IsZero()
+}
+
+// checkImpossibleTravel compares where the user signs in from with where the user last
+// used a session. If getting there in the meantime takes traveling faster than
+// ImpossibleTravelMaxSpeed, the user is emailed about the sign in and, with the deny
+// policy, it is rejected.
+func (wf *Workflows) checkImpossibleTravel(
+	ctx context.Context, user sql.AuthUser, logger *slog.Logger,
+) *APIError {
+	policy := wf.config.ImpossibleTravelPolicy
+	if policy == "" || policy == ImpossibleTravelPolicyOff || wf.geoIP == nil {
+		return nil
+	}
+
+	location := wf.clientLocation(ctx, logger)
+	if !location.HasCoordinates {
+		return nil
+	}
+
+	sessions, err := wf.db.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user sessions", logError(err))
+		return ErrInternalServerError
+	}
+	last, lastUsedAt, ok := lastLocatedSession(sessions)
+	if !ok {
+		return nil
+	}
+
+	km := distance(last.Latitude.Float64, last.Longitude.Float64, location.Latitude, location.Longitude)
+	if km < impossibleTravelMinDistance {
+		return nil
+	}
+	hours := time.Since(lastUsedAt).Hours()
+	if hours > 0 && km/hours <= float64(wf.config.ImpossibleTravelMaxSpeed) {
+		return nil
+	}
+
+	logger.Warn(
+		"impossible travel since the last session",
+		slog.Int("distance", int(km)),
+		slog.String("session_id", last.ID.String()),
+		slog.String("previous_country", last.Country.String),
+		slog.String("country", location.Country),
+	)
+
+	errorCode := ""
+	if policy == ImpossibleTravelPolicyDeny {
+		errorCode = string(api.ImpossibleTravel)
+	}
+	wf.recordAuditEvent(
+		ctx, auditImpossibleTravel, user.ID, errorCode,
+		map[string]any{
+			"policy":          policy,
+			"distance":        int(km),
+			"sessionId":       last.ID.String(),
+			"previousCountry": last.Country.String,
+			"country":         location.Country,
+		},
+		logger,
+	)
+
+	if user.Email.Valid {
+		wf.sendSignInAlert(
+			ctx, user, notifications.TemplateNameSuspiciousSignIn, formatLocation(location), logger,
+		)
+	}
+
+	if policy == ImpossibleTravelPolicyDeny {
+		return ErrImpossibleTravel
+	}
+
+	return nil
+}
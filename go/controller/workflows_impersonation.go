@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+)
+
+const claimImpersonatedBy = "x-hasura-impersonated-by"
+
+// Impersonate returns an access token of the user carrying the x-hasura-impersonated-by
+// claim. No refresh token is issued so the impersonation ends when the access token
+// expires. If role is set it is the only role allowed, and it must be one of the roles of
+// the user.
+func (wf *Workflows) Impersonate(
+	ctx context.Context,
+	userID uuid.UUID,
+	impersonatedBy string,
+	role *string,
+	logger *slog.Logger,
+) (*api.ImpersonationResponse, *APIError) {
+	user, apiErr := wf.adminGetUser(ctx, userID, logger)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	userRoles, err := wf.db.GetUserRoles(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user roles", logError(err))
+		return nil, ErrInternalServerError
+	}
+	allowedRoles := make([]string, len(userRoles))
+	for i, r := range userRoles {
+		allowedRoles[i] = r.Role
+	}
+
+	defaultRole := user.DefaultRole
+	if role != nil {
+		if !slices.Contains(allowedRoles, *role) {
+			logger.Warn("role not allowed", slog.String("role", *role))
+			return nil, ErrRoleNotAllowed
+		}
+		allowedRoles = []string{*role}
+		defaultRole = *role
+	}
+
+	accessToken, expiresIn, err := wf.jwtGetter.GetToken(
+		ctx,
+		user.ID,
+		user.IsAnonymous,
+		allowedRoles,
+		defaultRole,
+		map[string]any{claimImpersonatedBy: impersonatedBy},
+		logger,
+	)
+	if err != nil {
+		logger.Error("error getting jwt", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	var metadata map[string]any
+	if len(user.Metadata) > 0 {
+		if err := json.Unmarshal(user.Metadata, &metadata); err != nil {
+			logger.Error("error unmarshalling user metadata", logError(err))
+			return nil, ErrInternalServerError
+		}
+	}
+
+	logger.Warn(
+		"user impersonated",
+		slog.String("security_event", "impersonation"),
+		slog.String("impersonated_by", impersonatedBy),
+	)
+
+	return &api.ImpersonationResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresIn: expiresIn,
+		User: api.User{
+			AvatarUrl:           user.AvatarUrl,
+			CreatedAt:           user.CreatedAt.Time,
+			DefaultRole:         defaultRole,
+			DisplayName:         user.DisplayName,
+			Email:               pgtypeTextToOAPIEmail(user.Email),
+			EmailVerified:       user.EmailVerified,
+			Id:                  user.ID.String(),
+			IsAnonymous:         user.IsAnonymous,
+			Locale:              user.Locale,
+			Metadata:            metadata,
+			PhoneNumber:         user.PhoneNumber.String,
+			PhoneNumberVerified: user.PhoneNumberVerified,
+			Roles:               allowedRoles,
+		},
+	}, nil
+}
@@ -0,0 +1,229 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func (wf *Workflows) lockoutDuration() time.Duration {
+	return time.Duration(wf.config.LockoutDuration) * time.Second
+}
+
+// userLockoutDelay returns for how long sign in attempts of a user are rejected after the
+// given number of consecutive failures. The first LockoutBackoffAfter failures don't
+// delay the next attempt, after that the delay doubles with every failure until the
+// LockoutThreshold is reached and the user is locked for LockoutDuration.
+func (wf *Workflows) userLockoutDelay(attempts int) time.Duration {
+	if wf.config.LockoutThreshold > 0 && attempts >= wf.config.LockoutThreshold {
+		return wf.lockoutDuration()
+	}
+
+	if attempts <= wf.config.LockoutBackoffAfter {
+		return 0
+	}
+
+	delay := time.Duration(wf.config.LockoutBackoffDelay) * time.Second
+	for range attempts - wf.config.LockoutBackoffAfter - 1 {
+		delay *= 2
+		if delay >= wf.lockoutDuration() {
+			return wf.lockoutDuration()
+		}
+	}
+
+	return delay
+}
+
+// CheckIPSignInLockout rejects the sign in attempt if the IP failed too many times.
+func (wf *Workflows) CheckIPSignInLockout(
+	ctx context.Context, ip string, logger *slog.Logger,
+) *APIError {
+	if !wf.config.LockoutEnabled || ip == "" {
+		return nil
+	}
+
+	lockedUntil, err := wf.db.GetIPLockedUntil(ctx, ip)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		logger.Error("error getting ip lockout", logError(err))
+		return ErrInternalServerError
+	}
+
+	if lockedUntil.Valid && lockedUntil.Time.After(time.Now()) {
+		logger.Warn("ip is locked out", slog.Time("locked_until", lockedUntil.Time))
+		return ErrSignInLocked
+	}
+
+	return nil
+}
+
+// CheckUserSignInLockout rejects the sign in attempt if the user is locked out.
+func (wf *Workflows) CheckUserSignInLockout(user sql.AuthUser, logger *slog.Logger) *APIError {
+	if !wf.config.LockoutEnabled {
+		return nil
+	}
+
+	if user.LockedUntil.Valid && user.LockedUntil.Time.After(time.Now()) {
+		logger.Warn("user is locked out", slog.Time("locked_until", user.LockedUntil.Time))
+		return ErrSignInLocked
+	}
+
+	return nil
+}
+
+func (wf *Workflows) recordUserSignInFailure(
+	ctx context.Context, user sql.AuthUser, logger *slog.Logger,
+) *APIError {
+	attempts, err := wf.db.RecordUserSignInFailure(ctx, sql.RecordUserSignInFailureParams{
+		ID:            user.ID,
+		FailuresSince: sql.TimestampTz(time.Now().Add(-wf.lockoutDuration())),
+	})
+	if err != nil {
+		logger.Error("error recording user sign in failure", logError(err))
+		return ErrInternalServerError
+	}
+
+	delay := wf.userLockoutDelay(int(attempts))
+	if delay == 0 {
+		return nil
+	}
+
+	if err := wf.db.UpdateUserLockedUntil(ctx, sql.UpdateUserLockedUntilParams{
+		ID:          user.ID,
+		LockedUntil: sql.TimestampTz(time.Now().Add(delay)),
+	}); err != nil {
+		logger.Error("error locking user", logError(err))
+		return ErrInternalServerError
+	}
+
+	if wf.config.LockoutThreshold <= 0 || int(attempts) != wf.config.LockoutThreshold {
+		return nil
+	}
+
+	logger.Warn("user locked out after too many failed sign in attempts")
+
+	if !user.Email.Valid {
+		return nil
+	}
+
+	if err := wf.email.SendEmail(
+		ctx,
+		user.Email.String,
+		user.Locale,
+		notifications.TemplateNameAccountLocked,
+		notifications.TemplateData{
+			Link:             "",
+			DisplayName:      user.DisplayName,
+			Email:            user.Email.String,
+			NewEmail:         "",
+			Ticket:           "",
+			RedirectTo:       "",
+			Locale:           user.Locale,
+			ServerURL:        wf.config.ServerURL.String(),
+			ClientURL:        wf.config.ClientURL.String(),
+			Code:             "",
+			IPAddress:        "",
+			UserAgent:        "",
+			OrganizationName: "",
+			Location:         "",
+		},
+	); err != nil {
+		logger.Error("problem sending account locked email", logError(err))
+	}
+
+	return nil
+}
+
+func (wf *Workflows) recordIPSignInFailure(
+	ctx context.Context, ip string, logger *slog.Logger,
+) *APIError {
+	attempts, err := wf.db.RecordIPSignInFailure(ctx, sql.RecordIPSignInFailureParams{
+		Ip:            ip,
+		FailuresSince: sql.TimestampTz(time.Now().Add(-wf.lockoutDuration())),
+	})
+	if err != nil {
+		logger.Error("error recording ip sign in failure", logError(err))
+		return ErrInternalServerError
+	}
+
+	if wf.config.LockoutIPThreshold <= 0 || int(attempts) < wf.config.LockoutIPThreshold {
+		return nil
+	}
+
+	if err := wf.db.UpdateIPLockedUntil(ctx, sql.UpdateIPLockedUntilParams{
+		Ip:          ip,
+		LockedUntil: sql.TimestampTz(time.Now().Add(wf.lockoutDuration())),
+	}); err != nil {
+		logger.Error("error locking ip", logError(err))
+		return ErrInternalServerError
+	}
+
+	logger.Warn("ip locked out after too many failed sign in attempts")
+
+	return nil
+}
+
+// RecordSignInFailure counts a failed sign in attempt against the IP and, if known, the
+// user. Failures older than the lockout duration are forgotten.
+func (wf *Workflows) RecordSignInFailure(
+	ctx context.Context, user *sql.AuthUser, ip string, logger *slog.Logger,
+) *APIError {
+	if !wf.config.LockoutEnabled {
+		return nil
+	}
+
+	if ip != "" {
+		if apiErr := wf.recordIPSignInFailure(ctx, ip, logger); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	if user != nil {
+		return wf.recordUserSignInFailure(ctx, *user, logger)
+	}
+
+	return nil
+}
+
+// ResetSignInFailures clears the failed attempts of the user after a successful sign in.
+func (wf *Workflows) ResetSignInFailures(
+	ctx context.Context, user sql.AuthUser, logger *slog.Logger,
+) *APIError {
+	if !wf.config.LockoutEnabled || user.FailedSignInAttempts == 0 {
+		return nil
+	}
+
+	if _, err := wf.db.ResetUserSignInFailures(ctx, user.ID); err != nil {
+		logger.Error("error resetting user sign in failures", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// UnlockUser clears the failed attempts and the lockout of the user.
+func (wf *Workflows) UnlockUser(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	n, err := wf.db.ResetUserSignInFailures(ctx, userID)
+	if err != nil {
+		logger.Error("error unlocking user", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	logger.Info("user unlocked")
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image/png"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/pquerna/otp/totp"
+)
+
+const MFATypeTOTP = "totp"
+
+const totpQRCodeSize = 200
+
+// maxOTPFailedAttempts is the number of wrong codes after which a MFA ticket, or a one
+// time password sent by SMS, is invalidated so the user has to ask for a new one.
+const maxOTPFailedAttempts = 5
+
+// generateTOTP returns a new TOTP secret for the given account together with
+// a QR code of its otpauth:// URI encoded as a PNG data URL.
+func generateTOTP(issuer string, accountName string) (string, string, error) {
+	key, err := totp.Generate(totp.GenerateOpts{ //nolint:exhaustruct
+		Issuer:      issuer,
+		AccountName: accountName,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("error generating totp key: %w", err)
+	}
+
+	img, err := key.Image(totpQRCodeSize, totpQRCodeSize)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating qr code: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", "", fmt.Errorf("error encoding qr code: %w", err)
+	}
+
+	imageURL := "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	return key.Secret(), imageURL, nil
+}
+
+func verifyTOTP(code string, secret string) bool {
+	return totp.Validate(code, secret)
+}
+
+func (wf *Workflows) UpdateUserTotpSecret(
+	ctx context.Context,
+	userID uuid.UUID,
+	secret string,
+	logger *slog.Logger,
+) *APIError {
+	if err := wf.db.UpdateUserTotpSecret(ctx, sql.UpdateUserTotpSecretParams{
+		ID:         userID,
+		TotpSecret: sql.Text(secret),
+	}); err != nil {
+		logger.Error("error updating user totp secret", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (wf *Workflows) UpdateUserActiveMFAType(
+	ctx context.Context,
+	userID uuid.UUID,
+	mfaType string,
+	logger *slog.Logger,
+) *APIError {
+	if err := wf.db.UpdateUserActiveMFAType(ctx, sql.UpdateUserActiveMFATypeParams{
+		ID:            userID,
+		ActiveMfaType: sql.Text(mfaType),
+	}); err != nil {
+		logger.Error("error updating user active mfa type", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// GetUserByMFATicket returns the user a MFA challenge ticket was issued to
+// without consuming it so the ticket can be retried with a different code.
+func (wf *Workflows) GetUserByMFATicket(
+	ctx context.Context,
+	ticket string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.GetUserByTicket(ctx, sql.Text(ticket))
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("ticket not found or expired")
+		return sql.AuthUser{}, ErrInvalidOTP //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting user by ticket", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
+// RecordMFATicketFailure counts a wrong answer to the MFA challenge of the ticket and
+// invalidates the ticket after maxOTPFailedAttempts so codes can't be guessed until it
+// expires.
+func (wf *Workflows) RecordMFATicketFailure(
+	ctx context.Context,
+	ticket string,
+	logger *slog.Logger,
+) *APIError {
+	attempts, err := wf.db.UpdateUserTicketFailedAttempts(
+		ctx, sql.UpdateUserTicketFailedAttemptsParams{
+			MaxAttempts: maxOTPFailedAttempts,
+			Ticket:      sql.Text(ticket),
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		logger.Error("error recording mfa ticket failure", logError(err))
+		return ErrInternalServerError
+	}
+
+	if attempts >= maxOTPFailedAttempts {
+		logger.Warn("too many failed attempts, mfa ticket invalidated")
+	}
+
+	return nil
+}
+
+// GenerateRecoveryCodes replaces the user's recovery codes with a new set and
+// returns them. This is the only time the codes are available in plain text.
+func (wf *Workflows) GenerateRecoveryCodes(
+	ctx context.Context,
+	userID uuid.UUID,
+	logger *slog.Logger,
+) ([]string, *APIError) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		logger.Error("error generating recovery codes", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	if err := wf.db.ReplaceRecoveryCodes(ctx, sql.ReplaceRecoveryCodesParams{
+		UserID:     userID,
+		CodeHashes: hashes,
+	}); err != nil {
+		logger.Error("error storing recovery codes", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	return codes, nil
+}
+
+func (wf *Workflows) CountRecoveryCodes(
+	ctx context.Context,
+	userID uuid.UUID,
+	logger *slog.Logger,
+) (int64, *APIError) {
+	count, err := wf.db.CountRecoveryCodes(ctx, userID)
+	if err != nil {
+		logger.Error("error counting recovery codes", logError(err))
+		return 0, ErrInternalServerError
+	}
+
+	return count, nil
+}
+
+// ConsumeRecoveryCode deletes the recovery code so it can't be used again.
+func (wf *Workflows) ConsumeRecoveryCode(
+	ctx context.Context,
+	userID uuid.UUID,
+	code string,
+	logger *slog.Logger,
+) *APIError {
+	_, err := wf.db.DeleteRecoveryCode(ctx, sql.DeleteRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: hashRecoveryCode(code),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("recovery code not found")
+		return ErrInvalidOTP
+	}
+	if err != nil {
+		logger.Error("error consuming recovery code", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
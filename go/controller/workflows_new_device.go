@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// newDeviceRevokeExpiresIn is how long the link sent after a sign in from a new device can
+// be used to sign out every session.
+const newDeviceRevokeExpiresIn = 7 * 24 * time.Hour
+
+// deviceFingerprint matches the device_fingerprint column generated for each refresh token.
+// It identifies a device well enough to notice a new one, it isn't used for security.
+func deviceFingerprint(ip string, userAgent string) string {
+	hash := md5.Sum([]byte(ip + " " + userAgent)) //nolint:gosec
+	return hex.EncodeToString(hash[:])
+}
+
+// NotifyNewDeviceSignIn emails the user when the sign in comes from an IP address and user
+// agent none of the refresh tokens of the user was issued to. Users signing in for the
+// first time aren't notified. It must be called before the refresh token of the new
+// session is inserted. Errors are logged but don't fail the sign in.
+func (wf *Workflows) NotifyNewDeviceSignIn(
+	ctx context.Context,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) {
+	if !wf.config.NotifyNewDeviceSignIn || !user.Email.Valid || !user.LastSeen.Valid {
+		return
+	}
+
+	client := middleware.ClientInfoFromContext(ctx)
+	known, err := wf.db.RefreshTokenDeviceFingerprintExists(
+		ctx,
+		sql.RefreshTokenDeviceFingerprintExistsParams{
+			UserID:            user.ID,
+			DeviceFingerprint: sql.Text(deviceFingerprint(client.IP, client.UserAgent)),
+		},
+	)
+	if err != nil {
+		logger.Error("error checking if the device is known", logError(err))
+		return
+	}
+	if known {
+		return
+	}
+
+	if wf.sendSignInAlert(ctx, user, notifications.TemplateNameNewDeviceSignIn, "", logger) {
+		logger.Info("new device sign in notified")
+	}
+}
+
+// sendSignInAlert emails the user about a sign in from the client of the request with a
+// link to sign out every session. It reports if the email was sent, errors are logged.
+func (wf *Workflows) sendSignInAlert(
+	ctx context.Context,
+	user sql.AuthUser,
+	templateName notifications.TemplateName,
+	location string,
+	logger *slog.Logger,
+) bool {
+	client := middleware.ClientInfoFromContext(ctx)
+	ticket := generateTicket(TicketTypeNewDeviceRevoke)
+	if err := wf.db.InsertNewDeviceSignIn(ctx, sql.InsertNewDeviceSignInParams{
+		UserID:    user.ID,
+		Ticket:    ticket,
+		IpAddress: sql.NullableText(client.IP),
+		UserAgent: sql.NullableText(client.UserAgent),
+		ExpiresAt: sql.TimestampTz(time.Now().Add(newDeviceRevokeExpiresIn)),
+	}); err != nil {
+		logger.Error("error inserting new device sign in", logError(err))
+		return false
+	}
+
+	redirectTo := wf.config.ClientURL.String()
+	link, err := GenLink(*wf.config.ServerURL, LinkTypeNewDeviceRevoke, ticket, redirectTo)
+	if err != nil {
+		logger.Error("problem generating new device revoke link", logError(err))
+		return false
+	}
+
+	if err := wf.email.SendEmail(
+		ctx,
+		user.Email.String,
+		user.Locale,
+		templateName,
+		notifications.TemplateData{
+			Link:             link,
+			DisplayName:      user.DisplayName,
+			Email:            user.Email.String,
+			NewEmail:         "",
+			Ticket:           ticket,
+			RedirectTo:       redirectTo,
+			Locale:           user.Locale,
+			ServerURL:        wf.config.ServerURL.String(),
+			ClientURL:        wf.config.ClientURL.String(),
+			Code:             "",
+			IPAddress:        client.IP,
+			UserAgent:        client.UserAgent,
+			OrganizationName: "",
+			Location:         location,
+		},
+	); err != nil {
+		logger.Error("problem sending sign in alert email", logError(err))
+		return false
+	}
+
+	return true
+}
+
+// RevokeNewDeviceSessions signs out every session of the user the new device ticket was
+// sent to because the user didn't recognize the sign in.
+func (wf *Workflows) RevokeNewDeviceSessions(
+	ctx context.Context,
+	ticket string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	signIn, err := wf.db.ConsumeNewDeviceSignIn(ctx, ticket)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("ticket not found or expired")
+		return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error consuming new device sign in", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if err := wf.db.DeleteUserNewDeviceSignIns(ctx, signIn.UserID); err != nil {
+		logger.Error("error deleting new device sign ins", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if apiErr := wf.RevokeSessions(ctx, signIn.UserID, logger); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	return wf.GetUser(ctx, signIn.UserID, logger)
+}
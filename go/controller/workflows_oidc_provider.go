@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// oauth2AuthorizationCodeExpiresIn is how long clients have to exchange authorization
+// codes, RFC 6749 section 4.1.2 recommends at most 10 minutes.
+const oauth2AuthorizationCodeExpiresIn = 10 * time.Minute
+
+// oauth2AuthorizationRequest is an authorization request the user approved.
+type oauth2AuthorizationRequest struct {
+	clientID      string
+	userID        uuid.UUID
+	redirectURI   string
+	scopes        []string
+	nonce         string
+	codeChallenge string
+}
+
+// GetOIDCClient returns the client if the redirect URI is registered for it and it can use
+// the authorization code grant.
+func (wf *Workflows) GetOIDCClient(
+	ctx context.Context, clientID string, redirectURI string, logger *slog.Logger,
+) (sql.AuthOauth2Client, *APIError) {
+	client, err := wf.db.GetOAuth2Client(ctx, clientID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("oauth2 client not found")
+		return sql.AuthOauth2Client{}, ErrClientNotFound //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting oauth2 client", logError(err))
+		return sql.AuthOauth2Client{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if !slices.Contains(client.RedirectUris, redirectURI) {
+		logger.Warn("redirect uri not registered for the client")
+		return sql.AuthOauth2Client{}, ErrRedirecToNotAllowed //nolint:exhaustruct
+	}
+
+	if !oauth2ClientGrantAllowed(client, api.OAuth2TokenRequestGrantTypeAuthorizationCode) {
+		logger.Warn("client is not allowed to sign in users with openid connect")
+		return sql.AuthOauth2Client{}, ErrUnauthorizedClient //nolint:exhaustruct
+	}
+
+	return client, nil
+}
+
+// OAuth2ConsentRequired reports if the user needs to be asked before the client can access
+// the scopes. Clients registered with skipConsent never need it.
+func (wf *Workflows) OAuth2ConsentRequired(
+	ctx context.Context,
+	client sql.AuthOauth2Client,
+	userID uuid.UUID,
+	scopes []string,
+	logger *slog.Logger,
+) (bool, *APIError) {
+	if client.SkipConsent {
+		return false, nil
+	}
+
+	granted, err := wf.db.GetOAuth2ConsentScopes(ctx, sql.GetOAuth2ConsentScopesParams{
+		UserID:   userID,
+		ClientID: client.ClientID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return true, nil
+	}
+	if err != nil {
+		logger.Error("error getting oauth2 consent", logError(err))
+		return false, ErrInternalServerError
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GrantOAuth2AuthorizationCode records the scopes the user allowed the client to access
+// and returns an authorization code the client can exchange for the tokens of the user.
+// Only the hash of the code is stored as it is a bearer credential.
+func (wf *Workflows) GrantOAuth2AuthorizationCode(
+	ctx context.Context, request oauth2AuthorizationRequest, logger *slog.Logger,
+) (string, *APIError) {
+	granted, err := wf.db.GetOAuth2ConsentScopes(ctx, sql.GetOAuth2ConsentScopesParams{
+		UserID:   request.userID,
+		ClientID: request.clientID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("error getting oauth2 consent", logError(err))
+		return "", ErrInternalServerError
+	}
+
+	for _, scope := range request.scopes {
+		if !slices.Contains(granted, scope) {
+			granted = append(granted, scope)
+		}
+	}
+
+	if err := wf.db.UpsertOAuth2Consent(ctx, sql.UpsertOAuth2ConsentParams{
+		UserID:   request.userID,
+		ClientID: request.clientID,
+		Scopes:   granted,
+	}); err != nil {
+		logger.Error("error upserting oauth2 consent", logError(err))
+		return "", ErrInternalServerError
+	}
+
+	code := uuid.New().String()
+	if err := wf.db.InsertOAuth2AuthorizationCode(ctx, sql.InsertOAuth2AuthorizationCodeParams{
+		CodeHash:      hashRefreshToken([]byte(code)),
+		ClientID:      request.clientID,
+		UserID:        request.userID,
+		RedirectUri:   request.redirectURI,
+		Scopes:        request.scopes,
+		Nonce:         sql.NullableText(request.nonce),
+		CodeChallenge: sql.NullableText(request.codeChallenge),
+		ExpiresAt:     sql.TimestampTz(time.Now().Add(oauth2AuthorizationCodeExpiresIn)),
+	}); err != nil {
+		logger.Error("error inserting oauth2 authorization code", logError(err))
+		return "", ErrInternalServerError
+	}
+
+	logger.Info("oauth2 authorization code granted")
+
+	return code, nil
+}
+
+// ConsumeOAuth2AuthorizationCode returns the authorization request of the code. The code is
+// deleted so it can only be exchanged once.
+func (wf *Workflows) ConsumeOAuth2AuthorizationCode(
+	ctx context.Context, clientID string, code string, logger *slog.Logger,
+) (sql.AuthOauth2AuthorizationCode, *APIError) {
+	authCode, err := wf.db.DeleteOAuth2AuthorizationCode(
+		ctx, sql.DeleteOAuth2AuthorizationCodeParams{
+			CodeHash: hashRefreshToken([]byte(code)),
+			ClientID: clientID,
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("authorization code not found or already used")
+		return sql.AuthOauth2AuthorizationCode{}, ErrInvalidGrant //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error deleting oauth2 authorization code", logError(err))
+		return sql.AuthOauth2AuthorizationCode{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if time.Now().After(authCode.ExpiresAt.Time) {
+		logger.Warn("authorization code expired")
+		return sql.AuthOauth2AuthorizationCode{}, ErrInvalidGrant //nolint:exhaustruct
+	}
+
+	return authCode, nil
+}
+
+// GetUserAllowedRoles returns the roles of the user.
+func (wf *Workflows) GetUserAllowedRoles(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) ([]string, *APIError) {
+	userRoles, err := wf.db.GetUserRoles(ctx, userID)
+	if err != nil {
+		logger.Error("error getting user roles", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	roles := make([]string, len(userRoles))
+	for i, r := range userRoles {
+		roles[i] = r.Role
+	}
+
+	return roles, nil
+}
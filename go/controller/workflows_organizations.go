@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// organizationInviteExpiresIn is how long an invitation to join an organization can be
+// accepted.
+const organizationInviteExpiresIn = 7 * 24 * time.Hour
+
+// organizationRoleRanks orders the roles of the organizations, members can't hand out a
+// role above their own.
+var organizationRoleRanks = map[api.OrganizationRole]int{ //nolint:gochecknoglobals
+	api.OrganizationRoleMember: 0,
+	api.OrganizationRoleAdmin:  1,
+	api.OrganizationRoleOwner:  2,
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (wf *Workflows) CreateOrganization(
+	ctx context.Context, userID uuid.UUID, name string, logger *slog.Logger,
+) (*api.Organization, *APIError) {
+	organization, err := wf.db.InsertOrganization(ctx, sql.InsertOrganizationParams{
+		Name:   name,
+		UserID: userID,
+	})
+	if err != nil {
+		logger.Error("error inserting organization", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	logger.Info("organization created", slog.String("organization_id", organization.ID.String()))
+
+	return &api.Organization{
+		Id:        organization.ID,
+		Name:      organization.Name,
+		CreatedAt: organization.CreatedAt.Time,
+		Role:      api.OrganizationRoleOwner,
+	}, nil
+}
+
+// GetOrganizationMember returns the organization and the role of the user in it.
+// Organizations the user isn't a member of are reported as not found.
+func (wf *Workflows) GetOrganizationMember(
+	ctx context.Context, organizationID uuid.UUID, userID uuid.UUID, logger *slog.Logger,
+) (sql.GetOrganizationMemberRow, *APIError) {
+	member, err := wf.db.GetOrganizationMember(ctx, sql.GetOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user isn't a member of the organization")
+		return sql.GetOrganizationMemberRow{}, ErrOrganizationNotFound //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting organization member", logError(err))
+		return sql.GetOrganizationMemberRow{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return member, nil
+}
+
+func organizationInviteLink(redirectTo string, ticket string) (string, error) {
+	link, err := url.Parse(redirectTo)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	query := link.Query()
+	query.Set("ticket", ticket)
+	query.Set("type", string(TicketTypeOrganizationInvite))
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
+
+// InviteOrganizationMember emails an invitation to join the organization of the member.
+// Only owners and admins can invite, and they can't give a role above their own. Inviting
+// an email again replaces the previous invitation.
+func (wf *Workflows) InviteOrganizationMember(
+	ctx context.Context,
+	member sql.GetOrganizationMemberRow,
+	invitedBy uuid.UUID,
+	email string,
+	role api.OrganizationRole,
+	redirectTo string,
+	logger *slog.Logger,
+) *APIError {
+	memberRole := api.OrganizationRole(member.Role)
+	if memberRole == api.OrganizationRoleMember ||
+		organizationRoleRanks[role] > organizationRoleRanks[memberRole] {
+		logger.Warn(
+			"role in the organization doesn't allow the invitation",
+			slog.String("organization_role", member.Role),
+			slog.String("invited_role", string(role)),
+		)
+		return ErrForbiddenOrganizationRole
+	}
+
+	ticket := generateTicket(TicketTypeOrganizationInvite)
+	if err := wf.db.UpsertOrganizationInvite(ctx, sql.UpsertOrganizationInviteParams{
+		OrganizationID: member.ID,
+		Email:          email,
+		Role:           string(role),
+		Ticket:         ticket,
+		InvitedBy:      pgtype.UUID{Bytes: invitedBy, Valid: true},
+		ExpiresAt:      sql.TimestampTz(time.Now().Add(organizationInviteExpiresIn)),
+	}); err != nil {
+		logger.Error("error inserting organization invite", logError(err))
+		return ErrInternalServerError
+	}
+
+	link, err := organizationInviteLink(redirectTo, ticket)
+	if err != nil {
+		logger.Error("problem generating organization invite link", logError(err))
+		return ErrInternalServerError
+	}
+
+	if err := wf.email.SendEmail(
+		ctx,
+		email,
+		wf.config.DefaultLocale,
+		notifications.TemplateNameOrganizationInvite,
+		notifications.TemplateData{
+			Link:             link,
+			DisplayName:      "",
+			Email:            email,
+			NewEmail:         "",
+			Ticket:           ticket,
+			RedirectTo:       redirectTo,
+			Locale:           wf.config.DefaultLocale,
+			ServerURL:        wf.config.ServerURL.String(),
+			ClientURL:        wf.config.ClientURL.String(),
+			Code:             "",
+			IPAddress:        "",
+			UserAgent:        "",
+			OrganizationName: member.Name,
+			Location:         "",
+		},
+	); err != nil {
+		logger.Error("problem sending organization invite email", logError(err))
+		return ErrInternalServerError
+	}
+
+	logger.Info("organization invite sent", slog.String("invited_role", string(role)))
+
+	return nil
+}
+
+// AcceptOrganizationInvite makes the user a member of the organization they were invited
+// to. The invitation must have been sent to the verified email of the user. Users that are
+// already members keep their role.
+func (wf *Workflows) AcceptOrganizationInvite(
+	ctx context.Context, user sql.AuthUser, ticket string, logger *slog.Logger,
+) (*api.Organization, *APIError) {
+	if !user.EmailVerified {
+		logger.Warn("user's email isn't verified")
+		return nil, ErrUnverifiedUser
+	}
+
+	organization, err := wf.db.AcceptOrganizationInvite(ctx, sql.AcceptOrganizationInviteParams{
+		Ticket: ticket,
+		Email:  user.Email.String,
+		UserID: user.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("organization invite not found, expired or sent to another email")
+		return nil, ErrInvalidTicket
+	}
+	if err != nil {
+		logger.Error("error accepting organization invite", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	logger.Info(
+		"organization invite accepted", slog.String("organization_id", organization.ID.String()),
+	)
+
+	return &api.Organization{
+		Id:        organization.ID,
+		Name:      organization.Name,
+		CreatedAt: organization.CreatedAt.Time,
+		Role:      api.OrganizationRole(organization.Role),
+	}, nil
+}
+
+// SwitchOrganization sets the organization the access tokens of the user are issued for.
+// A nil organization leaves the organizations.
+func (wf *Workflows) SwitchOrganization(
+	ctx context.Context, user sql.AuthUser, organizationID *uuid.UUID, logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	activeOrganizationID := pgtype.UUID{} //nolint:exhaustruct
+	if organizationID != nil {
+		if _, apiErr := wf.GetOrganizationMember(
+			ctx, *organizationID, user.ID, logger,
+		); apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+		activeOrganizationID = pgtype.UUID{Bytes: *organizationID, Valid: true}
+	}
+
+	user, err := wf.db.UpdateUserActiveOrganization(ctx, sql.UpdateUserActiveOrganizationParams{
+		ID:                   user.ID,
+		ActiveOrganizationID: activeOrganizationID,
+	})
+	if err != nil {
+		logger.Error("error updating active organization", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	logger.Info("active organization switched")
+
+	return user, nil
+}
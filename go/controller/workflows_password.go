@@ -0,0 +1,251 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// adminPasswordResetExpiresIn is how long the link sent when an administrator resets the
+// password of a user can be used. It is longer than the one of the links users request
+// themselves as they aren't expecting it.
+const adminPasswordResetExpiresIn = 24 * time.Hour
+
+func (wf *Workflows) updateUserPasswordHash(
+	ctx context.Context, userID uuid.UUID, hash pgtype.Text, logger *slog.Logger,
+) *APIError {
+	n, err := wf.db.UpdateUserPasswordHash(ctx, sql.UpdateUserPasswordHashParams{
+		PasswordHash: hash,
+		ID:           userID,
+	})
+	if err != nil {
+		logger.Error("error updating user password", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// hashUserPassword hashes the password of a user with the algorithm of the config, after
+// peppering it with the current pepper if there is any.
+func (wf *Workflows) hashUserPassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+
+	var pepper PasswordPepper
+	if len(wf.config.PasswordPeppers) > 0 {
+		pepper = wf.config.PasswordPeppers[0]
+		password = pepperPassword(password, pepper.Secret)
+	}
+
+	var hash string
+	var err error
+	if wf.config.PasswordHashAlgorithm == PasswordHashAlgorithmArgon2id {
+		hash, err = hashArgon2idPassword(
+			password,
+			wf.config.PasswordArgon2Memory,
+			wf.config.PasswordArgon2Iterations,
+			wf.config.PasswordArgon2Parallelism,
+		)
+	} else {
+		hash, err = hashPassword(password)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if pepper.ID != "" {
+		return pepperedHash(pepper.ID, hash), nil
+	}
+	return hash, nil
+}
+
+// VerifyUserPassword checks the password of a user against its hash, peppering it first
+// with the pepper the hash was generated with.
+func (wf *Workflows) VerifyUserPassword(password, hash string) bool {
+	pepperID, hash, ok := parsePepperedHash(hash)
+	if !ok {
+		return verifyHashPassword(password, hash)
+	}
+
+	i := slices.IndexFunc(wf.config.PasswordPeppers, func(p PasswordPepper) bool {
+		return p.ID == pepperID
+	})
+	if i < 0 {
+		return false
+	}
+
+	return verifyHashPassword(pepperPassword(password, wf.config.PasswordPeppers[i].Secret), hash)
+}
+
+// passwordNeedsRehash reports if the hash wasn't generated with the current pepper or, with
+// argon2id, isn't an argon2id hash with the parameters of the config. Hashes are only
+// upgraded to argon2id, bcrypt hashes are kept as they are when bcrypt is the algorithm.
+func (wf *Workflows) passwordNeedsRehash(hash string) bool {
+	pepperID, hash, _ := parsePepperedHash(hash)
+	if len(wf.config.PasswordPeppers) > 0 && pepperID != wf.config.PasswordPeppers[0].ID {
+		return true
+	}
+
+	if wf.config.PasswordHashAlgorithm != PasswordHashAlgorithmArgon2id {
+		return false
+	}
+
+	h, err := parseArgon2Hash(hash)
+	if err != nil {
+		return true
+	}
+
+	return h.variant != "argon2id" ||
+		h.memory != wf.config.PasswordArgon2Memory ||
+		h.time != wf.config.PasswordArgon2Iterations ||
+		h.threads != wf.config.PasswordArgon2Parallelism
+}
+
+// RehashPassword hashes again the password the user just signed in with if its hash wasn't
+// generated with the algorithm, parameters and pepper of the config, i.e. bcrypt hashes
+// once argon2id is the algorithm or hashes peppered with a pepper being rotated out. The
+// hash is only replaced if the password wasn't changed in the meantime. Errors are logged
+// and otherwise ignored as the user is already signed in.
+func (wf *Workflows) RehashPassword(
+	ctx context.Context, user sql.AuthUser, password string, logger *slog.Logger,
+) {
+	if !wf.passwordNeedsRehash(user.PasswordHash.String) {
+		return
+	}
+
+	hash, err := wf.hashUserPassword(password)
+	if err != nil {
+		logger.Error("error re-hashing password", logError(err))
+		return
+	}
+
+	if _, err := wf.db.UpdateUserRehashPassword(ctx, sql.UpdateUserRehashPasswordParams{
+		PasswordHash:    sql.Text(hash),
+		ID:              user.ID,
+		OldPasswordHash: user.PasswordHash,
+	}); err != nil {
+		logger.Error("error updating re-hashed password", logError(err))
+		return
+	}
+
+	logger.Info("password re-hashed")
+}
+
+// SetUserPassword sets the password of the user. The password must comply with the
+// password policy, a hash must be a bcrypt hash and is stored as is.
+func (wf *Workflows) SetUserPassword(
+	ctx context.Context,
+	userID uuid.UUID,
+	password *string,
+	passwordHash *string,
+	revokeSessions bool,
+	logger *slog.Logger,
+) *APIError {
+	if (password == nil) == (passwordHash == nil) {
+		logger.Warn("exactly one of password and passwordHash must be set")
+		return ErrInvalidRequest
+	}
+
+	user, apiErr := wf.adminGetUser(ctx, userID, logger)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	var hash string
+	if password != nil {
+		if apiErr := wf.ValidatePassword(
+			ctx, *password, user.Email.String, logger,
+		); apiErr != nil {
+			return apiErr
+		}
+
+		var err error
+		hash, err = wf.hashUserPassword(*password)
+		if err != nil {
+			logger.Error("error hashing password", logError(err))
+			return ErrInternalServerError
+		}
+	} else {
+		if !validPasswordHash(*passwordHash) {
+			logger.Warn("password hash isn't a bcrypt or argon2 hash")
+			return ErrInvalidRequest
+		}
+		hash = *passwordHash
+	}
+
+	if apiErr := wf.updateUserPasswordHash(ctx, userID, sql.Text(hash), logger); apiErr != nil {
+		return apiErr
+	}
+
+	logger.Info("user password set")
+
+	if revokeSessions {
+		return wf.RevokeSessions(ctx, userID, logger)
+	}
+
+	return nil
+}
+
+// ForcePasswordReset removes the password of the user so it can't be used anymore,
+// revokes their sessions and emails them a link to set a new one.
+func (wf *Workflows) ForcePasswordReset(
+	ctx context.Context,
+	userID uuid.UUID,
+	redirectTo string,
+	logger *slog.Logger,
+) *APIError {
+	user, apiErr := wf.adminGetUser(ctx, userID, logger)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	if !user.Email.Valid {
+		logger.Warn("user doesn't have an email to send the password reset link to")
+		return ErrInvalidRequest
+	}
+
+	if apiErr := wf.updateUserPasswordHash(
+		ctx, userID, pgtype.Text{}, logger, //nolint:exhaustruct
+	); apiErr != nil {
+		return apiErr
+	}
+
+	logger.Warn("user password invalidated", slog.String("security_event", "password_reset"))
+
+	if apiErr := wf.RevokeSessions(ctx, userID, logger); apiErr != nil {
+		return apiErr
+	}
+
+	ticket := generateTicket(TicketTypePasswordReset)
+	if apiErr := wf.SetTicket(
+		ctx, userID, ticket, time.Now().Add(adminPasswordResetExpiresIn), logger,
+	); apiErr != nil {
+		return apiErr
+	}
+
+	return wf.SendEmail(
+		ctx,
+		user.Email.String,
+		user.Locale,
+		LinkTypePasswordReset,
+		ticket,
+		redirectTo,
+		notifications.TemplateNamePasswordReset,
+		user.DisplayName,
+		user.Email.String,
+		"",
+		logger,
+	)
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/metrics"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// checkDisposableEmail flags or rejects, depending on the DisposableEmailCheck setting, the
+// sign ups with the email of a disposable email provider.
+func (wf *Workflows) checkDisposableEmail(email string, logger *slog.Logger) *APIError {
+	if wf.disposableEmails == nil || email == "" ||
+		wf.config.DisposableEmailCheck == DisposableEmailCheckOff ||
+		wf.config.DisposableEmailCheck == "" {
+		return nil
+	}
+
+	if !wf.disposableEmails.IsDisposable(email) {
+		return nil
+	}
+
+	_, domain, _ := strings.Cut(email, "@")
+	if wf.config.DisposableEmailCheck == DisposableEmailCheckReject {
+		logger.Warn("sign up with a disposable email rejected", slog.String("domain", domain))
+		metrics.DisposableEmailSignUps.WithLabelValues("rejected").Inc()
+		return ErrDisposableEmail
+	}
+
+	logger.Warn("sign up with a disposable email", slog.String("domain", domain))
+	metrics.DisposableEmailSignUps.WithLabelValues("flagged").Inc()
+
+	return nil
+}
+
+// callPreSignUpHook asks the pre sign up hook, if configured, whether the user in request
+// can sign up, replacing the default role, allowed roles and metadata of request with the
+// ones returned by the hook. The sign up is rejected if the hook can't be reached.
+func (wf *Workflows) callPreSignUpHook(
+	ctx context.Context, request *webhooks.PreSignUpRequest, logger *slog.Logger,
+) *APIError {
+	if wf.preSignUpHook == nil {
+		return nil
+	}
+
+	client := middleware.ClientInfoFromContext(ctx)
+	request.IPAddress = client.IP
+	request.UserAgent = client.UserAgent
+
+	decision, err := wf.preSignUpHook.Call(ctx, *request)
+	if err != nil {
+		logger.Error("error calling pre sign up hook", logError(err))
+		return ErrInternalServerError
+	}
+
+	if !decision.Allow {
+		logger.Warn("sign up rejected by pre sign up hook", slog.String("message", decision.Message))
+		return signupRejectedError(decision.Message)
+	}
+
+	if decision.DefaultRole != nil {
+		request.DefaultRole = *decision.DefaultRole
+	}
+	if decision.AllowedRoles != nil {
+		request.AllowedRoles = *decision.AllowedRoles
+	}
+	if decision.Metadata != nil {
+		request.Metadata = *decision.Metadata
+	}
+
+	if !slices.Contains(request.AllowedRoles, request.DefaultRole) {
+		logger.Error(
+			"pre sign up hook returned a default role that isn't in the allowed roles",
+			slog.String("default_role", request.DefaultRole),
+		)
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// preSignUp calls the pre sign up hook with the user about to be created and updates the
+// options with the changes returned by the hook. options must have been validated.
+func (wf *Workflows) preSignUp(
+	ctx context.Context,
+	email string,
+	provider string,
+	options *api.SignUpOptions,
+	logger *slog.Logger,
+) *APIError {
+	if apiErr := wf.checkDisposableEmail(email, logger); apiErr != nil {
+		return apiErr
+	}
+
+	if wf.preSignUpHook == nil {
+		return nil
+	}
+
+	request := webhooks.PreSignUpRequest{
+		Email:        email,
+		PhoneNumber:  "",
+		DisplayName:  deptr(options.DisplayName),
+		Locale:       deptr(options.Locale),
+		DefaultRole:  deptr(options.DefaultRole),
+		AllowedRoles: deptr(options.AllowedRoles),
+		Metadata:     deptr(options.Metadata),
+		Provider:     provider,
+		IsAnonymous:  false,
+		IPAddress:    "",
+		UserAgent:    "",
+	}
+	if apiErr := wf.callPreSignUpHook(ctx, &request, logger); apiErr != nil {
+		return apiErr
+	}
+
+	options.DefaultRole = &request.DefaultRole
+	options.AllowedRoles = &request.AllowedRoles
+	options.Metadata = &request.Metadata
+
+	return nil
+}
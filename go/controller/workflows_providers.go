@@ -0,0 +1,617 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/providers"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+	"golang.org/x/oauth2"
+)
+
+// providerRequest is stored in auth.provider_requests, keyed by the OAuth state, while
+// the user authenticates with the provider.
+type providerRequest struct {
+	CodeVerifier string            `json:"codeVerifier"`
+	Options      api.SignUpOptions `json:"options"`
+	// SAMLRequestID is the ID of the SAML authentication request, the response must
+	// be in response to it
+	SAMLRequestID string `json:"samlRequestId,omitempty"`
+	// LinkUserID is the ID of the user the provider's account is linked to instead of
+	// signing in
+	LinkUserID *uuid.UUID `json:"linkUserId,omitempty"`
+	// DeanonymizeUserID is the ID of the anonymous user that is upgraded to a regular
+	// user with the provider's account instead of signing in
+	DeanonymizeUserID *uuid.UUID `json:"deanonymizeUserId,omitempty"`
+	// NonceHash is the S256 hash of the nonce stored in a cookie when the user is sent to
+	// the provider, the callback has to come from the same browser
+	NonceHash string `json:"nonceHash,omitempty"`
+}
+
+func (wf *Workflows) InsertProviderRequest(
+	ctx context.Context,
+	state uuid.UUID,
+	request providerRequest,
+	logger *slog.Logger,
+) *APIError {
+	options, err := json.Marshal(request)
+	if err != nil {
+		logger.Error("error marshalling provider request", logError(err))
+		return ErrInternalServerError
+	}
+
+	if err := wf.db.InsertProviderRequest(
+		ctx, sql.InsertProviderRequestParams{
+			ID:      state,
+			Options: options,
+		},
+	); err != nil {
+		logger.Error("error inserting provider request", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// ConsumeProviderRequest returns the provider request matching the state and deletes it
+// so the state can't be reused.
+func (wf *Workflows) ConsumeProviderRequest(
+	ctx context.Context,
+	state string,
+	logger *slog.Logger,
+) (providerRequest, *APIError) {
+	id, err := uuid.Parse(state)
+	if err != nil {
+		logger.Warn("error parsing state", logError(err))
+		return providerRequest{}, ErrInvalidState //nolint:exhaustruct
+	}
+
+	b, err := wf.db.DeleteProviderRequest(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("provider request not found")
+		return providerRequest{}, ErrInvalidState //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error deleting provider request", logError(err))
+		return providerRequest{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	var request providerRequest
+	if err := json.Unmarshal(b, &request); err != nil {
+		logger.Error("error unmarshalling provider request", logError(err))
+		return providerRequest{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return request, nil
+}
+
+func (wf *Workflows) SignupUserWithUserProvider( //nolint:funlen
+	ctx context.Context,
+	providerID string,
+	profile providers.Profile,
+	token *oauth2.Token,
+	options *api.SignUpOptions,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	if wf.config.DisableSignup {
+		logger.Warn("signup disabled")
+		return sql.AuthUser{}, ErrSignupDisabled //nolint:exhaustruct
+	}
+
+	if apiErr := wf.preSignUp(ctx, profile.Email, providerID, options, logger); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	metadata, err := json.Marshal(options.Metadata)
+	if err != nil {
+		logger.Error("error marshaling metadata", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	avatarURL := profile.AvatarURL
+	if avatarURL == "" {
+		avatarURL = wf.avatars.AvatarURL(profile.Email)
+	}
+
+	input := sql.InsertUserWithUserProviderParams{
+		ID:             uuid.New(),
+		Disabled:       wf.config.DisableNewUsers,
+		DisplayName:    deptr(options.DisplayName),
+		AvatarUrl:      avatarURL,
+		Email:          sql.Text(profile.Email),
+		EmailVerified:  profile.EmailVerified,
+		Locale:         deptr(options.Locale),
+		DefaultRole:    deptr(options.DefaultRole),
+		Metadata:       metadata,
+		Roles:          deptr(options.AllowedRoles),
+		ProviderID:     providerID,
+		ProviderUserID: profile.ID,
+		AccessToken:    token.AccessToken,
+		RefreshToken:   providerRefreshToken(token),
+	}
+
+	var user sql.AuthUser
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		insertedUser, err := wf.db.InsertUserWithUserProvider(ctx, input)
+		if err != nil {
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		user = sql.AuthUser{ //nolint:exhaustruct
+			ID:            insertedUser.UserID,
+			CreatedAt:     insertedUser.CreatedAt,
+			Disabled:      input.Disabled,
+			DisplayName:   input.DisplayName,
+			AvatarUrl:     input.AvatarUrl,
+			Locale:        input.Locale,
+			Email:         input.Email,
+			EmailVerified: input.EmailVerified,
+			DefaultRole:   input.DefaultRole,
+			Metadata:      metadata,
+		}
+
+		wf.SendWebhook(ctx, webhooks.EventUserCreated, webhookUser(user))
+
+		return nil
+	}); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
+func providerRefreshToken(token *oauth2.Token) pgtype.Text {
+	if token.RefreshToken == "" {
+		return pgtype.Text{} //nolint:exhaustruct
+	}
+	return sql.Text(token.RefreshToken)
+}
+
+// updateProviderSession stores the tokens the provider returned for the user's account.
+// Providers don't always return them, i.e. a refresh token is only returned the first
+// time the user consents, so the stored ones are only replaced by non-empty values.
+func (wf *Workflows) updateProviderSession(
+	ctx context.Context,
+	providerID string,
+	profile providers.Profile,
+	token *oauth2.Token,
+	logger *slog.Logger,
+) *APIError {
+	if token.AccessToken == "" && token.RefreshToken == "" {
+		return nil
+	}
+
+	if err := wf.db.UpdateProviderSession(ctx, sql.UpdateProviderSessionParams{
+		AccessToken:    token.AccessToken,
+		RefreshToken:   providerRefreshToken(token),
+		ProviderID:     providerID,
+		ProviderUserID: profile.ID,
+	}); err != nil {
+		logger.Error("error updating provider session", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// providerSignUpOptions fills the options the user didn't set with the information
+// returned by the provider before validating them.
+func (wf *Workflows) providerSignUpOptions(
+	options api.SignUpOptions,
+	profile providers.Profile,
+	logger *slog.Logger,
+) (*api.SignUpOptions, *APIError) {
+	if options.Locale == nil && profile.Locale != "" {
+		options.Locale = ptr(profile.Locale)
+	}
+
+	// the picture and locale are also kept in the metadata, the columns only hold the
+	// avatar and the language the user chose
+	metadata := make(map[string]any)
+	if options.Metadata != nil {
+		maps.Copy(metadata, *options.Metadata)
+	}
+	for key, value := range map[string]string{
+		"picture": profile.AvatarURL,
+		"locale":  profile.Locale,
+	} {
+		if _, ok := metadata[key]; !ok && value != "" {
+			metadata[key] = value
+		}
+	}
+	if len(metadata) > 0 {
+		options.Metadata = &metadata
+	}
+
+	displayName := profile.Name
+	if displayName == "" {
+		displayName = profile.Email
+	}
+
+	return wf.ValidateSignUpOptions(&options, displayName, SignInMethodProvider, logger)
+}
+
+// samlSignUpOptions sets the roles of the user, if it is signing up, to the ones asserted
+// by the identity provider. Roles that aren't allowed are ignored.
+func (wf *Workflows) samlSignUpOptions(
+	options api.SignUpOptions,
+	roles []string,
+	logger *slog.Logger,
+) api.SignUpOptions {
+	providerDefaultRole, providerAllowedRoles := wf.config.SignInMethodRoles(SignInMethodProvider)
+
+	allowedRoles := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if !slices.Contains(providerAllowedRoles, role) {
+			logger.Warn("ignoring role asserted by the identity provider", slog.String("role", role))
+			continue
+		}
+		allowedRoles = append(allowedRoles, role)
+	}
+
+	if len(allowedRoles) == 0 {
+		return options
+	}
+
+	defaultRole := deptr(options.DefaultRole)
+	if defaultRole == "" {
+		defaultRole = providerDefaultRole
+	}
+	if !slices.Contains(allowedRoles, defaultRole) {
+		defaultRole = allowedRoles[0]
+	}
+
+	options.AllowedRoles = &allowedRoles
+	options.DefaultRole = &defaultRole
+
+	return options
+}
+
+// SignInWithProvider returns the user linked to the provider's account, updating the
+// tokens we store for it. If there is none, the account is linked to the user with the
+// same email, provided the provider verified it and it isn't a private relay address,
+// or a new user is created.
+func (wf *Workflows) SignInWithProvider( //nolint:cyclop,funlen
+	ctx context.Context,
+	providerID string,
+	profile providers.Profile,
+	token *oauth2.Token,
+	options api.SignUpOptions,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.GetUserByProviderID(ctx, sql.GetUserByProviderIDParams{
+		ProviderID:     providerID,
+		ProviderUserID: profile.ID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		logger.Error("error getting user by provider id", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	default:
+		if apiErr := wf.updateProviderSession(ctx, providerID, profile, token, logger); apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+
+		return user, wf.ValidateUser(user, logger)
+	}
+
+	if profile.Email != "" {
+		user, err = wf.db.GetUserByEmail(ctx, sql.Text(profile.Email))
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			logger.Error("error getting user by email", logError(err))
+			return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+		case !profile.EmailVerified:
+			logger.Warn("provider didn't verify the email, refusing to link it to an existing user")
+			return sql.AuthUser{}, ErrEmailAlreadyInUse //nolint:exhaustruct
+		case profile.PrivateEmail:
+			// relay addresses are managed by the provider, we only trust the provider's
+			// user id to identify the owner of an account using one
+			logger.Warn("email is a private relay address, refusing to link it to an existing user")
+			return sql.AuthUser{}, ErrEmailAlreadyInUse //nolint:exhaustruct
+		default:
+			if _, err := wf.db.InsertUserProvider(ctx, sql.InsertUserProviderParams{
+				UserID:         user.ID,
+				ProviderID:     providerID,
+				ProviderUserID: profile.ID,
+				AccessToken:    token.AccessToken,
+				RefreshToken:   providerRefreshToken(token),
+			}); err != nil {
+				logger.Error("error inserting user provider", logError(err))
+				return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+			}
+
+			return user, wf.ValidateUser(user, logger)
+		}
+	}
+
+	if !wf.ValidateEmail(profile.Email) {
+		logger.Warn("email didn't pass access control checks")
+		return sql.AuthUser{}, ErrInvalidEmailPassword //nolint:exhaustruct
+	}
+
+	signUpOptions, apiErr := wf.providerSignUpOptions(options, profile, logger)
+	if apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	user, apiErr = wf.SignupUserWithUserProvider(
+		ctx, providerID, profile, token, signUpOptions, logger,
+	)
+	if apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	return user, wf.ValidateUser(user, logger)
+}
+
+// LinkProvider links the provider's account to the user. An account can only be linked
+// to one user and a user can only link one account of each provider.
+func (wf *Workflows) LinkProvider( //nolint:funlen
+	ctx context.Context,
+	userID uuid.UUID,
+	providerID string,
+	profile providers.Profile,
+	token *oauth2.Token,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	logger = logger.With(slog.String("user_id", userID.String()))
+
+	user, apiErr := wf.GetUser(ctx, userID, logger)
+	if apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	linkedUser, err := wf.db.GetUserByProviderID(ctx, sql.GetUserByProviderIDParams{
+		ProviderID:     providerID,
+		ProviderUserID: profile.ID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		logger.Error("error getting user by provider id", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	case linkedUser.ID != user.ID:
+		logger.Warn("provider account is already linked to another user")
+		return sql.AuthUser{}, ErrProviderAlreadyLinked //nolint:exhaustruct
+	default:
+		if apiErr := wf.updateProviderSession(ctx, providerID, profile, token, logger); apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+
+		return user, nil
+	}
+
+	userProviders, err := wf.db.GetUserProviders(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user providers", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+	if slices.ContainsFunc(userProviders, func(p sql.AuthUserProvider) bool {
+		return p.ProviderID == providerID
+	}) {
+		logger.Warn("user already linked another account of the provider")
+		return sql.AuthUser{}, ErrProviderAlreadyLinked //nolint:exhaustruct
+	}
+
+	if _, err := wf.db.InsertUserProvider(ctx, sql.InsertUserProviderParams{
+		UserID:         user.ID,
+		ProviderID:     providerID,
+		ProviderUserID: profile.ID,
+		AccessToken:    token.AccessToken,
+		RefreshToken:   providerRefreshToken(token),
+	}); err != nil {
+		logger.Error("error inserting user provider", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
+// DeanonymizeUserWithProvider turns the anonymous user into a regular user that signs in
+// with the provider's account, keeping its id and data.
+func (wf *Workflows) DeanonymizeUserWithProvider( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID uuid.UUID,
+	providerID string,
+	profile providers.Profile,
+	token *oauth2.Token,
+	options api.SignUpOptions,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	logger = logger.With(slog.String("user_id", userID.String()))
+
+	// GetUser would refuse the user for being anonymous, it is validated once upgraded
+	user, err := wf.db.GetUser(ctx, userID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		logger.Warn("user not found")
+		return sql.AuthUser{}, ErrInvalidEmailPassword //nolint:exhaustruct
+	case err != nil:
+		logger.Error("error getting user", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	case !user.IsAnonymous:
+		logger.Warn("user is not anonymous")
+		return sql.AuthUser{}, ErrUserNotAnonymous //nolint:exhaustruct
+	}
+
+	_, err = wf.db.GetUserByProviderID(ctx, sql.GetUserByProviderIDParams{
+		ProviderID:     providerID,
+		ProviderUserID: profile.ID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		logger.Error("error getting user by provider id", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	default:
+		logger.Warn("provider account is already linked to another user")
+		return sql.AuthUser{}, ErrProviderAlreadyLinked //nolint:exhaustruct
+	}
+
+	if profile.Email != "" {
+		if !wf.ValidateEmail(profile.Email) {
+			logger.Warn("email didn't pass access control checks")
+			return sql.AuthUser{}, ErrInvalidEmailPassword //nolint:exhaustruct
+		}
+
+		if apiErr := wf.checkDisposableEmail(profile.Email, logger); apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+
+		exists, apiErr := wf.UserByEmailExists(ctx, profile.Email, logger)
+		if apiErr != nil {
+			return sql.AuthUser{}, apiErr //nolint:exhaustruct
+		}
+		if exists {
+			logger.Warn("email already exists")
+			return sql.AuthUser{}, ErrEmailAlreadyInUse //nolint:exhaustruct
+		}
+	}
+
+	signUpOptions, apiErr := wf.providerSignUpOptions(options, profile, logger)
+	if apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	metadata, err := json.Marshal(signUpOptions.Metadata)
+	if err != nil {
+		logger.Error("error marshaling metadata", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	avatarURL := profile.AvatarURL
+	if avatarURL == "" {
+		avatarURL = user.AvatarUrl
+	}
+
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		if err := wf.db.DeleteUserRoles(ctx, userID); err != nil {
+			logger.Error("error deleting user roles", logError(err))
+			return ErrInternalServerError
+		}
+
+		_, err := wf.db.UpdateUserDeanonymizeWithUserProvider(
+			ctx,
+			sql.UpdateUserDeanonymizeWithUserProviderParams{
+				Roles:          deptr(signUpOptions.AllowedRoles),
+				Email:          sql.NullableText(profile.Email),
+				EmailVerified:  profile.EmailVerified,
+				AvatarUrl:      avatarURL,
+				DefaultRole:    deptr(signUpOptions.DefaultRole),
+				DisplayName:    deptr(signUpOptions.DisplayName),
+				Locale:         deptr(signUpOptions.Locale),
+				Metadata:       metadata,
+				ID:             userID,
+				ProviderID:     providerID,
+				ProviderUserID: profile.ID,
+				AccessToken:    token.AccessToken,
+				RefreshToken:   providerRefreshToken(token),
+			},
+		)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			logger.Warn("user is not anonymous")
+			return ErrUserNotAnonymous
+		case err != nil:
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		return nil
+	}); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	return wf.GetUser(ctx, userID, logger)
+}
+
+// hasOtherSignInMethod returns true if the user can still sign in after unlinking the
+// provider.
+func (wf *Workflows) hasOtherSignInMethod(
+	ctx context.Context,
+	user sql.AuthUser,
+	providerID string,
+	userProviders []sql.AuthUserProvider,
+	logger *slog.Logger,
+) (bool, *APIError) {
+	for _, p := range userProviders {
+		if p.ProviderID != providerID {
+			return true, nil
+		}
+	}
+
+	switch {
+	case user.PasswordHash.Valid && user.PasswordHash.String != "":
+		return true, nil
+	case wf.config.EmailPasswordlessEnabled && user.Email.Valid && user.Email.String != "":
+		return true, nil
+	case wf.config.SMSPasswordlessEnabled && user.PhoneNumber.Valid &&
+		user.PhoneNumber.String != "":
+		return true, nil
+	case !wf.config.WebauthnEnabled:
+		return false, nil
+	}
+
+	n, err := wf.db.CountSecurityKeysUser(ctx, user.ID)
+	if err != nil {
+		logger.Error("error counting security keys", logError(err))
+		return false, ErrInternalServerError
+	}
+
+	return n > 0, nil
+}
+
+// UnlinkProvider removes the provider's account from the user, as long as the user has
+// another way to sign in.
+func (wf *Workflows) UnlinkProvider(
+	ctx context.Context,
+	user sql.AuthUser,
+	providerID string,
+	logger *slog.Logger,
+) *APIError {
+	userProviders, err := wf.db.GetUserProviders(ctx, user.ID)
+	if err != nil {
+		logger.Error("error getting user providers", logError(err))
+		return ErrInternalServerError
+	}
+
+	if !slices.ContainsFunc(userProviders, func(p sql.AuthUserProvider) bool {
+		return p.ProviderID == providerID
+	}) {
+		logger.Warn("provider is not linked to the user")
+		return ErrProviderNotLinked
+	}
+
+	ok, apiErr := wf.hasOtherSignInMethod(ctx, user, providerID, userProviders, logger)
+	if apiErr != nil {
+		return apiErr
+	}
+	if !ok {
+		logger.Warn("refusing to unlink the last sign in method of the user")
+		return ErrLastSignInMethod
+	}
+
+	if _, err := wf.db.DeleteUserProvider(ctx, sql.DeleteUserProviderParams{
+		UserID:     user.ID,
+		ProviderID: providerID,
+	}); errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("provider is not linked to the user")
+		return ErrProviderNotLinked
+	} else if err != nil {
+		logger.Error("error deleting user provider", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/risk"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// riskSignals gathers what is known about the sign in attempt of the user: if the device is
+// new and, with the lockout enabled, the recent failures of the user and of the IP address.
+func (wf *Workflows) riskSignals(
+	ctx context.Context, user sql.AuthUser, logger *slog.Logger,
+) (risk.Signals, *APIError) {
+	client := middleware.ClientInfoFromContext(ctx)
+	signals := risk.Signals{
+		UserID:       user.ID.String(),
+		IPAddress:    client.IP,
+		UserAgent:    client.UserAgent,
+		Country:      wf.clientLocation(ctx, logger).Country,
+		NewDevice:    false,
+		UserFailures: 0,
+		IPFailures:   0,
+	}
+
+	known, err := wf.db.RefreshTokenDeviceFingerprintExists(
+		ctx,
+		sql.RefreshTokenDeviceFingerprintExistsParams{
+			UserID:            user.ID,
+			DeviceFingerprint: sql.Text(deviceFingerprint(client.IP, client.UserAgent)),
+		},
+	)
+	if err != nil {
+		logger.Error("error checking if the device is known", logError(err))
+		return risk.Signals{}, ErrInternalServerError //nolint:exhaustruct
+	}
+	signals.NewDevice = !known
+
+	if !wf.config.LockoutEnabled {
+		return signals, nil
+	}
+
+	failuresSince := time.Now().Add(-wf.lockoutDuration())
+	if user.LastFailedSignInAt.Valid && !user.LastFailedSignInAt.Time.Before(failuresSince) {
+		signals.UserFailures = int(user.FailedSignInAttempts)
+	}
+
+	if client.IP == "" {
+		return signals, nil
+	}
+
+	attempts, err := wf.db.GetIPSignInFailures(ctx, sql.GetIPSignInFailuresParams{
+		Ip:            client.IP,
+		FailuresSince: sql.TimestampTz(failuresSince),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("error getting ip sign in failures", logError(err))
+		return risk.Signals{}, ErrInternalServerError //nolint:exhaustruct
+	}
+	signals.IPFailures = int(attempts)
+
+	return signals, nil
+}
+
+// CheckSignInRisk scores the sign in attempt of the user, once their credentials are
+// verified, with the risk engine. Attempts scoring RiskBlockThreshold or more are rejected
+// and so are the ones scoring RiskMFAThreshold or more if the user doesn't have
+// multi-factor authentication to challenge them with. A threshold of 0 disables it.
+// Attempts the engine fails to score are allowed. Every sign in goes through it before its
+// session is issued, see newSession.
+func (wf *Workflows) CheckSignInRisk(
+	ctx context.Context, user sql.AuthUser, logger *slog.Logger,
+) *APIError {
+	if wf.riskEngine == nil || (wf.config.RiskMFAThreshold <= 0 && wf.config.RiskBlockThreshold <= 0) {
+		return nil
+	}
+
+	signals, apiErr := wf.riskSignals(ctx, user, logger)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	score, err := wf.riskEngine.Score(ctx, signals)
+	if err != nil {
+		logger.Warn("error scoring the sign in risk, allowing it", logError(err))
+		return nil
+	}
+
+	var riskErr *APIError
+	switch {
+	case wf.config.RiskBlockThreshold > 0 && score >= wf.config.RiskBlockThreshold:
+		riskErr = ErrRiskySignIn
+	case wf.config.RiskMFAThreshold > 0 && score >= wf.config.RiskMFAThreshold &&
+		user.ActiveMfaType.String != MFATypeTOTP:
+		riskErr = ErrRiskySignInWithoutMFA
+	default:
+		return nil
+	}
+
+	logger.Warn(
+		"risky sign in denied",
+		slog.Int("score", score),
+		slog.Bool("new_device", signals.NewDevice),
+		slog.Int("user_failures", signals.UserFailures),
+		slog.Int("ip_failures", signals.IPFailures),
+	)
+
+	wf.recordAuditEvent(
+		ctx, auditRiskySignIn, user.ID, string(api.RiskySignIn),
+		map[string]any{
+			"score":        score,
+			"newDevice":    signals.NewDevice,
+			"userFailures": signals.UserFailures,
+			"ipFailures":   signals.IPFailures,
+			"country":      signals.Country,
+		},
+		logger,
+	)
+
+	return riskErr
+}
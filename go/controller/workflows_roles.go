@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// sqlErrIsForeignKeyViolation reports if the query failed because a row references a role
+// that doesn't exist or because a deleted role is still referenced.
+func sqlErrIsForeignKeyViolation(err error) bool {
+	return strings.Contains(err.Error(), "SQLSTATE 23503")
+}
+
+// AddUserRole adds a role of auth.roles to the roles of the user. Adding a role the user
+// already has is a no-op.
+func (wf *Workflows) AddUserRole(
+	ctx context.Context, userID uuid.UUID, role string, logger *slog.Logger,
+) *APIError {
+	if _, apiErr := wf.adminGetUser(ctx, userID, logger); apiErr != nil {
+		return apiErr
+	}
+
+	if err := wf.db.InsertUserRole(ctx, sql.InsertUserRoleParams{
+		UserID: userID,
+		Role:   role,
+	}); err != nil {
+		if sqlErrIsForeignKeyViolation(err) {
+			logger.Warn("role doesn't exist", slog.String("role", role))
+			return ErrRoleNotFound
+		}
+		logger.Error("error inserting user role", logError(err))
+		return ErrInternalServerError
+	}
+
+	logger.Info("role added to user", slog.String("role", role))
+
+	return nil
+}
+
+// RemoveUserRole removes a role from the roles of the user. The default role of the user
+// can't be removed as it must be one of their roles.
+func (wf *Workflows) RemoveUserRole(
+	ctx context.Context, userID uuid.UUID, role string, logger *slog.Logger,
+) *APIError {
+	user, apiErr := wf.adminGetUser(ctx, userID, logger)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	if user.DefaultRole == role {
+		logger.Warn("can't remove the default role of the user", slog.String("role", role))
+		return ErrRoleInUse
+	}
+
+	if err := wf.db.DeleteUserRole(ctx, sql.DeleteUserRoleParams{
+		UserID: userID,
+		Role:   role,
+	}); err != nil {
+		logger.Error("error deleting user role", logError(err))
+		return ErrInternalServerError
+	}
+
+	logger.Info("role removed from user", slog.String("role", role))
+
+	return nil
+}
+
+// CreateRole adds a role to auth.roles.
+func (wf *Workflows) CreateRole(ctx context.Context, role string, logger *slog.Logger) *APIError {
+	n, err := wf.db.InsertRole(ctx, role)
+	if err != nil {
+		logger.Error("error inserting role", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("role already exists")
+		return ErrRoleAlreadyExists
+	}
+
+	logger.Info("role created")
+
+	return nil
+}
+
+// isDefaultRole reports if new users, of any sign in method, are given the role.
+func (wf *Workflows) isDefaultRole(role string) bool {
+	if role == wf.config.DefaultRole || slices.Contains(wf.config.DefaultAllowedRoles, role) {
+		return true
+	}
+
+	for _, method := range []SignInMethod{
+		SignInMethodAnonymous,
+		SignInMethodProvider,
+		SignInMethodPasswordless,
+		SignInMethodEmailPassword,
+	} {
+		defaultRole, allowedRoles := wf.config.SignInMethodRoles(method)
+		if role == defaultRole || slices.Contains(allowedRoles, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeleteRole deletes a role from auth.roles, the database removes it from the users that
+// have it. Roles used as default role, by users, OAuth2 clients or new users, and roles
+// given to new users can't be deleted.
+func (wf *Workflows) DeleteRole(ctx context.Context, role string, logger *slog.Logger) *APIError {
+	if wf.isDefaultRole(role) {
+		logger.Warn("role is part of the default roles")
+		return ErrRoleInUse
+	}
+
+	n, err := wf.db.DeleteRole(ctx, role)
+	if err != nil {
+		if sqlErrIsForeignKeyViolation(err) {
+			logger.Warn("role is still the default role of a user or client", logError(err))
+			return ErrRoleInUse
+		}
+		logger.Error("error deleting role", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("role not found")
+		return ErrRoleNotFound
+	}
+
+	logger.Info("role deleted")
+
+	return nil
+}
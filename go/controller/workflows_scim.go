@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// scimProviderID is the provider of auth.user_providers the external IDs of the users
+// provisioned over SCIM are stored as.
+const scimProviderID = "scim"
+
+// scimUserInput holds the attributes of AuthUser a SCIM user sets.
+type scimUserInput struct {
+	email       string
+	displayName string
+	externalID  pgtype.Text
+	disabled    bool
+}
+
+// sqlErrIsDuplicatedExternalID reports if the query failed because the external ID is
+// already used by another user.
+func sqlErrIsDuplicatedExternalID(err error) bool {
+	return strings.Contains(err.Error(), "SQLSTATE 23505") &&
+		strings.Contains(err.Error(), "\"user_providers_provider_id_provider_user_id_key\"")
+}
+
+// GetScimUser returns the user with its SCIM attributes.
+func (wf *Workflows) GetScimUser(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) (sql.GetScimUsersRow, *APIError) {
+	users, err := wf.db.GetScimUsers(ctx, sql.GetScimUsersParams{
+		ID:         pgtype.UUID{Bytes: userID, Valid: true},
+		Email:      pgtype.Text{}, //nolint:exhaustruct
+		ExternalID: pgtype.Text{}, //nolint:exhaustruct
+		RowOffset:  0,
+		RowLimit:   1,
+	})
+	if err != nil {
+		logger.Error("error getting scim user", logError(err))
+		return sql.GetScimUsersRow{}, ErrInternalServerError //nolint:exhaustruct
+	}
+	if len(users) == 0 {
+		logger.Warn("user not found")
+		return sql.GetScimUsersRow{}, ErrUserNotFound //nolint:exhaustruct
+	}
+
+	return users[0], nil
+}
+
+// ProvisionScimUser creates a user sent by the identity provider. The identity provider
+// is trusted so the email is verified and the sign up restrictions don't apply.
+func (wf *Workflows) ProvisionScimUser(
+	ctx context.Context, input scimUserInput, logger *slog.Logger,
+) (uuid.UUID, *APIError) {
+	user := sql.AuthUser{ //nolint:exhaustruct
+		ID:            uuid.New(),
+		Disabled:      input.disabled,
+		DisplayName:   input.displayName,
+		AvatarUrl:     wf.avatars.AvatarURL(input.email),
+		Locale:        wf.config.DefaultLocale,
+		Email:         sql.Text(input.email),
+		EmailVerified: true,
+		DefaultRole:   wf.config.DefaultRole,
+		Metadata:      []byte("{}"),
+	}
+
+	if apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		var err error
+		if input.externalID.Valid {
+			_, err = wf.db.InsertUserWithUserProvider(ctx, sql.InsertUserWithUserProviderParams{
+				ID:             user.ID,
+				Disabled:       user.Disabled,
+				DisplayName:    user.DisplayName,
+				AvatarUrl:      user.AvatarUrl,
+				Email:          user.Email,
+				EmailVerified:  user.EmailVerified,
+				Locale:         user.Locale,
+				DefaultRole:    user.DefaultRole,
+				Metadata:       user.Metadata,
+				Roles:          wf.config.DefaultAllowedRoles,
+				ProviderID:     scimProviderID,
+				ProviderUserID: input.externalID.String,
+				AccessToken:    "",
+				RefreshToken:   pgtype.Text{}, //nolint:exhaustruct
+			})
+		} else {
+			_, err = wf.db.InsertUser(ctx, sql.InsertUserParams{
+				ID:              user.ID,
+				Disabled:        user.Disabled,
+				DisplayName:     user.DisplayName,
+				AvatarUrl:       user.AvatarUrl,
+				Email:           user.Email,
+				PasswordHash:    pgtype.Text{}, //nolint:exhaustruct
+				Ticket:          pgtype.Text{}, //nolint:exhaustruct
+				TicketExpiresAt: sql.TimestampTz(time.Now()),
+				EmailVerified:   user.EmailVerified,
+				Locale:          user.Locale,
+				DefaultRole:     user.DefaultRole,
+				Metadata:        user.Metadata,
+				Roles:           wf.config.DefaultAllowedRoles,
+				PhoneNumber:     pgtype.Text{}, //nolint:exhaustruct
+				IsAnonymous:     false,
+			})
+		}
+		if err != nil {
+			if sqlErrIsDuplicatedExternalID(err) {
+				logger.Warn("external id already in use", logError(err))
+				return ErrProviderAlreadyLinked
+			}
+			return sqlErrIsDuplicatedEmail(err, logger)
+		}
+
+		logger.Info("scim user provisioned", slog.String("user_id", user.ID.String()))
+
+		wf.SendWebhook(ctx, webhooks.EventUserCreated, webhookUser(user))
+
+		return nil
+	}); apiErr != nil {
+		return uuid.UUID{}, apiErr
+	}
+
+	return user.ID, nil
+}
+
+// UpdateScimUser replaces the SCIM attributes of the user. Disabling the user revokes
+// their sessions so they are signed out right away.
+func (wf *Workflows) UpdateScimUser(
+	ctx context.Context, user sql.GetScimUsersRow, input scimUserInput, logger *slog.Logger,
+) *APIError {
+	_, err := wf.db.UpdateScimUser(ctx, sql.UpdateScimUserParams{
+		Email:       sql.Text(input.email),
+		DisplayName: input.displayName,
+		Disabled:    input.disabled,
+		ID:          user.ID,
+		ExternalID:  input.externalID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+	if err != nil {
+		if sqlErrIsDuplicatedExternalID(err) {
+			logger.Warn("external id already in use", logError(err))
+			return ErrProviderAlreadyLinked
+		}
+		return sqlErrIsDuplicatedEmail(err, logger)
+	}
+
+	logger.Info("scim user updated")
+
+	if input.disabled && !user.Disabled {
+		logger.Info("user disabled")
+		return wf.RevokeSessions(ctx, user.ID, logger)
+	}
+
+	return nil
+}
+
+// DeleteUser deletes the user and everything stored about them.
+func (wf *Workflows) DeleteUser(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	n, err := wf.db.DeleteUser(ctx, userID)
+	if err != nil {
+		logger.Error("error deleting user", logError(err))
+		return ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("user not found")
+		return ErrUserNotFound
+	}
+
+	logger.Info("user deleted")
+
+	return nil
+}
+
+// GetScimGroupMembers returns the users that have the role, or ErrRoleNotFound if the role
+// doesn't exist.
+func (wf *Workflows) GetScimGroupMembers(
+	ctx context.Context, role string, logger *slog.Logger,
+) ([]sql.GetRoleUsersRow, *APIError) {
+	n, err := wf.db.CountScimGroups(ctx, sql.Text(role))
+	if err != nil {
+		logger.Error("error counting roles", logError(err))
+		return nil, ErrInternalServerError
+	}
+	if n == 0 {
+		logger.Warn("role not found")
+		return nil, ErrRoleNotFound
+	}
+
+	members, err := wf.db.GetRoleUsers(ctx, role)
+	if err != nil {
+		logger.Error("error getting role users", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	return members, nil
+}
+
+// SetScimGroupMembers gives the role to the users and removes it from the other users
+// that have it.
+func (wf *Workflows) SetScimGroupMembers(
+	ctx context.Context, role string, userIDs []uuid.UUID, logger *slog.Logger,
+) *APIError {
+	members, apiErr := wf.GetScimGroupMembers(ctx, role, logger)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	current := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		current[i] = member.ID
+	}
+
+	for _, userID := range userIDs {
+		if slices.Contains(current, userID) {
+			continue
+		}
+		if apiErr := wf.AddUserRole(
+			ctx, userID, role, logger.With(slog.String("user_id", userID.String())),
+		); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	for _, userID := range current {
+		if slices.Contains(userIDs, userID) {
+			continue
+		}
+		if apiErr := wf.RemoveUserRole(
+			ctx, userID, role, logger.With(slog.String("user_id", userID.String())),
+		); apiErr != nil {
+			return apiErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/api"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// insertWithinSessionLimit runs insert, which creates a new session of the user, once
+// there is room for it. If the user already has SessionLimit active sessions it either
+// signs it out of the oldest ones or rejects the new one. The sessions are counted and
+// the new one inserted in a transaction holding a lock on the sessions of the user so
+// concurrent sign ins can't go over the limit.
+func (wf *Workflows) insertWithinSessionLimit(
+	ctx context.Context,
+	userID uuid.UUID,
+	insert func(ctx context.Context) *APIError,
+	logger *slog.Logger,
+) *APIError {
+	if wf.config.SessionLimit <= 0 {
+		return insert(ctx)
+	}
+
+	apiErr := wf.InTx(ctx, logger, func(ctx context.Context) *APIError {
+		if err := wf.db.LockUserSessions(ctx, userID); err != nil {
+			logger.Error("error locking user sessions", logError(err))
+			return ErrInternalServerError
+		}
+
+		if apiErr := wf.enforceSessionLimit(ctx, userID, logger); apiErr != nil {
+			return apiErr
+		}
+
+		return insert(ctx)
+	})
+
+	// the rejection is recorded once the transaction is rolled back so it is kept
+	if errors.Is(apiErr, ErrSessionLimitReached) {
+		wf.recordAuditEvent(
+			ctx, auditSessionLimit, userID, string(api.SessionLimitReached),
+			map[string]any{
+				"policy": SessionLimitPolicyReject,
+				"limit":  wf.config.SessionLimit,
+			},
+			logger,
+		)
+	}
+
+	return apiErr
+}
+
+// enforceSessionLimit makes room for a new session of the user if it already has
+// SessionLimit active sessions, either by signing it out of the oldest ones or by
+// rejecting the new one.
+func (wf *Workflows) enforceSessionLimit(
+	ctx context.Context, userID uuid.UUID, logger *slog.Logger,
+) *APIError {
+	sessions, err := wf.db.GetUserSessions(ctx, userID)
+	if err != nil {
+		logger.Error("error getting user sessions", logError(err))
+		return ErrInternalServerError
+	}
+	if len(sessions) < wf.config.SessionLimit {
+		return nil
+	}
+
+	if wf.config.SessionLimitPolicy == SessionLimitPolicyReject {
+		logger.Warn("session limit reached", slog.Int("sessions", len(sessions)))
+		return ErrSessionLimitReached
+	}
+
+	// sessions are sorted from the newest to the oldest, the new one takes the last place
+	for _, session := range sessions[wf.config.SessionLimit-1:] {
+		if _, err := wf.db.DeleteUserSession(ctx, sql.DeleteUserSessionParams{
+			ID:     session.ID,
+			UserID: userID,
+		}); err != nil {
+			logger.Error("error evicting session", logError(err))
+			return ErrInternalServerError
+		}
+
+		logger.Info("session evicted", slog.String("session_id", session.ID.String()))
+		wf.recordAuditEvent(
+			ctx, auditSessionLimit, userID, "",
+			map[string]any{
+				"policy":    SessionLimitPolicyEvictOldest,
+				"limit":     wf.config.SessionLimit,
+				"sessionId": session.ID.String(),
+			},
+			logger,
+		)
+	}
+
+	return nil
+}
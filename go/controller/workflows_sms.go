@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// e164Regexp matches phone numbers in the E.164 format: a plus sign followed by up to 15
+// digits, the first of which can't be 0.
+var e164Regexp = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`) //nolint:gochecknoglobals
+
+const (
+	OTPMethodSMS = "sms"
+	// OTPMethodSMSPhoneChange is used for the one time passwords sent to a new phone number
+	// so they can't be used to sign in.
+	OTPMethodSMSPhoneChange = "smsPhoneChange"
+)
+
+func SignupUserWithPhoneNumber(phoneNumber string) SignUpFn {
+	return func(input *sql.InsertUserParams) error {
+		input.PhoneNumber = sql.Text(phoneNumber)
+		input.Email = pgtype.Text{} //nolint:exhaustruct
+		input.AvatarUrl = ""
+		return nil
+	}
+}
+
+// ValidatePhoneNumber checks the phone number is in the E.164 format before it is stored
+// or sent an SMS.
+func (wf *Workflows) ValidatePhoneNumber(phoneNumber string, logger *slog.Logger) *APIError {
+	if !e164Regexp.MatchString(phoneNumber) {
+		logger.Warn("phone number isn't in the E.164 format")
+		return ErrInvalidRequest
+	}
+
+	return nil
+}
+
+func (wf *Workflows) GetUserByPhoneNumber(
+	ctx context.Context,
+	phoneNumber string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.GetUserByPhoneNumber(ctx, sql.Text(phoneNumber))
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("user not found")
+		return sql.AuthUser{}, ErrUserPhoneNumberNotFound //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error getting user by phone number", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if !wf.userInTenant(user) {
+		logger.Warn(
+			"user belongs to another tenant", slog.String("tenant_id", user.TenantID.String),
+		)
+		return sql.AuthUser{}, ErrUserPhoneNumberNotFound //nolint:exhaustruct
+	}
+
+	if user.Email.Valid && !wf.ValidateEmail(user.Email.String) {
+		logger.Warn("email didn't pass access control checks")
+		return sql.AuthUser{}, ErrDisabledUser //nolint:exhaustruct
+	}
+
+	if user.Disabled || userBanned(user) || user.DeletionScheduledAt.Valid {
+		logger.Warn("user is disabled, banned or scheduled for deletion")
+		return sql.AuthUser{}, ErrDisabledUser //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
+func (wf *Workflows) SetOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	otpHash string,
+	expiresAt time.Time,
+	method string,
+	logger *slog.Logger,
+) *APIError {
+	_, err := wf.db.UpdateUserOTPHash(
+		ctx,
+		sql.UpdateUserOTPHashParams{
+			ID:                userID,
+			OtpHash:           sql.Text(otpHash),
+			OtpHashExpiresAt:  sql.TimestampTz(expiresAt),
+			OtpMethodLastUsed: sql.Text(method),
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("user not found")
+		return ErrInvalidRequest
+	}
+	if err != nil {
+		logger.Error("error updating user otp", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// ConsumeOTP clears the OTP of the user and marks the phone number as verified.
+// The update only succeeds if the OTP hash hasn't changed or been used in the meantime.
+func (wf *Workflows) ConsumeOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	otpHash string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.UpdateUserConsumeOTP(
+		ctx,
+		sql.UpdateUserConsumeOTPParams{
+			ID:      userID,
+			OtpHash: sql.Text(otpHash),
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("otp already used or expired")
+		return sql.AuthUser{}, ErrInvalidOTP //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error consuming otp", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
+// RecordOTPFailure counts a wrong one time password and invalidates it after
+// maxOTPFailedAttempts so it can't be guessed until it expires.
+func (wf *Workflows) RecordOTPFailure(
+	ctx context.Context,
+	userID uuid.UUID,
+	otpHash string,
+	logger *slog.Logger,
+) *APIError {
+	attempts, err := wf.db.UpdateUserOTPHashFailedAttempts(
+		ctx, sql.UpdateUserOTPHashFailedAttemptsParams{
+			MaxAttempts: maxOTPFailedAttempts,
+			ID:          userID,
+			OtpHash:     sql.Text(otpHash),
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		logger.Error("error recording otp failure", logError(err))
+		return ErrInternalServerError
+	}
+
+	if attempts >= maxOTPFailedAttempts {
+		logger.Warn("too many failed attempts, otp invalidated")
+	}
+
+	return nil
+}
+
+func (wf *Workflows) UserByPhoneNumberExists(
+	ctx context.Context,
+	phoneNumber string,
+	logger *slog.Logger,
+) (bool, *APIError) {
+	_, err := wf.db.GetUserByPhoneNumber(ctx, sql.Text(phoneNumber))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		logger.Error("error getting user by phone number", logError(err))
+		return false, ErrInternalServerError
+	}
+
+	return true, nil
+}
+
+// ChangePhoneNumber stores the new phone number of the user together with the hash of the
+// one time password sent to it. The phone number isn't changed until the OTP is verified.
+func (wf *Workflows) ChangePhoneNumber(
+	ctx context.Context,
+	userID uuid.UUID,
+	newPhoneNumber string,
+	otpHash string,
+	expiresAt time.Time,
+	logger *slog.Logger,
+) *APIError {
+	if err := wf.db.UpdateUserChangePhoneNumber(
+		ctx,
+		sql.UpdateUserChangePhoneNumberParams{
+			ID:                userID,
+			OtpHash:           sql.Text(otpHash),
+			OtpHashExpiresAt:  sql.TimestampTz(expiresAt),
+			OtpMethodLastUsed: sql.Text(OTPMethodSMSPhoneChange),
+			NewPhoneNumber:    sql.Text(newPhoneNumber),
+		},
+	); err != nil {
+		logger.Error("error updating user new phone number", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// ConfirmChangePhoneNumber replaces the phone number of the user with the new one and
+// marks it as verified. Like ConsumeOTP, it only succeeds if the OTP hasn't been used.
+func (wf *Workflows) ConfirmChangePhoneNumber(
+	ctx context.Context,
+	userID uuid.UUID,
+	otpHash string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.UpdateUserConfirmChangePhoneNumber(
+		ctx,
+		sql.UpdateUserConfirmChangePhoneNumberParams{
+			ID:      userID,
+			OtpHash: sql.Text(otpHash),
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("otp already used or expired")
+		return sql.AuthUser{}, ErrInvalidOTP //nolint:exhaustruct
+	}
+	if err != nil {
+		return sql.AuthUser{}, sqlErrIsDuplicatedPhoneNumber(err, logger) //nolint:exhaustruct
+	}
+
+	return user, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/notifications"
 	"github.com/nhost/hasura-auth/go/sql"
 )
 
@@ -17,9 +18,23 @@ type TicketType string
 
 const (
 	TicketTypeEmailConfirmChange TicketType = "emailConfirmChange"
+	TicketTypeEmailChangeRevert  TicketType = "emailChangeRevert"
 	TicketTypePasswordLessEmail  TicketType = "passwordlessEmail"
 	TicketTypeVerifyEmail        TicketType = "verifyEmail"
 	TicketTypePasswordReset      TicketType = "passwordReset"
+	TicketTypeMFATOTP            TicketType = "mfaTotp"
+	TicketTypeNewDeviceRevoke    TicketType = "newDeviceRevoke"
+
+	// TicketTypeOrganizationInvite is sent to the people invited to join an organization.
+	TicketTypeOrganizationInvite TicketType = "organizationInvite"
+
+	// TicketTypeAccountDeletionCancel is sent to users when they schedule the deletion of
+	// their account so they can cancel it during the grace period.
+	TicketTypeAccountDeletionCancel TicketType = "accountDeletionCancel"
+
+	// TicketTypePasswordResetRevokeSessions is a password reset ticket that signs out every
+	// session of the user when it is used.
+	TicketTypePasswordResetRevokeSessions TicketType = "passwordResetRevokeSessions"
 )
 
 func generateTicket(ticketType TicketType) string {
@@ -53,13 +68,116 @@ func (wf *Workflows) SetTicket(
 	return nil
 }
 
+// ConsumeTicket removes the ticket from the user it belongs to and returns the
+// user. This is done in a single statement so a ticket can't be used twice.
+func (wf *Workflows) ConsumeTicket(
+	ctx context.Context,
+	ticket string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	user, err := wf.db.UpdateUserConsumeTicket(ctx, sql.Text(ticket))
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("ticket not found or expired")
+		return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error consuming ticket", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	return user, nil
+}
+
+// emailChangeRevertExpiresIn is how long the previous email address can undo an email
+// change.
+const emailChangeRevertExpiresIn = 7 * 24 * time.Hour
+
+// NotifyEmailChange lets the current email address know that an email change was
+// requested and sends it a link to undo it.
+func (wf *Workflows) NotifyEmailChange(
+	ctx context.Context,
+	user sql.AuthUser,
+	newEmail string,
+	redirectTo string,
+	logger *slog.Logger,
+) *APIError {
+	ticket := generateTicket(TicketTypeEmailChangeRevert)
+	if err := wf.db.InsertEmailChangeRevert(ctx, sql.InsertEmailChangeRevertParams{
+		UserID:    user.ID,
+		Ticket:    ticket,
+		OldEmail:  user.Email.String,
+		ExpiresAt: sql.TimestampTz(time.Now().Add(emailChangeRevertExpiresIn)),
+	}); err != nil {
+		logger.Error("error inserting email change revert", logError(err))
+		return ErrInternalServerError
+	}
+
+	return wf.SendEmail(
+		ctx,
+		user.Email.String,
+		user.Locale,
+		LinkTypeEmailChangeRevert,
+		ticket,
+		redirectTo,
+		notifications.TemplateNameEmailChangeNotify,
+		user.DisplayName,
+		user.Email.String,
+		newEmail,
+		logger,
+	)
+}
+
+// RevertEmailChange restores the email address the revert ticket was sent to, cancels any
+// pending email change and revokes all the sessions of the user as whoever changed the
+// email may have access to them.
+func (wf *Workflows) RevertEmailChange(
+	ctx context.Context,
+	ticket string,
+	logger *slog.Logger,
+) (sql.AuthUser, *APIError) {
+	revert, err := wf.db.ConsumeEmailChangeRevert(ctx, ticket)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.Warn("ticket not found or expired")
+		return sql.AuthUser{}, ErrInvalidTicket //nolint:exhaustruct
+	}
+	if err != nil {
+		logger.Error("error consuming email change revert", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	user, err := wf.db.UpdateUserRevertEmailChange(ctx, sql.UpdateUserRevertEmailChangeParams{
+		ID:    revert.UserID,
+		Email: sql.Text(revert.OldEmail),
+	})
+	if err != nil {
+		return sql.AuthUser{}, sqlErrIsDuplicatedEmail(err, logger) //nolint:exhaustruct
+	}
+
+	if err := wf.db.DeleteUserEmailChangeReverts(ctx, user.ID); err != nil {
+		logger.Error("error deleting email change reverts", logError(err))
+		return sql.AuthUser{}, ErrInternalServerError //nolint:exhaustruct
+	}
+
+	if apiErr := wf.RevokeSessions(ctx, user.ID, logger); apiErr != nil {
+		return sql.AuthUser{}, apiErr //nolint:exhaustruct
+	}
+
+	logger.Info("email change reverted")
+
+	return user, nil
+}
+
 type LinkType string
 
 const (
 	LinkTypeEmailVerify        LinkType = "emailVerify"
 	LinkTypeEmailConfirmChange LinkType = "emailConfirmChange"
+	LinkTypeEmailChangeRevert  LinkType = "emailChangeRevert"
 	LinkTypePasswordlessEmail  LinkType = "signinPasswordless"
 	LinkTypePasswordReset      LinkType = "passwordReset"
+	LinkTypeNewDeviceRevoke    LinkType = "newDeviceRevoke"
+
+	LinkTypeAccountDeletionCancel LinkType = "accountDeletionCancel"
 )
 
 func GenLink(serverURL url.URL, typ LinkType, ticket, redirectTo string) (string, error) {
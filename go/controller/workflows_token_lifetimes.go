@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// sessionLifetimes are the lifetimes of the tokens of a session. They are recorded on the
+// refresh tokens of the session, and honored when it is refreshed, only if they aren't the
+// defaults.
+type sessionLifetimes struct {
+	clientID              string
+	refreshTokenExpiresIn int
+	accessTokenExpiresIn  int
+	recorded              bool
+}
+
+// sessionLifetimes returns the lifetimes of the tokens of the sessions started by the
+// client of the request. Clients in AUTH_CLIENT_TOKEN_LIFETIMES get their own lifetimes
+// and any client can ask for a refresh token shorter than the one it would get.
+func (wf *Workflows) sessionLifetimes(ctx context.Context) sessionLifetimes {
+	client := middleware.ClientInfoFromContext(ctx)
+
+	l := sessionLifetimes{
+		clientID:              client.ID,
+		refreshTokenExpiresIn: wf.config.RefreshTokenExpiresIn,
+		accessTokenExpiresIn:  wf.config.AccessTokenExpiresIn,
+		recorded:              false,
+	}
+
+	if lifetimes, ok := wf.config.ClientTokenLifetimes[client.ID]; ok && client.ID != "" {
+		l.refreshTokenExpiresIn = lifetimes.RefreshTokenExpiresIn
+		l.accessTokenExpiresIn = lifetimes.AccessTokenExpiresIn
+		l.recorded = true
+	}
+
+	if client.RefreshTokenExpiresIn > 0 && client.RefreshTokenExpiresIn < l.refreshTokenExpiresIn {
+		l.refreshTokenExpiresIn = client.RefreshTokenExpiresIn
+		l.recorded = true
+	}
+
+	if maxLifetime := wf.config.RefreshTokenMaxLifetime; maxLifetime > 0 {
+		l.refreshTokenExpiresIn = min(l.refreshTokenExpiresIn, maxLifetime)
+	}
+
+	return l
+}
+
+func (l sessionLifetimes) refreshTokenExpiresAt() time.Time {
+	return time.Now().Add(time.Duration(l.refreshTokenExpiresIn) * time.Second)
+}
+
+func (l sessionLifetimes) accessTokenExpiration() time.Duration {
+	return time.Duration(l.accessTokenExpiresIn) * time.Second
+}
+
+// columns returns what is recorded on the refresh tokens of the session: the client, if
+// known, and the lifetimes of its tokens, or NULL if they are the defaults.
+func (l sessionLifetimes) columns() (pgtype.Text, pgtype.Int4, pgtype.Int4) {
+	if !l.recorded {
+		return sql.NullableText(l.clientID), pgtype.Int4{}, pgtype.Int4{} //nolint:exhaustruct
+	}
+
+	return sql.NullableText(l.clientID),
+		sql.NullableInt4(l.refreshTokenExpiresIn),
+		sql.NullableInt4(l.accessTokenExpiresIn)
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// InTx runs fn in a database transaction, if the database client supports them, so the
+// changes fn makes are stored along with the emails and webhook deliveries it queues in
+// the outboxes, or none of them are. The transaction is rolled back if fn fails, except
+// with ErrDisabledUser which is returned once a disabled user has been created.
+func (wf *Workflows) InTx(
+	ctx context.Context, logger *slog.Logger, fn func(ctx context.Context) *APIError,
+) *APIError {
+	transactor, ok := wf.db.(sql.Transactor)
+	if !ok {
+		return fn(ctx)
+	}
+
+	var apiErr *APIError
+	if err := transactor.InTx(ctx, func(ctx context.Context) error {
+		apiErr = fn(ctx)
+		if apiErr != nil && !errors.Is(apiErr, ErrDisabledUser) {
+			return apiErr
+		}
+		return nil
+	}); err != nil {
+		if apiErr != nil {
+			return apiErr
+		}
+		logger.Error("error running transaction", logError(err))
+		return ErrInternalServerError
+	}
+
+	return apiErr
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"log/slog"
+	"strings"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func securityKeyToCredential(key sql.AuthUserSecurityKey) (webauthn.Credential, error) {
+	credentialID, err := base64.RawURLEncoding.DecodeString(key.CredentialID)
+	if err != nil {
+		return webauthn.Credential{}, err //nolint:exhaustruct,wrapcheck
+	}
+
+	var transports []protocol.AuthenticatorTransport
+	if key.Transports != "" {
+		for _, t := range strings.Split(key.Transports, ",") {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+	}
+
+	return webauthn.Credential{ //nolint:exhaustruct
+		ID:        credentialID,
+		PublicKey: key.CredentialPublicKey,
+		Transport: transports,
+		Authenticator: webauthn.Authenticator{ //nolint:exhaustruct
+			SignCount: uint32(key.Counter), //nolint:gosec
+		},
+	}, nil
+}
+
+// credentialTransports returns the transports of the credential the way
+// securityKeyToCredential reads them.
+func credentialTransports(credential *webauthn.Credential) string {
+	transports := make([]string, len(credential.Transport))
+	for i, t := range credential.Transport {
+		transports[i] = string(t)
+	}
+
+	return strings.Join(transports, ",")
+}
+
+func (wf *Workflows) GetUserSecurityKeys(
+	ctx context.Context,
+	userID uuid.UUID,
+	logger *slog.Logger,
+) ([]webauthn.Credential, *APIError) {
+	keys, err := wf.db.GetSecurityKeys(ctx, userID)
+	if err != nil {
+		logger.Error("error getting security keys", logError(err))
+		return nil, ErrInternalServerError
+	}
+
+	credentials := make([]webauthn.Credential, 0, len(keys))
+	for _, key := range keys {
+		credential, err := securityKeyToCredential(key)
+		if err != nil {
+			logger.Error(
+				"error decoding security key",
+				logError(err),
+				slog.String("security_key_id", key.ID.String()),
+			)
+			return nil, ErrInternalServerError
+		}
+		credentials = append(credentials, credential)
+	}
+
+	return credentials, nil
+}
+
+func (wf *Workflows) GetWebauthnUser(
+	ctx context.Context,
+	user sql.AuthUser,
+	logger *slog.Logger,
+) (WebauthnUser, *APIError) {
+	credentials, apiErr := wf.GetUserSecurityKeys(ctx, user.ID, logger)
+	if apiErr != nil {
+		return WebauthnUser{}, apiErr //nolint:exhaustruct
+	}
+
+	name := user.DisplayName
+	if name == "" {
+		name = user.Email.String
+	}
+
+	return WebauthnUser{
+		ID:          user.ID,
+		Name:        name,
+		Email:       user.Email.String,
+		Credentials: credentials,
+	}, nil
+}
+
+func (wf *Workflows) InsertSecurityKey(
+	ctx context.Context,
+	userID uuid.UUID,
+	credential *webauthn.Credential,
+	nickname string,
+	logger *slog.Logger,
+) (uuid.UUID, *APIError) {
+	keyID, err := wf.db.InsertSecurityKey(
+		ctx,
+		sql.InsertSecurityKeyParams{
+			UserID:              userID,
+			CredentialID:        base64.RawURLEncoding.EncodeToString(credential.ID),
+			CredentialPublicKey: credential.PublicKey,
+			Transports:          credentialTransports(credential),
+			Nickname:            sql.Text(nickname),
+		},
+	)
+	if err != nil {
+		logger.Error("error inserting security key", logError(err))
+		return uuid.UUID{}, ErrInternalServerError
+	}
+
+	return keyID, nil
+}
+
+func (wf *Workflows) UpdateSecurityKeyCounter(
+	ctx context.Context,
+	userID uuid.UUID,
+	credential *webauthn.Credential,
+	logger *slog.Logger,
+) *APIError {
+	if err := wf.db.UpdateSecurityKeyCounter(
+		ctx,
+		sql.UpdateSecurityKeyCounterParams{
+			Counter:      int64(credential.Authenticator.SignCount),
+			UserID:       userID,
+			CredentialID: base64.RawURLEncoding.EncodeToString(credential.ID),
+		},
+	); err != nil {
+		logger.Error("error updating security key counter", logError(err))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/middleware"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// WebhookSenders sends the events to several senders, i.e. to the webhooks and to an event
+// bus.
+type WebhookSenders []WebhookSender
+
+func (s WebhookSenders) Send(ctx context.Context, event webhooks.Event) {
+	for _, sender := range s {
+		sender.Send(ctx, event)
+	}
+}
+
+func webhookUser(user sql.AuthUser) webhooks.User {
+	return webhooks.User{
+		ID:          user.ID,
+		Email:       user.Email.String,
+		PhoneNumber: user.PhoneNumber.String,
+		DisplayName: user.DisplayName,
+		IsAnonymous: user.IsAnonymous,
+	}
+}
+
+// SendWebhook notifies the endpoints subscribed to the event that it happened to the user.
+// Events are delivered in the background so it never fails the request.
+func (wf *Workflows) SendWebhook(
+	ctx context.Context, eventType webhooks.EventType, user webhooks.User,
+) {
+	if wf.webhooks == nil {
+		return
+	}
+
+	client := middleware.ClientInfoFromContext(ctx)
+	wf.webhooks.Send(ctx, webhooks.Event{
+		ID:        uuid.New(),
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		User:      user,
+		IPAddress: client.IP,
+		UserAgent: client.UserAgent,
+	})
+}
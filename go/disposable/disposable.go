@@ -0,0 +1,163 @@
+// Package disposable detects the email addresses of disposable email providers.
+package disposable
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fetchTimeout = 30 * time.Second
+	// maxListSize limits how much of a list is read so a wrong URL can't exhaust the memory.
+	maxListSize = 16 << 20
+)
+
+//go:embed domains.txt
+var bundledDomains string
+
+// List holds the domains of the disposable email providers. It starts with the bundled
+// domains, the domains downloaded from the list URL, if any, are added to them.
+type List struct {
+	url     string
+	client  *http.Client
+	logger  *slog.Logger
+	mu      sync.RWMutex
+	domains map[string]struct{}
+}
+
+// New returns a list with the bundled domains. If url is set Refresh adds the domains
+// served there, one per line.
+func New(url string, logger *slog.Logger) *List {
+	domains, _ := parseDomains(strings.NewReader(bundledDomains))
+
+	return &List{
+		url:     url,
+		client:  &http.Client{Timeout: fetchTimeout}, //nolint:exhaustruct
+		logger:  logger,
+		mu:      sync.RWMutex{},
+		domains: domains,
+	}
+}
+
+// parseDomains reads a domain per line. Empty lines and lines starting with # are skipped.
+func parseDomains(r io.Reader) (map[string]struct{}, error) {
+	domains := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains[line] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading domains: %w", err)
+	}
+
+	return domains, nil
+}
+
+// IsDisposable reports if the email belongs to a disposable email provider, including
+// subdomains of their domains.
+func (l *List) IsDisposable(email string) bool {
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for {
+		if _, ok := l.domains[domain]; ok {
+			return true
+		}
+
+		_, parent, ok := strings.Cut(domain, ".")
+		if !ok || !strings.Contains(parent, ".") {
+			return false
+		}
+		domain = parent
+	}
+}
+
+// Len returns how many domains are in the list.
+func (l *List) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.domains)
+}
+
+// Refresh downloads the list URL and replaces the downloaded domains with the new ones.
+// The bundled domains are always kept. It does nothing if there is no list URL.
+func (l *List) Refresh(ctx context.Context) error {
+	if l.url == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error downloading disposable domains: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf( //nolint:goerr113
+			"unexpected status downloading disposable domains: %d", resp.StatusCode,
+		)
+	}
+
+	domains, err := parseDomains(io.LimitReader(resp.Body, maxListSize))
+	if err != nil {
+		return err
+	}
+
+	bundled, _ := parseDomains(strings.NewReader(bundledDomains))
+	for domain := range bundled {
+		domains[domain] = struct{}{}
+	}
+
+	l.mu.Lock()
+	l.domains = domains
+	l.mu.Unlock()
+
+	return nil
+}
+
+// Run refreshes the list right away and then every interval until the context is done.
+func (l *List) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.Refresh(ctx); err != nil {
+			l.logger.Error(
+				"error refreshing disposable email domains", slog.String("error", err.Error()),
+			)
+		} else {
+			l.logger.Info("refreshed disposable email domains", slog.Int("domains", l.Len()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
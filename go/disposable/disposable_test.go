@@ -0,0 +1,110 @@
+package disposable_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/disposable"
+)
+
+func TestIsDisposable(t *testing.T) {
+	t.Parallel()
+
+	list := disposable.New("", slog.Default())
+
+	cases := []struct {
+		name     string
+		email    string
+		expected bool
+	}{
+		{
+			name:     "bundled domain",
+			email:    "jane@mailinator.com",
+			expected: true,
+		},
+		{
+			name:     "case insensitive",
+			email:    "jane@YopMail.com",
+			expected: true,
+		},
+		{
+			name:     "subdomain",
+			email:    "jane@inbox.guerrillamail.com",
+			expected: true,
+		},
+		{
+			name:     "regular domain",
+			email:    "jane@acme.com",
+			expected: false,
+		},
+		{
+			name:     "domain containing a disposable domain",
+			email:    "jane@notmailinator.com",
+			expected: false,
+		},
+		{
+			name:     "not an email",
+			email:    "mailinator.com",
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := list.IsDisposable(tc.email); got != tc.expected {
+				t.Errorf("IsDisposable(%q) = %v, expected %v", tc.email, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	t.Parallel()
+
+	body := "# custom list\nthrowaway.example\n\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	list := disposable.New(server.URL, slog.Default())
+	if list.IsDisposable("jane@throwaway.example") {
+		t.Fatal("domain shouldn't be disposable before the refresh")
+	}
+
+	if err := list.Refresh(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !list.IsDisposable("jane@throwaway.example") {
+		t.Error("expected downloaded domain to be disposable")
+	}
+	if !list.IsDisposable("jane@mailinator.com") {
+		t.Error("expected bundled domain to be kept")
+	}
+}
+
+func TestRefreshError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	list := disposable.New(server.URL, slog.Default())
+	before := list.Len()
+
+	if err := list.Refresh(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if list.Len() != before {
+		t.Errorf("expected the list to be kept, got %d domains instead of %d", list.Len(), before)
+	}
+}
@@ -0,0 +1,63 @@
+// Package eventbus publishes the authentication events to a message broker, like Kafka or
+// NATS, so they can be consumed in real time by analytics and security pipelines.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// Broker publishes an event, encoded as JSON in body, to a message broker.
+type Broker interface {
+	Publish(ctx context.Context, event webhooks.Event, body []byte) error
+	Close() error
+}
+
+// Publisher publishes every event to a broker. Events have the same schema as the body of
+// the webhooks. Publishing happens in the background and errors are logged.
+type Publisher struct {
+	broker Broker
+	logger *slog.Logger
+	wg     sync.WaitGroup
+}
+
+func NewPublisher(broker Broker, logger *slog.Logger) *Publisher {
+	return &Publisher{
+		broker: broker,
+		logger: logger,
+		wg:     sync.WaitGroup{},
+	}
+}
+
+// Send publishes the event in the background.
+func (p *Publisher) Send(ctx context.Context, event webhooks.Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		p.logger.Error("error marshalling event", slog.String("error", err.Error()))
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		if err := p.broker.Publish(ctx, event, body); err != nil {
+			p.logger.Error(
+				"error publishing event",
+				slog.String("event", string(event.Type)),
+				slog.String("id", event.ID.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+	}()
+}
+
+// Close waits for the events being published and closes the broker.
+func (p *Publisher) Close() error {
+	p.wg.Wait()
+	return p.broker.Close() //nolint:wrapcheck
+}
@@ -0,0 +1,96 @@
+package eventbus_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/eventbus"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+type fakeBroker struct {
+	mu     sync.Mutex
+	events []webhooks.Event
+	err    error
+	closed bool
+}
+
+func (b *fakeBroker) Publish(_ context.Context, _ webhooks.Event, body []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var event webhooks.Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return err //nolint:wrapcheck
+	}
+	b.events = append(b.events, event)
+
+	return b.err
+}
+
+func (b *fakeBroker) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestPublisher(t *testing.T) {
+	t.Parallel()
+
+	event := webhooks.Event{
+		ID:        uuid.MustParse("d1c1f2a4-3a8e-4b8f-9c1a-6f0f3e7ad2a1"),
+		Type:      webhooks.EventUserSignedIn,
+		CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		User: webhooks.User{
+			ID:          uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+			Email:       "jane@acme.com",
+			PhoneNumber: "",
+			DisplayName: "Jane",
+			IsAnonymous: false,
+		},
+		IPAddress: "192.0.2.1",
+		UserAgent: "curl/8.0",
+	}
+
+	cases := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "success",
+			err:  nil,
+		},
+		{
+			name: "broker error",
+			err:  errors.New("broker unavailable"), //nolint:goerr113
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			broker := &fakeBroker{mu: sync.Mutex{}, events: nil, err: tc.err, closed: false}
+			publisher := eventbus.NewPublisher(broker, slog.Default())
+
+			publisher.Send(context.Background(), event)
+			if err := publisher.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff([]webhooks.Event{event}, broker.events); diff != "" {
+				t.Errorf("unexpected events (-want +got):\n%s", diff)
+			}
+
+			if !broker.closed {
+				t.Error("expected broker to be closed")
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhost/hasura-auth/go/webhooks"
+	"github.com/segmentio/kafka-go"
+)
+
+// HeaderEvent is the header of the messages with the type of the event.
+const HeaderEvent = "event"
+
+// Kafka publishes the events to a Kafka topic. Messages are keyed by the id of the user so
+// the events of a user are kept in order.
+type Kafka struct {
+	writer *kafka.Writer
+}
+
+func NewKafka(brokers []string, topic string) *Kafka {
+	return &Kafka{
+		writer: &kafka.Writer{ //nolint:exhaustruct
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{}, //nolint:exhaustruct
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (k *Kafka) Publish(ctx context.Context, event webhooks.Event, body []byte) error {
+	if err := k.writer.WriteMessages(ctx, kafka.Message{ //nolint:exhaustruct
+		Key:   []byte(event.User.ID.String()),
+		Value: body,
+		Headers: []kafka.Header{
+			{Key: HeaderEvent, Value: []byte(event.Type)},
+		},
+	}); err != nil {
+		return fmt.Errorf("error writing kafka message: %w", err)
+	}
+	return nil
+}
+
+func (k *Kafka) Close() error {
+	if err := k.writer.Close(); err != nil {
+		return fmt.Errorf("error closing kafka writer: %w", err)
+	}
+	return nil
+}
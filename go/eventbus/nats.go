@@ -0,0 +1,51 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// NATS publishes the events to the subject <subject>.<event type>, i.e.
+// hasura-auth.user.created, so consumers can subscribe to the events they need. The id of
+// the event is sent in the Nats-Msg-Id header so JetStream can discard duplicates.
+type NATS struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func NewNATS(url string, subject string) (*NATS, error) {
+	conn, err := nats.Connect(url, nats.Name("hasura-auth"))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to nats: %w", err)
+	}
+
+	return &NATS{
+		conn:    conn,
+		subject: subject,
+	}, nil
+}
+
+func natsSubject(subject string, eventType webhooks.EventType) string {
+	return subject + "." + string(eventType)
+}
+
+func (n *NATS) Publish(_ context.Context, event webhooks.Event, body []byte) error {
+	msg := nats.NewMsg(natsSubject(n.subject, event.Type))
+	msg.Header.Set(nats.MsgIdHdr, event.ID.String())
+	msg.Data = body
+
+	if err := n.conn.PublishMsg(msg); err != nil {
+		return fmt.Errorf("error publishing nats message: %w", err)
+	}
+	return nil
+}
+
+func (n *NATS) Close() error {
+	if err := n.conn.Drain(); err != nil {
+		return fmt.Errorf("error draining nats connection: %w", err)
+	}
+	return nil
+}
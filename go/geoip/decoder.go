@@ -0,0 +1,233 @@
+package geoip
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Types of the fields of the data section of a MaxMind DB.
+const (
+	typeExtended  = 0
+	typePointer   = 1
+	typeString    = 2
+	typeDouble    = 3
+	typeBytes     = 4
+	typeUint16    = 5
+	typeUint32    = 6
+	typeMap       = 7
+	typeInt32     = 8
+	typeUint64    = 9
+	typeUint128   = 10
+	typeArray     = 11
+	typeContainer = 12
+	typeEndMarker = 13
+	typeBool      = 14
+	typeFloat     = 15
+)
+
+// maxDepth limits how deep maps and arrays can be nested so a corrupted database can't
+// exhaust the stack.
+const maxDepth = 32
+
+var errInvalidData = errors.New("invalid data section")
+
+// decoder decodes the data section of a MaxMind DB, fields can point to any offset of it.
+type decoder struct {
+	buf []byte
+}
+
+func (d decoder) byte(offset int) (byte, error) {
+	if offset >= len(d.buf) {
+		return 0, fmt.Errorf("%w: unexpected end of data", errInvalidData)
+	}
+	return d.buf[offset], nil
+}
+
+func (d decoder) bytes(offset, size int) ([]byte, error) {
+	if size < 0 || offset+size > len(d.buf) {
+		return nil, fmt.Errorf("%w: unexpected end of data", errInvalidData)
+	}
+	return d.buf[offset : offset+size], nil
+}
+
+// decode returns the field at offset and the offset of the next field.
+func (d decoder) decode(offset int, depth int) (any, int, error) { //nolint:cyclop
+	if depth > maxDepth {
+		return nil, 0, fmt.Errorf("%w: fields nested too deep", errInvalidData)
+	}
+
+	ctrl, err := d.byte(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset++
+
+	typ := int(ctrl >> 5) //nolint:mnd
+	if typ == typePointer {
+		return d.decodePointer(ctrl, offset, depth)
+	}
+	if typ == typeExtended {
+		next, err := d.byte(offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		typ = 7 + int(next) //nolint:mnd
+		offset++
+	}
+
+	size, offset, err := d.size(ctrl, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch typ {
+	case typeMap:
+		return d.decodeMap(size, offset, depth)
+	case typeArray:
+		return d.decodeArray(size, offset, depth)
+	case typeBool:
+		return size != 0, offset, nil
+	case typeContainer, typeEndMarker:
+		return nil, offset, nil
+	}
+
+	b, err := d.bytes(offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset += size
+
+	switch typ {
+	case typeString:
+		return string(b), offset, nil
+	case typeBytes:
+		return b, offset, nil
+	case typeDouble:
+		if size != 8 { //nolint:mnd
+			return nil, 0, fmt.Errorf("%w: doubles must be 8 bytes", errInvalidData)
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), offset, nil
+	case typeFloat:
+		if size != 4 { //nolint:mnd
+			return nil, 0, fmt.Errorf("%w: floats must be 4 bytes", errInvalidData)
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), offset, nil
+	case typeUint16, typeUint32, typeUint64:
+		if size > 8 { //nolint:mnd
+			return nil, 0, fmt.Errorf("%w: unsigned integer too long", errInvalidData)
+		}
+		return uintFromBytes(b), offset, nil
+	case typeInt32:
+		if size > 4 { //nolint:mnd
+			return nil, 0, fmt.Errorf("%w: signed integer too long", errInvalidData)
+		}
+		return int32(uintFromBytes(b)), offset, nil //nolint:gosec
+	case typeUint128:
+		return new(big.Int).SetBytes(b), offset, nil
+	default:
+		return nil, 0, fmt.Errorf("%w: unknown type %d", errInvalidData, typ)
+	}
+}
+
+// size returns the size of the field from its control byte and the bytes following it.
+func (d decoder) size(ctrl byte, offset int) (int, int, error) {
+	size := int(ctrl & 0x1f) //nolint:mnd
+	if size < 29 {           //nolint:mnd
+		return size, offset, nil
+	}
+
+	n := size - 28 //nolint:mnd
+	b, err := d.bytes(offset, n)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch n {
+	case 1:
+		return 29 + int(b[0]), offset + n, nil //nolint:mnd
+	case 2: //nolint:mnd
+		return 285 + (int(b[0])<<8 | int(b[1])), offset + n, nil //nolint:mnd
+	default:
+		return 65821 + (int(b[0])<<16 | int(b[1])<<8 | int(b[2])), offset + n, nil //nolint:mnd
+	}
+}
+
+// decodePointer decodes the field the pointer points to. The offset of the next field is
+// the one after the pointer.
+func (d decoder) decodePointer(ctrl byte, offset int, depth int) (any, int, error) {
+	n := int(ctrl>>3&0x3) + 1 //nolint:mnd
+	b, err := d.bytes(offset, n)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vvv := uint32(ctrl & 0x7) //nolint:mnd
+	var pointer uint32
+	switch n {
+	case 1:
+		pointer = vvv<<8 | uint32(b[0])
+	case 2: //nolint:mnd
+		pointer = (vvv<<16 | uint32(b[0])<<8 | uint32(b[1])) + 2048 //nolint:mnd
+	case 3: //nolint:mnd
+		pointer = (vvv<<24 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])) + 526336 //nolint:mnd
+	default:
+		pointer = binary.BigEndian.Uint32(b)
+	}
+
+	value, _, err := d.decode(int(pointer), depth+1)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return value, offset + n, nil
+}
+
+func (d decoder) decodeMap(size int, offset int, depth int) (any, int, error) {
+	m := make(map[string]any, size)
+	for range size {
+		key, next, err := d.decode(offset, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		k, ok := key.(string)
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: map keys must be strings", errInvalidData)
+		}
+
+		value, next, err := d.decode(next, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		m[k] = value
+		offset = next
+	}
+
+	return m, offset, nil
+}
+
+func (d decoder) decodeArray(size int, offset int, depth int) (any, int, error) {
+	a := make([]any, size)
+	for i := range size {
+		value, next, err := d.decode(offset, depth+1)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		a[i] = value
+		offset = next
+	}
+
+	return a, offset, nil
+}
+
+func uintFromBytes(b []byte) uint64 {
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n
+}
@@ -0,0 +1,194 @@
+// Package geoip locates IP addresses with MaxMind DB files, such as the GeoLite2 City and
+// GeoIP2 City databases.
+package geoip
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+)
+
+// metadataStart marks the start of the metadata, at the end of the file.
+var metadataStart = []byte("\xab\xcd\xefMaxMind.com")
+
+// dataSectionSeparator is the number of zero bytes between the search tree and the data
+// section.
+const dataSectionSeparator = 16
+
+var (
+	ErrInvalidDatabase = errors.New("invalid geoip database")
+	errInvalidIP       = errors.New("invalid IP address")
+)
+
+// Location is the approximate location of an IP address. Fields the database doesn't have
+// for the address are empty and HasCoordinates is false if it doesn't have its coordinates.
+type Location struct {
+	Country        string
+	City           string
+	Latitude       float64
+	Longitude      float64
+	HasCoordinates bool
+}
+
+// Reader locates IP addresses using a MaxMind DB loaded in memory.
+type Reader struct {
+	tree       []byte
+	data       decoder
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	ipv4Start  uint
+}
+
+// Open loads the MaxMind DB at path.
+func Open(path string) (*Reader, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading geoip database: %w", err)
+	}
+	return New(b)
+}
+
+// New returns a reader for the MaxMind DB in b.
+func New(b []byte) (*Reader, error) {
+	i := bytes.LastIndex(b, metadataStart)
+	if i < 0 {
+		return nil, fmt.Errorf("%w: metadata not found", ErrInvalidDatabase)
+	}
+
+	metadata, _, err := decoder{buf: b[i+len(metadataStart):]}.decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDatabase, err)
+	}
+	m, ok := metadata.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: metadata must be a map", ErrInvalidDatabase)
+	}
+
+	nodeCount, _ := m["node_count"].(uint64)
+	recordSize, _ := m["record_size"].(uint64)
+	ipVersion, _ := m["ip_version"].(uint64)
+	if recordSize != 24 && recordSize != 28 && recordSize != 32 { //nolint:mnd
+		return nil, fmt.Errorf("%w: unsupported record size %d", ErrInvalidDatabase, recordSize)
+	}
+	if ipVersion != 4 && ipVersion != 6 { //nolint:mnd
+		return nil, fmt.Errorf("%w: unsupported IP version %d", ErrInvalidDatabase, ipVersion)
+	}
+
+	treeSize := nodeCount * recordSize / 4 //nolint:mnd
+	if treeSize+dataSectionSeparator > uint64(i) {
+		return nil, fmt.Errorf("%w: search tree larger than the file", ErrInvalidDatabase)
+	}
+
+	r := &Reader{
+		tree:       b[:treeSize],
+		data:       decoder{buf: b[treeSize+dataSectionSeparator : i]},
+		nodeCount:  uint(nodeCount),
+		recordSize: uint(recordSize),
+		ipVersion:  uint(ipVersion),
+		ipv4Start:  0,
+	}
+
+	// IPv4 addresses are in the ::/96 subtree of IPv6 databases
+	if r.ipVersion == 6 { //nolint:mnd
+		for range 96 {
+			if r.ipv4Start >= r.nodeCount {
+				break
+			}
+			r.ipv4Start = r.record(r.ipv4Start, 0)
+		}
+	}
+
+	return r, nil
+}
+
+// record returns the left record of the node if bit is 0 or its right one.
+func (r *Reader) record(node uint, bit uint) uint {
+	b := r.tree[node*r.recordSize/4:] //nolint:mnd
+
+	switch r.recordSize {
+	case 24: //nolint:mnd
+		b = b[bit*3:]
+		return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+	case 28: //nolint:mnd
+		if bit == 0 {
+			return uint(b[3]&0xf0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0f)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		b = b[bit*4:]
+		return uint(b[0])<<24 | uint(b[1])<<16 | uint(b[2])<<8 | uint(b[3])
+	}
+}
+
+// lookup returns the data of the network the address is in, nil if it isn't in any.
+func (r *Reader) lookup(addr netip.Addr) (any, error) {
+	addr = addr.Unmap()
+
+	node := uint(0)
+	bits := addr.AsSlice()
+	if addr.Is4() && r.ipVersion == 6 { //nolint:mnd
+		node = r.ipv4Start
+	} else if addr.Is6() && r.ipVersion == 4 { //nolint:mnd
+		return nil, nil
+	}
+
+	for i := 0; i < len(bits)*8 && node < r.nodeCount; i++ {
+		bit := uint(bits[i/8]>>(7-i%8)) & 1 //nolint:mnd
+		node = r.record(node, bit)
+	}
+
+	switch {
+	case node == r.nodeCount:
+		return nil, nil
+	case node < r.nodeCount:
+		return nil, fmt.Errorf("%w: search tree deeper than the address", ErrInvalidDatabase)
+	}
+
+	value, _, err := r.data.decode(int(node-r.nodeCount-dataSectionSeparator), 0) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDatabase, err)
+	}
+
+	return value, nil
+}
+
+func field(value any, path ...string) any {
+	for _, key := range path {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+// Locate returns the location of the IP address. ok is false if the database doesn't
+// have it.
+func (r *Reader) Locate(ip string) (Location, bool, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return Location{}, false, fmt.Errorf("%w: %w", errInvalidIP, err) //nolint:exhaustruct
+	}
+
+	value, err := r.lookup(addr)
+	if err != nil || value == nil {
+		return Location{}, false, err //nolint:exhaustruct
+	}
+
+	country, _ := field(value, "country", "iso_code").(string)
+	city, _ := field(value, "city", "names", "en").(string)
+	latitude, hasLatitude := field(value, "location", "latitude").(float64)
+	longitude, hasLongitude := field(value, "location", "longitude").(float64)
+
+	return Location{
+		Country:        country,
+		City:           city,
+		Latitude:       latitude,
+		Longitude:      longitude,
+		HasCoordinates: hasLatitude && hasLongitude,
+	}, true, nil
+}
@@ -0,0 +1,347 @@
+package geoip_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/geoip"
+)
+
+// pointer is encoded as a pointer to the offset of the data section.
+type pointer int
+
+// array is encoded with an extended type.
+type array []any
+
+func encodeControl(typ int, size int) []byte {
+	if typ > 7 { //nolint:mnd
+		return []byte{byte(size), byte(typ - 7)} //nolint:gosec,mnd
+	}
+	return []byte{byte(typ<<5 | size)} //nolint:gosec,mnd
+}
+
+func encode(value any) []byte {
+	switch v := value.(type) {
+	case string:
+		return append(encodeControl(2, len(v)), v...) //nolint:mnd
+	case float64:
+		return binary.BigEndian.AppendUint64(encodeControl(3, 8), math.Float64bits(v)) //nolint:mnd
+	case uint16:
+		return binary.BigEndian.AppendUint16(encodeControl(5, 2), v) //nolint:mnd
+	case uint32:
+		return binary.BigEndian.AppendUint32(encodeControl(6, 4), v) //nolint:mnd
+	case pointer:
+		return []byte{1<<5 | byte(v>>8), byte(v)} //nolint:gosec,mnd
+	case array:
+		b := encodeControl(11, len(v)) //nolint:mnd
+		for _, item := range v {
+			b = append(b, encode(item)...)
+		}
+		return b
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		b := encodeControl(7, len(v)) //nolint:mnd
+		for _, k := range keys {
+			b = append(b, encode(k)...)
+			b = append(b, encode(v[k])...)
+		}
+		return b
+	default:
+		panic("unsupported value")
+	}
+}
+
+type network struct {
+	prefix string
+	data   map[string]any
+}
+
+// buildDatabase writes a MaxMind DB with the networks. shared is written first in the
+// data section so the networks can point to it.
+func buildDatabase(ipVersion int, recordSize int, shared any, networks []network) []byte {
+	const empty = -1
+
+	data := encode(shared)
+	nodes := [][2]int{{empty, empty}}
+	dataRecords := map[int]int{}
+	for _, n := range networks {
+		prefix := netip.MustParsePrefix(n.prefix)
+		addr := prefix.Addr().AsSlice()
+		bits := prefix.Bits()
+		if ipVersion == 6 && prefix.Addr().Is4() {
+			addr = append(make([]byte, 12), addr...) //nolint:mnd
+			bits += 96
+		}
+
+		record := -len(data) - 2 //nolint:mnd
+		dataRecords[record] = len(data)
+		data = append(data, encode(n.data)...)
+
+		node := 0
+		for i := range bits {
+			bit := int(addr[i/8]>>(7-i%8)) & 1 //nolint:mnd
+			if i == bits-1 {
+				nodes[node][bit] = record
+				break
+			}
+			if nodes[node][bit] == empty {
+				nodes = append(nodes, [2]int{empty, empty})
+				nodes[node][bit] = len(nodes) - 1
+			}
+			node = nodes[node][bit]
+		}
+	}
+
+	value := func(record int) uint32 {
+		switch {
+		case record == empty:
+			return uint32(len(nodes)) //nolint:gosec
+		case record < empty:
+			return uint32(len(nodes) + 16 + dataRecords[record]) //nolint:gosec,mnd
+		default:
+			return uint32(record) //nolint:gosec
+		}
+	}
+
+	var db []byte
+	for _, node := range nodes {
+		left, right := value(node[0]), value(node[1])
+		switch recordSize {
+		case 24: //nolint:mnd
+			db = append(db, byte(left>>16), byte(left>>8), byte(left))
+			db = append(db, byte(right>>16), byte(right>>8), byte(right))
+		case 28: //nolint:mnd
+			db = append(db, byte(left>>16), byte(left>>8), byte(left))
+			db = append(db, byte(left>>20&0xf0|right>>24&0x0f))
+			db = append(db, byte(right>>16), byte(right>>8), byte(right))
+		default:
+			db = binary.BigEndian.AppendUint32(db, left)
+			db = binary.BigEndian.AppendUint32(db, right)
+		}
+	}
+
+	db = append(db, make([]byte, 16)...) //nolint:mnd
+	db = append(db, data...)
+	db = append(db, "\xab\xcd\xefMaxMind.com"...)
+	db = append(db, encode(map[string]any{
+		"node_count":    uint32(len(nodes)), //nolint:gosec
+		"record_size":   uint16(recordSize), //nolint:gosec
+		"ip_version":    uint16(ipVersion),  //nolint:gosec
+		"database_type": "GeoLite2-City",
+	})...)
+
+	return db
+}
+
+func TestLocate(t *testing.T) {
+	t.Parallel()
+
+	networks := []network{
+		{
+			prefix: "81.2.69.0/24",
+			data: map[string]any{
+				"city":    map[string]any{"names": map[string]any{"en": "London", "fr": "Londres"}},
+				"country": pointer(0),
+				"location": map[string]any{
+					"latitude":  51.5142,
+					"longitude": -0.0931,
+				},
+				"subdivisions": array{map[string]any{"iso_code": "ENG"}},
+			},
+		},
+		{
+			prefix: "175.16.199.0/24",
+			data: map[string]any{
+				"country": map[string]any{"iso_code": "CN"},
+			},
+		},
+		{
+			prefix: "2001:db8::/32",
+			data: map[string]any{
+				"city":    map[string]any{"names": map[string]any{"en": "Paris"}},
+				"country": map[string]any{"iso_code": "FR"},
+				"location": map[string]any{
+					"latitude":  48.8566,
+					"longitude": 2.3522,
+				},
+			},
+		},
+	}
+	shared := map[string]any{"iso_code": "GB"}
+
+	london := geoip.Location{
+		Country:        "GB",
+		City:           "London",
+		Latitude:       51.5142,
+		Longitude:      -0.0931,
+		HasCoordinates: true,
+	}
+	paris := geoip.Location{
+		Country:        "FR",
+		City:           "Paris",
+		Latitude:       48.8566,
+		Longitude:      2.3522,
+		HasCoordinates: true,
+	}
+
+	cases := []struct {
+		name       string
+		ipVersion  int
+		recordSize int
+		ip         string
+		expected   geoip.Location
+		expectedOK bool
+	}{
+		{
+			name:       "ipv4 database",
+			ipVersion:  4,
+			recordSize: 24,
+			ip:         "81.2.69.142",
+			expected:   london,
+			expectedOK: true,
+		},
+		{
+			name:       "ipv4 database with 32 bits records",
+			ipVersion:  4,
+			recordSize: 32,
+			ip:         "81.2.69.142",
+			expected:   london,
+			expectedOK: true,
+		},
+		{
+			name:       "ipv4 mapped address",
+			ipVersion:  4,
+			recordSize: 24,
+			ip:         "::ffff:81.2.69.142",
+			expected:   london,
+			expectedOK: true,
+		},
+		{
+			name:       "country only",
+			ipVersion:  4,
+			recordSize: 24,
+			ip:         "175.16.199.1",
+			expected: geoip.Location{
+				Country:        "CN",
+				City:           "",
+				Latitude:       0,
+				Longitude:      0,
+				HasCoordinates: false,
+			},
+			expectedOK: true,
+		},
+		{
+			name:       "unknown address",
+			ipVersion:  4,
+			recordSize: 24,
+			ip:         "192.0.2.1",
+			expected:   geoip.Location{}, //nolint:exhaustruct
+			expectedOK: false,
+		},
+		{
+			name:       "ipv6 address in an ipv4 database",
+			ipVersion:  4,
+			recordSize: 24,
+			ip:         "2001:db8::1",
+			expected:   geoip.Location{}, //nolint:exhaustruct
+			expectedOK: false,
+		},
+		{
+			name:       "ipv4 address in an ipv6 database",
+			ipVersion:  6,
+			recordSize: 28,
+			ip:         "81.2.69.142",
+			expected:   london,
+			expectedOK: true,
+		},
+		{
+			name:       "ipv6 address",
+			ipVersion:  6,
+			recordSize: 28,
+			ip:         "2001:db8:1::7",
+			expected:   paris,
+			expectedOK: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var n []network
+			for _, network := range networks {
+				if tc.ipVersion == 4 && netip.MustParsePrefix(network.prefix).Addr().Is6() {
+					continue
+				}
+				n = append(n, network)
+			}
+
+			reader, err := geoip.New(buildDatabase(tc.ipVersion, tc.recordSize, shared, n))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			location, ok, err := reader.Locate(tc.ip)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != tc.expectedOK {
+				t.Errorf("expected ok to be %t, got %t", tc.expectedOK, ok)
+			}
+			if diff := cmp.Diff(tc.expected, location); diff != "" {
+				t.Errorf("unexpected location (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocateInvalidIP(t *testing.T) {
+	t.Parallel()
+
+	reader, err := geoip.New(buildDatabase(4, 24, map[string]any{}, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := reader.Locate("not an ip"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestNewInvalidDatabase(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		db   []byte
+	}{
+		{
+			name: "no metadata",
+			db:   []byte("not a database"),
+		},
+		{
+			name: "truncated search tree",
+			db:   buildDatabase(4, 24, map[string]any{}, []network{{prefix: "81.2.69.0/24", data: map[string]any{}}})[30:], //nolint:lll
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := geoip.New(tc.db); !errors.Is(err, geoip.ErrInvalidDatabase) {
+				t.Errorf("expected an invalid database error, got %v", err)
+			}
+		})
+	}
+}
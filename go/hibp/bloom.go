@@ -0,0 +1,162 @@
+package hibp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+const bloomFilterMagic = "HIBPBF1\n"
+
+var ErrInvalidBloomFilter = errors.New("invalid bloom filter")
+
+// BloomFilter checks passwords against a local bloom filter of the SHA-1 hashes of the
+// Pwned Passwords list so no request leaves the server. Bloom filters can return false
+// positives, at the rate chosen when the filter was built, but never false negatives.
+//
+// The SHA-1 hash is already uniformly distributed so the filter uses its first 16 bytes
+// as the two hashes of the Kirsch-Mitzenmacher double hashing scheme.
+type BloomFilter struct {
+	bits   []byte
+	m      uint64
+	hashes uint32
+}
+
+// NewBloomFilter returns an empty filter sized to hold n hashes with the given false
+// positive rate.
+func NewBloomFilter(n uint64, falsePositiveRate float64) *BloomFilter {
+	m := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	m = max(m, 8) //nolint:mnd
+	k := uint32(math.Round(float64(m) / float64(max(n, 1)) * math.Ln2))
+
+	return &BloomFilter{
+		bits:   make([]byte, (m+7)/8), //nolint:mnd
+		m:      m,
+		hashes: max(k, 1),
+	}
+}
+
+func (b *BloomFilter) positions(hash []byte, fn func(i uint64) bool) bool {
+	h1 := binary.BigEndian.Uint64(hash[:8])
+	h2 := binary.BigEndian.Uint64(hash[8:16]) | 1
+
+	for i := range uint64(b.hashes) {
+		if !fn((h1 + i*h2) % b.m) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add adds the SHA-1 hash of a password to the filter.
+func (b *BloomFilter) Add(hash []byte) {
+	b.positions(hash, func(i uint64) bool {
+		b.bits[i/8] |= 1 << (i % 8) //nolint:mnd
+		return true
+	})
+}
+
+// Contains returns true if the SHA-1 hash of a password is probably in the filter.
+func (b *BloomFilter) Contains(hash []byte) bool {
+	return b.positions(hash, func(i uint64) bool {
+		return b.bits[i/8]&(1<<(i%8)) != 0 //nolint:mnd
+	})
+}
+
+// AddHashes adds the hashes read from r, one hex encoded SHA-1 hash per line. The count
+// that follows the hash in the Pwned Passwords downloads is ignored.
+func (b *BloomFilter) AddHashes(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if line == "" {
+			continue
+		}
+
+		hash, err := hex.DecodeString(line)
+		if err != nil || len(hash) != sha1.Size {
+			return fmt.Errorf("invalid sha-1 hash: %s", line) //nolint:goerr113
+		}
+
+		b.Add(hash)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading hashes: %w", err)
+	}
+
+	return nil
+}
+
+// WriteTo writes the filter in the format read by ReadBloomFilter.
+func (b *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	header := make([]byte, 0, len(bloomFilterMagic)+12) //nolint:mnd
+	header = append(header, bloomFilterMagic...)
+	header = binary.BigEndian.AppendUint64(header, b.m)
+	header = binary.BigEndian.AppendUint32(header, b.hashes)
+
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), fmt.Errorf("error writing bloom filter header: %w", err)
+	}
+
+	m, err := w.Write(b.bits)
+	if err != nil {
+		return int64(n + m), fmt.Errorf("error writing bloom filter: %w", err)
+	}
+
+	return int64(n + m), nil
+}
+
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	header := make([]byte, len(bloomFilterMagic)+12) //nolint:mnd
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("error reading bloom filter header: %w", err)
+	}
+
+	if !bytes.Equal(header[:len(bloomFilterMagic)], []byte(bloomFilterMagic)) {
+		return nil, ErrInvalidBloomFilter
+	}
+
+	m := binary.BigEndian.Uint64(header[len(bloomFilterMagic):])
+	hashes := binary.BigEndian.Uint32(header[len(bloomFilterMagic)+8:])
+	if m == 0 || hashes == 0 {
+		return nil, ErrInvalidBloomFilter
+	}
+
+	bits := make([]byte, (m+7)/8) //nolint:mnd
+	if _, err := io.ReadFull(r, bits); err != nil {
+		return nil, fmt.Errorf("error reading bloom filter: %w", err)
+	}
+
+	return &BloomFilter{
+		bits:   bits,
+		m:      m,
+		hashes: hashes,
+	}, nil
+}
+
+func LoadBloomFilter(path string) (*BloomFilter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening bloom filter: %w", err)
+	}
+	defer f.Close()
+
+	return ReadBloomFilter(bufio.NewReader(f))
+}
+
+func (b *BloomFilter) IsPasswordPwned(_ context.Context, password string) (bool, error) {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec
+	return b.Contains(hash[:]), nil
+}
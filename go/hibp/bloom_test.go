@@ -0,0 +1,97 @@
+package hibp_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/hibp"
+)
+
+func sha1Hex(password string) string {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+func TestBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	pwned := []string{"password", "123456", "qwerty", "letmein"}
+
+	hashes := make([]string, 0, len(pwned))
+	for i, p := range pwned {
+		hashes = append(hashes, fmt.Sprintf("%s:%d", sha1Hex(p), i+1))
+	}
+
+	filter := hibp.NewBloomFilter(uint64(len(pwned)), 0.0001)
+	if err := filter.AddHashes(strings.NewReader(strings.Join(hashes, "\r\n"))); err != nil {
+		t.Fatalf("error adding hashes: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := filter.WriteTo(&buf); err != nil {
+		t.Fatalf("error writing bloom filter: %v", err)
+	}
+
+	loaded, err := hibp.ReadBloomFilter(&buf)
+	if err != nil {
+		t.Fatalf("error reading bloom filter: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		password string
+		isPwned  bool
+	}{
+		{
+			name:     "p0wn3d",
+			password: "password",
+			isPwned:  true,
+		},
+		{
+			name:     "p0wn3d last",
+			password: "letmein",
+			isPwned:  true,
+		},
+		{
+			name:     "s4f3",
+			password: "asdkjq;34ou90pdsaojfcmnkelwnfvsodvyo324jrnklasjdlaksjd891273jl",
+			isPwned:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pwned, err := loaded.IsPasswordPwned(context.Background(), tc.password)
+			if err != nil {
+				t.Errorf("error checking password: %v", err)
+			}
+
+			if pwned != tc.isPwned {
+				t.Errorf("expected pwned: %v, got: %v", tc.isPwned, pwned)
+			}
+		})
+	}
+}
+
+func TestBloomFilterInvalid(t *testing.T) {
+	t.Parallel()
+
+	if err := hibp.NewBloomFilter(1, 0.01).AddHashes(
+		strings.NewReader("not-a-hash:1"),
+	); err == nil {
+		t.Errorf("expected error adding invalid hash")
+	}
+
+	_, err := hibp.ReadBloomFilter(strings.NewReader("this is not a bloom filter"))
+	if !errors.Is(err, hibp.ErrInvalidBloomFilter) {
+		t.Errorf("expected ErrInvalidBloomFilter, got: %v", err)
+	}
+}
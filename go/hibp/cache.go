@@ -0,0 +1,54 @@
+package hibp
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/ttlcache"
+)
+
+type RangeClient interface {
+	Range(ctx context.Context, prefix string) ([]string, error)
+}
+
+// CachedClient caches the responses of the range API so passwords sharing a prefix,
+// usually the most common ones, don't need a request each time they are checked. At most
+// maxEntries prefixes are kept, each one for ttl.
+type CachedClient struct {
+	client RangeClient
+	cache  *ttlcache.Cache[string, []string]
+}
+
+func NewCachedClient(client RangeClient, ttl time.Duration, maxEntries int) *CachedClient {
+	return &CachedClient{
+		client: client,
+		cache:  ttlcache.New[string, []string](ttl, maxEntries),
+	}
+}
+
+func (c *CachedClient) Range(ctx context.Context, prefix string) ([]string, error) {
+	if suffixes, ok := c.cache.Get(prefix); ok {
+		return suffixes, nil
+	}
+
+	suffixes, err := c.client.Range(ctx, prefix)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	c.cache.Set(prefix, suffixes)
+
+	return suffixes, nil
+}
+
+func (c *CachedClient) IsPasswordPwned(ctx context.Context, password string) (bool, error) {
+	hashedPassword := sha1Hash(password)
+
+	suffixes, err := c.Range(ctx, hashedPassword[:5])
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(suffixes, hashedPassword[5:]), nil
+}
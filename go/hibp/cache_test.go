@@ -0,0 +1,102 @@
+package hibp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/hibp"
+)
+
+var errRange = errors.New("range error")
+
+type fakeRangeClient struct {
+	suffixes map[string][]string
+	calls    int
+	err      error
+}
+
+func (c *fakeRangeClient) Range(_ context.Context, prefix string) ([]string, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.suffixes[prefix], nil
+}
+
+func TestCachedClient(t *testing.T) {
+	t.Parallel()
+
+	hash := sha1Hex("password")
+
+	cases := []struct {
+		name          string
+		ttl           time.Duration
+		maxEntries    int
+		passwords     []string
+		err           error
+		expectedCalls int
+	}{
+		{
+			name:          "cached",
+			ttl:           time.Minute,
+			maxEntries:    10,
+			passwords:     []string{"password", "password", "password"},
+			err:           nil,
+			expectedCalls: 1,
+		},
+		{
+			name:          "expired",
+			ttl:           -time.Second,
+			maxEntries:    10,
+			passwords:     []string{"password", "password"},
+			err:           nil,
+			expectedCalls: 2,
+		},
+		{
+			name:          "evicted",
+			ttl:           time.Minute,
+			maxEntries:    1,
+			passwords:     []string{"password", "123456", "password"},
+			err:           nil,
+			expectedCalls: 3,
+		},
+		{
+			name:          "errors aren't cached",
+			ttl:           time.Minute,
+			maxEntries:    10,
+			passwords:     []string{"password", "password"},
+			err:           errRange,
+			expectedCalls: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rangeClient := &fakeRangeClient{
+				suffixes: map[string][]string{hash[:5]: {"0018A45C4D1DEF81644B54AB7F969B88D65", hash[5:]}},
+				calls:    0,
+				err:      tc.err,
+			}
+			client := hibp.NewCachedClient(rangeClient, tc.ttl, tc.maxEntries)
+
+			for _, password := range tc.passwords {
+				pwned, err := client.IsPasswordPwned(context.Background(), password)
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if tc.err == nil && pwned != (password == "password") {
+					t.Errorf("unexpected result for %s: %v", password, pwned)
+				}
+			}
+
+			if rangeClient.calls != tc.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tc.expectedCalls, rangeClient.calls)
+			}
+		})
+	}
+}
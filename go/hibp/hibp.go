@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 )
@@ -16,6 +17,8 @@ const (
 	rangeURL           = "https://api.pwnedpasswords.com/range/"
 	retryRateLimitTime = 3 * time.Second
 	maxRetries         = 3
+	// rangeSizeHint is roughly the number of hashes returned for each prefix.
+	rangeSizeHint = 1000
 )
 
 type Client struct {
@@ -64,25 +67,34 @@ func (c *Client) getRangeResponse(
 	return resp, nil
 }
 
-func (c *Client) IsPasswordPwned(ctx context.Context, password string) (bool, error) {
-	hashedPassword := sha1Hash(password)
-
-	resp, err := c.getRangeResponse(ctx, hashedPassword[:5], 0)
+// Range returns the suffixes of the pwned password hashes starting with the prefix.
+func (c *Client) Range(ctx context.Context, prefix string) ([]string, error) {
+	resp, err := c.getRangeResponse(ctx, prefix, 0)
 	if err != nil {
-		return false, fmt.Errorf("error querying hibp: %w", err)
+		return nil, fmt.Errorf("error querying hibp: %w", err)
 	}
 	defer resp.Body.Close()
 
+	suffixes := make([]string, 0, rangeSizeHint)
 	scanner := bufio.NewScanner(resp.Body)
-	hashSuffix := hashedPassword[5:]
-
 	for scanner.Scan() {
-		line := scanner.Text()
-		parts := strings.Split(line, ":")
-		if parts[0] == hashSuffix {
-			return true, nil
-		}
+		suffix, _, _ := strings.Cut(scanner.Text(), ":")
+		suffixes = append(suffixes, suffix)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading hibp response: %w", err)
+	}
+
+	return suffixes, nil
+}
+
+func (c *Client) IsPasswordPwned(ctx context.Context, password string) (bool, error) {
+	hashedPassword := sha1Hash(password)
+
+	suffixes, err := c.Range(ctx, hashedPassword[:5])
+	if err != nil {
+		return false, err
 	}
 
-	return false, nil
+	return slices.Contains(suffixes, hashedPassword[5:]), nil
 }
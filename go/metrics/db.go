@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// DBTX wraps the connection used by the queries to observe their latency.
+type DBTX struct {
+	db sql.DBTX
+}
+
+func NewDBTX(db sql.DBTX) *DBTX {
+	return &DBTX{
+		db: db,
+	}
+}
+
+func observeQuery(query string, start time.Time) {
+	DBQueryDuration.WithLabelValues(sql.QueryName(query)).Observe(time.Since(start).Seconds())
+}
+
+func (d *DBTX) Exec(
+	ctx context.Context, query string, args ...interface{},
+) (pgconn.CommandTag, error) {
+	defer observeQuery(query, time.Now())
+	return d.db.Exec(ctx, query, args...) //nolint:wrapcheck
+}
+
+func (d *DBTX) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	defer observeQuery(query, time.Now())
+	return d.db.Query(ctx, query, args...) //nolint:wrapcheck
+}
+
+func (d *DBTX) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	defer observeQuery(query, time.Now())
+	return d.db.QueryRow(ctx, query, args...)
+}
+
+// InTx runs fn in a transaction if the wrapped connection supports them.
+func (d *DBTX) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if t, ok := d.db.(sql.Transactor); ok {
+		return t.InTx(ctx, fn) //nolint:wrapcheck
+	}
+	return fn(ctx)
+}
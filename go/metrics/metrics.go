@@ -0,0 +1,97 @@
+// Package metrics defines the Prometheus metrics exposed by the service.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "hasura_auth"
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+)
+
+var (
+	// SignIns counts the sign in attempts by method and outcome, the outcome is either
+	// success or the error code returned to the client.
+	SignIns = promauto.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "sign_ins_total",
+			Help:      "Number of sign in attempts by method and outcome.",
+		},
+		[]string{"method", "outcome"},
+	)
+
+	// TokenRefreshes counts the refresh token exchanges by outcome.
+	TokenRefreshes = promauto.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "token_refreshes_total",
+			Help:      "Number of access token refreshes by outcome.",
+		},
+		[]string{"outcome"},
+	)
+
+	// EmailsSent counts the emails sent by template and outcome.
+	EmailsSent = promauto.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "emails_sent_total",
+			Help:      "Number of emails sent by template and outcome.",
+		},
+		[]string{"template", "outcome"},
+	)
+
+	// HIBPChecks counts the passwords checked against Have I Been Pwned by result.
+	HIBPChecks = promauto.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "hibp_checks_total",
+			Help:      "Number of passwords checked against Have I Been Pwned by result.",
+		},
+		[]string{"result"},
+	)
+
+	// DBQueryDuration observes the latency of the database queries by query name.
+	DBQueryDuration = promauto.NewHistogramVec( //nolint:gochecknoglobals
+		prometheus.HistogramOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Latency of the database queries by query.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"query"},
+	)
+
+	// DisposableEmailSignUps counts the sign ups with the email of a disposable email
+	// provider by action, flagged or rejected.
+	DisposableEmailSignUps = promauto.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "disposable_email_sign_ups_total",
+			Help:      "Number of sign ups with a disposable email address by action.",
+		},
+		[]string{"action"},
+	)
+
+	// RateLimitRejections counts the requests rejected by the rate limiter by category.
+	RateLimitRejections = promauto.NewCounterVec( //nolint:gochecknoglobals
+		prometheus.CounterOpts{ //nolint:exhaustruct
+			Namespace: namespace,
+			Name:      "rate_limit_rejections_total",
+			Help:      "Number of requests rejected by the rate limiter by category.",
+		},
+		[]string{"category"},
+	)
+)
+
+// Handler serves the metrics in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
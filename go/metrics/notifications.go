@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+type emailer interface {
+	SendEmail(
+		ctx context.Context,
+		to string,
+		locale string,
+		templateName notifications.TemplateName,
+		data notifications.TemplateData,
+	) error
+}
+
+// Emailer counts the emails sent by the wrapped emailer.
+type Emailer struct {
+	emailer emailer
+}
+
+func NewEmailer(emailer emailer) *Emailer {
+	return &Emailer{
+		emailer: emailer,
+	}
+}
+
+func (e *Emailer) SendEmail(
+	ctx context.Context,
+	to string,
+	locale string,
+	templateName notifications.TemplateName,
+	data notifications.TemplateData,
+) error {
+	err := e.emailer.SendEmail(ctx, to, locale, templateName, data)
+
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeError
+	}
+	EmailsSent.WithLabelValues(string(templateName), outcome).Inc()
+
+	return err //nolint:wrapcheck
+}
+
+func (e *Emailer) Ping(ctx context.Context) error {
+	return notifications.Ping(ctx, e.emailer) //nolint:wrapcheck
+}
+
+type hibpClient interface {
+	IsPasswordPwned(ctx context.Context, password string) (bool, error)
+}
+
+// HIBPClient counts the passwords checked by the wrapped client.
+type HIBPClient struct {
+	client hibpClient
+}
+
+func NewHIBPClient(client hibpClient) *HIBPClient {
+	return &HIBPClient{
+		client: client,
+	}
+}
+
+func (c *HIBPClient) IsPasswordPwned(ctx context.Context, password string) (bool, error) {
+	pwned, err := c.client.IsPasswordPwned(ctx, password)
+
+	switch {
+	case err != nil:
+		HIBPChecks.WithLabelValues(OutcomeError).Inc()
+	case pwned:
+		HIBPChecks.WithLabelValues("pwned").Inc()
+	default:
+		HIBPChecks.WithLabelValues("not-pwned").Inc()
+	}
+
+	return pwned, err //nolint:wrapcheck
+}
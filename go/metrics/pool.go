@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type dbPool struct {
+	name     string
+	tenantID string
+	pool     *pgxpool.Pool
+}
+
+// dbPoolCollector publishes the stats of the database pools when the metrics are
+// gathered.
+type dbPoolCollector struct {
+	mu    sync.Mutex
+	pools []dbPool
+
+	acquired       *prometheus.Desc
+	idle           *prometheus.Desc
+	constructing   *prometheus.Desc
+	total          *prometheus.Desc
+	max            *prometheus.Desc
+	acquires       *prometheus.Desc
+	emptyAcquires  *prometheus.Desc
+	acquireSeconds *prometheus.Desc
+}
+
+func newDBPoolDesc(name string, help string) *prometheus.Desc {
+	return prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", name),
+		help,
+		[]string{"pool", "tenant_id"},
+		nil,
+	)
+}
+
+func newDBPoolCollector() *dbPoolCollector {
+	return &dbPoolCollector{
+		mu:    sync.Mutex{},
+		pools: nil,
+		acquired: newDBPoolDesc(
+			"acquired_connections", "Number of connections of the pool currently in use.",
+		),
+		idle: newDBPoolDesc(
+			"idle_connections", "Number of idle connections of the pool.",
+		),
+		constructing: newDBPoolDesc(
+			"constructing_connections", "Number of connections of the pool being established.",
+		),
+		total: newDBPoolDesc(
+			"total_connections", "Number of connections of the pool.",
+		),
+		max: newDBPoolDesc(
+			"max_connections", "Maximum number of connections of the pool.",
+		),
+		acquires: newDBPoolDesc(
+			"acquires_total", "Number of connections acquired from the pool.",
+		),
+		emptyAcquires: newDBPoolDesc(
+			"empty_acquires_total",
+			"Number of connections acquired from the pool that had to wait for a connection.",
+		),
+		acquireSeconds: newDBPoolDesc(
+			"acquire_duration_seconds_total",
+			"Time spent acquiring connections from the pool.",
+		),
+	}
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquired
+	ch <- c.idle
+	ch <- c.constructing
+	ch <- c.total
+	ch <- c.max
+	ch <- c.acquires
+	ch <- c.emptyAcquires
+	ch <- c.acquireSeconds
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, p := range c.pools {
+		stat := p.pool.Stat()
+		gauge := func(desc *prometheus.Desc, value float64) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, p.name, p.tenantID)
+		}
+		counter := func(desc *prometheus.Desc, value float64) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, p.name, p.tenantID)
+		}
+
+		gauge(c.acquired, float64(stat.AcquiredConns()))
+		gauge(c.idle, float64(stat.IdleConns()))
+		gauge(c.constructing, float64(stat.ConstructingConns()))
+		gauge(c.total, float64(stat.TotalConns()))
+		gauge(c.max, float64(stat.MaxConns()))
+		counter(c.acquires, float64(stat.AcquireCount()))
+		counter(c.emptyAcquires, float64(stat.EmptyAcquireCount()))
+		counter(c.acquireSeconds, stat.AcquireDuration().Seconds())
+	}
+}
+
+var (
+	dbPools         = newDBPoolCollector() //nolint:gochecknoglobals
+	dbPoolsRegister sync.Once              //nolint:gochecknoglobals
+)
+
+// ObserveDBPool publishes the stats of the pool, i.e. how many of its connections are
+// in use, labeled with the name of the pool and the tenant it serves.
+func ObserveDBPool(name string, tenantID string, pool *pgxpool.Pool) {
+	dbPoolsRegister.Do(func() {
+		prometheus.MustRegister(dbPools)
+	})
+
+	dbPools.mu.Lock()
+	defer dbPools.mu.Unlock()
+	dbPools.pools = append(dbPools.pools, dbPool{
+		name:     name,
+		tenantID: tenantID,
+		pool:     pool,
+	})
+}
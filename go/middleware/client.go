@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeaderClient identifies the app sending the request, i.e. web or mobile. It is
+	// recorded on the sessions of the app, which can be given their own lifetimes.
+	HeaderClient = "X-Hasura-Auth-Client"
+	// HeaderClientVersion is the version of the app sending the request.
+	HeaderClientVersion = "X-Hasura-Auth-Client-Version"
+	// HeaderRefreshTokenExpiresIn is the lifetime, in seconds, the client asks for the
+	// refresh tokens of the sessions it starts.
+	HeaderRefreshTokenExpiresIn = "X-Hasura-Auth-Refresh-Token-Expires-In"
+)
+
+type clientInfoCtxKey struct{}
+
+// ClientInfo describes the client that sent the request.
+type ClientInfo struct {
+	IP                    string
+	UserAgent             string
+	ID                    string
+	Version               string
+	RefreshTokenExpiresIn int
+}
+
+// Stores the client information in the context.
+func ClientInfoToContext(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoCtxKey{}, info)
+}
+
+// Retrieves the client information from the context. It returns an empty ClientInfo if it
+// can't be found.
+func ClientInfoFromContext(ctx context.Context) ClientInfo { //nolint:contextcheck
+	ginCtx, ok := ctx.(*gin.Context)
+	if ok {
+		ctx = ginCtx.Request.Context()
+	}
+
+	info, ok := ctx.Value(clientInfoCtxKey{}).(ClientInfo)
+	if !ok {
+		return ClientInfo{} //nolint:exhaustruct
+	}
+	return info
+}
+
+func Client() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request = ctx.Request.WithContext(
+			ClientInfoToContext(ctx.Request.Context(), ClientInfo{
+				IP:                    ctx.ClientIP(),
+				UserAgent:             ctx.Request.UserAgent(),
+				ID:                    ctx.GetHeader(HeaderClient),
+				Version:               ctx.GetHeader(HeaderClientVersion),
+				RefreshTokenExpiresIn: parseExpiresIn(ctx.GetHeader(HeaderRefreshTokenExpiresIn)),
+			}),
+		)
+		ctx.Next()
+	}
+}
+
+// parseExpiresIn returns the seconds of the header, or 0 if it isn't a positive number.
+func parseExpiresIn(value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func grpcClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// GRPCLogger is the gRPC counterpart of Logger and Client. It stores the logger and the
+// client information in the context of the call, sends the request id back in the
+// x-request-id header and logs the call once it is completed.
+func GRPCLogger(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		startTime := time.Now()
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		id := firstMetadataValue(md, HeaderRequestID)
+		if !validRequestID(id) {
+			id = uuid.New().String()
+		}
+		r := &request{
+			id:     id,
+			userID: "",
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderRequestID, r.id))
+
+		client := ClientInfo{
+			IP:        grpcClientIP(ctx),
+			UserAgent: firstMetadataValue(md, "user-agent"),
+			ID:        firstMetadataValue(md, HeaderClient),
+			Version:   firstMetadataValue(md, HeaderClientVersion),
+			RefreshTokenExpiresIn: parseExpiresIn(
+				firstMetadataValue(md, HeaderRefreshTokenExpiresIn),
+			),
+		}
+
+		logger := logger.With(
+			slog.Group(
+				"request",
+				slog.String("id", r.id),
+				slog.String("client_ip", client.IP),
+				slog.String("method", info.FullMethod),
+			),
+		)
+
+		ctx = ClientInfoToContext(ctx, client)
+		ctx = context.WithValue(LoggerToContext(ctx, logger), requestCtxKey{}, r)
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		logger = logger.With(slog.Group(
+			"response",
+			slog.String("code", code.String()),
+			slog.Duration("latency_time", time.Since(startTime)),
+			slog.String("user_id", r.userID),
+		))
+
+		if code == codes.Internal || code == codes.Unknown {
+			logger.ErrorContext(ctx, "call completed with errors", slog.String("error", err.Error()))
+		} else {
+			logger.InfoContext(ctx, "call completed")
+		}
+
+		return resp, err
+	}
+}
+
+// GRPCRecovery is the gRPC counterpart of gin.Recovery. It turns panics into internal
+// errors so they don't crash the server.
+func GRPCRecovery(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			LoggerFromContext(ctx).ErrorContext(
+				ctx, "panic recovered", slog.Any("panic", r), slog.String("method", info.FullMethod),
+			)
+			err = status.Error(codes.Internal, "internal server error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
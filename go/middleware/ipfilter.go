@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/api"
+)
+
+// IPRule restricts the IPs that can reach the paths starting with Path, an empty Path
+// matches every request. An IP is rejected if it is in Deny or if Allow isn't empty and the
+// IP isn't in it.
+type IPRule struct {
+	Path  string
+	Allow []netip.Prefix
+	Deny  []netip.Prefix
+}
+
+// ParsePrefixes parses a list of CIDRs. Single IPs are accepted as well and match only
+// that address.
+func ParsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		if !strings.Contains(v, "/") {
+			addr, err := netip.ParseAddr(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ip %s: %w", v, err)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cidr %s: %w", v, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r IPRule) matchesPath(path string) bool {
+	prefix := strings.TrimSuffix(r.Path, "/")
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func (r IPRule) allows(addr netip.Addr) bool {
+	if containsAddr(r.Deny, addr) {
+		return false
+	}
+	return len(r.Allow) == 0 || containsAddr(r.Allow, addr)
+}
+
+// SetTrustedProxies sets the proxies the engine trusts to set X-Forwarded-For. Gin trusts
+// every proxy by default, so when proxies is empty none are trusted and the client IP is
+// always the one of the connection.
+func SetTrustedProxies(engine *gin.Engine, proxies []string) error {
+	if len(proxies) == 0 {
+		proxies = nil
+	}
+
+	if err := engine.SetTrustedProxies(proxies); err != nil {
+		return fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
+	return nil
+}
+
+// IPFilter rejects the requests from IPs not allowed by every rule matching the path of the
+// request, relative to basePath. The IP is the one returned by gin so it honours the
+// trusted proxies of the engine.
+func IPFilter(basePath string, rules []IPRule) gin.HandlerFunc {
+	basePath = strings.TrimSuffix(basePath, "/")
+
+	return func(ctx *gin.Context) {
+		path := strings.TrimPrefix(ctx.Request.URL.Path, basePath)
+		clientIP := ctx.ClientIP()
+		addr, err := netip.ParseAddr(clientIP)
+
+		for _, rule := range rules {
+			if !rule.matchesPath(path) {
+				continue
+			}
+
+			if err != nil || !rule.allows(addr.Unmap()) {
+				LoggerFromContext(ctx).Warn(
+					"ip not allowed", slog.String("ip", clientIP), slog.String("path", path),
+				)
+				ctx.AbortWithStatusJSON(http.StatusForbidden, api.ErrorResponse{
+					Status:  http.StatusForbidden,
+					Error:   api.IpNotAllowed,
+					Message: "Requests from this IP address are not allowed",
+				})
+				return
+			}
+		}
+
+		ctx.Next()
+	}
+}
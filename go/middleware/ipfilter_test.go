@@ -0,0 +1,187 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func mustParsePrefixes(t *testing.T, values ...string) []netip.Prefix {
+	t.Helper()
+
+	prefixes, err := middleware.ParsePrefixes(values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return prefixes
+}
+
+func TestParsePrefixes(t *testing.T) {
+	t.Parallel()
+
+	prefixes, err := middleware.ParsePrefixes(
+		[]string{"10.0.0.1/8", " 192.168.1.1 ", "2001:db8::/32", "::ffff:172.16.0.1", ""},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"10.0.0.0/8", "192.168.1.1/32", "2001:db8::/32", "172.16.0.1/32"}
+	if len(prefixes) != len(expected) {
+		t.Fatalf("expected %d prefixes, got %d", len(expected), len(prefixes))
+	}
+	for i, prefix := range prefixes {
+		if prefix.String() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], prefix)
+		}
+	}
+
+	if _, err := middleware.ParsePrefixes([]string{"not-an-ip"}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestIPFilter(t *testing.T) {
+	t.Parallel()
+
+	rules := []middleware.IPRule{
+		{Path: "", Allow: nil, Deny: mustParsePrefixes(t, "192.168.1.10")},
+		{Path: "/admin/", Allow: mustParsePrefixes(t, "10.0.0.0/8"), Deny: nil},
+	}
+
+	cases := []struct {
+		name           string
+		remoteAddr     string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "public endpoint",
+			remoteAddr:     "192.168.1.1:1234",
+			path:           "/v1/signin/email-password",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "denied everywhere",
+			remoteAddr:     "192.168.1.10:1234",
+			path:           "/v1/signin/email-password",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "admin from internal range",
+			remoteAddr:     "10.1.2.3:1234",
+			path:           "/v1/admin/users/123/unlock",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "admin from outside",
+			remoteAddr:     "192.168.1.1:1234",
+			path:           "/v1/admin/users/123/unlock",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "admin exact path",
+			remoteAddr:     "192.168.1.1:1234",
+			path:           "/v1/admin",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "path sharing the prefix",
+			remoteAddr:     "192.168.1.1:1234",
+			path:           "/v1/administrator",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := gin.New()
+			if err := engine.SetTrustedProxies(nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			engine.Use(middleware.IPFilter("/v1/", rules))
+			engine.NoRoute(func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.RemoteAddr = tc.remoteAddr
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, recorder.Code)
+			}
+		})
+	}
+}
+
+func TestSetTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	rules := []middleware.IPRule{
+		{Path: "/admin/", Allow: mustParsePrefixes(t, "10.0.0.0/8"), Deny: nil},
+	}
+
+	cases := []struct {
+		name           string
+		proxies        []string
+		remoteAddr     string
+		expectedStatus int
+	}{
+		{
+			name:           "spoofed header without trusted proxies",
+			proxies:        nil,
+			remoteAddr:     "192.168.1.1:1234",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "header from an untrusted proxy",
+			proxies:        []string{"172.16.0.0/12"},
+			remoteAddr:     "192.168.1.1:1234",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "header from a trusted proxy",
+			proxies:        []string{"172.16.0.0/12"},
+			remoteAddr:     "172.16.0.1:1234",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := gin.New()
+			if err := middleware.SetTrustedProxies(engine, tc.proxies); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			engine.Use(middleware.IPFilter("/v1/", rules))
+			engine.NoRoute(func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/v1/admin/users", nil)
+			req.RemoteAddr = tc.remoteAddr
+			req.Header.Set("X-Forwarded-For", "10.0.0.1")
+			engine.ServeHTTP(recorder, req)
+
+			if recorder.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, recorder.Code)
+			}
+		})
+	}
+
+	if err := middleware.SetTrustedProxies(gin.New(), []string{"not-a-cidr"}); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -3,13 +3,76 @@ package middleware
 import (
 	"context"
 	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type loggerCtxKey struct{}
 
+type requestCtxKey struct{}
+
+// HeaderRequestID is the header with the id of the request. It is kept if the client or a
+// proxy sets it, otherwise a new one is generated. It is sent back in the response.
+const HeaderRequestID = "X-Request-Id"
+
+const maxRequestIDLength = 128
+
+// request holds the data of the request logged once it is completed.
+type request struct {
+	id     string
+	userID string
+}
+
+func requestFromContext(ctx context.Context) *request { //nolint:contextcheck
+	ginCtx, ok := ctx.(*gin.Context)
+	if ok {
+		if ginCtx.Request == nil {
+			return nil
+		}
+		ctx = ginCtx.Request.Context()
+	}
+
+	req, _ := ctx.Value(requestCtxKey{}).(*request)
+	return req
+}
+
+// RequestIDFromContext returns the id of the request or an empty string if there isn't one.
+func RequestIDFromContext(ctx context.Context) string {
+	if req := requestFromContext(ctx); req != nil {
+		return req.id
+	}
+	return ""
+}
+
+// SetUserID records the user the request is about so it is logged with the request.
+func SetUserID(ctx context.Context, userID string) {
+	if req := requestFromContext(ctx); req != nil {
+		req.userID = userID
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func requestID(header http.Header) string {
+	if id := header.Get(HeaderRequestID); validRequestID(id) {
+		return id
+	}
+	return uuid.New().String()
+}
+
 // Stores the logger in the context.
 func LoggerToContext(ctx context.Context, logger *slog.Logger) context.Context {
 	return context.WithValue(ctx, loggerCtxKey{}, logger)
@@ -35,6 +98,14 @@ func Logger(logger *slog.Logger) gin.HandlerFunc {
 
 		trace := TraceFromHTTPHeaders(ctx.Request.Header)
 
+		req := &request{
+			id:     requestID(ctx.Request.Header),
+			userID: "",
+		}
+		// the id is forwarded to the node server and sent back to the client
+		ctx.Request.Header.Set(HeaderRequestID, req.id)
+		ctx.Header(HeaderRequestID, req.id)
+
 		clientIP := ctx.ClientIP()
 		reqMethod := ctx.Request.Method
 		reqURL := ctx.Request.RequestURI
@@ -47,13 +118,16 @@ func Logger(logger *slog.Logger) gin.HandlerFunc {
 			),
 			slog.Group(
 				"request",
+				slog.String("id", req.id),
 				slog.String("client_ip", clientIP),
 				slog.String("method", reqMethod),
 				slog.String("url", reqURL),
 			),
 		)
 		ctx.Request = ctx.Request.WithContext(
-			LoggerToContext(ctx.Request.Context(), logger),
+			context.WithValue(
+				LoggerToContext(ctx.Request.Context(), logger), requestCtxKey{}, req,
+			),
 		)
 		ctx.Next()
 
@@ -64,6 +138,7 @@ func Logger(logger *slog.Logger) gin.HandlerFunc {
 			"response",
 			slog.Int("status_code", statusCode),
 			slog.Duration("latency_time", latencyTime),
+			slog.String("user_id", req.userID),
 			slog.Any("errors", ctx.Errors.Errors()),
 		))
 
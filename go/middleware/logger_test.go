@@ -0,0 +1,95 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/middleware"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		requestID string
+		expected  func(id string) bool
+	}{
+		{
+			name:      "request id kept",
+			requestID: "abc-123",
+			expected:  func(id string) bool { return id == "abc-123" },
+		},
+		{
+			name:      "request id generated",
+			requestID: "",
+			expected:  func(id string) bool { return len(id) == 36 }, //nolint:mnd
+		},
+		{
+			name:      "invalid request id replaced",
+			requestID: strings.Repeat("a", 200),
+			expected:  func(id string) bool { return len(id) == 36 }, //nolint:mnd
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{ //nolint:exhaustruct
+				ReplaceAttr: middleware.RedactAttr,
+			}))
+
+			engine := gin.New()
+			engine.Use(middleware.Logger(logger))
+			engine.GET("/user", func(ctx *gin.Context) {
+				middleware.SetUserID(ctx, "db477732-48fa-4289-b694-2886a646b6eb")
+				ctx.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/user?ticket=secret", nil)
+			if tc.requestID != "" {
+				req.Header.Set(middleware.HeaderRequestID, tc.requestID)
+			}
+			engine.ServeHTTP(recorder, req)
+
+			id := recorder.Header().Get(middleware.HeaderRequestID)
+			if !tc.expected(id) {
+				t.Errorf("unexpected request id: %s", id)
+			}
+
+			var entry struct {
+				Request struct {
+					ID  string `json:"id"`
+					URL string `json:"url"`
+				} `json:"request"`
+				Response struct {
+					UserID string `json:"user_id"`
+				} `json:"response"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("error parsing log: %v", err)
+			}
+
+			if entry.Request.ID != id {
+				t.Errorf("expected request id %s to be logged, got %s", id, entry.Request.ID)
+			}
+
+			if entry.Request.URL != "/user?ticket=[redacted]" {
+				t.Errorf("expected ticket to be redacted, got %s", entry.Request.URL)
+			}
+
+			if entry.Response.UserID != "db477732-48fa-4289-b694-2886a646b6eb" {
+				t.Errorf("unexpected user id: %s", entry.Response.UserID)
+			}
+		})
+	}
+}
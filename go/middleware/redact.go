@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[redacted]"
+
+// redactedKeys are the attributes, compared in lowercase and without separators, whose
+// value is never logged.
+var redactedKeys = map[string]struct{}{ //nolint:gochecknoglobals
+	"password":      {},
+	"newpassword":   {},
+	"token":         {},
+	"accesstoken":   {},
+	"refreshtoken":  {},
+	"idtoken":       {},
+	"ticket":        {},
+	"secret":        {},
+	"otp":           {},
+	"authorization": {},
+	"cookie":        {},
+}
+
+var (
+	//nolint:gochecknoglobals
+	emailRegexp = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@([a-zA-Z0-9.\-]+\.[a-zA-Z]{2,})`)
+	//nolint:gochecknoglobals
+	jwtRegexp = regexp.MustCompile(`eyJ[a-zA-Z0-9_\-]+\.[a-zA-Z0-9_\-]+\.[a-zA-Z0-9_\-]*`)
+	//nolint:gochecknoglobals
+	bearerRegexp = regexp.MustCompile(`(?i)(bearer\s+)\S+`)
+	//nolint:gochecknoglobals,lll
+	queryRegexp = regexp.MustCompile(`(?i)([?&](?:ticket|token|refreshToken|accessToken|idToken|password|code|otp)=)[^&\s#]+`)
+)
+
+// RedactString replaces the emails, keeping their domain, the JWTs, the bearer tokens and
+// the sensitive query parameters in s.
+func RedactString(s string) string {
+	s = emailRegexp.ReplaceAllString(s, redacted+"@$1")
+	s = jwtRegexp.ReplaceAllString(s, redacted)
+	s = bearerRegexp.ReplaceAllString(s, "${1}"+redacted)
+	return queryRegexp.ReplaceAllString(s, "${1}"+redacted)
+}
+
+func redactedKey(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	_, ok := redactedKeys[key]
+	return ok
+}
+
+// RedactAttr is used as ReplaceAttr of the log handlers so passwords, tokens and emails
+// don't end up in the logs, whatever logged them.
+func RedactAttr(_ []string, a slog.Attr) slog.Attr {
+	if redactedKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+
+	switch a.Value.Kind() { //nolint:exhaustive
+	case slog.KindString:
+		return slog.String(a.Key, RedactString(a.Value.String()))
+	case slog.KindAny:
+		switch v := a.Value.Any().(type) {
+		case error:
+			return slog.String(a.Key, RedactString(v.Error()))
+		case []string:
+			values := make([]string, len(v))
+			for i, s := range v {
+				values[i] = RedactString(s)
+			}
+			return slog.Any(a.Key, values)
+		}
+	}
+
+	return a
+}
@@ -0,0 +1,254 @@
+package migrate
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// lockID is the key of the advisory lock taken while applying the migrations so
+// instances starting together don't apply them twice.
+const lockID = 7275253010092907470
+
+var (
+	ErrInvalidMigration   = errors.New("invalid migration")
+	ErrIncompatibleSchema = errors.New("incompatible schema")
+)
+
+var fileNameRegexp = regexp.MustCompile(`^(\d+)[-_]?(.*)\.sql$`)
+
+// Migration is a migration of the auth schema. Its id, name and hash are the ones
+// @djgrant/postgres-migrations stores in auth.migrations, so the Node.js server and the
+// Migrator can take over from each other.
+type Migration struct {
+	ID       int
+	Name     string
+	FileName string
+	Hash     string
+	SQL      string
+}
+
+// Load returns the migrations in fsys sorted by id. Files other than the SQL files are
+// ignored.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error reading migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	for _, entry := range entries {
+		match := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		id, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidMigration, entry.Name(), err)
+		}
+
+		b, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("error reading migration %s: %w", entry.Name(), err)
+		}
+
+		hash := sha1.Sum([]byte(entry.Name() + string(b))) //nolint:gosec
+		migrations = append(migrations, Migration{
+			ID:       id,
+			Name:     match[2],
+			FileName: entry.Name(),
+			Hash:     hex.EncodeToString(hash[:]),
+			SQL:      string(b),
+		})
+	}
+
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.ID - b.ID })
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].ID == migrations[i-1].ID {
+			return nil, fmt.Errorf(
+				"%w: %s and %s have the same id",
+				ErrInvalidMigration, migrations[i-1].FileName, migrations[i].FileName,
+			)
+		}
+	}
+
+	return migrations, nil
+}
+
+// DB is a single connection to the database, i.e. a *pgx.Conn, as the advisory lock
+// belongs to the connection that took it.
+type DB interface {
+	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
+}
+
+// Migrator applies the migrations that are missing from the migrations table of the
+// schema, with the tables of the migrations in that schema instead of auth. The schema is
+// only compatible with the migrations if every migration it has applied is known,
+// otherwise the database was migrated by a newer version of Hasura Auth.
+type Migrator struct {
+	db         DB
+	migrations []Migration
+	schema     string
+	logger     *slog.Logger
+}
+
+func NewMigrator(db DB, migrations []Migration, schema string, logger *slog.Logger) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		schema:     schema,
+		logger:     logger,
+	}
+}
+
+func (m *Migrator) sql(query string) string {
+	return sql.WithSchema(query, m.schema)
+}
+
+// Version is the id of the latest migration.
+func (m *Migrator) Version() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].ID
+}
+
+func (m *Migrator) applied(ctx context.Context) ([]int, error) {
+	var exists bool
+	if err := m.db.QueryRow(
+		ctx, "SELECT to_regclass($1) IS NOT NULL", m.schema+".migrations",
+	).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("error checking migrations table: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	rows, err := m.db.Query(ctx, m.sql("SELECT id FROM auth.migrations ORDER BY id"))
+	if err != nil {
+		return nil, fmt.Errorf("error getting applied migrations: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return nil, fmt.Errorf("error getting applied migrations: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Pending returns the migrations that haven't been applied yet. It fails with
+// ErrIncompatibleSchema if the database has migrations that aren't known.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range applied {
+		// 0 is the migration creating the migrations table of @djgrant/postgres-migrations
+		if id == 0 {
+			continue
+		}
+		if !slices.ContainsFunc(m.migrations, func(mig Migration) bool { return mig.ID == id }) {
+			return nil, fmt.Errorf(
+				"%w: the database has migration %d but this version only knows up to %d",
+				ErrIncompatibleSchema, id, m.Version(),
+			)
+		}
+	}
+
+	pending := make([]Migration, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		if !slices.Contains(applied, mig.ID) {
+			pending = append(pending, mig)
+		}
+	}
+
+	return pending, nil
+}
+
+// DryRun writes the SQL of the pending migrations to w without applying them.
+func (m *Migrator) DryRun(ctx context.Context, w io.Writer) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, mig := range pending {
+		if _, err := fmt.Fprintf(w, "-- %s\n%s\n", mig.FileName, m.sql(mig.SQL)); err != nil {
+			return fmt.Errorf("error writing migration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies the pending migrations in order and returns how many were applied. The
+// migrations manage their own transactions.
+func (m *Migrator) Apply(ctx context.Context) (int, error) {
+	if _, err := m.db.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return 0, fmt.Errorf("error locking migrations: %w", err)
+	}
+	defer func() {
+		if _, err := m.db.Exec(
+			context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockID,
+		); err != nil {
+			m.logger.Error("error unlocking migrations", slog.String("error", err.Error()))
+		}
+	}()
+
+	if _, err := m.db.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+m.schema); err != nil {
+		return 0, fmt.Errorf("error creating schema: %w", err)
+	}
+
+	if _, err := m.db.Exec(ctx, m.sql(`CREATE TABLE IF NOT EXISTS auth.migrations (
+  id integer PRIMARY KEY,
+  name varchar(100) UNIQUE NOT NULL,
+  hash varchar(40) NOT NULL,
+  executed_at timestamp DEFAULT current_timestamp
+);`)); err != nil {
+		return 0, fmt.Errorf("error creating migrations table: %w", err)
+	}
+
+	// checked once locked as another instance may have applied them in the meantime
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, mig := range pending {
+		m.logger.Info("applying migration", slog.String("migration", mig.FileName))
+
+		if _, err := m.db.Exec(ctx, m.sql(mig.SQL)); err != nil {
+			// the connection stays in the failed transaction of the migration otherwise
+			_, _ = m.db.Exec(context.WithoutCancel(ctx), "ROLLBACK")
+			return i, fmt.Errorf("error applying migration %s: %w", mig.FileName, err)
+		}
+
+		if _, err := m.db.Exec(
+			ctx,
+			m.sql("INSERT INTO auth.migrations (id, name, hash) VALUES ($1, $2, $3)"),
+			mig.ID, mig.Name, mig.Hash,
+		); err != nil {
+			return i, fmt.Errorf("error recording migration %s: %w", mig.FileName, err)
+		}
+	}
+
+	return len(pending), nil
+}
@@ -0,0 +1,99 @@
+package migrate_test
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/migrate"
+	"github.com/nhost/hasura-auth/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		fsys        fstest.MapFS
+		expected    []migrate.Migration
+		expectedErr error
+	}{
+		{
+			name: "sorted by id",
+			fsys: fstest.MapFS{
+				"00002_add-users.sql":    {Data: []byte("CREATE TABLE auth.users ();")},
+				"00001_create-roles.sql": {Data: []byte("CREATE TABLE auth.roles ();")},
+				"migrations.go":          {Data: []byte("package migrations")},
+			},
+			expected: []migrate.Migration{
+				{
+					ID:       1,
+					Name:     "create-roles",
+					FileName: "00001_create-roles.sql",
+					Hash:     "1561e6152f0ed3677895b447535963ca6b3dee15",
+					SQL:      "CREATE TABLE auth.roles ();",
+				},
+				{
+					ID:       2,
+					Name:     "add-users",
+					FileName: "00002_add-users.sql",
+					Hash:     "c4cfff9b8093483d4baba32cb99973b04efe191f",
+					SQL:      "CREATE TABLE auth.users ();",
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "duplicated id",
+			fsys: fstest.MapFS{
+				"00001_create-roles.sql": {Data: []byte("CREATE TABLE auth.roles ();")},
+				"00001_add-users.sql":    {Data: []byte("CREATE TABLE auth.users ();")},
+			},
+			expected:    nil,
+			expectedErr: migrate.ErrInvalidMigration,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := migrate.Load(tc.fsys)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected migrations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	t.Parallel()
+
+	got, err := migrate.Load(migrations.FS)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i, mig := range got {
+		if mig.ID != i+1 {
+			t.Fatalf("expected migration %d, got %s", i+1, mig.FileName)
+		}
+	}
+
+	// hashes stored in auth.migrations by @djgrant/postgres-migrations
+	hashes := map[int]string{
+		5:  "78f76f88eff3b11ebab9be4f2469020dae017110",
+		13: "0937470d919981a2052e4a00dfaad34378477765",
+		14: "e23fd094aef2ef926a06ac84000471a829548165",
+	}
+	for id, hash := range hashes {
+		if got[id-1].Hash != hash {
+			t.Errorf("unexpected hash of %s: %s", got[id-1].FileName, got[id-1].Hash)
+		}
+	}
+}
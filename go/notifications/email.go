@@ -1,87 +1,47 @@
 package notifications
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"net/smtp"
-	"strings"
-	"time"
 )
 
-type Email struct {
-	from             string
-	host             string
-	port             uint16
-	useTLSConnection bool
-	extraHeaders     map[string]string
-	auth             smtp.Auth
-	templates        *Templates
+// EmailProvider sends an already rendered email. Implementations should wrap their
+// errors with ErrEmailTemporaryFailure or ErrEmailPermanentFailure so callers know if
+// sending the email can be retried.
+type EmailProvider interface {
+	Send(ctx context.Context, to, subject, body string, headers map[string]string) error
 }
 
-func NewEmail(
-	host string,
-	port uint16,
-	useTLSConnection bool,
-	auth smtp.Auth,
-	from string,
-	extraHeaders map[string]string,
-	templates *Templates,
-) *Email {
-	return &Email{
-		from:             from,
-		host:             host,
-		port:             port,
-		useTLSConnection: useTLSConnection,
-		extraHeaders:     extraHeaders,
-		auth:             auth,
-		templates:        templates,
-	}
+// Pinger is implemented by the providers that can check the service sending the emails is
+// reachable.
+type Pinger interface {
+	Ping(ctx context.Context) error
 }
 
-func sanitize(s string) string {
-	return strings.Map(func(r rune) rune {
-		switch r {
-		case '\n', '\r':
-			return -1
-		default:
-			return r
-		}
-	}, s)
+// Ping checks the provider is reachable. It returns ErrPingNotSupported if the provider
+// can't be checked.
+func Ping(ctx context.Context, provider any) error {
+	pinger, ok := provider.(Pinger)
+	if !ok {
+		return ErrPingNotSupported
+	}
+	return pinger.Ping(ctx) //nolint:wrapcheck
 }
 
-func (sm *Email) Send(to, subject, contents string, headers map[string]string) error {
-	buf := new(bytes.Buffer)
-	for k, v := range sm.extraHeaders {
-		fmt.Fprintf(buf, "%s: %s\r\n", k, v)
-	}
-	for k, v := range headers {
-		fmt.Fprintf(buf, "%s: %s\r\n", k, v)
-	}
-	buf.WriteString("From: " + sm.from + "\r\n")
-	buf.WriteString("To: " + sanitize(to) + "\r\n")
-	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
-	buf.WriteString("Subject: " + sanitize(subject) + "\r\n")
-	buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
-	buf.WriteString("\r\n")
-	buf.WriteString(contents + "\r\n")
+type Email struct {
+	provider  EmailProvider
+	templates *Templates
+}
 
-	if err := sendMail(
-		sm.host,
-		sm.port,
-		sm.useTLSConnection,
-		sm.auth,
-		sm.from,
-		[]string{to},
-		buf.Bytes(),
-	); err != nil {
-		return fmt.Errorf("error sending email: %w", err)
+func NewEmail(provider EmailProvider, templates *Templates) *Email {
+	return &Email{
+		provider:  provider,
+		templates: templates,
 	}
-	return nil
 }
 
 func (sm *Email) SendEmail(
-	_ context.Context, to string, locale string, templateName TemplateName, data TemplateData,
+	ctx context.Context, to string, locale string, templateName TemplateName, data TemplateData,
 ) error {
 	body, subject, err := sm.templates.Render(locale, templateName, data)
 	if err != nil {
@@ -95,9 +55,13 @@ func (sm *Email) SendEmail(
 		"X-Link":           data.Link,
 	}
 
-	if err := sm.Send(to, subject, body, headers); err != nil {
+	if err := sm.provider.Send(ctx, to, subject, body, headers); err != nil {
 		return fmt.Errorf("error sending email: %w", err)
 	}
 
 	return nil
 }
+
+func (sm *Email) Ping(ctx context.Context) error {
+	return Ping(ctx, sm.provider)
+}
@@ -24,7 +24,7 @@ func TestEmailSend(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			mail := notifications.NewEmail(
+			mail := notifications.NewSMTP(
 				"localhost",
 				1025,
 				false,
@@ -33,12 +33,13 @@ func TestEmailSend(t *testing.T) {
 				map[string]string{
 					"x-something": "asd",
 				},
-				nil,
 			)
 			headers := map[string]string{
 				"x-another": "qwe",
 			}
-			if err := mail.Send("user@localhost", "some email", "contents", headers); err != nil {
+			if err := mail.Send(
+				context.Background(), "user@localhost", "some email", "contents", headers,
+			); err != nil {
 				t.Fatalf("error sending email: %v", err)
 			}
 		})
@@ -56,14 +57,16 @@ func TestEmailSendEmailVerify(t *testing.T) {
 		t.Fatalf("unexpected error: %s", err)
 	}
 	mail := notifications.NewEmail(
-		"localhost",
-		1025,
-		false,
-		smtp.PlainAuth("", "user", "password", "localhost"),
-		"admin@localhost",
-		map[string]string{
-			"x-something": "asd",
-		},
+		notifications.NewSMTP(
+			"localhost",
+			1025,
+			false,
+			smtp.PlainAuth("", "user", "password", "localhost"),
+			"admin@localhost",
+			map[string]string{
+				"x-something": "asd",
+			},
+		),
 		templates,
 	)
 
@@ -75,15 +78,20 @@ func TestEmailSendEmailVerify(t *testing.T) {
 		{
 			name: "",
 			data: notifications.TemplateData{
-				Link:        "http://link",
-				DisplayName: "Display Name",
-				Email:       "user@email",
-				NewEmail:    "",
-				Ticket:      "ticket",
-				RedirectTo:  "http://redirect-to",
-				Locale:      "en",
-				ServerURL:   "http://servier-url",
-				ClientURL:   "http://client-url",
+				Link:             "http://link",
+				DisplayName:      "Display Name",
+				Email:            "user@email",
+				NewEmail:         "",
+				Ticket:           "ticket",
+				RedirectTo:       "http://redirect-to",
+				Locale:           "en",
+				ServerURL:        "http://servier-url",
+				ClientURL:        "http://client-url",
+				Code:             "",
+				IPAddress:        "",
+				UserAgent:        "",
+				OrganizationName: "",
+				Location:         "",
 			},
 			locale: "en",
 		},
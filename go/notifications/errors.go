@@ -1,5 +1,30 @@
 package notifications
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
 
-var ErrTemplateNotFound = errors.New("template not found")
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+	// ErrEmailTemporaryFailure is returned by email providers when sending the email
+	// failed but it may succeed if retried, for instance when the provider is rate
+	// limiting us or is unavailable.
+	ErrEmailTemporaryFailure = errors.New("temporary failure sending email")
+	// ErrEmailPermanentFailure is returned by email providers when the email was rejected
+	// and retrying won't help, for instance because the credentials or the recipient
+	// are invalid.
+	ErrEmailPermanentFailure = errors.New("permanent failure sending email")
+	// ErrPingNotSupported is returned by Ping when the provider can't be checked.
+	ErrPingNotSupported = errors.New("provider doesn't support ping")
+)
+
+// HTTPStatusError classifies an error response from an email provider's API as a
+// temporary or permanent failure based on its status code.
+func HTTPStatusError(statusCode int, message string) error {
+	if statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%w: %d: %s", ErrEmailTemporaryFailure, statusCode, message)
+	}
+	return fmt.Errorf("%w: %d: %s", ErrEmailPermanentFailure, statusCode, message)
+}
@@ -0,0 +1,85 @@
+package notifications
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidLocaleFallback = errors.New("invalid locale fallback")
+
+// LocaleFallbacks maps a locale to the one that should be tried next when a template
+// isn't available in it, for instance pt-BR to pt-PT. Locales without an entry fall back
+// to their parent locale, fr-CA to fr, and eventually to the default locale.
+type LocaleFallbacks map[string]string
+
+// ParseLocaleFallbacks parses fallbacks in the form locale:fallback, i.e. pt-BR:pt-PT.
+func ParseLocaleFallbacks(values []string) (LocaleFallbacks, error) {
+	fallbacks := make(LocaleFallbacks, len(values))
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+
+		locale, fallback, ok := strings.Cut(v, ":")
+		if !ok || locale == "" || fallback == "" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLocaleFallback, v)
+		}
+		fallbacks[locale] = fallback
+	}
+
+	return fallbacks, nil
+}
+
+// UnmarshalJSON parses a comma-separated list of fallbacks, i.e. "pt-BR:pt-PT,es-AR:es".
+func (f *LocaleFallbacks) UnmarshalJSON(b []byte) error {
+	var aux string
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return fmt.Errorf("error unmarshalling locale fallbacks: %w", err)
+	}
+
+	fallbacks, err := ParseLocaleFallbacks(strings.Split(aux, ","))
+	if err != nil {
+		return err
+	}
+
+	*f = fallbacks
+	return nil
+}
+
+// parentLocale returns the locale without its last subtag, fr for fr-CA, or an empty
+// string if the locale has no subtags.
+func parentLocale(locale string) string {
+	i := strings.LastIndexAny(locale, "-_")
+	if i < 0 {
+		return ""
+	}
+	return locale[:i]
+}
+
+// Chain returns the locales to try, in order, for the given locale. The chain always
+// ends with the default locale.
+func (f LocaleFallbacks) Chain(locale string, defaultLocale string) []string {
+	chain := make([]string, 0, 3) //nolint:mnd
+	seen := make(map[string]struct{})
+	for l := locale; l != ""; {
+		if _, ok := seen[l]; ok {
+			break
+		}
+		seen[l] = struct{}{}
+		chain = append(chain, l)
+
+		if next, ok := f[l]; ok {
+			l = next
+		} else {
+			l = parentLocale(l)
+		}
+	}
+
+	if _, ok := seen[defaultLocale]; !ok {
+		chain = append(chain, defaultLocale)
+	}
+
+	return chain
+}
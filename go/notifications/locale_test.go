@@ -0,0 +1,119 @@
+package notifications_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+func TestLocaleFallbacksChain(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		fallbacks notifications.LocaleFallbacks
+		locale    string
+		expected  []string
+	}{
+		{
+			name:      "default locale",
+			fallbacks: nil,
+			locale:    "en",
+			expected:  []string{"en"},
+		},
+		{
+			name:      "locale",
+			fallbacks: nil,
+			locale:    "fr",
+			expected:  []string{"fr", "en"},
+		},
+		{
+			name:      "regional locale",
+			fallbacks: nil,
+			locale:    "fr-CA",
+			expected:  []string{"fr-CA", "fr", "en"},
+		},
+		{
+			name:      "underscore",
+			fallbacks: nil,
+			locale:    "zh_Hant_TW",
+			expected:  []string{"zh_Hant_TW", "zh_Hant", "zh", "en"},
+		},
+		{
+			name:      "configured fallback",
+			fallbacks: notifications.LocaleFallbacks{"pt-BR": "pt-PT"},
+			locale:    "pt-BR",
+			expected:  []string{"pt-BR", "pt-PT", "pt", "en"},
+		},
+		{
+			name:      "cycle",
+			fallbacks: notifications.LocaleFallbacks{"ca": "es", "es": "ca"},
+			locale:    "ca",
+			expected:  []string{"ca", "es", "en"},
+		},
+		{
+			name:      "empty locale",
+			fallbacks: nil,
+			locale:    "",
+			expected:  []string{"en"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.fallbacks.Chain(tc.locale, "en")
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected chain (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLocaleFallbacks(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		values      []string
+		expected    notifications.LocaleFallbacks
+		expectedErr error
+	}{
+		{
+			name:        "success",
+			values:      []string{"pt-BR:pt-PT", "", "es-AR:es"},
+			expected:    notifications.LocaleFallbacks{"pt-BR": "pt-PT", "es-AR": "es"},
+			expectedErr: nil,
+		},
+		{
+			name:        "missing fallback",
+			values:      []string{"pt-BR"},
+			expected:    nil,
+			expectedErr: notifications.ErrInvalidLocaleFallback,
+		},
+		{
+			name:        "empty fallback",
+			values:      []string{"pt-BR:"},
+			expected:    nil,
+			expectedErr: notifications.ErrInvalidLocaleFallback,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := notifications.ParseLocaleFallbacks(tc.values)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected fallbacks (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package mailgun
+
+func (m *Mailgun) URL() string {
+	return m.url
+}
+
+func (m *Mailgun) SetURL(url string) {
+	m.url = url
+}
@@ -0,0 +1,94 @@
+// Package mailgun sends emails using the Mailgun messages API.
+package mailgun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+const (
+	baseURLUS = "https://api.mailgun.net"
+	baseURLEU = "https://api.eu.mailgun.net"
+)
+
+type Mailgun struct {
+	url    string
+	apiKey string
+	from   string
+	cl     *http.Client
+}
+
+// New returns a Mailgun client sending emails from the given domain. Domains created
+// in Mailgun's EU region need to use the eu region.
+func New(apiKey, domain, region, from string) *Mailgun {
+	baseURL := baseURLUS
+	if region == "eu" {
+		baseURL = baseURLEU
+	}
+
+	return &Mailgun{
+		url:    baseURL + "/v3/" + url.PathEscape(domain) + "/messages",
+		apiKey: apiKey,
+		from:   from,
+		cl:     &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+// responseError extracts the message Mailgun includes in error responses.
+func responseError(resp *http.Response) error {
+	b, _ := io.ReadAll(resp.Body)
+
+	var errResp struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(b, &errResp); err != nil || errResp.Message == "" {
+		return notifications.HTTPStatusError(resp.StatusCode, string(b))
+	}
+	return notifications.HTTPStatusError(resp.StatusCode, errResp.Message)
+}
+
+func (m *Mailgun) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	form := url.Values{}
+	form.Set("from", m.from)
+	form.Set("to", to)
+	form.Set("subject", subject)
+	form.Set("html", body)
+	for k, v := range headers {
+		if v != "" {
+			form.Set("h:"+k, v)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, m.url, strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return fmt.Errorf("mailgun: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("api", m.apiKey)
+
+	resp, err := m.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf(
+			"mailgun: failed to make request: %w: %w",
+			notifications.ErrEmailTemporaryFailure, err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mailgun: %w", responseError(resp))
+	}
+
+	return nil
+}
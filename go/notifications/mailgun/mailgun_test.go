@@ -0,0 +1,126 @@
+package mailgun_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/mailgun"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		region   string
+		expected string
+	}{
+		{
+			name:     "us",
+			region:   "us",
+			expected: "https://api.mailgun.net/v3/mg.acme.com/messages",
+		},
+		{
+			name:     "eu",
+			region:   "eu",
+			expected: "https://api.eu.mailgun.net/v3/mg.acme.com/messages",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mg := mailgun.New("my-api-key", "mg.acme.com", tc.region, "auth@acme.com")
+			if got := mg.URL(); got != tc.expected {
+				t.Errorf("unexpected url: %s", got)
+			}
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expectedErr error
+	}{
+		{
+			name:        "success",
+			status:      http.StatusOK,
+			response:    `{"id":"<20240501.1@mg.acme.com>","message":"Queued. Thank you."}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "server error",
+			status:      http.StatusServiceUnavailable,
+			response:    `{"message":"service unavailable"}`,
+			expectedErr: notifications.ErrEmailTemporaryFailure,
+		},
+		{
+			name:        "invalid api key",
+			status:      http.StatusUnauthorized,
+			response:    `{"message":"Invalid private key"}`,
+			expectedErr: notifications.ErrEmailPermanentFailure,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					user, password, _ := r.BasicAuth()
+					if user != "api" || password != "my-api-key" {
+						t.Errorf("unexpected credentials: %s:%s", user, password)
+					}
+
+					if err := r.ParseForm(); err != nil {
+						t.Errorf("error parsing form: %s", err)
+					}
+
+					if diff := cmp.Diff(
+						url.Values{
+							"from":       {"auth@acme.com"},
+							"to":         {"jane@acme.com"},
+							"subject":    {"Verify your email"},
+							"html":       {"<p>Hello</p>"},
+							"h:X-Ticket": {"my-ticket"},
+						},
+						r.PostForm,
+					); diff != "" {
+						t.Errorf("unexpected form (-want +got):\n%s", diff)
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			mg := mailgun.New("my-api-key", "mg.acme.com", "us", "auth@acme.com")
+			mg.SetURL(server.URL)
+
+			err := mg.Send(
+				context.Background(),
+				"jane@acme.com",
+				"Verify your email",
+				"<p>Hello</p>",
+				map[string]string{"X-Ticket": "my-ticket", "X-Redirect-To": ""},
+			)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
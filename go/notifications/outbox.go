@@ -0,0 +1,162 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	outboxBatchSize = 50
+	// outboxLease is how long a claimed email is hidden from other workers while it is
+	// being sent.
+	outboxLease = time.Minute
+)
+
+type OutboxDB interface {
+	InsertEmailOutbox(ctx context.Context, arg sql.InsertEmailOutboxParams) error
+	ClaimEmailOutbox(
+		ctx context.Context, arg sql.ClaimEmailOutboxParams,
+	) ([]sql.AuthEmailOutbox, error)
+	DeleteEmailOutbox(ctx context.Context, id uuid.UUID) error
+	RescheduleEmailOutbox(ctx context.Context, arg sql.RescheduleEmailOutboxParams) error
+	FailEmailOutbox(ctx context.Context, arg sql.FailEmailOutboxParams) error
+}
+
+// Outbox is an EmailProvider that stores emails in the auth.email_outbox table instead
+// of sending them so requests don't wait for the provider. Run sends them in the
+// background, retrying temporary failures with an exponential backoff. Emails that
+// fail permanently, or too many times, are kept with the failed status.
+type Outbox struct {
+	db          OutboxDB
+	provider    EmailProvider
+	maxAttempts int
+	backoff     time.Duration
+	logger      *slog.Logger
+}
+
+func NewOutbox(
+	db OutboxDB,
+	provider EmailProvider,
+	maxAttempts int,
+	backoff time.Duration,
+	logger *slog.Logger,
+) *Outbox {
+	return &Outbox{
+		db:          db,
+		provider:    provider,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		logger:      logger,
+	}
+}
+
+func (o *Outbox) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	b, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("error marshalling headers: %w", err)
+	}
+
+	if err := o.db.InsertEmailOutbox(ctx, sql.InsertEmailOutboxParams{
+		Recipient: to,
+		Subject:   subject,
+		Body:      body,
+		Headers:   b,
+	}); err != nil {
+		return fmt.Errorf("error inserting email in outbox: %w", err)
+	}
+
+	return nil
+}
+
+// Run sends the pending emails every interval until the context is done.
+func (o *Outbox) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := o.Process(ctx); err != nil {
+				o.logger.Error("error processing email outbox", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// Process sends the emails that are due.
+func (o *Outbox) Process(ctx context.Context) error {
+	emails, err := o.db.ClaimEmailOutbox(ctx, sql.ClaimEmailOutboxParams{
+		LeaseExpiresAt: sql.TimestampTz(time.Now().Add(outboxLease)),
+		BatchSize:      outboxBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error claiming emails from outbox: %w", err)
+	}
+
+	for _, email := range emails {
+		if err := o.send(ctx, email); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *Outbox) send(ctx context.Context, email sql.AuthEmailOutbox) error {
+	logger := o.logger.With(
+		slog.String("email_id", email.ID.String()),
+		slog.Int("attempt", int(email.Attempts)+1),
+	)
+
+	var headers map[string]string
+	if err := json.Unmarshal(email.Headers, &headers); err != nil {
+		return fmt.Errorf("error unmarshalling headers: %w", err)
+	}
+
+	sendErr := o.provider.Send(ctx, email.Recipient, email.Subject, email.Body, headers)
+	switch {
+	case sendErr == nil:
+		if err := o.db.DeleteEmailOutbox(ctx, email.ID); err != nil {
+			return fmt.Errorf("error deleting sent email from outbox: %w", err)
+		}
+	case errors.Is(sendErr, ErrEmailTemporaryFailure) && int(email.Attempts)+1 < o.maxAttempts:
+		backoff := o.backoff << email.Attempts
+		logger.Warn(
+			"error sending email, retrying later",
+			slog.String("error", sendErr.Error()),
+			slog.Duration("backoff", backoff),
+		)
+		if err := o.db.RescheduleEmailOutbox(ctx, sql.RescheduleEmailOutboxParams{
+			ID:            email.ID,
+			NextAttemptAt: sql.TimestampTz(time.Now().Add(backoff)),
+			LastError:     sql.Text(sendErr.Error()),
+		}); err != nil {
+			return fmt.Errorf("error rescheduling email: %w", err)
+		}
+	default:
+		logger.Error("email failed permanently", slog.String("error", sendErr.Error()))
+		if err := o.db.FailEmailOutbox(ctx, sql.FailEmailOutboxParams{
+			ID:        email.ID,
+			LastError: sql.Text(sendErr.Error()),
+		}); err != nil {
+			return fmt.Errorf("error marking email as failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (o *Outbox) Ping(ctx context.Context) error {
+	return Ping(ctx, o.provider)
+}
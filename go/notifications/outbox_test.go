@@ -0,0 +1,177 @@
+package notifications_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+// fakeOutboxDB keeps the outbox in memory and ignores the lease and next attempt time so
+// every pending email is claimed.
+type fakeOutboxDB struct {
+	emails map[uuid.UUID]*sql.AuthEmailOutbox
+}
+
+func (db *fakeOutboxDB) InsertEmailOutbox(
+	_ context.Context, arg sql.InsertEmailOutboxParams,
+) error {
+	id := uuid.New()
+	db.emails[id] = &sql.AuthEmailOutbox{ //nolint:exhaustruct
+		ID:        id,
+		Recipient: arg.Recipient,
+		Subject:   arg.Subject,
+		Body:      arg.Body,
+		Headers:   arg.Headers,
+		Status:    "pending",
+	}
+	return nil
+}
+
+func (db *fakeOutboxDB) ClaimEmailOutbox(
+	_ context.Context, _ sql.ClaimEmailOutboxParams,
+) ([]sql.AuthEmailOutbox, error) {
+	emails := make([]sql.AuthEmailOutbox, 0, len(db.emails))
+	for _, email := range db.emails {
+		if email.Status == "pending" {
+			emails = append(emails, *email)
+		}
+	}
+	return emails, nil
+}
+
+func (db *fakeOutboxDB) DeleteEmailOutbox(_ context.Context, id uuid.UUID) error {
+	delete(db.emails, id)
+	return nil
+}
+
+func (db *fakeOutboxDB) RescheduleEmailOutbox(
+	_ context.Context, arg sql.RescheduleEmailOutboxParams,
+) error {
+	db.emails[arg.ID].Attempts++
+	db.emails[arg.ID].LastError = arg.LastError
+	return nil
+}
+
+func (db *fakeOutboxDB) FailEmailOutbox(_ context.Context, arg sql.FailEmailOutboxParams) error {
+	db.emails[arg.ID].Attempts++
+	db.emails[arg.ID].Status = "failed"
+	db.emails[arg.ID].LastError = arg.LastError
+	return nil
+}
+
+type outboxEmail struct {
+	Status    string
+	Attempts  int32
+	LastError string
+}
+
+func TestOutbox(t *testing.T) {
+	t.Parallel()
+
+	temporary := fmt.Errorf("%w: rate limited", notifications.ErrEmailTemporaryFailure)
+	permanent := fmt.Errorf("%w: invalid recipient", notifications.ErrEmailPermanentFailure)
+
+	cases := []struct {
+		name          string
+		errs          []error
+		runs          int
+		expected      []outboxEmail
+		expectedCalls int
+	}{
+		{
+			name:          "sent",
+			errs:          nil,
+			runs:          1,
+			expected:      []outboxEmail{},
+			expectedCalls: 1,
+		},
+		{
+			name: "temporary failure",
+			errs: []error{temporary},
+			runs: 1,
+			expected: []outboxEmail{
+				{Status: "pending", Attempts: 1, LastError: temporary.Error()},
+			},
+			expectedCalls: 1,
+		},
+		{
+			name:          "temporary failure then sent",
+			errs:          []error{temporary},
+			runs:          2,
+			expected:      []outboxEmail{},
+			expectedCalls: 2,
+		},
+		{
+			name: "too many temporary failures",
+			errs: []error{temporary, temporary, temporary},
+			runs: 4,
+			expected: []outboxEmail{
+				{Status: "failed", Attempts: 3, LastError: temporary.Error()},
+			},
+			expectedCalls: 3,
+		},
+		{
+			name: "permanent failure",
+			errs: []error{permanent},
+			runs: 2,
+			expected: []outboxEmail{
+				{Status: "failed", Attempts: 1, LastError: permanent.Error()},
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := &fakeOutboxDB{emails: make(map[uuid.UUID]*sql.AuthEmailOutbox)}
+			provider := &fakeEmailProvider{errs: tc.errs, calls: 0}
+			outbox := notifications.NewOutbox(db, provider, 3, time.Second, slog.Default())
+
+			if err := outbox.Send(
+				context.Background(),
+				"jane@acme.com",
+				"subject",
+				"body",
+				map[string]string{"X-Email-Template": "email-verify"},
+			); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if provider.calls != 0 {
+				t.Fatalf("email sent before processing the outbox")
+			}
+
+			for range tc.runs {
+				if err := outbox.Process(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			got := make([]outboxEmail, 0, len(db.emails))
+			for _, email := range db.emails {
+				got = append(got, outboxEmail{
+					Status:    email.Status,
+					Attempts:  email.Attempts,
+					LastError: email.LastError.String,
+				})
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected outbox (-want +got):\n%s", diff)
+			}
+
+			if provider.calls != tc.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tc.expectedCalls, provider.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package postmark
+
+func (p *Postmark) SetURL(url string) {
+	p.url = url
+}
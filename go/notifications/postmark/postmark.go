@@ -12,9 +12,12 @@ import (
 	"github.com/nhost/hasura-auth/go/notifications"
 )
 
-const url = "https://api.postmarkapp.com/email/withTemplate"
+const defaultURL = "https://api.postmarkapp.com"
 
+// Postmark can send emails using templates stored in Postmark, with SendEmail, or
+// emails rendered by hasura-auth, when used as an EmailProvider.
 type Postmark struct {
+	url         string
 	from        string
 	serverToken string
 	cl          *http.Client
@@ -22,6 +25,7 @@ type Postmark struct {
 
 func New(from string, serverToken string) *Postmark {
 	return &Postmark{
+		url:         defaultURL,
 		from:        from,
 		serverToken: serverToken,
 		cl:          &http.Client{}, //nolint:exhaustruct
@@ -36,8 +40,40 @@ type SendWithTemplateRequest struct {
 	To            string `json:"To"`
 }
 
+//nolint:tagliatelle
+type Header struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+//nolint:tagliatelle
+type SendRequest struct {
+	From     string   `json:"From"`
+	To       string   `json:"To"`
+	Subject  string   `json:"Subject"`
+	HTMLBody string   `json:"HtmlBody"`
+	Headers  []Header `json:"Headers,omitempty"`
+}
+
+// responseError extracts the error code and message Postmark includes in error responses.
+func responseError(resp *http.Response) error {
+	b, _ := io.ReadAll(resp.Body)
+
+	var errResp struct {
+		ErrorCode int    `json:"ErrorCode"` //nolint:tagliatelle
+		Message   string `json:"Message"`   //nolint:tagliatelle
+	}
+	if err := json.Unmarshal(b, &errResp); err != nil || errResp.Message == "" {
+		return notifications.HTTPStatusError(resp.StatusCode, string(b))
+	}
+	return notifications.HTTPStatusError(
+		resp.StatusCode, fmt.Sprintf("error code %d: %s", errResp.ErrorCode, errResp.Message),
+	)
+}
+
 func (p *Postmark) request(
 	ctx context.Context,
+	path string,
 	requestBody any,
 ) error {
 	b, err := json.Marshal(requestBody)
@@ -46,7 +82,7 @@ func (p *Postmark) request(
 	}
 	body := io.NopCloser(bytes.NewReader(b))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+path, body)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -58,7 +94,9 @@ func (p *Postmark) request(
 
 	resp, err := p.cl.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+		return fmt.Errorf(
+			"failed to make request: %w: %w", notifications.ErrEmailTemporaryFailure, err,
+		)
 	}
 
 	if resp.Header.Get("Content-Encoding") == "gzip" {
@@ -72,10 +110,7 @@ func (p *Postmark) request(
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		b, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf( //nolint:goerr113
-			"%s: %s", resp.Status, string(b),
-		)
+		return responseError(resp)
 	}
 
 	return nil
@@ -91,7 +126,7 @@ func (p *Postmark) SendEmail(
 	var templateModel any = data.ToMap(map[string]any{"locale": locale})
 	template := fmt.Sprintf("%s.%s", locale, templateName)
 
-	if err := p.request(ctx, SendWithTemplateRequest{
+	if err := p.request(ctx, "/email/withTemplate", SendWithTemplateRequest{
 		TemplateAlias: template,
 		TemplateModel: templateModel,
 		From:          p.from,
@@ -101,3 +136,25 @@ func (p *Postmark) SendEmail(
 	}
 	return nil
 }
+
+func (p *Postmark) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	h := make([]Header, 0, len(headers))
+	for k, v := range headers {
+		if v != "" {
+			h = append(h, Header{Name: k, Value: v})
+		}
+	}
+
+	if err := p.request(ctx, "/email", SendRequest{
+		From:     p.from,
+		To:       to,
+		Subject:  subject,
+		HTMLBody: body,
+		Headers:  h,
+	}); err != nil {
+		return fmt.Errorf("postmark: failed to send email: %w", err)
+	}
+	return nil
+}
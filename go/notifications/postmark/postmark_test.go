@@ -25,15 +25,20 @@ func TestSendWithTemplate(t *testing.T) {
 			locale:       "en",
 			templateName: notifications.TemplateNameEmailVerify,
 			data: notifications.TemplateData{
-				Link:        "https://auth.nhost.run/verify?ticket=123",
-				DisplayName: "Jane Doe",
-				Email:       "jane@acme.com",
-				NewEmail:    "",
-				Ticket:      "123",
-				RedirectTo:  "https://app.com/profile",
-				Locale:      "en",
-				ServerURL:   "https://auth.nhost.run",
-				ClientURL:   "https://app.com",
+				Link:             "https://auth.nhost.run/verify?ticket=123",
+				DisplayName:      "Jane Doe",
+				Email:            "jane@acme.com",
+				NewEmail:         "",
+				Ticket:           "123",
+				RedirectTo:       "https://app.com/profile",
+				Locale:           "en",
+				ServerURL:        "https://auth.nhost.run",
+				ClientURL:        "https://app.com",
+				Code:             "",
+				IPAddress:        "",
+				UserAgent:        "",
+				OrganizationName: "",
+				Location:         "",
 			},
 		},
 	}
@@ -0,0 +1,97 @@
+package postmark_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/postmark"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expectedErr error
+	}{
+		{
+			name:        "success",
+			status:      http.StatusOK,
+			response:    `{"ErrorCode":0,"Message":"OK"}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "inactive recipient",
+			status:      http.StatusUnprocessableEntity,
+			response:    `{"ErrorCode":406,"Message":"You tried to send to a recipient that has been marked as inactive."}`, //nolint:lll
+			expectedErr: notifications.ErrEmailPermanentFailure,
+		},
+		{
+			name:        "rate limited",
+			status:      http.StatusTooManyRequests,
+			response:    `{"ErrorCode":429,"Message":"Rate limit exceeded"}`,
+			expectedErr: notifications.ErrEmailTemporaryFailure,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/email" {
+						t.Errorf("unexpected path: %s", r.URL.Path)
+					}
+					if got := r.Header.Get("X-Postmark-Server-Token"); got != "my-token" {
+						t.Errorf("unexpected server token: %s", got)
+					}
+
+					var body postmark.SendRequest
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("error decoding body: %s", err)
+					}
+
+					if diff := cmp.Diff(
+						postmark.SendRequest{
+							From:     "auth@acme.com",
+							To:       "jane@acme.com",
+							Subject:  "Verify your email",
+							HTMLBody: "<p>Hello</p>",
+							Headers:  []postmark.Header{{Name: "X-Ticket", Value: "my-ticket"}},
+						},
+						body,
+					); diff != "" {
+						t.Errorf("unexpected body (-want +got):\n%s", diff)
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			pm := postmark.New("auth@acme.com", "my-token")
+			pm.SetURL(server.URL)
+
+			err := pm.Send(
+				context.Background(),
+				"jane@acme.com",
+				"Verify your email",
+				"<p>Hello</p>",
+				map[string]string{"X-Ticket": "my-ticket", "X-Redirect-To": ""},
+			)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
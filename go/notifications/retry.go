@@ -0,0 +1,47 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Retry is an EmailProvider that retries sending emails that failed with a temporary
+// error, waiting twice as long before each retry.
+type Retry struct {
+	provider   EmailProvider
+	maxRetries int
+	backoff    time.Duration
+}
+
+func NewRetry(provider EmailProvider, maxRetries int, backoff time.Duration) *Retry {
+	return &Retry{
+		provider:   provider,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+	}
+}
+
+func (r *Retry) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	backoff := r.backoff
+	for attempt := 0; ; attempt++ {
+		err := r.provider.Send(ctx, to, subject, body, headers)
+		if err == nil || !errors.Is(err, ErrEmailTemporaryFailure) || attempt >= r.maxRetries {
+			return err //nolint:wrapcheck
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (r *Retry) Ping(ctx context.Context) error {
+	return Ping(ctx, r.provider)
+}
@@ -0,0 +1,87 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+type fakeEmailProvider struct {
+	errs  []error
+	calls int
+}
+
+func (p *fakeEmailProvider) Send(
+	_ context.Context, _, _, _ string, _ map[string]string,
+) error {
+	p.calls++
+	if len(p.errs) == 0 {
+		return nil
+	}
+	err := p.errs[0]
+	p.errs = p.errs[1:]
+	return err
+}
+
+func TestRetrySend(t *testing.T) {
+	t.Parallel()
+
+	temporary := fmt.Errorf("%w: rate limited", notifications.ErrEmailTemporaryFailure)
+	permanent := fmt.Errorf("%w: invalid recipient", notifications.ErrEmailPermanentFailure)
+
+	cases := []struct {
+		name          string
+		errs          []error
+		expectedErr   error
+		expectedCalls int
+	}{
+		{
+			name:          "success",
+			errs:          nil,
+			expectedErr:   nil,
+			expectedCalls: 1,
+		},
+		{
+			name:          "temporary failure then success",
+			errs:          []error{temporary, temporary},
+			expectedErr:   nil,
+			expectedCalls: 3,
+		},
+		{
+			name:          "too many temporary failures",
+			errs:          []error{temporary, temporary, temporary, temporary},
+			expectedErr:   notifications.ErrEmailTemporaryFailure,
+			expectedCalls: 3,
+		},
+		{
+			name:          "permanent failure",
+			errs:          []error{permanent},
+			expectedErr:   notifications.ErrEmailPermanentFailure,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &fakeEmailProvider{errs: tc.errs, calls: 0}
+			retry := notifications.NewRetry(provider, 2, time.Millisecond)
+
+			err := retry.Send(
+				context.Background(), "jane@acme.com", "subject", "body", nil,
+			)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if provider.calls != tc.expectedCalls {
+				t.Errorf("unexpected number of calls: %d", provider.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package sendgrid
+
+func (s *SendGrid) SetURL(url string) {
+	s.url = url
+}
@@ -0,0 +1,124 @@
+// Package sendgrid sends emails using the SendGrid v3 mail send API.
+package sendgrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+const defaultURL = "https://api.sendgrid.com/v3/mail/send"
+
+type SendGrid struct {
+	url    string
+	apiKey string
+	from   string
+	cl     *http.Client
+}
+
+func New(apiKey string, from string) *SendGrid {
+	return &SendGrid{
+		url:    defaultURL,
+		apiKey: apiKey,
+		from:   from,
+		cl:     &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+type Address struct {
+	Email string `json:"email"`
+}
+
+type Personalization struct {
+	To []Address `json:"to"`
+}
+
+type Content struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type Request struct {
+	Personalizations []Personalization `json:"personalizations"`
+	From             Address           `json:"from"`
+	Subject          string            `json:"subject"`
+	Content          []Content         `json:"content"`
+	Headers          map[string]string `json:"headers,omitempty"`
+}
+
+type errorResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+		Field   string `json:"field"`
+	} `json:"errors"`
+}
+
+// responseError extracts the messages SendGrid includes in error responses.
+func responseError(resp *http.Response) error {
+	b, _ := io.ReadAll(resp.Body)
+
+	var errResp errorResponse
+	if err := json.Unmarshal(b, &errResp); err != nil || len(errResp.Errors) == 0 {
+		return notifications.HTTPStatusError(resp.StatusCode, string(b))
+	}
+
+	messages := make([]string, len(errResp.Errors))
+	for i, e := range errResp.Errors {
+		messages[i] = e.Message
+		if e.Field != "" {
+			messages[i] = e.Field + ": " + e.Message
+		}
+	}
+	return notifications.HTTPStatusError(resp.StatusCode, strings.Join(messages, ", "))
+}
+
+func (s *SendGrid) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	h := make(map[string]string, len(headers))
+	for k, v := range headers {
+		// sendgrid rejects headers without a value
+		if v != "" {
+			h[k] = v
+		}
+	}
+
+	b, err := json.Marshal(Request{
+		Personalizations: []Personalization{{To: []Address{{Email: to}}}},
+		From:             Address{Email: s.from},
+		Subject:          subject,
+		Content:          []Content{{Type: "text/html", Value: body}},
+		Headers:          h,
+	})
+	if err != nil {
+		return fmt.Errorf("sendgrid: failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("sendgrid: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	resp, err := s.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf(
+			"sendgrid: failed to make request: %w: %w",
+			notifications.ErrEmailTemporaryFailure, err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sendgrid: %w", responseError(resp))
+	}
+
+	return nil
+}
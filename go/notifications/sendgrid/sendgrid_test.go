@@ -0,0 +1,98 @@
+package sendgrid_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/sendgrid"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expectedErr error
+	}{
+		{
+			name:        "success",
+			status:      http.StatusAccepted,
+			response:    "",
+			expectedErr: nil,
+		},
+		{
+			name:        "rate limited",
+			status:      http.StatusTooManyRequests,
+			response:    `{"errors":[{"message":"too many requests"}]}`,
+			expectedErr: notifications.ErrEmailTemporaryFailure,
+		},
+		{
+			name:        "invalid recipient",
+			status:      http.StatusBadRequest,
+			response:    `{"errors":[{"message":"Does not contain a valid address.","field":"personalizations.0.to.0.email"}]}`, //nolint:lll
+			expectedErr: notifications.ErrEmailPermanentFailure,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if got := r.Header.Get("Authorization"); got != "Bearer my-api-key" {
+						t.Errorf("unexpected authorization header: %s", got)
+					}
+
+					var body sendgrid.Request
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("error decoding body: %s", err)
+					}
+
+					if diff := cmp.Diff(
+						sendgrid.Request{
+							Personalizations: []sendgrid.Personalization{
+								{To: []sendgrid.Address{{Email: "jane@acme.com"}}},
+							},
+							From:    sendgrid.Address{Email: "auth@acme.com"},
+							Subject: "Verify your email",
+							Content: []sendgrid.Content{
+								{Type: "text/html", Value: "<p>Hello</p>"},
+							},
+							Headers: map[string]string{"X-Ticket": "my-ticket"},
+						},
+						body,
+					); diff != "" {
+						t.Errorf("unexpected body (-want +got):\n%s", diff)
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			sg := sendgrid.New("my-api-key", "auth@acme.com")
+			sg.SetURL(server.URL)
+
+			err := sg.Send(
+				context.Background(),
+				"jane@acme.com",
+				"Verify your email",
+				"<p>Hello</p>",
+				map[string]string{"X-Ticket": "my-ticket", "X-Redirect-To": ""},
+			)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package ses
+
+import "time"
+
+func (s *SES) SetURL(url string) {
+	s.url = url
+}
+
+func (s *SES) SetNow(now func() time.Time) {
+	s.now = now
+}
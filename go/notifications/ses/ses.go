@@ -0,0 +1,154 @@
+// Package ses sends emails using the Amazon SES v2 API.
+package ses
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sigv4"
+)
+
+type SES struct {
+	url             string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	from            string
+	cl              *http.Client
+	now             func() time.Time
+}
+
+func New(region, accessKeyID, secretAccessKey, from string) *SES {
+	return &SES{
+		url:             "https://email." + region + ".amazonaws.com/v2/email/outbound-emails",
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		from:            from,
+		cl:              &http.Client{}, //nolint:exhaustruct
+		now:             time.Now,
+	}
+}
+
+//nolint:tagliatelle
+type Content struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+//nolint:tagliatelle
+type Header struct {
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+//nolint:tagliatelle
+type Body struct {
+	HTML Content `json:"Html"`
+}
+
+//nolint:tagliatelle
+type Simple struct {
+	Subject Content  `json:"Subject"`
+	Body    Body     `json:"Body"`
+	Headers []Header `json:"Headers,omitempty"`
+}
+
+//nolint:tagliatelle
+type EmailContent struct {
+	Simple Simple `json:"Simple"`
+}
+
+//nolint:tagliatelle
+type Destination struct {
+	ToAddresses []string `json:"ToAddresses"`
+}
+
+//nolint:tagliatelle
+type Request struct {
+	FromEmailAddress string       `json:"FromEmailAddress"`
+	Destination      Destination  `json:"Destination"`
+	Content          EmailContent `json:"Content"`
+}
+
+// responseError maps SES error responses. SES returns the type of the error in the
+// X-Amzn-ErrorType header, throttling errors are temporary even if SES doesn't respond
+// with a 429.
+func responseError(resp *http.Response) error {
+	b, _ := io.ReadAll(resp.Body)
+
+	message := string(b)
+	var errResp struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(b, &errResp); err == nil && errResp.Message != "" {
+		message = errResp.Message
+	}
+
+	errType, _, _ := strings.Cut(resp.Header.Get("X-Amzn-ErrorType"), ":")
+	if errType != "" {
+		message = errType + ": " + message
+	}
+
+	if errType == "TooManyRequestsException" {
+		return fmt.Errorf(
+			"%w: %d: %s", notifications.ErrEmailTemporaryFailure, resp.StatusCode, message,
+		)
+	}
+
+	return notifications.HTTPStatusError(resp.StatusCode, message)
+}
+
+func (s *SES) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	h := make([]Header, 0, len(headers))
+	for k, v := range headers {
+		if v != "" {
+			h = append(h, Header{Name: k, Value: v})
+		}
+	}
+
+	b, err := json.Marshal(Request{
+		FromEmailAddress: s.from,
+		Destination:      Destination{ToAddresses: []string{to}},
+		Content: EmailContent{
+			Simple: Simple{
+				Subject: Content{Data: subject, Charset: "UTF-8"},
+				Body:    Body{HTML: Content{Data: body, Charset: "UTF-8"}},
+				Headers: h,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("ses: failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("ses: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	sigv4.Sign(req, b, s.region, "ses", s.accessKeyID, s.secretAccessKey, s.now())
+
+	resp, err := s.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf(
+			"ses: failed to make request: %w: %w", notifications.ErrEmailTemporaryFailure, err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ses: %w", responseError(resp))
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package ses_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/notifications/ses"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		errorType   string
+		response    string
+		expectedErr error
+	}{
+		{
+			name:        "success",
+			status:      http.StatusOK,
+			errorType:   "",
+			response:    `{"MessageId":"my-message-id"}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "throttled",
+			status:      http.StatusBadRequest,
+			errorType:   "TooManyRequestsException:http://internal.amazon.com/coral/com.amazon.coral.service/", //nolint:lll
+			response:    `{"message":"Too many requests"}`,
+			expectedErr: notifications.ErrEmailTemporaryFailure,
+		},
+		{
+			name:        "message rejected",
+			status:      http.StatusBadRequest,
+			errorType:   "MessageRejected",
+			response:    `{"message":"Email address is not verified."}`,
+			expectedErr: notifications.ErrEmailPermanentFailure,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if got := r.Header.Get("Authorization"); !strings.HasPrefix(
+						got,
+						"AWS4-HMAC-SHA256 Credential=my-key-id/20240501/eu-west-1/ses/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=", //nolint:lll
+					) {
+						t.Errorf("unexpected authorization header: %s", got)
+					}
+
+					var body ses.Request
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("error decoding body: %s", err)
+					}
+
+					if diff := cmp.Diff(
+						ses.Request{
+							FromEmailAddress: "auth@acme.com",
+							Destination:      ses.Destination{ToAddresses: []string{"jane@acme.com"}},
+							Content: ses.EmailContent{
+								Simple: ses.Simple{
+									Subject: ses.Content{Data: "Verify your email", Charset: "UTF-8"},
+									Body: ses.Body{
+										HTML: ses.Content{Data: "<p>Hello</p>", Charset: "UTF-8"},
+									},
+									Headers: []ses.Header{{Name: "X-Ticket", Value: "my-ticket"}},
+								},
+							},
+						},
+						body,
+					); diff != "" {
+						t.Errorf("unexpected body (-want +got):\n%s", diff)
+					}
+
+					if tc.errorType != "" {
+						w.Header().Set("X-Amzn-ErrorType", tc.errorType)
+					}
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			s := ses.New("eu-west-1", "my-key-id", "my-secret", "auth@acme.com")
+			s.SetURL(server.URL)
+			s.SetNow(func() time.Time { return time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC) })
+
+			err := s.Send(
+				context.Background(),
+				"jane@acme.com",
+				"Verify your email",
+				"<p>Hello</p>",
+				map[string]string{"X-Ticket": "my-ticket", "X-Redirect-To": ""},
+			)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
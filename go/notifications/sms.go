@@ -0,0 +1,37 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+)
+
+type SMSProvider interface {
+	Send(ctx context.Context, to string, body string) error
+}
+
+type SMS struct {
+	provider  SMSProvider
+	templates *Templates
+}
+
+func NewSMS(provider SMSProvider, templates *Templates) *SMS {
+	return &SMS{
+		provider:  provider,
+		templates: templates,
+	}
+}
+
+func (s *SMS) SendSMS(
+	ctx context.Context, to string, locale string, templateName TemplateName, data TemplateData,
+) error {
+	body, err := s.templates.RenderSMS(locale, templateName, data)
+	if err != nil {
+		return fmt.Errorf("error rendering sms template: %w", err)
+	}
+
+	if err := s.provider.Send(ctx, to, body); err != nil {
+		return fmt.Errorf("error sending sms: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package smswebhook sends SMS messages by posting them to an HTTP endpoint
+// so any provider can be plugged in without changes to hasura-auth.
+package smswebhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type Webhook struct {
+	url    string
+	secret string
+	cl     *http.Client
+}
+
+func New(url string, secret string) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: secret,
+		cl:     &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+type Request struct {
+	To   string `json:"to"`
+	Body string `json:"body"`
+}
+
+func (w *Webhook) Send(ctx context.Context, to string, body string) error {
+	b, err := json.Marshal(Request{
+		To:   to,
+		Body: body,
+	})
+	if err != nil {
+		return fmt.Errorf("sms webhook: failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("sms webhook: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.secret != "" {
+		req.Header.Set("X-Webhook-Secret", w.secret)
+	}
+
+	resp, err := w.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("sms webhook: failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf( //nolint:goerr113
+			"sms webhook: %s: %s", resp.Status, string(b),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package smswebhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/notifications/smswebhook"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		secret         string
+		status         int
+		expectedHeader string
+		expectedErr    bool
+	}{
+		{
+			name:           "success",
+			secret:         "",
+			status:         http.StatusOK,
+			expectedHeader: "",
+			expectedErr:    false,
+		},
+		{
+			name:           "with secret",
+			secret:         "my-secret",
+			status:         http.StatusNoContent,
+			expectedHeader: "my-secret",
+			expectedErr:    false,
+		},
+		{
+			name:           "failure",
+			secret:         "",
+			status:         http.StatusInternalServerError,
+			expectedHeader: "",
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if got := r.Header.Get("X-Webhook-Secret"); got != tc.expectedHeader {
+						t.Errorf("unexpected secret header: %s", got)
+					}
+
+					var body smswebhook.Request
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("error decoding body: %s", err)
+					}
+
+					if diff := cmp.Diff(
+						smswebhook.Request{To: "+123456789", Body: "Your code is 123456."},
+						body,
+					); diff != "" {
+						t.Errorf("unexpected body (-want +got):\n%s", diff)
+					}
+
+					w.WriteHeader(tc.status)
+				}),
+			)
+			defer server.Close()
+
+			wh := smswebhook.New(server.URL, tc.secret)
+			err := wh.Send(context.Background(), "+123456789", "Your code is 123456.")
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTP sends emails using an SMTP server.
+type SMTP struct {
+	from             string
+	host             string
+	port             uint16
+	useTLSConnection bool
+	extraHeaders     map[string]string
+	auth             smtp.Auth
+}
+
+func NewSMTP(
+	host string,
+	port uint16,
+	useTLSConnection bool,
+	auth smtp.Auth,
+	from string,
+	extraHeaders map[string]string,
+) *SMTP {
+	return &SMTP{
+		from:             from,
+		host:             host,
+		port:             port,
+		useTLSConnection: useTLSConnection,
+		extraHeaders:     extraHeaders,
+		auth:             auth,
+	}
+}
+
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\n', '\r':
+			return -1
+		default:
+			return r
+		}
+	}, s)
+}
+
+// smtpError classifies errors returned by the SMTP server using their reply code, 4xx
+// codes are transient failures while 5xx codes are permanent. Network errors are
+// considered temporary.
+func smtpError(err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		if protoErr.Code >= 400 && protoErr.Code < 500 { //nolint:mnd
+			return fmt.Errorf("%w: %w", ErrEmailTemporaryFailure, err)
+		}
+		return fmt.Errorf("%w: %w", ErrEmailPermanentFailure, err)
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return fmt.Errorf("%w: %w", ErrEmailTemporaryFailure, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrEmailPermanentFailure, err)
+}
+
+func (sm *SMTP) Send(
+	_ context.Context, to, subject, contents string, headers map[string]string,
+) error {
+	buf := new(bytes.Buffer)
+	for k, v := range sm.extraHeaders {
+		fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+	}
+	for k, v := range headers {
+		fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+	}
+	buf.WriteString("From: " + sm.from + "\r\n")
+	buf.WriteString("To: " + sanitize(to) + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("Subject: " + sanitize(subject) + "\r\n")
+	buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(contents + "\r\n")
+
+	if err := sendMail(
+		sm.host,
+		sm.port,
+		sm.useTLSConnection,
+		sm.auth,
+		sm.from,
+		[]string{to},
+		buf.Bytes(),
+	); err != nil {
+		return fmt.Errorf("error sending email: %w", smtpError(err))
+	}
+	return nil
+}
+
+// dial connects to the SMTP server and reads its greeting.
+func (sm *SMTP) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(sm.host, strconv.Itoa(int(sm.port)))
+	dialer := &net.Dialer{} //nolint:exhaustruct
+
+	var (
+		conn net.Conn
+		err  error
+	)
+	if sm.useTLSConnection {
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config: &tls.Config{ //nolint:exhaustruct
+				ServerName: sm.host,
+			},
+		}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to smtp server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, sm.host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error reading smtp greeting: %w", err)
+	}
+
+	return client, nil
+}
+
+// Ping connects to the SMTP server and waits for its greeting to check it is reachable.
+func (sm *SMTP) Ping(ctx context.Context) error {
+	client, err := sm.dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Quit(); err != nil {
+		return fmt.Errorf("error closing smtp connection: %w", err)
+	}
+
+	return nil
+}
+
+// Verify connects to the SMTP server and authenticates like when sending an email, to
+// check the credentials are valid. No email is sent.
+func (sm *SMTP) Verify(ctx context.Context) error {
+	client, err := sm.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Hello("hasura-auth"); err != nil {
+		return fmt.Errorf("error greeting smtp server: %w", err)
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: sm.host}); err != nil { //nolint:exhaustruct
+			return fmt.Errorf("error starting tls: %w", err)
+		}
+	}
+
+	if err := client.Auth(sm.auth); err != nil {
+		return fmt.Errorf("error authenticating with smtp server: %w", err)
+	}
+
+	if err := client.Quit(); err != nil {
+		return fmt.Errorf("error closing smtp connection: %w", err)
+	}
+
+	return nil
+}
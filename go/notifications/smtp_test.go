@@ -0,0 +1,112 @@
+package notifications_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+)
+
+// fakeSMTPServer accepts the PLAIN credentials user and password, it serves a single
+// connection.
+func fakeSMTPServer(t *testing.T) (string, uint16) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+
+			switch cmd, arg, _ := strings.Cut(line, " "); cmd {
+			case "EHLO":
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 AUTH PLAIN")
+			case "AUTH":
+				_, credentials, _ := strings.Cut(arg, " ")
+				b, _ := base64.StdEncoding.DecodeString(credentials)
+				if string(b) == "\x00user\x00password" {
+					_ = tp.PrintfLine("235 2.7.0 Authentication successful")
+				} else {
+					_ = tp.PrintfLine("535 5.7.8 Authentication credentials invalid")
+				}
+			case "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	p, _ := strconv.ParseUint(port, 10, 16)
+
+	return host, uint16(p)
+}
+
+func TestSMTPVerify(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{
+			name:     "valid credentials",
+			password: "password",
+			wantErr:  false,
+		},
+		{
+			name:     "invalid credentials",
+			password: "wrong",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			host, port := fakeSMTPServer(t)
+			sm := notifications.NewSMTP(
+				host,
+				port,
+				false,
+				notifications.PlainAuth("", "user", tc.password, host),
+				"admin@localhost",
+				nil,
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			err := sm.Verify(ctx)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
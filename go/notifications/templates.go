@@ -1,12 +1,16 @@
 package notifications
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"sync"
+	"time"
 
+	"github.com/nhost/hasura-auth/go/sql"
 	"github.com/valyala/fasttemplate"
 )
 
@@ -15,29 +19,43 @@ type TemplateName string
 const (
 	TemplateNameEmailVerify        TemplateName = "email-verify"
 	TemplateNameEmailConfirmChange TemplateName = "email-confirm-change"
+	TemplateNameEmailChangeNotify  TemplateName = "email-change-notify"
+	TemplateNameAccountLocked      TemplateName = "account-locked"
+	TemplateNameAccountDeletion    TemplateName = "account-deletion"
+	TemplateNameNewDeviceSignIn    TemplateName = "new-device-sign-in"
+	TemplateNameSuspiciousSignIn   TemplateName = "suspicious-sign-in"
 	TemplateNameSigninPasswordless TemplateName = "signin-passwordless"
 	TemplateNamePasswordReset      TemplateName = "password-reset"
+	TemplateNameOrganizationInvite TemplateName = "organization-invite"
+
+	TemplateNameSigninPasswordlessSMS TemplateName = "signin-passwordless-sms"
+	TemplateNamePhoneChangeSMS        TemplateName = "phone-change-sms"
 )
 
-type Templates struct {
-	templates     map[string]*fasttemplate.Template
-	defaultLocale string
-	logger        *slog.Logger
+// TemplateSource loads template files keyed by their path relative to the templates
+// directory, i.e. {locale}/{template}/body.html.
+type TemplateSource interface {
+	LoadTemplates(ctx context.Context) (map[string]string, error)
 }
 
-func NewTemplatesFromFilesystem(
-	basePath string,
-	defaultLocale string,
-	logger *slog.Logger,
-) (*Templates, error) {
-	templates := make(map[string]*fasttemplate.Template)
-	if err := filepath.Walk(basePath, func(path string, info os.FileInfo, err error) error {
+// FilesystemTemplateSource loads the templates stored in a directory.
+type FilesystemTemplateSource struct {
+	basePath string
+}
+
+func NewFilesystemTemplateSource(basePath string) *FilesystemTemplateSource {
+	return &FilesystemTemplateSource{basePath: basePath}
+}
+
+func (s *FilesystemTemplateSource) LoadTemplates(_ context.Context) (map[string]string, error) {
+	templates := make(map[string]string)
+	if err := filepath.Walk(s.basePath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
 		if !info.IsDir() {
-			relativePath, err := filepath.Rel(basePath, path)
+			relativePath, err := filepath.Rel(s.basePath, path)
 			if err != nil {
 				return fmt.Errorf("error getting relative path: %w", err)
 			}
@@ -47,23 +65,132 @@ func NewTemplatesFromFilesystem(
 				if err != nil {
 					return fmt.Errorf("error reading file: %w", err)
 				}
-				templates[relativePath] = fasttemplate.New(string(f), "${", "}")
+				templates[relativePath] = string(f)
 			}
 		}
 
 		return nil
 	}); err != nil {
-		return nil, fmt.Errorf("error walking the templates path (%s): %w", basePath, err)
+		return nil, fmt.Errorf("error walking the templates path (%s): %w", s.basePath, err)
+	}
+
+	return templates, nil
+}
+
+type TemplatesDB interface {
+	GetEmailTemplates(ctx context.Context) ([]sql.AuthEmailTemplate, error)
+}
+
+// DatabaseTemplateSource loads the templates stored in the auth.email_templates table.
+type DatabaseTemplateSource struct {
+	db TemplatesDB
+}
+
+func NewDatabaseTemplateSource(db TemplatesDB) *DatabaseTemplateSource {
+	return &DatabaseTemplateSource{db: db}
+}
+
+func (s *DatabaseTemplateSource) LoadTemplates(ctx context.Context) (map[string]string, error) {
+	rows, err := s.db.GetEmailTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting email templates from the database: %w", err)
 	}
 
-	return &Templates{
-		templates:     templates,
+	templates := make(map[string]string, len(rows))
+	for _, row := range rows {
+		templates[filepath.Join(row.Locale, row.Template, row.File)] = row.Content
+	}
+
+	return templates, nil
+}
+
+// Templates holds the templates loaded from its sources. Sources are applied in order
+// so files from later sources override the ones with the same path from earlier ones.
+type Templates struct {
+	sources       []TemplateSource
+	mu            sync.RWMutex
+	templates     map[string]*fasttemplate.Template
+	defaultLocale string
+	fallbacks     LocaleFallbacks
+	logger        *slog.Logger
+}
+
+func NewTemplates(
+	ctx context.Context,
+	defaultLocale string,
+	fallbacks LocaleFallbacks,
+	logger *slog.Logger,
+	sources ...TemplateSource,
+) (*Templates, error) {
+	t := &Templates{
+		sources:       sources,
+		mu:            sync.RWMutex{},
+		templates:     nil,
 		defaultLocale: defaultLocale,
+		fallbacks:     fallbacks,
 		logger:        logger,
-	}, nil
+	}
+
+	if err := t.Reload(ctx); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func NewTemplatesFromFilesystem(
+	basePath string,
+	defaultLocale string,
+	logger *slog.Logger,
+) (*Templates, error) {
+	return NewTemplates(
+		context.Background(), defaultLocale, nil, logger, NewFilesystemTemplateSource(basePath),
+	)
+}
+
+// Reload loads the templates from all the sources again. If any of the sources fails
+// the templates loaded previously are kept.
+func (t *Templates) Reload(ctx context.Context) error {
+	templates := make(map[string]*fasttemplate.Template)
+	for _, source := range t.sources {
+		files, err := source.LoadTemplates(ctx)
+		if err != nil {
+			return err
+		}
+
+		for path, content := range files {
+			templates[path] = fasttemplate.New(content, "${", "}")
+		}
+	}
+
+	t.mu.Lock()
+	t.templates = templates
+	t.mu.Unlock()
+
+	return nil
+}
+
+// Watch reloads the templates every interval until the context is done so changes
+// to the templates are picked up without restarting the service.
+func (t *Templates) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.Reload(ctx); err != nil {
+				t.logger.Error("error reloading templates", slog.String("error", err.Error()))
+			}
+		}
+	}
 }
 
 func (t *Templates) GetRawTemplates() map[string]*fasttemplate.Template {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	return t.templates
 }
 
@@ -72,14 +199,16 @@ func (t *Templates) GetTemplate(
 ) (
 	*fasttemplate.Template, *fasttemplate.Template, error,
 ) {
+	templates := t.GetRawTemplates()
+
 	path := filepath.Join(locale, string(templateName), "body.html")
-	template, ok := t.templates[path]
+	template, ok := templates[path]
 	if !ok {
 		return nil, nil, ErrTemplateNotFound
 	}
 
 	path = filepath.Join(locale, string(templateName), "subject.txt")
-	subject, ok := t.templates[path]
+	subject, ok := templates[path]
 	if !ok {
 		return nil, nil, ErrTemplateNotFound
 	}
@@ -88,28 +217,38 @@ func (t *Templates) GetTemplate(
 }
 
 type TemplateData struct {
-	Link        string
-	DisplayName string
-	Email       string
-	NewEmail    string
-	Ticket      string
-	RedirectTo  string
-	Locale      string
-	ServerURL   string
-	ClientURL   string
+	Link             string
+	DisplayName      string
+	Email            string
+	NewEmail         string
+	Ticket           string
+	RedirectTo       string
+	Locale           string
+	ServerURL        string
+	ClientURL        string
+	Code             string
+	IPAddress        string
+	UserAgent        string
+	OrganizationName string
+	Location         string
 }
 
 func (data TemplateData) ToMap(extra map[string]any) map[string]any {
 	m := map[string]any{
-		"link":        data.Link,
-		"displayName": data.DisplayName,
-		"email":       data.Email,
-		"newEmail":    data.NewEmail,
-		"ticket":      data.Ticket,
-		"redirectTo":  data.RedirectTo,
-		"locale":      data.Locale,
-		"serverUrl":   data.ServerURL,
-		"clientUrl":   data.ClientURL,
+		"link":             data.Link,
+		"displayName":      data.DisplayName,
+		"email":            data.Email,
+		"newEmail":         data.NewEmail,
+		"ticket":           data.Ticket,
+		"redirectTo":       data.RedirectTo,
+		"locale":           data.Locale,
+		"serverUrl":        data.ServerURL,
+		"clientUrl":        data.ClientURL,
+		"code":             data.Code,
+		"ipAddress":        data.IPAddress,
+		"userAgent":        data.UserAgent,
+		"organizationName": data.OrganizationName,
+		"location":         data.Location,
 	}
 
 	for k, v := range extra {
@@ -119,18 +258,43 @@ func (data TemplateData) ToMap(extra map[string]any) map[string]any {
 	return m
 }
 
+// resolveLocale walks the fallback chain of the locale and returns the first locale the
+// template is available in according to get.
+func (t *Templates) resolveLocale(
+	templateName TemplateName, locale string, get func(locale string) error,
+) (string, error) {
+	for _, l := range t.fallbacks.Chain(locale, t.defaultLocale) {
+		err := get(l)
+		if errors.Is(err, ErrTemplateNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if l != locale {
+			t.logger.Warn("template not found, falling back to another locale",
+				slog.String("template", string(templateName)),
+				slog.String("locale", locale),
+				slog.String("fallback", l))
+		}
+		return l, nil
+	}
+
+	return "", ErrTemplateNotFound
+}
+
 func (t *Templates) Render(
 	locale string,
 	templateName TemplateName,
 	data TemplateData,
 ) (string, string, error) {
-	bodyTemplate, subjectTemplate, err := t.GetTemplate(templateName, locale)
-	if errors.Is(err, ErrTemplateNotFound) {
-		locale = t.defaultLocale
-		t.logger.Warn("email-verify template not found, falling back to default locale",
-			slog.String("locale", locale))
-		bodyTemplate, subjectTemplate, err = t.GetTemplate("email-verify", locale)
-	}
+	var bodyTemplate, subjectTemplate *fasttemplate.Template
+	locale, err := t.resolveLocale(templateName, locale, func(l string) error {
+		var err error
+		bodyTemplate, subjectTemplate, err = t.GetTemplate(templateName, l)
+		return err
+	})
 	if err != nil {
 		return "", "", fmt.Errorf("error getting email template: %w", err)
 	}
@@ -140,3 +304,33 @@ func (t *Templates) Render(
 	subject := subjectTemplate.ExecuteString(m)
 	return body, subject, nil
 }
+
+func (t *Templates) GetSMSTemplate(
+	templateName TemplateName, locale string,
+) (*fasttemplate.Template, error) {
+	path := filepath.Join(locale, string(templateName), "body.txt")
+	template, ok := t.GetRawTemplates()[path]
+	if !ok {
+		return nil, ErrTemplateNotFound
+	}
+
+	return template, nil
+}
+
+func (t *Templates) RenderSMS(
+	locale string,
+	templateName TemplateName,
+	data TemplateData,
+) (string, error) {
+	var bodyTemplate *fasttemplate.Template
+	locale, err := t.resolveLocale(templateName, locale, func(l string) error {
+		var err error
+		bodyTemplate, err = t.GetSMSTemplate(templateName, l)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting sms template: %w", err)
+	}
+
+	return bodyTemplate.ExecuteString(data.ToMap(map[string]any{"locale": locale})), nil
+}
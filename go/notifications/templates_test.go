@@ -1,14 +1,18 @@
 package notifications_test
 
 import (
+	"context"
 	"errors"
 	"io/fs"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/nhost/hasura-auth/go/notifications"
+	"github.com/nhost/hasura-auth/go/sql"
 )
 
 func TestGetRawTemplates(t *testing.T) {
@@ -24,51 +28,116 @@ func TestGetRawTemplates(t *testing.T) {
 			name: "success",
 			path: "../../email-templates/",
 			expectedTemplates: []string{
+				"bg/account-deletion/body.html",
+				"bg/account-deletion/subject.txt",
+				"bg/account-locked/body.html",
+				"bg/account-locked/subject.txt",
+				"bg/email-change-notify/body.html",
+				"bg/email-change-notify/subject.txt",
 				"bg/email-confirm-change/body.html",
 				"bg/email-confirm-change/subject.txt",
 				"bg/email-verify/body.html",
 				"bg/email-verify/subject.txt",
+				"bg/new-device-sign-in/body.html",
+				"bg/new-device-sign-in/subject.txt",
+				"bg/organization-invite/body.html",
+				"bg/organization-invite/subject.txt",
 				"bg/password-reset/body.html",
 				"bg/password-reset/subject.txt",
+				"bg/phone-change-sms/body.txt",
 				"bg/signin-passwordless-sms/body.txt",
 				"bg/signin-passwordless/body.html",
 				"bg/signin-passwordless/subject.txt",
+				"bg/suspicious-sign-in/body.html",
+				"bg/suspicious-sign-in/subject.txt",
+				"cs/account-deletion/body.html",
+				"cs/account-deletion/subject.txt",
+				"cs/account-locked/body.html",
+				"cs/account-locked/subject.txt",
+				"cs/email-change-notify/body.html",
+				"cs/email-change-notify/subject.txt",
 				"cs/email-confirm-change/body.html",
 				"cs/email-confirm-change/subject.txt",
 				"cs/email-verify/body.html",
 				"cs/email-verify/subject.txt",
+				"cs/new-device-sign-in/body.html",
+				"cs/new-device-sign-in/subject.txt",
+				"cs/organization-invite/body.html",
+				"cs/organization-invite/subject.txt",
 				"cs/password-reset/body.html",
 				"cs/password-reset/subject.txt",
+				"cs/phone-change-sms/body.txt",
 				"cs/signin-passwordless-sms/body.txt",
 				"cs/signin-passwordless/body.html",
 				"cs/signin-passwordless/subject.txt",
+				"cs/suspicious-sign-in/body.html",
+				"cs/suspicious-sign-in/subject.txt",
+				"en/account-deletion/body.html",
+				"en/account-deletion/subject.txt",
+				"en/account-locked/body.html",
+				"en/account-locked/subject.txt",
+				"en/email-change-notify/body.html",
+				"en/email-change-notify/subject.txt",
 				"en/email-confirm-change/body.html",
 				"en/email-confirm-change/subject.txt",
 				"en/email-verify/body.html",
 				"en/email-verify/subject.txt",
+				"en/new-device-sign-in/body.html",
+				"en/new-device-sign-in/subject.txt",
+				"en/organization-invite/body.html",
+				"en/organization-invite/subject.txt",
 				"en/password-reset/body.html",
 				"en/password-reset/subject.txt",
+				"en/phone-change-sms/body.txt",
 				"en/signin-passwordless-sms/body.txt",
 				"en/signin-passwordless/body.html",
 				"en/signin-passwordless/subject.txt",
+				"en/suspicious-sign-in/body.html",
+				"en/suspicious-sign-in/subject.txt",
+				"es/account-deletion/body.html",
+				"es/account-deletion/subject.txt",
+				"es/account-locked/body.html",
+				"es/account-locked/subject.txt",
+				"es/email-change-notify/body.html",
+				"es/email-change-notify/subject.txt",
 				"es/email-confirm-change/body.html",
 				"es/email-confirm-change/subject.txt",
 				"es/email-verify/body.html",
 				"es/email-verify/subject.txt",
+				"es/new-device-sign-in/body.html",
+				"es/new-device-sign-in/subject.txt",
+				"es/organization-invite/body.html",
+				"es/organization-invite/subject.txt",
 				"es/password-reset/body.html",
 				"es/password-reset/subject.txt",
+				"es/phone-change-sms/body.txt",
 				"es/signin-passwordless-sms/body.txt",
 				"es/signin-passwordless/body.html",
 				"es/signin-passwordless/subject.txt",
+				"es/suspicious-sign-in/body.html",
+				"es/suspicious-sign-in/subject.txt",
+				"fr/account-deletion/body.html",
+				"fr/account-deletion/subject.txt",
+				"fr/account-locked/body.html",
+				"fr/account-locked/subject.txt",
+				"fr/email-change-notify/body.html",
+				"fr/email-change-notify/subject.txt",
 				"fr/email-confirm-change/body.html",
 				"fr/email-confirm-change/subject.txt",
 				"fr/email-verify/body.html",
 				"fr/email-verify/subject.txt",
+				"fr/new-device-sign-in/body.html",
+				"fr/new-device-sign-in/subject.txt",
+				"fr/organization-invite/body.html",
+				"fr/organization-invite/subject.txt",
 				"fr/password-reset/body.html",
 				"fr/password-reset/subject.txt",
+				"fr/phone-change-sms/body.txt",
 				"fr/signin-passwordless-sms/body.txt",
 				"fr/signin-passwordless/body.html",
 				"fr/signin-passwordless/subject.txt",
+				"fr/suspicious-sign-in/body.html",
+				"fr/suspicious-sign-in/subject.txt",
 				"test/email-verify/body.html",
 				"test/email-verify/subject.txt",
 			},
@@ -122,15 +191,20 @@ func TestRenderEmailVerify(t *testing.T) {
 		{
 			name: "success",
 			data: notifications.TemplateData{
-				Link:        "http://link.test",
-				DisplayName: "Jane Doe",
-				Email:       "jane@doe.com",
-				NewEmail:    "",
-				Ticket:      "email-verify:xxxxxxxx",
-				RedirectTo:  "http://redirect.test",
-				Locale:      "en",
-				ServerURL:   "http://server.test",
-				ClientURL:   "http://client.test",
+				Link:             "http://link.test",
+				DisplayName:      "Jane Doe",
+				Email:            "jane@doe.com",
+				NewEmail:         "",
+				Ticket:           "email-verify:xxxxxxxx",
+				RedirectTo:       "http://redirect.test",
+				Locale:           "en",
+				ServerURL:        "http://server.test",
+				ClientURL:        "http://client.test",
+				Code:             "",
+				IPAddress:        "",
+				UserAgent:        "",
+				OrganizationName: "",
+				Location:         "",
 			},
 			locale:          "test",
 			expectedBody:    "http://link.test,\nJane Doe,\njane@doe.com,\nemail-verify:xxxxxxxx,\nhttp://redirect.test,\nhttp://server.test,\nhttp://client.test,\ntest,\n", //nolint:lll
@@ -139,15 +213,20 @@ func TestRenderEmailVerify(t *testing.T) {
 		{
 			name: "non-existent-locale",
 			data: notifications.TemplateData{
-				Link:        "http://link.test",
-				DisplayName: "Jane Doe",
-				Email:       "jane@doe.com",
-				NewEmail:    "",
-				Ticket:      "email-verify:xxxxxxxx",
-				RedirectTo:  "http://redirect.test",
-				Locale:      "en",
-				ServerURL:   "http://server.test",
-				ClientURL:   "http://client.test",
+				Link:             "http://link.test",
+				DisplayName:      "Jane Doe",
+				Email:            "jane@doe.com",
+				NewEmail:         "",
+				Ticket:           "email-verify:xxxxxxxx",
+				RedirectTo:       "http://redirect.test",
+				Locale:           "en",
+				ServerURL:        "http://server.test",
+				ClientURL:        "http://client.test",
+				Code:             "",
+				IPAddress:        "",
+				UserAgent:        "",
+				OrganizationName: "",
+				Location:         "",
 			},
 			locale:          "non-existent",
 			expectedBody:    "<!DOCTYPE html>\n<html>\n\n<head>\n  <meta charset=\"utf-8\" />\n</head>\n\n<body>\n  <h2>Verify Email</h2>\n  <p>Use this link to verify your email:</p>\n  <p>\n    <a href=\"http://link.test\">\n      Verify Email\n    </a>\n  </p>\n</body>\n\n</html>", //nolint:lll
@@ -184,3 +263,163 @@ func TestRenderEmailVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderSMS(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		locale       string
+		expectedBody string
+	}{
+		{
+			name:         "success",
+			locale:       "en",
+			expectedBody: "Your code is 123456.",
+		},
+		{
+			name:         "non-existent-locale",
+			locale:       "non-existent",
+			expectedBody: "Your code is 123456.",
+		},
+		{
+			name:         "regional-locale",
+			locale:       "fr-CA",
+			expectedBody: "Votre code est 123456.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := slog.Default()
+			templates, err := notifications.NewTemplatesFromFilesystem(
+				"../../email-templates/", "en", logger,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			body, err := templates.RenderSMS(
+				tc.locale,
+				notifications.TemplateNameSigninPasswordlessSMS,
+				notifications.TemplateData{ //nolint:exhaustruct
+					Code: "123456",
+				},
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(tc.expectedBody, body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeTemplatesDB struct {
+	templates []sql.AuthEmailTemplate
+}
+
+func (db *fakeTemplatesDB) GetEmailTemplates(_ context.Context) ([]sql.AuthEmailTemplate, error) {
+	return db.templates, nil
+}
+
+func writeTemplate(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("error writing template: %s", err)
+	}
+}
+
+func TestTemplatesOverrides(t *testing.T) {
+	t.Parallel()
+
+	overridesPath := t.TempDir()
+	writeTemplate(
+		t,
+		filepath.Join(overridesPath, "en", "email-verify", "subject.txt"),
+		"Welcome ${displayName}",
+	)
+
+	db := &fakeTemplatesDB{
+		templates: []sql.AuthEmailTemplate{
+			{ //nolint:exhaustruct
+				Locale:   "en",
+				Template: "password-reset",
+				File:     "subject.txt",
+				Content:  "Reset your password ${displayName}",
+			},
+		},
+	}
+
+	templates, err := notifications.NewTemplates(
+		context.Background(),
+		"en",
+		nil,
+		slog.Default(),
+		notifications.NewFilesystemTemplateSource("../../email-templates/"),
+		notifications.NewFilesystemTemplateSource(overridesPath),
+		notifications.NewDatabaseTemplateSource(db),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := notifications.TemplateData{ //nolint:exhaustruct
+		DisplayName: "Jane Doe",
+	}
+
+	render := func(templateName notifications.TemplateName) string {
+		t.Helper()
+
+		_, subject, err := templates.Render("en", templateName, data)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return subject
+	}
+
+	if diff := cmp.Diff("Welcome Jane Doe", render(notifications.TemplateNameEmailVerify)); diff != "" {
+		t.Errorf("unexpected subject (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(
+		"Reset your password Jane Doe", render(notifications.TemplateNamePasswordReset),
+	); diff != "" {
+		t.Errorf("unexpected subject (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(
+		"Change your email address", render(notifications.TemplateNameEmailConfirmChange),
+	); diff != "" {
+		t.Errorf("unexpected subject (-want +got):\n%s", diff)
+	}
+
+	writeTemplate(
+		t,
+		filepath.Join(overridesPath, "en", "email-verify", "subject.txt"),
+		"Hello ${displayName}",
+	)
+	db.templates = nil
+
+	if err := templates.Reload(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff("Hello Jane Doe", render(notifications.TemplateNameEmailVerify)); diff != "" {
+		t.Errorf("unexpected subject after reload (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(
+		"Reset your password", render(notifications.TemplateNamePasswordReset),
+	); diff != "" {
+		t.Errorf("unexpected subject after reload (-want +got):\n%s", diff)
+	}
+}
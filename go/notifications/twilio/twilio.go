@@ -0,0 +1,62 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const baseURL = "https://api.twilio.com/2010-04-01/Accounts"
+
+type Twilio struct {
+	accountSID         string
+	authToken          string
+	messagingServiceID string
+	cl                 *http.Client
+}
+
+func New(accountSID, authToken, messagingServiceID string) *Twilio {
+	return &Twilio{
+		accountSID:         accountSID,
+		authToken:          authToken,
+		messagingServiceID: messagingServiceID,
+		cl:                 &http.Client{}, //nolint:exhaustruct
+	}
+}
+
+func (t *Twilio) Send(ctx context.Context, to string, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("Body", body)
+	form.Set("MessagingServiceSid", t.messagingServiceID)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/%s/Messages.json", baseURL, t.accountSID),
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return fmt.Errorf("twilio: failed to create request: %w", err)
+	}
+	req.SetBasicAuth(t.accountSID, t.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.cl.Do(req)
+	if err != nil {
+		return fmt.Errorf("twilio: failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf( //nolint:goerr113
+			"twilio: %s: %s", resp.Status, string(b),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package providers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	appleIssuer           = "https://appleid.apple.com"
+	appleJWKSURL          = "https://appleid.apple.com/auth/keys"
+	appleClientSecretTTL  = 5 * time.Minute
+	applePrivateRelayHost = "privaterelay.appleid.com"
+)
+
+var ErrMissingIDToken = errors.New("token response is missing the id_token")
+
+//nolint:gochecknoglobals
+var appleEndpoint = oauth2.Endpoint{
+	AuthURL:       "https://appleid.apple.com/auth/authorize",
+	TokenURL:      "https://appleid.apple.com/auth/token",
+	DeviceAuthURL: "",
+	AuthStyle:     oauth2.AuthStyleInParams,
+}
+
+// Apple implements Sign in with Apple. Apple doesn't use a static client secret, instead
+// the client secret is a JWT signed with the private key downloaded from Apple's
+// developer portal, and the user's profile is only available in the id_token.
+type Apple struct {
+	config     *oauth2.Config
+	teamID     string
+	keyID      string
+	privateKey *ecdsa.PrivateKey
+	verifier   *IDTokenVerifier
+}
+
+func NewApple(
+	clientID string,
+	teamID string,
+	keyID string,
+	privateKey []byte,
+	redirectURL string,
+	scopes []string,
+) (*Apple, error) {
+	key, err := jwt.ParseECPrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing apple private key: %w", err)
+	}
+
+	if len(scopes) == 0 {
+		scopes = []string{"name", "email"}
+	}
+
+	return &Apple{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: "",
+			Endpoint:     appleEndpoint,
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+		},
+		teamID:     teamID,
+		keyID:      keyID,
+		privateKey: key,
+		verifier:   NewIDTokenVerifier(appleJWKSURL, appleIssuer, clientID),
+	}, nil
+}
+
+// AuthorizationURL returns the URL to sign in with Apple. Apple requires the response to
+// be posted back to us when requesting the name or email scopes and doesn't support PKCE
+// so the code verifier is ignored.
+func (p *Apple) AuthorizationURL(state string, _ string) string {
+	return p.config.AuthCodeURL(state, oauth2.SetAuthURLParam("response_mode", "form_post"))
+}
+
+// ClientSecret generates the JWT Apple expects as client secret.
+func (p *Apple) ClientSecret() (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    p.teamID,
+		Subject:   p.config.ClientID,
+		Audience:  jwt.ClaimStrings{appleIssuer},
+		ExpiresAt: jwt.NewNumericDate(now.Add(appleClientSecretTTL)),
+		NotBefore: nil,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ID:        "",
+	})
+	token.Header["kid"] = p.keyID
+
+	secret, err := token.SignedString(p.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("error signing client secret: %w", err)
+	}
+	return secret, nil
+}
+
+func (p *Apple) Exchange(ctx context.Context, code string, _ string) (*oauth2.Token, error) {
+	secret, err := p.ClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	config := *p.config
+	config.ClientSecret = secret
+
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging code: %w", err)
+	}
+	return token, nil
+}
+
+// GetProfile returns the profile contained in the id_token. Apple never includes the
+// user's name in it, see AppleUserName.
+func (p *Apple) GetProfile(ctx context.Context, token *oauth2.Token) (Profile, error) {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return Profile{}, ErrMissingIDToken
+	}
+
+	claims, err := p.verifier.Verify(ctx, idToken)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	return appleProfile(claims)
+}
+
+func appleProfile(claims jwt.MapClaims) (Profile, error) {
+	email := claimString(claims, "email")
+	profile := Profile{
+		ID:            claimString(claims, "sub"),
+		Email:         email,
+		EmailVerified: claimBool(claims, "email_verified"),
+		PrivateEmail: claimBool(claims, "is_private_email") ||
+			strings.HasSuffix(email, "@"+applePrivateRelayHost),
+		Name:      "",
+		AvatarURL: "",
+		Locale:    "",
+	}
+
+	if profile.ID == "" {
+		return Profile{}, ErrMissingProfileID
+	}
+
+	return profile, nil
+}
+
+// AppleUserName extracts the user's name from the user field Apple posts to the callback
+// the first time the user authorizes the application.
+func AppleUserName(user string) string {
+	var u struct {
+		Name struct {
+			FirstName string `json:"firstName"`
+			LastName  string `json:"lastName"`
+		} `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(user), &u); err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(u.Name.FirstName + " " + u.Name.LastName)
+}
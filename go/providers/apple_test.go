@@ -0,0 +1,381 @@
+package providers_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/providers"
+	"golang.org/x/oauth2"
+)
+
+func testAppleKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("error marshalling key: %v", err)
+	}
+
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b})
+}
+
+// testIDToken returns an id_token containing the given claims and a mux serving the JWKS
+// with the key used to sign it.
+func testIDToken(t *testing.T, claims jwt.MapClaims) (string, *http.ServeMux) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "my-key"
+	idToken, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("error signing id token: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "my-key",
+					"alg": "RS256",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e": base64.RawURLEncoding.EncodeToString(
+						big.NewInt(int64(key.E)).Bytes(),
+					),
+				},
+			},
+		})
+	})
+
+	return idToken, mux
+}
+
+// testIDTokenServer serves a JWKS with a single RSA key and a token endpoint that returns
+// an id_token signed with it containing the given claims.
+func testIDTokenServer(t *testing.T, claims jwt.MapClaims) *httptest.Server {
+	t.Helper()
+
+	idToken, mux := testIDToken(t, claims)
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %v", err)
+		}
+		if r.Form.Get("client_secret") == "" {
+			t.Errorf("missing client secret")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "my-access-token",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestAppleClientSecret(t *testing.T) {
+	t.Parallel()
+
+	key, pemKey := testAppleKey(t)
+
+	apple, err := providers.NewApple(
+		"com.acme.app", "my-team-id", "my-key-id", pemKey,
+		"https://auth.acme.com/signin/provider/apple/callback", nil,
+	)
+	if err != nil {
+		t.Fatalf("error creating provider: %v", err)
+	}
+
+	secret, err := apple.ClientSecret()
+	if err != nil {
+		t.Fatalf("error generating client secret: %v", err)
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(
+		secret, claims, func(*jwt.Token) (any, error) { return &key.PublicKey, nil },
+		jwt.WithValidMethods([]string{"ES256"}),
+	)
+	if err != nil {
+		t.Fatalf("error parsing client secret: %v", err)
+	}
+
+	if token.Header["kid"] != "my-key-id" {
+		t.Errorf("unexpected kid: %v", token.Header["kid"])
+	}
+
+	if diff := cmp.Diff(
+		jwt.MapClaims{
+			"iss": "my-team-id",
+			"sub": "com.acme.app",
+			"aud": []any{"https://appleid.apple.com"},
+		},
+		claims,
+		cmpopts.IgnoreMapEntries(func(k string, _ any) bool {
+			return k == "iat" || k == "exp"
+		}),
+	); diff != "" {
+		t.Errorf("unexpected claims (-want +got):\n%s", diff)
+	}
+}
+
+func TestAppleAuthorizationURL(t *testing.T) {
+	t.Parallel()
+
+	_, pemKey := testAppleKey(t)
+
+	apple, err := providers.NewApple(
+		"com.acme.app", "my-team-id", "my-key-id", pemKey,
+		"https://auth.acme.com/signin/provider/apple/callback", nil,
+	)
+	if err != nil {
+		t.Fatalf("error creating provider: %v", err)
+	}
+
+	authURL, err := url.Parse(apple.AuthorizationURL("my-state", "my-verifier"))
+	if err != nil {
+		t.Fatalf("error parsing authorization url: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		url.Values{
+			"client_id":     {"com.acme.app"},
+			"redirect_uri":  {"https://auth.acme.com/signin/provider/apple/callback"},
+			"response_mode": {"form_post"},
+			"response_type": {"code"},
+			"scope":         {"name email"},
+			"state":         {"my-state"},
+		},
+		authURL.Query(),
+	); diff != "" {
+		t.Errorf("unexpected authorization url query (-want +got):\n%s", diff)
+	}
+}
+
+func TestAppleGetProfile(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cases := []struct {
+		name        string
+		claims      jwt.MapClaims
+		expected    providers.Profile
+		expectedErr bool
+	}{
+		{
+			name: "email",
+			claims: jwt.MapClaims{
+				"iss":            "https://appleid.apple.com",
+				"aud":            "com.acme.app",
+				"iat":            now.Unix(),
+				"exp":            now.Add(time.Minute).Unix(),
+				"sub":            "001234.abcd",
+				"email":          "jane@acme.com",
+				"email_verified": "true",
+			},
+			expected: providers.Profile{
+				ID:            "001234.abcd",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "private relay",
+			claims: jwt.MapClaims{
+				"iss":              "https://appleid.apple.com",
+				"aud":              "com.acme.app",
+				"iat":              now.Unix(),
+				"exp":              now.Add(time.Minute).Unix(),
+				"sub":              "001234.abcd",
+				"email":            "x2mhd8q@privaterelay.appleid.com",
+				"email_verified":   true,
+				"is_private_email": "true",
+			},
+			expected: providers.Profile{
+				ID:            "001234.abcd",
+				Email:         "x2mhd8q@privaterelay.appleid.com",
+				EmailVerified: true,
+				PrivateEmail:  true,
+				Name:          "",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "email not verified",
+			claims: jwt.MapClaims{
+				"iss":            "https://appleid.apple.com",
+				"aud":            "com.acme.app",
+				"iat":            now.Unix(),
+				"exp":            now.Add(time.Minute).Unix(),
+				"sub":            "001234.abcd",
+				"email":          "jane@acme.com",
+				"email_verified": "false",
+			},
+			expected: providers.Profile{
+				ID:            "001234.abcd",
+				Email:         "jane@acme.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "wrong audience",
+			claims: jwt.MapClaims{
+				"iss":   "https://appleid.apple.com",
+				"aud":   "com.evil.app",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"sub":   "001234.abcd",
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: true,
+		},
+		{
+			name: "wrong issuer",
+			claims: jwt.MapClaims{
+				"iss":   "https://evil.com",
+				"aud":   "com.acme.app",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"sub":   "001234.abcd",
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: true,
+		},
+		{
+			name: "expired",
+			claims: jwt.MapClaims{
+				"iss":   "https://appleid.apple.com",
+				"aud":   "com.acme.app",
+				"iat":   now.Add(-time.Hour).Unix(),
+				"exp":   now.Add(-time.Minute).Unix(),
+				"sub":   "001234.abcd",
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testIDTokenServer(t, tc.claims)
+			defer server.Close()
+
+			_, pemKey := testAppleKey(t)
+			apple, err := providers.NewApple(
+				"com.acme.app", "my-team-id", "my-key-id", pemKey,
+				"https://auth.acme.com/signin/provider/apple/callback", nil,
+			)
+			if err != nil {
+				t.Fatalf("error creating provider: %v", err)
+			}
+			apple.SetEndpoints(
+				oauth2.Endpoint{
+					AuthURL:       server.URL + "/authorize",
+					TokenURL:      server.URL + "/token",
+					DeviceAuthURL: "",
+					AuthStyle:     oauth2.AuthStyleInParams,
+				},
+				providers.NewIDTokenVerifier(
+					server.URL+"/keys", "https://appleid.apple.com", "com.acme.app",
+				),
+			)
+
+			token, err := apple.Exchange(context.Background(), "my-code", "")
+			if err != nil {
+				t.Fatalf("error exchanging code: %v", err)
+			}
+
+			profile, err := apple.GetProfile(context.Background(), token)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, profile); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppleUserName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		user     string
+		expected string
+	}{
+		{
+			name:     "full name",
+			user:     `{"name":{"firstName":"Jane","lastName":"Doe"},"email":"jane@acme.com"}`,
+			expected: "Jane Doe",
+		},
+		{
+			name:     "first name only",
+			user:     `{"name":{"firstName":"Jane"}}`,
+			expected: "Jane",
+		},
+		{
+			name:     "invalid",
+			user:     `not-json`,
+			expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := providers.AppleUserName(tc.user); got != tc.expected {
+				t.Errorf("unexpected name: %q", got)
+			}
+		})
+	}
+}
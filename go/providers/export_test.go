@@ -0,0 +1,20 @@
+package providers
+
+import "golang.org/x/oauth2"
+
+// SetEndpoints points the provider to a test server.
+func (p *Apple) SetEndpoints(endpoint oauth2.Endpoint, verifier *IDTokenVerifier) {
+	p.config.Endpoint = endpoint
+	p.verifier = verifier
+}
+
+// SetEndpoints points the provider to a test server.
+func (p *Microsoft) SetEndpoints(endpoint oauth2.Endpoint, verifier *IDTokenVerifier) {
+	p.config.Endpoint = endpoint
+	p.verifier = verifier
+}
+
+// SetVerifier points the provider to a test server.
+func (p *NativeIDToken) SetVerifier(verifier *IDTokenVerifier) {
+	p.verifier = verifier
+}
@@ -0,0 +1,233 @@
+package providers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const jwksMinRefreshInterval = time.Minute
+
+var (
+	ErrUnknownSigningKey  = errors.New("id token is signed with an unknown key")
+	ErrUnsupportedKeyType = errors.New("unsupported key type")
+	ErrInvalidAudience    = errors.New("id token audience is not allowed")
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding key parameter: %w", err)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("%w: curve %s", ErrUnsupportedKeyType, k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, k.Kty)
+	}
+}
+
+// IDTokenVerifier validates ID tokens signed with the keys the provider publishes in its
+// JWKS endpoint. Keys are cached and only fetched again when a token is signed with a key
+// we don't know about.
+type IDTokenVerifier struct {
+	jwksURL   string
+	issuer    string
+	audiences []string
+	client    *http.Client
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewIDTokenVerifier returns a verifier accepting tokens issued to any of the audiences.
+func NewIDTokenVerifier(jwksURL, issuer string, audiences ...string) *IDTokenVerifier {
+	return &IDTokenVerifier{
+		jwksURL:   jwksURL,
+		issuer:    issuer,
+		audiences: audiences,
+		client:    &http.Client{Timeout: 10 * time.Second}, //nolint:exhaustruct,mnd
+		mu:        sync.Mutex{},
+		keys:      nil,
+		fetchedAt: time.Time{},
+	}
+}
+
+func (v *IDTokenVerifier) fetchKeys(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.jwksURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error fetching jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf( //nolint:goerr113
+			"unexpected status code fetching jwks: %d", resp.StatusCode,
+		)
+	}
+
+	var jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return fmt.Errorf("error decoding jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		key, err := k.publicKey()
+		if errors.Is(err, ErrUnsupportedKeyType) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error parsing key %s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+
+	v.keys = keys
+	v.fetchedAt = time.Now()
+
+	return nil
+}
+
+func (v *IDTokenVerifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+
+	if time.Since(v.fetchedAt) < jwksMinRefreshInterval {
+		return nil, ErrUnknownSigningKey
+	}
+
+	if err := v.fetchKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+
+	return nil, ErrUnknownSigningKey
+}
+
+// Verify checks the signature, issuer, audience and expiration of the token and returns
+// its claims. An empty issuer skips the issuer check, callers using it must validate the
+// issuer themselves.
+func (v *IDTokenVerifier) Verify(ctx context.Context, idToken string) (jwt.MapClaims, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(
+		idToken,
+		claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return v.key(ctx, kid)
+		},
+		opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying id token: %w", err)
+	}
+
+	audiences, err := claims.GetAudience()
+	if err != nil {
+		return nil, fmt.Errorf("error verifying id token: %w", err)
+	}
+	if !slices.ContainsFunc(audiences, func(aud string) bool {
+		return slices.Contains(v.audiences, aud)
+	}) {
+		return nil, fmt.Errorf("error verifying id token: %w", ErrInvalidAudience)
+	}
+
+	return claims, nil
+}
+
+// claimString returns the claim if it is a string.
+func claimString(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// claimBool returns the claim as a boolean. Some providers, like Apple, encode boolean
+// claims as strings.
+func claimBool(claims jwt.MapClaims, name string) bool {
+	switch v := claims[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,106 @@
+package providers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const googleJWKSURL = "https://www.googleapis.com/oauth2/v3/certs"
+
+var ErrInvalidNonce = errors.New("nonce doesn't match the id token")
+
+//nolint:gochecknoglobals
+var googleIssuers = []string{"https://accounts.google.com", "accounts.google.com"}
+
+// NativeIDToken implements IDTokenProvider for providers whose native SDKs hand the
+// id_token to the client. Native clients usually have their own client IDs, like the
+// bundle ID of iOS apps, so tokens issued to any of the audiences are accepted.
+type NativeIDToken struct {
+	verifier *IDTokenVerifier
+	issuers  []string
+	profile  func(claims jwt.MapClaims) (Profile, error)
+}
+
+// NewGoogleIDToken verifies id tokens obtained with Google Sign-In.
+func NewGoogleIDToken(audiences []string) *NativeIDToken {
+	return &NativeIDToken{
+		// google issues tokens with two different issuers so we check it ourselves
+		verifier: NewIDTokenVerifier(googleJWKSURL, "", audiences...),
+		issuers:  googleIssuers,
+		profile:  googleProfile,
+	}
+}
+
+// NewAppleIDToken verifies id tokens obtained with Sign in with Apple.
+func NewAppleIDToken(audiences []string) *NativeIDToken {
+	return &NativeIDToken{
+		verifier: NewIDTokenVerifier(appleJWKSURL, appleIssuer, audiences...),
+		issuers:  []string{appleIssuer},
+		profile:  appleProfile,
+	}
+}
+
+func (p *NativeIDToken) VerifyIDToken(
+	ctx context.Context, idToken string, nonce string,
+) (Profile, error) {
+	claims, err := p.verifier.Verify(ctx, idToken)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	if issuer := claimString(claims, "iss"); !slices.Contains(p.issuers, issuer) {
+		return Profile{}, fmt.Errorf("%w: %s", ErrInvalidIssuer, issuer)
+	}
+
+	if err := verifyNonce(claims, nonce); err != nil {
+		return Profile{}, err
+	}
+
+	return p.profile(claims)
+}
+
+// verifyNonce checks the nonce sent by the client matches the one in the id token. SDKs
+// include either the nonce as is or, like Sign in with Apple, its SHA-256 hash as hex.
+// Tokens without a nonce are only accepted if the client didn't send one.
+func verifyNonce(claims jwt.MapClaims, nonce string) error {
+	tokenNonce := claimString(claims, "nonce")
+	if tokenNonce == "" && nonce == "" {
+		return nil
+	}
+	if tokenNonce == "" || nonce == "" {
+		return ErrInvalidNonce
+	}
+
+	hash := sha256.Sum256([]byte(nonce))
+	if subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) == 1 ||
+		subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(hex.EncodeToString(hash[:]))) == 1 {
+		return nil
+	}
+
+	return ErrInvalidNonce
+}
+
+func googleProfile(claims jwt.MapClaims) (Profile, error) {
+	profile := Profile{
+		ID:            claimString(claims, "sub"),
+		Email:         claimString(claims, "email"),
+		EmailVerified: claimBool(claims, "email_verified"),
+		PrivateEmail:  false,
+		Name:          claimString(claims, "name"),
+		AvatarURL:     claimString(claims, "picture"),
+		Locale:        lookupLocale(claims, "locale"),
+	}
+
+	if profile.ID == "" {
+		return Profile{}, ErrMissingProfileID
+	}
+
+	return profile, nil
+}
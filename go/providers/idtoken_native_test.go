@@ -0,0 +1,205 @@
+package providers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/providers"
+)
+
+func TestGoogleIDTokenVerifyIDToken(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	now := time.Now()
+	hashedNonce := sha256.Sum256([]byte("my-nonce"))
+
+	googleClaims := func(extra jwt.MapClaims) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"iss":            "https://accounts.google.com",
+			"aud":            "my-ios-client-id",
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Minute).Unix(),
+			"sub":            "1234567890",
+			"email":          "jane@acme.com",
+			"email_verified": true,
+			"name":           "Jane Doe",
+			"picture":        "https://lh3.googleusercontent.com/jane",
+			"locale":         "fr",
+		}
+		for k, v := range extra {
+			claims[k] = v
+		}
+		return claims
+	}
+
+	profile := providers.Profile{
+		ID:            "1234567890",
+		Email:         "jane@acme.com",
+		EmailVerified: true,
+		PrivateEmail:  false,
+		Name:          "Jane Doe",
+		AvatarURL:     "https://lh3.googleusercontent.com/jane",
+		Locale:        "fr",
+	}
+
+	cases := []struct {
+		name        string
+		claims      jwt.MapClaims
+		nonce       string
+		expected    providers.Profile
+		expectedErr error
+	}{
+		{
+			name:        "no nonce",
+			claims:      googleClaims(nil),
+			nonce:       "",
+			expected:    profile,
+			expectedErr: nil,
+		},
+		{
+			name:        "legacy issuer",
+			claims:      googleClaims(jwt.MapClaims{"iss": "accounts.google.com"}),
+			nonce:       "",
+			expected:    profile,
+			expectedErr: nil,
+		},
+		{
+			name:        "nonce",
+			claims:      googleClaims(jwt.MapClaims{"nonce": "my-nonce"}),
+			nonce:       "my-nonce",
+			expected:    profile,
+			expectedErr: nil,
+		},
+		{
+			name: "hashed nonce",
+			claims: googleClaims(
+				jwt.MapClaims{"nonce": hex.EncodeToString(hashedNonce[:])},
+			),
+			nonce:       "my-nonce",
+			expected:    profile,
+			expectedErr: nil,
+		},
+		{
+			name:        "wrong nonce",
+			claims:      googleClaims(jwt.MapClaims{"nonce": "another-nonce"}),
+			nonce:       "my-nonce",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrInvalidNonce,
+		},
+		{
+			name:        "missing nonce",
+			claims:      googleClaims(nil),
+			nonce:       "my-nonce",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrInvalidNonce,
+		},
+		{
+			name:        "unexpected nonce",
+			claims:      googleClaims(jwt.MapClaims{"nonce": "my-nonce"}),
+			nonce:       "",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrInvalidNonce,
+		},
+		{
+			name:        "wrong issuer",
+			claims:      googleClaims(jwt.MapClaims{"iss": "https://evil.com"}),
+			nonce:       "",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrInvalidIssuer,
+		},
+		{
+			name:        "wrong audience",
+			claims:      googleClaims(jwt.MapClaims{"aud": "another-client-id"}),
+			nonce:       "",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrInvalidAudience,
+		},
+		{
+			name:        "expired",
+			claims:      googleClaims(jwt.MapClaims{"exp": now.Add(-time.Minute).Unix()}),
+			nonce:       "",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: jwt.ErrTokenExpired,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			idToken, mux := testIDToken(t, tc.claims)
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			google := providers.NewGoogleIDToken([]string{"my-web-client-id", "my-ios-client-id"})
+			google.SetVerifier(
+				providers.NewIDTokenVerifier(
+					server.URL+"/keys", "", "my-web-client-id", "my-ios-client-id",
+				),
+			)
+
+			got, err := google.VerifyIDToken(context.Background(), idToken, tc.nonce)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppleIDTokenVerifyIDToken(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	hashedNonce := sha256.Sum256([]byte("my-nonce"))
+
+	idToken, mux := testIDToken(t, jwt.MapClaims{
+		"iss":            "https://appleid.apple.com",
+		"aud":            "com.acme.app",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+		"sub":            "001234.abcd",
+		"email":          "jane@acme.com",
+		"email_verified": "true",
+		"nonce":          hex.EncodeToString(hashedNonce[:]),
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	apple := providers.NewAppleIDToken([]string{"com.acme.app"})
+	apple.SetVerifier(
+		providers.NewIDTokenVerifier(
+			server.URL+"/keys", "https://appleid.apple.com", "com.acme.app",
+		),
+	)
+
+	got, err := apple.VerifyIDToken(context.Background(), idToken, "my-nonce")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		providers.Profile{
+			ID:            "001234.abcd",
+			Email:         "jane@acme.com",
+			EmailVerified: true,
+			PrivateEmail:  false,
+			Name:          "",
+			AvatarURL:     "",
+			Locale:        "",
+		},
+		got,
+	); diff != "" {
+		t.Errorf("unexpected profile (-want +got):\n%s", diff)
+	}
+}
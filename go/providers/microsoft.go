@@ -0,0 +1,120 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+)
+
+const microsoftLoginURL = "https://login.microsoftonline.com"
+
+var (
+	ErrInvalidIssuer      = errors.New("id token issuer is not allowed")
+	ErrTenantNotAllowed   = errors.New("tenant is not allowed")
+	ErrMissingTenantClaim = errors.New("id token is missing the tid claim")
+)
+
+// Microsoft implements sign in with Microsoft Entra ID (formerly Azure AD). The tenant
+// can be "common", "organizations", "consumers" or a specific tenant ID or domain. When
+// using one of the multi-tenant endpoints allowedTenants can be used to restrict which
+// tenants can sign in.
+type Microsoft struct {
+	config         *oauth2.Config
+	verifier       *IDTokenVerifier
+	allowedTenants []string
+}
+
+func NewMicrosoft(
+	clientID string,
+	clientSecret string,
+	tenant string,
+	allowedTenants []string,
+	redirectURL string,
+	scopes []string,
+) *Microsoft {
+	if tenant == "" {
+		tenant = "common"
+	}
+
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &Microsoft{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Endpoint:     endpoints.AzureAD(tenant),
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+		},
+		// the issuer depends on the tenant the user belongs to so it is checked in
+		// GetProfile instead
+		verifier: NewIDTokenVerifier(
+			microsoftLoginURL+"/"+tenant+"/discovery/v2.0/keys", "", clientID,
+		),
+		allowedTenants: allowedTenants,
+	}
+}
+
+func (p *Microsoft) AuthorizationURL(state string, codeVerifier string) string {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier))
+}
+
+func (p *Microsoft) Exchange(
+	ctx context.Context, code string, codeVerifier string,
+) (*oauth2.Token, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging code: %w", err)
+	}
+	return token, nil
+}
+
+// GetProfile returns the profile contained in the id_token. Microsoft doesn't verify
+// the email claim so it is only considered verified when the optional xms_edov claim
+// states the domain of the email is owned by the tenant.
+func (p *Microsoft) GetProfile(ctx context.Context, token *oauth2.Token) (Profile, error) {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return Profile{}, ErrMissingIDToken
+	}
+
+	claims, err := p.verifier.Verify(ctx, idToken)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	tenantID := claimString(claims, "tid")
+	if tenantID == "" {
+		return Profile{}, ErrMissingTenantClaim
+	}
+
+	if claimString(claims, "iss") != microsoftLoginURL+"/"+tenantID+"/v2.0" {
+		return Profile{}, ErrInvalidIssuer
+	}
+
+	if len(p.allowedTenants) > 0 && !slices.Contains(p.allowedTenants, tenantID) {
+		return Profile{}, fmt.Errorf("%w: %s", ErrTenantNotAllowed, tenantID)
+	}
+
+	profile := Profile{
+		ID:            claimString(claims, "oid"),
+		Email:         claimString(claims, "email"),
+		EmailVerified: claimBool(claims, "xms_edov"),
+		PrivateEmail:  false,
+		Name:          claimString(claims, "name"),
+		AvatarURL:     "",
+		Locale:        "",
+	}
+
+	if profile.ID == "" {
+		return Profile{}, ErrMissingProfileID
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,242 @@
+package providers_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/providers"
+	"golang.org/x/oauth2"
+)
+
+const (
+	testTenantID      = "9188040d-6c67-4c5b-b112-36a304b66dad"
+	testOtherTenantID = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+)
+
+func TestMicrosoftAuthorizationURL(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		tenant       string
+		expectedPath string
+	}{
+		{
+			name:         "common",
+			tenant:       "",
+			expectedPath: "/common/oauth2/v2.0/authorize",
+		},
+		{
+			name:         "organizations",
+			tenant:       "organizations",
+			expectedPath: "/organizations/oauth2/v2.0/authorize",
+		},
+		{
+			name:         "specific tenant",
+			tenant:       testTenantID,
+			expectedPath: "/" + testTenantID + "/oauth2/v2.0/authorize",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			microsoft := providers.NewMicrosoft(
+				"my-client-id", "my-client-secret", tc.tenant, nil,
+				"https://auth.acme.com/signin/provider/azuread/callback", nil,
+			)
+
+			authURL, err := url.Parse(microsoft.AuthorizationURL("my-state", "my-verifier"))
+			if err != nil {
+				t.Fatalf("error parsing authorization url: %v", err)
+			}
+
+			if authURL.Path != tc.expectedPath {
+				t.Errorf("unexpected authorization url path: %s", authURL.Path)
+			}
+
+			if diff := cmp.Diff(
+				url.Values{
+					"client_id":             {"my-client-id"},
+					"code_challenge":        {oauth2.S256ChallengeFromVerifier("my-verifier")},
+					"code_challenge_method": {"S256"},
+					"redirect_uri": {
+						"https://auth.acme.com/signin/provider/azuread/callback",
+					},
+					"response_type": {"code"},
+					"scope":         {"openid email profile"},
+					"state":         {"my-state"},
+				},
+				authURL.Query(),
+			); diff != "" {
+				t.Errorf("unexpected authorization url query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMicrosoftGetProfile(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	now := time.Now()
+
+	cases := []struct {
+		name           string
+		allowedTenants []string
+		claims         jwt.MapClaims
+		expected       providers.Profile
+		expectedErr    error
+	}{
+		{
+			name:           "email not verified",
+			allowedTenants: nil,
+			claims: jwt.MapClaims{
+				"iss":   "https://login.microsoftonline.com/" + testTenantID + "/v2.0",
+				"aud":   "my-client-id",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"tid":   testTenantID,
+				"oid":   "00000000-0000-0000-66f3-3332eca7ea81",
+				"sub":   "AAAAAAAAAAAAAAAAAAAAAIkzqFVrSaSaFHy782bbtaQ",
+				"email": "jane@acme.com",
+				"name":  "Jane Doe",
+			},
+			expected: providers.Profile{
+				ID:            "00000000-0000-0000-66f3-3332eca7ea81",
+				Email:         "jane@acme.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:           "email domain owner verified",
+			allowedTenants: []string{testTenantID},
+			claims: jwt.MapClaims{
+				"iss":      "https://login.microsoftonline.com/" + testTenantID + "/v2.0",
+				"aud":      "my-client-id",
+				"iat":      now.Unix(),
+				"exp":      now.Add(time.Minute).Unix(),
+				"tid":      testTenantID,
+				"oid":      "00000000-0000-0000-66f3-3332eca7ea81",
+				"email":    "jane@acme.com",
+				"name":     "Jane Doe",
+				"xms_edov": true,
+			},
+			expected: providers.Profile{
+				ID:            "00000000-0000-0000-66f3-3332eca7ea81",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:           "tenant not allowed",
+			allowedTenants: []string{testTenantID},
+			claims: jwt.MapClaims{
+				"iss":   "https://login.microsoftonline.com/" + testOtherTenantID + "/v2.0",
+				"aud":   "my-client-id",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"tid":   testOtherTenantID,
+				"oid":   "00000000-0000-0000-66f3-3332eca7ea81",
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrTenantNotAllowed,
+		},
+		{
+			name:           "issuer doesn't match tenant",
+			allowedTenants: []string{testTenantID},
+			claims: jwt.MapClaims{
+				"iss":   "https://login.microsoftonline.com/" + testOtherTenantID + "/v2.0",
+				"aud":   "my-client-id",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"tid":   testTenantID,
+				"oid":   "00000000-0000-0000-66f3-3332eca7ea81",
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrInvalidIssuer,
+		},
+		{
+			name:           "missing tenant",
+			allowedTenants: nil,
+			claims: jwt.MapClaims{
+				"iss":   "https://login.microsoftonline.com/" + testTenantID + "/v2.0",
+				"aud":   "my-client-id",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"oid":   "00000000-0000-0000-66f3-3332eca7ea81",
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrMissingTenantClaim,
+		},
+		{
+			name:           "missing oid",
+			allowedTenants: nil,
+			claims: jwt.MapClaims{
+				"iss":   "https://login.microsoftonline.com/" + testTenantID + "/v2.0",
+				"aud":   "my-client-id",
+				"iat":   now.Unix(),
+				"exp":   now.Add(time.Minute).Unix(),
+				"tid":   testTenantID,
+				"email": "jane@acme.com",
+			},
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrMissingProfileID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testIDTokenServer(t, tc.claims)
+			defer server.Close()
+
+			microsoft := providers.NewMicrosoft(
+				"my-client-id", "my-client-secret", "common", tc.allowedTenants,
+				"https://auth.acme.com/signin/provider/azuread/callback", nil,
+			)
+			microsoft.SetEndpoints(
+				oauth2.Endpoint{
+					AuthURL:       server.URL + "/authorize",
+					TokenURL:      server.URL + "/token",
+					DeviceAuthURL: "",
+					AuthStyle:     oauth2.AuthStyleInParams,
+				},
+				providers.NewIDTokenVerifier(server.URL+"/keys", "", "my-client-id"),
+			)
+
+			token, err := microsoft.Exchange(context.Background(), "my-code", "my-verifier")
+			if err != nil {
+				t.Fatalf("error exchanging code: %v", err)
+			}
+
+			profile, err := microsoft.GetProfile(context.Background(), token)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, profile); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: providers.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination mock/providers.go --source=providers.go
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	providers "github.com/nhost/hasura-auth/go/providers"
+	gomock "go.uber.org/mock/gomock"
+	oauth2 "golang.org/x/oauth2"
+)
+
+// MockProvider is a mock of Provider interface.
+type MockProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderMockRecorder
+}
+
+// MockProviderMockRecorder is the mock recorder for MockProvider.
+type MockProviderMockRecorder struct {
+	mock *MockProvider
+}
+
+// NewMockProvider creates a new mock instance.
+func NewMockProvider(ctrl *gomock.Controller) *MockProvider {
+	mock := &MockProvider{ctrl: ctrl}
+	mock.recorder = &MockProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthorizationURL mocks base method.
+func (m *MockProvider) AuthorizationURL(state, codeVerifier string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizationURL", state, codeVerifier)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// AuthorizationURL indicates an expected call of AuthorizationURL.
+func (mr *MockProviderMockRecorder) AuthorizationURL(state, codeVerifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizationURL", reflect.TypeOf((*MockProvider)(nil).AuthorizationURL), state, codeVerifier)
+}
+
+// Exchange mocks base method.
+func (m *MockProvider) Exchange(ctx context.Context, code, codeVerifier string) (*oauth2.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, codeVerifier)
+	ret0, _ := ret[0].(*oauth2.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockProviderMockRecorder) Exchange(ctx, code, codeVerifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockProvider)(nil).Exchange), ctx, code, codeVerifier)
+}
+
+// GetProfile mocks base method.
+func (m *MockProvider) GetProfile(ctx context.Context, token *oauth2.Token) (providers.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, token)
+	ret0, _ := ret[0].(providers.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockProviderMockRecorder) GetProfile(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockProvider)(nil).GetProfile), ctx, token)
+}
+
+// MockIDTokenProvider is a mock of IDTokenProvider interface.
+type MockIDTokenProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDTokenProviderMockRecorder
+}
+
+// MockIDTokenProviderMockRecorder is the mock recorder for MockIDTokenProvider.
+type MockIDTokenProviderMockRecorder struct {
+	mock *MockIDTokenProvider
+}
+
+// NewMockIDTokenProvider creates a new mock instance.
+func NewMockIDTokenProvider(ctrl *gomock.Controller) *MockIDTokenProvider {
+	mock := &MockIDTokenProvider{ctrl: ctrl}
+	mock.recorder = &MockIDTokenProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDTokenProvider) EXPECT() *MockIDTokenProviderMockRecorder {
+	return m.recorder
+}
+
+// VerifyIDToken mocks base method.
+func (m *MockIDTokenProvider) VerifyIDToken(ctx context.Context, idToken, nonce string) (providers.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyIDToken", ctx, idToken, nonce)
+	ret0, _ := ret[0].(providers.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIDToken indicates an expected call of VerifyIDToken.
+func (mr *MockIDTokenProviderMockRecorder) VerifyIDToken(ctx, idToken, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIDToken", reflect.TypeOf((*MockIDTokenProvider)(nil).VerifyIDToken), ctx, idToken, nonce)
+}
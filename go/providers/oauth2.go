@@ -0,0 +1,263 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+var ErrMissingProfileID = errors.New("profile is missing the user id")
+
+// ProfileMapping tells where to find each field of the Profile in the JSON document
+// returned by the provider's profile endpoint. Nested fields are separated by dots.
+type ProfileMapping struct {
+	ID            string
+	Email         string
+	EmailVerified string
+	Name          string
+	AvatarURL     string
+	Locale        string
+}
+
+// Preset describes a provider that implements the standard authorization code flow and
+// exposes the user's profile as a JSON document. Adding such a provider only requires
+// adding its Preset to Presets.
+type Preset struct {
+	Endpoint      oauth2.Endpoint
+	ProfileURL    string
+	DefaultScopes []string
+	// AuthParams are sent to the provider along with the authorization request
+	AuthParams map[string]string
+	// EmailsURL, if set, lists the emails of the user in the format used by GitHub's
+	// /user/emails endpoint. It is used for providers that don't include a verified email
+	// in the profile.
+	EmailsURL string
+	Mapping   ProfileMapping
+}
+
+// OAuth2 is a Provider driven by a Preset.
+type OAuth2 struct {
+	config     *oauth2.Config
+	profileURL string
+	emailsURL  string
+	authParams map[string]string
+	mapping    ProfileMapping
+}
+
+func NewOAuth2(
+	preset Preset,
+	clientID string,
+	clientSecret string,
+	redirectURL string,
+	scopes []string,
+) *OAuth2 {
+	if len(scopes) == 0 {
+		scopes = preset.DefaultScopes
+	}
+
+	return &OAuth2{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Endpoint:     preset.Endpoint,
+			RedirectURL:  redirectURL,
+			Scopes:       scopes,
+		},
+		profileURL: preset.ProfileURL,
+		emailsURL:  preset.EmailsURL,
+		authParams: preset.AuthParams,
+		mapping:    preset.Mapping,
+	}
+}
+
+func (p *OAuth2) AuthorizationURL(state string, codeVerifier string) string {
+	opts := make([]oauth2.AuthCodeOption, 0, len(p.authParams)+1)
+	opts = append(opts, oauth2.S256ChallengeOption(codeVerifier))
+	for k, v := range p.authParams {
+		opts = append(opts, oauth2.SetAuthURLParam(k, v))
+	}
+
+	return p.config.AuthCodeURL(state, opts...)
+}
+
+func (p *OAuth2) Exchange(
+	ctx context.Context, code string, codeVerifier string,
+) (*oauth2.Token, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging code: %w", err)
+	}
+	return token, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf( //nolint:goerr113
+			"unexpected status code: %d: %s", resp.StatusCode, body,
+		)
+	}
+
+	return body, nil
+}
+
+func (p *OAuth2) GetProfile(ctx context.Context, token *oauth2.Token) (Profile, error) {
+	client := p.config.Client(ctx, token)
+
+	body, err := getJSON(ctx, client, p.profileURL)
+	if err != nil {
+		return Profile{}, fmt.Errorf("error fetching profile: %w", err)
+	}
+
+	profile, err := p.mapping.Map(body)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	if p.emailsURL != "" {
+		body, err := getJSON(ctx, client, p.emailsURL)
+		if err != nil {
+			return Profile{}, fmt.Errorf("error fetching emails: %w", err)
+		}
+
+		if err := resolveEmail(&profile, body); err != nil {
+			return Profile{}, err
+		}
+	}
+
+	return profile, nil
+}
+
+// resolveEmail picks the primary email if it is verified, otherwise any verified email.
+// If there is none the email in the profile is kept but considered unverified.
+func resolveEmail(profile *Profile, data []byte) error {
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := json.Unmarshal(data, &emails); err != nil {
+		return fmt.Errorf("error decoding emails: %w", err)
+	}
+
+	profile.EmailVerified = false
+
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			profile.Email = e.Email
+			profile.EmailVerified = true
+			return nil
+		}
+	}
+
+	for _, e := range emails {
+		if e.Verified {
+			profile.Email = e.Email
+			profile.EmailVerified = true
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Map extracts the profile from the JSON document returned by the provider.
+func (m ProfileMapping) Map(data []byte) (Profile, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var doc map[string]any
+	if err := decoder.Decode(&doc); err != nil {
+		return Profile{}, fmt.Errorf("error decoding profile: %w", err)
+	}
+
+	profile := Profile{
+		ID:            lookupString(doc, m.ID),
+		Email:         lookupString(doc, m.Email),
+		EmailVerified: lookupBool(doc, m.EmailVerified),
+		PrivateEmail:  false,
+		Name:          lookupString(doc, m.Name),
+		AvatarURL:     lookupString(doc, m.AvatarURL),
+		Locale:        lookupLocale(doc, m.Locale),
+	}
+
+	if profile.ID == "" {
+		return Profile{}, ErrMissingProfileID
+	}
+
+	return profile, nil
+}
+
+func lookup(doc map[string]any, path string) any {
+	if path == "" {
+		return nil
+	}
+
+	var v any = doc
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+
+	return v
+}
+
+func lookupString(doc map[string]any, path string) string {
+	switch v := lookup(doc, path).(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// lookupLocale returns the language part of the locale, i.e. "en" for "en-GB",
+// as we only store two-character locales.
+func lookupLocale(doc map[string]any, path string) string {
+	locale := lookupString(doc, path)
+	if len(locale) > 2 { //nolint:mnd
+		return locale[:2]
+	}
+	return locale
+}
+
+// lookupBool considers a field true if it is a boolean set to true or a non-empty string,
+// the latter is used by providers that return the date the email was confirmed.
+func lookupBool(doc map[string]any, path string) bool {
+	switch v := lookup(doc, path).(type) {
+	case bool:
+		return v
+	case string:
+		return v != ""
+	default:
+		return false
+	}
+}
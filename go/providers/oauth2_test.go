@@ -0,0 +1,371 @@
+package providers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/providers"
+	"golang.org/x/oauth2"
+)
+
+func TestProfileMappingMap(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		mapping     providers.ProfileMapping
+		data        string
+		expected    providers.Profile
+		expectedErr error
+	}{
+		{
+			name:    "gitlab",
+			mapping: providers.Presets["gitlab"].Mapping,
+			data: `{
+				"id": 1234567,
+				"username": "jane",
+				"name": "Jane Doe",
+				"email": "jane@acme.com",
+				"confirmed_at": "2024-01-01T00:00:00.000Z",
+				"avatar_url": "https://gitlab.com/avatar.png"
+			}`,
+			expected: providers.Profile{
+				ID:            "1234567",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://gitlab.com/avatar.png",
+				Locale:        "",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "google",
+			mapping: providers.Presets["google"].Mapping,
+			data: `{
+				"sub": "110169484474386276334",
+				"name": "Jane Doe",
+				"given_name": "Jane",
+				"family_name": "Doe",
+				"picture": "https://lh3.googleusercontent.com/a/jane",
+				"email": "jane@acme.com",
+				"email_verified": true,
+				"locale": "en-GB"
+			}`,
+			expected: providers.Profile{
+				ID:            "110169484474386276334",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://lh3.googleusercontent.com/a/jane",
+				Locale:        "en",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "nested fields",
+			mapping: providers.ProfileMapping{
+				ID:            "sub",
+				Email:         "contact.email",
+				EmailVerified: "contact.verified",
+				Name:          "profile.name",
+				AvatarURL:     "profile.picture",
+				Locale:        "profile.locale",
+			},
+			data: `{
+				"sub": "abc",
+				"contact": {"email": "jane@acme.com", "verified": false},
+				"profile": {"name": "Jane Doe", "picture": "https://acme.com/jane.png", "locale": "fr"}
+			}`,
+			expected: providers.Profile{
+				ID:            "abc",
+				Email:         "jane@acme.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://acme.com/jane.png",
+				Locale:        "fr",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "unconfirmed email",
+			mapping: providers.Presets["gitlab"].Mapping,
+			data:    `{"id": 1, "email": "jane@acme.com", "confirmed_at": null}`,
+			expected: providers.Profile{
+				ID:            "1",
+				Email:         "jane@acme.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "missing id",
+			mapping:     providers.Presets["gitlab"].Mapping,
+			data:        `{"email": "jane@acme.com"}`,
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrMissingProfileID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.mapping.Map([]byte(tc.data))
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGoogleAuthorizationURL(t *testing.T) {
+	t.Parallel()
+
+	provider := providers.NewOAuth2(
+		providers.Presets["google"],
+		"my-client-id",
+		"my-client-secret",
+		"https://auth.acme.com/signin/provider/google/callback",
+		nil,
+	)
+
+	authURL, err := url.Parse(provider.AuthorizationURL("my-state", "my-verifier"))
+	if err != nil {
+		t.Fatalf("error parsing authorization url: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		url.Values{
+			"access_type":           {"offline"},
+			"client_id":             {"my-client-id"},
+			"code_challenge":        {oauth2.S256ChallengeFromVerifier("my-verifier")},
+			"code_challenge_method": {"S256"},
+			"prompt":                {"consent"},
+			"redirect_uri":          {"https://auth.acme.com/signin/provider/google/callback"},
+			"response_type":         {"code"},
+			"scope":                 {"email profile"},
+			"state":                 {"my-state"},
+		},
+		authURL.Query(),
+	); diff != "" {
+		t.Errorf("unexpected authorization url query (-want +got):\n%s", diff)
+	}
+}
+
+func TestOAuth2(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %v", err)
+		}
+		if got := r.Form.Get("code"); got != "my-code" {
+			t.Errorf("unexpected code: %s", got)
+		}
+		if got := r.Form.Get("code_verifier"); got != "my-verifier" {
+			t.Errorf("unexpected code verifier: %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"my-access-token","token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer my-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1, "email": "jane@acme.com", "name": "Jane Doe"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := providers.NewOAuth2(
+		providers.Preset{
+			Endpoint: oauth2.Endpoint{ //nolint:exhaustruct
+				AuthURL:  server.URL + "/authorize",
+				TokenURL: server.URL + "/token",
+			},
+			ProfileURL:    server.URL + "/user",
+			DefaultScopes: []string{"read_user"},
+			AuthParams:    nil,
+			EmailsURL:     "",
+			Mapping:       providers.Presets["gitlab"].Mapping,
+		},
+		"my-client-id",
+		"my-client-secret",
+		"https://auth.acme.com/signin/provider/acme/callback",
+		nil,
+	)
+
+	authURL, err := url.Parse(provider.AuthorizationURL("my-state", "my-verifier"))
+	if err != nil {
+		t.Fatalf("error parsing authorization url: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		url.Values{
+			"client_id":             {"my-client-id"},
+			"code_challenge":        {oauth2.S256ChallengeFromVerifier("my-verifier")},
+			"code_challenge_method": {"S256"},
+			"redirect_uri":          {"https://auth.acme.com/signin/provider/acme/callback"},
+			"response_type":         {"code"},
+			"scope":                 {"read_user"},
+			"state":                 {"my-state"},
+		},
+		authURL.Query(),
+	); diff != "" {
+		t.Errorf("unexpected authorization url query (-want +got):\n%s", diff)
+	}
+
+	token, err := provider.Exchange(context.Background(), "my-code", "my-verifier")
+	if err != nil {
+		t.Fatalf("error exchanging code: %v", err)
+	}
+
+	profile, err := provider.GetProfile(context.Background(), token)
+	if err != nil {
+		t.Fatalf("error getting profile: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		providers.Profile{
+			ID:            "1",
+			Email:         "jane@acme.com",
+			EmailVerified: false,
+			PrivateEmail:  false,
+			Name:          "Jane Doe",
+			AvatarURL:     "",
+			Locale:        "",
+		},
+		profile,
+	); diff != "" {
+		t.Errorf("unexpected profile (-want +got):\n%s", diff)
+	}
+}
+
+func TestOAuth2Emails(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		emails   string
+		expected providers.Profile
+	}{
+		{
+			name: "primary verified",
+			emails: `[
+				{"email": "jane@other.com", "primary": false, "verified": true},
+				{"email": "jane@acme.com", "primary": true, "verified": true}
+			]`,
+			expected: providers.Profile{
+				ID:            "1",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://avatars.githubusercontent.com/u/1",
+				Locale:        "",
+			},
+		},
+		{
+			name: "primary not verified",
+			emails: `[
+				{"email": "jane@acme.com", "primary": true, "verified": false},
+				{"email": "jane@other.com", "primary": false, "verified": true}
+			]`,
+			expected: providers.Profile{
+				ID:            "1",
+				Email:         "jane@other.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://avatars.githubusercontent.com/u/1",
+				Locale:        "",
+			},
+		},
+		{
+			name: "no verified email",
+			emails: `[
+				{"email": "jane@acme.com", "primary": true, "verified": false}
+			]`,
+			expected: providers.Profile{
+				ID:            "1",
+				Email:         "jane@public.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://avatars.githubusercontent.com/u/1",
+				Locale:        "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/user", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{
+					"id": 1,
+					"login": "jane",
+					"name": "Jane Doe",
+					"email": "jane@public.com",
+					"avatar_url": "https://avatars.githubusercontent.com/u/1"
+				}`))
+			})
+			mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer my-access-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(tc.emails))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			preset := providers.Presets["github"]
+			preset.ProfileURL = server.URL + "/user"
+			preset.EmailsURL = server.URL + "/user/emails"
+
+			provider := providers.NewOAuth2(
+				preset,
+				"my-client-id",
+				"my-client-secret",
+				"https://auth.acme.com/signin/provider/github/callback",
+				nil,
+			)
+
+			profile, err := provider.GetProfile(
+				context.Background(),
+				&oauth2.Token{AccessToken: "my-access-token"}, //nolint:exhaustruct
+			)
+			if err != nil {
+				t.Fatalf("error getting profile: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, profile); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
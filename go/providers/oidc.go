@@ -0,0 +1,172 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrDiscoveryIssuerMismatch = errors.New("discovery document issuer doesn't match")
+	ErrUserinfoSubjectMismatch = errors.New("userinfo subject doesn't match the id token")
+)
+
+// OIDCDiscovery contains the fields we use from the provider's
+// .well-known/openid-configuration document.
+type OIDCDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// DiscoverOIDC fetches the discovery document of the issuer. As required by the spec
+// the issuer in the document must match the one we requested it for.
+func DiscoverOIDC(ctx context.Context, issuer string) (OIDCDiscovery, error) {
+	client := &http.Client{Timeout: 10 * time.Second} //nolint:exhaustruct,mnd
+
+	body, err := getJSON(
+		ctx, client, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration",
+	)
+	if err != nil {
+		return OIDCDiscovery{}, fmt.Errorf("error fetching discovery document: %w", err)
+	}
+
+	var discovery OIDCDiscovery
+	if err := json.Unmarshal(body, &discovery); err != nil {
+		return OIDCDiscovery{}, fmt.Errorf("error decoding discovery document: %w", err)
+	}
+
+	if discovery.Issuer != issuer {
+		return OIDCDiscovery{}, fmt.Errorf(
+			"%w: expected %s, got %s", ErrDiscoveryIssuerMismatch, issuer, discovery.Issuer,
+		)
+	}
+
+	return discovery, nil
+}
+
+// OIDC implements a generic OpenID Connect provider configured from the issuer's
+// discovery document. The profile is built from the standard claims in the id_token,
+// falling back to the userinfo endpoint if the id_token doesn't include the email.
+type OIDC struct {
+	config      *oauth2.Config
+	verifier    *IDTokenVerifier
+	userinfoURL string
+}
+
+func NewOIDC(
+	discovery OIDCDiscovery,
+	clientID string,
+	clientSecret string,
+	redirectURL string,
+	scopes []string,
+) *OIDC {
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	return &OIDC{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:       discovery.AuthorizationEndpoint,
+				TokenURL:      discovery.TokenEndpoint,
+				DeviceAuthURL: "",
+				AuthStyle:     oauth2.AuthStyleAutoDetect,
+			},
+			RedirectURL: redirectURL,
+			Scopes:      scopes,
+		},
+		verifier:    NewIDTokenVerifier(discovery.JWKSURI, discovery.Issuer, clientID),
+		userinfoURL: discovery.UserinfoEndpoint,
+	}
+}
+
+func (p *OIDC) AuthorizationURL(state string, codeVerifier string) string {
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(codeVerifier))
+}
+
+func (p *OIDC) Exchange(
+	ctx context.Context, code string, codeVerifier string,
+) (*oauth2.Token, error) {
+	token, err := p.config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("error exchanging code: %w", err)
+	}
+	return token, nil
+}
+
+func (p *OIDC) GetProfile(ctx context.Context, token *oauth2.Token) (Profile, error) {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return Profile{}, ErrMissingIDToken
+	}
+
+	claims, err := p.verifier.Verify(ctx, idToken)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	if claimString(claims, "sub") == "" {
+		return Profile{}, ErrMissingProfileID
+	}
+
+	if claimString(claims, "email") == "" && p.userinfoURL != "" {
+		if err := p.mergeUserinfo(ctx, token, claims); err != nil {
+			return Profile{}, err
+		}
+	}
+
+	return Profile{
+		ID:            claimString(claims, "sub"),
+		Email:         claimString(claims, "email"),
+		EmailVerified: claimBool(claims, "email_verified"),
+		PrivateEmail:  false,
+		Name:          claimString(claims, "name"),
+		AvatarURL:     claimString(claims, "picture"),
+		Locale:        lookupLocale(claims, "locale"),
+	}, nil
+}
+
+// mergeUserinfo adds the claims returned by the userinfo endpoint that are missing in
+// the id_token. The subject of both must match so a mix-up can't be used to take over
+// another user's account.
+func (p *OIDC) mergeUserinfo(
+	ctx context.Context, token *oauth2.Token, claims map[string]any,
+) error {
+	body, err := getJSON(ctx, p.config.Client(ctx, token), p.userinfoURL)
+	if err != nil {
+		return fmt.Errorf("error fetching userinfo: %w", err)
+	}
+
+	var userinfo map[string]any
+	if err := json.Unmarshal(body, &userinfo); err != nil {
+		return fmt.Errorf("error decoding userinfo: %w", err)
+	}
+
+	if claimString(userinfo, "sub") != claimString(claims, "sub") {
+		return ErrUserinfoSubjectMismatch
+	}
+
+	for k, v := range userinfo {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package providers_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/providers"
+)
+
+// testOIDCServer serves a discovery document, a JWKS, a token endpoint returning an
+// id_token with the given claims and, if userinfo isn't empty, a userinfo endpoint.
+func testOIDCServer(
+	t *testing.T, claims func(issuer string) jwt.MapClaims, userinfo string,
+) *httptest.Server {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		discovery := map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/keys",
+		}
+		if userinfo != "" {
+			discovery["userinfo_endpoint"] = server.URL + "/userinfo"
+		}
+		_ = json.NewEncoder(w).Encode(discovery)
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "my-key",
+					"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					"e": base64.RawURLEncoding.EncodeToString(
+						big.NewInt(int64(key.E)).Bytes(),
+					),
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing form: %v", err)
+		}
+		if got := r.Form.Get("code_verifier"); got != "my-verifier" {
+			t.Errorf("unexpected code verifier: %s", got)
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(server.URL))
+		token.Header["kid"] = "my-key"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			t.Errorf("error signing id token: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "my-access-token",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer my-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(userinfo))
+	})
+
+	return server
+}
+
+func TestDiscoverOIDC(t *testing.T) {
+	t.Parallel()
+
+	server := testOIDCServer(t, nil, `{}`)
+	defer server.Close()
+
+	discovery, err := providers.DiscoverOIDC(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("error discovering provider: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		providers.OIDCDiscovery{
+			Issuer:                server.URL,
+			AuthorizationEndpoint: server.URL + "/authorize",
+			TokenEndpoint:         server.URL + "/token",
+			UserinfoEndpoint:      server.URL + "/userinfo",
+			JWKSURI:               server.URL + "/keys",
+		},
+		discovery,
+	); diff != "" {
+		t.Errorf("unexpected discovery document (-want +got):\n%s", diff)
+	}
+
+	if _, err := providers.DiscoverOIDC(
+		context.Background(), server.URL+"/",
+	); !errors.Is(err, providers.ErrDiscoveryIssuerMismatch) {
+		t.Errorf("expected issuer mismatch, got: %v", err)
+	}
+}
+
+func TestOIDC(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	now := time.Now()
+
+	cases := []struct {
+		name        string
+		claims      func(issuer string) jwt.MapClaims
+		userinfo    string
+		expected    providers.Profile
+		expectedErr error
+	}{
+		{
+			name: "standard claims",
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss":            issuer,
+					"aud":            "my-client-id",
+					"iat":            now.Unix(),
+					"exp":            now.Add(time.Minute).Unix(),
+					"sub":            "248289761001",
+					"email":          "jane@acme.com",
+					"email_verified": true,
+					"name":           "Jane Doe",
+					"picture":        "https://acme.com/jane.png",
+					"locale":         "fr-CA",
+				}
+			},
+			userinfo: "",
+			expected: providers.Profile{
+				ID:            "248289761001",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "https://acme.com/jane.png",
+				Locale:        "fr",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "email from userinfo",
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss": issuer,
+					"aud": "my-client-id",
+					"iat": now.Unix(),
+					"exp": now.Add(time.Minute).Unix(),
+					"sub": "248289761001",
+				}
+			},
+			userinfo: `{"sub":"248289761001","email":"jane@acme.com","email_verified":true,"name":"Jane Doe"}`,
+			expected: providers.Profile{
+				ID:            "248289761001",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "userinfo subject mismatch",
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss": issuer,
+					"aud": "my-client-id",
+					"iat": now.Unix(),
+					"exp": now.Add(time.Minute).Unix(),
+					"sub": "248289761001",
+				}
+			},
+			userinfo:    `{"sub":"other","email":"jane@acme.com","email_verified":true}`,
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrUserinfoSubjectMismatch,
+		},
+		{
+			name: "missing subject",
+			claims: func(issuer string) jwt.MapClaims {
+				return jwt.MapClaims{
+					"iss":   issuer,
+					"aud":   "my-client-id",
+					"iat":   now.Unix(),
+					"exp":   now.Add(time.Minute).Unix(),
+					"email": "jane@acme.com",
+				}
+			},
+			userinfo:    "",
+			expected:    providers.Profile{}, //nolint:exhaustruct
+			expectedErr: providers.ErrMissingProfileID,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testOIDCServer(t, tc.claims, tc.userinfo)
+			defer server.Close()
+
+			discovery, err := providers.DiscoverOIDC(context.Background(), server.URL)
+			if err != nil {
+				t.Fatalf("error discovering provider: %v", err)
+			}
+
+			provider := providers.NewOIDC(
+				discovery,
+				"my-client-id",
+				"my-client-secret",
+				"https://auth.acme.com/signin/provider/oidc/callback",
+				[]string{"email"},
+			)
+
+			authURL, err := url.Parse(provider.AuthorizationURL("my-state", "my-verifier"))
+			if err != nil {
+				t.Fatalf("error parsing authorization url: %v", err)
+			}
+			if got := authURL.Query().Get("scope"); got != "openid email" {
+				t.Errorf("unexpected scope: %s", got)
+			}
+
+			token, err := provider.Exchange(context.Background(), "my-code", "my-verifier")
+			if err != nil {
+				t.Fatalf("error exchanging code: %v", err)
+			}
+
+			profile, err := provider.GetProfile(context.Background(), token)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, profile); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOIDCWrongIssuer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	server := testOIDCServer(t, func(string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": "https://evil.com",
+			"aud": "my-client-id",
+			"iat": now.Unix(),
+			"exp": now.Add(time.Minute).Unix(),
+			"sub": "248289761001",
+		}
+	}, "")
+	defer server.Close()
+
+	discovery, err := providers.DiscoverOIDC(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("error discovering provider: %v", err)
+	}
+
+	provider := providers.NewOIDC(
+		discovery, "my-client-id", "my-client-secret",
+		"https://auth.acme.com/signin/provider/oidc/callback", nil,
+	)
+
+	token, err := provider.Exchange(context.Background(), "my-code", "my-verifier")
+	if err != nil {
+		t.Fatalf("error exchanging code: %v", err)
+	}
+
+	if _, err := provider.GetProfile(context.Background(), token); err == nil {
+		t.Error("expected an error verifying the id token")
+	}
+}
@@ -0,0 +1,58 @@
+package providers
+
+import "golang.org/x/oauth2/endpoints"
+
+// Presets are the providers that can be enabled purely through configuration.
+var Presets = map[string]Preset{ //nolint:gochecknoglobals
+	"github": {
+		Endpoint:      endpoints.GitHub,
+		ProfileURL:    "https://api.github.com/user",
+		DefaultScopes: []string{"user:email"},
+		AuthParams:    nil,
+		// the email in the profile is the public one, which might not be set or verified
+		EmailsURL: "https://api.github.com/user/emails",
+		Mapping: ProfileMapping{
+			ID:            "id",
+			Email:         "email",
+			EmailVerified: "",
+			Name:          "name",
+			AvatarURL:     "avatar_url",
+			Locale:        "",
+		},
+	},
+	"gitlab": {
+		Endpoint:      endpoints.GitLab,
+		ProfileURL:    "https://gitlab.com/api/v4/user",
+		DefaultScopes: []string{"read_user"},
+		AuthParams:    nil,
+		EmailsURL:     "",
+		Mapping: ProfileMapping{
+			ID:            "id",
+			Email:         "email",
+			EmailVerified: "confirmed_at",
+			Name:          "name",
+			AvatarURL:     "avatar_url",
+			Locale:        "",
+		},
+	},
+	"google": {
+		Endpoint:      endpoints.Google,
+		ProfileURL:    "https://www.googleapis.com/oauth2/v3/userinfo",
+		DefaultScopes: []string{"email", "profile"},
+		// request a refresh token so the application can keep calling google's APIs
+		// on behalf of the user, consent is required for google to issue it every time
+		AuthParams: map[string]string{
+			"access_type": "offline",
+			"prompt":      "consent",
+		},
+		EmailsURL: "",
+		Mapping: ProfileMapping{
+			ID:            "sub",
+			Email:         "email",
+			EmailVerified: "email_verified",
+			Name:          "name",
+			AvatarURL:     "picture",
+			Locale:        "locale",
+		},
+	},
+}
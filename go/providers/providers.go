@@ -0,0 +1,40 @@
+//go:generate mockgen -package mock -destination mock/providers.go --source=providers.go
+package providers
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+)
+
+// Profile is the information about the user returned by a provider, normalised so
+// users can be found or created in the same way regardless of the provider.
+type Profile struct {
+	ID            string
+	Email         string
+	EmailVerified bool
+	// PrivateEmail is set when the email is a relay address generated by the provider,
+	// like the ones Apple gives to users that choose to hide their email.
+	PrivateEmail bool
+	Name         string
+	AvatarURL    string
+	Locale       string
+}
+
+type Provider interface {
+	// AuthorizationURL returns the URL the user needs to be redirected to in order
+	// to authenticate with the provider. The code verifier is used to derive the PKCE
+	// challenge.
+	AuthorizationURL(state string, codeVerifier string) string
+	// Exchange trades the authorization code returned by the provider for a token.
+	Exchange(ctx context.Context, code string, codeVerifier string) (*oauth2.Token, error)
+	// GetProfile uses the token to fetch the user's profile from the provider.
+	GetProfile(ctx context.Context, token *oauth2.Token) (Profile, error)
+}
+
+type IDTokenProvider interface {
+	// VerifyIDToken validates an id_token obtained by the client directly from the provider,
+	// i.e. with a native SDK, and returns the user's profile. The nonce is the value the
+	// client used when requesting the token, if any.
+	VerifyIDToken(ctx context.Context, idToken string, nonce string) (Profile, error)
+}
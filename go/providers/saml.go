@@ -0,0 +1,281 @@
+package providers
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+var (
+	ErrInvalidCertificate = errors.New("invalid certificate")
+	ErrInvalidPrivateKey  = errors.New("invalid private key, an RSA key is required")
+	ErrMissingIDPSSO      = errors.New("identity provider metadata has no SSO descriptor")
+	ErrMissingRedirectSSO = errors.New(
+		"identity provider doesn't support the HTTP-Redirect binding",
+	)
+	ErrMissingNameID = errors.New("assertion is missing the subject's NameID")
+)
+
+// SAMLAttributeMapping contains the names of the attributes of the assertion that are
+// mapped to the user's fields. Attributes are matched both by Name and FriendlyName.
+type SAMLAttributeMapping struct {
+	Email       string
+	DisplayName string
+	Roles       string
+}
+
+// SAML implements a SAML 2.0 service provider using the HTTP-Redirect binding to send
+// the authentication request and the HTTP-POST binding to receive the response. The
+// NameID of the assertion is used as the user id in the identity provider, so it should
+// be configured to use a persistent or email NameID format.
+type SAML struct {
+	sp         *saml.ServiceProvider
+	mapping    SAMLAttributeMapping
+	trustEmail bool
+}
+
+// FetchSAMLMetadata downloads the metadata of the identity provider.
+func FetchSAMLMetadata(ctx context.Context, metadataURL string) ([]byte, error) {
+	client := &http.Client{Timeout: 10 * time.Second} //nolint:exhaustruct,mnd
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching identity provider metadata: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf( //nolint:goerr113
+			"unexpected status code fetching identity provider metadata: %d", resp.StatusCode,
+		)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading identity provider metadata: %w", err)
+	}
+	return body, nil
+}
+
+// parseSAMLMetadata parses the identity provider metadata, which can be either an
+// EntityDescriptor or an EntitiesDescriptor wrapping it.
+func parseSAMLMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	var entity saml.EntityDescriptor
+	if err := xml.Unmarshal(data, &entity); err == nil {
+		if len(entity.IDPSSODescriptors) == 0 {
+			return nil, ErrMissingIDPSSO
+		}
+		return &entity, nil
+	}
+
+	var entities saml.EntitiesDescriptor
+	if err := xml.Unmarshal(data, &entities); err != nil {
+		return nil, fmt.Errorf("error parsing identity provider metadata: %w", err)
+	}
+
+	for i, e := range entities.EntityDescriptors {
+		if len(e.IDPSSODescriptors) > 0 {
+			return &entities.EntityDescriptors[i], nil
+		}
+	}
+
+	return nil, ErrMissingIDPSSO
+}
+
+func parseSAMLKeyPair(certificate, privateKey []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(certificate)
+	if certBlock == nil {
+		return nil, nil, ErrInvalidCertificate
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidCertificate, err)
+	}
+
+	keyBlock, _ := pem.Decode(privateKey)
+	if keyBlock == nil {
+		return nil, nil, ErrInvalidPrivateKey
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes); err == nil {
+		return cert, key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, ErrInvalidPrivateKey
+	}
+
+	return cert, rsaKey, nil
+}
+
+// NewSAML creates the service provider. The certificate and private key, in PEM format,
+// are used to sign the authentication requests and to decrypt encrypted assertions. If
+// trustEmail is set emails asserted by the identity provider are considered verified.
+func NewSAML(
+	entityID string,
+	metadataURL *url.URL,
+	acsURL *url.URL,
+	idpMetadata []byte,
+	certificate []byte,
+	privateKey []byte,
+	mapping SAMLAttributeMapping,
+	trustEmail bool,
+) (*SAML, error) {
+	idp, err := parseSAMLMetadata(idpMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, key, err := parseSAMLKeyPair(certificate, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := &saml.ServiceProvider{ //nolint:exhaustruct
+		EntityID:          entityID,
+		Key:               key,
+		Certificate:       cert,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idp,
+		AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+		SignatureMethod:   dsig.RSASHA256SignatureMethod,
+		AllowIDPInitiated: false,
+	}
+	if sp.GetSSOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return nil, ErrMissingRedirectSSO
+	}
+
+	return &SAML{
+		sp:         sp,
+		mapping:    mapping,
+		trustEmail: trustEmail,
+	}, nil
+}
+
+// Metadata returns the service provider metadata to register with the identity provider.
+func (p *SAML) Metadata() ([]byte, error) {
+	b, err := xml.MarshalIndent(p.sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling metadata: %w", err)
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// AuthenticationRequestURL returns the URL to send the user to the identity provider
+// and the ID of the request, which must be included in the response.
+func (p *SAML) AuthenticationRequestURL(relayState string) (string, string, error) {
+	req, err := p.sp.MakeAuthenticationRequest(
+		p.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding),
+		saml.HTTPRedirectBinding,
+		saml.HTTPPostBinding,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating authentication request: %w", err)
+	}
+
+	u, err := req.Redirect(relayState, p.sp)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating authentication request url: %w", err)
+	}
+
+	return u.String(), req.ID, nil
+}
+
+// ParseResponse validates the base64 encoded response posted by the identity provider,
+// which must be in response to requestID, and returns the user's profile and the roles
+// asserted for it.
+func (p *SAML) ParseResponse(samlResponse string, requestID string) (Profile, []string, error) {
+	b, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return Profile{}, nil, fmt.Errorf("error decoding saml response: %w", err)
+	}
+
+	assertion, err := p.sp.ParseXMLResponse(b, []string{requestID})
+	if err != nil {
+		var invalidErr *saml.InvalidResponseError
+		if errors.As(err, &invalidErr) {
+			return Profile{}, nil, fmt.Errorf("invalid saml response: %w", invalidErr.PrivateErr)
+		}
+		return Profile{}, nil, fmt.Errorf("invalid saml response: %w", err)
+	}
+
+	return p.mapping.Map(assertion, p.trustEmail)
+}
+
+// Map extracts the profile and roles from the assertion. If there is no email attribute
+// and the NameID is an email address, it is used as the email.
+func (m SAMLAttributeMapping) Map(assertion *saml.Assertion, trustEmail bool) (Profile, []string, error) {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil ||
+		assertion.Subject.NameID.Value == "" {
+		return Profile{}, nil, ErrMissingNameID
+	}
+	nameID := assertion.Subject.NameID
+
+	email := firstValue(samlAttribute(assertion, m.Email))
+	if email == "" && nameID.Format == string(saml.EmailAddressNameIDFormat) {
+		email = nameID.Value
+	}
+
+	return Profile{
+		ID:            nameID.Value,
+		Email:         email,
+		EmailVerified: trustEmail && email != "",
+		PrivateEmail:  false,
+		Name:          firstValue(samlAttribute(assertion, m.DisplayName)),
+		AvatarURL:     "",
+		Locale:        "",
+	}, samlAttribute(assertion, m.Roles), nil
+}
+
+func samlAttribute(assertion *saml.Assertion, name string) []string {
+	if name == "" {
+		return nil
+	}
+
+	var values []string
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			if attr.Name != name && attr.FriendlyName != name {
+				continue
+			}
+			for _, v := range attr.Values {
+				if s := strings.TrimSpace(v.Value); s != "" {
+					values = append(values, s)
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
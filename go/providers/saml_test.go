@@ -0,0 +1,286 @@
+package providers_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/providers"
+)
+
+func testSAMLKeyPair(t *testing.T) (*x509.Certificate, *rsa.PrivateKey, []byte, []byte) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048) //nolint:mnd
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+
+	template := &x509.Certificate{ //nolint:exhaustruct
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "hasura-auth"}, //nolint:exhaustruct
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error creating certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("error parsing certificate: %v", err)
+	}
+
+	return cert,
+		key,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(
+			&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)},
+		)
+}
+
+type testServiceProviderProvider struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (p testServiceProviderProvider) GetServiceProvider(
+	_ *http.Request, _ string,
+) (*saml.EntityDescriptor, error) {
+	return p.metadata, nil
+}
+
+type testSAML struct {
+	idp *saml.IdentityProvider
+	sp  *providers.SAML
+}
+
+func newTestSAML(
+	t *testing.T, mapping providers.SAMLAttributeMapping, trustEmail bool,
+) testSAML {
+	t.Helper()
+
+	idpCert, idpKey, _, _ := testSAMLKeyPair(t)
+	idp := &saml.IdentityProvider{ //nolint:exhaustruct
+		Key:         idpKey,
+		Certificate: idpCert,
+		MetadataURL: url.URL{Scheme: "https", Host: "idp.acme.com", Path: "/metadata"}, //nolint:exhaustruct,lll
+		SSOURL:      url.URL{Scheme: "https", Host: "idp.acme.com", Path: "/sso"},      //nolint:exhaustruct,lll
+	}
+
+	idpMetadata, err := xml.Marshal(idp.Metadata())
+	if err != nil {
+		t.Fatalf("error marshalling idp metadata: %v", err)
+	}
+
+	_, _, spCert, spKey := testSAMLKeyPair(t)
+	metadataURL, _ := url.Parse("https://auth.acme.com/signin/saml/metadata")
+	acsURL, _ := url.Parse("https://auth.acme.com/signin/saml/acs")
+	sp, err := providers.NewSAML(
+		"https://auth.acme.com/signin/saml/metadata",
+		metadataURL,
+		acsURL,
+		idpMetadata,
+		spCert,
+		spKey,
+		mapping,
+		trustEmail,
+	)
+	if err != nil {
+		t.Fatalf("error creating service provider: %v", err)
+	}
+
+	spMetadata, err := sp.Metadata()
+	if err != nil {
+		t.Fatalf("error getting sp metadata: %v", err)
+	}
+	var spEntity saml.EntityDescriptor
+	if err := xml.Unmarshal(spMetadata, &spEntity); err != nil {
+		t.Fatalf("error parsing sp metadata: %v", err)
+	}
+	idp.ServiceProviderProvider = testServiceProviderProvider{metadata: &spEntity}
+
+	return testSAML{idp: idp, sp: sp}
+}
+
+// respond makes the identity provider respond to the authentication request with an
+// assertion for the session containing the given attributes.
+func (s testSAML) respond(
+	t *testing.T, authURL string, session *saml.Session, attributes []saml.Attribute,
+) string {
+	t.Helper()
+
+	req, err := saml.NewIdpAuthnRequest(s.idp, httptest.NewRequest(http.MethodGet, authURL, nil))
+	if err != nil {
+		t.Fatalf("error parsing authentication request: %v", err)
+	}
+	if err := req.Validate(); err != nil {
+		t.Fatalf("error validating authentication request: %v", err)
+	}
+
+	if err := (saml.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		t.Fatalf("error making assertion: %v", err)
+	}
+	req.Assertion.AttributeStatements = []saml.AttributeStatement{{Attributes: attributes}}
+
+	form, err := req.PostBinding()
+	if err != nil {
+		t.Fatalf("error making response: %v", err)
+	}
+
+	return form.SAMLResponse
+}
+
+func samlAttr(name string, values ...string) saml.Attribute {
+	attr := saml.Attribute{ //nolint:exhaustruct
+		Name:       name,
+		NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+	}
+	for _, v := range values {
+		attr.Values = append(attr.Values, saml.AttributeValue{ //nolint:exhaustruct
+			Type:  "xs:string",
+			Value: v,
+		})
+	}
+	return attr
+}
+
+func TestSAMLMetadata(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSAML(t, providers.SAMLAttributeMapping{}, false) //nolint:exhaustruct
+
+	metadata, err := s.sp.Metadata()
+	if err != nil {
+		t.Fatalf("error getting metadata: %v", err)
+	}
+
+	for _, expected := range []string{
+		`entityID="https://auth.acme.com/signin/saml/metadata"`,
+		`Location="https://auth.acme.com/signin/saml/acs"`,
+	} {
+		if !bytes.Contains(metadata, []byte(expected)) {
+			t.Errorf("metadata doesn't contain %s:\n%s", expected, metadata)
+		}
+	}
+}
+
+func TestSAMLParseResponse(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	mapping := providers.SAMLAttributeMapping{
+		Email:       "email",
+		DisplayName: "displayName",
+		Roles:       "roles",
+	}
+
+	cases := []struct {
+		name          string
+		trustEmail    bool
+		session       *saml.Session
+		attributes    []saml.Attribute
+		requestID     func(string) string
+		expected      providers.Profile
+		expectedRoles []string
+		expectedErr   bool
+	}{
+		{
+			name:       "attributes",
+			trustEmail: true,
+			session: &saml.Session{ //nolint:exhaustruct
+				NameID:       "00u1abcd",
+				NameIDFormat: string(saml.PersistentNameIDFormat),
+			},
+			attributes: []saml.Attribute{
+				samlAttr("email", "jane@acme.com"),
+				samlAttr("displayName", "Jane Doe"),
+				samlAttr("roles", "user", "editor"),
+			},
+			requestID: func(id string) string { return id },
+			expected: providers.Profile{
+				ID:            "00u1abcd",
+				Email:         "jane@acme.com",
+				EmailVerified: true,
+				PrivateEmail:  false,
+				Name:          "Jane Doe",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedRoles: []string{"user", "editor"},
+			expectedErr:   false,
+		},
+		{
+			name:       "email from name id, not trusted",
+			trustEmail: false,
+			session: &saml.Session{ //nolint:exhaustruct
+				NameID:       "jane@acme.com",
+				NameIDFormat: string(saml.EmailAddressNameIDFormat),
+			},
+			attributes: nil,
+			requestID:  func(id string) string { return id },
+			expected: providers.Profile{
+				ID:            "jane@acme.com",
+				Email:         "jane@acme.com",
+				EmailVerified: false,
+				PrivateEmail:  false,
+				Name:          "",
+				AvatarURL:     "",
+				Locale:        "",
+			},
+			expectedRoles: nil,
+			expectedErr:   false,
+		},
+		{
+			name:       "not in response to our request",
+			trustEmail: true,
+			session: &saml.Session{ //nolint:exhaustruct
+				NameID:       "00u1abcd",
+				NameIDFormat: string(saml.PersistentNameIDFormat),
+			},
+			attributes:    nil,
+			requestID:     func(string) string { return "id-other" },
+			expected:      providers.Profile{}, //nolint:exhaustruct
+			expectedRoles: nil,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newTestSAML(t, mapping, tc.trustEmail)
+
+			authURL, requestID, err := s.sp.AuthenticationRequestURL("my-relay-state")
+			if err != nil {
+				t.Fatalf("error creating authentication request: %v", err)
+			}
+
+			response := s.respond(t, authURL, tc.session, tc.attributes)
+
+			profile, roles, err := s.sp.ParseResponse(response, tc.requestID(requestID))
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, profile); diff != "" {
+				t.Errorf("unexpected profile (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.expectedRoles, roles); diff != "" {
+				t.Errorf("unexpected roles (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
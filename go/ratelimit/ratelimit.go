@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the in-memory limiter drops the windows that already ended.
+const sweepInterval = time.Minute
+
+// Result describes the state of a rate limit after a request was counted.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func newResult(count int, limit int, reset time.Time) Result {
+	return Result{
+		Allowed:   count <= limit,
+		Limit:     limit,
+		Remaining: max(limit-count, 0),
+		Reset:     reset,
+	}
+}
+
+type window struct {
+	count int
+	reset time.Time
+}
+
+// Memory is a fixed window rate limiter that keeps the counters in memory. Counters aren't
+// shared so each replica enforces its own limits, use Redis when running more than one.
+type Memory struct {
+	mu        sync.Mutex
+	windows   map[string]window
+	lastSweep time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		mu:        sync.Mutex{},
+		windows:   make(map[string]window),
+		lastSweep: time.Now(),
+	}
+}
+
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+
+	for key, w := range m.windows {
+		if !now.Before(w.reset) {
+			delete(m.windows, key)
+		}
+	}
+	m.lastSweep = now
+}
+
+// Allow counts a request for key and reports if it is within limit requests per interval.
+func (m *Memory) Allow(
+	_ context.Context, key string, limit int, interval time.Duration,
+) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.sweep(now)
+
+	w, ok := m.windows[key]
+	if !ok || !now.Before(w.reset) {
+		w = window{count: 0, reset: now.Add(interval)}
+	}
+	w.count++
+	m.windows[key] = w
+
+	return newResult(w.count, limit, w.reset), nil
+}
@@ -0,0 +1,101 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/nhost/hasura-auth/go/ratelimit"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		requests int
+		interval time.Duration
+		expected ratelimit.Result
+	}{
+		{
+			name:     "within limit",
+			requests: 2,
+			interval: time.Minute,
+			expected: ratelimit.Result{
+				Allowed:   true,
+				Limit:     3,
+				Remaining: 1,
+				Reset:     time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name:     "last allowed request",
+			requests: 3,
+			interval: time.Minute,
+			expected: ratelimit.Result{
+				Allowed:   true,
+				Limit:     3,
+				Remaining: 0,
+				Reset:     time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name:     "limit exceeded",
+			requests: 4,
+			interval: time.Minute,
+			expected: ratelimit.Result{
+				Allowed:   false,
+				Limit:     3,
+				Remaining: 0,
+				Reset:     time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name:     "window ended",
+			requests: 4,
+			interval: time.Nanosecond,
+			expected: ratelimit.Result{
+				Allowed:   true,
+				Limit:     3,
+				Remaining: 2,
+				Reset:     time.Now(),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := ratelimit.NewMemory()
+
+			var (
+				got ratelimit.Result
+				err error
+			)
+			for range tc.requests {
+				got, err = limiter.Allow(context.Background(), "ip:127.0.0.1", 3, tc.interval)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			if diff := cmp.Diff(
+				tc.expected, got, cmpopts.EquateApproxTime(time.Second),
+			); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+
+			other, err := limiter.Allow(context.Background(), "ip:127.0.0.2", 3, tc.interval)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if other.Remaining != 2 {
+				t.Errorf("keys aren't independent, remaining: %d", other.Remaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "hasura-auth:ratelimit:"
+
+// incrementScript increments the counter of the window, starting it if needed, and returns
+// the count and the milliseconds left until the window ends.
+var incrementScript = redis.NewScript(` //nolint:gochecknoglobals
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+// Redis is a fixed window rate limiter that keeps the counters in Redis so limits are
+// shared by all the replicas.
+type Redis struct {
+	client redis.Scripter
+}
+
+func NewRedis(client redis.Scripter) *Redis {
+	return &Redis{
+		client: client,
+	}
+}
+
+// Allow counts a request for key and reports if it is within limit requests per interval.
+func (r *Redis) Allow(
+	ctx context.Context, key string, limit int, interval time.Duration,
+) (Result, error) {
+	res, err := incrementScript.Run(
+		ctx, r.client, []string{redisKeyPrefix + key}, interval.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf( //nolint:exhaustruct
+			"error incrementing rate limit counter: %w", err,
+		)
+	}
+
+	if len(res) != 2 { //nolint:mnd
+		return Result{}, fmt.Errorf( //nolint:goerr113,exhaustruct
+			"unexpected response from rate limit script: %v", res,
+		)
+	}
+
+	reset := time.Now().Add(time.Duration(max(res[1], 0)) * time.Millisecond)
+	return newResult(int(res[0]), limit, reset), nil
+}
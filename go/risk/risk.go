@@ -0,0 +1,78 @@
+// Package risk scores how risky sign in attempts are, from 0 for attempts that look safe
+// to 100 for the riskiest ones, so they can be allowed, challenged with multi-factor
+// authentication or blocked.
+package risk
+
+import (
+	"context"
+	"net/netip"
+)
+
+// MaxScore is the score of the riskiest sign in attempts.
+const MaxScore = 100
+
+// Signals are what is known about a sign in attempt when it is scored. The failures are
+// the recent failed sign in attempts, they are only counted with the lockout enabled.
+type Signals struct {
+	UserID       string `json:"userId"`
+	IPAddress    string `json:"ipAddress,omitempty"`
+	UserAgent    string `json:"userAgent,omitempty"`
+	Country      string `json:"country,omitempty"`
+	NewDevice    bool   `json:"newDevice"`
+	UserFailures int    `json:"userFailures"`
+	IPFailures   int    `json:"ipFailures"`
+}
+
+// Weights of the signals in the score of the Heuristic engine.
+const (
+	riskyNetworkScore   = 50
+	newDeviceScore      = 20
+	userFailureScore    = 10
+	maxUserFailureScore = 30
+	ipFailureScore      = 5
+	maxIPFailureScore   = 20
+)
+
+// Heuristic is the built-in engine. It adds up a fixed score for each signal: 50 if the IP
+// address is in one of the risky networks, i.e. the ranges of Tor exit nodes or of hosting
+// providers, 20 for a device the user never signed in from, 10 for each recent failure of
+// the user up to 30 and 5 for each recent failure of the IP address up to 20.
+type Heuristic struct {
+	riskyNetworks []netip.Prefix
+}
+
+func NewHeuristic(riskyNetworks []netip.Prefix) *Heuristic {
+	return &Heuristic{
+		riskyNetworks: riskyNetworks,
+	}
+}
+
+func (h *Heuristic) isRisky(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, network := range h.riskyNetworks {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Heuristic) Score(_ context.Context, signals Signals) (int, error) {
+	score := 0
+	if h.isRisky(signals.IPAddress) {
+		score += riskyNetworkScore
+	}
+	if signals.NewDevice {
+		score += newDeviceScore
+	}
+	score += min(signals.UserFailures*userFailureScore, maxUserFailureScore)
+	score += min(signals.IPFailures*ipFailureScore, maxIPFailureScore)
+
+	return min(score, MaxScore), nil
+}
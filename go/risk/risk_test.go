@@ -0,0 +1,110 @@
+package risk_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/risk"
+)
+
+func TestHeuristicScore(t *testing.T) {
+	t.Parallel()
+
+	heuristic := risk.NewHeuristic([]netip.Prefix{
+		netip.MustParsePrefix("198.51.100.0/24"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	})
+
+	cases := []struct {
+		name     string
+		signals  risk.Signals
+		expected int
+	}{
+		{
+			name: "known device",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress: "203.0.113.7",
+			},
+			expected: 0,
+		},
+		{
+			name: "new device",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress: "203.0.113.7",
+				NewDevice: true,
+			},
+			expected: 20,
+		},
+		{
+			name: "risky network",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress: "198.51.100.14",
+			},
+			expected: 50,
+		},
+		{
+			name: "risky ipv6 network",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress: "2001:db8::1",
+			},
+			expected: 50,
+		},
+		{
+			name: "ipv4 mapped address in a risky network",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress: "::ffff:198.51.100.14",
+			},
+			expected: 50,
+		},
+		{
+			name: "failures",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress:    "203.0.113.7",
+				UserFailures: 2,
+				IPFailures:   1,
+			},
+			expected: 25,
+		},
+		{
+			name: "failures are capped",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress:    "203.0.113.7",
+				UserFailures: 9,
+				IPFailures:   100,
+			},
+			expected: 50,
+		},
+		{
+			name: "everything",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress:    "198.51.100.14",
+				NewDevice:    true,
+				UserFailures: 9,
+				IPFailures:   100,
+			},
+			expected: 100,
+		},
+		{
+			name: "invalid ip address",
+			signals: risk.Signals{ //nolint:exhaustruct
+				IPAddress: "",
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := heuristic.Score(context.Background(), tc.signals)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected score %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package risk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+var errNoScore = errors.New("response without a score")
+
+type webhookResponse struct {
+	Score *int `json:"score"`
+}
+
+// Webhook delegates the scoring to an external engine. The signals are posted to its
+// endpoint, signed like the webhooks, and it must respond with the score, i.e.
+// {"score": 42}.
+type Webhook struct {
+	url    string
+	secret []byte
+	cl     *http.Client
+}
+
+func NewWebhook(url string, secret string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: []byte(secret),
+		cl:     &http.Client{Timeout: timeout}, //nolint:exhaustruct
+	}
+}
+
+// Score returns the score in the response of the endpoint, capped between 0 and MaxScore.
+// Errors, including responses with a status code other than 200 or without a score, mean
+// the attempt couldn't be scored.
+func (w *Webhook) Score(ctx context.Context, signals Signals) (int, error) {
+	body, err := json.Marshal(signals)
+	if err != nil {
+		return 0, fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhooks.HeaderSignature, webhooks.Sign(w.secret, time.Now(), body))
+
+	resp, err := w.cl.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf( //nolint:goerr113
+			"unexpected status code: %d", resp.StatusCode,
+		)
+	}
+
+	var score webhookResponse
+	if err := json.NewDecoder(resp.Body).Decode(&score); err != nil {
+		return 0, fmt.Errorf("error decoding response: %w", err)
+	}
+	if score.Score == nil {
+		return 0, errNoScore
+	}
+
+	return max(0, min(*score.Score, MaxScore)), nil
+}
@@ -0,0 +1,102 @@
+package risk_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/risk"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func TestWebhookScore(t *testing.T) {
+	t.Parallel()
+
+	signals := risk.Signals{
+		UserID:       "db477732-48fa-4289-b694-2886a646b6eb",
+		IPAddress:    "203.0.113.7",
+		UserAgent:    "test",
+		Country:      "GB",
+		NewDevice:    true,
+		UserFailures: 1,
+		IPFailures:   0,
+	}
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expected    int
+		expectedErr bool
+	}{
+		{
+			name:        "success",
+			status:      http.StatusOK,
+			response:    `{"score":42}`,
+			expected:    42,
+			expectedErr: false,
+		},
+		{
+			name:        "score above the maximum",
+			status:      http.StatusOK,
+			response:    `{"score":250}`,
+			expected:    100,
+			expectedErr: false,
+		},
+		{
+			name:        "negative score",
+			status:      http.StatusOK,
+			response:    `{"score":-5}`,
+			expected:    0,
+			expectedErr: false,
+		},
+		{
+			name:        "no score",
+			status:      http.StatusOK,
+			response:    `{}`,
+			expected:    0,
+			expectedErr: true,
+		},
+		{
+			name:        "server error",
+			status:      http.StatusBadGateway,
+			response:    ``,
+			expected:    0,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					b, _ := io.ReadAll(r.Body)
+					if string(b) != `{"userId":"db477732-48fa-4289-b694-2886a646b6eb","ipAddress":"203.0.113.7","userAgent":"test","country":"GB","newDevice":true,"userFailures":1,"ipFailures":0}` { //nolint:lll
+						t.Errorf("unexpected request: %s", b)
+					}
+					if r.Header.Get(webhooks.HeaderSignature) == "" {
+						t.Error("missing signature")
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			webhook := risk.NewWebhook(server.URL, "secret", time.Second)
+			got, err := webhook.Score(context.Background(), signals)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected score %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
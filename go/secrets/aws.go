@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/sigv4"
+)
+
+const awsService = "secretsmanager"
+
+// AWS reads secrets from AWS Secrets Manager with static or temporary credentials.
+type AWS struct {
+	url             func(region string) string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	cl              *http.Client
+	now             func() time.Time
+}
+
+func NewAWS(region, accessKeyID, secretAccessKey, sessionToken string) *AWS {
+	return &AWS{
+		url: func(region string) string {
+			return "https://secretsmanager." + region + ".amazonaws.com/"
+		},
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		sessionToken:    sessionToken,
+		cl:              &http.Client{Timeout: 10 * time.Second}, //nolint:exhaustruct,mnd
+		now:             time.Now,
+	}
+}
+
+// regionFromARN returns the region of the secret if name is its ARN, i.e.
+// arn:aws:secretsmanager:eu-west-1:123456789012:secret:hasura-auth-AbCdEf.
+func regionFromARN(name string) string {
+	parts := strings.Split(name, ":")
+	if len(parts) < 4 || parts[0] != "arn" { //nolint:mnd
+		return ""
+	}
+	return parts[3]
+}
+
+//nolint:tagliatelle
+type awsRequest struct {
+	SecretID string `json:"SecretId"`
+}
+
+//nolint:tagliatelle
+type awsResponse struct {
+	SecretString *string `json:"SecretString"`
+	SecretBinary *string `json:"SecretBinary"`
+}
+
+// Get returns the current version of the secret, name is its name or its ARN. Secrets of
+// other regions must be referenced by ARN.
+func (a *AWS) Get(ctx context.Context, name string) (string, error) {
+	region := a.region
+	if r := regionFromARN(name); r != "" {
+		region = r
+	}
+
+	body, err := json.Marshal(awsRequest{SecretID: name})
+	if err != nil {
+		return "", fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url(region), bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	if a.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", a.sessionToken)
+	}
+	sigv4.Sign(req, body, region, awsService, a.accessKeyID, a.secretAccessKey, a.now())
+
+	resp, err := a.cl.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Secrets Manager responds with a 400 status code when the secret doesn't exist
+	if resp.StatusCode == http.StatusBadRequest &&
+		strings.Contains(resp.Header.Get("X-Amzn-ErrorType"), "ResourceNotFoundException") {
+		return "", ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", statusError(resp)
+	}
+
+	var secret awsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("error decoding response: %w", err)
+	}
+
+	switch {
+	case secret.SecretString != nil:
+		return *secret.SecretString, nil
+	case secret.SecretBinary != nil:
+		b, err := base64.StdEncoding.DecodeString(*secret.SecretBinary)
+		if err != nil {
+			return "", fmt.Errorf("error decoding secret binary: %w", err)
+		}
+		return string(b), nil
+	default:
+		return "", ErrNotFound
+	}
+}
@@ -0,0 +1,99 @@
+package secrets_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/secrets"
+)
+
+func TestAWSGet(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		secretID    string
+		status      int
+		errorType   string
+		response    string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:        "secret string",
+			secretID:    "hasura-auth",
+			status:      http.StatusOK,
+			errorType:   "",
+			response:    `{"Name":"hasura-auth","SecretString":"{\"jwt-secret\":\"abc\"}"}`,
+			expected:    `{"jwt-secret":"abc"}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "secret binary",
+			secretID:    "arn:aws:secretsmanager:eu-west-1:123456789012:secret:hasura-auth-AbCdEf",
+			status:      http.StatusOK,
+			errorType:   "",
+			response:    `{"Name":"hasura-auth","SecretBinary":"czNjcjN0"}`,
+			expected:    "s3cr3t",
+			expectedErr: nil,
+		},
+		{
+			name:        "not found",
+			secretID:    "missing",
+			status:      http.StatusBadRequest,
+			errorType:   "ResourceNotFoundException",
+			response:    `{"__type":"ResourceNotFoundException","Message":"Secrets Manager can't find the specified secret."}`, //nolint:lll
+			expected:    "",
+			expectedErr: secrets.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					b, _ := io.ReadAll(r.Body)
+					if string(b) != `{"SecretId":"`+tc.secretID+`"}` {
+						t.Errorf("unexpected request: %s", b)
+					}
+					if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" {
+						t.Errorf("unexpected target: %s", r.Header.Get("X-Amz-Target"))
+					}
+					if r.Header.Get("X-Amz-Security-Token") != "session-token" {
+						t.Errorf("missing session token")
+					}
+					if !strings.Contains(
+						r.Header.Get("Authorization"), "x-amz-security-token;x-amz-target",
+					) {
+						t.Errorf("unexpected authorization: %s", r.Header.Get("Authorization"))
+					}
+
+					if tc.errorType != "" {
+						w.Header().Set("X-Amzn-ErrorType", tc.errorType)
+					}
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			aws := secrets.NewAWS("us-east-1", "AKIDEXAMPLE", "secret", "session-token")
+			aws.SetURL(server.URL)
+
+			got, err := aws.Get(context.Background(), tc.secretID)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package secrets
+
+import "time"
+
+func (a *AWS) SetURL(url string) {
+	a.url = func(string) string { return url }
+}
+
+func (a *AWS) SetNow(now func() time.Time) {
+	a.now = now
+}
+
+func (g *GCP) SetURLs(url string, metadataURL string) {
+	g.url = url
+	g.metadataURL = metadataURL
+}
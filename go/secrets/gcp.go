@@ -0,0 +1,137 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	gcpURL         = "https://secretmanager.googleapis.com/v1/"
+	gcpMetadataURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	// gcpTokenMargin is how long before it expires an access token from the metadata server
+	// is renewed.
+	gcpTokenMargin = time.Minute
+)
+
+// GCP reads secrets from GCP Secret Manager. Requests are authorized with the access token
+// it is given or, if it is empty, with the ones of the service account of the instance
+// returned by the metadata server.
+type GCP struct {
+	url         string
+	metadataURL string
+	accessToken string
+	cl          *http.Client
+	now         func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewGCP(accessToken string) *GCP {
+	return &GCP{ //nolint:exhaustruct
+		url:         gcpURL,
+		metadataURL: gcpMetadataURL,
+		accessToken: accessToken,
+		cl:          &http.Client{Timeout: 10 * time.Second}, //nolint:exhaustruct,mnd
+		now:         time.Now,
+	}
+}
+
+type gcpToken struct {
+	AccessToken string `json:"access_token"` //nolint:tagliatelle
+	ExpiresIn   int    `json:"expires_in"`   //nolint:tagliatelle
+}
+
+func (g *GCP) getToken(ctx context.Context) (string, error) {
+	if g.accessToken != "" {
+		return g.accessToken, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.token != "" && g.now().Before(g.expiresAt) {
+		return g.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.metadataURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := g.cl.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error getting access token from the metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error getting access token: %w", statusError(resp))
+	}
+
+	var token gcpToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("error decoding access token: %w", err)
+	}
+
+	g.token = token.AccessToken
+	g.expiresAt = g.now().Add(time.Duration(token.ExpiresIn)*time.Second - gcpTokenMargin)
+
+	return g.token, nil
+}
+
+type gcpResponse struct {
+	Payload struct {
+		Data string `json:"data"`
+	} `json:"payload"`
+}
+
+// Get accesses the version of the secret, name is its resource name, i.e.
+// projects/acme/secrets/hasura-auth/versions/3. The latest version is used if name
+// doesn't have one.
+func (g *GCP) Get(ctx context.Context, name string) (string, error) {
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+
+	token, err := g.getToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.url+name+":access", nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := g.cl.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", statusError(resp)
+	}
+
+	var secret gcpResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("error decoding response: %w", err)
+	}
+
+	b, err := base64.StdEncoding.DecodeString(secret.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("error decoding secret payload: %w", err)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,84 @@
+package secrets_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/secrets"
+)
+
+func TestGCPGet(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		accessToken   string
+		secret        string
+		expectedPath  string
+		expectedToken string
+	}{
+		{
+			name:          "metadata server token",
+			accessToken:   "",
+			secret:        "projects/acme/secrets/hasura-auth",
+			expectedPath:  "/projects/acme/secrets/hasura-auth/versions/latest:access",
+			expectedToken: "Bearer ya29.metadata",
+		},
+		{
+			name:          "access token and version",
+			accessToken:   "ya29.static",
+			secret:        "projects/acme/secrets/hasura-auth/versions/3",
+			expectedPath:  "/projects/acme/secrets/hasura-auth/versions/3:access",
+			expectedToken: "Bearer ya29.static",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+				tokens++
+				if r.Header.Get("Metadata-Flavor") != "Google" {
+					t.Error("missing metadata flavor header")
+				}
+				_, _ = w.Write([]byte(`{"access_token":"ya29.metadata","expires_in":3599}`))
+			})
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tc.expectedPath {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				if r.Header.Get("Authorization") != tc.expectedToken {
+					t.Errorf("unexpected authorization: %s", r.Header.Get("Authorization"))
+				}
+				_, _ = w.Write([]byte(`{"name":"hasura-auth","payload":{"data":"czNjcjN0"}}`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			gcp := secrets.NewGCP(tc.accessToken)
+			gcp.SetURLs(server.URL+"/", server.URL+"/token")
+
+			for range 2 {
+				got, err := gcp.Get(context.Background(), tc.secret)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got != "s3cr3t" {
+					t.Errorf("unexpected secret: %q", got)
+				}
+			}
+
+			if tc.accessToken == "" && tokens != 1 {
+				t.Errorf("expected the token to be requested once, got %d", tokens)
+			}
+			if tc.accessToken != "" && tokens != 0 {
+				t.Errorf("expected the metadata server not to be called, got %d", tokens)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Package secrets resolves references to secrets stored in HashiCorp Vault, AWS Secrets
+// Manager or GCP Secret Manager, so configuration values like signing keys and client
+// secrets don't need to be set in the environment.
+//
+// References are made of the provider, the name of the secret and, optionally, the key of
+// the value when the secret is a JSON object:
+//
+//	vault:secret/data/hasura-auth#smtp-password
+//	aws-secretsmanager:prod/hasura-auth#jwt-secret
+//	gcp-secretmanager:projects/acme/secrets/github-client-secret/versions/latest
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Providers of the references.
+const (
+	ProviderVault = "vault"
+	ProviderAWS   = "aws-secretsmanager"
+	ProviderGCP   = "gcp-secretmanager"
+)
+
+var (
+	ErrInvalidReference = errors.New("invalid secret reference")
+	ErrNotFound         = errors.New("secret not found")
+)
+
+// Provider returns the value of the secret with the name.
+type Provider interface {
+	Get(ctx context.Context, name string) (string, error)
+}
+
+// IsReference reports if the value is a reference to a secret of one of the providers.
+func IsReference(value string) bool {
+	provider, _, ok := strings.Cut(value, ":")
+	if !ok {
+		return false
+	}
+
+	switch provider {
+	case ProviderVault, ProviderAWS, ProviderGCP:
+		return true
+	default:
+		return false
+	}
+}
+
+// Resolver resolves references with the providers that are configured.
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// NewResolver returns a resolver for the references of the providers, keyed by their
+// name, i.e. ProviderVault.
+func NewResolver(providers map[string]Provider) *Resolver {
+	return &Resolver{
+		providers: providers,
+	}
+}
+
+func parseReference(ref string) (string, string, string, error) {
+	provider, rest, ok := strings.Cut(ref, ":")
+	if !ok || !IsReference(ref) {
+		return "", "", "", fmt.Errorf("%w: unknown provider in %q", ErrInvalidReference, ref)
+	}
+
+	name, key, _ := strings.Cut(rest, "#")
+	if name == "" {
+		return "", "", "", fmt.Errorf("%w: %q has no secret name", ErrInvalidReference, ref)
+	}
+
+	return provider, name, key, nil
+}
+
+// field returns the value of key in the JSON object secret. Values that aren't strings are
+// returned as JSON.
+func field(secret string, key string) (string, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(secret), &object); err != nil {
+		return "", fmt.Errorf("%w: the secret isn't a JSON object", ErrInvalidReference)
+	}
+
+	raw, ok := object[key]
+	if !ok {
+		return "", fmt.Errorf("%w: key %s", ErrNotFound, key)
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+
+	return string(raw), nil
+}
+
+// Resolve returns the value ref points to.
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	providerName, name, key, err := parseReference(ref)
+	if err != nil {
+		return "", err
+	}
+
+	provider, ok := r.providers[providerName]
+	if !ok {
+		return "", fmt.Errorf("%w: %s isn't configured", ErrInvalidReference, providerName)
+	}
+
+	secret, err := provider.Get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("error getting %s secret %s: %w", providerName, name, err)
+	}
+
+	if key == "" {
+		return secret, nil
+	}
+
+	return field(secret, key)
+}
+
+// ResolveAll returns the values the references point to, keyed like refs. Secrets
+// referenced more than once are only fetched once.
+func (r *Resolver) ResolveAll(
+	ctx context.Context, refs map[string]string,
+) (map[string]string, error) {
+	fetched := make(map[string]string)
+	cached := NewResolver(make(map[string]Provider, len(r.providers)))
+	for name, provider := range r.providers {
+		cached.providers[name] = cachedProvider{provider: provider, name: name, fetched: fetched}
+	}
+
+	values := make(map[string]string, len(refs))
+	for k, ref := range refs {
+		value, err := cached.Resolve(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		values[k] = value
+	}
+
+	return values, nil
+}
+
+type cachedProvider struct {
+	provider Provider
+	name     string
+	fetched  map[string]string
+}
+
+func (p cachedProvider) Get(ctx context.Context, name string) (string, error) {
+	k := p.name + ":" + name
+	if secret, ok := p.fetched[k]; ok {
+		return secret, nil
+	}
+
+	secret, err := p.provider.Get(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	p.fetched[k] = secret
+
+	return secret, nil
+}
+
+func statusError(resp *http.Response) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return fmt.Errorf("unexpected status code: %d", resp.StatusCode) //nolint:goerr113
+}
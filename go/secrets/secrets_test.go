@@ -0,0 +1,178 @@
+package secrets_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/secrets"
+)
+
+type fakeProvider struct {
+	secrets map[string]string
+	calls   int
+}
+
+func (f *fakeProvider) Get(_ context.Context, name string) (string, error) {
+	f.calls++
+	secret, ok := f.secrets[name]
+	if !ok {
+		return "", secrets.ErrNotFound
+	}
+	return secret, nil
+}
+
+func TestIsReference(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "vault:secret/data/hasura-auth#key", expected: true},
+		{value: "aws-secretsmanager:hasura-auth", expected: true},
+		{value: "gcp-secretmanager:projects/acme/secrets/hasura-auth", expected: true},
+		{value: "https://acme.com", expected: false},
+		{value: "secret", expected: false},
+		{value: "", expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := secrets.IsReference(tc.value); got != tc.expected {
+			t.Errorf("IsReference(%q) = %t, expected %t", tc.value, got, tc.expected)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	resolver := secrets.NewResolver(map[string]secrets.Provider{
+		secrets.ProviderVault: &fakeProvider{
+			secrets: map[string]string{
+				"secret/data/hasura-auth": `{"smtp-password":"p4ssw0rd","jwt":{"type":"HS256","key":"abc"}}`,
+			},
+			calls: 0,
+		},
+		secrets.ProviderAWS: &fakeProvider{
+			secrets: map[string]string{"github-client-secret": "s3cr3t"},
+			calls:   0,
+		},
+	})
+
+	cases := []struct {
+		name        string
+		ref         string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:        "whole secret",
+			ref:         "aws-secretsmanager:github-client-secret",
+			expected:    "s3cr3t",
+			expectedErr: nil,
+		},
+		{
+			name:        "string key",
+			ref:         "vault:secret/data/hasura-auth#smtp-password",
+			expected:    "p4ssw0rd",
+			expectedErr: nil,
+		},
+		{
+			name:        "object key",
+			ref:         "vault:secret/data/hasura-auth#jwt",
+			expected:    `{"type":"HS256","key":"abc"}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "missing key",
+			ref:         "vault:secret/data/hasura-auth#missing",
+			expected:    "",
+			expectedErr: secrets.ErrNotFound,
+		},
+		{
+			name:        "key of a secret that isn't an object",
+			ref:         "aws-secretsmanager:github-client-secret#key",
+			expected:    "",
+			expectedErr: secrets.ErrInvalidReference,
+		},
+		{
+			name:        "missing secret",
+			ref:         "aws-secretsmanager:missing",
+			expected:    "",
+			expectedErr: secrets.ErrNotFound,
+		},
+		{
+			name:        "provider not configured",
+			ref:         "gcp-secretmanager:projects/acme/secrets/hasura-auth",
+			expected:    "",
+			expectedErr: secrets.ErrInvalidReference,
+		},
+		{
+			name:        "no name",
+			ref:         "vault:#key",
+			expected:    "",
+			expectedErr: secrets.ErrInvalidReference,
+		},
+		{
+			name:        "not a reference",
+			ref:         "s3cr3t",
+			expected:    "",
+			expectedErr: secrets.ErrInvalidReference,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolver.Resolve(context.Background(), tc.ref)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveAll(t *testing.T) {
+	t.Parallel()
+
+	vault := &fakeProvider{
+		secrets: map[string]string{
+			"secret/data/smtp": `{"user":"postmaster","password":"p4ssw0rd"}`,
+		},
+		calls: 0,
+	}
+	resolver := secrets.NewResolver(map[string]secrets.Provider{
+		secrets.ProviderVault: vault,
+	})
+
+	got, err := resolver.ResolveAll(context.Background(), map[string]string{
+		"smtp-user":     "vault:secret/data/smtp#user",
+		"smtp-password": "vault:secret/data/smtp#password",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"smtp-user":     "postmaster",
+		"smtp-password": "p4ssw0rd",
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected values (-want +got):\n%s", diff)
+	}
+	if vault.calls != 1 {
+		t.Errorf("expected the secret to be fetched once, got %d", vault.calls)
+	}
+
+	if _, err := resolver.ResolveAll(context.Background(), map[string]string{
+		"smtp-host": "vault:secret/data/smtp-host",
+	}); !errors.Is(err, secrets.ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Vault reads secrets from HashiCorp Vault with a token.
+type Vault struct {
+	addr      string
+	token     string
+	namespace string
+	cl        *http.Client
+}
+
+func NewVault(addr string, token string, namespace string) *Vault {
+	return &Vault{
+		addr:      strings.TrimSuffix(addr, "/"),
+		token:     token,
+		namespace: namespace,
+		cl:        &http.Client{Timeout: 10 * time.Second}, //nolint:exhaustruct,mnd
+	}
+}
+
+type vaultResponse struct {
+	Data map[string]json.RawMessage `json:"data"`
+}
+
+// Get reads the secret at the path name and returns its data as a JSON object. With the
+// KV version 2 engine the path includes data, i.e. secret/data/hasura-auth for the
+// hasura-auth secret of the engine mounted at secret.
+func (v *Vault) Get(ctx context.Context, name string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, v.addr+"/v1/"+strings.TrimPrefix(name, "/"), nil,
+	)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.cl.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", statusError(resp)
+	}
+
+	var secret vaultResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("error decoding response: %w", err)
+	}
+
+	// KV version 2 returns the data of the secret along with its metadata
+	data, ok := secret.Data["data"]
+	if _, hasMetadata := secret.Data["metadata"]; ok && hasMetadata {
+		return string(data), nil
+	}
+
+	b, err := json.Marshal(secret.Data)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling secret: %w", err)
+	}
+
+	return string(b), nil
+}
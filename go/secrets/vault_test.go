@@ -0,0 +1,78 @@
+package secrets_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/secrets"
+)
+
+func TestVaultGet(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:        "kv version 2",
+			status:      http.StatusOK,
+			response:    `{"data":{"data":{"password":"p4ssw0rd"},"metadata":{"version":3}}}`,
+			expected:    `{"password":"p4ssw0rd"}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "kv version 1",
+			status:      http.StatusOK,
+			response:    `{"lease_duration":2764800,"data":{"password":"p4ssw0rd"}}`,
+			expected:    `{"password":"p4ssw0rd"}`,
+			expectedErr: nil,
+		},
+		{
+			name:        "not found",
+			status:      http.StatusNotFound,
+			response:    `{"errors":[]}`,
+			expected:    "",
+			expectedErr: secrets.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/v1/secret/data/hasura-auth" {
+						t.Errorf("unexpected path: %s", r.URL.Path)
+					}
+					if r.Header.Get("X-Vault-Token") != "hvs.token" {
+						t.Errorf("unexpected token: %s", r.Header.Get("X-Vault-Token"))
+					}
+					if r.Header.Get("X-Vault-Namespace") != "acme" {
+						t.Errorf("unexpected namespace: %s", r.Header.Get("X-Vault-Namespace"))
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			vault := secrets.NewVault(server.URL+"/", "hvs.token", "acme")
+			got, err := vault.Get(context.Background(), "secret/data/hasura-auth")
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package sessionstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/redis/go-redis/v9"
+)
+
+// one time passwords are hashes with the hash of the password, when it expires, what it
+// was sent for and the failed attempts at guessing it, like the otp_* columns of
+// auth.users. A user has at most one at a time and setting a new one voids the previous.
+const (
+	fieldOTPHash      = "hash"
+	fieldOTPExpiresAt = "expiresAt"
+	fieldOTPMethod    = "method"
+	fieldOTPAttempts  = "attempts"
+)
+
+// consumeOTPScript deletes the one time password if its hash is still ARGV[1].
+var consumeOTPScript = redis.NewScript(` //nolint:gochecknoglobals
+if redis.call("HGET", KEYS[1], "hash") == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// otpFailedAttemptScript counts a failed attempt at guessing the one time password if its
+// hash is still ARGV[1] and voids it after ARGV[2] attempts. It returns the attempts, or
+// nil if there is no such one time password.
+var otpFailedAttemptScript = redis.NewScript(` //nolint:gochecknoglobals
+if redis.call("HGET", KEYS[1], "hash") ~= ARGV[1] then
+	return false
+end
+local attempts = redis.call("HINCRBY", KEYS[1], "attempts", 1)
+if attempts >= tonumber(ARGV[2]) then
+	redis.call("HDEL", KEYS[1], "hash")
+end
+return attempts
+`)
+
+func userOTPKey(userID uuid.UUID) string {
+	return redisKeyPrefix + "user-otp:" + userID.String()
+}
+
+func (r *Redis) setOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	otpHash pgtype.Text,
+	expiresAt pgtype.Timestamptz,
+	method pgtype.Text,
+) error {
+	key := userOTPKey(userID)
+	ttl := time.Until(expiresAt.Time)
+	if _, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if !otpHash.Valid || ttl <= 0 {
+			return nil
+		}
+
+		pipe.HSet(ctx, key,
+			fieldOTPHash, otpHash.String,
+			fieldOTPExpiresAt, expiresAt.Time.Format(time.RFC3339Nano),
+			fieldOTPMethod, method.String,
+			fieldOTPAttempts, 0,
+		)
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error setting otp: %w", err)
+	}
+
+	return nil
+}
+
+// withOTP sets the one time password of the user if it is in Redis. Users with one in
+// PostgreSQL, set before switching to Redis, are returned as they are.
+func (r *Redis) withOTP(ctx context.Context, user sql.AuthUser) (sql.AuthUser, error) {
+	fields, err := r.client.HGetAll(ctx, userOTPKey(user.ID)).Result()
+	if err != nil {
+		return sql.AuthUser{}, fmt.Errorf("error getting otp: %w", err) //nolint:exhaustruct
+	}
+	if len(fields) == 0 {
+		return user, nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339Nano, fields[fieldOTPExpiresAt])
+	if err != nil {
+		return sql.AuthUser{}, fmt.Errorf("error decoding otp: %w", err) //nolint:exhaustruct
+	}
+
+	attempts, err := strconv.ParseInt(fields[fieldOTPAttempts], 10, 32)
+	if err != nil {
+		return sql.AuthUser{}, fmt.Errorf("error decoding otp: %w", err) //nolint:exhaustruct
+	}
+
+	hash, ok := fields[fieldOTPHash]
+	user.OtpHash = pgtype.Text{String: hash, Valid: ok}
+	user.OtpHashExpiresAt = sql.TimestampTz(expiresAt)
+	user.OtpMethodLastUsed = sql.Text(fields[fieldOTPMethod])
+	user.OtpHashFailedAttempts = int32(attempts)
+
+	return user, nil
+}
+
+// consumeOTP deletes the one time password of the user and returns if it was in Redis.
+func (r *Redis) consumeOTP(ctx context.Context, userID uuid.UUID, otpHash string) (bool, error) {
+	n, err := consumeOTPScript.Run(ctx, r.client, []string{userOTPKey(userID)}, otpHash).Int()
+	if err != nil {
+		return false, fmt.Errorf("error consuming otp: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *Redis) GetUser(ctx context.Context, id uuid.UUID) (sql.AuthUser, error) {
+	user, err := r.DBClient.GetUser(ctx, id)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct,wrapcheck
+	}
+	return r.withOTP(ctx, user)
+}
+
+func (r *Redis) GetUserByPhoneNumber(
+	ctx context.Context, phoneNumber pgtype.Text,
+) (sql.AuthUser, error) {
+	user, err := r.DBClient.GetUserByPhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct,wrapcheck
+	}
+	return r.withOTP(ctx, user)
+}
+
+func (r *Redis) UpdateUserOTPHash(
+	ctx context.Context, arg sql.UpdateUserOTPHashParams,
+) (uuid.UUID, error) {
+	if err := r.setOTP(
+		ctx, arg.ID, arg.OtpHash, arg.OtpHashExpiresAt, arg.OtpMethodLastUsed,
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+	return arg.ID, nil
+}
+
+func (r *Redis) UpdateUserOTPHashFailedAttempts(
+	ctx context.Context, arg sql.UpdateUserOTPHashFailedAttemptsParams,
+) (int32, error) {
+	attempts, err := otpFailedAttemptScript.Run(
+		ctx, r.client, []string{userOTPKey(arg.ID)}, arg.OtpHash.String, arg.MaxAttempts,
+	).Int()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return r.DBClient.UpdateUserOTPHashFailedAttempts(ctx, arg) //nolint:wrapcheck
+	case err != nil:
+		return 0, fmt.Errorf("error recording otp failure: %w", err)
+	}
+
+	return int32(attempts), nil //nolint:gosec
+}
+
+func (r *Redis) UpdateUserConsumeOTP(
+	ctx context.Context, arg sql.UpdateUserConsumeOTPParams,
+) (sql.AuthUser, error) {
+	ok, err := r.consumeOTP(ctx, arg.ID, arg.OtpHash.String)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct
+	}
+	if !ok {
+		return r.DBClient.UpdateUserConsumeOTP(ctx, arg) //nolint:wrapcheck
+	}
+
+	user, err := r.DBClient.UpdateUserVerifyPhoneNumber(ctx, arg.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return sql.AuthUser{}, pgx.ErrNoRows //nolint:exhaustruct
+	}
+	return user, err //nolint:wrapcheck
+}
+
+func (r *Redis) UpdateUserChangePhoneNumber(
+	ctx context.Context, arg sql.UpdateUserChangePhoneNumberParams,
+) error {
+	otpHash, expiresAt := arg.OtpHash, arg.OtpHashExpiresAt
+	arg.OtpHash = pgtype.Text{} //nolint:exhaustruct
+
+	if err := r.DBClient.UpdateUserChangePhoneNumber(ctx, arg); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.setOTP(ctx, arg.ID, otpHash, expiresAt, arg.OtpMethodLastUsed)
+}
+
+func (r *Redis) UpdateUserConfirmChangePhoneNumber(
+	ctx context.Context, arg sql.UpdateUserConfirmChangePhoneNumberParams,
+) (sql.AuthUser, error) {
+	ok, err := r.consumeOTP(ctx, arg.ID, arg.OtpHash.String)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct
+	}
+	if !ok {
+		return r.DBClient.UpdateUserConfirmChangePhoneNumber(ctx, arg) //nolint:wrapcheck
+	}
+
+	return r.DBClient.UpdateUserConfirmNewPhoneNumber(ctx, arg.ID) //nolint:wrapcheck
+}
@@ -0,0 +1,271 @@
+package sessionstore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sessionstore"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func setOTP(
+	ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID, otpHash string,
+	expiresIn time.Duration,
+) {
+	t.Helper()
+
+	if _, err := r.UpdateUserOTPHash(ctx, sql.UpdateUserOTPHashParams{
+		ID:                userID,
+		OtpHash:           sql.Text(otpHash),
+		OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(expiresIn)),
+		OtpMethodLastUsed: sql.Text("sms"),
+	}); err != nil {
+		t.Fatalf("error setting otp: %v", err)
+	}
+}
+
+func consumeOTP(
+	ctx context.Context, r *sessionstore.Redis, userID uuid.UUID, otpHash string,
+) (sql.AuthUser, error) {
+	return r.UpdateUserConsumeOTP(ctx, sql.UpdateUserConsumeOTPParams{ //nolint:wrapcheck
+		ID:      userID,
+		OtpHash: sql.Text(otpHash),
+	})
+}
+
+func TestOTPs(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		db   func(mock *mock.MockDBClient, userID uuid.UUID)
+		run  func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID)
+	}{
+		{
+			name: "sign in",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().GetUserByPhoneNumber(gomock.Any(), sql.Text("+11111111111")).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().UpdateUserVerifyPhoneNumber(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID, PhoneNumberVerified: true}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().UpdateUserConsumeOTP(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				otpHash := uuid.NewString()
+				setOTP(ctx, t, r, userID, otpHash, time.Minute)
+
+				user, err := r.GetUserByPhoneNumber(ctx, sql.Text("+11111111111"))
+				if err != nil {
+					t.Fatalf("error getting user: %v", err)
+				}
+				if user.OtpHash.String != otpHash || user.OtpMethodLastUsed.String != "sms" ||
+					user.OtpHashFailedAttempts != 0 ||
+					time.Until(user.OtpHashExpiresAt.Time) > time.Minute ||
+					time.Until(user.OtpHashExpiresAt.Time) <= 0 {
+					t.Errorf("unexpected otp: %v, %v, %v, %v", user.OtpHash, user.OtpMethodLastUsed,
+						user.OtpHashFailedAttempts, user.OtpHashExpiresAt)
+				}
+
+				if user, err := consumeOTP(ctx, r, userID, otpHash); err != nil ||
+					!user.PhoneNumberVerified {
+					t.Fatalf("unexpected user: %v, %v", user.PhoneNumberVerified, err)
+				}
+
+				if _, err := consumeOTP(ctx, r, userID, otpHash); !errors.Is(err, pgx.ErrNoRows) {
+					t.Errorf("otp shouldn't be consumed twice: %v", err)
+				}
+
+				if user, err := r.GetUser(ctx, userID); err != nil || user.OtpHash.Valid {
+					t.Errorf("consumed otp shouldn't be found: %v, %v", user.OtpHash, err)
+				}
+			},
+		},
+		{
+			name: "failed attempts void it",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().UpdateUserConsumeOTP(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+				mock.EXPECT().UpdateUserOTPHashFailedAttempts(gomock.Any(), gomock.Any()).Return(
+					int32(0), pgx.ErrNoRows,
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				otpHash := uuid.NewString()
+				setOTP(ctx, t, r, userID, otpHash, time.Minute)
+
+				arg := sql.UpdateUserOTPHashFailedAttemptsParams{
+					MaxAttempts: 3,
+					ID:          userID,
+					OtpHash:     sql.Text(otpHash),
+				}
+				for want := int32(1); want <= 3; want++ {
+					attempts, err := r.UpdateUserOTPHashFailedAttempts(ctx, arg)
+					if err != nil {
+						t.Fatalf("error recording failed attempt: %v", err)
+					}
+					if attempts != want {
+						t.Errorf("unexpected attempts: %d, want %d", attempts, want)
+					}
+				}
+
+				user, err := r.GetUser(ctx, userID)
+				if err != nil {
+					t.Fatalf("error getting user: %v", err)
+				}
+				if user.OtpHash.Valid || user.OtpHashFailedAttempts != 3 {
+					t.Errorf("unexpected otp: %v, %v", user.OtpHash, user.OtpHashFailedAttempts)
+				}
+
+				if _, err := consumeOTP(ctx, r, userID, otpHash); !errors.Is(err, pgx.ErrNoRows) {
+					t.Errorf("voided otp shouldn't be consumed: %v", err)
+				}
+
+				if _, err := r.UpdateUserOTPHashFailedAttempts(ctx, arg); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("voided otp shouldn't count failed attempts: %v", err)
+				}
+			},
+		},
+		{
+			name: "a new otp voids the previous",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().UpdateUserConsumeOTP(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+				mock.EXPECT().UpdateUserVerifyPhoneNumber(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				previous, otpHash := uuid.NewString(), uuid.NewString()
+				setOTP(ctx, t, r, userID, previous, time.Minute)
+				setOTP(ctx, t, r, userID, otpHash, time.Minute)
+
+				if _, err := consumeOTP(ctx, r, userID, previous); !errors.Is(err, pgx.ErrNoRows) {
+					t.Errorf("previous otp should've been voided: %v", err)
+				}
+
+				if user, err := consumeOTP(ctx, r, userID, otpHash); err != nil || user.ID != userID {
+					t.Errorf("unexpected user: %v, %v", user.ID, err)
+				}
+			},
+		},
+		{
+			name: "expired",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				).Times(2)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				setOTP(ctx, t, r, userID, uuid.NewString(), -time.Second)
+
+				if user, err := r.GetUser(ctx, userID); err != nil || user.OtpHash.Valid {
+					t.Errorf("expired otp shouldn't be stored: %v, %v", user.OtpHash, err)
+				}
+
+				setOTP(ctx, t, r, userID, uuid.NewString(), 100*time.Millisecond)
+
+				time.Sleep(200 * time.Millisecond)
+
+				if user, err := r.GetUser(ctx, userID); err != nil || user.OtpHash.Valid {
+					t.Errorf("otp should've expired: %v, %v", user.OtpHash, err)
+				}
+			},
+		},
+		{
+			name: "change phone number",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().UpdateUserChangePhoneNumber(
+					gomock.Any(),
+					testhelpers.GomockCmpOpts(
+						sql.UpdateUserChangePhoneNumberParams{ //nolint:exhaustruct
+							ID:                userID,
+							OtpHash:           pgtype.Text{}, //nolint:exhaustruct
+							OtpMethodLastUsed: sql.Text("sms"),
+							NewPhoneNumber:    sql.Text("+22222222222"),
+						},
+						cmpopts.IgnoreFields(
+							sql.UpdateUserChangePhoneNumberParams{}, //nolint:exhaustruct
+							"OtpHashExpiresAt",
+						),
+					),
+				).Return(nil)
+				mock.EXPECT().UpdateUserConfirmNewPhoneNumber(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID, PhoneNumber: sql.Text("+22222222222")}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				otpHash := uuid.NewString()
+				if err := r.UpdateUserChangePhoneNumber(ctx, sql.UpdateUserChangePhoneNumberParams{
+					ID:                userID,
+					OtpHash:           sql.Text(otpHash),
+					OtpHashExpiresAt:  sql.TimestampTz(time.Now().Add(time.Minute)),
+					OtpMethodLastUsed: sql.Text("sms"),
+					NewPhoneNumber:    sql.Text("+22222222222"),
+				}); err != nil {
+					t.Fatalf("error changing phone number: %v", err)
+				}
+
+				user, err := r.UpdateUserConfirmChangePhoneNumber(
+					ctx,
+					sql.UpdateUserConfirmChangePhoneNumberParams{
+						ID:      userID,
+						OtpHash: sql.Text(otpHash),
+					},
+				)
+				if err != nil {
+					t.Fatalf("error confirming phone number: %v", err)
+				}
+				if user.PhoneNumber.String != "+22222222222" {
+					t.Errorf("unexpected phone number: %v", user.PhoneNumber)
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			userID := uuid.New()
+
+			db := mock.NewMockDBClient(ctrl)
+			tc.db(db, userID)
+
+			tc.run(context.Background(), t, newRedis(t, db), userID)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Package sessionstore keeps the short lived rows of the sessions, the refresh tokens, the
+// tickets and the one time passwords, out of PostgreSQL so signing in and refreshing tokens
+// don't write to the database. Users, and everything else, stay in PostgreSQL.
+package sessionstore
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "hasura-auth:"
+
+// Redis keeps the refresh tokens, the tickets and the one time passwords in Redis, where
+// they expire on their own, and everything else in the DBClient it wraps. Rows written to
+// PostgreSQL before, or by the Node.js server, are still read from it so nothing is lost
+// when switching to Redis: refresh tokens are moved to Redis the first time they are rotated and tickets and one
+// time passwords are used up from wherever they are.
+//
+// Redis isn't part of the transactions of the DBClient, writes to it are kept even if the
+// transaction they were made in is rolled back. At worst, a ticket or a refresh token is
+// left behind for a user that doesn't exist and can't be used.
+type Redis struct {
+	controller.DBClient
+
+	client redis.UniversalClient
+}
+
+func NewRedis(db controller.DBClient, client redis.UniversalClient) *Redis {
+	return &Redis{
+		DBClient: db,
+		client:   client,
+	}
+}
+
+// InTx runs fn in a transaction if the wrapped client supports them.
+func (r *Redis) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if t, ok := r.DBClient.(sql.Transactor); ok {
+		return t.InTx(ctx, fn) //nolint:wrapcheck
+	}
+	return fn(ctx)
+}
+
+// hashRefreshToken hashes refresh tokens in the same way as the controller.
+func hashRefreshToken(refreshToken string) string {
+	hash := sha256.Sum256([]byte(refreshToken))
+	return "\\x" + hex.EncodeToString(hash[:])
+}
+
+// deviceFingerprint is the same as the device_fingerprint column of auth.refresh_tokens.
+func deviceFingerprint(ip string, userAgent string) string {
+	hash := md5.Sum([]byte(ip + " " + userAgent)) //nolint:gosec
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,25 @@
+package sessionstore_test
+
+import (
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/controller"
+	"github.com/nhost/hasura-auth/go/sessionstore"
+	"github.com/redis/go-redis/v9"
+)
+
+// newRedis returns the session store with the Redis of the development environment. The
+// keys of the tests are unique to them, the database is never flushed.
+func newRedis(t *testing.T, db controller.DBClient) *sessionstore.Redis {
+	t.Helper()
+
+	client := redis.NewClient(&redis.Options{ //nolint:exhaustruct
+		Addr: "localhost:6379",
+		DB:   1,
+	})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return sessionstore.NewRedis(db, client)
+}
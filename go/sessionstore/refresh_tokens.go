@@ -0,0 +1,708 @@
+package sessionstore
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/redis/go-redis/v9"
+)
+
+// refresh tokens are hashes with the immutable fields of the token, as JSON, in
+// fieldToken and the time the token was rotated, once it is, in fieldRotatedAt.
+const (
+	fieldToken     = "token"
+	fieldRotatedAt = "rotatedAt"
+)
+
+// insertRefreshTokenScript stores the refresh token and adds it to the sets of refresh
+// tokens of its user and of its family, which are kept until their last token expires.
+var insertRefreshTokenScript = redis.NewScript(` //nolint:gochecknoglobals
+redis.call("HSET", KEYS[1], "token", ARGV[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+redis.call("SET", KEYS[2], ARGV[2], "PX", ARGV[3])
+for i = 3, 4 do
+	redis.call("SADD", KEYS[i], ARGV[2])
+	if redis.call("PTTL", KEYS[i]) < tonumber(ARGV[3]) then
+		redis.call("PEXPIRE", KEYS[i], ARGV[3])
+	end
+end
+return 1
+`)
+
+// rotateRefreshTokenScript flags the refresh token as rotated, unless it already is, and
+// returns it with the time it was first rotated, or nil if it doesn't exist.
+var rotateRefreshTokenScript = redis.NewScript(` //nolint:gochecknoglobals
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return false
+end
+redis.call("HSETNX", KEYS[1], "rotatedAt", ARGV[1])
+return redis.call("HMGET", KEYS[1], "token", "rotatedAt")
+`)
+
+type refreshToken struct {
+	ID         uuid.UUID            `json:"id"`
+	Hash       string               `json:"hash"`
+	UserID     uuid.UUID            `json:"userId"`
+	FamilyID   uuid.UUID            `json:"familyId"`
+	Type       sql.RefreshTokenType `json:"type"`
+	Metadata   json.RawMessage      `json:"metadata,omitempty"`
+	CreatedAt  time.Time            `json:"createdAt"`
+	ExpiresAt  time.Time            `json:"expiresAt"`
+	LastUsedAt *time.Time           `json:"lastUsedAt,omitempty"`
+	IPAddress  string               `json:"ipAddress,omitempty"`
+	UserAgent  string               `json:"userAgent,omitempty"`
+	RotatedAt  *time.Time           `json:"-"`
+	// the client of the session, its version and the lifetimes of the tokens of the
+	// session, if it has its own
+	ClientID              string `json:"clientId,omitempty"`
+	ClientVersion         string `json:"clientVersion,omitempty"`
+	RefreshTokenExpiresIn int    `json:"refreshTokenExpiresIn,omitempty"`
+	AccessTokenExpiresIn  int    `json:"accessTokenExpiresIn,omitempty"`
+	// the approximate location the session was last used from, if known
+	Country   string   `json:"country,omitempty"`
+	City      string   `json:"city,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// valid reports if the refresh token can still be used to get a session.
+func (t refreshToken) valid() bool {
+	return t.RotatedAt == nil && time.Now().Before(t.ExpiresAt)
+}
+
+// usable reports if the refresh token can be exchanged for a new one. Tokens rotated less
+// than reuseInterval ago still can, like in PostgreSQL.
+func (t refreshToken) usable(reuseInterval time.Duration) bool {
+	return (t.RotatedAt == nil || time.Since(*t.RotatedAt) < reuseInterval) &&
+		time.Now().Before(t.ExpiresAt)
+}
+
+func (t refreshToken) row() sql.AuthRefreshToken {
+	var metadata []byte
+	if len(t.Metadata) > 0 {
+		metadata = t.Metadata
+	}
+
+	var latitude, longitude pgtype.Float8
+	if t.Latitude != nil && t.Longitude != nil {
+		latitude = pgtype.Float8{Float64: *t.Latitude, Valid: true}
+		longitude = pgtype.Float8{Float64: *t.Longitude, Valid: true}
+	}
+
+	var lastUsedAt, rotatedAt pgtype.Timestamptz
+	if t.LastUsedAt != nil {
+		lastUsedAt = sql.TimestampTz(*t.LastUsedAt)
+	}
+	if t.RotatedAt != nil {
+		rotatedAt = sql.TimestampTz(*t.RotatedAt)
+	}
+
+	return sql.AuthRefreshToken{
+		ID:                    t.ID,
+		CreatedAt:             sql.TimestampTz(t.CreatedAt),
+		ExpiresAt:             sql.TimestampTz(t.ExpiresAt),
+		UserID:                t.UserID,
+		Metadata:              metadata,
+		Type:                  t.Type,
+		RefreshTokenHash:      t.Hash,
+		FamilyID:              t.FamilyID,
+		RotatedAt:             rotatedAt,
+		LastUsedAt:            lastUsedAt,
+		IpAddress:             sql.NullableText(t.IPAddress),
+		UserAgent:             sql.NullableText(t.UserAgent),
+		DeviceFingerprint:     sql.Text(deviceFingerprint(t.IPAddress, t.UserAgent)),
+		ClientID:              sql.NullableText(t.ClientID),
+		ClientVersion:         sql.NullableText(t.ClientVersion),
+		RefreshTokenExpiresIn: sql.NullableInt4(t.RefreshTokenExpiresIn),
+		AccessTokenExpiresIn:  sql.NullableInt4(t.AccessTokenExpiresIn),
+		Country:               sql.NullableText(t.Country),
+		City:                  sql.NullableText(t.City),
+		Latitude:              latitude,
+		Longitude:             longitude,
+	}
+}
+
+func float8Ptr(f pgtype.Float8) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+func refreshTokenKey(hash string) string {
+	return redisKeyPrefix + "refresh-token:" + hash
+}
+
+func refreshTokenIDKey(id uuid.UUID) string {
+	return redisKeyPrefix + "refresh-token-id:" + id.String()
+}
+
+func userRefreshTokensKey(userID uuid.UUID) string {
+	return redisKeyPrefix + "user-refresh-tokens:" + userID.String()
+}
+
+func refreshTokenFamilyKey(familyID uuid.UUID) string {
+	return redisKeyPrefix + "refresh-token-family:" + familyID.String()
+}
+
+func parseRefreshToken(fields map[string]string) (refreshToken, error) {
+	var t refreshToken
+	if err := json.Unmarshal([]byte(fields[fieldToken]), &t); err != nil {
+		return refreshToken{}, fmt.Errorf("error decoding refresh token: %w", err)
+	}
+
+	if s, ok := fields[fieldRotatedAt]; ok {
+		rotatedAt, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return refreshToken{}, fmt.Errorf("error decoding refresh token: %w", err)
+		}
+		t.RotatedAt = &rotatedAt
+	}
+
+	return t, nil
+}
+
+func (r *Redis) insertRefreshToken(ctx context.Context, t refreshToken) error {
+	ttl := time.Until(t.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("error encoding refresh token: %w", err)
+	}
+
+	if err := insertRefreshTokenScript.Run(
+		ctx,
+		r.client,
+		[]string{
+			refreshTokenKey(t.Hash),
+			refreshTokenIDKey(t.ID),
+			userRefreshTokensKey(t.UserID),
+			refreshTokenFamilyKey(t.FamilyID),
+		},
+		string(b), t.Hash, ttl.Milliseconds(),
+	).Err(); err != nil {
+		return fmt.Errorf("error inserting refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// getRefreshTokens returns the refresh tokens of hashes that still exist.
+func (r *Redis) getRefreshTokens(ctx context.Context, hashes []string) ([]refreshToken, error) {
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(hashes))
+	if _, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, hash := range hashes {
+			cmds[i] = pipe.HGetAll(ctx, refreshTokenKey(hash))
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error getting refresh tokens: %w", err)
+	}
+
+	tokens := make([]refreshToken, 0, len(hashes))
+	for _, cmd := range cmds {
+		fields := cmd.Val()
+		if _, ok := fields[fieldToken]; !ok {
+			continue
+		}
+
+		t, err := parseRefreshToken(fields)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+
+	return tokens, nil
+}
+
+// getRefreshToken returns the refresh token of hash and if it exists.
+func (r *Redis) getRefreshToken(ctx context.Context, hash string) (refreshToken, bool, error) {
+	tokens, err := r.getRefreshTokens(ctx, []string{hash})
+	if err != nil || len(tokens) == 0 {
+		return refreshToken{}, false, err
+	}
+	return tokens[0], true, nil
+}
+
+func (r *Redis) getUserRefreshTokens(
+	ctx context.Context, userID uuid.UUID,
+) ([]refreshToken, error) {
+	hashes, err := r.client.SMembers(ctx, userRefreshTokensKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh tokens of user: %w", err)
+	}
+
+	return r.getRefreshTokens(ctx, hashes)
+}
+
+// deleteRefreshTokens deletes the refresh tokens, and the families they belong to if
+// deleteFamilies is set.
+func (r *Redis) deleteRefreshTokens(
+	ctx context.Context, tokens []refreshToken, deleteFamilies bool,
+) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	if _, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, t := range tokens {
+			pipe.Del(ctx, refreshTokenKey(t.Hash), refreshTokenIDKey(t.ID))
+			pipe.SRem(ctx, userRefreshTokensKey(t.UserID), t.Hash)
+			if deleteFamilies {
+				pipe.Del(ctx, refreshTokenFamilyKey(t.FamilyID))
+			} else {
+				pipe.SRem(ctx, refreshTokenFamilyKey(t.FamilyID), t.Hash)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error deleting refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Redis) deleteRefreshTokenFamily(
+	ctx context.Context, familyID uuid.UUID,
+) ([]refreshToken, error) {
+	hashes, err := r.client.SMembers(ctx, refreshTokenFamilyKey(familyID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh token family: %w", err)
+	}
+
+	tokens, err := r.getRefreshTokens(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.deleteRefreshTokens(ctx, tokens, true); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (r *Redis) deleteUserRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	tokens, err := r.getUserRefreshTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.deleteRefreshTokens(ctx, tokens, true); err != nil {
+		return err
+	}
+
+	if err := r.client.Del(ctx, userRefreshTokensKey(userID)).Err(); err != nil {
+		return fmt.Errorf("error deleting refresh tokens of user: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRefreshToken deletes the refresh token, if it is in Redis, when the user signs
+// out of the session.
+func (r *Redis) DeleteRefreshToken(ctx context.Context, token string) error {
+	t, ok, err := r.getRefreshToken(ctx, hashRefreshToken(token))
+	if err != nil || !ok {
+		return err
+	}
+
+	return r.deleteRefreshTokens(ctx, []refreshToken{t}, false)
+}
+
+// DeleteUserRefreshTokens deletes every refresh token of the user in Redis when the user
+// signs out of every session.
+func (r *Redis) DeleteUserRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	return r.deleteUserRefreshTokens(ctx, userID)
+}
+
+func (r *Redis) InsertRefreshtoken(
+	ctx context.Context, arg sql.InsertRefreshtokenParams,
+) (uuid.UUID, error) {
+	id := uuid.New()
+	now := time.Now()
+	if err := r.insertRefreshToken(ctx, refreshToken{
+		ID:         id,
+		Hash:       arg.RefreshTokenHash,
+		UserID:     arg.UserID,
+		FamilyID:   id,
+		Type:       arg.Type,
+		Metadata:   arg.Metadata,
+		CreatedAt:  now,
+		ExpiresAt:  arg.ExpiresAt.Time,
+		LastUsedAt: &now,
+		IPAddress:  arg.IpAddress.String,
+		UserAgent:  arg.UserAgent.String,
+		RotatedAt:  nil,
+
+		ClientID:              arg.ClientID.String,
+		ClientVersion:         arg.ClientVersion.String,
+		RefreshTokenExpiresIn: int(arg.RefreshTokenExpiresIn.Int32),
+		AccessTokenExpiresIn:  int(arg.AccessTokenExpiresIn.Int32),
+
+		Country:   arg.Country.String,
+		City:      arg.City.String,
+		Latitude:  float8Ptr(arg.Latitude),
+		Longitude: float8Ptr(arg.Longitude),
+	}); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+func (r *Redis) GetUserByRefreshTokenHash(
+	ctx context.Context, arg sql.GetUserByRefreshTokenHashParams,
+) (sql.AuthUser, error) {
+	t, ok, err := r.getRefreshToken(ctx, arg.RefreshTokenHash)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct
+	}
+	if !ok {
+		return r.DBClient.GetUserByRefreshTokenHash(ctx, arg) //nolint:wrapcheck
+	}
+
+	if !t.usable(time.Duration(arg.ReuseInterval)*time.Second) || t.Type != arg.Type {
+		return sql.AuthUser{}, pgx.ErrNoRows //nolint:exhaustruct
+	}
+
+	return r.DBClient.GetUser(ctx, t.UserID) //nolint:wrapcheck
+}
+
+func (r *Redis) GetRefreshTokenByHash(
+	ctx context.Context, refreshTokenHash string,
+) (sql.AuthRefreshToken, error) {
+	t, ok, err := r.getRefreshToken(ctx, refreshTokenHash)
+	if err != nil {
+		return sql.AuthRefreshToken{}, err //nolint:exhaustruct
+	}
+	if !ok {
+		return r.DBClient.GetRefreshTokenByHash(ctx, refreshTokenHash) //nolint:wrapcheck
+	}
+
+	if !t.valid() {
+		return sql.AuthRefreshToken{}, pgx.ErrNoRows //nolint:exhaustruct
+	}
+
+	return t.row(), nil
+}
+
+// RotateRefreshTokenAndGetUserRoles flags the refresh token as rotated, keeping it until
+// it expires so its reuse can be detected, and inserts the new one in the same family.
+// Tokens rotated less than arg.ReuseInterval seconds ago can be rotated again. Refresh
+// tokens stored in PostgreSQL are moved to Redis.
+func (r *Redis) RotateRefreshTokenAndGetUserRoles(
+	ctx context.Context, arg sql.RotateRefreshTokenAndGetUserRolesParams,
+) ([]sql.RotateRefreshTokenAndGetUserRolesRow, error) {
+	now := time.Now()
+	fields, err := rotateRefreshTokenScript.Run(
+		ctx, r.client, []string{refreshTokenKey(arg.OldRefreshTokenHash)},
+		now.Format(time.RFC3339Nano),
+	).StringSlice()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return r.moveRefreshToken(ctx, arg)
+	case err != nil:
+		return nil, fmt.Errorf("error rotating refresh token: %w", err)
+	}
+
+	old, err := parseRefreshToken(map[string]string{
+		fieldToken: fields[0], fieldRotatedAt: fields[1],
+	})
+	if err != nil {
+		return nil, err
+	}
+	if now.Sub(*old.RotatedAt) > time.Duration(arg.ReuseInterval)*time.Second {
+		return nil, nil
+	}
+
+	return r.insertRotatedRefreshToken(ctx, old, arg, now)
+}
+
+// moveRefreshToken moves a refresh token stored in PostgreSQL to Redis and rotates it
+// there. Its family is deleted from PostgreSQL first so it can't be moved twice.
+func (r *Redis) moveRefreshToken(
+	ctx context.Context, arg sql.RotateRefreshTokenAndGetUserRolesParams,
+) ([]sql.RotateRefreshTokenAndGetUserRolesRow, error) {
+	old, err := r.DBClient.GetRefreshTokenByHash(ctx, arg.OldRefreshTokenHash)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && old.Type != sql.RefreshTokenTypeRegular) {
+		return r.DBClient.RotateRefreshTokenAndGetUserRoles(ctx, arg) //nolint:wrapcheck
+	}
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	deleted, err := r.DBClient.DeleteUserSession(ctx, sql.DeleteUserSessionParams{
+		ID:     old.ID,
+		UserID: old.UserID,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	if len(deleted) == 0 {
+		// moved by a concurrent request
+		return nil, nil
+	}
+
+	var lastUsedAt *time.Time
+	if old.LastUsedAt.Valid {
+		lastUsedAt = &old.LastUsedAt.Time
+	}
+
+	if err := r.insertRefreshToken(ctx, refreshToken{
+		ID:         old.ID,
+		Hash:       old.RefreshTokenHash,
+		UserID:     old.UserID,
+		FamilyID:   old.FamilyID,
+		Type:       old.Type,
+		Metadata:   old.Metadata,
+		CreatedAt:  old.CreatedAt.Time,
+		ExpiresAt:  old.ExpiresAt.Time,
+		LastUsedAt: lastUsedAt,
+		IPAddress:  old.IpAddress.String,
+		UserAgent:  old.UserAgent.String,
+		RotatedAt:  nil,
+
+		ClientID:              old.ClientID.String,
+		ClientVersion:         old.ClientVersion.String,
+		RefreshTokenExpiresIn: int(old.RefreshTokenExpiresIn.Int32),
+		AccessTokenExpiresIn:  int(old.AccessTokenExpiresIn.Int32),
+
+		Country:   old.Country.String,
+		City:      old.City.String,
+		Latitude:  float8Ptr(old.Latitude),
+		Longitude: float8Ptr(old.Longitude),
+	}); err != nil {
+		return nil, err
+	}
+
+	return r.RotateRefreshTokenAndGetUserRoles(ctx, arg)
+}
+
+func (r *Redis) insertRotatedRefreshToken(
+	ctx context.Context,
+	old refreshToken,
+	arg sql.RotateRefreshTokenAndGetUserRolesParams,
+	now time.Time,
+) ([]sql.RotateRefreshTokenAndGetUserRolesRow, error) {
+	// the lifetimes of the session, like its client, are kept once it has them
+	expiresAt := arg.ExpiresAt.Time
+	if old.RefreshTokenExpiresIn > 0 {
+		expiresAt = now.Add(time.Duration(old.RefreshTokenExpiresIn) * time.Second)
+	}
+	if arg.MaxLifetime.Valid {
+		expiresAt = minTime(
+			expiresAt, old.CreatedAt.Add(time.Duration(arg.MaxLifetime.Int32)*time.Second),
+		)
+	}
+	if !arg.SlidingExpiration {
+		expiresAt = old.ExpiresAt
+	}
+
+	t := refreshToken{
+		ID:       uuid.New(),
+		Hash:     arg.RefreshTokenHash,
+		UserID:   old.UserID,
+		FamilyID: old.FamilyID,
+		Type:     old.Type,
+		Metadata: old.Metadata,
+		// carried over so it reflects when the session started
+		CreatedAt:  old.CreatedAt,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: &now,
+		IPAddress:  arg.IpAddress.String,
+		UserAgent:  arg.UserAgent.String,
+		RotatedAt:  nil,
+
+		ClientID:              cmp.Or(old.ClientID, arg.ClientID.String),
+		ClientVersion:         cmp.Or(arg.ClientVersion.String, old.ClientVersion),
+		RefreshTokenExpiresIn: cmp.Or(old.RefreshTokenExpiresIn, int(arg.RefreshTokenExpiresIn.Int32)),
+		AccessTokenExpiresIn:  cmp.Or(old.AccessTokenExpiresIn, int(arg.AccessTokenExpiresIn.Int32)),
+
+		Country:   arg.Country.String,
+		City:      arg.City.String,
+		Latitude:  float8Ptr(arg.Latitude),
+		Longitude: float8Ptr(arg.Longitude),
+	}
+	if err := r.insertRefreshToken(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.DBClient.UpdateUserLastSeen(ctx, t.UserID); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	roles, err := r.DBClient.GetUserRoles(ctx, t.UserID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if len(roles) == 0 {
+		return []sql.RotateRefreshTokenAndGetUserRolesRow{
+			{
+				RefreshTokenID:       t.ID,
+				AccessTokenExpiresIn: sql.NullableInt4(t.AccessTokenExpiresIn),
+				Role:                 pgtype.Text{}, //nolint:exhaustruct
+			},
+		}, nil
+	}
+
+	rows := make([]sql.RotateRefreshTokenAndGetUserRolesRow, len(roles))
+	for i, role := range roles {
+		rows[i] = sql.RotateRefreshTokenAndGetUserRolesRow{
+			RefreshTokenID:       t.ID,
+			AccessTokenExpiresIn: sql.NullableInt4(t.AccessTokenExpiresIn),
+			Role:                 sql.Text(role.Role),
+		}
+	}
+
+	return rows, nil
+}
+
+func (r *Redis) DeleteRefreshTokenFamilyByRotatedHash(
+	ctx context.Context, refreshTokenHash string,
+) ([]sql.DeleteRefreshTokenFamilyByRotatedHashRow, error) {
+	t, ok, err := r.getRefreshToken(ctx, refreshTokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.DBClient.DeleteRefreshTokenFamilyByRotatedHash( //nolint:wrapcheck
+			ctx, refreshTokenHash,
+		)
+	}
+
+	if t.RotatedAt == nil || t.Type != sql.RefreshTokenTypeRegular {
+		return nil, nil
+	}
+
+	deleted, err := r.deleteRefreshTokenFamily(ctx, t.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.DeleteRefreshTokenFamilyByRotatedHashRow, len(deleted))
+	for i, t := range deleted {
+		rows[i] = sql.DeleteRefreshTokenFamilyByRotatedHashRow{
+			UserID:   t.UserID,
+			FamilyID: t.FamilyID,
+		}
+	}
+
+	return rows, nil
+}
+
+// GetUserSessions returns the sessions of the user in both Redis and PostgreSQL.
+func (r *Redis) GetUserSessions(
+	ctx context.Context, userID uuid.UUID,
+) ([]sql.AuthRefreshToken, error) {
+	tokens, err := r.getUserRefreshTokens(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := r.DBClient.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	for _, t := range tokens {
+		if t.valid() && t.Type == sql.RefreshTokenTypeRegular {
+			sessions = append(sessions, t.row())
+		}
+	}
+
+	slices.SortFunc(sessions, func(a, b sql.AuthRefreshToken) int {
+		return b.CreatedAt.Time.Compare(a.CreatedAt.Time)
+	})
+
+	return sessions, nil
+}
+
+func (r *Redis) DeleteUserSession(
+	ctx context.Context, arg sql.DeleteUserSessionParams,
+) ([]uuid.UUID, error) {
+	hash, err := r.client.Get(ctx, refreshTokenIDKey(arg.ID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return r.DBClient.DeleteUserSession(ctx, arg) //nolint:wrapcheck
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh token: %w", err)
+	}
+
+	t, ok, err := r.getRefreshToken(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || t.UserID != arg.UserID || t.Type != sql.RefreshTokenTypeRegular ||
+		t.RotatedAt != nil {
+		return nil, nil
+	}
+
+	deleted, err := r.deleteRefreshTokenFamily(ctx, t.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(deleted))
+	for i, t := range deleted {
+		ids[i] = t.ID
+	}
+
+	return ids, nil
+}
+
+func (r *Redis) DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	if err := r.deleteUserRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+	return r.DBClient.DeleteRefreshTokens(ctx, userID) //nolint:wrapcheck
+}
+
+func (r *Redis) RevokeUserSessions(ctx context.Context, id uuid.UUID) (int64, error) {
+	if err := r.deleteUserRefreshTokens(ctx, id); err != nil {
+		return 0, err
+	}
+	return r.DBClient.RevokeUserSessions(ctx, id) //nolint:wrapcheck
+}
+
+func (r *Redis) RefreshTokenDeviceFingerprintExists(
+	ctx context.Context, arg sql.RefreshTokenDeviceFingerprintExistsParams,
+) (bool, error) {
+	tokens, err := r.getUserRefreshTokens(ctx, arg.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, t := range tokens {
+		if deviceFingerprint(t.IPAddress, t.UserAgent) == arg.DeviceFingerprint.String {
+			return true, nil
+		}
+	}
+
+	return r.DBClient.RefreshTokenDeviceFingerprintExists(ctx, arg) //nolint:wrapcheck
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,354 @@
+package sessionstore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sessionstore"
+	"github.com/nhost/hasura-auth/go/sql"
+	"go.uber.org/mock/gomock"
+)
+
+func insertRefreshToken(
+	ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID, hash string,
+	expiresIn time.Duration,
+) uuid.UUID {
+	t.Helper()
+
+	id, err := r.InsertRefreshtoken(ctx, sql.InsertRefreshtokenParams{ //nolint:exhaustruct
+		UserID:           userID,
+		RefreshTokenHash: hash,
+		ExpiresAt:        sql.TimestampTz(time.Now().Add(expiresIn)),
+		Type:             sql.RefreshTokenTypeRegular,
+		IpAddress:        sql.Text("203.0.113.7"),
+		UserAgent:        sql.Text("Mozilla/5.0 (X11; Linux x86_64)"),
+	})
+	if err != nil {
+		t.Fatalf("error inserting refresh token: %v", err)
+	}
+
+	return id
+}
+
+func rotateRefreshToken(
+	ctx context.Context, t *testing.T, r *sessionstore.Redis, oldHash, hash string,
+	reuseInterval int32,
+) []sql.RotateRefreshTokenAndGetUserRolesRow {
+	t.Helper()
+
+	rows, err := r.RotateRefreshTokenAndGetUserRoles(
+		ctx,
+		sql.RotateRefreshTokenAndGetUserRolesParams{ //nolint:exhaustruct
+			OldRefreshTokenHash: oldHash,
+			ReuseInterval:       reuseInterval,
+			RefreshTokenHash:    hash,
+			SlidingExpiration:   true,
+			ExpiresAt:           sql.TimestampTz(time.Now().Add(time.Hour)),
+		},
+	)
+	if err != nil {
+		t.Fatalf("error rotating refresh token: %v", err)
+	}
+
+	return rows
+}
+
+func getUserByRefreshTokenHash(
+	ctx context.Context, r *sessionstore.Redis, hash string, reuseInterval int32,
+) (sql.AuthUser, error) {
+	return r.GetUserByRefreshTokenHash( //nolint:wrapcheck
+		ctx,
+		sql.GetUserByRefreshTokenHashParams{
+			RefreshTokenHash: hash,
+			Type:             sql.RefreshTokenTypeRegular,
+			ReuseInterval:    reuseInterval,
+		},
+	)
+}
+
+func TestRefreshTokens(t *testing.T) { //nolint:maintidx
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		db   func(mock *mock.MockDBClient, userID uuid.UUID)
+		run  func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID)
+	}{
+		{
+			name: "rotate",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				).Times(3)
+				mock.EXPECT().UpdateUserLastSeen(gomock.Any(), userID).Return(
+					sql.TimestampTz(time.Now()), nil,
+				)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{{UserID: userID, Role: "user"}}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUserSessions(gomock.Any(), userID).Return(nil, nil)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				oldHash, hash := uuid.NewString(), uuid.NewString()
+				id := insertRefreshToken(ctx, t, r, userID, oldHash, time.Hour)
+
+				if user, err := getUserByRefreshTokenHash(ctx, r, oldHash, 10); err != nil ||
+					user.ID != userID {
+					t.Fatalf("unexpected user: %v, %v", user.ID, err)
+				}
+
+				rows := rotateRefreshToken(ctx, t, r, oldHash, hash, 10)
+				if len(rows) != 1 || rows[0].Role.String != "user" || rows[0].RefreshTokenID == id {
+					t.Fatalf("unexpected rows: %v", rows)
+				}
+
+				if user, err := getUserByRefreshTokenHash(ctx, r, hash, 10); err != nil ||
+					user.ID != userID {
+					t.Errorf("unexpected user of the new token: %v, %v", user.ID, err)
+				}
+
+				// within the reuse interval
+				if user, err := getUserByRefreshTokenHash(ctx, r, oldHash, 10); err != nil ||
+					user.ID != userID {
+					t.Errorf("unexpected user of the rotated token: %v, %v", user.ID, err)
+				}
+
+				if _, err := getUserByRefreshTokenHash(ctx, r, oldHash, 0); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("rotated token shouldn't be usable past the interval: %v", err)
+				}
+
+				if _, err := r.GetRefreshTokenByHash(ctx, oldHash); !errors.Is(err, pgx.ErrNoRows) {
+					t.Errorf("rotated token shouldn't be valid: %v", err)
+				}
+
+				token, err := r.GetRefreshTokenByHash(ctx, hash)
+				if err != nil {
+					t.Fatalf("error getting refresh token: %v", err)
+				}
+				if token.ID != rows[0].RefreshTokenID || token.FamilyID != id ||
+					token.UserID != userID {
+					t.Errorf("unexpected refresh token: %v", token)
+				}
+
+				sessions, err := r.GetUserSessions(ctx, userID)
+				if err != nil {
+					t.Fatalf("error getting sessions: %v", err)
+				}
+				if len(sessions) != 1 || sessions[0].ID != token.ID {
+					t.Errorf("unexpected sessions: %v", sessions)
+				}
+			},
+		},
+		{
+			name: "rotated twice past the reuse interval",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().UpdateUserLastSeen(gomock.Any(), userID).Return(
+					sql.TimestampTz(time.Now()), nil,
+				)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{{UserID: userID, Role: "user"}}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				oldHash := uuid.NewString()
+				insertRefreshToken(ctx, t, r, userID, oldHash, time.Hour)
+
+				if rows := rotateRefreshToken(ctx, t, r, oldHash, uuid.NewString(), 0); len(rows) != 1 {
+					t.Fatalf("unexpected rows: %v", rows)
+				}
+
+				if rows := rotateRefreshToken(ctx, t, r, oldHash, uuid.NewString(), 0); len(rows) != 0 {
+					t.Errorf("rotated token shouldn't be rotated again: %v", rows)
+				}
+			},
+		},
+		{
+			name: "reuse revokes the family",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().UpdateUserLastSeen(gomock.Any(), userID).Return(
+					sql.TimestampTz(time.Now()), nil,
+				)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{{UserID: userID, Role: "user"}}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUserByRefreshTokenHash(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				oldHash, hash := uuid.NewString(), uuid.NewString()
+				id := insertRefreshToken(ctx, t, r, userID, oldHash, time.Hour)
+				rotateRefreshToken(ctx, t, r, oldHash, hash, 10)
+
+				// the token that is still valid isn't a reuse
+				deleted, err := r.DeleteRefreshTokenFamilyByRotatedHash(ctx, hash)
+				if err != nil || len(deleted) != 0 {
+					t.Fatalf("unexpected deleted tokens: %v, %v", deleted, err)
+				}
+
+				deleted, err = r.DeleteRefreshTokenFamilyByRotatedHash(ctx, oldHash)
+				if err != nil {
+					t.Fatalf("error deleting refresh token family: %v", err)
+				}
+				if len(deleted) != 2 {
+					t.Fatalf("unexpected deleted tokens: %v", deleted)
+				}
+				for _, row := range deleted {
+					if row.UserID != userID || row.FamilyID != id {
+						t.Errorf("unexpected deleted token: %v", row)
+					}
+				}
+
+				if _, err := getUserByRefreshTokenHash(ctx, r, hash, 10); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("tokens of the family should've been deleted: %v", err)
+				}
+			},
+		},
+		{
+			name: "expired",
+			db: func(mock *mock.MockDBClient, _ uuid.UUID) {
+				mock.EXPECT().GetUserByRefreshTokenHash(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				).Times(2)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				expiredHash, expiringHash := uuid.NewString(), uuid.NewString()
+				insertRefreshToken(ctx, t, r, userID, expiredHash, -time.Second)
+				insertRefreshToken(ctx, t, r, userID, expiringHash, 100*time.Millisecond)
+
+				if _, err := getUserByRefreshTokenHash(ctx, r, expiredHash, 10); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("expired token shouldn't be stored: %v", err)
+				}
+
+				time.Sleep(200 * time.Millisecond)
+
+				if _, err := getUserByRefreshTokenHash(ctx, r, expiringHash, 10); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("token should've expired: %v", err)
+				}
+			},
+		},
+		{
+			name: "moved from postgres",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				id := uuid.New()
+				mock.EXPECT().GetRefreshTokenByHash(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, hash string) (sql.AuthRefreshToken, error) {
+						return sql.AuthRefreshToken{ //nolint:exhaustruct
+							ID:               id,
+							CreatedAt:        sql.TimestampTz(time.Now().Add(-time.Hour)),
+							ExpiresAt:        sql.TimestampTz(time.Now().Add(time.Hour)),
+							UserID:           userID,
+							Type:             sql.RefreshTokenTypeRegular,
+							RefreshTokenHash: hash,
+							FamilyID:         id,
+						}, nil
+					},
+				)
+				mock.EXPECT().DeleteUserSession(gomock.Any(), sql.DeleteUserSessionParams{
+					ID:     id,
+					UserID: userID,
+				}).Return([]uuid.UUID{id}, nil)
+				mock.EXPECT().UpdateUserLastSeen(gomock.Any(), userID).Return(
+					sql.TimestampTz(time.Now()), nil,
+				)
+				mock.EXPECT().GetUserRoles(gomock.Any(), userID).Return(
+					[]sql.AuthUserRole{{UserID: userID, Role: "user"}}, nil, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				hash := uuid.NewString()
+				if rows := rotateRefreshToken(ctx, t, r, uuid.NewString(), hash, 10); len(rows) != 1 {
+					t.Fatalf("unexpected rows: %v", rows)
+				}
+
+				if user, err := getUserByRefreshTokenHash(ctx, r, hash, 10); err != nil ||
+					user.ID != userID {
+					t.Errorf("unexpected user of the new token: %v, %v", user.ID, err)
+				}
+			},
+		},
+		{
+			name: "sign out",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().GetUserByRefreshTokenHash(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				).Times(3)
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), userID).Return(int64(0), nil)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				signedOut, other, another := uuid.NewString(), uuid.NewString(), uuid.NewString()
+				id := insertRefreshToken(ctx, t, r, userID, signedOut, time.Hour)
+				insertRefreshToken(ctx, t, r, userID, other, time.Hour)
+				insertRefreshToken(ctx, t, r, userID, another, time.Hour)
+
+				deleted, err := r.DeleteUserSession(ctx, sql.DeleteUserSessionParams{
+					ID:     id,
+					UserID: userID,
+				})
+				if err != nil || len(deleted) != 1 || deleted[0] != id {
+					t.Fatalf("unexpected deleted sessions: %v, %v", deleted, err)
+				}
+
+				if _, err := getUserByRefreshTokenHash(ctx, r, signedOut, 10); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("session should've been deleted: %v", err)
+				}
+
+				if _, err := r.RevokeUserSessions(ctx, userID); err != nil {
+					t.Fatalf("error revoking sessions: %v", err)
+				}
+
+				for _, hash := range []string{other, another} {
+					if _, err := getUserByRefreshTokenHash(ctx, r, hash, 10); !errors.Is(
+						err, pgx.ErrNoRows,
+					) {
+						t.Errorf("session should've been revoked: %v", err)
+					}
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			userID := uuid.New()
+
+			db := mock.NewMockDBClient(ctrl)
+			tc.db(db, userID)
+
+			tc.run(context.Background(), t, newRedis(t, db), userID)
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package sessionstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/redis/go-redis/v9"
+)
+
+// deleteIfEqualScript deletes the key if it still has the value.
+var deleteIfEqualScript = redis.NewScript(` //nolint:gochecknoglobals
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// tickets are stored with the id of their user and the user with its current ticket, as a
+// user has at most one ticket at a time and setting a new one voids the previous.
+func ticketKey(ticket string) string {
+	return redisKeyPrefix + "ticket:" + ticket
+}
+
+func userTicketKey(userID uuid.UUID) string {
+	return redisKeyPrefix + "user-ticket:" + userID.String()
+}
+
+// hasTicket reports if the ticket needs storing, many queries set an empty ticket that
+// already expired instead of none.
+func hasTicket(ticket pgtype.Text, expiresAt pgtype.Timestamptz) bool {
+	return ticket.Valid && ticket.String != "" && time.Now().Before(expiresAt.Time)
+}
+
+// noTicket is what the queries setting the ticket of a user are given instead of the
+// ticket, which is stored in Redis.
+func noTicket() (pgtype.Text, pgtype.Timestamptz) {
+	return pgtype.Text{}, sql.TimestampTz(time.Now()) //nolint:exhaustruct
+}
+
+func (r *Redis) setTicket(
+	ctx context.Context, userID uuid.UUID, ticket pgtype.Text, expiresAt pgtype.Timestamptz,
+) error {
+	if !hasTicket(ticket, expiresAt) {
+		return nil
+	}
+
+	ttl := time.Until(expiresAt.Time)
+	userKey := userTicketKey(userID)
+	err := r.client.Watch(ctx, func(tx *redis.Tx) error {
+		previous, err := tx.Get(ctx, userKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err //nolint:wrapcheck
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if previous != "" {
+				pipe.Del(ctx, ticketKey(previous))
+			}
+			pipe.Set(ctx, ticketKey(ticket.String), userID.String(), ttl)
+			pipe.Set(ctx, userKey, ticket.String, ttl)
+			return nil
+		})
+		return err //nolint:wrapcheck
+	}, userKey)
+	if err != nil {
+		return fmt.Errorf("error setting ticket: %w", err)
+	}
+
+	return nil
+}
+
+// ticketUser returns the id of the user of the ticket and if it is in Redis. The ticket
+// is deleted if consume is set.
+func (r *Redis) ticketUser(
+	ctx context.Context, ticket string, consume bool,
+) (uuid.UUID, bool, error) {
+	var cmd *redis.StringCmd
+	if consume {
+		cmd = r.client.GetDel(ctx, ticketKey(ticket))
+	} else {
+		cmd = r.client.Get(ctx, ticketKey(ticket))
+	}
+
+	s, err := cmd.Result()
+	if errors.Is(err, redis.Nil) {
+		return uuid.UUID{}, false, nil
+	}
+	if err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("error getting ticket: %w", err)
+	}
+
+	userID, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.UUID{}, false, fmt.Errorf("error decoding ticket: %w", err)
+	}
+
+	if consume {
+		if err := deleteIfEqualScript.Run(
+			ctx, r.client, []string{userTicketKey(userID)}, ticket,
+		).Err(); err != nil {
+			return uuid.UUID{}, false, fmt.Errorf("error consuming ticket: %w", err)
+		}
+	}
+
+	return userID, true, nil
+}
+
+func (r *Redis) UpdateUserTicket(
+	ctx context.Context, arg sql.UpdateUserTicketParams,
+) (uuid.UUID, error) {
+	if err := r.setTicket(ctx, arg.ID, arg.Ticket, arg.TicketExpiresAt); err != nil {
+		return uuid.UUID{}, err
+	}
+	return arg.ID, nil
+}
+
+func (r *Redis) GetUserByTicket(ctx context.Context, ticket pgtype.Text) (sql.AuthUser, error) {
+	userID, ok, err := r.ticketUser(ctx, ticket.String, false)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct
+	}
+	if !ok {
+		return r.DBClient.GetUserByTicket(ctx, ticket) //nolint:wrapcheck
+	}
+
+	return r.DBClient.GetUser(ctx, userID) //nolint:wrapcheck
+}
+
+func (r *Redis) UpdateUserConsumeTicket(
+	ctx context.Context, ticket pgtype.Text,
+) (sql.AuthUser, error) {
+	userID, ok, err := r.ticketUser(ctx, ticket.String, true)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct
+	}
+	if !ok {
+		return r.DBClient.UpdateUserConsumeTicket(ctx, ticket) //nolint:wrapcheck
+	}
+
+	user, err := r.DBClient.GetUser(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return sql.AuthUser{}, pgx.ErrNoRows //nolint:exhaustruct
+	}
+	return user, err //nolint:wrapcheck
+}
+
+func (r *Redis) UpdateUserChangeEmail(
+	ctx context.Context, arg sql.UpdateUserChangeEmailParams,
+) (sql.AuthUser, error) {
+	ticket, expiresAt := arg.Ticket, arg.TicketExpiresAt
+	arg.Ticket, arg.TicketExpiresAt = noTicket()
+
+	user, err := r.DBClient.UpdateUserChangeEmail(ctx, arg)
+	if err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct,wrapcheck
+	}
+
+	if err := r.setTicket(ctx, user.ID, ticket, expiresAt); err != nil {
+		return sql.AuthUser{}, err //nolint:exhaustruct
+	}
+
+	user.Ticket, user.TicketExpiresAt = ticket, expiresAt
+	return user, nil
+}
+
+func (r *Redis) UpdateUserDeanonymize(
+	ctx context.Context, arg sql.UpdateUserDeanonymizeParams,
+) error {
+	ticket, expiresAt := arg.Ticket, arg.TicketExpiresAt
+	arg.Ticket, arg.TicketExpiresAt = noTicket()
+
+	if err := r.DBClient.UpdateUserDeanonymize(ctx, arg); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.setTicket(ctx, uuid.UUID(arg.ID.Bytes), ticket, expiresAt)
+}
+
+func (r *Redis) InsertUser(ctx context.Context, arg sql.InsertUserParams) (sql.InsertUserRow, error) {
+	ticket, expiresAt := arg.Ticket, arg.TicketExpiresAt
+	arg.Ticket, arg.TicketExpiresAt = noTicket()
+
+	row, err := r.DBClient.InsertUser(ctx, arg)
+	if err != nil {
+		return sql.InsertUserRow{}, err //nolint:exhaustruct,wrapcheck
+	}
+
+	return row, r.setTicket(ctx, row.UserID, ticket, expiresAt)
+}
+
+func (r *Redis) InsertUserWithRefreshToken(
+	ctx context.Context, arg sql.InsertUserWithRefreshTokenParams,
+) (sql.InsertUserWithRefreshTokenRow, error) {
+	ticket, expiresAt := arg.Ticket, arg.TicketExpiresAt
+	arg.Ticket, arg.TicketExpiresAt = noTicket()
+
+	row, err := r.DBClient.InsertUserWithRefreshToken(ctx, arg)
+	if err != nil {
+		return sql.InsertUserWithRefreshTokenRow{}, err //nolint:exhaustruct,wrapcheck
+	}
+
+	return row, r.setTicket(ctx, row.UserID, ticket, expiresAt)
+}
+
+func (r *Redis) InsertUserWithSecurityKey(
+	ctx context.Context, arg sql.InsertUserWithSecurityKeyParams,
+) (uuid.UUID, error) {
+	ticket, expiresAt := arg.Ticket, arg.TicketExpiresAt
+	arg.Ticket, arg.TicketExpiresAt = noTicket()
+
+	userID, err := r.DBClient.InsertUserWithSecurityKey(ctx, arg)
+	if err != nil {
+		return uuid.UUID{}, err //nolint:wrapcheck
+	}
+
+	return userID, r.setTicket(ctx, userID, ticket, expiresAt)
+}
+
+func (r *Redis) InsertUserWithSecurityKeyAndRefreshToken(
+	ctx context.Context, arg sql.InsertUserWithSecurityKeyAndRefreshTokenParams,
+) (sql.InsertUserWithSecurityKeyAndRefreshTokenRow, error) {
+	ticket, expiresAt := arg.Ticket, arg.TicketExpiresAt
+	arg.Ticket, arg.TicketExpiresAt = noTicket()
+
+	row, err := r.DBClient.InsertUserWithSecurityKeyAndRefreshToken(ctx, arg)
+	if err != nil {
+		return sql.InsertUserWithSecurityKeyAndRefreshTokenRow{}, err //nolint:exhaustruct,wrapcheck
+	}
+
+	return row, r.setTicket(ctx, row.UserID, ticket, expiresAt)
+}
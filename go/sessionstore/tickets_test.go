@@ -0,0 +1,204 @@
+package sessionstore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nhost/hasura-auth/go/controller/mock"
+	"github.com/nhost/hasura-auth/go/sessionstore"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/testhelpers"
+	"go.uber.org/mock/gomock"
+)
+
+func setTicket(
+	ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID, ticket string,
+	expiresIn time.Duration,
+) {
+	t.Helper()
+
+	if _, err := r.UpdateUserTicket(ctx, sql.UpdateUserTicketParams{
+		ID:              userID,
+		Ticket:          sql.Text(ticket),
+		TicketExpiresAt: sql.TimestampTz(time.Now().Add(expiresIn)),
+	}); err != nil {
+		t.Fatalf("error setting ticket: %v", err)
+	}
+}
+
+func TestTickets(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		db   func(mock *mock.MockDBClient, userID uuid.UUID)
+		run  func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID)
+	}{
+		{
+			name: "consumed once",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				).Times(2)
+				mock.EXPECT().UpdateUserConsumeTicket(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUserByTicket(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				ticket := "verifyEmail:" + uuid.NewString()
+				setTicket(ctx, t, r, userID, ticket, time.Hour)
+
+				if user, err := r.GetUserByTicket(ctx, sql.Text(ticket)); err != nil ||
+					user.ID != userID {
+					t.Fatalf("unexpected user: %v, %v", user.ID, err)
+				}
+
+				if user, err := r.UpdateUserConsumeTicket(ctx, sql.Text(ticket)); err != nil ||
+					user.ID != userID {
+					t.Fatalf("unexpected user: %v, %v", user.ID, err)
+				}
+
+				if _, err := r.UpdateUserConsumeTicket(ctx, sql.Text(ticket)); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("ticket shouldn't be consumed twice: %v", err)
+				}
+
+				if _, err := r.GetUserByTicket(ctx, sql.Text(ticket)); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("consumed ticket shouldn't be found: %v", err)
+				}
+			},
+		},
+		{
+			name: "a new ticket voids the previous",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().UpdateUserConsumeTicket(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				)
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				previous, ticket := "passwordReset:"+uuid.NewString(), "passwordReset:"+uuid.NewString()
+				setTicket(ctx, t, r, userID, previous, time.Hour)
+				setTicket(ctx, t, r, userID, ticket, time.Hour)
+
+				if _, err := r.UpdateUserConsumeTicket(ctx, sql.Text(previous)); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("previous ticket should've been voided: %v", err)
+				}
+
+				if user, err := r.UpdateUserConsumeTicket(ctx, sql.Text(ticket)); err != nil ||
+					user.ID != userID {
+					t.Errorf("unexpected user: %v, %v", user.ID, err)
+				}
+			},
+		},
+		{
+			name: "expired",
+			db: func(mock *mock.MockDBClient, _ uuid.UUID) {
+				mock.EXPECT().UpdateUserConsumeTicket(gomock.Any(), gomock.Any()).Return(
+					sql.AuthUser{}, pgx.ErrNoRows, //nolint:exhaustruct
+				).Times(2)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				expired := "verifyEmail:" + uuid.NewString()
+				setTicket(ctx, t, r, userID, expired, -time.Second)
+
+				if _, err := r.UpdateUserConsumeTicket(ctx, sql.Text(expired)); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("expired ticket shouldn't be stored: %v", err)
+				}
+
+				expiring := "verifyEmail:" + uuid.NewString()
+				setTicket(ctx, t, r, userID, expiring, 100*time.Millisecond)
+
+				time.Sleep(200 * time.Millisecond)
+
+				if _, err := r.UpdateUserConsumeTicket(ctx, sql.Text(expiring)); !errors.Is(
+					err, pgx.ErrNoRows,
+				) {
+					t.Errorf("ticket should've expired: %v", err)
+				}
+			},
+		},
+		{
+			name: "change email",
+			db: func(mock *mock.MockDBClient, userID uuid.UUID) {
+				mock.EXPECT().UpdateUserChangeEmail(
+					gomock.Any(),
+					testhelpers.GomockCmpOpts(
+						sql.UpdateUserChangeEmailParams{ //nolint:exhaustruct
+							ID:       userID,
+							Ticket:   pgtype.Text{}, //nolint:exhaustruct
+							NewEmail: sql.Text("new@acme.local"),
+						},
+						cmpopts.IgnoreFields(
+							sql.UpdateUserChangeEmailParams{}, //nolint:exhaustruct
+							"TicketExpiresAt",
+						),
+					),
+				).Return(sql.AuthUser{ID: userID}, nil) //nolint:exhaustruct
+				mock.EXPECT().GetUser(gomock.Any(), userID).Return(
+					sql.AuthUser{ID: userID}, nil, //nolint:exhaustruct
+				)
+			},
+			run: func(ctx context.Context, t *testing.T, r *sessionstore.Redis, userID uuid.UUID) {
+				t.Helper()
+
+				ticket := "verifyEmail:" + uuid.NewString()
+				user, err := r.UpdateUserChangeEmail(ctx, sql.UpdateUserChangeEmailParams{
+					ID:              userID,
+					Ticket:          sql.Text(ticket),
+					TicketExpiresAt: sql.TimestampTz(time.Now().Add(time.Hour)),
+					NewEmail:        sql.Text("new@acme.local"),
+				})
+				if err != nil {
+					t.Fatalf("error changing email: %v", err)
+				}
+				if user.Ticket.String != ticket {
+					t.Errorf("unexpected ticket: %v", user.Ticket)
+				}
+
+				if user, err := r.UpdateUserConsumeTicket(ctx, sql.Text(ticket)); err != nil ||
+					user.ID != userID {
+					t.Errorf("unexpected user: %v, %v", user.ID, err)
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			userID := uuid.New()
+
+			db := mock.NewMockDBClient(ctrl)
+			tc.db(db, userID)
+
+			tc.run(context.Background(), t, newRedis(t, db), userID)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package sigv4 signs the requests to the AWS APIs, and the S3-compatible ones, with AWS
+// Signature Version 4.
+package sigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	algorithm     = "AWS4-HMAC-SHA256"
+	dateFormat    = "20060102T150405Z"
+	requestSuffix = "aws4_request"
+)
+
+// HexSHA256 returns the hex encoded SHA-256 of data, the format of the payload hash S3
+// expects in the x-amz-content-sha256 header.
+func HexSHA256(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// Sign sets the x-amz-date and authorization headers of the request. The host and all
+// the headers already set are signed, so headers like the session token or the content
+// hash need to be set before signing it. Headers the HTTP client adds when sending the
+// request, like the user agent, aren't.
+func Sign(
+	req *http.Request,
+	payload []byte,
+	region string,
+	service string,
+	accessKeyID string,
+	secretAccessKey string,
+	now time.Time,
+) {
+	date := now.UTC().Format(dateFormat)
+	req.Header.Set("X-Amz-Date", date)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	slices.Sort(names)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	// the query is sorted by key and, unlike in forms, spaces are encoded as %20
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		HexSHA256(payload),
+	}, "\n")
+
+	scope := strings.Join([]string{date[:8], region, service, requestSuffix}, "/")
+	stringToSign := strings.Join([]string{
+		algorithm, date, scope, HexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date[:8])
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, requestSuffix)
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, accessKeyID, scope, signedHeaders, signature,
+	))
+}
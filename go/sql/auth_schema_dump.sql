@@ -35,6 +35,41 @@ CREATE DOMAIN auth.email AS public.citext
 
 ALTER DOMAIN auth.email OWNER TO postgres;
 
+--
+-- Name: notify_change(); Type: FUNCTION; Schema: auth; Owner: postgres
+--
+
+CREATE FUNCTION auth.notify_change() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+  _row record;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    _row := OLD;
+  ELSE
+    _row := NEW;
+  END IF;
+
+  PERFORM pg_notify('hasura_auth_changes', json_build_object(
+    'schema', TG_TABLE_SCHEMA,
+    'table', TG_TABLE_NAME,
+    'userId', CASE WHEN TG_NARGS > 0 THEN to_jsonb(_row) ->> TG_ARGV[0] END
+  )::text);
+
+  RETURN NULL;
+END;
+$$;
+
+
+ALTER FUNCTION auth.notify_change() OWNER TO postgres;
+
+--
+-- Name: FUNCTION notify_change(); Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON FUNCTION auth.notify_change() IS 'Notifies the changes to the rows cached by Hasura Auth on the hasura_auth_changes channel. Don''t modify it as Hasura Auth relies on it to function properly.';
+
 --
 -- Name: set_current_timestamp_updated_at(); Type: FUNCTION; Schema: auth; Owner: postgres
 --
@@ -52,392 +87,1596 @@ END;
 $$;
 
 
-ALTER FUNCTION auth.set_current_timestamp_updated_at() OWNER TO postgres;
+ALTER FUNCTION auth.set_current_timestamp_updated_at() OWNER TO postgres;
+
+SET default_tablespace = '';
+
+SET default_table_access_method = heap;
+
+--
+-- Name: account_deletions; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.account_deletions (
+    user_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    ticket text NOT NULL
+);
+
+
+ALTER TABLE auth.account_deletions OWNER TO postgres;
+
+--
+-- Name: TABLE account_deletions; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.account_deletions IS 'Tickets sent to users when they schedule the deletion of their account so they can cancel it during the grace period. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: audit_logs; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.audit_logs (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    event text NOT NULL,
+    outcome text NOT NULL,
+    actor text NOT NULL,
+    user_id uuid,
+    ip_address text,
+    user_agent text,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    CONSTRAINT audit_logs_actor_check CHECK ((actor = ANY (ARRAY['user'::text, 'admin'::text]))),
+    CONSTRAINT audit_logs_outcome_check CHECK ((outcome = ANY (ARRAY['success'::text, 'failure'::text])))
+);
+
+
+ALTER TABLE auth.audit_logs OWNER TO postgres;
+
+--
+-- Name: TABLE audit_logs; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.audit_logs IS 'Authentication events such as sign ups, sign ins and admin actions. Entries are kept after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: data_exports; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.data_exports (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    requested_by text NOT NULL,
+    status text DEFAULT 'pending'::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_error text,
+    data jsonb,
+    completed_at timestamp with time zone,
+    expires_at timestamp with time zone,
+    CONSTRAINT data_exports_requested_by_check CHECK ((requested_by = ANY (ARRAY['user'::text, 'admin'::text]))),
+    CONSTRAINT data_exports_status_check CHECK ((status = ANY (ARRAY['pending'::text, 'completed'::text, 'failed'::text])))
+);
+
+
+ALTER TABLE auth.data_exports OWNER TO postgres;
+
+--
+-- Name: TABLE data_exports; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.data_exports IS 'Exports of the data stored about users. Exports are assembled in the background and deleted once they expire. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: device_codes; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.device_codes (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    device_code_hash text NOT NULL,
+    user_code text NOT NULL,
+    user_id uuid,
+    denied boolean DEFAULT false NOT NULL,
+    last_polled_at timestamp with time zone
+);
+
+
+ALTER TABLE auth.device_codes OWNER TO postgres;
+
+--
+-- Name: TABLE device_codes; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.device_codes IS 'Pending device authorization requests (RFC 8628). Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: email_change_reverts; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.email_change_reverts (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    ticket text NOT NULL,
+    old_email text NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+
+
+ALTER TABLE auth.email_change_reverts OWNER TO postgres;
+
+--
+-- Name: TABLE email_change_reverts; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.email_change_reverts IS 'Tickets sent to the previous email address of a user when the email is changed so the change can be undone. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: email_outbox; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.email_outbox (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    recipient text NOT NULL,
+    subject text NOT NULL,
+    body text NOT NULL,
+    headers jsonb DEFAULT '{}'::jsonb NOT NULL,
+    status text DEFAULT 'pending'::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_error text,
+    CONSTRAINT email_outbox_status_check CHECK ((status = ANY (ARRAY['pending'::text, 'failed'::text])))
+);
+
+
+ALTER TABLE auth.email_outbox OWNER TO postgres;
+
+--
+-- Name: TABLE email_outbox; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.email_outbox IS 'Emails waiting to be sent. Emails are removed once sent and kept with the failed status if they can''t be delivered. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: email_templates; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.email_templates (
+    locale text NOT NULL,
+    template text NOT NULL,
+    file text NOT NULL,
+    content text NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT email_templates_file_check CHECK ((file = ANY (ARRAY['body.html'::text, 'body.txt'::text, 'subject.txt'::text])))
+);
+
+
+ALTER TABLE auth.email_templates OWNER TO postgres;
+
+--
+-- Name: TABLE email_templates; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.email_templates IS 'Overrides of the email and sms templates. Each row replaces the file of the template for the given locale, for instance the body.html of the email-verify template in en. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: ip_sign_in_failures; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.ip_sign_in_failures (
+    ip text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    last_failed_at timestamp with time zone DEFAULT now() NOT NULL,
+    locked_until timestamp with time zone
+);
+
+
+ALTER TABLE auth.ip_sign_in_failures OWNER TO postgres;
+
+--
+-- Name: TABLE ip_sign_in_failures; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.ip_sign_in_failures IS 'Failed sign in attempts of each IP address. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: migrations; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.migrations (
+    id integer NOT NULL,
+    name character varying(100) NOT NULL,
+    hash character varying(40) NOT NULL,
+    executed_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
+);
+
+
+ALTER TABLE auth.migrations OWNER TO postgres;
+
+--
+-- Name: TABLE migrations; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.migrations IS 'Internal table for tracking migrations. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: new_device_sign_ins; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.new_device_sign_ins (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    ticket text NOT NULL,
+    ip_address text,
+    user_agent text,
+    expires_at timestamp with time zone NOT NULL
+);
+
+
+ALTER TABLE auth.new_device_sign_ins OWNER TO postgres;
+
+--
+-- Name: TABLE new_device_sign_ins; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.new_device_sign_ins IS 'Tickets sent to users when they sign in from a new device so they can sign out every session if it wasn''t them. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: oauth2_authorization_codes; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.oauth2_authorization_codes (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    code_hash text NOT NULL,
+    client_id text NOT NULL,
+    user_id uuid NOT NULL,
+    redirect_uri text NOT NULL,
+    scopes text[] NOT NULL,
+    nonce text,
+    code_challenge text
+);
+
+
+ALTER TABLE auth.oauth2_authorization_codes OWNER TO postgres;
+
+--
+-- Name: TABLE oauth2_authorization_codes; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.oauth2_authorization_codes IS 'Authorization codes issued to OAuth2 clients signing in users with OpenID Connect. Only the hash of the code is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: oauth2_clients; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.oauth2_clients (
+    client_id text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    client_secret_hash text NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    default_role text NOT NULL,
+    allowed_roles text[] DEFAULT '{}'::text[] NOT NULL,
+    token_exchange_enabled boolean DEFAULT false NOT NULL,
+    redirect_uris text[] DEFAULT '{}'::text[] NOT NULL,
+    skip_consent boolean DEFAULT false NOT NULL,
+    grant_types text[] DEFAULT '{client_credentials}'::text[] NOT NULL
+);
+
+
+ALTER TABLE auth.oauth2_clients OWNER TO postgres;
+
+--
+-- Name: TABLE oauth2_clients; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.oauth2_clients IS 'OAuth2 clients that can get access tokens with the client credentials grant or sign in users with OpenID Connect. Only the hash of the client secret is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: COLUMN oauth2_clients.grant_types; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.oauth2_clients.grant_types IS 'Grants the client can use to get access tokens, client_credentials or authorization_code. The token exchange grant is enabled with token_exchange_enabled';
+
+
+--
+-- Name: oauth2_consents; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.oauth2_consents (
+    user_id uuid NOT NULL,
+    client_id text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    scopes text[] NOT NULL
+);
+
+
+ALTER TABLE auth.oauth2_consents OWNER TO postgres;
+
+--
+-- Name: TABLE oauth2_consents; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.oauth2_consents IS 'Scopes users allowed OAuth2 clients to access. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: organization_invites; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.organization_invites (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    email auth.email NOT NULL,
+    role text NOT NULL,
+    ticket text NOT NULL,
+    invited_by uuid,
+    CONSTRAINT organization_invites_role_check CHECK ((role = ANY (ARRAY['owner'::text, 'admin'::text, 'member'::text])))
+);
+
+
+ALTER TABLE auth.organization_invites OWNER TO postgres;
+
+--
+-- Name: TABLE organization_invites; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.organization_invites IS 'Pending invitations to join an organization, they are deleted once accepted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: organization_members; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.organization_members (
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    role text NOT NULL,
+    CONSTRAINT organization_members_role_check CHECK ((role = ANY (ARRAY['owner'::text, 'admin'::text, 'member'::text])))
+);
+
+
+ALTER TABLE auth.organization_members OWNER TO postgres;
+
+--
+-- Name: TABLE organization_members; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.organization_members IS 'Members of the organizations and their role in the organization. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: organizations; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.organizations (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    name text NOT NULL
+);
+
+
+ALTER TABLE auth.organizations OWNER TO postgres;
+
+--
+-- Name: TABLE organizations; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.organizations IS 'Organizations users can be members of. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: personal_access_tokens; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.personal_access_tokens (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    name text DEFAULT ''::text NOT NULL,
+    token_hash text NOT NULL,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    metadata jsonb
+);
+
+
+ALTER TABLE auth.personal_access_tokens OWNER TO postgres;
+
+--
+-- Name: TABLE personal_access_tokens; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.personal_access_tokens IS 'Long-lived tokens users can exchange for a session. Only the hash of the token is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: provider_requests; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.provider_requests (
+    id uuid NOT NULL,
+    options jsonb
+);
+
+
+ALTER TABLE auth.provider_requests OWNER TO postgres;
+
+--
+-- Name: TABLE provider_requests; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.provider_requests IS 'Oauth requests, inserted before redirecting to the provider''s site. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: providers; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.providers (
+    id text NOT NULL
+);
+
+
+ALTER TABLE auth.providers OWNER TO postgres;
+
+--
+-- Name: TABLE providers; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.providers IS 'List of available Oauth providers. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: refresh_token_types; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.refresh_token_types (
+    value text NOT NULL,
+    comment text
+);
+
+
+ALTER TABLE auth.refresh_token_types OWNER TO postgres;
+
+--
+-- Name: refresh_tokens; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.refresh_tokens (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    user_id uuid NOT NULL,
+    metadata jsonb,
+    type text DEFAULT 'regular'::text NOT NULL,
+    refresh_token_hash character varying(255) NOT NULL,
+    family_id uuid DEFAULT gen_random_uuid() NOT NULL,
+    rotated_at timestamp with time zone,
+    last_used_at timestamp with time zone DEFAULT now(),
+    ip_address text,
+    user_agent text,
+    device_fingerprint text GENERATED ALWAYS AS (md5(((COALESCE(ip_address, ''::text) || ' '::text) || COALESCE(user_agent, ''::text)))) STORED,
+    client_id text,
+    refresh_token_expires_in integer,
+    access_token_expires_in integer,
+    client_version text,
+    country text,
+    city text,
+    latitude double precision,
+    longitude double precision
+);
+
+
+ALTER TABLE auth.refresh_tokens OWNER TO postgres;
+
+--
+-- Name: TABLE refresh_tokens; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.refresh_tokens IS 'User refresh tokens. Hasura auth uses them to rotate new access tokens as long as the refresh token is not expired. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: COLUMN refresh_tokens.access_token_expires_in; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.access_token_expires_in IS 'Seconds the access tokens of the session are valid for, NULL for the default lifetime';
+
+
+--
+-- Name: COLUMN refresh_tokens.city; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.city IS 'City the session was last used from, according to the GeoIP database';
+
+
+--
+-- Name: COLUMN refresh_tokens.client_id; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.client_id IS 'Client the session was started by, as sent in the X-Hasura-Auth-Client header';
+
+
+--
+-- Name: COLUMN refresh_tokens.client_version; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.client_version IS 'Version of the client that last used the session, as sent in the X-Hasura-Auth-Client-Version header';
+
+
+--
+-- Name: COLUMN refresh_tokens.country; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.country IS 'ISO code of the country the session was last used from, according to the GeoIP database';
+
+
+--
+-- Name: COLUMN refresh_tokens.device_fingerprint; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.device_fingerprint IS 'Hash of the IP address and user agent the refresh token was issued to, used to detect sign ins from new devices';
+
+
+--
+-- Name: COLUMN refresh_tokens.latitude; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.latitude IS 'Approximate latitude the session was last used from, according to the GeoIP database';
+
+
+--
+-- Name: COLUMN refresh_tokens.longitude; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.longitude IS 'Approximate longitude the session was last used from, according to the GeoIP database';
+
+
+--
+-- Name: COLUMN refresh_tokens.refresh_token_expires_in; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.refresh_token_expires_in IS 'Seconds the refresh tokens of the session are valid for, NULL for the default lifetime';
+
+
+--
+-- Name: COLUMN refresh_tokens.refresh_token_hash; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.refresh_tokens.refresh_token_hash IS 'SHA-256 of the refresh token, the refresh token itself is never stored';
+
+
+--
+-- Name: roles; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.roles (
+    role text NOT NULL
+);
+
+
+ALTER TABLE auth.roles OWNER TO postgres;
+
+--
+-- Name: TABLE roles; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.roles IS 'Persistent Hasura roles for users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: sign_in_methods; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.sign_in_methods (
+    method text NOT NULL,
+    enabled boolean NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+ALTER TABLE auth.sign_in_methods OWNER TO postgres;
+
+--
+-- Name: TABLE sign_in_methods; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.sign_in_methods IS 'Sign in methods enabled or disabled at runtime, overriding the configuration. Methods without a row follow the configuration. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: COLUMN sign_in_methods.method; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.sign_in_methods.method IS 'Sign in method, for instance email-password, anonymous or provider-github';
+
+
+--
+-- Name: token_exchanges; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.token_exchanges (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    client_id text NOT NULL,
+    user_id uuid NOT NULL,
+    roles text[] NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+
+
+ALTER TABLE auth.token_exchanges OWNER TO postgres;
+
+--
+-- Name: TABLE token_exchanges; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.token_exchanges IS 'Record of the access tokens exchanged by OAuth2 clients on behalf of users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: user_providers; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.user_providers (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    access_token text NOT NULL,
+    refresh_token text,
+    provider_id text NOT NULL,
+    provider_user_id text NOT NULL
+);
+
+
+ALTER TABLE auth.user_providers OWNER TO postgres;
+
+--
+-- Name: TABLE user_providers; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.user_providers IS 'Active providers for a given user. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: user_recovery_codes; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.user_recovery_codes (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    code_hash text NOT NULL
+);
+
+
+ALTER TABLE auth.user_recovery_codes OWNER TO postgres;
+
+--
+-- Name: TABLE user_recovery_codes; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.user_recovery_codes IS 'One-time MFA recovery codes. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: user_roles; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.user_roles (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    user_id uuid NOT NULL,
+    role text NOT NULL
+);
+
+
+ALTER TABLE auth.user_roles OWNER TO postgres;
+
+--
+-- Name: TABLE user_roles; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.user_roles IS 'Roles of users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: user_security_keys; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.user_security_keys (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    credential_id text NOT NULL,
+    credential_public_key bytea,
+    counter bigint DEFAULT 0 NOT NULL,
+    transports character varying(255) DEFAULT ''::character varying NOT NULL,
+    nickname text
+);
+
+
+ALTER TABLE auth.user_security_keys OWNER TO postgres;
+
+--
+-- Name: TABLE user_security_keys; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.user_security_keys IS 'User webauthn security keys. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: users; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.users (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_seen timestamp with time zone,
+    disabled boolean DEFAULT false NOT NULL,
+    display_name text DEFAULT ''::text NOT NULL,
+    avatar_url text DEFAULT ''::text NOT NULL,
+    locale character varying(35) NOT NULL,
+    email auth.email,
+    phone_number text,
+    password_hash text,
+    email_verified boolean DEFAULT false NOT NULL,
+    phone_number_verified boolean DEFAULT false NOT NULL,
+    new_email auth.email,
+    otp_method_last_used text,
+    otp_hash text,
+    otp_hash_expires_at timestamp with time zone DEFAULT now() NOT NULL,
+    default_role text DEFAULT 'user'::text NOT NULL,
+    is_anonymous boolean DEFAULT false NOT NULL,
+    totp_secret text,
+    active_mfa_type text,
+    ticket text,
+    ticket_expires_at timestamp with time zone DEFAULT now() NOT NULL,
+    metadata jsonb,
+    webauthn_current_challenge text,
+    tokens_valid_after timestamp with time zone,
+    new_phone_number text,
+    failed_sign_in_attempts integer DEFAULT 0 NOT NULL,
+    last_failed_sign_in_at timestamp with time zone,
+    locked_until timestamp with time zone,
+    banned_at timestamp with time zone,
+    banned_until timestamp with time zone,
+    ban_reason text,
+    deletion_scheduled_at timestamp with time zone,
+    deleted_at timestamp with time zone,
+    tenant_id text DEFAULT NULLIF(current_setting('hasura_auth.tenant_id'::text, true), ''::text),
+    active_organization_id uuid,
+    ticket_failed_attempts integer DEFAULT 0 NOT NULL,
+    otp_hash_failed_attempts integer DEFAULT 0 NOT NULL,
+    CONSTRAINT active_mfa_types_check CHECK (((active_mfa_type = 'totp'::text) OR (active_mfa_type = 'sms'::text)))
+);
+
+
+ALTER TABLE auth.users OWNER TO postgres;
+
+--
+-- Name: TABLE users; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.users IS 'User account information. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: COLUMN users.failed_sign_in_attempts; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.failed_sign_in_attempts IS 'Consecutive failed sign in attempts, reset after a successful sign in';
+
+
+--
+-- Name: COLUMN users.locked_until; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.locked_until IS 'Sign in attempts are rejected until this time';
+
+
+--
+-- Name: COLUMN users.banned_at; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.banned_at IS 'When the user was banned, sign ins and token refreshes are rejected while the ban lasts';
+
+
+--
+-- Name: COLUMN users.banned_until; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.banned_until IS 'When the ban expires, the ban is permanent if null';
+
+
+--
+-- Name: COLUMN users.deleted_at; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.deleted_at IS 'When the user was deleted with the anonymize deletion mode. Its personal data was erased and the row is kept so the rows referencing it stay valid';
+
+
+--
+-- Name: COLUMN users.deletion_scheduled_at; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.deletion_scheduled_at IS 'When the user is deleted, sign ins are rejected until then unless the deletion is cancelled';
+
+
+--
+-- Name: COLUMN users.tenant_id; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.tenant_id IS 'Tenant the user belongs to when Hasura Auth serves several tenants, users can only sign in to their tenant';
+
+
+--
+-- Name: COLUMN users.active_organization_id; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.active_organization_id IS 'Organization the access tokens of the user are issued for, it is only used if the user is a member of it';
+
+
+--
+-- Name: COLUMN users.new_phone_number; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.new_phone_number IS 'Phone number the user requested to change to. It replaces phone_number once the one time password sent to it is verified';
+
+
+--
+-- Name: COLUMN users.tokens_valid_after; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.tokens_valid_after IS 'Access tokens issued before this time are rejected by Hasura Auth when access token revocation is enabled';
+
+
+--
+-- Name: COLUMN users.ticket_failed_attempts; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.ticket_failed_attempts IS 'Failed attempts to answer the MFA challenge of the current ticket, the ticket is invalidated after too many';
+
+
+--
+-- Name: COLUMN users.otp_hash_failed_attempts; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON COLUMN auth.users.otp_hash_failed_attempts IS 'Failed attempts to verify the current one time password sent by SMS, the one time password is invalidated after too many';
+
+
+--
+-- Name: webhook_outbox; Type: TABLE; Schema: auth; Owner: postgres
+--
+
+CREATE TABLE auth.webhook_outbox (
+    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    endpoint text NOT NULL,
+    payload jsonb NOT NULL,
+    status text DEFAULT 'pending'::text NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_error text,
+    CONSTRAINT webhook_outbox_status_check CHECK ((status = ANY (ARRAY['pending'::text, 'failed'::text])))
+);
+
+
+ALTER TABLE auth.webhook_outbox OWNER TO postgres;
+
+--
+-- Name: TABLE webhook_outbox; Type: COMMENT; Schema: auth; Owner: postgres
+--
+
+COMMENT ON TABLE auth.webhook_outbox IS 'Webhook deliveries waiting to be sent. Deliveries are removed once sent and kept with the failed status if they can''t be delivered. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+
+--
+-- Name: account_deletions account_deletions_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.account_deletions
+    ADD CONSTRAINT account_deletions_pkey PRIMARY KEY (user_id);
+
+
+--
+-- Name: account_deletions account_deletions_ticket_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.account_deletions
+    ADD CONSTRAINT account_deletions_ticket_key UNIQUE (ticket);
+
+
+--
+-- Name: audit_logs audit_logs_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.audit_logs
+    ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: data_exports data_exports_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.data_exports
+    ADD CONSTRAINT data_exports_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: device_codes device_codes_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.device_codes
+    ADD CONSTRAINT device_codes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: device_codes device_codes_device_code_hash_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.device_codes
+    ADD CONSTRAINT device_codes_device_code_hash_key UNIQUE (device_code_hash);
+
+
+--
+-- Name: email_change_reverts email_change_reverts_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.email_change_reverts
+    ADD CONSTRAINT email_change_reverts_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: email_change_reverts email_change_reverts_ticket_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.email_change_reverts
+    ADD CONSTRAINT email_change_reverts_ticket_key UNIQUE (ticket);
+
+
+--
+-- Name: email_outbox email_outbox_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.email_outbox
+    ADD CONSTRAINT email_outbox_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: email_templates email_templates_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.email_templates
+    ADD CONSTRAINT email_templates_pkey PRIMARY KEY (locale, template, file);
+
+
+--
+-- Name: ip_sign_in_failures ip_sign_in_failures_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.ip_sign_in_failures
+    ADD CONSTRAINT ip_sign_in_failures_pkey PRIMARY KEY (ip);
+
+
+--
+-- Name: migrations migrations_name_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.migrations
+    ADD CONSTRAINT migrations_name_key UNIQUE (name);
+
+
+--
+-- Name: migrations migrations_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.migrations
+    ADD CONSTRAINT migrations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: new_device_sign_ins new_device_sign_ins_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.new_device_sign_ins
+    ADD CONSTRAINT new_device_sign_ins_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: new_device_sign_ins new_device_sign_ins_ticket_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.new_device_sign_ins
+    ADD CONSTRAINT new_device_sign_ins_ticket_key UNIQUE (ticket);
+
+
+--
+-- Name: oauth2_authorization_codes oauth2_authorization_codes_code_hash_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.oauth2_authorization_codes
+    ADD CONSTRAINT oauth2_authorization_codes_code_hash_key UNIQUE (code_hash);
+
+
+--
+-- Name: oauth2_authorization_codes oauth2_authorization_codes_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.oauth2_authorization_codes
+    ADD CONSTRAINT oauth2_authorization_codes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: oauth2_clients oauth2_clients_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.oauth2_clients
+    ADD CONSTRAINT oauth2_clients_pkey PRIMARY KEY (client_id);
+
+
+--
+-- Name: oauth2_consents oauth2_consents_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.oauth2_consents
+    ADD CONSTRAINT oauth2_consents_pkey PRIMARY KEY (user_id, client_id);
+
+
+--
+-- Name: organization_invites organization_invites_organization_id_email_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_invites
+    ADD CONSTRAINT organization_invites_organization_id_email_key UNIQUE (organization_id, email);
+
+
+--
+-- Name: organization_invites organization_invites_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_invites
+    ADD CONSTRAINT organization_invites_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: organization_invites organization_invites_ticket_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_invites
+    ADD CONSTRAINT organization_invites_ticket_key UNIQUE (ticket);
+
+
+--
+-- Name: organization_members organization_members_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT organization_members_pkey PRIMARY KEY (organization_id, user_id);
+
+
+--
+-- Name: organizations organizations_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.organizations
+    ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: personal_access_tokens personal_access_tokens_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.personal_access_tokens
+    ADD CONSTRAINT personal_access_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: personal_access_tokens personal_access_tokens_token_hash_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.personal_access_tokens
+    ADD CONSTRAINT personal_access_tokens_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: provider_requests provider_requests_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.provider_requests
+    ADD CONSTRAINT provider_requests_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: providers providers_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.providers
+    ADD CONSTRAINT providers_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: refresh_token_types refresh_token_types_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.refresh_token_types
+    ADD CONSTRAINT refresh_token_types_pkey PRIMARY KEY (value);
+
+
+--
+-- Name: refresh_tokens refresh_tokens_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.refresh_tokens
+    ADD CONSTRAINT refresh_tokens_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: roles roles_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.roles
+    ADD CONSTRAINT roles_pkey PRIMARY KEY (role);
+
+
+--
+-- Name: sign_in_methods sign_in_methods_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.sign_in_methods
+    ADD CONSTRAINT sign_in_methods_pkey PRIMARY KEY (method);
+
+
+--
+-- Name: token_exchanges token_exchanges_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.token_exchanges
+    ADD CONSTRAINT token_exchanges_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_providers user_providers_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_providers
+    ADD CONSTRAINT user_providers_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_providers user_providers_provider_id_provider_user_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_providers
+    ADD CONSTRAINT user_providers_provider_id_provider_user_id_key UNIQUE (provider_id, provider_user_id);
+
+
+--
+-- Name: user_providers user_providers_user_id_provider_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_providers
+    ADD CONSTRAINT user_providers_user_id_provider_id_key UNIQUE (user_id, provider_id);
+
+
+--
+-- Name: user_recovery_codes user_recovery_codes_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_recovery_codes
+    ADD CONSTRAINT user_recovery_codes_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_recovery_codes user_recovery_codes_user_id_code_hash_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_recovery_codes
+    ADD CONSTRAINT user_recovery_codes_user_id_code_hash_key UNIQUE (user_id, code_hash);
+
+
+--
+-- Name: user_roles user_roles_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_roles
+    ADD CONSTRAINT user_roles_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_roles user_roles_user_id_role_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_roles
+    ADD CONSTRAINT user_roles_user_id_role_key UNIQUE (user_id, role);
+
+
+--
+-- Name: user_security_keys user_security_key_credential_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_security_keys
+    ADD CONSTRAINT user_security_key_credential_id_key UNIQUE (credential_id);
+
+
+--
+-- Name: user_security_keys user_security_keys_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_security_keys
+    ADD CONSTRAINT user_security_keys_pkey PRIMARY KEY (id);
+
 
-SET default_tablespace = '';
+--
+-- Name: users users_email_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.users
+    ADD CONSTRAINT users_email_key UNIQUE (email);
 
-SET default_table_access_method = heap;
 
 --
--- Name: migrations; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: users users_phone_number_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.migrations (
-    id integer NOT NULL,
-    name character varying(100) NOT NULL,
-    hash character varying(40) NOT NULL,
-    executed_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP
-);
-
+ALTER TABLE ONLY auth.users
+    ADD CONSTRAINT users_phone_number_key UNIQUE (phone_number);
 
-ALTER TABLE auth.migrations OWNER TO postgres;
 
 --
--- Name: TABLE migrations; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: users users_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.migrations IS 'Internal table for tracking migrations. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+ALTER TABLE ONLY auth.users
+    ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
 --
--- Name: provider_requests; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: webhook_outbox webhook_outbox_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.provider_requests (
-    id uuid NOT NULL,
-    options jsonb
-);
-
+ALTER TABLE ONLY auth.webhook_outbox
+    ADD CONSTRAINT webhook_outbox_pkey PRIMARY KEY (id);
 
-ALTER TABLE auth.provider_requests OWNER TO postgres;
 
 --
--- Name: TABLE provider_requests; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: audit_logs_created_at_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.provider_requests IS 'Oauth requests, inserted before redirecting to the provider''s site. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX audit_logs_created_at_idx ON auth.audit_logs USING btree (created_at);
 
 
 --
--- Name: providers; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: audit_logs_user_id_created_at_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.providers (
-    id text NOT NULL
-);
-
+CREATE INDEX audit_logs_user_id_created_at_idx ON auth.audit_logs USING btree (user_id, created_at);
 
-ALTER TABLE auth.providers OWNER TO postgres;
 
 --
--- Name: TABLE providers; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: data_exports_status_next_attempt_at_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.providers IS 'List of available Oauth providers. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX data_exports_status_next_attempt_at_idx ON auth.data_exports USING btree (status, next_attempt_at);
 
 
 --
--- Name: refresh_token_types; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: data_exports_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.refresh_token_types (
-    value text NOT NULL,
-    comment text
-);
+CREATE INDEX data_exports_user_id_idx ON auth.data_exports USING btree (user_id);
 
 
-ALTER TABLE auth.refresh_token_types OWNER TO postgres;
+--
+-- Name: device_codes_user_code_pending_key; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE UNIQUE INDEX device_codes_user_code_pending_key ON auth.device_codes USING btree (user_code) WHERE ((user_id IS NULL) AND (NOT denied));
+
 
 --
--- Name: refresh_tokens; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: email_change_reverts_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.refresh_tokens (
-    id uuid DEFAULT gen_random_uuid() NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    expires_at timestamp with time zone NOT NULL,
-    user_id uuid NOT NULL,
-    metadata jsonb,
-    type text DEFAULT 'regular'::text NOT NULL,
-    refresh_token_hash character varying(255)
-);
+CREATE INDEX email_change_reverts_user_id_idx ON auth.email_change_reverts USING btree (user_id);
 
 
-ALTER TABLE auth.refresh_tokens OWNER TO postgres;
+--
+-- Name: email_outbox_status_next_attempt_at_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX email_outbox_status_next_attempt_at_idx ON auth.email_outbox USING btree (status, next_attempt_at);
+
 
 --
--- Name: TABLE refresh_tokens; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: new_device_sign_ins_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.refresh_tokens IS 'User refresh tokens. Hasura auth uses them to rotate new access tokens as long as the refresh token is not expired. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX new_device_sign_ins_user_id_idx ON auth.new_device_sign_ins USING btree (user_id);
 
 
 --
--- Name: roles; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: organization_members_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.roles (
-    role text NOT NULL
-);
+CREATE INDEX organization_members_user_id_idx ON auth.organization_members USING btree (user_id);
 
 
-ALTER TABLE auth.roles OWNER TO postgres;
+--
+-- Name: personal_access_tokens_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX personal_access_tokens_user_id_idx ON auth.personal_access_tokens USING btree (user_id);
+
 
 --
--- Name: TABLE roles; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: refresh_tokens_family_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.roles IS 'Persistent Hasura roles for users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX refresh_tokens_family_id_idx ON auth.refresh_tokens USING btree (family_id);
 
 
 --
--- Name: user_providers; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: refresh_tokens_refresh_token_hash_expires_at_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.user_providers (
-    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL,
-    user_id uuid NOT NULL,
-    access_token text NOT NULL,
-    refresh_token text,
-    provider_id text NOT NULL,
-    provider_user_id text NOT NULL
-);
+CREATE INDEX refresh_tokens_refresh_token_hash_expires_at_user_id_idx ON auth.refresh_tokens USING btree (refresh_token_hash, expires_at, user_id);
 
 
-ALTER TABLE auth.user_providers OWNER TO postgres;
+--
+-- Name: refresh_tokens_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX refresh_tokens_user_id_idx ON auth.refresh_tokens USING btree (user_id);
+
 
 --
--- Name: TABLE user_providers; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: refresh_tokens_user_id_device_fingerprint_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.user_providers IS 'Active providers for a given user. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX refresh_tokens_user_id_device_fingerprint_idx ON auth.refresh_tokens USING btree (user_id, device_fingerprint);
 
 
 --
--- Name: user_roles; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: token_exchanges_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.user_roles (
-    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    user_id uuid NOT NULL,
-    role text NOT NULL
-);
+CREATE INDEX token_exchanges_user_id_idx ON auth.token_exchanges USING btree (user_id);
 
 
-ALTER TABLE auth.user_roles OWNER TO postgres;
+--
+-- Name: users_created_at_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX users_created_at_id_idx ON auth.users USING btree (created_at, id);
+
 
 --
--- Name: TABLE user_roles; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: users_deletion_scheduled_at_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.user_roles IS 'Roles of users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX users_deletion_scheduled_at_idx ON auth.users USING btree (deletion_scheduled_at) WHERE (deletion_scheduled_at IS NOT NULL);
 
 
 --
--- Name: user_security_keys; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: users_tenant_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.user_security_keys (
-    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
-    user_id uuid NOT NULL,
-    credential_id text NOT NULL,
-    credential_public_key bytea,
-    counter bigint DEFAULT 0 NOT NULL,
-    transports character varying(255) DEFAULT ''::character varying NOT NULL,
-    nickname text
-);
+CREATE INDEX users_tenant_id_idx ON auth.users USING btree (tenant_id) WHERE (tenant_id IS NOT NULL);
 
 
-ALTER TABLE auth.user_security_keys OWNER TO postgres;
+--
+-- Name: users_display_name_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+--
+
+CREATE INDEX users_display_name_id_idx ON auth.users USING btree (display_name, id);
+
 
 --
--- Name: TABLE user_security_keys; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: users_email_id_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.user_security_keys IS 'User webauthn security keys. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE INDEX users_email_id_idx ON auth.users USING btree (COALESCE((email)::text, ''::text), id);
 
 
 --
--- Name: users; Type: TABLE; Schema: auth; Owner: postgres
+-- Name: webhook_outbox_status_next_attempt_at_idx; Type: INDEX; Schema: auth; Owner: postgres
 --
 
-CREATE TABLE auth.users (
-    id uuid DEFAULT public.gen_random_uuid() NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL,
-    last_seen timestamp with time zone,
-    disabled boolean DEFAULT false NOT NULL,
-    display_name text DEFAULT ''::text NOT NULL,
-    avatar_url text DEFAULT ''::text NOT NULL,
-    locale character varying(2) NOT NULL,
-    email auth.email,
-    phone_number text,
-    password_hash text,
-    email_verified boolean DEFAULT false NOT NULL,
-    phone_number_verified boolean DEFAULT false NOT NULL,
-    new_email auth.email,
-    otp_method_last_used text,
-    otp_hash text,
-    otp_hash_expires_at timestamp with time zone DEFAULT now() NOT NULL,
-    default_role text DEFAULT 'user'::text NOT NULL,
-    is_anonymous boolean DEFAULT false NOT NULL,
-    totp_secret text,
-    active_mfa_type text,
-    ticket text,
-    ticket_expires_at timestamp with time zone DEFAULT now() NOT NULL,
-    metadata jsonb,
-    webauthn_current_challenge text,
-    CONSTRAINT active_mfa_types_check CHECK (((active_mfa_type = 'totp'::text) OR (active_mfa_type = 'sms'::text)))
-);
+CREATE INDEX webhook_outbox_status_next_attempt_at_idx ON auth.webhook_outbox USING btree (status, next_attempt_at);
 
 
-ALTER TABLE auth.users OWNER TO postgres;
+--
+-- Name: email_templates notify_auth_email_templates_changes; Type: TRIGGER; Schema: auth; Owner: postgres
+--
+
+CREATE TRIGGER notify_auth_email_templates_changes AFTER INSERT OR DELETE OR UPDATE ON auth.email_templates FOR EACH ROW EXECUTE FUNCTION auth.notify_change();
+
 
 --
--- Name: TABLE users; Type: COMMENT; Schema: auth; Owner: postgres
+-- Name: roles notify_auth_roles_changes; Type: TRIGGER; Schema: auth; Owner: postgres
 --
 
-COMMENT ON TABLE auth.users IS 'User account information. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+CREATE TRIGGER notify_auth_roles_changes AFTER INSERT OR DELETE OR UPDATE ON auth.roles FOR EACH ROW EXECUTE FUNCTION auth.notify_change();
 
 
 --
--- Name: migrations migrations_name_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: sign_in_methods notify_auth_sign_in_methods_changes; Type: TRIGGER; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.migrations
-    ADD CONSTRAINT migrations_name_key UNIQUE (name);
+CREATE TRIGGER notify_auth_sign_in_methods_changes AFTER INSERT OR DELETE OR UPDATE ON auth.sign_in_methods FOR EACH ROW EXECUTE FUNCTION auth.notify_change();
 
 
 --
--- Name: migrations migrations_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: user_providers set_auth_user_providers_updated_at; Type: TRIGGER; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.migrations
-    ADD CONSTRAINT migrations_pkey PRIMARY KEY (id);
+CREATE TRIGGER set_auth_user_providers_updated_at BEFORE UPDATE ON auth.user_providers FOR EACH ROW EXECUTE FUNCTION auth.set_current_timestamp_updated_at();
 
 
 --
--- Name: provider_requests provider_requests_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: user_roles notify_auth_user_roles_changes; Type: TRIGGER; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.provider_requests
-    ADD CONSTRAINT provider_requests_pkey PRIMARY KEY (id);
+CREATE TRIGGER notify_auth_user_roles_changes AFTER INSERT OR DELETE OR UPDATE ON auth.user_roles FOR EACH ROW EXECUTE FUNCTION auth.notify_change('user_id');
 
 
 --
--- Name: providers providers_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: users notify_auth_users_changes; Type: TRIGGER; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.providers
-    ADD CONSTRAINT providers_pkey PRIMARY KEY (id);
+CREATE TRIGGER notify_auth_users_changes AFTER DELETE OR UPDATE OF disabled, default_role, banned_at, banned_until, deleted_at ON auth.users FOR EACH ROW EXECUTE FUNCTION auth.notify_change('id');
 
 
 --
--- Name: refresh_token_types refresh_token_types_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: users set_auth_users_updated_at; Type: TRIGGER; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.refresh_token_types
-    ADD CONSTRAINT refresh_token_types_pkey PRIMARY KEY (value);
+CREATE TRIGGER set_auth_users_updated_at BEFORE UPDATE ON auth.users FOR EACH ROW EXECUTE FUNCTION auth.set_current_timestamp_updated_at();
 
 
 --
--- Name: refresh_tokens refresh_tokens_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: account_deletions fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.refresh_tokens
-    ADD CONSTRAINT refresh_tokens_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY auth.account_deletions
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: roles roles_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: data_exports data_exports_user_id_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.roles
-    ADD CONSTRAINT roles_pkey PRIMARY KEY (role);
+ALTER TABLE ONLY auth.data_exports
+    ADD CONSTRAINT data_exports_user_id_fkey FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_providers user_providers_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: device_codes fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_providers
-    ADD CONSTRAINT user_providers_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY auth.device_codes
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_providers user_providers_provider_id_provider_user_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: email_change_reverts fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_providers
-    ADD CONSTRAINT user_providers_provider_id_provider_user_id_key UNIQUE (provider_id, provider_user_id);
+ALTER TABLE ONLY auth.email_change_reverts
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_providers user_providers_user_id_provider_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: new_device_sign_ins fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_providers
-    ADD CONSTRAINT user_providers_user_id_provider_id_key UNIQUE (user_id, provider_id);
+ALTER TABLE ONLY auth.new_device_sign_ins
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_roles user_roles_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: oauth2_authorization_codes fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_roles
-    ADD CONSTRAINT user_roles_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY auth.oauth2_authorization_codes
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_roles user_roles_user_id_role_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: oauth2_consents fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_roles
-    ADD CONSTRAINT user_roles_user_id_role_key UNIQUE (user_id, role);
+ALTER TABLE ONLY auth.oauth2_consents
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_security_keys user_security_key_credential_id_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: oauth2_authorization_codes fk_client; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_security_keys
-    ADD CONSTRAINT user_security_key_credential_id_key UNIQUE (credential_id);
+ALTER TABLE ONLY auth.oauth2_authorization_codes
+    ADD CONSTRAINT fk_client FOREIGN KEY (client_id) REFERENCES auth.oauth2_clients(client_id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_security_keys user_security_keys_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: oauth2_consents fk_client; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.user_security_keys
-    ADD CONSTRAINT user_security_keys_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY auth.oauth2_consents
+    ADD CONSTRAINT fk_client FOREIGN KEY (client_id) REFERENCES auth.oauth2_clients(client_id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: users users_email_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: oauth2_clients fk_default_role; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.users
-    ADD CONSTRAINT users_email_key UNIQUE (email);
+ALTER TABLE ONLY auth.oauth2_clients
+    ADD CONSTRAINT fk_default_role FOREIGN KEY (default_role) REFERENCES auth.roles(role) ON UPDATE CASCADE ON DELETE RESTRICT;
 
 
 --
--- Name: users users_phone_number_key; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: organization_invites fk_invited_by; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.users
-    ADD CONSTRAINT users_phone_number_key UNIQUE (phone_number);
+ALTER TABLE ONLY auth.organization_invites
+    ADD CONSTRAINT fk_invited_by FOREIGN KEY (invited_by) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE SET NULL;
 
 
 --
--- Name: users users_pkey; Type: CONSTRAINT; Schema: auth; Owner: postgres
+-- Name: organization_invites fk_organization; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-ALTER TABLE ONLY auth.users
-    ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY auth.organization_invites
+    ADD CONSTRAINT fk_organization FOREIGN KEY (organization_id) REFERENCES auth.organizations(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: refresh_tokens_refresh_token_hash_expires_at_user_id_idx; Type: INDEX; Schema: auth; Owner: postgres
+-- Name: organization_members fk_organization; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-CREATE INDEX refresh_tokens_refresh_token_hash_expires_at_user_id_idx ON auth.refresh_tokens USING btree (refresh_token_hash, expires_at, user_id);
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT fk_organization FOREIGN KEY (organization_id) REFERENCES auth.organizations(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: user_providers set_auth_user_providers_updated_at; Type: TRIGGER; Schema: auth; Owner: postgres
+-- Name: organization_members fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-CREATE TRIGGER set_auth_user_providers_updated_at BEFORE UPDATE ON auth.user_providers FOR EACH ROW EXECUTE FUNCTION auth.set_current_timestamp_updated_at();
+ALTER TABLE ONLY auth.organization_members
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
--- Name: users set_auth_users_updated_at; Type: TRIGGER; Schema: auth; Owner: postgres
+-- Name: users fk_active_organization; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
 
-CREATE TRIGGER set_auth_users_updated_at BEFORE UPDATE ON auth.users FOR EACH ROW EXECUTE FUNCTION auth.set_current_timestamp_updated_at();
+ALTER TABLE ONLY auth.users
+    ADD CONSTRAINT fk_active_organization FOREIGN KEY (active_organization_id) REFERENCES auth.organizations(id) ON UPDATE CASCADE ON DELETE SET NULL;
 
 
 --
@@ -461,7 +1700,7 @@ ALTER TABLE ONLY auth.user_providers
 --
 
 ALTER TABLE ONLY auth.user_roles
-    ADD CONSTRAINT fk_role FOREIGN KEY (role) REFERENCES auth.roles(role) ON UPDATE CASCADE ON DELETE RESTRICT;
+    ADD CONSTRAINT fk_role FOREIGN KEY (role) REFERENCES auth.roles(role) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
 --
@@ -496,6 +1735,30 @@ ALTER TABLE ONLY auth.user_security_keys
     ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
 
 
+--
+-- Name: personal_access_tokens fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.personal_access_tokens
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: token_exchanges fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.token_exchanges
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
+--
+-- Name: user_recovery_codes fk_user; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
+--
+
+ALTER TABLE ONLY auth.user_recovery_codes
+    ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users(id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+
 --
 -- Name: refresh_tokens refresh_tokens_types_fkey; Type: FK CONSTRAINT; Schema: auth; Owner: postgres
 --
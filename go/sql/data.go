@@ -2,6 +2,7 @@
 package sql
 
 import (
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -21,6 +22,22 @@ func Text[T ~string](value T) pgtype.Text {
 	}
 }
 
+// NullableText is like Text but an empty string is stored as NULL.
+func NullableText[T ~string](value T) pgtype.Text {
+	return pgtype.Text{
+		String: string(value),
+		Valid:  value != "",
+	}
+}
+
+// NullableInt4 is like Text but for integers, 0 is stored as NULL.
+func NullableInt4(value int) pgtype.Int4 {
+	return pgtype.Int4{
+		Int32: int32(value), //nolint:gosec
+		Valid: value != 0,
+	}
+}
+
 func TimestampTz(t time.Time) pgtype.Timestamptz {
 	return pgtype.Timestamptz{
 		Time:             t,
@@ -28,3 +45,14 @@ func TimestampTz(t time.Time) pgtype.Timestamptz {
 		Valid:            true,
 	}
 }
+
+// QueryName returns the name sqlc gives to the query in its first line, i.e.
+// "-- name: GetUser :one".
+func QueryName(query string) string {
+	rest, ok := strings.CutPrefix(query, "-- name: ")
+	if !ok {
+		return "unknown"
+	}
+	name, _, _ := strings.Cut(rest, " ")
+	return name
+}
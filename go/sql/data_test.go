@@ -0,0 +1,38 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func TestQueryName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "sqlc query",
+			query:    "-- name: GetUser :one\nSELECT * FROM auth.users WHERE id = $1",
+			expected: "GetUser",
+		},
+		{
+			name:     "other query",
+			query:    "SELECT 1",
+			expected: "unknown",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sql.QueryName(tc.query); got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
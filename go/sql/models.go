@@ -9,6 +9,94 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// Tickets sent to users when they schedule the deletion of their account so they can cancel it during the grace period. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthAccountDeletion struct {
+	UserID    uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Ticket    string
+}
+
+// Authentication events such as sign ups, sign ins and admin actions. Entries are kept after the user is deleted. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthAuditLog struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Event     string
+	Outcome   string
+	Actor     string
+	UserID    pgtype.UUID
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+	Metadata  []byte
+}
+
+// Exports of the data stored about users. Exports are assembled in the background and deleted once they expire. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthDataExport struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamptz
+	UserID        uuid.UUID
+	RequestedBy   string
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	Data          []byte
+	CompletedAt   pgtype.Timestamptz
+	ExpiresAt     pgtype.Timestamptz
+}
+
+// Pending device authorization requests (RFC 8628). Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthDeviceCode struct {
+	ID             uuid.UUID
+	CreatedAt      pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
+	DeviceCodeHash string
+	UserCode       string
+	UserID         pgtype.UUID
+	Denied         bool
+	LastPolledAt   pgtype.Timestamptz
+}
+
+// Tickets sent to the previous email address of a user when the email is changed so the change can be undone. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthEmailChangeRevert struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	UserID    uuid.UUID
+	Ticket    string
+	OldEmail  string
+	ExpiresAt pgtype.Timestamptz
+}
+
+// Emails waiting to be sent. Emails are removed once sent and kept with the failed status if they can't be delivered. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthEmailOutbox struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamptz
+	Recipient     string
+	Subject       string
+	Body          string
+	Headers       []byte
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+}
+
+// Overrides of the email and sms templates. Each row replaces the file of the template for the given locale, for instance the body.html of the email-verify template in en. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthEmailTemplate struct {
+	Locale    string
+	Template  string
+	File      string
+	Content   string
+	UpdatedAt pgtype.Timestamptz
+}
+
+// Failed sign in attempts of each IP address. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthIpSignInFailure struct {
+	Ip           string
+	Attempts     int32
+	LastFailedAt pgtype.Timestamptz
+	LockedUntil  pgtype.Timestamptz
+}
+
 // Internal table for tracking migrations. Don't modify its structure as Hasura Auth relies on it to function properly.
 type AuthMigration struct {
 	ID         int32
@@ -17,6 +105,94 @@ type AuthMigration struct {
 	ExecutedAt pgtype.Timestamp
 }
 
+// Tickets sent to users when they sign in from a new device so they can sign out every session if it wasn't them. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthNewDeviceSignIn struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	UserID    uuid.UUID
+	Ticket    string
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+}
+
+// Authorization codes issued to OAuth2 clients signing in users with OpenID Connect. Only the hash of the code is stored. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOauth2AuthorizationCode struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamptz
+	ExpiresAt     pgtype.Timestamptz
+	CodeHash      string
+	ClientID      string
+	UserID        uuid.UUID
+	RedirectUri   string
+	Scopes        []string
+	Nonce         pgtype.Text
+	CodeChallenge pgtype.Text
+}
+
+// OAuth2 clients that can get access tokens with the client credentials grant or sign in users with OpenID Connect. Only the hash of the client secret is stored. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOauth2Client struct {
+	ClientID             string
+	CreatedAt            pgtype.Timestamptz
+	ClientSecretHash     string
+	Description          string
+	DefaultRole          string
+	AllowedRoles         []string
+	TokenExchangeEnabled bool
+	RedirectUris         []string
+	SkipConsent          bool
+	// Grants the client can use to get access tokens, client_credentials or authorization_code. The token exchange grant is enabled with token_exchange_enabled
+	GrantTypes []string
+}
+
+// Scopes users allowed OAuth2 clients to access. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOauth2Consent struct {
+	UserID    uuid.UUID
+	ClientID  string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	Scopes    []string
+}
+
+// Organizations users can be members of. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOrganization struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Name      string
+}
+
+// Pending invitations to join an organization, they are deleted once accepted. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOrganizationInvite struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	CreatedAt      pgtype.Timestamptz
+	ExpiresAt      pgtype.Timestamptz
+	Email          string
+	Role           string
+	Ticket         string
+	InvitedBy      pgtype.UUID
+}
+
+// Members of the organizations and their role in the organization. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthOrganizationMember struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	CreatedAt      pgtype.Timestamptz
+	Role           string
+}
+
+// Long-lived tokens users can exchange for a session. Only the hash of the token is stored. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthPersonalAccessToken struct {
+	ID         uuid.UUID
+	CreatedAt  pgtype.Timestamptz
+	UserID     uuid.UUID
+	Name       string
+	TokenHash  string
+	ExpiresAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+	Metadata   []byte
+}
+
 // List of available Oauth providers. Don't modify its structure as Hasura Auth relies on it to function properly.
 type AuthProvider struct {
 	ID string
@@ -30,13 +206,37 @@ type AuthProviderRequest struct {
 
 // User refresh tokens. Hasura auth uses them to rotate new access tokens as long as the refresh token is not expired. Don't modify its structure as Hasura Auth relies on it to function properly.
 type AuthRefreshToken struct {
-	ID               uuid.UUID
-	CreatedAt        pgtype.Timestamptz
-	ExpiresAt        pgtype.Timestamptz
-	UserID           uuid.UUID
-	Metadata         []byte
-	Type             RefreshTokenType
-	RefreshTokenHash pgtype.Text
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	ExpiresAt pgtype.Timestamptz
+	UserID    uuid.UUID
+	Metadata  []byte
+	Type      RefreshTokenType
+	// SHA-256 of the refresh token, the refresh token itself is never stored
+	RefreshTokenHash string
+	FamilyID         uuid.UUID
+	RotatedAt        pgtype.Timestamptz
+	LastUsedAt       pgtype.Timestamptz
+	IpAddress        pgtype.Text
+	UserAgent        pgtype.Text
+	// Hash of the IP address and user agent the refresh token was issued to, used to detect sign ins from new devices
+	DeviceFingerprint pgtype.Text
+	// Client the session was started by, as sent in the X-Hasura-Auth-Client header
+	ClientID pgtype.Text
+	// Seconds the refresh tokens of the session are valid for, NULL for the default lifetime
+	RefreshTokenExpiresIn pgtype.Int4
+	// Seconds the access tokens of the session are valid for, NULL for the default lifetime
+	AccessTokenExpiresIn pgtype.Int4
+	// Version of the client that last used the session, as sent in the X-Hasura-Auth-Client-Version header
+	ClientVersion pgtype.Text
+	// ISO code of the country the session was last used from, according to the GeoIP database
+	Country pgtype.Text
+	// City the session was last used from, according to the GeoIP database
+	City pgtype.Text
+	// Approximate latitude the session was last used from, according to the GeoIP database
+	Latitude pgtype.Float8
+	// Approximate longitude the session was last used from, according to the GeoIP database
+	Longitude pgtype.Float8
 }
 
 type AuthRefreshTokenType struct {
@@ -49,6 +249,24 @@ type AuthRole struct {
 	Role string
 }
 
+// Sign in methods enabled or disabled at runtime, overriding the configuration. Methods without a row follow the configuration. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthSignInMethod struct {
+	// Sign in method, for instance email-password, anonymous or provider-github
+	Method    string
+	Enabled   bool
+	UpdatedAt pgtype.Timestamptz
+}
+
+// Record of the access tokens exchanged by OAuth2 clients on behalf of users. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthTokenExchange struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	ClientID  string
+	UserID    uuid.UUID
+	Roles     []string
+	ExpiresAt pgtype.Timestamptz
+}
+
 // User account information. Don't modify its structure as Hasura Auth relies on it to function properly.
 type AuthUser struct {
 	ID                       uuid.UUID
@@ -76,6 +294,32 @@ type AuthUser struct {
 	TicketExpiresAt          pgtype.Timestamptz
 	Metadata                 []byte
 	WebauthnCurrentChallenge pgtype.Text
+	// Access tokens issued before this time are rejected by Hasura Auth when access token revocation is enabled
+	TokensValidAfter pgtype.Timestamptz
+	// Phone number the user requested to change to. It replaces phone_number once the one time password sent to it is verified
+	NewPhoneNumber pgtype.Text
+	// Consecutive failed sign in attempts, reset after a successful sign in
+	FailedSignInAttempts int32
+	LastFailedSignInAt   pgtype.Timestamptz
+	// Sign in attempts are rejected until this time
+	LockedUntil pgtype.Timestamptz
+	// When the user was banned, sign ins and token refreshes are rejected while the ban lasts
+	BannedAt pgtype.Timestamptz
+	// When the ban expires, the ban is permanent if null
+	BannedUntil pgtype.Timestamptz
+	BanReason   pgtype.Text
+	// When the user is deleted, sign ins are rejected until then unless the deletion is cancelled
+	DeletionScheduledAt pgtype.Timestamptz
+	// When the user was deleted with the anonymize deletion mode. Its personal data was erased and the row is kept so the rows referencing it stay valid
+	DeletedAt pgtype.Timestamptz
+	// Tenant the user belongs to when Hasura Auth serves several tenants, users can only sign in to their tenant
+	TenantID pgtype.Text
+	// Organization the access tokens of the user are issued for, it is only used if the user is a member of it
+	ActiveOrganizationID pgtype.UUID
+	// Failed attempts to answer the MFA challenge of the current ticket, the ticket is invalidated after too many
+	TicketFailedAttempts int32
+	// Failed attempts to verify the current one time password sent by SMS, the one time password is invalidated after too many
+	OtpHashFailedAttempts int32
 }
 
 // Active providers for a given user. Don't modify its structure as Hasura Auth relies on it to function properly.
@@ -90,6 +334,14 @@ type AuthUserProvider struct {
 	ProviderUserID string
 }
 
+// One-time MFA recovery codes. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthUserRecoveryCode struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	UserID    uuid.UUID
+	CodeHash  string
+}
+
 // Roles of users. Don't modify its structure as Hasura Auth relies on it to function properly.
 type AuthUserRole struct {
 	ID        uuid.UUID
@@ -108,3 +360,15 @@ type AuthUserSecurityKey struct {
 	Transports          string
 	Nickname            pgtype.Text
 }
+
+// Webhook deliveries waiting to be sent. Deliveries are removed once sent and kept with the failed status if they can't be delivered. Don't modify its structure as Hasura Auth relies on it to function properly.
+type AuthWebhookOutbox struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamptz
+	Endpoint      string
+	Payload       []byte
+	Status        string
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+}
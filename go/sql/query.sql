@@ -6,18 +6,36 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM auth.users
 WHERE email = $1 LIMIT 1;
 
+-- name: GetUserByPhoneNumber :one
+SELECT * FROM auth.users
+WHERE phone_number = $1 LIMIT 1;
+
 -- name: GetUserRoles :many
 SELECT * FROM auth.user_roles
 WHERE user_id = $1;
 
 -- name: GetUserByRefreshTokenHash :one
 WITH refresh_token AS (
+    -- refresh tokens rotated less than reuse_interval seconds ago are still accepted so
+    -- clients refreshing the session concurrently don't look like a reuse
     SELECT * FROM auth.refresh_tokens
-    WHERE refresh_token_hash = $1 AND type = $2 AND expires_at > now()
+    WHERE refresh_token_hash = @refresh_token_hash AND type = @type AND expires_at > now()
+        AND (
+            rotated_at IS NULL
+            OR rotated_at > now() - make_interval(secs => @reuse_interval::integer)
+        )
     LIMIT 1
 )
 SELECT * FROM auth.users
-WHERE id = (SELECT user_id FROM refresh_token) LIMIT 1;
+WHERE id = (SELECT user_id FROM refresh_token)
+    -- the connections of each tenant set hasura_auth.tenant_id
+    AND tenant_id IS NOT DISTINCT FROM NULLIF(current_setting('hasura_auth.tenant_id', true), '')
+LIMIT 1;
+
+-- name: GetRefreshTokenByHash :one
+SELECT * FROM auth.refresh_tokens
+WHERE refresh_token_hash = $1 AND expires_at > now() AND rotated_at IS NULL
+LIMIT 1;
 
 -- name: InsertUser :one
 WITH inserted_user AS (
@@ -33,9 +51,11 @@ WITH inserted_user AS (
         email_verified,
         locale,
         default_role,
-        metadata
+        metadata,
+        phone_number,
+        is_anonymous
     ) VALUES (
-      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, @phone_number, @is_anonymous
     )
     RETURNING *
 )
@@ -64,16 +84,43 @@ WITH inserted_user AS (
     )
     RETURNING id
 ), inserted_refresh_token AS (
-    INSERT INTO auth.refresh_tokens
-        (user_id, refresh_token_hash, expires_at)
+    INSERT INTO auth.refresh_tokens (
+        user_id,
+        refresh_token_hash,
+        expires_at,
+        ip_address,
+        user_agent,
+        client_id,
+        client_version,
+        country,
+        city,
+        latitude,
+        longitude,
+        refresh_token_expires_in,
+        access_token_expires_in
+    )
     VALUES
-        ($1, @refresh_token_hash, @refresh_token_expires_at)
+        (
+            $1,
+            @refresh_token_hash,
+            @refresh_token_expires_at,
+            @refresh_token_ip_address,
+            @refresh_token_user_agent,
+            sqlc.narg('refresh_token_client_id'),
+            sqlc.narg('refresh_token_client_version'),
+            sqlc.narg('refresh_token_country'),
+            sqlc.narg('refresh_token_city'),
+            sqlc.narg('refresh_token_latitude'),
+            sqlc.narg('refresh_token_longitude'),
+            sqlc.narg('refresh_token_expires_in'),
+            sqlc.narg('access_token_expires_in')
+        )
     RETURNING id AS refresh_token_id
 ), inserted_security_key AS (
     INSERT INTO auth.user_security_keys
-        (user_id, credential_id, credential_public_key, nickname)
+        (user_id, credential_id, credential_public_key, transports, nickname)
     VALUES
-        ($1, @credential_id, @credential_public_key, @nickname)
+        ($1, @credential_id, @credential_public_key, @transports, @nickname)
 )
 INSERT INTO auth.user_roles (user_id, role)
     SELECT inserted_user.id, roles.role
@@ -101,9 +148,9 @@ WITH inserted_user AS (
     RETURNING id
 ), inserted_security_key AS (
     INSERT INTO auth.user_security_keys
-        (user_id, credential_id, credential_public_key, nickname)
+        (user_id, credential_id, credential_public_key, transports, nickname)
     VALUES
-        ($1, @credential_id, @credential_public_key, @nickname)
+        ($1, @credential_id, @credential_public_key, @transports, @nickname)
 )
 INSERT INTO auth.user_roles (user_id, role)
     SELECT inserted_user.id, roles.role
@@ -130,8 +177,35 @@ WITH inserted_user AS (
     )
     RETURNING id, created_at
 ), inserted_refresh_token AS (
-    INSERT INTO auth.refresh_tokens (user_id, refresh_token_hash, expires_at)
-        SELECT inserted_user.id, @refresh_token_hash, @refresh_token_expires_at
+    INSERT INTO auth.refresh_tokens (
+        user_id,
+        refresh_token_hash,
+        expires_at,
+        ip_address,
+        user_agent,
+        client_id,
+        client_version,
+        country,
+        city,
+        latitude,
+        longitude,
+        refresh_token_expires_in,
+        access_token_expires_in
+    )
+        SELECT
+            inserted_user.id,
+            @refresh_token_hash,
+            @refresh_token_expires_at,
+            @refresh_token_ip_address,
+            @refresh_token_user_agent,
+            sqlc.narg('refresh_token_client_id'),
+            sqlc.narg('refresh_token_client_version'),
+            sqlc.narg('refresh_token_country'),
+            sqlc.narg('refresh_token_city'),
+            sqlc.narg('refresh_token_latitude'),
+            sqlc.narg('refresh_token_longitude'),
+            sqlc.narg('refresh_token_expires_in'),
+            sqlc.narg('access_token_expires_in')
         FROM inserted_user
     RETURNING id AS refresh_token_id
 )
@@ -141,25 +215,169 @@ INSERT INTO auth.user_roles (user_id, role)
 RETURNING (SELECT refresh_token_id FROM inserted_refresh_token), user_id;
 
 -- name: InsertRefreshtoken :one
-INSERT INTO auth.refresh_tokens (user_id, refresh_token_hash, expires_at, type, metadata)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO auth.refresh_tokens (
+    user_id,
+    refresh_token_hash,
+    expires_at,
+    type,
+    metadata,
+    ip_address,
+    user_agent,
+    client_id,
+    client_version,
+    country,
+    city,
+    latitude,
+    longitude,
+    refresh_token_expires_in,
+    access_token_expires_in
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    @ip_address,
+    @user_agent,
+    sqlc.narg('client_id'),
+    sqlc.narg('client_version'),
+    sqlc.narg('country'),
+    sqlc.narg('city'),
+    sqlc.narg('latitude'),
+    sqlc.narg('longitude'),
+    sqlc.narg('refresh_token_expires_in'),
+    sqlc.narg('access_token_expires_in')
+)
 RETURNING id;
 
--- name: RefreshTokenAndGetUserRoles :many
-WITH refreshed_token AS (
+-- name: RotateRefreshTokenAndGetUserRoles :many
+WITH rotated_token AS (
+    -- a token rotated less than reuse_interval seconds ago can be rotated again, it keeps
+    -- the time it was first rotated so the interval isn't extended
     UPDATE auth.refresh_tokens
-    SET expires_at = $2
-    WHERE refresh_token_hash = $1
-    RETURNING id AS refresh_token_id, user_id
+    SET rotated_at = COALESCE(rotated_at, now())
+    WHERE auth.refresh_tokens.refresh_token_hash = @old_refresh_token_hash
+        AND (
+            auth.refresh_tokens.rotated_at IS NULL
+            OR auth.refresh_tokens.rotated_at
+                > now() - make_interval(secs => @reuse_interval::integer)
+        )
+    RETURNING
+        user_id,
+        family_id,
+        type,
+        metadata,
+        created_at,
+        expires_at,
+        client_id,
+        client_version,
+        refresh_token_expires_in,
+        access_token_expires_in
+),
+new_token AS (
+    -- created_at is carried over so it reflects when the session started and the
+    -- lifetimes of the session, if it has its own, are kept. With sliding expiration the
+    -- new token expires a lifetime from now, up to max_lifetime after the session
+    -- started, otherwise it expires along with the old one
+    INSERT INTO auth.refresh_tokens (
+        user_id,
+        refresh_token_hash,
+        expires_at,
+        type,
+        metadata,
+        family_id,
+        created_at,
+        last_used_at,
+        ip_address,
+        user_agent,
+        client_id,
+        client_version,
+        country,
+        city,
+        latitude,
+        longitude,
+        refresh_token_expires_in,
+        access_token_expires_in
+    )
+    SELECT
+        user_id,
+        @refresh_token_hash,
+        CASE
+            WHEN NOT @sliding_expiration::boolean THEN expires_at
+            ELSE LEAST(
+                CASE
+                    WHEN refresh_token_expires_in IS NULL THEN @expires_at::timestamptz
+                    ELSE now() + make_interval(secs => refresh_token_expires_in)
+                END,
+                created_at + make_interval(secs => sqlc.narg('max_lifetime')::integer)
+            )
+        END AS expires_at,
+        type,
+        metadata,
+        family_id,
+        created_at,
+        now(),
+        @ip_address,
+        @user_agent,
+        COALESCE(client_id, sqlc.narg('client_id')::text) AS client_id,
+        COALESCE(sqlc.narg('client_version')::text, client_version) AS client_version,
+        sqlc.narg('country'),
+        sqlc.narg('city'),
+        sqlc.narg('latitude'),
+        sqlc.narg('longitude'),
+        COALESCE(
+            refresh_token_expires_in, sqlc.narg('refresh_token_expires_in')::integer
+        ) AS refresh_token_expires_in,
+        COALESCE(
+            access_token_expires_in, sqlc.narg('access_token_expires_in')::integer
+        ) AS access_token_expires_in
+    FROM rotated_token
+    RETURNING id AS refresh_token_id, user_id, access_token_expires_in
+),
+pruned_tokens AS (
+    -- the tokens of the family rotated before are deleted, the one rotated now is kept
+    -- so its reuse can be detected until the next rotation
+    DELETE FROM auth.refresh_tokens
+    WHERE auth.refresh_tokens.family_id IN (SELECT family_id FROM rotated_token)
+        AND auth.refresh_tokens.rotated_at
+            <= now() - make_interval(secs => @reuse_interval::integer)
 ),
 updated_user AS (
     UPDATE auth.users
     SET last_seen = now()
-    FROM refreshed_token
-    WHERE auth.users.id = refreshed_token.user_id
+    FROM new_token
+    WHERE auth.users.id = new_token.user_id
+)
+SELECT new_token.refresh_token_id, new_token.access_token_expires_in, role FROM auth.user_roles
+RIGHT JOIN new_token ON auth.user_roles.user_id = new_token.user_id;
+
+-- name: DeleteRefreshTokenFamilyByRotatedHash :many
+DELETE FROM auth.refresh_tokens
+WHERE family_id = (
+    SELECT reused.family_id FROM auth.refresh_tokens AS reused
+    WHERE reused.refresh_token_hash = $1 AND reused.type = 'regular'
+        AND reused.rotated_at IS NOT NULL
+    LIMIT 1
+)
+RETURNING user_id, family_id;
+
+-- name: GetUserSessions :many
+SELECT * FROM auth.refresh_tokens
+WHERE user_id = $1 AND type = 'regular' AND rotated_at IS NULL AND expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: LockUserSessions :exec
+SELECT pg_advisory_xact_lock(hashtextextended('auth.user_sessions:' || CAST(@user_id::uuid AS text), 0));
+
+-- name: DeleteUserSession :many
+DELETE FROM auth.refresh_tokens
+WHERE family_id = (
+    SELECT session.family_id FROM auth.refresh_tokens AS session
+    WHERE session.id = $1 AND session.user_id = $2 AND session.type = 'regular'
+        AND session.rotated_at IS NULL
 )
-SELECT refreshed_token.refresh_token_id, role FROM auth.user_roles
-RIGHT JOIN refreshed_token ON auth.user_roles.user_id = refreshed_token.user_id;
+RETURNING id;
 
 -- name: UpdateUserLastSeen :one
 UPDATE auth.users
@@ -169,10 +387,115 @@ RETURNING last_seen;
 
 -- name: UpdateUserTicket :one
 UPDATE auth.users
-SET (ticket, ticket_expires_at) = ($2, $3)
+SET (ticket, ticket_expires_at, ticket_failed_attempts) = ($2, $3, 0)
 WHERE id = $1
 RETURNING id;
 
+-- name: UpdateUserTicketFailedAttempts :one
+UPDATE auth.users
+SET ticket_failed_attempts = ticket_failed_attempts + 1,
+    ticket = CASE
+        WHEN ticket_failed_attempts + 1 >= @max_attempts::integer THEN NULL
+        ELSE ticket
+    END
+WHERE ticket = @ticket AND ticket_expires_at > now()
+RETURNING ticket_failed_attempts;
+
+-- name: UpdateUserConsumeTicket :one
+UPDATE auth.users
+SET ticket = NULL
+WHERE ticket = $1 AND ticket_expires_at > now()
+RETURNING *;
+
+-- name: GetUserByTicket :one
+SELECT * FROM auth.users
+WHERE ticket = $1 AND ticket_expires_at > now()
+LIMIT 1;
+
+-- name: UpdateUserVerifyEmail :one
+UPDATE auth.users
+SET email_verified = true
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateUserConfirmChangeEmail :one
+UPDATE auth.users
+SET (email, new_email) = (new_email, NULL)
+WHERE id = $1 AND new_email IS NOT NULL
+RETURNING *;
+
+-- name: UpdateUserOTPHash :one
+UPDATE auth.users
+SET (otp_hash, otp_hash_expires_at, otp_method_last_used, otp_hash_failed_attempts) = ($2, $3, $4, 0)
+WHERE id = $1
+RETURNING id;
+
+-- name: UpdateUserOTPHashFailedAttempts :one
+UPDATE auth.users
+SET otp_hash_failed_attempts = otp_hash_failed_attempts + 1,
+    otp_hash = CASE
+        WHEN otp_hash_failed_attempts + 1 >= @max_attempts::integer THEN NULL
+        ELSE otp_hash
+    END
+WHERE id = @id AND otp_hash = @otp_hash AND otp_hash_expires_at > now()
+RETURNING otp_hash_failed_attempts;
+
+-- name: UpdateUserConsumeOTP :one
+UPDATE auth.users
+SET (otp_hash, phone_number_verified) = (NULL, true)
+WHERE id = $1 AND otp_hash = $2 AND otp_hash_expires_at > now()
+RETURNING *;
+
+-- name: UpdateUserVerifyPhoneNumber :one
+UPDATE auth.users
+SET (otp_hash, phone_number_verified) = (NULL, true)
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateUserChangePhoneNumber :exec
+UPDATE auth.users
+SET otp_hash = $2, otp_hash_expires_at = $3, otp_method_last_used = $4, new_phone_number = $5,
+    otp_hash_failed_attempts = 0
+WHERE id = $1;
+
+-- name: UpdateUserConfirmChangePhoneNumber :one
+UPDATE auth.users
+SET phone_number = new_phone_number,
+    new_phone_number = NULL,
+    phone_number_verified = true,
+    otp_hash = NULL
+WHERE id = $1 AND otp_hash = $2 AND otp_hash_expires_at > now() AND new_phone_number IS NOT NULL
+RETURNING *;
+
+-- name: UpdateUserConfirmNewPhoneNumber :one
+UPDATE auth.users
+SET phone_number = new_phone_number,
+    new_phone_number = NULL,
+    phone_number_verified = true,
+    otp_hash = NULL
+WHERE id = $1 AND new_phone_number IS NOT NULL
+RETURNING *;
+
+-- name: UpdateUserTotpSecret :exec
+UPDATE auth.users
+SET totp_secret = $2
+WHERE id = $1;
+
+-- name: UpdateUserActiveMFAType :exec
+UPDATE auth.users
+SET active_mfa_type = $2
+WHERE id = $1;
+
+-- name: UpdateUserMetadata :exec
+UPDATE auth.users
+SET metadata = $2
+WHERE id = $1;
+
+-- name: UpdateUserAvatarURL :exec
+UPDATE auth.users
+SET avatar_url = $2
+WHERE id = $1;
+
 -- name: UpdateUserChangeEmail :one
 UPDATE auth.users
 SET (ticket, ticket_expires_at, new_email) = ($2, $3, $4)
@@ -183,6 +506,22 @@ RETURNING *;
 SELECT COUNT(*) FROM auth.user_security_keys
 WHERE user_id = $1;
 
+-- name: GetSecurityKeys :many
+SELECT * FROM auth.user_security_keys
+WHERE user_id = $1;
+
+-- name: InsertSecurityKey :one
+INSERT INTO auth.user_security_keys
+    (user_id, credential_id, credential_public_key, transports, nickname)
+VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: UpdateSecurityKeyCounter :exec
+UPDATE auth.user_security_keys
+SET counter = @counter
+WHERE user_id = @user_id AND credential_id = @credential_id;
+
 -- name: UpdateUserDeanonymize :exec
 WITH inserted_user AS (
     UPDATE auth.users
@@ -203,10 +542,868 @@ INSERT INTO auth.user_roles (user_id, role)
     SELECT inserted_user.id, roles.role
     FROM inserted_user, unnest(@roles::TEXT[]) AS roles(role);
 
+-- name: UpdateUserDeanonymizeWithUserProvider :one
+WITH updated_user AS (
+    UPDATE auth.users
+    SET
+        is_anonymous = false,
+        email = @email,
+        email_verified = @email_verified,
+        avatar_url = @avatar_url,
+        default_role = @default_role,
+        display_name = @display_name,
+        locale = @locale,
+        metadata = @metadata
+    WHERE auth.users.id = @id AND auth.users.is_anonymous
+    RETURNING id
+), inserted_user_provider AS (
+    INSERT INTO auth.user_providers
+        (user_id, provider_id, provider_user_id, access_token, refresh_token)
+    SELECT updated_user.id, @provider_id, @provider_user_id, @access_token, @refresh_token
+    FROM updated_user
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT updated_user.id, roles.role
+    FROM updated_user, unnest(@roles::TEXT[]) AS roles(role)
+RETURNING user_id;
+
 -- name: DeleteRefreshTokens :exec
 DELETE FROM auth.refresh_tokens
 WHERE user_id = $1;
 
+-- name: RevokeUserSessions :execrows
+WITH deleted_refresh_tokens AS (
+    DELETE FROM auth.refresh_tokens
+    WHERE auth.refresh_tokens.user_id = $1
+), deleted_personal_access_tokens AS (
+    DELETE FROM auth.personal_access_tokens
+    WHERE auth.personal_access_tokens.user_id = $1
+)
+UPDATE auth.users
+SET tokens_valid_after = now()
+WHERE auth.users.id = $1;
+
 -- name: DeleteUserRoles :exec
 DELETE FROM auth.user_roles
 WHERE user_id = $1;
+
+-- name: CountRecoveryCodes :one
+SELECT COUNT(*) FROM auth.user_recovery_codes
+WHERE user_id = $1;
+
+-- name: ReplaceRecoveryCodes :exec
+WITH deleted_codes AS (
+    DELETE FROM auth.user_recovery_codes
+    WHERE user_id = @user_id
+)
+INSERT INTO auth.user_recovery_codes (user_id, code_hash)
+SELECT @user_id, unnest(@code_hashes::TEXT[]);
+
+-- name: DeleteRecoveryCode :one
+DELETE FROM auth.user_recovery_codes
+WHERE user_id = $1 AND code_hash = $2
+RETURNING id;
+
+-- name: InsertProviderRequest :exec
+INSERT INTO auth.provider_requests (id, options)
+VALUES ($1, $2);
+
+-- name: DeleteProviderRequest :one
+DELETE FROM auth.provider_requests
+WHERE id = $1
+RETURNING options;
+
+-- name: DeleteExpiredDeviceCodes :exec
+DELETE FROM auth.device_codes
+WHERE expires_at <= now();
+
+-- name: InsertDeviceCode :one
+INSERT INTO auth.device_codes (device_code_hash, user_code, expires_at)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- name: GetDeviceCode :one
+SELECT * FROM auth.device_codes
+WHERE device_code_hash = $1;
+
+-- name: UpdateDeviceCodeLastPolled :exec
+UPDATE auth.device_codes
+SET last_polled_at = now()
+WHERE id = $1;
+
+-- name: ApproveDeviceCode :one
+UPDATE auth.device_codes
+SET user_id = $2
+WHERE user_code = $1 AND user_id IS NULL AND NOT denied AND expires_at > now()
+RETURNING id;
+
+-- name: DenyDeviceCode :one
+UPDATE auth.device_codes
+SET denied = true
+WHERE user_code = $1 AND user_id IS NULL AND NOT denied AND expires_at > now()
+RETURNING id;
+
+-- name: DeleteDeviceCode :one
+DELETE FROM auth.device_codes
+WHERE id = $1
+RETURNING user_id;
+
+-- name: GetUserByProviderID :one
+WITH user_provider AS (
+    SELECT user_id
+    FROM auth.user_providers
+    WHERE provider_id = @provider_id AND provider_user_id = @provider_user_id
+    LIMIT 1
+)
+SELECT * FROM auth.users
+WHERE id = (SELECT user_id FROM user_provider) LIMIT 1;
+
+-- name: InsertUserProvider :one
+INSERT INTO auth.user_providers
+    (user_id, provider_id, provider_user_id, access_token, refresh_token)
+VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: GetUserProviders :many
+SELECT * FROM auth.user_providers
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: DeleteUserProvider :one
+DELETE FROM auth.user_providers
+WHERE user_id = $1 AND provider_id = $2
+RETURNING id;
+
+-- name: UpdateProviderSession :exec
+UPDATE auth.user_providers
+SET
+    -- providers return empty tokens when nothing changed, the stored ones are kept then
+    access_token = COALESCE(NULLIF(@access_token::text, ''), access_token),
+    refresh_token = COALESCE(sqlc.narg(refresh_token)::text, refresh_token)
+WHERE provider_id = @provider_id AND provider_user_id = @provider_user_id;
+
+-- name: InsertUserWithUserProvider :one
+WITH inserted_user AS (
+    INSERT INTO auth.users (
+        id,
+        disabled,
+        display_name,
+        avatar_url,
+        email,
+        email_verified,
+        locale,
+        default_role,
+        metadata
+    ) VALUES (
+      $1, $2, $3, $4, $5, $6, $7, $8, $9
+    )
+    RETURNING id, created_at
+), inserted_user_provider AS (
+    INSERT INTO auth.user_providers
+        (user_id, provider_id, provider_user_id, access_token, refresh_token)
+    SELECT inserted_user.id, @provider_id, @provider_user_id, @access_token, @refresh_token
+    FROM inserted_user
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT inserted_user.id, roles.role
+    FROM inserted_user, unnest(@roles::TEXT[]) AS roles(role)
+RETURNING user_id, (SELECT created_at FROM inserted_user WHERE id = user_id);
+
+-- name: InsertPersonalAccessToken :one
+INSERT INTO auth.personal_access_tokens (user_id, name, token_hash, expires_at, metadata)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: GetUserByPersonalAccessTokenHash :one
+WITH personal_access_token AS (
+    UPDATE auth.personal_access_tokens
+    SET last_used_at = now()
+    WHERE auth.personal_access_tokens.token_hash = $1
+        AND (auth.personal_access_tokens.expires_at IS NULL OR auth.personal_access_tokens.expires_at > now())
+    RETURNING auth.personal_access_tokens.user_id
+)
+SELECT * FROM auth.users
+WHERE id = (SELECT user_id FROM personal_access_token) LIMIT 1;
+
+-- name: GetPersonalAccessTokenByHash :one
+SELECT * FROM auth.personal_access_tokens
+WHERE token_hash = $1 AND (expires_at IS NULL OR expires_at > now());
+
+-- name: GetUserPersonalAccessTokens :many
+SELECT * FROM auth.personal_access_tokens
+WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at DESC;
+
+-- name: DeleteUserPersonalAccessToken :execrows
+DELETE FROM auth.personal_access_tokens
+WHERE id = $1 AND user_id = $2;
+
+-- name: InsertOAuth2Client :exec
+INSERT INTO auth.oauth2_clients (
+    client_id, client_secret_hash, description, default_role, allowed_roles,
+    token_exchange_enabled, redirect_uris, skip_consent, grant_types
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: GetOAuth2Client :one
+SELECT * FROM auth.oauth2_clients
+WHERE client_id = $1;
+
+-- name: DeleteOAuth2Client :execrows
+DELETE FROM auth.oauth2_clients
+WHERE client_id = $1;
+
+-- name: InsertOAuth2AuthorizationCode :exec
+INSERT INTO auth.oauth2_authorization_codes (
+    code_hash, client_id, user_id, redirect_uri, scopes, nonce, code_challenge, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: DeleteOAuth2AuthorizationCode :one
+DELETE FROM auth.oauth2_authorization_codes
+WHERE code_hash = $1 AND client_id = $2
+RETURNING *;
+
+-- name: GetOAuth2ConsentScopes :one
+SELECT scopes FROM auth.oauth2_consents
+WHERE user_id = $1 AND client_id = $2;
+
+-- name: UpsertOAuth2Consent :exec
+INSERT INTO auth.oauth2_consents (user_id, client_id, scopes)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, client_id) DO UPDATE
+SET scopes = EXCLUDED.scopes, updated_at = now();
+
+-- name: InsertTokenExchange :exec
+INSERT INTO auth.token_exchanges (client_id, user_id, roles, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetEmailTemplates :many
+SELECT * FROM auth.email_templates;
+
+-- name: InsertEmailOutbox :exec
+INSERT INTO auth.email_outbox (recipient, subject, body, headers)
+VALUES ($1, $2, $3, $4);
+
+-- name: ClaimEmailOutbox :many
+UPDATE auth.email_outbox
+SET next_attempt_at = @lease_expires_at
+WHERE id IN (
+    SELECT id FROM auth.email_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeleteEmailOutbox :exec
+DELETE FROM auth.email_outbox
+WHERE id = $1;
+
+-- name: RescheduleEmailOutbox :exec
+UPDATE auth.email_outbox
+SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE id = $1;
+
+-- name: FailEmailOutbox :exec
+UPDATE auth.email_outbox
+SET attempts = attempts + 1, status = 'failed', last_error = $2
+WHERE id = $1;
+
+-- name: GetFailedEmailOutbox :many
+SELECT * FROM auth.email_outbox
+WHERE status = 'failed'
+ORDER BY created_at DESC
+LIMIT $1;
+
+-- name: CountEmailOutbox :many
+SELECT status, COUNT(*) AS count FROM auth.email_outbox
+GROUP BY status;
+
+-- name: RetryEmailOutbox :execrows
+UPDATE auth.email_outbox
+SET status = 'pending', attempts = 0, next_attempt_at = now(), last_error = NULL
+WHERE id = $1 AND status = 'failed';
+
+-- name: InsertWebhookOutbox :exec
+INSERT INTO auth.webhook_outbox (endpoint, payload)
+VALUES ($1, $2);
+
+-- name: ClaimWebhookOutbox :many
+UPDATE auth.webhook_outbox
+SET next_attempt_at = @lease_expires_at
+WHERE id IN (
+    SELECT id FROM auth.webhook_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeleteWebhookOutbox :exec
+DELETE FROM auth.webhook_outbox
+WHERE id = $1;
+
+-- name: RescheduleWebhookOutbox :exec
+UPDATE auth.webhook_outbox
+SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE id = $1;
+
+-- name: FailWebhookOutbox :exec
+UPDATE auth.webhook_outbox
+SET attempts = attempts + 1, status = 'failed', last_error = $2
+WHERE id = $1;
+
+-- name: InsertEmailChangeRevert :exec
+INSERT INTO auth.email_change_reverts (user_id, ticket, old_email, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: ConsumeEmailChangeRevert :one
+DELETE FROM auth.email_change_reverts
+WHERE ticket = $1 AND expires_at > now()
+RETURNING *;
+
+-- name: DeleteUserEmailChangeReverts :exec
+DELETE FROM auth.email_change_reverts
+WHERE user_id = $1;
+
+-- name: RefreshTokenDeviceFingerprintExists :one
+SELECT EXISTS (
+    SELECT 1 FROM auth.refresh_tokens
+    WHERE user_id = $1 AND device_fingerprint = $2
+);
+
+-- name: InsertNewDeviceSignIn :exec
+INSERT INTO auth.new_device_sign_ins (user_id, ticket, ip_address, user_agent, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ConsumeNewDeviceSignIn :one
+DELETE FROM auth.new_device_sign_ins
+WHERE ticket = $1 AND expires_at > now()
+RETURNING *;
+
+-- name: DeleteUserNewDeviceSignIns :exec
+DELETE FROM auth.new_device_sign_ins
+WHERE user_id = $1;
+
+-- name: UpdateUserRevertEmailChange :one
+UPDATE auth.users
+SET email = $2, new_email = NULL, email_verified = true, ticket = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: RecordUserSignInFailure :one
+UPDATE auth.users
+SET failed_sign_in_attempts = CASE
+        WHEN last_failed_sign_in_at < @failures_since THEN 1
+        ELSE failed_sign_in_attempts + 1
+    END,
+    last_failed_sign_in_at = now()
+WHERE id = @id
+RETURNING failed_sign_in_attempts;
+
+-- name: UpdateUserLockedUntil :exec
+UPDATE auth.users
+SET locked_until = $2
+WHERE id = $1;
+
+-- name: ResetUserSignInFailures :execrows
+UPDATE auth.users
+SET failed_sign_in_attempts = 0, last_failed_sign_in_at = NULL, locked_until = NULL
+WHERE id = $1;
+
+-- name: RecordIPSignInFailure :one
+INSERT INTO auth.ip_sign_in_failures (ip, attempts, last_failed_at)
+VALUES (@ip, 1, now())
+ON CONFLICT (ip) DO UPDATE
+SET attempts = CASE
+        WHEN auth.ip_sign_in_failures.last_failed_at < @failures_since THEN 1
+        ELSE auth.ip_sign_in_failures.attempts + 1
+    END,
+    last_failed_at = now()
+RETURNING attempts;
+
+-- name: UpdateIPLockedUntil :exec
+UPDATE auth.ip_sign_in_failures
+SET locked_until = $2
+WHERE ip = $1;
+
+-- name: GetIPLockedUntil :one
+SELECT locked_until FROM auth.ip_sign_in_failures
+WHERE ip = $1;
+
+-- name: GetIPSignInFailures :one
+SELECT attempts FROM auth.ip_sign_in_failures
+WHERE ip = @ip AND last_failed_at >= @failures_since;
+
+-- name: InsertAuditLog :exec
+INSERT INTO auth.audit_logs (event, outcome, actor, user_id, ip_address, user_agent, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetAuditLogs :many
+SELECT * FROM auth.audit_logs
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+    AND (sqlc.narg('event')::text IS NULL OR event = sqlc.narg('event'))
+ORDER BY created_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountAuditLogs :one
+SELECT COUNT(*) FROM auth.audit_logs
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+    AND (sqlc.narg('event')::text IS NULL OR event = sqlc.narg('event'));
+
+-- name: DeleteAuditLogsBefore :execrows
+DELETE FROM auth.audit_logs
+WHERE created_at < $1;
+
+-- name: Ping :exec
+SELECT 1;
+
+-- name: GetUsers :many
+SELECT
+    u.id,
+    u.created_at,
+    u.last_seen,
+    u.disabled,
+    u.display_name,
+    u.avatar_url,
+    u.locale,
+    u.email,
+    u.email_verified,
+    u.phone_number,
+    u.phone_number_verified,
+    u.default_role,
+    u.is_anonymous,
+    u.metadata,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
+    ARRAY(
+        SELECT r.role FROM auth.user_roles r WHERE r.user_id = u.id ORDER BY r.role
+    )::text[] AS roles,
+    ARRAY(
+        SELECT p.provider_id FROM auth.user_providers p WHERE p.user_id = u.id ORDER BY p.provider_id
+    )::text[] AS providers
+FROM auth.users u
+WHERE (sqlc.narg('email')::text IS NULL OR u.email ILIKE sqlc.narg('email'))
+    AND (sqlc.narg('role')::text IS NULL OR EXISTS (
+        SELECT 1 FROM auth.user_roles r WHERE r.user_id = u.id AND r.role = sqlc.narg('role')
+    ))
+    AND (sqlc.narg('provider')::text IS NULL OR EXISTS (
+        SELECT 1 FROM auth.user_providers p
+        WHERE p.user_id = u.id AND p.provider_id = sqlc.narg('provider')
+    ))
+    AND (sqlc.narg('created_after')::timestamptz IS NULL OR u.created_at >= sqlc.narg('created_after'))
+    AND (sqlc.narg('created_before')::timestamptz IS NULL OR u.created_at < sqlc.narg('created_before'))
+    AND (sqlc.narg('disabled')::boolean IS NULL OR u.disabled = sqlc.narg('disabled'))
+    AND (sqlc.narg('email_verified')::boolean IS NULL OR u.email_verified = sqlc.narg('email_verified'))
+    AND (sqlc.narg('after_id')::uuid IS NULL
+        OR (@sort_by::text = 'created_at' AND NOT @descending::boolean
+            AND (u.created_at, u.id) > (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')))
+        OR (@sort_by = 'created_at' AND @descending
+            AND (u.created_at, u.id) < (sqlc.narg('after_created_at'), sqlc.narg('after_id')))
+        OR (@sort_by = 'email' AND NOT @descending
+            AND (COALESCE(u.email::text, ''), u.id) > (sqlc.narg('after_text')::text, sqlc.narg('after_id')))
+        OR (@sort_by = 'email' AND @descending
+            AND (COALESCE(u.email::text, ''), u.id) < (sqlc.narg('after_text'), sqlc.narg('after_id')))
+        OR (@sort_by = 'display_name' AND NOT @descending
+            AND (u.display_name, u.id) > (sqlc.narg('after_text'), sqlc.narg('after_id')))
+        OR (@sort_by = 'display_name' AND @descending
+            AND (u.display_name, u.id) < (sqlc.narg('after_text'), sqlc.narg('after_id'))))
+ORDER BY
+    CASE WHEN @sort_by = 'created_at' AND NOT @descending THEN u.created_at END ASC,
+    CASE WHEN @sort_by = 'created_at' AND @descending THEN u.created_at END DESC,
+    CASE WHEN @sort_by = 'email' AND NOT @descending THEN COALESCE(u.email::text, '') END ASC,
+    CASE WHEN @sort_by = 'email' AND @descending THEN COALESCE(u.email::text, '') END DESC,
+    CASE WHEN @sort_by = 'display_name' AND NOT @descending THEN u.display_name END ASC,
+    CASE WHEN @sort_by = 'display_name' AND @descending THEN u.display_name END DESC,
+    CASE WHEN NOT @descending THEN u.id END ASC,
+    CASE WHEN @descending THEN u.id END DESC
+LIMIT @row_limit;
+
+-- name: UpdateUserDisabled :execrows
+UPDATE auth.users
+SET disabled = @disabled
+WHERE id = @id;
+
+-- name: BanUser :execrows
+UPDATE auth.users
+SET banned_at = now(), banned_until = sqlc.narg('banned_until'), ban_reason = @ban_reason
+WHERE id = @id;
+
+-- name: UnbanUser :execrows
+UPDATE auth.users
+SET banned_at = NULL, banned_until = NULL, ban_reason = NULL
+WHERE id = $1;
+
+-- name: UpdateUserPasswordHash :execrows
+UPDATE auth.users
+SET password_hash = sqlc.narg('password_hash')
+WHERE id = @id;
+
+-- name: UpdateUserRehashPassword :execrows
+UPDATE auth.users
+SET password_hash = sqlc.narg('password_hash')
+WHERE id = @id AND password_hash = sqlc.narg('old_password_hash');
+
+-- name: GetRoles :many
+SELECT r.role, COUNT(ur.id) AS users
+FROM auth.roles r
+LEFT JOIN auth.user_roles ur ON ur.role = r.role
+GROUP BY r.role
+ORDER BY r.role;
+
+-- name: InsertRole :execrows
+INSERT INTO auth.roles (role)
+VALUES ($1)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteRole :execrows
+DELETE FROM auth.roles
+WHERE role = $1;
+
+-- name: InsertUserRole :exec
+INSERT INTO auth.user_roles (user_id, role)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteUserRole :exec
+DELETE FROM auth.user_roles
+WHERE user_id = $1 AND role = $2;
+
+-- name: ImportUsers :many
+WITH input AS (
+    SELECT
+        unnest(@ids::uuid[]) AS id,
+        unnest(@created_ats::timestamptz[]) AS created_at,
+        unnest(@emails::text[]) AS email,
+        unnest(@email_verified::boolean[]) AS email_verified,
+        unnest(@password_hashes::text[]) AS password_hash,
+        unnest(@phone_numbers::text[]) AS phone_number,
+        unnest(@phone_number_verified::boolean[]) AS phone_number_verified,
+        unnest(@display_names::text[]) AS display_name,
+        unnest(@avatar_urls::text[]) AS avatar_url,
+        unnest(@locales::text[]) AS locale,
+        unnest(@default_roles::text[]) AS default_role,
+        unnest(@metadata::jsonb[]) AS metadata,
+        unnest(@disabled::boolean[]) AS disabled
+), inserted_users AS (
+    INSERT INTO auth.users (
+        id,
+        created_at,
+        email,
+        email_verified,
+        password_hash,
+        phone_number,
+        phone_number_verified,
+        display_name,
+        avatar_url,
+        locale,
+        default_role,
+        metadata,
+        disabled
+    )
+    SELECT
+        id,
+        created_at,
+        email,
+        email_verified,
+        NULLIF(password_hash, ''),
+        NULLIF(phone_number, ''),
+        phone_number_verified,
+        display_name,
+        avatar_url,
+        locale,
+        default_role,
+        metadata,
+        disabled
+    FROM input
+    ON CONFLICT DO NOTHING
+    RETURNING id
+), inserted_roles AS (
+    INSERT INTO auth.user_roles (user_id, role)
+    SELECT roles.user_id, roles.role
+    FROM (
+        SELECT unnest(@role_user_ids::uuid[]) AS user_id, unnest(@roles::text[]) AS role
+    ) AS roles
+    WHERE roles.user_id IN (SELECT id FROM inserted_users)
+)
+SELECT id FROM inserted_users;
+
+-- name: GetUserAuditLogs :many
+SELECT * FROM auth.audit_logs
+WHERE user_id = $1
+ORDER BY created_at DESC, id;
+
+-- name: InsertDataExport :one
+INSERT INTO auth.data_exports (user_id, requested_by)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetDataExport :one
+SELECT * FROM auth.data_exports
+WHERE id = $1 AND (expires_at IS NULL OR expires_at > now());
+
+-- name: GetPendingUserDataExport :one
+SELECT * FROM auth.data_exports
+WHERE user_id = $1 AND status = 'pending'
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ClaimDataExports :many
+UPDATE auth.data_exports
+SET next_attempt_at = @lease_expires_at
+WHERE id IN (
+    SELECT id FROM auth.data_exports
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteDataExport :exec
+UPDATE auth.data_exports
+SET status = 'completed', data = @data, completed_at = now(), expires_at = @expires_at,
+    last_error = NULL
+WHERE id = @id;
+
+-- name: FailDataExportAttempt :exec
+UPDATE auth.data_exports
+SET attempts = attempts + 1,
+    last_error = @last_error,
+    status = CASE WHEN attempts + 1 >= @max_attempts::integer THEN 'failed' ELSE status END,
+    expires_at = CASE WHEN attempts + 1 >= @max_attempts::integer THEN @expires_at ELSE expires_at END
+WHERE id = @id;
+
+-- name: DeleteExpiredDataExports :execrows
+DELETE FROM auth.data_exports
+WHERE expires_at <= now();
+
+-- name: ScheduleUserDeletion :one
+WITH deletion AS (
+    INSERT INTO auth.account_deletions (user_id, ticket)
+    VALUES (@id, @ticket::text)
+    ON CONFLICT (user_id) DO UPDATE SET ticket = EXCLUDED.ticket, created_at = now()
+)
+UPDATE auth.users
+SET deletion_scheduled_at = @deletion_scheduled_at
+WHERE id = @id
+RETURNING *;
+
+-- name: CancelUserDeletion :one
+WITH deletion AS (
+    DELETE FROM auth.account_deletions
+    WHERE account_deletions.ticket = @ticket::text
+    RETURNING user_id
+)
+UPDATE auth.users
+SET deletion_scheduled_at = NULL
+WHERE id = (SELECT user_id FROM deletion) AND deletion_scheduled_at > now()
+RETURNING *;
+
+-- name: DeleteScheduledUsers :execrows
+DELETE FROM auth.users
+WHERE deletion_scheduled_at <= now();
+
+-- name: AnonymizeScheduledUsers :one
+WITH anonymized_users AS (
+    UPDATE auth.users
+    SET disabled = true,
+        display_name = '',
+        avatar_url = '',
+        email = NULL,
+        new_email = NULL,
+        email_verified = false,
+        phone_number = NULL,
+        new_phone_number = NULL,
+        phone_number_verified = false,
+        password_hash = NULL,
+        otp_hash = NULL,
+        totp_secret = NULL,
+        active_mfa_type = NULL,
+        ticket = NULL,
+        webauthn_current_challenge = NULL,
+        metadata = '{}'::jsonb,
+        last_seen = NULL,
+        ban_reason = NULL,
+        tokens_valid_after = now(),
+        deletion_scheduled_at = NULL,
+        deleted_at = now()
+    WHERE deletion_scheduled_at <= now()
+    RETURNING id
+), deleted_account_deletions AS (
+    DELETE FROM auth.account_deletions WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_data_exports AS (
+    DELETE FROM auth.data_exports WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_device_codes AS (
+    DELETE FROM auth.device_codes WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_email_change_reverts AS (
+    DELETE FROM auth.email_change_reverts WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_new_device_sign_ins AS (
+    DELETE FROM auth.new_device_sign_ins WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_user_providers AS (
+    DELETE FROM auth.user_providers WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_refresh_tokens AS (
+    DELETE FROM auth.refresh_tokens WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_security_keys AS (
+    DELETE FROM auth.user_security_keys WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_personal_access_tokens AS (
+    DELETE FROM auth.personal_access_tokens WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_token_exchanges AS (
+    DELETE FROM auth.token_exchanges WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_recovery_codes AS (
+    DELETE FROM auth.user_recovery_codes WHERE user_id IN (SELECT id FROM anonymized_users)
+)
+SELECT count(*) FROM anonymized_users;
+
+-- name: GetScimUsers :many
+SELECT
+    u.id,
+    u.created_at,
+    u.updated_at,
+    u.disabled,
+    u.display_name,
+    u.email,
+    p.provider_user_id AS external_id,
+    ARRAY(
+        SELECT r.role FROM auth.user_roles r WHERE r.user_id = u.id ORDER BY r.role
+    )::text[] AS roles
+FROM auth.users u
+LEFT JOIN auth.user_providers p ON p.user_id = u.id AND p.provider_id = 'scim'
+WHERE NOT u.is_anonymous
+    AND (sqlc.narg('id')::uuid IS NULL OR u.id = sqlc.narg('id'))
+    AND (sqlc.narg('email')::text IS NULL OR lower(u.email) = lower(sqlc.narg('email')))
+    AND (sqlc.narg('external_id')::text IS NULL OR p.provider_user_id = sqlc.narg('external_id'))
+ORDER BY u.created_at, u.id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountScimUsers :one
+SELECT COUNT(*)
+FROM auth.users u
+LEFT JOIN auth.user_providers p ON p.user_id = u.id AND p.provider_id = 'scim'
+WHERE NOT u.is_anonymous
+    AND (sqlc.narg('id')::uuid IS NULL OR u.id = sqlc.narg('id'))
+    AND (sqlc.narg('email')::text IS NULL OR lower(u.email) = lower(sqlc.narg('email')))
+    AND (sqlc.narg('external_id')::text IS NULL OR p.provider_user_id = sqlc.narg('external_id'));
+
+-- name: UpdateScimUser :one
+WITH updated_user AS (
+    UPDATE auth.users
+    SET email = @email, email_verified = true, display_name = @display_name, disabled = @disabled
+    WHERE id = @id
+    RETURNING id
+), deleted_user_provider AS (
+    DELETE FROM auth.user_providers
+    WHERE user_id IN (SELECT id FROM updated_user)
+        AND provider_id = 'scim'
+        AND sqlc.narg('external_id')::text IS NULL
+), upserted_user_provider AS (
+    INSERT INTO auth.user_providers (user_id, provider_id, provider_user_id, access_token)
+    SELECT id, 'scim', sqlc.narg('external_id'), ''
+    FROM updated_user
+    WHERE sqlc.narg('external_id') IS NOT NULL
+    ON CONFLICT (user_id, provider_id)
+        DO UPDATE SET provider_user_id = EXCLUDED.provider_user_id
+)
+SELECT id FROM updated_user;
+
+-- name: DeleteUser :execrows
+DELETE FROM auth.users
+WHERE id = $1;
+
+-- name: GetScimGroups :many
+SELECT role FROM auth.roles
+WHERE sqlc.narg('role')::text IS NULL OR role = sqlc.narg('role')
+ORDER BY role
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountScimGroups :one
+SELECT COUNT(*) FROM auth.roles
+WHERE sqlc.narg('role')::text IS NULL OR role = sqlc.narg('role');
+
+-- name: GetRoleUsers :many
+SELECT u.id, u.display_name, u.email
+FROM auth.user_roles r
+JOIN auth.users u ON u.id = r.user_id
+WHERE r.role = $1
+ORDER BY u.created_at, u.id;
+
+-- name: InsertOrganization :one
+WITH inserted_organization AS (
+    INSERT INTO auth.organizations (name)
+    VALUES (@name)
+    RETURNING *
+), inserted_member AS (
+    INSERT INTO auth.organization_members (organization_id, user_id, role)
+    SELECT inserted_organization.id, @user_id, 'owner'
+    FROM inserted_organization
+)
+SELECT * FROM inserted_organization;
+
+-- name: GetOrganizationMember :one
+SELECT o.id, o.created_at, o.name, m.role
+FROM auth.organization_members m
+JOIN auth.organizations o ON o.id = m.organization_id
+WHERE m.organization_id = $1 AND m.user_id = $2;
+
+-- name: GetUserActiveOrganizationMember :one
+SELECT m.organization_id, m.role
+FROM auth.users u
+JOIN auth.organization_members m
+    ON m.organization_id = u.active_organization_id AND m.user_id = u.id
+WHERE u.id = $1;
+
+-- name: UpdateUserActiveOrganization :one
+UPDATE auth.users
+SET active_organization_id = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UpsertOrganizationInvite :exec
+INSERT INTO auth.organization_invites (
+    organization_id, email, role, ticket, invited_by, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (organization_id, email) DO UPDATE
+SET role = EXCLUDED.role,
+    ticket = EXCLUDED.ticket,
+    invited_by = EXCLUDED.invited_by,
+    expires_at = EXCLUDED.expires_at,
+    created_at = now();
+
+-- name: AcceptOrganizationInvite :one
+WITH accepted_invite AS (
+    DELETE FROM auth.organization_invites
+    WHERE ticket = @ticket AND email = @email::text AND expires_at > now()
+    RETURNING organization_id, role
+), inserted_member AS (
+    INSERT INTO auth.organization_members (organization_id, user_id, role)
+    SELECT accepted_invite.organization_id, @user_id, accepted_invite.role
+    FROM accepted_invite
+    ON CONFLICT (organization_id, user_id) DO NOTHING
+)
+SELECT o.id, o.created_at, o.name, accepted_invite.role
+FROM auth.organizations o
+JOIN accepted_invite ON accepted_invite.organization_id = o.id;
+
+-- name: GetSignInMethods :many
+SELECT * FROM auth.sign_in_methods
+ORDER BY method;
+
+-- name: UpsertSignInMethod :one
+INSERT INTO auth.sign_in_methods (method, enabled)
+VALUES ($1, $2)
+ON CONFLICT (method) DO UPDATE
+SET enabled = EXCLUDED.enabled, updated_at = now()
+RETURNING *;
+
+-- name: DeleteSignInMethod :execrows
+DELETE FROM auth.sign_in_methods
+WHERE method = $1;
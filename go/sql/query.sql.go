@@ -12,45 +12,159 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const countSecurityKeysUser = `-- name: CountSecurityKeysUser :one
-SELECT COUNT(*) FROM auth.user_security_keys
-WHERE user_id = $1
+const acceptOrganizationInvite = `-- name: AcceptOrganizationInvite :one
+WITH accepted_invite AS (
+    DELETE FROM auth.organization_invites
+    WHERE ticket = $1 AND email = $2::text AND expires_at > now()
+    RETURNING organization_id, role
+), inserted_member AS (
+    INSERT INTO auth.organization_members (organization_id, user_id, role)
+    SELECT accepted_invite.organization_id, $3, accepted_invite.role
+    FROM accepted_invite
+    ON CONFLICT (organization_id, user_id) DO NOTHING
+)
+SELECT o.id, o.created_at, o.name, accepted_invite.role
+FROM auth.organizations o
+JOIN accepted_invite ON accepted_invite.organization_id = o.id
 `
 
-func (q *Queries) CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error) {
-	row := q.db.QueryRow(ctx, countSecurityKeysUser, userID)
+type AcceptOrganizationInviteParams struct {
+	Ticket string
+	Email  string
+	UserID uuid.UUID
+}
+
+type AcceptOrganizationInviteRow struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Name      string
+	Role      string
+}
+
+func (q *Queries) AcceptOrganizationInvite(ctx context.Context, arg AcceptOrganizationInviteParams) (AcceptOrganizationInviteRow, error) {
+	row := q.db.QueryRow(ctx, acceptOrganizationInvite, arg.Ticket, arg.Email, arg.UserID)
+	var i AcceptOrganizationInviteRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Role,
+	)
+	return i, err
+}
+
+const anonymizeScheduledUsers = `-- name: AnonymizeScheduledUsers :one
+WITH anonymized_users AS (
+    UPDATE auth.users
+    SET disabled = true,
+        display_name = '',
+        avatar_url = '',
+        email = NULL,
+        new_email = NULL,
+        email_verified = false,
+        phone_number = NULL,
+        new_phone_number = NULL,
+        phone_number_verified = false,
+        password_hash = NULL,
+        otp_hash = NULL,
+        totp_secret = NULL,
+        active_mfa_type = NULL,
+        ticket = NULL,
+        webauthn_current_challenge = NULL,
+        metadata = '{}'::jsonb,
+        last_seen = NULL,
+        ban_reason = NULL,
+        tokens_valid_after = now(),
+        deletion_scheduled_at = NULL,
+        deleted_at = now()
+    WHERE deletion_scheduled_at <= now()
+    RETURNING id
+), deleted_account_deletions AS (
+    DELETE FROM auth.account_deletions WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_data_exports AS (
+    DELETE FROM auth.data_exports WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_device_codes AS (
+    DELETE FROM auth.device_codes WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_email_change_reverts AS (
+    DELETE FROM auth.email_change_reverts WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_new_device_sign_ins AS (
+    DELETE FROM auth.new_device_sign_ins WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_user_providers AS (
+    DELETE FROM auth.user_providers WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_refresh_tokens AS (
+    DELETE FROM auth.refresh_tokens WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_security_keys AS (
+    DELETE FROM auth.user_security_keys WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_personal_access_tokens AS (
+    DELETE FROM auth.personal_access_tokens WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_token_exchanges AS (
+    DELETE FROM auth.token_exchanges WHERE user_id IN (SELECT id FROM anonymized_users)
+), deleted_recovery_codes AS (
+    DELETE FROM auth.user_recovery_codes WHERE user_id IN (SELECT id FROM anonymized_users)
+)
+SELECT count(*) FROM anonymized_users
+`
+
+func (q *Queries) AnonymizeScheduledUsers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, anonymizeScheduledUsers)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const deleteRefreshTokens = `-- name: DeleteRefreshTokens :exec
-DELETE FROM auth.refresh_tokens
-WHERE user_id = $1
+const approveDeviceCode = `-- name: ApproveDeviceCode :one
+UPDATE auth.device_codes
+SET user_id = $2
+WHERE user_code = $1 AND user_id IS NULL AND NOT denied AND expires_at > now()
+RETURNING id
 `
 
-func (q *Queries) DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteRefreshTokens, userID)
-	return err
+type ApproveDeviceCodeParams struct {
+	UserCode string
+	UserID   pgtype.UUID
 }
 
-const deleteUserRoles = `-- name: DeleteUserRoles :exec
-DELETE FROM auth.user_roles
-WHERE user_id = $1
+func (q *Queries) ApproveDeviceCode(ctx context.Context, arg ApproveDeviceCodeParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, approveDeviceCode, arg.UserCode, arg.UserID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const banUser = `-- name: BanUser :execrows
+UPDATE auth.users
+SET banned_at = now(), banned_until = $1, ban_reason = $2
+WHERE id = $3
 `
 
-func (q *Queries) DeleteUserRoles(ctx context.Context, userID uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteUserRoles, userID)
-	return err
+type BanUserParams struct {
+	BannedUntil pgtype.Timestamptz
+	BanReason   pgtype.Text
+	ID          uuid.UUID
 }
 
-const getUser = `-- name: GetUser :one
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
-WHERE id = $1 LIMIT 1
+func (q *Queries) BanUser(ctx context.Context, arg BanUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, banUser, arg.BannedUntil, arg.BanReason, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelUserDeletion = `-- name: CancelUserDeletion :one
+WITH deletion AS (
+    DELETE FROM auth.account_deletions
+    WHERE account_deletions.ticket = $1::text
+    RETURNING user_id
+)
+UPDATE auth.users
+SET deletion_scheduled_at = NULL
+WHERE id = (SELECT user_id FROM deletion) AND deletion_scheduled_at > now()
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
 `
 
-func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (AuthUser, error) {
-	row := q.db.QueryRow(ctx, getUser, id)
+func (q *Queries) CancelUserDeletion(ctx context.Context, ticket string) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, cancelUserDeletion, ticket)
 	var i AuthUser
 	err := row.Scan(
 		&i.ID,
@@ -78,115 +192,159 @@ func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (AuthUser, error) {
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
 	)
 	return i, err
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
-WHERE email = $1 LIMIT 1
+const claimDataExports = `-- name: ClaimDataExports :many
+UPDATE auth.data_exports
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id FROM auth.data_exports
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, created_at, user_id, requested_by, status, attempts, next_attempt_at, last_error, data, completed_at, expires_at
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (AuthUser, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i AuthUser
-	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.LastSeen,
-		&i.Disabled,
-		&i.DisplayName,
-		&i.AvatarUrl,
-		&i.Locale,
-		&i.Email,
-		&i.PhoneNumber,
-		&i.PasswordHash,
-		&i.EmailVerified,
-		&i.PhoneNumberVerified,
-		&i.NewEmail,
-		&i.OtpMethodLastUsed,
-		&i.OtpHash,
-		&i.OtpHashExpiresAt,
-		&i.DefaultRole,
-		&i.IsAnonymous,
-		&i.TotpSecret,
-		&i.ActiveMfaType,
-		&i.Ticket,
-		&i.TicketExpiresAt,
-		&i.Metadata,
-		&i.WebauthnCurrentChallenge,
-	)
-	return i, err
+type ClaimDataExportsParams struct {
+	LeaseExpiresAt pgtype.Timestamptz
+	BatchSize      int32
 }
 
-const getUserByRefreshTokenHash = `-- name: GetUserByRefreshTokenHash :one
-WITH refresh_token AS (
-    SELECT id, created_at, expires_at, user_id, metadata, type, refresh_token_hash FROM auth.refresh_tokens
-    WHERE refresh_token_hash = $1 AND type = $2 AND expires_at > now()
-    LIMIT 1
+func (q *Queries) ClaimDataExports(ctx context.Context, arg ClaimDataExportsParams) ([]AuthDataExport, error) {
+	rows, err := q.db.Query(ctx, claimDataExports, arg.LeaseExpiresAt, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthDataExport
+	for rows.Next() {
+		var i AuthDataExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UserID,
+			&i.RequestedBy,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.Data,
+			&i.CompletedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimEmailOutbox = `-- name: ClaimEmailOutbox :many
+UPDATE auth.email_outbox
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id FROM auth.email_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
 )
-SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge FROM auth.users
-WHERE id = (SELECT user_id FROM refresh_token) LIMIT 1
+RETURNING id, created_at, recipient, subject, body, headers, status, attempts, next_attempt_at, last_error
 `
 
-type GetUserByRefreshTokenHashParams struct {
-	RefreshTokenHash pgtype.Text
-	Type             RefreshTokenType
+type ClaimEmailOutboxParams struct {
+	LeaseExpiresAt pgtype.Timestamptz
+	BatchSize      int32
 }
 
-func (q *Queries) GetUserByRefreshTokenHash(ctx context.Context, arg GetUserByRefreshTokenHashParams) (AuthUser, error) {
-	row := q.db.QueryRow(ctx, getUserByRefreshTokenHash, arg.RefreshTokenHash, arg.Type)
-	var i AuthUser
-	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.LastSeen,
-		&i.Disabled,
-		&i.DisplayName,
-		&i.AvatarUrl,
-		&i.Locale,
-		&i.Email,
-		&i.PhoneNumber,
-		&i.PasswordHash,
-		&i.EmailVerified,
-		&i.PhoneNumberVerified,
-		&i.NewEmail,
-		&i.OtpMethodLastUsed,
-		&i.OtpHash,
-		&i.OtpHashExpiresAt,
-		&i.DefaultRole,
-		&i.IsAnonymous,
-		&i.TotpSecret,
-		&i.ActiveMfaType,
-		&i.Ticket,
-		&i.TicketExpiresAt,
-		&i.Metadata,
-		&i.WebauthnCurrentChallenge,
-	)
-	return i, err
+func (q *Queries) ClaimEmailOutbox(ctx context.Context, arg ClaimEmailOutboxParams) ([]AuthEmailOutbox, error) {
+	rows, err := q.db.Query(ctx, claimEmailOutbox, arg.LeaseExpiresAt, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthEmailOutbox
+	for rows.Next() {
+		var i AuthEmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Recipient,
+			&i.Subject,
+			&i.Body,
+			&i.Headers,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getUserRoles = `-- name: GetUserRoles :many
-SELECT id, created_at, user_id, role FROM auth.user_roles
-WHERE user_id = $1
+const claimWebhookOutbox = `-- name: ClaimWebhookOutbox :many
+UPDATE auth.webhook_outbox
+SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id FROM auth.webhook_outbox
+    WHERE status = 'pending' AND next_attempt_at <= now()
+    ORDER BY next_attempt_at
+    LIMIT $2
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, created_at, endpoint, payload, status, attempts, next_attempt_at, last_error
 `
 
-func (q *Queries) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]AuthUserRole, error) {
-	rows, err := q.db.Query(ctx, getUserRoles, userID)
+type ClaimWebhookOutboxParams struct {
+	LeaseExpiresAt pgtype.Timestamptz
+	BatchSize      int32
+}
+
+func (q *Queries) ClaimWebhookOutbox(ctx context.Context, arg ClaimWebhookOutboxParams) ([]AuthWebhookOutbox, error) {
+	rows, err := q.db.Query(ctx, claimWebhookOutbox, arg.LeaseExpiresAt, arg.BatchSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AuthUserRole
+	var items []AuthWebhookOutbox
 	for rows.Next() {
-		var i AuthUserRole
+		var i AuthWebhookOutbox
 		if err := rows.Scan(
 			&i.ID,
 			&i.CreatedAt,
-			&i.UserID,
-			&i.Role,
+			&i.Endpoint,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
 		); err != nil {
 			return nil, err
 		}
@@ -198,403 +356,3990 @@ func (q *Queries) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]AuthUse
 	return items, nil
 }
 
-const insertRefreshtoken = `-- name: InsertRefreshtoken :one
-INSERT INTO auth.refresh_tokens (user_id, refresh_token_hash, expires_at, type, metadata)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id
+const completeDataExport = `-- name: CompleteDataExport :exec
+UPDATE auth.data_exports
+SET status = 'completed', data = $1, completed_at = now(), expires_at = $2,
+    last_error = NULL
+WHERE id = $3
 `
 
-type InsertRefreshtokenParams struct {
-	UserID           uuid.UUID
-	RefreshTokenHash pgtype.Text
-	ExpiresAt        pgtype.Timestamptz
-	Type             RefreshTokenType
-	Metadata         []byte
+type CompleteDataExportParams struct {
+	Data      []byte
+	ExpiresAt pgtype.Timestamptz
+	ID        uuid.UUID
 }
 
-func (q *Queries) InsertRefreshtoken(ctx context.Context, arg InsertRefreshtokenParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertRefreshtoken,
-		arg.UserID,
-		arg.RefreshTokenHash,
-		arg.ExpiresAt,
-		arg.Type,
-		arg.Metadata,
-	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) CompleteDataExport(ctx context.Context, arg CompleteDataExportParams) error {
+	_, err := q.db.Exec(ctx, completeDataExport, arg.Data, arg.ExpiresAt, arg.ID)
+	return err
 }
 
-const insertUser = `-- name: InsertUser :one
-WITH inserted_user AS (
-    INSERT INTO auth.users (
-        id,
-        disabled,
-        display_name,
-        avatar_url,
-        email,
-        password_hash,
-        ticket,
-        ticket_expires_at,
-        email_verified,
-        locale,
-        default_role,
-        metadata
-    ) VALUES (
-      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
-    )
-    RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
-)
-INSERT INTO auth.user_roles (user_id, role)
-    SELECT inserted_user.id, roles.role
-    FROM inserted_user, unnest($13::TEXT[]) AS roles(role)
-RETURNING user_id, (SELECT created_at FROM inserted_user WHERE id = user_id)
+const consumeEmailChangeRevert = `-- name: ConsumeEmailChangeRevert :one
+DELETE FROM auth.email_change_reverts
+WHERE ticket = $1 AND expires_at > now()
+RETURNING id, created_at, user_id, ticket, old_email, expires_at
 `
 
-type InsertUserParams struct {
-	ID              uuid.UUID
-	Disabled        bool
-	DisplayName     string
-	AvatarUrl       string
-	Email           pgtype.Text
-	PasswordHash    pgtype.Text
-	Ticket          pgtype.Text
-	TicketExpiresAt pgtype.Timestamptz
-	EmailVerified   bool
-	Locale          string
-	DefaultRole     string
-	Metadata        []byte
-	Roles           []string
+func (q *Queries) ConsumeEmailChangeRevert(ctx context.Context, ticket string) (AuthEmailChangeRevert, error) {
+	row := q.db.QueryRow(ctx, consumeEmailChangeRevert, ticket)
+	var i AuthEmailChangeRevert
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.Ticket,
+		&i.OldEmail,
+		&i.ExpiresAt,
+	)
+	return i, err
 }
 
-type InsertUserRow struct {
-	UserID    uuid.UUID
-	CreatedAt pgtype.Timestamptz
-}
+const consumeNewDeviceSignIn = `-- name: ConsumeNewDeviceSignIn :one
+DELETE FROM auth.new_device_sign_ins
+WHERE ticket = $1 AND expires_at > now()
+RETURNING id, created_at, user_id, ticket, ip_address, user_agent, expires_at
+`
 
-func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (InsertUserRow, error) {
-	row := q.db.QueryRow(ctx, insertUser,
-		arg.ID,
-		arg.Disabled,
-		arg.DisplayName,
-		arg.AvatarUrl,
-		arg.Email,
-		arg.PasswordHash,
-		arg.Ticket,
-		arg.TicketExpiresAt,
-		arg.EmailVerified,
-		arg.Locale,
-		arg.DefaultRole,
-		arg.Metadata,
-		arg.Roles,
+func (q *Queries) ConsumeNewDeviceSignIn(ctx context.Context, ticket string) (AuthNewDeviceSignIn, error) {
+	row := q.db.QueryRow(ctx, consumeNewDeviceSignIn, ticket)
+	var i AuthNewDeviceSignIn
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.Ticket,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.ExpiresAt,
 	)
-	var i InsertUserRow
-	err := row.Scan(&i.UserID, &i.CreatedAt)
 	return i, err
 }
 
-const insertUserWithRefreshToken = `-- name: InsertUserWithRefreshToken :one
-WITH inserted_user AS (
-    INSERT INTO auth.users (
-        disabled,
-        display_name,
-        avatar_url,
-        email,
-        password_hash,
-        ticket,
-        ticket_expires_at,
-        email_verified,
-        locale,
-        default_role,
-        metadata,
-        last_seen
-    ) VALUES (
-      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
-    )
-    RETURNING id, created_at
-), inserted_refresh_token AS (
-    INSERT INTO auth.refresh_tokens (user_id, refresh_token_hash, expires_at)
-        SELECT inserted_user.id, $13, $14
-        FROM inserted_user
-    RETURNING id AS refresh_token_id
-)
-INSERT INTO auth.user_roles (user_id, role)
-    SELECT inserted_user.id, roles.role
-    FROM inserted_user, unnest($12::TEXT[]) AS roles(role)
-RETURNING (SELECT refresh_token_id FROM inserted_refresh_token), user_id
+const countAuditLogs = `-- name: CountAuditLogs :one
+SELECT COUNT(*) FROM auth.audit_logs
+WHERE ($1::uuid IS NULL OR user_id = $1)
+    AND ($2::text IS NULL OR event = $2)
 `
 
-type InsertUserWithRefreshTokenParams struct {
-	Disabled              bool
-	DisplayName           string
-	AvatarUrl             string
-	Email                 pgtype.Text
-	PasswordHash          pgtype.Text
-	Ticket                pgtype.Text
-	TicketExpiresAt       pgtype.Timestamptz
-	EmailVerified         bool
-	Locale                string
-	DefaultRole           string
-	Metadata              []byte
-	Roles                 []string
-	RefreshTokenHash      pgtype.Text
-	RefreshTokenExpiresAt pgtype.Timestamptz
+type CountAuditLogsParams struct {
+	UserID pgtype.UUID
+	Event  pgtype.Text
 }
 
-type InsertUserWithRefreshTokenRow struct {
-	RefreshTokenID uuid.UUID
-	UserID         uuid.UUID
+func (q *Queries) CountAuditLogs(ctx context.Context, arg CountAuditLogsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuditLogs, arg.UserID, arg.Event)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-func (q *Queries) InsertUserWithRefreshToken(ctx context.Context, arg InsertUserWithRefreshTokenParams) (InsertUserWithRefreshTokenRow, error) {
-	row := q.db.QueryRow(ctx, insertUserWithRefreshToken,
-		arg.Disabled,
-		arg.DisplayName,
-		arg.AvatarUrl,
-		arg.Email,
-		arg.PasswordHash,
-		arg.Ticket,
-		arg.TicketExpiresAt,
-		arg.EmailVerified,
-		arg.Locale,
-		arg.DefaultRole,
-		arg.Metadata,
-		arg.Roles,
-		arg.RefreshTokenHash,
-		arg.RefreshTokenExpiresAt,
-	)
-	var i InsertUserWithRefreshTokenRow
-	err := row.Scan(&i.RefreshTokenID, &i.UserID)
-	return i, err
+const countEmailOutbox = `-- name: CountEmailOutbox :many
+SELECT status, COUNT(*) AS count FROM auth.email_outbox
+GROUP BY status
+`
+
+type CountEmailOutboxRow struct {
+	Status string
+	Count  int64
 }
 
-const insertUserWithSecurityKey = `-- name: InsertUserWithSecurityKey :one
-WITH inserted_user AS (
-    INSERT INTO auth.users (
-        id,
-        disabled,
-        display_name,
-        avatar_url,
-        email,
-        ticket,
-        ticket_expires_at,
-        email_verified,
-        locale,
-        default_role,
-        metadata,
-        last_seen
-    ) VALUES (
-      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
-    )
-    RETURNING id
-), inserted_security_key AS (
-    INSERT INTO auth.user_security_keys
-        (user_id, credential_id, credential_public_key, nickname)
-    VALUES
-        ($1, $13, $14, $15)
-)
-INSERT INTO auth.user_roles (user_id, role)
-    SELECT inserted_user.id, roles.role
-    FROM inserted_user, unnest($12::TEXT[]) AS roles(role)
-RETURNING user_id
+func (q *Queries) CountEmailOutbox(ctx context.Context) ([]CountEmailOutboxRow, error) {
+	rows, err := q.db.Query(ctx, countEmailOutbox)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountEmailOutboxRow
+	for rows.Next() {
+		var i CountEmailOutboxRow
+		if err := rows.Scan(&i.Status, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countRecoveryCodes = `-- name: CountRecoveryCodes :one
+SELECT COUNT(*) FROM auth.user_recovery_codes
+WHERE user_id = $1
 `
 
-type InsertUserWithSecurityKeyParams struct {
-	ID                  uuid.UUID
-	Disabled            bool
-	DisplayName         string
-	AvatarUrl           string
-	Email               pgtype.Text
-	Ticket              pgtype.Text
-	TicketExpiresAt     pgtype.Timestamptz
-	EmailVerified       bool
-	Locale              string
-	DefaultRole         string
-	Metadata            []byte
-	Roles               []string
-	CredentialID        string
-	CredentialPublicKey []byte
-	Nickname            pgtype.Text
+func (q *Queries) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-func (q *Queries) InsertUserWithSecurityKey(ctx context.Context, arg InsertUserWithSecurityKeyParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertUserWithSecurityKey,
-		arg.ID,
-		arg.Disabled,
-		arg.DisplayName,
-		arg.AvatarUrl,
-		arg.Email,
-		arg.Ticket,
-		arg.TicketExpiresAt,
-		arg.EmailVerified,
-		arg.Locale,
-		arg.DefaultRole,
-		arg.Metadata,
-		arg.Roles,
-		arg.CredentialID,
-		arg.CredentialPublicKey,
-		arg.Nickname,
-	)
-	var user_id uuid.UUID
-	err := row.Scan(&user_id)
-	return user_id, err
+const countScimGroups = `-- name: CountScimGroups :one
+SELECT COUNT(*) FROM auth.roles
+WHERE $1::text IS NULL OR role = $1
+`
+
+func (q *Queries) CountScimGroups(ctx context.Context, role pgtype.Text) (int64, error) {
+	row := q.db.QueryRow(ctx, countScimGroups, role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-const insertUserWithSecurityKeyAndRefreshToken = `-- name: InsertUserWithSecurityKeyAndRefreshToken :one
-WITH inserted_user AS (
-    INSERT INTO auth.users (
-        id,
-        disabled,
-        display_name,
-        avatar_url,
-        email,
-        ticket,
-        ticket_expires_at,
-        email_verified,
-        locale,
-        default_role,
-        metadata,
-        last_seen
-    ) VALUES (
-      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
-    )
-    RETURNING id
-), inserted_refresh_token AS (
-    INSERT INTO auth.refresh_tokens
-        (user_id, refresh_token_hash, expires_at)
-    VALUES
-        ($1, $13, $14)
-    RETURNING id AS refresh_token_id
-), inserted_security_key AS (
-    INSERT INTO auth.user_security_keys
-        (user_id, credential_id, credential_public_key, nickname)
-    VALUES
-        ($1, $15, $16, $17)
-)
-INSERT INTO auth.user_roles (user_id, role)
-    SELECT inserted_user.id, roles.role
-    FROM inserted_user, unnest($12::TEXT[]) AS roles(role)
-RETURNING (SELECT refresh_token_id FROM inserted_refresh_token), user_id
+const countScimUsers = `-- name: CountScimUsers :one
+SELECT COUNT(*)
+FROM auth.users u
+LEFT JOIN auth.user_providers p ON p.user_id = u.id AND p.provider_id = 'scim'
+WHERE NOT u.is_anonymous
+    AND ($1::uuid IS NULL OR u.id = $1)
+    AND ($2::text IS NULL OR lower(u.email) = lower($2))
+    AND ($3::text IS NULL OR p.provider_user_id = $3)
 `
 
-type InsertUserWithSecurityKeyAndRefreshTokenParams struct {
-	ID                    uuid.UUID
-	Disabled              bool
-	DisplayName           string
-	AvatarUrl             string
-	Email                 pgtype.Text
-	Ticket                pgtype.Text
-	TicketExpiresAt       pgtype.Timestamptz
-	EmailVerified         bool
-	Locale                string
-	DefaultRole           string
-	Metadata              []byte
-	Roles                 []string
-	RefreshTokenHash      pgtype.Text
-	RefreshTokenExpiresAt pgtype.Timestamptz
-	CredentialID          string
-	CredentialPublicKey   []byte
-	Nickname              pgtype.Text
+type CountScimUsersParams struct {
+	ID         pgtype.UUID
+	Email      pgtype.Text
+	ExternalID pgtype.Text
 }
 
-type InsertUserWithSecurityKeyAndRefreshTokenRow struct {
-	RefreshTokenID uuid.UUID
-	UserID         uuid.UUID
+func (q *Queries) CountScimUsers(ctx context.Context, arg CountScimUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countScimUsers, arg.ID, arg.Email, arg.ExternalID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-func (q *Queries) InsertUserWithSecurityKeyAndRefreshToken(ctx context.Context, arg InsertUserWithSecurityKeyAndRefreshTokenParams) (InsertUserWithSecurityKeyAndRefreshTokenRow, error) {
-	row := q.db.QueryRow(ctx, insertUserWithSecurityKeyAndRefreshToken,
-		arg.ID,
-		arg.Disabled,
-		arg.DisplayName,
-		arg.AvatarUrl,
-		arg.Email,
-		arg.Ticket,
-		arg.TicketExpiresAt,
-		arg.EmailVerified,
-		arg.Locale,
-		arg.DefaultRole,
-		arg.Metadata,
-		arg.Roles,
-		arg.RefreshTokenHash,
-		arg.RefreshTokenExpiresAt,
-		arg.CredentialID,
-		arg.CredentialPublicKey,
-		arg.Nickname,
-	)
-	var i InsertUserWithSecurityKeyAndRefreshTokenRow
-	err := row.Scan(&i.RefreshTokenID, &i.UserID)
-	return i, err
+const countSecurityKeysUser = `-- name: CountSecurityKeysUser :one
+SELECT COUNT(*) FROM auth.user_security_keys
+WHERE user_id = $1
+`
+
+func (q *Queries) CountSecurityKeysUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countSecurityKeysUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-const refreshTokenAndGetUserRoles = `-- name: RefreshTokenAndGetUserRoles :many
-WITH refreshed_token AS (
-    UPDATE auth.refresh_tokens
-    SET expires_at = $2
-    WHERE refresh_token_hash = $1
-    RETURNING id AS refresh_token_id, user_id
-),
-updated_user AS (
-    UPDATE auth.users
-    SET last_seen = now()
-    FROM refreshed_token
-    WHERE auth.users.id = refreshed_token.user_id
-)
-SELECT refreshed_token.refresh_token_id, role FROM auth.user_roles
-RIGHT JOIN refreshed_token ON auth.user_roles.user_id = refreshed_token.user_id
+const deleteAuditLogsBefore = `-- name: DeleteAuditLogsBefore :execrows
+DELETE FROM auth.audit_logs
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteAuditLogsBefore(ctx context.Context, createdAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAuditLogsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDeviceCode = `-- name: DeleteDeviceCode :one
+DELETE FROM auth.device_codes
+WHERE id = $1
+RETURNING user_id
 `
 
-type RefreshTokenAndGetUserRolesParams struct {
-	RefreshTokenHash pgtype.Text
-	ExpiresAt        pgtype.Timestamptz
+func (q *Queries) DeleteDeviceCode(ctx context.Context, id uuid.UUID) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteDeviceCode, id)
+	var user_id pgtype.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
 }
 
-type RefreshTokenAndGetUserRolesRow struct {
-	RefreshTokenID uuid.UUID
-	Role           pgtype.Text
+const deleteEmailOutbox = `-- name: DeleteEmailOutbox :exec
+DELETE FROM auth.email_outbox
+WHERE id = $1
+`
+
+func (q *Queries) DeleteEmailOutbox(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteEmailOutbox, id)
+	return err
 }
 
-func (q *Queries) RefreshTokenAndGetUserRoles(ctx context.Context, arg RefreshTokenAndGetUserRolesParams) ([]RefreshTokenAndGetUserRolesRow, error) {
-	rows, err := q.db.Query(ctx, refreshTokenAndGetUserRoles, arg.RefreshTokenHash, arg.ExpiresAt)
+const deleteExpiredDataExports = `-- name: DeleteExpiredDataExports :execrows
+DELETE FROM auth.data_exports
+WHERE expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredDataExports(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredDataExports)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []RefreshTokenAndGetUserRolesRow
-	for rows.Next() {
-		var i RefreshTokenAndGetUserRolesRow
-		if err := rows.Scan(&i.RefreshTokenID, &i.Role); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const updateUserChangeEmail = `-- name: UpdateUserChangeEmail :one
-UPDATE auth.users
-SET (ticket, ticket_expires_at, new_email) = ($2, $3, $4)
-WHERE id = $1
-RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge
+const deleteExpiredDeviceCodes = `-- name: DeleteExpiredDeviceCodes :exec
+DELETE FROM auth.device_codes
+WHERE expires_at <= now()
 `
 
-type UpdateUserChangeEmailParams struct {
+func (q *Queries) DeleteExpiredDeviceCodes(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredDeviceCodes)
+	return err
+}
+
+const deleteOAuth2AuthorizationCode = `-- name: DeleteOAuth2AuthorizationCode :one
+DELETE FROM auth.oauth2_authorization_codes
+WHERE code_hash = $1 AND client_id = $2
+RETURNING id, created_at, expires_at, code_hash, client_id, user_id, redirect_uri, scopes, nonce, code_challenge
+`
+
+type DeleteOAuth2AuthorizationCodeParams struct {
+	CodeHash string
+	ClientID string
+}
+
+func (q *Queries) DeleteOAuth2AuthorizationCode(ctx context.Context, arg DeleteOAuth2AuthorizationCodeParams) (AuthOauth2AuthorizationCode, error) {
+	row := q.db.QueryRow(ctx, deleteOAuth2AuthorizationCode, arg.CodeHash, arg.ClientID)
+	var i AuthOauth2AuthorizationCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.CodeHash,
+		&i.ClientID,
+		&i.UserID,
+		&i.RedirectUri,
+		&i.Scopes,
+		&i.Nonce,
+		&i.CodeChallenge,
+	)
+	return i, err
+}
+
+const deleteOAuth2Client = `-- name: DeleteOAuth2Client :execrows
+DELETE FROM auth.oauth2_clients
+WHERE client_id = $1
+`
+
+func (q *Queries) DeleteOAuth2Client(ctx context.Context, clientID string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOAuth2Client, clientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteProviderRequest = `-- name: DeleteProviderRequest :one
+DELETE FROM auth.provider_requests
+WHERE id = $1
+RETURNING options
+`
+
+func (q *Queries) DeleteProviderRequest(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	row := q.db.QueryRow(ctx, deleteProviderRequest, id)
+	var options []byte
+	err := row.Scan(&options)
+	return options, err
+}
+
+const deleteRecoveryCode = `-- name: DeleteRecoveryCode :one
+DELETE FROM auth.user_recovery_codes
+WHERE user_id = $1 AND code_hash = $2
+RETURNING id
+`
+
+type DeleteRecoveryCodeParams struct {
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) DeleteRecoveryCode(ctx context.Context, arg DeleteRecoveryCodeParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteRecoveryCode, arg.UserID, arg.CodeHash)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteRefreshTokenFamilyByRotatedHash = `-- name: DeleteRefreshTokenFamilyByRotatedHash :many
+DELETE FROM auth.refresh_tokens
+WHERE family_id = (
+    SELECT reused.family_id FROM auth.refresh_tokens AS reused
+    WHERE reused.refresh_token_hash = $1 AND reused.type = 'regular'
+        AND reused.rotated_at IS NOT NULL
+    LIMIT 1
+)
+RETURNING user_id, family_id
+`
+
+type DeleteRefreshTokenFamilyByRotatedHashRow struct {
+	UserID   uuid.UUID
+	FamilyID uuid.UUID
+}
+
+func (q *Queries) DeleteRefreshTokenFamilyByRotatedHash(ctx context.Context, refreshTokenHash string) ([]DeleteRefreshTokenFamilyByRotatedHashRow, error) {
+	rows, err := q.db.Query(ctx, deleteRefreshTokenFamilyByRotatedHash, refreshTokenHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteRefreshTokenFamilyByRotatedHashRow
+	for rows.Next() {
+		var i DeleteRefreshTokenFamilyByRotatedHashRow
+		if err := rows.Scan(&i.UserID, &i.FamilyID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteRefreshTokens = `-- name: DeleteRefreshTokens :exec
+DELETE FROM auth.refresh_tokens
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRefreshTokens, userID)
+	return err
+}
+
+const deleteRole = `-- name: DeleteRole :execrows
+DELETE FROM auth.roles
+WHERE role = $1
+`
+
+func (q *Queries) DeleteRole(ctx context.Context, role string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRole, role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteScheduledUsers = `-- name: DeleteScheduledUsers :execrows
+DELETE FROM auth.users
+WHERE deletion_scheduled_at <= now()
+`
+
+func (q *Queries) DeleteScheduledUsers(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteScheduledUsers)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSignInMethod = `-- name: DeleteSignInMethod :execrows
+DELETE FROM auth.sign_in_methods
+WHERE method = $1
+`
+
+func (q *Queries) DeleteSignInMethod(ctx context.Context, method string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSignInMethod, method)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM auth.users
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserEmailChangeReverts = `-- name: DeleteUserEmailChangeReverts :exec
+DELETE FROM auth.email_change_reverts
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserEmailChangeReverts(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserEmailChangeReverts, userID)
+	return err
+}
+
+const deleteUserNewDeviceSignIns = `-- name: DeleteUserNewDeviceSignIns :exec
+DELETE FROM auth.new_device_sign_ins
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserNewDeviceSignIns(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserNewDeviceSignIns, userID)
+	return err
+}
+
+const deleteUserPersonalAccessToken = `-- name: DeleteUserPersonalAccessToken :execrows
+DELETE FROM auth.personal_access_tokens
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteUserPersonalAccessTokenParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteUserPersonalAccessToken(ctx context.Context, arg DeleteUserPersonalAccessTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserPersonalAccessToken, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserProvider = `-- name: DeleteUserProvider :one
+DELETE FROM auth.user_providers
+WHERE user_id = $1 AND provider_id = $2
+RETURNING id
+`
+
+type DeleteUserProviderParams struct {
+	UserID     uuid.UUID
+	ProviderID string
+}
+
+func (q *Queries) DeleteUserProvider(ctx context.Context, arg DeleteUserProviderParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteUserProvider, arg.UserID, arg.ProviderID)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteUserRole = `-- name: DeleteUserRole :exec
+DELETE FROM auth.user_roles
+WHERE user_id = $1 AND role = $2
+`
+
+type DeleteUserRoleParams struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+func (q *Queries) DeleteUserRole(ctx context.Context, arg DeleteUserRoleParams) error {
+	_, err := q.db.Exec(ctx, deleteUserRole, arg.UserID, arg.Role)
+	return err
+}
+
+const deleteUserRoles = `-- name: DeleteUserRoles :exec
+DELETE FROM auth.user_roles
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserRoles(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserRoles, userID)
+	return err
+}
+
+const deleteUserSession = `-- name: DeleteUserSession :many
+DELETE FROM auth.refresh_tokens
+WHERE family_id = (
+    SELECT session.family_id FROM auth.refresh_tokens AS session
+    WHERE session.id = $1 AND session.user_id = $2 AND session.type = 'regular'
+        AND session.rotated_at IS NULL
+)
+RETURNING id
+`
+
+type DeleteUserSessionParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteUserSession(ctx context.Context, arg DeleteUserSessionParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, deleteUserSession, arg.ID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteWebhookOutbox = `-- name: DeleteWebhookOutbox :exec
+DELETE FROM auth.webhook_outbox
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookOutbox(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteWebhookOutbox, id)
+	return err
+}
+
+const denyDeviceCode = `-- name: DenyDeviceCode :one
+UPDATE auth.device_codes
+SET denied = true
+WHERE user_code = $1 AND user_id IS NULL AND NOT denied AND expires_at > now()
+RETURNING id
+`
+
+func (q *Queries) DenyDeviceCode(ctx context.Context, userCode string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, denyDeviceCode, userCode)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const failDataExportAttempt = `-- name: FailDataExportAttempt :exec
+UPDATE auth.data_exports
+SET attempts = attempts + 1,
+    last_error = $1,
+    status = CASE WHEN attempts + 1 >= $2::integer THEN 'failed' ELSE status END,
+    expires_at = CASE WHEN attempts + 1 >= $2::integer THEN $3 ELSE expires_at END
+WHERE id = $4
+`
+
+type FailDataExportAttemptParams struct {
+	LastError   pgtype.Text
+	MaxAttempts int32
+	ExpiresAt   pgtype.Timestamptz
+	ID          uuid.UUID
+}
+
+func (q *Queries) FailDataExportAttempt(ctx context.Context, arg FailDataExportAttemptParams) error {
+	_, err := q.db.Exec(ctx, failDataExportAttempt,
+		arg.LastError,
+		arg.MaxAttempts,
+		arg.ExpiresAt,
+		arg.ID,
+	)
+	return err
+}
+
+const failEmailOutbox = `-- name: FailEmailOutbox :exec
+UPDATE auth.email_outbox
+SET attempts = attempts + 1, status = 'failed', last_error = $2
+WHERE id = $1
+`
+
+type FailEmailOutboxParams struct {
+	ID        uuid.UUID
+	LastError pgtype.Text
+}
+
+func (q *Queries) FailEmailOutbox(ctx context.Context, arg FailEmailOutboxParams) error {
+	_, err := q.db.Exec(ctx, failEmailOutbox, arg.ID, arg.LastError)
+	return err
+}
+
+const failWebhookOutbox = `-- name: FailWebhookOutbox :exec
+UPDATE auth.webhook_outbox
+SET attempts = attempts + 1, status = 'failed', last_error = $2
+WHERE id = $1
+`
+
+type FailWebhookOutboxParams struct {
+	ID        uuid.UUID
+	LastError pgtype.Text
+}
+
+func (q *Queries) FailWebhookOutbox(ctx context.Context, arg FailWebhookOutboxParams) error {
+	_, err := q.db.Exec(ctx, failWebhookOutbox, arg.ID, arg.LastError)
+	return err
+}
+
+const getAuditLogs = `-- name: GetAuditLogs :many
+SELECT id, created_at, event, outcome, actor, user_id, ip_address, user_agent, metadata FROM auth.audit_logs
+WHERE ($1::uuid IS NULL OR user_id = $1)
+    AND ($2::text IS NULL OR event = $2)
+ORDER BY created_at DESC, id
+LIMIT $4 OFFSET $3
+`
+
+type GetAuditLogsParams struct {
+	UserID    pgtype.UUID
+	Event     pgtype.Text
+	RowOffset int32
+	RowLimit  int32
+}
+
+func (q *Queries) GetAuditLogs(ctx context.Context, arg GetAuditLogsParams) ([]AuthAuditLog, error) {
+	rows, err := q.db.Query(ctx, getAuditLogs,
+		arg.UserID,
+		arg.Event,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthAuditLog
+	for rows.Next() {
+		var i AuthAuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Event,
+			&i.Outcome,
+			&i.Actor,
+			&i.UserID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDataExport = `-- name: GetDataExport :one
+SELECT id, created_at, user_id, requested_by, status, attempts, next_attempt_at, last_error, data, completed_at, expires_at FROM auth.data_exports
+WHERE id = $1 AND (expires_at IS NULL OR expires_at > now())
+`
+
+func (q *Queries) GetDataExport(ctx context.Context, id uuid.UUID) (AuthDataExport, error) {
+	row := q.db.QueryRow(ctx, getDataExport, id)
+	var i AuthDataExport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.Data,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getDeviceCode = `-- name: GetDeviceCode :one
+SELECT id, created_at, expires_at, device_code_hash, user_code, user_id, denied, last_polled_at FROM auth.device_codes
+WHERE device_code_hash = $1
+`
+
+func (q *Queries) GetDeviceCode(ctx context.Context, deviceCodeHash string) (AuthDeviceCode, error) {
+	row := q.db.QueryRow(ctx, getDeviceCode, deviceCodeHash)
+	var i AuthDeviceCode
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.DeviceCodeHash,
+		&i.UserCode,
+		&i.UserID,
+		&i.Denied,
+		&i.LastPolledAt,
+	)
+	return i, err
+}
+
+const getEmailTemplates = `-- name: GetEmailTemplates :many
+SELECT locale, template, file, content, updated_at FROM auth.email_templates
+`
+
+func (q *Queries) GetEmailTemplates(ctx context.Context) ([]AuthEmailTemplate, error) {
+	rows, err := q.db.Query(ctx, getEmailTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthEmailTemplate
+	for rows.Next() {
+		var i AuthEmailTemplate
+		if err := rows.Scan(
+			&i.Locale,
+			&i.Template,
+			&i.File,
+			&i.Content,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFailedEmailOutbox = `-- name: GetFailedEmailOutbox :many
+SELECT id, created_at, recipient, subject, body, headers, status, attempts, next_attempt_at, last_error FROM auth.email_outbox
+WHERE status = 'failed'
+ORDER BY created_at DESC
+LIMIT $1
+`
+
+func (q *Queries) GetFailedEmailOutbox(ctx context.Context, limit int32) ([]AuthEmailOutbox, error) {
+	rows, err := q.db.Query(ctx, getFailedEmailOutbox, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthEmailOutbox
+	for rows.Next() {
+		var i AuthEmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Recipient,
+			&i.Subject,
+			&i.Body,
+			&i.Headers,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIPLockedUntil = `-- name: GetIPLockedUntil :one
+SELECT locked_until FROM auth.ip_sign_in_failures
+WHERE ip = $1
+`
+
+func (q *Queries) GetIPLockedUntil(ctx context.Context, ip string) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getIPLockedUntil, ip)
+	var locked_until pgtype.Timestamptz
+	err := row.Scan(&locked_until)
+	return locked_until, err
+}
+
+const getIPSignInFailures = `-- name: GetIPSignInFailures :one
+SELECT attempts FROM auth.ip_sign_in_failures
+WHERE ip = $1 AND last_failed_at >= $2
+`
+
+type GetIPSignInFailuresParams struct {
+	Ip            string
+	FailuresSince pgtype.Timestamptz
+}
+
+func (q *Queries) GetIPSignInFailures(ctx context.Context, arg GetIPSignInFailuresParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getIPSignInFailures, arg.Ip, arg.FailuresSince)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const getOAuth2Client = `-- name: GetOAuth2Client :one
+SELECT client_id, created_at, client_secret_hash, description, default_role, allowed_roles, token_exchange_enabled, redirect_uris, skip_consent, grant_types FROM auth.oauth2_clients
+WHERE client_id = $1
+`
+
+func (q *Queries) GetOAuth2Client(ctx context.Context, clientID string) (AuthOauth2Client, error) {
+	row := q.db.QueryRow(ctx, getOAuth2Client, clientID)
+	var i AuthOauth2Client
+	err := row.Scan(
+		&i.ClientID,
+		&i.CreatedAt,
+		&i.ClientSecretHash,
+		&i.Description,
+		&i.DefaultRole,
+		&i.AllowedRoles,
+		&i.TokenExchangeEnabled,
+		&i.RedirectUris,
+		&i.SkipConsent,
+		&i.GrantTypes,
+	)
+	return i, err
+}
+
+const getOAuth2ConsentScopes = `-- name: GetOAuth2ConsentScopes :one
+SELECT scopes FROM auth.oauth2_consents
+WHERE user_id = $1 AND client_id = $2
+`
+
+type GetOAuth2ConsentScopesParams struct {
+	UserID   uuid.UUID
+	ClientID string
+}
+
+func (q *Queries) GetOAuth2ConsentScopes(ctx context.Context, arg GetOAuth2ConsentScopesParams) ([]string, error) {
+	row := q.db.QueryRow(ctx, getOAuth2ConsentScopes, arg.UserID, arg.ClientID)
+	var scopes []string
+	err := row.Scan(&scopes)
+	return scopes, err
+}
+
+const getOrganizationMember = `-- name: GetOrganizationMember :one
+SELECT o.id, o.created_at, o.name, m.role
+FROM auth.organization_members m
+JOIN auth.organizations o ON o.id = m.organization_id
+WHERE m.organization_id = $1 AND m.user_id = $2
+`
+
+type GetOrganizationMemberParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type GetOrganizationMemberRow struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Name      string
+	Role      string
+}
+
+func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (GetOrganizationMemberRow, error) {
+	row := q.db.QueryRow(ctx, getOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i GetOrganizationMemberRow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Name,
+		&i.Role,
+	)
+	return i, err
+}
+
+const getPendingUserDataExport = `-- name: GetPendingUserDataExport :one
+SELECT id, created_at, user_id, requested_by, status, attempts, next_attempt_at, last_error, data, completed_at, expires_at FROM auth.data_exports
+WHERE user_id = $1 AND status = 'pending'
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetPendingUserDataExport(ctx context.Context, userID uuid.UUID) (AuthDataExport, error) {
+	row := q.db.QueryRow(ctx, getPendingUserDataExport, userID)
+	var i AuthDataExport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.Data,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getPersonalAccessTokenByHash = `-- name: GetPersonalAccessTokenByHash :one
+SELECT id, created_at, user_id, name, token_hash, expires_at, last_used_at, metadata FROM auth.personal_access_tokens
+WHERE token_hash = $1 AND (expires_at IS NULL OR expires_at > now())
+`
+
+func (q *Queries) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (AuthPersonalAccessToken, error) {
+	row := q.db.QueryRow(ctx, getPersonalAccessTokenByHash, tokenHash)
+	var i AuthPersonalAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.Name,
+		&i.TokenHash,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.Metadata,
+	)
+	return i, err
+}
+
+const getRefreshTokenByHash = `-- name: GetRefreshTokenByHash :one
+SELECT id, created_at, expires_at, user_id, metadata, type, refresh_token_hash, family_id, rotated_at, last_used_at, ip_address, user_agent, device_fingerprint, client_id, refresh_token_expires_in, access_token_expires_in, client_version, country, city, latitude, longitude FROM auth.refresh_tokens
+WHERE refresh_token_hash = $1 AND expires_at > now() AND rotated_at IS NULL
+LIMIT 1
+`
+
+func (q *Queries) GetRefreshTokenByHash(ctx context.Context, refreshTokenHash string) (AuthRefreshToken, error) {
+	row := q.db.QueryRow(ctx, getRefreshTokenByHash, refreshTokenHash)
+	var i AuthRefreshToken
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.UserID,
+		&i.Metadata,
+		&i.Type,
+		&i.RefreshTokenHash,
+		&i.FamilyID,
+		&i.RotatedAt,
+		&i.LastUsedAt,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.DeviceFingerprint,
+		&i.ClientID,
+		&i.RefreshTokenExpiresIn,
+		&i.AccessTokenExpiresIn,
+		&i.ClientVersion,
+		&i.Country,
+		&i.City,
+		&i.Latitude,
+		&i.Longitude,
+	)
+	return i, err
+}
+
+const getRoleUsers = `-- name: GetRoleUsers :many
+SELECT u.id, u.display_name, u.email
+FROM auth.user_roles r
+JOIN auth.users u ON u.id = r.user_id
+WHERE r.role = $1
+ORDER BY u.created_at, u.id
+`
+
+type GetRoleUsersRow struct {
+	ID          uuid.UUID
+	DisplayName string
+	Email       pgtype.Text
+}
+
+func (q *Queries) GetRoleUsers(ctx context.Context, role string) ([]GetRoleUsersRow, error) {
+	rows, err := q.db.Query(ctx, getRoleUsers, role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRoleUsersRow
+	for rows.Next() {
+		var i GetRoleUsersRow
+		if err := rows.Scan(&i.ID, &i.DisplayName, &i.Email); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoles = `-- name: GetRoles :many
+SELECT r.role, COUNT(ur.id) AS users
+FROM auth.roles r
+LEFT JOIN auth.user_roles ur ON ur.role = r.role
+GROUP BY r.role
+ORDER BY r.role
+`
+
+type GetRolesRow struct {
+	Role  string
+	Users int64
+}
+
+func (q *Queries) GetRoles(ctx context.Context) ([]GetRolesRow, error) {
+	rows, err := q.db.Query(ctx, getRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRolesRow
+	for rows.Next() {
+		var i GetRolesRow
+		if err := rows.Scan(&i.Role, &i.Users); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScimGroups = `-- name: GetScimGroups :many
+SELECT role FROM auth.roles
+WHERE $1::text IS NULL OR role = $1
+ORDER BY role
+LIMIT $3 OFFSET $2
+`
+
+type GetScimGroupsParams struct {
+	Role      pgtype.Text
+	RowOffset int32
+	RowLimit  int32
+}
+
+func (q *Queries) GetScimGroups(ctx context.Context, arg GetScimGroupsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getScimGroups, arg.Role, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScimUsers = `-- name: GetScimUsers :many
+SELECT
+    u.id,
+    u.created_at,
+    u.updated_at,
+    u.disabled,
+    u.display_name,
+    u.email,
+    p.provider_user_id AS external_id,
+    ARRAY(
+        SELECT r.role FROM auth.user_roles r WHERE r.user_id = u.id ORDER BY r.role
+    )::text[] AS roles
+FROM auth.users u
+LEFT JOIN auth.user_providers p ON p.user_id = u.id AND p.provider_id = 'scim'
+WHERE NOT u.is_anonymous
+    AND ($1::uuid IS NULL OR u.id = $1)
+    AND ($2::text IS NULL OR lower(u.email) = lower($2))
+    AND ($3::text IS NULL OR p.provider_user_id = $3)
+ORDER BY u.created_at, u.id
+LIMIT $5 OFFSET $4
+`
+
+type GetScimUsersParams struct {
+	ID         pgtype.UUID
+	Email      pgtype.Text
+	ExternalID pgtype.Text
+	RowOffset  int32
+	RowLimit   int32
+}
+
+type GetScimUsersRow struct {
+	ID          uuid.UUID
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	Disabled    bool
+	DisplayName string
+	Email       pgtype.Text
+	ExternalID  pgtype.Text
+	Roles       []string
+}
+
+func (q *Queries) GetScimUsers(ctx context.Context, arg GetScimUsersParams) ([]GetScimUsersRow, error) {
+	rows, err := q.db.Query(ctx, getScimUsers,
+		arg.ID,
+		arg.Email,
+		arg.ExternalID,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetScimUsersRow
+	for rows.Next() {
+		var i GetScimUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Disabled,
+			&i.DisplayName,
+			&i.Email,
+			&i.ExternalID,
+			&i.Roles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSecurityKeys = `-- name: GetSecurityKeys :many
+SELECT id, user_id, credential_id, credential_public_key, counter, transports, nickname FROM auth.user_security_keys
+WHERE user_id = $1
+`
+
+func (q *Queries) GetSecurityKeys(ctx context.Context, userID uuid.UUID) ([]AuthUserSecurityKey, error) {
+	rows, err := q.db.Query(ctx, getSecurityKeys, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthUserSecurityKey
+	for rows.Next() {
+		var i AuthUserSecurityKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CredentialID,
+			&i.CredentialPublicKey,
+			&i.Counter,
+			&i.Transports,
+			&i.Nickname,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSignInMethods = `-- name: GetSignInMethods :many
+SELECT method, enabled, updated_at FROM auth.sign_in_methods
+ORDER BY method
+`
+
+func (q *Queries) GetSignInMethods(ctx context.Context) ([]AuthSignInMethod, error) {
+	rows, err := q.db.Query(ctx, getSignInMethods)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthSignInMethod
+	for rows.Next() {
+		var i AuthSignInMethod
+		if err := rows.Scan(&i.Method, &i.Enabled, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUser(ctx context.Context, id uuid.UUID) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUser, id)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserActiveOrganizationMember = `-- name: GetUserActiveOrganizationMember :one
+SELECT m.organization_id, m.role
+FROM auth.users u
+JOIN auth.organization_members m
+    ON m.organization_id = u.active_organization_id AND m.user_id = u.id
+WHERE u.id = $1
+`
+
+type GetUserActiveOrganizationMemberRow struct {
+	OrganizationID uuid.UUID
+	Role           string
+}
+
+func (q *Queries) GetUserActiveOrganizationMember(ctx context.Context, id uuid.UUID) (GetUserActiveOrganizationMemberRow, error) {
+	row := q.db.QueryRow(ctx, getUserActiveOrganizationMember, id)
+	var i GetUserActiveOrganizationMemberRow
+	err := row.Scan(&i.OrganizationID, &i.Role)
+	return i, err
+}
+
+const getUserAuditLogs = `-- name: GetUserAuditLogs :many
+SELECT id, created_at, event, outcome, actor, user_id, ip_address, user_agent, metadata FROM auth.audit_logs
+WHERE user_id = $1
+ORDER BY created_at DESC, id
+`
+
+func (q *Queries) GetUserAuditLogs(ctx context.Context, userID pgtype.UUID) ([]AuthAuditLog, error) {
+	rows, err := q.db.Query(ctx, getUserAuditLogs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthAuditLog
+	for rows.Next() {
+		var i AuthAuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Event,
+			&i.Outcome,
+			&i.Actor,
+			&i.UserID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE email = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserByPersonalAccessTokenHash = `-- name: GetUserByPersonalAccessTokenHash :one
+WITH personal_access_token AS (
+    UPDATE auth.personal_access_tokens
+    SET last_used_at = now()
+    WHERE auth.personal_access_tokens.token_hash = $1
+        AND (auth.personal_access_tokens.expires_at IS NULL OR auth.personal_access_tokens.expires_at > now())
+    RETURNING auth.personal_access_tokens.user_id
+)
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE id = (SELECT user_id FROM personal_access_token) LIMIT 1
+`
+
+func (q *Queries) GetUserByPersonalAccessTokenHash(ctx context.Context, tokenHash string) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUserByPersonalAccessTokenHash, tokenHash)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserByPhoneNumber = `-- name: GetUserByPhoneNumber :one
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE phone_number = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByPhoneNumber(ctx context.Context, phoneNumber pgtype.Text) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUserByPhoneNumber, phoneNumber)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserByProviderID = `-- name: GetUserByProviderID :one
+WITH user_provider AS (
+    SELECT user_id
+    FROM auth.user_providers
+    WHERE provider_id = $1 AND provider_user_id = $2
+    LIMIT 1
+)
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE id = (SELECT user_id FROM user_provider) LIMIT 1
+`
+
+type GetUserByProviderIDParams struct {
+	ProviderID     string
+	ProviderUserID string
+}
+
+func (q *Queries) GetUserByProviderID(ctx context.Context, arg GetUserByProviderIDParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUserByProviderID, arg.ProviderID, arg.ProviderUserID)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserByRefreshTokenHash = `-- name: GetUserByRefreshTokenHash :one
+WITH refresh_token AS (
+    -- refresh tokens rotated less than reuse_interval seconds ago are still accepted so
+    -- clients refreshing the session concurrently don't look like a reuse
+    SELECT id, created_at, expires_at, user_id, metadata, type, refresh_token_hash, family_id, rotated_at, last_used_at, ip_address, user_agent, device_fingerprint, client_id, refresh_token_expires_in, access_token_expires_in, client_version, country, city, latitude, longitude FROM auth.refresh_tokens
+    WHERE refresh_token_hash = $1 AND type = $2 AND expires_at > now()
+        AND (
+            rotated_at IS NULL
+            OR rotated_at > now() - make_interval(secs => $3::integer)
+        )
+    LIMIT 1
+)
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE id = (SELECT user_id FROM refresh_token)
+    -- the connections of each tenant set hasura_auth.tenant_id
+    AND tenant_id IS NOT DISTINCT FROM NULLIF(current_setting('hasura_auth.tenant_id', true), '')
+LIMIT 1
+`
+
+type GetUserByRefreshTokenHashParams struct {
+	RefreshTokenHash string
+	Type             RefreshTokenType
+	ReuseInterval    int32
+}
+
+func (q *Queries) GetUserByRefreshTokenHash(ctx context.Context, arg GetUserByRefreshTokenHashParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUserByRefreshTokenHash, arg.RefreshTokenHash, arg.Type, arg.ReuseInterval)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserByTicket = `-- name: GetUserByTicket :one
+SELECT id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts FROM auth.users
+WHERE ticket = $1 AND ticket_expires_at > now()
+LIMIT 1
+`
+
+func (q *Queries) GetUserByTicket(ctx context.Context, ticket pgtype.Text) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, getUserByTicket, ticket)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const getUserPersonalAccessTokens = `-- name: GetUserPersonalAccessTokens :many
+SELECT id, created_at, user_id, name, token_hash, expires_at, last_used_at, metadata FROM auth.personal_access_tokens
+WHERE user_id = $1 AND (expires_at IS NULL OR expires_at > now())
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetUserPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]AuthPersonalAccessToken, error) {
+	rows, err := q.db.Query(ctx, getUserPersonalAccessTokens, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthPersonalAccessToken
+	for rows.Next() {
+		var i AuthPersonalAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UserID,
+			&i.Name,
+			&i.TokenHash,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserProviders = `-- name: GetUserProviders :many
+SELECT id, created_at, updated_at, user_id, access_token, refresh_token, provider_id, provider_user_id FROM auth.user_providers
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) GetUserProviders(ctx context.Context, userID uuid.UUID) ([]AuthUserProvider, error) {
+	rows, err := q.db.Query(ctx, getUserProviders, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthUserProvider
+	for rows.Next() {
+		var i AuthUserProvider
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UserID,
+			&i.AccessToken,
+			&i.RefreshToken,
+			&i.ProviderID,
+			&i.ProviderUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserRoles = `-- name: GetUserRoles :many
+SELECT id, created_at, user_id, role FROM auth.user_roles
+WHERE user_id = $1
+`
+
+func (q *Queries) GetUserRoles(ctx context.Context, userID uuid.UUID) ([]AuthUserRole, error) {
+	rows, err := q.db.Query(ctx, getUserRoles, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthUserRole
+	for rows.Next() {
+		var i AuthUserRole
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UserID,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSessions = `-- name: GetUserSessions :many
+SELECT id, created_at, expires_at, user_id, metadata, type, refresh_token_hash, family_id, rotated_at, last_used_at, ip_address, user_agent, device_fingerprint, client_id, refresh_token_expires_in, access_token_expires_in, client_version, country, city, latitude, longitude FROM auth.refresh_tokens
+WHERE user_id = $1 AND type = 'regular' AND rotated_at IS NULL AND expires_at > now()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetUserSessions(ctx context.Context, userID uuid.UUID) ([]AuthRefreshToken, error) {
+	rows, err := q.db.Query(ctx, getUserSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthRefreshToken
+	for rows.Next() {
+		var i AuthRefreshToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.UserID,
+			&i.Metadata,
+			&i.Type,
+			&i.RefreshTokenHash,
+			&i.FamilyID,
+			&i.RotatedAt,
+			&i.LastUsedAt,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.DeviceFingerprint,
+			&i.ClientID,
+			&i.RefreshTokenExpiresIn,
+			&i.AccessTokenExpiresIn,
+			&i.ClientVersion,
+			&i.Country,
+			&i.City,
+			&i.Latitude,
+			&i.Longitude,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsers = `-- name: GetUsers :many
+SELECT
+    u.id,
+    u.created_at,
+    u.last_seen,
+    u.disabled,
+    u.display_name,
+    u.avatar_url,
+    u.locale,
+    u.email,
+    u.email_verified,
+    u.phone_number,
+    u.phone_number_verified,
+    u.default_role,
+    u.is_anonymous,
+    u.metadata,
+    u.banned_at,
+    u.banned_until,
+    u.ban_reason,
+    ARRAY(
+        SELECT r.role FROM auth.user_roles r WHERE r.user_id = u.id ORDER BY r.role
+    )::text[] AS roles,
+    ARRAY(
+        SELECT p.provider_id FROM auth.user_providers p WHERE p.user_id = u.id ORDER BY p.provider_id
+    )::text[] AS providers
+FROM auth.users u
+WHERE ($1::text IS NULL OR u.email ILIKE $1)
+    AND ($2::text IS NULL OR EXISTS (
+        SELECT 1 FROM auth.user_roles r WHERE r.user_id = u.id AND r.role = $2
+    ))
+    AND ($3::text IS NULL OR EXISTS (
+        SELECT 1 FROM auth.user_providers p
+        WHERE p.user_id = u.id AND p.provider_id = $3
+    ))
+    AND ($4::timestamptz IS NULL OR u.created_at >= $4)
+    AND ($5::timestamptz IS NULL OR u.created_at < $5)
+    AND ($6::boolean IS NULL OR u.disabled = $6)
+    AND ($7::boolean IS NULL OR u.email_verified = $7)
+    AND ($8::uuid IS NULL
+        OR ($9::text = 'created_at' AND NOT $10::boolean
+            AND (u.created_at, u.id) > ($11::timestamptz, $8))
+        OR ($9 = 'created_at' AND $10
+            AND (u.created_at, u.id) < ($11, $8))
+        OR ($9 = 'email' AND NOT $10
+            AND (COALESCE(u.email::text, ''), u.id) > ($12::text, $8))
+        OR ($9 = 'email' AND $10
+            AND (COALESCE(u.email::text, ''), u.id) < ($12, $8))
+        OR ($9 = 'display_name' AND NOT $10
+            AND (u.display_name, u.id) > ($12, $8))
+        OR ($9 = 'display_name' AND $10
+            AND (u.display_name, u.id) < ($12, $8)))
+ORDER BY
+    CASE WHEN $9 = 'created_at' AND NOT $10 THEN u.created_at END ASC,
+    CASE WHEN $9 = 'created_at' AND $10 THEN u.created_at END DESC,
+    CASE WHEN $9 = 'email' AND NOT $10 THEN COALESCE(u.email::text, '') END ASC,
+    CASE WHEN $9 = 'email' AND $10 THEN COALESCE(u.email::text, '') END DESC,
+    CASE WHEN $9 = 'display_name' AND NOT $10 THEN u.display_name END ASC,
+    CASE WHEN $9 = 'display_name' AND $10 THEN u.display_name END DESC,
+    CASE WHEN NOT $10 THEN u.id END ASC,
+    CASE WHEN $10 THEN u.id END DESC
+LIMIT $13
+`
+
+type GetUsersParams struct {
+	Email          pgtype.Text
+	Role           pgtype.Text
+	Provider       pgtype.Text
+	CreatedAfter   pgtype.Timestamptz
+	CreatedBefore  pgtype.Timestamptz
+	Disabled       pgtype.Bool
+	EmailVerified  pgtype.Bool
+	AfterID        pgtype.UUID
+	SortBy         string
+	Descending     bool
+	AfterCreatedAt pgtype.Timestamptz
+	AfterText      pgtype.Text
+	RowLimit       int32
+}
+
+type GetUsersRow struct {
+	ID                  uuid.UUID
+	CreatedAt           pgtype.Timestamptz
+	LastSeen            pgtype.Timestamptz
+	Disabled            bool
+	DisplayName         string
+	AvatarUrl           string
+	Locale              string
+	Email               pgtype.Text
+	EmailVerified       bool
+	PhoneNumber         pgtype.Text
+	PhoneNumberVerified bool
+	DefaultRole         string
+	IsAnonymous         bool
+	Metadata            []byte
+	BannedAt            pgtype.Timestamptz
+	BannedUntil         pgtype.Timestamptz
+	BanReason           pgtype.Text
+	Roles               []string
+	Providers           []string
+}
+
+func (q *Queries) GetUsers(ctx context.Context, arg GetUsersParams) ([]GetUsersRow, error) {
+	rows, err := q.db.Query(ctx, getUsers,
+		arg.Email,
+		arg.Role,
+		arg.Provider,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Disabled,
+		arg.EmailVerified,
+		arg.AfterID,
+		arg.SortBy,
+		arg.Descending,
+		arg.AfterCreatedAt,
+		arg.AfterText,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsersRow
+	for rows.Next() {
+		var i GetUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.LastSeen,
+			&i.Disabled,
+			&i.DisplayName,
+			&i.AvatarUrl,
+			&i.Locale,
+			&i.Email,
+			&i.EmailVerified,
+			&i.PhoneNumber,
+			&i.PhoneNumberVerified,
+			&i.DefaultRole,
+			&i.IsAnonymous,
+			&i.Metadata,
+			&i.BannedAt,
+			&i.BannedUntil,
+			&i.BanReason,
+			&i.Roles,
+			&i.Providers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const importUsers = `-- name: ImportUsers :many
+WITH input AS (
+    SELECT
+        unnest($1::uuid[]) AS id,
+        unnest($2::timestamptz[]) AS created_at,
+        unnest($3::text[]) AS email,
+        unnest($4::boolean[]) AS email_verified,
+        unnest($5::text[]) AS password_hash,
+        unnest($6::text[]) AS phone_number,
+        unnest($7::boolean[]) AS phone_number_verified,
+        unnest($8::text[]) AS display_name,
+        unnest($9::text[]) AS avatar_url,
+        unnest($10::text[]) AS locale,
+        unnest($11::text[]) AS default_role,
+        unnest($12::jsonb[]) AS metadata,
+        unnest($13::boolean[]) AS disabled
+), inserted_users AS (
+    INSERT INTO auth.users (
+        id,
+        created_at,
+        email,
+        email_verified,
+        password_hash,
+        phone_number,
+        phone_number_verified,
+        display_name,
+        avatar_url,
+        locale,
+        default_role,
+        metadata,
+        disabled
+    )
+    SELECT
+        id,
+        created_at,
+        email,
+        email_verified,
+        NULLIF(password_hash, ''),
+        NULLIF(phone_number, ''),
+        phone_number_verified,
+        display_name,
+        avatar_url,
+        locale,
+        default_role,
+        metadata,
+        disabled
+    FROM input
+    ON CONFLICT DO NOTHING
+    RETURNING id
+), inserted_roles AS (
+    INSERT INTO auth.user_roles (user_id, role)
+    SELECT roles.user_id, roles.role
+    FROM (
+        SELECT unnest($14::uuid[]) AS user_id, unnest($15::text[]) AS role
+    ) AS roles
+    WHERE roles.user_id IN (SELECT id FROM inserted_users)
+)
+SELECT id FROM inserted_users
+`
+
+type ImportUsersParams struct {
+	Ids                 []uuid.UUID
+	CreatedAts          []pgtype.Timestamptz
+	Emails              []string
+	EmailVerified       []bool
+	PasswordHashes      []string
+	PhoneNumbers        []string
+	PhoneNumberVerified []bool
+	DisplayNames        []string
+	AvatarUrls          []string
+	Locales             []string
+	DefaultRoles        []string
+	Metadata            [][]byte
+	Disabled            []bool
+	RoleUserIds         []uuid.UUID
+	Roles               []string
+}
+
+func (q *Queries) ImportUsers(ctx context.Context, arg ImportUsersParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, importUsers,
+		arg.Ids,
+		arg.CreatedAts,
+		arg.Emails,
+		arg.EmailVerified,
+		arg.PasswordHashes,
+		arg.PhoneNumbers,
+		arg.PhoneNumberVerified,
+		arg.DisplayNames,
+		arg.AvatarUrls,
+		arg.Locales,
+		arg.DefaultRoles,
+		arg.Metadata,
+		arg.Disabled,
+		arg.RoleUserIds,
+		arg.Roles,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertAuditLog = `-- name: InsertAuditLog :exec
+INSERT INTO auth.audit_logs (event, outcome, actor, user_id, ip_address, user_agent, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertAuditLogParams struct {
+	Event     string
+	Outcome   string
+	Actor     string
+	UserID    pgtype.UUID
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+	Metadata  []byte
+}
+
+func (q *Queries) InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error {
+	_, err := q.db.Exec(ctx, insertAuditLog,
+		arg.Event,
+		arg.Outcome,
+		arg.Actor,
+		arg.UserID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.Metadata,
+	)
+	return err
+}
+
+const insertDataExport = `-- name: InsertDataExport :one
+INSERT INTO auth.data_exports (user_id, requested_by)
+VALUES ($1, $2)
+RETURNING id, created_at, user_id, requested_by, status, attempts, next_attempt_at, last_error, data, completed_at, expires_at
+`
+
+type InsertDataExportParams struct {
+	UserID      uuid.UUID
+	RequestedBy string
+}
+
+func (q *Queries) InsertDataExport(ctx context.Context, arg InsertDataExportParams) (AuthDataExport, error) {
+	row := q.db.QueryRow(ctx, insertDataExport, arg.UserID, arg.RequestedBy)
+	var i AuthDataExport
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.Data,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const insertDeviceCode = `-- name: InsertDeviceCode :one
+INSERT INTO auth.device_codes (device_code_hash, user_code, expires_at)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type InsertDeviceCodeParams struct {
+	DeviceCodeHash string
+	UserCode       string
+	ExpiresAt      pgtype.Timestamptz
+}
+
+func (q *Queries) InsertDeviceCode(ctx context.Context, arg InsertDeviceCodeParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertDeviceCode, arg.DeviceCodeHash, arg.UserCode, arg.ExpiresAt)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertEmailChangeRevert = `-- name: InsertEmailChangeRevert :exec
+INSERT INTO auth.email_change_reverts (user_id, ticket, old_email, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertEmailChangeRevertParams struct {
+	UserID    uuid.UUID
+	Ticket    string
+	OldEmail  string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertEmailChangeRevert(ctx context.Context, arg InsertEmailChangeRevertParams) error {
+	_, err := q.db.Exec(ctx, insertEmailChangeRevert,
+		arg.UserID,
+		arg.Ticket,
+		arg.OldEmail,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertEmailOutbox = `-- name: InsertEmailOutbox :exec
+INSERT INTO auth.email_outbox (recipient, subject, body, headers)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertEmailOutboxParams struct {
+	Recipient string
+	Subject   string
+	Body      string
+	Headers   []byte
+}
+
+func (q *Queries) InsertEmailOutbox(ctx context.Context, arg InsertEmailOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertEmailOutbox,
+		arg.Recipient,
+		arg.Subject,
+		arg.Body,
+		arg.Headers,
+	)
+	return err
+}
+
+const insertNewDeviceSignIn = `-- name: InsertNewDeviceSignIn :exec
+INSERT INTO auth.new_device_sign_ins (user_id, ticket, ip_address, user_agent, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertNewDeviceSignInParams struct {
+	UserID    uuid.UUID
+	Ticket    string
+	IpAddress pgtype.Text
+	UserAgent pgtype.Text
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertNewDeviceSignIn(ctx context.Context, arg InsertNewDeviceSignInParams) error {
+	_, err := q.db.Exec(ctx, insertNewDeviceSignIn,
+		arg.UserID,
+		arg.Ticket,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertOAuth2AuthorizationCode = `-- name: InsertOAuth2AuthorizationCode :exec
+INSERT INTO auth.oauth2_authorization_codes (
+    code_hash, client_id, user_id, redirect_uri, scopes, nonce, code_challenge, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+`
+
+type InsertOAuth2AuthorizationCodeParams struct {
+	CodeHash      string
+	ClientID      string
+	UserID        uuid.UUID
+	RedirectUri   string
+	Scopes        []string
+	Nonce         pgtype.Text
+	CodeChallenge pgtype.Text
+	ExpiresAt     pgtype.Timestamptz
+}
+
+func (q *Queries) InsertOAuth2AuthorizationCode(ctx context.Context, arg InsertOAuth2AuthorizationCodeParams) error {
+	_, err := q.db.Exec(ctx, insertOAuth2AuthorizationCode,
+		arg.CodeHash,
+		arg.ClientID,
+		arg.UserID,
+		arg.RedirectUri,
+		arg.Scopes,
+		arg.Nonce,
+		arg.CodeChallenge,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertOAuth2Client = `-- name: InsertOAuth2Client :exec
+INSERT INTO auth.oauth2_clients (
+    client_id, client_secret_hash, description, default_role, allowed_roles,
+    token_exchange_enabled, redirect_uris, skip_consent, grant_types
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+`
+
+type InsertOAuth2ClientParams struct {
+	ClientID             string
+	ClientSecretHash     string
+	Description          string
+	DefaultRole          string
+	AllowedRoles         []string
+	TokenExchangeEnabled bool
+	RedirectUris         []string
+	SkipConsent          bool
+	GrantTypes           []string
+}
+
+func (q *Queries) InsertOAuth2Client(ctx context.Context, arg InsertOAuth2ClientParams) error {
+	_, err := q.db.Exec(ctx, insertOAuth2Client,
+		arg.ClientID,
+		arg.ClientSecretHash,
+		arg.Description,
+		arg.DefaultRole,
+		arg.AllowedRoles,
+		arg.TokenExchangeEnabled,
+		arg.RedirectUris,
+		arg.SkipConsent,
+		arg.GrantTypes,
+	)
+	return err
+}
+
+const insertOrganization = `-- name: InsertOrganization :one
+WITH inserted_organization AS (
+    INSERT INTO auth.organizations (name)
+    VALUES ($1)
+    RETURNING id, created_at, name
+), inserted_member AS (
+    INSERT INTO auth.organization_members (organization_id, user_id, role)
+    SELECT inserted_organization.id, $2, 'owner'
+    FROM inserted_organization
+)
+SELECT id, created_at, name FROM inserted_organization
+`
+
+type InsertOrganizationParams struct {
+	Name   string
+	UserID uuid.UUID
+}
+
+type InsertOrganizationRow struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamptz
+	Name      string
+}
+
+func (q *Queries) InsertOrganization(ctx context.Context, arg InsertOrganizationParams) (InsertOrganizationRow, error) {
+	row := q.db.QueryRow(ctx, insertOrganization, arg.Name, arg.UserID)
+	var i InsertOrganizationRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.Name)
+	return i, err
+}
+
+const insertPersonalAccessToken = `-- name: InsertPersonalAccessToken :one
+INSERT INTO auth.personal_access_tokens (user_id, name, token_hash, expires_at, metadata)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type InsertPersonalAccessTokenParams struct {
+	UserID    uuid.UUID
+	Name      string
+	TokenHash string
+	ExpiresAt pgtype.Timestamptz
+	Metadata  []byte
+}
+
+func (q *Queries) InsertPersonalAccessToken(ctx context.Context, arg InsertPersonalAccessTokenParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertPersonalAccessToken,
+		arg.UserID,
+		arg.Name,
+		arg.TokenHash,
+		arg.ExpiresAt,
+		arg.Metadata,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertProviderRequest = `-- name: InsertProviderRequest :exec
+INSERT INTO auth.provider_requests (id, options)
+VALUES ($1, $2)
+`
+
+type InsertProviderRequestParams struct {
+	ID      uuid.UUID
+	Options []byte
+}
+
+func (q *Queries) InsertProviderRequest(ctx context.Context, arg InsertProviderRequestParams) error {
+	_, err := q.db.Exec(ctx, insertProviderRequest, arg.ID, arg.Options)
+	return err
+}
+
+const insertRefreshtoken = `-- name: InsertRefreshtoken :one
+INSERT INTO auth.refresh_tokens (
+    user_id,
+    refresh_token_hash,
+    expires_at,
+    type,
+    metadata,
+    ip_address,
+    user_agent,
+    client_id,
+    client_version,
+    country,
+    city,
+    latitude,
+    longitude,
+    refresh_token_expires_in,
+    access_token_expires_in
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15
+)
+RETURNING id
+`
+
+type InsertRefreshtokenParams struct {
+	UserID                uuid.UUID
+	RefreshTokenHash      string
+	ExpiresAt             pgtype.Timestamptz
+	Type                  RefreshTokenType
+	Metadata              []byte
+	IpAddress             pgtype.Text
+	UserAgent             pgtype.Text
+	ClientID              pgtype.Text
+	ClientVersion         pgtype.Text
+	Country               pgtype.Text
+	City                  pgtype.Text
+	Latitude              pgtype.Float8
+	Longitude             pgtype.Float8
+	RefreshTokenExpiresIn pgtype.Int4
+	AccessTokenExpiresIn  pgtype.Int4
+}
+
+func (q *Queries) InsertRefreshtoken(ctx context.Context, arg InsertRefreshtokenParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertRefreshtoken,
+		arg.UserID,
+		arg.RefreshTokenHash,
+		arg.ExpiresAt,
+		arg.Type,
+		arg.Metadata,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ClientID,
+		arg.ClientVersion,
+		arg.Country,
+		arg.City,
+		arg.Latitude,
+		arg.Longitude,
+		arg.RefreshTokenExpiresIn,
+		arg.AccessTokenExpiresIn,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertRole = `-- name: InsertRole :execrows
+INSERT INTO auth.roles (role)
+VALUES ($1)
+ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) InsertRole(ctx context.Context, role string) (int64, error) {
+	result, err := q.db.Exec(ctx, insertRole, role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertSecurityKey = `-- name: InsertSecurityKey :one
+INSERT INTO auth.user_security_keys
+    (user_id, credential_id, credential_public_key, transports, nickname)
+VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type InsertSecurityKeyParams struct {
+	UserID              uuid.UUID
+	CredentialID        string
+	CredentialPublicKey []byte
+	Transports          string
+	Nickname            pgtype.Text
+}
+
+func (q *Queries) InsertSecurityKey(ctx context.Context, arg InsertSecurityKeyParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertSecurityKey,
+		arg.UserID,
+		arg.CredentialID,
+		arg.CredentialPublicKey,
+		arg.Transports,
+		arg.Nickname,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTokenExchange = `-- name: InsertTokenExchange :exec
+INSERT INTO auth.token_exchanges (client_id, user_id, roles, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertTokenExchangeParams struct {
+	ClientID  string
+	UserID    uuid.UUID
+	Roles     []string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertTokenExchange(ctx context.Context, arg InsertTokenExchangeParams) error {
+	_, err := q.db.Exec(ctx, insertTokenExchange,
+		arg.ClientID,
+		arg.UserID,
+		arg.Roles,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const insertUser = `-- name: InsertUser :one
+WITH inserted_user AS (
+    INSERT INTO auth.users (
+        id,
+        disabled,
+        display_name,
+        avatar_url,
+        email,
+        password_hash,
+        ticket,
+        ticket_expires_at,
+        email_verified,
+        locale,
+        default_role,
+        metadata,
+        phone_number,
+        is_anonymous
+    ) VALUES (
+      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $14, $15
+    )
+    RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT inserted_user.id, roles.role
+    FROM inserted_user, unnest($13::TEXT[]) AS roles(role)
+RETURNING user_id, (SELECT created_at FROM inserted_user WHERE id = user_id)
+`
+
+type InsertUserParams struct {
+	ID              uuid.UUID
+	Disabled        bool
+	DisplayName     string
+	AvatarUrl       string
+	Email           pgtype.Text
+	PasswordHash    pgtype.Text
+	Ticket          pgtype.Text
+	TicketExpiresAt pgtype.Timestamptz
+	EmailVerified   bool
+	Locale          string
+	DefaultRole     string
+	Metadata        []byte
+	Roles           []string
+	PhoneNumber     pgtype.Text
+	IsAnonymous     bool
+}
+
+type InsertUserRow struct {
+	UserID    uuid.UUID
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertUser(ctx context.Context, arg InsertUserParams) (InsertUserRow, error) {
+	row := q.db.QueryRow(ctx, insertUser,
+		arg.ID,
+		arg.Disabled,
+		arg.DisplayName,
+		arg.AvatarUrl,
+		arg.Email,
+		arg.PasswordHash,
+		arg.Ticket,
+		arg.TicketExpiresAt,
+		arg.EmailVerified,
+		arg.Locale,
+		arg.DefaultRole,
+		arg.Metadata,
+		arg.Roles,
+		arg.PhoneNumber,
+		arg.IsAnonymous,
+	)
+	var i InsertUserRow
+	err := row.Scan(&i.UserID, &i.CreatedAt)
+	return i, err
+}
+
+const insertUserProvider = `-- name: InsertUserProvider :one
+INSERT INTO auth.user_providers
+    (user_id, provider_id, provider_user_id, access_token, refresh_token)
+VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type InsertUserProviderParams struct {
+	UserID         uuid.UUID
+	ProviderID     string
+	ProviderUserID string
+	AccessToken    string
+	RefreshToken   pgtype.Text
+}
+
+func (q *Queries) InsertUserProvider(ctx context.Context, arg InsertUserProviderParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertUserProvider,
+		arg.UserID,
+		arg.ProviderID,
+		arg.ProviderUserID,
+		arg.AccessToken,
+		arg.RefreshToken,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUserRole = `-- name: InsertUserRole :exec
+INSERT INTO auth.user_roles (user_id, role)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type InsertUserRoleParams struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+func (q *Queries) InsertUserRole(ctx context.Context, arg InsertUserRoleParams) error {
+	_, err := q.db.Exec(ctx, insertUserRole, arg.UserID, arg.Role)
+	return err
+}
+
+const insertUserWithRefreshToken = `-- name: InsertUserWithRefreshToken :one
+WITH inserted_user AS (
+    INSERT INTO auth.users (
+        disabled,
+        display_name,
+        avatar_url,
+        email,
+        password_hash,
+        ticket,
+        ticket_expires_at,
+        email_verified,
+        locale,
+        default_role,
+        metadata,
+        last_seen
+    ) VALUES (
+      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+    )
+    RETURNING id, created_at
+), inserted_refresh_token AS (
+    INSERT INTO auth.refresh_tokens (
+        user_id,
+        refresh_token_hash,
+        expires_at,
+        ip_address,
+        user_agent,
+        client_id,
+        client_version,
+        country,
+        city,
+        latitude,
+        longitude,
+        refresh_token_expires_in,
+        access_token_expires_in
+    )
+        SELECT
+            inserted_user.id,
+            $13,
+            $14,
+            $15,
+            $16,
+            $17,
+            $18,
+            $19,
+            $20,
+            $21,
+            $22,
+            $23,
+            $24
+        FROM inserted_user
+    RETURNING id AS refresh_token_id
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT inserted_user.id, roles.role
+    FROM inserted_user, unnest($12::TEXT[]) AS roles(role)
+RETURNING (SELECT refresh_token_id FROM inserted_refresh_token), user_id
+`
+
+type InsertUserWithRefreshTokenParams struct {
+	Disabled                  bool
+	DisplayName               string
+	AvatarUrl                 string
+	Email                     pgtype.Text
+	PasswordHash              pgtype.Text
+	Ticket                    pgtype.Text
+	TicketExpiresAt           pgtype.Timestamptz
+	EmailVerified             bool
+	Locale                    string
+	DefaultRole               string
+	Metadata                  []byte
+	Roles                     []string
+	RefreshTokenHash          string
+	RefreshTokenExpiresAt     pgtype.Timestamptz
+	RefreshTokenIpAddress     pgtype.Text
+	RefreshTokenUserAgent     pgtype.Text
+	RefreshTokenClientID      pgtype.Text
+	RefreshTokenClientVersion pgtype.Text
+	RefreshTokenCountry       pgtype.Text
+	RefreshTokenCity          pgtype.Text
+	RefreshTokenLatitude      pgtype.Float8
+	RefreshTokenLongitude     pgtype.Float8
+	RefreshTokenExpiresIn     pgtype.Int4
+	AccessTokenExpiresIn      pgtype.Int4
+}
+
+type InsertUserWithRefreshTokenRow struct {
+	RefreshTokenID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) InsertUserWithRefreshToken(ctx context.Context, arg InsertUserWithRefreshTokenParams) (InsertUserWithRefreshTokenRow, error) {
+	row := q.db.QueryRow(ctx, insertUserWithRefreshToken,
+		arg.Disabled,
+		arg.DisplayName,
+		arg.AvatarUrl,
+		arg.Email,
+		arg.PasswordHash,
+		arg.Ticket,
+		arg.TicketExpiresAt,
+		arg.EmailVerified,
+		arg.Locale,
+		arg.DefaultRole,
+		arg.Metadata,
+		arg.Roles,
+		arg.RefreshTokenHash,
+		arg.RefreshTokenExpiresAt,
+		arg.RefreshTokenIpAddress,
+		arg.RefreshTokenUserAgent,
+		arg.RefreshTokenClientID,
+		arg.RefreshTokenClientVersion,
+		arg.RefreshTokenCountry,
+		arg.RefreshTokenCity,
+		arg.RefreshTokenLatitude,
+		arg.RefreshTokenLongitude,
+		arg.RefreshTokenExpiresIn,
+		arg.AccessTokenExpiresIn,
+	)
+	var i InsertUserWithRefreshTokenRow
+	err := row.Scan(&i.RefreshTokenID, &i.UserID)
+	return i, err
+}
+
+const insertUserWithSecurityKey = `-- name: InsertUserWithSecurityKey :one
+WITH inserted_user AS (
+    INSERT INTO auth.users (
+        id,
+        disabled,
+        display_name,
+        avatar_url,
+        email,
+        ticket,
+        ticket_expires_at,
+        email_verified,
+        locale,
+        default_role,
+        metadata,
+        last_seen
+    ) VALUES (
+      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+    )
+    RETURNING id
+), inserted_security_key AS (
+    INSERT INTO auth.user_security_keys
+        (user_id, credential_id, credential_public_key, transports, nickname)
+    VALUES
+        ($1, $13, $14, $15, $16)
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT inserted_user.id, roles.role
+    FROM inserted_user, unnest($12::TEXT[]) AS roles(role)
+RETURNING user_id
+`
+
+type InsertUserWithSecurityKeyParams struct {
+	ID                  uuid.UUID
+	Disabled            bool
+	DisplayName         string
+	AvatarUrl           string
+	Email               pgtype.Text
+	Ticket              pgtype.Text
+	TicketExpiresAt     pgtype.Timestamptz
+	EmailVerified       bool
+	Locale              string
+	DefaultRole         string
+	Metadata            []byte
+	Roles               []string
+	CredentialID        string
+	CredentialPublicKey []byte
+	Transports          string
+	Nickname            pgtype.Text
+}
+
+func (q *Queries) InsertUserWithSecurityKey(ctx context.Context, arg InsertUserWithSecurityKeyParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertUserWithSecurityKey,
+		arg.ID,
+		arg.Disabled,
+		arg.DisplayName,
+		arg.AvatarUrl,
+		arg.Email,
+		arg.Ticket,
+		arg.TicketExpiresAt,
+		arg.EmailVerified,
+		arg.Locale,
+		arg.DefaultRole,
+		arg.Metadata,
+		arg.Roles,
+		arg.CredentialID,
+		arg.CredentialPublicKey,
+		arg.Transports,
+		arg.Nickname,
+	)
+	var user_id uuid.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const insertUserWithSecurityKeyAndRefreshToken = `-- name: InsertUserWithSecurityKeyAndRefreshToken :one
+WITH inserted_user AS (
+    INSERT INTO auth.users (
+        id,
+        disabled,
+        display_name,
+        avatar_url,
+        email,
+        ticket,
+        ticket_expires_at,
+        email_verified,
+        locale,
+        default_role,
+        metadata,
+        last_seen
+    ) VALUES (
+      $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now()
+    )
+    RETURNING id
+), inserted_refresh_token AS (
+    INSERT INTO auth.refresh_tokens (
+        user_id,
+        refresh_token_hash,
+        expires_at,
+        ip_address,
+        user_agent,
+        client_id,
+        client_version,
+        country,
+        city,
+        latitude,
+        longitude,
+        refresh_token_expires_in,
+        access_token_expires_in
+    )
+    VALUES
+        (
+            $1,
+            $13,
+            $14,
+            $15,
+            $16,
+            $17,
+            $18,
+            $19,
+            $20,
+            $21,
+            $22,
+            $23,
+            $24
+        )
+    RETURNING id AS refresh_token_id
+), inserted_security_key AS (
+    INSERT INTO auth.user_security_keys
+        (user_id, credential_id, credential_public_key, transports, nickname)
+    VALUES
+        ($1, $25, $26, $27, $28)
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT inserted_user.id, roles.role
+    FROM inserted_user, unnest($12::TEXT[]) AS roles(role)
+RETURNING (SELECT refresh_token_id FROM inserted_refresh_token), user_id
+`
+
+type InsertUserWithSecurityKeyAndRefreshTokenParams struct {
+	ID                        uuid.UUID
+	Disabled                  bool
+	DisplayName               string
+	AvatarUrl                 string
+	Email                     pgtype.Text
+	Ticket                    pgtype.Text
+	TicketExpiresAt           pgtype.Timestamptz
+	EmailVerified             bool
+	Locale                    string
+	DefaultRole               string
+	Metadata                  []byte
+	Roles                     []string
+	RefreshTokenHash          string
+	RefreshTokenExpiresAt     pgtype.Timestamptz
+	RefreshTokenIpAddress     pgtype.Text
+	RefreshTokenUserAgent     pgtype.Text
+	RefreshTokenClientID      pgtype.Text
+	RefreshTokenClientVersion pgtype.Text
+	RefreshTokenCountry       pgtype.Text
+	RefreshTokenCity          pgtype.Text
+	RefreshTokenLatitude      pgtype.Float8
+	RefreshTokenLongitude     pgtype.Float8
+	RefreshTokenExpiresIn     pgtype.Int4
+	AccessTokenExpiresIn      pgtype.Int4
+	CredentialID              string
+	CredentialPublicKey       []byte
+	Transports                string
+	Nickname                  pgtype.Text
+}
+
+type InsertUserWithSecurityKeyAndRefreshTokenRow struct {
+	RefreshTokenID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) InsertUserWithSecurityKeyAndRefreshToken(ctx context.Context, arg InsertUserWithSecurityKeyAndRefreshTokenParams) (InsertUserWithSecurityKeyAndRefreshTokenRow, error) {
+	row := q.db.QueryRow(ctx, insertUserWithSecurityKeyAndRefreshToken,
+		arg.ID,
+		arg.Disabled,
+		arg.DisplayName,
+		arg.AvatarUrl,
+		arg.Email,
+		arg.Ticket,
+		arg.TicketExpiresAt,
+		arg.EmailVerified,
+		arg.Locale,
+		arg.DefaultRole,
+		arg.Metadata,
+		arg.Roles,
+		arg.RefreshTokenHash,
+		arg.RefreshTokenExpiresAt,
+		arg.RefreshTokenIpAddress,
+		arg.RefreshTokenUserAgent,
+		arg.RefreshTokenClientID,
+		arg.RefreshTokenClientVersion,
+		arg.RefreshTokenCountry,
+		arg.RefreshTokenCity,
+		arg.RefreshTokenLatitude,
+		arg.RefreshTokenLongitude,
+		arg.RefreshTokenExpiresIn,
+		arg.AccessTokenExpiresIn,
+		arg.CredentialID,
+		arg.CredentialPublicKey,
+		arg.Transports,
+		arg.Nickname,
+	)
+	var i InsertUserWithSecurityKeyAndRefreshTokenRow
+	err := row.Scan(&i.RefreshTokenID, &i.UserID)
+	return i, err
+}
+
+const insertUserWithUserProvider = `-- name: InsertUserWithUserProvider :one
+WITH inserted_user AS (
+    INSERT INTO auth.users (
+        id,
+        disabled,
+        display_name,
+        avatar_url,
+        email,
+        email_verified,
+        locale,
+        default_role,
+        metadata
+    ) VALUES (
+      $1, $2, $3, $4, $5, $6, $7, $8, $9
+    )
+    RETURNING id, created_at
+), inserted_user_provider AS (
+    INSERT INTO auth.user_providers
+        (user_id, provider_id, provider_user_id, access_token, refresh_token)
+    SELECT inserted_user.id, $11, $12, $13, $14
+    FROM inserted_user
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT inserted_user.id, roles.role
+    FROM inserted_user, unnest($10::TEXT[]) AS roles(role)
+RETURNING user_id, (SELECT created_at FROM inserted_user WHERE id = user_id)
+`
+
+type InsertUserWithUserProviderParams struct {
+	ID             uuid.UUID
+	Disabled       bool
+	DisplayName    string
+	AvatarUrl      string
+	Email          pgtype.Text
+	EmailVerified  bool
+	Locale         string
+	DefaultRole    string
+	Metadata       []byte
+	Roles          []string
+	ProviderID     string
+	ProviderUserID string
+	AccessToken    string
+	RefreshToken   pgtype.Text
+}
+
+type InsertUserWithUserProviderRow struct {
+	UserID    uuid.UUID
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertUserWithUserProvider(ctx context.Context, arg InsertUserWithUserProviderParams) (InsertUserWithUserProviderRow, error) {
+	row := q.db.QueryRow(ctx, insertUserWithUserProvider,
+		arg.ID,
+		arg.Disabled,
+		arg.DisplayName,
+		arg.AvatarUrl,
+		arg.Email,
+		arg.EmailVerified,
+		arg.Locale,
+		arg.DefaultRole,
+		arg.Metadata,
+		arg.Roles,
+		arg.ProviderID,
+		arg.ProviderUserID,
+		arg.AccessToken,
+		arg.RefreshToken,
+	)
+	var i InsertUserWithUserProviderRow
+	err := row.Scan(&i.UserID, &i.CreatedAt)
+	return i, err
+}
+
+const insertWebhookOutbox = `-- name: InsertWebhookOutbox :exec
+INSERT INTO auth.webhook_outbox (endpoint, payload)
+VALUES ($1, $2)
+`
+
+type InsertWebhookOutboxParams struct {
+	Endpoint string
+	Payload  []byte
+}
+
+func (q *Queries) InsertWebhookOutbox(ctx context.Context, arg InsertWebhookOutboxParams) error {
+	_, err := q.db.Exec(ctx, insertWebhookOutbox, arg.Endpoint, arg.Payload)
+	return err
+}
+
+const lockUserSessions = `-- name: LockUserSessions :exec
+SELECT pg_advisory_xact_lock(hashtextextended('auth.user_sessions:' || CAST($1::uuid AS text), 0))
+`
+
+func (q *Queries) LockUserSessions(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockUserSessions, userID)
+	return err
+}
+
+const ping = `-- name: Ping :exec
+SELECT 1
+`
+
+func (q *Queries) Ping(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, ping)
+	return err
+}
+
+const recordIPSignInFailure = `-- name: RecordIPSignInFailure :one
+INSERT INTO auth.ip_sign_in_failures (ip, attempts, last_failed_at)
+VALUES ($1, 1, now())
+ON CONFLICT (ip) DO UPDATE
+SET attempts = CASE
+        WHEN auth.ip_sign_in_failures.last_failed_at < $2 THEN 1
+        ELSE auth.ip_sign_in_failures.attempts + 1
+    END,
+    last_failed_at = now()
+RETURNING attempts
+`
+
+type RecordIPSignInFailureParams struct {
+	Ip            string
+	FailuresSince pgtype.Timestamptz
+}
+
+func (q *Queries) RecordIPSignInFailure(ctx context.Context, arg RecordIPSignInFailureParams) (int32, error) {
+	row := q.db.QueryRow(ctx, recordIPSignInFailure, arg.Ip, arg.FailuresSince)
+	var attempts int32
+	err := row.Scan(&attempts)
+	return attempts, err
+}
+
+const recordUserSignInFailure = `-- name: RecordUserSignInFailure :one
+UPDATE auth.users
+SET failed_sign_in_attempts = CASE
+        WHEN last_failed_sign_in_at < $1 THEN 1
+        ELSE failed_sign_in_attempts + 1
+    END,
+    last_failed_sign_in_at = now()
+WHERE id = $2
+RETURNING failed_sign_in_attempts
+`
+
+type RecordUserSignInFailureParams struct {
+	FailuresSince pgtype.Timestamptz
+	ID            uuid.UUID
+}
+
+func (q *Queries) RecordUserSignInFailure(ctx context.Context, arg RecordUserSignInFailureParams) (int32, error) {
+	row := q.db.QueryRow(ctx, recordUserSignInFailure, arg.FailuresSince, arg.ID)
+	var failed_sign_in_attempts int32
+	err := row.Scan(&failed_sign_in_attempts)
+	return failed_sign_in_attempts, err
+}
+
+const refreshTokenDeviceFingerprintExists = `-- name: RefreshTokenDeviceFingerprintExists :one
+SELECT EXISTS (
+    SELECT 1 FROM auth.refresh_tokens
+    WHERE user_id = $1 AND device_fingerprint = $2
+)
+`
+
+type RefreshTokenDeviceFingerprintExistsParams struct {
+	UserID            uuid.UUID
+	DeviceFingerprint pgtype.Text
+}
+
+func (q *Queries) RefreshTokenDeviceFingerprintExists(ctx context.Context, arg RefreshTokenDeviceFingerprintExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, refreshTokenDeviceFingerprintExists, arg.UserID, arg.DeviceFingerprint)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const replaceRecoveryCodes = `-- name: ReplaceRecoveryCodes :exec
+WITH deleted_codes AS (
+    DELETE FROM auth.user_recovery_codes
+    WHERE user_id = $1
+)
+INSERT INTO auth.user_recovery_codes (user_id, code_hash)
+SELECT $1, unnest($2::TEXT[])
+`
+
+type ReplaceRecoveryCodesParams struct {
+	UserID     uuid.UUID
+	CodeHashes []string
+}
+
+func (q *Queries) ReplaceRecoveryCodes(ctx context.Context, arg ReplaceRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, replaceRecoveryCodes, arg.UserID, arg.CodeHashes)
+	return err
+}
+
+const rescheduleEmailOutbox = `-- name: RescheduleEmailOutbox :exec
+UPDATE auth.email_outbox
+SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE id = $1
+`
+
+type RescheduleEmailOutboxParams struct {
+	ID            uuid.UUID
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+}
+
+func (q *Queries) RescheduleEmailOutbox(ctx context.Context, arg RescheduleEmailOutboxParams) error {
+	_, err := q.db.Exec(ctx, rescheduleEmailOutbox, arg.ID, arg.NextAttemptAt, arg.LastError)
+	return err
+}
+
+const rescheduleWebhookOutbox = `-- name: RescheduleWebhookOutbox :exec
+UPDATE auth.webhook_outbox
+SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3
+WHERE id = $1
+`
+
+type RescheduleWebhookOutboxParams struct {
+	ID            uuid.UUID
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+}
+
+func (q *Queries) RescheduleWebhookOutbox(ctx context.Context, arg RescheduleWebhookOutboxParams) error {
+	_, err := q.db.Exec(ctx, rescheduleWebhookOutbox, arg.ID, arg.NextAttemptAt, arg.LastError)
+	return err
+}
+
+const resetUserSignInFailures = `-- name: ResetUserSignInFailures :execrows
+UPDATE auth.users
+SET failed_sign_in_attempts = 0, last_failed_sign_in_at = NULL, locked_until = NULL
+WHERE id = $1
+`
+
+func (q *Queries) ResetUserSignInFailures(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, resetUserSignInFailures, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const retryEmailOutbox = `-- name: RetryEmailOutbox :execrows
+UPDATE auth.email_outbox
+SET status = 'pending', attempts = 0, next_attempt_at = now(), last_error = NULL
+WHERE id = $1 AND status = 'failed'
+`
+
+func (q *Queries) RetryEmailOutbox(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, retryEmailOutbox, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeUserSessions = `-- name: RevokeUserSessions :execrows
+WITH deleted_refresh_tokens AS (
+    DELETE FROM auth.refresh_tokens
+    WHERE auth.refresh_tokens.user_id = $1
+), deleted_personal_access_tokens AS (
+    DELETE FROM auth.personal_access_tokens
+    WHERE auth.personal_access_tokens.user_id = $1
+)
+UPDATE auth.users
+SET tokens_valid_after = now()
+WHERE auth.users.id = $1
+`
+
+func (q *Queries) RevokeUserSessions(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserSessions, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const rotateRefreshTokenAndGetUserRoles = `-- name: RotateRefreshTokenAndGetUserRoles :many
+WITH rotated_token AS (
+    -- a token rotated less than reuse_interval seconds ago can be rotated again, it keeps
+    -- the time it was first rotated so the interval isn't extended
+    UPDATE auth.refresh_tokens
+    SET rotated_at = COALESCE(rotated_at, now())
+    WHERE auth.refresh_tokens.refresh_token_hash = $1
+        AND (
+            auth.refresh_tokens.rotated_at IS NULL
+            OR auth.refresh_tokens.rotated_at
+                > now() - make_interval(secs => $2::integer)
+        )
+    RETURNING
+        user_id,
+        family_id,
+        type,
+        metadata,
+        created_at,
+        expires_at,
+        client_id,
+        client_version,
+        refresh_token_expires_in,
+        access_token_expires_in
+),
+new_token AS (
+    -- created_at is carried over so it reflects when the session started and the
+    -- lifetimes of the session, if it has its own, are kept. With sliding expiration the
+    -- new token expires a lifetime from now, up to max_lifetime after the session
+    -- started, otherwise it expires along with the old one
+    INSERT INTO auth.refresh_tokens (
+        user_id,
+        refresh_token_hash,
+        expires_at,
+        type,
+        metadata,
+        family_id,
+        created_at,
+        last_used_at,
+        ip_address,
+        user_agent,
+        client_id,
+        client_version,
+        country,
+        city,
+        latitude,
+        longitude,
+        refresh_token_expires_in,
+        access_token_expires_in
+    )
+    SELECT
+        user_id,
+        $3,
+        CASE
+            WHEN NOT $4::boolean THEN expires_at
+            ELSE LEAST(
+                CASE
+                    WHEN refresh_token_expires_in IS NULL THEN $5::timestamptz
+                    ELSE now() + make_interval(secs => refresh_token_expires_in)
+                END,
+                created_at + make_interval(secs => $6::integer)
+            )
+        END AS expires_at,
+        type,
+        metadata,
+        family_id,
+        created_at,
+        now(),
+        $7,
+        $8,
+        COALESCE(client_id, $9::text) AS client_id,
+        COALESCE($10::text, client_version) AS client_version,
+        $11,
+        $12,
+        $13,
+        $14,
+        COALESCE(
+            refresh_token_expires_in, $15::integer
+        ) AS refresh_token_expires_in,
+        COALESCE(
+            access_token_expires_in, $16::integer
+        ) AS access_token_expires_in
+    FROM rotated_token
+    RETURNING id AS refresh_token_id, user_id, access_token_expires_in
+),
+pruned_tokens AS (
+    -- the tokens of the family rotated before are deleted, the one rotated now is kept
+    -- so its reuse can be detected until the next rotation
+    DELETE FROM auth.refresh_tokens
+    WHERE auth.refresh_tokens.family_id IN (SELECT family_id FROM rotated_token)
+        AND auth.refresh_tokens.rotated_at
+            <= now() - make_interval(secs => $2::integer)
+),
+updated_user AS (
+    UPDATE auth.users
+    SET last_seen = now()
+    FROM new_token
+    WHERE auth.users.id = new_token.user_id
+)
+SELECT new_token.refresh_token_id, new_token.access_token_expires_in, role FROM auth.user_roles
+RIGHT JOIN new_token ON auth.user_roles.user_id = new_token.user_id
+`
+
+type RotateRefreshTokenAndGetUserRolesParams struct {
+	OldRefreshTokenHash   string
+	ReuseInterval         int32
+	RefreshTokenHash      string
+	SlidingExpiration     bool
+	ExpiresAt             pgtype.Timestamptz
+	MaxLifetime           pgtype.Int4
+	IpAddress             pgtype.Text
+	UserAgent             pgtype.Text
+	ClientID              pgtype.Text
+	ClientVersion         pgtype.Text
+	Country               pgtype.Text
+	City                  pgtype.Text
+	Latitude              pgtype.Float8
+	Longitude             pgtype.Float8
+	RefreshTokenExpiresIn pgtype.Int4
+	AccessTokenExpiresIn  pgtype.Int4
+}
+
+type RotateRefreshTokenAndGetUserRolesRow struct {
+	RefreshTokenID       uuid.UUID
+	AccessTokenExpiresIn pgtype.Int4
+	Role                 pgtype.Text
+}
+
+func (q *Queries) RotateRefreshTokenAndGetUserRoles(ctx context.Context, arg RotateRefreshTokenAndGetUserRolesParams) ([]RotateRefreshTokenAndGetUserRolesRow, error) {
+	rows, err := q.db.Query(ctx, rotateRefreshTokenAndGetUserRoles,
+		arg.OldRefreshTokenHash,
+		arg.ReuseInterval,
+		arg.RefreshTokenHash,
+		arg.SlidingExpiration,
+		arg.ExpiresAt,
+		arg.MaxLifetime,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.ClientID,
+		arg.ClientVersion,
+		arg.Country,
+		arg.City,
+		arg.Latitude,
+		arg.Longitude,
+		arg.RefreshTokenExpiresIn,
+		arg.AccessTokenExpiresIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RotateRefreshTokenAndGetUserRolesRow
+	for rows.Next() {
+		var i RotateRefreshTokenAndGetUserRolesRow
+		if err := rows.Scan(&i.RefreshTokenID, &i.AccessTokenExpiresIn, &i.Role); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const scheduleUserDeletion = `-- name: ScheduleUserDeletion :one
+WITH deletion AS (
+    INSERT INTO auth.account_deletions (user_id, ticket)
+    VALUES ($2, $3::text)
+    ON CONFLICT (user_id) DO UPDATE SET ticket = EXCLUDED.ticket, created_at = now()
+)
+UPDATE auth.users
+SET deletion_scheduled_at = $1
+WHERE id = $2
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+type ScheduleUserDeletionParams struct {
+	DeletionScheduledAt pgtype.Timestamptz
+	ID                  uuid.UUID
+	Ticket              string
+}
+
+func (q *Queries) ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, scheduleUserDeletion, arg.DeletionScheduledAt, arg.ID, arg.Ticket)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const unbanUser = `-- name: UnbanUser :execrows
+UPDATE auth.users
+SET banned_at = NULL, banned_until = NULL, ban_reason = NULL
+WHERE id = $1
+`
+
+func (q *Queries) UnbanUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unbanUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateDeviceCodeLastPolled = `-- name: UpdateDeviceCodeLastPolled :exec
+UPDATE auth.device_codes
+SET last_polled_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateDeviceCodeLastPolled(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, updateDeviceCodeLastPolled, id)
+	return err
+}
+
+const updateIPLockedUntil = `-- name: UpdateIPLockedUntil :exec
+UPDATE auth.ip_sign_in_failures
+SET locked_until = $2
+WHERE ip = $1
+`
+
+type UpdateIPLockedUntilParams struct {
+	Ip          string
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateIPLockedUntil(ctx context.Context, arg UpdateIPLockedUntilParams) error {
+	_, err := q.db.Exec(ctx, updateIPLockedUntil, arg.Ip, arg.LockedUntil)
+	return err
+}
+
+const updateProviderSession = `-- name: UpdateProviderSession :exec
+UPDATE auth.user_providers
+SET
+    -- providers return empty tokens when nothing changed, the stored ones are kept then
+    access_token = COALESCE(NULLIF($1::text, ''), access_token),
+    refresh_token = COALESCE($2::text, refresh_token)
+WHERE provider_id = $3 AND provider_user_id = $4
+`
+
+type UpdateProviderSessionParams struct {
+	AccessToken    string
+	RefreshToken   pgtype.Text
+	ProviderID     string
+	ProviderUserID string
+}
+
+func (q *Queries) UpdateProviderSession(ctx context.Context, arg UpdateProviderSessionParams) error {
+	_, err := q.db.Exec(ctx, updateProviderSession,
+		arg.AccessToken,
+		arg.RefreshToken,
+		arg.ProviderID,
+		arg.ProviderUserID,
+	)
+	return err
+}
+
+const updateScimUser = `-- name: UpdateScimUser :one
+WITH updated_user AS (
+    UPDATE auth.users
+    SET email = $1, email_verified = true, display_name = $2, disabled = $3
+    WHERE auth.users.id = $4
+    RETURNING id
+), deleted_user_provider AS (
+    DELETE FROM auth.user_providers
+    WHERE user_id IN (SELECT id FROM updated_user)
+        AND provider_id = 'scim'
+        AND $5::text IS NULL
+), upserted_user_provider AS (
+    INSERT INTO auth.user_providers (user_id, provider_id, provider_user_id, access_token)
+    SELECT id, 'scim', $5, ''
+    FROM updated_user
+    WHERE $5 IS NOT NULL
+    ON CONFLICT (user_id, provider_id)
+        DO UPDATE SET provider_user_id = EXCLUDED.provider_user_id
+)
+SELECT id FROM updated_user
+`
+
+type UpdateScimUserParams struct {
+	Email       pgtype.Text
+	DisplayName string
+	Disabled    bool
+	ID          uuid.UUID
+	ExternalID  pgtype.Text
+}
+
+func (q *Queries) UpdateScimUser(ctx context.Context, arg UpdateScimUserParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, updateScimUser,
+		arg.Email,
+		arg.DisplayName,
+		arg.Disabled,
+		arg.ID,
+		arg.ExternalID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateSecurityKeyCounter = `-- name: UpdateSecurityKeyCounter :exec
+UPDATE auth.user_security_keys
+SET counter = $1
+WHERE user_id = $2 AND credential_id = $3
+`
+
+type UpdateSecurityKeyCounterParams struct {
+	Counter      int64
+	UserID       uuid.UUID
+	CredentialID string
+}
+
+func (q *Queries) UpdateSecurityKeyCounter(ctx context.Context, arg UpdateSecurityKeyCounterParams) error {
+	_, err := q.db.Exec(ctx, updateSecurityKeyCounter, arg.Counter, arg.UserID, arg.CredentialID)
+	return err
+}
+
+const updateUserActiveMFAType = `-- name: UpdateUserActiveMFAType :exec
+UPDATE auth.users
+SET active_mfa_type = $2
+WHERE id = $1
+`
+
+type UpdateUserActiveMFATypeParams struct {
+	ID            uuid.UUID
+	ActiveMfaType pgtype.Text
+}
+
+func (q *Queries) UpdateUserActiveMFAType(ctx context.Context, arg UpdateUserActiveMFATypeParams) error {
+	_, err := q.db.Exec(ctx, updateUserActiveMFAType, arg.ID, arg.ActiveMfaType)
+	return err
+}
+
+const updateUserActiveOrganization = `-- name: UpdateUserActiveOrganization :one
+UPDATE auth.users
+SET active_organization_id = $2
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+type UpdateUserActiveOrganizationParams struct {
+	ID                   uuid.UUID
+	ActiveOrganizationID pgtype.UUID
+}
+
+func (q *Queries) UpdateUserActiveOrganization(ctx context.Context, arg UpdateUserActiveOrganizationParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserActiveOrganization, arg.ID, arg.ActiveOrganizationID)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserAvatarURL = `-- name: UpdateUserAvatarURL :exec
+UPDATE auth.users
+SET avatar_url = $2
+WHERE id = $1
+`
+
+type UpdateUserAvatarURLParams struct {
+	ID        uuid.UUID
+	AvatarUrl string
+}
+
+func (q *Queries) UpdateUserAvatarURL(ctx context.Context, arg UpdateUserAvatarURLParams) error {
+	_, err := q.db.Exec(ctx, updateUserAvatarURL, arg.ID, arg.AvatarUrl)
+	return err
+}
+
+const updateUserChangeEmail = `-- name: UpdateUserChangeEmail :one
+UPDATE auth.users
+SET (ticket, ticket_expires_at, new_email) = ($2, $3, $4)
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+type UpdateUserChangeEmailParams struct {
 	ID              uuid.UUID
 	Ticket          pgtype.Text
 	TicketExpiresAt pgtype.Timestamptz
 	NewEmail        pgtype.Text
 }
 
-func (q *Queries) UpdateUserChangeEmail(ctx context.Context, arg UpdateUserChangeEmailParams) (AuthUser, error) {
-	row := q.db.QueryRow(ctx, updateUserChangeEmail,
-		arg.ID,
-		arg.Ticket,
-		arg.TicketExpiresAt,
-		arg.NewEmail,
-	)
+func (q *Queries) UpdateUserChangeEmail(ctx context.Context, arg UpdateUserChangeEmailParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserChangeEmail,
+		arg.ID,
+		arg.Ticket,
+		arg.TicketExpiresAt,
+		arg.NewEmail,
+	)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserChangePhoneNumber = `-- name: UpdateUserChangePhoneNumber :exec
+UPDATE auth.users
+SET otp_hash = $2, otp_hash_expires_at = $3, otp_method_last_used = $4, new_phone_number = $5,
+    otp_hash_failed_attempts = 0
+WHERE id = $1
+`
+
+type UpdateUserChangePhoneNumberParams struct {
+	ID                uuid.UUID
+	OtpHash           pgtype.Text
+	OtpHashExpiresAt  pgtype.Timestamptz
+	OtpMethodLastUsed pgtype.Text
+	NewPhoneNumber    pgtype.Text
+}
+
+func (q *Queries) UpdateUserChangePhoneNumber(ctx context.Context, arg UpdateUserChangePhoneNumberParams) error {
+	_, err := q.db.Exec(ctx, updateUserChangePhoneNumber,
+		arg.ID,
+		arg.OtpHash,
+		arg.OtpHashExpiresAt,
+		arg.OtpMethodLastUsed,
+		arg.NewPhoneNumber,
+	)
+	return err
+}
+
+const updateUserConfirmChangeEmail = `-- name: UpdateUserConfirmChangeEmail :one
+UPDATE auth.users
+SET (email, new_email) = (new_email, NULL)
+WHERE id = $1 AND new_email IS NOT NULL
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+func (q *Queries) UpdateUserConfirmChangeEmail(ctx context.Context, id uuid.UUID) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserConfirmChangeEmail, id)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserConfirmChangePhoneNumber = `-- name: UpdateUserConfirmChangePhoneNumber :one
+UPDATE auth.users
+SET phone_number = new_phone_number,
+    new_phone_number = NULL,
+    phone_number_verified = true,
+    otp_hash = NULL
+WHERE id = $1 AND otp_hash = $2 AND otp_hash_expires_at > now() AND new_phone_number IS NOT NULL
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+type UpdateUserConfirmChangePhoneNumberParams struct {
+	ID      uuid.UUID
+	OtpHash pgtype.Text
+}
+
+func (q *Queries) UpdateUserConfirmChangePhoneNumber(ctx context.Context, arg UpdateUserConfirmChangePhoneNumberParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserConfirmChangePhoneNumber, arg.ID, arg.OtpHash)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserConfirmNewPhoneNumber = `-- name: UpdateUserConfirmNewPhoneNumber :one
+UPDATE auth.users
+SET phone_number = new_phone_number,
+    new_phone_number = NULL,
+    phone_number_verified = true,
+    otp_hash = NULL
+WHERE id = $1 AND new_phone_number IS NOT NULL
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+func (q *Queries) UpdateUserConfirmNewPhoneNumber(ctx context.Context, id uuid.UUID) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserConfirmNewPhoneNumber, id)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserConsumeOTP = `-- name: UpdateUserConsumeOTP :one
+UPDATE auth.users
+SET (otp_hash, phone_number_verified) = (NULL, true)
+WHERE id = $1 AND otp_hash = $2 AND otp_hash_expires_at > now()
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+type UpdateUserConsumeOTPParams struct {
+	ID      uuid.UUID
+	OtpHash pgtype.Text
+}
+
+func (q *Queries) UpdateUserConsumeOTP(ctx context.Context, arg UpdateUserConsumeOTPParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserConsumeOTP, arg.ID, arg.OtpHash)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserConsumeTicket = `-- name: UpdateUserConsumeTicket :one
+UPDATE auth.users
+SET ticket = NULL
+WHERE ticket = $1 AND ticket_expires_at > now()
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+func (q *Queries) UpdateUserConsumeTicket(ctx context.Context, ticket pgtype.Text) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserConsumeTicket, ticket)
 	var i AuthUser
 	err := row.Scan(
 		&i.ID,
@@ -622,6 +4367,20 @@ func (q *Queries) UpdateUserChangeEmail(ctx context.Context, arg UpdateUserChang
 		&i.TicketExpiresAt,
 		&i.Metadata,
 		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
 	)
 	return i, err
 }
@@ -676,6 +4435,88 @@ func (q *Queries) UpdateUserDeanonymize(ctx context.Context, arg UpdateUserDeano
 	return err
 }
 
+const updateUserDeanonymizeWithUserProvider = `-- name: UpdateUserDeanonymizeWithUserProvider :one
+WITH updated_user AS (
+    UPDATE auth.users
+    SET
+        is_anonymous = false,
+        email = $2,
+        email_verified = $3,
+        avatar_url = $4,
+        default_role = $5,
+        display_name = $6,
+        locale = $7,
+        metadata = $8
+    WHERE auth.users.id = $9 AND auth.users.is_anonymous
+    RETURNING id
+), inserted_user_provider AS (
+    INSERT INTO auth.user_providers
+        (user_id, provider_id, provider_user_id, access_token, refresh_token)
+    SELECT updated_user.id, $10, $11, $12, $13
+    FROM updated_user
+)
+INSERT INTO auth.user_roles (user_id, role)
+    SELECT updated_user.id, roles.role
+    FROM updated_user, unnest($1::TEXT[]) AS roles(role)
+RETURNING user_id
+`
+
+type UpdateUserDeanonymizeWithUserProviderParams struct {
+	Roles          []string
+	Email          pgtype.Text
+	EmailVerified  bool
+	AvatarUrl      string
+	DefaultRole    string
+	DisplayName    string
+	Locale         string
+	Metadata       []byte
+	ID             uuid.UUID
+	ProviderID     string
+	ProviderUserID string
+	AccessToken    string
+	RefreshToken   pgtype.Text
+}
+
+func (q *Queries) UpdateUserDeanonymizeWithUserProvider(ctx context.Context, arg UpdateUserDeanonymizeWithUserProviderParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, updateUserDeanonymizeWithUserProvider,
+		arg.Roles,
+		arg.Email,
+		arg.EmailVerified,
+		arg.AvatarUrl,
+		arg.DefaultRole,
+		arg.DisplayName,
+		arg.Locale,
+		arg.Metadata,
+		arg.ID,
+		arg.ProviderID,
+		arg.ProviderUserID,
+		arg.AccessToken,
+		arg.RefreshToken,
+	)
+	var user_id uuid.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const updateUserDisabled = `-- name: UpdateUserDisabled :execrows
+UPDATE auth.users
+SET disabled = $1
+WHERE id = $2
+`
+
+type UpdateUserDisabledParams struct {
+	Disabled bool
+	ID       uuid.UUID
+}
+
+func (q *Queries) UpdateUserDisabled(ctx context.Context, arg UpdateUserDisabledParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserDisabled, arg.Disabled, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateUserLastSeen = `-- name: UpdateUserLastSeen :one
 UPDATE auth.users
 SET last_seen = now()
@@ -690,9 +4531,189 @@ func (q *Queries) UpdateUserLastSeen(ctx context.Context, id uuid.UUID) (pgtype.
 	return last_seen, err
 }
 
+const updateUserLockedUntil = `-- name: UpdateUserLockedUntil :exec
+UPDATE auth.users
+SET locked_until = $2
+WHERE id = $1
+`
+
+type UpdateUserLockedUntilParams struct {
+	ID          uuid.UUID
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateUserLockedUntil(ctx context.Context, arg UpdateUserLockedUntilParams) error {
+	_, err := q.db.Exec(ctx, updateUserLockedUntil, arg.ID, arg.LockedUntil)
+	return err
+}
+
+const updateUserMetadata = `-- name: UpdateUserMetadata :exec
+UPDATE auth.users
+SET metadata = $2
+WHERE id = $1
+`
+
+type UpdateUserMetadataParams struct {
+	ID       uuid.UUID
+	Metadata []byte
+}
+
+func (q *Queries) UpdateUserMetadata(ctx context.Context, arg UpdateUserMetadataParams) error {
+	_, err := q.db.Exec(ctx, updateUserMetadata, arg.ID, arg.Metadata)
+	return err
+}
+
+const updateUserOTPHash = `-- name: UpdateUserOTPHash :one
+UPDATE auth.users
+SET (otp_hash, otp_hash_expires_at, otp_method_last_used, otp_hash_failed_attempts) = ($2, $3, $4, 0)
+WHERE id = $1
+RETURNING id
+`
+
+type UpdateUserOTPHashParams struct {
+	ID                uuid.UUID
+	OtpHash           pgtype.Text
+	OtpHashExpiresAt  pgtype.Timestamptz
+	OtpMethodLastUsed pgtype.Text
+}
+
+func (q *Queries) UpdateUserOTPHash(ctx context.Context, arg UpdateUserOTPHashParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, updateUserOTPHash,
+		arg.ID,
+		arg.OtpHash,
+		arg.OtpHashExpiresAt,
+		arg.OtpMethodLastUsed,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateUserOTPHashFailedAttempts = `-- name: UpdateUserOTPHashFailedAttempts :one
+UPDATE auth.users
+SET otp_hash_failed_attempts = otp_hash_failed_attempts + 1,
+    otp_hash = CASE
+        WHEN otp_hash_failed_attempts + 1 >= $1::integer THEN NULL
+        ELSE otp_hash
+    END
+WHERE id = $2 AND otp_hash = $3 AND otp_hash_expires_at > now()
+RETURNING otp_hash_failed_attempts
+`
+
+type UpdateUserOTPHashFailedAttemptsParams struct {
+	MaxAttempts int32
+	ID          uuid.UUID
+	OtpHash     pgtype.Text
+}
+
+func (q *Queries) UpdateUserOTPHashFailedAttempts(ctx context.Context, arg UpdateUserOTPHashFailedAttemptsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateUserOTPHashFailedAttempts, arg.MaxAttempts, arg.ID, arg.OtpHash)
+	var otp_hash_failed_attempts int32
+	err := row.Scan(&otp_hash_failed_attempts)
+	return otp_hash_failed_attempts, err
+}
+
+const updateUserPasswordHash = `-- name: UpdateUserPasswordHash :execrows
+UPDATE auth.users
+SET password_hash = $1
+WHERE id = $2
+`
+
+type UpdateUserPasswordHashParams struct {
+	PasswordHash pgtype.Text
+	ID           uuid.UUID
+}
+
+func (q *Queries) UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserPasswordHash, arg.PasswordHash, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUserRehashPassword = `-- name: UpdateUserRehashPassword :execrows
+UPDATE auth.users
+SET password_hash = $1
+WHERE id = $2 AND password_hash = $3
+`
+
+type UpdateUserRehashPasswordParams struct {
+	PasswordHash    pgtype.Text
+	ID              uuid.UUID
+	OldPasswordHash pgtype.Text
+}
+
+func (q *Queries) UpdateUserRehashPassword(ctx context.Context, arg UpdateUserRehashPasswordParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUserRehashPassword, arg.PasswordHash, arg.ID, arg.OldPasswordHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateUserRevertEmailChange = `-- name: UpdateUserRevertEmailChange :one
+UPDATE auth.users
+SET email = $2, new_email = NULL, email_verified = true, ticket = NULL
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+type UpdateUserRevertEmailChangeParams struct {
+	ID    uuid.UUID
+	Email pgtype.Text
+}
+
+func (q *Queries) UpdateUserRevertEmailChange(ctx context.Context, arg UpdateUserRevertEmailChangeParams) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserRevertEmailChange, arg.ID, arg.Email)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
 const updateUserTicket = `-- name: UpdateUserTicket :one
 UPDATE auth.users
-SET (ticket, ticket_expires_at) = ($2, $3)
+SET (ticket, ticket_expires_at, ticket_failed_attempts) = ($2, $3, 0)
 WHERE id = $1
 RETURNING id
 `
@@ -709,3 +4730,223 @@ func (q *Queries) UpdateUserTicket(ctx context.Context, arg UpdateUserTicketPara
 	err := row.Scan(&id)
 	return id, err
 }
+
+const updateUserTicketFailedAttempts = `-- name: UpdateUserTicketFailedAttempts :one
+UPDATE auth.users
+SET ticket_failed_attempts = ticket_failed_attempts + 1,
+    ticket = CASE
+        WHEN ticket_failed_attempts + 1 >= $1::integer THEN NULL
+        ELSE ticket
+    END
+WHERE ticket = $2 AND ticket_expires_at > now()
+RETURNING ticket_failed_attempts
+`
+
+type UpdateUserTicketFailedAttemptsParams struct {
+	MaxAttempts int32
+	Ticket      pgtype.Text
+}
+
+func (q *Queries) UpdateUserTicketFailedAttempts(ctx context.Context, arg UpdateUserTicketFailedAttemptsParams) (int32, error) {
+	row := q.db.QueryRow(ctx, updateUserTicketFailedAttempts, arg.MaxAttempts, arg.Ticket)
+	var ticket_failed_attempts int32
+	err := row.Scan(&ticket_failed_attempts)
+	return ticket_failed_attempts, err
+}
+
+const updateUserTotpSecret = `-- name: UpdateUserTotpSecret :exec
+UPDATE auth.users
+SET totp_secret = $2
+WHERE id = $1
+`
+
+type UpdateUserTotpSecretParams struct {
+	ID         uuid.UUID
+	TotpSecret pgtype.Text
+}
+
+func (q *Queries) UpdateUserTotpSecret(ctx context.Context, arg UpdateUserTotpSecretParams) error {
+	_, err := q.db.Exec(ctx, updateUserTotpSecret, arg.ID, arg.TotpSecret)
+	return err
+}
+
+const updateUserVerifyEmail = `-- name: UpdateUserVerifyEmail :one
+UPDATE auth.users
+SET email_verified = true
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+func (q *Queries) UpdateUserVerifyEmail(ctx context.Context, id uuid.UUID) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserVerifyEmail, id)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const updateUserVerifyPhoneNumber = `-- name: UpdateUserVerifyPhoneNumber :one
+UPDATE auth.users
+SET (otp_hash, phone_number_verified) = (NULL, true)
+WHERE id = $1
+RETURNING id, created_at, updated_at, last_seen, disabled, display_name, avatar_url, locale, email, phone_number, password_hash, email_verified, phone_number_verified, new_email, otp_method_last_used, otp_hash, otp_hash_expires_at, default_role, is_anonymous, totp_secret, active_mfa_type, ticket, ticket_expires_at, metadata, webauthn_current_challenge, tokens_valid_after, new_phone_number, failed_sign_in_attempts, last_failed_sign_in_at, locked_until, banned_at, banned_until, ban_reason, deletion_scheduled_at, deleted_at, tenant_id, active_organization_id, ticket_failed_attempts, otp_hash_failed_attempts
+`
+
+func (q *Queries) UpdateUserVerifyPhoneNumber(ctx context.Context, id uuid.UUID) (AuthUser, error) {
+	row := q.db.QueryRow(ctx, updateUserVerifyPhoneNumber, id)
+	var i AuthUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastSeen,
+		&i.Disabled,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.Locale,
+		&i.Email,
+		&i.PhoneNumber,
+		&i.PasswordHash,
+		&i.EmailVerified,
+		&i.PhoneNumberVerified,
+		&i.NewEmail,
+		&i.OtpMethodLastUsed,
+		&i.OtpHash,
+		&i.OtpHashExpiresAt,
+		&i.DefaultRole,
+		&i.IsAnonymous,
+		&i.TotpSecret,
+		&i.ActiveMfaType,
+		&i.Ticket,
+		&i.TicketExpiresAt,
+		&i.Metadata,
+		&i.WebauthnCurrentChallenge,
+		&i.TokensValidAfter,
+		&i.NewPhoneNumber,
+		&i.FailedSignInAttempts,
+		&i.LastFailedSignInAt,
+		&i.LockedUntil,
+		&i.BannedAt,
+		&i.BannedUntil,
+		&i.BanReason,
+		&i.DeletionScheduledAt,
+		&i.DeletedAt,
+		&i.TenantID,
+		&i.ActiveOrganizationID,
+		&i.TicketFailedAttempts,
+		&i.OtpHashFailedAttempts,
+	)
+	return i, err
+}
+
+const upsertOAuth2Consent = `-- name: UpsertOAuth2Consent :exec
+INSERT INTO auth.oauth2_consents (user_id, client_id, scopes)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, client_id) DO UPDATE
+SET scopes = EXCLUDED.scopes, updated_at = now()
+`
+
+type UpsertOAuth2ConsentParams struct {
+	UserID   uuid.UUID
+	ClientID string
+	Scopes   []string
+}
+
+func (q *Queries) UpsertOAuth2Consent(ctx context.Context, arg UpsertOAuth2ConsentParams) error {
+	_, err := q.db.Exec(ctx, upsertOAuth2Consent, arg.UserID, arg.ClientID, arg.Scopes)
+	return err
+}
+
+const upsertOrganizationInvite = `-- name: UpsertOrganizationInvite :exec
+INSERT INTO auth.organization_invites (
+    organization_id, email, role, ticket, invited_by, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (organization_id, email) DO UPDATE
+SET role = EXCLUDED.role,
+    ticket = EXCLUDED.ticket,
+    invited_by = EXCLUDED.invited_by,
+    expires_at = EXCLUDED.expires_at,
+    created_at = now()
+`
+
+type UpsertOrganizationInviteParams struct {
+	OrganizationID uuid.UUID
+	Email          string
+	Role           string
+	Ticket         string
+	InvitedBy      pgtype.UUID
+	ExpiresAt      pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertOrganizationInvite(ctx context.Context, arg UpsertOrganizationInviteParams) error {
+	_, err := q.db.Exec(ctx, upsertOrganizationInvite,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Role,
+		arg.Ticket,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const upsertSignInMethod = `-- name: UpsertSignInMethod :one
+INSERT INTO auth.sign_in_methods (method, enabled)
+VALUES ($1, $2)
+ON CONFLICT (method) DO UPDATE
+SET enabled = EXCLUDED.enabled, updated_at = now()
+RETURNING method, enabled, updated_at
+`
+
+type UpsertSignInMethodParams struct {
+	Method  string
+	Enabled bool
+}
+
+func (q *Queries) UpsertSignInMethod(ctx context.Context, arg UpsertSignInMethodParams) (AuthSignInMethod, error) {
+	row := q.db.QueryRow(ctx, upsertSignInMethod, arg.Method, arg.Enabled)
+	var i AuthSignInMethod
+	err := row.Scan(&i.Method, &i.Enabled, &i.UpdatedAt)
+	return i, err
+}
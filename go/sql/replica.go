@@ -0,0 +1,145 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ReplicaRetryAfter is how long the reads are sent to the primary after the replica
+// fails.
+const ReplicaRetryAfter = 30 * time.Second
+
+// ReplicaQueries are the queries sent to the replica. They are read heavy and can work
+// with data a bit behind the primary.
+var ReplicaQueries = []string{ //nolint:gochecknoglobals
+	"GetUserByEmail",
+	"GetUserRoles",
+	"GetUserSessions",
+}
+
+// ReplicaDBTX sends the ReplicaQueries to a read replica and everything else to the
+// primary. If the replica can't be reached the reads fall back to the primary for
+// ReplicaRetryAfter.
+type ReplicaDBTX struct {
+	primary          DBTX
+	replica          DBTX
+	queries          map[string]struct{}
+	logger           *slog.Logger
+	mu               sync.Mutex
+	unavailableUntil time.Time
+}
+
+func NewReplicaDBTX(primary DBTX, replica DBTX, logger *slog.Logger) *ReplicaDBTX {
+	queries := make(map[string]struct{}, len(ReplicaQueries))
+	for _, name := range ReplicaQueries {
+		queries[name] = struct{}{}
+	}
+
+	return &ReplicaDBTX{
+		primary:          primary,
+		replica:          replica,
+		queries:          queries,
+		logger:           logger,
+		mu:               sync.Mutex{},
+		unavailableUntil: time.Time{},
+	}
+}
+
+// replicaUnavailable reports if the error means the replica couldn't answer the query,
+// as opposed to the query failing.
+func replicaUnavailable(ctx context.Context, err error) bool {
+	if err == nil || errors.Is(err, pgx.ErrNoRows) || ctx.Err() != nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// connection exceptions and operator interventions, like a shutting down server
+		return strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "57")
+	}
+
+	return true
+}
+
+func (d *ReplicaDBTX) useReplica(query string) bool {
+	if _, ok := d.queries[QueryName(query)]; !ok {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return time.Now().After(d.unavailableUntil)
+}
+
+func (d *ReplicaDBTX) fallback(query string, err error) {
+	d.mu.Lock()
+	d.unavailableUntil = time.Now().Add(ReplicaRetryAfter)
+	d.mu.Unlock()
+
+	d.logger.Warn(
+		"read replica unavailable, falling back to primary",
+		slog.String("query", QueryName(query)),
+		slog.String("error", err.Error()),
+	)
+}
+
+func (d *ReplicaDBTX) Exec(
+	ctx context.Context, query string, args ...interface{},
+) (pgconn.CommandTag, error) {
+	return d.primary.Exec(ctx, query, args...) //nolint:wrapcheck
+}
+
+func (d *ReplicaDBTX) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	if !d.useReplica(query) {
+		return d.primary.Query(ctx, query, args...) //nolint:wrapcheck
+	}
+
+	rows, err := d.replica.Query(ctx, query, args...)
+	if replicaUnavailable(ctx, err) {
+		d.fallback(query, err)
+		return d.primary.Query(ctx, query, args...) //nolint:wrapcheck
+	}
+
+	return rows, err //nolint:wrapcheck
+}
+
+func (d *ReplicaDBTX) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	if !d.useReplica(query) {
+		return d.primary.QueryRow(ctx, query, args...)
+	}
+
+	return &replicaRow{
+		ctx:   ctx,
+		db:    d,
+		query: query,
+		args:  args,
+		row:   d.replica.QueryRow(ctx, query, args...),
+	}
+}
+
+// replicaRow reruns the query in the primary if the replica fails, which for QueryRow
+// is only known when the row is scanned.
+type replicaRow struct {
+	ctx   context.Context //nolint:containedctx
+	db    *ReplicaDBTX
+	query string
+	args  []interface{}
+	row   pgx.Row
+}
+
+func (r *replicaRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if replicaUnavailable(r.ctx, err) {
+		r.db.fallback(r.query, err)
+		return r.db.primary.QueryRow(r.ctx, r.query, r.args...).Scan(dest...) //nolint:wrapcheck
+	}
+
+	return err //nolint:wrapcheck
+}
@@ -0,0 +1,171 @@
+package sql_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+var errConnRefused = errors.New("dial tcp: connection refused")
+
+type fakeRow struct {
+	err error
+}
+
+func (r fakeRow) Scan(_ ...any) error {
+	return r.err
+}
+
+// fakeDBTX counts the queries it gets and fails them with err.
+type fakeDBTX struct {
+	calls int
+	err   error
+}
+
+func (f *fakeDBTX) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	f.calls++
+	return pgconn.CommandTag{}, f.err
+}
+
+func (f *fakeDBTX) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	f.calls++
+	return nil, f.err
+}
+
+func (f *fakeDBTX) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	f.calls++
+	return fakeRow{err: f.err}
+}
+
+func TestReplicaDBTX(t *testing.T) {
+	t.Parallel()
+
+	getUserByEmail := "-- name: GetUserByEmail :one\nSELECT 1"
+	getUserSessions := "-- name: GetUserSessions :many\nSELECT 1"
+	updateUser := "-- name: UpdateUser :one\nUPDATE auth.users SET disabled = true"
+
+	cases := []struct {
+		name            string
+		replicaErr      error
+		run             func(db *sql.ReplicaDBTX) error
+		expectedErr     error
+		expectedPrimary int
+		expectedReplica int
+	}{
+		{
+			name:       "reads go to the replica",
+			replicaErr: nil,
+			run: func(db *sql.ReplicaDBTX) error {
+				return db.QueryRow(context.Background(), getUserByEmail).Scan()
+			},
+			expectedErr:     nil,
+			expectedPrimary: 0,
+			expectedReplica: 1,
+		},
+		{
+			name:       "other queries go to the primary",
+			replicaErr: nil,
+			run: func(db *sql.ReplicaDBTX) error {
+				return db.QueryRow(context.Background(), updateUser).Scan()
+			},
+			expectedErr:     nil,
+			expectedPrimary: 1,
+			expectedReplica: 0,
+		},
+		{
+			name:       "exec goes to the primary",
+			replicaErr: nil,
+			run: func(db *sql.ReplicaDBTX) error {
+				_, err := db.Exec(context.Background(), getUserByEmail)
+				return err
+			},
+			expectedErr:     nil,
+			expectedPrimary: 1,
+			expectedReplica: 0,
+		},
+		{
+			name:       "no rows are not a failure",
+			replicaErr: pgx.ErrNoRows,
+			run: func(db *sql.ReplicaDBTX) error {
+				return db.QueryRow(context.Background(), getUserByEmail).Scan()
+			},
+			expectedErr:     pgx.ErrNoRows,
+			expectedPrimary: 0,
+			expectedReplica: 1,
+		},
+		{
+			name:       "query errors are not a failure",
+			replicaErr: &pgconn.PgError{Code: "42P01"}, //nolint:exhaustruct
+			run: func(db *sql.ReplicaDBTX) error {
+				_, err := db.Query(context.Background(), getUserSessions)
+				return err
+			},
+			expectedErr:     &pgconn.PgError{Code: "42P01"}, //nolint:exhaustruct
+			expectedPrimary: 0,
+			expectedReplica: 1,
+		},
+		{
+			name:       "row falls back to the primary",
+			replicaErr: errConnRefused,
+			run: func(db *sql.ReplicaDBTX) error {
+				return db.QueryRow(context.Background(), getUserByEmail).Scan()
+			},
+			expectedErr:     nil,
+			expectedPrimary: 1,
+			expectedReplica: 1,
+		},
+		{
+			name:       "rows fall back to the primary",
+			replicaErr: &pgconn.PgError{Code: "57P03"}, //nolint:exhaustruct
+			run: func(db *sql.ReplicaDBTX) error {
+				_, err := db.Query(context.Background(), getUserSessions)
+				return err
+			},
+			expectedErr:     nil,
+			expectedPrimary: 1,
+			expectedReplica: 1,
+		},
+		{
+			name:       "replica is skipped after failing",
+			replicaErr: errConnRefused,
+			run: func(db *sql.ReplicaDBTX) error {
+				for range 3 {
+					if err := db.QueryRow(context.Background(), getUserByEmail).Scan(); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			expectedErr:     nil,
+			expectedPrimary: 3,
+			expectedReplica: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			primary := &fakeDBTX{calls: 0, err: nil}
+			replica := &fakeDBTX{calls: 0, err: tc.replicaErr}
+			db := sql.NewReplicaDBTX(primary, replica, slog.Default())
+
+			err := tc.run(db)
+			if tc.expectedErr == nil && err != nil || tc.expectedErr != nil && err == nil {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if primary.calls != tc.expectedPrimary {
+				t.Errorf("expected %d primary queries, got %d", tc.expectedPrimary, primary.calls)
+			}
+			if replica.calls != tc.expectedReplica {
+				t.Errorf("expected %d replica queries, got %d", tc.expectedReplica, replica.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// DefaultSchema is the schema of the tables in the queries and migrations.
+const DefaultSchema = "auth"
+
+var ErrInvalidSchema = errors.New("invalid schema")
+
+var (
+	schemaNameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,62}$`)
+	schemaRefRegexp  = regexp.MustCompile(`\bauth\.|"auth"\.`)
+)
+
+// ValidateSchema checks schema is a lowercase, unquoted, PostgreSQL identifier so it can
+// be written in the queries as is.
+func ValidateSchema(schema string) error {
+	if !schemaNameRegexp.MatchString(schema) {
+		return fmt.Errorf("%w: %q", ErrInvalidSchema, schema)
+	}
+	return nil
+}
+
+// WithSchema returns query with the references to the auth schema replaced by schema.
+func WithSchema(query string, schema string) string {
+	if schema == DefaultSchema {
+		return query
+	}
+	return schemaRefRegexp.ReplaceAllLiteralString(query, schema+".")
+}
+
+// SchemaDBTX sends the queries to db with their tables in schema instead of auth. The
+// queries are rewritten once and cached as they are the constants generated by sqlc.
+type SchemaDBTX struct {
+	db      DBTX
+	schema  string
+	queries sync.Map
+}
+
+func NewSchemaDBTX(db DBTX, schema string) *SchemaDBTX {
+	return &SchemaDBTX{
+		db:      db,
+		schema:  schema,
+		queries: sync.Map{},
+	}
+}
+
+func (d *SchemaDBTX) query(query string) string {
+	if q, ok := d.queries.Load(query); ok {
+		return q.(string) //nolint:forcetypeassert
+	}
+
+	q := WithSchema(query, d.schema)
+	d.queries.Store(query, q)
+	return q
+}
+
+func (d *SchemaDBTX) Exec(
+	ctx context.Context, query string, args ...interface{},
+) (pgconn.CommandTag, error) {
+	return d.db.Exec(ctx, d.query(query), args...) //nolint:wrapcheck
+}
+
+func (d *SchemaDBTX) Query(
+	ctx context.Context, query string, args ...interface{},
+) (pgx.Rows, error) {
+	return d.db.Query(ctx, d.query(query), args...) //nolint:wrapcheck
+}
+
+func (d *SchemaDBTX) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	return d.db.QueryRow(ctx, d.query(query), args...)
+}
+
+// InTx runs fn in a transaction if the wrapped connection supports them.
+func (d *SchemaDBTX) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if t, ok := d.db.(Transactor); ok {
+		return t.InTx(ctx, fn) //nolint:wrapcheck
+	}
+	return fn(ctx)
+}
@@ -0,0 +1,80 @@
+package sql_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+func TestWithSchema(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		query    string
+		schema   string
+		expected string
+	}{
+		{
+			name:     "default schema",
+			query:    "SELECT * FROM auth.users WHERE id = $1",
+			schema:   "auth",
+			expected: "SELECT * FROM auth.users WHERE id = $1",
+		},
+		{
+			name:     "tables",
+			query:    "SELECT * FROM auth.users JOIN auth.user_roles ON auth.users.id = user_id",
+			schema:   "tenant_auth",
+			expected: "SELECT * FROM tenant_auth.users JOIN tenant_auth.user_roles ON tenant_auth.users.id = user_id", //nolint:lll
+		},
+		{
+			name:     "quoted",
+			query:    `ALTER TABLE "auth"."refresh_tokens" ADD COLUMN hash text`,
+			schema:   "tenant_auth",
+			expected: `ALTER TABLE tenant_auth."refresh_tokens" ADD COLUMN hash text`,
+		},
+		{
+			name:     "other schemas and settings are kept",
+			query:    "SELECT current_setting('hasura_auth.tenant_id'), oauth.id FROM auth.users",
+			schema:   "tenant_auth",
+			expected: "SELECT current_setting('hasura_auth.tenant_id'), oauth.id FROM tenant_auth.users",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sql.WithSchema(tc.query, tc.schema); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		schema      string
+		expectedErr error
+	}{
+		{schema: "auth", expectedErr: nil},
+		{schema: "tenant_1_auth", expectedErr: nil},
+		{schema: "", expectedErr: sql.ErrInvalidSchema},
+		{schema: "Auth", expectedErr: sql.ErrInvalidSchema},
+		{schema: "1auth", expectedErr: sql.ErrInvalidSchema},
+		{schema: "auth; DROP TABLE users", expectedErr: sql.ErrInvalidSchema},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.schema, func(t *testing.T) {
+			t.Parallel()
+
+			if err := sql.ValidateSchema(tc.schema); !errors.Is(err, tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
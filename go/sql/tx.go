@@ -0,0 +1,92 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type txContextKey struct{}
+
+// Beginner starts transactions, i.e. a *pgxpool.Pool.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Transactor runs functions in a transaction.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// TxDBTX sends the queries run with the contexts of InTx to their transaction and the
+// other queries to db.
+type TxDBTX struct {
+	db       DBTX
+	beginner Beginner
+}
+
+func NewTxDBTX(db DBTX, beginner Beginner) *TxDBTX {
+	return &TxDBTX{
+		db:       db,
+		beginner: beginner,
+	}
+}
+
+func (d *TxDBTX) conn(ctx context.Context) DBTX { //nolint:ireturn
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return d.db
+}
+
+// InTx runs fn in a transaction that is committed if fn succeeds and rolled back
+// otherwise. The queries run with the context given to fn are part of the transaction,
+// so the context must not be used once fn returns. Calls nested in fn join the
+// transaction of the outer call.
+func (d *TxDBTX) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := d.beginner.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	// no-op once committed
+	defer tx.Rollback(context.WithoutCancel(ctx)) //nolint:errcheck
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (d *TxDBTX) Exec(
+	ctx context.Context, query string, args ...interface{},
+) (pgconn.CommandTag, error) {
+	return d.conn(ctx).Exec(ctx, query, args...) //nolint:wrapcheck
+}
+
+func (d *TxDBTX) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	return d.conn(ctx).Query(ctx, query, args...) //nolint:wrapcheck
+}
+
+func (d *TxDBTX) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	return d.conn(ctx).QueryRow(ctx, query, args...)
+}
+
+// InTx runs fn in a transaction if the connection of the queries supports them, see
+// TxDBTX. Otherwise fn runs without one.
+func (q *Queries) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if t, ok := q.db.(Transactor); ok {
+		return t.InTx(ctx, fn)
+	}
+	return fn(ctx)
+}
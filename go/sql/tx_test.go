@@ -0,0 +1,161 @@
+package sql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+var errFn = errors.New("something went wrong")
+
+// fakeTx counts the queries it gets and whether it was committed or rolled back. The
+// methods the tests don't use panic.
+type fakeTx struct {
+	pgx.Tx
+
+	calls      int
+	committed  bool
+	rolledBack bool
+}
+
+func (f *fakeTx) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	f.calls++
+	return pgconn.CommandTag{}, nil
+}
+
+func (f *fakeTx) Commit(context.Context) error {
+	f.committed = true
+	return nil
+}
+
+func (f *fakeTx) Rollback(context.Context) error {
+	if !f.committed {
+		f.rolledBack = true
+	}
+	return nil
+}
+
+type fakeBeginner struct {
+	txs []*fakeTx
+}
+
+func (f *fakeBeginner) Begin(context.Context) (pgx.Tx, error) { //nolint:ireturn
+	tx := &fakeTx{} //nolint:exhaustruct
+	f.txs = append(f.txs, tx)
+	return tx, nil
+}
+
+func TestTxDBTX(t *testing.T) {
+	t.Parallel()
+
+	query := "-- name: UpdateUser :exec\nUPDATE auth.users SET disabled = true"
+
+	cases := []struct {
+		name               string
+		run                func(db *sql.TxDBTX) error
+		expectedErr        error
+		expectedDB         int
+		expectedTxCalls    []int
+		expectedCommitted  bool
+		expectedRolledBack bool
+	}{
+		{
+			name: "queries outside transactions go to the db",
+			run: func(db *sql.TxDBTX) error {
+				_, err := db.Exec(context.Background(), query)
+				return err
+			},
+			expectedErr:        nil,
+			expectedDB:         1,
+			expectedTxCalls:    nil,
+			expectedCommitted:  false,
+			expectedRolledBack: false,
+		},
+		{
+			name: "committed",
+			run: func(db *sql.TxDBTX) error {
+				return db.InTx(context.Background(), func(ctx context.Context) error {
+					_, err := db.Exec(ctx, query)
+					return err
+				})
+			},
+			expectedErr:        nil,
+			expectedDB:         0,
+			expectedTxCalls:    []int{1},
+			expectedCommitted:  true,
+			expectedRolledBack: false,
+		},
+		{
+			name: "rolled back",
+			run: func(db *sql.TxDBTX) error {
+				return db.InTx(context.Background(), func(ctx context.Context) error {
+					if _, err := db.Exec(ctx, query); err != nil {
+						return err
+					}
+					return errFn
+				})
+			},
+			expectedErr:        errFn,
+			expectedDB:         0,
+			expectedTxCalls:    []int{1},
+			expectedCommitted:  false,
+			expectedRolledBack: true,
+		},
+		{
+			name: "nested calls join the transaction",
+			run: func(db *sql.TxDBTX) error {
+				return db.InTx(context.Background(), func(ctx context.Context) error {
+					if _, err := db.Exec(ctx, query); err != nil {
+						return err
+					}
+					return db.InTx(ctx, func(ctx context.Context) error {
+						_, err := db.Exec(ctx, query)
+						return err
+					})
+				})
+			},
+			expectedErr:        nil,
+			expectedDB:         0,
+			expectedTxCalls:    []int{2},
+			expectedCommitted:  true,
+			expectedRolledBack: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := &fakeDBTX{calls: 0, err: nil}
+			beginner := &fakeBeginner{txs: nil}
+			txdb := sql.NewTxDBTX(db, beginner)
+
+			if err := tc.run(txdb); !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if db.calls != tc.expectedDB {
+				t.Errorf("expected %d db queries, got %d", tc.expectedDB, db.calls)
+			}
+
+			if len(beginner.txs) != len(tc.expectedTxCalls) {
+				t.Fatalf("expected %d transactions, got %d", len(tc.expectedTxCalls), len(beginner.txs))
+			}
+			for i, tx := range beginner.txs {
+				if tx.calls != tc.expectedTxCalls[i] {
+					t.Errorf("expected %d transaction queries, got %d", tc.expectedTxCalls[i], tx.calls)
+				}
+				if tx.committed != tc.expectedCommitted {
+					t.Errorf("expected committed to be %t", tc.expectedCommitted)
+				}
+				if tx.rolledBack != tc.expectedRolledBack {
+					t.Errorf("expected rolled back to be %t", tc.expectedRolledBack)
+				}
+			}
+		})
+	}
+}
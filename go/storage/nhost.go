@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Nhost stores the avatars in a bucket of Nhost storage. The files are served by the
+// storage itself, so the public role needs permission to read them.
+type Nhost struct {
+	url         string
+	bucket      string
+	adminSecret string
+	httpClient  *http.Client
+}
+
+// NewNhost returns a storage for the bucket of the Nhost storage served at url, i.e.
+// https://{subdomain}.storage.{region}.nhost.run/v1.
+func NewNhost(url string, bucket string, adminSecret string) *Nhost {
+	return &Nhost{
+		url:         strings.TrimSuffix(url, "/"),
+		bucket:      bucket,
+		adminSecret: adminSecret,
+		httpClient:  &http.Client{Timeout: 30 * time.Second}, //nolint:exhaustruct,mnd
+	}
+}
+
+type nhostFileMetadata struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (n *Nhost) uploadBody(
+	fileID uuid.UUID, userID uuid.UUID, contentType string, body []byte,
+) (*bytes.Buffer, string, error) {
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+
+	if err := w.WriteField("bucket-id", n.bucket); err != nil {
+		return nil, "", fmt.Errorf("error writing bucket: %w", err)
+	}
+
+	name := fmt.Sprintf("avatar-%s.%s", userID, Extension(contentType))
+	metadata, err := json.Marshal(nhostFileMetadata{ID: fileID.String(), Name: name})
+	if err != nil {
+		return nil, "", fmt.Errorf("error marshaling metadata: %w", err)
+	}
+	if err := w.WriteField("metadata[]", string(metadata)); err != nil {
+		return nil, "", fmt.Errorf("error writing metadata: %w", err)
+	}
+
+	part, err := w.CreatePart(map[string][]string{
+		"Content-Disposition": {fmt.Sprintf(`form-data; name="file[]"; filename="%s"`, name)},
+		"Content-Type":        {contentType},
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating file part: %w", err)
+	}
+	if _, err := part.Write(body); err != nil {
+		return nil, "", fmt.Errorf("error writing file: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", fmt.Errorf("error closing multipart writer: %w", err)
+	}
+
+	return buf, w.FormDataContentType(), nil
+}
+
+// Upload stores the avatar of the user and returns its URL.
+func (n *Nhost) Upload(
+	ctx context.Context, userID uuid.UUID, contentType string, body []byte,
+) (string, error) {
+	fileID := uuid.New()
+	buf, bodyContentType, err := n.uploadBody(fileID, userID, contentType, body)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url+"/files", buf)
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", bodyContentType)
+	req.Header.Set("X-Hasura-Admin-Secret", n.adminSecret)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, http.StatusOK, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("error uploading avatar: %w", err)
+	}
+
+	return n.url + "/files/" + fileID.String(), nil
+}
+
+// Delete deletes the avatar stored at the URL. URLs that weren't returned by Upload, like
+// the ones of the avatar providers, are ignored.
+func (n *Nhost) Delete(ctx context.Context, avatarURL string) error {
+	id, ok := strings.CutPrefix(avatarURL, n.url+"/files/")
+	if !ok {
+		return nil
+	}
+	fileID, err := uuid.Parse(id)
+	if err != nil {
+		return nil //nolint:nilerr
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodDelete, n.url+"/files/"+fileID.String(), nil,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("X-Hasura-Admin-Secret", n.adminSecret)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, http.StatusOK, http.StatusNoContent); err != nil {
+		return fmt.Errorf("error deleting avatar: %w", err)
+	}
+
+	return nil
+}
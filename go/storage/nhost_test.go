@@ -0,0 +1,89 @@
+package storage_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/storage"
+)
+
+func TestNhost(t *testing.T) { //nolint:cyclop
+	t.Parallel()
+
+	userID := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+
+	var uploadedID string
+	deleted := make(chan string, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Hasura-Admin-Secret") != "admin-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/files":
+			if r.FormValue("bucket-id") != "avatars" {
+				t.Errorf("unexpected bucket: %s", r.FormValue("bucket-id"))
+			}
+
+			var metadata struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal([]byte(r.FormValue("metadata[]")), &metadata); err != nil {
+				t.Errorf("unexpected metadata: %v", err)
+			}
+			uploadedID = metadata.ID
+
+			f, header, err := r.FormFile("file[]")
+			if err != nil {
+				t.Errorf("missing file: %v", err)
+				return
+			}
+			b, _ := io.ReadAll(f)
+			if string(b) != "png" || header.Header.Get("Content-Type") != "image/png" {
+				t.Errorf("unexpected file: %s %s", b, header.Header.Get("Content-Type"))
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"processedFiles":[{"id":"` + metadata.ID + `"}]}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/files/"):
+			deleted <- strings.TrimPrefix(r.URL.Path, "/v1/files/")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	nhost := storage.NewNhost(server.URL+"/v1/", "avatars", "admin-secret")
+
+	avatarURL, err := nhost.Upload(context.Background(), userID, "image/png", []byte("png"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if avatarURL != server.URL+"/v1/files/"+uploadedID {
+		t.Errorf("unexpected avatar url: %s", avatarURL)
+	}
+
+	if err := nhost.Delete(
+		context.Background(), "https://www.gravatar.com/avatar/abc",
+	); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := nhost.Delete(context.Background(), avatarURL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if id := <-deleted; id != uploadedID {
+		t.Errorf("unexpected deleted file: %s", id)
+	}
+}
@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sigv4"
+)
+
+const (
+	amzContentHash = "X-Amz-Content-Sha256"
+	avatarsPrefix  = "avatars"
+	avatarsMaxAge  = "public, max-age=31536000, immutable"
+	s3ServiceName  = "s3"
+)
+
+// S3 stores the avatars in a bucket of an S3-compatible object storage. Objects are
+// addressed with path-style URLs, {endpoint}/{bucket}/{key}, which all the providers
+// support, and are served from publicURL, which needs to be readable by everyone.
+type S3 struct {
+	endpoint        *url.URL
+	publicURL       string
+	bucket          string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	httpClient      *http.Client
+}
+
+// NewS3 returns a storage for the bucket. If publicURL is empty the objects are served
+// from the endpoint.
+func NewS3(
+	endpoint string,
+	publicURL string,
+	bucket string,
+	region string,
+	accessKeyID string,
+	secretAccessKey string,
+) (*S3, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing s3 endpoint: %w", err)
+	}
+
+	if publicURL == "" {
+		publicURL = u.JoinPath(bucket).String()
+	}
+
+	return &S3{
+		endpoint:        u,
+		publicURL:       strings.TrimSuffix(publicURL, "/"),
+		bucket:          bucket,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		httpClient:      &http.Client{Timeout: 30 * time.Second}, //nolint:exhaustruct,mnd
+	}, nil
+}
+
+func (s *S3) do(
+	ctx context.Context, method string, key string, contentType string, body []byte,
+) error {
+	u := s.endpoint.JoinPath(s.bucket, key)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Cache-Control", avatarsMaxAge)
+	}
+	req.Header.Set(amzContentHash, sigv4.HexSHA256(body))
+	sigv4.Sign(req, body, s.region, s3ServiceName, s.accessKeyID, s.secretAccessKey, time.Now())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return checkResponse(resp, http.StatusOK, http.StatusNoContent)
+}
+
+// Upload stores the avatar of the user and returns its public URL.
+func (s *S3) Upload(
+	ctx context.Context, userID uuid.UUID, contentType string, body []byte,
+) (string, error) {
+	key := fmt.Sprintf(
+		"%s/%s/%s.%s", avatarsPrefix, userID, objectName(), Extension(contentType),
+	)
+	if err := s.do(ctx, http.MethodPut, key, contentType, body); err != nil {
+		return "", fmt.Errorf("error uploading avatar: %w", err)
+	}
+
+	return s.publicURL + "/" + key, nil
+}
+
+// Delete deletes the avatar stored at the URL. URLs that weren't returned by Upload, like
+// the ones of the avatar providers, are ignored.
+func (s *S3) Delete(ctx context.Context, avatarURL string) error {
+	key, ok := strings.CutPrefix(avatarURL, s.publicURL+"/")
+	if !ok || !strings.HasPrefix(key, avatarsPrefix+"/") {
+		return nil
+	}
+
+	if err := s.do(ctx, http.MethodDelete, key, "", nil); err != nil {
+		return fmt.Errorf("error deleting avatar: %w", err)
+	}
+
+	return nil
+}
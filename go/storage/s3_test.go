@@ -0,0 +1,102 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/storage"
+)
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24")
+
+	type request struct {
+		method      string
+		path        string
+		contentType string
+		body        string
+	}
+	requests := make(chan request, 2) //nolint:mnd
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(
+			r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access-key/",
+		) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		requests <- request{r.Method, r.URL.Path, r.Header.Get("Content-Type"), string(b)}
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	s3, err := storage.NewS3(
+		server.URL, "https://cdn.acme.com/", "avatars-bucket", "eu-central-1", "access-key", "secret",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	avatarURL, err := s3.Upload(context.Background(), userID, "image/png", []byte("png"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	key, ok := strings.CutPrefix(avatarURL, "https://cdn.acme.com/")
+	if !ok || !strings.HasPrefix(key, "avatars/"+userID.String()+"/") ||
+		!strings.HasSuffix(key, ".png") {
+		t.Errorf("unexpected avatar url: %s", avatarURL)
+	}
+
+	got := <-requests
+	if got.method != http.MethodPut || got.path != "/avatars-bucket/"+key ||
+		got.contentType != "image/png" || got.body != "png" {
+		t.Errorf("unexpected upload request: %+v", got)
+	}
+
+	if err := s3.Delete(context.Background(), "https://www.gravatar.com/avatar/abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := s3.Delete(context.Background(), avatarURL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got = <-requests
+	if got.method != http.MethodDelete || got.path != "/avatars-bucket/"+key {
+		t.Errorf("unexpected delete request: %+v", got)
+	}
+}
+
+func TestS3Error(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+	}))
+	defer server.Close()
+
+	s3, err := storage.NewS3(server.URL, "", "avatars", "us-east-1", "access-key", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s3.Upload(
+		context.Background(), uuid.New(), "image/png", []byte("png"),
+	); !strings.Contains(err.Error(), "AccessDenied") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Package storage stores the avatars uploaded by the users in S3-compatible object storages
+// or in Nhost storage.
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var ErrUnexpectedStatus = errors.New("unexpected status code")
+
+// maxErrorBodySize is how much of the body of an error response is kept in the error.
+const maxErrorBodySize = 1024
+
+func checkResponse(resp *http.Response, expected ...int) error {
+	for _, status := range expected {
+		if resp.StatusCode == status {
+			return nil
+		}
+	}
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return fmt.Errorf("%w %d: %s", ErrUnexpectedStatus, resp.StatusCode, string(b))
+}
+
+// objectName returns a random name for an object so every upload gets its own URL and
+// cached copies of a previous avatar aren't served.
+func objectName() string {
+	b := make([]byte, 16) //nolint:mnd
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Extension returns the file extension of the content type of an image.
+func Extension(contentType string) string {
+	_, ext, _ := strings.Cut(contentType, "/")
+	if ext == "jpeg" {
+		return "jpg"
+	}
+	return ext
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Middleware starts a span for every request, continuing the trace of the caller if the
+// request has a traceparent header. The span is named after the route so requests to the
+// same endpoint are grouped together. The handlers receive the gin context so the engine
+// needs ContextWithFallback for the span to reach the database queries and clients. The
+// request context isn't canceled when the client disconnects so enabling tracing doesn't
+// interrupt requests halfway through.
+func Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		reqCtx := otel.GetTextMapPropagator().Extract(
+			ctx.Request.Context(), propagation.HeaderCarrier(ctx.Request.Header),
+		)
+
+		route := ctx.FullPath()
+		name := ctx.Request.Method
+		if route != "" {
+			name += " " + route
+		}
+
+		reqCtx, span := tracer().Start(
+			reqCtx,
+			name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(ctx.Request.Method),
+				semconv.URLPath(ctx.Request.URL.Path),
+				semconv.HTTPRoute(route),
+			),
+		)
+		defer span.End()
+
+		ctx.Request = ctx.Request.WithContext(context.WithoutCancel(reqCtx))
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
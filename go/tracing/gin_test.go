@@ -0,0 +1,61 @@
+package tracing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nhost/hasura-auth/go/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) { //nolint:paralleltest
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var handlerSpan trace.SpanContext
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.Use(tracing.Middleware())
+	router.GET("/users/:id", func(ctx *gin.Context) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		ctx.Status(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/123", nil)
+	req.Header.Set("Traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+
+	if span.Name != "GET /users/:id" {
+		t.Errorf("unexpected span name: %s", span.Name)
+	}
+
+	if got := span.SpanContext.TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the trace of the caller to be continued, got %s", got)
+	}
+
+	if got := span.Parent.SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("unexpected parent span: %s", got)
+	}
+
+	if !handlerSpan.Equal(span.SpanContext) {
+		t.Error("expected the span to be in the context of the handler")
+	}
+
+	if span.Status.Code != codes.Error {
+		t.Errorf("expected error status, got %s", span.Status.Code)
+	}
+}
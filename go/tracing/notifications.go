@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/nhost/hasura-auth/go/notifications"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type emailProvider interface {
+	Send(ctx context.Context, to, subject, body string, headers map[string]string) error
+}
+
+// EmailProvider starts a span for every email sent by the wrapped provider.
+type EmailProvider struct {
+	provider emailProvider
+}
+
+func NewEmailProvider(provider emailProvider) *EmailProvider {
+	return &EmailProvider{
+		provider: provider,
+	}
+}
+
+func (p *EmailProvider) Send(
+	ctx context.Context, to, subject, body string, headers map[string]string,
+) error {
+	ctx, span := tracer().Start(ctx, "email send", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	if err := p.provider.Send(ctx, to, subject, body, headers); err != nil {
+		recordError(span, err)
+		return err //nolint:wrapcheck
+	}
+
+	return nil
+}
+
+func (p *EmailProvider) Ping(ctx context.Context) error {
+	return notifications.Ping(ctx, p.provider) //nolint:wrapcheck
+}
+
+type hibpClient interface {
+	IsPasswordPwned(ctx context.Context, password string) (bool, error)
+}
+
+// HIBPClient starts a span for every password checked by the wrapped client.
+type HIBPClient struct {
+	client hibpClient
+}
+
+func NewHIBPClient(client hibpClient) *HIBPClient {
+	return &HIBPClient{
+		client: client,
+	}
+}
+
+func (c *HIBPClient) IsPasswordPwned(ctx context.Context, password string) (bool, error) {
+	ctx, span := tracer().Start(ctx, "hibp check", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	pwned, err := c.client.IsPasswordPwned(ctx, password)
+	if err != nil {
+		recordError(span, err)
+		return false, err //nolint:wrapcheck
+	}
+
+	span.SetAttributes(attribute.Bool("hibp.pwned", pwned))
+
+	return pwned, nil
+}
@@ -0,0 +1,128 @@
+// Package ttlcache keeps values in memory for a while. Caches are bounded, once full
+// expired entries are dropped first and, if none are, a random one.
+package ttlcache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Cache keeps each value for ttl and at most maxEntries values. It is safe for
+// concurrent use.
+type Cache[K comparable, V any] struct {
+	ttl        time.Duration
+	maxEntries int
+	mu         sync.Mutex
+	entries    map[K]entry[V]
+	// generation changes with every deletion so values read before it aren't cached
+	generation uint64
+}
+
+func New[K comparable, V any](ttl time.Duration, maxEntries int) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		mu:         sync.Mutex{},
+		entries:    make(map[K]entry[V]),
+		generation: 0,
+	}
+}
+
+// Get returns the value of the key if it is cached and hasn't expired.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// Generation returns the current generation, to be passed to SetIfUnchanged.
+func (c *Cache[K, V]) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+// Set caches the value of the key.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, value)
+}
+
+// SetIfUnchanged caches the value of the key unless an entry was deleted since
+// generation was returned by Generation, in which case the value may be stale.
+func (c *Cache[K, V]) SetIfUnchanged(key K, value V, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	c.set(key, value)
+}
+
+func (c *Cache[K, V]) set(key K, value V) {
+	now := time.Now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		for k, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+
+		// if we are still full we drop a random entry, the map iteration order is random
+		for k := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = entry[V]{
+		value:     value,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+// Delete evicts the value of the key.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+	c.generation++
+}
+
+// Clear evicts every value.
+func (c *Cache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+	c.generation++
+}
+
+// Len returns the number of entries, including the expired ones that haven't been
+// dropped yet.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
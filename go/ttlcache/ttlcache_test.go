@@ -0,0 +1,148 @@
+package ttlcache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nhost/hasura-auth/go/ttlcache"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		ttl        time.Duration
+		maxEntries int
+		run        func(t *testing.T, c *ttlcache.Cache[string, int])
+	}{
+		{
+			name:       "cached",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			run: func(t *testing.T, c *ttlcache.Cache[string, int]) {
+				t.Helper()
+
+				if _, ok := c.Get("a"); ok {
+					t.Errorf("unexpected value before it is set")
+				}
+
+				c.Set("a", 1)
+				c.Set("a", 2)
+				if v, ok := c.Get("a"); !ok || v != 2 {
+					t.Errorf("unexpected value: %d, %t", v, ok)
+				}
+			},
+		},
+		{
+			name:       "expired",
+			ttl:        -time.Second,
+			maxEntries: 10,
+			run: func(t *testing.T, c *ttlcache.Cache[string, int]) {
+				t.Helper()
+
+				c.Set("a", 1)
+				if _, ok := c.Get("a"); ok {
+					t.Errorf("expired value shouldn't be returned")
+				}
+			},
+		},
+		{
+			name:       "expired dropped first when full",
+			ttl:        -time.Second,
+			maxEntries: 2,
+			run: func(t *testing.T, c *ttlcache.Cache[string, int]) {
+				t.Helper()
+
+				c.Set("a", 1)
+				c.Set("b", 2)
+				c.Set("c", 3)
+				if c.Len() != 1 {
+					t.Errorf("expired values should've been dropped: %d", c.Len())
+				}
+			},
+		},
+		{
+			name:       "bounded",
+			ttl:        time.Minute,
+			maxEntries: 2,
+			run: func(t *testing.T, c *ttlcache.Cache[string, int]) {
+				t.Helper()
+
+				c.Set("a", 1)
+				c.Set("b", 2)
+				c.Set("b", 3)
+				if c.Len() != 2 {
+					t.Errorf("updating a value shouldn't drop another: %d", c.Len())
+				}
+
+				c.Set("c", 4)
+				if c.Len() != 2 {
+					t.Errorf("unexpected number of entries: %d", c.Len())
+				}
+				if v, ok := c.Get("c"); !ok || v != 4 {
+					t.Errorf("unexpected value: %d, %t", v, ok)
+				}
+			},
+		},
+		{
+			name:       "deleted",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			run: func(t *testing.T, c *ttlcache.Cache[string, int]) {
+				t.Helper()
+
+				c.Set("a", 1)
+				c.Set("b", 2)
+				c.Delete("a")
+				if _, ok := c.Get("a"); ok {
+					t.Errorf("deleted value shouldn't be returned")
+				}
+				if _, ok := c.Get("b"); !ok {
+					t.Errorf("other values should be kept")
+				}
+
+				c.Clear()
+				if c.Len() != 0 {
+					t.Errorf("unexpected number of entries: %d", c.Len())
+				}
+			},
+		},
+		{
+			name:       "set if unchanged",
+			ttl:        time.Minute,
+			maxEntries: 10,
+			run: func(t *testing.T, c *ttlcache.Cache[string, int]) {
+				t.Helper()
+
+				generation := c.Generation()
+				c.SetIfUnchanged("a", 1, generation)
+				if _, ok := c.Get("a"); !ok {
+					t.Errorf("value should be set if nothing was deleted")
+				}
+
+				generation = c.Generation()
+				c.Delete("b")
+				c.SetIfUnchanged("b", 2, generation)
+				if _, ok := c.Get("b"); ok {
+					t.Errorf("value read before a deletion shouldn't be set")
+				}
+
+				generation = c.Generation()
+				c.Clear()
+				c.SetIfUnchanged("c", 3, generation)
+				if _, ok := c.Get("c"); ok {
+					t.Errorf("value read before clearing the cache shouldn't be set")
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.run(t, ttlcache.New[string, int](tc.ttl, tc.maxEntries))
+		})
+	}
+}
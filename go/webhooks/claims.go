@@ -0,0 +1,70 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type claimsRequest struct {
+	UserID string `json:"userId"`
+}
+
+type claimsResponse struct {
+	Claims map[string]any `json:"claims"`
+}
+
+// ClaimsHook asks an external endpoint for the custom claims of the user every time an
+// access token is issued, so they can be computed by the backend of the application, for
+// instance from the organization or the subscription of the user.
+type ClaimsHook struct {
+	url    string
+	secret []byte
+	cl     *http.Client
+}
+
+func NewClaimsHook(url string, secret string, timeout time.Duration) *ClaimsHook {
+	return &ClaimsHook{
+		url:    url,
+		secret: []byte(secret),
+		cl:     &http.Client{Timeout: timeout}, //nolint:exhaustruct
+	}
+}
+
+// GetClaims returns the claims in the response of the endpoint. The endpoint must respond
+// with a 200 status code and a body like {"claims": {"org-id": "acme"}}.
+func (h *ClaimsHook) GetClaims(ctx context.Context, userID string) (map[string]any, error) {
+	body, err := json.Marshal(claimsRequest{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(h.secret, time.Now(), body))
+
+	resp, err := h.cl.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf( //nolint:goerr113
+			"unexpected status code: %d", resp.StatusCode,
+		)
+	}
+
+	var claims claimsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return claims.Claims, nil
+}
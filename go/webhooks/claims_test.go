@@ -0,0 +1,85 @@
+package webhooks_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func TestClaimsHookGetClaims(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expected    map[string]any
+		expectedErr bool
+	}{
+		{
+			name:     "success",
+			status:   http.StatusOK,
+			response: `{"claims":{"org-id":"acme","plan":"pro","teams":["a","b"]}}`,
+			expected: map[string]any{
+				"org-id": "acme",
+				"plan":   "pro",
+				"teams":  []any{"a", "b"},
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "no claims",
+			status:      http.StatusOK,
+			response:    `{}`,
+			expected:    nil,
+			expectedErr: false,
+		},
+		{
+			name:        "server error",
+			status:      http.StatusBadGateway,
+			response:    ``,
+			expected:    nil,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					b, _ := io.ReadAll(r.Body)
+					if string(b) != `{"userId":"db477732-48fa-4289-b694-2886a646b6eb"}` {
+						t.Errorf("unexpected request: %s", b)
+					}
+					if r.Header.Get(webhooks.HeaderSignature) == "" {
+						t.Error("missing signature")
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			hook := webhooks.NewClaimsHook(server.URL, "secret", time.Second)
+			got, err := hook.GetClaims(
+				context.Background(), "db477732-48fa-4289-b694-2886a646b6eb",
+			)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected claims (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
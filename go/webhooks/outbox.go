@@ -0,0 +1,159 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+)
+
+const (
+	outboxBatchSize = 50
+	// outboxLease is how long a claimed delivery is hidden from other workers while it is
+	// being sent.
+	outboxLease = time.Minute
+)
+
+type OutboxDB interface {
+	InsertWebhookOutbox(ctx context.Context, arg sql.InsertWebhookOutboxParams) error
+	ClaimWebhookOutbox(
+		ctx context.Context, arg sql.ClaimWebhookOutboxParams,
+	) ([]sql.AuthWebhookOutbox, error)
+	DeleteWebhookOutbox(ctx context.Context, id uuid.UUID) error
+	RescheduleWebhookOutbox(ctx context.Context, arg sql.RescheduleWebhookOutboxParams) error
+	FailWebhookOutbox(ctx context.Context, arg sql.FailWebhookOutboxParams) error
+}
+
+// Outbox stores the deliveries of the events in the auth.webhook_outbox table instead of
+// sending them right away. As they are stored with the context of the request, events of
+// a transaction are only delivered if it is committed. Run posts them in the background
+// with the Sender, retrying failures with an exponential backoff. Deliveries that fail
+// permanently, or too many times, are kept with the failed status.
+type Outbox struct {
+	db     OutboxDB
+	sender *Sender
+	logger *slog.Logger
+}
+
+func NewOutbox(db OutboxDB, sender *Sender, logger *slog.Logger) *Outbox {
+	return &Outbox{
+		db:     db,
+		sender: sender,
+		logger: logger,
+	}
+}
+
+// Send stores a delivery of the event for every endpoint subscribed to it.
+func (o *Outbox) Send(ctx context.Context, event Event) {
+	endpoints := slices.Concat(o.sender.endpoints[event.Type], o.sender.endpoints[AllEvents])
+	if len(endpoints) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		o.logger.Error("error marshalling webhook event", slog.String("error", err.Error()))
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		if err := o.db.InsertWebhookOutbox(ctx, sql.InsertWebhookOutboxParams{
+			Endpoint: endpoint,
+			Payload:  body,
+		}); err != nil {
+			o.logger.Error(
+				"error inserting webhook delivery in outbox",
+				slog.String("event", string(event.Type)),
+				slog.String("endpoint", endpoint),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// Run sends the pending deliveries every interval until the context is done.
+func (o *Outbox) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := o.Process(ctx); err != nil {
+				o.logger.Error("error processing webhook outbox", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// Process sends the deliveries that are due.
+func (o *Outbox) Process(ctx context.Context) error {
+	deliveries, err := o.db.ClaimWebhookOutbox(ctx, sql.ClaimWebhookOutboxParams{
+		LeaseExpiresAt: sql.TimestampTz(time.Now().Add(outboxLease)),
+		BatchSize:      outboxBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error claiming webhook deliveries from outbox: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := o.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *Outbox) deliver(ctx context.Context, delivery sql.AuthWebhookOutbox) error {
+	var event Event
+	if err := json.Unmarshal(delivery.Payload, &event); err != nil {
+		return fmt.Errorf("error unmarshalling webhook event: %w", err)
+	}
+
+	logger := o.logger.With(
+		slog.String("event", string(event.Type)),
+		slog.String("delivery", event.ID.String()),
+		slog.String("endpoint", delivery.Endpoint),
+		slog.Int("attempt", int(delivery.Attempts)+1),
+	)
+
+	retry, postErr := o.sender.post(ctx, delivery.Endpoint, event, delivery.Payload)
+	switch {
+	case postErr == nil:
+		if err := o.db.DeleteWebhookOutbox(ctx, delivery.ID); err != nil {
+			return fmt.Errorf("error deleting sent webhook delivery from outbox: %w", err)
+		}
+	case retry && int(delivery.Attempts)+1 < o.sender.maxAttempts:
+		backoff := o.sender.backoff << delivery.Attempts
+		logger.Warn(
+			"webhook delivery failed, retrying later",
+			slog.String("error", postErr.Error()),
+			slog.Duration("backoff", backoff),
+		)
+		if err := o.db.RescheduleWebhookOutbox(ctx, sql.RescheduleWebhookOutboxParams{
+			ID:            delivery.ID,
+			NextAttemptAt: sql.TimestampTz(time.Now().Add(backoff)),
+			LastError:     sql.Text(postErr.Error()),
+		}); err != nil {
+			return fmt.Errorf("error rescheduling webhook delivery: %w", err)
+		}
+	default:
+		logger.Error("webhook delivery failed", slog.String("error", postErr.Error()))
+		if err := o.db.FailWebhookOutbox(ctx, sql.FailWebhookOutboxParams{
+			ID:        delivery.ID,
+			LastError: sql.Text(postErr.Error()),
+		}); err != nil {
+			return fmt.Errorf("error marking webhook delivery as failed: %w", err)
+		}
+	}
+
+	return nil
+}
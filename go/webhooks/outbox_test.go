@@ -0,0 +1,232 @@
+package webhooks_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/sql"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+// fakeOutboxDB keeps the outbox in memory and ignores the lease and next attempt time so
+// every pending delivery is claimed.
+type fakeOutboxDB struct {
+	deliveries map[uuid.UUID]*sql.AuthWebhookOutbox
+}
+
+func (db *fakeOutboxDB) InsertWebhookOutbox(
+	_ context.Context, arg sql.InsertWebhookOutboxParams,
+) error {
+	id := uuid.New()
+	db.deliveries[id] = &sql.AuthWebhookOutbox{ //nolint:exhaustruct
+		ID:       id,
+		Endpoint: arg.Endpoint,
+		Payload:  arg.Payload,
+		Status:   "pending",
+	}
+	return nil
+}
+
+func (db *fakeOutboxDB) ClaimWebhookOutbox(
+	_ context.Context, _ sql.ClaimWebhookOutboxParams,
+) ([]sql.AuthWebhookOutbox, error) {
+	deliveries := make([]sql.AuthWebhookOutbox, 0, len(db.deliveries))
+	for _, delivery := range db.deliveries {
+		if delivery.Status == "pending" {
+			deliveries = append(deliveries, *delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+func (db *fakeOutboxDB) DeleteWebhookOutbox(_ context.Context, id uuid.UUID) error {
+	delete(db.deliveries, id)
+	return nil
+}
+
+func (db *fakeOutboxDB) RescheduleWebhookOutbox(
+	_ context.Context, arg sql.RescheduleWebhookOutboxParams,
+) error {
+	db.deliveries[arg.ID].Attempts++
+	db.deliveries[arg.ID].LastError = arg.LastError
+	return nil
+}
+
+func (db *fakeOutboxDB) FailWebhookOutbox(
+	_ context.Context, arg sql.FailWebhookOutboxParams,
+) error {
+	db.deliveries[arg.ID].Attempts++
+	db.deliveries[arg.ID].Status = "failed"
+	db.deliveries[arg.ID].LastError = arg.LastError
+	return nil
+}
+
+type outboxDelivery struct {
+	Status    string
+	Attempts  int32
+	LastError string
+}
+
+func TestOutbox(t *testing.T) {
+	t.Parallel()
+
+	event := webhooks.Event{
+		ID:        uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"),
+		Type:      webhooks.EventUserCreated,
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		User: webhooks.User{
+			ID:          uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+			Email:       "jane@acme.com",
+			PhoneNumber: "",
+			DisplayName: "Jane",
+			IsAnonymous: false,
+		},
+		IPAddress: "",
+		UserAgent: "",
+	}
+
+	body := `{"id":"2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24","type":"user.created","createdAt":"2024-01-01T00:00:00Z","user":{"id":"db477732-48fa-4289-b694-2886a646b6eb","email":"jane@acme.com","displayName":"Jane","isAnonymous":false}}` //nolint:lll
+
+	cases := []struct {
+		name          string
+		statuses      []int
+		runs          int
+		expected      []outboxDelivery
+		expectedCalls int
+	}{
+		{
+			name:          "delivered",
+			statuses:      []int{http.StatusOK},
+			runs:          1,
+			expected:      []outboxDelivery{},
+			expectedCalls: 1,
+		},
+		{
+			name:     "server error",
+			statuses: []int{http.StatusServiceUnavailable},
+			runs:     1,
+			expected: []outboxDelivery{
+				{Status: "pending", Attempts: 1, LastError: "unexpected status code: 503"},
+			},
+			expectedCalls: 1,
+		},
+		{
+			name:          "server error then delivered",
+			statuses:      []int{http.StatusTooManyRequests, http.StatusOK},
+			runs:          2,
+			expected:      []outboxDelivery{},
+			expectedCalls: 2,
+		},
+		{
+			name: "too many failures",
+			statuses: []int{
+				http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway,
+			},
+			runs: 4,
+			expected: []outboxDelivery{
+				{Status: "failed", Attempts: 3, LastError: "unexpected status code: 502"},
+			},
+			expectedCalls: 3,
+		},
+		{
+			name:     "client errors aren't retried",
+			statuses: []int{http.StatusBadRequest},
+			runs:     2,
+			expected: []outboxDelivery{
+				{Status: "failed", Attempts: 1, LastError: "unexpected status code: 400"},
+			},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu    sync.Mutex
+				calls []received
+			)
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					b, _ := io.ReadAll(r.Body)
+
+					mu.Lock()
+					defer mu.Unlock()
+					calls = append(calls, received{
+						event:     r.Header.Get(webhooks.HeaderEvent),
+						delivery:  r.Header.Get(webhooks.HeaderDelivery),
+						signature: r.Header.Get(webhooks.HeaderSignature),
+						body:      string(b),
+					})
+					w.WriteHeader(tc.statuses[len(calls)-1])
+				}),
+			)
+			defer server.Close()
+
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sender := webhooks.NewSender(
+				map[webhooks.EventType][]string{
+					webhooks.EventUserCreated:  {server.URL},
+					webhooks.EventUserSignedIn: {"http://localhost:1"},
+				},
+				"secret",
+				time.Second,
+				3,
+				time.Millisecond,
+				logger,
+			)
+
+			db := &fakeOutboxDB{deliveries: make(map[uuid.UUID]*sql.AuthWebhookOutbox)}
+			outbox := webhooks.NewOutbox(db, sender, logger)
+
+			outbox.Send(context.Background(), event)
+			if len(db.deliveries) != 1 {
+				t.Fatalf("expected 1 delivery in the outbox, got %d", len(db.deliveries))
+			}
+
+			for range tc.runs {
+				if err := outbox.Process(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			got := make([]outboxDelivery, 0, len(db.deliveries))
+			for _, delivery := range db.deliveries {
+				got = append(got, outboxDelivery{
+					Status:    delivery.Status,
+					Attempts:  delivery.Attempts,
+					LastError: delivery.LastError.String,
+				})
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected outbox (-want +got):\n%s", diff)
+			}
+
+			if len(calls) != tc.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", tc.expectedCalls, len(calls))
+			}
+
+			for _, call := range calls {
+				if call.delivery != event.ID.String() {
+					t.Errorf("unexpected delivery header: %s", call.delivery)
+				}
+				if diff := cmp.Diff(body, call.body); diff != "" {
+					t.Errorf("unexpected body (-want +got):\n%s", diff)
+				}
+				if call.signature == "" {
+					t.Error("missing signature header")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PreSignUpRequest is the body posted to the pre sign up hook with the user about to be
+// created. Provider is only set when signing up with an OAuth2, OIDC or SAML provider.
+type PreSignUpRequest struct {
+	Email        string         `json:"email,omitempty"`
+	PhoneNumber  string         `json:"phoneNumber,omitempty"`
+	DisplayName  string         `json:"displayName"`
+	Locale       string         `json:"locale"`
+	DefaultRole  string         `json:"defaultRole"`
+	AllowedRoles []string       `json:"allowedRoles"`
+	Metadata     map[string]any `json:"metadata"`
+	Provider     string         `json:"provider,omitempty"`
+	IsAnonymous  bool           `json:"isAnonymous"`
+	IPAddress    string         `json:"ipAddress,omitempty"`
+	UserAgent    string         `json:"userAgent,omitempty"`
+}
+
+// PreSignUpResponse is the decision of the pre sign up hook. Message is sent to the client
+// when the sign up is rejected. DefaultRole, AllowedRoles and Metadata replace the ones of
+// the user when set.
+type PreSignUpResponse struct {
+	Allow        bool            `json:"allow"`
+	Message      string          `json:"message,omitempty"`
+	DefaultRole  *string         `json:"defaultRole,omitempty"`
+	AllowedRoles *[]string       `json:"allowedRoles,omitempty"`
+	Metadata     *map[string]any `json:"metadata,omitempty"`
+}
+
+// PreSignUpHook posts the users about to sign up to an external endpoint, signed like the
+// webhooks, so it can accept, reject or change them before they are created.
+type PreSignUpHook struct {
+	url    string
+	secret []byte
+	cl     *http.Client
+}
+
+func NewPreSignUpHook(url string, secret string, timeout time.Duration) *PreSignUpHook {
+	return &PreSignUpHook{
+		url:    url,
+		secret: []byte(secret),
+		cl:     &http.Client{Timeout: timeout}, //nolint:exhaustruct
+	}
+}
+
+// Call returns the decision of the hook. Errors, including responses with a status code
+// other than 200, mean no decision was made.
+func (h *PreSignUpHook) Call(
+	ctx context.Context, request PreSignUpRequest,
+) (PreSignUpResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return PreSignUpResponse{}, fmt.Errorf( //nolint:exhaustruct
+			"error marshalling request: %w", err,
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return PreSignUpResponse{}, fmt.Errorf( //nolint:exhaustruct
+			"error creating request: %w", err,
+		)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(h.secret, time.Now(), body))
+
+	resp, err := h.cl.Do(req)
+	if err != nil {
+		return PreSignUpResponse{}, fmt.Errorf( //nolint:exhaustruct
+			"error sending request: %w", err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return PreSignUpResponse{}, fmt.Errorf( //nolint:goerr113,exhaustruct
+			"unexpected status code: %d", resp.StatusCode,
+		)
+	}
+
+	var decision PreSignUpResponse
+	if err := json.NewDecoder(resp.Body).Decode(&decision); err != nil {
+		return PreSignUpResponse{}, fmt.Errorf( //nolint:exhaustruct
+			"error decoding response: %w", err,
+		)
+	}
+
+	return decision, nil
+}
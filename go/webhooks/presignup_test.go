@@ -0,0 +1,121 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func TestPreSignUpHookCall(t *testing.T) {
+	t.Parallel()
+
+	request := webhooks.PreSignUpRequest{
+		Email:        "jane@acme.com",
+		PhoneNumber:  "",
+		DisplayName:  "Jane",
+		Locale:       "en",
+		DefaultRole:  "user",
+		AllowedRoles: []string{"user"},
+		Metadata:     map[string]any{},
+		Provider:     "github",
+		IsAnonymous:  false,
+		IPAddress:    "192.168.1.1",
+		UserAgent:    "test",
+	}
+
+	cases := []struct {
+		name        string
+		status      int
+		response    string
+		expected    webhooks.PreSignUpResponse
+		expectedErr bool
+	}{
+		{
+			name:     "allowed",
+			status:   http.StatusOK,
+			response: `{"allow":true,"defaultRole":"customer","allowedRoles":["customer"],"metadata":{"plan":"free"}}`, //nolint:lll
+			expected: webhooks.PreSignUpResponse{
+				Allow:        true,
+				Message:      "",
+				DefaultRole:  ptr("customer"),
+				AllowedRoles: &[]string{"customer"},
+				Metadata:     &map[string]any{"plan": "free"},
+			},
+			expectedErr: false,
+		},
+		{
+			name:     "rejected",
+			status:   http.StatusOK,
+			response: `{"allow":false,"message":"Sign ups are invite only"}`,
+			expected: webhooks.PreSignUpResponse{
+				Allow:        false,
+				Message:      "Sign ups are invite only",
+				DefaultRole:  nil,
+				AllowedRoles: nil,
+				Metadata:     nil,
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "server error",
+			status:      http.StatusInternalServerError,
+			response:    `{"allow":true}`,
+			expected:    webhooks.PreSignUpResponse{}, //nolint:exhaustruct
+			expectedErr: true,
+		},
+		{
+			name:        "invalid response",
+			status:      http.StatusOK,
+			response:    `allow`,
+			expected:    webhooks.PreSignUpResponse{}, //nolint:exhaustruct
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if !strings.HasPrefix(r.Header.Get(webhooks.HeaderSignature), "t=") {
+						t.Errorf("missing signature: %s", r.Header.Get(webhooks.HeaderSignature))
+					}
+
+					var got webhooks.PreSignUpRequest
+					if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+						t.Errorf("error decoding request: %v", err)
+					}
+					if diff := cmp.Diff(request, got); diff != "" {
+						t.Errorf("unexpected request (-want +got):\n%s", diff)
+					}
+
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.response))
+				}),
+			)
+			defer server.Close()
+
+			hook := webhooks.NewPreSignUpHook(server.URL, "secret", time.Second)
+			got, err := hook.Call(context.Background(), request)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func ptr[T any](x T) *T {
+	return &x
+}
@@ -0,0 +1,247 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventUserCreated         EventType = "user.created"
+	EventUserSignedIn        EventType = "user.signed_in"
+	EventUserEmailVerified   EventType = "user.email_verified"
+	EventUserEmailChanged    EventType = "user.email_changed"
+	EventUserPasswordReset   EventType = "user.password_reset"
+	EventUserSessionsRevoked EventType = "user.sessions_revoked"
+
+	// AllEvents subscribes an endpoint to every event.
+	AllEvents EventType = "*"
+)
+
+const (
+	HeaderEvent     = "X-Hasura-Auth-Event"
+	HeaderDelivery  = "X-Hasura-Auth-Delivery"
+	HeaderSignature = "X-Hasura-Auth-Signature"
+)
+
+var ErrUnknownEvent = errors.New("unknown webhook event")
+
+func validEventType(eventType EventType) bool {
+	switch eventType {
+	case EventUserCreated,
+		EventUserSignedIn,
+		EventUserEmailVerified,
+		EventUserEmailChanged,
+		EventUserPasswordReset,
+		EventUserSessionsRevoked,
+		AllEvents:
+		return true
+	}
+	return false
+}
+
+// ParseEndpoints groups the event=url entries by event.
+func ParseEndpoints(entries []string) (map[EventType][]string, error) {
+	endpoints := make(map[EventType][]string, len(entries))
+	for _, entry := range entries {
+		event, endpoint, ok := strings.Cut(entry, "=")
+		if !ok || event == "" || endpoint == "" {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"invalid webhook endpoint %s, expected event=url", entry,
+			)
+		}
+
+		if !validEventType(EventType(event)) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, event)
+		}
+
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf( //nolint:goerr113
+				"invalid webhook url %s, expected an http or https url", endpoint,
+			)
+		}
+
+		endpoints[EventType(event)] = append(endpoints[EventType(event)], endpoint)
+	}
+
+	return endpoints, nil
+}
+
+type User struct {
+	ID          uuid.UUID `json:"id"`
+	Email       string    `json:"email,omitempty"`
+	PhoneNumber string    `json:"phoneNumber,omitempty"`
+	DisplayName string    `json:"displayName"`
+	IsAnonymous bool      `json:"isAnonymous"`
+}
+
+// Event is the body posted to the endpoints subscribed to its type.
+type Event struct {
+	ID        uuid.UUID `json:"id"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+	User      User      `json:"user"`
+	IPAddress string    `json:"ipAddress,omitempty"`
+	UserAgent string    `json:"userAgent,omitempty"`
+}
+
+// Sign returns the signature sent in the X-Hasura-Auth-Signature header, in the form
+// t=<unix timestamp>,v1=<hex encoded HMAC-SHA256 of "<timestamp>.<body>">. Receivers
+// should recompute it with the shared secret and reject old timestamps to prevent replays.
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(t + "."))
+	mac.Write(body)
+
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sender posts events, signed with a shared secret, to the endpoints subscribed to them.
+// Deliveries happen in the background, failed deliveries are retried with an exponential
+// backoff and given up after maxAttempts.
+type Sender struct {
+	endpoints   map[EventType][]string
+	secret      []byte
+	maxAttempts int
+	backoff     time.Duration
+	cl          *http.Client
+	logger      *slog.Logger
+	wg          sync.WaitGroup
+}
+
+func NewSender(
+	endpoints map[EventType][]string,
+	secret string,
+	timeout time.Duration,
+	maxAttempts int,
+	backoff time.Duration,
+	logger *slog.Logger,
+) *Sender {
+	return &Sender{
+		endpoints:   endpoints,
+		secret:      []byte(secret),
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		cl:          &http.Client{Timeout: timeout}, //nolint:exhaustruct
+		logger:      logger,
+		wg:          sync.WaitGroup{},
+	}
+}
+
+// Send delivers the event in the background to every endpoint subscribed to it.
+func (s *Sender) Send(ctx context.Context, event Event) {
+	endpoints := slices.Concat(s.endpoints[event.Type], s.endpoints[AllEvents])
+	if len(endpoints) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		s.logger.Error("error marshalling webhook event", slog.String("error", err.Error()))
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	for _, endpoint := range endpoints {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.deliver(ctx, endpoint, event, body)
+		}()
+	}
+}
+
+// Wait blocks until the deliveries in progress are done.
+func (s *Sender) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Sender) deliver(ctx context.Context, endpoint string, event Event, body []byte) {
+	logger := s.logger.With(
+		slog.String("event", string(event.Type)),
+		slog.String("delivery", event.ID.String()),
+		slog.String("endpoint", endpoint),
+	)
+
+	backoff := s.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := s.post(ctx, endpoint, event, body)
+		if err == nil {
+			return
+		}
+
+		if !retry || attempt >= s.maxAttempts {
+			logger.Error(
+				"webhook delivery failed",
+				slog.Int("attempt", attempt),
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		logger.Warn(
+			"webhook delivery failed, retrying",
+			slog.Int("attempt", attempt),
+			slog.String("error", err.Error()),
+			slog.Duration("backoff", backoff),
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends the event once and reports if a failure is worth retrying. Client errors,
+// other than 429 Too Many Requests, aren't retried as the endpoint rejected the event.
+func (s *Sender) post(
+	ctx context.Context, endpoint string, event Event, body []byte,
+) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(event.Type))
+	req.Header.Set(HeaderDelivery, event.ID.String())
+	req.Header.Set(HeaderSignature, Sign(s.secret, time.Now(), body))
+
+	resp, err := s.cl.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	retry := resp.StatusCode >= http.StatusInternalServerError ||
+		resp.StatusCode == http.StatusTooManyRequests
+
+	return retry, fmt.Errorf("unexpected status code: %d", resp.StatusCode) //nolint:goerr113
+}
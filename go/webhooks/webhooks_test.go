@@ -0,0 +1,245 @@
+package webhooks_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/nhost/hasura-auth/go/webhooks"
+)
+
+func TestParseEndpoints(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		entries     []string
+		expected    map[webhooks.EventType][]string
+		expectedErr bool
+	}{
+		{
+			name: "success",
+			entries: []string{
+				"user.created=https://crm.acme.com/hooks",
+				"user.created=https://analytics.acme.com/hooks",
+				"*=http://audit.internal/hooks",
+			},
+			expected: map[webhooks.EventType][]string{
+				webhooks.EventUserCreated: {
+					"https://crm.acme.com/hooks",
+					"https://analytics.acme.com/hooks",
+				},
+				webhooks.AllEvents: {"http://audit.internal/hooks"},
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "missing url",
+			entries:     []string{"user.created"},
+			expected:    nil,
+			expectedErr: true,
+		},
+		{
+			name:        "unknown event",
+			entries:     []string{"user.unknown=https://crm.acme.com/hooks"},
+			expected:    nil,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid url",
+			entries:     []string{"user.created=ftp://crm.acme.com/hooks"},
+			expected:    nil,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := webhooks.ParseEndpoints(tc.entries)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("unexpected endpoints (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := webhooks.ParseEndpoints(
+		[]string{"user.unknown=https://crm.acme.com/hooks"},
+	); !errors.Is(err, webhooks.ErrUnknownEvent) {
+		t.Errorf("expected ErrUnknownEvent, got %v", err)
+	}
+}
+
+type received struct {
+	event     string
+	delivery  string
+	signature string
+	body      string
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	event := webhooks.Event{
+		ID:        uuid.MustParse("2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24"),
+		Type:      webhooks.EventUserSignedIn,
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		User: webhooks.User{
+			ID:          uuid.MustParse("db477732-48fa-4289-b694-2886a646b6eb"),
+			Email:       "jane@acme.com",
+			PhoneNumber: "",
+			DisplayName: "Jane",
+			IsAnonymous: false,
+		},
+		IPAddress: "192.168.1.1",
+		UserAgent: "test",
+	}
+
+	body := `{"id":"2c35b6f3-c4b9-48e3-978a-d4d0f1d42e24","type":"user.signed_in","createdAt":"2024-01-01T00:00:00Z","user":{"id":"db477732-48fa-4289-b694-2886a646b6eb","email":"jane@acme.com","displayName":"Jane","isAnonymous":false},"ipAddress":"192.168.1.1","userAgent":"test"}` //nolint:lll
+
+	cases := []struct {
+		name          string
+		endpoints     func(url string) map[webhooks.EventType][]string
+		statuses      []int
+		expectedCalls int
+	}{
+		{
+			name: "delivered",
+			endpoints: func(url string) map[webhooks.EventType][]string {
+				return map[webhooks.EventType][]string{webhooks.EventUserSignedIn: {url}}
+			},
+			statuses:      []int{http.StatusOK},
+			expectedCalls: 1,
+		},
+		{
+			name: "subscribed to every event",
+			endpoints: func(url string) map[webhooks.EventType][]string {
+				return map[webhooks.EventType][]string{webhooks.AllEvents: {url}}
+			},
+			statuses:      []int{http.StatusNoContent},
+			expectedCalls: 1,
+		},
+		{
+			name: "not subscribed",
+			endpoints: func(url string) map[webhooks.EventType][]string {
+				return map[webhooks.EventType][]string{webhooks.EventUserCreated: {url}}
+			},
+			statuses:      nil,
+			expectedCalls: 0,
+		},
+		{
+			name: "retried on server errors",
+			endpoints: func(url string) map[webhooks.EventType][]string {
+				return map[webhooks.EventType][]string{webhooks.EventUserSignedIn: {url}}
+			},
+			statuses: []int{
+				http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK,
+			},
+			expectedCalls: 3,
+		},
+		{
+			name: "given up after max attempts",
+			endpoints: func(url string) map[webhooks.EventType][]string {
+				return map[webhooks.EventType][]string{webhooks.EventUserSignedIn: {url}}
+			},
+			statuses: []int{
+				http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway,
+			},
+			expectedCalls: 3,
+		},
+		{
+			name: "client errors aren't retried",
+			endpoints: func(url string) map[webhooks.EventType][]string {
+				return map[webhooks.EventType][]string{webhooks.EventUserSignedIn: {url}}
+			},
+			statuses:      []int{http.StatusBadRequest},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu    sync.Mutex
+				calls []received
+			)
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					b, _ := io.ReadAll(r.Body)
+
+					mu.Lock()
+					defer mu.Unlock()
+					calls = append(calls, received{
+						event:     r.Header.Get(webhooks.HeaderEvent),
+						delivery:  r.Header.Get(webhooks.HeaderDelivery),
+						signature: r.Header.Get(webhooks.HeaderSignature),
+						body:      string(b),
+					})
+					w.WriteHeader(tc.statuses[len(calls)-1])
+				}),
+			)
+			defer server.Close()
+
+			sender := webhooks.NewSender(
+				tc.endpoints(server.URL),
+				"secret",
+				time.Second,
+				3,
+				time.Millisecond,
+				slog.New(slog.NewTextHandler(io.Discard, nil)),
+			)
+			sender.Send(context.Background(), event)
+			sender.Wait()
+
+			if len(calls) != tc.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", tc.expectedCalls, len(calls))
+			}
+
+			for _, call := range calls {
+				if call.event != "user.signed_in" {
+					t.Errorf("unexpected event header: %s", call.event)
+				}
+				if call.delivery != event.ID.String() {
+					t.Errorf("unexpected delivery header: %s", call.delivery)
+				}
+				if diff := cmp.Diff(body, call.body); diff != "" {
+					t.Errorf("unexpected body (-want +got):\n%s", diff)
+				}
+				if call.signature == "" {
+					t.Error("missing signature header")
+				}
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	got := webhooks.Sign(
+		[]byte("secret"),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		[]byte(`{"type":"user.created"}`),
+	)
+
+	expected := "t=1704067200,v1=" +
+		"11cc050878aa12cdb206f7b82d245bce5dedcba5be412069a6e5ab073e4662c0"
+	if got != expected {
+		t.Errorf("unexpected signature: %s", got)
+	}
+}
@@ -12,6 +12,8 @@ var Version string
 
 //go:generate oapi-codegen -config go/api/server.cfg.yaml go/api/openapi.yaml
 //go:generate oapi-codegen -config go/api/types.cfg.yaml go/api/openapi.yaml
+//go:generate oapi-codegen -config go/api/client.cfg.yaml go/api/openapi.yaml
+//go:generate go run ./go/api/tsgen -spec go/api/openapi.yaml -out clients/typescript/src/client.gen.ts
 func main() {
 	serveCmd := cmd.CommandServe()
 	app := &cli.App{ //nolint:exhaustruct
@@ -19,7 +21,7 @@ func main() {
 		Version:  Version,
 		Usage:    "Nhost Auth API server",
 		Flags:    serveCmd.Flags,
-		Commands: []*cli.Command{},
+		Commands: []*cli.Command{cmd.CommandHIBPBloomFilter(), cmd.CommandMigrate(), cmd.CommandAdmin()},
 		Action:   serveCmd.Action,
 	}
 
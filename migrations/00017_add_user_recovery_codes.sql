@@ -0,0 +1,14 @@
+BEGIN;
+CREATE TABLE auth.user_recovery_codes (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  user_id uuid NOT NULL,
+  code_hash text NOT NULL,
+  UNIQUE (user_id, code_hash)
+);
+
+ALTER TABLE auth.user_recovery_codes
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+COMMENT ON TABLE auth.user_recovery_codes IS 'One-time MFA recovery codes. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+CREATE TABLE auth.device_codes (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  expires_at timestamp with time zone NOT NULL,
+  device_code_hash text NOT NULL UNIQUE,
+  user_code text NOT NULL,
+  user_id uuid,
+  denied boolean DEFAULT false NOT NULL,
+  last_polled_at timestamp with time zone
+);
+
+ALTER TABLE auth.device_codes
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- user codes are short so they are only unique among the pending requests, expired ones are
+-- pruned when new requests are started
+CREATE UNIQUE INDEX device_codes_user_code_pending_key ON auth.device_codes (user_code)
+  WHERE user_id IS NULL AND NOT denied;
+
+COMMENT ON TABLE auth.device_codes IS 'Pending device authorization requests (RFC 8628). Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
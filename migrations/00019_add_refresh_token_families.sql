@@ -0,0 +1,7 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens
+  ADD COLUMN family_id uuid DEFAULT public.gen_random_uuid () NOT NULL,
+  ADD COLUMN rotated_at timestamp with time zone;
+
+CREATE INDEX refresh_tokens_family_id_idx ON auth.refresh_tokens USING btree (family_id);
+COMMIT;
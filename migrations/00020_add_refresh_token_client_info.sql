@@ -0,0 +1,8 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens
+  ADD COLUMN last_used_at timestamp with time zone,
+  ADD COLUMN ip_address text,
+  ADD COLUMN user_agent text;
+
+CREATE INDEX refresh_tokens_user_id_idx ON auth.refresh_tokens USING btree (user_id);
+COMMIT;
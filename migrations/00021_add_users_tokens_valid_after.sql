@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN tokens_valid_after timestamp with time zone;
+
+COMMENT ON COLUMN auth.users.tokens_valid_after IS 'Access tokens issued before this time are rejected by Hasura Auth when access token revocation is enabled';
+COMMIT;
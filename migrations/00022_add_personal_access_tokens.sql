@@ -0,0 +1,27 @@
+BEGIN;
+CREATE TABLE auth.personal_access_tokens (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  user_id uuid NOT NULL,
+  name text DEFAULT '' NOT NULL,
+  token_hash text NOT NULL UNIQUE,
+  expires_at timestamp with time zone,
+  last_used_at timestamp with time zone,
+  metadata jsonb
+);
+
+ALTER TABLE auth.personal_access_tokens
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX personal_access_tokens_user_id_idx ON auth.personal_access_tokens (user_id);
+
+COMMENT ON TABLE auth.personal_access_tokens IS 'Long-lived tokens users can exchange for a session. Only the hash of the token is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+-- personal access tokens used to be stored as refresh tokens
+INSERT INTO auth.personal_access_tokens (id, created_at, user_id, name, token_hash, expires_at, metadata)
+SELECT id, created_at, user_id, COALESCE(metadata->>'name', ''), refresh_token_hash, expires_at, metadata
+FROM auth.refresh_tokens
+WHERE type = 'pat' AND refresh_token_hash IS NOT NULL;
+
+DELETE FROM auth.refresh_tokens WHERE type = 'pat';
+COMMIT;
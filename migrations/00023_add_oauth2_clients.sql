@@ -0,0 +1,15 @@
+BEGIN;
+CREATE TABLE auth.oauth2_clients (
+  client_id text NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  client_secret_hash text NOT NULL,
+  description text DEFAULT '' NOT NULL,
+  default_role text NOT NULL,
+  allowed_roles text[] DEFAULT '{}' NOT NULL
+);
+
+ALTER TABLE auth.oauth2_clients
+  ADD CONSTRAINT fk_default_role FOREIGN KEY (default_role) REFERENCES auth.roles (role) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+COMMENT ON TABLE auth.oauth2_clients IS 'OAuth2 clients that can get access tokens with the client credentials grant. Only the hash of the client secret is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+ALTER TABLE auth.oauth2_clients
+  ADD COLUMN token_exchange_enabled boolean DEFAULT false NOT NULL;
+
+CREATE TABLE auth.token_exchanges (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  client_id text NOT NULL,
+  user_id uuid NOT NULL,
+  roles text[] NOT NULL,
+  expires_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE auth.token_exchanges
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX token_exchanges_user_id_idx ON auth.token_exchanges (user_id);
+
+COMMENT ON TABLE auth.token_exchanges IS 'Record of the access tokens exchanged by OAuth2 clients on behalf of users. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+CREATE TABLE auth.email_templates (
+  locale text NOT NULL,
+  template text NOT NULL,
+  file text NOT NULL CHECK (file IN ('body.html', 'body.txt', 'subject.txt')),
+  content text NOT NULL,
+  updated_at timestamp with time zone DEFAULT now() NOT NULL,
+  PRIMARY KEY (locale, template, file)
+);
+
+COMMENT ON TABLE auth.email_templates IS 'Overrides of the email and sms templates. Each row replaces the file of the template for the given locale, for instance the body.html of the email-verify template in en. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE auth.users
+  ALTER COLUMN locale TYPE varchar(35);
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+CREATE TABLE auth.email_outbox (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  recipient text NOT NULL,
+  subject text NOT NULL,
+  body text NOT NULL,
+  headers jsonb DEFAULT '{}'::jsonb NOT NULL,
+  status text DEFAULT 'pending' NOT NULL CHECK (status IN ('pending', 'failed')),
+  attempts integer DEFAULT 0 NOT NULL,
+  next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+  last_error text
+);
+
+CREATE INDEX email_outbox_status_next_attempt_at_idx ON auth.email_outbox (status, next_attempt_at);
+
+COMMENT ON TABLE auth.email_outbox IS 'Emails waiting to be sent. Emails are removed once sent and kept with the failed status if they can''t be delivered. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+CREATE TABLE auth.email_change_reverts (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  user_id uuid NOT NULL,
+  ticket text NOT NULL UNIQUE,
+  old_email text NOT NULL,
+  expires_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE auth.email_change_reverts
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX email_change_reverts_user_id_idx ON auth.email_change_reverts (user_id);
+
+COMMENT ON TABLE auth.email_change_reverts IS 'Tickets sent to the previous email address of a user when the email is changed so the change can be undone. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
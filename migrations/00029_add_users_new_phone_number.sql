@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN new_phone_number text;
+
+COMMENT ON COLUMN auth.users.new_phone_number IS 'Phone number the user requested to change to. It replaces phone_number once the one time password sent to it is verified';
+COMMIT;
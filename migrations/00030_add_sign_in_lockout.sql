@@ -0,0 +1,18 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN failed_sign_in_attempts integer DEFAULT 0 NOT NULL,
+  ADD COLUMN last_failed_sign_in_at timestamp with time zone,
+  ADD COLUMN locked_until timestamp with time zone;
+
+COMMENT ON COLUMN auth.users.failed_sign_in_attempts IS 'Consecutive failed sign in attempts, reset after a successful sign in';
+COMMENT ON COLUMN auth.users.locked_until IS 'Sign in attempts are rejected until this time';
+
+CREATE TABLE auth.ip_sign_in_failures (
+  ip text NOT NULL PRIMARY KEY,
+  attempts integer DEFAULT 0 NOT NULL,
+  last_failed_at timestamp with time zone DEFAULT now() NOT NULL,
+  locked_until timestamp with time zone
+);
+
+COMMENT ON TABLE auth.ip_sign_in_failures IS 'Failed sign in attempts of each IP address. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens
+  ADD COLUMN device_fingerprint text GENERATED ALWAYS AS (md5(COALESCE(ip_address, '') || ' ' || COALESCE(user_agent, ''))) STORED;
+
+COMMENT ON COLUMN auth.refresh_tokens.device_fingerprint IS 'Hash of the IP address and user agent the refresh token was issued to, used to detect sign ins from new devices';
+
+CREATE INDEX refresh_tokens_user_id_device_fingerprint_idx ON auth.refresh_tokens (user_id, device_fingerprint);
+
+CREATE TABLE auth.new_device_sign_ins (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  user_id uuid NOT NULL,
+  ticket text NOT NULL UNIQUE,
+  ip_address text,
+  user_agent text,
+  expires_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE auth.new_device_sign_ins
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX new_device_sign_ins_user_id_idx ON auth.new_device_sign_ins (user_id);
+
+COMMENT ON TABLE auth.new_device_sign_ins IS 'Tickets sent to users when they sign in from a new device so they can sign out every session if it wasn''t them. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
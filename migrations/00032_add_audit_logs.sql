@@ -0,0 +1,21 @@
+BEGIN;
+CREATE TABLE auth.audit_logs (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  event text NOT NULL,
+  outcome text NOT NULL,
+  actor text NOT NULL,
+  user_id uuid,
+  ip_address text,
+  user_agent text,
+  metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+  CONSTRAINT audit_logs_outcome_check CHECK (outcome IN ('success', 'failure')),
+  CONSTRAINT audit_logs_actor_check CHECK (actor IN ('user', 'admin'))
+);
+
+CREATE INDEX audit_logs_created_at_idx ON auth.audit_logs (created_at);
+
+CREATE INDEX audit_logs_user_id_created_at_idx ON auth.audit_logs (user_id, created_at);
+
+COMMENT ON TABLE auth.audit_logs IS 'Authentication events such as sign ups, sign ins and admin actions. Entries are kept after the user is deleted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+CREATE INDEX users_created_at_id_idx ON auth.users (created_at, id);
+
+CREATE INDEX users_display_name_id_idx ON auth.users (display_name, id);
+
+CREATE INDEX users_email_id_idx ON auth.users ((COALESCE(email::text, '')), id);
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN banned_at timestamp with time zone,
+  ADD COLUMN banned_until timestamp with time zone,
+  ADD COLUMN ban_reason text;
+
+COMMENT ON COLUMN auth.users.banned_at IS 'When the user was banned, sign ins and token refreshes are rejected while the ban lasts';
+COMMENT ON COLUMN auth.users.banned_until IS 'When the ban expires, the ban is permanent if null';
+COMMIT;
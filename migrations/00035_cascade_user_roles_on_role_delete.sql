@@ -0,0 +1,5 @@
+BEGIN;
+ALTER TABLE auth.user_roles
+  DROP CONSTRAINT fk_role,
+  ADD CONSTRAINT fk_role FOREIGN KEY (role) REFERENCES auth.roles(role) ON UPDATE CASCADE ON DELETE CASCADE;
+COMMIT;
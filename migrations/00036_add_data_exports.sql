@@ -0,0 +1,20 @@
+BEGIN;
+CREATE TABLE auth.data_exports (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  user_id uuid NOT NULL REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE,
+  requested_by text NOT NULL CHECK (requested_by IN ('user', 'admin')),
+  status text DEFAULT 'pending' NOT NULL CHECK (status IN ('pending', 'completed', 'failed')),
+  attempts integer DEFAULT 0 NOT NULL,
+  next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+  last_error text,
+  data jsonb,
+  completed_at timestamp with time zone,
+  expires_at timestamp with time zone
+);
+
+CREATE INDEX data_exports_user_id_idx ON auth.data_exports (user_id);
+CREATE INDEX data_exports_status_next_attempt_at_idx ON auth.data_exports (status, next_attempt_at);
+
+COMMENT ON TABLE auth.data_exports IS 'Exports of the data stored about users. Exports are assembled in the background and deleted once they expire. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN deletion_scheduled_at timestamp with time zone;
+
+COMMENT ON COLUMN auth.users.deletion_scheduled_at IS 'When the user is deleted, sign ins are rejected until then unless the deletion is cancelled';
+
+CREATE TABLE auth.account_deletions (
+  user_id uuid NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  ticket text NOT NULL UNIQUE
+);
+
+ALTER TABLE auth.account_deletions
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX users_deletion_scheduled_at_idx ON auth.users (deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
+COMMENT ON TABLE auth.account_deletions IS 'Tickets sent to users when they schedule the deletion of their account so they can cancel it during the grace period. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
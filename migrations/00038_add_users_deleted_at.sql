@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN deleted_at timestamp with time zone;
+
+COMMENT ON COLUMN auth.users.deleted_at IS 'When the user was deleted with the anonymize deletion mode. Its personal data was erased and the row is kept so the rows referencing it stay valid';
+COMMIT;
@@ -0,0 +1,7 @@
+-- start a transaction
+BEGIN;
+INSERT INTO auth.providers (id)
+    VALUES ('scim')
+ON CONFLICT
+    DO NOTHING;
+COMMIT;
@@ -0,0 +1,45 @@
+BEGIN;
+ALTER TABLE auth.oauth2_clients
+  ADD COLUMN redirect_uris text[] DEFAULT '{}' NOT NULL,
+  ADD COLUMN skip_consent boolean DEFAULT false NOT NULL;
+
+COMMENT ON TABLE auth.oauth2_clients IS 'OAuth2 clients that can get access tokens with the client credentials grant or sign in users with OpenID Connect. Only the hash of the client secret is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+CREATE TABLE auth.oauth2_authorization_codes (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  expires_at timestamp with time zone NOT NULL,
+  code_hash text NOT NULL UNIQUE,
+  client_id text NOT NULL,
+  user_id uuid NOT NULL,
+  redirect_uri text NOT NULL,
+  scopes text[] NOT NULL,
+  nonce text,
+  code_challenge text
+);
+
+ALTER TABLE auth.oauth2_authorization_codes
+  ADD CONSTRAINT fk_client FOREIGN KEY (client_id) REFERENCES auth.oauth2_clients (client_id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE auth.oauth2_authorization_codes
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+COMMENT ON TABLE auth.oauth2_authorization_codes IS 'Authorization codes issued to OAuth2 clients signing in users with OpenID Connect. Only the hash of the code is stored. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+CREATE TABLE auth.oauth2_consents (
+  user_id uuid NOT NULL,
+  client_id text NOT NULL,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  updated_at timestamp with time zone DEFAULT now() NOT NULL,
+  scopes text[] NOT NULL,
+  PRIMARY KEY (user_id, client_id)
+);
+
+ALTER TABLE auth.oauth2_consents
+  ADD CONSTRAINT fk_client FOREIGN KEY (client_id) REFERENCES auth.oauth2_clients (client_id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE auth.oauth2_consents
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+COMMENT ON TABLE auth.oauth2_consents IS 'Scopes users allowed OAuth2 clients to access. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+-- the connections of each tenant set hasura_auth.tenant_id so the users they insert belong
+-- to it without changing the queries
+ALTER TABLE auth.users
+  ADD COLUMN tenant_id text DEFAULT NULLIF(current_setting('hasura_auth.tenant_id', true), '');
+
+CREATE INDEX users_tenant_id_idx ON auth.users USING btree (tenant_id) WHERE (tenant_id IS NOT NULL);
+
+COMMENT ON COLUMN auth.users.tenant_id IS 'Tenant the user belongs to when Hasura Auth serves several tenants, users can only sign in to their tenant';
+COMMIT;
@@ -0,0 +1,57 @@
+BEGIN;
+CREATE TABLE auth.organizations (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  name text NOT NULL
+);
+
+COMMENT ON TABLE auth.organizations IS 'Organizations users can be members of. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+CREATE TABLE auth.organization_members (
+  organization_id uuid NOT NULL,
+  user_id uuid NOT NULL,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  role text NOT NULL,
+  PRIMARY KEY (organization_id, user_id),
+  CONSTRAINT organization_members_role_check CHECK (role IN ('owner', 'admin', 'member'))
+);
+
+ALTER TABLE auth.organization_members
+  ADD CONSTRAINT fk_organization FOREIGN KEY (organization_id) REFERENCES auth.organizations (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE auth.organization_members
+  ADD CONSTRAINT fk_user FOREIGN KEY (user_id) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE INDEX organization_members_user_id_idx ON auth.organization_members (user_id);
+
+COMMENT ON TABLE auth.organization_members IS 'Members of the organizations and their role in the organization. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+CREATE TABLE auth.organization_invites (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  organization_id uuid NOT NULL,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  expires_at timestamp with time zone NOT NULL,
+  email auth.email NOT NULL,
+  role text NOT NULL,
+  ticket text NOT NULL UNIQUE,
+  invited_by uuid,
+  UNIQUE (organization_id, email),
+  CONSTRAINT organization_invites_role_check CHECK (role IN ('owner', 'admin', 'member'))
+);
+
+ALTER TABLE auth.organization_invites
+  ADD CONSTRAINT fk_organization FOREIGN KEY (organization_id) REFERENCES auth.organizations (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE auth.organization_invites
+  ADD CONSTRAINT fk_invited_by FOREIGN KEY (invited_by) REFERENCES auth.users (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+COMMENT ON TABLE auth.organization_invites IS 'Pending invitations to join an organization, they are deleted once accepted. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+
+ALTER TABLE auth.users
+  ADD COLUMN active_organization_id uuid;
+
+ALTER TABLE auth.users
+  ADD CONSTRAINT fk_active_organization FOREIGN KEY (active_organization_id) REFERENCES auth.organizations (id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+COMMENT ON COLUMN auth.users.active_organization_id IS 'Organization the access tokens of the user are issued for, it is only used if the user is a member of it';
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+CREATE TABLE auth.webhook_outbox (
+  id uuid DEFAULT public.gen_random_uuid () NOT NULL PRIMARY KEY,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  endpoint text NOT NULL,
+  payload jsonb NOT NULL,
+  status text DEFAULT 'pending' NOT NULL CHECK (status IN ('pending', 'failed')),
+  attempts integer DEFAULT 0 NOT NULL,
+  next_attempt_at timestamp with time zone DEFAULT now() NOT NULL,
+  last_error text
+);
+
+CREATE INDEX webhook_outbox_status_next_attempt_at_idx ON auth.webhook_outbox (status, next_attempt_at);
+
+COMMENT ON TABLE auth.webhook_outbox IS 'Webhook deliveries waiting to be sent. Deliveries are removed once sent and kept with the failed status if they can''t be delivered. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMIT;
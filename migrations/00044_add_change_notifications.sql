@@ -0,0 +1,48 @@
+BEGIN;
+-- notifies the changes to the rows hasura-auth caches so every instance can invalidate them,
+-- the argument is the column with the id of the user the row belongs to
+CREATE FUNCTION auth.notify_change ()
+  RETURNS TRIGGER
+  LANGUAGE plpgsql
+  AS $$
+DECLARE
+  _row record;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    _row := OLD;
+  ELSE
+    _row := NEW;
+  END IF;
+
+  PERFORM pg_notify('hasura_auth_changes', json_build_object(
+    'schema', TG_TABLE_SCHEMA,
+    'table', TG_TABLE_NAME,
+    'userId', CASE WHEN TG_NARGS > 0 THEN to_jsonb(_row) ->> TG_ARGV[0] END
+  )::text);
+
+  RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER notify_auth_users_changes
+  AFTER UPDATE OF disabled, default_role, banned_at, banned_until, deleted_at OR DELETE ON auth.users
+  FOR EACH ROW
+  EXECUTE FUNCTION auth.notify_change ('id');
+
+CREATE TRIGGER notify_auth_user_roles_changes
+  AFTER INSERT OR UPDATE OR DELETE ON auth.user_roles
+  FOR EACH ROW
+  EXECUTE FUNCTION auth.notify_change ('user_id');
+
+CREATE TRIGGER notify_auth_roles_changes
+  AFTER INSERT OR UPDATE OR DELETE ON auth.roles
+  FOR EACH ROW
+  EXECUTE FUNCTION auth.notify_change ();
+
+CREATE TRIGGER notify_auth_email_templates_changes
+  AFTER INSERT OR UPDATE OR DELETE ON auth.email_templates
+  FOR EACH ROW
+  EXECUTE FUNCTION auth.notify_change ();
+
+COMMENT ON FUNCTION auth.notify_change IS 'Notifies the changes to the rows cached by Hasura Auth on the hasura_auth_changes channel. Don''t modify it as Hasura Auth relies on it to function properly.';
+COMMIT;
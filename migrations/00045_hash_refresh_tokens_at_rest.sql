@@ -0,0 +1,15 @@
+BEGIN;
+-- refresh tokens issued before the refresh token id column was added kept the token itself
+-- as their id, they are given a new id so the token can only be checked against its hash
+UPDATE auth.refresh_tokens
+SET id = public.gen_random_uuid()
+WHERE refresh_token_hash = '\x' || encode(sha256(id::text::bytea), 'hex');
+
+-- refresh tokens without a hash can't be used to refresh a session
+DELETE FROM auth.refresh_tokens
+WHERE refresh_token_hash IS NULL;
+
+ALTER TABLE auth.refresh_tokens ALTER COLUMN refresh_token_hash SET NOT NULL;
+
+COMMENT ON COLUMN auth.refresh_tokens.refresh_token_hash IS 'SHA-256 of the refresh token, the refresh token itself is never stored';
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens
+  ADD COLUMN client_id text,
+  ADD COLUMN refresh_token_expires_in integer,
+  ADD COLUMN access_token_expires_in integer;
+
+COMMENT ON COLUMN auth.refresh_tokens.client_id IS 'Client the session was started by, as sent in the X-Hasura-Auth-Client header';
+COMMENT ON COLUMN auth.refresh_tokens.refresh_token_expires_in IS 'Seconds the refresh tokens of the session are valid for, NULL for the default lifetime';
+COMMENT ON COLUMN auth.refresh_tokens.access_token_expires_in IS 'Seconds the access tokens of the session are valid for, NULL for the default lifetime';
+COMMIT;
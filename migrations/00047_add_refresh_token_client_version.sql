@@ -0,0 +1,7 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens
+  ADD COLUMN client_version text,
+  ALTER COLUMN last_used_at SET DEFAULT now();
+
+COMMENT ON COLUMN auth.refresh_tokens.client_version IS 'Version of the client that last used the session, as sent in the X-Hasura-Auth-Client-Version header';
+COMMIT;
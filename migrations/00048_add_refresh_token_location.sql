@@ -0,0 +1,12 @@
+BEGIN;
+ALTER TABLE auth.refresh_tokens
+  ADD COLUMN country text,
+  ADD COLUMN city text,
+  ADD COLUMN latitude double precision,
+  ADD COLUMN longitude double precision;
+
+COMMENT ON COLUMN auth.refresh_tokens.country IS 'ISO code of the country the session was last used from, according to the GeoIP database';
+COMMENT ON COLUMN auth.refresh_tokens.city IS 'City the session was last used from, according to the GeoIP database';
+COMMENT ON COLUMN auth.refresh_tokens.latitude IS 'Approximate latitude the session was last used from, according to the GeoIP database';
+COMMENT ON COLUMN auth.refresh_tokens.longitude IS 'Approximate longitude the session was last used from, according to the GeoIP database';
+COMMIT;
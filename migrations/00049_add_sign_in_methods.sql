@@ -0,0 +1,15 @@
+BEGIN;
+CREATE TABLE auth.sign_in_methods (
+  method text PRIMARY KEY,
+  enabled boolean NOT NULL,
+  updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE auth.sign_in_methods IS 'Sign in methods enabled or disabled at runtime, overriding the configuration. Methods without a row follow the configuration. Don''t modify its structure as Hasura Auth relies on it to function properly.';
+COMMENT ON COLUMN auth.sign_in_methods.method IS 'Sign in method, for instance email-password, anonymous or provider-github';
+
+CREATE TRIGGER notify_auth_sign_in_methods_changes
+  AFTER INSERT OR UPDATE OR DELETE ON auth.sign_in_methods
+  FOR EACH ROW
+  EXECUTE FUNCTION auth.notify_change ();
+COMMIT;
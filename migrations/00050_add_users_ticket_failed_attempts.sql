@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN ticket_failed_attempts integer DEFAULT 0 NOT NULL;
+
+COMMENT ON COLUMN auth.users.ticket_failed_attempts IS 'Failed attempts to answer the MFA challenge of the current ticket, the ticket is invalidated after too many';
+COMMIT;
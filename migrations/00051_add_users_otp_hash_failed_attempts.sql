@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE auth.users
+  ADD COLUMN otp_hash_failed_attempts integer DEFAULT 0 NOT NULL;
+
+COMMENT ON COLUMN auth.users.otp_hash_failed_attempts IS 'Failed attempts to verify the current one time password sent by SMS, the one time password is invalidated after too many';
+COMMIT;
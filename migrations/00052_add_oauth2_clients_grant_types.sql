@@ -0,0 +1,9 @@
+BEGIN;
+ALTER TABLE auth.oauth2_clients
+  ADD COLUMN grant_types text[] DEFAULT '{client_credentials}' NOT NULL;
+
+-- clients with redirect URIs sign in users with OpenID Connect
+UPDATE auth.oauth2_clients SET grant_types = '{authorization_code}' WHERE redirect_uris <> '{}';
+
+COMMENT ON COLUMN auth.oauth2_clients.grant_types IS 'Grants the client can use to get access tokens, client_credentials or authorization_code. The token exchange grant is enabled with token_exchange_enabled';
+COMMIT;
@@ -0,0 +1,7 @@
+// Package migrations embeds the migrations of the auth schema so they ship with the binary.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
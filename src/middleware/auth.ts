@@ -42,7 +42,8 @@ export const authenticationGate = (
 }
 
 export const failsElevatedCheck = async (auth: RequestAuth, bypassIfNoKeys = false) => {
-  if (ENV.AUTH_REQUIRE_ELEVATED_CLAIM === 'disabled' || !ENV.AUTH_WEBAUTHN_ENABLED || auth.elevated) {
+  // sessions can be elevated with a password or TOTP code so the check doesn't depend on webauthn
+  if (ENV.AUTH_REQUIRE_ELEVATED_CLAIM === 'disabled' || auth.elevated) {
     return false;
   }
 
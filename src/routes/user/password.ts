@@ -3,7 +3,12 @@ import { ReasonPhrases } from 'http-status-codes';
 
 import { failsElevatedCheck } from '@/middleware/auth';
 
-import { gqlSdk, hashPassword, getUserByTicket } from '@/utils';
+import {
+  gqlSdk,
+  hashPassword,
+  getUserByTicket,
+  deleteUserRefreshTokens,
+} from '@/utils';
 import { sendError } from '@/errors';
 import { Joi, password } from '@/validation';
 
@@ -54,5 +59,11 @@ export const userPasswordHandler: RequestHandler<
       ticket: ticket ? null : undefined, // Hasura does not update when variable is undefined
     },
   });
+
+  // * Password reset tickets can request every session of the user to be signed out
+  if (ticket?.startsWith('passwordResetRevokeSessions:')) {
+    await deleteUserRefreshTokens(user.id);
+  }
+
   return res.json(ReasonPhrases.OK);
 };
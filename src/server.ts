@@ -22,7 +22,11 @@ export const start = async () => {
   // wait for hasura to be ready
   await waitForHasura();
   // apply migrations and metadata
-  await applyMigrations();
+  if (ENV.AUTH_SKIP_MIGRATIONS) {
+    logger.info('Skipping migrations, they were applied by the Go server');
+  } else {
+    await applyMigrations();
+  }
   await applyMetadata();
   // }
 
@@ -96,8 +96,20 @@ export type SignInResponse = {
 };
 
 export type JwtSecret = {
-  type: 'HS256' | 'HS238' | 'HS512' | 'RS256' | 'RS384' | 'RS512' | 'Ed25519';
+  type:
+    | 'HS256'
+    | 'HS238'
+    | 'HS512'
+    | 'RS256'
+    | 'RS384'
+    | 'RS512'
+    | 'ES256'
+    | 'ES384'
+    | 'ES512'
+    | 'Ed25519';
   key: string;
+  signing_key?: string;
+  kid?: string;
   jwk_url?: string;
   claims_namespace?: string;
   claims_namespace_path?: string;
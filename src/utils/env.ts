@@ -209,6 +209,11 @@ export const ENV = {
     return castStringEnv('AUTH_EMAIL_TEMPLATE_FETCH_URL');
   },
 
+  get AUTH_JWT_RETIRED_SECRETS(): JwtSecret[] {
+    const env = process.env.AUTH_JWT_RETIRED_SECRETS;
+    return env ? JSON.parse(env) : [];
+  },
+
   get AUTH_JWT_CUSTOM_CLAIMS() {
     try {
       return castObjectEnv<Record<string, string>>('AUTH_JWT_CUSTOM_CLAIMS');
@@ -242,7 +247,12 @@ export const ENV = {
 
   get AUTH_VERSION() {
     return castStringEnv('AUTH_VERSION', '0.0.0-dev');
-  }
+  },
+
+  // * Set by the Go server when it applies the migrations itself
+  get AUTH_SKIP_MIGRATIONS() {
+    return castBooleanEnv('AUTH_SKIP_MIGRATIONS', false);
+  },
 
   // * See ../server.ts
   // get AUTH_SKIP_INIT() {
@@ -3,7 +3,7 @@ import { ClaimValueType } from '@/types';
 import { UserFieldsFragment } from '../__generated__/graphql-request';
 import { ENV } from '../env';
 import { generateCustomClaims } from './custom-claims';
-import { createSecretKey } from 'crypto';
+import { signingKey, keyId } from './keys';
 
 /**
  * * Signs a payload with the existing JWT configuration
@@ -15,15 +15,15 @@ export const sign = async ({
   payload: JWTPayload;
   user: UserFieldsFragment;
 }) => {
-  const { key, type, issuer } = ENV.HASURA_GRAPHQL_JWT_SECRET;
-  const secret = createSecretKey(key, 'utf-8');
+  const { type, issuer } = ENV.HASURA_GRAPHQL_JWT_SECRET;
+  const kid = await keyId();
   return new SignJWT(payload)
-    .setProtectedHeader({ alg: type })
+    .setProtectedHeader(kid ? { alg: type, kid } : { alg: type })
     .setSubject(user.id)
     .setIssuedAt()
     .setExpirationTime(`${ENV.AUTH_ACCESS_TOKEN_EXPIRES_IN}s`)
     .setIssuer(issuer || 'hasura-auth')
-    .sign(secret);
+    .sign(signingKey());
 };
 
 /**
@@ -1,22 +1,43 @@
 import { Claims, PermissionVariables, Token } from '@/types';
-import { createSecretKey } from 'crypto';
-import { jwtVerify } from 'jose';
+import { decodeProtectedHeader, jwtVerify } from 'jose';
 import { ENV } from '../env';
+import { verificationKeys } from './keys';
 
-const ALLOWED_JWT_TYPES = ['HS256', 'HS384', 'HS512'];
+const ALLOWED_JWT_TYPES = [
+  'HS256',
+  'HS384',
+  'HS512',
+  'RS256',
+  'RS384',
+  'RS512',
+  'ES256',
+  'ES384',
+  'ES512',
+];
 
 if (!ALLOWED_JWT_TYPES.includes(ENV.HASURA_GRAPHQL_JWT_SECRET.type)) {
   throw new Error(`Invalid JWT type: ${ENV.HASURA_GRAPHQL_JWT_SECRET.type}`);
 }
 
-if (!ENV.HASURA_GRAPHQL_JWT_SECRET.key) {
+if (
+  !ENV.HASURA_GRAPHQL_JWT_SECRET.key &&
+  !ENV.HASURA_GRAPHQL_JWT_SECRET.signing_key
+) {
   throw new Error('Empty JWT key');
 }
 
 export const verifyJwt = async (jwt: string) => {
-  const secret = createSecretKey(ENV.HASURA_GRAPHQL_JWT_SECRET.key, 'utf-8');
-  const result = await jwtVerify(jwt, secret);
-  return result.payload as unknown as Token;
+  const keys = await verificationKeys(decodeProtectedHeader(jwt));
+  let error: unknown = new Error('Token is signed with an unknown key');
+  for (const key of keys) {
+    try {
+      const result = await jwtVerify(jwt, key);
+      return result.payload as unknown as Token;
+    } catch (err) {
+      error = err;
+    }
+  }
+  throw error;
 };
 
 /**
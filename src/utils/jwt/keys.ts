@@ -0,0 +1,82 @@
+import {
+  createPrivateKey,
+  createPublicKey,
+  createSecretKey,
+  KeyObject,
+} from 'crypto';
+import { calculateJwkThumbprint, JWK } from 'jose';
+import { JwtSecret } from '@/types';
+import { ENV } from '../env';
+
+const isSymmetric = (secret: JwtSecret) => secret.type.startsWith('HS');
+
+/**
+ * Key used to sign the tokens: the shared secret for HMAC algorithms, or the
+ * private key in `signing_key` for asymmetric ones.
+ */
+export const signingKey = (): KeyObject => {
+  const secret = ENV.HASURA_GRAPHQL_JWT_SECRET;
+  if (isSymmetric(secret)) {
+    return createSecretKey(secret.key, 'utf-8');
+  }
+  if (!secret.signing_key) {
+    throw new Error('Missing JWT signing key');
+  }
+  return createPrivateKey(secret.signing_key);
+};
+
+/**
+ * Key used to verify the tokens. Asymmetric algorithms use the public key in
+ * `key`, or derive it from the signing key when it is not set.
+ */
+const verifyKey = (secret: JwtSecret): KeyObject => {
+  if (isSymmetric(secret)) {
+    return createSecretKey(secret.key, 'utf-8');
+  }
+  return createPublicKey(secret.key || (secret.signing_key as string));
+};
+
+/**
+ * Key ID of asymmetric keys, defaults to the RFC 7638 thumbprint of the public
+ * key, same as the JWKS endpoint. HMAC keys only have one if it is configured.
+ */
+const secretKeyId = async (secret: JwtSecret): Promise<string | undefined> => {
+  if (secret.kid || isSymmetric(secret)) {
+    return secret.kid;
+  }
+  const jwk = verifyKey(secret).export({ format: 'jwk' }) as JWK;
+  return calculateJwkThumbprint(jwk, 'sha256');
+};
+
+/**
+ * Key ID added to the header of the tokens.
+ */
+export const keyId = async (): Promise<string | undefined> =>
+  secretKeyId(ENV.HASURA_GRAPHQL_JWT_SECRET);
+
+/**
+ * Keys a token may have been signed with: the current key and the retired
+ * ones in `AUTH_JWT_RETIRED_SECRETS`. Tokens with a `kid` header are verified
+ * with the key with that ID, tokens without one with every key of the same
+ * algorithm.
+ */
+export const verificationKeys = async ({
+  alg,
+  kid,
+}: {
+  alg?: string;
+  kid?: string;
+}): Promise<KeyObject[]> => {
+  const secrets = [
+    ENV.HASURA_GRAPHQL_JWT_SECRET,
+    ...ENV.AUTH_JWT_RETIRED_SECRETS,
+  ].filter((secret) => secret.type === alg);
+
+  const keys: KeyObject[] = [];
+  for (const secret of secrets) {
+    if (!kid || (await secretKeyId(secret)) === kid) {
+      keys.push(verifyKey(secret));
+    }
+  }
+  return keys;
+};